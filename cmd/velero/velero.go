@@ -20,6 +20,9 @@ import (
 	"os"
 	"path/filepath"
 
+	// the time zones of the schedules are loaded from the binary, as the image has no tzdata
+	_ "time/tzdata"
+
 	"k8s.io/klog/v2"
 
 	"github.com/vmware-tanzu/velero/pkg/cmd"
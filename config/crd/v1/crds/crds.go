@@ -29,17 +29,18 @@ import (
 )
 
 var rawCRDs = [][]byte{
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xccXO\xaf\xdb6\f\xbf\xe7S\x10\xdduNV\f\x1b\x86\xdc\xdal\x05\x8a\xb5\xc5CR\xbc\xbbbӉ\xfadI\x93\xa8tٟ\xef>P\xb2\x13\xc7V\xe2\x977`\x98n\x96H\x8a\xe4\x8f\xfcQIQ\x143a\xe5#:/\x8d^\x82\xb0\x12\x7f'\xd4\xfc\xe5\xe7O?\xf9\xb94\x8b\xc3\xebٓ\xd4\xd5\x12V\xc1\x93i\xd6\xe8Mp%\xfe\x8c\xb5Ԓ\xa4ѳ\x06IT\x82\xc4r\x06 \xb46$x\xdb\xf3'@i49\xa3\x14\xbab\x87z\xfe\x14\xb6\xb8\rRU\xe8\xa2\xf1\xee\xea\xc3w\xf3\xd7?\xce\x7f\x98\x01h\xd1\xe0\x12\xb6\xa2|\n֡5^\x92q\x12\xfd\xfc\x80\n\x9d\x99K3\xf3\x16K\xb6\xbes&\xd8%\x9c\x0f\x92v{s\xf2\xfam4\xb4\xee\f\x1d㑒\x9e~\xcd\x1e\x7f\x90\x9e\xa2\x88U\xc1\t\x95s$\x1e{\xa9wA\t7\x12\xe0\v|i,.\xe1\x13\xfbbE\x89\xd5\f\xa0\x8d4\xfaV\x80\xa8\xaa\x98;\xa1\x1e\x9cԄneTh\xba\x9c\x15\xf0\xc5\x1b\xfd h\xbf\x84y\x97\xddy\xe90&\xf6\xb3lГhl\x94\xed\x12\xf6f\x87\xed7\x1d\xf9\xf2J\x10\x8e\x8dq\xe6\xe6g_?\x1f-^X9'\x02zgɢ''\xf5nv\x16>\xbcN\xa9(\xf7؈e+k,\xea7\x0f\xef\x1f\xbf\xdf\\l\x03Xg,:\x92\x1d<i\xf5ʯ\xb7\vP\xa1/\x9d\xb4\x14\x8b\xe3\xaf\xe2\xe2\f\x80/HZPq\x1d\xa2\a\xdac\x97c\xacZ\x9f\xc0\xd4@{\xe9\xc1\xa1u\xe8Q\xa7\xca\xe4m\xa1\xc1l\xbf`I\xf3\x81\xe9\r:6\x03~o\x82\xaa\xb8|\x0f\xe8\b\x1c\x96f\xa7\xe5\x1f'\xdb\x1e\xc8\xc4K\x95 \xf4\x04\x11E-\x14\x1c\x84\n\xf8-\b]\r,7\xe2\b\x0e\xf9N\b\xbag/*\xf8\xa1\x1f\x1f\x8dC\x90\xba6K\xd8\x13Y\xbf\\,v\x92\xba\xa6,M\xd3\x04-鸈\xfd%\xb7\x81\x8c\xf3\x8b\n\x0f\xa8\x16^\xee\n\xe1ʽ$,)8\\\b+\x8b\x18\x88\x8e\x8d9o\xaao\\\xdb\xc6\xfe\xe2\xda\x11\xd0i\xc5N\xba\x03\x1en-\x90\x1eDk*\x85xF\x81\xb78u\xeb_6\x9f\xa1\xf3$!\x95@9\x8b\x8e\xf2\xd2\xe1\xc3ٔ\xbaF\x97\xf4jg\x9ah\x13ue\x8d\xd4\x14?J%Q\x13\xf8\xb0m$q\x19\xfc\x16\xd0\x13C74\xbb\x8a\xc4\x05[\x84`\xb9u\xaa\xa1\xc0{\r+ѠZ\t\x8f\xff1V\x8c\x8a/\x18\x84g\xa1է\xe3\xa1pJo\uf823\xd2+\xd0\x0e\xe9qc\xb1dd9\xb9\xac*kY\xa6\x9e\xaa\x8d\x031\x92\xbf\xccT\x9e\x02x%\x12ݐqb\x87\x1fL\xb29\x14\x9a*;^os\x86:\x8f\x99\xb6\x12'`^0c\x90\xf6\x82zd@B\xea\x13\xa7d\x83\xbc\x81LDG0Sh\xa1K|\x17\xebQ\x97ǉ@?fT8\xa4\xbd\xf9\n\xa6&\xd4}\xa3\xad\xaf\x99H\xb6\b.軜=Ǹ2\xba\x96\xbb\xb1\xa3\xfdAv\r܉K\x06Ѯ\awr\xa4\\\\g_\x8a\xae\xf2\x18\x90Z\ue0bb\x06^-QU#\n\x01\xd0A)\xb1U\xb8\x04r\x01\xafdd\xd4+\x97\x19\xe1\xf98\x01\xdc\xfaB\x18\xa4\xae\xb8[\xdaaŗt\xc5\xc8叺\x02w\xf9L\xe9/ԡ\x19_W\xc0\x93\xb1Rd\xf6\x1dz\x92e\xe6\xe0ի\xfb*\x80ͼ\xaf\x98\x8ej\x89\xee%=\xb9\x1e\xd8\xe8ڱ\x0eJ\xb5\x17\x14\xa5i\xac \xb9U\xd8\xcd\f\xc6\\&\x9dc\xaeh\xe0_\xb5\xe1\x81\xdf[xz\xa1\xbd$\xac\xc7K\x13}\x92I\x1bѿ\xc4l=7;\x16\xf1\x19\x93\xd6T\xadg\xad^,\xfd;\x02cz\x90\x0e\aӺ\xc8\xf3\xeb@&\xc7L\x03\x91a5\f\x8e\aI}\xd6\xfc!A\xc1\xdf3\x81\xa2B\x97\xec28\x17'|\xda\xe5\x87\u074bg\x90\x12\x9ezT\xcb\xcf쉲\xf80\xd6\xe8\x1ccc@\xbc\xc1\xc8\xf7s\x9b\x81݇\xb2D\xacƏ\x0e`\xf8\x1bA\xe99_\xb0\xbd\x97qY~\x14\xa1\xf7b7\x15\xe4\xc7$\x95\xdes\xad\n\x88\xad\tt\x05\x01\xda\xe7b\xbc\x8dʄ\xa7v/\xfc\x94\x9f\x0f,\x93\xab\x8b\xc1ȿ\xe5\xc25\x92\xfd\x84_3\xbbk\x14\u0558\xa8\v\xf8d(\x7ft\x93gK\xd4\xfdb\x9a\x1c-\x03y\x8e\xfc\x02\x83\xd6\xe4\xa8\xfe\xc6QK\xc2&;\xb4\xaf\xf7JZL\xda\n\tO\xbfH\xf3b\x03\xd7WC\xad\x13h\xe9\x80\x1fl\xb1s\xae\xd6R\x97\xb2\xa9\xc0Қn\xa1\xb4&\x1a)\xad\x9b\xef\x18\xb8\xd5T\x99L\xdc\xdbZWS\x91\xe0~^:&#p胢g\x05\xb0\x8e\xa2\x1d~I\xf1\\~\xcf\xf3'\xdfsi\x15\xb0\xe9\xa8\xf1\xaa\xc4;!\xd5\xd5\xe3\xc9`=\tG\xf7\xd5\xef\xe6B\xe5\xf4k\x88w\xfbu\xfb\xbf\xac\xcf\x1b/\xdb\xeeP8'\x8eӣ{\xb4\xe9\xf9\x87y\xd5sΧ\xd7F\x7f'lO\xff;,\xe1Ͽg\xff\x04\x00\x00\xff\xff\xe4\xeb\x14ǁ\x14\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=]s\xdb8\x92\xef\xf9\x15(\xdf\xc3\xecnI\xf6\xa6\ue8ee\xfc\x96q\x92\x1b\xd5\xce$\xaeؓ}\x86Ȗ\x841\bp\x01P\xb6\xf6\xee\xfe\xfb\x15\x1a\x00?D\x90\x04eٓ\xdd\v_\x12\x8b`\x03\xe8n\xf47\x80\xe5r\xf9\x86\x96\xec+(ͤ\xb8&\xb4d\xf0d@ؿ\xf4\xe5\xc3\x7f\xeaK&\xaf\xf6o\xdf<0\x91_\x93\x9bJ\x1bY|\x01-+\x95\xc1{\xd80\xc1\f\x93\xe2M\x01\x86\xe6\xd4\xd0\xeb7\x84P!\xa4\xa1\xf6gm\xff$$\x93\xc2(\xc99\xa8\xe5\x16\xc4\xe5C\xb5\x86u\xc5x\x0e\n\x81\x87\xae\xf7\x7f\xbe|\xfb\x1f\x97\xff\xfe\x86\x10A\v\xb8&k\x9a=T\xa5\xbe\xdc\x03\a%/\x99|\xa3K\xc8,ȭ\x92UyM\x9a\x17\xee\x13ߝ\x1b\xea\x8f\xf85\xfe\xc0\x996\x7fi\xfd\xf83\xd3\x06_\x94\xbcR\x94\xd7=\xe1o\x9a\x89mũ\n\xbf\xbe!Dg\xb2\x84k\xf2\xc9vQ\xd2\f\xf27\x84\xf8Qc\x97K?\xe0\xfd[\a!\xdbAA\xddX\b\x91%\x88w\xb7\xab\xaf\xffz\xd7\xf9\x99\x90\x1ct\xa6Xip\xee\xff\xb3\xac\x7f'~\x94\x84iB\xc9W\x9c#Q\x1e\xe5\xc4\xec\xa8!\nJ\x05\x1a\x84\xd1\xc4\xec\x80d\xb44\x95\x02\"7\xe4/\xd5\x1a\x94\x00\x03\xba\x05/\xe3\x956\xa0\x886\xd4\x00\xa1\x86PRJ&\fa\x82\x18V\x00\xf9û\xdb\x15\x91\xeb\xdf 3\x9aP\x91\x13\xaa\xb5\xcc\x185\x90\x93\xbd\xe4U\x01\xee\xdb?^\xd6PK%KP\x86\x05\xa4\xbb\xa7\xc5I\xad_\xc7\xe6j\x1f\x8b\x1e\xf7\x15\xc9-K\x81\x9b\x96G1\xe4\x1e\xa3v~f\xc7t3}d2\xfb3\x15~\xf8\x97G\xa0\xef@Y0D\xefd\xc5sˉ{P\x16\x81\x99\xdc\n\xf6\xf7\x1a\xb6&Fb\xa7\x9c\x1a\xd0\x163\x06\x94\xa0\x9c\xec)\xaf`a\x91r\x04\xb9\xa0\a\xa2\xc0\xf6I*т\x87\x1f\xe8\xe3q\xfc\"\x15\x10&6\xf2\x9a\xec\x8c)\xf5\xf5\xd5Ֆ\x99\xb0\xbe2Y\x14\x95`\xe6p\x85K\x85\xad+#\x95\xbe\xcaa\x0f\xfcJ\xb3풪l\xc7\fd\x96\xccW\xb4dK\x9c\x88\xc05vY\xe4\xff\x12\xd8Cw\xba5\a˶\xda(&\xb6\xad\x17\xb8>f\x90\xc7.\x1dǌ\x0e\x94\x9bbC\x05\xfb\x93Eݗ\x0fw\xf7mFe\xda\x13\xa5ůC\xf4\xb1\xd8db\x03\xca}\xb7Q\xb2@\x98 rǪ\xc8省0DW\xeb\x82\x19\xcb\x06\x7f\xab@\xdb5 \x8f\xc1ޠ\f\"k U\x99[6>n\xb0\x12\xe4\x86\x16\xc0o\xa8\x86W\xa6\x95\xa5\x8a^Z\"$Q\xab-Y\x8f\x1b;\xf4\xb6^\x04\x019@Z'X\xeeJ\xc8:\v\xcd~\xc56,s\xcbi#U#w\x9c\f\xecb(\xbe\xf4\xed\x93iv'h\xa9w\xd2ܳ\x02de\x8e[L\xf1\x1a\x12\xefnu\x04%\x8cЏ\x17eV\xa5!\xb7\x8b\xf6\x912\x83c\xbe\xb9[\x91\xaf(\xac\xc2\xd7(\xb4*ML\xa5\x84\xe5\x92H__\x80\xe6\x87{\xf9\xab\x06\x92W\xc8ܙ\x02\xc4Â\xacac9A\x81\xfd\u07be\x02\xa5,n4\x0e@V=ac\x9f\xfb\x1dX\xdcҊ\x1b\xbfN\x98&o\xffL\n&*\xd3c\xb5A\xaa#\xa6\xa8\xa1\x85܃:\x05\x89視\xbf؏\x8fpg\x81\x12\x84j\x91\xb7\xf6x\\\x1f\xf0e\x8c\xda\xeeYmZ\x10\x99&\x17\x17D*r\xe14\xf0\xc5\xc2}]1n\x96L\xb4\xfbxd\x9c\x87^\xe6M\xde\xe1\xd0\x11T\xdfˏ\xda1\xefI\xb8\x18\x80\xd5B\xcd\xe3\x0e\xcc\x0e\x14)e\xad\xf16\x8c\x03\xd1\am\xa0\xf0\x88\tZ\xc4\xcf'\xd2\x13\xae\x1d\xce=\bm\xf1\xea'ҟ\xbc\xa88\xa7k\x0e\xd7Ĩ\n\x06p\xb3\x96\x92\x03\x15\x13\xc8\xf9\x02ڰ\xec\x1c\xa8q\x90\"\x88Q\xfeE\a\x03\xa84\xe9\x03\x10\x1a\x01\xedqf\xb53\xe7-\xc4v\xb1\x12\x1dS\xa9 \xb3R\xfb\xdak\x03\x06\x1c5\x90\x90\x84K\xb1\x05\xe5z\xb7\x96J`0\x05\x96\xe1rb\x05\xad\x02n\xb5\t\xd9TV\x06_\x12\xbb\xba\ay\x80\tm\x80F\x98\xf3\x19\U001019ccW9\xe47\xce\xf0\xba\xb3\xf6c\x1e\xac\xe6\x9e\xd4L\xa1ӇQ\x88^;s\x96\xa1\x11\xe8\xed\xbd%ڭ16m\x94\xf4\xa1\x04g:[R\xfaa7\xdawT\x1eh0\xf6\xa3\x8b?],\x90\xc2\xdd^\xbb}hB\x15\xd4hI\x96\x9bP\x94\xe6\xd0o\xcd\f\x14\x11,\x8eʓDzR\xa5\xe8a\x80\x9a\xb5\xfd\x7fFz\x0e\xc1<\xa2\xa8\b\xcd^\x99\xa6\xc7\xfd\xfe3S\xf5<t\xd4\xe8\xedR&,\xfd\xac\xe3\xd9!\x9fv\xfe\x9bE\x9b\x90&\x02\x8f\t\a\x0f]\xb3\x11j\xfdN\xc8:\v\xcf\x0f1y\xcd[\x9ey\xff!1\xb5\x93\xf2a\n;?\xd96\x8dSD2\x8c\xaa\x905\xec\xe8\x9eI\xe5\xa7ިZx\x82\xac2\xd1UO\r\xc9\xd9f\x03\xca\xc2)wT\x83vn\xf20B\x86\xcdw\xd2\x12#їG\xf3h\biɄ3\x1f\x1a\xba\xb5#\x8e\xb5dx\xec@\xady\x8d\xca8g{\x96W\x94\xa3^\xa6\"s\xf3\xa1\xf5\xb8bRf\x84Ƚ1G9\xd3=\xce \b\x93\xb2D\xeaxJR\x80\xb5y\v\xeb\x13\xf4\x9b\x0e\xcf|M\xad\xad\"\x87fO\x90X\xaa\xe2\xa0}W9\x9a\x91\x8d\xccX4D\xc1@\x04\xe1t\r\x9ch\xe0\x90\x19\xa9\xe2\x18\x99\xa2\xb3{R\x84\xe0\x00\"#\x92\xaf\xebi4\x13\x18\x01IЅ۱l\xe7L=\xcbD\b\x87\xe4\x12\xac\xc1g\b-K\x1eQ\x17\xcd3J|\xdf\xc9\xd8Zo\x9e\x89U\x7f\f/\xb6\xfe\x9b'Af6O\x14\xb5\xcd\xfa\xeab\xb6f\x87\xb8O\xdb<\xff\x9c\x88\r\x92\xff\x04\xa6\x1dY\xfd\x04\xa3B\xc9<=ȷ\x16\xab\f\xf4\xa55\xa7\xd0\xd2Y\x10f¯S+\xa1cs\xf5\x82e\x1d$|۴\x99\xcf\xf4\x89\xa4IY\x13/D\x98\xba\x8b\x7f@\xba\xa0ʸ\xf3\x1a#\x99&?\xb7\xbfZ\x10\xb6\xa9\x91\x9e/Ȇq\x03\xea\b\xfb'\x89\xfa@\x99s #E\xeb\x11\fߛl\xf7\xe1ɚ`\xba\xc9T%\xe2\xe5\xf8cg\xc8\x06k\xbf\xab\x9e'\xe0\x12\fc3\x05\x05\x86\xc7\xd1cj\xff\x82\xa6ջO\xef\xe3\xfeU\xfbI\xe0\xbc\xdeD&\x16\x9d{\xde\x1dͨ=>o\u00877h\x03\xd5\x0e\x90K\x85,\b%\x0fpp\xa6\v\x15\xc4҇\x86\xc6\t\xdd+\xc0\x9c\f\xf2\xd9\x03\x1c\x10L<\xc9\xd2\x7fR\xb9\xc1=\x0fpHiv\x84C;&\xa6}\xf2\xc8\xe2\xc9\xfe\x80\x88\xc0\xd8z*\x1b\xb8\xc7/\x85HJ#\xfe$ʒ\xf0\x04ܟ0\xcd$Vi\xf7\xd1\xceR\"\a\xfc\xa0\x1d-\xed\x8aٱ\x12\xc5*F\x1c\xe4&\x99\xa0\xee\xf9J9\xcb\xeb\x8e\xdc\x1aY\x89\x05\xf9$\x8d\xfd\xe7\xc3\x13\xd3>\x91\xf9^\x82\xfe$\r\xfe\xf2\"\x18u\x03\x7fI|\xba\x1ep\xa1\t'\xe5-\xc2ک8\xa7\xd3,\xb7ոg\x9a\xac\x84uW\x1cJ\x12\xbb¬\xab\xeb\xceuTT\x1a\xb3hB\x8a\xa5\v\xdb\xc4z\xf2\xf8\x96\xaa\x83\xeegw\xea;\xbc\xb7\xca½q\xb9_N3\xc8C\xba\x06\x93\x92\xd4\xc0\x96e\x89\xfd\x15\xa0\xb6@J+\xc2\xd38\"Q\xb0\xfa\xd9\xccc\x9f4\xed\xdd~\x9e\x96\x0fu\x8e\x7fiU\xce\xd2C0\xb2H\xc0\x81\x97\xdd\xf9\xf4|\x96v\xcd&\xb4\n\x9c0\xd9t g9\xdc4\x05)\xcf@\ajq4q&\xa9K\xf3\x1c\xeb\\(\xbf\x9d\xa1Qf\xf0\xc2\\\xd1\xd0\x1a\xbbS\xc1\x05\xc5T\xcb\x7f[M\x8b\xab\xe9\x7fII\x99җ\xe4\x1d\x96\xb4p\xe8\xbc\xf3A\xb3\x16\x98\x84.\xb1$\xc5\xf2Ϟr\xab\xfb\xad\x00\x17\x04\xb8\xb3\x04\xe4\xa6g\x17-\xc8\xe3Nj\xa7\xb6\xeb$\xce\xc5\x03\x1c\\\xc6p\xb2˶\x90\xb9X\x89\vgC\xf4\x04FmpH\xc1\x0f\xe4\x02\xdf]<ǔJ\xe4\xd4\xc4f\x1d\x16-h\x99ơXR\x94j\xa8[\x875\x18!\xf6úT\xc6\x1a\xd9c\xb3Mb\xd1R\xeaH\"\x7f`(\x13\xcc{+\xb5q\xf1\xb2\x8e\xcd\x1c\r\xa8\xc9\x10D#t\xe3ꗤ\n\xc5&V(O\x85~\xdb\xcf\xfd\x0e4\xf8|\x85\x0f\xcc9\xa0ֳ\xbbhַ\x93\xf6\x17._\x82\x9d\xd0\f-\x16\xfc\xb6T2\x03\x1d\xcde7O\x82\xbe\x88Te\xb4\xe7^\xc7\x1c\xa9\xf3\x92\\I\xc6x\b4<\xe9&\xafE\xc4L\x7f\xe1\xc3S+ j\u05fe\xfd{\x8a\xc7掋`\xc9`Q\xd0\xe32\xa5\xa4!\u07b8/\xc3j\xf0\x80\x9c\xf3\xa1\xb6\x15J\x82T]^3\xe0\xb7`(\x14L\xac\xb0\x03\xf2\xf6\x05\f\v/Cc\xc5&\xb1\xe74S\xf6&t\xd2P\xa7\xfe\xc1-\xe5Rb\xaa@A\x87x\xfd\xa8:ڡB\x9aV@b\x86\xb9Y\xca\xfc\aM6Li\xd3\x1e\x82\x1e(S\x89\x82\x99\xe9x\x89\x0fJ\x9d\xe4w}v_\xb6\xc2];\xf9\x18ʳ\x1cb\x12g\x8e\xf9% lC\x98! 2Y\t\f\xe0\xd8u\x8c]8\xe4:\t\xcbR\x17I\xda\xea\xb7\x0f\x88\xaaHC\xc0\x129\x85\x89\xd1HO\xbb\xf9G\xca\xf8K\x90\xcd\fU\xb1Ş\xd3\xd6D(qk\x17\xe4\x15\xf4\x89\x15UAhai\x84ʜ\x15\xd0%zS\xf8f\xbf@5a\xa4]1%\a\x03\xbex-q\f\x99\x14\x9a\xe5P+W\xcf\bR\x10J6\x94\xf1J%J\xc0Y\xe8\x9d\xe3\x8axIp>\x1f#\xad\xf3%\xa2\"!\x9a\x9bh+\x8eK\xe3R\xa5[|Sf\x96\x82\xf9VV\xa9\x98Ĳ\xc03\x1bZ\xbe\x90\x92\x8a\xc3wK+u\xa8\xdf-\xad\xb1绥5\xf1|\xb7\xb4\xbe[Z)-\xbf[Z\xdf-\xad\xf6\xf3\xff\xc2Қ\x1a\x91\xdb\xcf7\xf0rr\x14\t\xa9\xea\xb1!\x8e\xc0\xf7\xc5\x15\xbe\x06\xfcY\xb5\x98\xab8\xa8H\xe1\xff@YwLh5ʣ.δ\xab&\xf0\xbc\xdb^4aJ>\xa3\xea>tz\xbe\xaa\xfb\xd5(\xc43U\xdd\xfbaO\xdb\xd8'\xd5\xdc\a\xa4̫\xce^\xf8B\x8d\x02h\b\xab\xbb4|l^C\x1c2\xd1\xff+\x17\xe6\xf6\xaa\xc6\xce\xc8\x1f/^ş\xcc#Q\x92^\xfc\xe9\xe2\xdbC\xffy\x10>\x88\xe2>\xee\xfc\xfe\xe6\bT끶\xcbºUx\xdf&\x1b\x9f\x85oS+\xf1k$F`uY\xf2\b\x8bߪ,0P|.\xbdFz\xc6N\xd5U\x04N\xd2^U\xaa\x0f\"\xdb))d\xa5}T\xc2\xc2z\x97\xb9\r\xed\x01d\x8cY\xa3+\xfc\xdf\xc8NV\x91J\xf0\x11\xf4MT\x04NO\xbeS\x1c\xe8\x93\xd0`\xe8\xfe\xede\xf7\x8d\x91\xbeT\x90<2\xb3\x8b\x00z܁\xc0\f\xbbض7\x00\x84\xf3\b\xfc\xc6\xfcc\x06\x8b\x00\x92\x8a\b\xc6\x1d\xe7է\x19\xb4\xf9\x8e|.]\xeci\xb6\xdd1\x1eSI+&<\xb9\x84\xb0[\"8`\x97\xce\xcdv\x9fe\xcb\xc4\xefR\x1a8\xbf 0%\"6Q\xfcwB\xc9_bm\xf1\xb3\xd3\xf3)E}s<\xe6\x17+\xe0;\x7f\xd9^\x12~\xa6K\xf4\xe6`\xe7\xc5\xcb\xf1^\xb1\b\xefuJ\xef\x12\v\xee\xceW9\x9f\x16\x8f=\xa9rl:t0\\47Y*7\x19Z\x98\x9a\xd8\xec)M\x96\xc0\xcd)|\x9b\xa4N\xda2{\xb5ҶW+h{\xdd2\xb6Q.\x1a}9\xa7P-~,\r\x99T\xb6\xfc\xb5\x98\xedT4H\xd51_O\xf2\xaf>\x1f\xc1\xb0\x84\x0f\xa6\xdd+\xd9\xc8E\xc5\r+9&R\xf7,\x8f\x06\x1b\xcc\x0e\x0e\xf5\x01\x1a\xbfI\xdcz\xeaO\x82\xf9\xfc\xa5\xe6\xda\xcb#K\x9fj\xf2\b\x9c\x13\x1a[W\xbd\x99g\xee$\xa6L.\xc1\xea#\xbb:\xfd\xc1 \xfe\xf8\xa6\x85cw\xdc]\x8bZ\xad\x88\x85\x98\xa8\x18>EfPq\xa4ț\x9e\x05\xeb\xecp\xfc\xedo\x15\xa8\x03\xc1slj;\xa7\xd9\x04\xe6\x17\xa6\xb6\x8eX\x10\x15^l\r\xc5\xcf{F\x7f\xb3\x94\xc9;\xe1\xb4\xee\xf1x\xf0\x1b+#\x1a\xa7\xc6\n>\xeb\xafD\xfb\x18\xf8\\\xc8\xfa\xeb\xc8gS\x06r\xean\xa9\x97uq\xe6;9\x93VE\xba\xe5\xf7;\xed\x82:e\xf7SZ\x01\xc0\xe4n\xa7\x97ry\xa6\x9c\x9ed;/m7Ӽd\xe1\v\xee^z\x89]K\x89\x98J٥4\x0fO\xaf\xb0+\xe9Uw#\xbd\xd6.\xa4\xe4\xddGI%.\xc9Y\xe0\xd4\x12\x95\x13\xb7\xd3L\xe7x\xc7w\x13%\xec\"J\xc8\xfeNO\xf2\x84\xe9%\xec\x12\x9a\xb7;(\x81f\xa9K\xf1\x15w\x01\xbd\xe2\xee\x9f\xd7\xde\xf53\xc1Y\x13\xaf\xe7\xed\xee99e!U\x0ej4\xed\x93ʅ\xa3\xfc\x97\xe2\xdbt\ar\x94\xef\b\xa7\xfe\xd9V\x1d{\x19Ճ?h\x14\x8f\x94\x1dJ_ZNkY\x1b\x9d\\Tc\xfet\x8dI\x7fάKWi(\xa9³\x8b\xd7\aW\xce\x12U\xcd\x1fh\xb6;\x82\xbe\xa3\x9al\xa4*\xa8!\x17u\x02\xf0\xca\x01\xb7\x7f_\\\x12\xf2Q\xd65\x11\xedsy4+J~\xb0\x1e\n\xb9h\x7fp\x1a\aD\xb9-\xf4v+9\xcb\"\xb6[\xf4l&\u05f8wX\x06\x9e\x18\x95\xb5K\x06J\xdb0n\xba\xa1\x99\xd7=\x02s#9\x97\x8f3}\x7fZ\xb2\xff\u0093\xbb\x9f\x11\x1dzw\xbbB\x18\x81=\xf0(\xf0\xba8\xab\x9e\xcd\x1a\xacZn\xe69\xb4\xf6W\x9b\x0e\xc4n\x9dc\xfbp\\\xc8\xdd9\xc8\xc1,\xf0\xa23\x93V\xbaܮ\xdc8\x86z\xb1<CŁH\xac\xa81;\xa6\xf2eI\x959\xb8B\x8dEg\fA\x97\x8eEw\x06\xb5G\xffl\xe7(zÑΘ\xa1<\x94ݤ\xef1\xeeN\x19\xc7\xf0\xee\xc5\xc9}\x8bg\x1cǰY\xb2DLE~\x8eV~\x9d-j\xa6\xfd\xc9Ŀ\xc8=\xbc\x8fF\xcf:\xe8\xb9;j\x1e)\xcf\n\x10ݡ\xbb\x83U\xaak\xc0\x03y\xfb\xaf\x9eQo\x15\xba\xf6g\xaa\x9e\x12(\xbb낈\xcc/\x9c0\x1b:\x8b\xc9'<\x00\xfe@n\xbf\xa2\x8fV\x8b6\xbfD\xbd\x8f\x16Be!\x19\x1c\x81\xe3?\xf8\xf1\xfc\xa5i\xdaHE\xb7\xf0\xb3tglO\x91\xbdۺs\xf6\xba\xb7zB\xfdhX4\xb1\x03x\xfdi\xdfG\xc0\x9a\x9a\xefޡ\xc6v\x943\x8fi6\x86\x9fB\xf7\xfb\xfb\x9fݬ\f+\xe0\xf2}\xe5\xca\x1d\xacL\xd4`Q\x1cf\xeb \xad\xed\x7fw\xf2\x11\x0f\xff\x8d\xc71Ý\t\xcdd\x14`\xb19\x96 ΚRUrIsP7Rl\xd8vbv\xbfv\x1a\x1f\xa9\xd9\f\x7f\xf4\x93\xabuT\x80\x7f\xe6\x1a\x04k\xf3p\x0e\xfc#\xe3\xa0ݰ\x12\x04\xf0m\xff\xabZ\x1eW\xc5\xda\xd9p\x1b\xfb\xb2\xee`@ǹia(\xba\x04e\xad(\x17\xb4\xaet\xe0\xd5\xe1\x897\x14a\xc2\xc0\x16\xfa^\xe0\x88\x04\xdew\xce|\x0f|>%\x8e\xbeƿj\x99\x95\xad\x95\xe6\xecJ\xb9\x89\f|\bN\xeb\x06\x8dGf\xfc\x91W\xe7=\xa3t\xc8Y\x18\xba\x1b\x00\x0fß\xbe\x1d\xc0\x9d\x99\xef\xef\x14\xf1\x8c\\)<`ԟ\xa7\x8f\ar\x9etA\xc0\xba.u\xaa˦\xf4;c\xa0(MLKO\v\x92\x1f\xc7\x00\xd6\x16\x8e4\x94\xb7\xf8\x99\x86\x061\x1bU\x1fD6V\x92\xe5\xd7\xf1\b5\xc789\x86\x80\x1b\xbf\x93\xe0l\b\xa8\x01\x0e!@WY\x06Zo*\xce\x0f\xf5F\x86o\x04\x1b\x1f)\xe3\xe7C\x85\x836\xc8\bvz\xa3\x90&'\xec\v\xa5A\xe4a\xa5\x87M>\xf3P\xe1\xa9\xe0\xeb\b\xb5\xa1\xc5IW\x1d\xdc\xf4\xc1\xe0e7*o\x95#\xd2z\xecT7䏉\xe5\x06\x9c\xfb\x12\xdd\x13\v\rr\x02{\x10\xc4\xea5\x87\xe2p[\xd3L(~o\xa8\xd3\rAS\x84 B\xf4J\x1f\xe2\xe3\x04\x1a\xaf\x8e\xf9A\xd70\xb1\xca\x12o\x02\xe9#\xa1o6:?\xff\xda\xdaͰ\xb4 N\xb3\xf7\xa2\xb29Ӭ\xab\x17\x9e'\xe4n\xeeVC\xe0N\x11q\xfd\x8bR\x9e\xb9\x8c\xfb\xd3}\x96H\xebOw\x96@\x8b@\xacy\xfc\xfcsǥ~\xdaq\xe8\xf8\xa538\xb2\xb0\xfb\x8cr\xee\xb7\b\x16\xa05݆s\xd0\x1f\xadѾ\x05\x01.\xb0\xe5\xd2\x0e\x11\xa0\xcd~\xb2\xee)\xe0n\xc9\xd0\xccT\xd4w\x10Jc[\xad~Є\xcb\x18T\xbc\n\x85\x85;\xb6\x8273\x13QO%S)\xdeχ\xba\xa1\xc5\rڐH\x9d\xe6V4\xe0lˬ\x97`)\xb7\xa5jM\xb7\xb0\xcc$\xe7\x80Һ?\xae\x97\\\xeb~\xd7\xde\x17\xa0zrj\x1f\xdbm}\xee\xccQۥ\x8c\xa9+\x14\xc7{\xaf\fS\xd0\\A\xd7\x1b\x90Ďg96\x0e\v\xd1\xfb\xd9\xfa#m\xb7\r\xab\u038be\x1f!\xf5׳-\xbcG\x1d\xe7ǂ\xfe&Ղ\x14L\xd8\x7f\xa8\xc8]\xea+|<k\xfc;)\x1f\xee\"Flo\xf0?\xd5\r\x9b$\x01\x13nظ\xd5r-+\x9f\xb7\xae\r\xdaxB\x02ϴ?\xb3\xa3\x860G\xf4Ao:\x83\xb1П:\x90&U\x81\xeby\x00\xd6]\xb8\x03\x8c\xf3\xc3\xe2\x18\xf2\xd1}\x83\r\xec֙\xff\xde\fhv\xf2\x0ft\x14r9Q \xf5\x91\x11m\x81~\x8a\xbf\xe8\xd1<dL\xf6p\xfcS\xd3z\b\x8fn\x98-so`\x82\x1d#\xf0\xbc\xae.^\xf00\xc1\xfc\xb7\xb6M\xbd\xeb\xbf帅\xfa\xaa\xc1\xf8V|\xd7\xf8\x92|\x82~\xa0\xdfm\x04\x87\x1ck\x1apUE\x9a\xacĭ\x92[\x05\xba\xcftK\xf2W\xca\f\x13ۏR\xdd\xf2j\xcb\xc4\xe7\xe1M/c\x8do\xa92\xcc2\xad\x1bOl\xa0LP\xce\xfe\x1e\x93O\xed\x97Ӏn\x06\x1d\xa5%I\x18\xc6Ћ\xf7`m\xd5A\xff>*\nK\x8f\xd7S\xec\x8e@\x93)\xd9X\xdb\x04\x8dM\x11\xba\xbd$\x9fdt\x81\xfb\x82 օiM+\xd0f\t\x9b\x8dT\xc6\xe5k\x97K\xc26!\x88`e\aF\x8e\xdcm\x8d\x84\xc5\x12\xadu\xa9E\xa3\x860\xec\xabP\x9b\xe2a\xee\x05=\xb8\xdc\fͲ\xcaZJW\xdaP\x1e1T\x9e%\xc01Zc\x17\x11\xe4\xbf>+\x97\xb5j\x03\xea\x87ݰ\x1f\x87R<N\xc2Yo\xdcN\x11\x04yT\xcc\x18k\x1bɑd\xbaG\x95\xb16\x12\xe7D[T\x9f\x14\x7f#N\x1c\xae\x86\x8bRҦ|_C\x19\x12\xb3~\xd6x7\xe1\x1aqC\xac\xfd\x8a\xf57\xbe\x95%s\xb6\xa3b;\xb8G\x7f\xa7d\xb5\xdd\x05N\x1e0\x8aI^\x01\x86+Q\xa4\xe8p\xb5\xae\xa9\x94h%\xd3G6>\x93\xc0\f8\\\x9a=\x90\xaa\\\xf8\xabk\xfd\xcd\xc4W\xfe\x16\x90\xe5F\xc9b\xe9\xfb\xc5\x02\xba\x85\xcfe+&\xad\x05bvQ\xac\x13g}\xfb\x83\xf6\x91\x13\xca\x12\x04\xa1\xda\xf7\x9cpV\xd2\xc9\xeaF\x1b\xaa̳\xc2\x11w\x1d\b\x13\x91\b\xec.>\x89;\x9f\xd2w\x87E\xdd\xf8+:k\xc0\v\xa2\x99\b\x97#\xbb\xf2\x00\xc7\x1f\xd1l\x91\xc0\xcb\f\xa5\x8aW썇\x16\xba\x13zݨ¾ֵ\x1fNv:\xbf\x1e\xc18\xda\xf8\x8bwW\xd6M\x82\xa3\xf8\a\x16\x8b|c\xa9gf\xa7\xf2\xc7\xdf}C\xef>ɩ\x89cd\xcc\xc7A\xf7e\xd8Y\xe9\xdeUy\xcb\xc1\x9a^\x1a\xa0\xeb>\xcdr\x93\xf7g\x8c\x1b\x9d3h\x14\xae\x01?O\xd4d\x7f\xc6pыŊ\xce;\xe5G\x8a\x97\b\x9f\xb4j\xff꿍\x04\x8b<\xd8s\x87\x8bZѢ0\xf0W\x8d\x17E\xb5R\xefG\x94\xd3yKZ\xf8\x9e\xfc/\xff\x17\x00\x00\xff\xff\xa5\x85<q܀\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xccYK\x8f\x1b\xb9\x11\xbe\xebW\x14v\x0f{ٖ\xec\x04\t\x02\xdd\xc6r\x02\x18\x19\xc7\x03k2\xb9.EVK\\\xb1\xc9\x0e\x1f\x92\x95\xc7\x7f\x0f\x8a\x0f\xa9\xd5\x0fK\xe3\x04\x9b\xe5eF|\x14\xeb\xf9U\x15\xbb\xaa\xaa\x19k\xe5\vZ'\x8d^\x02k%~\xf1\xa8闛\xef\xff\xe0\xe6\xd2,\x0eog{\xa9\xc5\x12V\xc1y\xd3|Fg\x82\xe5\xf8\x1ek\xa9\xa5\x97F\xcf\x1a\xf4L0ϖ3\x00\xa6\xb5\xf1\x8c\xa6\x1d\xfd\x04\xe0F{k\x94B[mQ\xcf\xf7a\x83\x9b \x95@\x1b\x89\x97\xab\x0fo\xe6o\x7f?\xff\xdd\f@\xb3\x06\x97\xb0a|\x1fZ\xe7\x8de[T\x86'\x92\xf3\x03*\xb4f.\xcd̵\xc8醭5\xa1]\xc2e!Qȷ'\xce\xdfEb\xebD\xec1\x13\x8b\xebJ:\xff\xe7\xe9=\x8f\xd2\xf9\xb8\xafU\xc125\xc5V\xdc\xe2v\xc6\xfa\xbf\\\xae\xae`\xe3TZ\x91z\x1b\x14\xb3\x13\xc7g\x00\x8e\x9b\x16\x97\x10O\xb7\x8c\xa3\x98\x01d\xd5Dj\x150!\xa2\xb2\x99z\xb2R{\xb4+\xa3B\xa3\xcfw\tt\xdc\xca\xd6Ge&Y \v\x03E\x1ap\x9e\xf9\xe0\xc0\x05\xbe\x03\xe6\xe0\xe1\xc0\xa4b\x1b\x85\x8b\xbfjV\xfe\x8f\xf4\x00~vF?1\xbf[\xc2<\x9d\x9a\xb7;\xe6\xcaj\xb2\xd1SgƟH\x00\xe7\xad\xd4\xdb1\x96\x1e\x99\xf3/LI\x119y\x96\r\x82t\xe0w\b\x8a9\x0f\x9e&\xe8W\xd2\x10\x90\x8a\x10\x8a\x86\xe0\xc8\\\xbe\a\xe0\x90\xa8D\x1d\x8ds\xaa\x06w]\xb1M\xac\xc0K\x8fJ\xe2\x9ff2\xf7\x1d\xb2ſ\xe7\xdc♤\xf3\xaci\xaf\xe8>lq\x8aؕ*\xdec͂\xf2]Q\xc9J\xaa\xeb\x97\xd7b\xb5\xc8\xe7\"\x9d\xba\xba\xf1\xfd\xd5\\\xbauc\x8cB\x96\xa8\xa4]\x87\xb7\xc9\v\xf9\x0e\x1b\xb6̛M\x8b\xfa\xe1\xe9\xc3\xcbo\xd7W\xd30\xe6H\xbd\xa0 ñ\x8emvh\x11^b\xfc%\xbb\xb9,ڙ&\x80\xd9\xfc\x8c\xdc_\x8c\xd8ZӢ\xf5\xb2\x04K\x1a\x1d,\xea\xcc\xf6x\xfaWu\xb5\x06@b\xa4S \b\x940\xf9U\x8e\x1f\x14Yr05\xf8\x9dt`\xb1\xb5\xe8P'\x98\xa2i\xa63\x83\xf3\x1e\xe95Z\"C\xb1\x1d\x94 ,;\xa0\xf5`\x91\x9b\xad\x96\xff8\xd3v\xe0Mvf\x8f\xceC\x8cP\xcd\x149k\xc0\x1f\x81iѣܰ\x13X\xa4;!\xe8\x0e\xbdx\xc0\xf5\xf9\xf8H\xd1 um\x96\xb0\xf3\xbeu\xcb\xc5b+}Ahn\x9a&h\xe9O\x8b\b\xb6r\x13\xbc\xb1n!\xf0\x80j\xe1\xe4\xb6b\x96\xef\xa4G\xee\x83\xc5\x05ke\x15\x05\xd1\tR\x1b\xf1\xbd͘\uebae\x1d\x84t\x1a\x11R_a\x1e\x82\xd7\xe42\x89T\x12\xf1b\x05\x9a\"\xd5}\xfe\xe3\xfa\x19\n'\xc9R\xc9(\x97\xad\x03\xbd\x14\xfb\x906\xa5\xaeѦs\xb55M\xa4\x89Z\xb4Fj\x1f\x7fp%Q{pa\xd3HOn\xf0\xf7\x80Γ\xe9\xfadW1\x8b\xc1\x06!\xb4\x11$\xfa\x1b>hX\xb1\x06Պ9\xfc\x85mEVq\x15\x19\xe1.kuss\x7fsRog\xa1\xe4\xd4\tӎ\xa2\xc1\xbaE~\x15w\x02\x9d\xb4\x14\x19\x9ey\x8c\xd1\xd5SP\x86\x8a\xe9\xa4\\\xc68H\xd0`\x9c\xa3s\x1f\x8d\xc0\xfeJ\x8f\xe5\x87\xf3\xc6+\x1e[\xb4\x8dt1\xbdBml?\xf3\xb03\x92wGA\xbc\xbe\xc1\x01P\x87f\xc8H\x05\x9f\x91\x89OZ\x9d&\x96\xfef\xa5\x1f^4aH\x1a\x89\xc5\xf5I\xf3'\xb4҈\x1b¿\xebm?\xab`g\x8ePG\xff\xd7^\x9d\b\xbb\xdcI\xf3!j\x97\xf1\xf0\xf4\xa1 x\x8a\xad\x1c\x98YWsx\xc8Amjx\x03B:*$\\$:T\x96\x0e*\x16\x1aK\xf06\xbcJ|nt-\xb7C\xa1\xbb\xb5є\xc7\xdc \xdd\xd3\xdc*\xdeD\xa8E\xde\xd1Zs\x90\x02mE\xf1!k\xc93'\xc1\xa6\fRKTb\x80M\x93Q\x16E\xb1((\xa8\x99\xbaa\xc3\xd5yc\xac\xa4\x99\xd4Ƀ/\x04\"\xd6\xd8&\xa7f\xedQ\v\xecg\x9bȍ\x89\x80\xe6P\xc0Q\xfa]BJ5\x16w\xf0\xd5أ\xb1\xc7\xd3\xd8t\x8f\xf7\xe7\x1d\xd2Δx\x11\x1cr\x8b>z\x1b*r\x1fr\xa59\xc0\xc7\xe0\"\xd6\xf6q\xa2\x8cX\xf0\x95\xd3{<\r\x15\r\xb7\x8c\x9bK\xa1\t\x96c\x11\xb5\x84ﾻ-\xd2 \xbb\x95A\xa5{\x11\xd4b\x8d\x16\xf5\xa0\x9a(\xe39\xe6(r\x1a\xf20\xack\xe4^\x1eP\x9dbN\"\xf0\xfc\x116\xc1\x83\b\x18\xad\xc6\xf8\xfeȬp\xc0M\xd32/7RI\x7f\x02\xe9&\xe83\xa5\xcc\x11E\xb686\xad?\xcd\xe1\x83v\x9ei\x8e\xee\\\a\x91ƒ+0\x9dv\xe5(\x8e\x05\x1d\xb3c\x18\x98\xc87\xc6y\xe0h\xc9\x1d\xd5\t\x8e\xd6\xe8픰#\xe9\x90z@\xab\xd1c̈\xc2pGɐc\xeb\xdd\xc2\x1c\xd0\x1e$\x1e\x17Gc\xf7Ro+b\xb0\xcaೈ\x9d\xdd\xe2\xfb\xf8\xe7[\xbc\xc0\xb4\t'\xeep\xdeu\x8c\xf5\x13\x95\xb7~\x87)E\xac\x93\x0f\x1a\vT@\x90k7\xd9w\x13\xb2\x8e\x85\xddX]\xde\x1d\xc5\xe4c\xf9c\x8f\xc3\xd4\xf1\x15P\x01\xf8R]t[5\xac\xad\xd2n\xe6M#\xf9\xac/m\xf2\xfb\xaf\xe3OiV\xa4\x16\x92Sq{\x8d\x1b\xa5\x89\x13W=͈\x1a\xfa]\xce\x14Z\x8e\xab)\x89\x9bk\x85\x1b\x1c\x7f\xea\uef74\xbe\t\xbas\xfew\xe8\xa9\xeet\xa0\x91\xea\x03f\x87z\x8e\x80ɍքT\xde\x00;\xa7\x81\x1f\\?\xff\xbd\x12=7\x81\xefqD\xf1\x03Q\xdeōE\xc7\xe9\x18\xf1\x12\x1c\xc6\xc4t\x8b\r\xb8\x1d\x11\x9c\xad\xd0\xde\xc3\xcb\xea\x816\x9eK\b\x06\xab\a\xd8\x04-\x14\x16\x8e\x8e;\xd4\xd4u\xc9\xfa4~\x17\x8d\xe7\xc7u\xd1j\xac\xber\xdfTt;.C\xcaoK\u061cF\xea\xa5;\x84l-\xd6\xf2\xcb\x1dB>ōE\xe1-\xf3;\x90\xdaI\x81\xc0Fԟ\n\xd9\tAϵѧ\x8c9\xdf`\x9e\xafaCb\xe75\xf0Pt|#~\x9e\xf2\xb6\xb3\x16\xca\xef\x9cݮ\xeb\xe4\xa98\x1e\x95\xe8p~\x94\xf9S\xaa>\xf9H\x19q\xc5\xcc\xcb\xf0\xc4W\xaa\xd8\xf244\x16\xccT3\x19kѵF\v\xea9\xef\xaba/,\xff\xef*\xd9q\xb3V\xd7(\xd7[+V\xb8\xab\x8d\x8b\xcf`\xafn\xe4\xd2\xe3`\xb7M2\x1bG\r\xf6\xa5\x97\xeb\xc9\xf8\x8b\xb4p\xa3%W\xa7\xaf\x93\x8eꗠce\x1b\xab\xaa\xf9l\xe4\xc4{l-R\x06\x13K\x92\xcdƃ\xda\x1c\xe9p\x87Z*ˌN\xf9\x9ez[\xa6E~U\xa0\xa5\x11\xcaG\xa9\x14\xd5\x00\x16\x1bCʢ\xb2\xdcR5\xc7b\xadu\xf8\xcd\xfc\xcd\xff\xafeT\xccy\xea\x00Q|ƃ\x1c>\xadݧ\xee\xc7\x01\x95\x82\x0e瘡\x1f?\x95׆\x85\xcd\xdb~\x82Z*\xaa\xff:\xd0qGu0\xf20\xfcn\xfd\xf8\x83\x8b=\x10j\xef\xe0H\x16t\x91%jzL~\xe1\t\xceS\x12\xb9i\xffn\x01\xae\r(\xa3\xb7h\xcbk\x0f\x15xɛ\x8c\x05\x81\x9er\x95\xde\x02\xdf1\xbd\xa5\xc8\x18\x83\xfc\xc8p\xe6\xbe\xcb'yϤ\x83H=\xe1\x1dw\x19\xf4Y\x8e\xb54\xaf1\xe6\xf43\xfc\x99\xffl\xd9\xcbkoO\xefSP[,\xd1_,\xa9\x9c\x14]\xf9\xcb\xd3\xfce|\xfb\xfb\xc0\xf0\xdd\xff[\xd5\xf3_}\xa9\x18|\xa1\xf8U(\xa7\xa1:\xf7f\xf1\xfc1\xedJ\xef\xb5\xf9\b\xb0\x8d\t~$\xf7w\x1c~4\xa6\xe3ǘ\xd7\xf0\x18?1\xdd*OhO\xb1\b\x0f\xd6\xc67\xdd\xf2\xd6\x18\x91b,+ݏ\xc0\x0f\xbd/aݵ\xe1w\xb2;\xe4\x1a\xcd҃ɔi;v\xcdJ\xee΄\xcd\xf9\xa5~\t\xff\xfc\xf7\xec?\x01\x00\x00\xff\xff\x03f\x86Y\xc0\x1d\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcVMo\x1b7\x10\xbd\xebW\f\xd0kwU\xa3hQ\xec\xadqr0\xda\x06\x82\x1d\xe4N\x91#-c.\xc9\xce\f\xe5\xba\x1f\xff\xbd \xb9+K\xab\x95\x93\\\xb27\x91Ù\xc7\xf7f\x1e\xd54\xcdJE\xfb\x11\x89m\xf0\x1d\xa8h\xf1/A\x9f\x7fq\xfb\xf8\v\xb76\xac\x0f7\xabG\xebM\a\xb7\x89%\f\xf7\xc8!\x91Ʒ\xb8\xb3ފ\r~5\xa0(\xa3Du+\x00\xe5}\x10\x95\x979\xff\x04\xd0\xc1\v\x05琚=\xfa\xf61mq\x9b\xac3H%\xf9T\xfa\xf0C{\xf3s\xfb\xd3\n\xc0\xab\x01;0\xe8Pp\xab\xf4c\x8a\x84\x7f&d\xe1\xf6\x80\x0e)\xb46\xac8\xa2\xce\xf9\xf7\x14R\xec\xe0e\xa3\x9e\x1fkW\xdcoK\xaa7%\xd5}MUv\x9de\xf9\xedZ\xc4\xefv\x8c\x8a.\x91rˀJ\x00[\xbfON\xd1b\xc8\n\x80u\x88\xd8\xc1\xfb\f+*\x8df\x050^\xbb\xc0l@\x19S\x88TnC\xd6\v\xd2mpi\x98\bl\xc0 k\xb2Q\nQ\x1fz,W\x84\xb0\x03\xe9\x11j9\x90\x00[\x1c\x11\x98r\x0e\xe0\x13\a\xbfQ\xd2w\xd0f\xbe\xda\x1a\x9a\x81\x8c\x01\x95\xea7\xf3ey\u0380Y\xc8\xfa\xfd5\b,J\x12O J]\x1b<\xd0\t\xbf\xe7\x00J|\x1b{\xc5\xe7\xd5\x1f\xcaƵ\xca5\xe6pS\x99\xd6=\x0e\xaa\x1bcCD\xff\xeb\xe6\xee\xe3\x8f\x0fg\xcbp\x8euAZ\xb0\fjB\x9a\x89\xab\xacA\xf0\b\x81`\b4\xb1\xca\xed1i\xa4\x10\x91\xc4N\xadU\xbf\x93\xe19Y\x9dA\xf8\xb79\xdb\x03Ȩ\xeb)0y\x8a\x90\v\x89cS\xa0\x19/Zɵ\f\x84\x91\x90\xd1\u05f9\xca\xcb\xcaC\xd8~B-\xed,\xf5\x03RN\x03܇\xe4L\x1e\xbe\x03\x92\x00\xa1\x0e{o\xff>\xe6\xe6|\xef\\\xd4))\x94\xe4\xb6\xf3\xca\xc1A\xb9\x84߃\xf2f\x96yP\xcf@\x98kB\xf2'\xf9\xca\x01\x9e\xe3\xf8#\x93h\xfd.tЋD\xee\xd6뽕\xc9Rt\x18\x86\xe4\xad<\xaf\x8b;\xd8m\x92@\xbc6x@\xb7f\xbbo\x14\xe9\xde\njI\x84k\x15mS.⋭\xb4\x83\xf9\x8eF\x13Ⳳ\x17\xddS\xbf\xe2\x02_!O\xf6\x84\xda#5U\xbd\xe2\x8b\ny)Sw\xff\xee\xe1\x03LH\xaaRU\x94\x97\xd0\v^&}2\x9b\xd6\xef\x90\xea\xb9\x1d\x85\xa1\xe4Dob\xb0^\xca\x0f\xed,z\x01N\xdb\xc1\nO\x1d\x9b\xa5\x9b\xa7\xbd-\xb6\x9b\x1d E\xa3\x04\xcd<\xe0\xceí\x1a\xd0\xdd*\xc6o\xacUV\x85\x9b,\xc2\x17\xa9u\xfa\x98̃+\xbd'\x1b\xd33pEڅ\xe1\x7f\x88\xa8\xb3\xb8\x99\xdf|\xda\ueb2ec\xb5\v\x04O\xbd\xd5\xfd4\xfc3\x9a\x8eFq\xce߲1\xe4\xef\xc5n\xe7;W/\x0fEdK8k\xd8\x06.\xbc\xfbu^\x8a\xa9~%3\xd5\xd1Gnt\"*\xcdw\xf4y\xb5t\xe8K\xb9@\xa2@\x17\xab3P\xefJP\xf9Ǡ\xacgP\xfey<\b\xd2+\x81'\xa4<!:\xa4\xecVh\xc0\xa4\v\xfeFZNߤHA#_\x8c\"\x80\x15\x1c\x160\xbd\xa2N\xfe|rNm\x1dv \x94\xf0\x8a\xb2\x8aH=\xcf\xf6\xca\xdb\xf7\x19\n69fI\x83\xe3{\xffY\x11\n\xdd>\r\x97\x95\x1ax\x8fO\v\xabw~CaO\xc8\xf3\x96ϛ\x9b\xca\x1e\xce߃WXZlʋE\xceVhNXd\t\xa4\xf6\xa7\xbcr\xda\x1e\x9d\xbe\x83\x7f\xfe[\xfd\x1f\x00\x00\xff\xff\xbeM\x1a\xea\xb1\n\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcWMo\xe36\x10\xbd\xfbW\f\xd0K\v\xac\xe4\x06E\x8b·\xd6\xd9C\xb0\xe96\x88\xb7\xb9S\xd4HbC\x91,9t6E\x7f|1\xa4\xe4\x0fYv\x9c\xcb\xea\xe6\xe1p\xf8\xe6\xcd\xcc#]\x14\xc5B8\xf5\x84>(kV \x9c¯\x84\x86\x7f\x85\xf2\xf9\xd7P*\xbb\xdc\xde,\x9e\x95\xa9W\xb0\x8e\x81l\xff\x88\xc1F/\xf1\x16\x1be\x14)k\x16=\x92\xa8\x05\x89\xd5\x02@\x18cI\xb09\xf0O\x00i\ry\xab5\xfa\xa2ES>\xc7\n\xab\xa8t\x8d>\x05\x1f\x8f\xde\xfeX\xde\xfcR\xfe\xbc\x000\xa2\xc7\x15\xd4\xf6\xc5h+j\x8f\xffD\f\x14\xca-j\xf4\xb6Tv\x11\x1cJ\x8e\xddz\x1b\xdd\n\xf6\vy\xefpn\xc6|;\x84y\xccaҊV\x81>ͭޫ\xc1\xc3\xe9\xe8\x85>\x05\x91\x16\x832m\xd4\u009f,/\x00\x82\xb4\x0eW\xf0\x99a8!\xb1^\x00\f)&XŐ\xdd\xf6&\x87\x92\x1d\xf6\"\xe3\x05\xb0\x0e\xcdo\x0fwO?m\x8e\xcc\x005\x06镣D\xd4\x7f\xc5\xce\x0e\xd3\x04@\x05\x100\xc0\x01\xb2;\x84 \f\bO\xaa\x11\x92\xa0\xf1\xb6\x87J\xc8\xe7\xe8\xc0V\x7f\xa3$\bd\xbdh\xf1\x03\x84(;\x10\x1c%;\x1c\x9c\xa5m\v\x8d\xd2X\xeel\xce[\x87\x9e\xd4Hy\xfe\x0e\x1a\xea\xc0z)\v\xfe8\xf1\xbc\vj\xee,\f@\x1d\x8e\xe4a=p\x05\xb6\x01\xeaT\x00\x8f\xcec@\x93{\x8d\xcd\xc2\fٔ\x93\xd0\x1b\xf4\x1c\x06Bg\xa3\xae\xb9!\xb7\xe8\t<J\xdb\x1a\xf5\xef.v`\xc6\xf8P-(\x91i\b\xbd\x11\x1a\xb6BG\xfc\x00\xc2ԓȽx\x05\x8f\x89\xc1h\x0e\xe2\xa5\ra\x8a\xe3\x0f\xeb\x11\x94i\xec\n:\"\x17V\xcbe\xabh\x1c3i\xfb>\x1aE\xaf\xcb41\xaa\x8ad}XָE\xbd\f\xaa-\x84\x97\x9d\"\x94\x14=.\x85SEJĤQ+\xfb\xfa;?\ff8:\x96^\xb9!\x03yeڃ\x854\x1d\xef(\x0f\xcfK\xee\xae\x1c*\xa7\xb8\xaf\x02\x9b\x98\xbaǏ\x9b/0\"ɕ\x1aZl\xe7z\xc2\xcbX\x1ffS\x99\x06}ޗڔc\xa2\xa9\x9dU\x86\xd2\x0f\xa9\x15\x1a\x82\x10\xab^Q\x18{\x9dK7\r\xbbNR\x04\x15Bt\xb5 \xac\xa7\x0ew\x06֢G\xbd\x16\x01\xbfq\xad\xb8*\xa1\xe0\"\\U\xadC\x81\x9d:gz\x0f\x16Fy<Sډdl\x1cJ.,s\xcb;U\xa3d\x1e\xa9\xc6z\x10{\x05\x19\x98>&j^\x01\x128\xe1[\xa4\xa9u\x82\xe5Kr\xe2\xe3_:q,X\xdfcٖ\xac9a\x00\x92\xf5\xe8\x87i\xa1.a\x80\xd9F\x9fE2\xf67\xd3\xc0\xbc\xb2\xa0\xb0\xd8\x1db:=\x9a?4\xb1\x9f?\xa0\x80\xdf\x13\xe6{\xdb^\\_[C<\x17\x17\x9d\x9e\xac\x8e=n\x8cp\xa1\xb3o\xf8\xde\x11\xf6\x7f:\xf4\xf9\x1a\xbe\xe8:\xde滫\xef\x82c\xd4g\xcf}D\xbeA\xf0|\xa6\x83\xc3UQ\xae\xc04x^\x95\xe8zs\xf7\x1e\nϸ\xbf\xa3Hw\xa6\xb1o\xa4\xb8w\x9c\xf5;#\x03\xe3\x97\xde\x10o\xf74\xbfBƞ\xe6-\xf9\xeeD\xf8\x14+\xf4\x06\t\xc3^\xa9_\x14u\xb3\x11\x01^:%\xbb\xb41\r\x04_\x02!X\xa9\xe6$\xf5\n\xf8\xac#\xca\xe3\xccP\x16iXg\xcc\f\xfe\xc4|F\xfd\xce\x1dP\f\x8at\x95\x82\x92\xa0\x18ޡ\xa1\xc9\x7f\xa4ZF\xef\xd3\x15\x95\xad\xfc2\x99n\xb8VDG\xe5\xf9\xeb\xf1\xfe\r%\xbd\xdd{\xa6\x17\xb7P&\xa3q\x1e\x8b\xa0Z~A\xf1\x1akiҸS2\xf2w\xfc\xc2;&j\xb6\xa2\xf8թ<\x80o@\xfc\xb8ŝ\x8f&\xdf\xf3\xd37l\n\x88\x81\x9f[ \x85\x99\xc1X!Ԩ\x91\xb0\x86\xea5\xdf\\\xaf\x81\xb0?\xc5\xddX\xdf\vZ\x01\xdf\xff\x05\xa9\x9962QkQi\\\x01\xf9x\xae\xcbf\x13w\x9d\b3cx\x94\xf3\x03\xfb\xcc5\xc6n\x18/v\x06\x9c\xbd_\n\xf8\x8c/3\xd6\ao%\x86\x80\xa7ct6\x93\xd9!81\x06~\xa4\xd5\a,\r\x7f\x19\x06\xcb\xff\x01\x00\x00\xff\xffx\xae@\xbaJ\x0e\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4ZK\x93\x1b\xb7\x11\xbe\xef\xaf\xe8Z\x1flWi\xc8HI\\)ޤU\x9c\xda\xc4\xdel\x89+]\\>4\aM\x0e\xbc3\x00\f`\xb8b\x1c\xff\xf7T\xe3A\xce\f\x87\xe4\x92r\xa4\xb9Hģ\xfbC\xbf\xd1آ(\xae\xd0\xc8\x0fd\x9d\xd4j\x06h$}\xf4\xa4\xf8\x97\x9b<\xfe\xcdM\xa4\x9e\xae_^=J%fp\xd3:\xaf\x9bw\xe4tkKzKK\xa9\xa4\x97Z]5\xe4Q\xa0\xc7\xd9\x15\x00*\xa5=\xf2\xb0\xe3\x9f\x00\xa5V\xde\xea\xba&[\xacHM\x1e\xdb\x05-ZY\v\xb2\x81xf\xbd\xfe\xd3\xe4\xe5w\x93\xbf^\x01(lh\x06F\x8b\xb5\xaeۆ\x16X>\xb6\xc6M\xd6T\x93\xd5\x13\xa9\xaf\x9c\xa1\x92i\xaf\xacn\xcd\fv\x13qo\xe2\x1b1\xdfk\xf1!\x90y\x13Ȅ\x99Z:\xff\xaf\xb1\xd9\x1f\xa4\xf3a\x85\xa9[\x8b\xf5>\x880\xe9\xa4Z\xb55ڽ\xe9+\x00WjC3\xb8c\x18\x06K\x12W\x00\xe9\x88\x01V\x01(D\x10\x1a\xd6\xf7V*O\xf6\x86)da\x15 ȕV\x1a\x1f\x84r\xaf\x05D\x80\x10\x11\x82\xf3\xe8[\a\xae-+@\aw\xf44\xbdU\xf7V\xaf,\xb9\b\x0f\xe0\x17\xa7\xd5=\xfaj\x06\x93\xb8|b*t\x94f\xa3x\xe7a\"\r\xf9\r\x83v\xdeJ\xb5\x1a\x83\xf1 \x1b\x82\xa7\x8a\x14\xf8J:\x88\xa7\x85't\f\xc7\xfap\xcaq\xc6a\x9e\xb7;\x8f\x8d\xe9!\xb8\xb1\x84\xbb\xad\x11\x82@Oc\x00\xb6\xf2\x04\xbd\x04_\x11K>\x18\x16J%\xd5*\fEM\x80װ\xa0\x00\x91\x04\xb4f\x04\x99\xa1rb\xb4\x98\xa8L\xb4\a\xebn0zJ6\xbc\xfe\x8fF\xd5\x03t\xaf\xc5\x05P\xce\xe2\x1b\x17\xf7\xb8~\xe8\x0e\x9d\xb4\x8f\x8a\u009a̼5\xb5FA\x96\xd9W\xa8DM\xacY\x04oQ\xb9%\xd9\x030\U000b61cd\xe9\x83y\x9f\xe9uf\xce\x11F\xf2\x9d\xb9\xd7\x16W\x04?\xe82\x04(6iK=\x9bv\x95nk\x01\x8b\xcc\x05\xc0ymG\r\x9c\x11\xc7]\x89n&;\xf0\xb3>\xcf\xc3\xe8;\xb4s<\x9d\x94\xec#R\xabq\x0fz\xbd\xa2q\xef\x89\xd3\xeb\x971\\\x95\x1558K+\xb5!\xf5\xfa\xfe\xf6ß\xe7\xbda\x00c\xb5!\xebe\x0e\x9f\xf1\xeb$\x87\xce(\xf4E\xfdߢ7\a\xc0\f\xe2.\x10\x9c%\xc8E\x9b\x8cc$\x12\xa6\xa8\x1e\xe9\xc0\x92\xb1\xe4Hż\xc1è@/~\xa1\xd2O\x06\xa4\xe7d\x99LVT\xa9՚\xac\aK\xa5^)\xf9\x9f-mǶ\xc7Lk\xf4\xe4<\x84P\xab\xb0\x865\xd6-\xbd\x00Tb@\xb9\xc1\rXb\x9eЪ\x0e\xbd\xb0\xc1\rq\xfc\xa8-\x81TK=\x83\xca{\xe3f\xd3\xe9J\xfa\x9c2K\xdd4\xad\x92~3\r\xd9O.Z\xaf\xad\x9b\nZS=urU\xa0-+\xe9\xa9\xf4\xad\xa5)\x1aY\x84\x83\xa8\x906'\x8d\xf8ʦ$\xebzl\xf7\xac&~!ӝ\xa1\x1e\xce} \x1d`\"\x15\x8f\xb8\xd3B\x8e]\xef\xfe>\x7f\x80\x8c$j**e\xb7tO.Y?,M\xa9\x96\x1c\x03x\xdf\xd2\xea&\xd0$%\x8c\x96ʇ\x1fe-Iyp\xed\xa2\x91\x9e\xcd\xe0ז\x9cg\xd5\r\xc9ބ\xb2\x82cYk\xd8\xcc\xc5p\xc1\xad\x82\x1bl\xa8\xbeAG\x9fYW\xac\x15W\xb0\x12\x9e\xa5\xadn\xb14\\\x1c\xc5ۙȥ\xce\x01\xd5\x0eꗹ\xa1\x92\x15˲\xe5\x9dr)S\xa4[j\v8\\ޗ\xd3x\x00\xe0o4\xca\r\x17\x9d2:\xfeތ\x11ʀU'`\xe7h\x9c\x82g\xdd\x0f\x9e\xdd/\x87\xf0\xed\x1eKF;\xe9\xb5\xdd0\xe1\x18\xbd\x87\x06qP7\xfc)-\xe8\xc4\xe1\ued201ؼ\x15|\x85Ѻ\xb9x\xe3\xe0\xd6*\xb5υ?\xad\xce\x02f\xb48\x81+qD\xb0\xb4$K\xaa\xa4\x1c\x05\x8fU&#Ⱥ5\xc3>\xc6Ö\x02GR\xc6(\xe2\xd7\xf7\xb79-d!&\xec{\x91\xff\xa4|\xf8[J\xaaEȢ\xa7y\x8f\x9a(\x7f\xb7\xcb\b\"\xc4F\xaf\x01\xc1H\x8a\xb5\xe76/\x81T\xce\x13\x8a4\xc8\xe1\xc0R\x9a{\x11c\xdeA\x90\xfc\xed\xf2\x17\xeb\x04\x90c\xb0\x14\xf0\xcf\xf9\xbf\xef\xa6\xff\xd0\xf1\x1c\x80eI.\x14ٞ\x1aR\xfeŶ\xee\x17\xe4\xa4%\xc1U<M\x1aTrI\xceO\x125\xb2\xee\xa7W?\x8f\xcb\x0f\xe0{m\x81>bcjz\x012\xca|\x1bֳ\xd9H\x17\x0f\xbe\xa5\bO\xd2W\x01\xa8\xd1\"\x1d\xf0)\x1c\xc1\xe3#\x81NGh\tj\xf98\xe2?\xf1\xbb\x0eU\xd3\x0e\xe6o\xec=\xbf_\xc37э\xaf\xf9\xe7u\x84\xb1M\xe0]\a\xdb\xc1\x89^f\xe5jE\xbb\xf2l\xcfX8\xe1p\xa8\xfe\x16\xb4\xe5\xb3*\xdd!\x11\b\xb3\x9eb\xa4$\xb1\a\xef\xa7W?_\xc37}\x19\x1c`%\x95\xa0\x8f\xf0\nd\xba#\x19-\xbe\x9d\xc0C\xb0\x83\x8d\xf2\xf8\x919\x95\x95v\xa4@\xabz\x13K\xe35\x81\xd3|\xb7\xa2\xba.b\xa9$\xe0\t7\xa0\x97\a\xf8d\x15\xb1i\"\x18\xb4\xfeh\xb9\x94\xe4p\xdci\xf6\xeb\x87\xfc=\xcf_B=\xf1,\xef\xfdb\xb9\xf8\x99\x92\b\x85\xf3'H\xa2{\xe9\xb8@\x12\x8f킬\"OA\x18B\x97\x8e\xe5P\x92\xf1n\xaa\xd7dג\x9e\xa6O\xda>J\xb5*\xd8\x18\x8b\xa8u7\rw\xd9\xe9W\xe1\x9fK\x0f\x1en\xbd\x9fz\xfa\xde-\xfd\U000cb039\xbb\xe9%\x12\xc8u\xee\xf3s\xd7A9\xccS\xe95\xa4\xc9>\xffTɲʷ\x9eN\xb4mP\xc4p\x8cj\xf3\x85|\x87\xe5\xdcZF\xb4)RӮ@%\xf8\xffN:\xcf\xe3\x97\b\xb6\x95\x9f\x14\\\xde߾\xfd\x92\x1e\xd5\xcaK\"Ɂj>~\x1f\x8b\x1d\xaa\xa2AS\xc4\xd5\xe8u#\xcb\xc1j\xaefo\x05+i)ɞ(\xff\xde\xf5\x16\xe7\x02u\xa4.ޮ9\xab\xfe\xf4\xb8\x1a)\xf8\xba\xfd\xccce\xe1Qy\x9d6\x85\a\\9@K\x80Рa\x8bx\xa4M\x11+\x0e\x83\x92\xcb\x05\xae\b\xb6\xfd\x1b@cj\xce鱊\x18\xa1\x98\xea\xdf$\x1et\xe1|\x87\x042\xaa\xcaܯ\x9a\x93\xe7;\xf3\x97\x13\xce\xfb\x01\x90?VP\xdbn^\xa9\xd5R\xaeZ\x1b\xeeb\xfb\x92Rm]㢦\x19x\xdb\xee\x13z\x86 \x1fx\xc9\xf1\xf3\xbf\xef,\xcd\x16~\xa2\xf58~\xaa^Cr\xff0\xa4\xdaf\x1fJ\x01\x8f\xdaH\x1c\x19\xb7\xe4\xfc\x9e\xf7\xf2\xc4\xf5\xf59>\x16\x8d\xf2\x92\xbbuz&\x18\xb9\x95&CO\x05|\xbe\x99v;ãJ?#6X\xfa\xb5\xe5\xebH\x1fw1\xde8\x18\xac\xe1;\xf3`\xc8h1\x18\xe9\x87\xc1\xc1d\xaf{\xddE\xba\xdfM\t\x8f\x12g\xf4S\xe2cK\x92iL\x8e>?\xc1p\xd9}iG\xa5\xd4|\xfd\xeauv/\xd1\xf9\xcd>\x99\xd0\t\xb5\"9\x86l8\x0et\xdek\x12㱖H\x97\\\xdc\x19j\x14\xa6F\"\\\xa3\xf8\x96\xb7DY\x93\x80\xfc(w&\x95\x05-9GG'͍\x88\x04\xef\xf0\x05\xe6\xa1\"p\xa1\xaf\xf8\xb5\xdb\xd2l\x1d\x89\xd0\xd6\x1a\x11\xc2~\xc6^j۠\x8f-\xf2\x82I\\\x16\xbdF}\xb6!\xe7pu\xcai\x7f\x8c\xabb\x7f&m\x01\\\xe8\xd6o\x1b4\xbd\x8c\xf4\xb5K\x86v^\x8fh\xb4\xf5ѷq\xf4U6\xe9e[\xd7aO7:\xec\x1el\x03\xaa\x05\x8d\xd7uG\x1aD\xc7\x00V\xe8N\x89\xea\x9e\u05ccy\xdd6\xa4\x1du;8\x12\xbe\xef\xe8idt\xef\x05\xb5;y\x93]fd\xee\xfb\xe0\rg\x9d?1\xba\xc4\xdd3H\xa8t\x9d=\\{\xacA\xb5͂,\vg\xb1\xf1\xe4\x06\x81\x1f\x95\xe8Jr\xec\xf6\xb7۟\x95\x1a)\xa5\x0eF\x89*\xb4\xde],\x13\x84t\xa6\xc6\xcd\xf6,\xa1\xe6f\xff\x1ao\xd1\xee\x8c<{\xba\xa1C5\xc4\xf1\xd6b\xc0\xf4V\xab\x03\xb7\xd4\xec\xe4R\xf9\xef\xfer\xa4h\x97\xca\xd3j\x90F\xd2<\x8b\xf3\rs\xf9\xffp8R\x039\x85\xc6U\xda߾=a\x1a\xf3\xed\xc2\xec\"\xbbz>\x04\xc4\xd0\xfdO\x8b\x92)\x8c@\xdd\x05\x9c\xb3\xfc\xb7\xff\xa0\x7f\x89\x15\xcf{\x14N\xe4\xab\xf4\xf7\x05cYaN\x06-Ǆ\xf0\xb6t3|)}\x01N\x86\x068W\xbb\xb1\xfc-+T\xab\xd1\xfe\x88V\xa1\x80\xd3v\xff\xa1\x0fN&\xa0\xfe\x81>g\xee\x195\xa7\xbd\xc1\x80\\th\xa7g\x95\xeeH\xbbؾ8\xce\xe0\xb7߯\xfe\x17\x00\x00\xff\xff\x8fTl=\x19$\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y_\x93۶\x11\x7fקع<$\x991\xa5\xdam3\x1d\xbd\xd9\xe7\xa6smr\xbd\xb1\xce~\xc9\xe4aE\xacHD$\x80\x00\xa0d5\xcdw\xef,@R\xfc'\xe9tnl\xbe\xdc\t\x00\x17?\xfc\xf6/\x96I\x92\xcc\xd0\xc8\x0fd\x9d\xd4j\th$}\xf4\xa4\xf8\x97\x9bo\xff\xe6\xe6R/v/g[\xa9\xc4\x12n+\xe7u\xf9\x8e\x9c\xaelJoi#\x95\xf4R\xabYI\x1e\x05z\\\xce\x00P)푇\x1d\xff\x04H\xb5\xf2V\x17\x05\xd9$#5\xdfVkZW\xb2\x10d\x83\xf0f\xebݟ\xe6/\xbf\x9b\xffu\x06\xa0\xb0\xa4%\x18-v\xba\xa8J\xb2伶\xe4\xe6;*\xc8\xea\xb9\xd43g(e\xe1\x99ՕY\xc2q\"\xbe\\o\x1cA?h\xf1!\xc8y\x17儩B:\xff\xaf\xc9\xe9\x1f\xa4\xf3a\x89)*\x8b\xc5\x04\x8e0\xeb\xa4ʪ\x02\xedx~\x06\xe0Rmh\t\xf7\f\xc5`Jb\x06P\x9f3@K\x00\x85\b\xcca\xf1`\xa5\xf2doYD\xc3X\x02\x82\\j\xa5\xf1\x81\x99V\x0e\xe8\r\xf8\x9cx\xcb\xc0*J%U\x16\x86\"\x04\xf0\x1a\xd6\x045\x12\x11\x84\x01\xfc\xe2\xb4z@\x9f/a\xce\xc4͍\x16s\xd5Ȭ\xd7D\xce\xef\a\xa3\xfe\xc0\xe7p\xdeJ\x95\x9dB\xf6\x7f\x06\xd5\xc3\xf3\xa0\xc5\x13\x91<\xe6\x14\xd64h*Sh\x14dy\xf3\x1c\x95(\b\xd8@\xc1[TnC\xf6\x04\x8a\xe6\xb5ǃ\xe9#y\xdf\xc8\xeb\xcc\\\xc3\xce5Tĵ\xbd\xed?t\x87.\xed\xfb\xa0E\xfd\x02\xd4F\rΣ\xaf\x1c\xb8*\xcd\x01\x1d\xdc\xd3~q\xa7\x1e\xac\xce,97\x01#,\x9f\x9b\x1c]\x1f\xc7*L\xfc\xb186ږ\xe8\x97 \x95\xff\xee/\xa7\xb1\xd5/ͽ\xf6X\xbc9xr=\xa4\x8f\xc3ሖ\x9d-\xab\xd5\xffE\xe0\xae\x19\xd2[\xad\xfa\xbc\xbe\x19\x8cN\x81\xed\bm\xe2\xed<\xb5\x14B\xed\xa3,\xc9y,MO\xea\xeb\xac/O\xa0\x8f\x03qz\xf72\x86\xb24\xa7\x12\x97\xf5JmH\xbd~\xb8\xfb\xf0\xe7Uo\x18\xc0Xm\xc8z\xd9D\xd7\xf8t\x92Gg\x14\xfa\xcc\xfe7\xe9\xcd\x01\xf0\x06\xf1-\x10\x9cE\xc8E'\x89c$jL\xd1y\xa4\x03Kƒ#\x15\xf3\n\x0f\xa3\x02\xbd\xfe\x85R?\x1f\x88^\x91e1\xe0r]\x15!\"\xed\xc8z\xb0\x94\xeaL\xc9\xff\xb4\xb2\x1d\xfb\"oZ\xa0'\xe7\x03\xd7Va\x01;,*z\x01\xa8\xc4@r\x89\a\xb0\xc4{B\xa5:\xf2\xc2\vn\x88\xe3G\xb6\x1f\xa96z\t\xb9\xf7\xc6-\x17\x8bL\xfa&\xa5\xa6\xba,+%\xfda\x11\xb2\xa3\\W^[\xb7\x10\xb4\xa3b\xe1d\x96\xa0Ms\xe9)\xf5\x95\xa5\x05\x1a\x99\x84\x83\xa8\x90V\xe7\xa5\xf8\xca\xd6I\xd8\xf5\xb6\x1dyd|B\"\xbcB=\x9c\x19A:\xc0ZT<\xe2Q\vM|\x7f\xf7\xf7\xd5#4H\xa2\xa6\xa2R\x8eKG\xbc4\xfaa6\xa5\xdap\x84\xe6\xf76V\x97A&)a\xb4T>\xfcH\vIʃ\xab֥\xf4l\x06\xbfV\xe4<\xabn(\xf66\x94\x1d\x1c\\+\xc3f.\x86\v\xee\x14\xdcbI\xc5-:\xfa̺b\xad\xb8\x84\x95\xf0$mu\x8b\xa9\xe1\xe2Hog\xa2\xa9\x84N\xa8vXݬ\f\xa5\xacY&\x97_\x95\x1b\x99F\x9f\xdah\v8Z\xdfgj:\x04\xf0\xb3\xc6t[\x99\x95\xd7\x163\xfaAG\x99\xc3E\x97̎\x9f7S\x82\x1aĪ\x93P\xe3\x8e\xe0\xe2J(\xea\xa5\x13\"\xf79Y\xea\xbec\xc9h'\xbd\xb6\a\x16\x1cS\xf1\xd0$Nj'\xf0\xa0Ņ\xb3q.\t\x0ediC\x96TJM\xb89W&M\x80\xefT\vc\x88\xa7\xf5\x01gB\xf3$\xe0\xd7\x0fwM\xf8m\x18\xae\xa1\x8f\"\xecEz\xf8\xd9H*D\xc8V\x97\xf7\x9e4\x04~\xee6\x11D\x88A^\x03\x82\x91\x14\xcb\xe06\xfe\x83T\xce\x13\x8az\x90\xdd\xceR=\xf7\"Ɩ\x93 \xf99\xe6\tV\t \xc7:)\xe0\x9f\xab\x7f\xdf/\xfe\xa1\xe39\x00Ӕ\x9c\v\xe5\x00\x95\xa4\xfc\x8b\xb6$\x10\xe4\xa4%\xc1u\x11\xcdKTrC\xce\xcfkid\xddO\xaf~\x9e\xe6\x0f\xe0{m\x81>bi\nz\x012rކ\xcf\xc6j\xa4\x8b\ao%\xc2^\xfa<\x005Z\xd4\a܇#x\xdc\x12\xe8\xfa\b\x15A!\xb74\xcd>\xc0M(4\x8f0\x7fc\xd7\xfa\xfd\x06\xbe\x89\xcer\xc3?o\"\x8c6Qv\xbd\xef\b\xc7\xe7\xe8\xc1[\x99et\xachG\xc6\u0081\x9dCⷠ-\x9fU鎈 \x98\xf5\x14\x03\x12\x89\x11\xbc\x9f^\xfd|\x03\xdf\xf498\xb1\x95T\x82>\xc2+\x90*rc\xb4\xf8v\x0e\x8f\xc1\x0e\x0e\xca\xe3G\xde)͵#\x05Z\x15\x87xA\xd8\x118]\x12\xec\xa9(\x92X\x92\b\xd8\xe3\x01\xf4\xe6\xc4>\x8d\x8a\xd84\x11\fZ\x7f\xb6,\xa9y8\xef4\xe3<\xdd<O\U000d7437\x9f\xe4\xbd_,\xe7=\x91\x89P\xa0~\x02\x13ݫ\xd73\x98\xd8Vk\xb2\x8a<\x052\x84N\x1d\xf3\x90\x92\xf1n\xa1wdw\x92\xf6\x8b\xbd\xb6[\xa9\xb2\x84\x8d1\x89Zw\x8bp\xaf^|\x15\xfe<\xf7\xe0\xe1\x06\xfe\xa9\xa7\xef5\f>?\x05\xbc\xbb[<\x87\x81\xa6\x9e|z\xee:\xc9ê\xaep\x862\xd9\xe7\xf7\xb9L\xf3\xe6vщ\xb6%\x8a\x18\x8eQ\x1d\xbe\x90\xef0ϕeD\x87\xa4n\x9e%\xa8\x04\xff\xef\xa4\xf3<\xfe\x1cb+\xf9I\xc1\xe5\xfd\xdd\xdb/\xe9Q\x95|N$9Q5\xc7\xe7crD\x95\x94h\x92\xb8\x1a\xbd.e:X\xcd5\xe3\x9d`%m$\xd9\v\xd5\u07fb\xde\xe2\xa6z\x9d\xa8>\xdb5W\x95\x9fN\xa1q\xb9\xf6wo/\xe0X\xb5\v\x1b\fG\x1d\xd6Eg#kЙ\xba\x0eO\xf0\xad\xfbӑ\xab\x0f\xaa\xbf\xbaA\xa6\xad\xcc$߿\xdb\xf0\x11\xae$\nK\xecv$\xbbO\x89\xc6H\x95]\x85\xb5i\xf0\xad\xc8\xf35v\xa2p\xee\xb6fϕ\xd7g\xed\xee\xb2K\xbd\x1f\x00\x01\xb4\x04\xc8gb\rm\xe9\x90\xc4*Π\xe4\x12\x8c\xab\xac\xbaT]\x13\xa01\x05\xd7I\xb12\x9b\xf2\xf5\xa6]\x99j\xb5\x91Ye\xc3\xe5h̔\xaa\x8a\x02\xd7\x05-\xc1\xdbj,\xe8\x8c\xfbt;\xa5\x174\xfe\xbe\xb3\xb4Q\xf7\x85^\xed\xf4\xa9z\x1d\xdc\xf1aHU\xe5\x18J\x02[m$N\x8c\xb3\xb1\x8f\x1c\x9d'nn\xae1\xa9\xe8I\x178\xa8\x1b\x8b\x13\x17\xd9\xda\x11벞G\xf8\xf2\x18\xdcq:;^렖~\xad\xf8\x8e\xd2G\x98L\xdf\xd9\ak\x8c\x16\xb3!i\xdd\xd86\x98<F\xa6\xe1D\xdf\xe9\a\xb3\xbd\x86w\xf74\xe3vG\xe8\xa6^\xd3\xf0\x88\x1dܚ\xf7\x98V}\xd3\xd7\xe5\x82\xfd\xd9-\x8fT\xf3ͭ\xd7|}N\xc3\xe3v,&4+\xad\xa8\x1dE\x96\x14\xfa\b\xb1#\xbdG\xd7\xec<e\x04]y\xf1\xd5P߰8\x12\xe1\n\xc67\xc4\rʂ\x04\xb4\x1f\xd6&\xc4<\xe6\x04.\xb4\xea\xbev\xad\xa0ʑ\bQy\x02\xf4897\x8dq\x81\x9e\x12\x16\xf1\xbc\xe83\xe9s%9\x87\xd9%\xa7\xfb1\xae\x8a\x9d\x98\xfa\x15\xc0\xb5\xae|ۊ\xa9\xbd\xaf\xa6\xe2kW\x9b\xc6u\xed\xa0\x1c\xdd%(\x0f\xbcf\xca\f\xdb8p\xde\x0e\xe1L|\xbb\xa7\xfd\xc4\xe8\xe8CEw\xf2\xb61\xa1\x89\xb9\xef\x83u\\E@\xbd\xd1s\xec\xbf\x01\t\xb9.\x1a\x93מ뀪\\\x93ev\xc2'\x93\x86\xa6\xb6`A%\xbadNݥZ\tMԌ\xa2\xeav@\x8a*\xf4\x8b]L\xa4B:S\xe0\xa1=L(`ق\xa7\xbb\x8aG3j\\\x93\x03ŉ4{\xbeQ\xd7~\x12\x9a.ϧ>0\xf5\x9f\xf1ע\xc1|\xfb-\xec\x8f\xd9\xe1L\x99\xe0<Z\xffI\x01rՓp)6\x86\xfd\xa6#\xe3\xf9\x90\xd6\xdf\xe6sF\xb3I\xf6F\x83\x01\xb9\xe8Ȯ;\xdfݑj\xdd~\x16Z\xc2o\xbf\xcf\xfe\x17\x00\x00\xff\xffU\x18\x13\xf6\xde!\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xdc=[s\xdb8w\xef\xf9\x15\x98\xf4a\xdb\x19\xcbi\xa6\x97\xe9\xf8\xcd\xf5:\x8d\xfb}\xebx\xec4\xfb\f\x91G\">\x83\x00\x17\x00\xa5h\xdb\xfe\xf7\x0e\x0e.$%\x90\x84d˛-^2\xa6\x80\x03\xe0\xdc\xcf\xc1\x01\xb2X,\xdeц}\x03\xa5\x99\x14W\x846\f\xbe\x1b\x10\xf6/}\xf9\xfco\xfa\x92\xc9\x0f\x9b\x8f\uf799(\xaf\xc8M\xab\x8d\xac\x1fA\xcbV\x15\xf03\xac\x98`\x86I\xf1\xae\x06CKj\xe8\xd5;B\xa8\x10\xd2P\xfbY\xdb?\t)\xa40Jr\x0ej\xb1\x06q\xf9\xdc.a\xd92^\x82B\xe0a\xea\xcd?^~\xfc\xd7\xcb\x7fyG\x88\xa05\\\x11\x05\xdaH\x05\xfar\x03\x1c\x94\xbcd\xf2\x9dn\xa0\xb00\xd7J\xb6\xcd\x15\xe9~pc\xfc|n\xad\x8fn8~\xe1L\x9b\xbf\xf4\xbf\xfe\x95i\x83\xbf4\xbcU\x94w\x93\xe1G\xcdĺ\xe5T\xc5\xcf\xef\bхl\xe0\x8a\xdc\xdbi\x1aZ@\xf9\x8e\x10\xbft\x9cv\xe1W\xbd\xf9\xe8@\x14\x15\xd4ԭ\x87\x10ـ\xb8~\xb8\xfb\xf6OO\x83τ\x94\xa0\v\xc5\x1a\x83\b\xf8\x9fE\xfcN\xc2B\tӄ\x92o\xb8Q\xbb\x1aD<1\x155DA\xa3@\x830\x9a\x98\n\bm\x1a\xce\n\xc4;\x91\xab\x1e\xa40J\x93\x95\x92u\amI\x8b\xe7\xb6!F\x12J\fUk0\xe4/\xed\x12\x94\x00\x03\x9a\x14\xbc\xd5\x06\xd4e\x04\xd4(ـ2,`ٵ\x1e\xef\xf4\xbeNm\xcc6\x8b\v7\x8a\x94\x96\x89\xc0m\xc1\xe3\x13J\x8f>\"W\xc4TLw[\r\xdb#T\x10\xb9\xfc\x1b\x14\xe6r\x0f\xf4\x13(\v\x86\xe8J\xb6\xbc\xb4\xbc\xb7\x01e\x91Uȵ`\xbfG\xd8\xdan\xdcNʩ\x01m\b\x13\x06\x94\xa0\x9cl(o\xe1\x82PQ\xeeA\xae\xe9\x8e(\xb0s\x92V\xf4\xe0\xe1\x00\xbd\xbf\x8e_\x90xb%\xafHeL\xa3\xaf>|X3\x13$\xaa\x90u\xdd\nfv\x1fP8ز5R\xe9\x0f%l\x80\x7f\xd0l\xbd\xa0\xaa\xa8\x98\x81´\n>І-p#\x02\xa5\xea\xb2.\xff.\x12u0\xad\xd9Y\x1e\xd5F1\xb1\xee\xfd\x80\x02q\x04y\xac\xa88\xc6s\xa0\xdc\x16;*\xd8O\x16u\x8f\xb7O_\xfbLɴ'J\x8f7\xc7\xe8c\xb1\xc9\xc4\n\x94\x1b\x87\xacia\x82(\x1bɄ\xc1?\n\xce@\x18\xa2\xdbe͌e\x83\xdfZЖ\xdf\xe5>\xd8\x1b\xd4:d\t\xa4mJj\xa0\xdc\xefp'\xc8\r\xad\x81\xdfP\roL+K\x15\xbd\xb0DȢV_\x97\xeewv\xe8\xed\xfd\x104\xe2\bi\xbd\x16yj\xa0\x18H\x9a\x1d\xc6VA]\xac\xa4\x1a(\x19;d\x88\xa3\xb4\xf0\xdb洈U\x8b\xfb\xbf\xccq\x99m\xff\x1eG[~\xb3+k\x05\xfb\xad\x05T\xa6N\xfc\xe1P_\xa9\x9ej\x1f6\xcbF\xfb\xd4\x1dE\xb4m\xf0\xbd\xe0m\te\xd4\xeb\a\x1b\xcc\xd9\xc6\xed\x01\x144z\x94\t+D\xd6\xfaؽ\x88\xeeWT\xe0T\x01\x11\xd2$\xe01\xe1\xe0\x11&\x10\x03I\x9a`G\x03ubœ[&D\xb4\x9c\xd3%\x87+bT{\x88F7\x96*Ew#\xd8\n\x1e\xc0\x8b\x90\x15\x81xU\xc3Y\x81$\x8f\n\x05\xf1\xf5\xe7E\x15\xd3VQ\x86]>HΊ\xdd\f\xben\x93\x83\x82\xb4z\xd9\xf5;$K\xa8\xe8\x86I\x95\x12\x03\xa9\xb0kϞwjZZ-\xe9\x81\xec۸\xcc\r'\x91UI\xf9<\xc7\x10\x9fm\x9f\xce:\x90\x02\x1dʸ\x15Omo\xbb\x97@\xe0;\x14\xadI,\x93\x90\xb2E\xd3$\x15i\xa46\xe3t\x1fW]\xa4\xef\x1c\xa5~\x9c`\x9a\x83\x9d%Y\xdd5\xaf\x84\x03Q-\x0e\x06\nY\n\xb0ۨ-Q\xbb\xbeJ\xb6\xae\xef(RȒj(\x89\x14\xa33#\xbb\xb4\x1c\xb4\x9f\xabD\xce\xe8\xf4\xd0E\xb7\x7f\xf4x\b\xa7K\xe0D\x03\x87\xc2Hu\x88\xcc\x1c\x94\xba\x96\xa3XGP\x99ЦC\t\xe860\x01\x92XN\xdfV\xac\xa8\x9c\x87a\xd9\x13\xe1\x90R\x82\xb6\xda\x04]\xe6\xdd\xd8&\xc9\x1c\xf9\xfd$Sڣk3b\xb5\x0f/\xa5Q\xba\x96\xa1\x86\xbb\x96Dm\xa7{\x0ft\x8b\xffn\xe4\xe4\xb6\xff\x7f\"6\x18\x93\x13\x98vB\xfe\t\xba\x9f\xd9<=ʷ\x18ၾ$w+\x02ucv\x17\x84\x99\xf0uN\x12(\xe7\xbd9\xfeĴ9\x9e\xe93I\x93#\x13g\"L\x9c\xe2OH\x174\x19O\xdebd\xd3\xe4\xaf\xfdQ\x17\x84\xad\"\xd2\xcb\v\xb2b܀\xda\xc3\xfeI\xaa>P\xe65\x90\x91c\xf5\b\xe6\tLQ\xdd~\xb7.\x8e\xee\x92`\x99x\xd9\x1f\xec|\xe3\x10A\f\xcd\xf3\f\\\x82\xf12SPc\x1cN\xbe\"6\xbb/\xe8T_\xdf\xff|\x18+\xef\xb7\f\xce;\xd8Ȍйv\xbd\xb7\xa3\xfe\xfa|T\x10~A\x1f(\x06U.\xe7rA(y\x86\x9ds]\xa8 \x96>4tΘ^\x01&\x7f\x90Ϟa\x87`\xd2ٜÖ\xcb\r\xae=C\xc2\xf5O\xb5\x01\x0e\xed\x9a|X\xec\xf0d? \"0\x86\xcfe\x03\u05fc($r'閩KB\v\xb8?a\x9bY\xacҟ\xa3\x9f\xfaD\x0e\xf8I;ZZ\x89\xa9\x98\xcfij@\x99\xc9%\xa8k\xdf(ge\x9c\xc8\xc9ȝ\xb8 \xf7\xd2\xd8\x7f0@\xd3\xc8(?K\xd0\xf7\xd2\xe0\x97\xb3`\xd4-\xfc\x9c\xf8t3\xa0\xa0\t\xa7\xe5-\xc2\xfa9?g\xd3,\xb7E\xdc3M\ue10dW\x1cJ2\xa7\xc2\xf4\xae\x9b\xceMT\xb7\x1a\xd3uB\x8a\x05\xda\xcc\xe4L\x1e\xdfR\r\xd0\xfd\xe2I\xfd\x84_\xad\xb1p\xbf\xb8$3\xa7\x05\x94!\xb2\xc4\xec'5\xb0fE\xe6|5\xa85\x90ƪ\xf0<\x8e\xc8T\xac~7ǱO\x9e\xf5\xee\xb7\xef\x8b\xe7\x98/XX\x93\xb3\xf0\x10\x8c\xac3p\xe0uw9\xbf\x9f\x85\x95ٌ^\x81\x13f\xbb\x8e$Gǻ\xe6 \xe5\x05\xe8@+\x8e.\xce,uiY\xe2\x11\x1a\xe5\x0fGX\x94#x\xe1X\xd5\xd0[\xbb3\xc15m\xacZ\xf8okiQ\x9a\xfe\x974\x94)}I\xae\xf1\xa4\x8c\xc3\xe07\x9f\x87\xeb\x81ɘ\xb2\xb1SY\xfe\xd9Pnm\xbfU\xe0\x82\x00w\x9e\x80\\\x1d\xf8E\x17d[I\xed\xcc\xf6\x8a\x01\xc7\xf3\x8a\xf7ϰ{\x7fa\xa7\x9f\x9d\xb2\xafd\xde߉\xf7·8P\x18\xd1ᐂ\xef\xc8{\xfc\xed\xfdK\\\xa9LN\xcd\xec6`њ6y\x1c*\x92\xc9\xfa\xae\r8\xa6\x9f\x9b\xef\x92\xf2\xdeɞ\xdam\x16\x8b6R\x9b\xcf\xe9\xbc\xe1\xc8z\x1e\u0088\xa1g\x9cȱ\xcdF\f>\x8f\x16\xf5\xbdu\"W\x06\x94\xcf%:\x1b\x10\xe2\x8f\x17Ff\xa9S\x99\xfebc2\x90\xc6\xfc\xaeE\xf0\f7\xb9\x83\x9b\x9c%\x1e\xe3\xb0Z\xbc\x1c\xe9\xed\xdf~\xef\xe53\xad\xe4ڿ\xfb\x1bym\x87\xba\x90uM\xf7O5\xb3\x96z\xe3F\x06\x9e\xf6\x80\x1c\xf5պEyε\xc8\x1d\x0f\xe1\xf9喙\x8a\tB\x83\xda\x00\xe5\x19\x8a\x92F\xa6rةVQM\x96\x00\"\xa6\xe8\x7f\x04W\xa2f\xe2\x0e' \x1f\xcf\xe0zDt\x9d\xd3ٽ\x894\x89\x94\x8f\x1f\x9c\xc9jdI\xb6\x15(\x180\xc6a\xde\x1d=U!M/eq\x84C\xda\xc8\xf2'MVLi\xd3_\x82&\xadΥ\xf5\x91\xe4\xb3\xeb\xfe\xcaj\x90\xad9'\x82o\xbbi\x06g\xcd5\xfd\xce\xea\xb6&\xb4\x96\xad3\xe6\x86\xd5\xf1TףwK\x99\x89\xc7V\x98\xbf1Ғ\xa0\xe1`\x80,a\x95>\xefM\xb5B\n\xcdJP\xa1J\xc1\x91\x8dI+\x98+\xcax\x9b:%J\xb5c#`q\xab\xd4I\x01\xf0\x177\xb2\x97w\xac\xe4v\x88\xa0̽\xe3A\x1a\x10\xb6\"\xcc\x10\x10\x85\xc58(\xa7\x92q\n\x8f\fD\r\xcb\xd5sy\n\xdc6\x10m\x9d\x87\x80\x05\n$\x13\x93)\xb7~\xf7O\x94\xf1s\x90\xcdr\xde'\xa9\x1e\x81\x96\xa7\xe4h~\xed\r' t\xab\xf0\xf0\xdf\xe9\x8e-\xe3yk\xb6\x94#\x9c\xb6\xa2\xa8\x00\x95\x90\x18\xea\x06\a\x9e\tm\x80\xe6\xf2\x82\xf5\x8aZ!\x98X\xe7\xd1.;\x11\xda5\x87\ua954\x1c\xe8\xf8)d\xd7,\xae\xdf@\x13\xfd\xdaM\xf3BM\xd4\x11\xc1\x1d\x9b#\x1d\xb2)j\x95\x16\xa1\xc6@\xdd8\x91\x93D\xb5\xa2o]Π\x88\x8e\t\xc3\xfd*^3\xbef\x82e\xd0v@\xd7;\xc1L\xdfy\xb4 \xce\xea<\xda\t\xa2;pJ\x86\xedn\x00\xc0\nh\x88Cp\xed\x91k\x8ep$\x97@hYB\xe9r\x97\xd6\x15\xf1a\x89+|\x1b)nH\xee\xeexO0\x8b\xb2\xa1\r\x82N\xccê\r,Z\xf1,\xe4V,0\x18\xd7G\xeb\x90\x13\xb3T/\x9dޜ\xac\x8c\xe6\xf5K\xbe\x9a\x9e\xd3BC~\xcd\xe7\xa9\xe0?\x9dA\xcbd\xf3\xcdQ\t\x8f).\x98\xd3k\xae\x00{\xe4\xc7\xd9UL\xcd?1\xd8\x1fJ߸b\xe9\x17\x95\xc5ݥA\xf5\x9c\xc2m\x05\xa6\x02\x15J\xb3\x17X\x92^N\x9e\x90v\xc1K\xac\x93\xb3L\x15\\dW\xfe\xb9W9\x87\xd1M\xcb\xf9\x85\xe5m\xda\xf2d8l$\x8a\xd8!geՏ\xa5=\x86\x9c\xea\x8bl<\xf6+-\x86\xf5\x85\xb1\n\"\x14\x18\xca0\xb3\xa7qj\xbfXX\xda;\xdf\x1f\x96S`\xfe/,\xff\x0f/=̨\x94\xc8Gcn\x95fDb\x02V\x82\xc1zh\xec\xea+|?_\xe8\xfbc\xe1\xd4@\xfd\xa5\xf1\x123\xea\xc2f\xa05\x01g\xaf\xde\x04\xadA\xab\x9d+\x10\xed\x80\xcf\x19\xda\xf1ׅ\xbb\x05\x11\xc0\xa4\xf8\xf5k\x05A|}\xf5>\xd3\xe4\x9fI%\xdbDU\xdf\x04\xcaf\xaa;\xe67<(\xf4\xf0\a\n`\xe8\xe6\xe3\xe5\xf0\x17#}\xd9\af\xd1\x12\x800(\xea2\xb3L\x94l\xc3ʖ\xf2 \xb5\xdd\x1d\x02\xc7@\x1d\x9f%\xa0IE\x04\xe3\x8e\x01\xc3\xf8\x01Ñ/\x8d;\x969Z\xc5M\xfb\xa2y\xd5!'ׄ\fk>F\xac\xe1\xb1\xc7\x17\xafR\x05\xfb\x87\xd4z\x1c_\xe1\x91\x13I\xccTs\x9cPÑY,\xf6\xe2\xf3\x96\x9c*\x8dcb\xee\xb3Ud\xbc~\x1dF\x16~\xe6k.\x8e\xc1\xce\xd9\xeb+ް\xaa\xe2mj)2+(^\xaf\x142/\xfa<\xa9\x14`>`\x19\xaf\x82\x98\xad}xQ@sҖfk\x1a\x8e\xa9d\x98\xa5N\x9e\x98\xbdY\xad\u009bU(\xbcm]\xc2$\x17M\xfexL\xe5A\x8c\x93~\xa1M\xc3\xc4\xfa\x90)rYg\x92m\xe6Y\xe6~o!\x03\x9e\xe9\x873]t8\x12\xfa\xba\xeb҉H2\xa4-\x990\xf2\x92\\\x8b\x9d\x87\x9b\x80\xd3\v\x1f\x854\a\x17\xd9첶\x8c\xf3\xfem-\x04;\r\xcaߙԴv\xab\x1a\xf3\xf6\x93t\x95j\xe0\x94\x9f\x148~ك\xd1ώ\xbe\xa5\xe7_\xb7ܰ\x86\x83\xf5\xe86\xacL\xde!3\x15\xec\"\x92\xff&\xf1\x86\xd4r\x87\x90\xbe<FY\xbc\xdc\vb\xa8&[\xe0\x9c\xd0\x14w\x1cl\xbfp7\x93\v\xb9\xc0+\x81\x96\xbc\x81I\xfc}\xe6\v'\xc5x\r\f\xa9W'\xe0\x16T\xe0\xedf\x9d\xd8Ȩ9\xccѢ\a~\xb9\x8b.\xf0\xdbo-\xa8\x1d\x91\x1b,a\xf0\xde[wW\xc1\xab\x1bmc̠\x00\xbd2\x1e;T8\be:\x05E\xae\x85\xf3%\xf6׃c\xac\xe6\xebB5\xab\xcem\x14\x96\x9ccd\xb8\x90qtb\u061c۟[\xd4\x7f\xde\xc0\xed\xf8\xd0m\xd6W\xca\xf7g\xff\xa0b\xfdS\x8a\xf4\xf3\x8e\x83f\x8b\xf2\xcf\x15\xc8ͅr\xd9\xdek^\xd1\xfdq\x87\xa8g,\xb2?Gq}&\xa6r\x8a\xe9\x8f\xc3\xd3\x1b\x14Ͽi\xd1\xfc[\x15\xcbg\x17\xc9g\x9dcf\x1fZ\xe5\x1e3\x9eX\xf5=\x7f\xea>]\xf4\x9eQ\xec\x9eq\x926\xbf\xc9\x13\xb6\x97Q\xcc~\\\x11{\x06\xcdrE\xf1\r\x8b\xd5߰H\xfd\xad\x8b\xd3g8k\xe6\xe7\xe3\x8a\xd0O>\x81\tG\xfd\xf7\xb2\x84\a\xa9\xcc\\p\xf2\xb0\xdf?q\x92\xda\v\xd8$/\x89\b]\x13\xbb\xc4\x10Ç\x17\xa7m*}\xe8\x19\xdc\xe9_di\xd76w\xc6\xf2\xb8\xd7\xfd\xe0\xae\xf2\n\x14\b\xf7\xcc\xc7\x7f>}\xb9\x8f\xf0S>\xaf\xf7\x8c\xf7\x9e\x97p\x1eL\xe9\x91\xe3\x8f\xe6|1\x93\xc3\x16\xfa\x00\xaf|.B\x1b\xf6\x1f\xf8\xaa\xdb\v\xd2A\xd7\x0fw\b#\xf8i\xf8L\\\xac\xa2\x88'\x96K\xb0\x16+\xa2jT,\xeeV\x03\x88Ê\xdf\xfe3JP\xba'\xb3\x82\xc5d\xa1\xc6\xcb\n\xdeÝ[\xc7\xd8,\x9f\xac\xd3(vD:\x8e\xac\x98*\x17\rUf\x87l\xa3/\x06k\bff*\x9d3\xaaX\x0f\x9f\x01K\xa27\xbc\xfe\x85g\x91\xbbfxڻ\x8f\xbbS\xd61~\xffd\xf6\xe6\xc9+\xaec\xdcb/\x10S\x89\xcf\xc9\x02\x93WK\x93yM\xf4\xf0\xed\xa4\xb4\xcbc\x1c=\xad\xe7l\x14\x1dRM\t0v<\xaa:-h\xa3\xabēK/\xd3u\xf8\x1a\x99\xa1\xa6}\xc9&\x1d\x80\xc1>YQ\xf5\xb4\xd5\x16\x82>\v\xdbFi\xc5a)\xddnm\xb3\xab{a\xfc\xa2\x97)x\x9b#\xe1\xcc\xe7\\N~\xc8šgD\xfd`\xf6˪\xb6CL\x9dp\x18<\xeb\xdae\x14\x19O;\xb1\x99π\xe4\x19\x8c\x13\x9e\xfe@|\xe5\xe2\x8a$_\x04\xc9|\xf5\xe3\x0fE\xf4\x84V\xd3E\x05e\xcb\xe1\xd47\xff\x9ez\xe3\xe7_\xfd\v\xb3e\xbc\xfbg\x91\xdd3\xd0\xd6g\x1e\xbe/\xe8)\xe1!\xf7)9\xe6\xf0ap\xe0\x9e\x17+\xdcK\x94E\x01Z\xafZ\x1e\xaa\x94\n\x05\xd4@\x19\xba3\x1dW|T\x9dM\xdbpIKP7R\xacX\xe2\x84d\x80\xd6\xff\x1at\xde\xe3\xd9\x02?\xb6\xaa{\xdaq\xf2Y\xbc\x17i\xae\x86*\xca9\xf0O\x8c\x83\xfeYn\x85]W\x86@>\xa4\xc6\xf5\xeee\x15\xad\xb2f}GD[/\xad\x93\vƌ\a\x8b+\xa9\xa6+\xa4\x1dޙ0\xb0\x86T|\xbdU\xcc\xc0SC\x95\x06\\Q\xc6\x0e~\xdd\x1b\xe2\xa2\xcf\x15\xa7kW\nW\xb2\x82\x1a\x88\x06\x18g\x18[>\x8e\xd7\b\x8b\xef\xb02I\x8e$\xbd\xb2\x85z\xecJƨX\x8f=/\x9a0\xd5\xc9\aF\x9dE.hc\xf0\x02\f\xd2\x11\x89h<\f|\xb4w\xef\x8d\xd1\x01\xd8qN\xf3e̾`N\x1bZ'\xa2\x84y\xbdss\b\x06\x9f\x05Ve\xaf\xee\xae\xff\xc0b,\xb0#[\xaac1u\xd2\xf7\xee`;0\xe8\xaa[\xd0P\x12\u0600 V\x14)\xe3PNq\xeaWL$\xab\r\xa8\x9ft\x84\x83\x95\x80\x96ş\fU&.\xfdЏYIUSsEJj`aG\x9f溥\x9fIU\xea\xc4\xe3@\xbc\xd9\xe6ţ\b\xd7n\xac\xf5s\xf7\xd1jК\xaeC\x10\xba\x05\x05d\r\xc2\xe2=\xe6\x16\x93\x1eS\xb8\xd2\xe7\x8dE,-\xb5(\xa4\x85i\xa9\x9f\xc0\xb9p\xf1\xf44\xbcO\x8cQ\xeczTE\xa7U\x85\xbf<\xf8\bT\xef?w}\x80\x8bO\xfd\xbe>I\xecv\xec\xceF\xa8+\xf0\xc4\a\x8f\r\x8b\x91uJ\xa6\x8dę\x8f2'\x95\x94\xcfYn\xf6\xe7رK'1\xe1X\t\xafL.ekz~\x8eGxb\x99\xf8\xfc\xe7+\xdb\x17\x84y\xed.P\x8d\xe5V\xf3<\xbd\xcf\x03H1\xbc\x95\x86\xf2`d,_\xc6\x0e\xd5\xc4\x03\x02O\xe1\xf1d\xcew\x17\xfb\x90\xf7^e\xef`W\xddS\x9e^\x13t\xd7\xc7\xc7Ҫ>\xeb\x97\x04\x12_\x01\xed|\x92\xb17\x17\xe7\xec\x1fB\xfd\x84\x8b\xca\xc0\xf1\xe7\xae\xf7\x18\x1e\xdd2\x9d\xc3\f\"\x1di\x12\f>L\x15%ㄥOx\xa9ME\xf5\x9c{\xfa`\xfbD\xb7\xa3g\xae\xa2\x13\xfa8\"\x95\xe9{\xae\vr\x0f\xdb\xc4W\x87,<\xfdB\xa9Jt\xb9\x13\x0fJ\xae\x15\xe8C\xa6[\xe0}F&֟\xa4z\xe0횉/\xe3\x95\xdfS\x9d\x1f\xa82\xcc2\xad[Ob\xecM\xb0q\x89\xdf\xe6G\x8f\xff\xc0\x04\xe5\xec\xf7\x94.\xef\xff87Ä\xbek<\xf2N\xb1P\x01\xf1s\n\xd0k\xe8\x9ft\xcf\xfc\x84y/ɽL\x8a\xb1? fC\xa0L\x93%h\xb3\x80\xd5J*\xe3\xf2\xf7\x8b\x05a\xab\xe0 Y\r\x81q\xa2{͞\xb0T\xe2=\x1e\xbd\x05\x87e\xe5S\x89\n\xad\x0e\x86\x9c5ݹ\x8c$-\n\x1b\x13\xc0\amh*6y\x91\x9e\xc6P\xd5\xcbJ\x8e\n\xb9\xeb\xf7\x8f9\xbe\xa8>\x10\x9cC\x1d^gw\x06\x9d\x8f\x9di\r^\xcb \xdab\xef\x14eB\x9c\x1a\xbb\x1b\x0f\xbb\xf3L\xcd\xd7\beL=\xfa\xfd\r\x1e\xe2\xf6\a\xac\xbe\x93%[QQ\xb1\x1e\xbd\xd0V)ٮ\xab\xc0\x9bc\x0e\x11)[\x8c\x9c\x1bT\x05:\xfc\xc7!\xa6U\xa2wh\xe7k,ƴt\\\uee0f\xf2\x02E\xad\xba\x8b-\x9d\xaa\x9a\xb0\xf9\xd9Y\xc2\x11\x88\xb3\xb6?\x01\x91\xea\x9d(&\xaf\xe0\xf8@\x9bM\xdc՝\xc2P\x12\tQ\x1b\xbf\x1a\x12\"\xc41$\xf4}\x89.\xe2\xf9a02棜\x88\x8ei'\x06\xb78\rj~\xd3}'h\xe8\xee\x1c\x87\x0e=\b\xfeNJ\xbb\r \x1c\x13\xf9\xe2\xdc\xe9\xb8\xf7ǍX7\xd1ۺ=9v\xfd\xb6\ac\xef\n\xa4\x8db\xbbiB\xbc\xf9\xf7l\x95\x92\x17\xf7\xbf3-9\xfc\xc3\xc1\xafo|\x95qK\x95`b}\x12F~\xf5c\x13\xf1\xbc\a{Έ>\xac\xfc\xd5b\xfa\xa4Y:\xf8\x88\f^\xf6\xf0\xecg\xf2_\xfe/\x00\x00\xff\xffP\a\xb5\x16Cm\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=]s\x1c)\x92\xef\xfa\x15\x84\xeea?B\xdd^\xc7}ą\xde|\xb2\xe7\xae\xe3<c\x85\xa5\xf1\xf3\xd2U\xd9jFUP\x03T\xcb}{\xfb\xdf72\x81\xfaꢋj\xb5\xe4\x9d]\xf3b\xab\x1a\x92$3\xc9\x0fH`\xb1X\\\xf0J|\x01m\x84\x92\u05ccW\x02\xbeZ\x90\xf8\x97Y>\xfe\xa7Y\n\xf5f\xf7\xf6\xe2Q\xc8\xfc\x9a\xdd\xd4ƪ\xf23\x18U\xeb\f\xde\xc3FHa\x85\x92\x17%X\x9es˯/\x18\xe3R*\xcb\xf1\xb3\xc1?\x19˔\xb4Z\x15\x05\xe8\xc5\x03\xc8\xe5c\xbd\x86u-\x8a\x1c4\x01\x0f]\xef\xfe\xb4|\xfb\x1f\xcb\x7f\xbf`L\xf2\x12\xae\x99ɶ\x90\xd7\x05\x98\xe5\x0e\n\xd0j)ԅ\xa9 C\xa0\x0fZ\xd5\xd55k\x7fp\x8d|\x87\x0e\xd9;ߞ>\x15\xc2\xd8\xff\xed}\xfe(\x8c\xa5\x9f\xaa\xa2ּ\xe8\xf4G_\x8d\x90\x0fu\xc1u\xfb\xfd\x821\x93\xa9\n\xae\xd9O\xd8U\xc53\xc8/\x18\xf3\xf8S\xd7\v\xc6\xf3\x9c(\u008b[-\xa4\x05}\xa3\x8a\xba\f\x94X\xb0\x1cL\xa6Eei\xc4w\x96\xdb\xda0\xb5av\v\xdd~\xb0\xfcb\x94\xbc\xe5v{͖\x86\xea-\xab-7\xe1WG\"\a\xc0\x7f\xb2{\xc4\xcdX-\xe4\xc3Xo\xef؍V\x92\xc1\xd7J\x83A\x94YN\f\x94\x0f\xeci\v\x92Y\xc5t-\t\x95\xff\xe2\xd9c]\x8d RA\xb6\x1c\xe0\xe91\xe9\x7f\x9c\xc2\xe5~\v\xac\xe0\xc62+J`\xdcwȞ\xb8!\x1c6J3\xbb\x15f\x9a&\b\xa4\x87\xadC\xe7\xe3\xf0\xb3C(\xe7\x16<:\x1dPAx\x97\x99\x06\x92\xdb{Q\x82\xb1\xbc\xec\xc3|\xf7\x00\t\xc0\x88D\x15\xaf\r\tG\xdb\xfa\xb6\xfb\xc9\x01X+U\x00\x97\x17m\xa5\xdd['{\xd9\x16J~\xed+\xab\n\xe4\xbb\xdb\u0557\x7f\xbd\xeb}f}\x8a\xfe\xff\xa2\xf9\xce\x1an0a\x18g_h\x960\xed\xa7-\xb3[n\x99\x06\x14\x03\x90\x16kT\x1a\x16\x81\xd49S\xba\x03\xaa\x02-T.\xb2\xc0\"jl\xb6\xaa.r\xb6\x06\xe4ֲ\xa9]iU\x81\xb6\"\xccCW:\xea\xa5\xf3\xf5\x18\xfaXpĮ\x95\x13S0$\x99~\xb6A\xee\x89\xe4&\x8f0\xedx\x88\x83\xf8\x99K\xa6ֿ@f\x97\x03\xd0w\xa0\x11L\x18E\xa6\xe4\x0e4R$S\x0fR\xfc_\x03\xdb\xe0\x94\xb0$\xa9\x16\x8ce4\x9f%/؎\x175\\1.\xf3\x01\xe4\x92\xef\x99\x06\xec\x93ղ\x03\x8f\x1a\x98!\x1e?*\rLȍ\xbaf[k+s\xfd\xe6̓\xb0A\xe9f\xaa,k)\xec\xfe\r\xe9O\xb1\xae\xad\xd2\xe6M\x0e;(\xde\x18\xf1\xb0\xe0:\xdb\n\v\x99\xad5\xbc\xe1\x95X\xd0@$)\xdee\x99\xffK\xe0\xb7\xe9u{03]!\x959\x83=\xa8K\x9dt9Pn\x88-\x17\xf0\x13\x92\xee\xf3\x87\xbb\xfb\xae\xe4\t\xe3\x99\xd2\x11\xc0\x18\x7f\x90\x9aBn\xc0낍V%\xc1\x04\x99WJHK\x7fd\x85\x00i\x99\xa9ץ\xb0(\x06\xbf\xd6`,\xb2n\b\xf6\x86\f\x13\nm]\xe1\xdc͇\x15V\x92\xdd\xf0\x12\x8a\x1bn\xe0\x95y\x85\\1\vdB\x12\xb7\xba\xe6vXّ\xb7\xf3C\xb0\x99\x11\xd6\x06]qWA֛j\xd8NlD\xe6&\x14\xaa\xe4F\x95\fԲ+㳟~!\xdd7\xfc:\xc0\xc3)\xc8\xd0+\x184JvK\xccom#\x8a\x9c\x83ƔfR\xd9\x03\x98\x87\xaa\xb5C\t\x0fe\x02\x93\x03ag\a*5Œ\x8e\x00im\xebP\xbe\xa2\xac&\xbc\x1fE\xb5*K\xc8\x05\xb7P\xecOB\xbf\x0fb\x8c̊\xfaak\xa7\xe7ŦG\xf4\xbc\x06&:\xedi2\xfe9\xd48\xb4\xc6\x7f&\xcbNF\xd4Ѥ\v\xac\x96-\x0f\a\xfdHx:$\rc\xab\r\xb3\x1au\xae\xc7\xeeI\x14\x05\xcedĸ\x82\xbc\x87Z\xbc;\xb1a\u0086Ѭ9a \xd9\xd2yQ\xcb\xd6gh\xec?\"8\xc0\x8eԾ\xeb\x1f=\x15n\x99\x84\xaf\xb6\xad\x85Î\x8c`\xc3\v3\x18\x82WH\xb3\x86q\xc5ֵ=\r\x03(+\xbb\xbfrm7\xaa(\xd4\x133\xa4l\xd1\bn\xc4C\xad\xddd\xff}\x0e\x1b^\x17\xf6\xda\xe1\xfc\x87\x98\xb4\x8eO3\ve\x85&\xf3\x149\xbd\xf7mq\xc08[\xf2&\xc6\bnr\xf0C\x94w?F\x80(\xe7\xc5VZ\xedD\xee\xcd\xf9\x81\xbabGU\x16\x96̈;\xc9+\xb3U\x16%B\xd5v\xacVʨ\xb0\xdcܭ\x06\xd0:\x93\x10\xd1%W\x98\xe4\xd4*\xf6ą%\x9d{s\xb7b_0\x86\x80К\xb9\xc9\xc6l\xad%ڹH\x7f\x9f\x81\xe7\xfb{\xf5\xb3\x01\x96\xd7d\xa2\x83{{\xc5ְA{\xa6\x01a\xe0O\xa05\xeawCH\xa8\xfa\xc0ej\xd8\xe3X\x82\xb2\xe1-\xbe0\xec\xed\x9fX)dmG\xa5\xee\xa8b#\xeaq\xcbK\xb5\x03\xfd\x1c\xe2\xbe\xe7\x96\xff\x88@\x064E\xe0\x8c\xa0{\x81!\xfa\xae\xf7\xf4\xe3:\xa2\x89]Ym:P\x85a\x97\x97\xa8\r.]\xc8yy\xe5 Ԣ\xb0\v!\xbb\xfd\x04Մ=\x9dF\x10G_\xc7ts\xaf~0N\xe4\x9fE\x9f\b\xcc\x11;P\xa9\x9c\xed\xa8\x1eۈ\x02\x98\xd9\x1b\ve\xd0Z\xad\xe7\xdf\tg\x86\x85|\x85\xa2\xf0`\f\xd2\xdb\x0fj\x9c \xb2.\n\xbe.\xe0\x9a\x94\xfc\x11\x9a\x8d\xeb\x9b1\xa2}\x06cEvN\x929\x88#\x04\xd3\xfe\x87\x1ee(t\xe0\x8f\xc0x\x04\xbc\xa7'\xc6)E\xd1!z\x9fZQ\xdc*\r\x19\xfa\xb0\xd7\xde7\x16P\x90?.\x15+\x94|\x00\xed\xb0hl\x15\xeaJ@\x01\xcd\x19\xba\x9d\x1a-\x8c\x90lS\xa3G\xbad\xa8%\xa22\"\xa4\xb1\xc0#\xc2|\x06\xde\xc1\u05ec\xa8s\xc8o\x8a\xdaX\xd0w\x99\xaa \x0f\x8bL\xa3\x9a9\x95\x87\x1f\x8eB\xf6\xf1K!2@>d\xae҂\x16yb\xa2݆2\xfb\nܚ\x13\xb2\xda\x0f\xa1\x8dQ&u\x8b\x01\x8b\r/\xffxyE\x12\xd0\xef\xbdߏa\\CC\xa6Y\xba\x99,\xfex\va\xa1\x8cPwRG\xcd\xe0;ך\xef\x8fp\xbdYL{\x01\xbe\xc7`\x0f8/C\xb5o\xc4\xfba\xff\xff\x8c\xdc?/\xbf\r-:s!\x91υ0\xb6\xc7f\xe3V\xb1\x90\xacc!\xa4'\x90t0QMNq\xf5\uf118g\x9d;\xb1\xc9\xd2Ȧ\x9f\x00\xffP\x94\xdc*\xf5\x98B\xbd\xff\xc1z\xed\x12\x16\xcbhc\x84\xada\xcbwBi3\\&\x85\xaf\x90\xd56\xaaY\xb8e\xb9\xd8l@#,Z\xe6ov\x05\x8e\x11\xebx\xf8\xc2:*+Za0\xae\x96\xe9\xc8R\xa2Fl(\x14\xa0F\xa1:\a\aC\vr r\xb1\x13y\xcd\v\xf2%\xb8\xcc\xdc\xf8x\x83_L\xabM\b\xc4\x01\xfeQ\xa9v\xc594a\x90\xc8\xc4ު\x97\x92\x80>~\x89\xb1\xd1a\xd58%\xc2R\xc2Ѿ\x91\x99\xba.\xc0\xf8\xeerr\x93[\x9dt\xd52˭1\x14|\r\x053P@f\x95\x8eS(E\x0e\\IU\xba\x11\xe2\x8eh\xd9~\xb4\xd5\x0ef\x02,\xa3\x10w+\xb2\xads_Q\xd0\b\x16\xcb\x15\x18Z\x15\xe1UUDLW[&\x85\xc3w6\xa57ڒ\xa0A\x86pc\xba\xa4-\x89\xfa\xb9-\xa3do\xe7f\x9f\xea\xe3\xeb\xfc\xa3\xf8\xfe3\x11=X\x9d\x13\x85}B\x930\xda/H\x9e\x0fQ\xd2#\xc5\x05\x98eguN\xd8\xf05\x85\xa1=\xff\xf1`+\xe5\x80(\xbf-ޝ6af\xb0nrN\xbd,\xe3\x9an\xfeA\xf8F&\xeb\xce[\xacY<\xfb\xd8myE\xbb\x02\x9e!\xf9\x15ۈ\xc2\x029US\x88\xb2\x19\x9c;'\x81R-0\xa3Mb\x9bm?4{G\t-\x06\xb4\x1a\x02p\x0ez\x88r\x88\a\t Y\xe3ZЦ\xa9\xd0P\xd2f,E\x92\xdd/\xe4\n\xbe\xfb\xe9}<\xf6\xec\x96DI=\x18T¤u\xe5\xdd\xc01\xea\xe2\xeaC\x95\xf0\v\xf9kM \xe86\xe1\xaf\x18g\x8f\xb0w.\x16\x97\f\xf9\xc6C\xe5D\x144PF\x00i\x8aG\xd8\x13\xa8\xf1-\xfe\xf12GZ\\y\x84\x91]\xbfX\xe9\xd1\x15\xf1\xf3{)\x8en\xf8\x81\b\x932\x9b\xda\xd2\x10\xd5O\x9f\x91\r\xf6x\x99\xa1\x97B\t|9q\xd8\xc9\xe2\xd4\xed\xab\x9f\x14\xf3\b\xfb\xdf\x19\xc7k\x9ce[A\x9bN\x9cVo\xd4f\x16\xc3]\xf9\xc2\v\x917\x9d\xb9y\xb5\x92W\xec'e\xf1\x9f\x0f_\x85\xc1\x8ee\xce\xde+0?)K_^\x94\xcan\x10\xafAc\xd7\x13MP\xe9,\t\x12\xb1\x9b<\xe2l)\nj\xc3\x0fa\xd8JbH\xe6H4\xa3;\xca\x15r]\xba\xce\xca\xda\xd0V\xabTr\xe1\x96\xc5\xc6z\xf3<P\xbaǂ\xb3t\xec;\xbdGc\xe4~qYK\x05\xcf \x0f[t\x94N\xc3-<\x88lF\x9f%\xe8\a`\x15\x9a\x85ti\x99\xa1\xa8\xfd\xc8\xe6\x8bW\xba\xe7\xd0-_\x17\x8f\xf5\x1a\xb4\x04\vf\x81fm\xe1\xa1XU&\xd2\xc5ۄ\x91\x9c\x93\xb1\xb2\xc0\xb9\x9eX3HKR\xf5HF\xce\xf1\xea\xa9\xc4z&\x99ȋ \xb7+I\n\xba\x89\xad\xf3\xac\xd7L\xb99E\xc5t\xc6\xe2\\\x80\x92\xd3\xd6\xda_\xd0\xd2\xd3l\xfc+\xab\xb8\xd0f\xc9\xdeQfo\x01\xbd\xdf\xfc\xc2d\aLb\xb7\x15\xad\xb2\xffZ\x8b\x1d/\xd0\xff@\x03!\x19\x14\xce\x1bQ\x9b\x03_\xed\x8a=m\x95qnC\xb3iw\xf9\b{\xb7\xa3\x9c\xd4mWa]\xae\xe4\xa5\xf3e\x0e\x14O\xe3\xf8(Y\xec\xd9%\xfdv\xf9\\\xf7n\x86DϨ\xda\x13\xe5\x92W\xe9\x92Ly\xb3s\x02\r\fփC\x84\x8d\x9b\x04R\f\x10\xa6(\x90,ʕ2\x91d\x91\bZ\t\x82~\xab\x8cu\xeb\x90=\x7f\x7ft\xa1R\x85\xc5I\xc67\x1643V鐒\x89\x8a?e)\xbe[\xee\xb7`\xc0\xefC\xf9EO\a\x18\xa3\xd8\xcbV78\xabr\xe9\xf6¨#\x9e\x91\xf7Dm+\xad20Ѽ\x88\xb6$ڦ\x1e\x05\x0f\xe9Ь\xebr\x17\xfdm\x92\xb4vʢt(\xf3\x1cy$\xdd\t\x91ч\xaf\x9d%j\xd4.\xf8w\x8a\xb4\x9e\x82#\xa3\xf3\x1aeɇ\xe9\xc0\xc9\xe8\u07b8\xd6a\x8ey`.\xdc\xd2\x0f5\xe9\x9c9^G#\xca\x7fo\xaeM)\xe4\x8a:bo_\xd0\x1d\xf2Z<\x96\x1e5VNw\xd2oBg-\xf7\x9a\x0f>\xa7N\xd1Ə\x86\x1es\x0f\xf7DȻ\x96\xcav\x96qf:ѕ\xca\x7fg\xd8Fhc\xbbh\x98#\x89U\xa3\xa0N\b=\xe5\a\xadO\x8e<?\xb9֝\x05ŭz\xf2\x89\xd3s\xe2\xed@\xd2-߁\xcf\\\x05\x99\xa9Z\xd2R\x18\xea\x01\xecf\x06D\xc7\x1ag\x05\x12\xed]\xa7\xb1\xac\xcbt\x82,H\x92\x84\x9c\\7\xeb6\xf9\x81\x8b\xb4u+v\x1a[\xed\xb1\x1cαr\xfa<\n\t\x9e\xddt\xfa\x92\x7f\x15e]2^\"\x0f\xc9\xed\x10%4\x19\xf5\x8e\xddM\xda'\xb6 \xa3e\x15β\xaa\x00\v>ms\x06\x1e\x99\x92F\xe4И~/\x02J2\xce6\\\x14\xb5\x9e\xa1Ug\x93|n\x10\xe6\xb5\xc9\xf9#\xabtD\x16D\xa2\xc4u\xf6\x19^\xf0\xb4Ư\xf4<?6\xc5a\xd40\xdf_\xac\xb4P\xee0\xc0\xf9]F\x9fv\xcc\xe5\xfe\xbb\xcf\xf8\xddg\xfc\xee3\xce\xe9\xe8\xbb\xcf8Q\xbe\xfb\x8c\xdf}\xc6\xe3\xe5\xbbϘR\xbe\xfb\x8c3\x11\xf9V>c\n\x86\vZ\xe3<R!\t\xab\xc4T\x88)\xb4'\xfa\xf2I?\xfe\xac\xc6Yr\x99W\xe3 G\x0e\xf1D\x8e_ļ\x8e\xd6x5\xc9\xcd8\x03\xc3\xdcq\xa7(\x13\x1c\xe63\x9c\x9e\t\b\x9c\xff\xf4\xcc\xea(\xe43\x9e\x9e\xf1CH\x8b0N:;\x13\x884\xff\xf4ĕO\"*\x81\x87\xad\x14\x97\xfe\x11\x1bcL\x92\x12\xf0\xf8\xc6\xc9\xef\a\x19\x93/ K\xafr\"g\x96<\x8d\xb2\xfe\U0008f5ff\r\x16\x9d\x97)Q6\x1c\xd2֩\xf1\x98~\xc4X\xbe\x9b\x1a\xd9\xcfR\xfd\xedL\x85\xb3\xca~ꉚ\x86\xc8\x11x}\xb1\x1eP\xf9\xb7\xa4o,\x94\x9f*o-\xcfp\xc2~5\x02/\xe9\x8c=7{\x99m\xb5\x92\xaa6~M\ba\xbd\xcbܽ\x03\x01dL\xd8G5ȿ\xb1\xad\xaa#\xa76&H\x9b\x90E\x9bF\x90^R\xadO\x8c\x00\xcbwo\x97\xfd_\xac\xf2)\xb6\xecI\xd8m\x04\x18\xddG\xc1\xf3\x1c\xe3\x82\u0381\x1e\xaf\a\xc2UIC\xa1\x8c\x00S\x9aIQ8\x89\r\x10z\xf2\xca>Unu\xf0d\xbfiz\r+=\x11wn\xfam\x93-9\xed\xbe?#\xe9\xf6\xacG\xa3\xbeYZ\xediɴ\xa9+\x94\t\x89\xb3\xe9\xe9\xb2)lu%=I69BNM\x88\x9d\xbb\x02\xf1\xa2ɯ/\x93\xf2\x9aL\xb3\xb4\xf4ֹ\x14{\x95T\xd6WN`}\xbd\xb4\xd5\x19ɪ\xe7?\xf5\x92\xbe\x96~rveڲ\xcc\xf1\x84Ӥ4Ӥ\xa5\x9b\x94\x01\x9f4Ԥ\xf4ѹI\xa3I\x9cL\x9f\xae\xaf\x9a\x16\xfa\xaaɠ\xaf\x9f\x02:)m\x93\x15\xe6&y\x8e_r\x18ʴ\x03P|\v\xe1|.\x99\x94\xee\xb9\xe6ϊ;?\r`\xa1\xb0\x047\xf5\x15〲.\xac\xa8\x8a\xf6>\xb6X\xc0\xb9\x85}sY\xd1/\x8a\x8e\xc8\xfb\x9b\xba>}n$~9\x88j\xb8aOP\x14\x8c\xc7\xe6\xe6\x01\x152w\x0fh\xa6\x16\x80\xb6\x11g\xb9\xbf\x8c\xc9_\x1ez\xe5\xa6\v\xdd\x06@\x16\xb6\x8c-\xf5qy\xfc\xa6\xaf\xa3\x06,U\x8f\x1dx\xe6.ޠo\xbf֠\xf7\x8c\xee\x1dk|\xb3\xf6P\xa9\x9f\xe8\x06\x03Ӡ~\xbc:<\xb6gr\x10\xe0\xb4ꁽ\x93\xce#\x18\xe2DmP\xef\xb4\x01\x1d*U\x8cӢ\xfdD@H\xd5@\x884Mq\xfe眲|\x89\xf0\xee\x1c\x01^\x92\a4\xcf{\xfd\x86\xa7'O=5\x99\x9e\x8c\x92tJ\xf2%½9\x01\xdf,\x7f5\xfd\x14\xe4\xfc\x8d\xe7\x17>\xf5\xf8R\xa7\x1dgP/\xf5t\xe3|ڽ\xd2i\xc6W?\xc5\xf8\x9a\xa7\x17g\x9dZLNϚ\x95q0'\xb5\xea\x19\xc7\xed\xd2r\t\xa6O!&\x9e>L\xcc4H\x1b\xfc\x89\xc3N<]8\xffTa\"\x7f\xe7L\xe9W>=\xf8ʧ\x06\xbf\xc5i\xc1\x04\tL\xa82\xffT\u0cf7\xa4\x94\xceAOn\xfb͑\xdaIyM\x8d\xe5\xfa\x88\r\xf6\xb5\xc2m\xb2X\xab\x17\x03\x90Y\xf2\x17\xf9ӣ\rǶ\xc1Q2;\x1eQo_\xb2u\xd7\xfa\x0e\xb1\x7f\xcd\xc1m]\x1a\xa88\x1a\x00\n\xdc(5+\xea*|\xe0\xd9v\xd0Ö\x1b\xb6Q\xba\xe4\x96]6\x9b\xc5o\\\a\xf8\xf7咱\x1fT\x93\xabӽ/͈\xb2*\xf6\x18\x89\xb1\xcbn\x83\xe7IIT:CϷ\xaa\x10Y\xc4\xe7\x1c\xbdW\xcf58\xb8l\x88n\xfe\xcb:\xd9\"\xb1\xc0\a\x9b\x8bp\xebb\xffJfw\x9f\xfb\x89k%\xbc\x12\xffMO*\x9da\xd5\xed\xdd\xed\x8a`\x051\xa2\xb7\x9a\x9a\x04ņ\xe5k@\x97\xa1\x1d\xfb1}\xb2\xda\xf4\xa0\xf6s\x84\xbb\x8fU@\xee^&\tn\x8bW͙B\xadu\xbbr\xb8\x1c\xeb\t\xe5\x8b\xcb=S\xfe\xe9\t\xa1\xf3Eŵݻd\xa2\xab\x1e\x1e\xc1\xaeO\xad\x9a\x1d\xb5V\x87/\xaftK\x8f\xec\xe1\xd1\x15\xda\xc9\xdeW\xfd\xe4\x81!=\x9f\x83\xd3\xf1SՓ\xe7\xa9_\x00\xa7\xe3.Ԃ\xa8\x18\xf9)\x9a\x01y\xf6\x15K\xe3o\xe8\xffQ\xed\xe0}t\xe5\xb2\xff\xfaʠ\xc9Hjb\x80J\x97\xccG(\xd8\xe6#\xd2\x1d\xdf\xcfS{\xf1\\À\x8a\xbf#\xfc9\x8b\x93w}P\xe3\x0f\x92\xd0\r\xea\xa1ӘWEO=\xed\xd9\xed\x17\x8a[\x1bUꧾ\x8f[\xc3\xf2dH0\x88\xc0\x12\xf2\xe8\x1b-\xe7\"\xa3U\x9a?\xc0G\xe5\xde\xd6I\x11\x93~\x8b\xde\xcbK\xdes\v\xf9\xda~\x12\xc6\x14\xbd\x1f\xdb\x10`{>\xe3\xe0\xa2\x7f\xc4\xf6ħ\f\xac-\x9e##\xf7\xf7\x1f\xddH\xe9I\x93\xf7\xfeu\x12\xd4\xc7\x06\x90\x05\x81\x02\x0e\xda\x1a\xff\xbbUOt\x01~|\x8d9< \xd2y\xc3\f\xe8\xa0\b\xa5\xf0\x9e4̺*\x14\xcfA\xdf\xd0#*\t#\xfe\xb9\xd7`\xe0\x0e\xf4\x9fb\xf1v32\x9e\xd0\xf3\vfɠGW\x14P\xfc \n0\x0e\xf1D\xd3p{ز\xb1\x14u\xb9v\x9e\xea\x06\x7fl:9b\x99\xddPi\x83\xa1\x02\x8d~\xa2ۊ\xa8M\x90\xfc\xe3\xc4`\r\x1f\x85\xb4\xf0\x00\xe31\xf4\x84M\xd8\xf5^b\t\xb3'E\x11~\x19o\xd9q\xa6;\xf3\x98\xbc߸\xba\x8b\xc1\xe2ƨL\x90\xff\xfd$\xac\xbf\xf8\xf0\xe5n\xdb>\x16J\x1d\xa1cm\xe0ӓ\x04\xfd9\xe8j\xb3\x92\xb1\x17N\xa6\xf5\xc4\xcf\aТ/\x9bX\x85}\x8f\xc0\x18\x00`*\xec\b\x19\xf7fN؈\x12\xa6y\x06쐞\x13\x93-n\x13\xc6]\x9b\xc5\xf8\xabE\x8b\xe6u\xa5\x8b\x04r\xbb\x97\x82\xfa\x80\xc7\x1f\x7fsO\ne\xbc\xb2\xb5\x0ez\xa8\xd6t\x1f9\x02\x01w]\xf7iϿ\xb5\xaf\x82\x9d\xc2\xe0\xf6Y\xaev\xa5~\xf2\xe1\xd0\x118\xcd\x03n\xd1נ\\\xec\xe9\x1e\xf6\\ \xfc\xd3x<:c\x10\xe7;\xf7\xca\xd7\x04\x11>\xb65\xc7\x06\xdc\f\x03\x87\xec\xdf\r{Ց\xd0\xf5\xf4\x13c\xb8\xc5:\xcdyP/G\xd40\\k\x7f\x17c\xc2\xf8\xa1\xc1\x05\xfb\t\x0ec\xdb\x05\xfb q\x10\x87\x04p'\x03!\xa7M\bҎs\x86\xb8kZѱ\xcc\x11\r9-\xb6_\x060\x069\xdf\xf4<RSŝ\xcb4\xec\xf7b\xcco\xa3\xbd\xa5\f\a\xfa\x87\x83_\xa3\x1a\xfc\xa8\xf6\x8ei\xeeQ5r\U0001178c\xcb;\x92\xe3\xfd\xd9\xee\x97z\xdd>=\xc0\xfe\xf2\u05cb\xbf\x05\x00\x00\xff\xffq\xe5\x82\xc1hz\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcV\xcfo+5\x10\xbe\xe7\xaf\x18\x89+\xbb\xa1B \x94\x1b*\x1c*\xe0\xa9j\x9ezw\xbc\x93d\xa8\xd7^f\xc6)A\xfc\xf1\xc8\xf6n\x9b\xee:\xb4\x8f\x03\xbe\xad\xed\xf9\xe6\x9bo~x\x9b\xa6Y\x99\x81\x1e\x91\x85\x82߀\x19\b\xffT\xf4\xe9Kڧ\x1f\xa4\xa5\xb0>ݬ\x9e\xc8w\x1b\xb8\x8d\xa2\xa1\x7f@\t\x91-\xfe\x84{\xf2\xa4\x14\xfc\xaaG5\x9dQ\xb3Y\x01\x18\uf0da\xb4-\xe9\x13\xc0\x06\xaf\x1c\x9cCn\x0e\xe8ۧ\xb8\xc3]$\xd7!g\xf0\xc9\xf5\xe9\x9b\xf6\xe6\xfb\xf6\xbb\x15\x807=n@\x90ә\x1a\x8d\xc2\xf8GDQiO\xe8\x90CKa%\x03ڄ\x7f\xe0\x10\x87\r\xbc\x1e\x14\xfb\xd1w\xe1\xbd\xcdP\xdb\f\xf5P\xa0\xf2\xa9#\xd1_\xae\xdd\xf8\x95\xc6[\x83\x8bl\\\x9dP\xbe \xc7\xc0\xfa\xe9\xd5i\x03\"\\N\xc8\x1f\xa23\\5^\x01\x88\r\x03n \xdb\x0e\xc6b\xb7\x02\x18\x05\xc9Xͨ\xc5\xe9\xa6\xc0\xd9#\xf6\xa68\x01\b\x03\xfa\x1f\xef\xef\x1e\xbfݾ\xd9\x06\xe8P,ӠYֿ\x9b\x97}\xa8\x85\t$``\xa4\x04\x1a\xc0X\x8b\"`#3z\x85B\x19\xc8\xef\x03\xf79\xad`v!\xea\x05\xaa\x1e\x11\x1e\xb3\xfec\x98\xed\xcb\xe1\xc0a@V\x9a\xa4)\xeb\xa2\xe2.v\xff\x8dxZ)\xd6b\x05]*=\x94\xecy\xd4\v\xbbQ\x1e\b{\xd0#\t0\x0e\x8c\x82\xbe\x14c\xda6\x1e\xc2\xeew\xb4\xdaΠ\x8b.\x922\x19]\x97*\xf6\x84\xac\xc0h\xc3\xc1\xd3_/ؒ\x04JN\x9dѬ\x9dWdo\x1c\x9c\x8c\x8b\xf85\x18\xdf͐{s\x06\xc6\xe4\x13\xa2\xbf\xc0\xcb\x062\xe7\xf1[`\xccRo\xe0\xa8:\xc8f\xbd>\x90N}hC\xdfGOz^疢]\xd4\xc0\xb2\xee\xf0\x84n-th\f\xdb#)Z\x8d\x8ck3P\x93\x03\xf1\xb9\x17۾\xfb\x8a\xc7Ε7n\xf5\x9cjP\x94\xc9\x1f.\x0er\xeb|AzR#\x95b*P%\xc4\xd7,\xa4\xad$\xdd\xc3\xcf\xdb\xcf01)\x99*Iy\xbd\xba\xd0e\xcaOR\x93\xfc\x1e\xb9\xd8\xed9\xf4\x19\x13}7\x04\xf2\x9a?\xac\xa3\\\xb8qד\xcaT\xda)us\xd8\xdb<\xab`\x87\x10\x87\xce(v\xf3\vw\x1enM\x8f\xee\xd6\b\xfeϹJY\x91&%\xe1Cٺ\x9c\xc0\xf3\xcbEދ\x83iv^ImeJl\a\xb4)\xb9I\xdfdM{\xb2\xa5\xad\xf6\x81\xc1\xd4L\xda\x0f1\xc9\x16_\xc8e\x9cH\x85\xcdlN\xa5.\x7f\x9fM},哣\x11\x9co\xce8ݧ;s\xff\x8e\xf6h\xcf\xd6a\x81(S\bߧ\x92\x16\xfa\xd8/}6\xf0\t\x9f+\xbb\xf7\x1c҄\xc6\xf9\xa8\xb9Z\x1bP\x1e\xb1\x03\xf9E\xb8\xf3\xc8ʭ\xfc0.G~\x0eh\x04\x02\x8eާ\x96\x0e~\x01Yy\x11\x16wH\xb1\xaf\xb0\xa9\xf2\xb9\xf3\xfb\x90\xff\"Lrl\xb4\xb4\x13\x8e\xc9\x1e\xfd\x14^\x15\xc0\xeb\xb9.k9\xe7>$hY\xf9y\xfeo\xc6i.\x11c\xd5w\x93YU\x0f\x92ǚ\xe2\xf5\xfe\x1aYF\xe7\xcc\xce\xe1\x06\x94\xe3Һ\xd8\x1afs\x9eW\xcdTj\x9f\xa9GQ\xd3\x0f\xef\x14\xd0\xe2UH\xeb~\x81\x92\x9a\xe7\xf9\x88\xfeZ\x8b\xc0\xb3\x91W\xe7\x15\xc8\xdd\xf9\x9a\xe9\xed\xcb\xdf\xe6\xb2\xcfJ=o \xcd\xfaF\xa9\"䇔\xaa\xa6\xb4\xd4y\xf5\xb7f\xa1\xd2\xf6\xf2\xee4H\xde\xf4\xcb\xf4W\xb3\x8c\xe1*\x85j\x05,63|w\x11\x9eh`s\x98\x02\xfe'\x00\x00\xff\xff\xef\xf8\xa6>\x10\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcVM\x8f\xdb6\x10\xbd\xfbW\f\x92kd7(Z\x14\xbe\x05\xdb\x1e\x82&\xc5\"N\xf7N\x93#{j\x8ad\x87C9.\xfa\xe3\v\x92Ү-\xcb\xc9nQT\x17\xc3\xe4\xf0q>\u07bca\xd34\v\x15\xe8\x019\x92wkP\x81\xf0\x8b\xa0\xcb\xff\xe2\xf2\xf0S\\\x92_\xf5o\x17\arf\rw)\x8a\xef>a\xf4\x895\xfe\x8c-9\x12\xf2nѡ(\xa3D\xad\x17\x00\xca9/*/\xc7\xfc\x17@{'\xec\xadEnv薇\xb4\xc5m\"k\x90\v\xf8xu\xff\xdd\xf2\xed\x8f\xcb\x1f\x16\x00Nu\xb8\x86\xde\xdb\xd4at*Ľ\x17\xebu\xc5\\\xf6h\x91\xfd\x92\xfc\"\x06\xd4\xf9\x8a\x1d\xfb\x14\xd6\xf0\xb4Q!\x86\xeb\xab\xeb\x0f\x05m3\xa0}\x18Њ\x81\xa5(\xbf~\xc5\xe8\x03E)\x86\xc1&V\xf6\xa6g\xc5&\xee=\xcboO\xb77\xd0G[w\xc8\xed\x92U|\xeb\xfc\x02 j\x1fp\r\xe5xP\x1a\xcd\x02`\xc8O\x81k\xc6Լ\xad\x88z\x8f\x9d\xaa\xf7\x00\xf8\x80\xee\xdd\xfd\xfb\x87\xef7\x17\xcb\x00\x06\xa3f\nR\xb2<\x1f\"P\x04\x05\xa3'p\xdc##<\x94|B\x14\xcf\x18\a\xa7\x1fA\x01F\xff\xe3\xf2q1\xb0\x0f\xc8Bc\xf0\xf5;\xe3\xd7\xd9\xeaį\xbf\x9b\x8b=\x80\x1cJ=\x05&\x13\r#\xc8\x1e\xc7t\xa0\x19\xa2\a߂\xec)\x02c`\x8c\xe8*\xf5\xf2\xb2r\xe0\xb7\x7f\xa0\x96\xe5\x04z\x83\x9car\xad\x925\x99\x9f=\xb2\x00\xa3\xf6;G\x7f=bG\x10_.\xb5J0\n\x90\x13d\xa7,\xf4\xca&|\x03ʙ\tr\xa7N\xc0\x98\xef\x84\xe4\xce\xf0ʁ8\xf5\xe3\xa3g\x04r\xad_\xc3^$\xc4\xf5j\xb5#\x19\xbbN\xfb\xaeK\x8e\xe4\xb4*\rD\xdb$\x9e\xe3\xca`\x8fv\x15i\xd7(\xd6{\x12Ԓ\x18W*PS\x02q\xb5K:\xf3\x9a\x87>\x8d\x17\xd7\xca)S,\n\x93\u06ddm\x94.yAyr\xc3T\xd6T\xa8\x1a\xe2S\x15\xf2RNݧ_6\x9fa\xf4\xa4V\xaa\x16\xe5\xc9\xf4*/c}r6ɵ\xc8\xf5\\˾+\x98\xe8L\xf0\xe4\xa4\xfcі\xd0\tĴ\xedH2\r\xfeL\x18%\x97n\n{W\x94\t\xb6\b)\x18%h\xa6\x06\xef\x1dܩ\x0e흊\xf8?\xd7*W%6\xb9\bϪֹ\xdeN\x8dkz\xcf\x1bu\x90\xc9\x1b\xa5\x9dW\x84M@}\xd1x\x19\x85Z\x1a\x14\xa2\xf5<I\x90\x1a\xf5b\x1e\xef2\x9f\xf3B\x01uX\xb4\xb4\x9b\xae\x02(cʨQ\xf6\xfe\xe6ٯ$l&\xee\xbbrS\xe6p\xeb9{ԓAn\xc68\aO\x12\x0f\x01\x13Zs\xc5ԛ9/\xa10\x9a\\be\xaf\x1d\xbd\xf4\xe4Ѱ\xccJE\xae\xa6\xfc\t\xa00\x8f\xbbA\xab\x9d\xa038՞\xe2\x8d/\xf4\x8eh\xe0H\xb2\xaf}cχ\xdd\U000ea43f\x03\x9e\xe6\x96'\xbe\x7f\xdec\xb6\xac2\x8c\x10Q3J\xf6#\xa2\xcd]\x9e\x9bv\t\xf01\xc5\xd2yj\x16\x11\xb2z\x90\x19O\x1f\xf0t\x9dh\xf8Vq\x87w\xc3\r\x97[\x95\xac\xac\xe1իo\x87t\xa5u\xe3\x97\xe7\xf2\x18(c\x8b\x8c\xeej\xb6\x8c\xdf\xe7\xa2X\x994\x99aض\xa8\x85z\xb4\xa7\xa2P\xc4h\xde\xc06\t\x98\x84\xa5jJ\x1f\x8e\x8aM\x04\xed\xbb\xa0\x84\xb6dIN@\xf1\x06\xbe\xb2\xd6\x1f\xd1\f\x15\xc7.\xc8i\t\xef]\x14\xe54\xc6ǩ\x983V\xa9\xa0\\\xb5\x1a\x84\xbaLx\xc5x\x13\xbe\xf3Q@#g:\xda\x13\x1cٻݭ`g\xc41\xbf\xf2ء`\xd1G\xe3u\xccҨ1H\\\xf9\x1e\xb9'<\xae\x8e\x9e\x0f\xe4vMv\xb0\xa9=\x14W\xe5\xe9\xb6z]~\xfe\r\v|\xa8:\xf1\f\xf2nJ\xaf\x9f\xf2{G\xf6e\xcc l*\a=C\x1e'\x99\xda\xdd\xc0ݪ\x86sm7\xfa\xb4\xf5ޢ\xban\xb4\xb1\xe4\xd7.5\xf9\x86\x97\x88\n\xc0\x97\xe6)\xb7M\xa7BS\xad\x95\xf8\x8e\xf4\xc4zT\xb5o\b\xd0\xfd`\x96\xa9\x9as0\x1e\x1b\xc9^\xdf~\xe5%\xa8vW\xa3\xf1+\x15\x99\x0f\xbcy\xbc\xe0Y\xe3K\x94\xa4\xf8\xf2\x01V\x8e\r\x96\xdba\x88\xe9\xc4\\^\ves\x12G~2\xfe7C,\xecU\x9cQ\xa2gx}\x9fO\x8ee\xb0Ԣ>i\x8b\x15\x10|;ý\x17\xb9\x9c?t\xa9\x9b#\xe2\xbb^\x91U[{-\t\r\xfc\xee\xd4\xcdݛş\xad\xe7\xd5b̏=\xb3\x06\xe1T\xb1\a\x96\r+\xff\x04\x00\x00\xff\xffNy\xc1Q\xa1\x0e\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xccY_oܸ\x11\x7f\xdfO1H\v4\x0e,9N\xda\xf4n_\x82\x9c\xdb\x1c\x82&\xb9\xc0v\xf3P\xd7-f\xa5\xd9\x15\xcf\x12\xa9\x92\x94\xed\xbd\xb6߽\x18R\x94\xb4\x12\xe5];EQ\xcb\x0f\x9683\x9c\xbf?\xce\xd0I\x92,\xb0\x16_I\x1b\xa1\xe4\x12\xb0\x16toI\xf2\x9bIo\xbe3\xa9P'\xb7\xa7\x8b\x1b!\xf3%\x9c5ƪ꜌jtF\x7f\xa0\xb5\x90\xc2\n%\x17\x15Y\xcc\xd1\xe2r\x01\x80R*\x8b\xfc\xd9\xf0+@\xa6\xa4ժ,I'\x1b\x92\xe9M\xb3\xa2U#ʜ\xb4\x13\x1e\xb6\xbe}\x99\x9e\xbeI\x7f\xb7\x00\x90X\xd1\x12V\x98\xdd4\xb5\xa6Z\x19a\x95\x16d\xd2[*I\xabT\xa8\x85\xa9)c\xe9\x1b\xad\x9az\t\xfd\x82\xe7nw\xf6Z\xff\xe0\x04\x9d\aA[\xb7T\nc\xff\x14]\xfe(\x8cu$u\xd9h,c\x8a\xb8e#\xe4\xa6)QO\b\xb6\v\x00\x93\xa9\x9a\x96\xf0\x19+25f\x94/\x00ZK\x9dn\t`\x9e;\xdfa\xf9E\viI\x9f\xa9\xb2\xa9\x82\xcf\x12\xf8\xd9(\xf9\x05m\xb1\x844x7\xcd49\xc7^\x8a\x8a\x8cŪv\x8a\x04\x87\xbd\xdbP\xfbn\xb7\xbcy\x8e\x96\xa6\xc2\xd8si\xaf\xeb\xe5\xb6\x0e\\^J\xef\b\x18\xacy\x89\xc6j!7\x8b\x9e\xf8\xf6Խ\x98\xac\xa0\xca\x05\x9f\xdfTM\xf2ݗ\x0f___\xec|\x06\xa8\xb5\xaaI[\x11\xc2\xe3\x9fA\xfa\r\xbe\x02\xe4d2-j\xb6w\t\xffJv\xd6\x00x\x03\xcf\x059\xe7!\x19\xb0\x05\x05\x1fS\xde\xea\x04j\r\xb6\x10\x064՚\fI\x9f\x99\xfc\x19%\xa8\xd5ϔ\xd9t$\xfa\x824\x8b\x01S\xa8\xa6\xcc9}oI[Д\xa9\x8d\x14\xbft\xb2\rX\xe56-ђ\xb1\xe0\xa2(\xb1\x84[,\x1b:\x06\x94\xf9Hr\x85[\xd0\xc4{B#\a\xf2\x1c\x83\x19\xeb\xf1Ii\x02!\xd7j\t\x85\xb5\xb5Y\x9e\x9cl\x84\rE\x99\xa9\xaaj\xa4\xb0\xdb\x13W_b\xd5X\xa5\xcdIN\xb7T\x9e\x18\xb1IPg\x85\xb0\x94\xd9F\xd3\t\xd6\"q\x86H6ߤU\xfe+ݖ\xb1\xd9\xd9v\x12h\xff\xeb*\xe9\x11\xe1\xe1\xd2\x02a\x00[Q\xde'}\x14\xf8\x13\xbb\xee\xfc\x8f\x17\x97\x104\xf1\x91\xf2A\xe9I\xcd\\|؛B\xaeI{\xbe\xb5V\x95\v\aɼVBZ\xf7\x92\x95\x82\xa4\x05Ӭ*a9\r\xfeѐ\xb1\x1c\xba\xb1\xd83\a\\\xb0\"hj.\x9d|L\xf0A\xc2\x19VT\x9e\xa1\xa1\xffq\xac8*&\xe1 \x1c\x14\xad!\x1c\xf7?\x9eػw\xb0\x10\xa0t&\xb4cx\xbc\xa8)\xe3Ȳs\x99U\xacE\xe6kj\xad4\xe0\x04Nw=\x15\x87\x00~<\x88^X\xa5qC\x1f\x95\x979&ڗv\xfc\xfc\x10\x13\x144f\x8c\xe3\xe2翣\x84\x11\x81\xb6@;\x00\x03\x8bBv\x98\x125\xf2\x81\xc8\xf0o\x85\x8c\x14\x12eF\xef]>\xcal\xbb\xc7\xd0O\x11\x166\xa9Pw\xa0֖\xe4Ph\xab\xebD\"pn\xebF>J\xd9\xde\xc63%\xd7b3Utx\x90\xcd\x05w\xcf&#k\xfb\xe4\xf1{\xb2\xa5\x9c\\\xbd.I\xc8<F\xe7\xb5\xd84z.xkAe>\x81\x10\x00ٔ%\xaeJZ\x82\xd5\r-v\xd6\xe6ke\xd7#|>.\x0f5\x85\x89AȜ\xab\xa5=\xac\xd8#!\x199\xfdI\xe6\x03\xe9\x13\xc1$\x9bj\xba]\x027\xaa\x16\x18\xf9\xae\xc9X\x91E\x16\x9e=[<\"8^̇\x9c\xe1h-H?\xa5&\xcfG2B9\xae\x9b\xb2l7H2U\xd5hŪ\xa4V\x0f\x17s\xe1y\xb6\xb1\xa4\x81o*\xc3[\uede8\xebОb\xd6\xd7]\x11C\x90q2\xbd~\x1cڦ\x1e\xa8\x19Pd\x17\xcb[\x80Ty\xabY\xcb\xe7R\xff\x11\x861\xa2\bM\xa3\xd3:\x81\xd5^\xb4K\xa2\xc84\"\x19g\xc3hy\xe4\xd4\xc5\x015e,\xdaf\x84\x18\x0f\x9f@\x8e!8;k\xb4v'\xbc\xffʍ݄\xe3\xd03(S\xd2c\x99yJ6\x9cu\xdc-\xf1\x8a&\x1a\x8e\x0e\x9f^E\x10\x12\x90C]\xc1]!\xb2\x82\xcd\xe3\xb6AI\xee2\xb1,#\x1b~uSO\xd7>\x99c0ܐ\xa2\x05\xabTi\xa0\x147\x04<ne\xb6\x84;\x14\x96[R\xf8Q؟j\x03\x05ai\v\xc8\n\xcan\fd(\xa11N\xdfj\x9al\xc2R\x15q\xc9\xc8)\x9d\xfd]~CN\x16E\xe9R\x18\x94$@\xc6m\x1b|\xd0:&\"\x17\x86\xce\x12\x86\xbb}\bc\xe7T\xbd\x87B\xea\x9f\x12\x8d\xbd\xd4(\x8dӏǧ8\xdd!a\x9e\x93\x18\x12\x92W\xc0\x8a\xaa\r~\xe7\x14\xdbQS\xee\x9bU\xf6\b\xdb\xd9p;\n(\x95-H\xc7\xcc\xf3\xcfe!\xba\xa1dEpW\x10\xf7!\x04\x8d\xccI\x97\x8c\x90\x83ݲ\x02\xe5\x86\xf2\x14\xe0\x03;\x1b-\xab\xc7\xfd\xed\x8dTw\xf2\x98\x199\xe2\xa1\x0fw\xfav\x12\xd9\xdd\xee\xe0\fb\x98\x19\xb3\x8cj\xcbg朊\x9c\xbdh\xfd\xe0\x99\xb0\xc4\x19\xba\a@9\xf4\xaf\xc6\xe0\xe6\x9bcԊq\xcaC\xd1T(A\x13\xe6lB\xd8\"\x9c\xc8쇐\xac\xb8R\x8d\x1f\x1e\xfa\x90\xed\x89\n\x8f\"+\x02\x94@Um\xb7\xadmsL\x15\xde\x7f$\xb9\xe1q\xfc\xf5\xab߿\xf9\xee\xa9nR+ãP\xfe#I\xd23\xfd\xf2\xe3<6\x958\x98ÜK\xfaˈMO\xe3\xf2k7\xdb\xefЀ!\v+4\x94CS?\xe4\xc2\xf7|\xceKc\xf9\xdc9\x06\xb1\x8eo\u0080\xe8\x01\xa3\xdc\xc2\xe9\xabcX\xb5QJ}\r\xa5\xdd\xe6\xe6\xea\xfe:\x8d\x98\"\f|\x7f<\xaaJa\x80\xa3\xad\xd6\xfduI\xec\xe7N\u0602\x81\xd6\xc1\x97UC\xf8\xda\xc5\xf5`Ǿ\x1a\x11Ҿ\xf9\xed\fM%\xa4\xa8\x9aj\t/g\b|\x01\xf1H\xb0\x19\x9d\xc0\xe1ф\xe6\xdb\xd3\xc1K\xe9\xe1\x1c\x19h7\x1a\xab\n\xad\xc8Bo\xe6\xfa\xb9\xbe\x8c\xd8\v-#\xc3\xfe\x8e\xbb\x7fcZx<\xa0\xb0\xbeh\x957\x19_Ĩu7i\x0e\"\xc7N\xf0\x95\xe7/\x80\x80\xee9:\xddu\x8a;\xec*B)\xe4Ɵ@\x96\x01\xd4\xe1\xda\xf1\xec\xae\xcctW\x10#\xb1\vr\x90\xa5\x9d\x15F\xe4\xa4)\a\x84M\x83\x1a\xa5%\xca\xf9p\x9a\xb7\xe22\xc8\x18 7\xf6\xf7\b{\x90\xa2\x85\x17\xa7\xb33\xb5\xbd\xa1p(s\x00\xbc\x9c\xbe|\xf5@\x92uT3$5Z\xbe\xd1Z\xc2߮\xde%\x7f\xc1\xe4\x97\xeb\xe7\xed\x1f/\x93\xef\xff~\xbc\xbc~1x\xbd>z\xfb\xeb\xa7\x02Y\xac\x05\x9c\xc9\xd6\xf6\xbcT\xeb\xdd\xc4:v\xed\x85Zå櫷\xf7X\x1a:\x86?Kw\xda\xcd9*>P\x85V\xf6\x19\x8bz6\xbf\xec\xf6\x98_o\xf7~\xaaKlt\xb6\x8c8$\f\x91}a\x88\xc1=\x158h\x85\xb5R)\xddcU\x97\x94f\xaa:\xe9\xd6\x0fȡקo\xf6\xe6\xc7\xf3+\x9f\x05\xd7ϯ\x92\xf6\xaf\x17\xe1\xd3\xd1\xdb\xe7\x7fM\x1f\\?zqr\xf4\xf6\xf9 \xb7\xae\xaf\x92>\xb1\xd2\xeb\x17Go\akGOL\xb3\xf8`\x14\xc25\xed\xe7\xa2dm\xdb\x10]\xf3\xa0\x17]\xf2Y\x1b]\xb2\xfd%\xfb\x01\xc3\xd2p\x11\xb5\xc6\xedd\xed>\xb9iV\xa4%Y2\t\xff\x97#\xa9\xb0Nnh\x1b\xa9\xaf\x99ݧ\"\x98l\t\x15\u058b\x1dB\a惻\xa9xc\xbd\x93\xae\x1f\xa7\x1c\xd3\xc6YS\xad\x86\xc3\xe8D$\x80i\xb2\x8c(\x9f\xde\xd2\x1e҆\x1et\xf9\x13M\xa46\xfe{\x8c\xfc4l<[\x96A[9l\x1c:,\x9b\xbdɜ\x1bc\xf7h:\xed\x7f\x96\x8b\xc7\xf7\x01?E\xbb(\x8e֠3\x9b\x1bi\x99\xac\xb5\x91\xdbA\x17\xdf\xf6n\x9d\x83\x94\xb6\x03ld\xdbn\xa4\x85\x02o\t\xa4\nrL\xb3\nk\xed\xb4\xbb\xa3\t\x96F\xb53\x8b\xe9\a\xa4\x967Wd\xe6\xb3%ސ=\xd4i\xd5\x05\x9a}\xe9\xfe\x85i\x82ˢ\r\xe3\xfe \xcf\xdd\xfb}\xa6\xbb\xc8\xd7s\xc2|\x8a\n\t|V6\xbe\xf4@\x0ei\xcaH\x0e\xcbu\x8f\xb5\xe7cz\xb6\xbc\xf5~k\xae\x179\xa9\xf0G\xdc9\xec\x9b\xf5\xf9\x1e\xb1$K\xdd?I\xe3d#\xd5\xcf\xc6\\]\xd0\xfc\x02g>\x8f\xb4\xf3\xd5\x1a\\\xb6ϰCA\xea \xa8\xda\x1b\xc2=\xb0\xf5_\x00\xaf\x19\x99І\xfb0w\xec\xb5@\x93iJ{\x90\x01\xe7\x8e4\xc4\xcf3\x86j;T\x9f\x87[Ëp\xf8\xccR\xbcGQR\xfeTc\x8dEm\x1f\x97\xbf\x17;,\xc1x'h\x98\xb7\xff\x97\xf9\xf9\xa4^'\xca4\xf9\xe8O\xaf\x81r\xc6_\x80\x0f\xbf\xf4G\x8aY\xc2?\xff\xbd\xf8\xcf\x00\x00\xa3\x94\t\x14#\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}k\x93\x1b7\x92\xe0w\xfe\nD\xdfE\xf8\x11$e\xcf\xec\xfav;bcBnI3}cK}jY\x13\xb1>\xdf\x05X\x85&1]\x04j\x00T?\xe6\xf6\xfe\xfbF&\x1e\x85*\x02\xf5`\xb7d\xcf\x06E;$\xb2P\t 3\x91H\xe4\v\xab\xd5jAk\xfe\x91)ͥ8'\xb4\xe6\xec\xc10\x01\xdf\xf4\xfa\xf6_\xf4\x9a\xcb\x17w\xdf.n\xb9(\xcf\xc9E\xa3\x8dܿgZ6\xaa`\xaf\xd8\r\x17\xdcp)\x16{fhI\r=_\x10B\x85\x90\x86\xc2\xcf\x1a\xbe\x12RHa\x94\xac*\xa6V[&ַ͆m\x1a^\x95L!p\xdf\xf5\xdd7\xebo\xbf[\xff\xf3\x82\x10A\xf7\xec\x9clhq\xdb\xd4z}\xc7*\xa6\xe4\x9a˅\xaeY\x01 \xb7J6\xf59i\x1f\xd8W\\wv\xa8\xdf\xe3\xdb\xf8Cŵ\xf9s\xf4\xe3\x0f\\\x1b|PW\x8d\xa2U\xe8\t\x7f\xd3\\l\x9b\x8a*\xff\xeb\x82\x10]Ț\x9d\x93\xb7t\xcftM\vV.\bq\xa3\xc6.Wn\xc0w\xdfZ\bŎ\xed\x11\x13\xf0M\xd6L\xbc\xbc\xba\xfc\xf8\xfb\xeb\xceτ\x94L\x17\x8a׀\xa7s\xf2\x1f\xab\xf0;q\xa3$\\\x13J>\xe2\x1c\x89r('fG\rQ\xacVL3a41;F\nZ\x9bF1\"oȟ\x9b\rS\x82\x19\xa6#xE\xd5h\xc3\x14ц\x1aF\xa8!\x94Ԓ\vC\xb8 \x86\xef\x19\xf9\xf2\xe5\xd5%\x91\x9b\xbf\xb2\xc2hBEI\xa8ֲ\xe0\u0530\x92\xdcɪ\xd93\xfb\xeeW\xeb\x00\xb5V\xb2f\xcap\x8ft\xfb\x898)\xfauh\xae\xf0\x01\xf4طH\t,\xc5\xec\xb4\x1c\x8aY\xe90\n\xf33;\xae\xdb\xe9#\x93\xc1\xcfT\xb8\xe1\xb7\x03\xb4\x9fk\xa6\x00\f\xd1;\xd9T%p\xe2\x1dS\x80\xc0Bn\x05\xff{\x80\xad\x89\x91\xd8iE\rӀ\x19Ô\xa0\x15\xb9\xa3UÖ\x80\x94\x1e\xe4=}$\x8a\x01\xcaH#\"x\xf8\x82\xee\x8f\xe3G\xa9\x18\xe1\xe2F\x9e\x93\x9d1\xb5>\x7f\xf1bˍ__\x85\xdc\xef\x1b\xc1\xcd\xe3\v\\*|\xd3\x18\xa9\xf4\x8b\x92ݱ\xea\x85\xe6\xdb\x15UŎ\x1bV\x98F\xb1\x17\xb4\xe6+\x9c\x88\x80\xe9\xeb\xf5\xbe\xfco\x9e=b\xaa\x13b\x1e\x81m\xb5Q\\l\xa3\a\xb8>f\x90\a\x96\x8eeF\v\xca⤥\x02\x17[D\xdd\xfb\xd7\xd7\x1fbF\xe5\xda\x11\xa5m\xaas\xf4\x01lrqÔ}\xefF\xc9=\xc2d\xa2\xb4\xac\n_\x8a\x8a3a\x88n6{n\x80\r\xfe\xd60\rk@\xf6\xc1^\xa0\f\"\x1bF\x9a\xba\x046\xee7\xb8\x14\xe4\x82\xeeYuA5\xfb̴\x02\xaa\xe8\x15\x10a\x12\xb5b\xc9\xda\xfe\xb1\x8d-z\xa3\a^@fHk\x05\xcbu͊\xceB\x83\xb7\xf8\r/\xecr\xba\x91\xaa\x95;V\x06v1\x94^\xfa\xf0)4\xbf\x16\xb4\xd6;i>\xf0=\x93\x8d\xe9\xb7\x18\xe35\xf8\\\\_\xf6\xa0\xf8\x11\xba\xf1\xa2\xccj4+a\xd1\xdeSnp\xcc\x17ח\xe4#\n+\xff6\n\xadF\x13\xd3(\x01\\\x92\xe8\xeb=\xa3\xe5\xe3\a\xf9\x93f\xa4l\x00\xf3\xa4P\f\xf1\xb0$\x1bv\x03\xabV1x\x1f\x1e1\xa5\x007\x1a\x85\xa6lL\x9fq\xe0\xf3a\xc7\x00\xb7\xb4\xa9\x8c['\\\x93o\xbf!{.\x1as\xc0jY\xaa\xc3\x7f@\xf5\xbd\xbcc\xea\x18$\xbe\xa2\x86\xfe\b/\xf7p\a@\tB\x05\xe4m\x1c\x1e7\x8f\xf80Em\xb7^n\"\x88\\\x93\xb33\"\x159\xb3;\xf0\xd9Ҿ\xdd\xf0ʬ\xb8\x88\xfb\xb8\xe7U\xe5{\x997y\x8bCKP\xfdA\xbeіy\x8f\xc2E\x06V\x84\x9a\xfb\x1d3;\xa6H-Îw\xc3+F\xf4\xa36l\uf581\xdfE\xdc|\x12=\x01\x1fҪr 4\xd9<\xfa\x89\x1cN^4UE7\x15;'F5\xec\xe0\xb1\xc5\xcdFʊQ1\x82\x9c\xf7L\x1b^<\aj,\xa4\x04b\x94{\xd0\xc1\x00\xb0\x90\xa1\xb7\x8c\xd0\x04h\x873؝\xab*Bl\x17+\xc91Պ\x15 \xb5\xcf\xddn\xc0Y\x85;\x90\x90\xa4\x92b˔\xed\x1d4\x15\xcf`\x8a\x01S\x97\x04\x04\xadb\x15\xec&䦁\xfdrM`ugy\x80\vm\x18-\x9f\x99>\x15\x03\xa4\xffI\xca[=B\x96Wq[B\x15윌\xec\xf0\x1b{`E\x03J\x98\x13E0azc\x98:\x00I\xa2\xf5\v\x98\xc2\x11\xb0\xf9\xb3\xca\xcbv\xf8\xd4R'$\xfa\xc1\x94\xae\xa46\xedt\xc2$p\xe4S\xc7\t\x1fn\xd8>9\x8e\x83\x1e--cT\x02\x12(\x81\xcd\x1a\x90\x16\xc6\xc0\x05*\xbf\xe5\"\t\x93\x10\xe0wh2q\x84c\bC}\v{\xcf?\xedM\xe5\xf5Cow\xf6s0\xd2O#7\x96\xa9く\x83:ܨ7\xb4\v7\x12\xde\x1d\x18\xfe\xaf\xb6͞\t\x93\xd9f\xbb\x9f\t\xd3\x18%\xff\xa4M\xa4\xff\xd9sq\x89<E\xbe\x1dii\x81R\xa5\xe8\xe3`K\xd0\x01)\x17\xa9=z\x00\x91IQ\xdc\xfd\\x\xc0-\xb6\xc3\x0f\x02\xd1\x0f\x12\xf5~\xc7\x14\xeb\x10\xa3ݢ\x1c\x96\xcb5\xb9\xbc!\xa0\r{\xa1^.G{w\xf0\xbf\x00٫\xb4\x89;י\xbd\xfcH\xa2H\xf1\x1a\xb4\xaaY\xe8{g߉v\xa9\x9d\xbc\xf7\x1ak@\xc0\x8eޱ\xc5 P\xe0\xb1\x1b\xc2\ra\xa2\x90\x8d0pP\xa4©y\x16}\xa0\xf6\xe1\x1e\x04\x02yl\xd2L4\xfb\xb1\x89\xac\x90\xb2\\$do\xf7\xb3\"o(\xaf\x9e\v\xcdNc}n.\xf5\xfay,\xaf\xf6\xf4\x81\xef\x9b=\xa1{\xc0)\x9cΡ\xf3\x1ey\x82\xd6\xee7;P%\n\xb9\xafAغ\xedn\xb4\xf7B\n\xcdK\xa6\xfc\x01ԑL\x82\x00\xbf\xa1\xbc\x82\xcd\xffy\x10\bGM\xaeX\xef\xd8\xdc\xfd\xac\xfc\x1a\x1ch\x939\xb6u?hLZL$\x12\x18\xa5\xbc\x88\x80\x17\x83\x91d\x8ca'\xcd\\x\x93\u05ec\xf1\xe0\x1b\xf1\xa0\xec\x0f82\x94+\xb1\xc4\x1a\x00L\x00\x86\x17c\x84\x8b'O\xa7\x96\xe55\xabXa\xa4\x9a<\xa1\x91UpՂ$\x1aa\xeb\xd4,{3\xb1\a&+[U#\x04p\xf0\x90V\x02\x9f=5\xc5\x0e\x1ar3E\nOU\x04\x10\xec\xeb\a0(\x06\x83&!\x13\x91\xd3\x7f\x19\x06F\xd1\xde\n|X\xd1\r\xab\x1cV\xa4ZdAv\x17\x19\xaa\x11k<Hǿ\xa0j\xfc\xf2\xed+V>\x93\xde0\x87\xca\xceNٛQ<>g \xf3O\xd0L\xebvMm\r\x01zI(\xb9e\x8fhLD\x8be\xcd\x14\xf5\x8d't\xaf\x18\x1a'\x91un\xd9#\x82I[\x1b\x8f\xe7\x06g!d\x8fS\x9a\xf5p\bcr\x8b\xde\xe2\t~\x80\xb9\xe1O\x93\xd9\xc0[\x92늳\x94m\xef\t\xeb\xbf\xfdx\xdc\x1f1\xcdI\xac\x12\xf7\x11\x99?-\a|\x01\xb6\xcb\n\xadLz\xc7k\xd8\xfa\x80up\xcdL%\xa8\xfd|\xa4\x15/CG\xf6\xbcu)\x96\xe4\xad4\xf0\xd7\xeb\a\xae\x9dE\xff\x95d\xfa\xad4\xf8\xcb'\xc1\xa8\x1d\xf8\xa7ħ\xed\x01\x17\x9a\xb0\xaa9 ,\xb6Ik\xd4u\x81\xdb\x02\xee\xb9&\x97\x02lU\x16%\x13\xbb\x02\x10\xae;\xdbѾ\xd1\x06\x94j!Ŋ\xedk\xf3\x98\xec\xc9\xe1[\xaa\x0e\xba\x9fܩ\xeb\xf0\x03\xe8\xa1v8\xd6\tR\x81/\xca\xdb-\xd1:O\r\xdb\xf2bb\x7f{\xa6\xb6\x8c\xd4 §q\xc4D\xc1z\x14\xfbL?r\xf9?\x0f\xab\xdb\xe0\xecZ\xc1\x96\xb3r\x10\x8c\xdcO\xc0\xc1\x14\x95\xce+v\xb7l|H\xab\xc0\t\xa3M'i\x81s\x91\xf2\x04t\xe0.\xfe\x03\x88\xecQ\xeaҲD\x87/\xad\xaef\xec(3xa\xaeh\x88Ǝ\x92\x81\xeci\rb\xe1\xff\xc1N\x8b\xab\xe9\xff\x93\x9ar\xa5\xd7\xe4%\xfav+\xd6y\x06.\xd0\x1d\x8b\xc1L\xe8\x12MW\xc0?w\xb4\x02\x8f\x14\bpAX\x85\x9a\n\xf4\xde\u05cb\x96\xe4~'5\x03\xe1\xdfZ3\xcfn٣5\x9d\x8fv\x19\v\x99\xb3Kqfu\x88\x03\x81\x11\x14\x0e)\xaaGr\x86\xcfΞ\xa2JM\xe4ԉ\xcd:,\xba\xa7\xf5\x14\x0e\x1d[\xa6+4\x8ae\x1f\xc2\xe9c\xf0!\x1eM\xb2-\xa2\x03\xc3\xe2ȩ\x0f\xaf\xe0Ze\x8ez\xd3\xd6\xc1\x95b\tC\xab\xb3\x16\aw\x8f\xbc\xc9X]\xc9K<'\xc3\xf6\x01\xc7Eˤ\x99\xae\xbcхk4L\x10\xba\x91\xca\xc5\x1fxs\xf7z1{\xd78YqOVܓ\x15\xf7d\xc5=YqOVܓ\x15\xf7d\xc5=YqOVܓ\x15\xf7d\xc5=YqOVܓ\x15\xf7d\xc5=YqOVܓ\x15\xf7d\xc5\xfd-[q\a^F#\xc4\xf7M\xb9e\x89C\xfb\xf8\"yݾ\xeeu\xb2\xbd\x84\xec$\x10\xe1\xe4~ǋ\x1df\u0380\x11ׅ\xf3\x83ɓ\x95\x04\xd2\xe3\xa09<\x81\xb3\n\xbe@\x93\xa7\xf1\xbf5TQ\bIs\x11Ց\xa9x+\x99&R,I#\f\xaf\xc8\x1e\xd2!P/wp\xc1\xad\xc1Dϸ\x8c\x86\xe1dt<\xa8\xbaL\x94\x1aR(\xc0,\x02\x16跼Z:#2\x06h/ɞQaC\xbd\xf9\x9e'\xb4\x9c=\x17`\x998'\xdf\xcc\rn\xb6\x84\x82Ԯ\xedA\b5{(\xaa\xa6d\xe5\x85͕\xbb\x86\x94\xbf\xd2':\ua8c87\bѝ4*n\x8f\xd4.Eo\x85\xa9\x86)ܵyU\x8f\xb5;\x8e\x03\xc5ݰۄ\xa9\xc1\x14\x0e\xd0N\x8d$g_\x83詪^\xaf\xdd>\xbcO\x01ᗓS]\xacZ\xb5\x98\xact\fn*\x93\xe8\x99Z\x94~ؗ\xe9n\xa7\x13\x0f\x01xpQ^\x9acw`\\\xbb \x9cYd\xcb\xef\x98\b\x88\xd4n\xc3@\x97_jK\xc2\rkI\xd8z\xbb\xc6ׯd\xa9\xfdfv\xdd\x14\x05c%+I\xbd\xa3\x9a\x11gf{}\x87\xe7hY\x95\x98,\aJ4)\xe9\xe3҉\x83\xf4>$1\x87\xe3\x86Wh\x1d\xbdG\xdb*\x178\xc5\x19\xb4\x1aG\x1b!\x97\x86\xed\xdf\xc0t\xdf`g\xee\xc0\xa8\xbb\x98\xa2-\xabm\x1e\xe3\r\xd0b\x91+\xbb\xbd\x82\xcfV \xeb\x10\x9e\xde\xd0-t\x86\x81\xd0\xd0\xd2n\xd9L\x93\x8d4;g\x9d\x81ܑp\xa2\xf7\x02\x8en\x99\x13^\x9a%ORcGm\x84\xebw\x95t\x93i\b\x83ϛ\x18X\x02e\x83HZ\x92{\xee&\xab\x1f\x85\xa1\x0f\xaeA\xb6\xb76G\xb8\x87\x1d\xed8Ѧͭ\x91\xed\xfe-\xb0\xe1\x9a\xfc$*~\xcb\x12h\xd5c]B~\xb1\xc6T\xcf%PI7u\x8d\xdeC*\xbc&\xe5\xd6\x0f\x10;{n\x1eU@qQ|\xd8Qq\x9e|\xdc#\xc8;\xdf:\x81q\xcc\x02d\xa5O7\xa2[\x89k-\x03֞\xfa\xcaFѼ\x1btT\x9aM\x9c\xa3_9\x93\xa6跛C\xdb2k\x97`\x8c\xfa\xfc)\x179\xa3\x06\xf9\x04\xc1\xf1(\x84\xd6\xee/\x9b\xc5|$ՆT\xc3U\x18\xe4b\xa6\xce\xf6\xe4\x9d#X\xc0\x9fQ\x13\xc8\xc1\xec\xe9\x02A\xa1\xfd\xcc\xda@\xbf\xdf\xff\x82\xfa@\xa0\xc0\xf3\xd0Q\xb7g\xb5\xd6^\x1e\xd0\bK\x0e\x8a-(08\x1d\xb2(\xf1;p\xe9w\xfc\x1c\xb5~%d=\v\xcf\xe7\x98<\xf0\x96c\xde\x7fHL\xe1\xd6u\xa5$\x9c\xfc\xd2^\x97qD\xbd\xe9\xc1p\x99\xacno\x8eT\xceA=\xb3\r\xf9y\xfc\"yʻW\xdc\x188\xab\xc9\b\x81\x91湧\x82nY\xe9{uY\xbbQ\xbf\xea \x9e\xe8\x9a\x15\x8a\x19=\x83\n\xe3\xd88\xc0\xc78:be\xb2\x83\x85ޜ\x93\xbd\xe5\x18i\\\x01\xf4\xab\x04Ǜi3m\xc6\xf1r\xb1\xd0B\x1a\xf0\xff\xbc~\xf7\xf6\x8a\x9a\x1da\x91wΡ\xdf!\xc4'>w\x11c)\x9b\xedn\xed\xabJ\xac\x1d\xdd\xdf8Ur\r?\xc2Q\xa3m\x11\x95\xf3\xf9\xf9\v\xb0N\x16\xa6Z\xb7& \xa8\x89QQmV6b\xbf\x84\xd2$7|\xebt\xa1/~\xc9\x0f\xe2C;\t^B\xde\xf6ͣ\x8f\x01pJ\x18\x15_\x98(\xb9;\a*\xcbo\x13\xd6\xfe\xd8\x12\xef\xee\xb7O%\xf3|}\xcci\xe4v\xa9\x01aJVW\xf2\x11-\x80kZ\xd7z\t?\x9e}}\x96\xed\xd3\xd7$\x88\xfbПDY\xeb.\x89d\x13?\x80Ϧ\xcf\xed&\xa4\xe0\xdb\x10\xca\xe0\xdf#\x05V\xb9\xb2\xc1G\x1cN7\xb8\x8f\x87\x90\xa4\x10\x02p\x00\x95@q\xa5\x92\xdf\xdc0\x05p\xf0\x00\x15\xd6kN\xd4\f\v\x9aZ\x96\xaf\xb8V\r\xf2\x96\xb5$^Ɋ\x17\x19\xd7\xee4F\xbc\xca\x01\x05\xbe\x84\\Z\x1f\xcf\xe3\x04,$\xb3\x81H\xdaQQV\xfe\xb4\xed\xec\x15}@\xe9\x83:\xc4\xd8\xdd\xd9LMn`k\x91\xf7`\xe2C\x8bb\x19 \x9c\x93\xf7\f\xe2\xdb\fѷ\xbc\x06\xbc\xb3=\xda$\x15+\xa4\x02\xb9H\xee)\xd6bY\x92˭\x80\x97U#r=\xe6\xdf\x06/\x02\xcc\t\xe3\xc5\xd0\x1c\xe9\xc6\x10\xcbQm\xa820\x7f\xa85T+\x8f\x104\x85fzĖ`\xa0\xb5\xb8S\x8dX\xa7\xb5b;\xf8\xf5b^\xfc\xd9ʣ'\xf3\xd4B]\x1c\xb5\xae\x9d\\\x98\xc0U^\x86\xd9\x03\x81\x9dif\x81 \xa3$!\x12\x8cF\x06v\x00\xa3\xb1(\xf9\x1d/\x1bZa5\x0e*\n\xd6\xdb\xd9\u05cb\xd9r\x7f\xdaJ\xf0\xc5\xd6\xfc\xa4@\x14t\xea#I\x81\x967d\xd4æ\xf9\x99o(T(\x91b\x91\xec\xd4\xf9\x89US1\xed\xba*1\x90\xae=<,[\xa2\xd8h\xffn\xd4J\x1a#\xe3j\xcb\xd4\xd3P\x06\x91\xaf\x0f^\x8d\xe27\xfd\x8ef\x1f\f\x80$\xa0\x86z{\xa5\x8brC8\xa4\x04\x8f\x03\x84\xb9\x826\x9187N$\xfe$\xa6\x9f\xb8\xb7L\xd9e\x0eq\xeb\xb9d>jÛ=\xcc\x06v\x18\v\xce\xfe\xaf\x89X.\xfa\x9c7\x19\xb3\x03\xab\x1f\xfe\xbb\x14\x93y:˷.\xd0\t\xb3\x02\xd1\x05\x82vN\xf7\xeb`\xefFv\x8d/\xfa\x1f\x986\xf3\x99~\"i\xa6\xac\x89OD\x98\xd0\xc5? ]p\xcb\x18sR\x1c\xd0\xe4\x87\xf8\xad%\x94H\xf1H/\x97\xc1\x87\xd4\xc1\xfeQ\xa2\xdeS\xe69\x901e\xd7\v\xfe\xb6(\xa2c\xb8u\x0f/\xa70\xd9S\x98\xec)L\xf6\x14&{\n\x93=\x85ɞ\xc2dOa\xb2\xa70\xd9S\x98\xec\xf3\x86\xc9\xfe\xa6\xb2\x06\xf3\xc5^\xe73o[\x11\xb6\xa33'\rj!A\xde\x15\x8c\xd5F\x86\xdcP\x10\xcac>\xe0\xf8χ\x1d\xd3,U\x85\x16\x1c\"g\xed\xfa\xb6j\xf4\x995\xfe¿\tu\xdeXx\xb7V\xb2`z$So\xc2~\xd1\xc1\xd8\xe1܃͑\xdaS\x12\xd8\x03\xc7L\xa0\xf3Uޱ2\x06\x89\xa1v\x8a\x19\xc0ڇ\xefc<6w\\3\xca\x19\x1cY\xd4`\x12T2\xb1B\xc3,\xc2\xcf\\y\xc7\x15;\x98\xbb\x87\xce*|0\x7f\xc9\xffV\x8a <S)\x84\xa3\xc87\xb1,\xc2q\xc5\x11&\x01%\u058b\xc9&\x97H\x98\bu\xda\xea\x9fZNafQ\x85\x19\xa5\x15\x8e\"\xdb\xc42\vOY\x13\xbfn\xe1\xdcg+\xbcp\x04z\xe7\x1cE\x9c$\x18m9Q%\x9b\xda\xf9`6Ҭ\x1e\xa7H\xe3l\t\xa8\xf9\xfc\x15\xcaA\xcdѲjť\x82\x1f\x9eY\xd1r\xc1X\x10\xe2}ҴN\x9a\xd6I\xd3:iZ'M\xeb\xa4i\x9d4\xad\x93\xa6\xf5\xabhZc#\x1aL3\x1f\x1d\xc5\x04W\xf5\xd0\x10\a\xe0\xbb\xe0\n\x97F\xec\u0558\xc4>8\xbe>.Ӡ\x12\xd7}e2\x83SB\xab\xdd<|\x18\bF\xb2y\x9eG\xcfߘ*\xf9\x84\xbb\xb6\xba\xe8\xb1\xd9Z\xafX\xcdD\xc9D\xc1\x9f\x03O\x870\x13\b\x83\xd9\xe5\x90\x16\xa6\x9e\f\x19n\xea6\xf8\xc7g\xea+\x86!\xc4\x05[\x92\x90rym\xa4\xa2[vQQ\x1dE\x15_}\xbc\xd0hV'n\xb4\xefe\x15\x9e&z\x83\xc7\xdfsQr\xb1\xd5\xc1\xae~)\xb6`\xbc\xef\x81v\xbfb\xfc\xa1\x8a*\v`\xf6_\x88\x01N\xf4\x91\xc5\x03U\f\"\xfa=\x9fXc={\xa8+^pS=\x86ใW>\x05\xc7<c\xaa\xff\xe5 \xc4^\xe6S\x17;\th\x99\xe4>7챥td\xa2\xbfGʼ\xc4>\x9funk6\xa0#\x06kb%\xe7\x95\x19\xc4X\xffY\xad\x7fp3\x9c\xc4\x1f)Y\xcc\xfbр\xcf\xc8\x1f9\x98=\x0e\t\xe2\xc0\xa1*\x01\xf1\xa9<\x92$\xe9\xd9\xd7g\xbf=\xf4?\x0f³(>ĝ\xbb\a;\x01\x15\xbcCq a7n\xf3\xb7\xc9\xc6\xcf·9F\r\\\xd8Gb\x02V\x97%{X\xfc\xcdʂ\x8a\v\xe6g\x9f˻\x99\x82\xc7C8\x96!\x03\x06k\x00\x0e\xa7\xcfR\x16hD\x89\xb0\xe5U\xac\x1b\ty3K\xb7\xba\x13\xfd\xdcH\xb5\xa7\xc6\xef\xdf\x1eR\xd8\xd0/03\xefGZk\xd2\x1bK\xd07 Pִ\x89w\x9a\xa5\xb4]#\xb7\xf6\xb6\\,<\xd1\x05\xb5^\xcc \r\x90\xf3]\xedt\xc4\x0f\xb9\xb3\xe0\x04\xfc&\xe0L\xba3\x9a\xeaGQ\xec\x94\x14\xb2\xd1\xceNxi\xd8\xfe%\x9a$] \x02\x18'\xa7J\xd0\x7f\";٨Y8\x18\x89\xd1\x1d\x9f|'\\\x17\x06A\t\xe4n\xde}\xbb\xee>1\xd2\x05\xefb\xbd\x90\x04 \xd4\xe8\xc0R+\xb6qJ\x8e\x93\x87\xdd\xcc\xe1v\x01'\x00A\x1e\v\x94u\xa2U\xfbvg]\x93w8!Z\xad\xe7\xae\xd5a+g?\x12%զ\x87\xd29A\xbd\xfeP\xbbO\xddd\xef?s\xe3O\xb2\"m\x1a\xf5\x7f\xc5`\xdd\xf9!\xbaSl\xd4#\xe1\xb8\x1d\x8cL\v\u009d\x18\xed\x9f\x1b\xf4\xc8\xfa=\x8c[\x9a<\xfc\xffX-&\xc5A=wH\xed\xf3\a\xd2N\xc2\xcfx\xd0\xec\x1c\xec|\xf2\x00\xd9\xcf\x18\x16\xfby\x82a'\x86\xc0\x0e\n\xa4\x19\xe4\x1eR\xac\xb2\x81rSc9Ǎy\xf90\xd6\xd1\xe0\xd5Qc\xdf\xd8\xc4fO)\x8a\xc8L\xcfhN(\xea(u\xa6-\xb3hL\x9f6\xd8\xf4\xb3\x85\x98~\xde\xc0\xd2A.\x1a|\xd8a\x9f\x91\n\xab\xb0`:\xe5\xe3\x12l1N\xef\x1f\x0e\xa0\xe0\xecj\xb0\a\x96^\xf3k\x8b\xb8Y\xe3\x1ft\x1d\xc7\v\x84s\x06\x16AL\xf4\x12NyK\xa2\xa5\xadn\x1b8\x04\x00\x85*\xa5\x18\xba\xe0ʑ\x06\xb3cT\xac\xc6U\x9e\xc1\xfe\x1e\xeb\x14\xb9[\x04B}\x12Si'D\x15+\x1bo\x91\xad$źs\xf1<\xc2\x10QI&{\x88^\xc8Ԥ\xcb\xca\xc9\x0e\xba\xfd騃]`@\xea\x187R\xccb\x14'\xe0\x12D\x8b\xceV\xbf\x81\x11\xaf\x17\xf3\xb5\xaeOX\xca\xd0)gي\x83\xa9Z'\xc0\xec\xffvH\xbfl\x8f\xef<\x1f\xb9Z2=V\r\xb5\x06\xbd+/ૠ\x02θC>\xe9QQ\xea\x81MB\x9b\xe7\x85Ø\xedhT\x1d\x8c\fW㋪\xc2|\xb6\x82|\x1dfI\xb6\xf03Y\xcc\x14\x89G[i`\x1d\x7fO+(\x1b\xa1\x8e\xb7\xd1\xfcp\x00%.\x86r\xcd\xd4\x1dwe)\x00\x7f\x9d枆\xceZ\x03\x02L1\x88\xa6\x82(\xa8\x94\x86\xe0\b\x0e-|\x19\xe7R\x82g\x04\v\xf4BmO\xbd\x9e\x8b\x9f\xe1U\x1e\x15q:_\x8c2jv}\xbfl\xc1\xc4؉\xa0{\\\x14\x95lJ\x88\xe7\xba\x03'\xa0sQ\x01\xa5\xc8\xc6c\r\x0e\xa1JV\x15S9\xcd\x00\xb6\xe7\xd7\x0f\x86)A\xabWo\xaf\x9d3\f\x166/\xd8z\xc3\f\xed\x15\xa2\xfa\x1aׂ{cU\n\xbd\xa6U\xbd;h\x95\xd3\xc6cʭ\xc9+k\xddA\xbb\xe6\x15\\\xfd\xa1\xee2n\xfa|\xe4\xc5*\xbc\x99y|m\x14\xaf\x17G,S(\xd2ʋ˫'\xd1\xf3\xda\x03\x89\xa9i!C\xf6\x93b\xb1?\xb0C\xbd\x88\xa2~\x19\\^y}*\xd3[\xcc&\xa0I1\xbb\xef^^\xe1q\xa9n6\x15/\xc8\xe5U\x90\x85z\xf9\x0fE\x91\xc1`\x96i\xf4\xf0\xb6KG\r\xa8>۱W\x1e\x92\xc1\x95}ǅ\x06\xaaq\x1fMyjx,\xfb \x1cOf\xc4M\xa6\xbë\f\x9a\x80$\x98\xca\x1b\xa9\xae\xfcx\xb9\xd8>\x05a\x7f9\x04\x87\x81;P\x16M\x14\xac\xddJ;\x9c\xb4\xcc!s\xb0\x8c\xb1\x7f\xbb\xdd\f\x0ep\xbf\xecn\x166\x0f\xae\xd3\a\xe1\x1a\x04}\xf4\x0e\xe1b\x91\xec\x0f\tC6\fֈbP0\x19Tb\xed\x8bX\xe9'\x92(\xed\x1c\x1fܤ\xf7\xf4\xe1\x95+\xecw\xbe\x98O\xb0\x1f\xdb׃\xd9\x0e\xea+k\x13o\x9f{\xfa\b\xb7\xa9-}\x88\xb0v\x95\xb8\xb0\xf0\x16~\x8f\xad\xf6\x89nZ\xb3=\x12݇l\xe1\xa1\x03\f\x9aP\x00\xc4z8\xe4\x1dS\x15\xad\xb1w\xc1\x1e\x8c\x1f\xc2=\x17\xa5\xbc_\x93\xbf\xc01\x87=\xd82\xec\xa9M\xa3e/(\xad\xd3FH<2[\xcdT\xdf\xf2\xba\x8e\xeeU\x88\x86\xa6\r\xaf\xa0\xde\x15\xec\x91\x18g\x81/\x14\xc0$UZ!\xfdw\xa6\xe4̻\x12\x06\x16cD˗\xc53P\xd4\x02\xf1\x92+ܽk\xb1\a,\f\x94\x8b9\x00n\x828'WT\x19N\xab\xea\x11\x929\xc8-c5hDI\xab\xf3=\xd5\x11\x8a\xc3e\x12\xb1楻\xf0X\xb9$\x17\x88Q۔\x9b\xe8ꉩ>\x9d\x0e\xc4\xf5b\xdaN\xb3꾖xn\xc75\x8bb\xae\"\xe7\xf9Lݯ\xfa\\v\xa5A\xf5~H\xacp\b\x8f\x05<5ʹ\x11\x8fb\xc6C0\x9e\x1d#\x1eAF\xf0\xd7\x05\x04GgT*\x16\xf9\x14A\xb9\x88\xab\x1fd\x91\x11y\x04\x03cSl\xe8\xb9\xef/T\t\xc7\xd5Q\x03.H\x0fv\xa6N\xe1T\x1e\x9dǚ\x19\x8e\x84\xb1.f\x10}?\rIS\t\xd7\xc7H\xef\x90\f.\xacB\x8aҹi\xfb\xadc\xec\xea\xa8\xf2o\xa2\xbbh\xf7\xa80\xfe\x00\xb4,0\x9e\U00109c9e\x83\x8d\x10\br\x05U?\xcbc\xf0\x10\xa2U,\x88L\x94a/⤕\x88P\xaa\xd0e7\v{\xe1\aMm\x8f\\\x94.\x94\xd1W(u7H`4\x02\x04\x04\xd9j\x9bp\xf1\b\x8b*\x12\x12\xde\xc1\xb2\xbb$b1S\xff\x18\xd2=\xa4\xeax\xac\xf518|׃\x01\xdcཹ\x9f\xc9-\xbeo*\xc3\xeb\xca)\x86e2~\vJT\x93{\xd0\x006\x8c\xfcU\xe2\xe5K\ue58fw\xef\x83\x7fb\xdds\xeeSM\xeeYU\xa5\xe9z0\xf3\x02\xcf[\xa4\x90+\x06>)\xa0\x9f\xa3\x9d;|\x81\x8e\\=\"\xdfX\xc5w\x9f\x00;h%\x9bf\x03M\x12*\xe1\xb4F\xab\xa8\xfd\xedo\rS\x8f\xa8\x9f\xb5\xae\xcdp,\xf4\xb6x\xddT\xadw\xc0y*rA\xec\a~\xfe\xd6z\x0f\x97\xcc`,R\x7f<\xfe2\x99(\x8e\x01|\x1dp\bJ\xf6\x91y]\xc8\xf0v\xe2\xb5\xe1\xbd\xfbp\xe0\xe9V=\x8c?{T\xc3\xfc\xb8\x86\x01\xe6\x98\xce\"\x19F\xf9\x1c\xd1\rǕ \x1b\xa3\xe6\xa4\x18\x87\x1en\x9e1\xcaa,\xceap\x87\x8b?\x1e\x873\xa61H\xe2\x18\xe6'(!\xf6)J\x87M\xc4ԔRa\xf3\xf0\xf4\xc9#\x1f>k\xec\xc3\xe7\x8a~\x98\x1c\xff0*\xb8f\x91\x7f\xc8q1\xe0\xf5\x9d\x1a\a1\x1e\t1V\xd2kB)\xaf\xc1s\xdd\xd4I\x1e1\xbdh_\xcf\xcdn\xce\xf9u\x12ͦ.\xc5\xcf\x16\x1d\xf1YKp}\xde\b\x89Q\xce\x1ay\xdca\xa9\x918\x89\x89\a\x93\x14\aKU25\x18I?\x95\v\a\xf9o\x9c\xf3\xde\xf5\x06\xd2\vqv\xca=\x0e\xb7\xa3/\xc3\x17״ \x7f\xe6\"I\x0e \x1epZ\xa4mx\x00x\x06l՟\xae2i\xa9\xe3\xd2(4\xab)\bc\xf0{\xda\xd2\x00ɭ\xf95-vax\xf8*\xd9Q\xed\xc3\xd7\xcf\u0091\xf3\x85\x05\x0e\xdf\xcfք\xbc\x91!1\xb1\x9dܒh\xbe\xaf\xabG8\xa1\x90\xb3\xf8\x85\xe38 \xc9mxN~όJ\x12v\x9crW\xd1\xfb\x11\xd5\xc04\x85!&`鷊f\xea\xce\x10\xe7\xbbX\xa9F\xb8\x03>\x1c\x1f\x13\xdd\xf8[y\xbd\xa1\xdb(*4\a\x19\u1c8c}\x8c\x05\x15q\x88\x048a\xc1\xeb\x14|\":\f@\xc8d2\xc7NZ\xd7\x1d\xc5\x06+\xbae\xc2,\x9d\x0f\x1b\xba\x8a\x06?xůbF=\xce&\u0530\x92\r\xbb\xf7\x05\xb8\x953V\xedi\x14\xf3i\x05-$\xbf*D\xb3\xdf8\x9f?R-\x19\x06\x15\x85\xe5`\xf9R\xe0\x9cP{.\xd3]K-\xbc6ړ\xa0%\x94#\xde\xfd\x8eW\xd0\x15\x84\x01\xc3\xe8J\"\x9b\x8c\xae:pW\xf2\u0605\xc8\xf0т\xd6z'\x9f\xe4Ҽv0r\xe83\xf4\xd6cOP\xc3\xef\U0003a34b\xeb\xcb\xd094\xa5\xe4NV\xcd>Ff\xa6;\x87\xe2\x88\xf5\x11\x99\x0eu\a(%-\x9e\xeb\xaa\xd9Zw\f\x94\xa0\xf9$\xf8lj\xf0\x05>\x05\x9b?!\x84\x1c.\xa9\xbf\xef\xfbJ\x96\x1f\x11a\xdf\a\x93\xa8b+w\xa9)\xde\x18\x15\x9af\xf5>\xef\x8a\"\xaf\xa8\xa1m\xbfN\x0e9\x8b\xa1`\xf7\x81P\x0e\xc7AB\xa1l\b\xa2\"\xafv\xb4\"\xc4\xc5\xc2X4\xb1\xb2\xbdq\x0e\\?\x95\xd4\xe6\x13Pe@\xea\xfb%\xfc\xa3,\xa12H\xe2\xb0;N\xb4\xf7=\x18\x91\xf4\a\x14\x85\xc4+oG\fbc\xef^\xd0\xee`\x1f\xc20\x83\xc17ћ\xbb.x\xe8\x1a<'\x96\x1d\xb1\x8c$\x8a\x95\xb4\x80\xd0#\xa19\xae?w\xf7\xf2L\xb1Kk\xfeG%\x9b\xfa)\xdc\xfd\xf2\xea\x12ax\xfe\xde\xe2\x17\xef\xab\x0f\xa8\xf1.q\x87\xba\xacvyyӁ\xd8-g\x83\xb8\b_Q-\n\aO\xa7\x9c\x17\x80E\x10\xbf8\x8e\\/\xa0\x95\xc0\x1e.\x9d\x85\x9e\xabrUSe\x1e\x91\xf1\xf4\xb23\x06\x7fZ[/\x8e8\x9f\xdcrQN@/N\xc5a\x10 ƺ\xe0\x01\xee\x8e\x19G\xbeH\xedhy\xdag\x1c\x87G\xe5\xe1HV\x88\xa9\xc5\xc4\x02\x1f\x03\x02`\xde\x11\xc3\xcfm\x92\a\xd3\xcb\x05\xe7\xa7\xccH\x85\xf20A\xf4\x00,\x18P\xa8I\xa6\x8a\x9e\x96\xf0i\t\x9f\x96\xf0\x8c%\xecU\x99\x1f\xe5\x1d{\x95\f\xb5\xe8\xa0\xe7\xba\xd7<\xe1\xb1\xf5\x10\xad\x1e\x93\xad'\xb6a\x04\xafq\x9d{\x14\x1ar\xa7\xfa\xae\xad&\xa8G\xe6\x92\\\xd1\xd7]\x10\x89\xf9\x81RAoY\xe8,e\xc6\x02\x05^<\x92\xab\x8f_D\x95m\xc2]\xceΐ\xef\\d!I8\x01ǽ\xf0}\xa6\xaa\xc5SP\xd5u\xfc\x8f\x91\xbd\xdbڹ\xa0\x90Ži\xac=Ҹ\xe8\x85E\xee\xe6\xc1>\xb0\xb6:_W\xa2o 0W&\xe5\xce\xc0\x1a3t\xfb\xeb٫>Эu\xb5 \x89]\x01B\x17\x11\xde2\x8c?\x7f\xb9\xe9RQBU\x19\x8c\xcfў0\xa4\xf2\xe8a\x02H\x9c\xe42d b\xe8v\x8b\x17\x84\x02a\x8c\x8e\xf8\xca\xfd\xd3\xc3l5`j\x8c\xe2\x1b\xa8\x85\n\xe3(\xa4\xee\x0f\xea\x10\xe5\xd6\x1f\n\xd4M\x8c\xdf_\x1a\xaa\x8b\x1d+\x9b\x8a!\x0ehuO\x1f5\xf8\xb2\xd7s䗡jˌ+,t~\x14\x11\"\x00}YN\xdd%\xde~-\xba\nxm\xcc\xc7NVP\x0f`I\x1aQ\xbaS]ڙp\x06z\x92\xbd\xfa\xd9ژI\xfb\x83ǐ\xb7\xddA\xd8,-n!f\x05\xee\xfbd\xb4\xec\xb7p\xe3P\r\xb8\xae\x13q8`\x9b\xf9\u0099\x9fwR\xb8L\vt\x04\x83\xa1\x04\xe2G!~\xcaOk\xd7l\xc8^\x96l\xde\xd21\xd5Q\xf8\xfe\xf0\x03`\x99b\xf4\xee\xdaG;\u0089@3`]י\x83\xb4\x81\x7f\xfaP\xef\x04\xb4V\xdeEr@1\x101\xb6\xceڬ)\xb9\x83\xb5\xb2\x85@Ff\xf7S\xa7q$\xfa]a\xd1\xf6\x92\xef\xa0\xdey\xf8\xb3e\xf3\xb0^Z\xec\xa8ز\xf2\xfbJ\x16\xb7\x1f\x94\xbd76\xd5n\ny\xe0s\x91\x80\xe7\x05\vl\xc3\xf05d'n\xa0W\xed\xc7\x00\xae\x1c\x17V^+vǡn\x88[\xf8\xf2&\xd3\x1d\xe0K\x83\xf2t\xf5\xf1\"\xa0\n\xc1:\xab\x96\x0f\x14\a\xabW\xa9800\x1a\xfc\xecZ\rJ\x86\v\xff\x04et9t\xb3\xae\x97\xacV\xe5\xe08\xa56\xbch\xd3\xf0ʬ\xb8\xb0O\xe1Q\x82\\c\xfb%|\xc0\xd2_U\xacz\xc3+\xa6\x7f\x9ajٺ:|\xebКu\x03\x0fC\aI\xa0\x9e\x991\b\xbff\n|\a\x88\x14\xd2h\xbf\xf9\xe6\xd9\xf1If!K4<\x0fxڠ\a\xefϩ\xa8\x8eq\x8e\xfc\x98\a\xe71\x03>\x19'!m$\xcc\x16:\xf7\xd3\x04\xb6\xf1\x8c\x04\xaaV\x1b\xb2\x97\xa2\x87/\x1a\x88\xe1n-o\xa2\x0f\xaf\xdb\t\xecZ\x9e\x97\xc0\xa5\x13\xca\xfeXIk}\x9a\x85\xa2z\aw\xfekH\xd9\x15f\xda\x04c\xb9O]\x83\xf0\x8c\xd1b\xb7&\xaf\xc1\xf9\x9f\xf4\x1b\xa4-\x89gw\xb8g@~\x97E\xc6\n\x91tfcff\x89ɻ\xcex\xbcf\xa6G\x88\xfb1\xfdV\xe4-\x8btC\xaf9d\xd1u\b\x87j-\v\x8e\xce5G:\xeee\xcf\xe1\xec\xb2!\f\x03\xd3\xce\xfb@3\x8b\xc1\x86\x80\x9e/\xb2(\xf1\x1a.4#\x05\xad\r\xb8\xa0\x90\xa4E\xa3\xf0\x8a|\v±\x01\x1209\xa5\xfc\xfe\x00Q\xf67\xb40\xaf8\xe4\x91\xfcz\xba\xee\xcb\xee8P僉\xee\xd8Ê\x89BBU\xcb\xeb?\xbd\\\xfd\ue7ff#\xa5k\xe3V\x9b\x95v]-҉\xae2\x1d\xc1\xccM\xd8u\xfa\n\xf2\x12|\xfe\xad\xb4\xefh\xa8ؑu\xd3\xef\xfdf\x02\xbf9\xcbJ\xaa\xa3P\xb1\t^\xf2ㆹ\xddAD\x15\xf5w\xbc\xc7C\xe7\x1a#\xac\xe3\xab\xf4\xfd\xe0f\xeb\x05\x03Rx\x13\xaau\x85\xca_\xfa\xa51\x10ș2(\x8cS\xf0\xfb!\x80^\x12\x1bih\x15\xedT\xd47H\x00\xc44\xa5\b\xecAU1\xa7\f\f,\xe3\xa1=*\x85\x80\v\x97\xeb\xf4l\b\b\x00s\b\xd0M\x01\uec9b\xa6\xaa\x1eCu\xec\xdf\b6 \xcf\xe1\xf9x\xc1B\xcb2\x02\x10{\x10\xd2脝\xf7\vB\xf3\x9d\x88\xf7\x95\xe3\xe7\xa1\xc2Q\xc1\x95\xc2ӆ\xee\xebcppq\b&d\xa8\x84\x8az!\xcf\v<t\x81\xfc\xebApx2\x02<\x86<\x03\xa8^@\xe0\x1caQlA\xea\xb9P܅#Vtz\xe5\xc8\rϊ\x90\x14\xc4\x0f!\xe5\xf5\v\x1d`BM\b\\\x9d\t$\x1c\xda\x1e@\xf7\xa4\xe6\x1c4j\xb6\x02\x10ǉ\xb9\xe4\xdeSHac\x8b\xf4q4\xf4o\xbb\xc6\x1bv\xb0\xfd\x86\x92\x13\xdeW\x8cU\xe9\xad:͋\x1d\xa0\x18\"y\x80nxU}\xa2\x1b\x7f\\w\x96a\x1dE\xa0HYA\x02ƭ\xb3\a\x98\n\xd3s1\x98\xe8\x8fܼ\xab5\xd91Z\x99\x1d)v\f\xcfYT`$\x8fٱ\xfd\f\xb5\xa6\x83\x8a0\xeb6P\xad\x84#se\x97\x1c\xe4;P8Ά\x94g\x87\x8e\x04\\\x12\xa3\x88k8{\x05\x97n\x8a\x9b\x86\x0f\xb2\x90\x8b\xa7\xcd\a\fM\xf0<\x95n7\x85\xb89\x88^D\xc1\x13\xcb\xd1\xee\xc4\xee\x90bBk\xbfG\x03F\x9c&\x86\xa1\x85\xe8\aIM\xcf/\x19\xae#sDP\x00\xd0FT=:3\xa8'\x81=8\xafї\x83\x9e*\xe7ǹ\x15\xf2^\xa0\x82\x1f\x9f\xd9p\xbc\x01\"\xa0\x1b\xdd\xd1\xe1\xfc\r\xdatQ\xb0ڀ\u0590\x1b\xe2\xf8\x82\x1c]w.\xb2\x80iM\xb7O\xa6\x91\x03\x03\x84\xa1d\xd7\xec\xa9 \x8a\xd1\x12\xa6\xe0\xbb\xc0\x8a\x9b\xa0%\x89m`V\xba\x81\xa8,\xc4J \xd9\bU yz\xc3\b\xf5\x19-vn\xb9\x97\xf6\xf4\xe1\a&\xb6fwN~\xff\xbb\xff\xf1ݿ\x1c\x8b&\xb9A\tZ\xfe\x91\t\xb7\xb9=\x15c\x87\x10\xe3\xac\x00@\xc9ګ\xb0\xebm\xdb&dE\xb4\xfc\a\x1b\x13؟7\x14dzS\x0f\xa1\x10\xfc\x80p2\x85ȗ%\\\xe4\x92\xec\x04\x04\xa2\x15\x18\xd5#\xf9\xf6wK\xb2qTZ\xbb\x9c\xb8й\xfe\xf9\xe1\x97ub*\\\x93\x7f]\xf6\xc6\xc95qE\x18\x80k\xb3CD\xbd@1+\xbe\x8c\x8c\xc5WW\x9a\xfby\x8c\xad\x11.\xccw\xff\x94i3\x12X3\xac\x86x\x17\x1f\xd5Og\a\v\xa5\x15\xe7\x14<\xd9[E\xf7{,U\xc2!\x99\x11\x9c\xc0*^F\x80\x05\xf7\xa2\xb7\xba\x05t\x7f\xa1\x9dx\x9c\xb0\xb0\xae\x94,\x1b_\x1e\u0099A\x8b\x88r\x80\x04\xbb\xf2\xec\x1d3\x84=\x00u\x98\xcf\x16\xc2\xcd\x0eB\x1e\xf1΅\xa0\xf4\xa1\\\xcb\xe7F\xc0K\xc1\xc9\x16\a`\xb3p\x9d\f\x14\x16 ۆ**\fc%lN\xf9Y|\xf00\"\xc9M\xc9\x05ݳ\xea\x82jo\x96\x1ezߏ\x19\xa7*d\x94\xa21.^\xbe\xfd\xe6w\x03L\x16Ze\x9a\xd4p\xccR\xe2\x9c\xfc\x9f\x9f_\xae\xfe\x9d\xae\xfe\xfe˗\xee\x1f߬\xfe\xf5\xff.\xcf\x7f\xf9:\xfa\xfa\xcbW\x7f\xf8\xef\xc7\n\xb2\x94E#í\xad\xe5\xa2\xc3XK\x9fN\xf9A5lI\xde\xd0J\xb3%\xf9I\xe0n\x97\xc3n:Qۻ\xbc\xcf\x00\xd4Y\xfe1\xf6\x91\x7f\xee\xfa>\x16%\xc0ݓ\x10\xe2\xe3\x14څ\xc1E\xc4_(Zɍ\x94k\xf6@A\xa9^\x17r\xff\"<\x9f\xc0C\xbf\xff\xf6\xbbQ\xfe\xf8\xf2g\xcb\x05\xbf|\xf9\xf3\xca\xfd\xebk\xff\xd3W\x7f\xf8\xf2\x7f\xaf\a\x9f\x7f\xf5\xf5\x8b\xaf\xfe\xf0e\xc4[\xbf\xfc\xbcj\x19k\xfd\xcb\xd7_\xfd!z\xf6Ցl\x96\x8fz\x00r\x1d\xeas\xc9fNmH>\xb3B/\xf9\xc8rm\xf2Q\xa6\x92\xe2\x80\t&o0<\x88\xbb\x00\xfb'\xc6Oݲ\xc7\xc4\xfa\xca\xf4~\b\x02\x9a\x9dCFL\xafm\xa1y\xd7n\xfa4[\xd0\xc5\xf5e\x0e\\\xd6\x00\xe0\x1b\xa4\xc1\xf5̺\a\x87\xff\xf5b\xce\xdez8]wP}\xae\xe9\x06pS\xec>\t\x88\xc1\x14\xf0\xfcs\xc7x\xee\xef\x9br\xcb\xcckW\x9a\xe7\x989\xbf>\x04\x83sU\x8d;\x7f\xec!\xfa\x13\x0f\x9c\xde.av\x14TL\x16\xbf\xeb7\x00;\x93D?\x14\x02\xf1ګ\x96\"s\t\xdd`I\xa7\xf5b\x8e\xe7\rg\xaf\x8f\x9e\xb0KV+\xfc\xbdw\x90ڎ \xfd9\x04\xa8M\r\xb9\x87 \x14\xa7\xf3\x86\\\xcb\x04\xd0\xf6&\xbb\x0e\x1e֠/0B\v\x037\b`\a\xfe\n\x80\xa8\x15(a2%!\xadQ\xba\x1f\xb01\x93M\x1ej>\xa9T\xd5\xeb\xd0\x10p㎞\xdc_\a\x01\xbf\xb1\x8ao9\x9c\xd5`\xcdn\xa9\xda\xd0-[\x15!1d\xbd\xc8\xe9֟\xc2 \xe42y\xdeg\xf4\xea\xce\xd4\\-\x1c\xdb\xd6%\f#1\\\x9e<E;\x17\x10\x04\xf4g5\xc0\xc5pyD\xb2\xc6\xcc\xd0H\xf1\x14\xfe\x91)=N\x847q[/s\xdcZqiaw\xf6\xe1\xd29%\x0e\xfb\x83Ϟ\xfeU\xaa%\xd9s\x01\x7f\xc1\xa2\xc3|_\xff\xf2\xac\xf1Ý\x8f\xd7\x19\x85\xb03\xf8?\x85\x86\xed\t\x85\v;l`\xab\xf6\x1c\xdfQ\x1a\x0f\x80\xda\xcb>\xf5z.\xb7\f\x1b\x9d\x10\xe6\xc0n8Mz\xc0\xe7O\x1dH\xa3.\x11;\x9b\f\xackw\x8e\x82\nY\xcb>\xe4\xdeQ\xbf\x85\x8d\x10-\xf3z\x99\x1c\xee\x10\xcet\xe4\x05o\x12\x88\xbf\uedb3\x9d\x1d\xe2\x7fL\xd6\x044\xe7<\x0eI\x96\x19\xf1( \xc0\xd8'\xb0\x18\xb0\b\xf8\x85}\xc4\xd0\a\x14<\xbe\xdf7hh\xfb\tj\xef\x9d/\x06\xa7\x94d\x9b\xcb\x0e\x84H\xc0\x86{\xb6\xfc\xc6\xf1\xbdKK\xf1\xd9*\x10\x1fc\xe9K{\xbe\xceD7\xde\xc1h\x91\xe1\xb6\r\x80\xa0\x97\xbe\x80M\xc92\xae\x89O)\xac\xb9\xf0\x8aХa{\xa8\xb2>\x82\xc4˃\x17\x0e\x8a~\xe3\xde\r\xcbO\xf4Z'\xcbE7\xb5\xb7\x12\xd9똿\xfd\xe6\x1b\x87\xf1\xa3\xcd\xeb\x19\xf9\xd0\x1d\x8c\xdbm@\x7fr<|O5\x16[\xf3\xe9K\x98\x86f\x03\x9f:\xd70\x05\a\x19(I\x8b\xa1̴%ٰ\x82:o\x81g\x12_\xae\xb1_o\xf0\x18k}>J~B\x9c\xbc\xa7X\xaa\xe3\x11\xb6i{φ\xf1$\x87\xe0\xeat\xb5\xe3\xb0?D\x83Y:\xbb0h24\x03\xf6\xe0\xa6\xc6'\xcd\xc2;\x85&M»V\xfc\x1c0\x84'\xf8\x95\x9e\x01\xab\xc3g\xefdr\xc1\xafRӼ+8\x12\xec9\xbe\"{\xc2!\xb0E\xd8`\x00\x8d\xbe\xfc8\xe8\xfb\x9dՙXZ\x89>r\x8b-R\xd2\x02\xc5\xf4\xe0b\x87\xcaf\x83\xf5O\x876\xb1\xa8\x96\xe9\xc4#ᏇotO\x7f\x91DQT`xnb\xef\x84p:*\xfa\xb3\xc7\xc3\x1e\xf8\x1d\xac+\x9fQU=\xce;\xe5u*bNR\xf5\x93,\xf0\xe3!\x18\xcf\x06\xf6\x18`\x89\x0f\x91\xacL\x80\xe2\x15\xcd\x1a\xab\xef\xda\x14\x9f6\x814\xd1G\xb6\\\xe6z1cm\xda\tc\x99\x841ʵ-\xfd\\\xb0f\x82\x17\x0e\x85\xacC\xb0\xa4\x9b\n\x17O\x1bw\xae\x8ef0\x92$\x9e\x01\xd2Y9\a\x05A^\xbf\xc7\xc2vG\xad\xf9\xb7=\x18!\fM\xb9\xef]\xc4\xc8\x1b\f\xb6l\xbb^\x86h\x8a\x04\xf0\xfe\xba\xe0\xba}q\x854(\x8fU)z\xe3\xf6\x84mK\xfcu\a\x1d\x85\xb8& \x83\xdaE\xe8\xc1\xd8\xdc\xfb\xc7\xe8\x019\xa3Kb&\xad\x95\xa5+l\x9d\r\x04B\x01A}Sn<\x87l\xd0\xfei\xea\xee>\x9e\x1a\xf9\x98d\x8c\x88\x00\n6+\x7f\xaa'M\xe32~\xe3p6\xa1\xceEg\x80\x19\xc0.A\x15\xe5{\xd8_\x8e\x9fL\xe8n\xd2D\x02g\xa5Գ\x89\xa8M.W\xc79i\x89\x95\x18HGb\xc9\xc6\x14r\xcf\x0e9{Ҩ\x86\xddEy\xa94\"\x9b&Nٗ\x9f\x9f4뿸Ƈ,\xe4\xc1\xc4K\"\x03\x91\xf8\xa5\xf2lKb\\\r\xcc1\xf4\xca\xd6\xcc\xfdl\x8a\xe0a\xf4\xc0\xf9b\x10\xe3\xc9}\xe1]2\x06\xc1삍7\xb2\xe0:\xbbgd\xae\x02U\x06\xdcR\xa4\xa9\xe1\x90l\xf3\x8e\\\xb8v\xa23\xaf,k\xb2\xa3w\f\xaa\xd3;8\xba\xd9\xf8g.B\xac\xd3?\xad\xb4tq>\x91\x99\xc0\xbd[J\xa6\xf3g\xf7t\x10\xc3\x10\x17d\x16n~\xc9&\x83,r\xa9\xa89\x8d\xe1-\xbb_\xe4\xd6#֨D\xda$\x9a\\\x8a+wM@\xe2!\\\x82\xc1\xc5\x16\xae\xd5\xc0\x1a6m\xcc\xea\xacƝ\x8a\xf5\x89Ÿ\"o\xb8\xa0\x15\xff{J2\xc4\x0f\xc7\x01\riN\x13\x86\x91{\xf0\nl<\xa9\xd1\r\b5\x7f\xfd\xc21\xcb\xca\xd3d\xcc\xec\x1b\xdc\x1d\xad\xbb\xc4w\xbb&oe\xd2v\xe9B\x99x\x17&\xf8\v\x996+vs#\x15$\xd5V\x8fd\xb5\x82P%\x17\x83\tfQ̉\xb2k\x95\xf0CYD\xdaҙn\xe3\xb9q\xf5\x0f\xac\xe3x\t\x95\xf1] \x19\x17\xb4(\xe0\xa0\xc4^hCS\x11w#\x92mX\xb5\x9a\xa0\x98L/\xd55\xaa\xaf JQ&Y\xc7T\x05Sd\":\xdf\fT)r\xa82\xe0\xfe\xa9*\x10_74\x11\x98=&w\xe0\xf3\xb7\x865\xac\xec9E\x9f2\xfb\xff\x95\x02x\x88\x85\x90mhE\x80s\xc3\xda\x042\x9f\xe8\x85Z\x1d\xc6\x7ff\xfa\x8a2\xcdB\xf2\x983̒\xa2\xa2P:N\xf9,FH\xf4e$\x94\x9d\xf2&\x05\xf7*\xdeY\xe2\x01ez\x1b\xba\x8ch\f\xcd\xe8\x98ȘO\xa6\xe3\xf6C\x80\x923\xd4;\xe6¹\xb6\xfa\xad\xbdoҵ\x82\xd5dw\xb6L/f\xa7d\xb3\xddy\x81\x91q\xab\x92\xb2\x81\xee]\xa92\xc7Њ\x99F\x89(\xcf\xc9\x15\xae>\x14\x90њ#F\x92\r-n\xff\x93\xbc\xa3\xebm\x1b\x87\xbd\xfbW\xf8\xf1\x0eH\xee\a\xe4\xad\xd7;\xa0\xc0P\xa0C\x80\xbc\x1b\xb1R\x18\rb/J\xb7\xee\xdf\x0f\xa4HY\xb2I\xd9V\x92a\xd8ފZ\xa1(\x91\xa2(~b_M\x98\xb1Ϟ3\x1f`\xb91k0\x00\xaci^̡[Q\t\xc83f\xbd\xa6x\xc4\xf5\x99\xf7\a\xae렂\xbe\xa5\x8d\x82')\x15Y3u\x0ee\x13\x8a\xd6\x17\x17\f\a\xc9\xd1s\xecf\x9f\a\xc3G\xb6vg\x1f\xe9]M\x9e\xc2#\xb8\xf0\\[\xdd\xc3\xda>\xc0qlW\x9f\x87\x1d\xe0\a'\x93\x9bXe?\x83\xe5O\x03\xa4\xf1\x15\xcc\xe7\x85=\xce\xcep\xcb\xf8j\xddJ'\xae\xeb\x00\x93G\x906\xf3\xd1\xc1\xe1\x8c\x13I\xaa\x83\x8e\xa0\x02\xb7\xbc\x0e\xf1\xdfڣ\xa0\x00-\xcb\xea\x8f\xf5(\xf4\x8c\xfa\x8b;\x1cl\xf3z\x92\x161}In\xf1\x97\xdez\xee\xdb\xee9\x88\xd0B\xb99\x18{Ie\xbbF\x1d{\x82\xecVa6V5|J)6\x1fǙ\b\x98\xf32\xf8i!)\xa2\xb1X\x13\xc0\xb5\xde\xd3%`\xca\"O\x9d\xdc\xe6\xdc*\xf2.\x05\xbf\xf7VY\xa7\x1dX\xc9\x19\x83)2\x9c~\x1b\x87M\xad䦲Nyw\xe9\xbd\xdcwn\xd4Q\x1b-\xa3@\x82\xb2n\xbf\x9d Q\x0e\xb8\x05.g\xca\xd5\x0e\xd0̽\xb1\xb6oM\xe7|\x16\x8f\xe0\xaa\x1e\xeb\xa1\b\xc6\xe3\b\xd5\x04\x8a\x84\xc6\rZjū˸\xb5к$\x7f\x1a >\v]V\x9au\x84\xa65\x98\x9e\\\xb3\xf0\x8a=7\x94\xd2\xc0b\x8b\x1cg\x84^\x9e\xc9\xf0\x7f\xa7\xf2a\x15\vu\x90\xbf\x0eh\xb4\xa6_\xae\xbd̝\x1c\x185,RG\xfd\vN(|\xda'@\xbd\xa0J\xac\x7f\x86\xea\x89ҩa\xdbE\xba\xab\xf5\xd5\xf7\x002\xe1\xb2`\xed;\xc9y\xe8\xa5\xe9sY7E\x92\xf1dI\x16A \x87\x91\x96\x12\x8c\xad;e\xb6\xdcR\xadx\x97\x82\xf8\b\xb5\x89\xc34ە/\xb5Sq\xa3;\xb2\r\n\xb00=\fź]\x9e\xe3\x1b/ȪF\xc6{\x04\bݧ\xbc\x17\xd9L\xe9\x92us\xb0\n\x16\u07bb@\xa1K\xf5\x06\xc9\xd7\a_tM\t\xbb\xf1O\xb6\xa8\xd0\xd6d\x81\xad\x89\xadI\vo\xa8\x1d-\xfd\x7f\xb01O\xad\xf5b\x9b\xbaVK\x95\xbe\xc3\xdd(\x92\x81/\x12\x0f%ɘV\xedg)\xf6T\x88\x8d\n\xae\r*\xa8\x89`K\x99\xc0YȻ=RcuG\xab؆\xe3y9\x9f|\xb2\x06\xc7\xdb\xc6\x18\xde|\xd3u\x99\xab\xa8\xd6\t\x99J\x05M  $;ľ7\xe1\x87\xc1\xf6\xf6ظ\x97\x06\x04\xdb\xf7ӰK\xf8\xafF\xaa\x02\x84E\xbf\xf7pr\xfe^\xa0\x87%w+\xfb\xce bf\xedH\xcc!qD7\x06k\xeb\xa1\xd9e\xf9\x1f\xc4\x01\xef\xc1\x96\xb5)_\x8e\x06\x1c\xa8֘8X\xbcX\xa2z9㥫\xb9\fu\xed\x8e\xcd>/\xd0b'\x01\xf2z=\xdbH\xad\xf9j\xceՑ\f\xaf\xbe\x843\x9f\x8aQmg\xb9 T\xb0q\xd8u\xc8p\x81L\x19\x06$;\xbbv\xe1\x1e\xb1\x05\x1c\x14-]Z%\x90ҷch\x0f\xfduO\x8b-t\xa3q\x8f#\xad#G\x9dg\x18\xf2\xd79\xb4#\xdf\r\xe3\xc2D\xf3\xc8\xf5\x14d<W(MjWǢ9E\xd4\x7fj..\x95\x9b\xca\xf4\xab\x13B^(\xc6\xe0J\xabN\xd2d\xc6ٞ:\xc2!\x19\xaeݹ\x9do\xc5\x11\x1b\x85\xc0:\x1c\xdehP\xee\x90\xfet\x13\xafJs\xb4&\xba\xf9\xe0\xad\xff\xb2+U\xa5\x1b\xe8]\x13\xde\xff\x14Y\x1b\x93V̙\xea\xe2G7\xaf\xf0)q\x8f\\#gc\xb6\x12xa\x9aD\x03\xd6\x14m\r,\x9e0\xf6+<\x9a\xdfU\xc9$\xcc\xd4\x1eD\xc9\x14\a~\xf2\xefɲA\xa3 &@\xa3\xe9r\xc9\xf4\xd4\b\x8fv^c \xa1\x04\x98eY\r6\x800̑LU\xa2\xe1\xd0\x00\xfd\x87\xa8\xa3\x10gNÆUT%\x8az\xd2 R\x1223\x18\x9f\x94\bS\xcfB)q\xe0\x1f\xa8\x01\x89\xba\xc9\x1a\xe2\xf0\x94`$\xcaK\xeb\xe4d{\x82敗B\x99\x8c)\x90\x96\x95\xd3\xf6\x15\x023k\xed\xcf4er\x81\xd3\f2\x0f\xb1Ni\x0f\xb1\x8c&}\x7f{\xd8Ӯ\xb5\x8d\xb0\xfd\xf4:\v9\xbc\x81\x8ei\xdd\xd9\x1c\x9a\x0f\xae2\x16\xc8cu:\x90\xd3u\xbb\x7f\x87&\xc1\xbd\xf2\x01:A\xf3\xfa\\u\xaa\xdc\xc0\xf7L\xaf\b\x01\xed3\xb99-\xc6\x1d\xf7ɟ\xd4{y\xcd\xc4\x14\xbfu\x9a\xc9\xe8\x9e\xc2\xdf{\xef}2ܦX\xce\";\x05\x96\xe6\xc1NU1$汷I\xde\x1e\xac\xd2\xc7\x01\xdd`\x95\x1e\xd6\xd5)\xeb\xb7]2\a:\xe6,1\f\x9f\x1cdm\x13\xd8[\xe7m\aiی\xf8OM\xdc\x16\x0f\xd7\xe8\x9fh\xae\xa8\x833F3m\xca\xcb\xf9\xdd\x14?\x06\x00\x82L\xc6?\xd5 \x01\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec|m\x8f\xdb6\xb6\xf0w\xff\x8a\x83\xec\x03$\xd3ښd\xb2ͳ\xf5\x97b:i\xb3A&\x9bAf\x9a\x027\x9b{\x97\x96\x8el\xae%RKR\xf6\xb8\xb7\xf7\xbf_\x1c\xbeH\xb2M\xc9\xf6$-\x16\xb8\xad\ahl\x91\x87\x87\xe7\xfd\x85\xd4d2\x19\xb1\x8a\x7f@\xa5\xb9\x14S`\x15\xc7{\x83\x82\xbe\xe9d\xf9\x17\x9dpy\xbez6Zr\x91M\xe1\xaa\xd6F\x96\xefQ\xcbZ\xa5\xf8\x12s.\xb8\xe1R\x8cJ4,c\x86MG\x00L\bi\x18\xfd\xac\xe9+@*\x85Q\xb2(PM\xe6(\x92e=\xc3Y͋\f\x95\x05\x1e\x96^=M\x9e\xbdH\xbe\x19\x01\bV\xe2\x14f,]֕6R\xb19\x162u \x93\x15\x16\xa8d\xc2\xe5HW\x98\xd2\ns%\xebj\n\xed\x03\a\xc1\xaf\xee0\xff\xde\x02\xbbu\xc0\xae=0\xfb\xbc\xe0ڼ\xe9\x1fs͵\xb1㪢V\xac\xe8C\xcb\x0e\xd1\v\xa9\xcc\xdfڥ'0Ӆ{\xc2ż.\x98\xea\x99>\x02Щ\xacp\nvv\xc5R\xccF\x00\x9e4v#\x13`Yf\x89͊\x1bŅAu%\x8b\xba\fD\x9e@\x86:U\xbc\xa2!a/\xe07\x03a7\xa0\r3\xb5\x06]\xa7\v`\x1a.W\x8c\x17lV\xe0\xf9O\x82\x85\x7f[\x8c\x01\xfe\xa9\xa5\xb8af1\x85\xc4\xcdJ\xaa\x05\xd3\xe1)Qx\n7\x9d_̆6\xa0\x8d\xe2b\x1eC\xe9\x9ai\xf3\x81\x15<\xb3[\xbe\xe3%\x02\xd7`\x16\b\x05\xd3\x06\f\xfd@\xdf\x1c\x85\x80H\x84\x10(\x04k\xa6\xfd:\x00+\a\x05\xb3^L\x8b\xbd\xb5\xfcP\x876\xa1\x02\x1fv\xa08\xfc\xe9\x17\x8f}\al\x90\xef$U\u0600Ԇ\x95\xd5\x16\xdc\xcb9\xf6\x01\xdb\"\xc5K\xccY]\x98\xeeVټ\xddld[\x15\xa6I\xe6f\xf9\xa7n'/\xb7~s\xabΤ,\x90\x89Q;j\xf5\xcc~\xd1\xe9\x02K\xab\xa3\xf4MV(.o^\x7fx~\xbb\xf53\xc4\x04iG)\x88q\xacÛ\x05*\x84\x0fV\xff\x1cߴ\xdfZ\x03\x13@\xce\xfe\x89\xa9i\x99X)Y\xa12<(\x8b\xfbtlQ\xe7\xd7\x1d\x9c~\x9dl=\x03\xa0m\xb8Y\x90\x91QB'W^\x7f0\xf3;\a\x99\x83Yp\r\n+\x85\x1a\x853S\xf43\x13\x1e\xc1d\a\xf4-*\x02\x03z!\xeb\"#[\xb6Be@a*\xe7\x82\xff\xd2\xc0\xd6`\xa4\x17f\x83ڀ\xd5P\xc1\n\x12\xd6\x1a\xc7\xc0D6\xda\x02\f%ۀB\"\nԢ\x03\xcfNлx\xbc%m\xe0\"\x97SX\x18S\xe9\xe9\xf9\xf9\x9c\x9b`\xa1SY\x96\xb5\xe0fsn\x8d-\x9f\xd5F*}\x9e\xe1\n\x8bs\xcd\xe7\x13\xa6\xd2\x057\x98\x9aZ\xe19\xab\xf8\xc4nD\xd0\xf6uRf\x7fRަ\xb7\xfc\x89\xaa\xb4\xfb\xb3&\xf5\x04\xf6\x90yu\"\xe3@9\x9a\xb4\\\xe0bnI\xf7\xfe\x87\xdb;\b\x988N9\xa6\xb4Cu\x1f\x7f\x88\x9a\\\xe4\xa8ܼ\\\xc9\xd2\xc2D\x91U\x92\vc\xbf\xa4\x05Ga@׳\x92\x1b\x12\x83\x7fը\r\xb1n\x17\xec\x95\xf5b0C\xa8+\xd2\xe2lw\xc0k\x01W\xac\xc4\xe2\x8ai\xfc\x9dyE\\\xd1\x13b\xc2Q\xdc\xea\xfa\xe6\xf6?7ؑ\xb7\xf3 \xf8\xd4\x1e\xd6F\xad\xc1m\x85\xe9\x96\xdee\xa8\xb9\"\xcd0̠ծ-\x88\x10LE\x14\xda\xd6и\x91\xa0\x0fKS\xd4\xfa\xad\xccp\xf7\xc9\x0eʗ\xcd\xc0-\x1c+T%\xd7d24\xe4R\xedz\x1e\xd6X\xf2\xee'X\xbc]\x86\x03\xa0\xa8\xcb}D&\xf0\x1eY\xf6N\x14\x9b\x9eG?+\xee=\xc4\x11\x8c\xa4?\x87ⵜ뻻\xeb\x03;\xdf\xd3C\xfa\xfb\xbe\v\x80\x94r!\xd7PH\xaf\x81\x85\x9ck2U\xa4\x85ua41\xaf\xa5\x8c\x06.\x9cz5\xa6\x9f)\x84%Vf\xb4\xb7\x10\xb0\xdc`\x97\xae\x9a\xe4A\x19\xcc\xc6\xc0E\x86\x15\x8a\f\x85)6a\r\xc2g{\xb9\x04\xee\"8E\x96\"\x11\xf3\x93 \xc3\x02\rf0ÜL\xa6Y0\xd3`\xe9\xf0\xefD\x15\xb50\xbc\xa0%\xc5\x18\xd6\v^\xd0x\xa9\x11\xf0\xbe\xe2\x11\xea\xd3_Εv\x10\xf7V\n\x88[\xbc7\xa0\x17\xcc\xff\\\xf0\x1cm|\xb3\xbd?X/P\x00\xd9\x19\x8df_\xa6D]\xd8\xd8l\nF\xd5\x0f\x90\x92ۍHoPq\x99\x1d\x10\x94\xefw\x867\x8aB\xb2\x91[+i\x19e$\xe8\x8dH=\xf8=\x98\xd6\x0f{\x93\xe2-\xb07\xdf^\xa3\x12\xb8\xf4\xa6_\xe6\xf0\x142\xaei{\xda\x02\xfd\x92\xdbO\xa5\xc8\xf9|\x7f\xd3\xdd\b\xbaϮ\x1c\x00\xbdC\xb9+\xbb\x12\xa9\x11ِJ\xc9\x15\xcfPMȊ\xf2\x9c\xa7\x1e\x93ZY\xcb\x069\xc7\"\xd3I\xcfV\xf6l1\xfd\xa5\nIK8+\xa6\a0i\x06Ң\x86q\xe1b\xa0\x16\x80\xf5H\xaa\xf4\x01\x9c0\xa4\x7f\xbb1\t}\x8c\xb4nOc\x06kn\x16\xdb\n\xbf7\xbe\xdfB\xd3g\x89\x9b\xd8\xcf;\xb8\x93\x96/\xb11\x04\x1aS\x85\x86\xe2)\x8d\x05\x85G$J\t\xc0\xdbZ\x1bB\x8dE!\xfa\xb4 \xcc^\xe2f\x9f\xd0\a\x99\xeb\x03\xe6\xe8D\x1f~O\xe1ѣ\xc3[\x8a\xda^\xfa\xa3\x04/lTa\x8e\nED\xf5\xdd\xdf\x1dQ\xde\n\rI\x18\xe69\xa6\x86\xaf\xb0\xa0\xb8\xf1_5\xb9\xd81\xccj\x03Y\x8dD-R\xcb5S\x99\x86T\x96\x153|\xc6\vn6\xc0\xf5(\x06\x1d\x80\x15\x85\\c\xe69\x8eee6\t\xbc\x16\xda0\x91\xa2\xb7\xfd\x94\xa2m*t\xa2\xc0\x84\x1b\xe5\xb5؆\xfdLa/\xf8Rj\x03)*\x12\xc7b\x03k%żo\xb3\x91\xa0\x89*\x05J\xa0A[\x85\xc8d\xaa)\xbcM\xb12\xfa\\\xaeP\xad8\xae\xcf\xd7R-\xb9\x98O\b\xc1\x897>\xe7\xc4E}\xfe'\xfb\xbf\x87H\x81\xb4\x92Ɋ#\x84\x97\xa2\x1f\x9eo`\xbd@\xb3\xf0\x0e\xef\xd6ɠT@a&\x89v\xe9e\xd7Y\xd6l\x00\xa7n\xf6\xd6\xfd/\xb0|\x1f\xa5\t,qs\x8aQ\x01\xb8\x9f\xb4\xb4\x9d\x94\xac\x9a\xb8\xd1\xccȒ\xa7\xa3\xb8\u070f\x06\xc9\x10RZ.2\x9e2\x83z\xdbn\x84T\xdf\x03\xebw!\xdeU4\x13\x93\xd1)dB\x91\xaa\x8dc\xcc0\xbaQ\xfd\xfc\xa1\x99\r%[\xa2\x0eq\xaa\x87\xdaq\xdd`\x98\x9a\xb1\xa2\xd0\xe3\xee\x8f!Ҷ\x11T\x13N\xf1}\xf2\x03\xac)\xf0ks\xc6@%)\xb6\xf2\x14\x9e\xe1\x18\xb4tA\x8cY\xe0\xe6\xb1B\xa8\x94\xa4\xe4\x003\xc0\x15\xda\xe4\xdbN\x8a,\xd2R#X\x9cY\x9d.\xd1\x103J\xae\x83s\xc2\xcc\a,L\xa1xl\xc2v1\xfb\xbc\xf8\xe4Kx\x86^3\xfa\x067A\xa4:\x9e\xc3\xe9\xdd8\x84y\x9e}\"\xd4\xd4ư\x90E\x16\xb2\xcd\x19\xd3\xf8\xe2\xcf\x13\x14\xa9\xcc0\x83\x8bo^LfQ^yta\xadXU\x85ٖ\xcfK\xdc\xe8\x04^\x9bǺQO\x98m\xba&\x80慰 \x19\xc5\xdc\xd6\x01*\x1e\xa6\xe4 5?\xc7\xd7\xf6\x02\x04녏\xf4\xb7GX\xdba\xbf{\x8c\xef=^pN\xf5\xc1\xbf\x87\x1f\xfe\x1d|\xf1\xe9\xfe\xf8\xf7\xf7\xc9GJʰo\xfe<\xff\xdc\v\x12\x06=\xf7!\xb7tȃ\xf7{\xf1\x83\x9e\xfcTo\xee\xad\xc5\xeb\x97\xd3\xd1A\xd2\rY\xdf\xd7/\x81ۄ#\xe7\xb8g\x87\xc9\xea\x95L\xb09\x96\xb6\xdeF\xa1Z\x8a=\x06tjg_\xbe\xff\x1bȼg=;\xe0\xe7[x\xf3\xf6\x96\xa68\xa7\xfb\xd3\xfb\xa6^p\xf9K\xad\x90\xb0\x82\x0f\x14\xaa\xb81\xbe\xa8\xd3\xd4\x13EG\xeb_]\xdd\x10\xb0\x9e\xe5\xacה\x04\xa5Ǹw\xad\xba\x1e\u07b4N\x06x\xda+\xe7K\xdc\xdcx\xf8G\xf0\xe9M;:8ŀ]\x17\xb9.\xf9\xa3@\xa1\xe3բ\x03\xe2U.\xfaL\xbcN\xf5<\xbc\xfc\xf9\xb6\x8f\xdc\x13ǽ7\xb8\xf9\xd0\xe9dl\xff7\x81WW7}\x00\x06Iٯs\x93.\x91G'\xe8\\\xcexA\xd9G(XF\x9c\xf2a-\xfaq\x17\b\x84Z\x91\x8f%w\xe3`\xc7V\xeacdu\x81YSB2L\xcd\xd1WУ\x0e\xa6\x89-i\x01\n;\r\n\xf2\xf7\xae\xdc\xc5)v\xa9\xdbޟS-_\xe3\n?\x82\x14\b3\xa4Ej\xbd_\n\a\xe0\x06\xcbhl2\xc8\x1bo\xa5\x94b\xbbvo\x81\xac0\x8b\x1b%g\xf8\x10\xe2\xfe\xb5\x9dN\xfa\x10\"d\xa8l1\x8c\xa7\xa1\x81\xd8\t\x8c\x1b*\x95L-5p\xd3%\x8a\xb3\x02\x91\x85h0fݡ\xd4TՒ\xa8\xadC\x84\xe4k?\x8c\x17\xfa\xb7\f\xa8I,k\x85w\v\x85\x9a\xc2\xdcؘc\x88\x17\xa4\xb3\v+X\x15Q\x973gSڝ٢0\x03%\xd7TAM\x17Ε\x126M]\xbd!\xae\x91=\v\xce0B\xcb1<;@0\xfas5\xad)5ܞ_DG\x94\\\xf0\xb2.\xa7\xf04\xfa؉!\xf5\xeb\xe6\x11C\x00\xb6\xa1'\xd2\xcd\x17!\xec\xf5\x0e\xac@X*\xc0Sא\r\x8b\xe6\x06\f[z\x1d\xd5\x14/\xaa\xa1R\x98g\x0f\xb1\x82\x8b\xb9\xcd\xf1\x14>\xd6 (\xa1\f\b\x1c&\xf0\x11:\x1c\xb5\x93\xee\xc7k\x99.\xa7\xa3ө\xf5\xae\x99\xbd\x9d\x8b\x93\x05sEu\x1fW\xee\xd6\xd4wS\xeaB\xa6K\xcc\xdaJ\x7fd\xad0\xd5V\xfdql喅\\\x99bX\xbf\x16\x01]j@AҙA\xc1\x97\b\xb7\xcf=\xaat\xc6b\xb9\x9d\xadG\x96J\x19e\xd83\x04\xf2 \xc1\x1a\v\xa9v\x9a\tdqBԡ;\xbb\xf5\xa7\"\xaa\xa2\x9eS\x8e+A\xd7U%\xd5.\xe9[\xf2;\x94-\xef\xfd/:\xa4\xf9\x9e0\xbf\xa1I*\xa3ݹ=\xfeS\x13/(\x02M\xe9\b\xfe\xf2\x01\x81\xc8+\"\xad\xa0\x12fπ+YV\x05\xef\x1d\xf0\xe0x\x82p?]A|\xfbs:\x1a\xa4ѻ\xee\xd8\x104\x80\xef3x\x11\xd1h(\x10\xd0 \x90Z\x9eL\xc5\x04\xd0H\xea\x0f\b\x12X#\x815\x81\xe2\xe3\xa6\xd7\xee#\x8f\xe4Df;u9\x82\xdd\xdf75\xa8NE\xcaH\xa85Z\xcd;\x84\xc6A\x1e\x01\xa4\xec\n\xd51\xb8\\]\xd2\xc0\xa6\xdf\xc5\xe0\xea\x12f\xb5Ȩ\x11\xe80\xb2\xea\xb1B\xc5\xf3M|-\xfa\xdc]\xdf\x06\xaaZ\x93k\xe4V\x10>\xec\xb8f\x1b\x83\x0f\xd9d\xa50\xe7\xf7Gl\xf2\xc6\x0e\f\x04\xaf\x98Y\x00\x17\x9ag\xd8\x1a\xb9\x0e\xf9]\xd1,\n\xb5\xa9\xce&\xf0\xce'\xe1ɗU!\x87\xce\xe9Jt\xc7\xe6s.\"-\xbfc\x1d\x8d\a\xd0Ѩn\xbd\xc0\xb0\xf9\x9e\x9f\xa1p\xbab\x9a\xdad\x9e\xdd\x1d\xc1\x8d1\xd4\x19\xed1\xd4\"\xf3`\x1f\x19\xb7\ua8dd>\xa1M_]!H\xa3\tEḿ\x8b1\xe0\xb5qA\x98\x14ņ\x80\x04\x87\x15\xe21\x87\x89\x0e\u0383\xf6m+\xcc\xd1\x1a\xdeP]#\b\xf8\x01\xba\x1fJN\xb7S\x9edt\x828i>\x17\x0fd\xfc\xad\x9b\xba\x1d^\x10<Kޒ\t\x9eSH\xe6q\xcc\xf8\xdc\x1e\x15\x92\xf9.3\x90\xa5\x8b\xb0\x85h\xfd8\xe7\x82\x15\xfc\x17\xf4\xc7'\xda\xd8dL'<\xc9~G\xce\x10\x10\xad\x14\x92\xbe\xf9\xf2\xbc\xff=\xb8\xef\xc8:\x84\xfb\xff\xb1\xa2\xfd\xf3\x8bI\xaf\xf9\x04ЈYX\xe5\x87\xec\xe2\x9bo\x9e}\v\x95\xe2+:\x95D\bx\xe1\xf1)o\x81z[ {b\x8fa\x12\r\x92\xe9\x8fj\xfc\x1f\xd5\xf8?\xaa\xf1\x7fT\xe3;\xd5\xf8~4\xe2(\f,\xef\xfd\xe7\xf7u6\x8f\x05\xe2Ll\xde\xe5\xb1e\x86\v \x93a)8\xac\xe7>iqh\x05\xfboe\xdf#<|\u0090\x0e\xf6֚NPW\x95\x92\xf7\xbcd\xa6)\xc4GV+䜧\xac\x00r\v\x8d=_\xd1M\t\xdfr\xa0\xc4܆\x1a\xa4\xde\v%\xeb\xf9\xa2q\x00\xa07\xda`\xe9\x91i\xda\xf84/\xb2TI\x89\xe68\xb8\xee\f\xb3\xba*\xb8ǚ\xa6\x92\xbdQhϘ\x8e\xa9\x1eȷc\x85\xb5\xb5\x11\x90\xd7EA\x05\xd6\x04~&Ǎ\xf7)b\x86\xd98\xb2 \xa1)\x8b\x8c\xa2\x92@\xae\xee9D{\x1c\xc0,\u008e\xb8\xb2G*\x17LS\xb6\xefj\x1b\x19lиpB\xe0\xba\x05\x14Y\x8c\xcek\x17k\xb6!\x8d\xac\x1e\x10H0C\a\xe0\xa7\xf0\x9fO\xfe\xfe\xf5\xaf\x93\xb3\xef\x9e<\xf9\xf8t\xf2\xed\xa7\xaf\x9f\xfc=\xb1\xff\xf8\xea컳_×\xaf\xcfΞ<\xf9\xf8\xe6\xed\xab\xbb\x9b\x1f>\xf1\xb3_?\x8a\xba\\\xbao\xbf>\xf9\x88?|:\x12\xc8\xd9\xd9w\xffo4\xa8\x90\\\x98\x89T\x13'\xcdQܽT^\xb3\x8d\xac\xcd\xf4\xe1\x02\xef\x00\x84#\xb6{1F#\xe0\xc4\u0082\xf1\fdm|r@vХw\x8eWy\xc1L\xb8ı\xfd)\x9aEj\xfd\xdb\xc6~iQksT;\xeaʍ\f\xaa\xee'v(@;\xf6\x1aH)\t\xf9\xa5(T\xb0sV\x17~\x97cx\xe4#\x8fGn\xa3\xb6\xff\x9d\fX\xe9^\xcfeP0a\x8e\xd8˝\x1d\x18\xb6\xe2\xa6\xfd[\xed\xc4\xdfq9b+QY\xa5\xbfpu\x86oݚi\xe4\xd4\xd2~\n\xabg\xe1jO\xbb\xfd\x8c+L\xe9dp\x9b\xd3:\xb1\x1d\xc3*^\x8b\a?\x94Q3f\xe2\xe9Iƒ\xbe\x06I\t0\x18\x95ś\xf8\x8a\x0e\x8d\xdf{\xcfgo\xed\xb59\xc1@\xb4>T)\x8cj\x94}pq:+\x06\x1cs]\x15\x92e\x1fl\xae\xe7\x94~::\x9dU?\xedA\xd9N]m.i\xdd\"\xa4\vL\x97\xba.\xf7\xd2U\xeakY0\xa1F\x15Y'\x18\xa6\xb1\x1f\xea\xc9\\\x02\x9b3ޞ7\xdb@&ɱ\x94̤\vk\xa6\xec\xe942>MV\xfb\x1b\x9a#V̥\xe2fQ\x1e!\xf9\x97al\x10\xf1fr\xa0OC\xb0Ӆ\xe8\xea\xfd\xd5\U000cbade\x87\xb7\x7f\xbd\xbc\xf8\xe6\xc5\xe9\xc2\x04P\xb2\xfb\xf7hT\xcf\ue3d1\x17\xfa\xbcm\xa0\x04?T2\xb1\xb1w-u{獞9^c\xd6\xe52\xb9\xa1@\x19\xc8$\xea\x86߱\xe8\x84>\xcf\x0fx\xa0#\xdaq\a\xa4☎]\xa8/\xb57@?\x87\x867{\xd0z\xaa\x84\xadT\xd9\xd8I\xcb\xd3\xea\x83=5\xc2\xc0\x80F\x88\xa3\xd5¦\xb4\xe7\x91%\xf5\xf6M@\xec\xd1s\xfa\xec\x19\x8a`\x1d\xb8\xd1X\xe4ɗ-%\x1eN̆\xb2\xa1\x86\xbc\xa7\x98\u07b6\x11\xfa#\xc1\xa6\xe6\xebt4(\a\x1f\xf6g\f܇\t4ރ\xe9b\x97T*\x85\xba\x92\u009e:=\xee6L\x8br2:Q9zmJ\x9c\xae\x13O3\x1f\xb0\xee<\vZ4:\x82\xd4\xee\xda\xf5t\xd4K\xd5\xe8U\xbf[;\xab\xa1.\x11L\xce\xe8\xdcT\xe7\xee\xe0(v}m\a\xce\xe88\xb7q\xf4\x95\xc1\xa8)\xe8\xdc#$\xf5\x16P\v\x1br\xdbJT2\x8a\xccxI\x97V\xe9,|f\x8f\xb8Q\xf1\x86\x9a\xefk\x9a܁f\x01\x84\"?\xd5G|\xde\x19\xaa\\\x11\xc8k^\x14\xa4\x8d\n)\à\xce\xe1):\x8fʬ\"\xaf.\x92\xa7\xc9\xe88'\xf6\xe5\xaf(\xa6$\xed\x0f>\x18u\xd5\xcc\xf6\x83g\xd6~AZ+:\xa6\xdb\xde)\xa5\x1f\xa3\xd2@%\x04F\xad\xac\xd2\x1fH\xe1\xb6\xf4W\x12\x85%U\xf6\"\xab\xfa\x10*\x9c\x15ԝ>\xbe\x94\x85v\xad~:d\x99\x9a\x02\u058c\x1bˣWܼ\xab\xb4?\xad\xe4m)\xa4L\xd8^\x1a\x85L'\x9c\x94ڢLC\x84\xf6.W\x86ƞ \"\xc3KǱ\x18\xf9\xa0\xa6;\xe1\xa9\x13\x81\v]\x8aqm\xaf\xe8\x85\x17x\xec\xa3w(ꢄS\x9b;ń\xb6\xf8ћ\x15\xe2\xe3\x8e\xe1u\x1f\xc4\xf8{!\x1a\xb9\x02ӌ&\xfd\xa3\x9b\xdeD\x11\xffj\v\xeaj\vI\xfa\x96\x8c\x06K\xc8\xfeF\xff\xcc\xf7xi\t\xebv\vj\xf4vVK\x17L̩\t\x03\xafs'\x13V\x8d\r,\x85\\\v{\x18\x878\x1e\xb2\x11\x8a\xadZ\x88Dn\xa7\xe0\x1e\f\xed\x8d\fQeȎ\xf7\xa1\x18z\xc3\xe4Z&\xa6}}\xc5\tj\xe8\x83-:\b0\xffl\x1ey0\x16yX\xd4%\x13\xa0\x90e\xb4\x85\xf6\x99\xbb\xf9Ct\b\xc2\xcafT\x9c :\xb4,;\xc0\x15*\xf7\xd1\xed\x02\x9f\x13\xfb\xbd\xf5M*\xd9\xfd5\x8a9\xbd\xa3\xe3\xf9\xc5\xff\x7f\U000571d2)\xb8\x9dW(P\rD\x8c\xc7Sl\x1fb\xe7%\x06$3\x9d\x97\x8a\xcc\xdb1\xe1\xecPG\xfe\xd6t\xa4\x10\xa9RG\ue9ae\x86H\xf8#\x1di\xf7m\x8a1\xf0<\xbe\b\x19Dg0\x8a\r<\xbbp\x97\x17,J\xfe\xf5)\xcd\xe2\xfa\xe3\xfd\xa7$\xb2\x15\xae\xe1\xdb\xf1\x0e\x9e\\\xdb\n\x96\xcc\xdbמ\xc4\xfe\xb3\xe9<\x05E\xfe\bJ\xafq\x0f\xfb8\xa4#\\\x98\x17\x7f\xee\x19s \xd78\x9cJPH\xca\xf4狃\x83ҚsF\x86v\xaeXI\xf7q\xd3\xf6(\xbd\xea\xaa\x11\x91\xc6O\f\xf1vC\xee\xc7ڛ\xc7#\x14\xebFɬN\xfdQu\x9f\xbb\xa4\x1d\xce\x11\x11\xb4=^\xe8B1\xba\xfe\xeen\x98\xd9\xf8\x94\xa2\x9d\fJd\xd4.\x0foK\b\xd1I_&H\xfd\x86l+=\n\xb0\x94\xdd\x05\x9d?\xa1\xb2\x19\x83y\xcd\x14\x13\x86z\xb5\x977\xaf\xfbwq\x17`\x84w\xb1\x90\x99h_\xc2q\xc0Rx\xf3\xe2l1mտ\xdec\xa0\xf2\xb6e^\x9e=\xbd\x18\x10\xb2fTϐ\xb6\x1a\xfe\xf1r\xf2\x1fl\xf2˧'\xfe\x1fO'\xdf\xfe\xd7x\xfa\xe9\xab\xce\xd7O\xb1\"\xf6\x91\x86,\x16\x88\xf7H\xab\xf7\x972\xdf\x16\xac15#(\xae\xb8S\xf4ޚ\x1fYA͗\x9f\x84\xf5v}\x84\xea/\x90P\x84\xf9\x88@\xf5\xb5\x8a'\xf0Ȯ\xd1\xffܯ\xfdP\x92X\x9a\x1dC\x10\x1aH\x1bo\x15\x83w^\xf2b\xfb\x93\x02r)\x13\xbcgeU`\x92\xca\xf2\xbcy~\x84\f=\x7f\xf6\xe2\xa0|<\xf9\xe8\xa4\xe0ӓ\x8f\x13\xff\xaf\xaf\xc2Og\xdfQ\xabc\xe8\xf9\xd9W\xe7\xb6\xd3\xd2\bӧ\x8f\x93V\xb0\x12ꗴ\x82\xf6\xe9\xec\x81b֟\xa5\x13\xbb\xf6\xe3\xb9\xe80\x1f6D\x9f9\xa3\x17}\xe4\xa46\xfa\x88\xb0\x8e<\x18\xa8\x0e\x84\x87\xb1;\b;}#*8\xdbn.ݎ\x99\x8e\x8e\\}\x1f\x04\r\x9bB\xc9v[nD5z\x03\af\xefq\xc5\xe3%\xfd\xc3\xce\xe6z\x0fJ\x88\xa5\x9bJ\x03}\xf9G\x88\nΕ\x1f\xf6\x0f\xdb\xd0\bgpz\x9b\x82\xbet\xd1vg\xf7\xc3\xf4\xefo\xaf\x1fS\xc2E/\x980\xdau9\xe9\x05\x1f\x98\xd1\x11}\xef\xef]\xa1\xff\x88\xac\xb91\xd96\xe6\xb6/\xaaA\x15\xde\xc9D*\xe9rp{\x80\x9anES\xf4\xe9\"m\xaapG\xc0w[o]<\xad\xb7\xeaK\xab\xb9\xe8ɩ\a\x14\xa5eh<I:\x85\x99\x83I\x91\xc3_\xe6[[ۣ{\x04\xfe\x16'\u008f\xbb\xd1U\x7f\x06\xf2\xd0Z\x94\x93\xf5\xb6\xcc\xe6\xefD\x1c\xa0\xd0ul\xce\xfe\xfb\x8b($j\vh{ !\xd0\xc9\xf7\xe0\xf7\xe5Y\xcae\xf2\xf0\xbd|\x0e\xab\xb7\xa1\xc4\xd9\xddA\xbb\xcbk\xd6T\r1\xfbwb\xb4\xb7\xf1\a(\xf2\xb6\x9b\\\xfa)\x9dԱ\x87U\xd1\vY>\x7f9\x05\xc7\xfd\xec\xe6!\f|\x17͑\x88e\x9d\xbck\xb0je\x16M\t\x832>\xcb\xf7`\xe7r\xa9\x12\xdf括\xddT\xad`\xc1VHf\xd2\xc3\xd1\xf5,<\xf3\x05\xad-tX\xa1ec,\x9b\x8a\x85\x9fK\xed\x9edtZ\xce5\x94L\xd9w\x87\x1e\xa0\xac}\x9bh\xa0\xdb\xf1\x05\xbfdt\\8:i_w\x1ay\xb6\xff\x02ԣħ56\xfeҜ>\xb0ɨ\xf8|\u0603\xb2\x7f\xe5.b\xdf\x1a\xb3ߣ#\x91\x95l\x81\x81\xae\x84Y\xbf\xe0.\xee%!\xb7\xf2`C\x8f#\xa5S\x92\xf6\xb8\x12\xf9\xda<o^\x91\x86\x1bX\"V\xcdղ!9y~q\x82\x9cDc\xb5\xbd\x1f\x9d\xaaȗ\xdfw\xf7\x97V\xf4\xf5\x14\xfe\xfb\x7fF\xff;\x00\x8a\xb1\xde\n\xe9X\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcV_\xaf۶\x0f}ϧ \xf0{\xad\x9d_1l\x18\xfc\xb6\xde\x16X\xb1\xad\bn\x8a\xbe+2\x13\xabW\x96<R\xcam\xf6\xe7\xbb\x0f\x94\xec\xc4q\xec6\x17\x03f\xfb\xc5\"E\x1e\x1dRG*\x8ab\xa5:\xf3\t\x89\x8dw\x15\xa8\xce\xe0\x97\x80N\xfe\xb8|\xfa\x91K\xe3\xd7\xc7\u05eb'\xe3\xea\n\x1e\"\a\xdf>\"\xfbH\x1a\xdf\xe2\xde8\x13\x8cw\xab\x16\x83\xaaUP\xd5\n@9烒a\x96_\x00\xed] o-Rq@W>\xc5\x1d\ue8b15R\n>\xa4>\xfe\xbf|\xfdC\xf9\xfd\n\xc0\xa9\x16+\xa8\xd1b\xc0\x9d\xd2O\xb1#\xfc=\"\a.\x8fh\x91|i\xfc\x8a;\xd4\x12\xff@>v\x15\\\fy~\x9f;\xe3~\x9bB\xbdI\xa1\x1es\xa8d\xb5\x86\xc3/K\x1e\xbf\x9aޫ\xb3\x91\x94\x9d\a\x94\x1cظC\xb4\x8af]V\x00\xac}\x87\x15|P-r\xa74\xd6+\x80~\xd9\tf\x01\xaa\xae\x13\x91\xcanȸ\x80\xf4\xe0ml\a\x02\v\xa8\x915\x99N\\*\xf8\xd8`Z\"\xf8=\x84\x06!\xa7\x83\xe0a\x87=\x02\xc9 \xefg\xf6n\xa3BSA)|\x95\xd9U\x80\xf4\x0e\x12\xa7\x827\xd3\xe1p\x12\xc0\x1cȸ\xc3\x12\x04\x0e*D\x1e@\xa4\xbc\xc6;\xb8,{\n \xf9\x97]\xa3\xf8:\xfb6\x19\x962g\x9f\xe3\xebdg\xdd`\x9b\xbaL\xfe|\x87\xee\xa7\xcd\xfbO\xdfm\xaf\x86\xe1\x1a\xebLi\xc10\xa8\x01\xa9\x10\x97\xd0#x\x87\xe0\tZO\x03\xab\\\x9e\x83v\xe4;\xa4`\x86\xd6\xca\xefh\xf3\x8cF'\x10\xfe*\xael\x00\x82:ςZv\x11r\xaad\xdf\x14X\xf7\v\xcd\xe4\x1a\x06\u008e\x90\xd1\xe5}%\xc3ʁ\xdf}F\x1d.\x00\xf3\xbbE\x920\xc0\x8d\x8f\xb6\x96\xcdwD\n@\xa8\xfd\xc1\x99?αY\xd6-I\xad\n\x89\x12i;\xa7,\x1c\x95\x8d\xf8\n\x94\xabWW\x81\xa1U' \x94\x9c\x10\xdd(^\x9a0\"*\x7f\xbf\t\x89\xc6\xed}\x05M\b\x1dW\xeb\xf5\xc1\x84AR\xb4o\xdb\xe8L8\xad\x93:\x98]\f\x9ex]\xe3\x11\xed\x9a͡P\xa4\x1b\x13P\x87H\xb8V\x9d)\xd2B\x9c,\x9f˶\xfe\x1f\xf5\"\xc4Wio\xba'\x7fI\x05^P\x1eф\xdc#9T\xe6\xe4R\x05\xe3\x0e\xa9^\x8f\xef\xb6\x1fa@\x92+\x95\x8brq\xe5\xa5\xfa\b\x9b\xc6\xed\x91\xf2\xbc=\xf96\xc5DWw\u07b8\x90~\xb45\xe8\x02pܵ&\xf0бR\xbai؇$\xbb\xa2\x00\xb1\xabU\xc0z\xea\xf0\xde\xc1\x83j\xd1>(\xc6\xff\xb8VR\x15.\xa4\bwUk|\x98\\\x9e\xec\x9c\xe9\x1d\x19\x86c`\xa1\xb43\x9b\x7fۡ\x96\xe2\n\xbf2\xdb\xec\x8d\xce\xdbj\xef\t\x9e\x1b\xa3\x9ba\xf3_Ņ\x8bP\\\xf37/\f\xf2^\xe4vjY\\<\xa4\"\x1b\xc2I\xc3\x16\xa3`w\xf1\x92D\xf5\x85̤9\x037:\x12\xa5\xe6;뼚\x9bt/\x17H\xe4\xe9ft\x02\xea]r\x12\xd1\n\xca8\x06\xe5N\xfdD\b\x8d\n\xf0\x8c\x84\x80N\xfb(j\x855\xd4\U00046fde\x96\xf1\x99ԑ\xd7\xc87[\x11\xc0\x04lg0}\xa5:\xf2\xb9h\xad\xdaY\xac P\xc4Օ\xed\\\x11E\xa4N\x13[\xe3\xfd\xd3#r\xb4\xe1[D\xfc|\xf1\x04E\x98Ā\xfa\xff\xe9\x89+Q\xa5>7\x11at=x\xc1گ\x80\xbc\xed\xb3\\\x00\r\xfd\x91ᤳ\xe8\x1a\xcbm\xaa\xaf\xf5Ũ;\xe6M\x13@\xa9E\x06\fi\xda@\x88\xe4~\x05f\x0f&\xc0^\x19{\xab\x81wU\xf7|\xe9\xb8\a\x8d\xec\xed\x01\xcc\xf8N\xb6\xc4\xc3]\xd9\xd3\x15\xe9.26\xe29\xe4O\xd3n\xbaø^\xd3Bs۪\xc3#p\xe1Y1\xe0\x17\xd4q\xe6\xf4\x18^t\xb1]BV\xc0\x86\x96R\x14\xb0\xf1\xe7K\xe1\x8b\t\xe1\xa85b=\xd5\xc4\x05R\xb6\x83\xb7\x10\xf3\xdc`h\xd2!\x9b\x8b\x02ڷ\x9dhx\x9d\xa32\uf8f5\xa7\xaf\xd7j\xe7\xbdE\xe5f|\xe6\xc5zX\xb3\x9b\x8a\xf5`\x18_\x82\xc7OqY\xea\x8cuA\xea\xff\xa5(-t\xdbr\x9f\x8d\x0f\x86s\xc3\x7f\xf3dX\xee\x9e\x02>\xe0\xf3\xcc\xe8{\xb7!\x7f \xe4\xe99,S6Y\xd2gxZ\xec\xa6Y\xfan\x06Y\xeeg\xf5\x88E\x0e\x9e\xd4a\xcc+\xc7\xdd\xf9\xfaY\xc1\x9f\x7f\xaf\xfe\x19\x00@\xe7\x16\xe9F\x0f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcVK\x8f\xdb6\x10\xbe\xebW\f\xd0C[ \x92\x1b\xb4\r\n\xdf\xda\xdd\x1c\x16٤\v;ɝ\x96\xc6\x12\xbb\x14\xc9r\x86v\\\xf4\xc7\x17CI\xb6W\x96\x1f{\xe9r\x0f\xd6p8\x8fo\x9ey\x9eg\xca\xeb\xaf\x18H;;\a\xe55~c\xb4\xf2E\xc5\xf3oTh7ۼ͞\xb5\xad\xe6p\x17\x89]\xbb@r1\x94x\x8fkm5kg\xb3\x16YU\x8a\xd5<\x03P\xd6:VB&\xf9\x04(\x9d\xe5\xe0\x8c\xc1\x90\xd7h\x8b\xe7\xb8\xc2UԦ\u0090\x84\x0f\xaa7?\x15o\xdf\x15\xbff\x00V\xb58\x87\xcam\xadq\xaa\n\xf8wDb*6h0\xb8B\xbb\x8c<\x96\"\xbb\x0e.\xfa9\x1c.\xba\xb7\xbd\xde\xce\xe6\xfb^̢\x13\x93n\x8c&\xfe0u\xfb\xa8{\x0eobP\xe6ԈtI\xda\xd6Ѩpr\x9d\x01P\xe9<\xce\xe1\x93j\x91\xbc*\xb1\xca\x00z\x17\x93Yy\xef\xdd\xe6m'\xaal\xb0M\xb0ɗ\xf3h\x7f\x7fz\xf8\xfa\xf3\xf2\x05\x19\xa0B*\x83\xf6\x02\xea\x1c\xfe\xcd\xf7t\x18;\x00\x9a@Ao\x0e\xb0\xdb[\bʂ\n\xacתdX\a\xd7\xc2J\x95\xcfу[\xfd\x85%\x03\xb1\v\xaa\xc67@\xb1l@\x89\x94\x8e\xe1H\x97q5\xac\xb5\xc1bO\xf3\xc1y\f\xac\aȻs\x94PG\xd4K^\xc8\x11ǻWPIf!\x0178\x80\x87U\x8f\x15\xb85p\xa3\t\x02\xfa\x80\x84\xb6\xcb5!+\xdb{s0\xb0;K\f\"\x06\xa8q\xd1T\x92\x90\x1b\f\f\x01KW[\xfd\xcf^6\tb\xa2\xd4(\x16\xfc\xb4e\fV\x19\xd8(\x13\xf1\r([\x8d$\xb7j\a\x01\x13\x82\xd1\x1e\xc9K\x0fhl\xc7G\x17\x10\xb4]\xbb94̞\xe6\xb3Y\xady(\xb3ҵm\xb4\x9aw\xb3T1z\x15\xd9\x05\x9aU\xb8A3#]\xe7*\x94\x8df,9\x06\x9c)\xaf\xf3\xe4\x88\x15\xf7\xa9h\xab\xefB_\x98\xf4B-\xef$!\x89\x83\xb6\xf5\xd1E\xaa\x8eW\x84G\xea\xa5ˮNT\x87\xc9!\n\xda\xd6)^\x8b\xf7\xcb\xcf0X\xd2E\xaaO\xb1=+\x9d\x8b\x8f\xa0\xa9\xed\x1aC\xf7.\xa5\xa9\xc8D[y\xa7-'\x05\xa5\xd1h\x19(\xaeZ\xcd4亄n,\xf6.\xb5\"X!D_)\xc6j\xcc\xf0`\xe1N\xb5h\xee\x14\xe1\xff\x1c+\x89\n\xe5\x12\x84\x9b\xa2u\xdc`\x0f\x7f\x1ds\a\xef\xd1\xc5\xd0\x1eτv\xd42\x96\x1eK\t\xac`+/\xf5Z\x97]I\xad]\x00u\xe8 =\xd2/\x81\x9a\xee\x00rX\x85\x1ayL\x1d\xd9\xf291\x89\xfam\xa3^6\xac\x1f\xb0\xa8\v0\xae\xa6ސ\xae\x1f\xfd8\x0e\xd4%\x1b\xa6\x13}Ғ!\xbf\x05\x06\xc1U\x1a\x8a4\xbbc\x9bNU\xcbA\x1b\xdbi\x059\xfc\x91l~tuvryt\x7f\xe7,K]\\d\xfa\xeaLlqi\x95\xa7\xc6]\xe1}`l\xff\xf4\x18R\x1c/\xb3\x0e\xd3|?\xfa.0FsV\xef\x02e\x82\xe0yO{\x86\x9b\xa4\xdc`S\xcfy\x93\xa3wˇ\xd7@x\x86\xfd\x15Az\xb0kG\x97\r?0^\x94\xb7|\xd6\xdec%n^\x11x\x1f\xf4\x9a\x17\xe8]\xb8\x02\xd9S\xc0\x8d\xc6\xed-\xac\x1f\x95\xf7\xda\xd6\x17Xϴ\xab\xe1\xa4]\xe7z\xedɶ4Ԟ<\x91ړ\xdf\x1f\xe2\n\x83EF:L\x94\xad\xe6fR\"\xc0\xb6\xd1e\x93fD*\\\x19VD\xae\xd4S\xad\xff\x06\xf3\xa5\xdf\xe9\x80\x13\xcd#OMe\x82,Ɵ\x90\xcft\xe9s\n\xf2\xbesf7\xc8 V\x1cG]\xefb\xafO\xfc\x03\xd4e\f!\x8dҎ*\x1b\xd4\xf8A\x91\xdd\xd6he6}\xc0\xdd<\xbb\x18瓥B\xfeﻧ\x83Q+E\xf8\xee\x97\x1cm\xe9*\xac\x92`x\xc6\x1dTX\x86\x9d߯\x19\x1dFi\x1d\x85m\x83\x164\x7fO\x8061a\x05\xab݄*\xd9\x17\xfa\xb5\xb7\xdfw\xc1\xb8n\xd8\x15\xf0\xc0\xe0\xac\xd9+\xa2~\ay\xb1\xefސ7ì\xf8\xb2x\xbc\x82\xc6\x00\xf5\x97ţ\xac\xa4\xac\xb4\x15\xa5\b>`N\xba\xb6X\x81\xdc\xc9\xf4;\xb8|\"\x13^o#~\xf3\xba\x9b\rWL|\xbfg\x94\xf0$\x9c\x13*\xa3,\xe9\x04\"ɂ\f\xa5\xb2'BA\x96\xb0\n\rv\xa1I^Ҏ\x18\xdbS\xbb\xd7.\xb4\x8a\xe7\x12y\xccYO\x14\x94\x8dƨ\x95\xc19p\x88\xf8\x1a\xc7}\xa3\b\xaf\xf8\xfc$<S%\xb2oK#\xef\x8b춍 \x87O\xb8\x9d\xa0>\x05W\"\x11V\xb7{2\xd9\x0eN\x88$kuu\x84R\x9f\xf4s\xe0\x101\xfbo\x00\xe3>&\x1a\xfc\x0f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Zݏ\x1b\xb7\x11\x7f\xd7_1\xb8<$\x01\xbcR\xe3\xb6A\xa17\xfb\xdc\x14\xd7&\xee\xc1:\xfb%\xc8\xc3h9\x92\x98\xdb%Y\x92\xab\xb3\x9a\xe6\x7f/\x86\x1f\xd2~I:\xc9F|\xbb\x80\xb5\xfc\x98\xf9q8_\x1c\xba(\x8a\t\x1a\xf9\x81\xac\x93Z\xcd\x01\x8d\xa4\x8f\x9e\x14\x7f\xb9\xe9\xe3\xdf\xdcT\xea\xd9\xf6\xbbɣTb\x0e\xb7\x8d\xf3\xba~GN7\xb6\xa47\xb4\x92Jz\xa9դ&\x8f\x02=\xce'\x00\xa8\x94\xf6\xc8͎?\x01J\xad\xbc\xd5UE\xb6X\x93\x9a>6KZ6\xb2\x12d\x03\xf1\xccz\xfb\xa7\xe9w\xdfO\xff:\x01PX\xd3\x1c\x8c\x16[]55-\xb1|l\x8c\x9bn\xa9\"\xab\xa7RO\x9c\xa1\x92i\xaf\xadn\xcc\x1c\x0e\x1dqn\xe2\x1b1\xdfk\xf1!\x90y\x1dȄ\x9eJ:\xff\xaf\xb1\xde\x1f\xa5\xf3a\x84\xa9\x1a\x8b\xd5\x10D\xe8tR\xad\x9b\n\xed\xa0{\x02\xe0Jmh\x0eo\xb1&g\xb0$1\x01HK\f\xb0\n@!\x82а\xba\xb7Ry\xb2\xb7L!\v\xab\x00A\xae\xb4\xd2\xf0\x90\x80\x1e\"@\x88\b\xc1y\xf4\x8d\x03ה\x1b@\ao\xe9iv\xa7\xee\xad^[r\x11\x1e\xc0\xafN\xab{\xf4\x9b9L\xe3\xf0\xa9٠\xa3\xd4\xcb\"\x9a\xc3\"t\xa4&\xbfc\xd0\xce[\xa9\xd6c0\x1edM\xf0\xb4!\x05~#\x1d\xc4\x1d\x81't\f\xc7z\x12G\x19\x87~\x9e\xee<\xd6&\r\x8b\bn-\xe1aj\x84 \xd0\xd3\x18\x80\xbd<A\xaf\xc0o\x88%\x1f\x14\v\xa5\x92j\x1d\x9a\xa2\xb6\x80װ\xa4\x00\x91\x044f\x04\x99\xa1rj\xb4\x98\xaaL4\x8d\xe1\xef\x16\xabgʆ\xc7\x7fnT\xa9\x9b\x7f\x06\x1d\xb8\x02\xcaE|\xe3\xe0\xd4\x19\xb9~h7\x9dc\xfc\xb0\xa1\x00.3oL\xa5Q\x90e\xf6\x1bT\xa2\"`\xf7\x00ޢr+\xb2G`\xe4i\x0f;\xd3\x05\xf3>\xd3k\xf5\\\"\x8cd;\v\xaf-\xae\t~\xd4epP\xacҖ::\xed6\xba\xa9\x04,3\x17\x00\xe7\xb5\x1dUpް8+\xd1\xcdd{v\xd6\xe5y\x1c}\x8bv\xf6\xa7ӒmDj5nA\xaf\xd64n=\xb1{\xfb]\xf8p\xe5\x86\xea\xe0\x9a\xf9K\x1bR\xaf\xee\xef>\xfcy\xd1i\x060V\x1b\xb2^f\xf7\x19\x9fVph\xb5BW\xd4\xff+:}\x00\xcc \xce\x02\xc1Q\x82\\\xd4\xc9\xd8F\"a\x8a\xdb#\x1dX2\x96\x1c\xa9\x187\xb8\x19\x15\xe8\xe5\xafT\xfai\x8f\xf4\x82,\xfbӼQ\xa5V[\xb2\x1e,\x95z\xad\xe4\x7f\xf7\xb4\x1d\xeb\x1e3\xadГ\xf3\x10\\\xad\xc2\n\xb6X5\xf4\x02P\x89I\x870Ը\x03K\xcc\x13\x1aբ\x17&\xb8>\x8e\x9f\xb4%\x90j\xa5\xe7\xb0\xf1\u07b8\xf9l\xb6\x96>\x87\xccR\xd7u\xa3\xa4\xdf\xcd\xd8\x1dX\xb9l\xbc\xb6n&hK\xd5\xcc\xc9u\x81\xb6\xdcHO\xa5o,\xcd\xd0\xc8\",D\xf1\xf2ݴ\x16_\xd9\x14d\xb3K?\xa25\xf1\r\x91\xee\x82\xed\xe1\xd8\a\xd2\x01&RQ&\x87]Ⱦ\xeb\xdd\xdf\x17\x0f\x90\x91D3\x89\x9br\x18\xea\x8e\xed\x0fKS\xaa\x15\xfb\x00\x9e\xb7\xb2\xba\x0e:@J\x18-\x95\x0f\x1fe%IypͲ\x96\x9e\xd5\xe0?\r9\xcf[\xd7'{\x1b\xd2\n\xf6\xa1\x8da5\x17\xfd\x01w\nn\xb1\xa6\xea\x16\x1d\xfd\xc1{Ż\xe2\nބg\xedV;Y:\xfc\xc5\xc1Q\xbc\xad\x8e\x9c\xea\x1c\xd9\xda^\xfe\xb20T\xf2Ʋly\xa6\\\xc9\xe4\xe9V\xda\x02\xf6ӝ\xae\x9c\xc6\x1d\x00?\xa3^\xae?\xe8\x9c\xd2\xf1\xf3z\x8cP\x06\xacZ\x0e;{\xe3䰫4t\x84dv\xe1\xfb9\x96\x8cv\xd2k\xbbc\xc2\xd1{\xf7\x15\xe2\xe8\xde𫴠3\x8b{\xab\x05\x8d\xc1\xe6\xa9\xe07\x18\xb5\x9b\x937vn\x8dRC.\xfcju\x110\xa3\xc5\x19\\\x89#\x82\xa5\x15YRl\xb5\xfalf2\xa0\t\x9d\x9ca\x88\U0007899c\n\x19\xa3\x88_\xdd\xdf尐\x85\x98\xb0\x0f<\xffY\xf9\xf0\xbb\x92T\x89\x10E\xcf\xf3\x1eUQ~\xefVQ\x80̃\x05\x88`$\x95ԉK \x95\xf3\x84\"5\xb2;\xb0\x94\xfa^D\x9fw\x14$\xbf\x87\xf8\xe5Q*@\xf6\xc1R\xc0?\x17\xff~;\xfb\x87\x8e\xeb\x00,KrL\b=դ\xfc\x8b}\xde/\xc8IK\x82\xb3x\x9a֨䊜\x9f&jd\xdd\xcf/\x7f\x19\x97\x1f\xc0\x0f\xda\x02}\xc4\xdaT\xf4\x02d\x94\xf9ޭg\xb5a\xe5\xe6\x85\xef)\u0093\xf4\x9b\x00\xd4h\x91\x16\xf8\x14\x96\xe0\xf1\x91@\xa7%4\x04\x95|\x1c\xb1\x9f\xf8ްWj\xc1\xfc\x8d\xad\xe7\xf7\x1b\xf8&\x9a\xf1\r\x7f\xdeD\x18\xfb\x00\xde6\xb0\x03\x9cheV\xae\xd7tH\xcf\xfa\x7f<\x85\xb6\xa4\xfc\xb7\xa0-\xafU\xe9\x16\x89@\x98}D\xf4\x94$\x06\xf0~~\xf9\xcb\r|s\x98\xc128\xc2J*A\x1f\xe1%\xc8tF2Z|;\x85\x87\xa0\a;\xe5\xf1#\xfb\x8br\xa3\x1d)Ъ\xda\xf1\xea6\xb8%p\x9a\xcfVTUEL\x95\x04<\xe1\x0e\xf4\xea\b\x9f\xbcE\xac\x9a\b\x06\xad\xef\xa8\xe5UF3\xcc\x1f.\xb3\x97\x90O<\xcbz\xbfX,~\xa6$X%>E\x12\xedC\xc7\x15\x92\xe0ڈU\xe4)\xd4]\x84.\x1d\xe7\x8f%\x19\xeffzKv+\xe9i\xf6\xa4\xed\xa3T낕\xb1\x88\x86\xebf\f\xdc;\n\xff\\\xbb\xf0p\xea\xfd\xd4\xd5wN\xe9\x7f\xbc\b\x98\xbb\x9b]#\x81\x9c\xe7>?v\x1d\x95\xc3\"\xa5^}\x9al\xf3O\x1bYn\xf2\xa9\xa7\xe5mk\x14\xd1\x1d\xa3\xda}!\xdba97\x96\x11\xed\x8aT\xb4+P\t\xfe\xed\xa4\xf3\xdc~\x8d`\x1b\xf9I\xce\xe5\xfdݛ/iQ\x8d\xbcƓ\x1c\xc9\xe6\xe3\xfb\xb18\xa0*j4E\x1c\x8d^ײ\xec\x8d\xe6l\xf6N\xf0&\xad$\xd9\xf9\xe4\xa4\f\xdfu\x06\xe7\x04u$/ޏ\x99N.X\x96\xc7\xf5H\xc2\u05eeg\x9eJ\vO\xca\xeb\xbc*<\xe0\xda\x01Z\x02\x84\x1a\rk\xc4#튘q\x18\x94\x96\u05ca>\xa7UK\x024\xa6\x92$R\x161B1\xe5\xbfI<\xe8\xc2\xfa\xa6\x97le\xaeW-\xc8{\xa9\xbe\xa0p\xde\xf7\x80|^A\xe5er괒\xebƆ\xb3\xd8PR\xaa\xa9*\\V4\ao\x1b\xbaF\x90\\ޛ\x9f^\x7f^*\x0f\xcd\x1a~\xa6\xf48\xbe\xaaNAr\xb8\x18RM=\x84R\xc0\xa36\x12G\xda-9?\xb0^\x9eps3\xb9`\xb7\xa3RίЁtM \xdd iN\x8a\x9e\x12\xf8|2mW\x86Gȍ\x9d\xfb\x8e\xe2\xe6\xc2\r\x1fG\xba\xb8\vX\x8e\x9d\xf7{c\xf8\xcc\xdck2Z\xf4Z\xban\xb0\xd7٩^\x9f\xd45>H5=\x03<YO\t㳚\xc5\xe0\xe8\xf3\x15\x8c^]_Q)5\x1f\xbf:\x95\xddk\xf6\xfcvH&TB\xadH\x86\xc1\xf76\x98#\x00\xdf\xd7$\xc6c%\x916\xb98\x93\x8b\x17\x81\x1a\x89p\x8c\xe2S\xde\neE\"\x91t\x97RYҊ\xeb\xa6\xd1Hs!\"\xc1;~\x80\xe1\xeb\x05\x17\xea\xbe_\xbb=\xcdƑ\be\xad\x11!\f#\xf6J\xdb\x1a},\x91\x17L\xe2:\xef5j\xb359\x87\xebsF\xfbS\x1c\xc5\xe2\xc0<\x05p\xa9\x1b\xbf/\xd0t\"\xd2\xd7.)\xda\xf4\x12,f\xb4\xf4\xd1\x01\xc2Ց\xacҫ\xa6\xaa\u009c|\xbcχ\xecxa\x1b\xaeٖ4d\x93\xab\x82G\nD\xa7\x00\xf2M\xe49\x84<f\xcc\xea\xf6.\xed\xa4ٝr\xdfo\xe9i\xa4up\x83zx\x8a\xac_#^\xb2\x80\x1f\x825\\\xb4\xfe\xc4\xe8\x1as\xcf a\xa3\xabl\xe1\xdac\x05\xaa\xa9\x97dY8˝'\xd7s\xfc\xa8D[\x92#\x84[\xf3\xf3\xa6FJ\xa9\x82Q\xa2\xe2`\x11L\xcek\x10ҙ\nw\xfb\xb5\x84\x9c\xdb\xd6C\uf792\xa0\xbd\x92gK7t,\x878]Z\f\x98\xdeh5\xa2@m#\x97\xca\x7f\xff\x97\xd1\x11Q1\xf9.h\xdd\v#\xa9\x9f\xc5\xf9z\xe7\xc7\xd9\x7f:\x87\x139\x90Sh\xdcF\xfb\xbb7gTc\xb1\x1f\x98MD\xee##\x03\f\x92\xceԒ*\f(B\xcb\xe1L/\xd1\xdf\xee\x85\xfe5Z\xbc\xe8P8\x13\xaf\xd2\xff/\x18B\x04X\x90A\xcb>!\xdc-\xdd\xf6oJ_\x80\x93|\xb6\x0e\xd9nL\x7f\xcb\r\xaa\xf5h}D+>\xab\xf3]\x81\xbb<\x00u\x17\xe4&ǔ\xe6\xf3ǞQu\x1a4\x86\xd0)Z\xb4ӵJ\xbb\xa5Y\xe6Z\x85\x9b\xc3o\xbfO\xfe?\x00\x8fTl=\x19$\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y_\x93۶\x11\x7fקع<$\x991\xa9\xdam3\x1d\xbd\xd9\xe7\xa6sm\xe2\xdeXg\xbfd\xf2\xb0\"V\x14r$\x80\x02\xa0tj\x9a\xef\xdeY\x80\x90H\x91\x92NrbK\x9a\xb9#\xb0\xd8\xfda\xffa\xb1̲l\x82F~$\xeb\xa4V3@#\xe9ɓ\xe2'\x97?\xfe\xcd\xe5RO\xd7/'\x8fR\x89\x19\xdc6\xce\xeb\xfa=9\xdd\u0602\xde\xd2R*\xe9\xa5V\x93\x9a<\n\xf48\x9b\x00\xa0R\xda#\x0f;~\x04(\xb4\xf2VW\x15٬$\x95?6\vZ4\xb2\x12d\x03\xf3$z\xfd\xa7\xfc\xe5w\xf9_'\x00\nk\x9a\x81\xd1b\xad\xab\xa6&K\xcekK._SEV\xe7RO\x9c\xa1\x82\x99\x97V7f\x06\xfb\x89\xb8\xb8\x15\x1cA\xdfk\xf11\xf0y\x1f\xf9\x84\xa9J:\xff\xaf\xd1\xe9\x1f\xa4\xf3\x81\xc4T\x8d\xc5j\x04G\x98uR\x95M\x85v8?\x01p\x8564\x83wX\x933X\x90\x98\x00\xb4\xfb\f\xd02@!\x82氺\xb7Ry\xb2\xb7\xcc\"i,\x03A\xae\xb0\xd20I\x87\x0f\xe8%\xf8\x15\xb1ȠU\x94J\xaa2\fEU\x81װ h\x91\xb0X\xfe\xfeⴺG\xbf\x9aAΊˍ\x16\xb9J<[\x1a~\xeeHjG\xfd\x96\xf7ἕ\xaa<\x86\xecw\x06\xd5NG<\xf7Z<\x13\xc9Ê\x02MBӘJ\xa3 \xcb\x1aY\xa1\x12\x15\x01;(x\x8b\xca-\xc9\x1eA\x91\x96=l\r\xb5$\x11ɇį3s\x89v.QE\xa4m'\xa3\xf8\x8fݡsr\xef\xb5h\x17@\xeb\xd4\xe0<\xfaƁk\x8a\x15\xa0\x83w\xb4\x99ީ{\xabKK\u038d\xc0\b\xe4\xb9Y\xa1\xeb㘇\x89?\x16\xc7R\xdb\x1a\xfd\f\xa4\xf2\xdf\xfd\xe58\xb6vQ\xee\xb5\xc7\xea\xcd֓\xeb!}8\x1c\x8eZ\xe3`+\xc9~9\xb8\vF\xfaV\xab\xbe^\xdf\x1c\x8c\x8e\x81\xed0M\xf96/,\x85T\xfb kr\x1ek\xd3\xe3\xfa\xba\xec\xf3\x13\xe8\xe3@\x14\xba~\x19\x1e\\\xb1\xa2:\xa4n~҆\xd4\xeb\xfb\xbb\x8f\x7f\x9e\xf7\x86\x01\x8cՆ\xac\x97)\xbb\xc6o\xe7\xf0\xe8\x8cB_\xb3\xff\xcbzs\x00, \xae\x02\xc1\xa7\b\xb9\x98/\xe2\x18\x89\x16S\f\x1e\xe9\xc0\x92\xb1\xe4H\xc5s\x85\x87Q\x81^\xfcB\x85\xcf\x0fX\xcf\xc9r\xaa\x05\xb7\xd2M\x152Қ\xac\aK\x85.\x95\xfc\uf3b7\xe3Xd\xa1\x15zr\x9e\xcdGVa\x05k\xac\x1az\x01\xa8Ĥ\xc7\x18j܂%\x96\t\x8d\xea\xf0\v\v\xdc!\x8e\x1f\xd9ݥZ\xea\x19\xac\xbc7n6\x9d\x96ҧ#\xb5\xd0u\xdd(\xe9\xb7SN\x99V.\x1a\xaf\xad\x9b\nZS5u\xb2\xcc\xd0\x16+\xe9\xa9\xf0\x8d\xa5)\x1a\x99\x85\x8d(\u07be\xcbk\xf1\x95m\x0f\xe1\xe4\x85G\"2\xfe\xc2Ax\x81y\xf8d\x04\xe9\x00[VQ'{+\xa4\xfc\xfe\xfe\xef\xf3\aHH\xa2\xa5\xa2Q\xf6\xa4\xee\x98}X\x9bR-9C\xf3\xba\xa5\xd5u\xf0\x01R\xc2h\xa9|x(*Iʃk\x16\xb5\xf4\xec\x06\xffi\xc8y6\xdd!\xdb\xdbPv\xf09\xd3\x18vsqHp\xa7\xe0\x16k\xaan\xd1\xd1g\xb6\x15[\xc5el\x84gY\xab[L\xed?\x918\xaa\xb73\x91*\xa1#\xa6=\xacn\xe6\x86\n\xb6,+\x97\x97ʥ,bL-\xb5\x05\x1cTC}M\x8d\xa7\x00\xfe.\xb0xl\xcc\xdck\x8b%\xfd\xa0#\xcfC\xa2sn\xc7\xdf7c\x8c\x12b\xd59P\xa3D`\x94X\x12T-\xe9\b\xcb͊,u\xd7X2\xdaI\xaf\xed\x96\x193\x87\xa1\xbb\x1c\xb5\x0e\xff\x8c\x16g\xf6\xc6gI\b KK\xb2\xa4\nJ\xe9\xe6T\x994\xe0\t\xddja\b\xf1\xb8=N\xa5\xe6Q\xc0\xaf\xef\xefR\xfaM\x1an\xa1\x0f2\xecY\xf5\xf0o)\xa9\x12\xe1\xb4:/{\xd4\x11\xf8w\xb7\x8c X\x06\xeb\x0f\xc1H*\xa8\x97\xffA*\xe7\tE;\xc8ag\xa9\x9d{\x11s\xcbQ\x90\xfc۟\x13\x1e\xa5\x02\xe4\\'\x05\xfcs\xfe\xefw\xd3\x7f\xe8\xb8\x0f\xc0\xa2 ǌ\xd0SMʿؕ\x04\x82\x9c\xb4$\xb8.\xa2\xbcF%\x97\xe4|\xder#\xeb~z\xf5\xf3\xb8\xfe\x00\xbe\xd7\x16\xe8\tkS\xd1\v\x90Q\xe7\xbb\xf4\x99\xbc\x86=\x9f7\xbe\xe3\b\x1b\xe9W\x01\xa8Ѣ\xdd\xe0&l\xc1\xe3#\x81n\xb7\xd0\x10T\xf2\x91\xc6-\x0fp\xc3\xc1߁\xf9+\x87\xd6o7\xf0M\f\x96\x1b~\xbc\x890v\ae7\xfa\xf6p\xfc\n=x+˒\xf6\x15\xedᇗК\x94\xff\x16\xb4\xe5\xbd*\xdda\x11\x18s$ƄDb\x00\xef\xa7W?\xdf\xc07\xfb\x15\xac\x83#\xa2\xa4\x12\xf4\x04\xaf@\xaa\xa8\x1b\xa3ŷ9<\xf0\xbfn\xab<>q\xcc\x17+\xedH\x81VՖw\xb7\xc25\x81\xd35\xc1\x86\xaa*\x8b%\x89\x80\rnA/\x8f\xc8I&b\xd7D0h}\xcf-\xaf\n\x9a\xe19}Y\xbc\x84s\xfbY\xd1\xfb\xc5μgj\x82]\xe2S4ѽz]\xa1\t\xeeQXE\x9eB\xffC\xe8\xc2q\x9dV\x90\xf1n\xaa\xd7dג6Ӎ\xb6\x8fR\x95\x19;c\x16\x03\xd7M\x19\xb8\x9b~\x15\xfe\\\xbb\xf1p\x03\xff\xd4\xdd\xf7\x1a\x06\x9f_\x05,\xddM\xaf\xd1@\xaa'\x9f\x7fv\x1d\xd5ü\xadp\x0eyr\xccoV\xb2X\xa5\xdbE'\xdb\xd6(b:F\xb5\xfdB\xb1\xc3zn,#\xdafm\xf3,C%\xf8\x7f'\x9d\xe7\xf1k\x14\xdb\xc8OJ.\x1f\xee\xde~Ɉj\xe45\x99\xe4H\xd5\x1c\x7fO\xd9\x1eUV\xa3\xc9\"5z]\xcb‚k\xc6;\xc1FZJ\xb2\xb3\xc9I\x1d\xbe\xef\x11\xa7\xeau\xa4\xfa\xdc\xd1\xe4\x93\v\xb6\xe5\x14\x1a\xb7\xd2\xfe\xee\xed\x19\x1c\xf3\x1da°\xb7a[t&^\a\x9d\xa9\xcb\xf0\x84\xd8\xda%\x9ds\xa0\xfa\xd4\t\x99\xb6\xb2\x94\n\xab}\x06\xe4\xce\n?a\xb7#\xd9\xfd\xd4h\x8cT\xe5EXS\x83oN\xdeKU\x8e\x14\xce\xdd\xd6\xec\xa9\xf2\xfa\x84\x90\xe7\x84ԇ\x03 \x80\x96\x00\xa1F\xc3\x16z\xa4m\x16\xab8\x83Ҳ\x86ЧRuA\x80\xc6T\x92D[\x99\x8dpO\xdb\xe4*k)\xcbƆ\xcb\xd1PS\xaa\xa9*\\T4\x03o\x1b\xba$|\x92\x04\xee\x87\xceN\xef?m\x95I\x93\xb9\xcf\xf4j\xc7w\xd5\xeb\xe0\x0e7C\xaa\xa9\x87P2x\xd4F\xe2\xc88_\xac\x06\x81\xce\vnn&\x17X;F\xd2\x19\x1d\xb4\x8dE\xe9\x06\xa5t\x1b\x88mY\xcf\xfa\xe0\xcbc\b\xc7\x01K\xb8&@\xb9k\xc2w\x94>\xc2\f\x16cW\xed\x03\x1a\xa3\xc5\xc1H?\x11\x1eL\xee3\xd3\xe1D?\xe8\x0ff{\r\uf4de\xc77\xb0\xe6 \x1cO7<\u0082\xe4u\xf1X\xf5\xa9\xaf\xab\x97\x9f\xd0\xf2(4\xdf\xdcz\xcd\xd73>0\x9a\an\x87lB\xb3Ҋ6Pd\xcdy\xa1\xb5;l\xd0%\xc9cN\xd0\xe5\x17\x97\x86\xeei\xa1\xad \x11\xae`|C\\\xa2\xacH$\x9e\x83\x16\x1d\xff\xf8}\x8a\v\xadԯݎQ\xe3H\x84\xac<\x02zx8\xa7\xc68\xb7\xe32fq]\xf6\x19\x8d\xb9\x9a\x9c\xc3\xf2\\\xd0\xfd\x18\xa9\xd8\xfa\x98\x96\x00.t\xe3w\xad\x986\xfaZU|\xedZ\xd7\xc8/\x01\x13^\x93\x9c\x81r\xcf4cn\xb8\xcb\x03\xa7\xfd\xf0T~{G\x9b\x91\xd1\xc1\x8b\x8a\xfd7K^2ra\xcf\xe0\xfb\xe0\x1d\x17)\xa0\x15t\x8d\xff'\x90\xb0\xd2Ury~u\x03\xaa\xa9\x17dY;\xe1\x95IRӮ`A%\xba\xca\x1ca\xbd\xe7КWDVm;\xa0@\xc5\xed\xb5\xe0\xd4^\x83\x90\xceT\xb8\xddm&\x14\xb0\xb6\x1efŶLعQ\xcb\x1c\xb8X8r̞n\xd4\xed^\t\x8dM\x8e\xbf`\xea\x7f\x86o\x8b\xfa\x9f\xfd+\xb2?F\u00892\xc1y\xb4~\x97$\xaeq\x90y\x8fù\xdc\x18䑸<\xa5\xf5\xc5|\xcel6\xaa\xbd\xc1`@.:\xbc\xdb\xceww\xa4Y\xa4\x8b\xae\x9b\xc1\xaf\xbfM\xfe?\x00U\x18\x13\xf6\xde!\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec\xbdms\x1b\xb7\xb2 \xfc\x9d\xbf\x02\xe5\xe7V9I\x91\xb4\x9ds\x9f\xb3\xf7\xea\xcb)Gr\x12ݓc+\x92cWm6\xbb\x05\u0380$\x8e\x87\xc0\x04\xc0Hf\xf6\xee\x7f\xdf\xea\xc6\xcb`f0/\x1cIN\xb2\xd7&\xab\x12q0\r\xa0\xbb\xd1\xe874V\xabՂ\x96\xfc\x1dS\x9aKqFh\xc9\xd9G\xc3\x04\xfc\xa5\xd7\x1f\xfeM\xaf\xb9|v\xfbb\xf1\x81\x8b\xfc\x8c\x9cW\xda\xc8\xc35ӲR\x19\xbb`[.\xb8\xe1R,\x0e\xccМ\x1az\xb6 \x84\n!\r\x85\x9f5\xfcIH&\x85Q\xb2(\x98Z\xed\x98X\x7f\xa86lS\xf1\"g\n\x81\xfb\xaeo\x9f\xaf_\xfcu\xfd\xff/\b\x11\xf4\xc0Έb\xdaH\xc5\xf4\xfa\x96\x15L\xc95\x97\v]\xb2\f`\ue52c\xca3R?\xb0\xef\xb8\xfe\xecX\xaf\xed\xeb\xf8K\xc1\xb5\xf9{\xfc\xeb\x0f\\\x1b|R\x16\x95\xa2E\xdd\x19\xfe\xa8\xb9\xd8U\x05U\xe1\xe7\x05!:\x93%;#\xaf\xe9\x81\xe9\x92f,_\x10\u218eݮܨo_X\x10ٞ\x1d\x10\x1d\xf0\x97,\x99xyu\xf9\xee/7\x8d\x9f\tə\xce\x14/\x01Yg\xe4?W\xe1w\xe2\aJ\xb8&\x94\xbcÉ\xc2h\x10\xf1\xc4\xec\xa9!\x8a\x95\x8ai&\x8c&f\xcf\b-˂g\x88w\"\xb7\x11$\xff\x96&[%\x0f5\xb4\r\xcd>T%1\x92Pb\xa8\xda1C\xfe^m\x98\x12\xcc0M\xb2\xa2҆\xa9u\x00T*Y2e\xb8ǲ\xfdD\xbc\x13\xfd:41\xf8\x00.\xec[$\a&bv\n\x0e\x9f,w\xe8#rK̞\xebz\xaa~z\x84\n\"7\xffd\x99\xa9\ah?7L\x01\x18\xa2\xf7\xb2*r\xe0\xbd[\xa6\x00Y\x99\xdc\t\xfe[\x80\xada\xe2\xd0iA\rӆpa\x98\x12\xb4 \xb7\xb4\xa8ؒP\x91\xb7 \x1f\xe8\x91(\x06}\x92JD\xf0\xf0\x05\xdd\x1e\xc7?\x90xb+\xcf\xc8ޘR\x9f={\xb6\xe3Ư\xa8L\x1e\x0e\x95\xe0\xe6\xf8\f\x17\a\xdfTF*\xfd,g\xb7\xacx\xa6\xf9nEU\xb6\xe7\x86e\xa6R\xec\x19-\xf9\n'\"`\xfaz}\xc8\xff\xbf@\xd4F\xb7\xe6\b<\xaa\x8d\xe2b\x17=\xc0\x05q\x02y`\xa9XƳ\xa0,Nj*p\xb1Cz]\xbf\xbay\x1b3%\u05ce(uS\xddG\x1f\xc0&\x17[\xa6,\x85\x915\x01&\x13y)\xb90\xd8AVp&\f\xd1\xd5\xe6\xc0\r\xb0\xc1\xaf\x15\xd3\xc0\xef\xb2\r\xf6\x1c\xa5\x0e\xd90R\x9595,o7\xb8\x14\xe4\x9c\x1eXqN5\xfbĴ\x02\xaa\xe8\x15\x10a\x12\xb5bYZ\xff\x03 g\x0e\xbd\xd1\x03/\x11{H\xeb\xa4\xc8Mɲ\xc6J\x83\xd7\xf8\u058b\x8b\xadT\r!\x03\x82\xa7\x89\xa3\xf4⇏\x95\" \x16\xdbOƸ\f>߄\xb7\x81߀\xe4\x95\xe0\xbfV\f\x85\xa9]\xfe\xac+\xafj\xa9\xdc\xfe\alԦn/\xa2ᛳ\x92\x89\x9c\x89\xec\xf8\x9ers.EΣ\x9d\xeb\xb4\xc9\\\xf4\xc0\"T\xc1\xea`$\xab\x7f\x82?\xdd4r\xc2\r;h?\xdb\x1d\xbfe\"\xac*M\x0e\x95۪\x9a\x9f\x03c\x86l\xd8V:\xd8\x16\x86\x9d\x0e\xacO)\xa0\xcb\x03\xf6\xed;Z\x92\xbb=\x13D\xaa\x9c\x01*\xc8\xe6Xϟ\xb3\xceR%v`]TLAF/:\xbc`\xa1\xa6\xd25Fz\x10Bk\xf1\x02x\x88g\x9d\xecs*&\xbaS\x1d\xe2q\xfb\tcM?n!\xa51_\x18\x160\xa1\xa7qg\xf6\xf6\xf7\x1e\xb8\x8e\x0e\xe4nϳ=\xf2\x03ȹ\xb7\n\xb6)\xb6ޭ\xc9\xcb[\xca\v\xba):\x82m\xc2\x02h\xea\b\x93\xa6\xe6\xf5??\xb3x\xad\x06r\xb9\xbfq\xe4v\x98=\xa0\x01xY\xc8\xe3\x014\x995-K={\x16\x86\x1f\x98\xac̤I\xf40-|\xdfZ00\xbd\xbd\xbc#\x85\x84\xedN\x92;\xca\r\x8a\xca\xc6R^ƜKv\xd2q\xdc\x1d7{B\xc9\x1dU\x02~\xa1[\xc3\x14\xe1\x1dm\xa5\xfe\\\xb0-\xad\n\x13Ԓ\x80H7\xa9\xc0:\xb8\x7f\xf6\xc1\x11U\x81\x8cpF\x8c\xaa\xd8<<\xc2.\xcb\x15ki\f\xf6\xbb\xaa'\x9e|\xeaG\x9dxس\x81M\x1a\xb7}\x97*E\x8f\xadgL)\xa9\xbe\xa9\xf2\x1dK\x90}\x9c\xe0\xaf\xea\xd7=7\x1f\xa46\xcd\x05G\x8fdKy\x01\x94\xd9\xd4\"\xe4\f\t\x0f\x0f\x82\xc0\xa2I\xa9\xf4kE\x15\x05\xa5\x89\xe5\xa0U\xb6\xf8\x85i\"ŒT\xc2\xf0\x82\x1c@\x9a\xe3\x92\xc1\x1e\x83Ď_\x01\xf1\xb9\x91ʰ\xb6~\n_\x80\xcfD\xae\t\xd5\xe4[\x84\xb0&\xafya\x994\xb7,\xb6$\aF\x85&B\x92\x82\x1fR<y\xe0\x82\x1f\xaa\xc3\x19y>\x8fP\xa0K\xef\x98j=e\x1f\xb3\xa2\xca\xd9\x0ftÊ\x1bV\xb0\xccH5\x8bf\t8@<\x8a\x9a\xd3\xed\x8bu\xf3\xc9\xdd^jF\x0e\xd4d{X\x89\x96\x01\x9b\x8a\x983\xd2\xec\x02sj\x06UL<5\x1e\xeb\xf9\x920ؖ9\xb69Zp\xa4ّlO\x18>oT\xa3\x91^\x93\xcb-\x11@\x11!\xddX`\xec\x0e7\xf9\x9a\xbc\xc1\xa9\xd3b}*ꇷ/\x1c\xf0\xab\x8f`1\x06\x93\x95\x90A\xe4\xb7_\x81qR4\xa5A\x16\x150-\xa2\xfd\xe4\x9d\xd08\xa4T~\xffy\xbbg\x8dv\xa8\x9b\xbc|}\x91ގ\a\xb4\x8fi|\xe2Ĺ\x91:U\xc4?A\xab\x1at|ʅ\xb66\x8f^\x12J>\xb0#ڃht\x96LQ߸\xb7S\xc5Ъ\x04^\x81\xb7\xf1崙8\x8dzΌc\xc7\xfe\x87-\x8c@\xafN\xa0\xd9\xf9\xc3\x0f0f\xb7\x89\xb8)\xa3ӠeD\xb6?]c\xeb\x84\xcd$\xf8?\x10k\x93\x87?\xb0;\xc7\xf0\";\xd3\xd2\xe9)\x18\x89\x05Z5zϝsC3\xe4\xd8a\x02\xd8\xcf;Z\xf0<\x80\xb7\x1cz)\x96\xe4\xb54\xf0\x9fW\x1f9\x98\x9f@\xce\v\xc9\xf4ki\xf0\x97{\xe3\xc7\x0e\xed\xa1\xb0c\xa1!s\v\xbbi\xc2\xf4cSފ!\xe0\x84\x80I\xae\xc9%\x98\x06n\xaa\x83\x1d\xc0\x8b\xae\x13\v\xde\xeb\xa4B\x8a\x15;\x94昄\xef\xb0'U\x03y3\xbbrݼ\x05\xe7\x81}b\xfdD\x05\xf8\xe6H^\xe1d\xc1\xceP\u0530\x1d\xcf\x06{90\xb5c\xa4\x04\x817D\xcbA\x81t\x02\xb9\x87\x14\x9a\xf8\xdf\xc7Շ\xe0\x90[\x81\xe0]\xb9\xf7\x8c<\xf4\xcehH}\x83\xcf\n\xd6I\xef3O\xaf\x9e\x06\x83Jܔ\x89\x9d<%܅p\x0f\xed\xc1<ͭ>J\x8b\xabQ\x19:J\x9di\xcb,\x1a\x93S<h\tK\xec\x7f\xc3N\x81\v\xe3\xff\x90\x92r\xa5\xd7\xe4%:\x93\v\xd6x\xc6\xd16\x8f\xc1\xf4vTB\a@\xd1[Z\xc0\x8e\x05\x02M\x10V\xd8\xfdKn;\x1b\xfb\xd2)< \ufddc\x159\x00x\xf2\x81\x1d\x9f,\aL\xccx\x99>\xb9\x14O\x96ASm,\xbe\xb09JQ\x1c\xc9\x13|\xf6d}\xf2\xc6>\xc8E\x83\x0f\x1b\xecs\xa0\xe5\x10\xf7x\x9d*\xb8\xec\x13l1N\xefW\x1d(5\x16jmH\xd4Oq\x93\x05\x04\b\xd9\x1d?!\\Xx\x847\xd4\xfa\xf5b\xb2\xb0\x19d\xe2I\xfayjyzly\xe3\xfe^\xc8\n@\x9c\x86Up\xeb\x11\bF-\xe2\xebϋ*\xae\r\x17;?\xcb+Y\xf0\xec8\x82\xafWɗ\xbc#\x96\xe9x\x86d\xc3\xf6\xf4\x96'\xa5\xb0w@D\xa1\x9a\x80զ\x81:o\xc2Id\xed\x14\xcd+Z\xdcd\xb4\x98\xe5\xe6\xfd.z\x9fh\x80\xd2\xf2\x80^\xd4. R\x95\xbe\xbf\xe2\x88F\xf6\xf1i乳\x9e\x95\xb4$S\fC`\xces\xa8\r+Q\xe6\t\xdbe\x0e\x90A#`%\x81%\x8a\x9e\x95%\x91\x020\xb7g\\\xd5\xef\x03O*F\xf3\xe3\x92\x18\x99\xe8\xc86\aK\xd1B\xf5/zQ\x88\xb3\"\x99<\x94\x05R\xc8\xf7\x813\t\x83\xb1\xad\xa3\xa9'z\xa2\xa9\xa9'\xfb\xb6\xbe]\b\x82hf֧\x12\x7f\xd8\xfe\x00\x83^\xdd\xd2\"\xf5l\n\xfd\xe1s\xe9`\xa4\xdcj5\x15\x124t\x8e[\xa4\x86sx\x03\nA\xbf\xabJ\xb29.\x92\xdd!0\xc1>\x1a\x84\xd1\xc5\xc7\b\xc7\xc3\x17^\x9c0\xe3\x1b\x18\xa3\xb3\xb5Du\xd80\x05\xfc\x17\xe6aFi\xec\xe9\\s\xe9\xe6Xshz\xe8[\xa9\x0eԠ\xab\xe5/_'[\x04'\u038b\x81\xb9\xa7=5\xa3\xbe\xd4i\x14\x9f\xe2GM\x90\xdbK1$x\x13{d\xc3Ҥ\x82O\xc1\xb6\x06\xd0\x06\x91¬R\n\x14\xa4\x00\xbe\xcf\x1f;\xc5\xef\xda\xd3ߘ7vdō2`\xbf\"\xbfB\x9e>Es\xdaK\xf9!\xb1\xb2\x1bt\xfc\x1e\xda\xd4\x165\xc90\xd9#\xecEN\xb3qq\xf5\r#\xec#˪\xb4\x1bҙ_R\x91\x12\xbc\xa9N\x80\xadO\x94:\x9e\x14ɇ\x03\xbb\xfet\x0e\ri\x16~W\x06\x1c4\x82\xa5R00\x8a\xd11[\xb7U\xb2\xb2m{\x91B6T\xb3\x9c$\xdd\xe4\x8eZ\xc0l\x15\xec\x8460\x9bcl\xa1V$\x97\xf5\xfc\xad6\xde\xf4c\xa5yn\f\xa5\xd35\xe3\x1eT\xbe\xea\xbc\xdaRa\xea\t\f\x80\x04\xa7\x92\xf3\xaec\xf4\x1f\xd8\x13\xe1\x90\\2\xf0L\x1bLg9\xf6Mr\x94\xfc\x13\x96\xd7I\vuL%\xec\xe2\xd6s\xd4\xe9\xa8\rov\x95C\xf7\xbb\x91\x030\xc9\xff\xa3\x88\xe5\xa2\xcdy\x931;\xb0\xfe\xe1{)&\xf3t/\xdf:G*\xfa\xbd\xd05\xb5$\xdc21\x1f_\t\xb4(\xa2>\xfeĴ9\x9d\xe9'\x92fʚx$\u0084.\xfe\x84t)\xe2\xd8\xd3d\x9a4\"VK·\x01\xe9\xf9\x92lya\x98ja\x7f\x96\xa8\xf7\x94y\bdL\xd9\xf5\x82\xe7.\xf2\x96\r\xb7n\xe1唀\xd8\bܠ݁V\xabO\xf7\xa0\x9d\xc4y\xa7.\xba\xdf1pv\x9f\x10\xda\xe9\xdc0)\xacփ\xc3i\x01\xb6Ip\x89\x17G#\xa1\xb6\x93eIۻ;c\x9a\x93X\xe5QCr\x8f\x19\x9c\x9b\x8d\xd1\xf1\x80\xdd}\xf1\xf9\xe8A\xbc\t1\xb6\x87\x0f\xe7M\xe8\xf4A\x03{'\x87\xf8N\x16\xac\xb3\xd8g\xda\xee\xdd\x1b\xf8\x98\x1a\n\xac\xff\xf5\xfb\x12N\t\x0f\x9e\x10(\x9c䕘\x8f\x94{\xa0#\x8a\xba\x8da\xe3\x94\xd0\xe2,^8U4|\xb2\xc0\xe3\xef\x10\x82\xac?\x9f6\x18y2\xa7Nl\xd6`ёPe\xfd\x013\xf0l1\x91c\xe2\xbc\xf9:\t\xd7)\xd9\xeb\xc5=Y\x14\\wߧ\xfd\x86=\xe3\xb9\xf2o45ㄏm\xd4\xf2r~\xb4 \xefE\xee|\xb6֗\x88\xbf\x05\xfbc\xbd\xb8\x97\x18o\xcc!1\xd8\xe0\f\xa4ޓ\x89\b\x1e\x84Iܡ\x8a)C<Ea\x05\xbc\x8c\xb5i\xcd\xe8\xd5\xc7ȟI\x05\xba(\x1b\x13yh\x85\x1a\x0e\xcc\xd0\xf6\x89\xa3IC=\xb7oz\x9ev\x80\xd0\xfbIծ\x1a\n\xa0\f\xf0\x10\x1c\n\xc1\xd8\x19\x17\x84z\xb1\xc1\x94c(JJ\x99/F\xa0\xb9Ϟj\xb2aL\f\x9e#\x98Ń'\xae\xcd\xf8s\xe0\xe2\x12\x03\xe0\xe4\xc5b\xb4\xf1I\xbb\xac?\xbc\x89\xe8zLe\xf7<\xd0$P>\xfc`c\xff\xa5\xcc!\xc0\x19\x0e\xd2X>\xe9\xfa\xdd\xd1\x01\a\xfe\xe3\xdae1q\f\xae\x97\xa7\x9al\xb9\xd2\xc1\x9e\xb5c\xaa\xf4TZ\x9fH>\x18\xf7\xdb\xe1c\v\x0f\x81\xe0Wu7A\x14\xc0\x84\x0f\xf4#dz\x13z\x90\x95\xdd\xcc!\xec\xe5O\\9\xf46\x02v \xf9\xc0\x86\xf3\xc1\xed\xa1\xd38\xdd\x7f\x99\x14\x9a\xbb\xd3G\xd0?L\xbf\x02\x15\x8bP\xccx\xafRQ\xa2\a@\xb3\x14\x98\xe9?\x03\xc5o웁\x9f`s\xbdk\"h\x12Pb\x03i\f\xdci\xdc\x10&2\xc08x\xd2@$c\x17\x0e\x19\x88\x1a>U\xceM\x13\xe0\xf0a\xa2:LC\xc0\n\x17$\x17\x83.\xb7\xfa\xb3£\x06\x8fA6\xe0\xbco\xa5\xba\x86T\x8c\x19\xb4{\x1f\xbdN\x98Еb:Ȏ;^\x14\x93@\x02\xe5HA+\x91\xed!\a\x03\xb2,\x1a\xb2\x01GG\xb8Іѩ\xbc \xb7\xe4\xba\x12\x10\x8a\x9eF\xbbɎ\xd0\xfacW\xc8FʂQ\xb1\x18i\xecp\xedD\xc4cJ\xa2\xf7u7\xf7\x94D5\x11l\xc6\x00\xd2a\xe2(\\\x1e\t5\x06\xdc\r\xa0M\x1aIT%\xe2\xdde\xfd\xf0\x1c}\x8a\x19\xeeF1\xdar\xa29\x02_\xa8\xd6p\xb68\x89\xae\x97\x82\xd7t\xa2\x02A<\xaa\xf2\b\x1d\x04u@\xcf\xe0\xc4\xcb\x06\x00ؼ\xbd\x1d\x02\xa0\xeb\xa5{\x82\"\xb9a\x84\xe69\xcba\xdfCuћ%\x90j\xe2\x90\xf1h\x9a\xe0$\xca&\x8dN0\xc8\xe1\xb4\xe0\xaa\x12\x1f\x84\xbc\x13+\xcc\a\xd6'ː\xa9\xaa\xe2\x03w?\x98\x814\xc8\x02\xe3\xf2e\x12L2E\n5\xf9u\"\xdcH\x7fz\x04)s\x02\xdf\xdc2ŷ\x13\xb6\xd6\x06z\xdf\xe1K\xb5T\xc0$\x9f\x95\x17\n\b\xd2U\x16X<\x94\xfer\xaa\x01\xea\xe81\x83w\x02-k#4\xfc &\xb9\xaf܈%\x8a\v\xc4\xc61a\x95\xb4퍉`?\x8dU\x02\x15Kf\xe0\xee\xfb\xb7o\xafj\xb6\x10\xf6\xef=\xa3\x85ٓlϲ\x0f\x93@\x12Bw\x10H4\x1eE\x8f\xa6\"\x9d\xc6U\xf0)\xa9\xd9Om\xdbB\xce\x155{\xcfS\x00\x06\xb8\xc3\x154\x19J\x13\xeb\xfe\x03\x00\x88١\xe4\xc3\a`\x02\xf8\x96R\x99\xb9\xf3\x95\xcat\xd7\x10\x00L\xe7T\xf7\xfdˤ\x10pJvjltZv윔\xd8\xd4?,T4\xe8\xb1\x1d@\x11V\x83b\xc0\b\x95f\xa8\u05fa\xc9N'\x90\xdbM\xfcJi\xa4\xb3\x02\x93L\xc7\xd9t\xf3\x10>+\\\xdc'6\xbfy<V\x9d\xaeY\xc3g\x85|\xb8x\x04%L\n\xb0\x85+5\x91%\xe6\xd9Po|'-\xaf\x04uU\x03\x1a{0\xa1\xdb-\xcb\\\x910\xaf\xac\x92\xf7T\x81\x173\x93*\xd7u^\xf4T_\xd9\x15U\x86Ӣ8\xc28X^\x03\xf2\xae\f*rr\xa0\xeaC\xa3\xd7\xf6kMn\x85\x11\xad\x17\x0f˩+\x9c\xe7Ħ\xad\xd1-\x1e\x81O\xf5\xaf=G(\x06\xf9\xe2\xe6\xc7\x1f\"e\xeb\u05ca\xa9\xa37W\xddN9\t&!\x94@])\xc8L\xb6{G\x0e\x15\x80\x1a\xf2\xf9\x0f\xb4\xd5\xfa\xa1Nm\xdfBڅ\x9fi'>\xc6\x02\x16&Cv\x1a\xfb\xe9\x1b\xd1\xc9r\f\xb8{\xc7\xc5\xdcY\xbf\u0097\xfd\x9c\xfd<\x1d̩\xab\xbb\xce!\xb6iLn\x0f\xb7\xb5\xd8\xc0\x13\x1e9KN\x00\x89\x8c\xfbx\xfb\x11\x18!;_\xc1qʿ\x159\x1c\xf5\xaf\xc5c\xd2\x12\xa7<\x93\x94\x93w\x03\xf8\xfe\b\x1dy\xb2\x83\xbc\x80\nS\x18\xff\x8e\x02akr\xe3\x7fu\xe7\x16\xac\xb0\xfe\x024\x0f\xf6\x91\x82C\x1fd\x04\xbf\xe5\x10\xc7\a\xe1\xf0\x1b\x98\xbd'i\xa7\xe0͆\f\x1eb@B|\xe9*\xe7\xec\x9bv\xe1\xa3.\xa0J35\x13\xe7?i\xa6:\x8b\a\xe0\xcdSY\xa9~ĉ\x9e\xaa\xf1X\x190\xb112\xeec\xe8G\xf3\x9d:\x93\xd7\xc3\x03y\x97\xc1^}\xb8@WS#{\xd4X\xd7\x7fEG\xbe\xb2\xc1\x94\x9ar\x8f\x80\xd9ɜ>\xb1\xe1\xb8sul\x89ۚË\x99\xa3\x18\xea\x7f\xe0e~8T(ۿ\x05o2\x1ecOjuS\x93\xe7\xa6p\xdfe\xb7ϣ?VJE8\x90\x8fG\x02]\xf2Y\x18\xa6MA\xd3$\xe7[\xa86\x1b\n͆\x13\xd4\xc9\x1e])b褧\xa0쐎\xb2\"7\x1fx\x99|p\xcd2Ũa\x8b\x13⨃l:\x8e\xbf\x04\xf6 \xd9\x1cҧ!\x96\rk\xa6\x81\xc1P\x8a\xd4'/\xe9%\xe4\xf2\x05s\x81+[\x80;ѕ\x7f\x83\x14\xfc\x03d\xc1\xab[\x0e\x87s\xa4\"\xff\x94\x1b\xbd\xde@\xa6\xe0\xf2!(\xe4郓\xa0\"/\xa2\xe3\xf1}\xb5\x16,!\x97>T\v\xb3\x049\xec\xa2\x7f\x9b\x14I0_\x98\xe5\xad\xe3\xc36ɰ=\xfe\xa5\x9d4\xa0\xd3\x15Ͼ\xbc\x82>(\xd6<\xe6\x19[\xbaڟR\xd1]\xaa\xb3\xac\xa0\xda\x1d\x84\xbezwN\xa4j\x1c%\xb0\x0f\xfeCn\x96\x98ш!\x15 \x88\x13\xac^\x98Beu\xb3\xe2Q\x05\xd9\xf5\xe2D\xfbmh\xf1\xdb\xf3X\xe7v~\x1e\xbf\xfal\x0eW\xa6AE.\x8d\xbb=3{\xa6<6WX\x82=\xaf'\x96\x00Z'\x04\xf9#iޭ\x86i'\xa8|\xfa\x94\xa2\xe0\v\x81\x8c\xa1\xaa(\x96\xbe@bʄ\x00\x1f\x9b\xaa\xd8L\\\xa6\xa3\xf0~\x88\x97\xe9\xa0\xe0d\x14Z\x00\xadR+\\\xe4\xfc\x96C\xd5\r\xb7\xa6\xeb\xba\xc9>\x1a\x91\x80\xe8N\xc8aFm(\xdfڪ\x8cX\x97R\x14ޢ\xc6\xe2\xba\tp\xae\xc3\x1c\xea;\x10#K\x0fI\"]\xddQ\xb2%\xa1X\v\",߫w\xbaǴ\xbczwn\xe5\xd6FV\"OZ\xe2\xbd\x11\xd6T\x96\xe7\xa5a\a\x7f\xd0-\x18\xbbT\xb4\x91\xd7W\x03 BJ\x84\xdd\xc5\xe9ޏ\xa1\xac\xdf\t\x19\xbf\x96N]\\L\xd8=|\xefx\xbcu\xd2\x10\x92\x9ch\xbf\xe1\x00m<D\xfbC4N\xbf\xfe,\xe6\x96\xce\\\x84eJ{!\xb7D\xc0\xbd\xa6\xeb\xe5\xc7}g\xebE\x96\x9f\xac\x87\x1b\xcf\xd5\x15hְ\xe9\xe3Y\x99^h%lh\xda0aneQ\x1dXVP~\xd0˨\x06-d!\x80\xfe\xac\x8c#=\xe4\x17CU\xf9\x99\x98\x18\xd2.{5\xcbߡ\xc4/\xef\x1c\xcf>[\x9cN\xb3\xcb\x0e\x94\x96\xc0\xacy\xd5\x15\xa7\x92^@\xbb\x19\xa5\xb6\x05\xd0U\xe2\xa3\xc5͓\xdc(\xaa\xfc\xf0O\x10U\x83\x84\xbb7\x1e\xc3V{\x1f4\x06 -,\xb6+|\x05$&`%\xf6\xe1\b\x8d\x1e\x92nʋ?\x18N\r;\xbc)\x9db\xe1\xac\xe1YhM\xc0\x894!\x98>:,\xbc\xf75\xd8\xcf\xd1F\xf62\x83\x97\xdd\xe9\x198\x9f\x9c\xe8\xe7m]\x06\xda]\xea\xc15\xf9W\xb2\x97U\"\x8e<\x80\xb2\x91\x83\xe5\xe3\x13n\x9c1\x1f\xa8\xdel\xa4;q\x8e*x\x02\x10V\xbd\xaa\x0f\x85D;\xb7[\xb5M{\xa2*k>K@\x83\n,P\x9c\x99\x16\xf5\xfb\r\x86\xfb\\\x9f\xf9s}\xe6\xcf\xf5\x99?\xd7g\xfe\\\x9f\xf9s}\xe6\xcf\xf5\x99?\xd7g\xfe\\\x9fyv}\xe6B\xd2\xfc\x1bZP\x9115\xa9\x84n\x92\xde?t\xa0\xf8\xc0\x00\x90\xd0y^ѫ\nCi4o\xdc\b\xb5$\xf2\x96)\xc5s\x17F\x9a֕\xdcƚ\xe5ê\x84\x9d{4OG\x0e|^\xd6`b\xccD\xd0\xfd,\xb2BV9\xf8\xaan\xc1W\xaa\xad\xbb\x1a\xa8D6\x1ec\xf5U\x9e}K\x02T\xa9W\x1f\xed]\x8a\x17\xafo\x96\x8dH\xc0z\xc3\f]\xd7,\x02\xd7\xeb}\x05\x1b\x0eso\xacr\xa1״(\xf7\x9dV}\xdbPL\xc3fBٕ;\x7f\xb0^\x9c\x96^\xb2\no\xf6<\xbe1\x8a\x97\x03\v\xa7W~AR\x06\xcf.\xaf\xeeE\xcf\x1b\x0f$\xa6\xa6\x85\f\xa7T\xc0\x80`!\x82Ӡ^DQ\xcfƗW^\x90\xf4\xf4\x16\xb3\x89\xcbV\x86\x05\x02\xddCu\xcfjS\xf0\x8c\\^\x05\xaf\x90^\xfe\xa9(2\x98\x8e0\x8d\x1e\xdeZ\x1f\xaar\xdb$\x83\vs\xe3B\x83=\xa1\x8d\xa6~j$\x8a\xd3>z\x19\xdap\n\xf3ʏ\x97\x8b\xdd}\x10\xf6\xbe\v\x0e\xbb\xd0Q\x15\xe8p\x9fV\xe0\xa4e\x1f2\a\xaf\xce\xf3o\xd7\x1bA\a\xf7\xcb\xe6Fac\x93\x8d>\b\xd7p\x15T\xf4\x0e\xe1})Q\xc0P\xb6J1\xd8>xM\x17\xdc\xc5\xe5\x02\x87\xfa\x9e$\xea;\xcd9\xb0'\ao\xe4?hY&)7UA\x1b\xe4\x92qҿn\r\xa4\xa1\x99\xc5N\xc3\xda\a\x9b\x80\x02q8{Wq\xabmt/(\x14Ŗk\xf2R\x1cI\xaf\xab:\xbcm\xab\xcdz\xffN\xad\xfa\x95x@8\xae\xa7\x8f`\x87A\xf9\xe5\b\xeey\xe8a=\x8fR\xee\x02\xd8\xf9J\xd1\xeb4\xa8x\xc7@\x0f\x9evn\x93\x8e*\x10B\xef\xf1\xec\xd21>[\xbb.\xf7\xa7\x10\xa2\xf66\x87\x94\x16X\xcaۦ\x18x\xfc\xbax\xce\x19\xf9\a\u07bcC\xf3\x1c\x9d1\a\x0f\xc5\xe7#$\xfa\x93\x82\xe9ek\x90N\t\xbe\xe3\x98\x05\xb4$o\xac*Ǽ\xc1\xa9\x9bI\x0en\xee>\xe0x@,\xa0\x8d\x99d\xb9\x96\xb6\x84\n\r\x8e{M^\x81\x95\x9b\xbc]\xaf\xd1a\x13\x89\xaeR9\x0e*e\x85Qp\x86\xbfM\xe12\x97 \x88\x10,\x02\xa2\xc5\x1d=jb3J\xa2\x04\x88\x1a3i2\xaf\x17\xd36ߕ\xa5O\xe2w\x8f\xe1\xc5\tR\"L\xe7Jq\xa9\xb8\xb9\x1fk{ ލ\x86\xb7ڲ<8@\x9b̸\xacsW\xe0\xc7VLA\xaa\xf4=\x02\xbbBn\xe0Z(PN\x1dៀ^\xba\xfa\xeaɲ\x96\v.\a-\x04\xb6\xf5\x19\x060☠\xee\x8e(\xd1]\x88\xacëx*\x900aԱ\xb5\x11\xe2\x95\x16\x06\xf7\xa9\x0e\xd4\xfa\xa2C\x80\xa1Y&\x05T!\xef\xd2\xc9j\xea\x1a\xb2{\x97\xbd0\x84t\x03\xf0;\x9a\x9bqA\xb5\xb1,\xda\n̆\xf9\xa6\xfa\x8b&a\xcf<\xae\x17\x93=8\x83\x9b\xcf\xc8\xfe\xd9\xef\xf3\bs\xbef[\xa6\x98\xc8\xd85Td\xbf\x17_6A\xb5\x828\xca?\x04A\x87\xb5\x88\xb6|g7\x1b\xb7t\x95}\v}ީ\xb9Zy\xe42\xa6\x1c\xf9\x03V\x11}\xa1\x93\xa6\xe4i\xe7`\xf80\x90\x95\xa7\x10FI\xf4\xa5؝\xe2ƳS\x18}\xb8-\xe1@˒\xe5Q/\xebS\x893b\x03\x97\xfc;H5K=\x9bB\x15\xf8\xbc\xbc\xbaD\x18^P`\xeeZ\xd0&=\xc7\x06\xa5\xcdM\xb1\xc75B\xc0q\x1aCL\x9c\xea\r\x7fڋ\xf4\xbdG\xd4o}\xa0\xa1\xbc\xbc\xba\xb49t}\xbd|\v\xce\x7fq\xb4\x02\x05\xea\xc1\xa8|UR\x05y\xf5p\x9b\xfc\xb21\x06\xefRL\x03\x1b\\:\x043\b&\xa0\x17\xa7Һʺ\x17ws\xc6џ\x033\x9a\x01\xf3\x80\xe3\xf0\xa8\xec\x8ed\x85\x98ZL̔x0/\x99l] {\xb6\x18DOr\x15\xb4/\xa1\x8d\x8bb|ʨ\xeb\xa1*\f\x87C&\xceǔ\xa2\x0f\xe8DA\xf5\xfe\xa7\xe4\xa2>\xa5\xf6\xe6:\xf8A\u05ed\x002\x98X\xac(\b\xd5S\xa6\x9f\xa1\xc6K2\xb9\nJ\xa9\x13\xa1\xde\xc7\xe1R\xe0\xa2\f\xb7\x04܌\nX\xd7\x10\x93\x9f\xbe\x91\x8dS+\x11\x13ES\xc9\xfe\x86'3\xd0WYG\xce<\xff\xd7\xf7OUE\xed|v\x8e\xf0\xbeZ2\x9d0r\xed\x1c&/\xfda\x9e\xd6x\xf0\x1d\xa6\xe309\xb8\xd2A\xd4'\xfb\xe8y=\\\x81\x9c\xb4\x8b\x87w\x86\xee\xc0ӭZ\x18\x7f\xf0\xa0\xf9\xe9a\xf3\x01\xe6\x98\xce\"=\x8c\xf2)\x82\xe7\xf3j\xb3\x8fQsR\b\xbd\x85\x9b\a\f\xa2\x8f\x85\xd1G\xb6\x8d\xfa\xe3qx\xc24\x06I\xfc\xa8\xe1\xf4ǩ\xa9>\x11SSj\xa8\x9f\x86\xa7G\x0f\xac\x7f\xd2\xd0\xfa\xa7\n\xae\x9fP\x1b}Dp\x9dD\xfe!\xbbl@]\x9a\x1af\x1f\x0f\xb4\x8f\xd5:\x9fP\xe3|P˛:\xc9\x19Ӌ\xf6\xf5\xbe\xd9M\xf5\xeaN\xa6\xd9ԥ\xf8ɂ\uf7f46\xf9\xa7\r\xc0\x8fr\xd6\xc8\xe3\x06K\x8d\x18\x18\xf7p\x9f\xa0\xcb\xed\x9b\xe3\x05+\x99șȒ,6\xce7o\xba`\xbc\xa3H\x13F\xb3=*L\xae\\s\x1d\x1d\xc2\u0084\xf0\n`\x1b/\x8a%W!\x89\xfe\x1d&\xd1':\xa3\x9dF\xe7\x90i\x0ft\xf5\x87Jjں\xa8\x10\xb0/%wl\x03\xc5V\x9dӦR\xee,o8\xbb\x92\xe8\f`\xdcI\xf5\x01\xc2I\xba\x01\xd1\x1d6\xb1\xac!\xef\xa0v\x9c\xe7|\xfc+x\x91pK\x06\xafo\x1e\xa1\xa7\x8fW\x83!\\Z\xe7h\x8dI\xef\xc2)e\xae\xfd\xa1Y\xeb\x0e\x8dB\xfa\xc1|\xe8\x00\x01vN\xf4\x87\f\x10\x05\xecb/\xb5\x1d/8\x9d\x90\x84\xe8Ȱ\x1d\x9c\xe3Œ>\x83\xfc\x02\xce\x0e\xe3\r\xee8U\xa2M\xba0\xab\xe3\b\xef:$\x17\\\x03\xd3\xe2\xa1D\xe7\xcbZ\xcfc\xedtT͗/|-sv%\x95\x19c\xed\xabv\xfb\xc4I\xb6(F%\x8b\x9c\bߴ\x03ٞ,\xf0\xb6\xf3\x03O떳\xbb9\xeb\xf4ʾ\x9a\x9aW턴\x86\xb3\x8d}jr\a\xca87\xe4\x0e\x8f\xe5\xe5r\xd98\xa9\xed\x0f\x80%\xfaj\xb8\xe6\x0e2\a\x7f\x9aOI\xf1=\x81\x18 4s\xac#\U000a1cdf\x9b\xca\x10\xe7\xcaL\xf4&\xa4ٻc\xa0Qt\xca\x1d\xf3\xf4\xc2\xc6\xce\xc1\xc6Z\x96\x10\x15\x00\xaeVD\x7f\xe0%2.h&\xe0\x83\x85\"\xf3[^t\xc9BH.\xef\x04\xc8\x02`I\b\v\xbb\xec}\x87\xd8kD\xda\x03S[\x1a\x96\x99\xe0\x9c\x9e%\x9f\xaf\xda@\x88\v\xaa\xc1<\x05-\xf8o\x90K\x05&\xa43ä\b\xee9\xf7\x82w\xccy?\xb502Eu\x8d\xf8?\x92\x8c\x82\x04\xc1\xd8\xebA\u07b2|I\x04\x84h\x18)\xb9\x0flm\x8e$\x83\tÉ\x90\xcaȃ\x95\xc6{)jY\x87\x83IuS\tË&+i\x92K\xc1>\x81T\xb9͠2\xd1Mě\xb3H\xf2\xee\xbc\r&\x0e\xee\xe6\xe1\x19ҥ\xfe\xf3\x9am\xbd\xff?\x10\x03\x0eU.\x81X\x0eo\x89\xee\xecnz#h\xa9\xf7\xd2,\xdd)\xcdpD\xb9\x94eU8\xc7\x01#\xf6\xfc\x1a\xb9\xa3u`\x12\x84\xd92>\xab\xdd\xc5)i\xa4\xc0\xbc\xac\x8c\x9c\x1e\xa4\x84\u058b\xa9YA\x03\xc9D\x03Zp\x82n\xdf\x1co\xecQ\xees8\xb7}\xb6\x98\xab\x817\xa8=\x81\xb0.\xa9\x01\xe8\x98>0\x19S\x16_\xee\xc7y\x1a\xa3\xbd8\x1dʵ\x1aL\xd2\x1a\xb4/f\xb1{\x13\xfb\xc8[m\x04\x95\xbe\xd2A\x8a\xe3ݏ\x89\xce\xdc\x01}{\x1c\x9f\xe9Fbhb 3\xc5CRw\xff\xb5\x92\x86^C\xd06\xe3\x05G\x91v6\x03]?v\xc1\xf8\xc9[-\xd4o\x8e\xd8\x10\xbc%9\xf9\x81\x1f\xb8\xb9\xa6b\x97\x8aU;\x8d1ѕ\xd5!\x83\x8ekUg\xe5\xbaf\xba\xa5\x01\a\n\xa0.\x7fG\xa1\xf8`\x88|\xe2\xe4\x93+\xe4\x06.\xc6'\x85\xbc\xab/\x1e\xado\x8do\xf6`5P\xbbU\xb3\x8f\x19\x83\xbe,䥯y\x88CH\xa9\\\x98\x80bo\xec\xb7!\xf7vՇI\x9bC\x9f\x90\xc2I,&V(\x1cX/^+\xfa\x87S\x8aF\x18\xe4\xba\xd5<\xd2\xde\x1a!]\xd0}\xfe\xe3\xe6\xcd\xeb\xa0u\xf5V\xc3\xe8\xdcy\x1ee\x00\xf9\x97=\xc78t\xf7\x14\xfd\x1aY(\xc3.\xe3ϡ\xe1ϡ\xe1\xffڡa'ʮ\xde%\xd6\xc78\xff{\xdb\xe3݈\xa1\n1>\x9f\x1e\x99\x00s\xf5\xce\xc5z\xb5\xd3\x0eO]\xe5Cڲ\x1b\x03\xa4\xbcW\xf7\x99\xa4\x05И'l\x13\x9e9 x\xec噟\xb6Ϫ\xaf\x92\xc69x\x0e\xd1я\xa7\x85\x85\xfc\xb4\x87\x85\xd9\xc7ѓ\xfc\x1d\xf4\xbcj\xbf\x13a#}\x93z\x12&\xb1\xb1y\b\x9fw1\x95\x162\x83A\x83\x91\x95?\x8a\xa8a\a\xe5Ĳ\a\xd3x)]\xfe`\f\x8b\x16_SqE\x92\xd7\xd4O\xbc\x8a\xfewE\xf4\x80T\xb3Id\xac\x9b!\x97\x18\xec8\x19\xae{\xa1\xf9\x8e<)\xda\xc9j\x91>\xeb\xf4\xc6vNz\xa2;.F\x13\xee\xa2\xc2_\xa1\v\xdfR'<ȉ^\x9a>e\xa9Z\xc0|(\xdbU\a{\xcd\fh\xbc\xce\xfext\x9f\x85\x06\xcd\xf5Bމs)\xb6\x05\xcf \x1f\xf0\xbd\u05f8\xe7\x90\xf0f\b\xa0\xed\xae\x95@}\xc1\xcaB\x1e]\xecD䶐\xed\xb6*n\x98\xd11E\x12\x9d\x81\xf5\xe6\xd8\x02\xdco\xc0\fX\xd5\xd6\xdb\x10\xd6d\xc1\x03(^\xf3\xe3\nn\xe6\xc05l\x98:p\x81\x1e\xbf\x86F\x9bf\x96\xe0\t_:W\x16\xbay\x11\x96u\x8a\xef\xe1\xef\xdaI\xd2e(h\xbb&\x97\xc6k\x1b}\x95\xb0\x06K\xdc=\xbe\x1b\v\xae=ȫ\x02\xd7\xf4<\x0e\xa8\xdf\xf7*[%\xf8\xafU\xad\xb9\x99}]4Ե\x8eԒ\xa1Z<^$疴ߠ\x13\xdd\xf7䄫\x83\x1c\v\xe7\x1e\x90@\x00r\x90\x1aH\x92ATQWYƴ\xdeV\x85\xf3\xcf7\xdc\\\x90\xab\xa9È\u05cb\x13\xe40\xc8\n\xa6.\xd4\xf1\xba\x12\xb3\x90\x1a\xbd\x9f\xd2\xe9\x823\x9bz7\xbd\xae6\anL}z\x03\xf2R\xed0\xc0&\xc9\xd5q\xa5\xaa6\xed\xe1s\x909\x03\xbdǺͭn\xed+X\xe5>\x8c\x04[AHJ\x86>\xe3\x13QXz\xbav\xdb;\xf9\x9af\xf6hT\xd9\x1e]\x14ˈ\xb1\xa1o\xf0\x0eC\x99\x038\xc6\x02\xe18+h\xf5r\xf8\xec\xd5}\x16\x80\xa1;.v\xce\a\xf5\x83\xccf;kn\x92\x90\xfc\xa2\xb0\xcc\xdb~\xe8\x82'\x1d\xf9\xe1v\"\x10e\x85L\t(@\xb7M\x0ft\a5\xfd>&⪘\x00\xb1\xf0}A-A\xa3}(\nD\x93\xb7Z\xe1½\xf7 Y\xbb\x98%\xe4=\x1cR\x005\x11ґ<\x95c\xa0\xd1\xf1\vw\x89\xe2\x1bQ4\xcf\xd2\xd4\xed\xddeG\x84\xa7\n\xf1q\xf3T\x0f\rf`\xc9ٳd\xae\x06\xe5\x1c꽍\x01\xb4\x8dO\xa8\xfc\t\x85\u07fc}\xef\x84N\xbd\xaf\xc3v\x80\a\x96*\xe1\x03\xa9\xe9\x8c\x14<ub\x95\x04\x9b\xa8@\xea\x1f<2]\xd4*\xb6\xdd\xe0\xc4Z\x9b\xb0\xbe\x99\x1dL\xa2/UAZ\xa4\x80]\xe8\xa9Kd\xc0\xb0\x8a\xddM\xa8_\x89>\x90\xe7涯6(\x15NB\x7fU\u0096\xcf\x14(\x16|7\x82\xff\x9f\x1a\x8d#\x01\xe7jHG\nT\xe4\xc1I\x97d\xbc\x97\xf9\xb5\xe3\xb9\xd3\x17\xcf\x16\xf7M\xbd\x19@\xceT\x16\x84\xcfw\x97\x17nH\x90\x14\x13;\xb3./4\x91w!\xe4Z\x9f\f\xb3\xf2\xc3\xc8\u07b6=]9\x9cB\x1c\xbe`zM`\xd5Ɔ\nt\xfd-\xc0>j\x88\xcfzE'\xbc撹?Ȓ\xd3\xc0\x00]\nM\xa0҈\xd9\x01ߒ*Z\x14\xac\xc0\x01]\xb8\xe8\xeb\xd98\xa2\xafR\xef\xf9\xe5\x9dI\x91U\nl\x8b#\x11\xd5a\x03NUfzB\xcb\xfe*\xf8^V\x9cr\xf3T\xf5\xc7㸟\x12\x1c\x877%Lc\xb8DӞ\x8e\x02\xe3 \xbf\xb9\x82\x9aO^<\x7f\xfe\xfc\xc9\x19y\xf25\xfc\xb7>\x1b\x0e\xea\xb3\x17t\ue730\x97w^^\xf5\xa4\xea\xc0\xd7zT//\xfe\xe8\\\x8d\xe6\xccMI\x95f\xc8\xd8g\xe3t|\xdfz\x05x\x99\x92mA\xb1X\x01T\xcd˨aAW\xc4\x1e\x92P\x89\xa3\xa3FX\xc5\x11b\xc0B\x9a{N5\xadd\r\"\u0092\xe0\x82\x19\x9a\xed\xe7\a\xd2\xdfu\xa0\xc4\xe1\xd6@^䫸\xa4\x03W\xb5\xc6\xf1\x06\xc2'\x9e#\xfa*\x89\xe78\xd0\xdaH\x80b\\9\xac\x87=;>\xf5iO\x84\x1a\xd7\xca\xc8`p\x06\xbe\x06\x15ڙ\x1a)t\x0f\x9fEnC\xc0\x945\xa8[\x01\xb3J\xdeC\xdd\x17Ȃ\xfa\r\x89\x9f\xbf\x95*s\x88\\L\x969=\xf4\xd5\t\x87o\x83\x96M\xbfnFKS\xf9ئ\x15\xcdƹ\xd9@\x18P\xafx9r.\xa6m\xf5\xb4\xe4\xef\xc0\xa4\x91\xe2B\xf1\xad\x99\xc3]/\xaf.c\x10DW\x87\x03U\xfc7\xa6\x9b\xec\xe5\xb3\xe7\xe0H/\x18;\xbe\xba\xbd\xbd\xb3 :L\x05\a\x92Ң҅9\xbc\xb4+\xd1\xfd\xa5\xfc\x85\xe5\x98Us\xc7T$\x8fф\x82\xb8\x05\xda`\x90\x91\v\xb8\x8az\xd7k\xf2*EL\xe2\x04\xac\xf6\xe6$\x88\x92B\xcb(\x01*\x9a\x1c)d\x82\xb7z]\x95\xe38\xedb\x15\xfa\xf7\x1b\xb1\xdc\xd65\xcbmu\xe4\x1a\xcb\xc0\xf1\x84\x82\xd1\xcaT\x03\xcdfO]\xeae\xcf\x059\xf0l\x0e\x82YF+\xcdB\x9f\xbe?L\x8d\xd9K\r\x84\x91\x8b\x81M\x0f\x06uX\xfa\vd\xdcX;\x1d\x81yaK\x94\xb8[ݨ8\x1e\xe0m8\xfbBʢ\xdaq\xe1\fg`\xb5.5\xc6\x14\xdeP\xe4\xa1\xc6|\xbaY\x8b~/\xdboy\r\xaa\x89|\xc7G=\x10\x89\x9dm\x83\x8a\xa9)\fʙQ\xbe\xeb\x8c=\xd4ԇ\xf1\xb5\x98k\xbd\x98{\x81h\x7fDu \xa6\n/y\xa5fB\xff\x03ӷ<|\"\x15oZ/\xf5\x11\xb17m\xc0\xb9\xb8;\v\xc7+m\xf7\x99S\x7fP\x16\xb6\xaa\x0e\xdb&[\xf5q_OX\x17\x1e\xb4\x11\x99hԳ\xb5MR\x8c\xfa\x03-\xee6*W%Z\x1bzH$@\x8c\v\xd1\xf3.\x98p\x89g(6\x1dK\xf1PU\xda\xe6\xf5\xb9;\xb1\xf2\xf5 l,S\x05\xecbA\xb3\x9c\xb0[& %\xdc\xddSꠧ\xa0\xbc\re\xad\x9e\xea\x00\a\x8eڢ\x06vc\xa82a\xe8z\xd1w\x010\\\xf8\xb2\x82\xb7\xe7Q \xc9v\x99\x14־\xd7\xf30\xef\xdfv\x8d7\xac\xa3\xb6\x04\xff\xb7Ss\\N\xb1T\a\x17S\xe4H\x82\x03l\a\x18\x19L\xf4S\xab<\xbe.\x1bF$(\x9c\x91\x91\x85+f\x82N$S`\xf9-T\x03\xbe\xe3\xe6M\xa9\x1bwv\x83z%\xc0\xfb\x06#:\xcc\xdd\xcaô\xeb#2\xa0\x11\xf3B#=A\xaf\xa1\xe0\xd1\t\xa5[\x1c>\x12pI\x8c#\xaeq'\xf7a\x909{\x1b\x143y\xab\xa8\xd0ܯ\x87t\xbb)\xd4\xed\x83\xe8e&<\xa9\x17W\xe0$bBko!\x00F\x9c\n\v\xd1_\xabA\xa4\xa6\xe7\x97\v\xd7QJ\x96\xd7I\xacc\xb18\x82\xe1[\xf7\xe6t\x815\x81h\t&s\xb9l%\xbcT\xc8\x15\x98\xa9\xb47\xe1q\xbc\x01\"\xa0\x1b\xbd\xf5\xb5J\xa1\t\xcd2V\xe2eH\xeb\xc5\xf0\x95\xdc\xfd+rt\xe1\xb9\xd0\x03Ӛ\xee\xeeM#\a\x06\aO\xf6ՁBj\xa0\xcb\xcc\x0fϬY\fx\xf0\xccJ7`3\x01\xf1j\x92\x8dP\xc5\xdf\xdd\xe1\xcf\xd2۹\xf5\xbdt\xa0\x1f\x7f`bg\xf6g\xe4/_\xff\xb7\xbf\xfe\xdb\\4\xc9\rJ\xcf\xfc;&\x9c\xe4\xbe/ƺ\x10\xe3\xf3Ȁ\x92\xf5\xc1\x95\x1b[\xef\xea6\xe1<v\xcd\x7f\xb0\x85@X\x00\xae\xd7\x04\xd7\xd0\x10\n!\xdb\r<\xd8P\x89oI\xf86\xdd\t\bD+0\x8a#y\xf1\xf5\x92l\x1c\x95\xd6.\xdb\"t\xae\x7f\xfe\xf8\xcb:1\x15\xaeɿ/[\xe3䚸\"\x8by\xfa\xc66\xa7\x9f\x82]\xa1\x98\x15_F\xc6\xe2\xab)\xce\xfd<\xc6\xd6\b\x17\xe6\xaf\xff\xda\xd3\xe6\xc0\x05\\\xafxF\x9e\xcfVB\x15\xa3\xfa\xfe\xec`\xa1\xd4✂\x11\xb1S\xf4\x00G12\xc2s&\fDaU\xbc\x8c\x00\v\xeeE\xaf\xfd\x05t?\xd5N<NXXWJ\xe6\x95/\xff\xe8\"\x01YD9\x90\"\x1aoͱ\xb7\x80\x12\xf6\x11\xa8\xc3|\x9d\x02W\U0004d09bR;\x9f\x0e\xd76\xcb#ub\xc4YA\"\x0f\x1e\xb2\xc6\xd1O\x16n\x18\x03\xeb\x8b\xec*\xaa\xa80\x90|\xfc\xf2\xea\xb2\x7f\x16o=\x8cHrSrN\x0f\xac8\x87\xab\xab\x87%\x85\x13/8f\x9c\xaa\x90\xd1\xe1\xf0q\xf1\xf2\xe2\xf9\xd7\x03L\x16Z\xf54qU\xd1\xce\xc8\xff\xfc\xf9\xe5\xea\xbf\xd3\xd5o\xbf|\xe1\xfe\xe7\xf9\xea\xdf\xff\xd7\xf2엯\xa2?\x7f\xf9\xf2o\xff2W\x90\xa5|A=\xdcZ\xbb|\x1a\x8c\xb5\xf47\x95\xbdU\x15[\x92oi\xa1ْ\xfc$p\xb7\xeb\xc3n\xda\xfb\xe5\xf5\xff'\x00\xeaI\xffc\xec\xa3\xff\xb9\xeb{.J\x80\xbb'!\xc4g\xe3\xd6\v\x83\x8b\x88\xbfP\xb4\x92\xad\x94kw\xfb\xf3:\x93\x87g\xe1\xf9\x04\x1e\xfaˋ\xbf\x8e\xf2\xc7\x17?[.\xf8募W\xee\xff\xbe\xf2?}\xf9\xb7/\xfe\xc7z\xf0\xf9\x97_=\xfb\xf2o_D\xbc\xf5\xcbϫ\x9a\xb1ֿ|\xf5\xe5ߢg_\xfe\xcbc\x98\x91]}.\xd9̩\r\xc9gV\xe8%\x1f\xf5f\x99\xae\x90\x13N5-\x87\x92\xf4\x1a\xd9\xc5\xe0\xae\xc3\x14\xe3\x0f\xec\x98X_=\xbdwA@\xb33\b;\xb6\xdafR\xdc2e\xeeq\xbf\xe1y\x03B\x8f3\xa6\xed\xb5l\x04\xb9s\xc9jǘ\xf7\x8b%zr\xa9\x9a\xe0h\n\xc3\xf6[y\x00\f\x19c4sۘu\xcb\xd9s\"Mǧ\xcf7I\xdf\x1a\x18\x19\xd5\xeb\xc5){7&\xcc|S\xe5;f^\xe1\xc1\x16\x96\xcf\xc1\xe9\xab.\x18D\xac\xaa\x9c\x8e\x7f\xf0Gk\xb5\xb7҃{4z\xd7\vY7\x95DG\xb4(\xe4]\x9d\xe0\xe3\x1a\xa2\xfb\x80n\xb00\xf2zqJ0\b\xe7?\x8b\x8dp\xd8.\xe2\x95\xf9K\xa9!\x9f\x16Az\x83\xc2\x1dkAg\xa3\xd3,C-\x95\x04P{\xa7~\x94\xcb\xe2&h\x93\x9fhf\xa0\x18\x9aOrj$\xdaX\x97\x90\xbf\a\xf64&p\xf7\x86_\xf7hp\r\\|\x1b\xb7uEqp@\xae\x16\x14\xb8\xa6-m@S\xab]\xac\x1d\xa8P\x1b\tya\xbd8A\xacB\x02֤\xc4\xfd\xefC\xc3Z\x99\xe4\xc2\xea\u0080\xdf\xda\xe4j\xec\xef\x1d\xa0\xb6K\xbd>\xd5\xd53\xec\x1f@\x98/\x8d\x01\xdb-\xbd?LaA\xf8|߀䥙\x91\x86\x16\x91L\xa3\xa1\x01\xf6\xdc\x03\xebƩ\xbc\xb4\x80\x94\xa9\x16\xe4\x96UV\xc3F\x88\x96\xfa~iK\xc7\xc8lѯ\xf2\xf6\x02q\xaf\xe6QJdѣy\x0e1u@3p\xec$\x1c\x7f_\xb7\xee\xc3#\x02t\xee2&\xd2gW\x82\xf1\xe6Wƌ\xa1\x0f\xec\xc5\xf1E\v/\xfd\xcd\fg\x8b\xc1\x99\xfd\xe7j\xe4\xe2\x91\x00(\x1cy\xa5\xe1\x17\xb9\xed/\x90\x9f(\x85\x9fޟ:\xb7\xa4p\x11\xe7\xeaQ\x116:\xb7ɺ\x94\f#]\xf6\xb1\v\x8f_\xbc\xbe\xf1.\xe5\xb9NÞ\xa5\x94@\x87OE\xe1z\x02J\xe0G\xda\xc1\x87\x9bU\xb2\xc7\u07b9\xcf\xf17\x86\xc1\xa5\x1fO\xc3\x01|\xba\xac\x00\xd7r\x00\x81\xf6R\x1b\xcc2L\xcf?\xbe\xc9\xc0\x9b\xe1\x1e\x1d\xbd\xbd94\xf9\xfbz9\xa8_\x89\x9b\x11\x8e\xac'\x17d\x80\xe8\xa3{\xc9DI>\xae\x00\xd7\xc4|y\x12\x15\xbei\xbe3\r\xe1=\x80IM\b\xc7L}e^\xfePh\xeb?\x84\xd9\xc2U\x9c\xd3\x1f'\xf3;\x06Z/f\xce#\xa4\xcdN\x1eE\xcf]\xd4q\x1d&\x1e\xe5pA\xb6\xec\xfa1\xec\xc7\xe41P\xff\x00\ay\xaa\x917B\xd1~Zv=\x9fg\x8bA<&\xe5ϛ\xa4\xff\xd4\xec\x83\xe6\x1c\xe9\xc5ם\xa3oh\x03\x80M\xed6\f\x14B끄d\x1f\xc3\"{z\vyS\x1e\x8e\xae6>\xbe\x15\x0e\xdcD\x03\xc0\xa4\x14w` di\xf8w\xc12\\/N\xf3\xc0\x0ei\x02\xe5\x9ej6\x82\xcb+h\xe315\x14\xf0[L\xf3F\xad\xc8kv\x97\xf8\xd5\xeaQX\xda\x13\x89\x93hr)\xae\xc0[\xcbt\xd7\xf1`3\xbc\xb8\xd8\xc1%=\x98<\x12n8>\xad\xf1\x15U\x86\x83\x86jǓxׅ\x8a\x93\xcf\xc6\xdf\xee\x7f`\xab\x12\xa5\x96j\xfcp\xac\x87\x815_:\xe4\xcdY<\x1e\xf1c֎\x93KO\xb5S\xd1\xe1\xa9\xefw\r7\x9evل\xf8h\vo\x02\x85\xe2vL\x9b\x15\xdbn\xa5\x82\x92\xffő\xacV\x10Mqab0\at\xa4\xc2%2\xfc\x88\xb3\x85k\xd5\t\x96-\xf8T\x9cG\x1fO\xac\xba`\x17\x174\xcb\xe08#{\xa6\rME\x05\xefe\x94\xe1\x96\xe8\xd6\xca\x14{\xe12n\xef\x17`m+ 8\x8b:\x940\xd6zOV\xbc\x83\uf185\xe2\xe7\f\xaf\xd0\xd8Ү]0&/\xe0\x836K\x8fwl\x1a/\xc1\xe7m\x80\xd2g\v\xb9\xf9\xc9\xf86#W=\xd65\x02\xb2YI\xd9Ӊ\xd9+Y\xed\xf6\x9e7\xfb\xbc\x1f$\xaf\xa0{\x97t\xe6\xccD\xc5L\xa5D\x94\xa4\xee\nH\xe7C\n\xcf\xf0Y\xbf{Xe\xbf\xda\xe0\f\x17\xd3\x1c\x93?\xb6\x9ac\x96\xa3\xae\U000d6709Y\xdb\xd3\x11\x8e\x93\xb5\xc7J\xefW\xc4:\x86\xe4\xc5\xf3\xe7\x0e\x87\xb3s+ZCt\xae\x1e\x18]jp6!?\x01\x13\xc7V\x9fT\xc8\xe7\xd86\xe8_J?j\r\x1a\x9dr\x9ea\xbd[\xca\xd5\xf4s\xe3\xbdW\xa6\x1f\x82\xec\xa9\xcc\xd57\x1cl\xeeǄ\xe5\x9f<{'\a\xd8\x03\x97\xdc/E\xf1\xdez6\x8c\xf0\xf7W\xb2\xa3\xc1x\x93q;PI\x99z\x8b\xda\xdf$t\xafYx\xadp\xd2$|\ue41f\x83=\v\xe5A<\x00V\x87\x8d\x83\x9aQO\xb3\x1d\xfc\x00?\x99\xe9\x80U\xb4\xce\xf1\u009f)\x823\xb9_\xfd\u0602\xd1\u074b\xbd\xf4\x19\xab\xe9婆\x10\x13=Y\xb2qU\xb3䔀M\xbc\x97\xad\x17\xa7\xec9\xee%\x98U\xad\x01\a\x9f\xec\x1c\\]\x0fB\xec\xdb\xeb\x83\xff8\x01\x91\xea\xa3\xc8b\xb8/\xb1xj\x9d\xdc\x19\xa5B<\x1c\x12\x82\x92\xff`H\b\x10\xfb\x90\x10\xfb\xa3\xebd\xd5?\fF\xfa\xfc\xdc3\xd11\xec\bG\xa2\x0f\x83\x1a\x9f\xb4[\x83\xe8Ho\xba\xccOC\x87n\xe4\xed\xce\xc1@3\xf3\xd7{\x98\xa7$-c\xdf,\xffs%\x1b?\xce\x01u\xe7\x03I\x1f\x94\x8c1\bx;\xd0\x1cR\xd7\xe0D\x1a:Q\xa0\x9cs\xf2\x9e\xa5RI\x88\x02\xb5Ή\x8f\x9e\x0f\xbf\x97%\b\x9e\xee\xd4\xef-\xd4|\x0f\xf5;\xdc\xe4\x9d\xff\xddK\xfb\xa8\xb8D\x03\x1f\x8b\xc1c\f).\x1a$\xe4\xb0j7I\xb1s\xc5\x04\\рV\x15\x80$XҜҽ\x06oq\xe4\xce;L\x98\xc5M\xdc\xdeO\xe7\xef!wħU4G\xf8\xe0H\xef\u05fdzT\xab\x01ݩ\x12.7\x84o\n6[\x05\xfa\xa9\x03%\xd0\xfa\xd1\x12[2Н\\Im\xd7;\xcbOՇ\x82\v\x95\xe3\xe9\xb9TgT\xfb\x8a\xdd\xe1x\xa9M\x9a\xc1k/|\xfc\x8c\x9bP\xfe\x05\xb3\xda\xef\xb8f\xa7m#\xb7\xc1\xb5\xf9jvVH\xed\x1e\x8d\xf3Ct\xe1\xabu\x15Eԍ\x1f\xef\x17\xc9\x02$x\xcc(\x03\x19\xf6\xe5t\x13~\x90mg+\xe9\xb7LA*,\x0ezR\xf6Ż\xce\v\x13\xfc\x92P?\xa6\x03\x16\x85M)\xb5Yy\x86\x89\a\xf3(\xc9\x19q\aC\xca\xf6\xe0\xac\xef\xa7S\xb7\x87\xd17\xcf1\x96\xeeLgr2Dc.ú`\xdcA\x12\xb0K\xc4pb\xc3\xfaa\xe6\xcce@\x8az\xe9r\xb6\x18\x9cUr;\xf7\x92\xa9\x9b\xcb\xe5\xc0>f6\x97\x1f\xf9\x83\xe5s%\xb1\xd4\xf9\x117\xde<Z\x1f\xae\xa73bT\xc5\x16\xffw\x00\xdb\x19\x1d\x91\xc2\xf9\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xe4<k\x93\xe36r\xdf\xf5+\xba&\xa9\xf2\xaeK\xe4z\xedĹ\xd3\x17\xd7f<>Oŷ\x9eۙ[We\xb3IAdK\xc2\r\tp\x01pf\xe4\xcb\xfd\xf7T\xe3\xc1\x87D\x91\x90fu\xb9K\x96Se\x8b\x04\x1a\x8dF\xbf\xd1@\x92$3V\xf1\xf7\xa84\x97b\x01\xac\xe2\xf8dP\xd0/\x9d\xde\xffF\xa7\\\xbezx=\xbb\xe7\"_\xc0e\xad\x8d,ߡ\x96\xb5\xca\xf0{\\q\xc1\r\x97bV\xa2a93l1\x03`BH\xc3赦\x9f\x00\x99\x14Fɢ@\x95\xacQ\xa4\xf7\xf5\x12\x975/rT\x16x\x18\xfa\xe1\xab\xf4\xf5\xb7\xe9?\xcf\x00\x04+q\x01\n\xb5\x91\n\rj\xa3\xd3\a,Pɔ˙\xae0#\xb8k%\xebj\x01\xed\a\xd7Ϗ\xe9\xf0}\xe7@ܡ6\xf6m\xc1\xb5\xf9\xb7\xdd/?q\xff\xb5*jŊ\xfe\xc0\xf6\x83\xe6b]\x17L\xf5>\xcd\x00t&+\\\xc0[V\xa2\xaeX\x86\xf9\f\xc0OǢ\x91\x00\xcbsK V\xdc(.\f\xaaKY\xd4e L\x029\xeaL\xf1\x8a\x9a,\xe0\xd60Sk\x90+0\x1b\fC\x81\x1f\x8b\xda\xffIKq\xc3\xccf\x01\xa9\xb6m\xd3j\xc34\xfa\xaf4\xfb\x00Ŀ2[\xc2O\x1b\xc5\xc5zh\xc47p\xa9\xa4\x00|\xaa\x14jB\x1br\xbb\xa6b\r\x8f\x1b\x14`$\xa8ZĠSa\x96\xeal\x83y]\xec\xe0\xd3\x7f9\x85ѝ\x1b\xaa.L\xa0C\xc1\xb4\xb1X\x1cC\x17\xea\xf4\xae\x16\xa9\x03\xe5\x9b9\x84~\xa2O\xdd\xd71(\x11<0\xbcD`\x87p\x81\x8ai\x8d\xf9(J7\xdd&-:\xbd\xd7\x0e\x9d\x9c\x19\xf4\xc8t@\x051K3\x85V\xc2\xeex\x89ڰ\xb2\xea\xc1|\xb3\xc6\b`$Hi\xc5\xea]\x8cn\xba\xaf\x1c\x80\xa5\x94\x0521k\x1b=\xbc\xb6?h\xc9K+\xf5\xf4KV(\xde\xdc\\\xbf\xff\xe6\xb6\xf7\x1a\xfa\xf4\xfc\xef\xa4y\x0f]9\x04\xae\x81\xc1{+ϴ\xcaVǀ\xd90\x03\x15*.s\x9e\xb1\xa2\xd8\x06\xa2k\xcf\x1d\x1d>\xa0\xbf%\xcb\xee\xeb\n\xb80\x12t\xa6\x98\xc96\x16e+\xa0zN\xf2\xc9W\x1c5p\x03L\xe4\x90\xd1\xc4쯺\x9a\x03#\x14rŋ\xa2\x03\xb2R\U000812f5\x1dρא1\x01ˆ\x01\xf2\xb4i^)Y\xa12<(\"\xf7tTl\xe7\xed\x18a\xe8!Z\xba^N.\xfd\x9c\xbd\x8a\xc1ܓ\xdfq#נ\x90\xe4\x18\x85Ӿ\xf4\x9a\t\x90\xcb?afZ\x04\xdds\x8b\x8a\xc0\x80\xdeȺ\xc8IE?\xa02\xa00\x93k\xc1\x7fm`k\xd2\x01-\xa1\x89\xae\xa8\x04+\xe0\x81\x155Ή\x84;\x90KFKDcB-:\xf0l\a\xbd\x8b\xc7\xefI|\xb8X\xc9\x05l\x8c\xa9\xf4\xe2ի57\xc1\xf0d\xb2,k\xc1\xcd\xf6\x95\xb5!|Y\x1b\xa9\xf4\xab\x1c\x1f\xb0x\xa5\xf9:a*\xdbp\x83\x99\xa9\x15\xbeb\x15O\xecD\x04M_\xa7e\xfe\x0f\x81\x8d\x82B< \xf1\xee\xcfڌ#\x96\x87,\x89cZ\a\xcaѤ]\x85\xc03\xef\xaen\xef\xba\f͵_\x94\xb6\xa9>\xb4>DM.V\xa8\\\xbf\x95\x92\xa5\xe5\x01\x14y%\xb90\xf6GVp\x14\x06t\xbd,\xb9!6\xf8T\x93\xed\x02#w\xc1^Z\xe3L\x9c[W\xa4\x15:\x8c\xeb\xfe\xae\x05\\\xb2\x12\x8bK\xa6\xf1\xaf\xbcV\xb4*:\xa1E\x88Z\xad\xae\xcb\xd1\xfes\x8d\x1dy;\x1f\x82\xd3p`i;Z\xe8\xb6¬'mԕ\xafx\xe6dj%U\xa3\xa4z\xf0 \xe8\x02k\x98\xfa\xa4\x1b\xd6\t\xf4l\xa4\xbc\xdf{9\xc5w\xf4\xfcH\x1d\x81)\xec\xd9!\v\xce\x1a(\u07b3\xda9T2\xb7\xa2l\xd5ߖ\xbe\x95)\xdcmpփj\xff\x0eٷ\x15\xe3\x85\x06\xde\xff\x92K\xd4\xe2\v\x03\x99,\xab\x02\r\xce\x01\xd3u\xea\xbc\a6\x00\x9c0\x84Ǎ\xd4\bR\\)%\x15I\xd0\x0f\x8c\x17\x0e\xfe.ύ\x11\xcf\x13݊\xd5\xe0G\x00n\xb0<\xf0)\x86\xca=\x1b\x15\xdc^\"}\x8fK\xa4@\x90\nJ\xa2G\xdbV\xc9ڵ%\xa5\xcdLдK\x04|¬6\x98Òi\xccA\x8a\x83#[J\xd7\x05j?Vn\xf9\xafkΚ\xf9[U\f\x05[b\x01\x1a\v̌T\xfbČ!\xa9Յ\x80OYQ\xe7\x987\xce\xedH\xdb\x1dR^\xedu\rB\xe4E\xaa\x9d\xc0\bH v}\xdc\xf0l\xe3T\x9f\xe5\x1c\x82cy\x0eH\x8d\xb1\xaa*\xb6\x87&9\xb9\xfc\xa3\xdae\xf7\x11uQ\xb0e\x81\v0\xaa\xc6\xd9\xc1v\x1e\x1eS\x8am'i\x1b8\xeax\xd26=w(۰\x03\x189\x02\x13\xfe\x8f\x12\x96\x8b\x93\x99vD\xfe\xe9\xefZD\xf3\xf4A\xbe%v\xe5\xa8S\xb8^\x01\x96\x95\xd9\xce\xc9\xed\xf4oGG7\x12XQt\xc6\xf8;^\x9b\xe3\x99>ribd\xe2L\v\xd3\f\xf1w\xb8.\xd6d\xdcz\x8b\x11\xbd&?u{\xcd\xc9+\bD\xcf\xe7\xb0\xe2\x85A\xb5C\xfd\x93T}X\x99\xcfA\x8c\x18\xabGOI1\xe3U\x93\x12\x99h\xbdC\x97\xdd\xce\xe4\xdd0\x9bw\xa2p\xaco\x9e'\xe0\x92s\xf3\xa9\xe6\nK\x1b \xf8\xd4H\xfb\xc6z\x7fo\xde~\xbf\xefğ\xc0y\xc7\n\x9d\x0fPwf\xd4\xc5\xcfGF\xe1\x8b\xf5\x81(2`\\h\x17)\xe990\xb8ǭs](T\xadP\xb1\xd08bx\x856*\xb5\x96\xef\x1e\xb7\x16\xccp\x98y:7\xf8\xd0\x10\xb71\xcdvhH8\U000509e0\x95\xa7\x1747\xfb*\x9a\rB\n\xc1\x8a\xc2@P\xf7,]\x12\x9e@\xfb\x13\xa6\x19\xc5*\xdd1:q\xaf\xe3\x80/(h-l\x84\xa57\xbc\"u@\xaccs\x80\xb1\v\xea\x9e\xf7\xac\xe0y3\x90\x93\x91k1\x87\xb7\xd2\xd0\x7f\xae\x9e8\x05\xc6\xc4(\xdfK\xd4o\xa5\xb1o\xceBQ\x87\xf89\xe9\xe9F\xb0\x82&\x9c\x96'\x82u\x93\x11Φ\x11\xb75\xb4\xe7\x1a\xae\x05\xc5+\x8e$\x91C\x11\b?\x9c\x1b\xa8\xac\xb5\xcd#\b)\x12k3\aG\xf2\xf4\x96\xaaG\xeeg\x0f\xea\a\xbc#3\xee\xd0qٯ\x82\x92\xf0\x90ז\x006-\xc3\f\xaey\x169^\x89j\x8dP\x91\n\x8f\xe3\x88H\xc5z\x12\xfb\xc4Y\xef\uefe7\x84\xb6V\x94\xa0퉄LN\xe2!\x18YF\xd0\xc0\xeb\xee\x9d\x14\xd8Г\x90\xccF\xb4\n\x9c0\xd9\xf4@\xd6\xe6yDy\x069\xac\x15\xb7.\xce\xe4\xeavwx\xe2-\xca\x11\xbcp\xacj\xe8\xe0n5\x03\x94\xac\"\xb5\xf0g\xb2\xb4V\x9a\xfe\x02\x15\xe3J\xa7\xf0\xc6\xeel\x15\xd8\xfb\xe63G\x1d0\x11CV4\x14\xf1\xcf\x03+(\x15I\n\\\x00\x16\xd6S\xa1\xd1w\xfd\xa2\xb9O\x02\x91E\\q,r\x02pq\x8fۋ9\r?9dW\xc9\\\\\x8b\v\xe7C\xec)\x8c\xc6ᐢ\xd8\u0085\xfdv\xf1\x1cW*\x92S#\x9b\xf5X\xb4dU\x1c\x87R\x18\xb8\x98Er\f\x85\xc2\xc1\t\xa1\x8e\xcdf\x01\x85?\xe9\xec\x99,ZIm~\x1c\xcea\x1e\xc0\xe7&\xf4\xe8{\xc6\x039\xb6\xc9\xc8\xcb\xe7\xd1\x1a}/r`+\x83\xca''\xed\xbb&\xfeHg\xcfR\xe3\xbd9\f \xdb$\x03Y\x93\x1a%\x02\x8f\xc2\x04\x9fM\x8eA\xf1\x18\x87\x95\xe82\xd5fgFWO\x9d|&\xa3\x1da\xccz\x13\xf9\xdc\x0e5\xed\x16\xb0\xdd\xed\x96(T/]\xcf\xc0\xd3\x1e\x90\x15\x7f\xa6\xd65)\x1c=\x8b\x00\xda\xe7!\xbb\xb1\xf2\xc8͆\v`Am\xa0\xf2\f\xc5(\x7f\x1e\tt\xc34,\x11E _\xfe\xb7\xe0J\x94\\\\\xdb\x01\xe0uT\xfbx+\x1b\n<,\xb9\xce\xe9\xec^6kҬ|\xf3\u0099\xacJ\xe6\xb4\xf1\xa0\xb0\xc7\x18\xfbyw\xeb\xa9R\xfe\xb8MYD\xe2\xe0G\xf9BÊ+\xddĳ\x0e\xa7ZǮ\xf5\x91\xcbGx\xd3F\xbf\xac\xcd9\t|\xd5\x0eӨ\x02\x9apɞxY\x97\xc0JY\v\x1b\x92\xd9B\b\xbfQ\xef\xc9\xfbȸ\xb1\xea\x8cz\x90\xe6#\xe1\n\x9bB\xb0ĕT\xd3F\xbd\xe1&\xcdsTa\xfb\x94\xa6_\x93\x8b\x05\xcc\xee\x11\xd5jBS\x9eHf\xbf\x1fu\x02\x89\x7f\xf6;Y\x81\x9f(\xb7\xf8\x18*\x19\x1c\x81\xa2\x80\x02,q\xc3\x1e\x90\xd2i\xdc\x00\x8a\x8c(N\x994R\xc9v\bO\fK\x1a\x1e\xab\xe7\xe2\x148=(\xea2\x8e\x00\x89\x15H.FSn\xed\x93\xd8=\xbes,\x1bq\xde\x0fR\xbdC\x96\x9f\x92\xa3\xf9\xa5\xd3\x1dP\xe8\x9a*K\x82\xeex\xec\x17\x82\x8c\xfd[R\x8e\xa7\x16T\xedDJH\xf4u\x83\x03υ6\xc8byA\xae\xe0]-\x04\x17븵\x8bN\x84\xb6\xcf~u\xcf\xf8?\xa2\xb5W\x11\xe7\xd4D\xbf\xb4\xc3<S\x13\xb5\x8b`$\x99\x00\xbb\x0e\x91X8\xa5\x05\xcc\x18J7XmԖ\xc3y\x0eI??G\x1f\x13\x86{,&[F\x86#\xf4G\x15\x9d\x8b\xd9Q\xebz-x\xbbNLX\x10gu\x1ei\x80\xc6\x1d\xd0'p\xe2u\x0f\x00\th\x88C\bt+\xbaG8\x92K\xa4bO\xcc\xc9\xeeYw1\x84%\xae\"\xc7\x06\fg\xf3\x04\xa3Vv0\xe8\xa4]\x0e*5Jjq/\xe4\xa3Hl0\xae\x8f\xd6!\xb1\xae\xe2g\x1eޜ\xac\x8c\xa6\xf5K\x14L\x88\xd1B}~\x8d\x84\xdb\xf1\x9fΠe\x8e\xe0\x1bW2\xb4\x98\x1dE\xde\xf7\xb6S\xab\x15l\xa6 \tJ\xc1\x82\xf4%U\xb3\xcf\xe5\xbf\x1c\x1b\x80\xfa\xf58\x81w\x9a\xb5l\x83\xd0慈J_y\x8ce\xde\xd6d\rD%\xbb\xf1F$ؿNTB\xe5\x9a'\xd0\xeeǻ\xbb\x9b\x96-\x84\xfb\xbdAV\x98\rd\x1b̦R&\xe1\x1f[S^\xcf\x04\x12\x9d\xcdE:\x8e\xab詨\xbc:\xb2\xed\x0eq\xa8\xcc;\xf0\x14\x81!\xee\xf0՜ceb\xfb\xff\b\x80\xa5\xacծ\a\v\xc1\x9e\xcd\x04\xf4WIeN\x9d\xafTf_\x86\b\xe0T\xfdR\xff_&\x85\xa0z\xdaؽQ\x9f{+\x99YPE\xf37_G\xf7r\xf4\xa1*\xe85\xc6\xee\xdc\xda*\xedь\xed\b\x89l)=\x12#\xd4\x1a\xad_\xeb'\x1b\xbf@ޚ\x04I\x81\xefq\xc5\xea\xc2\xd6\a[\xf1\x8b\xa7Y|xHOb\xa1\x1f\xd9\xfc\xf6|\xac\x1a\xefYӓX>\x9c\x9d\xc1\t\x93\x82b\xe1ZE\xb2\xc4i1\xd4\xcfa\x90ƞ\xb8\xac\x84ˡ`\u07b3\xc1\xc0V+\xccLS\xb1c\x9dU\xf8\x85)\xcabfR唪\x7fd\x8a\x82\xd1\xd8\\\xd9\rS\x86Ӂ\r\xc2\x03\xf3\x16PHe0\x91C\xc9\xd4}o\xd4\xddn}n%\x8c\xd2\xd9\xe7\xe5\xd4\xc4\xce3\xb2\xe9\x0ev\xb33\xf0\xa9\xfeT\x9c\xc0\x17\xb7\x7f\xf8\xa9\xe3l}\xaaQmC\xb8\xea-e\x14L\x00\x06TTO\x95\xc9\xcev\xe4\xb0\xdc\xf6\xf5\xf3ߐ\xa9\r\xa8ƶ\xdf!\xda\xf7a\xa6{\xfbc\xd8P!\x1a\xb2\xf7؏7DG\xeb1\xe2\xee5\x17\xa7\xce\xfa\xcav\x0es\x0e\xf3\xf40c\xa5\xbb\xad!veLކ\xbb\x83(\x94\t\xef$K\x8e\x00i\x19\xf7|\xf6\x88\x82\x90\xb5\x9a\xa8E\xec>\t\x94[\xfd\xa98\xe7Z\xda)\x9f\xb8\x94\xd1ր\xfe\xfe@\x03\x85e'}A\a\x13\xed\xfewg#,\xb5\aH\xfd\xae\xb8-Us\xca\xfa\x05y\x1e\xf8\xc4(\xa1O:\x82?p{.m\xb9\x85_)\xec=\xca;\xa5l6U\xf0\x80!\r\xf1\xd2Z\xa4p\xb2\xad\xb1Ig\x15\xa0Z\xa3:\x91\xe6\x7fԨ\xf6\x84\x87\xe0\x9d\xe6\xb22}Ɖ\x1e\xeb\xf18\x1d\x10\xd9\xd82\xee9\xfc\xa3ӓ:\xd1\xf2\xf0\x99\xb2\xcb\x14\xaf~\xbe\x8d\xae\xbeGvֽ\xae\xff\x8f\x89|\xe56Sڕ;\x03e\xa39=\xb2\xe1truJ\xc4\x13\xeb\xd5\xccN\xc4bl\xfc\x91\xce1\xe7p\xa69j\xe0̍\xad\x19\xd2\x05Ϭ\x9f֜\x87\xb1s\xb4\xf1l\b#\x9a\x83\xb2\xee\xc0\xf6\xd0R_\xb1l㽽\x92\x14\xba\xef\x9aSF\x80r\xf8{\xa7\xc7\xed(\xa1ƈ\xef\x1d\xa9\x1e\xc9\u070f\xf2\xd0a\x1a\xbb\xc3\xf9\x13\xa4s\xc7\xf5;Q\xde\xe3\x06\xcd\x06U/\xaa2\xfe|\xbd\x83\b\x83%\x99B\x9a\xd91\x1b\x84ẇ\t\xfcn}3\x1a\x9eE\xdf7\xb1\as\xec|\xed\x04\x89\x03\xa2oY9\x85\xec \x1f\x86\x19\f\x97\xd2aC\b_\xd2h\xcf$\xb4'b\xf3\xe6J\x02}xRy\xf7\xec\x91ٌ\x01i\xbb\x1cG\x03\xcb̗E\xad\r\xaaӨЅ0D\x87[\xcc\x14Zm\xce\xc00\xb5F\x03\x99k=\x0f%:\x83\a\xa7CM\xa3\x15\xb2\xf9\xeei:\xaa\xe0\x0fSv\xa2Y\x8bܱ7W \x1f\x85\x17~\xbf\xb9\x0er5\x00\xfe\xc0u\x10)\\\x9b\xa0A\xfdio\x8ayU`D\x8f\xf5\xa6^B)s<\x85\xe2\x8d\xf2\xbaQ\xb8\xe2OϠ\xfc\x0e\xa4\xb0\x02\x95\xfb\xe5\xd7\xc0\xd1\xc2\xff؟\xf0\x00\xf4)r\xf730\x17\xfe[B\xec\x99\\\x1cA\x91aC\x954\xe7\x02\xdf\x0e#\x994\xe25\x8b0>\x14\xd2\xd4;*x\xa8t\x93\xee\x1b\xf1\xd7\xedd\xac\xa2\xdb#|\x00[+E\x01\x11\xc1\xb1&&\xe2\xac\xff,.\x87\x91I\xe1*\xc5\xf5)<p\xd9\xf4\xf6\x8d\x978\x8c0\xbd\xecL\x92\x04\x8f\x91\x17U\xfap\x9d\xbb\xea\x16)\xfc\xd9Ł\xb1\xbc\x1b\x16\xcaR\xf5\x1c\xb4\xf4ǖ\xa4,h\xaf\xfc\x1e\x816q3S8\x87\x98Ry\xbf\xe3\xe6\xe7J\xf7\xb6r\xdc5)\x94\xb7&\x1b{\x84\xbd\xecѣ\x99zp\x02\xe94\xbc\xa1\xcb\x02h^\xf6\xf4=#\xeb\xd7\xdc\x18\xe4i2\x00\x17\xbat\xe2\x1a\xde\xdc\\C\xa8\xe2Mg\xc7g\xa4\xe8Z\xa0;ń\xb6\xf8\x91C=\xdc.f\x85\x0fA\fr\xde^A\xe4\xfdaO\x14Ӵ\xc6ܹ=D\x11\x9agm=\"&$\xb9\x03\xe9\xec`,D\xa7h\xbcϽDo\x887\xe8\xd4l\xb1%\xe3\u070e\x96m\x98XSZ֝\xf6av\xfb\x86j)\xed\xf6\xbdU\u07b4\xe2\xc1϶\x11T\x03\x91\xc8m7\xf8\x03\x18\xea̲\f+\x9b\bHg\xe3;5taIB\x10\x0f\xb4\x1bQƾ\n\x16\xb5f\xebg\xaf\x91\ac\x91\x87M]2ʒ\xb3\x9c\xa6\x10\x86\x00.\xe8\xba\"Ct\b\xccʖ\x14qZ\xaa4K6\xb1*t\xfb\xcb\x12\xdb|\x89\x9bۡN%{\xfa\tŚn\x8a\xfa\xe6\xeb\x7f\xf9\xf67\xa7\x92I.]\xe6\xf7w(\xe8\x90\xc5ޥE\xc7Sl\x1fb\xf7\b \x91\xa4\xbd\xdajݶi\x8eJ\xb6\xfc\xf7ȴ=\x18HY\x97\x1c\xeaj\x8c\x84?\xd0\xf1\x10\xa1\r\x13\x19\xda#ʃ\x83\x90Bt\n\xa3\xd8\xc2\xeb\xaf\xe7\xb0\xf4\xab\x14.\xeej\x06\xd7\x1f\x9e>\xa6\x03S\xe1\x1a~;\xdf\xc1\x93\xee\xf8\xa9\xadFj.\xdf\x1az\xa8✌\x89U_Fv\xd5W_\xa5\x87yL\xc9\b\x17\xe6\xdb\x7f:Ц䂢\xee\x05|5;usS!\xd3\xcfg\a\a\xa5U\xe7\x8c\xcc\xe6Z\xb1\xb2d\x86g\xc0s\xba\x15h\xc5Quň\xa8\xe0;v\x92\x02N\t~\xa1\xbdz\x8c\x10\xac\x1b%\xf3:\xa3\xa2Z\xd9\x1cZ\xcf:+GZ\xc4I\x9eK\x0e\xd1\xedx\x98\x99\xe6\x06+{ҠDF\xb9\x04\xed\xf3\x13t3\x13\xe9\xb5\xc3\xd9s\xea\xd4\r̚SJؤ\x810\a\x06\xeb\x9a)&\fbN\xc6\xe9\xf0,\xee\x02\x8c\x8e\xe6f\xed\xd5M\x13\x9a«\x17\xa7\x8bi\xaa\xfeR(\xabe\"\xd4\xcb믾\x1ea\xb2\xa6Ձ&\x15\x95T*\xb1\x80\xff\xfc\xf0&\xf9w\x96\xfc\xfa\xf1\x85\xff\x9f\xaf\x92\xdf\xfe\xd7|\xf1\xf1\xcb\xceϏ/\xbf\xfb\xc7S\x15ِ7x\x80[\xbd\xbd\x94\xab>cͭ{!Wp\xa7足\x1fX\xa1q\x0e\x7ft\xb5r\xe9\xec\xf8]\x89\x04.\b\xd4\xc5\xe1\xcfv\x8c\xc3\xdf\xfdا\x92\x84\xb8;\x8a Ԑ\x94O+\x18\xbcs5\x18\x9d\x1f\xe6\x02VR\xa6~S \xcdd\xf9\xaa\xf9\x1e\xc1C\u07fc\xfev\x92?^|p\\\xf0\xf1Ň\xc4\xffߗ\xe1\xd5\xcb\xef^\xfcG:\xfa\xfd嗯^~\xf7\xa2\xc3[\x1f?$-c\xa5\x1f\xbf|\xf9]\xe7\xdb\xcb\x13\xd9l8\xae\t˵\xef\xcf\r6\xf3n\xc3\xe07\xa7\xf4\x06?\xe9\xeee\x9f\xdd'\xb1\x9c0\xf0a$i7\x96\x89\xda)ۤjY{^\xf1\x1e\xb7\x03\xf2u`\xf4}\x10\xd4lA\xc7Gwڶwe.f\xa3\\:hd\xda+5\x83\xef\xac\rS\xdey\x8e\xb8U\xd4EJ\x03\x80\xdd\r\x9f\xe9\xec\x90\xf1=\xec\xa0N솏\xb0\x98\xbf\xc9t\x82\x0e4\xe5w\xb5\xe8\xc5\n\af\x97\x1e\x8b\xdcx\x10\xe4\x92[C_vP\xfc\xd7&\x81\x152\x0e;\u0605D\xd9>\x82\x13$\xf2\xe7\t)Q\xe6e\xcc^\x89\xba\x98\x9d\xee\xa3\\\xee\x83k\nX\x9a\xb8\x86\xfe\x87\x88L>i\x93\xa8#\x8b\x91!\xf0\x83\x87\x10\xf7\x932\xf0\x88\x8a\xf6Α\t\xcc\xe1\x10\x01\xa6\x99,b-#(\xe9UQ\x04\xf5~\xbf\x17\a%{q\x90OV\x90\x03\xf7\xb8\xd9\xd7*\x1e#OH\xda\xf1\xc2\xfc\xa4\xf5\xf7<\x14\x81\xb5O\x8e\x8c0b\xf3\xb3\x16\xa7\xe2R\x17&\x0e\x15\xba\xe8\xd8cҿ\xf6\xf8\xe0\xe0\x87\xbd\x8b\x04\xae\xc5\r9Ҩ\x87\x99/\x81\xdeM\xc3\xfd'\x81\x91\x9a\xa6\x89\x19[\xfdz\x8c\xe0\xdd\xf6:\x8c\x8b\x96\x05\x8e\xf9\xff\xa6T\x8cX\xcd\xfdxp1\x1b\x9d\xfa\xa0\xce\xf9y0\xaa$*t\"Ձ\xec\x9e7ntm8\x91\xca\xea}\x7f\xb3+\xac\xa4J\x0f'ܛ\xec\x1e\xd8\x13\x87B\x068\xba^\x86o>\xf1\xd7C\x82\x15Z\xfa\xf4\x8dnsE\xbe/] \x98\xce\x0e\xad\xd1pl:\x16t\xda\xeb\xcd'\xe8y\xb3\xe9Tp\x85\xd8\xd9v\x1c \xd8,N\x9a\x12x\x8b\x8f\x03o\xaf\x04[\x0e\x89H\x90\x1d{\xfb\xd0\xf0\xa9\x86\x11\xfezhz\xd9c\xa4zb\u0083\fԎ\xec`\xec\xec\x94\xd2\xed\x81\xed0\xae\x00S\xc3\v>\xb4Wb/\x9a\xcah\xa2/\xe33\xb6'\xedp\x0e\x8a\xd5\xdeK'\x19\x1dѥ\xd5d\xeb\xae0wxV/\xe0\xcf\x7f\x99\xfd\xcf\x00\x93\xd7;\xac\xd7`\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}\x7f\x93\xdb6\x92\xe8\xff\xfa\x14\xa8y[e;+\xc9\xc9\u07bd}\xb7Su\xb55\x19\xdb{sq\xec)\xcfĩ\xba\\\xee\x1dDB\x12vH\x80\v\x803\xa3\xdd\xecw\xbf\xea\x06@\x82\x14\xc1\x1f\x9a\x1fI\xf6d\xbaʖ\x044\x1aݍF\xa3\xbb\xd1\\,\x163Z\xf0\xcfLi.\xc5)\xa1\x05g\xf7\x86\t\xf8\xa4\x977\xff\xa2\x97\\\xbe\xbe\xfdjv\xc3EzJ\xceKmd\xfe\x89iY\xaa\x84\xbdak.\xb8\xe1R\xccrfhJ\r=\x9d\x11B\x85\x90\x86\xc2\xd7\x1a>\x12\x92Ha\x94\xcc2\xa6\x16\x1b&\x967劭J\x9e\xa5L!p?\xf4\xed\x97˯~\xbf\xfc\xbf3B\x04\xcd\xd9)\xd1ɖ\xa5e\xc6\xf4\xf2\x96eL\xc9%\x973]\xb0\x04\x80n\x94,\x8bSR\xff`;\xb9\x01-\xb2W\xae?~\x95qm\xbei|\xfd\x9ek\x83?\x15Y\xa9h\x16\x8c\x87\xdfj.6eFU\xfd\xfd\x8c\x10\x9dȂ\x9d\x92\x0f4g\xba\xa0\tKg\x848\xfcq\xe8\x05\xa1i\x8a\x14\xa1٥\xe2\xc20u.\xb32\xf7\x94X\x90\x94\xe9D\xf1\x02\x9a\x9c\x92+CM\xa9\x89\\\x13\xb3e\xe18\xf0\xfcYKqI\xcd\xf6\x94,5\xb6[\x16[\xaa\xfd\xaf0[\x0f\xc0}ev\x80\x9b6\x8a\x8bM\xd7hg\xe4\\IA\xd8}\xa1\x98\x06\x94I\x8a\f\x14\x1br\xb7e\x82\x18IT)\x10\x95\xafirS\x16\x1d\x88\x14,Y\xb6\xf0t\x984\xbf\x1c\xc2\xe5z\xcbHF\xb5!\x86\xe7\x8cP7 \xb9\xa3\x1aqXKE̖\xeba\x9a\x00\x90\x06\xb6\x16\x9d\xf7\xed\xaf-B)5̡\x13\x80\xf2»L\x14C\xb9\xbd\xe69ӆ\xe6M\x98g\x1b6\x02\x18H負\xa5fi\xa3\xf7e\xf8\x95\x05\xb0\x922cT\xcc\xeaF\xb7_\xe1\a\x98u\x8ek\t>ɂ\x89\xb3ˋ\xcf\xfft\xd5\xf8\x9a4)\xfaӢ\xfa\x9eT\xdc \\\x13J>\xe3*!\xca-[b\xb6\xd4\x10\xc5@\f\x980ТPl\xe1I\x9d\x12\xa9\x02P\x05S\\\xa6<\xf1,\xc2\xcez+\xcb,%+\x06\xdcZV\xad\v%\v\xa6\f\xf7\xeb\xd0>\x81z\t\xbe\xedC\x1f\x1e\x98\xb1\xedeŔi\x94L\xb7\xdaX\x8a\xa2\x91S\xbbx\xb8\xae\xe7\x83\x1c\x84\xaf\xa9 r\xf5g\x96\x98\x1aAG\x1d\xa6\x00\x8c\x9fE\"\xc5-S@\x91Dn\x04\xffk\x05[Ò\x80A3j\x986\x04׳\xa0\x19\xb9\xa5Y\xc9愊t\xd6\x00Lr\xba#\x8a\xc1\x98\xa4\x14\x01<\xec\xa0\xdbx|+\x15#\\\xac\xe5)\xd9\x1aS\xe8\xd3ׯ7\xdcx\xa5\x9b\xc8</\x057\xbbר?\xf9\xaa4R\xe9\xd7)\xbbe\xd9k\xcd7\v\xaa\x92-7,1\xa5b\xafi\xc1\x178\x11\x01\xd3\xd7\xcb<\xfd?\x9e\xdf^?DV\xa6\xfd\x8b*s\x02{@\x97Z鲠,Mj.p\xb1A~}z{u\x1dJ\x1e\u05ce)u\xd3=\xbax\xfe\x005\xb9X3\xa7\v\xd6J\xe6\b\x93\x89\xb4\x90\\\x18\xfc\x90d\x9c\tCt\xb9ʹ\x011\xf8Kɴ\x01ֵ\xc1\x9e\xe3\xc6\x04B[\x16\xb0v\xd3v\x83\vA\xceiβs\xaa\xd93\xf3\n\xb8\xa2\x17\xc0\x84Q\xdc\n\xb7\xdb\xfa\x8fml\xc9\x1b\xfc\xe0\xf7\xcc\bk\xbd\xae\xb8*X\xd2XjЏ\xafyb\x17\x14\xa8\xe4J\x95\xb4\xd4r\xdf\xea\x87'e\x05\x13\xa9\xfe\xd8R\x00\xc3R\x06\xcf\x1bߙ\xe4\xf4ơ\xb6B]\xe4v\xce`\x9b w\x94\x1bD\x15D#\x97\x1aW5ȇ\xed\x01\x1d\xa8\x90f\xcb\xd4lo\xa0\nʜ\xe0F\x00z\x80\x8b\x849\x15\xa0C \x8dQ\xe7\xa0'\x12\x99\x17\x193\x8c\xe82I\x98\xd6\xeb2\xcbvd\xc5\xd6R5\xc9\xe4x\xb5e;\xa2\rU{ډ\x10Qf\x19]e\xec\x94\x18U\xeew\x8e\xd3\x19\x9e5\xe5Y\xa9إ\xccx\xb2\xebj0\x86\xe6\xf0\xbc\v\x01\xc1R\xbf\x03Ϳ\xa5E\xc1\x04,/BIZzV8\v\"J\xf4\x0e\xfb\xa6\xfdX!a)\x91\x02'\x01\xffS$\xe5\xa9xaj\xeaZ\x82\"K\xc0t\x90\xa59%W7\xbc\x98\xe3W)[\xd323s\xa2ox\x81\xa2\x12\x19\xccaV\n\xc33hF\x04\xbbw\xc6H\x88*L;\x05U\xff\xa9\x14\xb0\xd5i\xc2\r\xa1bwGw\xfbl\x83\x87\x892\xef&\xfa\x02ь\xfc\xf4\xa9\x14\x9d\xbfD\x96\xbf\x7f<\x9a#\xd8\x1cZ\x040C0iڌ\tY0'\xbc\x1b%\x14]\xa2\xa1\xbb\xf0f\xf0\xf2\x10\xe4=\xfb\x86q\x8f\x8a(\xd8i\xb240\xa7\xad\xbc#\x99\x14\x9b\x96TR\xd8\x13\xfa\xf5A'\x05\"\x03J\x11.\xf59\x91\x82\x91\xad,\x15Y\xed\xbc\xec\x1d@\vس\xb8b\xad\xfd\x17\xfe.*\xcc\xf6~\x8a({\xf8\xfbgn\fS\xa7\xb3\xe9D\xfdw\xec\xe9e\x04\xe9իn\xa9b$e\x19ݱ\x94\xd05t\xf5\v\x13\xa4d\xf7\x02~.\x19\xa1f\xde1\x98\x06㊚\x06\x03\xb4k_\v\x19\x02K%(\x01\x9aeN3\xc3\xcf\\y&RC\xa4H\xd8\x12O\x15\x88N\xc7hrM\x18M\xb6\xbe\x0fפ\xe0\xc9\r\xe0m\x88\xa2\"\x959\x1at\xde(\\1\xf8\x9f\xb2S\xa2V\xb5\xa1\xfdwK\xb3\xb6\xd4,g\x13\xd8m\x8f\x06\x03̱\x87\x05\xbf\x033н\f6\xadư\xc0&\v\r\x14\xa5\x90&\x82Fx̨\xff\xa0\xf5\xafn\x995\xeb\xf5G\xe15\xc4\x1b\x06\xdb\xd8!\xd2s\xd9\x0f22\x9dJ\xb8\xee\x04Ka!\xe1\xde\xea\xbb\xce\tmZD\xf6)5\xfbx'\x98\xfa\xc4\xd6L1\x910}!\xdc\x01\x056ff\xe6(\x9b7\xac00\x98 ܼ\xd0 \xaa\f\xec>\x14\x14\t\xfd\x89\xaa\x00@\x87\x8e\x91\x14\xcb\xe5-Kk\xeb\xd3\xe3\x1b\xecD\x1eY«1\xe6DQ\xb3\r\xa5\xa7\xeeץ\x03\x88\xefH(\xaa\xb1;n\xb6\xb0\xd9 =\x18\xd9P\xb5\xa2\x1bF\x12p\xa3$F\xaa\xe5$f+f\xac\xb1y\b[?\xf9\xce^/l`\xbd\xacqz\v\xf7\x8f\x96\xa2\x1e\x84\x14h|\xf8e\x12\xd3\x1e\xfbS \xe4:h\xcf\rI%Ӱ\xf2o\x18+\xbc\xb2\x01\x0e\x12v\v\x0e\x8b\xad,7[\xa7\v\xae\xafߓ-\xc5\xd6\xec\xbe\x00uJv챍+\xc0\xe3\r\xe5\xd9\x18\xc3\xea\x1b\xdf֓M\x94\xf9\x8a)O\x154(S\xba\x83\xb5-5#\x82\xdd1\xe7\x90\xda\x7fj\xa5\x05\x12\xddE8Br.x^\xe6\xa7\xe4\xcbΟ\xadx\x80\n\xdbt\x1a\xbf0\xb5o\xa50\xdbѓs\xad{\xa6\x97C\v7\xc1N\x98\xc4M\xfb\xb9&\xf8=c7\xa3\xe7g\x1b\xf7L\xef\xe2\xea#\xb9c\xec\xe6\x971\xc3\x1e\x83\xc0\xaf\xb8\xd3Y\xef\xa4;W\x7f\xa8\xdb\xe8(\x0fb\a\x90ڧ8i\xaf\xd47\xbc\xb8\xc8s\x96rjX\xb6;\b\xfd&\x88\xae=H\xe2i\xa1bк\xb1\xc1\x829\u0083\xfe\xb8\r\xfc\xb7o\xb1\xef\x85\xfco<D\xa0\xf3\x10F\x10\r`\xa5\xa8\xf7\xeb\xd68\x82\xddu\xc9\xc4\xc5\x1a\xd5\xd4\xdccwǳ\f<\x18\x80q\xc1\xd2\x06j\xf1\xe1\xf8\x1a\xb6\x127\x9b\x15\x85\xaf\xa4 K\xeb=^־\xd2\xca\xef\t\b\xb6\xb0\xb3\xd6\x11\x8e\x0f\x1eZj쑩j\x05ӎ\xcc`M3ݚ\x82s\xc4L\x9aƜ\xacJs\x18\x06,/\xccnn\xfb\xaee\x96\xc9;\x82\x96\x8a\x82\xd8ĚoJe\x9d\x1c/\x9d\x11\x7fjq~5m\x97\xd5F*\xbaa_\x97\xe9\x86u\x9ck\xa8\xd8}\\\xef\x7f\xbd\x18X\u05cb\xbe\x152j\t\x84hyu\x86\xb6\xbdC\xb8w\x97F\x9ff\t\xfc\xa3E\xa1\xe4=\xcf\xc1u\xe6쒎\xd12\xb9\xe1\t\xcd\xc8jg\x98\x83\xc6\xc8-\x04A\x18\x01\x97\x95\xf7|Hء\xcdV\xf9-\x9c\xacyƈ\xdei\xc3r/* q\x80\x1b\xf4\xeb\x18\n\f35\xf7\x86X\xcaҲȼ\xbb\n\xba\x82\xd3\xc0)*0#\to\x1e\x01\ue602\xd0\x038j\xe0 \xb7$\xdf\xc3\x02b\xf7\tc)K\xbbN,\x80\x8b\xcc\xd2Z\x9d\xebÌ\x124s\x1a\xfbB\xc7`\xe0\\\xcd\xee\xe8.\xb6a\f\x192\x14\x8er\xe2\x94\xfc\xd7\xcb\xff\xfc\xedO\x8bW\x7f|\xf9\xf2\x87/\x17\x7f\xf8\xf1\xb7/\xffs\x89\xff\xf9\xe2\xd5\x1f_\xfd\xe4?\xfc\xf6ի\x97/\x7f\xf8\xe6\xdb?]_\xbe\xfd\x91\xbf\xfa\xe9\aQ\xe67\xf6\xd3O/\x7f`o\x7f\x1c\t\xe4ի?\xfef\x0f\x95\xfb\x05\x84\xfc\x94`\x86\xe9\x05\x17f!\xd5\xc2Js'\xee\x86\xe5\x05x\xdcO\x0f\x90\xf5k\xd7\u05cbyZ\x85(\xbd(\xfa0\x86tы\x0e p\xca\xdf2R(y\xcbS\x96\xc6\xcf\xe0\xfd\xc6b\xa2\xf9\x95\xa0\x85\xdeJs\xfdp_\xc7\xf9\xd5E\vZ\xb0\x97U\xa7n\xdc]\x8c\xac\xfd\xa0\xe7W\x17\xe43\xae>\xdf\x1b\xbc\x8e\x10u4\xa5B?^d\xbcO\x8c\xa6\xbbk\xf9\x9d\x86#<\xf0\x8a\xf8\xe8X\xb5\xe2\x14\x03\x18\xf0\x13S\n\xdc\xc3\xda;u\xf6\xa5\xb56\uf74au\x01\x03\xae\xc9W_\x82\xe1S\x9aN\xe5\xddk\x1f\xc0_\xd0\r\xa8\b\x1eB\xdc7\xd4\xd0o\x01H\x8b\xa6\x00\x9c t'0H_w&[E\f\x9ajש\xa1rMNN`S=\xb1\x11\xeb\x13뮄(\xb8Yp\x11\x8e\xe3wx\x18\xe90\x82X\xfaZ\xa6\xebk\xf9N[\x91\x7f\x10}\"0;̩B\xa6^\xddwh\xf4ڻ\x12DC\xdb\x0f\xc8-\xf8z,\x18=\xe8\\\x1bЄC\xdbv\x17\xd1>1mx+j\xf20\x92Y\x88\x1d\x04S\xee\x87\x06e@\xdc\f\xbda\x84\xf6\x9f\b\xe5\x1a)U\x13\xbdI\xad(n\x85b\t\xec\xe3\xa7.\xb4\xc6Y\x96\x82\xce\x14\x92\x80\xfb\x81)\x8bEe\xf2\xadX\xe5\b\x813\xbe\x02C\x8d\v\xb2.!\xf8\xb8$\xa0%\xa22\u00856\x8c\xa6OȻ\x8c\x81\xf2\xfc7)o\xf4\b\x96\xbd\t\xdb\xe3\x06\x0ekq\v\xbd\t\xbbgI\x89\xf6\x8d5*\x80\x00\xe8\xd8\xec\x04K\x02=\x10\xf8~\x0e\x9ei\xff~\x02O!ud\x17ٛ\xe6\xa5Ԧ\x9eb5\xb1\xdaM;\x12o\xf8\xcb\rˣ8\xed\x8dl\xf9\x1e\x92\x19\x06\xa1\xe07ρ\xa0\x15.\xd1\x10\x833\x98Q\xae\xf1<@\xa7`;\x86\x90.\\s\xdf\x0e\x8d\x0eL\xed\xed}+H\xea\xe7d\xa4\x9fV\x1f^Sp\x83\xc7A\x1fn\xd8B\xf3\xdcaśH\x02\xa2Tmʜ\t\xa3g\x03\x00\xf1\xef\xf8i\x8d\x12\x93ћX\xfbɹ\xb8@\x19$_\x8dhm\x81S\xa5:#\x01\xcd\a\x02\xf6\x94\x8b\x98\xfd\xd0C\xe4\xa8\xeao>\xe7~\x00o\x93V#\x12\xee\fM+\xe5\x8a5\x98Uo\x95\x8e\x03\xe9\x12\x8e\xb2pr\xf6\x9bH\xe7!e\xffqc\xbc\x00=\xaf\xb4\t\x11\xd0=v\xc6\x03\x18&\xc5[\xb0\b'\x93\xf4\xa3\xed\x17\xec\x92\x10\xd7\xf3I\aH\x90\x11 \tY\xb1-\xbde\xce\xef\xc1D\"K\b\xddhB\x853U-I\xc1t\x85\xfdo\x14L\xd8 \xc6\x10*\x1e\x02n\xfeY\xa0dp\x11\xd9\v\x9a\xcf\x02\xc3\xf1\x8fͦ\xde\x10l\x0f\x9bFJ\xbe?\xa7\x84\xfa2\xa7\xf7\xe0\xe2$4\a\x9e\xe0\xa1\f\x02|\r\x167\xb38\x80\xee\x8d\x04\x8bhN\xc5\xfe\x93H\xa1yʔ\xcfFrl\x97\x02\xce\xfd6\xbf\xe1\x91e?\x1e\xcbm\xfeY\xf8u>Юǫ\xdb| \x18\x7f:\x9b\xc0DHb\xdd\xcf\x06\xe0z\x94\xa0\x8f\xa6H\x95#0\x197\xec\x15\"h\xbfp\xc7x\xb0\f\xe2\xe9\x1d\xf5\x1f\xafMy`\xdb\xf1 e\xf1\x81\xd3+dz\xc5lD\xeet\xf6\xb8+\xe8\xb2\x06M4\x8e\xa1ÙGf6w\xbe4\xd0\xf3\xaa\x14\x02$\xbf\x90CRFHNM\xb2\x85\xc6܌\xdd\x15\xa6\x182\b\xfem\x157\x18e$4\b\xd6\x06\x00HR\xcc\xea\x06\xb9\xcd芍ю\xc4QR*\xbfP\xd1\x14\xb2\x99\x03\xe17x,8\xfb\xf0\x86\xa5\x8fl\xf7L\x95\x02\x97\fkg؉\xbd\xcb\xc2\xf4\xbf`J\x85\xdb\xe1\xb5u\xb2\xe89\xa1\xe4\x86\xed\xac\v\x1f\xd2b\v\xa6\xa8o<\x12\x05\xc5\xc0'gE\xf0\x86\xed\x10TwZ\xebå\xc5\a\xec\"\x91\xbaA\xba\x02~NqX\xba\xc1\x17>\xd7d4\xc8@XhQd\x9cu%\x95>\x82\x0e\xa9\x1fϗ\x03\xa7=Z\x9c±\x82<\\+%/ \x896CO\x9f\xde\xf2\x02\xb6^\x10/\\gS\x18n\x9f\xcf4\xe3i5\x98=\x8b^\x889\xf9 \r\xfc\xf3\xf6\x9eC\xb2.\b\xd3\x1b\xc9\xf4\ai\xf0\x9b'\xa5\xb2\x9d\xc4s\xd0؎\x84\vT\xd8\xe3\b\x101L\x98\xd6h\xd3Ú\xaa\xf8\xc15\xb9\x10\xe0+\xb4$\x9a0\x1c\x80qC\xda\xc1\xf2\x12\x02\f\x8c\b)\x16\x18\x02\xeb\x1c\xcd\xf1@\xaa\x06\v\x1ee`7\xe85\xf8\x98,J6S?\x83\xbb3ޯ\x8c)\xe4\u0530\rO&\x8c\x993\xb5a\x10\xe5H\xb6\xe3\xa5e\x82\xa2>X\xbc\xa6\x1d?;c$\xb0\xad-\x1c\x14#\xf3\x91t\x19kzz\x03\xf4\x86\x8dCoQI˨\xe6\xa3-\xd6C\x88\xf5@2\xa1\x15\xf1\x1e\xb6\x84QR\x10^暶{M\x94\x9bCTL0\x17\xd40$\xa7\x98j\xfd7\xd8\xe9q5\xfe\x9d\x14\x94+\xbd$g\x90Ⱦ\xc9X\xe37\xe7|\b\xc0\x8c\x1c\x16\x9dp k\xb74\x03\xfb\x036\bAXf\xad\x11\xb9\xde3\xf6\xe6.\xc5\tv\xe1\xca\xd3|r\xc3v6\f2j\xd8Pa\x9d\\\x88\x93y\x15\x1cn(\x9e\xca\xf0\x91\"ۑ\x13\xfc\xed\xe4\xa1\xe6\xdd\x04\x89\x9eд!\xca9-\xc6J\xf2\x98e\xbe\xc0\xc3No\x038Q\r6\xc0#Wo\xab\xe0\x004{ Y\x865A\xa1z\x8e\xb8\xe3\xd7Хb\x1d\x8eq\x17Ԭ\xc2~r\x1d\xf1\x92\x933\xf4\x1d\xc0օ\xbe\x89\xbe\xe4/x\xbcS\x8bkt\xe2\x10\xba\x92\xca\xf8\xf0\xb4\xf5\x91/g\a\xefXG\xcf\xfb\xd1\xf3~\xf4\xbc\x1f=\xefG\xcf\xfb\xd1\xf3~\xf4\xbc\x1f=\xefG\xcf\xfb\xd1\xf3~\xf4\xbc\x1f=\xefG\xcf\xfb\xd1\xf3~\xf4\xbc\x1f=\xefG\xcf\xfb\xd1\xf3~\xf4\xbc\x1f=\xefG\xcf\xfb\xd1\xf3~\xa8\xe7}\x00\b:vb\xb7\x1e\xc7/\xb2\xb75\x18oC\xe2-E\xb4\xf1\xc9ݖ'[\xbc\x8c\b\xceww\x1d\a\\\xd3,%P\n\x12\x9a\xbb\xb2A\xb6Cw9\ax\xfeRRE!\xf5\xd2\xddp\b\xdc\xfc\x1b\t\x97\x16\xc5\xdcU\x06\xca\xe1\x0e\x80\x05ga\xcf\xebBG\xb5[\x1c\x1d\xfa\xd1\xdb,`\xaeC\x01+\xb8\x1e\x05\xae$\x88 |\xe0Y\xab`QΨ\xb0\xd7/x\xce\x0f\xbb\x1e>\xfa*E\xec\xa6)\xe4\xc3'Y\x99\xb2\xf4<+\xb5a\xea\n\xca]\xa6\xbeܧ~\x10s{!\xbb\x93Tƭ\x9b!\xb1\x8d\x16Xn3F\u05fa\xa8ܮpn\n\x90\n7\x85v\xbd\x8e\x98\xb6\xb9Xcn\x8b\x91\xe4\xe4\vPmY\xd6\x1a\xbd9\x8e\x8f\x19\xe1\x18\xe9\xa4kn\xd6\f\x9cM6\x8e\x067\xb4\xd1|\x8f-p?\x9d\x8b8\x1aӘ\x8c\x80<ؠp\x9f[2\xc0\x15\xbb\xa8\x9cKi\xc3\xe1\xe6\xac'\xb4+\xa7`7\xac\xd8ֈ\x9b眰\xe5f\x89 .e\xaa\xfd\xc6z\x05\x15\xd8\xe0\x0e/\xc1\x8a\xa9Ĺ3\xdfޢ\x7f\x01.\xf0\xba\xb2,\x94\xa4\x14\xae\x85\xa3j\x89\xef\x87X/\x0e\xee'\xa3'\xfb\x0e|\xe1\x84\v\x9c\xea\x01\xfc\x1cGJB.\f\xcb\xdf\x01\t\xde\xe1\xc0\xeeH\xac\x9bԣ\xb5x\xaev\xe1\xa6l)˕\xa3⒜A\r\x1c\x96\x93\x1e\x9f\xbb\x1d\x81\xb9\xc0\x1f7֜`\x9a\xac\xa4\xf1w\xb4!z_\x1fΝ\xf2\xa4\x1b\xe6\x14\xa3\xee*\xb92\xde\r\x81\xf0\xfd\xae\x16o6\x9e\x88\xf0\xbc\v\x81v\x90\xb1\x97pP4\xc0M^\uf121\xf7\xaeA\xef\x88\xdfT\xe6E\x8bb\xdaI\xac+\x1b\x81\xe2\xf9\xaf\x95\xb8.\xc9w\"\xe37\xac\x83\xd4z̰g\x97\x17\xae\xac\xc1\x1c\xa2/\xba,\n\x8c4S\xe1\xad?\xb7\xde@\x10z}\t\xa3\x8ch\\H\xd7[*N\xa3MZ\x8c\xfa\xe8{tp\xc1\x97\\t\xd7\x0f\xe9F\xe2\x1a\xed\x01mO\xbf\xa9\xab\xdd\xd07\x9d\x11\x1ar¼\xfd\x8a\x1b=m\xbf\xcd\xed\xfb\xfcY\xbd|C\xd6\xf4{\x00P\x82\n\xd0wp\x91\x05\x95\xda\xd2\xfdcK\xc7>\x90\xb3Cf\xee\xa2Bzv\xa0\xcd\xf9h;V\x15\xadx\x02K%\x06\xbbe\xabT\xc6\xfa\xcfd\xad\xb4\xc7\xff_d\xafT\x1cz\\~\xeb\xfa,[\xc79*2\xc3\x12\xa6\x06\xcd\xc0\xae\x92{\x8e@\xd6:H\xbdE\xd2\xc7\xd5_\b1\x1fu\xed\xc4\x16K%\x9bn\x01\xfcCQ\x12\xb7\xd8K%\xe1\x94\x1c\x8f\xb4\x8d#\xe4\xbb\x16,w\xfb\xde\xdd\xd4\x0fL\xea^;\xbaNi\x83R\x9c\x91\xa1\xee\x14\x14\xfd\x14\xbeֹ%p`Y\xe7T\xd0\r\x14@\x04\x94p(\xa88\x10\x8c\xad\xf6r\xe6\xaeX\xa2\x98\xd1\api\x1cu\xf6\xe83L\x9e\xd0PnP\xa55\xff\xe8\x88}\x827θ\xf5+\rq\xefi7\x9e\nᒳP\xabr\x06\xff~\xf5\xf1\x03\xbc\xde (\xd6V\x89\x89#\xd2^eK\xe4\x8c\xe5|\xef\x90\xf5\v\x13\x9cl\xbcs\xa6\xf2\x12\xbe\x84\xe3V\xdd\"x\x19\xc8\x0f/\xc0\x83\x9c\x98lY\xbbߠx:\x14O[\xd8\x1b6\xe9\xa2Q\x8f\xebŏ\xfd\x88\\ד\xe1)ԥX\xef|\xbe\x893*)\x94^\xaa\x8bW\xf4\x81\xeb\x95ˑ:d\x8c\x9ah\xda\x03\x8f%\x06\xd3mLw\x12\xb1K\x15\x98\x96\xb2\"\x93;\xf4\xd2.iQ\xe89|y\xf2\xc5I︾VK8\x8e~r\x03\xb4\xb9\x94\xa2\xcd<B?\x9b\x9d\xba\x1dY\x92Ħ#W\xf1`\x92\xe0{vl2\x1d\x87\xd3_\xeb\xad\x1b>\xed\xa4\x132!Ԑ\x94\xaf\xb1\xb0\xad\xb1>\x90j\xed\xf7\xa9\xb1a%V\xc8\xf4\rתD\x99\xb4\x1e߾B\xf3ӄ\xf82\x06\xdc\xd7\xf7\xf69iN\xa1\xc3EXPw[*\xd2\xcc{-\x9c/\xa8\r(\xee\xf4\x80<\xd4[{C\x1cj\xbbcm@!\xad\xe77\xad\xa0\x9c\x92O\fr?M]Y>G\xf7\x87b\x89T\xa0w\xc9\x1d\xc5ZXsr\xb1\x11\xd0Y\x95\xa2o\xd48\x04\x88\x1a\xc1\xdc0w\x12\xdd\xc6\x0e\x8fPW\xe3\xab\x03\x80\x0eP\xe0\xbcP\x9e0\xe8\xb6\xee\x19\x15[\x83S\xdd\xd2\x11\xde\xde\xd2m\xfd\xdbI,g\x87\xe5Z.<\xb9zZ\xd8\x11f\x0f\xd2\x13Nߌ\x94>\xaf#\xed\xa1\xc8R \xb2\xb0Л\x15\x85j\xcb/\x81\xc8@\x00@\xa4\xfc\x96\xa7%Ͱ\xd2\x11\x85b\xd4M\x8bc9;x\xcf\x19\xbfz\x88K\xfe\xf7\x93\x04\x95\xd2x\xa5\aԪ\x97\xca\n\xf6~\xd38%|\xbd\xd0ޱA$\x15\xbc\xab\xcb\r\x97b\x12i}h\x9a\xd7̲7w\x9aYUq\n\x8d3\xad\xa6\x9c\n#\xc4}\xbb\xd7=ȅ\xf6;\xaa\xfda\x00,\xe6\xee{\x9f\xb2\xcb\xeaDXX\xce\x1a\xd3\xc8\xc1ډ\x9c\xad'\b\xc7\xe8\x852a?\x1b\xbb\xb3\xed\xd3\xddK\xd3ad\xafz\xb7\xa8^\x89͑\xe8!ѹhK\xeb$\xaa\x0fh\x12\xf8{!F\xaf\x87(\xe9]\xf2\xde2(\xc1\v{\xac\xfdv\x10\x03#\x9b\x0e.\xfd\x0fƻ\xc3\x16\xcc\x04\xd6\r\xae\xa9\xa7e\\5\xcc?\b\xdfp\xcb\x1a\x13\x9c\xda\xe3\xd9\xfb\xb0\xe7\x1c\xcaRy\x86\xa4\xf3*\xae8\x14\xddiX<\x83\x9c{L\x02\x8d݁\xab\xb8l\x90\x814ܣE\xabc\xba\xf91\xdd\xfc\x98n~L7?\xa6\x9b\x1f\xd3͏\xe9\xe6\xc7t\xf3c\xba\xf91\xdd\xfc\x7fg\xba\xf9/\xf6fq\x7f\x11\xf2\xc3\x04\xbd\xaeVް\xf7;\x1d\x95Ue\f\xf7\xceIx\x99L\x18\xf7\x1b\x93+\x10\xfe\xb9\xde2\xcd\\\xa2\x8cszZ\xc0p\x8a=\xa9u\x835\xffO\xac\x13\x1e\xfeO\xa8\x8b\xceC\xdfBIx\xefﰤ\x8dܛ\x1a\x14ܧC\xe5ץ\xf6\xf4\xb7\x1e\xa5\xb5\xc78\xa5\x0f3\xe4\xc7\x14t\xe9\x98X\xa3\xac\vh\x17\xf8<FZ\x0f\xc1qba\x97\xa7,\xefrH\x91\x97\xe74m\xa6\x95}9d\x87\x9f\\\x02\xe60\xc5\xf2K*\a\xf3\x88Ea\x0ef\xed\x84\x021\x13\xcbČ\x86Hj\x92\xf6\x17\x8b\x99\x00\xb1YVf\x82\x06\x99R8\xe6\x80\xf21\x13\x8b\xc8\x1c\xcc\xd6\t\x05e\x1e\xba\x8e~\xfe\xb2\xee\x8fZb\xe6@\x92O=\x849m2\xaa\xf5\x04\xe3r\n\"\x83w\x13'\x8f>V\xe3\xf7\x16\xee;L\x1e\xab\"~S\xec\xc5Bq\xa9\xe0\x8b'0\x19]Z!\\\xb68ڌG\x9b\xf1h3\x1emƣ\xcdx\xb4\x19\x8f6\xe3\xd1f<ڌ\x93m\xc61\x18\x0e\x96\xd2\x18\x85\xd5\xc8T\x88!\xb4\a\xc6rI?\xae\xfc\x817\xca\"{\xf2\xb8uv\xd1\r\xb2\xe3\x15\xa3\x91\x8a\x06z6\xa0i\xabT%\xcc\xe6\xf4k\a#\xc6c\f\xe6Gx\xb7g\x93l\xf6\x96\xe7\x1bV0\x912\x91\xf0Ǥ\xdf>\xec\x0eB\u008ccĬ\xc8\x11M\xcb/\x8b:\x99\xcdW)Q\f\xd3\xf4\x136'\xd5\xd5\xef+\xfbZ\xf6\xf3\x8c\xea s\xff\xf2\xf3\xb9\xc60\nq\x18\x7f\x92Y\xf5kdDh\xf25\x17)\x17\x1b]\xc5Q.\xc4\x06\x026-\xf0\xee[\xcc\xcfUAe\x15\xbca\\\xe5\xd6GƉ҄*\x067p\xbc\x1c\xd9\x00\r\xbb\x87\xf7\xb4s\x93\xed\xaa\xe4ѽ.O-QOP\xe2\xe4\xa2\x17r\xeb&d\x93b\x11\x88\x91K\xc3n\nc\x96\xe0\x81\x05N<\x91\xa6_\x18\xf6\xd54l=\x1b\f\xceaM\xc3\xe8\x1c#Ȍ\xc1\xa3\xf7T3\xb89\x8f\x96\xa5\x98\xce\xe7\xed\f\xd9'\x90\xa5\x18\xec\x964Ujő1\x02\xf51䩓\xf5'_\x9c\xfc:X\xf4\xb8L\x89\xb2a\x9f\xb6\xd60\x88\xed\xb8\x10Q\f\x93m\x9byϿ\x9e\xa5\xf0\xa8\xb2\x1f\x13\xf6J\x8a\xdbD\x8e\xc0k\x8au\x8bʿ*}\x93q\xc1<U\xfa\xeeݍ\xa5\xf3><+\xd0\x15\x85\v\x18\x04N\xec\xa9L\xd0Q\x15Pқ\x89k\tw\xe6\xe6N{D\xc6ZK\x95S\xe3m\r\x0f\xad2>\xce\xf1\xd6ﷴФ\x85Oe\x1fA\xbdVS_\xe8\xd5,f\xd1\x1b\xb9\xb1\xc6\x1a\x16\xeei\x82[\xce\x0e`\x1d\xb0\xfdc\xe1\xec\xde\xeb\xbe3\xf3H\xbaw\xc0\vlM\xa00V\xe6\x87J\xe0\xa0B\xaa#0\xd5;\x91l\x95\x14\xb2\xd4λ{aX~\x86\x0ee\x978\x03\xae\xe5)\x9a\xfb\x9f\xc9V\x96\xea \xba\x8cȇ\x1fG\x90Fz< E\t\xdc\x1f\xbf\xfdj\xd9\xfc\xc5H\x97,\x8f5\x99\"\xc0\xd0R\x05\xff\xbb\u0604W\xf3\x9c\xfemV9\xa8\x95A\x04\x18\xdca\x83R}4\xab!4\xf4\x04\xf9\x88\x93\xa3\xd9\xf2\xd05?\xec\x8dngY\xc5ڵ\xc8=\"\x91\xbe\xca{\x1e>\x88? }\xbeWm\x8e\x97\x92\x9f9A\xfe\xb0\xb4\xf8\xb1\xb1\x86\x11)\xf0\r*\xf5&\xbeW$\x18\x80H&\xa4\xbb\x0f\xe8\x82\xfd\xfc\xbdI\xd3\xf9i1\x1b\x9d\x17\xf8\x14i\xecO\x93\xbc>\x9af\xe3\x12էR\xecY\x92ҟ9\x15\xfd\xf9\x12\xd0'\xa4\x9d\x0f*\xb8\x89\xe20d\bF\x93K\xa7\xe4I\x8fs\xb0\xf6\xa7\x8e\x8fJ\x18\x1f\xe5\x84\x1d3ღ\x1ad=\xc7g:5\xfd{\x14'\xc7/\xd7\x00ǧO\xf0~ִ\xee\xe7O\xe6\x1e\x94\xb6\xc1\x06\r1\x1bQ\x1d\x1c\x16]\xa3\xc4hDt\xc6\xc9\xc3\xfb=h8\xeb\x02|\xb5\xa9\xb7^\xebB\x9f\xd61\v(\x84\xd9,չ\n\v\xebFF\xaaN\xbes\xa2\xa5\xad\xda^I\x11\x00\xab\xaahcr\x8d+\x95]\xb9\x85\x83\x02a\xae\xda\x17\x8e\xb9+b\xe2P\x13\x15j>\x99L;e\xadXZz\xefy&)\xd6(\r\xe7S\xa1\x89F?\xc9!\xbf\xa6\xa7~i\xaf.n\xb0\xc0\x9f\f\x1b\xd4\x06A\xa5N\xc8\x03C2$y\x046A2\xe9h\xd51\xc0~9;\xdcJ|\x86ҸΠ\x8cV\xaf\xed\xaa\x1f\x05\x8b\xe3_\xf7y\xdb;\xeaG/k\xaevWK\xa4\xab\xba\xb5>\xec[\xd10\xa1\x02\xce\xfeC\xf9\x0e\xa3Գ\a:\x9a\x94^^\xf6\xefW\x04\x186(4\\\xc55\xa8\xbe\xf5\xb3\x14rm\bU\xb4\x95\x9f\xdd\xec@\x95\xfb`\xcf\x17腯i\x06\xa5u\xd4\xc3\xfd^\xef\xf7\xa0\x85\x05\xa6\xae\x98\xba宄\x0fи\xd1\xdc\xf3\xdby\xc0@9*\x06\xb9\x84\x90\xff\x17\xb3\\\x9c\x80@+\xed\xa2#\xa9\x84\xa8\x18\x16\x9e\x87Z\xd3zy(݆5GP\x80\xeft6J\xd0{u\xc6Y\r.\xa4Z0\x8a\xa7Q\x92\xc92\x85\f\xc7[\b\x1a\xbb\xd0%p\x92\xac<5\xe1p\xaed\x961\xd5g\xb1\x80\xc9\xf0\xf6\xde0%h\xf6\xe6Õ\v\x94\x82\xb2\xe0\t[\xae\x98\xa1\xad\x82\x82_\xe0zr=\x16\xa9\xd0K\x9a\x15۽V}\xa7\x8d\x90\xb3K\xf2\xc6z\xcd\xd0\xd7|\t\xaf\xecR\xb7=i\"\xfd\x99A\x8b\nBO\x93+\xa3x1{\xc0҇\x02\xe3<\xb9\xb8|\x14\x9e_y`!\xc7\xed\bpsR\xb10\x8e\xdc\xe0p\xc0u\xbf\x84..\xbd\r\xd83b(N`\x012k\x0f\\\\⑱(W\x19O\xc8\xc5e\xa5w\xf5\xfcWϱ\xc1d\xac\xf1\xfc\xf2>e\xc7-\xa8\xa8\xde\xf0#\xef\xb3ɽN\x05\x17+\x98\xfcm\x12\xf6s\xcbs\xc1'\x94yQ@\x9a\xf5\xd4\xf6\x1a\xa1\xdfF\x12\x0f\xa6\xf6N\xaaK\x8f?\x17\x9b\xc7 \xe4\xf7\xfb`1!\rJc\x8a\x84\xd5[}C\xfa\xe61\"\x0f\x96\xf0\xf7\x10\xeaMh\x8f/\xf3\xe6&e\xef\xdb6\xc6!\\\xc3\xe6\x12\xf4\xe9\x7f\xdb;0\x8d\xac\x18\xac/\xc5\xe0\x85\x01`\xeak_\x8cP?\x12\xfb\xe2\t\x19\x83\x06DN\xef߸\x82\xb0\xa7\xb3\xc3\x19\xfam\r\xa6r\x9d»\x06\xb4\t\xb7\xf4\x9c\xee\xe0\xed\xads\x9f\xb0\xaf]\xa5E,\xac\x88\x9f\xc3(Ld\xa8:\x14\x83\x82\xe1\xd3\x15\xf1\xa0\x05\x8efx;\x83\x8dd\xc9[\xa62Z \x06\x82\xdd\x1b\x8f\xc6\x1d\x17\xa9\xbc[\x92\xef\xe1x\xc7\xee\xed\xebLb\x1bV-\x86P\xe6\xac\xce\xdc\xd91[][\xdf\xf0\xa2\b\xdeu\x14\xa0\xa7\rϠn!\xecӘ\xff\x83\x1d\x12\x10\xa4,nd\xff\aS\xf2\x80\xf7\x17\r,\xe4\x80\xcfg\xc9#r\xdb\x02\xf3ڐz\x12[\xaa\x82\xd8\x03WC逷5\x9d\x92K\xaa\f\xa7Y\xb6\x83\xab[䆱\x02\xac\xb7h\x94\xe0\x8e\xea\x80\xf4\xd5K\x9fBkQ7a\xc2ۤΑҶ)7\xc1+\xa2\xa6\xc4\xf0\x1aP\x97\xb3i;ܢ\xd9=\xd2\xc6\xe2y\x10W]%\xe8\xd3\x03\xed\xd7\xec\xe7\xf0\xdd\r\x1ei\x86T\x16\x87Tt\xa0g\xa9\\\xe8\xf9A¼\x0f\u038bs _(D\xfe\xd5=U\xa0<(o\x8er\x8e\xa0\\\x86\xe1{\x99\xf4\xa8U\x82\t\xe8]b\xec\xa5\xf7{\xaa\x84[\x19A\x03.H\v~\xa4\xce\xed\x14\x19?L\xb4{$\x1ap\x9f\x1d  \xf9x\x02Nan\x9bb-'\x03\x845\x13)R\x17\xf6o\xb7\x0e\xa9\xaf\x83\x8a\xf6\x91!\x83\x1d,\xc3<\x18\xb0\x10\xc1A\xd5f\xdc\xf2\x10\nU\x89K\x97Py:}\bm\xaaL+\v*\x92\x91\xdbʔ\xaa\xb50\x94\xbcu\x95\x1c\x84}\x89\x17\x8dm\xd9\\\xa4.\xf5\xd7W\xccvo~\xc2l\x18Hv\xb3\x95\x9e\xe1\xa5b,\xa8lKx\x83\xfa\xee\xc5N\xb3\x03\xed\xa5![I\xaaFF\x84~\bm?\xb6`\x81\xe4\xf8\xec\x80gL\xbf\xc8\xcb\xcc\xf0\"sFn\x1a\xcd]\x84\xd75\x90;\xb0VV\x8c\xfcYb\x8da\xf7殏\x9f\xaa8Բ\x95LB5\xb9cY\x16\xe7\xfb\x1e\x15\x12<{\x92D.\x18\xc4(\x81\xbf\x8e\xb7\xee \n\xb6\x7f\xb6Cٲ\x06}\x1e\x01=\xe8\xad\x1c龜2\xb1#!\x02=\xd8\xf6\xbb\xbf\x94L\xed\xd0ƬC\xe2Ց\xd9\xc7Wt\x99\xd5Q\x1f\x17\x85\xea\xbbt\xb2\x97WRGe\xe0%s\x98[\xd7\xc6ɿH.ȣ\x81X\x16\x1c\x00\xa3\xe3D@\bYA\x88t\x1d\xb6)\xf6'\x11o\xd9\xe2\xc4#e\xd5<F^̀\x00M\x13\xa3\x880=Wv\xcd\xe1e'\xc7p{t\x8eM\x8b^\x8f\x94e3%\xcffpw\r\x1fO߉\xd3\x1a\x14\x83\x10\xf6\x13\x95\x8d|\xaar\x91\x13\xa87\xb6<\xe4t\xda=K\xe6ͳ\xe7\xde<g\xf6ͤ\xfc\x9bQ\x8ap\xb2x\f\x05\xa5z\xb2\x06\xa6\xe4\xe1\f\x87\xe9\xc6\xe5\xe2\x8c.\xdf8x\xb6\x9d2\xf9\x03\xa7\x1d\xd8\x1a}\xb3\x9ez\xb6\x1f\xcd\xdf)K\xfaY\xb3s\x9e\xbd\xec\xe2\xf3g茒\xc0\x11M\x1a\xa27\"Og\xc2\x01,&\xf5R\xa5L\r\xder\x99\"\xb5\x83\xf2:NR?\xb6\x10k]'p\a\x18D\xbfq\x06\x80\x0f\xaeiB\xbe\xe1\"\xca6`4Hf`\x11y x\x16\xae͵\xa6Al9\xe8\xaeCiVP\xd8\x00 VnˠDM\x85\xb74\xd9Vhbw\xb2\xa5\xda_#9\xa9\x8e߯\xed\x00\xf0\xf9dI\xc8;Y]v\xae'9'\x9a\xe7E\xb6\x83\x93\x189\t;<LJ\xa2҉\xfe\x83Ǫ(\xe3\xc7q\xf52\x80\x13p\x14\xdc~\x98\x06\x05\x91\x1bk0w\xbd\xe3\xcbŤ\x16\xaa\x14\xce\t\x02G\xe8\xc8Pk\xe7\xd1\xf3\x01\n\xa3\xa8\xd0\x1c\U0010daca\xe0\xf3}\xa8\bSu x\x0f\x91\xc6*ޥ+$\x84\x8c^\xc8\xdaJ\x1bΥ\xd8hA7L\x98\xb9ˉ\x80\xe1\x82I,\xc9\a\x9e\xc5B\r\x8a\x19\xb5;\x98\x89\xc3\a\a\xb0*\xce!=\xa1'\x1a1\x9e\x9b\xfe\xeaO\rѯ$Q\xe6+\x97[\x82\x1c\xedL\xe7\vRɰ\xb46HWU\xa7\xb4gȚ\x93\f\xfc\x8c\x9e=5\x13\x1dc\xef\xb6<\x83\xe1 \x9d\x1e0L\x89,{\xec\xed\x9c\v\x9e\x97\xf9)\xf92\xdaĮ\x12.\f\xdbD\xb3洠\x85\xde\xcaG\t{_9X1\xb2\x1az\xe3\xa9*\xa8\xe1\xb7\xf8:\xac\xf3\xab\x8b\n\thJɭ\xcc\xca<$rϐ\x8e\xfc\xc1rA\";\x92\ue45a\xd4\xf4/\xb2rc\xc3nP\x00\xec\xc9\xe9\\\x16\x10#~\f*\x7f\x87\x90b4\xb6UTXJ.e\xfa\x19\t\xf9u\xe5\x96Vl\xe1^\xf2\x8eo\x96\xac\x9a\xf6ګ>\x14I\xdePC뱝^s\x1eZ\xc1\xee*&:\xdaW\x1a\x0fuL\xa5r\xfa͡Z\x1d\xb9<-K6\x96\xd6o\xb8\x850_&\xb5yb\x8e\r\xec0^\x15|+S\xa8\xa8\x14q\x00\x8cc\xea\xa7\x16\xac`\xa7\x01\xf2U\x170\xbd\xef\xb6RC\xb9렝{\xa3JO\xae\x1c\xf0\x91\x11\xadN\xeb}\r\xafS\xff\x8e\x99\x06t}J\x13H\x99\x13\x9a\xe3\xda\xc5\xd4E\xbd<P\xb5ӂ\xffIɲx\x8c\x15qvy\x81\xb0\xfc\x9a\xd8\xe0\a\x9f\x03R\x91˧X8r\xf6Z\xc8\x17\xeb\x06\xd4f\xd90 Y\xfd\x11Ͷ\xea \xee\x0e\x1b\t\xbc\xe4\x0fT<\xe2\xd27\x12XL`KH\x17M\xe1*]\x14T\x99\x1d\n\xa9\x9e7\xf0\xf0'\xd5\xe5\xec\x01\xe7\xaf\x1b.ґdǩ9\xaa\x02\xe4\xd0vݣ\xe7Cp\xea/\xb4>Xb\xfd\tp\xf2\xa4\xee\xc6j\x81T\x9cM,\x8a4\xa0T\xa6\x1f\xa9\xfc\xbcGG\xb8\xbd\xaeq1숦\xa9\xab\x80tB$\xf5\xb5ttBw]G?\xaa\x85\xa3Z8\xaa\x85\x9fI-xS\xec[y\xcb\xdeDS\x7f\x1a\xe4\xbbju\xe9\x88\xf4{\xa8h\x87\rV.\xc3W\xdb\x1fz4\x1c\n\xc3{T\xac\x85\xabG\xcc/\xaa)\xae\x9a\xa0:\xe6\rF\x15\xbdaՠ1\xf7!\x1c^Ď\\~~\x11T\x15K\xfd\xd2w\x01\x1a\x17:\xad\n\x18D`\xb9N_\xf7T\x02z\f26\x93MƈI\xb3\x87\vI\xe2r\xf1.\xca\xfa\x88\x87\x8b\xb0\x13&\xd44\xeeN\xa4\xa9+\xb96w\x95\x15$\xbc˨\x8e\x1bX\xb7\x86n~9\xbe\xc2k\xba\xb1\xe16\x14\tW\xc4\xd6\xddҨ\x85̟S\x1d\x19\xa8H\xa1\xb2\x17\xe6\x96i\xcf8\x92y\xb21\x01\xa2\x10\x95L\x14:b\xe8f\x83/H\a\xc6\x19\x1dȢ\xfb\xaf\x87[[\xfd\xd4\x18\xc5WP\xb9\x1bpI\xa4n#\xd6\xcd\x0e[\xa5\n$\xa0c\x1e\xfe\xa5\xe9:ٲ\xb4\xcc\x18҂fwt\xa7!Gby\x88\x8e4Tm\x98q\x85\xdfN\x1fĜ\x00P{?\xa1\xe4\n\xef\x8b\xf95\xed*\xa5ֹH[\x99Aݓ9)E\xeaN\xbf\xf1\xa0\xd1\t\xd8z\t\x16ޱq\x02R\x7f\xe1\xa9\xe6}\xa9\x90\x86N\x93\x1bȩ\x82w\x9c3\x9a\xb6[8\\T\t)\x11\x91\xbc1\xf0\x7f\xbdp!\x84\xad\x14\xee\xd6\x14&\x15\x80#\n\xf2\xae!/\xd0Oo[\xaeH.Svؒ3ك\xf8p\xfd\x1e\xa8O\xf1J\xc3\xd2g\x06\xc3\xc9H3\x10u7\xb0\x83\xb6\x82\xff\xfa\xab\x16\x11\x88\xb5>\rt\x8ab\xa0\xb2\xe0\xbd\xfeR\x1d4M\xe7\xa0P\xb6\x80҈\x19\x7f\xd7\xe8\x10l7\xae\xb8\xf5\x9ao|\x1a\xb43U{=JL\x1d\xbc;\f[\xe3ɖ\x8a\rK\xbf\xcedrs\xad\xec\x1b\xf7cm\xc72\x16\x9e\xf3\x0e\xb8^\x85\x91\\\xde\xc2\xc7\xea>\xf4\nF\xd7\x1e\x17\b\xf0\xb9\x8b\x1f\x85b\xb7\x1c*19\xd5\x12\xddk<\xf75\x98\x85\x97\x9fϫ3\x00\x82v\xfeF\x7f\x95\x03\xfc\x91\xa9\xe2\xb0\x1c\xd0=k5@e\x1e\xb9dj0\xbf\xad>\xee\x19\xd3\xebr4\x98@\x9a\xd1&\xf2Is\xab\x92gf\xc1\x85\xfd\x15~\x8a\xb0r\xccN\x0e\x0f\xc4v\xb2\x8ce\xefx\xc6\xf4wS\xfc\x8d\x97\xfb=\xf7\xfd\x8bk\xf8\xb1\x1a$\n\xd8\v&\xe4\xda@\xa6&D\x8c\x90P\xa4\xd4\xde4\xe8\x17\xddGq\xd0Y\xa6\xe2\t\xc9\xf3\x0ec\xbf\xdf\xc4r\x90\xc6I\xef\xe78XO1\x88\xd09\xddls\xb96\x80\x84\x9f:\x88\x97\x1780\x18\xebD\xd6\x18\xaf|\xe9YL\xfa\xac\xe5\x18#\xc0́`\x1f\xf52\aA\xbe\xaa\x10\x9b\xd5\xf162\x9e(\xaa\xb7\v,%\xac\r\x13f\xfcDÝ\x87\xba\x06\xd5o\x8c&\xdb%y\vi(\x9dѢ\xb8\x1e;\xb9ŝ\vn\x84Z\xc2,\x90`'6\xe3\xeb \xa5|\xdb\xc0\xcdۖz\x04\xe3?w\xf7\fb\xaa\x81\x95\x8b\xac\xeb\x84I\x80F1XTk\x99p\f\xc3:\x96r\xafúgۛ\\3@\x8a\xfe\x88z\xcf\"\x82}\xf7\xafRt\x88\xe5\xf0J\xb9v}\xfd\x92\xb88\xfbpV\x19QUy=l\x01\x9f\xae\xbc!\bi\x17 \xd7H\x1b.\xac\x19\xda\x01\xff\xac\x84D\xa6\x8c\xd3\xd7W\xbbT\xb0]\x106\xad,M0\x92鎤%#>]\x10\x10\x00\x8b9C\xa3\x82\xd0DI\xad]da\x97\xf1Ͷk1h\x8a\xdb\x11\xf6\xb0{\x90\u07bb\x9a\x19\xccG\xaeC\xcb0v\x01\xb3\x87i\xa5f\x1f\xef\x04\x94\x13w'H}!\xac\xd9r\b'\xbeۃ\xe6M\xa0\xaecn\xa9\xbb\x16i\v\x00\x91>![\x13\x17N\xb2[\x1a\xd7\x15'\x97\xb3\x89\xf6H\xdf\xfevK\x15\x87\xae\xba*Ry\b%>\xefA\xf1\xd2\x19\nf\xf5\xa3/\xa7\x19\\4pM\xfc\xb1\xc5%\xfdt\f\x85\x1b:(\xe3\ns\xc2\xee\v*\xd2\xda\x1b\x90Ui\xde]7\xf7=y\xfd\xcbC\xab!;\x06\xa3\xa2\x02\x1aL\x84\xab\xbd3b㚉\v\xa7\xb1yp\n\x13\xa9?L|\xe8\xf6TU\xd3q\xe7\xc3\xdf\xfc\r@\xfc\x1db\x89\xbf\xf9ۆ\x9b\xab\x7f;\xfb\xbbMI\xaa'\xee\xea\x8fW\xf3wG\xd8\x17\xcey\xcā\xc5\xd1\xed{[TSh}mX^@J\xf3l\x84Ƴ\xd7FNgQ\x99\xf2\x92\rW\xf1KM\x12Z\x18H\xca@\xba'\xa5R\x10\xdf\a \xee\x9c\xe8\xd7\x7f\x17fq\xbb;\x91\xc2f.\xe9C$\xfc\xbc\xea\xed\x1a\xafX7zM\x9d\x8boްF\x1aO\xb6\xb0( 3H\xe2\x855\xday=\xc0M\xce{Wu\xa0~\xa5\xcc\xe0\x12ˍ;˚\f\xafk\xa3\x9c\xff\x89\x9b\x8f\x85&[F3\xb3%ɖ\xa1UO\x05f\x05\x99-˗\xb3\xd1\x1b_\x83\x18ռ\xeb$\xb9\x14\x8eu\x19\xa6+\xe1=\x11\nǬ\xeaZ\xbc#H\a\\\x12\x12\x89k\xb0\xf2\xab\xf0\xecr6\xfd\b\x95Qm\xae15\xc1\x97\x9a\xedn7\x86\xbd1\x88^\x8d\xc1/v#r'IG\x14S\xb5\x86c.\\\xaa\x06\x8a\xc0<K\xb4\xd1\xddͬ\xae\xe9\xb9\xdd\x1cUPud\xf6o\"\xb0>\x8el\xe7\\\x7f\x9e\x05\xb8E\xa6K\x8c\x95`t\xc8\xc5In\x84\xbc\x13h\x1a\x86'\x01ķ\x82\b\xe4ưru\xda\x03\xbb+IXa@\x9b\xc4P\x84#\x065\xa7p\x90b\v\x80\x18i׳\xeb\xba\xcc\x01\xa65\xdd<\x98G\x0e\f0\x86\x92m\x99SA\x14\xa3)L\xc1\x0f\x81\x95q\xc1 \x14\x9bJX\xe9\n2\xb9\x90*\x15\xcb\x06\xb8\x02\x97\xe5W\xa0\xbaݭ\x1f;\xb7X\xa7\x9c\u07bfgbc\xb6\xa7\xe4\x9f~\xf7\xff~\xff/\x87\x92I\xaeЮI\xffĄ\xbb\xc7\xfeP\x8a\xedC\fo=\x00I\x96\xb9;y/7u\x9b\xca\xe4\xab\xe5\x0f\xd2C\xc0\xaf\xba\xa2P\xae\xad,\xfaH\b168\xd3@\x86\xcb\x1c^h\xd59\b(D\xab0\xb2\x1d\xf9\xeaws\xb2r\\Z\xba\xbb\x86\xd5\xe0\xfa\x87\xfb\x1f\x97\x1dS\xe1\x9a\xfca\xde\u0093k\xe2\nu\xa4\xed-*|p\xb3V̪/#C\xf5\xd5\xd4\xe7~\x1eCk\x84\v\xf3\xfb\x7f\x9e\x1d\x9883|*W\x8cꇋ\x83\x85R\xabs\n\x91㍢y\x8e%o8\\\x12\x85 \xab\n\x97\x11P\xc1u\xf4\xfe\x9d\x8a\xdc/\xb4S\x8f#\x16֥\x92i\xe9˅839\t8\aD\xb0+ϾK\vl;\x96\x80\x15\xec\x13\xb6!\xee\xcb(\x1c\x11}\t5\xee*\xb9\xc5\xefxP\x91֖x\x98\xfcͪWfA\x01\t\xb2)\xa9\xa2\xc20\x96\xc2\xe6\x14\x9fŵ\x87\x11hnJ\xceiβs\xaa\xbd\xfb\xb4\xaf\xbf\xc7\x19\xa7*dp\xcddX\xbd|\xf5\xe5\xefz\x84\xacj\x15iRP\x03\xa5\xa3N\xc9\x7f\xfdp\xb6\xf8\x0f\xba\xf8\xeb\x8f/\xdd\x7f\xbe\\\xfc\xe1\xff\xcfO\x7f\xfc\"\xf8\xf8\xe3\xab?\xfe\xe6PE\xd6e\xf5E\xa4\xd5\xed\x97r\xdd\x14\xac\xb9\xbf\x86z\xadJ6'\xefh\xa6ٜ|'p\xb7\x8bQ7\xbc0OZ\xff,\xc8\t\x80:\x89t]\x90\x13\x1c#\xfe\xbb\x1b\xfbP\x92\x80t\x8f\"\x88\x8f\xfb\xd7\v\x83\x8b@\xbeP\xb5\x92\xb5\x94KvO\xa1\xfa\xca2\x91\xf9\xeb\xea\xf7\x112\xf4O_\xfd~P>^\xfe`\xa5\xe0Ǘ?,\xdc\xff\xbe\xf0_\xbd\xfa\xe3\xcb\xff\\\xf6\xfe\xfe\xea\x8bׯ\xfe\xf82\x90\xad\x1f\x7fXԂ\xb5\xfc\xf1\x8bW\x7f\f~{u\xa0\x98\xf5e\f,:\xec\xb9\xcef\xcel\xe8\xfc\xcd*\xbdΟ\xac\xd4v\xfe\x14\xa9\x14\xda\xe3\x11\xeaw%5r\x14\xc0S\x86\xf9K7lױ\xbe\"\xa3\uf0c0f\xa7p#\xa7\xd5\x16\xa8v\xb8S\xe4}\xd5{\xdfv\xf6qi4$ s\xdf+\xf0\x0e8\xd5\x19\xaa\xf3\x987\xce2\x1d\xe5\x17\xe9\x94-\xc0\xf9\xca\x16\x16\x1a \xc2\xfb\xbaeׄ\xabi\xc0\x94]\xa9\xa2g\x9dɾ\xc9t\bW?v\x1a^0\xd9\xc0\x98s\xfa\xbb\x9a\xb2/\xa4WB\xbd-g$\x94\x05\xb0ˆ\x04\x9d#\xafc\xb8\xea\xf4K\xf0u\xa8Bz8\xba\\\xf9\xdf\xdc\xc1\xb8\x81\x01ʹt\xc7\x1bW,&\xc0!\x95]\x97w\xfbm\xb7>\xa3\f\xaf\xa5\f\x10\x13/\xb9xRy\xdb\x12;\xb6\xa95\x1b\xb7\x91-\xc8\a\xb6\x9f\xff\xb7 o1\xc0\xb7\x9f\x1d\xb5p\xe5l\xf0F22n\x8a\xf0\xdcV\xbd\xf0%\xb7z`\xb6\x9d\xa2S\x8fla\xb4\xdew\x04E\x13\xeaa\xec[n5yɻ⍘\x89\x9d\xc0D_\x8dwg\xf4L/\xaet;5\xf5ޗvM\x04kҹ\x05\xc3oj\x81է\xe4o\x7f\x9f\xfd\xcf\x00\xf6%\x82a\x93\b\x01\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcVϏ\xeb4\x10\xbe\xf7\xaf\x18\x89+IyB \x94\x1b*\x1cV\xc0\xd3j\xfb\xb4w7\x99\xb6\xc3&\xb6\x99\x19w)\xe2\x8fGc'\xdbn\x9b>\xfa8\xd0\xe6\x12{~|\xfe\xbe\x99q\xaa\xaaZ\xb8H\xcf\xc8B\xc17\xe0\"៊\xdeޤ~\xf9Aj\n\xcbÇ\xc5\v\xf9\xae\x81U\x12\r\xc3\x13JH\xdc\xe2O\xb8%OJ\xc1/\x06T\xd79u\xcd\x02\xc0y\x1f\xd4ٲ\xd8+@\x1b\xbcr\xe8{\xe4j\x87\xbe~I\x1b\xdc$\xea;\xe4\x1c|J}\xf8\xa6\xfe\xf0}\xfd\xdd\x02\xc0\xbb\x01\x1b\x10\xe4\x03\xb2\xa8\xd3$\x8c\x7f$\x14\x95\xfa\x80=r\xa8),$bk\xf1w\x1cRl\xe0\xb4Q\xfc\xc7\xdc\x05\xf7:\x87Z\xe7PO%T\xde\xedI\xf4\x97[\x16\xbf\xd2h\x15\xfbĮ\x9f\a\x94\rd\x1fX?\x9e\x92V \xc2e\x87\xfc.\xf5\x8eg\x9d\x17\x00҆\x88\rd\xdf\xe8Z\xec\x16\x00v艼j\xe4\xe2\xf0\xa1\x84k\xf78d\x92\xed-D\xf4?>><\x7f\xbb~\xb7\fС\xb4L\xd1$h\xe0\xef\xeam\x1d\xe6\x8e\t$\xe0`\x84\x04\x1a\xc0\xb5-\x8a@\x9b\x98\xd1+\x14\xc8@~\x1bxȲ\x82ۄ\xa4gQu\x8f\xf0\x9c\xf9\x1f\x8fY\xbfmF\x0e\x11Yi\xa2\xa6\xfc\xcf*\xeel\xf5s\xc0\xedog-^\xd0Y\xe9\xa1\xe4\xcc#_؍\xf4@\u0602\xeeI\x8012\n\xfaR\x8c\xb6\xec<\x84\xcd\xef\xd8\xea\t\xe09/\x02\xb2\x0f\xa9\xef\xacb\x0f\xc8\n\x8cm\xd8y\xfa\xeb-\xb6\x18A\x96\xb4wjt\x91Wd\xefz8\xb8>\xe1\xd7\xe0|w\x11ypG`\xb4\x9c\x90\xfcY\xbc\xec \x978~\v\x8c\x99\xea\x06\xf6\xaaQ\x9a\xe5rG:\xf5a\x1b\x86!y\xd2\xe32\xb7\x14m\x92\x06\x96e\x87\a\xec\x97B\xbb\xcaq\xbb'\xc5V\x13\xe3\xd2E\xaa\xf2A\xbc\x1d_\xea\xa1\xfb\x8a\xc7Εwi\xf5h5(\xca\xe4wg\x1b\xb9u\xbe@\x1ek\xa4RL%T\xe1\xe4\xa4\x02\xf9]\xd6\xeb\xe9\xe7\xf5'\x98\x90\x14\xa5\x8a('S\xb9\xa5\x8f\xb1I~\x8b\\\xfc\xb6\x1c\x86\x1c\x13}\x17\x03y\xcd/mO\xb9p\xd3f \x95\xa9\xb4M\xba˰\xab<\xab`\x83\x90b\xe7\x14\xbbK\x83\a\x0f+7`\xbfr\x82\xff\xb3V\xa6\x8aT&\xc2]j\x9dO\xe0ӯ\x18\x17z\xcf6\xa6\xd9yCڙ)\xb1\x8eؚ\xb8ƯyӖ\xda\xd2V\xdb\xc0\xe0\xe6\\껐d\x8f/\xc42N\xa4\x82\xe6bN\x85\xed=h\xe6ǒ\xfd\xe3\xde\t^.^`z4\x9b\xcb\xfc=m\xb1=\xb6=\x96\x106nl\xfb_\xa1\u0603>\r\xd79+\xf8\x88\xaf3\xab\x8f\x1clB\xe3娹Y\x1b\xe3%\xb6\xa3\xe9F\xbe}\xb2b\x95/\xc6둟\xf9\x1e\x03\x01'ﭥ\x83\xbf\n9s#\\ِ\xe20\x83f\x16σ\xdf\x06\x9b\xc9\xea,\xb1\xd3\xd2N8\x8a=\xe6)\xb8f\x02\xde\xd6\xfa֜\xbb\x8b\xd0\xf2\xe4\xeb\xf9\xbf9\xdb\\\"\xc6\xd9\xdcUF5\xbba\x19g6n\xf4\u05c82\xf5\xbd\xdb\xf4\u0600r\xba\xf6.\xbe\x8e\xd9\x1d/\xf6\xe2Tj\x9fh@Q7\xc4f\xf1Y\xc1\xaen\x05{\x1e\xaf\xa2X\xf3\xbc\xee\xd1\xdfj\x11xurJ>\x13rs\xbc\xe5\xbaz\xfbڼ\xee\xb3\xf2\tӀ\xcd\xfaJi\x86Ȼ\x98\x9a\x95\xb4|\xf9\xcc~\xd6\\\xb1\xb4>\xb7\x9d\x06ɻ~\x99\xbej\xea\xfb!\xccV\xc0\xd5b\x86ٝ\x1dO4\xb0\xdba\x03\xca\t\x17\xff\f\x00\xef\xf8\xa6>\x10\f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcVKo\xdc8\x12\xbe\xf7\xaf($ר;\xc1b\x17\x8b\xbe\x05\xce\x1e\x82M\x06F\x9c\xf1\x9dM\x96$NS\xa4R,v\xa7\a\xf3\xe3\aEJ\xfd\x94\x1c;\x98\x19K\xb0->>\xd6㫏UU\xd5B\xf5\xf6\x11)\xda\xe0נz\x8b\xdf\x19\xbd|\xc5\xe5\xf6\xbfqi\xc3j\xf7n\xb1\xb5ެ\xe1.E\x0e\xdd\x17\x8c!\x91\xc6\x0fX[o\xd9\x06\xbf萕Q\xac\xd6\v\x00\xe5}`%\xc3Q>\x01t\xf0L\xc19\xa4\xaaA\xbfܦ\rn\x92u\x06)\x83\x8fG\xef\xde.\xdf\xfdg\xf9\xef\x05\x80W\x1d\xaea\x17\\\xea0z\xd5\xc76\xb0\v\xba`.w\xe8\x90\xc2҆E\xecQ\xcb\x11\r\x85ԯ\xe14Q \x86\xe3\x8b\xe9\x8f\x19\xeda@\xfb4\xa0\xe5\x05\xceF\xfe\xff\x13\x8b>\xd9\xc8ya\xef\x12)7kY^\x13\xdb@\xfc\xcb\xe9\xf4\nvѕ\x19\xeb\x9b\xe4\x14\xcd\xed_\x00D\x1dz\\C\xde\xde+\x8df\x010\xc4';S\x8d\xa1yW\x10u\x8b]\x8e\xb9|\x85\x1e\xfd\xfb\xfb\x8f\x8f\xffz\xb8\x18\x060\x185\xd9^Θs\x11l\x04\x05\xa3%\xb0o\x91\x10\x1es<!r \x8c\x83\xd1GP\x80\xd1\xfe\xb8<\x0e\xf6\x14z$\xb6\xa3\xf3\xe59\xe3\xd7\xd9\xe8\x95]\x7fT\x17s\x00\xe2J\xd9\x05F\x88\x86\x11\xb8\xc51\x1ch\x06\xef!\xd4\xc0\xad\x8d@\xd8\x13F\xf4\x85z2\xac<\x84\xcdo\xa8\xf9d`y\x1e\x90\x04\x06b\x1b\x923\xc2\xcf\x1d\x12\x03\xa1\x0e\x8d\xb7\xbf\x1f\xb1#pȇ:\xc5\x18\x19\xacg$\xaf\x1c\xec\x94K\xf8\x06\x947Wȝ:\x00\xa1\x9c\tɟ\xe1\xe5\rg\x81*\xef\xe7@\b\xd6\xd7a\r-s\x1f\u05ebUcy\xac:\x1d\xba.yˇU. \xbbI\x1c(\xae\f\xeeЭ\xa2m*E\xba\xb5\x8c\x9a\x13\xe1J\xf5\xb6ʎxq?.;\xf3\x9a\x86:\x8d\x17\xc7\xf2A(\x16\x99\xaco\xce&r\x95\xbc =R0\x855\x05\xaa\xc4\xe4\x94\x05\xeb\x9b\x1c\xba/\xff{\xf8\n\xa3%%S%)\xa7\xa5q.?\x12M\xebk\xa4\xb2\xaf\xa6\xd0eL\xf4\xa6\x0f\xd6s\xfe\xd0\u03a2g\x88i\xd3Y\x16\x1a|K\x18YRw\r{\x97\x95\t6\b\xa97\x8a\xd1\\/\xf8\xe8\xe1Nu\xe8\xeeT\xc4\x7f8W\x92\x95XI\x12\x9e\x95\xads\xbd=\xfd\x94\xc5%\xbcg\x13\xa3LΤvZ\x11\x1ez\xd4\x17\x85'(\xb6\xb6\x83Bԁ.\x10\x01Ԩ\x17\xd3x\x97\xf1\x9c\x16\x8aᲨms=\n\xa0\x8c\xc9W\x8dr\xf7\xb3{\x9f\b\u0604\xdfw\xc1\u05f6\x11\x0eׁ\xa0\xa7\xb0\xb3\x06\xa9\x1a\xfd\x1c,I48lљ\x1b\xa6\xce\xc6\\^Mh$\xc5ʭ\x7f`\xc9q\xa1\x1c\xca\xca\xfa\xa2u'\x80\xcc<\xea\x06\xad\xf6\x8c\xde\xe0\xb5\xf6\xc8\xcb!\xd3;\xa2\x81\xbd\xe5\xb6\xd4\xcd\xd9\x05\x03\xf0\xbc,ȳ\xc5\xc3\xd4\xf0\x95\xed_[\x84-\x1eDo\xc5䈚\x90E7#:\x91A)\xda%\xc0\xe7\x14YLS\x93\x88 \xeaa\u0378{\x8b\x87\xdb@\xff0\xb9C\xdf0\xb9\xd1`\xad\x92\xe35\xbcz\xf5c\x97n\xb4n|\xe4^\x1e\x1d%\xac\x91\xd0\xf3rf\xedW\x89|&\x8d0\f\xeb\x1a5\xdb\x1d:\xb9\x1f\xbe%Kh\xde\xc0&1\x98\x84\x12\xad\x8d\xd2۽\"\x13A\x87\xaeWl7\xd6Y>\x80\x8d\x8b\tp\x00P΅=\x9a!\xe3\xd8\xf5|X\xc2G\x1fYy\x8d\xf1x+J\xc4\n\x15\x94/\xab\x06\xa1\xce7\xbc\"\x9c\x85\xefBd\xd0HBGw\x80=\x05\xdf\xcc9;!\x8e\xd2\xe5\x91G\xc6\xdcA\x9a\xa0\xa3\\c\x1a{\x8e\xab\xb0C\xdaYܯ\xf6\x81\xb6\xd67\x95\x18X\x95\x1a\x8a+\xc9b\\\xbd\xce\x7f~\x86\x05!3S\xb9g\x90WD\xce\xd6\aط\xc8m\xbef\x10\x1e\n\a\x03\x81\\'B\xedn\xe0nQC\xf3\x84M\x9b\x10\x1c\xaa\xdbB\x1bS~kR%\xc5\xf3\x12Q\x01\xf8^\x9db[u\xaa\xaf\xcaيCg\xf5\xd5\xeaQ\xd5\u058b'\xe3p?,\x13\xaa\n\xb9\xc7m#\xd9K\xef\x97;A\xd5\xe0r\xc6\xdeɌ\x90b\xfcd;\xcb\xeb\xc5ˋ\xee˸\x19\x9c\xfc.\xc6\t\xe2h\xd8\u0603J\xb7\bZ9\x17\xc7[\xa8S[\x8c\xa0\x1a\x11S\xbe\xd4\xc17\x13'\xc5V\x11\x1a\xd8\x1c\xa4\xb0\xf21R\x91\xa9\x8f\xd2\xe4\r\xff\x83A\x87\u00ad\b\x94\xbc\x97\x0eG(\x9dHt\xc0MH\x96OΩ\x8d\xc350%|\xa1\xfcj\xc2lm\xbcG\xfal}\xe2Yi;\x8b\xe2\xddͦ1\xa5\x9d\xfan\xbbԁOݦ$\xf6ؿO\xc2\x0e\x06\xa0\x81\x1e\t\xba\x8cu\xeb\xa1<\x9d\xf5\x82\xbc\x86\xb7\x93Ӆ\x9d\xd2:7x\xdd3\xc8s\f\xeaK\xfc\xfcp\xb3\xe9\xe7\xfd\xcc\x06\xfc\xdd~\xceV\xf4\xb44T\xc7\x12\\<\x03%\xb2\xe2tE\xa2\xe7\xb4xy\xdb\x10\xd8\xcd\xd0\xe6\rt\x1e0/ A\xa2\xf9\x17\xb5y}\xab\xe2D\xa2\x9fa\xf5\xbd\xec\x1c\xb3\xedl\x8d\xfa\xa0\x1d\x16@\b\xf5\r\xe4\v;Syѧ\xeeֶ\n\xde\xef\x94\xcd\x05=1\xf7\xabW\xb3\xb3\xb3\xf28\x99ϛ\xc1\x88\xb4Cs&\"\x83\x0e\xaf\x81)\xe1\xe2\xcf\x01\x00\xa1\xe7C\xfb\xc3\x11\x00\x00"),
 }
 
 var CRDs = crds()
@@ -29,8 +29,8 @@ import (
 )
 
 var rawCRDs = [][]byte{
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcYɒ\x1b\xb9\x11\xbd\xf7Wd\xc8\a]D\xb6\xe5e\xc2\xc1\x9bĶ#:<j1\x86\xed\xbe\xa3\n\xc9\"F(\x00\xc6B\x0e\xbd\xfc\xfbD\x02U\xc5Z\xc0u\x16\xde\b$\x12/\x17d>\xa0f\xb3\xd9\x033\xe2\r\xad\x13Z-\x80\x19\x81?yT\xf4\xcfͿ\xfd\xcdͅ~\xdc}|\xf8&\x14_\xc028\xaf\xeb\x1f\xd0\xe9`K|\u008dP\xc2\v\xad\x1ej\xf4\x8c3\xcf\x16\x0f\x00L)\xed\x19\r;\xfa\vPj孖\x12\xed\xacB5\xff\x16\n,\x82\x90\x1cmT\xden\xbd\xfb\xe3\xfc\xe3w\xf3\xbf>\x00(V\xe3\x02H\x1f\xd7{%5\xe3n\xbeC\x89Vυ~p\x06KR\\Y\x1d\xcc\x02\x8e\x13ia\xb3i\x02\xfc\xc4<{jt\xc4a)\x9c\xff\xe7d\xea{\xe1|\x9c62X&G{\xc7\x19'T\x15$\xb3ù\a\x00Wj\x83\vx\xa1\xad\r+\x91\xc6\x1a\x9b\"\x94\x190Σ\x97\x98\\Y\xa1<ڥ\x96\xa1n\xbd3\x03\x8e\xae\xb4\xc2\xf8\xe8\x85>,p\x9e\xf9\xe0\xc0\x85r\v\xcc\xc1\v\xee\x1f\x9f\xd5\xca\xeaʢK\xb0\x00~tZ\xad\x98\xdf.`\x9e\xc4\xe7f\xcb\x1c6\xb3ɕ\xeb8\xd1\f\xf9\x03\xe1u\xde\nU\xe5\x10\xbc\x8a\x1a\x81\a\x1bCHv\x97\b~+\xdc\x10ڞ9\x82g=\xf2\x93@\xe2<\xa9s\x9e\xd5f\x8c\xa8\xb74A\xe2\xccc\x0e\xd0R\xd7F\xa2G\x0e\xc5\xc1ck\xc6Fۚ\xf9\x05\b\xe5\xbf\xfb\xcbi_4ΚǥOZ\r\x1d\xf3\x99F\xa17\x9c\x90P\x94*\xb4Y\xefh\xcf\xe4/\x01\xe2I\xc1\xe7\xde\xfa\x84$\xe9\xed\x8f_\x84B)\az\x03~\x8b\xf0\x99\x95߂\x81\xb5זU\b\xdf\xeb2\x85o\xbfE\x8bQ\xa2H\x12\x94\xbd (v\xdafCg\xb0\x9c'\xd9FY\xabk\x14\xbf\xe1F\xbfzn\x95\x16Y6\xb7\xdaR3\x8f\x12B\xab|\x82}\xaa\xf0\xaa\xe4\xea;Qi\x8e=\x8f\r0\t\a\xc6\xea\x12\x9d;\x93\xf0\xa4`\x80\xe2\xe580qM\x92\xd8\xfd\x89I\xb3e\x1fS\x91)\xb7X\xb3E\xb3B\x1bT\x9fV\xcfo\x7f^\x0f\x86\xe1L\xc1`\xa5wT)\b\xbe\xb1\xda\xebRK(\xd0\xef\x11U\n}\xadwh\xa9\xceUB\xb9N#Um\xde\x178\xd6l\xca﨏fӤŘ=\x04\xd0\xf6\xa3\x0f\xb4\xa7A\xebE[\x85\x1b\xdd\xc7\x06\xd3\x1b\x1d\xd9\xf1\xbf\xd9`\x0e\x80LO\xab\x80S\xa7\xc1dVS[\x917\xdeJ\xc1\x13\x0e,\x1a\x8b\x0eU\xea=4\xcc\x14\xe8\xe2G,\xfd|\xa4z\x8d\x96Ԁ\xdb\xea 9\x19\xbbC\xeb\xc1b\xa9+%\xfe\xd3\xe9v\xe0u\xdcT2\x8f\xce\xc7\xc3h\x15\x93\xb0c2\xe0\ar\xdaHs\xcd\x0e`\x91\xf6\x84\xa0z\xfa\xe2\x027\xc6\xf1\x85\xbc(\xd4F/`\xeb\xbdq\x8b\xc7\xc7J\xf8\xb6햺\xae\x83\x12\xfe\xf0\x18\xa3!\x8a\xe0\xb5u\x8f\x1cw(\x1f\x9d\xa8f̖[\xe1\xb1\xf4\xc1\xe2#3b\x16\rQ\xb1\xf5\xcek\xfe\a\xdb4j7\xd8v\x92\x88\xe9\x17\x1b\xe6\r\xe1\xa1.J\xa7\x825\xaa\x92\x89\xc7(\xd0\x10\xb9\ue1ff\xaf_\xa1E\x92\"\x95\x82r\x14\x9d\xf8\xa5\x8d\x0fyS\xa8\rڴncu\x1du\xa2\xe2F\v\xe5\xe3\x9fR\nT\x1e\\(j\xe1)\r\xfe\x1d\xd0y\n\xddX\xed2R\x13(\x10\x82\xa1z\xc0\xc7\x02\xcf\n\x96\xacF\xb9d\x0e\x7f\xe7XQT܌\x82pU\xb4\xfa\x84k,\x9c\xdcۛh\x19Ӊ\xd0\xf6+\xc8\xda`IQ%\xc7\xd22\xb1\x11M'\xa12\xc0\x06\xb2C\x0f\xe5\x8f>\xfd\xb2\xddd,t)\xdd\xe8\xf79\xa7\xa8E\xabz\x85\xbc\xe9u\xaeiRrؤ\xfa\xbfI\x7f\xb4h\xb4\x13^\xdbñK\x8eS\xe1dT\xe8W2U\xa2\xbcǼe\\\tBq\xf29v\xa9LE(i\x8d@\xb5\xaa4\x1d\xaeA(\xe0ٓ\f\xe5\xb6C\x9f7Te\xbb\x9aPp\xe4\x94\xd0\xe7\x8ecs\v\xad%\xb2\xb1\x17)\v\xbfP[Xj\xb5\x11\xd5\xd4\xf0>\xfd=\x95\"\x17|\x9aI\xd8ޖd\x05e'!\x99\xc5\x0e5kS\x97J\xfbFT\xc1\x9e\x8a\xffF\xa0\xe4\x93\xfas\xf2$\xb5\x06\xc7]\xee\x89q\a\xbd=]MW\xeb\xb5^\xafc\x85r\x91\xef\xf6Rs\n\x12\xe0y\xd3\xd3(\x1c\xbc{\a\xda»t'z\xf7!\xad\x0eB\xfa\x99\x18\xf4\xff\xbd\x90\xb2\xdd\xe5\xa6\xec&\x86\xf3u}\xc1\xf2\x97(Dx\xbe\xaeo\xe5VS4\xa8B=\xddp\x06,x\x9d\x19\x96B\x85\x9f2\xe3{\xa1\xb8\u07bb[\x8c\xed\xf8\rQL\x1d\xfc=\x01\xff:\xd21\x8a\xbb'B\x1cc\xed5\xec\x99\xe8q\x8cnw\xf7!\xa3\xb7\xc0\r5$\x8b>XE\xe5\x00\xad\xa5\n\xed\xa2J\x1d&\x9c笥N1\xe3\xb6\xda??]\xb0q\xdd\t\xb6u\xf7\xf9\xa9\r\xf1[̺\xae\xf86\x92\x90\x89\x12\xc1oY$\x8fm\xfd6\xb4\x91Kt7\xee{²\x1e\xaah\x8d\xd1VT\x82\x9c\xaf\xba\x99c\xce\xee\xe8\xda\x1eE\xc9D\xe4\x10\xcc\t\xec@\xe5\x98\xc8K\x81\xc0\xc5f\x83\x96\x18J\xa4/i\xe3\xd5\xdb\xf2\xbd\xebm\"6\xfd?T\xf9kf\fr\xba+Qp\x1b_\xdd\xe4%\xcfl\x85\xfe-\x82\xbe\xe0\xa2מh\xeb\n\xa2:t\xb1m\xb8tL\xd6(\x06\xab\xb7e\x86\xf9\xd2o\xf56Ex\x9a\x17@s\t:\x11\xc4\t\xcaI\xb4\x1a<\x9d\x8e\xac\x8a\xb3m\x05\xc0\xec\xae\xd8y\xf5\x96c\x19\x9d;\xc0o\x99'\x89\xe6\xd2\n\xc5!\xab\x13\xda#҄\xf3>\xbc\xe5U\x80\x97g\x11/ǐO\xe0-\x0e\xbf\x182\x91\x18a\x91\xe7J\xf8\xe9\xc8\xcd\xc0첃\xe5\xf5\xad:\xbf\xf3,\xcfGG2\xe3\xd2?\x9a>\xd6\xcb\xf1İ\xae\x8cf\xfbG\xf2*\xe2\x1e\x9f\x15\xae\xa5\xee鱰\t{\x19l,:\xcd\x13\"݆\xef\"\xef\xac,\xd1x\xe4\x9f\x0f\xd4կh\xfc\x04@\x9d\x7fT\xf9\x979\xb6}4\xecV\x86\xddB\xea\x1e~\xeei\x00\x9f\xc6J\xe2\xed\xdf\xf2^[\x9e\xc2M\xd4\xec4h\x80W\xba7\xc5\xdb\xeb\xfbԉiY\xec\xef\xc4P'\x9bN4\xb4\x8f\x89t=\x9d\xd1\xfa\x89\x84\nR\xb2B\xe2\x02\xbc\r\xa7\xa8z\xfef\x92\xdeQ\xfbOfw]S\xa6j\xa6\xbec\xdd#Q|\xcck_ps.;\xea\xeb\x1c\x96\xd4!\aܡ\x02\xba|2!\x91\xb7:3|\xfd\x92\xe73\xa0\xa7T\xf0\xb7t~\x8dα\xea\xd2\x01\xfa\x92\xa4һJ\xb3\x04XA\xbcq̚\u07fb\xe6l\xdf\xcc\xdf\x7f\x9dC|%{?\x83%\xde5/\x80Y\x91L\xae\xa6u\xd0N\x1758syx\xc1}f\xb4=\x9f\x99\xa9Us\xe83S\x93O2\xfd\xc9t\xa9\xcf5\xc6v.\xab\xb3\xfb摙\xfbG<\f7y\xba\xc1w\xcfq\xef\x9e\x06\xb6Z\xb6'<~\xabP\xa1.\xd0R\x18\xe2א6\x1e\x1d\xefg\x8a\xf7\xa3\x96#\x7f\x9d\x86\xee.\x10U\xcd\xe1uK\xd4$\xbdg\xb4\xb7#.\x9c\x91\xec\xd0\x19\xd3g\xa8\x19\xe5\xc7S3y\xae\xbe\x95\xa4vߎ\xf2\xcc+\xf7\x01h\xf8\x9b~\xca\x19\xcdw߄~\x9b\x1dμf\f\xbf\xd1\xddu\x95\x1ah\xb8\xd4\n\x9ao\x86\xb7W\xf0\xe16\xbfg\xf1\xcezo2\x18\x91\xf3\x9e\xee\xe6\xf5\xb1?\x12\x8a\xeeI~\x01\xff\xfd\xff\xc3\xcf\x01\x00\x00\xff\xff\xb1\xea?f~\x1f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcZIs\xe3\xb8\x15\xbe\xfbW\xbc\xea\x1c\xe6b\xc9\xe9,S)\xdd\xdarR\xe5ʴ\xdb\xd5r|\x87\xc8'\x12c\x10`\xb0H\xe3,\xff}\xea\x01 \x05\x92\xd0:\xd3\xcdCW\v\xcb\xc3\xdb\xf0\xbd\x05\x9e\xcdf7\xac寨\rWr\x01\xac\xe5\xf8\x8bEI\xbf\xcc\xfc\xedof\xce\xd5\xdd\xf6\xe3\xcd\x1b\x97\xe5\x02\x96\xceX\xd5|E\xa3\x9c.\xf0\x017\\r˕\xbciв\x92Y\xb6\xb8\x01`R*\xcbh\xd8\xd0O\x80BI\xab\x95\x10\xa8g\x15\xca\xf9\x9b[\xe3\xdaqQ\xa2\xf6Ļ\xa3\xb7\x7f\x9c\x7f\xfcq\xfe\xd7\x1b\x00\xc9\x1a\\\x00\xd1s\xadP\xac4\xf3-\n\xd4j\xceՍi\xb1 \xb2\x95V\xae]\xc0~\"l\x8bG\x06v\x1f\x98e\xff\xf2\x14\xfc\xa0\xe0\xc6\xfes4\xf1\x137\xd6O\xb6\xc2i&\x06\xa7\xfaq\xc3e\xe5\x04\xd3\xe9\xcc\r\x80)T\x8b\vx\xa2#[V \x8dEI<\v3`e\xe9u\xc3ĳ\xe6Ң^*\xe1\x9aN'3(\xd1\x14\x9a\xb7\xd6˾g\b\x8ce\xd6\x190\xae\xa8\x81\x19x\xc2\xddݣ|֪\xd2h\x02K\x00?\x1b%\x9f\x99\xad\x170\x0f\xcb\xe7m\xcd\f\xc6٠\xbe\x95\x9f\x88C\xf6\x9d\xb85VsY\xe5\xce\x7f\xe1\rB\xe9\xb47\x1b\xc9\\ ؚ\x9b\x94\xb1\x1d3Ĝ\xb6X\x1ed\xc3\xcf\x131cYӎ\xf9I\xb6\x06\x86Jf1\xc7\xceR5\xad@\x8b%\xac\xdf-vBl\x94n\x98]\x00\x97\xf6ǿ\x1c\xd6DT\xd5\xdco}Pr\xa8\x96{\x1a\x85d8pB\x16\xaaPgu\xa3,\x13\xbf\x85\x11K\x04\xee\x93\xfd\x81\x93@7\x1d?\xc9\n\xb9\x1b\xa8\r\xd8\x1a\xe1\x9e\x15o\xae\x85\x95U\x9aU\b?\xa9\"\x18oW\xa3\x8e\xc6[\x87%\xa6VN\x94\xb0\xee$\x060V\xe9\xac\x15[,\xe6aW\xa4ۑ\x1d\x99rx\xe6\xef\xecd\x85F\x96u\xb2\x0ee\xe6~\x05W2\xefi\x9f*<\xcb\xcbRmJUb\xaf:L9\xe2\x06Z\xad\n4\xe6\x88\xdf\xd3\xf6\x01\x0fO\xfb\x81\x89Z\u008aퟘhk\xf61\xa0LQc\xc3\x16q\x87jQ~z~|\xfd\xf3j0\f\a1\x83\x15\xd6\x10X\x10\xeb\xadVV\x15J\xc0\x1a\xed\x0eQz܂FmQ\x13\xc8U\\\x1a`\xb2\xeciB\xba`\x0f\xd5\xe4\xe4\x9e\x1e͆\xc9\xe8N\xaaE\x9d\x9a\x1d\xe8\xc8\x16\xb5\xe5\x1d\xfa\x86/\t+\xc9\xe8H\x88\xff\xcd\x06s\x00$w\xd8\x05%\xc5\x17\fREl\xc52\xaa*؍\x1b\xd0\xd8j4(Cġa&A\xad\x7f\xc6\xc2\xceG\xa4W\xa8\x89Lw\x1f\n%\xb7\xa8-h,T%\xf9\x7fz\xda\x06\xac\xf2\x87\nf\xd1X\x7f!\xb5d\x02\xb6L8\xbc\x1di\x8f\xbe\x86\xbd\x83F:\x13\x9cL\xe8\xf9\rf\xcc\xc7g\xa5\x11\xb8ܨ\x05\xd4ֶfqwWq\xdb\x05\xdbB5\x8d\x93ܾ\xdfyc\xf0\xb5\xb3J\x9b\xbb\x12\xb7(\xee\f\xaffL\x175\xb7XX\xa7\xf1\x8e\xb5|\xe6\x05\x91>\xe0Λ\xf2\x0f:\x86g38v\xe2\x85\xe1\xf3\x81\xf2\x02\xf3P\xfc\xa4+\xc1\"\xa9 \xe2\xde\n4D\xaa\xfb\xfa\xf7\xd5\vt\x9c\x04K\x05\xa3\xec\x97N\xf4\xd2ه\xb4\xc9\xe5\x06uطѪ\xf14Q\x96\xad\xe2\xd2\xfa\x1f\x85\xe0(-\x18\xb7n\xb8%7\xf8\xb7Cc\xc9tc\xb2K\x9f\x90\xc0\x1a\xc1\xb5\x04\x05\xe5x\xc1\xa3\x84%kP,\x99\xc1\xefl+\xb2\x8a\x99\x91\x11βV\x9af\x8d\x17\a\xf5&\x13]\xa6t\xc0\xb4{\xf8X\xb5X\x90MI\xad\xb4\x89ox\x8c%\x84\x01,Y9\xd4N\xfe\xdaӗ\r!\xe3E\xa7\\\x8d\xbe\xfb\x1c\xa1\x8eW\x99\xe0w\x17\xeabd\x12\xc3Ȕ~{\x90\x8f{4\xb6\xcap\xab\xf4;\x11\x0e\xa1q\xec\x06\a-B_\xc1d\x81\xe2\x1a\xf1\x96~'pY\x92Ʊwc\x02\xa0@\xd53\xaad\xa5\xe8b%\x86\x80GK+ȫ\rڼ\x982\x13ʸ\x84}6\ti\xd68\x16u\xad\x94@6\xd6`a\xf8J\xb2\xd6\xd4ʞ\x10\xf8q\x03\xddʗ\xf7\x16\xe9\xf0\xe5\xea\xf1\x96\xfe\xe9\xc6Ƀ\xb6\xbc\x8c\x10O\xb7\x8c\xf2\xaa\xbc٢\x9d\x97\xabG0q\xfb\xd4H\xd2\t\xc1\xd6\x02\x17`\xb5\x9b\nv\xd8a\xe9\xeb\xc8.\x053\xd9\x05#\x01W\xe9\xfa\x9cOv\x04\xa1\xf0+l\xcdr\x86\xf2\x1a\xa7\bG\xe5A\xb2\x89\xf7\x89\x10츭\xb3;\x8f8%\xc44\x8fUx\xb6@\xc9\xf2\xac<\xf1r\x05q\xd4\xe6\x880ϯK/\xef)\xc9\bۯ\x91,\x90<\xec\x89\x13\xd9^\a\x1brҍ\xb8<$\x9c\xa2+Gȁ%\xb8\xf6r\xde\xe9\x86s\x8d\xe5\x94\xe7\xd9\xc0^\x99\xe9\xa1\xd0\a\xae\xed$\f@\xcc\xf0>S\x0e\xb7Trë\xe9\xd9i\xb1z\xec\x8e\x1c\x15m\x12^\x92#I\xe3\x14M\x88\x93\x99O'g]\xa8\xa1Dl\xc3+\xa7\x0f]\xfd\rGQN\xb2\x85\x93\xb7\xfd\x84><\x13׀v/Y\x17,#~%it\xf0\x12g|\x01\x9bĚ\xa9\f@8\xb9\xa7\xc8\r|\xf8\x00JÇ\xd0\xd8\xf8p\x1bv;.\xec\x8c\x0fr\xf9\x1d\x17\xa2;\xe5\xa2p\xd5\xe7\xefT=)w\nǳ:\xf82\xa21R\x85\xa5Jϋo\x15\xec\x18Or\xe8\xfets\x9b\xa1\xbb\xc6\r%\\\x1a\xadӒB\x1ejM9\x88\xf1$\x95\xcb`\xfe\x11IM\x12\x7fNH9\x0eU^\n\xfa\xff\x18\xcbS\x00\xc8\b\x90\xb3\xf11\x0e}~\xdcw\x91\xae1\xc5jH\xa2c^i^qR\xb8\xecg\xf6\x99Oĺ\xd8\"\xf0H\xe6\xa18\xeb\x9f=Z\x1aB\xcb=9\xba\xce\xe1pB{&K\x1f\x9c\xfb\xf92^\xbd\xcc\xc5=\xa9\x90\xe7\xd7\xe5){\xf5\ag\xa0\x9c\x86w5/\xea\xa1\xe9\xf8\x14T\x01,{C\x9f\xe8^\xc0f\x1e\xc3g\xf9\xb4w\xb4f|\xfbFөˎ\xa7\x86\x86\xce\xce>\xbf.\xcf*\r|\xd7\xe2\xbc\xe2 \xb4#\xa3\x96\v\xa7\xb5/\xbb\xc2(U\xdbW\x94\a\xac(\xb0\xb5X\u07bf?\xa9\xf2\x94\xd3\x7f\x1a,&F\xe49}\x9b\x8c\xa9}'\a[vi~߱\xdbw\x9b\xae\xb9\xa6\x9f\xc6D|\xdfA\x97\t`N\xb3\xf5\x006\x87\x99\x06x!\a\xf7u\xf3\x0f\x01#i\x9bG^\xba\x9e\x93C'\x14\xbaV&\x15\xc63\xda\x7f]\x94\xcd\xd7E\xa1\x8b\x9b\xf6\xe9\xae*\x92\xa6d\xa6\xbac]5\xe7\x1b\x88]\xfb8\xa7\xb1=\xb9^_\x81\x1a\x96\x80[\x94@u/\xe3\x82b\xb7'\x99\x01\xb0\xe3Tb\x10\vo\x05]C\xa4k\x9ee;S\xa7-\x99Q\xc2\x14;\xa51\xfb\x14\xf2+\x1a'2I\xc37L!Ñ\xa147\xd9\x14\xf2x\xed\xc8\f0ЁHčC\xa0u\xb6\x92\xb2ye\x83ư\xea\x14\xa2}\x0e\xabB\x1b-n\x01\xb6\xa64j\xc8\xda\x0f&\x02\xedEp%Oc\xeaEH:\xe8\x80_\xccɗ\xd5\x19\xbc|Y\xd1!_V\xbf\x95\x17\x94\xae\xc9\x15V\xccY\x95\x19\x16\\\xba_2\xe3;.K\xb5\x9bޯ#\xa2\xb6\xcc\xd6'\x04}f\xb6\xee\xe2\xe8\xc6\t\xe1\xf7L\xf2˘\x9a\xad\x91\x80\xe3\xf7J3}\x9f\xe7\x14{\xb4&\x17\xe7\xf1\x9c;sH\xf3O\xb8ˌvq)3\xf5\x1c\x83]fj\xf2\f\x9aN\x86VZ\x0eS\xba\xb9,\xcd\xfe\xa513\xf7\x0f\x1f\x05.\xd2s\xe4\xef\x9a0\xd77\xe5j%\xba\xc8\xe6_\b\xa5k֨\xc9\b\xfe\rrԯ\xa0\xbc;\xb1X\x86p\xb2\xbfO\xf6=\xa59\xbc\xd4\xdctmĮ\\+\xb9i\x05{\xefe9\x85\xad=n\x8d\x9f\x87\xa6Nr\xbc\xffֿ\xd7\xe6\xdb9\xb9G\xd7\xe17}>\x1d\xcd\xf7\xef\xb0\xdf\xe6\x84#\x81\xa1\xbbޏ\x0fg֡\x8f\x0f\xddU\xe4%JK\xa5\xf5\xfeIn_\xd1\xf8\x16oN\x97\xe3\xd6\xf6eE\xd8\xe0\x15\xff\xaa\xa2t@\xe1D\xba\x16\xff\xa8 \x97\x14\xad\b\f\b\x82\xfc#\xd0r\xfc\xec{ۿ\"3\x1b_\xa2\x8a\x9a\xc9\ns\x95\x9e\x92\x94\x03\xf8\x1c\xe2\xf2\xfck(\xd0\xf7L\xbd\xb2^5\x19\xf4\x9c\x97\t\xed\xd8KLGܺ\x7f\x1a\\\xc0\x7f\xff\x7f\xf3k\x00\x00\x00\xff\xff\x80\xa2\a{\xfc#\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcYIs[\xb9\x11\xbe\xf3Wt9\a_̧8\xcbT\x8a7\x8bJ\xaaT\x19۬\xa1\xa2;\xf8^\x93\xc4\x18\x0f@\xb0\x90\xa3,\xff=\xd5X\xde\nJ\xa2fb\x92\x17bi|\xdd_\xa3\xbb\x01,\x97\xcb\x05\xd3\xfc\x11\x8d\xe5J\xae\x80i\x8e\xbf8\x94\xf4\xcfV\xdf\xfeb+\xaenN\x1f\x17߸lV\xb0\xf6֩\xf6'\xb4ʛ\x1a\xefp\xcf%w\\\xc9E\x8b\x8e5̱\xd5\x02\x80I\xa9\x1c\xa3fK\x7f\x01j%\x9dQB\xa0Y\x1ePV\xdf\xfc\x0ew\x9e\x8b\x06M\x10\x9e\x97>\xfd\xbe\xfa\xf8C\xf5\xe7\x05\x80d-\xae\x80\xe45\xea,\x85b\x8d\xadN(Ш\x8a\xab\x85\xd5X\x93\xe0\x83Q^\xaf\xa0\xef\x88\x13Ӣ\x11\xf0\x1ds\xec.\xc9\b͂[\xf7\xf7Y\u05cfܺЭ\x857LL\xd6\x0e=\x96˃\x17̌\xfb\x16\x00\xb6V\x1aW\xf0\x85\xb5h5\xab\xb1Y\x00$\x9d\x02\x94%\xb0\xa6\tVbbc\xb8th\xd6J\xf86[g\t\r\xda\xdapMCư\xc0:\xe6\xbc\x05\xeb\xeb#0\v_\xf0|s/7F\x1d\f\xda\b\v\xe0g\xab䆹\xe3\n\xaa8\xbc\xd2Gf1\xf5\x92EV\xb0\r\x1d\xa9\xc9=\x11^\xeb\f\x97\x87\x12\x82\a\xde\"4\xde\x04\n\xc1rY#\xb8#\xb7chgf\t\x9eq\xd8\\\x04\x12\xfaI\x9cu\xac\xd5SD\x83\xa9\x11R\xc3\x1c\x96\x00\xadU\xab\x05:l`\xf7\xe40\xeb\xbdW\xa6en\x05\\\xba\x1f\xfet\x11\x82Nƪ\xc2\xd4;%ǆ\xb9\xa5V\x184G$\xc4\xd2\x01M\xd1:\xca1\xf1k\x808\x12p;\x98\x1f\x91<P3\f\xdb_\x84B.\aj\x0f\xee\x88p\xcb\xeao^\xc3\xd6)\xc3\x0e\b?\xaa:\xd2w>\xa2!\xfa\x10vq\x04y/p\xe2N\x99\"u\x1a\xeb*\x8eM²\xac\t\x7f\xe3\x85~sߪ\r\xb2\xa2o\xe5PS\x85\x11\\ɲ\x83}:\u0adckhD\xa9\x1a\x1cXl\x84\x89[\xd0F\xd5hm\xd1ja\x83U$ uF\x14_\xfa\x86\x99i\xe2\x88\xd3\x1f\x98\xd0G\xf614\xd9\xfa\x88m\b\xa2\xf4Oi\x94\x9f6\xf7\x8f\x7f\u070e\x9aa\xac\xc0\b%\xab\x9d\xa5HA\xdah\xa3\x9c\xaa\x95\x80\x1d\xba3\xa2\f\x81\vZuB\x03Z\xf8\x03\x97\xd9\xd3\xe8\xcbd3\x1c\xd0\xc7l\xf2\xef`\x0eꍝ\x06\x83\xf7\x80\xd2h\x86\xec\x03\x99H\xa3q<G\xe1$\xbbO0\x83։\x1e\xffY\x8e\xfa\x00H\xf5\x18G\xa1\xa1L\x83Q\xad\x14[\xb1I֊\xe4q\v\x06\xb5A\x8b2\xe6\x1ejf\x12\xd4\xeeg\xac]5\x11\xbdECb\xc0\x1e\x95\x17\r%\xa8\x13\x1a\a\x06ku\x90\xfc_\x9dl\vN\x85E\x05sh\x1dmq4\x92\t81\xe1\xf1\x030\xd9,F\x82\xa1eO`\x90\xd6\x04/\a\xf2\xc2\x04;\xc5\xf1\x99\xac\xc8\xe5^\xad\xe0蜶\xab\x9b\x9b\x03w9\xed֪m\xbd\xe4\xee\xe9&\xb0\xc1w\xde)co\x1a<\xa1\xb8\xb1\xfc\xb0d\xa6>r\x87\xb5\xf3\x06o\x98\xe6ˠ\x88$\xf5m\xd56\xbf3)Q\x0fy.8b\xfc\x85\x84y\x05=\x94E)\x90\xb0$*ڤg\x81\x9a\xc8t?\xfdu\xfb\x00\x19I\xdc쑔~\xa8\xbd\xc4\x0fY\x93\xcb=\x9a8ooT\x1b\xe8@\xd9hť\v\x7fj\xc1Q:\xb0~\xd7rGn\xf0O\x8f\xd6\x11uS\xb1\xebP\x9a\xc0\x0e\xc1k\x8a\a\xcdt\xc0\xbd\x845kQ\xac\x99\xc5\xef\xcc\x15\xb1b\x97D«\xd8\x1a\x16\\\xfd'\x0e\x8e\xe6\x1dt\xe4\x8a\xe9\x02\xb5\xc3\b\xb2\xd5X\x13\xabdX\x9a\xc6\xf7<e\x12\n\x03l\x14m\xc6\x16*o}\xfa\x16\xb3\xc9t\xd0K\xeeF\xdfے\xa0\x8cV\x0e\x02y\xcau6eC\x91\x86\x16D\xce\xf2\xa3A\xad,w\xca<\xf5Yr\xea\n\x17Y\xa1_\xcdd\x8d\xe2-\xea\xad\xc3L\xe0\xb2!\x9bc\xe7\xca\x14\x84\xa2\xd4\xe0\xefJ\x1e\x14m\xae\x11\x15p\xef\xa0f\x92|ۢ[\xccdSZ\x93Ŭ\xc6%\xf45%\fk\xc7\xfe\x13\xd5\xdd)%\x90\xc9\xc5D/\xe6\xd8gJ\vk%\xf7\xfc0W|X\xfe^r\x91\x17l:\xb1\xde\xddxI\"\x8a\xbc\x93\xf6\xc32d\xa8ev]\n\xed{~H\x05Ga\xd1=G\xd1\xd8\xea\x82Ƴ\x9d\x94\x15\x0e\xab\xac\x9eGY七\x9ewW\xcaj\x83\xd4\xeb\x14\xb1\xe8m\xa8w\a\xae9\a\tp\xbf\x1fH\xe4\x16\u07bd\x03e\xe0]<\x13\xbd\xfb\x10g{.ܒ\x8f\xf2\xff\x99\v\x91W\xa9\x16W0A\x15\xce\xd7\xed\v\x9aS\xd5\xf3uK\xb4|\xdd^[[\xcdѠ\xf4\xed|\xc1%0\xefT\xa1Yp\xe9\x7f)\xb4\x9f\xb9l\xd4\xd9^\xa3lW\xdfP\x89\xa9\xbc{\v\xe1_'2&\xbc;*\x88\x03\xd7N\xc1\x99\xf1A\x8dѭn?\x14\xe4\xeepOŃA獤p\x80\xc6P\x84\xb6A\xa4\xf2\xae\xbaFS+\x99\xb6G\xe5\xee\xef^\xd0q\xdb\r\xccq\xf7\xfe.S\xfc\x18\xbc.\a\xd2,\x12\n,\x01\xf9^\xaa\"\x9b\x90֯C\x1b\xaa\x9a\xee\xc4\xfd\x16Z\xb6c\x11Y\x19e\xf8\x81K&\xc2)'\b\x1f\xf8쉎\xeda(\xa9\x88\rx}\x01;P8\xa6\xe2e\x87\xd0\xf0\xfd\x1e\rU(46-\xbcy\\\xbf\xb7\x83E\xf8~\xf8\x87\"\x7f˴Ɔ\xce\xe1Dn\xb2\xd5UVr\xcc\x1c\xd0=\x06\xd0/\x98\xe8a04\x9b\x82\xcaR\xd3v\xb545E\x89\xb0y\\\x17*_\xfam\x1e\xe7\b/\xd7\x05\xf9\x10t\x81\xc4\x19\xca\x19[\tO'\xa3(\xe2\x19\v\xd1O\x9f^\xb1\xf2\xe6\xb1Tet\xe6\x00wd\x0exwh\x85\xddSQ&\xe4-\x92\xe8|\x1b\xdeI)w\x01\xf0\xfaY\xc4\xeb)\xe4\xa2H\xa0\x04\xf4k!S\x11\xc3\rN\xce\x16\xf4[\xf6\xec\x17\xfa\xf4\xa9\xd8X\xbf>U\x97W^®TFN\xc6LC\xff\xa4\xbb\x8f\x97ӎq\\\x99\xf4\x0e\xb7\xe4\xe2\x15:Ļ\xa3\xd5\xe2\"\xcf\xc34\x1a/\xf92\xed\xb57!\xe8\xa4+D:\r\x8f\x92n\xb5x\xdd&eu\x8d\xdaas\xfbDY}\xb5x\xd6\xedh\b\x01\x90\xcf_\xaa\xfcC\xf7i\x1f5\xbb\xb6\xc2ΐ\xba\x8b\x9f\xb7$\x80OS!\xe1\xf4o\x9aAZ\x9eÍ\xa5\xd9e\xd0\x00\x0ftn\n\xa7\xd7\xf71\x13Ӵ\x90ߩB\x9d-:\x93\x90/\x13\xe9x\xba\xa4\xf9\xb3\x11\xd2\v\xc1v\x02W\xe0\x8c\xc7k\xecV\xc7{\xd4\xe4\xd4o\xb6\xdcz.fn;\x96\x03F\xbc\xcc\xcb7\xb8ճ\xf2:\x83Eq\xd8\x00\x9eP\x02\x1d>\x19\x17\xd8d\x99\xf6z\xcb\x17@\xdb\xefj\xfc\x16\xade\x87\x976\xd0\xe78\x8a\xa0\xb3<\x05؎\xea\xc6\xec\x8dy\x03\xbf\xb7)<T\xd7\xc0\x90\xbf\xd9&~e\xf5\xfe\f\x96p\xd6|\x01̆ƔbZ\am\x88\xe5\xf5\x87\x87/x.\xb4\xe6\xfdY\xe8ڤM_\xe8\x9a=\xc9\xf4\xdfe:\xd4ϕ\xef\xfb\x8a2\x93\xbf\x16\xfb\xfe\xc6xi\xd2s\x96N\xf8\u07b2ݻ\xab\x81\xa3\x12y\x87\x87\xb7\n\xe9\xdb\x1d\x1a\xa2!\xbc\x86d>\xba\xba\x9fn\x94\a\xac\x15D\xf7\x12\xd2\xc6N/<\x15<\xd0u_\xba\xcfȧ\xa3\x86[-\xd8S\xa7̰B-\b\xefw\xcd\xec\xba\xfa\xda\"\xb5{;*u\x96\x1f\x80Ɵ\xf9S\xce\xf8ӿ\t\xfd\x7fV\xb8X\"\x01\x8c\xdf\xe8\xde\xe2 ۑ\x84\x97RAz3\xbc>\x82\x8f\x97\xf9\x9e\xc1\xbbh\xbdYc@\xde\fd\xa7\xdb\xc7a\x8b\xdfuW\xf2+\xf8\xf7\x7f\x17\xff\x1b\x00\xb1\xea?f~\x1f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbc;k\x8f\x1b7\x92\xdf\xf5+\n\xbe\x03b\x1b\xd3\x1a۹\xf3%\xfab\xd8\xf29\x18lb\x0f\xa2\x89\x17X\xafwAu\x97$f\xd8d/ɞ\x19ew\xff\xfb\xa2\xf8\xe8'\xf5tbi>X|\x14\xeb]\xc5*:˲\t\xab\xf8GԆ+9\x03Vq|\xb0(闙\xde~g\xa6\\]\xde=\x9f\xdcrY\xcc`^\x1b\xabʟѨZ\xe7\xf8\x16W\\r˕\x9c\x94hY\xc1,\x9bM\x00\x98\x94\xca2\x1a6\xf4\x13 W\xd2j%\x04\xeal\x8drz[/qYsQ\xa0v\xc0\xe3\xd1wϦ\xcf_N\xffw\x02 Y\x893 xu%\x14+\xcc\xf4\x0e\x05j5\xe5jb*\xcc\t\xecZ\xab\xba\x9aA;᷅#=\xbao\x99e\xbf8\bnPpc\xff4\x98\xf8\x91\x1b\xeb&+Qk&z\xa7\xbaq\xc3\xe5\xba\x16Lwg&\x00&W\x15\xce\xe0=+\xd1T,\xc7b\x02\x10(q(d\xc0\x8a\xc2\xf1\x86\x89kͥE=W\xa2.#O2(\xd0\xe4\x9aW\xb4\xa4\x8b\x10\x18\xcblm\xc0\xd4\xf9\x06\x98\x81\xf7x\x7fy%\xaf\xb5Zk4\x1e%\x80_\x8d\x92\xd7\xccnf0\xf5˧Ն\x19\f\xb3ć\x19,\xdcD\x18\xb2[\xc2\xd6X\xcd\xe5:u\xfe\r/\x11\x8aZ;\xb1\x81\xe12G\xb0\x1bn\xba\x88\xdd3C\xc8i\x8b\xc5N4\xdc<\x013\x96\x95\xd5\x10\x9f\xceV\x8fP\xc1,\xa6Й\xab\xb2\x12h\xb1\x80\xe5\xd6b\xa4z\xa5t\xc9\xec\f\xb8\xb4/\xffg'\nU`\xd5\xd4m}\xabd\x9f-oh\x14:\xc3\x1e\x13\x92\xd0\x1au\x927\xca2\xf1%\x88X\x02\xf0\xa6\xb3\xdfcrC\xc3\xd0\x1d?\x88\n\xa9\x1b\xa8\x15\xd8\r\xc2\x1b\x96\xdf\xd6\x15,\xac\xd2l\x8d\xf0\xa3ʽ\xf0\xee7\xa8\x83\xf0\x96~\x89٨Z\x14\xb0\x8c\x14\x03\x18\xabtR\x8a\x15\xe6S\xbf+\xc0\x8d`\a\xa2\xec\x9f\xf9;+Y\xae\x91%\x95,z\x99\xa9[\xc1\x95Lk\xda\xeb5\x1e\xa5e]nJU`\xc3:\xecb\xc4\rTZ\xe5hL\x92c\xceʦ\xb4=Lz\x1c\u07b7\x03#\xb6\xf8\x15w/\x98\xa86\xec\xb9\x1b2\xf9\x06K\xe7=闪P\xbe\xbe\xbe\xfa\xf8\xed\xa27\f}\xf4;8\xb2\xdc\x1ar\x16DI\xa5\x95U\xb9\x12\xb0D{\x8f(\x9d߂Rݡ\x86J\xd4k.\r0\x19I\xa1ogA\xeb\xaaI\xc9\x1d+h\xd6\xef\x0e\xea\xa4*\xd4]\xb1\x03\xf1\xa7Bmy\xf4\xbe\xfe\xdb\t+\x9d\xd1\x01\x11\xff\xcazs\x00D\xb7\xdf\x05\x05\xc5\x17\xf4T\x05ߊE`\x95\x97\x1b7\xa0\xb1\xd2hP\xfa\x88C\xc3L\x82Z\xfe\x8a\xb9\x9d\x0e@/P\x13\x98h\x0f\xb9\x92w\xa8-h\xcc\xd5Z\xf2\xdf\x1a\xd8\x06\xacr\x87\nf\xd1X2sԒ\t\xb8c\xa2Ƌ\x01\xf7\xe8\xafd[\xd0HgB-;\xf0\xdc\x063\xc4\xe3'\xa5\x11\xb8\\\xa9\x19l\xac\xad\xcc\xec\xf2r\xcdm\f\xb6\xb9*\xcbZr\xbb\xbdt\xc2\xe0\xcb\xda*m.\v\xbcCqi\xf8:c:\xdfp\x8b\xb9\xad5^\xb2\x8ag\x8e\x10I\xe4\x9biY\xfc\x97\x0e\xe19z\x95\x1dZ\xe8\xff\\\xa0<A<\x14?\x81\x1b`\x01\x94\xe7I+\x05\x1a\"\xd6\xfd\xfc\xff\x8b\x1b\x88\x98x+\xf7Bi\x97\x9a]\xf2!nr\xb9B\xed\xf7\xad\xb4*\x9d8P\x16\x95\xe2Һ\x1f\xb9\xe0(-\x98zYrKj\xf0\x8f\x1a\x8d%\xd1\r\xc1\xce]B\x02K\x84\xba\"WP\f\x17\\I\x98\xb3\x12Ŝ\x19\xfcʲ\"\xa9\x98\x8c\x84p\x94\xb4\xbaiV\xfb\xf1\x8b={;\x131S\xda!\xda\xd6},*\xccI\xa6\xc4V\xda\xc4W<\xc4\x12\xf2\x01\xac\xe3h\xfa\xdcI\x9b=}\x93!d\xb8萪\xd1\xf7M\nP\xc4Uv\xfcw\fu!\x1a\x8a\xb04\x01\xb2u\xf2a\x8f\xc6J\x19n\x95\xde\x12`\x1f\x1a\x87j\xb0S\"\xf4\x973\x99\xa38\x87\xbc\xb9\xdb\t\\\x16\xc4qlԘ\x1c\x90\x87\xeat]ɵ\"\xc3\xea\b\x02\xae,\xe4L\x92V\x1b\xb4\x93\x11d\x8ae2\x11ʸ\x846\x9b\x84n\xd6\xd8~<\xa9K\xa5\x0429\xa4\xd5\xf0\x85d\x95\xd9({\x80\xe0\xab\x15ĕ7\xdb\n\x89\xb7\xf3\xc5\xd5\x05\xcc\x17Wq\x9c\x02\xc7\x1d/\x82\x8b'\x8f\xa8\xcb]b\vr\x9e/\xae\xc0\x84\xedc!\xc9Z\b\xb6\x148\x03\xab\xeb1a\xbb\x15\x96\xbe\x11\xec\\0\x93\\0 0R\xe1֧t2\x02\x84ܭ\xb0\x1b\x96\x12\x14}i\xf5\x1d]\x0f:\x9bx\x93\b\xc1=\xb7\x9b\xe4\xce=J\x19\xd3<\xb6ƣ\t\xea,O\xd2\x13\x8c˓\xa3VI\x88\x9e\x98\xeb\x8fsG\xef!\xcaȷ\x9fC\x99gV\x94\xc0\x11\xb4}\xecmHQ7\xc02\t\x12\xc80\x97\xdes`\x01u5I,ُ;Y8\xd78\b\xba\xf4\x97\xf5䕘\xee\x13=Z\xb0#\f\xc4\f\xef'\xca\xe1\xe6J\xae\xf8z|v\xf7\xb2\xba\xcfF\xf6\x92\xd6c\xf8\xdb\xfe\x91\xc4q\x8a&\x84I\xe6\xd2\xc9,\x86\x1a\xaa\x0f\xac\xf8:\xdc\v\x12\x87\xae8\x8a\u009cl\xed\a\xf8ᐘ\xed'\"\xe9\xb4\x1b\xcab\xb0\f\xfe\xab\x93F{-\xa9\x8d\xbb\xc0vb͘\x06\x80\xabU\a\"7\xf0\xe8\x11(\r\x8f|a\xe3х\xdf]sa3\xde\xcb\xe5\xef\xb9\x10\xf1\x94\xe9\xe4\x04A5\xf9;ݞTm\xcf\xe1\xc1\x87\x01\x8c\x01+,\xdd\xf4\x1c\xf9V\xc1=\xe3\x9d\x1c\xba9\xdd\\$\xe0.qEɱF[kI!\x0f\xb5\xa6\x1c\xc48\x90\xaa\xb6'Q\x1am\xf9\x86\x96\xec\xa7r\x18\xaa\x88\xeb\xc4\xc3\xc6\xf7\x85\xf9\x9e\x03\x18\x81\x04\xa8\xab\xd30t\x99zSE:G\x14\x8b>\x88\x88\xbc\xd2|\xcd%\x13\xee\xca\xee\x80w\xae\xb7\xc1ׅ\x12\x81\xf3d\xce\x15\x8fq\aJ4\x02HC\xf9V\v\x8e\xcc\xd9\x1fNޞɂB{;_\x04\xd33g0\xe4\xfa\xe3\xfc\x90\xbc\x9a\x83\x13\xae\x9c\xf0\xb9\xdf\xf0|\xd3\x17\x1d\xef\xe7\xd8\x01\x17v\x8b\x92.\xbb'\xa1i\xd9\xda|\r/\x9a\x94\xf8\r[\x1b`\x1a\x81A\xc9*\xd2\xd0[\xdcf\xfe\"V1\xaeM\x9fl\xb5\xea\xcb<\x01Ѳ\xf5:\xe4\x19\xc0e:9\xfe\xfd\xfco:\x04f\xb0L%\xfb\x835C\xe75\x98\x8eD\x93\x19\x0f\xa7\xfav\x92\x9c\xbd\xfe8\x9f\x1cA\x82/\xf0\xcd&;\xc5\xd6\xe6ܾ\n\x1b-(\xaf\xb5v\xb7V?JŊnR?9.Yey\x8e\x95\xc5\xe2͖\xaaL\xb3\xfd\x1a\xf4\xba\xb7\x98\x10\x91ǔ\xbdF@\x81\xb6V\x1a+v\xea\xf5(\xa2\xdb\x14\xebfg\xe8\xfc\xeb!\x10W\xb6\xd1E'ތ/;\xdeW\xefF\x1a\xe0\x86\xfc\x83+;|\xe3C\fms\x81\x8b\xbc\xdb\xe8\xd0\x11\x84X\t\xa6\xbaBF\xfb\xcf3\x92$\xdfr_\x04\x0f\xba~6\xe7\xe6c0cޱh\xefT\xe4\x0f\a\xa79ւk\xf8\xe5\xa1a\x01x\x87\x12\xa8l\xc0\xb8\xa0\xd4ǁ4\xa7B\t9@\xed\xf40֓\x02z\xe9\xc2\xdeaI&\x98`\xbe\xb20\xa5ϰ\xcdy2\x8c\xbb\xc3\xe2%\x8e\xfcIc\xd3]\x1b\x90\xc0(\xb6\x95!\x1cҝR\x95%\xc9N\x01\x13\"q\xd4G\x97w6\xa5;s\x01F\x85\xab\x9cR\u0080\xe0\xb7\b\xd4\xc2˭\xf0\xa9\x1dE\xfe\x1f\xb8\xfdP\x19\xd8 \x13v\x03\xf9\x06\xf3[\xe3\xea\x13\xb5q\x98&2\vn\xb1L0c\xc0\x8e\x86r\xba%XF\xc5\xeb\x02-\xe3\xc2_'\x94D`\x94{6a.\xb0$\x01\x17\xbal\xe2\x86*\xcd\x10[\x99)\x8d\xda_-\x00\x10\xcc\xd8\x1bͤ\xe1Q\xaf\xd2\xeb\x8e\x11\xf0.\x881p\xd0L\xeb\xe5\x1ae\x02۬\x0e\x97iǑ\xd0B\xb4\n\x98Tv\x83z\xba\xf3ț\ro\n\xe2Kl\xabF\xb5,P\x8b-\x99_{Z\xbear\x8d\xc5\xd4]Z\x9cNP8Q\x16n\xa5\xba\x97\xee\xaa\"\xa16\xd1f\x1d\xbe\rDb\xb7\xbb\xc9E0D\x9b\x8f\rdR\xbbP<l\x94\am/\xd6N\x8da\xeb/\x96Q\x00㐇M]2\t\x1aYA$\xc4#b=\x8f\xf8\x10\x95\x95-\xe9\x96D\xc2kEv@*T\x06_\"0\tXVv\x1bh۵\xa9d\x0f?\xa2\\S\x9f\xec\xdb\x17\xff\xf7\xf2\xbbs٤\x96\u038b\x16?\xa0\f\x99֗rl\f\xb1\xd3\x03p\xaa\xd16\xf6\xd6횦|\xd4\xea\x1f\x05'\x83\x16\x96\x8c\xfcz]\xedc\xe1;\xa5\x81Kc\xa9\x8az\x01|\x95>\x84\x1c\xa2w\x18b\v\xcf_\\\xc02H)6\xf6\x9a\xc3ͧ\x87\xcf\xd3\x04)\xdc\xc0\xf7\x17\x03<\xb9\x01\x92\xb6Z\xb5\xad\xc7ԇ\xcaz\xe4h\x9d\xfb\xb2\xaa\xeb\xbe\xfa\x1e=\xd2q\xc8F\xba\xfd\xe8\xe1\xa7䒗u9\x83g;\x16\x8c\x9b\xcfÏFf\xbe\\\x1d<\x94֝3\xcatך\x95T\xfá\x17\xd4\x1eYq\xd4]3\".\x84\x8d\xb1/ٰ\xfb\x1b\x13\xdc\xe3\x11\x86u\xadUQ\xe7\xd4\x04T\xabX\xb3\xc8;\x92#&x\xcb\xf3\xcdG\xc0\a\x92N\xd3ʣ\xde\x1f\x94Ȩ6aB\x8b\x94*[\xe4\xd7R\xf5\x8c\x90\x01\xcb\x02\xee7H\x9e\xd8\t9\xc2Ҏ\n\xc3\v\xd4X\x00\x83u\xcd4\x93\x16\xb1\xa0ഛ\x8a\x9b\b\xa3\xe3\xb9Y\xdb\xc3:\xe0)\x82{\xf1\xbe\x98H\r\xdd1\xe7e\x8ep/ϟ\xbdأdͪ\x1dK*f\xa9\x124\x83\xbf}z\x9d\xfd\x85e\xbf}~\x1c\xfe\xf1,\xfb\xfe\xef\x17\xb3\xcfO;???y\xf5\xdf\xe7:\xb2\xd4%m\x87\xb6\x86x\xa9V}źp\xc1T\xad\xe0FS\xdb\xf7\x1d\x13\x06/\xe0\x17\xe9\xa2\xdd.F\xa1\xac\xcb]\x87f\xf0\x88@=\xda=\xed\xce\xd8=\x1f\xce>\x97%6Y\aK0$V\xbeZ\xc3\xe0\x9d\x1e)\xd5'9\xd5L\xd4\x14\x1f\x18%\xd6\xd3\\\x95\x97\xcd\xfc\x11:\xf4\xed\xf3\x97\a\xf5\xe3\xf1'\xaf\x05\x9f\x1f\x7f\xca¿\x9eơ'\xaf\x1e\xffu\xbaw\xfe\xc9\xd3\xcb'\xaf\x1ewt\xeb\xf3\xa7\xacU\xac\xe9\xe7\xa7O^u果\xa9f\xbb\xab\xf9$\xaeq>\x97\\\x16҆\xe4\x9cwz\xc9)\xd3}\xe0\xd5\xfdfN\x13\x12\x13;+2\xed$ӚmGs\x0f\xd9m\xbdD-Ѣ\xc9\xe8\x19]V\xb2*\xbb\xc5m¾v\x9c>\x06A\xcbfT\xc1\xdaլ\xf8\x19M-\xec\xd7(\xb35\x05x\x7f\xa4kĠI6+\xf6w)\x19\xc53큌\xaec\xd3\xf3\xee\x93Iy\x05\x9d\x99\xed\xa7\xeb\xa7n\xb2\x1a\xb6tR\xd1\x16\xb5oL\xf0\x97\xd3\xc9\t\\\x94\x87\xcbO'\x15\x9dzo\xadN\xc6\xe4\xc3\xe2\b\\>,\xe8\x90\x0f\x8b/\xc5%\xed\xe63`\xb5U\x89a\xc1e\xfd\x90\x18\xbf\xe7\xb2P\xf7\xe6\x14R\xc79\xe8lrz.\xf6!\x99\xc9\x12G:ٱZ\r\x99b7\xcd풒qrp\xf1U\x8d\xab\xa1\x87\xf2A\xe2\xc0\xa6\xa0\x00\x1bvG|\x8fpL\xbd\x8cs\xa1\xd6\xd0Á\t\xa3\u008dѴ\xd7Ӱ\xb7P\x98\xd0\xd8\xfd\xe9\xf0\xbe<\xb7\xa2G}\xfb\xb9IO\x11#\xabV\xb5\x10\x14\xb06Q\x9bb\xf99\x16ۗH\xc9\xeb\xef\xd5,r\xaf5\x0e\xa1Gk\"~\xc9\xcbD+\xcd\xe3\xb5\xfa=\xde'Fcy41u\x1dj\xae\x89\xa9\xd1c\xe6\xf6\x9b\x85\a1c\xd2۹$\xccP\xdeKνc<\xb5i\x1f\x9f\x03~\xe7\xd8U\xf3\xb4f\xa3D,\xb0\xbaw\xbe\xb2.\x97\xa8IQ\xdcK\xe2(\x8d\xa0(t\xad\xe8H,\x01\xb8\xb3\xbfi\xd99HS\x9f·\xc7@\xb1\xe9ZpS\t\xb6mh9\x14\xb7\x9a\x98\x10\v\xad\xb1\xcf2\x9d\x9cV\x17k^]\xcf&\xe7]U\x0f\xddC\xdb\xd7\xd4\x7f\xcc\t{\x82n4\ufaf7\aT#v\x93\xaf\xdeFS\xec\\k\xe3\x05\xb6q\x16\xbe\xe36\x82\b\xc7\xf4\xe0\xf6\xa8q\xff-\xfe9ʼ\xe8A8\xd05\b\xff5`\x8c\"\xc0\x82\x9c\x01\xb9 \xea1\xc3|\xf8x\xfb\xa2y\v\xcel\xb8\xd7\x06\x87\x9f\x80\xe5\xaaG>?;\xbd\r\xd0'\xc8Lv\xe9\xce\x1f\xd1\x01\xa0Z9\x97\xebwJG\xed8G$\x7f\x1eA\x01\x8bBx%\x8b\x9d\xb6A\x1a\x13N\xa6\x00\r\xf4V\xb6Q<\xdf\x1f\xa3\xf2\xe5\x16\x12i\v8н\xcaE\xb35\x8fu\a\xda\r\x05ט[\xb1\x9d\x9e\xf0\x960ig\xa3A'ˢ\xc3\xed\xf0F\xaa;\xd2&\x10f\x06\xff\xfc\xf7\xe4?\x03\x00Չ\xc0\x9a\xd44\x00\x00"),
 }
 
 var CRDs = crds()
@@ -29,4 +29,13 @@ protoc \
   --go-grpc_opt=require_unimplemented_servers=false \
   $(find pkg/plugin/proto -name '*.proto')
 
+protoc \
+  -I pkg/util/csi/snapshotmetadata/ \
+  --go_out=pkg/util/csi/snapshotmetadata/ \
+  --go_opt=paths=source_relative \
+  --go-grpc_out=pkg/util/csi/snapshotmetadata/ \
+  --go-grpc_opt=paths=source_relative \
+  --go-grpc_opt=require_unimplemented_servers=false \
+  pkg/util/csi/snapshotmetadata/schema.proto
+
 echo "Updating plugin proto - done!"
@@ -26,7 +26,6 @@ import (
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
-	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
 // FileStore defines operations for interacting with credentials
@@ -64,7 +63,7 @@ func NewNamespacedFileStore(client kbclient.Client, namespace string, fsRoot str
 // Path returns a path on disk where the secret key defined by
 // the given selector is serialized.
 func (n *namespacedFileStore) Path(selector *corev1api.SecretKeySelector) (string, error) {
-	creds, err := kube.GetSecretKey(n.client, n.namespace, selector)
+	creds, err := getSecretKey(n.client, n.namespace, selector)
 	if err != nil {
 		return "", errors.Wrap(err, "unable to get key for secret")
 	}
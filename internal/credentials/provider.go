@@ -0,0 +1,110 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+const (
+	// ProviderVault is the name of the provider reading the credentials from HashiCorp Vault.
+	ProviderVault = "vault"
+	// ProviderAWSSecretsManager is the name of the provider reading the credentials from AWS Secrets Manager.
+	ProviderAWSSecretsManager = "aws-secrets-manager"
+	// ProviderAzureKeyVault is the name of the provider reading the credentials from Azure Key Vault.
+	ProviderAzureKeyVault = "azure-key-vault"
+
+	providerTimeout = time.Minute
+)
+
+// Provider reads credentials from an external secret store.
+type Provider interface {
+	// Get returns the credentials the reference points to in the secret store.
+	Get(ctx context.Context, ref string) ([]byte, error)
+}
+
+var (
+	providersLock sync.RWMutex
+	providers     = map[string]Provider{
+		ProviderVault:             newVaultProvider(),
+		ProviderAWSSecretsManager: newAWSSecretsManagerProvider(),
+		ProviderAzureKeyVault:     newAzureKeyVaultProvider(),
+	}
+)
+
+// RegisterProvider registers the provider with the name, replacing the provider registered with
+// the same name if any.
+func RegisterProvider(name string, provider Provider) {
+	providersLock.Lock()
+	defer providersLock.Unlock()
+
+	providers[name] = provider
+}
+
+func getProvider(name string) (Provider, error) {
+	providersLock.RLock()
+	defer providersLock.RUnlock()
+
+	provider, found := providers[name]
+	if !found {
+		return nil, errors.Errorf("credentials provider %q is not supported", name)
+	}
+	return provider, nil
+}
+
+// getSecretKey returns the secret key defined by the given selector. If the secret is annotated
+// with a credentials provider, the value of the key is a reference resolved by the provider,
+// so the credentials are read from the secret store every time they are used.
+func getSecretKey(client kbclient.Client, namespace string, selector *corev1api.SecretKeySelector) ([]byte, error) {
+	secret, err := kube.GetSecret(client, namespace, selector.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	key, found := secret.Data[selector.Key]
+	if !found {
+		return nil, errors.Errorf("%q secret is missing data for key %q", selector.Name, selector.Key)
+	}
+
+	name, found := secret.Annotations[velerov1api.CredentialsProviderAnnotation]
+	if !found {
+		return key, nil
+	}
+
+	provider, err := getProvider(name)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), providerTimeout)
+	defer cancel()
+
+	creds, err := provider.Get(ctx, string(key))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting %q from credentials provider %q", string(key), name)
+	}
+	return creds, nil
+}
@@ -0,0 +1,140 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	secretsManagerEndpointEnv = "AWS_ENDPOINT_URL_SECRETS_MANAGER"
+	secretsManagerService     = "secretsmanager"
+)
+
+// awsSecretsManagerProvider reads the credentials from AWS Secrets Manager. The reference is the
+// name or the ARN of a secret, optionally followed by the key of a JSON secret holding the
+// credentials, e.g. "velero/repo#password". The AWS credentials and region are loaded the way
+// the AWS SDK does by default, e.g. from the environment or through IRSA, the region of an ARN
+// taking precedence.
+type awsSecretsManagerProvider struct {
+	httpClient *http.Client
+	getenv     func(string) string
+	loadConfig func(ctx context.Context) (aws.Config, error)
+}
+
+func newAWSSecretsManagerProvider() *awsSecretsManagerProvider {
+	return &awsSecretsManagerProvider{
+		httpClient: http.DefaultClient,
+		getenv:     os.Getenv,
+		loadConfig: func(ctx context.Context) (aws.Config, error) {
+			return config.LoadDefaultConfig(ctx)
+		},
+	}
+}
+
+func (p *awsSecretsManagerProvider) Get(ctx context.Context, ref string) ([]byte, error) {
+	id, key, _ := strings.Cut(ref, "#")
+	if id == "" {
+		return nil, errors.Errorf("invalid AWS Secrets Manager reference %q, the format is <secret id>[#<key>]", ref)
+	}
+
+	cfg, err := p.loadConfig(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "error loading AWS config")
+	}
+	region := cfg.Region
+	if parsed, err := arn.Parse(id); err == nil {
+		region = parsed.Region
+	}
+	if region == "" {
+		return nil, errors.Errorf("the region of secret %s is unknown", id)
+	}
+
+	if cfg.Credentials == nil {
+		return nil, errors.New("no AWS credentials are configured")
+	}
+	creds, err := cfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "error retrieving AWS credentials")
+	}
+
+	endpoint := p.getenv(secretsManagerEndpointEnv)
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://secretsmanager.%s.amazonaws.com", region)
+	}
+
+	body, err := json.Marshal(map[string]string{"SecretId": id})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+
+	hash := sha256.Sum256(body)
+	if err := v4.NewSigner().SignHTTP(ctx, creds, req, hex.EncodeToString(hash[:]), secretsManagerService, region, time.Now()); err != nil {
+		return nil, errors.Wrap(err, "error signing request")
+	}
+
+	var secret struct {
+		SecretString *string `json:"SecretString"`
+		SecretBinary []byte  `json:"SecretBinary"`
+	}
+	if err := doJSON(p.httpClient, req, &secret); err != nil {
+		return nil, errors.Wrapf(err, "error getting secret %s", id)
+	}
+
+	value := secret.SecretBinary
+	if secret.SecretString != nil {
+		value = []byte(*secret.SecretString)
+	}
+	if key == "" {
+		return value, nil
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(value, &fields); err != nil {
+		return nil, errors.Wrapf(err, "secret %s is not a JSON object", id)
+	}
+	field, found := fields[key]
+	if !found {
+		return nil, errors.Errorf("secret %s is missing key %q", id, key)
+	}
+	str, ok := field.(string)
+	if !ok {
+		return nil, errors.Errorf("key %q of secret %s is not a string", key, id)
+	}
+	return []byte(str), nil
+}
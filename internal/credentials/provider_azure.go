@@ -0,0 +1,89 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/pkg/errors"
+)
+
+const keyVaultAPIVersion = "7.4"
+
+// azureKeyVaultProvider reads the credentials from Azure Key Vault. The reference is the URL of
+// a secret, e.g. "https://my-vault.vault.azure.net/secrets/velero-repo", optionally followed by
+// its version. Velero authenticates the way the Azure SDK does by default, e.g. through the
+// environment or Workload Identity.
+type azureKeyVaultProvider struct {
+	httpClient    *http.Client
+	newCredential func() (azcore.TokenCredential, error)
+}
+
+func newAzureKeyVaultProvider() *azureKeyVaultProvider {
+	return &azureKeyVaultProvider{
+		httpClient: http.DefaultClient,
+		newCredential: func() (azcore.TokenCredential, error) {
+			return azidentity.NewDefaultAzureCredential(nil)
+		},
+	}
+}
+
+func (p *azureKeyVaultProvider) Get(ctx context.Context, ref string) ([]byte, error) {
+	secretURL, err := url.Parse(ref)
+	if err != nil || secretURL.Host == "" || !strings.HasPrefix(secretURL.Path, "/secrets/") {
+		return nil, errors.Errorf("invalid Azure Key Vault reference %q, the format is https://<vault>.<domain>/secrets/<name>[/<version>]", ref)
+	}
+
+	// the token is scoped to the DNS suffix of the vault, which differs in the sovereign clouds
+	_, suffix, found := strings.Cut(secretURL.Hostname(), ".")
+	if !found {
+		return nil, errors.Errorf("invalid Azure Key Vault host %q", secretURL.Host)
+	}
+
+	credential, err := p.newCredential()
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating Azure credential")
+	}
+	token, err := credential.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{"https://" + suffix + "/.default"}})
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting Azure token")
+	}
+
+	query := secretURL.Query()
+	query.Set("api-version", keyVaultAPIVersion)
+	secretURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, secretURL.String(), nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token.Token)
+
+	var secret struct {
+		Value string `json:"value"`
+	}
+	if err := doJSON(p.httpClient, req, &secret); err != nil {
+		return nil, errors.Wrapf(err, "error getting secret %s", ref)
+	}
+	return []byte(secret.Value), nil
+}
@@ -0,0 +1,275 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscredentials "github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+type fakeProvider map[string]string
+
+func (p fakeProvider) Get(ctx context.Context, ref string) ([]byte, error) {
+	value, found := p[ref]
+	if !found {
+		return nil, errors.New("not found")
+	}
+	return []byte(value), nil
+}
+
+func TestNamespacedSecretStoreWithProvider(t *testing.T) {
+	provider := fakeProvider{"velero/repo": "static-passw0rd"}
+	RegisterProvider("fake", provider)
+
+	client := velerotest.NewFakeControllerRuntimeClient(t,
+		builder.ForSecret("velero", "plain").Data(map[string][]byte{"key": []byte("plain-value")}).Result(),
+		builder.ForSecret("velero", "external").
+			ObjectMeta(builder.WithAnnotations(velerov1api.CredentialsProviderAnnotation, "fake")).
+			Data(map[string][]byte{"key": []byte("velero/repo"), "missing": []byte("velero/missing")}).Result(),
+		builder.ForSecret("velero", "unsupported").
+			ObjectMeta(builder.WithAnnotations(velerov1api.CredentialsProviderAnnotation, "unsupported")).
+			Data(map[string][]byte{"key": []byte("velero/repo")}).Result(),
+	)
+	store, err := NewNamespacedSecretStore(client, "velero")
+	require.NoError(t, err)
+
+	value, err := store.Get(builder.ForSecretKeySelector("plain", "key").Result())
+	require.NoError(t, err)
+	assert.Equal(t, "plain-value", value)
+
+	value, err = store.Get(builder.ForSecretKeySelector("external", "key").Result())
+	require.NoError(t, err)
+	assert.Equal(t, "static-passw0rd", value)
+
+	// the credentials are read from the provider every time they are used
+	provider["velero/repo"] = "rotated-passw0rd"
+	value, err = store.Get(builder.ForSecretKeySelector("external", "key").Result())
+	require.NoError(t, err)
+	assert.Equal(t, "rotated-passw0rd", value)
+
+	_, err = store.Get(builder.ForSecretKeySelector("external", "missing").Result())
+	require.EqualError(t, err, `unable to get key for secret: error getting "velero/missing" from credentials provider "fake": not found`)
+
+	_, err = store.Get(builder.ForSecretKeySelector("external", "absent").Result())
+	require.EqualError(t, err, `unable to get key for secret: "external" secret is missing data for key "absent"`)
+
+	_, err = store.Get(builder.ForSecretKeySelector("unsupported", "key").Result())
+	require.EqualError(t, err, `unable to get key for secret: credentials provider "unsupported" is not supported`)
+}
+
+func TestVaultProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/auth/kubernetes/login":
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"role":"velero","jwt":"sa-token"}`, string(body))
+			w.Write([]byte(`{"auth":{"client_token":"role-token"}}`))
+		case "/v1/secret/data/velero":
+			if r.Header.Get("X-Vault-Token") != "role-token" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			assert.Equal(t, "team-a", r.Header.Get("X-Vault-Namespace"))
+			w.Write([]byte(`{"data":{"data":{"password":"kv2-passw0rd"},"metadata":{"version":3}}}`))
+		case "/v1/kv/velero":
+			if r.Header.Get("X-Vault-Token") != "static-token" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			w.Write([]byte(`{"data":{"password":"kv1-passw0rd"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		env      map[string]string
+		ref      string
+		expected string
+		wantErr  string
+	}{
+		{
+			name:     "KV version 2 with kubernetes auth",
+			env:      map[string]string{vaultAddrEnv: server.URL, vaultRoleEnv: "velero", vaultNamespaceEnv: "team-a"},
+			ref:      "secret/data/velero#password",
+			expected: "kv2-passw0rd",
+		},
+		{
+			name:     "KV version 1 with token",
+			env:      map[string]string{vaultAddrEnv: server.URL, vaultTokenEnv: "static-token"},
+			ref:      "kv/velero#password",
+			expected: "kv1-passw0rd",
+		},
+		{
+			name:    "missing field",
+			env:     map[string]string{vaultAddrEnv: server.URL, vaultTokenEnv: "static-token"},
+			ref:     "kv/velero#username",
+			wantErr: `secret kv/velero is missing field "username"`,
+		},
+		{
+			name:    "invalid reference",
+			env:     map[string]string{vaultAddrEnv: server.URL, vaultTokenEnv: "static-token"},
+			ref:     "kv/velero",
+			wantErr: `invalid vault reference "kv/velero", the format is <path>#<field>`,
+		},
+		{
+			name:    "no token",
+			env:     map[string]string{vaultAddrEnv: server.URL},
+			ref:     "kv/velero#password",
+			wantErr: "either VAULT_TOKEN or VAULT_ROLE must be set",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider := &vaultProvider{
+				httpClient: server.Client(),
+				getenv:     func(key string) string { return test.env[key] },
+				readFile:   func(string) ([]byte, error) { return []byte("sa-token\n"), nil },
+			}
+
+			value, err := provider.Get(context.Background(), test.ref)
+			if test.wantErr != "" {
+				require.EqualError(t, err, test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, string(value))
+		})
+	}
+}
+
+func TestAWSSecretsManagerProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "secretsmanager.GetSecretValue", r.Header.Get("X-Amz-Target"))
+		assert.Contains(t, r.Header.Get("Authorization"), "/secretsmanager/aws4_request")
+
+		var input struct {
+			SecretID string `json:"SecretId"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&input))
+
+		switch input.SecretID {
+		case "velero/plain":
+			assert.Contains(t, r.Header.Get("Authorization"), "/us-east-1/")
+			w.Write([]byte(`{"SecretString":"plain-passw0rd"}`))
+		case "arn:aws:secretsmanager:eu-west-1:123456789012:secret:velero/json":
+			assert.Contains(t, r.Header.Get("Authorization"), "/eu-west-1/")
+			w.Write([]byte(`{"SecretString":"{\"password\":\"json-passw0rd\"}"}`))
+		case "velero/binary":
+			w.Write([]byte(`{"SecretBinary":"YmluYXJ5LXBhc3N3MHJk"}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"__type":"ResourceNotFoundException"}`))
+		}
+	}))
+	defer server.Close()
+
+	provider := &awsSecretsManagerProvider{
+		httpClient: server.Client(),
+		getenv:     func(string) string { return server.URL },
+		loadConfig: func(context.Context) (aws.Config, error) {
+			return aws.Config{
+				Region:      "us-east-1",
+				Credentials: awscredentials.NewStaticCredentialsProvider("id", "secret", ""),
+			}, nil
+		},
+	}
+
+	value, err := provider.Get(context.Background(), "velero/plain")
+	require.NoError(t, err)
+	assert.Equal(t, "plain-passw0rd", string(value))
+
+	value, err = provider.Get(context.Background(), "arn:aws:secretsmanager:eu-west-1:123456789012:secret:velero/json#password")
+	require.NoError(t, err)
+	assert.Equal(t, "json-passw0rd", string(value))
+
+	value, err = provider.Get(context.Background(), "velero/binary")
+	require.NoError(t, err)
+	assert.Equal(t, "binary-passw0rd", string(value))
+
+	_, err = provider.Get(context.Background(), "velero/absent")
+	require.Error(t, err)
+	assert.True(t, strings.HasPrefix(err.Error(), "error getting secret velero/absent: unexpected status 400"))
+}
+
+type fakeTokenCredential struct {
+	scopes []string
+}
+
+func (c *fakeTokenCredential) GetToken(ctx context.Context, options policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	c.scopes = options.Scopes
+	return azcore.AccessToken{Token: "azure-token", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+func TestAzureKeyVaultProvider(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer azure-token", r.Header.Get("Authorization"))
+		assert.Equal(t, keyVaultAPIVersion, r.URL.Query().Get("api-version"))
+		if r.URL.Path != "/secrets/velero-repo" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"value":"azure-passw0rd","id":"https://my-vault.vault.azure.net/secrets/velero-repo/1"}`))
+	}))
+	defer server.Close()
+
+	// send the requests to the vault in the sovereign cloud to the test server
+	client := server.Client()
+	client.Transport = &http.Transport{
+		DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+		},
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec // the test server has a self-signed certificate
+	}
+
+	credential := &fakeTokenCredential{}
+	provider := &azureKeyVaultProvider{
+		httpClient:    client,
+		newCredential: func() (azcore.TokenCredential, error) { return credential, nil },
+	}
+
+	value, err := provider.Get(context.Background(), "https://my-vault.vault.azure.cn/secrets/velero-repo")
+	require.NoError(t, err)
+	assert.Equal(t, "azure-passw0rd", string(value))
+	assert.Equal(t, []string{"https://vault.azure.cn/.default"}, credential.scopes)
+
+	_, err = provider.Get(context.Background(), "https://my-vault.vault.azure.cn/keys/velero-repo")
+	require.ErrorContains(t, err, "invalid Azure Key Vault reference")
+}
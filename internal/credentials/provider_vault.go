@@ -0,0 +1,175 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	vaultAddrEnv      = "VAULT_ADDR"
+	vaultTokenEnv     = "VAULT_TOKEN"
+	vaultRoleEnv      = "VAULT_ROLE"
+	vaultAuthPathEnv  = "VAULT_AUTH_PATH"
+	vaultNamespaceEnv = "VAULT_NAMESPACE"
+
+	defaultVaultAuthPath    = "kubernetes"
+	serviceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+)
+
+// vaultProvider reads the credentials from HashiCorp Vault. The reference is the path of a
+// secret followed by the field holding the credentials, e.g. "secret/data/velero#password",
+// both KV version 1 and 2 secrets engines are supported. Velero authenticates with the token
+// in VAULT_TOKEN or, if VAULT_ROLE is set, with the token of its service account through the
+// Kubernetes auth method mounted at VAULT_AUTH_PATH.
+type vaultProvider struct {
+	httpClient *http.Client
+	getenv     func(string) string
+	readFile   func(string) ([]byte, error)
+}
+
+func newVaultProvider() *vaultProvider {
+	return &vaultProvider{
+		httpClient: http.DefaultClient,
+		getenv:     os.Getenv,
+		readFile:   os.ReadFile,
+	}
+}
+
+func (p *vaultProvider) Get(ctx context.Context, ref string) ([]byte, error) {
+	path, field, found := strings.Cut(ref, "#")
+	if !found || path == "" || field == "" {
+		return nil, errors.Errorf("invalid vault reference %q, the format is <path>#<field>", ref)
+	}
+
+	addr := strings.TrimSuffix(p.getenv(vaultAddrEnv), "/")
+	if addr == "" {
+		return nil, errors.Errorf("%s is not set", vaultAddrEnv)
+	}
+
+	token, err := p.token(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	var secret struct {
+		Data map[string]any `json:"data"`
+	}
+	if err := p.do(ctx, http.MethodGet, addr+"/v1/"+strings.TrimPrefix(path, "/"), token, nil, &secret); err != nil {
+		return nil, errors.Wrapf(err, "error reading secret %s", path)
+	}
+
+	// the KV version 2 secrets engine nests the fields of the secret under data
+	data := secret.Data
+	if nested, ok := data["data"].(map[string]any); ok {
+		if _, isMetadata := data["metadata"]; isMetadata {
+			data = nested
+		}
+	}
+
+	value, found := data[field]
+	if !found {
+		return nil, errors.Errorf("secret %s is missing field %q", path, field)
+	}
+	str, ok := value.(string)
+	if !ok {
+		return nil, errors.Errorf("field %q of secret %s is not a string", field, path)
+	}
+	return []byte(str), nil
+}
+
+func (p *vaultProvider) token(ctx context.Context, addr string) (string, error) {
+	role := p.getenv(vaultRoleEnv)
+	if role == "" {
+		token := p.getenv(vaultTokenEnv)
+		if token == "" {
+			return "", errors.Errorf("either %s or %s must be set", vaultTokenEnv, vaultRoleEnv)
+		}
+		return token, nil
+	}
+
+	jwt, err := p.readFile(serviceAccountTokenPath)
+	if err != nil {
+		return "", errors.Wrap(err, "error reading service account token")
+	}
+
+	authPath := p.getenv(vaultAuthPathEnv)
+	if authPath == "" {
+		authPath = defaultVaultAuthPath
+	}
+
+	body, err := json.Marshal(map[string]string{"role": role, "jwt": strings.TrimSpace(string(jwt))})
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	var login struct {
+		Auth struct {
+			ClientToken string `json:"client_token"`
+		} `json:"auth"`
+	}
+	if err := p.do(ctx, http.MethodPost, fmt.Sprintf("%s/v1/auth/%s/login", addr, strings.Trim(authPath, "/")), "", body, &login); err != nil {
+		return "", errors.Wrapf(err, "error logging in to vault with role %s", role)
+	}
+	if login.Auth.ClientToken == "" {
+		return "", errors.Errorf("vault returned no token for role %s", role)
+	}
+	return login.Auth.ClientToken, nil
+}
+
+func (p *vaultProvider) do(ctx context.Context, method, url, token string, body []byte, out any) error {
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if namespace := p.getenv(vaultNamespaceEnv); namespace != "" {
+		req.Header.Set("X-Vault-Namespace", namespace)
+	}
+
+	return doJSON(p.httpClient, req, out)
+}
+
+// doJSON sends the request and decodes the JSON response into out.
+func doJSON(client *http.Client, req *http.Request, out any) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status %s: %s", resp.Status, strings.TrimSpace(string(data)))
+	}
+
+	return errors.WithStack(json.Unmarshal(data, out))
+}
@@ -20,8 +20,6 @@ import (
 	"github.com/pkg/errors"
 	corev1api "k8s.io/api/core/v1"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
-
-	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
 // SecretStore defines operations for interacting with credentials
@@ -47,7 +45,7 @@ func NewNamespacedSecretStore(client kbclient.Client, namespace string) (SecretS
 
 // Buffer returns the secret key defined by the given selector.
 func (n *namespacedSecretStore) Get(selector *corev1api.SecretKeySelector) (string, error) {
-	creds, err := kube.GetSecretKey(n.client, n.namespace, selector)
+	creds, err := getSecretKey(n.client, n.namespace, selector)
 	if err != nil {
 		return "", errors.Wrap(err, "unable to get key for secret")
 	}
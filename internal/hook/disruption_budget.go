@@ -0,0 +1,131 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	policyv1api "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// DisruptionBudgetGuard keeps the hooks of a backup from disrupting more pods than the
+// PodDisruptionBudgets covering them allow. A pod counts as disrupted from the start of its pre
+// hooks until its post hooks run, and is deducted from the disruptions the budgets allow.
+type DisruptionBudgetGuard struct {
+	client crclient.Client
+	policy velerov1api.PodDisruptionBudgetPolicy
+	lock   sync.Mutex
+	// disrupted records, per PodDisruptionBudget, the pods whose hooks are running.
+	disrupted map[string]map[string]struct{}
+	// budgets records the PodDisruptionBudgets covering each pod whose hooks are running.
+	budgets map[string][]string
+	// skipped records the pods whose hooks were skipped.
+	skipped map[string]struct{}
+}
+
+// NewDisruptionBudgetGuard returns a DisruptionBudgetGuard handling the pods the budgets allow no
+// more disruption of according to policy.
+func NewDisruptionBudgetGuard(client crclient.Client, policy velerov1api.PodDisruptionBudgetPolicy) *DisruptionBudgetGuard {
+	return &DisruptionBudgetGuard{
+		client:    client,
+		policy:    policy,
+		disrupted: make(map[string]map[string]struct{}),
+		budgets:   make(map[string][]string),
+		skipped:   make(map[string]struct{}),
+	}
+}
+
+// Acquire returns whether the pre hooks of the pod may run and, if so, counts the pod as
+// disrupted by the budgets covering it until it's released. A pod the budgets allow no more
+// disruption of is reported as a warning and, only if the policy is Respect, skipped.
+func (g *DisruptionBudgetGuard) Acquire(log logrus.FieldLogger, namespace, name string, podLabels labels.Set) (bool, error) {
+	pdbs := new(policyv1api.PodDisruptionBudgetList)
+	if err := g.client.List(context.Background(), pdbs, crclient.InNamespace(namespace)); err != nil {
+		return false, errors.Wrapf(err, "error listing the PodDisruptionBudgets of namespace %s", namespace)
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	pod := namespace + "/" + name
+	var budgets, exhausted []string
+	for i := range pdbs.Items {
+		pdb := &pdbs.Items[i]
+		if pdb.Spec.Selector == nil {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			return false, errors.Wrapf(err, "error parsing the selector of the PodDisruptionBudget %s/%s", pdb.Namespace, pdb.Name)
+		}
+		if !selector.Matches(podLabels) {
+			continue
+		}
+
+		budget := pdb.Namespace + "/" + pdb.Name
+		budgets = append(budgets, budget)
+		if int(pdb.Status.DisruptionsAllowed)-len(g.disrupted[budget]) <= 0 {
+			exhausted = append(exhausted, budget)
+		}
+	}
+
+	if len(exhausted) > 0 {
+		if g.policy == velerov1api.PodDisruptionBudgetPolicyRespect {
+			log.Warnf("Skipping the hooks of pod %s, the PodDisruptionBudgets %s allow no more disruption", pod, strings.Join(exhausted, ", "))
+			g.skipped[pod] = struct{}{}
+			return false, nil
+		}
+		log.Warnf("Running the hooks of pod %s although the PodDisruptionBudgets %s allow no more disruption", pod, strings.Join(exhausted, ", "))
+	}
+
+	for _, budget := range budgets {
+		if g.disrupted[budget] == nil {
+			g.disrupted[budget] = make(map[string]struct{})
+		}
+		g.disrupted[budget][pod] = struct{}{}
+	}
+	g.budgets[pod] = budgets
+	return true, nil
+}
+
+// Release stops counting the pod as disrupted and returns whether its post hooks may run, that
+// is whether its pre hooks weren't skipped.
+func (g *DisruptionBudgetGuard) Release(namespace, name string) bool {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	pod := namespace + "/" + name
+	if _, skipped := g.skipped[pod]; skipped {
+		delete(g.skipped, pod)
+		return false
+	}
+
+	for _, budget := range g.budgets[pod] {
+		delete(g.disrupted[budget], pod)
+	}
+	delete(g.budgets, pod)
+	return true
+}
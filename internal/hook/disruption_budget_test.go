@@ -0,0 +1,151 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	policyv1api "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+func newPodDisruptionBudget(name string, matchLabels map[string]string, disruptionsAllowed int32) *policyv1api.PodDisruptionBudget {
+	return &policyv1api.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name},
+		Spec:       policyv1api.PodDisruptionBudgetSpec{Selector: &metav1.LabelSelector{MatchLabels: matchLabels}},
+		Status:     policyv1api.PodDisruptionBudgetStatus{DisruptionsAllowed: disruptionsAllowed},
+	}
+}
+
+func TestDisruptionBudgetGuard(t *testing.T) {
+	client := velerotest.NewFakeControllerRuntimeClient(t,
+		newPodDisruptionBudget("web", map[string]string{"app": "web"}, 1),
+		newPodDisruptionBudget("db", map[string]string{"app": "db"}, 0),
+	)
+	web := labels.Set{"app": "web"}
+
+	t.Run("the pods are skipped once the budget allows no more disruption", func(t *testing.T) {
+		guard := NewDisruptionBudgetGuard(client, velerov1api.PodDisruptionBudgetPolicyRespect)
+		log := logrus.New()
+
+		run, err := guard.Acquire(log, "ns", "web-1", web)
+		require.NoError(t, err)
+		assert.True(t, run)
+
+		run, err = guard.Acquire(log, "ns", "web-2", web)
+		require.NoError(t, err)
+		assert.False(t, run)
+		assert.False(t, guard.Release("ns", "web-2"))
+
+		// the budget is freed once the post hooks of the first pod run
+		assert.True(t, guard.Release("ns", "web-1"))
+		run, err = guard.Acquire(log, "ns", "web-2", web)
+		require.NoError(t, err)
+		assert.True(t, run)
+	})
+
+	t.Run("the pods not covered by a budget aren't skipped", func(t *testing.T) {
+		guard := NewDisruptionBudgetGuard(client, velerov1api.PodDisruptionBudgetPolicyRespect)
+
+		run, err := guard.Acquire(logrus.New(), "ns", "cache-1", labels.Set{"app": "cache"})
+		require.NoError(t, err)
+		assert.True(t, run)
+	})
+
+	t.Run("the hooks run anyway by default", func(t *testing.T) {
+		guard := NewDisruptionBudgetGuard(client, "")
+
+		run, err := guard.Acquire(logrus.New(), "ns", "db-1", labels.Set{"app": "db"})
+		require.NoError(t, err)
+		assert.True(t, run)
+	})
+
+	t.Run("the Ignore policy runs the hooks anyway", func(t *testing.T) {
+		guard := NewDisruptionBudgetGuard(client, velerov1api.PodDisruptionBudgetPolicyIgnore)
+
+		run, err := guard.Acquire(logrus.New(), "ns", "db-1", labels.Set{"app": "db"})
+		require.NoError(t, err)
+		assert.True(t, run)
+	})
+}
+
+func TestHandleHooksWithDisruptionBudgetGuard(t *testing.T) {
+	client := velerotest.NewFakeControllerRuntimeClient(t, newPodDisruptionBudget("db", map[string]string{"app": "db"}, 0))
+	pod := velerotest.UnstructuredOrDie(`
+		{
+			"apiVersion": "v1",
+			"kind": "Pod",
+			"metadata": {
+				"namespace": "ns",
+				"name": "db-1",
+				"labels": {
+					"app": "db"
+				}
+			}
+		}
+	`)
+	hooks := []ResourceHook{
+		{
+			Name:     "quiesce",
+			Selector: ResourceHookSelector{Namespaces: collections.NewIncludesExcludes()},
+			Pre:      []velerov1api.BackupResourceHook{{Exec: &velerov1api.ExecHook{Container: "db", Command: []string{"freeze"}}}},
+			Post:     []velerov1api.BackupResourceHook{{Exec: &velerov1api.ExecHook{Container: "db", Command: []string{"unfreeze"}}}},
+		},
+	}
+
+	t.Run("the hooks of a pod the budget allows no disruption of are skipped", func(t *testing.T) {
+		podCommandExecutor := &velerotest.MockPodCommandExecutor{}
+		defer podCommandExecutor.AssertExpectations(t)
+		h := &DefaultItemHookHandler{
+			PodCommandExecutor:    podCommandExecutor,
+			DisruptionBudgetGuard: NewDisruptionBudgetGuard(client, velerov1api.PodDisruptionBudgetPolicyRespect),
+		}
+		hookTracker := NewHookTracker()
+
+		require.NoError(t, h.HandleHooks(velerotest.NewLogger(), kuberesource.Pods, pod, hooks, PhasePre, hookTracker))
+		require.NoError(t, h.HandleHooks(velerotest.NewLogger(), kuberesource.Pods, pod, hooks, PhasePost, hookTracker))
+		attempted, _ := hookTracker.Stat()
+		assert.Equal(t, 0, attempted)
+	})
+
+	t.Run("the hooks of a pod the budget allows no disruption of run with the Ignore policy", func(t *testing.T) {
+		podCommandExecutor := &velerotest.MockPodCommandExecutor{}
+		defer podCommandExecutor.AssertExpectations(t)
+		podCommandExecutor.On("ExecutePodCommand", mock.Anything, pod.UnstructuredContent(), "ns", "db-1", "quiesce", hooks[0].Pre[0].Exec).Return(nil)
+		podCommandExecutor.On("ExecutePodCommand", mock.Anything, pod.UnstructuredContent(), "ns", "db-1", "quiesce", hooks[0].Post[0].Exec).Return(nil)
+		h := &DefaultItemHookHandler{
+			PodCommandExecutor:    podCommandExecutor,
+			DisruptionBudgetGuard: NewDisruptionBudgetGuard(client, velerov1api.PodDisruptionBudgetPolicyIgnore),
+		}
+		hookTracker := NewHookTracker()
+
+		require.NoError(t, h.HandleHooks(velerotest.NewLogger(), kuberesource.Pods, pod, hooks, PhasePre, hookTracker))
+		require.NoError(t, h.HandleHooks(velerotest.NewLogger(), kuberesource.Pods, pod, hooks, PhasePost, hookTracker))
+		attempted, _ := hookTracker.Stat()
+		assert.Equal(t, 2, attempted)
+	})
+}
@@ -19,6 +19,8 @@ package hook
 import (
 	"fmt"
 	"sync"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
 
 const (
@@ -36,7 +38,7 @@ type hookKey struct {
 	// For hooks specified in the backup/restore spec, this field is an applicable pod name.
 	// For hooks specified in pod annotation, this field is the pod where hooks are annotated.
 	podName string
-	// HookPhase is only for backup hooks, for restore hooks, this field is empty.
+	// HookPhase is only for backup hooks and restore verification hooks, for other restore hooks, this field is empty.
 	hookPhase HookPhase
 	// HookName is only for hooks specified in the backup/restore spec.
 	// For hooks specified in pod annotation, this field is empty or "<from-annotation>".
@@ -70,6 +72,12 @@ type HookTracker struct {
 	hookExecutedCnt int
 	// hookErrs records hook execution errors if any.
 	hookErrs []HookErrInfo
+	// hookWarnings records failures of hooks which are reported as warnings, e.g. verification hooks with the Warn policy.
+	hookWarnings []HookErrInfo
+	// verificationExecutedCnt indicates the number of executed verification hooks.
+	verificationExecutedCnt int
+	// verificationFailedCnt indicates the number of failed verification hooks.
+	verificationFailedCnt int
 }
 
 // NewHookTracker creates a hookTracker instance.
@@ -139,6 +147,51 @@ func (ht *HookTracker) Record(podNamespace, podName, container, source, hookName
 	return nil
 }
 
+// RecordVerification records the result of a restore verification hook.
+// A failed verification is reported as a hook error when onFailure is PartiallyFailed, otherwise as a warning.
+// Add must precede the RecordVerification for each individual hook.
+func (ht *HookTracker) RecordVerification(podNamespace, podName, container, hookName string, onFailure velerov1api.VerifyFailurePolicy, verifyErr error) error {
+	ht.lock.Lock()
+	defer ht.lock.Unlock()
+
+	key := hookKey{
+		podNamespace: podNamespace,
+		podName:      podName,
+		hookSource:   HookSourceSpec,
+		container:    container,
+		hookPhase:    PhaseVerify,
+		hookName:     hookName,
+	}
+
+	if _, ok := ht.tracker[key]; !ok {
+		return fmt.Errorf("hook not exist in hook tracker, hook: %+v", key)
+	}
+
+	if ht.tracker[key].hookExecuted {
+		return nil
+	}
+
+	failAsError := verifyErr != nil && onFailure == velerov1api.VerifyFailurePolicyPartiallyFailed
+	ht.tracker[key] = hookStatus{
+		hookFailed:   failAsError,
+		hookExecuted: true,
+	}
+	ht.hookExecutedCnt++
+	ht.verificationExecutedCnt++
+
+	if verifyErr == nil {
+		return nil
+	}
+	ht.verificationFailedCnt++
+	if failAsError {
+		ht.hookFailedCnt++
+		ht.hookErrs = append(ht.hookErrs, HookErrInfo{Namespace: key.podNamespace, Err: verifyErr})
+	} else {
+		ht.hookWarnings = append(ht.hookWarnings, HookErrInfo{Namespace: key.podNamespace, Err: verifyErr})
+	}
+	return nil
+}
+
 // Stat returns the number of attempted hooks and failed hooks
 func (ht *HookTracker) Stat() (hookAttemptedCnt int, hookFailedCnt int) {
 	ht.lock.RLock()
@@ -147,6 +200,14 @@ func (ht *HookTracker) Stat() (hookAttemptedCnt int, hookFailedCnt int) {
 	return ht.hookAttemptedCnt, ht.hookFailedCnt
 }
 
+// VerificationStat returns the number of executed verification hooks and failed verification hooks
+func (ht *HookTracker) VerificationStat() (verificationExecutedCnt int, verificationFailedCnt int) {
+	ht.lock.RLock()
+	defer ht.lock.RUnlock()
+
+	return ht.verificationExecutedCnt, ht.verificationFailedCnt
+}
+
 // IsComplete returns whether the execution of all hooks has finished or not
 func (ht *HookTracker) IsComplete() bool {
 	ht.lock.RLock()
@@ -163,6 +224,14 @@ func (ht *HookTracker) HookErrs() []HookErrInfo {
 	return ht.hookErrs
 }
 
+// HookWarnings returns hook failures which are reported as warnings
+func (ht *HookTracker) HookWarnings() []HookErrInfo {
+	ht.lock.RLock()
+	defer ht.lock.RUnlock()
+
+	return ht.hookWarnings
+}
+
 // MultiHookTrackers tracks all hooks' execution status for multiple backups/restores.
 type MultiHookTracker struct {
 	lock *sync.RWMutex
@@ -203,6 +272,20 @@ func (mht *MultiHookTracker) Record(name, podNamespace, podName, container, sour
 	return err
 }
 
+// RecordVerification records a restore verification hook result
+func (mht *MultiHookTracker) RecordVerification(name, podNamespace, podName, container, hookName string, onFailure velerov1api.VerifyFailurePolicy, verifyErr error) error {
+	mht.lock.RLock()
+	defer mht.lock.RUnlock()
+
+	var err error
+	if _, ok := mht.trackers[name]; ok {
+		err = mht.trackers[name].RecordVerification(podNamespace, podName, container, hookName, onFailure, verifyErr)
+	} else {
+		err = fmt.Errorf("the restore not exist in hook tracker, restore name: %s", name)
+	}
+	return err
+}
+
 // Stat returns the number of attempted hooks and failed hooks for a particular backup/restore
 func (mht *MultiHookTracker) Stat(name string) (hookAttemptedCnt int, hookFailedCnt int) {
 	mht.lock.RLock()
@@ -214,6 +297,17 @@ func (mht *MultiHookTracker) Stat(name string) (hookAttemptedCnt int, hookFailed
 	return
 }
 
+// VerificationStat returns the number of executed verification hooks and failed verification hooks for a particular restore
+func (mht *MultiHookTracker) VerificationStat(name string) (verificationExecutedCnt int, verificationFailedCnt int) {
+	mht.lock.RLock()
+	defer mht.lock.RUnlock()
+
+	if _, ok := mht.trackers[name]; ok {
+		return mht.trackers[name].VerificationStat()
+	}
+	return
+}
+
 // Delete removes the hook data for a particular backup/restore
 func (mht *MultiHookTracker) Delete(name string) {
 	mht.lock.Lock()
@@ -243,3 +337,14 @@ func (mht *MultiHookTracker) HookErrs(name string) []HookErrInfo {
 	}
 	return nil
 }
+
+// HookWarnings returns hook failures which are reported as warnings for a particular backup/restore
+func (mht *MultiHookTracker) HookWarnings(name string) []HookErrInfo {
+	mht.lock.RLock()
+	defer mht.lock.RUnlock()
+
+	if _, ok := mht.trackers[name]; ok {
+		return mht.trackers[name].HookWarnings()
+	}
+	return nil
+}
@@ -21,6 +21,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
 
 func TestNewHookTracker(t *testing.T) {
@@ -195,3 +197,30 @@ func TestMultiHookTracker_HookErrs(t *testing.T) {
 	hookErrs2 := mht.HookErrs("restore2")
 	assert.Empty(t, hookErrs2)
 }
+
+func TestMultiHookTracker_RecordVerification(t *testing.T) {
+	mht := NewMultiHookTracker()
+	mht.Add("restore1", "ns1", "pod1", "container1", HookSourceSpec, "h1", PhaseVerify)
+	mht.Add("restore1", "ns1", "pod1", "container1", HookSourceSpec, "h2", PhaseVerify)
+	mht.Add("restore1", "ns2", "pod2", "container1", HookSourceSpec, "h3", PhaseVerify)
+
+	assert.NoError(t, mht.RecordVerification("restore1", "ns1", "pod1", "container1", "h1", velerov1api.VerifyFailurePolicyWarn, fmt.Errorf("err")))
+	assert.NoError(t, mht.RecordVerification("restore1", "ns1", "pod1", "container1", "h2", velerov1api.VerifyFailurePolicyPartiallyFailed, fmt.Errorf("err")))
+	assert.NoError(t, mht.RecordVerification("restore1", "ns2", "pod2", "container1", "h3", velerov1api.VerifyFailurePolicyPartiallyFailed, nil))
+	assert.Error(t, mht.RecordVerification("restore1", "ns3", "pod3", "container1", "h4", velerov1api.VerifyFailurePolicyWarn, nil))
+	assert.Error(t, mht.RecordVerification("restore2", "ns1", "pod1", "container1", "h1", velerov1api.VerifyFailurePolicyWarn, nil))
+
+	assert.True(t, mht.IsComplete("restore1"))
+
+	attempted, failed := mht.Stat("restore1")
+	assert.Equal(t, 3, attempted)
+	assert.Equal(t, 1, failed)
+
+	verified, verifyFailed := mht.VerificationStat("restore1")
+	assert.Equal(t, 3, verified)
+	assert.Equal(t, 2, verifyFailed)
+
+	assert.Len(t, mht.HookErrs("restore1"), 1)
+	assert.Len(t, mht.HookWarnings("restore1"), 1)
+	assert.Empty(t, mht.HookWarnings("restore2"))
+}
@@ -48,6 +48,8 @@ type HookPhase string
 const (
 	PhasePre  HookPhase = "pre"
 	PhasePost HookPhase = "post"
+	// PhaseVerify is used to track restore verification hooks separately from restore exec hooks.
+	PhaseVerify HookPhase = "verify"
 )
 
 const (
@@ -193,6 +195,9 @@ func (i *InitContainerRestoreHookHandler) HandleRestoreHooks(
 // DefaultItemHookHandler is the default itemHookHandler.
 type DefaultItemHookHandler struct {
 	PodCommandExecutor podexec.PodCommandExecutor
+	// DisruptionBudgetGuard, if set, keeps the hooks from disrupting more pods than the
+	// PodDisruptionBudgets covering them allow.
+	DisruptionBudgetGuard *DisruptionBudgetGuard
 }
 
 func (h *DefaultItemHookHandler) HandleHooks(
@@ -222,6 +227,24 @@ func (h *DefaultItemHookHandler) HandleHooks(
 		// See if the pod has the legacy hook annotation keys (i.e. without a phase specified)
 		hookFromAnnotations = getPodExecHookFromAnnotations(metadata.GetAnnotations(), "", log)
 	}
+
+	labels := labels.Set(metadata.GetLabels())
+	if h.DisruptionBudgetGuard != nil {
+		if phase == PhasePost {
+			if !h.DisruptionBudgetGuard.Release(namespace, name) {
+				return nil
+			}
+		} else if hookFromAnnotations != nil || hasSpecHooks(resourceHooks, groupResource, namespace, labels, phase) {
+			run, err := h.DisruptionBudgetGuard.Acquire(log, namespace, name, labels)
+			if err != nil {
+				return err
+			}
+			if !run {
+				return nil
+			}
+		}
+	}
+
 	if hookFromAnnotations != nil {
 		hookTracker.Add(namespace, name, hookFromAnnotations.Container, HookSourceAnnotation, "", phase)
 
@@ -245,13 +268,13 @@ func (h *DefaultItemHookHandler) HandleHooks(
 		}
 
 		if errExec != nil && hookFromAnnotations.OnError == velerov1api.HookErrorModeFail {
+			h.releaseFailedPod(namespace, name, phase)
 			return errExec
 		}
 
 		return nil
 	}
 
-	labels := labels.Set(metadata.GetLabels())
 	// Otherwise, check for hooks defined in the backup spec.
 	// modeFailError records the error from the hook with "Fail" error mode
 	var modeFailError error
@@ -301,9 +324,41 @@ func (h *DefaultItemHookHandler) HandleHooks(
 		}
 	}
 
+	if modeFailError != nil {
+		h.releaseFailedPod(namespace, name, phase)
+	}
 	return modeFailError
 }
 
+// releaseFailedPod releases the pod from the DisruptionBudgetGuard when its pre hooks failed, as
+// its post hooks won't run.
+func (h *DefaultItemHookHandler) releaseFailedPod(namespace, name string, phase HookPhase) {
+	if h.DisruptionBudgetGuard != nil && phase == PhasePre {
+		h.DisruptionBudgetGuard.Release(namespace, name)
+	}
+}
+
+// hasSpecHooks returns whether the hooks defined in the backup spec have exec hooks of the phase
+// for the item.
+func hasSpecHooks(resourceHooks []ResourceHook, groupResource schema.GroupResource, namespace string, labels labels.Set, phase HookPhase) bool {
+	for _, resourceHook := range resourceHooks {
+		if !resourceHook.Selector.applicableTo(groupResource, namespace, labels) {
+			continue
+		}
+
+		hooks := resourceHook.Post
+		if phase == PhasePre {
+			hooks = resourceHook.Pre
+		}
+		for _, hook := range hooks {
+			if hook.Exec != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // NoOpItemHookHandler is the an itemHookHandler for the Finalize controller where hooks don't run
 type NoOpItemHookHandler struct{}
 
@@ -533,7 +588,10 @@ type PodExecRestoreHook struct {
 	HookName   string
 	HookSource string
 	Hook       velerov1api.ExecRestoreHook
-	executed   bool
+	// Verify is set for verification hooks, in which case Hook only carries the
+	// container and wait settings used to decide when the check is run.
+	Verify   *velerov1api.VerifyRestoreHook
+	executed bool
 }
 
 // GroupRestoreExecHooks returns a list of hooks to be executed in a pod grouped by
@@ -580,6 +638,11 @@ func GroupRestoreExecHooks(
 			continue
 		}
 		for _, rh := range rrh.RestoreHooks {
+			if rh.Verify != nil {
+				named := newPodVerifyRestoreHook(rrh.Name, rh.Verify, pod)
+				hookTrack.Add(restoreName, metadata.GetNamespace(), metadata.GetName(), named.Hook.Container, HookSourceSpec, rrh.Name, PhaseVerify)
+				byContainer[named.Hook.Container] = append(byContainer[named.Hook.Container], named)
+			}
 			if rh.Exec == nil {
 				continue
 			}
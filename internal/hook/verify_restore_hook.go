@@ -0,0 +1,200 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/podexec"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+const defaultVerifyTimeout = 30 * time.Second
+
+// RestoreHookVerifier runs post-restore verification hooks against a restored pod.
+type RestoreHookVerifier interface {
+	// Verify runs the check defined by the hook and returns an error if the check fails.
+	Verify(ctx context.Context, log logrus.FieldLogger, pod *corev1api.Pod, hookName string, hook *velerov1api.VerifyRestoreHook) error
+}
+
+// DefaultRestoreHookVerifier runs SQL verifications through the pod exec API and HTTP
+// verifications directly against the pod IP, in the same way the kubelet runs HTTP probes.
+type DefaultRestoreHookVerifier struct {
+	PodCommandExecutor podexec.PodCommandExecutor
+	// HTTPClient is used for HTTP verifications. If nil, a client which skips TLS verification is used.
+	HTTPClient *http.Client
+}
+
+var _ RestoreHookVerifier = &DefaultRestoreHookVerifier{}
+
+func (v *DefaultRestoreHookVerifier) Verify(ctx context.Context, log logrus.FieldLogger, pod *corev1api.Pod, hookName string, hook *velerov1api.VerifyRestoreHook) error {
+	if err := ValidateVerifyRestoreHook(hook); err != nil {
+		return err
+	}
+
+	timeout := hook.Timeout.Duration
+	if timeout <= 0 {
+		timeout = defaultVerifyTimeout
+	}
+
+	if hook.SQL != nil {
+		podMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		eh := &velerov1api.ExecHook{
+			Container: hook.Container,
+			Command:   SQLVerificationCommand(hook.SQL),
+			OnError:   velerov1api.HookErrorModeFail,
+			Timeout:   metav1.Duration{Duration: timeout},
+		}
+		return v.PodCommandExecutor.ExecutePodCommand(log, podMap, pod.Namespace, pod.Name, hookName, eh)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return v.verifyHTTP(ctx, log, pod, hook.HTTP)
+}
+
+func (v *DefaultRestoreHookVerifier) verifyHTTP(ctx context.Context, log logrus.FieldLogger, pod *corev1api.Pod, check *velerov1api.HTTPVerification) error {
+	if pod.Status.PodIP == "" {
+		return errors.Errorf("pod %s/%s has no IP address", pod.Namespace, pod.Name)
+	}
+
+	scheme := "http"
+	if check.Scheme == velerov1api.VerifyHTTPSchemeHTTPS {
+		scheme = "https"
+	}
+	url := fmt.Sprintf("%s://%s/%s", scheme, net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(int(check.Port))), strings.TrimPrefix(check.Path, "/"))
+
+	client := v.HTTPClient
+	if client == nil {
+		client = &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec // Same as kubelet HTTPS probes, certificates are not verified.
+			},
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	log.Infof("running HTTP verification against %s", url)
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "error requesting %s", url)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+		return errors.Errorf("HTTP verification against %s returned status code %d", url, resp.StatusCode)
+	}
+	return nil
+}
+
+// ValidateVerifyRestoreHook checks that exactly one check type is set on the hook.
+func ValidateVerifyRestoreHook(hook *velerov1api.VerifyRestoreHook) error {
+	if hook == nil {
+		return errors.New("verify hook is required")
+	}
+	if (hook.SQL == nil) == (hook.HTTP == nil) {
+		return errors.New("exactly one of sql or http must be specified for a verify hook")
+	}
+	if hook.SQL != nil {
+		if hook.SQL.Query == "" {
+			return errors.New("sql verification requires a query")
+		}
+		switch hook.SQL.Engine {
+		case velerov1api.SQLEnginePostgres, velerov1api.SQLEngineMySQL:
+		default:
+			return errors.Errorf("unsupported sql verification engine %q", hook.SQL.Engine)
+		}
+	}
+	if hook.HTTP != nil && (hook.HTTP.Port <= 0 || hook.HTTP.Port > 65535) {
+		return errors.Errorf("invalid http verification port %d", hook.HTTP.Port)
+	}
+	return nil
+}
+
+// SQLVerificationCommand returns the command which runs the verification query with the
+// engine's command line client. Both clients exit non-zero when the statement errors.
+func SQLVerificationCommand(check *velerov1api.SQLVerification) []string {
+	switch check.Engine {
+	case velerov1api.SQLEngineMySQL:
+		cmd := []string{"mysql", "--batch", "--skip-column-names"}
+		if check.User != "" {
+			cmd = append(cmd, "--user", check.User)
+		}
+		cmd = append(cmd, "--execute", check.Query)
+		if check.Database != "" {
+			cmd = append(cmd, check.Database)
+		}
+		return cmd
+	default:
+		cmd := []string{"psql", "--set", "ON_ERROR_STOP=1", "--no-align", "--tuples-only"}
+		if check.User != "" {
+			cmd = append(cmd, "--username", check.User)
+		}
+		if check.Database != "" {
+			cmd = append(cmd, "--dbname", check.Database)
+		}
+		return append(cmd, "--command", check.Query)
+	}
+}
+
+// newPodVerifyRestoreHook wraps a verification hook so that it is scheduled by the wait exec hook
+// handler once its container is Ready.
+func newPodVerifyRestoreHook(hookName string, verify *velerov1api.VerifyRestoreHook, pod *corev1api.Pod) PodExecRestoreHook {
+	container := verify.Container
+	// default to first container in pod if unset, without mutating resource restore hook
+	if container == "" {
+		container = pod.Spec.Containers[0].Name
+	}
+	named := PodExecRestoreHook{
+		HookName:   hookName,
+		HookSource: HookSourceSpec,
+		Hook: velerov1api.ExecRestoreHook{
+			Container:    container,
+			OnError:      velerov1api.HookErrorModeContinue,
+			ExecTimeout:  verify.Timeout,
+			WaitTimeout:  verify.WaitTimeout,
+			WaitForReady: boolptr.True(),
+		},
+		Verify: verify.DeepCopy(),
+	}
+	named.Verify.Container = container
+	if named.Verify.OnFailure == "" {
+		named.Verify.OnFailure = velerov1api.VerifyFailurePolicyWarn
+	}
+	return named
+}
@@ -0,0 +1,213 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestValidateVerifyRestoreHook(t *testing.T) {
+	tests := []struct {
+		name    string
+		hook    *velerov1api.VerifyRestoreHook
+		wantErr bool
+	}{
+		{
+			name:    "nil hook",
+			wantErr: true,
+		},
+		{
+			name:    "no check",
+			hook:    &velerov1api.VerifyRestoreHook{},
+			wantErr: true,
+		},
+		{
+			name: "both checks",
+			hook: &velerov1api.VerifyRestoreHook{
+				SQL:  &velerov1api.SQLVerification{Engine: velerov1api.SQLEnginePostgres, Query: "select 1"},
+				HTTP: &velerov1api.HTTPVerification{Port: 8080},
+			},
+			wantErr: true,
+		},
+		{
+			name: "sql without query",
+			hook: &velerov1api.VerifyRestoreHook{
+				SQL: &velerov1api.SQLVerification{Engine: velerov1api.SQLEnginePostgres},
+			},
+			wantErr: true,
+		},
+		{
+			name: "sql with unknown engine",
+			hook: &velerov1api.VerifyRestoreHook{
+				SQL: &velerov1api.SQLVerification{Engine: "oracle", Query: "select 1 from dual"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "http with invalid port",
+			hook: &velerov1api.VerifyRestoreHook{
+				HTTP: &velerov1api.HTTPVerification{Port: 0},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid sql",
+			hook: &velerov1api.VerifyRestoreHook{
+				SQL: &velerov1api.SQLVerification{Engine: velerov1api.SQLEngineMySQL, Query: "select 1"},
+			},
+		},
+		{
+			name: "valid http",
+			hook: &velerov1api.VerifyRestoreHook{
+				HTTP: &velerov1api.HTTPVerification{Port: 8080, Path: "/healthz"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateVerifyRestoreHook(tc.hook)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSQLVerificationCommand(t *testing.T) {
+	tests := []struct {
+		name  string
+		check *velerov1api.SQLVerification
+		want  []string
+	}{
+		{
+			name:  "postgres with query only",
+			check: &velerov1api.SQLVerification{Engine: velerov1api.SQLEnginePostgres, Query: "select 1"},
+			want:  []string{"psql", "--set", "ON_ERROR_STOP=1", "--no-align", "--tuples-only", "--command", "select 1"},
+		},
+		{
+			name:  "postgres with user and database",
+			check: &velerov1api.SQLVerification{Engine: velerov1api.SQLEnginePostgres, User: "app", Database: "orders", Query: "select count(*) from orders"},
+			want:  []string{"psql", "--set", "ON_ERROR_STOP=1", "--no-align", "--tuples-only", "--username", "app", "--dbname", "orders", "--command", "select count(*) from orders"},
+		},
+		{
+			name:  "mysql with user and database",
+			check: &velerov1api.SQLVerification{Engine: velerov1api.SQLEngineMySQL, User: "root", Database: "orders", Query: "select 1"},
+			want:  []string{"mysql", "--batch", "--skip-column-names", "--user", "root", "--execute", "select 1", "orders"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, SQLVerificationCommand(tc.check))
+		})
+	}
+}
+
+func TestDefaultRestoreHookVerifierSQL(t *testing.T) {
+	pod := builder.ForPod("ns-1", "pod-1").Containers(&corev1api.Container{Name: "db"}).Result()
+	hook := &velerov1api.VerifyRestoreHook{
+		Container: "db",
+		SQL:       &velerov1api.SQLVerification{Engine: velerov1api.SQLEnginePostgres, Query: "select 1"},
+	}
+
+	podCommandExecutor := &velerotest.MockPodCommandExecutor{}
+	defer podCommandExecutor.AssertExpectations(t)
+	podCommandExecutor.On("ExecutePodCommand", mock.Anything, mock.Anything, "ns-1", "pod-1", "verify-db", &velerov1api.ExecHook{
+		Container: "db",
+		Command:   SQLVerificationCommand(hook.SQL),
+		OnError:   velerov1api.HookErrorModeFail,
+		Timeout:   metav1.Duration{Duration: defaultVerifyTimeout},
+	}).Return(nil)
+
+	verifier := &DefaultRestoreHookVerifier{PodCommandExecutor: podCommandExecutor}
+	require.NoError(t, verifier.Verify(context.Background(), velerotest.NewLogger(), pod, "verify-db", hook))
+}
+
+func TestDefaultRestoreHookVerifierHTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/healthz" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	host, portStr, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+	port, err := strconv.Atoi(portStr)
+	require.NoError(t, err)
+
+	pod := builder.ForPod("ns-1", "pod-1").Result()
+	pod.Status.PodIP = host
+
+	verifier := &DefaultRestoreHookVerifier{HTTPClient: server.Client()}
+
+	err = verifier.Verify(context.Background(), velerotest.NewLogger(), pod, "verify-http", &velerov1api.VerifyRestoreHook{
+		HTTP: &velerov1api.HTTPVerification{Port: int32(port), Path: "/healthz"},
+	})
+	assert.NoError(t, err)
+
+	err = verifier.Verify(context.Background(), velerotest.NewLogger(), pod, "verify-http", &velerov1api.VerifyRestoreHook{
+		HTTP: &velerov1api.HTTPVerification{Port: int32(port), Path: "/ready"},
+	})
+	assert.ErrorContains(t, err, "returned status code 503")
+
+	pod.Status.PodIP = ""
+	err = verifier.Verify(context.Background(), velerotest.NewLogger(), pod, "verify-http", &velerov1api.VerifyRestoreHook{
+		HTTP: &velerov1api.HTTPVerification{Port: int32(port)},
+	})
+	assert.ErrorContains(t, err, "has no IP address")
+}
+
+func TestNewPodVerifyRestoreHook(t *testing.T) {
+	pod := builder.ForPod("ns-1", "pod-1").Containers(&corev1api.Container{Name: "app"}, &corev1api.Container{Name: "sidecar"}).Result()
+	verify := &velerov1api.VerifyRestoreHook{
+		HTTP:        &velerov1api.HTTPVerification{Port: 8080},
+		WaitTimeout: metav1.Duration{Duration: 60},
+	}
+
+	named := newPodVerifyRestoreHook("my-hook", verify, pod)
+
+	assert.Equal(t, "my-hook", named.HookName)
+	assert.Equal(t, "app", named.Hook.Container)
+	assert.Equal(t, "app", named.Verify.Container)
+	assert.Equal(t, velerov1api.VerifyFailurePolicyWarn, named.Verify.OnFailure)
+	assert.Equal(t, verify.WaitTimeout, named.Hook.WaitTimeout)
+	assert.True(t, *named.Hook.WaitForReady)
+	// the hook from the restore spec must not be mutated
+	assert.Empty(t, verify.Container)
+	assert.Empty(t, verify.OnFailure)
+}
@@ -64,8 +64,9 @@ func (d *DefaultListWatchFactory) NewListWatch(namespace string, selector fields
 var _ ListWatchFactory = &DefaultListWatchFactory{}
 
 type DefaultWaitExecHookHandler struct {
-	ListWatchFactory   ListWatchFactory
-	PodCommandExecutor podexec.PodCommandExecutor
+	ListWatchFactory    ListWatchFactory
+	PodCommandExecutor  podexec.PodCommandExecutor
+	RestoreHookVerifier RestoreHookVerifier
 }
 
 var _ WaitExecHookHandler = &DefaultWaitExecHookHandler{}
@@ -167,18 +168,38 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 				if hook.Hook.WaitTimeout.Duration != 0 && time.Since(waitStart) > hook.Hook.WaitTimeout.Duration {
 					err := fmt.Errorf("hook %s in container %s expired before executing", hook.HookName, hook.Hook.Container)
 					hookLog.Error(err)
-					errors = append(errors, err)
 
-					errTracker := multiHookTracker.Record(restoreName, newPod.Namespace, newPod.Name, hook.Hook.Container, hook.HookSource, hook.HookName, HookPhase(""), true, err)
+					errTracker := recordRestoreHook(multiHookTracker, restoreName, newPod.Namespace, newPod.Name, hook, err)
 					if errTracker != nil {
 						hookLog.WithError(errTracker).Warn("Error recording the hook in hook tracker")
 					}
 
+					// A failed verification is reported through the hook tracker according to its
+					// failure policy and must not stop the remaining hooks.
+					if hook.Verify != nil {
+						continue
+					}
+					errors = append(errors, err)
+
 					if hook.Hook.OnError == velerov1api.HookErrorModeFail {
 						cancel()
 						return
 					}
 				}
+
+				if hook.Verify != nil {
+					hookLog = hookLog.WithField("hookType", "verify")
+					verifyErr := e.RestoreHookVerifier.Verify(ctx, hookLog, newPod, hook.HookName, hook.Verify)
+					if verifyErr != nil {
+						hookLog.WithError(verifyErr).Warn("Restore verification failed")
+						verifyErr = fmt.Errorf("verification hook %s in container %s of pod %s failed: %v", hook.HookName, hook.Hook.Container, kube.NamespaceAndName(newPod), verifyErr)
+					}
+					if errTracker := recordRestoreHook(multiHookTracker, restoreName, newPod.Namespace, newPod.Name, hook, verifyErr); errTracker != nil {
+						hookLog.WithError(errTracker).Warn("Error recording the hook in hook tracker")
+					}
+					continue
+				}
+
 				eh := &velerov1api.ExecHook{
 					Container: hook.Hook.Container,
 					Command:   hook.Hook.Command,
@@ -186,16 +207,14 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 					Timeout:   hook.Hook.ExecTimeout,
 				}
 
-				hookFailed := false
 				var hookErr error
 				if hookErr = e.PodCommandExecutor.ExecutePodCommand(hookLog, podMap, pod.Namespace, pod.Name, hook.HookName, eh); hookErr != nil {
 					hookLog.WithError(hookErr).Error("Error executing hook")
 					hookErr = fmt.Errorf("hook %s in container %s failed to execute, err: %v", hook.HookName, hook.Hook.Container, hookErr)
 					errors = append(errors, hookErr)
-					hookFailed = true
 				}
 
-				errTracker := multiHookTracker.Record(restoreName, newPod.Namespace, newPod.Name, hook.Hook.Container, hook.HookSource, hook.HookName, HookPhase(""), hookFailed, hookErr)
+				errTracker := recordRestoreHook(multiHookTracker, restoreName, newPod.Namespace, newPod.Name, hook, hookErr)
 				if errTracker != nil {
 					hookLog.WithError(errTracker).Warn("Error recording the hook in hook tracker")
 				}
@@ -252,19 +271,30 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 				},
 			)
 
-			errTracker := multiHookTracker.Record(restoreName, pod.Namespace, pod.Name, hook.Hook.Container, hook.HookSource, hook.HookName, HookPhase(""), true, err)
+			errTracker := recordRestoreHook(multiHookTracker, restoreName, pod.Namespace, pod.Name, hook, err)
 			if errTracker != nil {
 				hookLog.WithError(errTracker).Warn("Error recording the hook in hook tracker")
 			}
 
 			hookLog.Error(err)
-			errors = append(errors, err)
+			if hook.Verify == nil {
+				errors = append(errors, err)
+			}
 		}
 	}
 
 	return errors
 }
 
+// recordRestoreHook records the result of a restore hook in the hook tracker. Verification hooks
+// are recorded according to their failure policy.
+func recordRestoreHook(multiHookTracker *MultiHookTracker, restoreName, podNamespace, podName string, hook PodExecRestoreHook, hookErr error) error {
+	if hook.Verify != nil {
+		return multiHookTracker.RecordVerification(restoreName, podNamespace, podName, hook.Hook.Container, hook.HookName, hook.Verify.OnFailure, hookErr)
+	}
+	return multiHookTracker.Record(restoreName, podNamespace, podName, hook.Hook.Container, hook.HookSource, hook.HookName, HookPhase(""), hookErr != nil, hookErr)
+}
+
 func podHasContainer(pod *v1.Pod, containerName string) bool {
 	if pod == nil {
 		return false
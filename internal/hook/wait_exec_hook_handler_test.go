@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -1275,3 +1276,100 @@ func TestRestoreHookTrackerUpdate(t *testing.T) {
 		})
 	}
 }
+
+type fakeRestoreHookVerifier struct {
+	errs map[string]error
+}
+
+func (f *fakeRestoreHookVerifier) Verify(_ context.Context, _ logrus.FieldLogger, _ *v1.Pod, hookName string, _ *velerov1api.VerifyRestoreHook) error {
+	return f.errs[hookName]
+}
+
+func TestWaitExecHandleVerifyHooks(t *testing.T) {
+	pod := builder.ForPod("default", "my-pod").
+		Containers(&v1.Container{
+			Name: "container1",
+		}).
+		ContainerStatuses(&v1.ContainerStatus{
+			Name:  "container1",
+			Ready: true,
+			State: v1.ContainerState{
+				Running: &v1.ContainerStateRunning{},
+			},
+		}).
+		Result()
+
+	verifyHook := func(name string, onFailure velerov1api.VerifyFailurePolicy) PodExecRestoreHook {
+		return newPodVerifyRestoreHook(name, &velerov1api.VerifyRestoreHook{
+			HTTP:        &velerov1api.HTTPVerification{Port: 8080},
+			OnFailure:   onFailure,
+			WaitTimeout: metav1.Duration{Duration: time.Minute},
+		}, pod)
+	}
+
+	tests := []struct {
+		name                   string
+		hooks                  []PodExecRestoreHook
+		verifyErrs             map[string]error
+		expectedHooksFailed    int
+		expectedVerifyFailed   int
+		expectedHookErrs       int
+		expectedHookWarnings   int
+		expectedVerifyExecuted int
+	}{
+		{
+			name:                   "verification passes",
+			hooks:                  []PodExecRestoreHook{verifyHook("verify-1", velerov1api.VerifyFailurePolicyPartiallyFailed)},
+			expectedVerifyExecuted: 1,
+		},
+		{
+			name:                   "failed verification with Warn policy is recorded as warning",
+			hooks:                  []PodExecRestoreHook{verifyHook("verify-1", "")},
+			verifyErrs:             map[string]error{"verify-1": errors.New("connection refused")},
+			expectedVerifyFailed:   1,
+			expectedHookWarnings:   1,
+			expectedVerifyExecuted: 1,
+		},
+		{
+			name: "failed verification with PartiallyFailed policy is recorded as error and does not stop other verifications",
+			hooks: []PodExecRestoreHook{
+				verifyHook("verify-1", velerov1api.VerifyFailurePolicyPartiallyFailed),
+				verifyHook("verify-2", velerov1api.VerifyFailurePolicyPartiallyFailed),
+			},
+			verifyErrs:             map[string]error{"verify-1": errors.New("connection refused")},
+			expectedHooksFailed:    1,
+			expectedVerifyFailed:   1,
+			expectedHookErrs:       1,
+			expectedVerifyExecuted: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			source := fcache.NewFakeControllerSource()
+			source.Add(pod)
+
+			h := &DefaultWaitExecHookHandler{
+				ListWatchFactory:    &fakeListWatchFactory{source},
+				RestoreHookVerifier: &fakeRestoreHookVerifier{errs: test.verifyErrs},
+			}
+
+			hookTracker := NewMultiHookTracker()
+			for _, hook := range test.hooks {
+				hookTracker.Add("restore1", pod.Namespace, pod.Name, hook.Hook.Container, HookSourceSpec, hook.HookName, PhaseVerify)
+			}
+
+			errs := h.HandleHooks(context.Background(), velerotest.NewLogger(), pod, map[string][]PodExecRestoreHook{"container1": test.hooks}, hookTracker, "restore1")
+			assert.Empty(t, errs)
+
+			assert.True(t, hookTracker.IsComplete("restore1"))
+			_, hooksFailed := hookTracker.Stat("restore1")
+			assert.Equal(t, test.expectedHooksFailed, hooksFailed)
+			verifyExecuted, verifyFailed := hookTracker.VerificationStat("restore1")
+			assert.Equal(t, test.expectedVerifyExecuted, verifyExecuted)
+			assert.Equal(t, test.expectedVerifyFailed, verifyFailed)
+			assert.Len(t, hookTracker.HookErrs("restore1"), test.expectedHookErrs)
+			assert.Len(t, hookTracker.HookWarnings("restore1"), test.expectedHookWarnings)
+		})
+	}
+}
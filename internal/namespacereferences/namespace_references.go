@@ -0,0 +1,243 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package namespacereferences rewrites the references to the namespaces mapped by a restore in the
+// fields of the restored items, like the namespace of the service of a webhook configuration.
+package namespacereferences
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/gobwas/glob"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	ConfigmapRefType                    = "configmap"
+	NamespaceReferencesSupportedVersion = "v1"
+)
+
+// Rule is a set of fields of the items of the matching group resources which reference namespaces.
+type Rule struct {
+	// GroupResource is the group resource of the items, which may be a glob, e.g. *.cert-manager.io.
+	GroupResource string `json:"groupResource"`
+
+	// Paths are the JSON pointers of the fields, in which "*" matches every element of a list or
+	// every value of a map, e.g. /spec/targets/*/namespace.
+	Paths []string `json:"paths"`
+}
+
+// Rules are the rules of a restore, on top of the default ones.
+type Rules struct {
+	Version                 string `json:"version"`
+	NamespaceReferenceRules []Rule `json:"namespaceReferenceRules"`
+}
+
+// defaultRules are the fields of the Kubernetes resources referencing namespaces. The subjects of
+// the role bindings are rewritten by their restore item actions.
+var defaultRules = []Rule{
+	{
+		GroupResource: "mutatingwebhookconfigurations.admissionregistration.k8s.io",
+		Paths:         []string{"/webhooks/*/clientConfig/service/namespace"},
+	},
+	{
+		GroupResource: "validatingwebhookconfigurations.admissionregistration.k8s.io",
+		Paths:         []string{"/webhooks/*/clientConfig/service/namespace"},
+	},
+	{
+		GroupResource: "apiservices.apiregistration.k8s.io",
+		Paths:         []string{"/spec/service/namespace"},
+	},
+	{
+		GroupResource: "customresourcedefinitions.apiextensions.k8s.io",
+		Paths:         []string{"/spec/conversion/webhook/clientConfig/service/namespace"},
+	},
+	{
+		GroupResource: "networkpolicies.networking.k8s.io",
+		Paths: []string{
+			"/spec/ingress/*/from/*/namespaceSelector/matchLabels/kubernetes.io~1metadata.name",
+			"/spec/egress/*/to/*/namespaceSelector/matchLabels/kubernetes.io~1metadata.name",
+		},
+	},
+}
+
+// serviceDNSName matches the namespace of the DNS name of a service, e.g. "ns-1" in
+// "svc-1.ns-1.svc.cluster.local".
+var serviceDNSName = regexp.MustCompile(`\.([a-z0-9]([-a-z0-9]*[a-z0-9])?)\.svc\b`)
+
+func GetRulesFromConfig(cm *v1.ConfigMap) (*Rules, error) {
+	if cm == nil {
+		return nil, fmt.Errorf("could not parse config from nil configmap")
+	}
+	if len(cm.Data) != 1 {
+		return nil, fmt.Errorf("illegal namespace reference rules %s/%s configmap", cm.Namespace, cm.Name)
+	}
+
+	var yamlData string
+	for _, v := range cm.Data {
+		yamlData = v
+	}
+
+	rules := &Rules{}
+	if err := yaml.UnmarshalStrict([]byte(yamlData), rules); err != nil {
+		return nil, errors.Wrap(err, "failed to decode yaml data into namespace reference rules")
+	}
+	return rules, nil
+}
+
+func (r *Rules) Validate() error {
+	if !strings.EqualFold(r.Version, NamespaceReferencesSupportedVersion) {
+		return fmt.Errorf("unsupported namespace reference rules version %s", r.Version)
+	}
+	for _, rule := range r.NamespaceReferenceRules {
+		if rule.GroupResource == "" {
+			return fmt.Errorf("groupResource cannot be empty")
+		}
+		if _, err := glob.Compile(rule.GroupResource, '.'); err != nil {
+			return errors.Wrapf(err, "bad glob pattern of groupResource %s", rule.GroupResource)
+		}
+		if len(rule.Paths) == 0 {
+			return fmt.Errorf("paths of groupResource %s cannot be empty", rule.GroupResource)
+		}
+		for _, path := range rule.Paths {
+			if !strings.HasPrefix(path, "/") {
+				return fmt.Errorf("path %s of groupResource %s isn't a JSON pointer", path, rule.GroupResource)
+			}
+		}
+	}
+	return nil
+}
+
+// Rewriter rewrites the references to the mapped namespaces in the fields of the items the rules
+// select.
+type Rewriter struct {
+	namespaceMapping map[string]string
+	rules            []compiledRule
+}
+
+type compiledRule struct {
+	groupResource glob.Glob
+	paths         [][]string
+}
+
+// NewRewriter returns the rewriter of the references to the namespaces of the mapping in the
+// fields of the default rules and of the given ones, if any.
+func NewRewriter(namespaceMapping map[string]string, rules *Rules) (*Rewriter, error) {
+	all := defaultRules
+	if rules != nil {
+		all = append(append([]Rule{}, defaultRules...), rules.NamespaceReferenceRules...)
+	}
+
+	rewriter := &Rewriter{namespaceMapping: namespaceMapping}
+	for _, rule := range all {
+		g, err := glob.Compile(rule.GroupResource, '.')
+		if err != nil {
+			return nil, errors.Wrapf(err, "bad glob pattern of groupResource %s", rule.GroupResource)
+		}
+		compiled := compiledRule{groupResource: g}
+		for _, path := range rule.Paths {
+			compiled.paths = append(compiled.paths, parsePath(path))
+		}
+		rewriter.rules = append(rewriter.rules, compiled)
+	}
+	return rewriter, nil
+}
+
+// Rewrite rewrites the references to the mapped namespaces in the fields of the item, returning how
+// many were rewritten.
+func (r *Rewriter) Rewrite(obj *unstructured.Unstructured, groupResource string) int {
+	var rewritten int
+	for _, rule := range r.rules {
+		if !rule.groupResource.Match(groupResource) {
+			continue
+		}
+		for _, path := range rule.paths {
+			rewritten += r.rewritePath(map[string]any{"": obj.Object}, "", path)
+		}
+	}
+	return rewritten
+}
+
+// rewritePath rewrites the references in the field at the path under the key of the map, the
+// elements of the lists being wrapped into maps to be rewritten in place.
+func (r *Rewriter) rewritePath(parent map[string]any, key string, path []string) int {
+	value, found := parent[key]
+	if !found {
+		return 0
+	}
+	if len(path) == 0 {
+		s, ok := value.(string)
+		if !ok {
+			return 0
+		}
+		if rewritten := r.rewriteValue(s); rewritten != s {
+			parent[key] = rewritten
+			return 1
+		}
+		return 0
+	}
+
+	var rewritten int
+	switch node := value.(type) {
+	case map[string]any:
+		if path[0] == "*" {
+			for k := range node {
+				rewritten += r.rewritePath(node, k, path[1:])
+			}
+		} else {
+			rewritten += r.rewritePath(node, path[0], path[1:])
+		}
+	case []any:
+		for i := range node {
+			if path[0] != "*" && path[0] != fmt.Sprint(i) {
+				continue
+			}
+			element := map[string]any{"": node[i]}
+			rewritten += r.rewritePath(element, "", path[1:])
+			node[i] = element[""]
+		}
+	}
+	return rewritten
+}
+
+// rewriteValue returns the value referencing the mapped namespace in place of the original one,
+// when the value is the name of the namespace or contains the DNS name of a service in it.
+func (r *Rewriter) rewriteValue(value string) string {
+	if namespace, found := r.namespaceMapping[value]; found {
+		return namespace
+	}
+	return serviceDNSName.ReplaceAllStringFunc(value, func(match string) string {
+		source := strings.TrimSuffix(strings.TrimPrefix(match, "."), ".svc")
+		if namespace, found := r.namespaceMapping[source]; found {
+			return "." + namespace + ".svc"
+		}
+		return match
+	})
+}
+
+// parsePath returns the reference tokens of the JSON pointer.
+func parsePath(path string) []string {
+	tokens := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens
+}
@@ -0,0 +1,144 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package namespacereferences
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestGetRulesFromConfig(t *testing.T) {
+	cm := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "rules"},
+		Data: map[string]string{
+			"rules.yaml": `version: v1
+namespaceReferenceRules:
+- groupResource: "*.cert-manager.io"
+  paths:
+  - /spec/issuerRef/namespace
+`,
+		},
+	}
+	rules, err := GetRulesFromConfig(cm)
+	require.NoError(t, err)
+	require.NoError(t, rules.Validate())
+	assert.Equal(t, []Rule{{GroupResource: "*.cert-manager.io", Paths: []string{"/spec/issuerRef/namespace"}}}, rules.NamespaceReferenceRules)
+
+	cm.Data["other.yaml"] = ""
+	_, err = GetRulesFromConfig(cm)
+	require.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   *Rules
+		wantErr bool
+	}{
+		{
+			name:  "valid rules",
+			rules: &Rules{Version: "v1", NamespaceReferenceRules: []Rule{{GroupResource: "foos.example.com", Paths: []string{"/spec/namespace"}}}},
+		},
+		{
+			name:    "unsupported version",
+			rules:   &Rules{Version: "v2"},
+			wantErr: true,
+		},
+		{
+			name:    "no group resource",
+			rules:   &Rules{Version: "v1", NamespaceReferenceRules: []Rule{{Paths: []string{"/spec/namespace"}}}},
+			wantErr: true,
+		},
+		{
+			name:    "no paths",
+			rules:   &Rules{Version: "v1", NamespaceReferenceRules: []Rule{{GroupResource: "foos.example.com"}}},
+			wantErr: true,
+		},
+		{
+			name:    "path isn't a JSON pointer",
+			rules:   &Rules{Version: "v1", NamespaceReferenceRules: []Rule{{GroupResource: "foos.example.com", Paths: []string{"spec.namespace"}}}},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.rules.Validate()
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRewrite(t *testing.T) {
+	rewriter, err := NewRewriter(map[string]string{"ns-1": "ns-2", "ns-2": "ns-3"}, &Rules{
+		Version: "v1",
+		NamespaceReferenceRules: []Rule{
+			{GroupResource: "*.example.com", Paths: []string{"/spec/namespace", "/spec/endpoints/*"}},
+		},
+	})
+	require.NoError(t, err)
+
+	webhook := &unstructured.Unstructured{Object: map[string]any{
+		"webhooks": []any{
+			map[string]any{"clientConfig": map[string]any{"service": map[string]any{"namespace": "ns-1", "name": "svc"}}},
+			map[string]any{"clientConfig": map[string]any{"service": map[string]any{"namespace": "other", "name": "svc"}}},
+			map[string]any{"clientConfig": map[string]any{"url": "https://svc.ns-1.svc"}},
+		},
+	}}
+	assert.Equal(t, 1, rewriter.Rewrite(webhook, "validatingwebhookconfigurations.admissionregistration.k8s.io"))
+	webhooks := webhook.Object["webhooks"].([]any)
+	assert.Equal(t, "ns-2", webhooks[0].(map[string]any)["clientConfig"].(map[string]any)["service"].(map[string]any)["namespace"])
+	assert.Equal(t, "other", webhooks[1].(map[string]any)["clientConfig"].(map[string]any)["service"].(map[string]any)["namespace"])
+	// the URL isn't one of the fields of the default rules
+	assert.Equal(t, "https://svc.ns-1.svc", webhooks[2].(map[string]any)["clientConfig"].(map[string]any)["url"])
+
+	policy := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"ingress": []any{map[string]any{"from": []any{
+				map[string]any{"namespaceSelector": map[string]any{"matchLabels": map[string]any{"kubernetes.io/metadata.name": "ns-1"}}},
+				map[string]any{"podSelector": map[string]any{"matchLabels": map[string]any{"app": "ns-1"}}},
+			}}},
+		},
+	}}
+	assert.Equal(t, 1, rewriter.Rewrite(policy, "networkpolicies.networking.k8s.io"))
+	from := policy.Object["spec"].(map[string]any)["ingress"].([]any)[0].(map[string]any)["from"].([]any)
+	assert.Equal(t, "ns-2", from[0].(map[string]any)["namespaceSelector"].(map[string]any)["matchLabels"].(map[string]any)["kubernetes.io/metadata.name"])
+	assert.Equal(t, "ns-1", from[1].(map[string]any)["podSelector"].(map[string]any)["matchLabels"].(map[string]any)["app"])
+
+	custom := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"namespace": "ns-2",
+			"endpoints": []any{"http://svc.ns-1.svc.cluster.local:8080", "db.ns-2.svc", "svc.other.svc", "ns-1"},
+		},
+	}}
+	assert.Equal(t, 4, rewriter.Rewrite(custom, "foos.example.com"))
+	// each reference is rewritten once, the namespaces not being chained
+	assert.Equal(t, map[string]any{
+		"namespace": "ns-3",
+		"endpoints": []any{"http://svc.ns-2.svc.cluster.local:8080", "db.ns-3.svc", "svc.other.svc", "ns-2"},
+	}, custom.Object["spec"])
+
+	assert.Equal(t, 0, rewriter.Rewrite(custom, "bars.other.io"))
+}
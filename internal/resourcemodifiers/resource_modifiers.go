@@ -49,6 +49,9 @@ type Conditions struct {
 	ResourceNameRegex string                `json:"resourceNameRegex,omitempty"`
 	LabelSelector     *metav1.LabelSelector `json:"labelSelector,omitempty"`
 	Matches           []MatchRule           `json:"matches,omitempty"`
+	// Expression is a Go template the item matches when it renders as true, e.g.
+	// {{ eq .object.spec.type "LoadBalancer" }}.
+	Expression string `json:"expression,omitempty"`
 }
 
 type ResourceModifierRule struct {
@@ -56,6 +59,8 @@ type ResourceModifierRule struct {
 	Patches          []JSONPatch           `json:"patches,omitempty"`
 	MergePatches     []JSONMergePatch      `json:"mergePatches,omitempty"`
 	StrategicPatches []StrategicMergePatch `json:"strategicPatches,omitempty"`
+	// Templated renders the paths and values of the patches as Go templates before applying them.
+	Templated bool `json:"templated,omitempty"`
 }
 
 type ResourceModifiers struct {
@@ -84,15 +89,18 @@ func GetResourceModifiersFromConfig(cm *v1.ConfigMap) (*ResourceModifiers, error
 	return resModifiers, nil
 }
 
-func (p *ResourceModifiers) ApplyResourceModifierRules(obj *unstructured.Unstructured, groupResource string, scheme *runtime.Scheme, log logrus.FieldLogger) []error {
+// ApplyResourceModifierRules applies the rules matching the item to it. The data of the restore is
+// what the expressions and the templated values of the rules can access, besides the item.
+func (p *ResourceModifiers) ApplyResourceModifierRules(obj *unstructured.Unstructured, groupResource string, scheme *runtime.Scheme, data *TemplateData, log logrus.FieldLogger) []error {
 	var errs []error
 	origin := obj
 	// If there are more than one rules, we need to keep the original object for condition matching
 	if len(p.ResourceModifierRules) > 1 {
 		origin = obj.DeepCopy()
 	}
+	values := templateValues(origin, data)
 	for _, rule := range p.ResourceModifierRules {
-		matched, err := rule.match(origin, groupResource, log)
+		matched, err := rule.match(origin, groupResource, values, log)
 		if err != nil {
 			errs = append(errs, err)
 			continue
@@ -100,6 +108,15 @@ func (p *ResourceModifiers) ApplyResourceModifierRules(obj *unstructured.Unstruc
 			continue
 		}
 
+		if rule.Templated {
+			rendered, err := rule.renderPatches(values)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			rule = *rendered
+		}
+
 		log.Infof("Applying resource modifier patch on %s/%s", origin.GetNamespace(), origin.GetName())
 		err = rule.applyPatch(obj, scheme, log)
 		if err != nil {
@@ -110,7 +127,7 @@ func (p *ResourceModifiers) ApplyResourceModifierRules(obj *unstructured.Unstruc
 	return errs
 }
 
-func (r *ResourceModifierRule) match(obj *unstructured.Unstructured, groupResource string, log logrus.FieldLogger) (bool, error) {
+func (r *ResourceModifierRule) match(obj *unstructured.Unstructured, groupResource string, values map[string]any, log logrus.FieldLogger) (bool, error) {
 	ns := obj.GetNamespace()
 	if ns != "" {
 		namespaceInclusion := collections.NewIncludesExcludes().Includes(r.Conditions.Namespaces...)
@@ -157,6 +174,16 @@ func (r *ResourceModifierRule) match(obj *unstructured.Unstructured, groupResour
 		return false, nil
 	}
 
+	if r.Conditions.Expression != "" {
+		match, err := matchExpression(r.Conditions.Expression, values)
+		if err != nil {
+			return false, err
+		} else if !match {
+			log.Info("Expression does not match, skip it")
+			return false, nil
+		}
+	}
+
 	return true, nil
 }
 
@@ -44,6 +44,24 @@ func (r *ResourceModifierRule) Validate() error {
 			return err
 		}
 	}
+
+	if r.Templated {
+		var templates []string
+		for _, patch := range r.Patches {
+			templates = append(templates, patch.Path, patch.Value)
+		}
+		for _, patch := range r.MergePatches {
+			templates = append(templates, patch.PatchData)
+		}
+		for _, patch := range r.StrategicPatches {
+			templates = append(templates, patch.PatchData)
+		}
+		for _, text := range templates {
+			if _, err := parseTemplate(text); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -80,5 +98,10 @@ func (c *Conditions) Validate() error {
 	if c.GroupResource == "" {
 		return fmt.Errorf("groupkResource cannot be empty")
 	}
+	if c.Expression != "" {
+		if _, err := parseTemplate(c.Expression); err != nil {
+			return err
+		}
+	}
 	return nil
 }
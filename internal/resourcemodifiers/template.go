@@ -0,0 +1,163 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package resourcemodifiers
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// TemplateData is the data of the restore the expressions and the templated values of the rules
+// can access, besides the item being restored.
+type TemplateData struct {
+	RestoreName      string
+	TargetCluster    string
+	NamespaceMapping map[string]string
+}
+
+// templateFuncs are the functions of the templates, besides the builtin ones of text/template.
+// Their last argument is the one piped into them.
+var templateFuncs = template.FuncMap{
+	"replace": func(oldValue, newValue, s string) string {
+		return strings.ReplaceAll(s, oldValue, newValue)
+	},
+	"regexReplace": func(pattern, replacement, s string) (string, error) {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return "", err
+		}
+		return re.ReplaceAllString(s, replacement), nil
+	},
+	"hasPrefix": func(prefix, s string) bool {
+		return strings.HasPrefix(s, prefix)
+	},
+	"hasSuffix": func(suffix, s string) bool {
+		return strings.HasSuffix(s, suffix)
+	},
+	"trimPrefix": func(prefix, s string) string {
+		return strings.TrimPrefix(s, prefix)
+	},
+	"trimSuffix": func(suffix, s string) string {
+		return strings.TrimSuffix(s, suffix)
+	},
+	"default": func(def, value any) any {
+		if value == nil || value == "" {
+			return def
+		}
+		return value
+	},
+	// field returns the field of the object at the dot-separated path, or an empty string when
+	// the object doesn't have it, the templates failing on the missing fields otherwise.
+	"field": func(path string, obj map[string]any) any {
+		value, found, err := unstructured.NestedFieldNoCopy(obj, strings.Split(path, ".")...)
+		if err != nil || !found || value == nil {
+			return ""
+		}
+		return value
+	},
+}
+
+// parseTemplate parses the template of an expression or of a templated value.
+func parseTemplate(text string) (*template.Template, error) {
+	t, err := template.New("").Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("error in parsing template %q: %s", text, err)
+	}
+	return t, nil
+}
+
+// templateValues returns the values the templates are executed with: the item as .object, the
+// restore as .restore, the namespace mapping as .namespaceMapping and the namespace the item is
+// restored into as .targetNamespace.
+func templateValues(obj *unstructured.Unstructured, data *TemplateData) map[string]any {
+	if data == nil {
+		data = &TemplateData{}
+	}
+	targetNamespace := obj.GetNamespace()
+	if namespace, found := data.NamespaceMapping[targetNamespace]; found {
+		targetNamespace = namespace
+	}
+	namespaceMapping := make(map[string]any, len(data.NamespaceMapping))
+	for source, target := range data.NamespaceMapping {
+		namespaceMapping[source] = target
+	}
+	return map[string]any{
+		"object": obj.Object,
+		"restore": map[string]any{
+			"name":          data.RestoreName,
+			"targetCluster": data.TargetCluster,
+		},
+		"namespaceMapping": namespaceMapping,
+		"targetNamespace":  targetNamespace,
+	}
+}
+
+// renderTemplate executes the template with the values. It fails on the fields the values don't
+// have, which are read with the field function when they're optional.
+func renderTemplate(text string, values map[string]any) (string, error) {
+	t, err := parseTemplate(text)
+	if err != nil {
+		return "", err
+	}
+	var rendered strings.Builder
+	if err := t.Execute(&rendered, values); err != nil {
+		return "", fmt.Errorf("error in executing template %q: %s", text, err)
+	}
+	return rendered.String(), nil
+}
+
+// matchExpression returns whether the expression of the conditions renders as true.
+func matchExpression(expression string, values map[string]any) (bool, error) {
+	rendered, err := renderTemplate(expression, values)
+	if err != nil {
+		return false, err
+	}
+	return strings.TrimSpace(rendered) == "true", nil
+}
+
+// renderPatches returns the rule with the templates of the values of its patches rendered.
+func (r *ResourceModifierRule) renderPatches(values map[string]any) (*ResourceModifierRule, error) {
+	rendered := &ResourceModifierRule{Conditions: r.Conditions}
+	for _, patch := range r.Patches {
+		var err error
+		if patch.Path, err = renderTemplate(patch.Path, values); err != nil {
+			return nil, err
+		}
+		if patch.Value, err = renderTemplate(patch.Value, values); err != nil {
+			return nil, err
+		}
+		rendered.Patches = append(rendered.Patches, patch)
+	}
+	for _, patch := range r.MergePatches {
+		data, err := renderTemplate(patch.PatchData, values)
+		if err != nil {
+			return nil, err
+		}
+		rendered.MergePatches = append(rendered.MergePatches, JSONMergePatch{PatchData: data})
+	}
+	for _, patch := range r.StrategicPatches {
+		data, err := renderTemplate(patch.PatchData, values)
+		if err != nil {
+			return nil, err
+		}
+		rendered.StrategicPatches = append(rendered.StrategicPatches, StrategicMergePatch{PatchData: data})
+	}
+	return rendered, nil
+}
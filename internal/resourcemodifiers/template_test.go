@@ -0,0 +1,222 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package resourcemodifiers
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newTemplateTestDeployment(image string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]any{
+			"name":      "app",
+			"namespace": "ns-1",
+		},
+		"spec": map[string]any{
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{
+						map[string]any{"name": "app", "image": image},
+					},
+				},
+			},
+		},
+	}}
+}
+
+func TestApplyTemplatedResourceModifierRules(t *testing.T) {
+	data := &TemplateData{
+		RestoreName:      "restore-1",
+		TargetCluster:    "cluster-2",
+		NamespaceMapping: map[string]string{"ns-1": "ns-2"},
+	}
+
+	tests := []struct {
+		name          string
+		rule          ResourceModifierRule
+		image         string
+		expectedImage string
+		expectedEnv   any
+		wantErr       bool
+	}{
+		{
+			name: "expression selects the items",
+			rule: ResourceModifierRule{
+				Conditions: Conditions{
+					GroupResource: "deployments.apps",
+					Expression:    `{{ with index .object.spec.template.spec.containers 0 }}{{ hasPrefix "registry-1.example.com/" .image }}{{ end }}`,
+				},
+				Patches:   []JSONPatch{{Operation: "replace", Path: "/spec/template/spec/containers/0/image", Value: "{{ with index .object.spec.template.spec.containers 0 }}{{ .image | replace \"registry-1.\" \"registry-2.\" }}{{ end }}"}},
+				Templated: true,
+			},
+			image:         "registry-1.example.com/app:v1",
+			expectedImage: "registry-2.example.com/app:v1",
+		},
+		{
+			name: "expression doesn't select the items",
+			rule: ResourceModifierRule{
+				Conditions: Conditions{
+					GroupResource: "deployments.apps",
+					Expression:    `{{ with index .object.spec.template.spec.containers 0 }}{{ hasPrefix "registry-1.example.com/" .image }}{{ end }}`,
+				},
+				Patches: []JSONPatch{{Operation: "replace", Path: "/spec/template/spec/containers/0/image", Value: "other"}},
+			},
+			image:         "docker.io/app:v1",
+			expectedImage: "docker.io/app:v1",
+		},
+		{
+			name: "expression on a missing optional field doesn't select the items",
+			rule: ResourceModifierRule{
+				Conditions: Conditions{
+					GroupResource: "deployments.apps",
+					Expression:    `{{ eq (field "spec.paused" .object) "true" }}`,
+				},
+				Patches: []JSONPatch{{Operation: "replace", Path: "/spec/template/spec/containers/0/image", Value: "other"}},
+			},
+			image:         "app:v1",
+			expectedImage: "app:v1",
+		},
+		{
+			name: "expression on a missing field fails",
+			rule: ResourceModifierRule{
+				Conditions: Conditions{
+					GroupResource: "deployments.apps",
+					Expression:    `{{ eq .object.metadata.labels.tier "gold" }}`,
+				},
+				Patches: []JSONPatch{{Operation: "replace", Path: "/spec/template/spec/containers/0/image", Value: "other"}},
+			},
+			image:         "app:v1",
+			expectedImage: "app:v1",
+			wantErr:       true,
+		},
+		{
+			name: "templated value of a missing optional field",
+			rule: ResourceModifierRule{
+				Conditions: Conditions{GroupResource: "deployments.apps"},
+				Templated:  true,
+				Patches:    []JSONPatch{{Operation: "replace", Path: "/spec/template/spec/containers/0/image", Value: `"app:{{ field "metadata.labels.tier" .object | default "standard" }}"`}},
+			},
+			image:         "app:v1",
+			expectedImage: "app:standard",
+		},
+		{
+			name: "expression of the target cluster",
+			rule: ResourceModifierRule{
+				Conditions: Conditions{
+					GroupResource: "deployments.apps",
+					Expression:    `{{ eq .restore.targetCluster "cluster-2" }}`,
+				},
+				Patches: []JSONPatch{{Operation: "replace", Path: "/spec/template/spec/containers/0/image", Value: "app:cluster-2"}},
+			},
+			image:         "app:v1",
+			expectedImage: "app:cluster-2",
+		},
+		{
+			name: "templated merge patch with the restore and the target namespace",
+			rule: ResourceModifierRule{
+				Conditions: Conditions{GroupResource: "deployments.apps"},
+				MergePatches: []JSONMergePatch{{PatchData: `
+spec:
+  template:
+    spec:
+      containers:
+      - name: app
+        image: app:v1
+        env:
+        - name: RESTORE
+          value: "{{ .restore.name }}"
+        - name: HOST
+          value: "db.{{ .targetNamespace }}.svc"`}},
+				Templated: true,
+			},
+			image:         "app:v1",
+			expectedImage: "app:v1",
+			expectedEnv: []any{
+				map[string]any{"name": "RESTORE", "value": "restore-1"},
+				map[string]any{"name": "HOST", "value": "db.ns-2.svc"},
+			},
+		},
+		{
+			name: "values aren't rendered unless the rule is templated",
+			rule: ResourceModifierRule{
+				Conditions: Conditions{GroupResource: "deployments.apps"},
+				Patches:    []JSONPatch{{Operation: "replace", Path: "/spec/template/spec/containers/0/image", Value: `"{{ .restore.name }}"`}},
+			},
+			image:         "app:v1",
+			expectedImage: "{{ .restore.name }}",
+		},
+		{
+			name: "error executing the expression",
+			rule: ResourceModifierRule{
+				Conditions: Conditions{
+					GroupResource: "deployments.apps",
+					Expression:    `{{ regexReplace "(" "" "app" }}`,
+				},
+				Patches: []JSONPatch{{Operation: "replace", Path: "/spec/template/spec/containers/0/image", Value: "other"}},
+			},
+			image:         "app:v1",
+			expectedImage: "app:v1",
+			wantErr:       true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := newTemplateTestDeployment(tc.image)
+			modifiers := &ResourceModifiers{Version: "v1", ResourceModifierRules: []ResourceModifierRule{tc.rule}}
+			require.NoError(t, modifiers.Validate())
+
+			errs := modifiers.ApplyResourceModifierRules(obj, "deployments.apps", nil, data, logrus.New())
+			if tc.wantErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+
+			containers, _, err := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "containers")
+			require.NoError(t, err)
+			container := containers[0].(map[string]any)
+			assert.Equal(t, tc.expectedImage, container["image"])
+			assert.Equal(t, tc.expectedEnv, container["env"])
+		})
+	}
+}
+
+func TestValidateTemplates(t *testing.T) {
+	rule := ResourceModifierRule{
+		Conditions: Conditions{GroupResource: "deployments.apps", Expression: "{{ .object"},
+		Patches:    []JSONPatch{{Operation: "replace", Path: "/spec/replicas", Value: "1"}},
+	}
+	require.Error(t, rule.Validate())
+
+	rule = ResourceModifierRule{
+		Conditions: Conditions{GroupResource: "deployments.apps"},
+		Patches:    []JSONPatch{{Operation: "replace", Path: "/spec/replicas", Value: "{{ .restore.name"}},
+		Templated:  true,
+	}
+	require.Error(t, rule.Validate())
+
+	// the values are only parsed as templates when the rule is templated
+	rule.Templated = false
+	require.NoError(t, rule.Validate())
+}
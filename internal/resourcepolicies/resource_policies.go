@@ -18,10 +18,14 @@ package resourcepolicies
 import (
 	"context"
 	"fmt"
+	"io"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
 	v1 "k8s.io/api/core/v1"
 	crclient "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -33,12 +37,17 @@ type VolumeActionType string
 const (
 	// currently only support configmap type of resource config
 	ConfigmapRefType string = "configmap"
+	// inlineResourcePoliciesKey names the documents of the inline resource policies of a backup
+	inlineResourcePoliciesKey = "inline"
 	// skip action implies the volume would be skipped from the backup operation
 	Skip VolumeActionType = "skip"
 	// fs-backup action implies that the volume would be backed up via file system copy method using the uploader(kopia/restic) configured by the user
 	FSBackup VolumeActionType = "fs-backup"
 	// snapshot action can have 3 different meaning based on velero configuration and backup spec - cloud provider based snapshots, local csi snapshots and datamover snapshots
 	Snapshot VolumeActionType = "snapshot"
+
+	// SnapshotClassParameter is the parameter of the snapshot action selecting the VolumeSnapshotClass the CSI snapshots of the matched volumes are taken with
+	SnapshotClassParameter = "snapshotClass"
 )
 
 // Action defined as one action for a specific way of backup
@@ -49,25 +58,103 @@ type Action struct {
 	Parameters map[string]any `yaml:"parameters,omitempty"`
 }
 
+// SnapshotClass returns the VolumeSnapshotClass selected by the snapshotClass parameter of the action, empty if not set
+func (a *Action) SnapshotClass() string {
+	snapshotClass, _ := a.Parameters[SnapshotClassParameter].(string)
+	return snapshotClass
+}
+
 // volumePolicy defined policy to conditions to match Volumes and related action to handle matched Volumes
 type VolumePolicy struct {
 	// Conditions defined list of conditions to match Volumes
 	Conditions map[string]any `yaml:"conditions"`
 	Action     Action         `yaml:"action"`
+	// Priority orders the policies matching a same volume: the policy of the highest priority is
+	// applied, the first one in the list among policies of a same priority. Optional, 0 by default.
+	Priority int `yaml:"priority,omitempty"`
 }
 
 // resourcePolicies currently defined slice of volume policies to handle backup
 type ResourcePolicies struct {
 	Version        string         `yaml:"version"`
 	VolumePolicies []VolumePolicy `yaml:"volumePolicies"`
+	// ReportConflicts records the volumes several volume policies match, see Policies.Conflicts.
+	ReportConflicts bool `yaml:"reportConflicts,omitempty"`
+	// Priority orders the documents of a ConfigMap holding several ones: the policies of the
+	// documents of higher priority apply first. Only supported by version v2, 0 by default.
+	Priority int `yaml:"priority,omitempty"`
 	// we may support other resource policies in the future, and they could be added separately
 	// OtherResourcePolicies []OtherResourcePolicy
+
+	// document is the name of the document of the ConfigMap the policies are loaded from, when
+	// it holds several ones.
+	document string
 }
 
 type Policies struct {
 	version        string
 	volumePolicies []volPolicy
 	// OtherPolicies
+
+	reportConflicts bool
+	conflictsLock   sync.Mutex
+	conflicts       map[string]Conflict
+
+	hitsLock sync.Mutex
+	// matchedVolumes and appliedVolumes are the volumes each volume policy matched and was
+	// applied to.
+	matchedVolumes map[*volPolicy]map[string]bool
+	appliedVolumes map[*volPolicy]map[string]bool
+}
+
+// Conflict is a volume several volume policies matched.
+type Conflict struct {
+	// Volume is the namespace and name of the PVC of the volume, else the name of its PV or pod volume.
+	Volume string
+	// Matched are the positions in the volume policies of the policies the volume matched, the
+	// applied one first.
+	Matched []int
+	// Documents are the documents the matched policies are defined in, when the ConfigMap holds
+	// several ones.
+	Documents []string
+}
+
+// Policies returns the names the matched policies are reported as, the applied one first.
+func (c Conflict) Policies() []string {
+	policies := make([]string, len(c.Matched))
+	for i, index := range c.Matched {
+		document := ""
+		if i < len(c.Documents) {
+			document = c.Documents[i]
+		}
+		policies[i] = policyName(document, index)
+	}
+	return policies
+}
+
+func (c Conflict) String() string {
+	matched := c.Policies()
+	return fmt.Sprintf("the volume %s matched the policies %s, %s was applied", c.Volume, strings.Join(matched, ", "), matched[0])
+}
+
+// Hit is the number of volumes a volume policy matched.
+type Hit struct {
+	// Policy is the position of the policy in the volume policies, prefixed with the name of its
+	// document when the ConfigMap holds several ones.
+	Policy string
+	Action VolumeActionType
+	// Matched is the number of volumes the policy matched, and Applied the number of the ones it
+	// matched first, its action was applied to.
+	Matched int
+	Applied int
+}
+
+// policyName returns the name the volume policy at the index of the document is reported as.
+func policyName(document string, index int) string {
+	if document == "" {
+		return fmt.Sprintf("volumePolicies[%d]", index)
+	}
+	return fmt.Sprintf("%s volumePolicies[%d]", document, index)
 }
 
 func unmarshalResourcePolicies(yamlData *string) (*ResourcePolicies, error) {
@@ -77,20 +164,42 @@ func unmarshalResourcePolicies(yamlData *string) (*ResourcePolicies, error) {
 		return nil, fmt.Errorf("failed to decode yaml data into resource policies  %v", err)
 	}
 
+	if err := validateConditionMaps(resPolicies); err != nil {
+		return nil, err
+	}
+	return resPolicies, nil
+}
+
+// validateConditionMaps checks the conditions matching labels and annotations are maps.
+func validateConditionMaps(resPolicies *ResourcePolicies) error {
 	for _, vp := range resPolicies.VolumePolicies {
 		if raw, ok := vp.Conditions["pvcLabels"]; ok {
 			switch raw.(type) {
 			case map[string]any, map[string]string:
 			default:
-				return nil, fmt.Errorf("pvcLabels must be a map of string to string, got %T", raw)
+				return fmt.Errorf("pvcLabels must be a map of string to string, got %T", raw)
+			}
+		}
+		if raw, ok := vp.Conditions["pvcAnnotations"]; ok {
+			switch raw.(type) {
+			case map[string]any, map[string]string:
+			default:
+				return fmt.Errorf("pvcAnnotations must be a map of string to string, got %T", raw)
+			}
+		}
+		if raw, ok := vp.Conditions["podLabels"]; ok {
+			switch raw.(type) {
+			case map[string]any, map[string]string:
+			default:
+				return fmt.Errorf("podLabels must be a map of string to string, got %T", raw)
 			}
 		}
 	}
-	return resPolicies, nil
+	return nil
 }
 
 func (p *Policies) BuildPolicy(resPolicies *ResourcePolicies) error {
-	for _, vp := range resPolicies.VolumePolicies {
+	for i, vp := range resPolicies.VolumePolicies {
 		con, err := unmarshalVolConditions(vp.Conditions)
 		if err != nil {
 			return errors.WithStack(err)
@@ -99,27 +208,72 @@ func (p *Policies) BuildPolicy(resPolicies *ResourcePolicies) error {
 		if err != nil {
 			return errors.WithStack(err)
 		}
+		storageClassCon, err := newStorageClassCondition(con.StorageClass)
+		if err != nil {
+			return errors.WithStack(err)
+		}
 		var volP volPolicy
 		volP.action = vp.Action
+		volP.index = i
+		volP.priority = vp.Priority
+		volP.document = resPolicies.document
+		volP.documentPriority = resPolicies.Priority
 		volP.conditions = append(volP.conditions, &capacityCondition{capacity: *volCap})
-		volP.conditions = append(volP.conditions, &storageClassCondition{storageClass: con.StorageClass})
+		volP.conditions = append(volP.conditions, storageClassCon)
 		volP.conditions = append(volP.conditions, &nfsCondition{nfs: con.NFS})
 		volP.conditions = append(volP.conditions, &csiCondition{csi: con.CSI})
 		volP.conditions = append(volP.conditions, &volumeTypeCondition{volumeTypes: con.VolumeTypes})
 		if len(con.PVCLabels) > 0 {
 			volP.conditions = append(volP.conditions, &pvcLabelsCondition{labels: con.PVCLabels})
 		}
+		if len(con.PVCAnnotations) > 0 {
+			volP.conditions = append(volP.conditions, &pvcAnnotationsCondition{annotations: con.PVCAnnotations})
+		}
+		if len(con.AccessModes) > 0 {
+			volP.conditions = append(volP.conditions, &accessModesCondition{accessModes: con.AccessModes})
+		}
+		if con.VolumeMode != "" {
+			volP.conditions = append(volP.conditions, &volumeModeCondition{volumeMode: con.VolumeMode})
+		}
+		if len(con.PodLabels) > 0 {
+			volP.conditions = append(volP.conditions, &podLabelsCondition{labels: con.PodLabels})
+		}
+		if len(con.WorkloadKinds) > 0 {
+			volP.conditions = append(volP.conditions, &workloadKindsCondition{kinds: con.WorkloadKinds})
+		}
 		p.volumePolicies = append(p.volumePolicies, volP)
 	}
 
+	// the first policy of the highest priority of the document of the highest priority matching a
+	// volume is applied
+	sort.SliceStable(p.volumePolicies, func(i, j int) bool {
+		if p.volumePolicies[i].documentPriority != p.volumePolicies[j].documentPriority {
+			return p.volumePolicies[i].documentPriority > p.volumePolicies[j].documentPriority
+		}
+		return p.volumePolicies[i].priority > p.volumePolicies[j].priority
+	})
+
 	// Other resource policies
 
 	p.version = resPolicies.Version
+	p.reportConflicts = p.reportConflicts || resPolicies.ReportConflicts
 	return nil
 }
 
 func (p *Policies) match(res *structuredVolume) *Action {
-	for _, policy := range p.volumePolicies {
+	matched := p.matchAll(res, true)
+	if len(matched) == 0 {
+		return nil
+	}
+	return &matched[0].action
+}
+
+// matchAll returns the policies matching the volume in the order they apply, only the first one
+// if firstOnly is set.
+func (p *Policies) matchAll(res *structuredVolume, firstOnly bool) []*volPolicy {
+	var matched []*volPolicy
+	for i := range p.volumePolicies {
+		policy := &p.volumePolicies[i]
 		isAllMatch := false
 		for _, con := range policy.conditions {
 			if !con.match(res) {
@@ -129,10 +283,108 @@ func (p *Policies) match(res *structuredVolume) *Action {
 			isAllMatch = true
 		}
 		if isAllMatch {
-			return &policy.action
+			matched = append(matched, policy)
+			if firstOnly {
+				break
+			}
 		}
 	}
-	return nil
+	return matched
+}
+
+// Hits returns the numbers of volumes each volume policy matched, in the order the policies
+// apply, including the policies which matched no volume.
+func (p *Policies) Hits() []Hit {
+	p.hitsLock.Lock()
+	defer p.hitsLock.Unlock()
+
+	hits := make([]Hit, len(p.volumePolicies))
+	for i := range p.volumePolicies {
+		policy := &p.volumePolicies[i]
+		hits[i] = Hit{
+			Policy:  policyName(policy.document, policy.index),
+			Action:  policy.action.Type,
+			Matched: len(p.matchedVolumes[policy]),
+			Applied: len(p.appliedVolumes[policy]),
+		}
+	}
+	return hits
+}
+
+// recordHits records the volume as matched by the policies, and applied the first one. A volume
+// is only counted once per policy however many times its action is looked up.
+func (p *Policies) recordHits(data VolumeFilterData, matched []*volPolicy) {
+	volume := volumeName(data)
+
+	p.hitsLock.Lock()
+	defer p.hitsLock.Unlock()
+	if p.matchedVolumes == nil {
+		p.matchedVolumes = make(map[*volPolicy]map[string]bool)
+		p.appliedVolumes = make(map[*volPolicy]map[string]bool)
+	}
+	for i, policy := range matched {
+		if p.matchedVolumes[policy] == nil {
+			p.matchedVolumes[policy] = make(map[string]bool)
+		}
+		p.matchedVolumes[policy][volume] = true
+		if i == 0 {
+			if p.appliedVolumes[policy] == nil {
+				p.appliedVolumes[policy] = make(map[string]bool)
+			}
+			p.appliedVolumes[policy][volume] = true
+		}
+	}
+}
+
+// Conflicts returns the volumes several volume policies matched, sorted by volume. They're only
+// recorded when ReportConflicts is set.
+func (p *Policies) Conflicts() []Conflict {
+	p.conflictsLock.Lock()
+	defer p.conflictsLock.Unlock()
+
+	conflicts := make([]Conflict, 0, len(p.conflicts))
+	for _, conflict := range p.conflicts {
+		conflicts = append(conflicts, conflict)
+	}
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].Volume < conflicts[j].Volume
+	})
+	return conflicts
+}
+
+// volumeName returns the namespace and name of the PVC of the volume, else the name of its PV, else
+// the namespace and name of its pod followed by the name of the pod volume, the pod volumes of
+// different pods sharing their names.
+func volumeName(data VolumeFilterData) string {
+	switch {
+	case data.PVC != nil:
+		return data.PVC.Namespace + "/" + data.PVC.Name
+	case data.PersistentVolume != nil:
+		return data.PersistentVolume.Name
+	case data.Pod != nil:
+		return data.Pod.Namespace + "/" + data.Pod.Name + "/" + data.PodVolume.Name
+	default:
+		return data.PodVolume.Name
+	}
+}
+
+func (p *Policies) recordConflict(data VolumeFilterData, matched []*volPolicy) {
+	volume := volumeName(data)
+
+	conflict := Conflict{Volume: volume}
+	for _, policy := range matched {
+		conflict.Matched = append(conflict.Matched, policy.index)
+		if policy.document != "" {
+			conflict.Documents = append(conflict.Documents, policy.document)
+		}
+	}
+
+	p.conflictsLock.Lock()
+	defer p.conflictsLock.Unlock()
+	if p.conflicts == nil {
+		p.conflicts = make(map[string]Conflict)
+	}
+	p.conflicts[volume] = conflict
 }
 
 func (p *Policies) GetMatchAction(res any) (*Action, error) {
@@ -156,13 +408,24 @@ func (p *Policies) GetMatchAction(res any) (*Action, error) {
 	default:
 		return nil, errors.New("failed to convert object")
 	}
+	if data.Pod != nil {
+		volume.parsePod(data.Pod)
+	}
 
-	return p.match(volume), nil
+	matched := p.matchAll(volume, false)
+	p.recordHits(data, matched)
+	if len(matched) == 0 {
+		return nil, nil
+	}
+	if p.reportConflicts && len(matched) > 1 {
+		p.recordConflict(data, matched)
+	}
+	return &matched[0].action, nil
 }
 
 func (p *Policies) Validate() error {
-	if p.version != currentSupportDataVersion {
-		return fmt.Errorf("incompatible version number %s with supported version %s", p.version, currentSupportDataVersion)
+	if p.version != currentSupportDataVersion && p.version != legacyDataVersion {
+		return fmt.Errorf("incompatible version number %s with supported versions %s and %s", p.version, legacyDataVersion, currentSupportDataVersion)
 	}
 
 	for _, policy := range p.volumePolicies {
@@ -183,6 +446,26 @@ func GetResourcePoliciesFromBackup(
 	client crclient.Client,
 	logger logrus.FieldLogger,
 ) (resourcePolicies *Policies, err error) {
+	if backup.Spec.InlineResourcePolicy != "" {
+		if backup.Spec.ResourcePolicy != nil {
+			return nil, fmt.Errorf("the inline ResourcePolicies of backup %s can't be set together with the ResourcePolicies %s",
+				backup.Namespace+"/"+backup.Name, backup.Spec.ResourcePolicy.Name)
+		}
+		resourcePolicies, err = getResourcePoliciesFromData(map[string]string{inlineResourcePoliciesKey: backup.Spec.InlineResourcePolicy})
+		if err != nil {
+			logger.Errorf("Fail to read the inline ResourcePolicies of backup %s with error %s.",
+				backup.Namespace+"/"+backup.Name, err.Error())
+			return nil, fmt.Errorf("fail to read the inline ResourcePolicies of backup %s with error %s",
+				backup.Namespace+"/"+backup.Name, err.Error())
+		} else if err = resourcePolicies.Validate(); err != nil {
+			logger.Errorf("Fail to validate the inline ResourcePolicies of backup %s with error %s.",
+				backup.Namespace+"/"+backup.Name, err.Error())
+			return nil, fmt.Errorf("fail to validate the inline ResourcePolicies of backup %s with error %s",
+				backup.Namespace+"/"+backup.Name, err.Error())
+		}
+		return resourcePolicies, nil
+	}
+
 	if backup.Spec.ResourcePolicy != nil &&
 		strings.EqualFold(backup.Spec.ResourcePolicy.Kind, ConfigmapRefType) {
 		policiesConfigMap := &v1.ConfigMap{}
@@ -218,24 +501,102 @@ func getResourcePoliciesFromConfig(cm *v1.ConfigMap) (*Policies, error) {
 	if cm == nil {
 		return nil, fmt.Errorf("could not parse config from nil configmap")
 	}
-	if len(cm.Data) != 1 {
+	if len(cm.Data) == 0 {
 		return nil, fmt.Errorf("illegal resource policies %s/%s configmap", cm.Namespace, cm.Name)
 	}
+	return getResourcePoliciesFromData(cm.Data)
+}
+
+// getResourcePoliciesFromData returns the resource policies of the documents of the values of
+// data, named after their keys.
+func getResourcePoliciesFromData(data map[string]string) (*Policies, error) {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
 
-	var yamlData string
-	for _, v := range cm.Data {
-		yamlData = v
+	var documents []*yaml.Node
+	var names []string
+	for _, key := range keys {
+		dec := yaml.NewDecoder(strings.NewReader(data[key]))
+		for n := 1; ; n++ {
+			document := new(yaml.Node)
+			if err := dec.Decode(document); err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, fmt.Errorf("failed to decode yaml data of key %s into resource policies: %v", key, err)
+			}
+			documents = append(documents, document)
+			names = append(names, fmt.Sprintf("%s#%d", key, n))
+		}
+	}
+
+	// the single document ConfigMaps of version v1 are loaded as they've always been
+	if len(documents) == 1 && documentVersion(documents[0]) != currentSupportDataVersion {
+		yamlData := data[keys[0]]
+		resPolicies, err := unmarshalResourcePolicies(&yamlData)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		policies := &Policies{}
+		if err := policies.BuildPolicy(resPolicies); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return policies, nil
 	}
 
-	resPolicies, err := unmarshalResourcePolicies(&yamlData)
+	schema, err := loadResourcePoliciesV2Schema()
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return nil, err
 	}
 
 	policies := &Policies{}
-	if err := policies.BuildPolicy(resPolicies); err != nil {
-		return nil, errors.WithStack(err)
+	for i, document := range documents {
+		if version := documentVersion(document); version != currentSupportDataVersion {
+			return nil, fmt.Errorf("document %s of version %q: several resource policies documents are only supported by version %s",
+				names[i], version, currentSupportDataVersion)
+		}
+		if schemaErrs := validateSchema(document, schema, "$"); len(schemaErrs) > 0 {
+			messages := make([]string, len(schemaErrs))
+			for j, schemaErr := range schemaErrs {
+				messages[j] = schemaErr.Error()
+			}
+			return nil, fmt.Errorf("document %s doesn't match the resource policies schema: %s", names[i], strings.Join(messages, "; "))
+		}
+
+		resPolicies := &ResourcePolicies{}
+		if err := document.Decode(resPolicies); err != nil {
+			return nil, fmt.Errorf("failed to decode document %s into resource policies: %v", names[i], err)
+		}
+		if err := validateConditionMaps(resPolicies); err != nil {
+			return nil, fmt.Errorf("document %s: %v", names[i], err)
+		}
+		if len(documents) > 1 {
+			resPolicies.document = names[i]
+		}
+
+		if err := policies.BuildPolicy(resPolicies); err != nil {
+			return nil, errors.WithStack(err)
+		}
 	}
 
 	return policies, nil
 }
+
+// documentVersion returns the version of a resource policies document, empty if it has none.
+func documentVersion(document *yaml.Node) string {
+	if document.Kind == yaml.DocumentNode && len(document.Content) > 0 {
+		document = document.Content[0]
+	}
+	if document.Kind != yaml.MappingNode {
+		return ""
+	}
+	for i := 0; i+1 < len(document.Content); i += 2 {
+		if document.Content[i].Value == "version" {
+			return document.Content[i+1].Value
+		}
+	}
+	return ""
+}
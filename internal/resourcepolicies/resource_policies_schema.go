@@ -0,0 +1,187 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package resourcepolicies
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// resourcePoliciesV2Schema is the JSON Schema the v2 resource policies documents are validated
+// against when they're loaded.
+//
+//go:embed resource_policies_v2.schema.json
+var resourcePoliciesV2Schema []byte
+
+// jsonSchema is the subset of JSON Schema the resource policies schema is written with.
+type jsonSchema struct {
+	Type                 string                 `json:"type,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	AdditionalProperties *additionalProperties  `json:"additionalProperties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	Enum                 []string               `json:"enum,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+}
+
+// additionalProperties is either a boolean allowing the properties not listed, or the schema of
+// their values.
+type additionalProperties struct {
+	allowed bool
+	schema  *jsonSchema
+}
+
+func (a *additionalProperties) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &a.allowed); err == nil {
+		return nil
+	}
+	a.allowed = true
+	a.schema = new(jsonSchema)
+	return json.Unmarshal(data, a.schema)
+}
+
+// SchemaError is a violation of the schema of the resource policies, at a position of the
+// document.
+type SchemaError struct {
+	Line    int
+	Column  int
+	Path    string
+	Message string
+}
+
+func (e SchemaError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s: %s", e.Line, e.Column, e.Path, e.Message)
+}
+
+func loadResourcePoliciesV2Schema() (*jsonSchema, error) {
+	schema := new(jsonSchema)
+	if err := json.Unmarshal(resourcePoliciesV2Schema, schema); err != nil {
+		return nil, fmt.Errorf("failed to load the resource policies schema: %v", err)
+	}
+	return schema, nil
+}
+
+// validateSchema returns the violations of the schema by the YAML document, in the order of the
+// document.
+func validateSchema(node *yaml.Node, schema *jsonSchema, path string) []SchemaError {
+	if node.Kind == yaml.DocumentNode {
+		if len(node.Content) == 0 {
+			return []SchemaError{{Line: node.Line, Column: node.Column, Path: path, Message: "empty document"}}
+		}
+		return validateSchema(node.Content[0], schema, path)
+	}
+	if node.Kind == yaml.AliasNode {
+		return validateSchema(node.Alias, schema, path)
+	}
+
+	violation := func(n *yaml.Node, format string, args ...any) SchemaError {
+		return SchemaError{Line: n.Line, Column: n.Column, Path: path, Message: fmt.Sprintf(format, args...)}
+	}
+
+	if schema.Type != "" && !matchesType(node, schema.Type) {
+		return []SchemaError{violation(node, "expected %s, got %s", schema.Type, describeNode(node))}
+	}
+
+	var errs []SchemaError
+	switch node.Kind {
+	case yaml.MappingNode:
+		present := make(map[string]bool)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			present[key.Value] = true
+
+			property, listed := schema.Properties[key.Value]
+			if !listed && schema.AdditionalProperties != nil {
+				if !schema.AdditionalProperties.allowed {
+					errs = append(errs, SchemaError{
+						Line:    key.Line,
+						Column:  key.Column,
+						Path:    path,
+						Message: fmt.Sprintf("unknown field %q%s", key.Value, suggestField(key.Value, schema.Properties)),
+					})
+					continue
+				}
+				property = schema.AdditionalProperties.schema
+			}
+			if property != nil {
+				errs = append(errs, validateSchema(value, property, path+"."+key.Value)...)
+			}
+		}
+		for _, required := range schema.Required {
+			if !present[required] {
+				errs = append(errs, violation(node, "missing required field %q", required))
+			}
+		}
+	case yaml.SequenceNode:
+		if schema.Items != nil {
+			for i, item := range node.Content {
+				errs = append(errs, validateSchema(item, schema.Items, fmt.Sprintf("%s[%d]", path, i))...)
+			}
+		}
+	case yaml.ScalarNode:
+		if len(schema.Enum) > 0 && !slices.Contains(schema.Enum, node.Value) {
+			errs = append(errs, violation(node, "unsupported value %q, must be one of %s", node.Value, strings.Join(schema.Enum, ", ")))
+		}
+	}
+	return errs
+}
+
+// matchesType returns whether the YAML node is of the JSON Schema type. As YAML decodes any
+// scalar into a string, the non-null scalars are strings.
+func matchesType(node *yaml.Node, schemaType string) bool {
+	switch schemaType {
+	case "object":
+		return node.Kind == yaml.MappingNode
+	case "array":
+		return node.Kind == yaml.SequenceNode
+	case "string":
+		return node.Kind == yaml.ScalarNode && node.Tag != "!!null"
+	case "integer":
+		return node.Kind == yaml.ScalarNode && node.Tag == "!!int"
+	case "boolean":
+		return node.Kind == yaml.ScalarNode && node.Tag == "!!bool"
+	}
+	return true
+}
+
+func describeNode(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.MappingNode:
+		return "a map"
+	case yaml.SequenceNode:
+		return "a list"
+	}
+	return fmt.Sprintf("%q", node.Value)
+}
+
+// suggestField returns a hint to the listed property the unknown field is a case or plural typo
+// of, if any.
+func suggestField(field string, properties map[string]*jsonSchema) string {
+	field = strings.ToLower(field)
+	for property := range properties {
+		lower := strings.ToLower(property)
+		for _, suffix := range []string{"", "s", "es"} {
+			if field == lower+suffix || lower == field+suffix {
+				return fmt.Sprintf(", did you mean %q?", property)
+			}
+		}
+	}
+	return ""
+}
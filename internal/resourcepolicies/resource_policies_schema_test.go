@@ -0,0 +1,161 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package resourcepolicies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetResourcePoliciesFromConfigV2(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        map[string]string
+		expectedErr string
+	}{
+		{
+			name: "valid document",
+			data: map[string]string{"policies": `version: v2
+volumePolicies:
+  - conditions:
+      storageClass:
+        - gp2
+    action:
+      type: skip
+`},
+		},
+		{
+			name: "typo in a condition",
+			data: map[string]string{"policies": `version: v2
+volumePolicies:
+  - conditions:
+      storageClasses:
+        - gp2
+    action:
+      type: skip
+`},
+			expectedErr: `document policies#1 doesn't match the resource policies schema: line 4, column 7: $.volumePolicies[0].conditions: unknown field "storageClasses", did you mean "storageClass"?`,
+		},
+		{
+			name: "unsupported action",
+			data: map[string]string{"policies": `version: v2
+volumePolicies:
+  - conditions:
+      capacity: "0,100Gi"
+    action:
+      type: snapshots
+`},
+			expectedErr: `document policies#1 doesn't match the resource policies schema: line 6, column 13: $.volumePolicies[0].action.type: unsupported value "snapshots", must be one of skip, snapshot, fs-backup`,
+		},
+		{
+			name: "wrong type and missing action",
+			data: map[string]string{"policies": `version: v2
+volumePolicies:
+  - conditions:
+      storageClass: gp2
+`},
+			expectedErr: `document policies#1 doesn't match the resource policies schema: line 4, column 21: $.volumePolicies[0].conditions.storageClass: expected array, got "gp2"; line 3, column 5: $.volumePolicies[0]: missing required field "action"`,
+		},
+		{
+			name: "several documents of version v1",
+			data: map[string]string{"policies": `version: v1
+volumePolicies: []
+---
+version: v1
+volumePolicies: []
+`},
+			expectedErr: `document policies#1 of version "v1": several resource policies documents are only supported by version v2`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := getResourcePoliciesFromConfig(&v1.ConfigMap{Data: tc.data})
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestGetResourcePoliciesFromConfigV2Documents(t *testing.T) {
+	cm := &v1.ConfigMap{
+		Data: map[string]string{
+			"defaults": `version: v2
+reportConflicts: true
+volumePolicies:
+  - conditions:
+      capacity: "0,100Gi"
+    action:
+      type: fs-backup
+`,
+			"overrides": `version: v2
+priority: 10
+volumePolicies:
+  - conditions:
+      storageClass:
+        - gp2
+    action:
+      type: skip
+---
+version: v2
+priority: 20
+volumePolicies:
+  - conditions:
+      csi:
+        driver: ebs.csi.aws.com
+    action:
+      type: snapshot
+`,
+		},
+	}
+
+	policies, err := getResourcePoliciesFromConfig(cm)
+	require.NoError(t, err)
+	require.NoError(t, policies.Validate())
+
+	pv := &v1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "pv-1"},
+		Spec: v1.PersistentVolumeSpec{
+			StorageClassName:       "gp2",
+			Capacity:               v1.ResourceList{v1.ResourceStorage: resource.MustParse("10Gi")},
+			PersistentVolumeSource: v1.PersistentVolumeSource{CSI: &v1.CSIPersistentVolumeSource{Driver: "ebs.csi.aws.com"}},
+		},
+	}
+
+	// the policies of the document of the highest priority apply first
+	action, err := policies.GetMatchAction(NewVolumeFilterData(pv, nil, nil))
+	require.NoError(t, err)
+	require.NotNil(t, action)
+	assert.Equal(t, Snapshot, action.Type)
+
+	conflicts := policies.Conflicts()
+	assert.Equal(t, []Conflict{
+		{
+			Volume:    "pv-1",
+			Matched:   []int{0, 0, 0},
+			Documents: []string{"overrides#2", "overrides#1", "defaults#1"},
+		},
+	}, conflicts)
+	assert.Equal(t, "the volume pv-1 matched the policies overrides#2 volumePolicies[0], overrides#1 volumePolicies[0], defaults#1 volumePolicies[0], overrides#2 volumePolicies[0] was applied", conflicts[0].String())
+}
@@ -18,10 +18,15 @@ package resourcepolicies
 import (
 	"testing"
 
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 )
 
 func TestLoadResourcePolicies(t *testing.T) {
@@ -141,6 +146,29 @@ volumePolicies:
       pvcLabels: "production"
     action:
       type: skip
+`,
+			wantErr: true,
+		},
+		{
+			name: "supported format pvcAnnotations",
+			yamlData: `version: v1
+volumePolicies:
+  - conditions:
+      pvcAnnotations:
+        example.com/scratch: "true"
+    action:
+      type: skip
+`,
+			wantErr: false,
+		},
+		{
+			name: "error format of pvcAnnotations (not a map)",
+			yamlData: `version: v1
+volumePolicies:
+  - conditions:
+      pvcAnnotations: "scratch"
+    action:
+      type: skip
 `,
 			wantErr: true,
 		},
@@ -414,6 +442,38 @@ volumePolicies:
 	assert.Equal(t, p, resPolicies)
 }
 
+func TestGetResourcePoliciesFromBackupInline(t *testing.T) {
+	backup := velerov1api.Backup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "backup-1"},
+		Spec: velerov1api.BackupSpec{
+			InlineResourcePolicy: `version: v1
+volumePolicies:
+  - conditions:
+      storageClass:
+        - gp2
+    action:
+      type: skip
+`,
+		},
+	}
+
+	policies, err := GetResourcePoliciesFromBackup(backup, nil, logrus.New())
+	require.NoError(t, err)
+	action, err := policies.GetMatchAction(NewVolumeFilterData(&v1.PersistentVolume{Spec: v1.PersistentVolumeSpec{StorageClassName: "gp2"}}, nil, nil))
+	require.NoError(t, err)
+	require.NotNil(t, action)
+	assert.Equal(t, Skip, action.Type)
+
+	backup.Spec.InlineResourcePolicy = "version: v2\nvolumePolicies: {}\n"
+	_, err = GetResourcePoliciesFromBackup(backup, nil, logrus.New())
+	assert.ErrorContains(t, err, "fail to read the inline ResourcePolicies of backup velero/backup-1")
+	assert.ErrorContains(t, err, "document inline#1 doesn't match the resource policies schema")
+
+	backup.Spec.ResourcePolicy = &v1.TypedLocalObjectReference{Kind: ConfigmapRefType, Name: "policies"}
+	_, err = GetResourcePoliciesFromBackup(backup, nil, logrus.New())
+	assert.EqualError(t, err, "the inline ResourcePolicies of backup velero/backup-1 can't be set together with the ResourcePolicies policies")
+}
+
 func TestGetMatchAction(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -807,6 +867,72 @@ volumePolicies:
 			},
 			skip: true,
 		},
+		{
+			name: "PVC annotations match",
+			yamlData: `version: v1
+volumePolicies:
+- conditions:
+    pvcAnnotations:
+      example.com/scratch: "true"
+  action:
+    type: skip`,
+			vol: &v1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "pv-1",
+				},
+				Spec: v1.PersistentVolumeSpec{
+					Capacity: v1.ResourceList{
+						v1.ResourceStorage: resource.MustParse("1Gi"),
+					},
+					PersistentVolumeSource: v1.PersistentVolumeSource{},
+					ClaimRef: &v1.ObjectReference{
+						Namespace: "default",
+						Name:      "pvc-1",
+					},
+				},
+			},
+			pvc: &v1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "default",
+					Name:        "pvc-1",
+					Annotations: map[string]string{"example.com/scratch": "true", "owner": "ci"},
+				},
+			},
+			skip: true,
+		},
+		{
+			name: "PVC annotations mismatch",
+			yamlData: `version: v1
+volumePolicies:
+- conditions:
+    pvcAnnotations:
+      example.com/scratch: "true"
+  action:
+    type: skip`,
+			vol: &v1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "pv-1",
+				},
+				Spec: v1.PersistentVolumeSpec{
+					Capacity: v1.ResourceList{
+						v1.ResourceStorage: resource.MustParse("1Gi"),
+					},
+					PersistentVolumeSource: v1.PersistentVolumeSource{},
+					ClaimRef: &v1.ObjectReference{
+						Namespace: "default",
+						Name:      "pvc-1",
+					},
+				},
+			},
+			pvc: &v1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "pvc-1",
+					Labels:    map[string]string{"example.com/scratch": "true"},
+				},
+			},
+			skip: false,
+		},
 		{
 			name: "PVC labels match don't match exactly",
 			yamlData: `version: v1
@@ -1073,3 +1199,274 @@ func TestParsePVC(t *testing.T) {
 		})
 	}
 }
+
+func TestActionSnapshotClass(t *testing.T) {
+	yamlData := `version: v1
+volumePolicies:
+  - conditions:
+      storageClass:
+        - gold-sc
+    action:
+      type: snapshot
+      parameters:
+        snapshotClass: gold-vsc
+  - conditions:
+      storageClass:
+        - silver-sc
+    action:
+      type: snapshot
+`
+	resPolicies, err := unmarshalResourcePolicies(&yamlData)
+	require.NoError(t, err)
+	policies := &Policies{}
+	require.NoError(t, policies.BuildPolicy(resPolicies))
+	require.NoError(t, policies.Validate())
+
+	assert.Equal(t, "gold-vsc", policies.volumePolicies[0].action.SnapshotClass())
+	assert.Empty(t, policies.volumePolicies[1].action.SnapshotClass())
+}
+
+func TestGetMatchActionPriorityAndConflicts(t *testing.T) {
+	yamlData := `version: v1
+reportConflicts: true
+volumePolicies:
+  - conditions:
+      storageClass:
+        - gp2
+    action:
+      type: skip
+  - conditions:
+      csi:
+        driver: ebs.csi.aws.com
+    action:
+      type: snapshot
+    priority: 10
+  - conditions:
+      capacity: "0,100Gi"
+    action:
+      type: fs-backup
+`
+	resPolicies, err := unmarshalResourcePolicies(&yamlData)
+	require.NoError(t, err)
+	policies := &Policies{}
+	require.NoError(t, policies.BuildPolicy(resPolicies))
+	require.NoError(t, policies.Validate())
+
+	pv := &v1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "pv-1"},
+		Spec: v1.PersistentVolumeSpec{
+			StorageClassName:       "gp2",
+			Capacity:               v1.ResourceList{v1.ResourceStorage: resource.MustParse("10Gi")},
+			PersistentVolumeSource: v1.PersistentVolumeSource{CSI: &v1.CSIPersistentVolumeSource{Driver: "ebs.csi.aws.com"}},
+		},
+	}
+	pvc := &v1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "data"}}
+
+	// the policy of the highest priority is applied
+	action, err := policies.GetMatchAction(NewVolumeFilterData(pv, nil, pvc))
+	require.NoError(t, err)
+	require.NotNil(t, action)
+	assert.Equal(t, Snapshot, action.Type)
+
+	// the policies of a same priority apply in the order they're listed
+	otherPV := pv.DeepCopy()
+	otherPV.Name = "pv-2"
+	otherPV.Spec.CSI = nil
+	action, err = policies.GetMatchAction(NewVolumeFilterData(otherPV, nil, nil))
+	require.NoError(t, err)
+	require.NotNil(t, action)
+	assert.Equal(t, Skip, action.Type)
+
+	conflicts := policies.Conflicts()
+	assert.Equal(t, []Conflict{
+		{Volume: "ns/data", Matched: []int{1, 0, 2}},
+		{Volume: "pv-2", Matched: []int{0, 2}},
+	}, conflicts)
+	assert.Equal(t, "the volume ns/data matched the policies volumePolicies[1], volumePolicies[0], volumePolicies[2], volumePolicies[1] was applied", conflicts[0].String())
+}
+
+func TestGetMatchActionWithoutConflictReporting(t *testing.T) {
+	yamlData := `version: v1
+volumePolicies:
+  - conditions:
+      storageClass:
+        - gp2
+    action:
+      type: skip
+  - conditions:
+      storageClass:
+        - gp2
+    action:
+      type: snapshot
+`
+	resPolicies, err := unmarshalResourcePolicies(&yamlData)
+	require.NoError(t, err)
+	policies := &Policies{}
+	require.NoError(t, policies.BuildPolicy(resPolicies))
+
+	pv := &v1.PersistentVolume{Spec: v1.PersistentVolumeSpec{StorageClassName: "gp2"}}
+	action, err := policies.GetMatchAction(NewVolumeFilterData(pv, nil, nil))
+	require.NoError(t, err)
+	require.NotNil(t, action)
+	assert.Equal(t, Skip, action.Type)
+	assert.Empty(t, policies.Conflicts())
+}
+
+func TestGetMatchActionHits(t *testing.T) {
+	yamlData := `version: v1
+volumePolicies:
+  - conditions:
+      storageClass:
+        - gp2
+    action:
+      type: skip
+  - conditions:
+      capacity: "0,100Gi"
+    action:
+      type: fs-backup
+  - conditions:
+      nfs: {}
+    action:
+      type: skip
+`
+	resPolicies, err := unmarshalResourcePolicies(&yamlData)
+	require.NoError(t, err)
+	policies := &Policies{}
+	require.NoError(t, policies.BuildPolicy(resPolicies))
+
+	gp2 := &v1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "pv-1"},
+		Spec: v1.PersistentVolumeSpec{
+			StorageClassName: "gp2",
+			Capacity:         v1.ResourceList{v1.ResourceStorage: resource.MustParse("10Gi")},
+		},
+	}
+	gp3 := gp2.DeepCopy()
+	gp3.Name = "pv-2"
+	gp3.Spec.StorageClassName = "gp3"
+
+	// a volume is counted once however many times its action is looked up
+	for _, pv := range []*v1.PersistentVolume{gp2, gp2, gp3} {
+		_, err := policies.GetMatchAction(NewVolumeFilterData(pv, nil, nil))
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []Hit{
+		{Policy: "volumePolicies[0]", Action: Skip, Matched: 1, Applied: 1},
+		{Policy: "volumePolicies[1]", Action: FSBackup, Matched: 2, Applied: 1},
+		{Policy: "volumePolicies[2]", Action: Skip, Matched: 0, Applied: 0},
+	}, policies.Hits())
+	// the conflicts are still only recorded when reportConflicts is set
+	assert.Empty(t, policies.Conflicts())
+}
+
+func TestGetMatchActionHitsOfPodVolumes(t *testing.T) {
+	yamlData := `version: v1
+volumePolicies:
+  - conditions:
+      volumeTypes:
+        - emptyDir
+    action:
+      type: skip
+`
+	resPolicies, err := unmarshalResourcePolicies(&yamlData)
+	require.NoError(t, err)
+	policies := &Policies{}
+	require.NoError(t, policies.BuildPolicy(resPolicies))
+
+	// the volumes of the pods share their name
+	volume := &v1.Volume{Name: "cache", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}}
+	for _, pod := range []*v1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "pod-1"}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "pod-2"}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-2", Name: "pod-1"}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-2", Name: "pod-1"}},
+	} {
+		data := NewVolumeFilterData(nil, volume, nil)
+		data.Pod = pod
+		_, err := policies.GetMatchAction(data)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []Hit{
+		{Policy: "volumePolicies[0]", Action: Skip, Matched: 3, Applied: 3},
+	}, policies.Hits())
+}
+
+func TestGetMatchActionPodConditions(t *testing.T) {
+	yamlData := `version: v1
+volumePolicies:
+  - conditions:
+      workloadKinds:
+        - DaemonSet
+    action:
+      type: skip
+  - conditions:
+      podLabels:
+        app: db
+      workloadKinds:
+        - StatefulSet
+        - Deployment
+    action:
+      type: fs-backup
+`
+	resPolicies, err := unmarshalResourcePolicies(&yamlData)
+	require.NoError(t, err)
+	policies := &Policies{}
+	require.NoError(t, policies.BuildPolicy(resPolicies))
+	require.NoError(t, policies.Validate())
+
+	podOwnedBy := func(kind string, podLabels map[string]string) *v1.Pod {
+		return &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "ns",
+			Name:            "pod",
+			Labels:          podLabels,
+			OwnerReferences: []metav1.OwnerReference{{Kind: kind, Name: "owner", Controller: boolptr.True()}},
+		}}
+	}
+	volume := &v1.Volume{Name: "data", VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: "/data"}}}
+
+	tests := []struct {
+		name     string
+		pod      *v1.Pod
+		expected *Action
+	}{
+		{
+			name:     "the volumes of a DaemonSet are skipped",
+			pod:      podOwnedBy("DaemonSet", map[string]string{"app": "db"}),
+			expected: &Action{Type: Skip},
+		},
+		{
+			name:     "the volumes of a StatefulSet with the labels are backed up",
+			pod:      podOwnedBy("StatefulSet", map[string]string{"app": "db"}),
+			expected: &Action{Type: FSBackup},
+		},
+		{
+			name:     "the pods of a ReplicaSet with a pod-template-hash are owned by a Deployment",
+			pod:      podOwnedBy("ReplicaSet", map[string]string{"app": "db", "pod-template-hash": "5d4f8"}),
+			expected: &Action{Type: FSBackup},
+		},
+		{
+			name: "the volumes of a StatefulSet without the labels match no policy",
+			pod:  podOwnedBy("StatefulSet", map[string]string{"app": "web"}),
+		},
+		{
+			name: "the volumes of a pod owned by no workload match no policy",
+			pod:  &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "pod", Labels: map[string]string{"app": "db"}}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := NewVolumeFilterData(nil, volume, nil)
+			data.Pod = test.pod
+			action, err := policies.GetMatchAction(data)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, action)
+		})
+	}
+
+	// without the pod, the pod conditions don't match
+	action, err := policies.GetMatchAction(NewVolumeFilterData(nil, volume, nil))
+	require.NoError(t, err)
+	assert.Nil(t, action)
+}
@@ -9,6 +9,8 @@ type VolumeFilterData struct {
 	PersistentVolume *corev1.PersistentVolume
 	PodVolume        *corev1.Volume
 	PVC              *corev1.PersistentVolumeClaim
+	// Pod is the pod mounting the volume, if known, that the pod conditions are matched against.
+	Pod *corev1.Pod
 }
 
 // NewVolumeFilterData constructs a new VolumeFilterData instance.
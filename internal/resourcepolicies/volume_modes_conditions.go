@@ -0,0 +1,105 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcepolicies
+
+import (
+	"slices"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+)
+
+// accessModeAbbreviations maps the abbreviations of the access modes, as printed by kubectl, to the access modes
+var accessModeAbbreviations = map[string]corev1api.PersistentVolumeAccessMode{
+	"RWO":  corev1api.ReadWriteOnce,
+	"ROX":  corev1api.ReadOnlyMany,
+	"RWX":  corev1api.ReadWriteMany,
+	"RWOP": corev1api.ReadWriteOncePod,
+}
+
+// parseAccessMode returns the access mode of its name or of its abbreviation
+func parseAccessMode(mode string) (corev1api.PersistentVolumeAccessMode, error) {
+	if accessMode, ok := accessModeAbbreviations[mode]; ok {
+		return accessMode, nil
+	}
+
+	switch accessMode := corev1api.PersistentVolumeAccessMode(mode); accessMode {
+	case corev1api.ReadWriteOnce, corev1api.ReadOnlyMany, corev1api.ReadWriteMany, corev1api.ReadWriteOncePod:
+		return accessMode, nil
+	}
+	return "", errors.Errorf("invalid access mode %s", mode)
+}
+
+// accessModesCondition matches the volumes having any of the access modes
+type accessModesCondition struct {
+	accessModes []string
+}
+
+func (c *accessModesCondition) match(v *structuredVolume) bool {
+	if len(c.accessModes) == 0 {
+		return true
+	}
+
+	for _, mode := range c.accessModes {
+		accessMode, err := parseAccessMode(mode)
+		if err != nil {
+			continue
+		}
+		if slices.Contains(v.accessModes, accessMode) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *accessModesCondition) validate() error {
+	for _, mode := range c.accessModes {
+		if _, err := parseAccessMode(mode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// volumeModeCondition matches the volumes of the volume mode
+type volumeModeCondition struct {
+	volumeMode string
+}
+
+func (c *volumeModeCondition) match(v *structuredVolume) bool {
+	if c.volumeMode == "" {
+		return true
+	}
+
+	return v.volumeMode == corev1api.PersistentVolumeMode(c.volumeMode)
+}
+
+func (c *volumeModeCondition) validate() error {
+	switch corev1api.PersistentVolumeMode(c.volumeMode) {
+	case "", corev1api.PersistentVolumeFilesystem, corev1api.PersistentVolumeBlock:
+		return nil
+	}
+	return errors.Errorf("invalid volume mode %s, only %s and %s are supported", c.volumeMode, corev1api.PersistentVolumeFilesystem, corev1api.PersistentVolumeBlock)
+}
+
+// volumeModeOf returns the volume mode, which defaults to Filesystem when not set
+func volumeModeOf(mode *corev1api.PersistentVolumeMode) corev1api.PersistentVolumeMode {
+	if mode == nil {
+		return corev1api.PersistentVolumeFilesystem
+	}
+	return *mode
+}
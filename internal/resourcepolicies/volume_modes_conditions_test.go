@@ -0,0 +1,194 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcepolicies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+)
+
+func TestAccessModesCondition(t *testing.T) {
+	rwx := &structuredVolume{accessModes: []corev1api.PersistentVolumeAccessMode{corev1api.ReadWriteMany}}
+	rwo := &structuredVolume{accessModes: []corev1api.PersistentVolumeAccessMode{corev1api.ReadWriteOnce, corev1api.ReadOnlyMany}}
+
+	tests := []struct {
+		name        string
+		accessModes []string
+		volume      *structuredVolume
+		expected    bool
+		expectedErr string
+	}{
+		{
+			name:     "no access modes matches any volume",
+			volume:   rwo,
+			expected: true,
+		},
+		{
+			name:        "abbreviation matches",
+			accessModes: []string{"RWX"},
+			volume:      rwx,
+			expected:    true,
+		},
+		{
+			name:        "full name matches",
+			accessModes: []string{"ReadWriteMany"},
+			volume:      rwx,
+			expected:    true,
+		},
+		{
+			name:        "any of the access modes of the volume matches",
+			accessModes: []string{"ROX"},
+			volume:      rwo,
+			expected:    true,
+		},
+		{
+			name:        "other access mode doesn't match",
+			accessModes: []string{"RWX", "RWOP"},
+			volume:      rwo,
+			expected:    false,
+		},
+		{
+			name:        "volume without access modes doesn't match",
+			accessModes: []string{"RWO"},
+			volume:      &structuredVolume{},
+			expected:    false,
+		},
+		{
+			name:        "invalid access mode",
+			accessModes: []string{"RWM"},
+			volume:      rwo,
+			expectedErr: "invalid access mode RWM",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &accessModesCondition{accessModes: tc.accessModes}
+			if tc.expectedErr != "" {
+				require.EqualError(t, c.validate(), tc.expectedErr)
+				return
+			}
+			require.NoError(t, c.validate())
+			assert.Equal(t, tc.expected, c.match(tc.volume))
+		})
+	}
+}
+
+func TestVolumeModeCondition(t *testing.T) {
+	block := &structuredVolume{volumeMode: corev1api.PersistentVolumeBlock}
+
+	assert.True(t, (&volumeModeCondition{}).match(block))
+	assert.True(t, (&volumeModeCondition{volumeMode: "Block"}).match(block))
+	assert.False(t, (&volumeModeCondition{volumeMode: "Filesystem"}).match(block))
+	assert.False(t, (&volumeModeCondition{volumeMode: "Block"}).match(&structuredVolume{}))
+
+	require.NoError(t, (&volumeModeCondition{volumeMode: "Filesystem"}).validate())
+	require.EqualError(t, (&volumeModeCondition{volumeMode: "block"}).validate(), "invalid volume mode block, only Filesystem and Block are supported")
+}
+
+func TestGetMatchActionAccessModesAndVolumeMode(t *testing.T) {
+	yamlData := `version: v1
+volumePolicies:
+  - conditions:
+      accessModes:
+        - RWX
+      nfs: {}
+    action:
+      type: fs-backup
+  - conditions:
+      volumeMode: Block
+    action:
+      type: skip
+  - conditions: {}
+    action:
+      type: snapshot
+`
+	resPolicies, err := unmarshalResourcePolicies(&yamlData)
+	require.NoError(t, err)
+	policies := &Policies{}
+	require.NoError(t, policies.BuildPolicy(resPolicies))
+	require.NoError(t, policies.Validate())
+
+	block := corev1api.PersistentVolumeBlock
+	nfsPV := func(accessModes ...corev1api.PersistentVolumeAccessMode) *corev1api.PersistentVolume {
+		return &corev1api.PersistentVolume{
+			Spec: corev1api.PersistentVolumeSpec{
+				AccessModes: accessModes,
+				PersistentVolumeSource: corev1api.PersistentVolumeSource{
+					NFS: &corev1api.NFSVolumeSource{Server: "nfs-server", Path: "/data"},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		pv       *corev1api.PersistentVolume
+		pvc      *corev1api.PersistentVolumeClaim
+		podVol   *corev1api.Volume
+		expected VolumeActionType
+	}{
+		{
+			name:     "RWX NFS volume is backed up by fs-backup",
+			pv:       nfsPV(corev1api.ReadWriteMany),
+			expected: FSBackup,
+		},
+		{
+			name:     "RWO NFS volume is snapshotted",
+			pv:       nfsPV(corev1api.ReadWriteOnce),
+			expected: Snapshot,
+		},
+		{
+			name: "access modes of the PVC are used when the PV has none",
+			pv:   nfsPV(),
+			pvc: &corev1api.PersistentVolumeClaim{
+				Spec: corev1api.PersistentVolumeClaimSpec{AccessModes: []corev1api.PersistentVolumeAccessMode{corev1api.ReadWriteMany}},
+			},
+			expected: FSBackup,
+		},
+		{
+			name: "block volume is skipped",
+			pv: &corev1api.PersistentVolume{
+				Spec: corev1api.PersistentVolumeSpec{
+					AccessModes: []corev1api.PersistentVolumeAccessMode{corev1api.ReadWriteOnce},
+					VolumeMode:  &block,
+				},
+			},
+			expected: Skip,
+		},
+		{
+			name:   "pod volume of a block PVC is skipped",
+			podVol: &corev1api.Volume{Name: "vol-1"},
+			pvc: &corev1api.PersistentVolumeClaim{
+				Spec: corev1api.PersistentVolumeClaimSpec{VolumeMode: &block},
+			},
+			expected: Skip,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			action, err := policies.GetMatchAction(NewVolumeFilterData(tc.pv, tc.podVol, tc.pvc))
+			require.NoError(t, err)
+			require.NotNil(t, action)
+			assert.Equal(t, tc.expected, action.Type)
+		})
+	}
+}
@@ -18,10 +18,13 @@ package resourcepolicies
 import (
 	"bytes"
 	"fmt"
+	"regexp"
 	"strings"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 
+	"github.com/gobwas/glob"
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v3"
 	corev1api "k8s.io/api/core/v1"
@@ -31,6 +34,13 @@ import (
 type volPolicy struct {
 	action     Action
 	conditions []volumeCondition
+	// index is the position of the policy in the volume policies of its document.
+	index    int
+	priority int
+	// document is the name of the document the policy is defined in when the ConfigMap holds
+	// several ones, and documentPriority the priority of that document.
+	document         string
+	documentPriority int
 }
 
 type volumeCondition interface {
@@ -45,12 +55,17 @@ type capacity struct {
 }
 
 type structuredVolume struct {
-	capacity     resource.Quantity
-	storageClass string
-	nfs          *nFSVolumeSource
-	csi          *csiVolumeSource
-	volumeType   SupportedVolume
-	pvcLabels    map[string]string
+	capacity       resource.Quantity
+	storageClass   string
+	nfs            *nFSVolumeSource
+	csi            *csiVolumeSource
+	volumeType     SupportedVolume
+	pvcLabels      map[string]string
+	pvcAnnotations map[string]string
+	accessModes    []corev1api.PersistentVolumeAccessMode
+	volumeMode     corev1api.PersistentVolumeMode
+	podLabels      map[string]string
+	workloadKind   string
 }
 
 func (s *structuredVolume) parsePV(pv *corev1api.PersistentVolume) {
@@ -67,12 +82,24 @@ func (s *structuredVolume) parsePV(pv *corev1api.PersistentVolume) {
 	}
 
 	s.volumeType = getVolumeTypeFromPV(pv)
+	s.accessModes = pv.Spec.AccessModes
+	s.volumeMode = volumeModeOf(pv.Spec.VolumeMode)
 }
 
 func (s *structuredVolume) parsePVC(pvc *corev1api.PersistentVolumeClaim) {
 	if pvc != nil && len(pvc.GetLabels()) > 0 {
 		s.pvcLabels = pvc.Labels
 	}
+	if pvc != nil && len(pvc.GetAnnotations()) > 0 {
+		s.pvcAnnotations = pvc.Annotations
+	}
+	// the access modes and the volume mode of the PV take precedence, the PVC's are those requested
+	if pvc != nil && len(s.accessModes) == 0 {
+		s.accessModes = pvc.Spec.AccessModes
+	}
+	if pvc != nil && s.volumeMode == "" {
+		s.volumeMode = volumeModeOf(pvc.Spec.VolumeMode)
+	}
 }
 
 func (s *structuredVolume) parsePodVolume(vol *corev1api.Volume) {
@@ -89,6 +116,26 @@ func (s *structuredVolume) parsePodVolume(vol *corev1api.Volume) {
 	s.volumeType = getVolumeTypeFromVolume(vol)
 }
 
+func (s *structuredVolume) parsePod(pod *corev1api.Pod) {
+	if len(pod.GetLabels()) > 0 {
+		s.podLabels = pod.Labels
+	}
+	s.workloadKind = workloadKindOf(pod)
+}
+
+// workloadKindOf returns the kind of the controller owning the pod, if any. The pods of a
+// ReplicaSet labeled with a pod-template-hash are owned by a Deployment through it.
+func workloadKindOf(pod *corev1api.Pod) string {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return ""
+	}
+	if owner.Kind == "ReplicaSet" && pod.Labels["pod-template-hash"] != "" {
+		return "Deployment"
+	}
+	return owner.Kind
+}
+
 // pvcLabelsCondition defines a condition that matches if the PVC's labels contain all the provided key/value pairs.
 type pvcLabelsCondition struct {
 	labels map[string]string
@@ -110,6 +157,78 @@ func (c *pvcLabelsCondition) validate() error {
 	return nil
 }
 
+// pvcAnnotationsCondition defines a condition that matches if the PVC's annotations contain all the provided key/value pairs.
+type pvcAnnotationsCondition struct {
+	annotations map[string]string
+}
+
+func (c *pvcAnnotationsCondition) match(v *structuredVolume) bool {
+	// No annotations specified: always match.
+	if len(c.annotations) == 0 {
+		return true
+	}
+	if v.pvcAnnotations == nil {
+		return false
+	}
+	for key, value := range c.annotations {
+		if actual, ok := v.pvcAnnotations[key]; !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *pvcAnnotationsCondition) validate() error {
+	return nil
+}
+
+// podLabelsCondition defines a condition that matches if the labels of the pod mounting the volume
+// contain all the provided key/value pairs.
+type podLabelsCondition struct {
+	labels map[string]string
+}
+
+func (c *podLabelsCondition) match(v *structuredVolume) bool {
+	if len(c.labels) == 0 {
+		return true
+	}
+	if v.podLabels == nil {
+		return false
+	}
+	return labels.SelectorFromSet(c.labels).Matches(labels.Set(v.podLabels))
+}
+
+func (c *podLabelsCondition) validate() error {
+	return nil
+}
+
+// workloadKindsCondition defines a condition that matches if the pod mounting the volume is owned
+// by a workload of one of the provided kinds, such as Deployment, StatefulSet or DaemonSet.
+type workloadKindsCondition struct {
+	kinds []string
+}
+
+func (c *workloadKindsCondition) match(v *structuredVolume) bool {
+	if len(c.kinds) == 0 {
+		return true
+	}
+	for _, kind := range c.kinds {
+		if kind == v.workloadKind {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *workloadKindsCondition) validate() error {
+	for _, kind := range c.kinds {
+		if kind == "" {
+			return errors.New("the workload kinds must not be empty")
+		}
+	}
+	return nil
+}
+
 type capacityCondition struct {
 	capacity capacity
 }
@@ -120,10 +239,25 @@ func (c *capacityCondition) match(v *structuredVolume) bool {
 
 type storageClassCondition struct {
 	storageClass []string
+	matchers     []func(string) bool
+}
+
+// newStorageClassCondition compiles the storage class patterns once, so they aren't compiled
+// again for every volume, and returns an error for an invalid pattern.
+func newStorageClassCondition(storageClass []string) (*storageClassCondition, error) {
+	c := &storageClassCondition{storageClass: storageClass}
+	for _, sc := range storageClass {
+		matcher, err := storageClassMatcher(sc)
+		if err != nil {
+			return nil, err
+		}
+		c.matchers = append(c.matchers, matcher)
+	}
+	return c, nil
 }
 
 func (s *storageClassCondition) match(v *structuredVolume) bool {
-	if len(s.storageClass) == 0 {
+	if len(s.matchers) == 0 {
 		return true
 	}
 
@@ -131,15 +265,33 @@ func (s *storageClassCondition) match(v *structuredVolume) bool {
 		return false
 	}
 
-	for _, sc := range s.storageClass {
-		if v.storageClass == sc {
+	for _, matcher := range s.matchers {
+		if matcher(v.storageClass) {
 			return true
 		}
 	}
-
 	return false
 }
 
+// storageClassMatcher returns the matcher of a storage class pattern: a regular expression when
+// it's enclosed in slashes, such as "/^gp[23]$/", a glob pattern otherwise, such as "premium-*".
+// A plain storage class name is a glob pattern only matching itself.
+func storageClassMatcher(pattern string) (func(string) bool, error) {
+	if len(pattern) > 1 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		re, err := regexp.Compile(pattern[1 : len(pattern)-1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid storage class regular expression %s", pattern)
+		}
+		return re.MatchString, nil
+	}
+
+	g, err := glob.Compile(pattern)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid storage class glob pattern %s", pattern)
+	}
+	return g.Match, nil
+}
+
 type nfsCondition struct {
 	nfs *nFSVolumeSource
 }
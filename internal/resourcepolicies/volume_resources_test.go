@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 )
@@ -136,6 +137,54 @@ func TestPVCLabelsMatch(t *testing.T) {
 	}
 }
 
+func TestPVCAnnotationsMatch(t *testing.T) {
+	tests := []struct {
+		name           string
+		annotations    map[string]string
+		pvcAnnotations map[string]string
+		expectedMatch  bool
+	}{
+		{
+			name:           "match annotations",
+			annotations:    map[string]string{"example.com/scratch": "true"},
+			pvcAnnotations: map[string]string{"example.com/scratch": "true", "owner": "ci"},
+			expectedMatch:  true,
+		},
+		{
+			name:           "mismatch annotation value",
+			annotations:    map[string]string{"example.com/scratch": "true"},
+			pvcAnnotations: map[string]string{"example.com/scratch": "false"},
+			expectedMatch:  false,
+		},
+		{
+			name:           "annotation values aren't restricted like label values",
+			annotations:    map[string]string{"description": "scratch space, safe to drop"},
+			pvcAnnotations: map[string]string{"description": "scratch space, safe to drop"},
+			expectedMatch:  true,
+		},
+		{
+			name:           "empty condition always matches",
+			annotations:    map[string]string{},
+			pvcAnnotations: nil,
+			expectedMatch:  true,
+		},
+		{
+			name:           "nil pvcAnnotations fails non-empty condition",
+			annotations:    map[string]string{"example.com/scratch": "true"},
+			pvcAnnotations: nil,
+			expectedMatch:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition := &pvcAnnotationsCondition{annotations: tt.annotations}
+			match := condition.match(&structuredVolume{pvcAnnotations: tt.pvcAnnotations})
+			assert.Equal(t, tt.expectedMatch, match)
+		})
+	}
+}
+
 func TestParseCapacity(t *testing.T) {
 	var emptyCapacity capacity
 	tests := []struct {
@@ -193,45 +242,71 @@ func TestCapacityIsInRange(t *testing.T) {
 func TestStorageClassConditionMatch(t *testing.T) {
 	tests := []struct {
 		name          string
-		condition     *storageClassCondition
+		storageClass  []string
 		volume        *structuredVolume
 		expectedMatch bool
 	}{
 		{
 			name:          "match single storage class",
-			condition:     &storageClassCondition{[]string{"gp2"}},
+			storageClass:  []string{"gp2"},
 			volume:        setStructuredVolume(*resource.NewQuantity(0, resource.BinarySI), "gp2", nil, nil, nil),
 			expectedMatch: true,
 		},
 		{
 			name:          "match multiple storage classes",
-			condition:     &storageClassCondition{[]string{"gp2", "ebs-sc"}},
+			storageClass:  []string{"gp2", "ebs-sc"},
 			volume:        setStructuredVolume(*resource.NewQuantity(0, resource.BinarySI), "gp2", nil, nil, nil),
 			expectedMatch: true,
 		},
 		{
 			name:          "mismatch storage class",
-			condition:     &storageClassCondition{[]string{"gp2"}},
+			storageClass:  []string{"gp2"},
 			volume:        setStructuredVolume(*resource.NewQuantity(0, resource.BinarySI), "ebs-sc", nil, nil, nil),
 			expectedMatch: false,
 		},
 		{
 			name:          "empty storage class",
-			condition:     &storageClassCondition{[]string{}},
+			storageClass:  []string{},
 			volume:        setStructuredVolume(*resource.NewQuantity(0, resource.BinarySI), "ebs-sc", nil, nil, nil),
 			expectedMatch: true,
 		},
 		{
 			name:          "empty volume storage class",
-			condition:     &storageClassCondition{[]string{"gp2"}},
+			storageClass:  []string{"gp2"},
 			volume:        setStructuredVolume(*resource.NewQuantity(0, resource.BinarySI), "", nil, nil, nil),
 			expectedMatch: false,
 		},
+		{
+			name:          "match glob pattern",
+			storageClass:  []string{"premium-*"},
+			volume:        setStructuredVolume(*resource.NewQuantity(0, resource.BinarySI), "premium-zone-a", nil, nil, nil),
+			expectedMatch: true,
+		},
+		{
+			name:          "mismatch glob pattern",
+			storageClass:  []string{"premium-*"},
+			volume:        setStructuredVolume(*resource.NewQuantity(0, resource.BinarySI), "standard-zone-a", nil, nil, nil),
+			expectedMatch: false,
+		},
+		{
+			name:          "match regular expression",
+			storageClass:  []string{"/^gp[23]$/"},
+			volume:        setStructuredVolume(*resource.NewQuantity(0, resource.BinarySI), "gp3", nil, nil, nil),
+			expectedMatch: true,
+		},
+		{
+			name:          "mismatch regular expression",
+			storageClass:  []string{"/^gp[23]$/"},
+			volume:        setStructuredVolume(*resource.NewQuantity(0, resource.BinarySI), "gp2-encrypted", nil, nil, nil),
+			expectedMatch: false,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			match := tt.condition.match(tt.volume)
+			condition, err := newStorageClassCondition(tt.storageClass)
+			require.NoError(t, err)
+			match := condition.match(tt.volume)
 			if match != tt.expectedMatch {
 				t.Errorf("expected %v, but got %v", tt.expectedMatch, match)
 			}
@@ -239,6 +314,11 @@ func TestStorageClassConditionMatch(t *testing.T) {
 	}
 }
 
+func TestNewStorageClassConditionInvalidPattern(t *testing.T) {
+	_, err := newStorageClassCondition([]string{"ebs-sc", "/gp[/"})
+	require.ErrorContains(t, err, "invalid storage class regular expression /gp[/")
+}
+
 func TestNFSConditionMatch(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -23,7 +23,12 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
-const currentSupportDataVersion = "v1"
+const (
+	currentSupportDataVersion = "v2"
+	// legacyDataVersion is the version of the resource policies loaded without schema validation,
+	// a single document per ConfigMap.
+	legacyDataVersion = "v1"
+)
 
 type csiVolumeSource struct {
 	Driver string `yaml:"driver,omitempty"`
@@ -40,12 +45,17 @@ type nFSVolumeSource struct {
 
 // volumeConditions defined the current format of conditions we parsed
 type volumeConditions struct {
-	Capacity     string            `yaml:"capacity,omitempty"`
-	StorageClass []string          `yaml:"storageClass,omitempty"`
-	NFS          *nFSVolumeSource  `yaml:"nfs,omitempty"`
-	CSI          *csiVolumeSource  `yaml:"csi,omitempty"`
-	VolumeTypes  []SupportedVolume `yaml:"volumeTypes,omitempty"`
-	PVCLabels    map[string]string `yaml:"pvcLabels,omitempty"`
+	Capacity       string            `yaml:"capacity,omitempty"`
+	StorageClass   []string          `yaml:"storageClass,omitempty"`
+	NFS            *nFSVolumeSource  `yaml:"nfs,omitempty"`
+	CSI            *csiVolumeSource  `yaml:"csi,omitempty"`
+	VolumeTypes    []SupportedVolume `yaml:"volumeTypes,omitempty"`
+	PVCLabels      map[string]string `yaml:"pvcLabels,omitempty"`
+	PVCAnnotations map[string]string `yaml:"pvcAnnotations,omitempty"`
+	AccessModes    []string          `yaml:"accessModes,omitempty"`
+	VolumeMode     string            `yaml:"volumeMode,omitempty"`
+	PodLabels      map[string]string `yaml:"podLabels,omitempty"`
+	WorkloadKinds  []string          `yaml:"workloadKinds,omitempty"`
 }
 
 func (c *capacityCondition) validate() error {
@@ -61,7 +71,11 @@ func (c *capacityCondition) validate() error {
 }
 
 func (s *storageClassCondition) validate() error {
-	// validate by yamlv3
+	for _, sc := range s.storageClass {
+		if _, err := storageClassMatcher(sc); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -96,6 +110,14 @@ func (a *Action) validate() error {
 		return fmt.Errorf("invalid action type %s", a.Type)
 	}
 
-	// TODO validate parameters
+	// validate parameters
+	if raw, ok := a.Parameters[SnapshotClassParameter]; ok {
+		if a.Type != Snapshot {
+			return fmt.Errorf("parameter %s is only supported by the %s action", SnapshotClassParameter, Snapshot)
+		}
+		if snapshotClass, ok := raw.(string); !ok || snapshotClass == "" {
+			return fmt.Errorf("parameter %s must be a non-empty string, got %v", SnapshotClassParameter, raw)
+		}
+	}
 	return nil
 }
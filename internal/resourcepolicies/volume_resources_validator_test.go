@@ -81,6 +81,45 @@ func TestCapacityConditionValidate(t *testing.T) {
 	}
 }
 
+func TestStorageClassConditionValidate(t *testing.T) {
+	testCases := []struct {
+		name         string
+		storageClass []string
+		wantErr      bool
+	}{
+		{
+			name:         "plain names",
+			storageClass: []string{"gp2", "ebs-sc"},
+			wantErr:      false,
+		},
+		{
+			name:         "glob pattern and regular expression",
+			storageClass: []string{"premium-*", "/^gp[23]$/"},
+			wantErr:      false,
+		},
+		{
+			name:         "invalid regular expression",
+			storageClass: []string{"/gp[/"},
+			wantErr:      true,
+		},
+		{
+			name:         "invalid glob pattern",
+			storageClass: []string{"premium-[a"},
+			wantErr:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := (&storageClassCondition{storageClass: tc.storageClass}).validate()
+
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Expected error %v, but got error %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
 func TestValidate(t *testing.T) {
 	testCases := []struct {
 		name    string
@@ -209,7 +248,7 @@ func TestValidate(t *testing.T) {
 		{
 			name: "unsupported version",
 			res: &ResourcePolicies{
-				Version: "v2",
+				Version: "v3",
 				VolumePolicies: []VolumePolicy{
 					{
 						Action: Action{Type: "skip"},
@@ -438,6 +477,51 @@ func TestValidate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "snapshot action with snapshotClass parameter",
+			res: &ResourcePolicies{
+				Version: "v1",
+				VolumePolicies: []VolumePolicy{
+					{
+						Action: Action{Type: Snapshot, Parameters: map[string]any{"snapshotClass": "gold"}},
+						Conditions: map[string]any{
+							"storageClass": []string{"gp2"},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "snapshotClass parameter on a non-snapshot action",
+			res: &ResourcePolicies{
+				Version: "v1",
+				VolumePolicies: []VolumePolicy{
+					{
+						Action: Action{Type: FSBackup, Parameters: map[string]any{"snapshotClass": "gold"}},
+						Conditions: map[string]any{
+							"storageClass": []string{"gp2"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "snapshotClass parameter not a string",
+			res: &ResourcePolicies{
+				Version: "v1",
+				VolumePolicies: []VolumePolicy{
+					{
+						Action: Action{Type: Snapshot, Parameters: map[string]any{"snapshotClass": 1}},
+						Conditions: map[string]any{
+							"storageClass": []string{"gp2"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "error format volume policies with pvcLabels (not a map)",
 			res: &ResourcePolicies{
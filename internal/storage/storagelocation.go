@@ -18,6 +18,8 @@ package storage
 
 import (
 	"context"
+	"hash/fnv"
+	"math"
 	"time"
 
 	"github.com/pkg/errors"
@@ -35,6 +37,14 @@ type DefaultBackupLocationInfo struct {
 	StorageLocation string
 	// ServerValidationFrequency is the server default validation frequency for all backup storage locations
 	ServerValidationFrequency time.Duration
+	// ServerValidationJitter is the fraction of the validation frequency added to it, different for each
+	// backup storage location, so that the locations sharing a frequency aren't all validated at once
+	ServerValidationJitter float64
+	// ServerValidationMaxBackoff is the maximum interval between the validations of a backup storage location
+	// whose validations keep failing. Zero disables the backoff
+	ServerValidationMaxBackoff time.Duration
+	// ServerValidationConcurrency is the maximum number of backup storage locations validated at the same time
+	ServerValidationConcurrency int
 }
 
 // IsReadyToValidate calculates if a given backup storage location is ready to be validated.
@@ -74,6 +84,59 @@ func IsReadyToValidate(bslValidationFrequency *metav1.Duration, lastValidationTi
 	return !time.Now().UTC().Before(nextValidation)           // ready only when NOW is equal to or after the next validation time
 }
 
+// ValidationInterval returns the interval between the validations of a backup storage location, zero
+// meaning it isn't validated.
+//
+// The validation frequency of the location is doubled each time its validation fails again, up to the
+// server's maximum backoff, and the server's jitter is added to it.
+func ValidationInterval(location *velerov1api.BackupStorageLocation, info DefaultBackupLocationInfo) time.Duration {
+	interval := info.ServerValidationFrequency
+	if location.Spec.ValidationFrequency != nil && location.Spec.ValidationFrequency.Duration >= 0 {
+		interval = location.Spec.ValidationFrequency.Duration
+	}
+	if interval == 0 {
+		return 0
+	}
+
+	// the failures until the location is marked unavailable are retried at the validation frequency
+	if interval < info.ServerValidationMaxBackoff {
+		for i := HealthProbeFailureThreshold(location); i < location.Status.ValidationFailures && interval < info.ServerValidationMaxBackoff; i++ {
+			interval *= 2
+		}
+		interval = min(interval, info.ServerValidationMaxBackoff)
+	}
+
+	if info.ServerValidationJitter > 0 {
+		// the jitter of a location is the same each time, so that its validations are evenly spaced
+		h := fnv.New32a()
+		h.Write([]byte(location.Namespace + "/" + location.Name))
+		interval += time.Duration(float64(interval) * info.ServerValidationJitter * float64(h.Sum32()) / math.MaxUint32)
+	}
+	return interval
+}
+
+// HealthProbeFailureThreshold returns the number of validations in a row which must fail for the
+// location to be marked unavailable.
+func HealthProbeFailureThreshold(location *velerov1api.BackupStorageLocation) int32 {
+	if location.Spec.HealthProbe == nil || location.Spec.HealthProbe.FailureThreshold < 1 {
+		return 1
+	}
+	return location.Spec.HealthProbe.FailureThreshold
+}
+
+// IsLocationReadyToValidate returns whether a backup storage location is due for validation, according to
+// its validation interval. A location is always validated at least once.
+func IsLocationReadyToValidate(location *velerov1api.BackupStorageLocation, info DefaultBackupLocationInfo, now time.Time) bool {
+	if location.Status.LastValidationTime == nil {
+		return true
+	}
+	interval := ValidationInterval(location, info)
+	if interval == 0 {
+		return false
+	}
+	return !now.Before(location.Status.LastValidationTime.Add(interval))
+}
+
 // ListBackupStorageLocations verifies if there are any backup storage locations.
 // For all purposes, if either there is an error while attempting to fetch items or
 // if there are no items an error would be returned since the functioning of the system
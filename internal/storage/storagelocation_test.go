@@ -126,6 +126,109 @@ func TestIsReadyToValidate(t *testing.T) {
 	}
 }
 
+func TestValidationInterval(t *testing.T) {
+	info := DefaultBackupLocationInfo{
+		ServerValidationFrequency:  time.Minute,
+		ServerValidationMaxBackoff: time.Hour,
+	}
+	tests := []struct {
+		name     string
+		location *velerov1api.BackupStorageLocation
+		info     DefaultBackupLocationInfo
+		want     time.Duration
+	}{
+		{
+			name:     "server frequency",
+			location: builder.ForBackupStorageLocation("ns-1", "location-1").Result(),
+			info:     info,
+			want:     time.Minute,
+		},
+		{
+			name:     "location frequency",
+			location: builder.ForBackupStorageLocation("ns-1", "location-1").ValidationFrequency(5 * time.Minute).Result(),
+			info:     info,
+			want:     5 * time.Minute,
+		},
+		{
+			name:     "disabled validation",
+			location: builder.ForBackupStorageLocation("ns-1", "location-1").ValidationFrequency(0).ValidationFailures(3).Result(),
+			info:     info,
+			want:     0,
+		},
+		{
+			name:     "first failure",
+			location: builder.ForBackupStorageLocation("ns-1", "location-1").ValidationFailures(1).Result(),
+			info:     info,
+			want:     time.Minute,
+		},
+		{
+			name:     "repeated failures",
+			location: builder.ForBackupStorageLocation("ns-1", "location-1").ValidationFailures(4).Result(),
+			info:     info,
+			want:     8 * time.Minute,
+		},
+		{
+			name:     "maximum backoff",
+			location: builder.ForBackupStorageLocation("ns-1", "location-1").ValidationFailures(30).Result(),
+			info:     info,
+			want:     time.Hour,
+		},
+		{
+			name:     "frequency above the maximum backoff",
+			location: builder.ForBackupStorageLocation("ns-1", "location-1").ValidationFrequency(2 * time.Hour).ValidationFailures(3).Result(),
+			info:     info,
+			want:     2 * time.Hour,
+		},
+		{
+			name:     "disabled backoff",
+			location: builder.ForBackupStorageLocation("ns-1", "location-1").ValidationFailures(3).Result(),
+			info:     DefaultBackupLocationInfo{ServerValidationFrequency: time.Minute},
+			want:     time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(ValidationInterval(tt.location, tt.info)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestValidationIntervalJitter(t *testing.T) {
+	g := NewWithT(t)
+	info := DefaultBackupLocationInfo{
+		ServerValidationFrequency: time.Minute,
+		ServerValidationJitter:    0.5,
+	}
+
+	intervals := map[time.Duration]bool{}
+	for _, name := range []string{"location-1", "location-2", "location-3"} {
+		location := builder.ForBackupStorageLocation("ns-1", name).Result()
+		interval := ValidationInterval(location, info)
+		g.Expect(interval).To(BeNumerically(">=", time.Minute))
+		g.Expect(interval).To(BeNumerically("<=", 90*time.Second))
+		// the jitter of a location doesn't change
+		g.Expect(ValidationInterval(location, info)).To(Equal(interval))
+		intervals[interval] = true
+	}
+	g.Expect(intervals).To(HaveLen(3))
+}
+
+func TestIsLocationReadyToValidate(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Now()
+	info := DefaultBackupLocationInfo{
+		ServerValidationFrequency:  time.Minute,
+		ServerValidationMaxBackoff: time.Hour,
+	}
+
+	g.Expect(IsLocationReadyToValidate(builder.ForBackupStorageLocation("ns-1", "location-1").ValidationFrequency(0).Result(), info, now)).To(BeTrue())
+	g.Expect(IsLocationReadyToValidate(builder.ForBackupStorageLocation("ns-1", "location-1").ValidationFrequency(0).LastValidationTime(now.Add(-time.Hour)).Result(), info, now)).To(BeFalse())
+	g.Expect(IsLocationReadyToValidate(builder.ForBackupStorageLocation("ns-1", "location-1").LastValidationTime(now.Add(-2*time.Minute)).Result(), info, now)).To(BeTrue())
+	g.Expect(IsLocationReadyToValidate(builder.ForBackupStorageLocation("ns-1", "location-1").LastValidationTime(now.Add(-2*time.Minute)).ValidationFailures(3).Result(), info, now)).To(BeFalse())
+}
+
 func TestListBackupStorageLocations(t *testing.T) {
 	tests := []struct {
 		name            string
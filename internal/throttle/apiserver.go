@@ -0,0 +1,103 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+// latencyWeight is the weight of each request in the moving average of the latency.
+const latencyWeight = 0.2
+
+// APIServerProbe reports the API server as overloaded when it throttled requests with 429
+// responses, or when the moving average of the latency of its responses exceeds a threshold.
+// The requests are observed through the round trippers it wraps.
+type APIServerProbe struct {
+	latencyThreshold time.Duration
+	clock            clock.PassiveClock
+	lock             sync.Mutex
+	latency          time.Duration
+	throttled        int
+}
+
+// NewAPIServerProbe returns an APIServerProbe with the latency threshold.
+func NewAPIServerProbe(latencyThreshold time.Duration) *APIServerProbe {
+	return &APIServerProbe{
+		latencyThreshold: latencyThreshold,
+		clock:            clock.RealClock{},
+	}
+}
+
+// Wrap returns a round tripper observing the requests made through rt, it's meant to be set
+// as the WrapTransport of a rest.Config.
+func (p *APIServerProbe) Wrap(rt http.RoundTripper) http.RoundTripper {
+	return &probeRoundTripper{RoundTripper: rt, probe: p}
+}
+
+// Overloaded implements Probe. The count of the throttled requests is reset on each call.
+func (p *APIServerProbe) Overloaded() (bool, string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	throttled := p.throttled
+	p.throttled = 0
+	if throttled > 0 {
+		return true, fmt.Sprintf("the API server throttled %d requests", throttled)
+	}
+	if p.latencyThreshold > 0 && p.latency > p.latencyThreshold {
+		return true, fmt.Sprintf("the API server latency of %s exceeds %s", p.latency.Round(time.Millisecond), p.latencyThreshold)
+	}
+	return false, ""
+}
+
+func (p *APIServerProbe) observe(latency time.Duration, statusCode int) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if statusCode == http.StatusTooManyRequests {
+		p.throttled++
+	}
+	if p.latency == 0 {
+		p.latency = latency
+	} else {
+		p.latency = time.Duration(latencyWeight*float64(latency) + (1-latencyWeight)*float64(p.latency))
+	}
+}
+
+type probeRoundTripper struct {
+	http.RoundTripper
+	probe *APIServerProbe
+}
+
+func (rt *probeRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	// the watches are long-running requests, their latency isn't meaningful
+	if req.URL.Query().Get("watch") == "true" {
+		return rt.RoundTripper.RoundTrip(req)
+	}
+
+	start := rt.probe.clock.Now()
+	resp, err := rt.RoundTripper.RoundTrip(req)
+	if err == nil {
+		rt.probe.observe(rt.probe.clock.Since(start), resp.StatusCode)
+	}
+	return resp, err
+}
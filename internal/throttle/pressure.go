@@ -0,0 +1,109 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultPressureDir is where the kernel exposes the pressure stall information of the node.
+const DefaultPressureDir = "/proc/pressure"
+
+// NodePressureProbe reports the node as overloaded when the share of time the tasks were stalled
+// waiting for CPU or IO over the last 10 seconds, as reported by the pressure stall information
+// of the kernel, exceeds a threshold.
+type NodePressureProbe struct {
+	dir                  string
+	cpuPressureThreshold float64
+	ioPressureThreshold  float64
+	log                  logrus.FieldLogger
+}
+
+// NewNodePressureProbe returns a NodePressureProbe reading the pressure stall information from
+// dir. The thresholds are percentages, zero meaning the pressure isn't checked.
+func NewNodePressureProbe(dir string, cpuPressureThreshold, ioPressureThreshold float64, log logrus.FieldLogger) *NodePressureProbe {
+	return &NodePressureProbe{
+		dir:                  dir,
+		cpuPressureThreshold: cpuPressureThreshold,
+		ioPressureThreshold:  ioPressureThreshold,
+		log:                  log,
+	}
+}
+
+// Overloaded implements Probe. The node isn't reported as overloaded if its pressure stall
+// information can't be read.
+func (p *NodePressureProbe) Overloaded() (bool, string) {
+	for _, resource := range []struct {
+		name      string
+		threshold float64
+	}{
+		{"cpu", p.cpuPressureThreshold},
+		{"io", p.ioPressureThreshold},
+	} {
+		if resource.threshold <= 0 {
+			continue
+		}
+
+		pressure, err := readPressure(filepath.Join(p.dir, resource.name))
+		if err != nil {
+			p.log.WithError(err).Debugf("Error reading the %s pressure of the node", resource.name)
+			continue
+		}
+		if pressure > resource.threshold {
+			return true, fmt.Sprintf("the %s pressure of %.2f%% exceeds %.2f%%", resource.name, pressure, resource.threshold)
+		}
+	}
+	return false, ""
+}
+
+// readPressure returns the avg10 value of the "some" line of a pressure stall information file.
+func readPressure(file string) (float64, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || fields[0] != "some" {
+			continue
+		}
+		for _, field := range fields[1:] {
+			if value, found := strings.CutPrefix(field, "avg10="); found {
+				pressure, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					return 0, errors.Wrapf(err, "error parsing the avg10 value of %s", file)
+				}
+				return pressure, nil
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return 0, errors.Errorf("no avg10 value found in %s", file)
+}
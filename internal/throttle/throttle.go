@@ -0,0 +1,173 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package throttle slows down the backups and the data movements when the cluster is under
+// load, and speeds them up again when it's idle.
+package throttle
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/utils/clock"
+)
+
+const (
+	// adjustInterval is how often the delay and the concurrency are adjusted to the load.
+	adjustInterval = 5 * time.Second
+
+	// minDelay is the delay the throttler starts from when the cluster becomes overloaded.
+	minDelay = 50 * time.Millisecond
+)
+
+// Probe reports whether the cluster is overloaded.
+type Probe interface {
+	// Overloaded returns whether the cluster is overloaded and, if so, the reason.
+	Overloaded() (bool, string)
+}
+
+// Throttler delays the operations while its probe reports the cluster is overloaded. The delay
+// doubles on each adjustment under load, up to a maximum, and halves back to zero once the load
+// is gone.
+type Throttler struct {
+	probe      Probe
+	maxDelay   time.Duration
+	log        logrus.FieldLogger
+	clock      clock.Clock
+	lock       sync.Mutex
+	delay      time.Duration
+	lastAdjust time.Time
+}
+
+// NewThrottler returns a Throttler whose delay, adjusted according to probe, doesn't exceed maxDelay.
+func NewThrottler(probe Probe, maxDelay time.Duration, log logrus.FieldLogger) *Throttler {
+	return &Throttler{
+		probe:    probe,
+		maxDelay: maxDelay,
+		log:      log,
+		clock:    clock.RealClock{},
+	}
+}
+
+// Wait blocks for the current delay, or until ctx is done. It returns immediately if the
+// throttler is nil.
+func (t *Throttler) Wait(ctx context.Context) error {
+	if t == nil {
+		return nil
+	}
+
+	delay := t.adjust()
+	if delay == 0 {
+		return nil
+	}
+
+	timer := t.clock.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Delay returns the current delay.
+func (t *Throttler) Delay() time.Duration {
+	if t == nil {
+		return 0
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.delay
+}
+
+// adjust adjusts the delay to the load, at most once per adjustInterval, and returns it.
+func (t *Throttler) adjust() time.Duration {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := t.clock.Now()
+	if now.Sub(t.lastAdjust) < adjustInterval {
+		return t.delay
+	}
+	t.lastAdjust = now
+
+	previous := t.delay
+	if overloaded, reason := t.probe.Overloaded(); overloaded {
+		t.delay = min(max(2*t.delay, minDelay), t.maxDelay)
+		if t.delay != previous {
+			t.log.Infof("The cluster is overloaded (%s), delaying the operations by %s", reason, t.delay)
+		}
+	} else if t.delay > 0 {
+		t.delay /= 2
+		if t.delay < minDelay {
+			t.delay = 0
+			t.log.Info("The cluster isn't overloaded anymore, the operations aren't delayed")
+		}
+	}
+	return t.delay
+}
+
+// Concurrency adapts a concurrency limit to the load: it's halved, down to one, on each
+// adjustment while its probe reports the cluster is overloaded and it grows back by one, up to
+// the configured limit, on each adjustment once the load is gone.
+type Concurrency struct {
+	probe      Probe
+	limit      int
+	log        logrus.FieldLogger
+	clock      clock.Clock
+	lock       sync.Mutex
+	current    int
+	lastAdjust time.Time
+}
+
+// NewConcurrency returns a Concurrency adapting limit according to probe.
+func NewConcurrency(probe Probe, limit int, log logrus.FieldLogger) *Concurrency {
+	return &Concurrency{
+		probe:   probe,
+		limit:   limit,
+		log:     log,
+		clock:   clock.RealClock{},
+		current: limit,
+	}
+}
+
+// Limit returns the concurrency limit adjusted to the load.
+func (c *Concurrency) Limit() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := c.clock.Now()
+	if now.Sub(c.lastAdjust) < adjustInterval {
+		return c.current
+	}
+	c.lastAdjust = now
+
+	previous := c.current
+	if overloaded, reason := c.probe.Overloaded(); overloaded {
+		c.current = max(c.current/2, 1)
+		if c.current != previous {
+			c.log.Infof("The node is overloaded (%s), lowering the concurrency to %d", reason, c.current)
+		}
+	} else if c.current < c.limit {
+		c.current++
+		c.log.Infof("The node isn't overloaded, raising the concurrency to %d", c.current)
+	}
+	return c.current
+}
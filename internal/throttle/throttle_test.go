@@ -0,0 +1,184 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	testclocks "k8s.io/utils/clock/testing"
+)
+
+type fakeProbe struct {
+	overloaded bool
+}
+
+func (p *fakeProbe) Overloaded() (bool, string) {
+	return p.overloaded, "fake"
+}
+
+func TestThrottlerAdjust(t *testing.T) {
+	probe := &fakeProbe{overloaded: true}
+	clock := testclocks.NewFakeClock(time.Now())
+	throttler := NewThrottler(probe, 300*time.Millisecond, logrus.StandardLogger())
+	throttler.clock = clock
+
+	var delays []time.Duration
+	for range 5 {
+		delays = append(delays, throttler.adjust())
+		clock.Step(adjustInterval)
+	}
+	assert.Equal(t, []time.Duration{50 * time.Millisecond, 100 * time.Millisecond, 200 * time.Millisecond, 300 * time.Millisecond, 300 * time.Millisecond}, delays)
+
+	// the delay isn't adjusted more than once per interval
+	probe.overloaded = false
+	clock.Step(-time.Second)
+	assert.Equal(t, 300*time.Millisecond, throttler.adjust())
+
+	delays = nil
+	for range 4 {
+		clock.Step(adjustInterval)
+		delays = append(delays, throttler.adjust())
+	}
+	assert.Equal(t, []time.Duration{150 * time.Millisecond, 75 * time.Millisecond, 0, 0}, delays)
+}
+
+func TestThrottlerWait(t *testing.T) {
+	var throttler *Throttler
+	require.NoError(t, throttler.Wait(context.Background()))
+	assert.Equal(t, time.Duration(0), throttler.Delay())
+
+	throttler = NewThrottler(&fakeProbe{overloaded: true}, time.Hour, logrus.StandardLogger())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, throttler.Wait(ctx), context.Canceled)
+	assert.Equal(t, minDelay, throttler.Delay())
+}
+
+func TestConcurrencyLimit(t *testing.T) {
+	probe := &fakeProbe{overloaded: true}
+	clock := testclocks.NewFakeClock(time.Now())
+	concurrency := NewConcurrency(probe, 5, logrus.StandardLogger())
+	concurrency.clock = clock
+
+	var limits []int
+	for range 4 {
+		limits = append(limits, concurrency.Limit())
+		clock.Step(adjustInterval)
+	}
+	probe.overloaded = false
+	for range 5 {
+		limits = append(limits, concurrency.Limit())
+		clock.Step(adjustInterval)
+	}
+	assert.Equal(t, []int{2, 1, 1, 1, 2, 3, 4, 5, 5}, limits)
+}
+
+func TestAPIServerProbe(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	probe := NewAPIServerProbe(time.Hour)
+	client := &http.Client{Transport: probe.Wrap(http.DefaultTransport)}
+	get := func() {
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	get()
+	overloaded, _ := probe.Overloaded()
+	assert.False(t, overloaded)
+
+	status = http.StatusTooManyRequests
+	get()
+	get()
+	overloaded, reason := probe.Overloaded()
+	assert.True(t, overloaded)
+	assert.Equal(t, "the API server throttled 2 requests", reason)
+
+	// the count of the throttled requests is reset on each check
+	overloaded, _ = probe.Overloaded()
+	assert.False(t, overloaded)
+
+	probe.latencyThreshold = time.Nanosecond
+	overloaded, reason = probe.Overloaded()
+	assert.True(t, overloaded)
+	assert.Contains(t, reason, "exceeds 1ns")
+}
+
+func TestNodePressureProbe(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cpu"), []byte(
+		"some avg10=12.50 avg60=3.00 avg300=1.00 total=1000\nfull avg10=0.00 avg60=0.00 avg300=0.00 total=0\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "io"), []byte(
+		"some avg10=40.00 avg60=3.00 avg300=1.00 total=1000\n"), 0644))
+
+	tests := []struct {
+		name                 string
+		cpuPressureThreshold float64
+		ioPressureThreshold  float64
+		expectedOverloaded   bool
+		expectedReason       string
+	}{
+		{
+			name: "no thresholds",
+		},
+		{
+			name:                 "pressures under the thresholds",
+			cpuPressureThreshold: 20,
+			ioPressureThreshold:  50,
+		},
+		{
+			name:                 "cpu pressure over the threshold",
+			cpuPressureThreshold: 10,
+			expectedOverloaded:   true,
+			expectedReason:       "the cpu pressure of 12.50% exceeds 10.00%",
+		},
+		{
+			name:                 "io pressure over the threshold",
+			cpuPressureThreshold: 20,
+			ioPressureThreshold:  30,
+			expectedOverloaded:   true,
+			expectedReason:       "the io pressure of 40.00% exceeds 30.00%",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			probe := NewNodePressureProbe(dir, tc.cpuPressureThreshold, tc.ioPressureThreshold, logrus.StandardLogger())
+			overloaded, reason := probe.Overloaded()
+			assert.Equal(t, tc.expectedOverloaded, overloaded)
+			assert.Equal(t, tc.expectedReason, reason)
+		})
+	}
+
+	// the node isn't reported as overloaded when its pressure can't be read
+	overloaded, _ := NewNodePressureProbe(t.TempDir(), 1, 1, logrus.StandardLogger()).Overloaded()
+	assert.False(t, overloaded)
+}
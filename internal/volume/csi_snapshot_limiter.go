@@ -0,0 +1,115 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volume
+
+import (
+	"context"
+	"sync"
+)
+
+// CSISnapshotLimiter limits the number of CSI snapshots created at once for each storage class
+// and CSI driver, as some drivers fail above a small parallelism. The limits are shared by all
+// the backups running concurrently in the server, the volumes over the limit are queued until
+// the snapshot of another volume of the same storage class or driver is created.
+type CSISnapshotLimiter struct {
+	lock       sync.Mutex
+	limits     map[string]int
+	semaphores map[string]chan struct{}
+}
+
+// NewCSISnapshotLimiter returns a CSISnapshotLimiter allowing as many snapshots at once as
+// the values of limits, keyed by the names of the storage classes or CSI drivers.
+func NewCSISnapshotLimiter(limits map[string]int) *CSISnapshotLimiter {
+	return &CSISnapshotLimiter{
+		limits:     limits,
+		semaphores: make(map[string]chan struct{}),
+	}
+}
+
+// Limited returns whether the snapshots of the volumes of the storage class or provisioned by
+// the driver are limited. It returns false if the limiter is nil.
+func (l *CSISnapshotLimiter) Limited(storageClass, driver string) bool {
+	if l == nil {
+		return false
+	}
+	return l.limits[storageClass] > 0 || l.limits[driver] > 0
+}
+
+// Acquire blocks until the snapshot of a volume of the storage class provisioned by the driver
+// is allowed, or ctx is done. The returned function must be called once the snapshot is
+// created. onQueue, if not nil, is called with 1 when the volume is queued and with -1 when it
+// is dequeued.
+func (l *CSISnapshotLimiter) Acquire(ctx context.Context, storageClass, driver string, onQueue func(delta int)) (func(), error) {
+	var acquired []chan struct{}
+	release := func() {
+		for _, semaphore := range acquired {
+			<-semaphore
+		}
+	}
+
+	keys := []string{storageClass}
+	if driver != storageClass {
+		keys = append(keys, driver)
+	}
+	// the semaphores are always acquired in the same order so that the volumes don't deadlock
+	for _, key := range keys {
+		semaphore := l.semaphore(key)
+		if semaphore == nil {
+			continue
+		}
+
+		select {
+		case semaphore <- struct{}{}:
+		default:
+			if onQueue != nil {
+				onQueue(1)
+			}
+			var err error
+			select {
+			case semaphore <- struct{}{}:
+			case <-ctx.Done():
+				err = ctx.Err()
+			}
+			if onQueue != nil {
+				onQueue(-1)
+			}
+			if err != nil {
+				release()
+				return nil, err
+			}
+		}
+		acquired = append(acquired, semaphore)
+	}
+
+	return release, nil
+}
+
+func (l *CSISnapshotLimiter) semaphore(key string) chan struct{} {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	limit := l.limits[key]
+	if key == "" || limit <= 0 {
+		return nil
+	}
+	semaphore, ok := l.semaphores[key]
+	if !ok {
+		semaphore = make(chan struct{}, limit)
+		l.semaphores[key] = semaphore
+	}
+	return semaphore
+}
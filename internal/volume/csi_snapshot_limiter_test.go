@@ -0,0 +1,89 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volume
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSISnapshotLimiterLimited(t *testing.T) {
+	var limiter *CSISnapshotLimiter
+	assert.False(t, limiter.Limited("gp3", "ebs.csi.aws.com"))
+
+	limiter = NewCSISnapshotLimiter(map[string]int{"gp3": 1, "disk.csi.azure.com": 2})
+	assert.True(t, limiter.Limited("gp3", "ebs.csi.aws.com"))
+	assert.True(t, limiter.Limited("managed-premium", "disk.csi.azure.com"))
+	assert.False(t, limiter.Limited("io2", "ebs.csi.aws.com"))
+}
+
+func TestCSISnapshotLimiterAcquire(t *testing.T) {
+	limiter := NewCSISnapshotLimiter(map[string]int{"gp3": 1, "ebs.csi.aws.com": 2})
+	var queued atomic.Int32
+	onQueue := func(delta int) { queued.Add(int32(delta)) }
+
+	// not limited
+	for i := 0; i < 3; i++ {
+		_, err := limiter.Acquire(context.Background(), "standard", "pd.csi.storage.gke.io", onQueue)
+		require.NoError(t, err)
+	}
+
+	releaseGP3, err := limiter.Acquire(context.Background(), "gp3", "ebs.csi.aws.com", onQueue)
+	require.NoError(t, err)
+	releaseIO2, err := limiter.Acquire(context.Background(), "io2", "ebs.csi.aws.com", onQueue)
+	require.NoError(t, err)
+
+	// both the storage class and the driver are at their limits
+	acquired := make(chan func())
+	go func() {
+		release, err := limiter.Acquire(context.Background(), "gp3", "ebs.csi.aws.com", onQueue)
+		assert.NoError(t, err)
+		acquired <- release
+	}()
+	require.Eventually(t, func() bool { return queued.Load() == 1 }, time.Second, time.Millisecond)
+
+	// the storage class is still at its limit
+	releaseIO2()
+	select {
+	case <-acquired:
+		t.Fatal("the snapshot should be queued")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	releaseGP3()
+	release := <-acquired
+	assert.Equal(t, int32(0), queued.Load())
+
+	// the queued volumes are released when the context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = limiter.Acquire(ctx, "gp3", "ebs.csi.aws.com", onQueue)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, int32(0), queued.Load())
+
+	// the driver slot taken by the canceled volume was released
+	release()
+	for i := 0; i < 2; i++ {
+		_, err := limiter.Acquire(context.Background(), "io2", "ebs.csi.aws.com", onQueue)
+		require.NoError(t, err)
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volume
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	vsv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/volumesnapshotter/v1"
+)
+
+const (
+	SnapshotOperationCreate = "create"
+	SnapshotOperationDelete = "delete"
+)
+
+// SnapshotThrottler limits the rate of the snapshot API calls made against each
+// VolumeSnapshotLocation according to its RateLimit. The limits are shared by all
+// the backups and backup deletions running concurrently in the server, calls over
+// the limit are queued until they are allowed to proceed.
+type SnapshotThrottler struct {
+	lock     sync.Mutex
+	limiters map[string]*rate.Limiter
+	metrics  *metrics.ServerMetrics
+}
+
+// NewSnapshotThrottler returns a SnapshotThrottler reporting the queued calls to metrics, if not nil.
+func NewSnapshotThrottler(metrics *metrics.ServerMetrics) *SnapshotThrottler {
+	return &SnapshotThrottler{
+		limiters: make(map[string]*rate.Limiter),
+		metrics:  metrics,
+	}
+}
+
+// Wrap returns a VolumeSnapshotter whose snapshot creations and deletions are throttled
+// according to the RateLimit of location, the queued calls giving up once ctx is done.
+// volumeSnapshotter is returned as is if the throttler is nil.
+func (t *SnapshotThrottler) Wrap(ctx context.Context, location *velerov1api.VolumeSnapshotLocation, volumeSnapshotter vsv1.VolumeSnapshotter) vsv1.VolumeSnapshotter {
+	if t == nil {
+		return volumeSnapshotter
+	}
+
+	var limit velerov1api.SnapshotRateLimit
+	if location.Spec.RateLimit != nil {
+		limit = *location.Spec.RateLimit
+	}
+
+	return &throttledVolumeSnapshotter{
+		VolumeSnapshotter: volumeSnapshotter,
+		ctx:               ctx,
+		throttler:         t,
+		location:          location.Namespace + "/" + location.Name,
+		limit:             limit,
+	}
+}
+
+// Wait blocks until a call of operation against location is allowed by perMinute,
+// or ctx is done. A perMinute of zero means no limit.
+func (t *SnapshotThrottler) Wait(ctx context.Context, location, operation string, perMinute int) error {
+	if perMinute <= 0 {
+		return nil
+	}
+
+	limiter := t.limiter(location, operation, perMinute)
+
+	if t.metrics != nil {
+		t.metrics.RegisterSnapshotAPICallQueued(location, operation)
+	}
+	start := time.Now()
+	err := limiter.Wait(ctx)
+	if t.metrics != nil {
+		t.metrics.RegisterSnapshotAPICallDequeued(location, operation, time.Since(start).Seconds())
+	}
+
+	return err
+}
+
+func (t *SnapshotThrottler) limiter(location, operation string, perMinute int) *rate.Limiter {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	key := location + "/" + operation
+	limit := rate.Limit(float64(perMinute) / time.Minute.Seconds())
+
+	limiter, ok := t.limiters[key]
+	if !ok {
+		limiter = rate.NewLimiter(limit, 1)
+		t.limiters[key] = limiter
+	} else if limiter.Limit() != limit {
+		// the RateLimit of the location has been updated since
+		limiter.SetLimit(limit)
+	}
+
+	return limiter
+}
+
+type throttledVolumeSnapshotter struct {
+	vsv1.VolumeSnapshotter
+	ctx       context.Context
+	throttler *SnapshotThrottler
+	location  string
+	limit     velerov1api.SnapshotRateLimit
+}
+
+func (s *throttledVolumeSnapshotter) CreateSnapshot(volumeID, volumeAZ string, tags map[string]string) (string, error) {
+	if err := s.throttler.Wait(s.ctx, s.location, SnapshotOperationCreate, s.limit.CreationsPerMinute); err != nil {
+		return "", err
+	}
+	return s.VolumeSnapshotter.CreateSnapshot(volumeID, volumeAZ, tags)
+}
+
+func (s *throttledVolumeSnapshotter) DeleteSnapshot(snapshotID string) error {
+	if err := s.throttler.Wait(s.ctx, s.location, SnapshotOperationDelete, s.limit.DeletionsPerMinute); err != nil {
+		return err
+	}
+	return s.VolumeSnapshotter.DeleteSnapshot(snapshotID)
+}
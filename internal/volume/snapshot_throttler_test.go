@@ -0,0 +1,100 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volume
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestSnapshotThrottlerWrap(t *testing.T) {
+	volumeSnapshotter := &velerotest.FakeVolumeSnapshotter{
+		SnapshottableVolumes: map[string]velerotest.VolumeBackupInfo{
+			"vol-1": {SnapshotID: "snap-1"},
+		},
+	}
+	location := builder.ForVolumeSnapshotLocation("velero", "default").Provider("aws").Result()
+
+	var throttler *SnapshotThrottler
+	assert.Same(t, volumeSnapshotter, throttler.Wrap(context.Background(), location, volumeSnapshotter))
+
+	throttler = NewSnapshotThrottler(metrics.NewServerMetrics())
+	wrapped := throttler.Wrap(context.Background(), location, volumeSnapshotter)
+	assert.NotSame(t, volumeSnapshotter, wrapped)
+
+	snapshotID, err := wrapped.CreateSnapshot("vol-1", "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "snap-1", snapshotID)
+	require.NoError(t, wrapped.DeleteSnapshot("snap-1"))
+	assert.Empty(t, volumeSnapshotter.SnapshotsTaken)
+
+	// the queued calls give up once the context is done
+	location = builder.ForVolumeSnapshotLocation("velero", "limited").Provider("aws").RateLimit(1, 0).Result()
+	ctx, cancel := context.WithCancel(context.Background())
+	wrapped = throttler.Wrap(ctx, location, volumeSnapshotter)
+	_, err = wrapped.CreateSnapshot("vol-1", "", nil)
+	require.NoError(t, err)
+	cancel()
+	_, err = wrapped.CreateSnapshot("vol-1", "", nil)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestSnapshotThrottlerWait(t *testing.T) {
+	throttler := NewSnapshotThrottler(nil)
+
+	// no limit
+	for i := 0; i < 10; i++ {
+		require.NoError(t, throttler.Wait(context.Background(), "velero/default", SnapshotOperationCreate, 0))
+	}
+
+	// the first call is allowed right away, the next one has to wait for a second
+	require.NoError(t, throttler.Wait(context.Background(), "velero/default", SnapshotOperationCreate, 60))
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	require.Error(t, throttler.Wait(ctx, "velero/default", SnapshotOperationCreate, 60))
+
+	// the limits are per location and per operation
+	require.NoError(t, throttler.Wait(ctx, "velero/default", SnapshotOperationDelete, 60))
+	require.NoError(t, throttler.Wait(ctx, "velero/other", SnapshotOperationCreate, 60))
+}
+
+func TestSnapshotThrottlerLimitUpdate(t *testing.T) {
+	throttler := NewSnapshotThrottler(nil)
+
+	limiter := throttler.limiter("velero/default", SnapshotOperationCreate, 60)
+	assert.InDelta(t, 1, float64(limiter.Limit()), 0.001)
+
+	assert.Same(t, limiter, throttler.limiter("velero/default", SnapshotOperationCreate, 120))
+	assert.InDelta(t, 2, float64(limiter.Limit()), 0.001)
+}
+
+func TestSnapshotRateLimitOfLocation(t *testing.T) {
+	location := builder.ForVolumeSnapshotLocation("velero", "default").RateLimit(10, 0).Result()
+
+	wrapped := NewSnapshotThrottler(nil).Wrap(context.Background(), location, &velerotest.FakeVolumeSnapshotter{}).(*throttledVolumeSnapshotter)
+	assert.Equal(t, "velero/default", wrapped.location)
+	assert.Equal(t, 10, wrapped.limit.CreationsPerMinute)
+	assert.Equal(t, 0, wrapped.limit.DeletionsPerMinute)
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/features"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/util/csi"
 )
 
 type Method string
@@ -411,7 +412,9 @@ func (v *BackupVolumesInformation) generateVolumeInfoForCSIVolumeSnapshot() {
 			continue
 		}
 
-		if volumeSnapshot.Spec.VolumeSnapshotClassName == nil {
+		// the VolumeSnapshots taken as members of a VolumeGroupSnapshot have no class
+		memberOfGroup := volumeSnapshot.Annotations[velerov1api.VolumeSnapshotSourcePVCAnnotation] != ""
+		if volumeSnapshot.Spec.VolumeSnapshotClassName == nil && !memberOfGroup {
 			v.logger.Warnf("Cannot find VolumeSnapshotClass for VolumeSnapshot %s/%s", volumeSnapshot.Namespace, volumeSnapshot.Name)
 			continue
 		}
@@ -421,14 +424,17 @@ func (v *BackupVolumesInformation) generateVolumeInfoForCSIVolumeSnapshot() {
 			continue
 		}
 
-		if volumeSnapshot.Spec.Source.PersistentVolumeClaimName == nil {
+		sourcePVC := csi.GetVolumeSnapshotSourcePVC(&volumeSnapshot)
+		if sourcePVC == "" {
 			v.logger.Warnf("VolumeSnapshot %s/%s doesn't have a source PVC", volumeSnapshot.Namespace, volumeSnapshot.Name)
 			continue
 		}
 
-		for index := range v.volumeSnapshotClasses {
-			if *volumeSnapshot.Spec.VolumeSnapshotClassName == v.volumeSnapshotClasses[index].Name {
-				volumeSnapshotClass = &v.volumeSnapshotClasses[index]
+		if volumeSnapshot.Spec.VolumeSnapshotClassName != nil {
+			for index := range v.volumeSnapshotClasses {
+				if *volumeSnapshot.Spec.VolumeSnapshotClassName == v.volumeSnapshotClasses[index].Name {
+					volumeSnapshotClass = &v.volumeSnapshotClasses[index]
+				}
 			}
 		}
 
@@ -438,11 +444,15 @@ func (v *BackupVolumesInformation) generateVolumeInfoForCSIVolumeSnapshot() {
 			}
 		}
 
-		if volumeSnapshotClass == nil || volumeSnapshotContent == nil {
+		if (volumeSnapshotClass == nil && !memberOfGroup) || volumeSnapshotContent == nil {
 			v.logger.Warnf("fail to get VolumeSnapshotContent or VolumeSnapshotClass for VolumeSnapshot: %s/%s",
 				volumeSnapshot.Namespace, volumeSnapshot.Name)
 			continue
 		}
+		driver := volumeSnapshotContent.Spec.Driver
+		if volumeSnapshotClass != nil {
+			driver = volumeSnapshotClass.Driver
+		}
 
 		var operation itemoperation.BackupOperation
 		for _, op := range v.BackupOperations {
@@ -461,7 +471,7 @@ func (v *BackupVolumesInformation) generateVolumeInfoForCSIVolumeSnapshot() {
 		if volumeSnapshotContent.Status.SnapshotHandle != nil {
 			snapshotHandle = *volumeSnapshotContent.Status.SnapshotHandle
 		}
-		if pvcPVInfo := v.pvMap.retrieve("", *volumeSnapshot.Spec.Source.PersistentVolumeClaimName, volumeSnapshot.Namespace); pvcPVInfo != nil {
+		if pvcPVInfo := v.pvMap.retrieve("", sourcePVC, volumeSnapshot.Namespace); pvcPVInfo != nil {
 			volumeInfo := &BackupVolumeInfo{
 				BackupMethod:          CSISnapshot,
 				PVCName:               pvcPVInfo.PVCName,
@@ -473,7 +483,7 @@ func (v *BackupVolumesInformation) generateVolumeInfoForCSIVolumeSnapshot() {
 				CSISnapshotInfo: &CSISnapshotInfo{
 					VSCName:        *volumeSnapshot.Status.BoundVolumeSnapshotContentName,
 					Size:           size,
-					Driver:         volumeSnapshotClass.Driver,
+					Driver:         driver,
 					SnapshotHandle: snapshotHandle,
 					OperationID:    operation.Spec.OperationID,
 					ReadyToUse:     volumeSnapshot.Status.ReadyToUse,
@@ -490,7 +500,7 @@ func (v *BackupVolumesInformation) generateVolumeInfoForCSIVolumeSnapshot() {
 
 			tmpVolumeInfos = append(tmpVolumeInfos, volumeInfo)
 		} else {
-			v.logger.Warnf("cannot find info for PVC %s/%s", volumeSnapshot.Namespace, volumeSnapshot.Spec.Source.PersistentVolumeClaimName)
+			v.logger.Warnf("cannot find info for PVC %s/%s", volumeSnapshot.Namespace, sourcePVC)
 			continue
 		}
 	}
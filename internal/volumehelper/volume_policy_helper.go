@@ -167,6 +167,7 @@ func (v volumeHelperImpl) ShouldPerformFSBackup(volume corev1api.Volume, pod cor
 		}
 
 		vfd := resourcepolicies.NewVolumeFilterData(pv, podVolume, pvc)
+		vfd.Pod = &pod
 		action, err := v.volumePolicy.GetMatchAction(vfd)
 		if err != nil {
 			v.logger.WithError(err).Error("fail to get VolumePolicy match action for volume")
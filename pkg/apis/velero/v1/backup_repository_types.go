@@ -79,6 +79,19 @@ type BackupRepositoryStatus struct {
 	// RecentMaintenance is status of the recent repo maintenance.
 	// +optional
 	RecentMaintenance []BackupRepositoryMaintenanceStatus `json:"recentMaintenance,omitempty"`
+	// ObservedGeneration is the generation of the BackupRepository the status was last updated for. Velero
+	// resources have no status subresource, so the generation also changes when the status does.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions describe the current state of the BackupRepository in a form which is common to all
+	// Velero resources, so that tools like kubectl wait and GitOps health checks can use them.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 }
 
 // BackupRepositoryMaintenanceResult represents the result of a repo maintenance.
@@ -101,6 +101,26 @@ type BackupSpec struct {
 	// +nullable
 	OrLabelSelectors []*metav1.LabelSelector `json:"orLabelSelectors,omitempty"`
 
+	// ExcludedItems excludes from the backup the items of the given resources whose fields
+	// match, e.g. the Pods in the Succeeded phase or the Events older than a day, which the
+	// other filters would include.
+	// +optional
+	// +nullable
+	ExcludedItems []ItemFieldFilter `json:"excludedItems,omitempty"`
+
+	// ListFieldSelectors are passed to the API server when listing the items of the given
+	// resources, so only the matching items are listed and backed up, e.g. the Secrets of type
+	// kubernetes.io/tls. This reduces the load of listing the resources with many items.
+	// +optional
+	// +nullable
+	ListFieldSelectors []ResourceFieldSelector `json:"listFieldSelectors,omitempty"`
+
+	// FieldProjections removes fields from the items of the given resources before they're
+	// written to the backup, e.g. the managed fields of all the items or the data of the Secrets.
+	// +optional
+	// +nullable
+	FieldProjections []FieldProjection `json:"fieldProjections,omitempty"`
+
 	// SnapshotVolumes specifies whether to take snapshots
 	// of any PV's referenced in the set of objects included
 	// in the Backup.
@@ -123,10 +143,38 @@ type BackupSpec struct {
 	// +optional
 	Hooks BackupHooks `json:"hooks,omitempty"`
 
+	// DeletionHooks are the hooks executed before and after the backup is deleted.
+	// +optional
+	// +nullable
+	DeletionHooks *BackupDeletionHooks `json:"deletionHooks,omitempty"`
+
 	// StorageLocation is a string containing the name of a BackupStorageLocation where the backup should be stored.
 	// +optional
 	StorageLocation string `json:"storageLocation,omitempty"`
 
+	// MirrorStorageLocation is the name of a second BackupStorageLocation the backup is written to
+	// synchronously, along with StorageLocation.
+	// +optional
+	MirrorStorageLocation string `json:"mirrorStorageLocation,omitempty"`
+
+	// MirrorFailurePolicy is how the backup ends when it can't be written to its MirrorStorageLocation:
+	// Fail ends the backup as Failed, Warn keeps the backup in StorageLocation and records a warning.
+	// The default value is Fail.
+	// +optional
+	MirrorFailurePolicy MirrorFailurePolicy `json:"mirrorFailurePolicy,omitempty"`
+
+	// TargetCluster is the name of a Secret in the Velero namespace holding, under the key
+	// "kubeconfig", the kubeconfig of the cluster to back up instead of the cluster Velero runs in.
+	// It's only honored by a server running in the hub mode.
+	// +optional
+	TargetCluster string `json:"targetCluster,omitempty"`
+
+	// Tags are set on the provider snapshots of the backup and, when its storage location enables
+	// object tagging, on its objects in object storage, e.g. to attribute the costs of the backup.
+	// The names of the backup and of its schedule are always set.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
 	// VolumeSnapshotLocations is a list containing names of VolumeSnapshotLocations associated with this backup.
 	// +optional
 	VolumeSnapshotLocations []string `json:"volumeSnapshotLocations,omitempty"`
@@ -162,10 +210,57 @@ type BackupSpec struct {
 	// The default value is 4 hour.
 	// +optional
 	ItemOperationTimeout metav1.Duration `json:"itemOperationTimeout,omitempty"`
+
+	// MaxDuration is the longest the backup may run, from its start until its asynchronous
+	// operations are complete, so that it can't overlap the next backup window. When exceeded,
+	// the items not backed up yet are skipped and the operations still in progress are canceled.
+	// Zero, the default, means no limit.
+	// +optional
+	MaxDuration metav1.Duration `json:"maxDuration,omitempty"`
+
+	// MaxDurationAction is how a backup exceeding its MaxDuration ends: PartiallyFail keeps what
+	// was backed up and ends the backup as PartiallyFailed, Cancel ends it as Failed.
+	// The default value is PartiallyFail.
+	// +optional
+	MaxDurationAction MaxDurationAction `json:"maxDurationAction,omitempty"`
+
+	// ErrorBudget is the most items which may fail to be backed up: the failed items are
+	// quarantined and the backup goes on, until more items failed, when the backup is aborted
+	// and ends as Failed. Nil, the default, means no limit.
+	// +optional
+	// +nullable
+	// +kubebuilder:validation:Minimum=0
+	ErrorBudget *int `json:"errorBudget,omitempty"`
+
+	// NamespacePhased specifies whether the namespaces are backed up one after another as
+	// independent phases, with a result recorded per namespace in the backup status.
+	// +optional
+	// +nullable
+	NamespacePhased *bool `json:"namespacePhased,omitempty"`
+
+	// IncludeClusterScopedDependencies specifies whether the cluster-scoped resources the backed
+	// up namespaced items reference, like the StorageClasses of the PVCs, the ClusterRoles of the
+	// RoleBindings and the IngressClasses of the Ingresses, are backed up even when the
+	// cluster-scoped resources aren't included. The explicitly excluded resources aren't.
+	// +optional
+	// +nullable
+	IncludeClusterScopedDependencies *bool `json:"includeClusterScopedDependencies,omitempty"`
+
 	// ResourcePolicy specifies the referenced resource policies that backup should follow
 	// +optional
 	ResourcePolicy *v1.TypedLocalObjectReference `json:"resourcePolicy,omitempty"`
 
+	// InlineResourcePolicy is a resource policies document the backup should follow, in the
+	// format of the documents of the ConfigMaps ResourcePolicy references. It can't be set
+	// together with ResourcePolicy.
+	// +optional
+	InlineResourcePolicy string `json:"inlineResourcePolicy,omitempty"`
+
+	// ResourceModifier specifies the reference to the resource modifiers applied to the backed up
+	// items before they're written to the backup, e.g. to redact sensitive fields.
+	// +optional
+	ResourceModifier *v1.TypedLocalObjectReference `json:"resourceModifier,omitempty"`
+
 	// SnapshotMoveData specifies whether snapshot data should be moved
 	// +optional
 	// +nullable
@@ -180,6 +275,67 @@ type BackupSpec struct {
 	// +optional
 	// +nullable
 	UploaderConfig *UploaderConfigForBackup `json:"uploaderConfig,omitempty"`
+
+	// VolumeGroupSnapshotLabelKey is the key of the label grouping the CSI volumes of a namespace:
+	// the PVCs with the same value of the label are snapshotted together by a single crash-consistent
+	// VolumeGroupSnapshot instead of a VolumeSnapshot each. Empty, the default, means the
+	// "velero.io/volume-group" label.
+	// +optional
+	VolumeGroupSnapshotLabelKey string `json:"volumeGroupSnapshotLabelKey,omitempty"`
+
+	// PhaseRetries specifies how many times the phases of the backup are re-run after they
+	// failed, so that transient failures, e.g. an API server rollout or the loss of the node
+	// hosting a node-agent, don't fail the backup. Nil, the default, means no retry.
+	// +optional
+	// +nullable
+	PhaseRetries *BackupPhaseRetries `json:"phaseRetries,omitempty"`
+
+	// LoadBalancerPolicy is how the Services of type LoadBalancer of the backup are restored by
+	// the restores which don't set theirs.
+	// +optional
+	// +nullable
+	LoadBalancerPolicy *LoadBalancerPolicy `json:"loadBalancerPolicy,omitempty"`
+}
+
+// ItemFieldFilter selects the items of a resource by the values of their fields. An item is
+// selected when it matches both the field selector and the age, when set.
+type ItemFieldFilter struct {
+	// Resource is the name of the resource of the items, e.g. pods or events.events.k8s.io.
+	Resource string `json:"resource"`
+
+	// FieldSelector selects the items by the values of their fields, with the syntax of the
+	// Kubernetes field selectors, e.g. status.phase=Succeeded. Unlike the field selectors of the
+	// API server, it supports any field of the items.
+	// +optional
+	FieldSelector string `json:"fieldSelector,omitempty"`
+
+	// OlderThan selects the items created longer ago than the duration.
+	// +optional
+	// +nullable
+	OlderThan *metav1.Duration `json:"olderThan,omitempty"`
+}
+
+// ResourceFieldSelector is a field selector the API server lists the items of a resource with.
+type ResourceFieldSelector struct {
+	// Resource is the name of the resource of the items, e.g. secrets or events.events.k8s.io.
+	Resource string `json:"resource"`
+
+	// FieldSelector is the Kubernetes field selector of the items, e.g. type=kubernetes.io/tls.
+	// Only the fields the API server supports for the resource can be used.
+	FieldSelector string `json:"fieldSelector"`
+}
+
+// FieldProjection removes fields from the items of a resource before they're written to the
+// backup.
+type FieldProjection struct {
+	// Resource is the name of the resource of the items, e.g. secrets or deployments.apps, or "*"
+	// for all the resources.
+	Resource string `json:"resource"`
+
+	// ExcludedFields are the JSONPath expressions of the fields removed from the items, e.g.
+	// .metadata.managedFields, .data or .metadata.annotations['kubectl.kubernetes.io/last-applied-configuration'].
+	// The fields identifying the items can't be removed.
+	ExcludedFields []string `json:"excludedFields"`
 }
 
 // UploaderConfigForBackup defines the configuration for the uploader when doing backup.
@@ -187,6 +343,35 @@ type UploaderConfigForBackup struct {
 	// ParallelFilesUpload is the number of files parallel uploads to perform when using the uploader.
 	// +optional
 	ParallelFilesUpload int `json:"parallelFilesUpload,omitempty"`
+
+	// ChangedBlockTracking enables moving only the blocks changed since the previous backup of
+	// the same PVC for the block volumes whose CSI driver serves the SnapshotMetadata API, when
+	// snapshot data is moved by the built-in data mover.
+	// +optional
+	ChangedBlockTracking bool `json:"changedBlockTracking,omitempty"`
+}
+
+// BackupPhaseRetries defines how many times each phase of a backup is re-run after it failed.
+// The phases are idempotent, so re-running them doesn't duplicate any data.
+type BackupPhaseRetries struct {
+	// ItemCollection is the number of times listing the items of a resource is retried after a
+	// transient error of the API server, e.g. while it's rolled out.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	ItemCollection int `json:"itemCollection,omitempty"`
+
+	// Snapshot is the number of times taking the native or CSI snapshot of a volume is retried
+	// after a transient error, e.g. of the API server or of the plugin process.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Snapshot int `json:"snapshot,omitempty"`
+
+	// Upload is the number of times a failed PodVolumeBackup is re-created, or a failed or
+	// canceled DataUpload is re-run with a new snapshot, e.g. after the node hosting the
+	// node-agent which uploaded the data was lost.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Upload int `json:"upload,omitempty"`
 }
 
 // BackupHooks contains custom behaviors that should be executed at different phases of the backup.
@@ -195,6 +380,13 @@ type BackupHooks struct {
 	// +optional
 	// +nullable
 	Resources []BackupResourceHookSpec `json:"resources,omitempty"`
+
+	// PodDisruptionBudgetPolicy is how the hooks of a pod are handled when the PodDisruptionBudgets
+	// covering it allow no more disruption: Respect skips them and records a warning, Ignore runs
+	// them and records a warning. A pod counts as disrupted from the start of its pre hooks until
+	// its post hooks run. The default value is Ignore.
+	// +optional
+	PodDisruptionBudgetPolicy PodDisruptionBudgetPolicy `json:"podDisruptionBudgetPolicy,omitempty"`
 }
 
 // BackupResourceHookSpec defines one or more BackupResourceHooks that should be executed based on
@@ -247,6 +439,35 @@ type BackupResourceHook struct {
 	Exec *ExecHook `json:"exec"`
 }
 
+// BackupDeletionHooks contains the hooks executed when a backup is deleted, e.g. to notify an
+// external inventory or to release the locks of external snapshots.
+type BackupDeletionHooks struct {
+	// PreHooks are executed before the data of the backup is deleted. A failing hook whose
+	// OnError is Fail aborts the deletion.
+	// +optional
+	PreHooks []BackupDeletionHook `json:"pre,omitempty"`
+
+	// PostHooks are executed after the backup is deleted.
+	// +optional
+	PostHooks []BackupDeletionHook `json:"post,omitempty"`
+}
+
+// BackupDeletionHook is a command executed in a pod when a backup is deleted.
+type BackupDeletionHook struct {
+	// Name is the name of this hook.
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the pod the command is executed in.
+	Namespace string `json:"namespace"`
+
+	// PodSelector selects the pod the command is executed in, the first running pod
+	// matching it is used.
+	PodSelector *metav1.LabelSelector `json:"podSelector"`
+
+	// Exec defines the command to execute.
+	Exec *ExecHook `json:"exec"`
+}
+
 // ExecHook is a hook that uses the pod exec API to execute a command in a container in a pod.
 type ExecHook struct {
 	// Container is the container in the pod where the command should be executed. If not specified,
@@ -282,6 +503,225 @@ const (
 	HookErrorModeFail HookErrorMode = "Fail"
 )
 
+// MaxDurationAction is how a backup exceeding its MaxDuration ends.
+// +kubebuilder:validation:Enum=PartiallyFail;Cancel
+type MaxDurationAction string
+
+const (
+	// MaxDurationActionPartiallyFail keeps the items backed up before the MaxDuration was exceeded
+	// and ends the backup as PartiallyFailed.
+	MaxDurationActionPartiallyFail MaxDurationAction = "PartiallyFail"
+
+	// MaxDurationActionCancel ends the backup as Failed.
+	MaxDurationActionCancel MaxDurationAction = "Cancel"
+)
+
+// PodDisruptionBudgetPolicy is how the hooks of a pod are handled when the PodDisruptionBudgets
+// covering it allow no more disruption.
+// +kubebuilder:validation:Enum=Respect;Ignore
+type PodDisruptionBudgetPolicy string
+
+const (
+	// PodDisruptionBudgetPolicyRespect skips the hooks of the pod and records a warning.
+	PodDisruptionBudgetPolicyRespect PodDisruptionBudgetPolicy = "Respect"
+
+	// PodDisruptionBudgetPolicyIgnore runs the hooks of the pod and records a warning.
+	PodDisruptionBudgetPolicyIgnore PodDisruptionBudgetPolicy = "Ignore"
+)
+
+// MirrorFailurePolicy is how a backup which can't be written to its mirror storage location ends.
+// +kubebuilder:validation:Enum=Fail;Warn
+type MirrorFailurePolicy string
+
+const (
+	// MirrorFailurePolicyFail ends the backup as Failed.
+	MirrorFailurePolicyFail MirrorFailurePolicy = "Fail"
+
+	// MirrorFailurePolicyWarn keeps the backup in its storage location and records a warning.
+	MirrorFailurePolicyWarn MirrorFailurePolicy = "Warn"
+)
+
+// MirrorPhase is the phase of the copy of a backup in its mirror storage location.
+// +kubebuilder:validation:Enum=Completed;Failed
+type MirrorPhase string
+
+const (
+	// MirrorPhaseCompleted means the backup was written to its mirror storage location.
+	MirrorPhaseCompleted MirrorPhase = "Completed"
+
+	// MirrorPhaseFailed means the backup couldn't be written to its mirror storage location.
+	MirrorPhaseFailed MirrorPhase = "Failed"
+)
+
+// NamespaceResultPhase is the outcome of the backup of a namespace.
+// +kubebuilder:validation:Enum=Completed;Failed
+type NamespaceResultPhase string
+
+const (
+	// NamespaceResultPhaseCompleted means the namespace was backed up without errors.
+	NamespaceResultPhaseCompleted NamespaceResultPhase = "Completed"
+
+	// NamespaceResultPhaseFailed means errors were encountered backing up the namespace.
+	NamespaceResultPhaseFailed NamespaceResultPhase = "Failed"
+)
+
+// NamespaceResult is the result of the backup of a namespace in a namespace-phased backup.
+type NamespaceResult struct {
+	// Namespace is the name of the namespace.
+	Namespace string `json:"namespace"`
+
+	// Phase is the outcome of the backup of the namespace.
+	Phase NamespaceResultPhase `json:"phase"`
+
+	// ItemsBackedUp is the number of items of the namespace that were backed up.
+	// +optional
+	ItemsBackedUp int `json:"itemsBackedUp,omitempty"`
+
+	// Errors is the number of errors encountered backing up the namespace.
+	// +optional
+	Errors int `json:"errors,omitempty"`
+
+	// Warnings is the number of warnings encountered backing up the namespace.
+	// +optional
+	Warnings int `json:"warnings,omitempty"`
+}
+
+// ItemErrorClass is the class of the error an item failed with.
+type ItemErrorClass string
+
+const (
+	ItemErrorClassNotFound      ItemErrorClass = "NotFound"
+	ItemErrorClassForbidden     ItemErrorClass = "Forbidden"
+	ItemErrorClassAlreadyExists ItemErrorClass = "AlreadyExists"
+	ItemErrorClassConflict      ItemErrorClass = "Conflict"
+	ItemErrorClassInvalid       ItemErrorClass = "Invalid"
+	ItemErrorClassTimeout       ItemErrorClass = "Timeout"
+	ItemErrorClassUnavailable   ItemErrorClass = "Unavailable"
+	ItemErrorClassUnknown       ItemErrorClass = "Unknown"
+)
+
+// QuarantinedItem is an item which failed to be backed up or restored.
+type QuarantinedItem struct {
+	// Resource is the group resource of the item.
+	Resource string `json:"resource"`
+
+	// Namespace is the namespace of the item, empty for a cluster-scoped item.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the item.
+	Name string `json:"name"`
+
+	// ErrorClass is the class of the error the item failed with.
+	ErrorClass ItemErrorClass `json:"errorClass"`
+
+	// Error is the error the item failed with.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// IncompleteItem is an item which wasn't backed up, or whose asynchronous operation was
+// canceled, because the backup exceeded its MaxDuration.
+type IncompleteItem struct {
+	// Resource is the group resource of the item.
+	Resource string `json:"resource"`
+
+	// Namespace is the namespace of the item, empty for a cluster-scoped item.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the item.
+	Name string `json:"name"`
+}
+
+// SkipReason is the reason an item was not included in a backup.
+// +kubebuilder:validation:Enum=ExcludeLabel;NamespaceExcluded;ResourceExcluded;LabelSelector;BeingDeleted;Plugin;Policy;FieldSelector
+type SkipReason string
+
+const (
+	// SkipReasonExcludeLabel means the item has the velero.io/exclude-from-backup=true label.
+	SkipReasonExcludeLabel SkipReason = "ExcludeLabel"
+
+	// SkipReasonNamespaceExcluded means the namespace of the item is excluded by the
+	// namespace filters of the backup.
+	SkipReasonNamespaceExcluded SkipReason = "NamespaceExcluded"
+
+	// SkipReasonResourceExcluded means the type of the item is excluded by the resource
+	// filters of the backup, or by default.
+	SkipReasonResourceExcluded SkipReason = "ResourceExcluded"
+
+	// SkipReasonLabelSelector means the item doesn't match the label selectors of the backup.
+	SkipReasonLabelSelector SkipReason = "LabelSelector"
+
+	// SkipReasonBeingDeleted means the item was being deleted.
+	SkipReasonBeingDeleted SkipReason = "BeingDeleted"
+
+	// SkipReasonPlugin means a backup item action excluded the item.
+	SkipReasonPlugin SkipReason = "Plugin"
+
+	// SkipReasonPolicy means a volume policy with the skip action matched the persistent
+	// volume, so its data isn't backed up, while the persistent volume itself is.
+	SkipReasonPolicy SkipReason = "Policy"
+
+	// SkipReasonFieldSelector means the item matches an excluded items filter of the backup.
+	SkipReasonFieldSelector SkipReason = "FieldSelector"
+)
+
+// SkipReasonCount is the number of items skipped by a backup for a reason.
+type SkipReasonCount struct {
+	// Reason is the reason the items were skipped.
+	Reason SkipReason `json:"reason"`
+
+	// Count is the number of items skipped for the reason.
+	Count int `json:"count"`
+}
+
+// VolumePolicyHit is the number of volumes of a backup a volume policy matched.
+type VolumePolicyHit struct {
+	// Policy is the position of the policy in the volume policies, prefixed with the name of
+	// its document when the ConfigMap of the resource policies holds several ones.
+	Policy string `json:"policy"`
+
+	// Action is the type of the action of the policy.
+	Action string `json:"action"`
+
+	// Matched is the number of volumes the policy matched.
+	Matched int `json:"matched"`
+
+	// Applied is the number of volumes the action of the policy was applied to, the ones it
+	// matched first.
+	Applied int `json:"applied"`
+}
+
+// VolumePolicyConflict is a volume of a backup several volume policies matched.
+type VolumePolicyConflict struct {
+	// Volume is the namespace and name of the PVC of the volume, else the name of its PV or
+	// pod volume.
+	Volume string `json:"volume"`
+
+	// Policies are the policies the volume matched, named like in VolumePolicyHit, the applied
+	// one first.
+	Policies []string `json:"policies"`
+}
+
+// SkippedItem is an item which was not included in a backup. A type skipped as a whole
+// is recorded with an empty name.
+type SkippedItem struct {
+	// Resource is the group resource of the item.
+	Resource string `json:"resource"`
+
+	// Namespace is the namespace of the item, empty for a cluster-scoped item.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the item.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Reason is the reason the item was skipped.
+	Reason SkipReason `json:"reason"`
+}
+
 // BackupPhase is a string representation of the lifecycle phase
 // of a Velero backup.
 // +kubebuilder:validation:Enum=New;FailedValidation;InProgress;WaitingForPluginOperations;WaitingForPluginOperationsPartiallyFailed;Finalizing;FinalizingPartiallyFailed;Completed;PartiallyFailed;Failed;Deleting
@@ -363,6 +803,12 @@ type BackupStatus struct {
 	// +nullable
 	Expiration *metav1.Time `json:"expiration,omitempty"`
 
+	// ImmutableUntil is when the objects of the Backup, written locked to a backup storage
+	// location with object locks, can be deleted.
+	// +optional
+	// +nullable
+	ImmutableUntil *metav1.Time `json:"immutableUntil,omitempty"`
+
 	// Phase is the current state of the Backup.
 	// +optional
 	Phase BackupPhase `json:"phase,omitempty"`
@@ -447,10 +893,113 @@ type BackupStatus struct {
 	// +optional
 	BackupItemOperationsFailed int `json:"backupItemOperationsFailed,omitempty"`
 
+	// MaxDurationExceeded is true when the backup ran longer than its MaxDuration and was ended early.
+	// +optional
+	MaxDurationExceeded bool `json:"maxDurationExceeded,omitempty"`
+
+	// IncompleteItems is the number of items which weren't backed up because the backup
+	// exceeded its MaxDuration, including the items whose asynchronous operations were canceled.
+	// +optional
+	IncompleteItems int `json:"incompleteItems,omitempty"`
+
+	// IncompleteItemList lists the items counted in IncompleteItems, up to the first 100 of them.
+	// +optional
+	// +nullable
+	IncompleteItemList []IncompleteItem `json:"incompleteItemList,omitempty"`
+
+	// QuarantinedItems lists the items which failed to be backed up, up to the first 100 of them.
+	// +optional
+	// +nullable
+	QuarantinedItems []QuarantinedItem `json:"quarantinedItems,omitempty"`
+
+	// SkippedItems are the numbers of items which were not included in the backup, by
+	// reason. The skipped items are listed in a file downloadable as BackupSkippedItems.
+	// +optional
+	// +nullable
+	SkippedItems []SkipReasonCount `json:"skippedItems,omitempty"`
+
+	// VolumePolicyHits are the numbers of volumes each volume policy of the resource policies
+	// of the backup matched, including the policies which matched no volume.
+	// +optional
+	// +nullable
+	VolumePolicyHits []VolumePolicyHit `json:"volumePolicyHits,omitempty"`
+
+	// VolumePolicyConflicts are the volumes several volume policies of the resource policies of
+	// the backup matched, when the resource policies set reportConflicts.
+	// +optional
+	// +nullable
+	VolumePolicyConflicts []VolumePolicyConflict `json:"volumePolicyConflicts,omitempty"`
+
+	// ErrorBudgetExceeded is true when more items failed than the ErrorBudget of the backup
+	// allows and the backup was aborted.
+	// +optional
+	ErrorBudgetExceeded bool `json:"errorBudgetExceeded,omitempty"`
+
+	// MirrorPhase is the phase of the copy of the backup in its MirrorStorageLocation.
+	// +optional
+	MirrorPhase MirrorPhase `json:"mirrorPhase,omitempty"`
+
+	// MirrorFailureReason is the error which prevented the backup from being written to
+	// its MirrorStorageLocation.
+	// +optional
+	MirrorFailureReason string `json:"mirrorFailureReason,omitempty"`
+
+	// ArtifactDigests are the hex-encoded SHA-256 digests of the files of the backup uploaded to
+	// its backup storage location, keyed by the names of the files. The metadata file is not
+	// included. The digests are validated when the backup is synced from the location.
+	// +optional
+	// +nullable
+	ArtifactDigests map[string]string `json:"artifactDigests,omitempty"`
+
+	// Signed is true once the signed manifest of the files of the backup is written to its backup
+	// storage location. A signed backup whose manifest is missing isn't restored.
+	// +optional
+	Signed bool `json:"signed,omitempty"`
+
+	// NamespaceResults are the results of the backup of each namespace, recorded
+	// when the backup is namespace-phased.
+	// +optional
+	// +nullable
+	NamespaceResults []NamespaceResult `json:"namespaceResults,omitempty"`
+
+	// TargetCluster is the status of the target cluster the backup was taken of, when it was
+	// processed by a server in the hub mode.
+	// +optional
+	// +nullable
+	TargetCluster *TargetClusterStatus `json:"targetCluster,omitempty"`
+
 	// HookStatus contains information about the status of the hooks.
 	// +optional
 	// +nullable
 	HookStatus *HookStatus `json:"hookStatus,omitempty"`
+	// ObservedGeneration is the generation of the Backup the status was last updated for. Velero
+	// resources have no status subresource, so the generation also changes when the status does.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions describe the current state of the Backup in a form which is common to all
+	// Velero resources, so that tools like kubectl wait and GitOps health checks can use them.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// TargetClusterStatus is the status of the target cluster a backup or a restore processed by a
+// server in the hub mode was run against.
+type TargetClusterStatus struct {
+	// Name is the name of the Secret holding the kubeconfig of the target cluster.
+	Name string `json:"name"`
+
+	// ServerVersion is the Kubernetes version of the target cluster.
+	// +optional
+	ServerVersion string `json:"serverVersion,omitempty"`
+
+	// Host is the address of the API server of the target cluster.
+	// +optional
+	Host string `json:"host,omitempty"`
 }
 
 // BackupProgress stores information about the progress of a Backup's execution.
@@ -466,6 +1015,12 @@ type BackupProgress struct {
 	// backup tarball so far.
 	// +optional
 	ItemsBackedUp int `json:"itemsBackedUp,omitempty"`
+
+	// QueuedVolumeSnapshots is the number of volumes waiting for the CSI snapshots of other
+	// volumes of the same storage class or driver to be created, because of the limits of the
+	// server.
+	// +optional
+	QueuedVolumeSnapshots int `json:"queuedVolumeSnapshots,omitempty"`
 }
 
 // HookStatus stores information about the status of the hooks.
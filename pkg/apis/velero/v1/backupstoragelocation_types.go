@@ -18,6 +18,7 @@ package v1
 
 import (
 	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -54,6 +55,171 @@ type BackupStorageLocationSpec struct {
 	// +optional
 	// +nullable
 	ValidationFrequency *metav1.Duration `json:"validationFrequency,omitempty"`
+
+	// StorageBudget is the most storage the backups in this location may use, approximated by the
+	// logical bytes of the volume data they stored through the file system backup and the data
+	// mover, before deduplication and compression, as if each backup were a full one. When exceeded,
+	// the oldest backups are deleted even though their TTL hasn't expired yet. The newest backup
+	// is always kept.
+	// +optional
+	// +nullable
+	StorageBudget *resource.Quantity `json:"storageBudget,omitempty"`
+
+	// BackupLogsTTL is how long the logs and results of the backups in this location are kept
+	// after the backups started, independently of the TTL of the backups. The logs and results
+	// of a backup deleted before that are kept in the location until then, while those expiring
+	// first are deleted before the backup. They share the lifetime of the backups when not set.
+	// +optional
+	// +nullable
+	BackupLogsTTL *metav1.Duration `json:"backupLogsTTL,omitempty"`
+
+	// ObjectTagging specifies whether the tags of the backups are passed to the object store
+	// plugin, under the "tagging" configuration key, to be set on the objects of the backups.
+	// It must only be enabled for the plugins supporting that key.
+	// +optional
+	ObjectTagging bool `json:"objectTagging,omitempty"`
+
+	// StorageLayout is how the files of the location are laid out under its prefix. The flat v1
+	// layout is used when not set.
+	// +optional
+	// +nullable
+	StorageLayout *StorageLayout `json:"storageLayout,omitempty"`
+
+	// UploadVerification makes Velero verify the checksums of the objects it uploads to the
+	// location, uploading them again when they don't match. They're not verified when not set.
+	// +optional
+	// +nullable
+	UploadVerification *UploadVerification `json:"uploadVerification,omitempty"`
+
+	// HealthProbe is when the periodic validation of the location marks it unavailable. It's
+	// marked unavailable as soon as a validation fails when not set.
+	// +optional
+	// +nullable
+	HealthProbe *HealthProbe `json:"healthProbe,omitempty"`
+
+	// FailoverLocations are the backup storage locations the scheduled backups targeting this
+	// location are written to while it's unavailable, the first available one being used.
+	// +optional
+	FailoverLocations []string `json:"failoverLocations,omitempty"`
+
+	// Encryption makes Velero encrypt the backup tarballs, the backup metadata and the logs it
+	// writes to the location on the client side, so that they're protected even when the
+	// encryption of the bucket is misconfigured. They aren't encrypted when not set.
+	// +optional
+	// +nullable
+	Encryption *Encryption `json:"encryption,omitempty"`
+
+	// Signing makes Velero sign the manifest of the digests of the objects of each backup it
+	// finalizes in the location, verified before the backup is restored. The backups aren't
+	// signed when not set.
+	// +optional
+	// +nullable
+	Signing *Signing `json:"signing,omitempty"`
+
+	// ObjectLock makes Velero write the objects of the backups to the location locked until the
+	// backups expire, for a bucket with object locks enabled like S3 Object Lock, so that they
+	// can't be overwritten nor deleted before. It requires the object store plugin to support
+	// object locks. The objects aren't locked when not set.
+	// +optional
+	// +nullable
+	ObjectLock *ObjectLock `json:"objectLock,omitempty"`
+}
+
+// Encryption is how the objects written to a backup storage location are encrypted. Each object
+// is encrypted with AES-256-GCM by a data key of its own, stored along with the object once
+// wrapped by the key of the key provider.
+type Encryption struct {
+	// KeyProvider is the provider of the key wrapping the data keys.
+	KeyProvider EncryptionKeyProvider `json:"keyProvider"`
+
+	// Key is the key of the Secret, in the Velero namespace, holding the base64-encoded 256-bit
+	// key wrapping the data keys. It's required by the Secret key provider.
+	// +optional
+	// +nullable
+	Key *corev1api.SecretKeySelector `json:"key,omitempty"`
+
+	// KeyID identifies the key of the key management service wrapping the data keys: the ARN of
+	// the AWS KMS key, the URL of the Azure Key Vault key, or the resource name of the GCP KMS
+	// crypto key. It's required by the key providers of the key management services.
+	// +optional
+	KeyID string `json:"keyID,omitempty"`
+}
+
+// Signing is how the manifests of the backups written to a backup storage location are signed.
+type Signing struct {
+	// Key is the key of the Secret, in the Velero namespace, holding the base64-encoded 32-byte
+	// seed of the Ed25519 private key signing the files of the backups.
+	Key corev1api.SecretKeySelector `json:"key"`
+}
+
+// ObjectLock is how the objects of the backups written to a backup storage location are locked.
+type ObjectLock struct {
+	// Mode is the mode of the locks.
+	Mode ObjectLockMode `json:"mode"`
+}
+
+// ObjectLockMode is the mode of the locks of the objects of the backups.
+// +kubebuilder:validation:Enum=Governance;Compliance
+type ObjectLockMode string
+
+const (
+	// ObjectLockModeGovernance locks the objects for the users without the permission to bypass
+	// the governance locks.
+	ObjectLockModeGovernance ObjectLockMode = "Governance"
+
+	// ObjectLockModeCompliance locks the objects for all the users, the retention of the objects
+	// can't be shortened.
+	ObjectLockModeCompliance ObjectLockMode = "Compliance"
+)
+
+// HealthProbe is when the periodic validation of a backup storage location marks it unavailable.
+type HealthProbe struct {
+	// LatencyThreshold is the longest a validation of the location may take, the slower
+	// validations failing. There's no threshold when not set.
+	// +optional
+	LatencyThreshold metav1.Duration `json:"latencyThreshold,omitempty"`
+
+	// FailureThreshold is the number of validations in a row which must fail for the location to
+	// be marked unavailable, 1 when not set.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	FailureThreshold int32 `json:"failureThreshold,omitempty"`
+}
+
+// UploadVerification is how the checksums of the objects uploaded to a backup storage location
+// are verified.
+type UploadVerification struct {
+	// Algorithm is the algorithm of the checksums.
+	Algorithm ChecksumAlgorithm `json:"algorithm"`
+
+	// MaxRetries is how many times an object is uploaded again when its checksum doesn't match,
+	// 3 when not set.
+	// +optional
+	// +nullable
+	// +kubebuilder:validation:Minimum=0
+	MaxRetries *int `json:"maxRetries,omitempty"`
+
+	// ProviderValidation specifies whether the algorithm is also passed to the object store
+	// plugin, under the "checksumAlgorithm" configuration key, for the provider to validate the
+	// checksums of the uploads itself. It must only be enabled for the plugins supporting that key.
+	// +optional
+	ProviderValidation bool `json:"providerValidation,omitempty"`
+}
+
+// StorageLayout is how the files of a backup storage location are laid out under its prefix.
+type StorageLayout struct {
+	// Version is the version of the layout: v1 stores the files directly under the prefix, v2
+	// under a per-tenant and per-cluster prefix, along with an index object listing the backups.
+	// +optional
+	Version StorageLayoutVersion `json:"version,omitempty"`
+
+	// Tenant is the tenant the files are stored for with the v2 layout, "default" when empty.
+	// +optional
+	Tenant string `json:"tenant,omitempty"`
+
+	// Cluster is the cluster the files are stored for with the v2 layout, "default" when empty.
+	// +optional
+	Cluster string `json:"cluster,omitempty"`
 }
 
 // BackupStorageLocationStatus defines the observed state of BackupStorageLocation
@@ -74,6 +240,15 @@ type BackupStorageLocationStatus struct {
 	// +nullable
 	LastValidationTime *metav1.Time `json:"lastValidationTime,omitempty"`
 
+	// ValidationFailures is the number of the last validations of the backup storage location
+	// that failed in a row. The validation frequency is backed off while they keep failing.
+	// +optional
+	ValidationFailures int32 `json:"validationFailures,omitempty"`
+
+	// LastValidationLatency is how long the last validation of the backup storage location took.
+	// +optional
+	LastValidationLatency metav1.Duration `json:"lastValidationLatency,omitempty"`
+
 	// Message is a message about the backup storage location's status.
 	// +optional
 	Message string `json:"message,omitempty"`
@@ -92,6 +267,19 @@ type BackupStorageLocationStatus struct {
 	// will be removed entirely as of v2.0.
 	// +optional
 	AccessMode BackupStorageLocationAccessMode `json:"accessMode,omitempty"`
+	// ObservedGeneration is the generation of the BackupStorageLocation the status was last updated for. Velero
+	// resources have no status subresource, so the generation also changes when the status does.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions describe the current state of the BackupStorageLocation in a form which is common to all
+	// Velero resources, so that tools like kubectl wait and GitOps health checks can use them.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 }
 
 // TODO(2.0) After converting all resources to use the runtime-controller client,
@@ -175,5 +363,50 @@ const (
 	BackupStorageLocationAccessModeReadWrite BackupStorageLocationAccessMode = "ReadWrite"
 )
 
+// StorageLayoutVersion is the version of the layout of a backup storage location.
+// +kubebuilder:validation:Enum=v1;v2
+type StorageLayoutVersion string
+
+const (
+	// StorageLayoutV1 stores the files directly under the prefix of the location.
+	StorageLayoutV1 StorageLayoutVersion = "v1"
+
+	// StorageLayoutV2 stores the files under a per-tenant and per-cluster prefix, along with an
+	// index object listing the backups.
+	StorageLayoutV2 StorageLayoutVersion = "v2"
+)
+
+// ChecksumAlgorithm is the algorithm of the checksums of the objects uploaded to a backup storage
+// location.
+// +kubebuilder:validation:Enum=CRC32C;SHA256
+type ChecksumAlgorithm string
+
+const (
+	// ChecksumAlgorithmCRC32C is the CRC-32 checksum with the Castagnoli polynomial.
+	ChecksumAlgorithmCRC32C ChecksumAlgorithm = "CRC32C"
+
+	// ChecksumAlgorithmSHA256 is the SHA-256 checksum.
+	ChecksumAlgorithmSHA256 ChecksumAlgorithm = "SHA256"
+)
+
+// EncryptionKeyProvider is the provider of the key wrapping the data keys of the objects of a
+// backup storage location.
+// +kubebuilder:validation:Enum=Secret;AWSKMS;AzureKeyVault;GCPKMS
+type EncryptionKeyProvider string
+
+const (
+	// EncryptionKeyProviderSecret wraps the data keys with a key stored in a Secret.
+	EncryptionKeyProviderSecret EncryptionKeyProvider = "Secret"
+
+	// EncryptionKeyProviderAWSKMS wraps the data keys with an AWS KMS key.
+	EncryptionKeyProviderAWSKMS EncryptionKeyProvider = "AWSKMS"
+
+	// EncryptionKeyProviderAzureKeyVault wraps the data keys with an RSA key of Azure Key Vault.
+	EncryptionKeyProviderAzureKeyVault EncryptionKeyProvider = "AzureKeyVault"
+
+	// EncryptionKeyProviderGCPKMS wraps the data keys with a GCP KMS symmetric crypto key.
+	EncryptionKeyProviderGCPKMS EncryptionKeyProvider = "GCPKMS"
+)
+
 // TODO(2.0): remove the AccessMode field from BackupStorageLocationStatus.
 // TODO(2.0): remove the LastSyncedRevision field from BackupStorageLocationStatus.
@@ -0,0 +1,71 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// Condition types set on the status of Velero resources. The phase of a resource stays
+// the primary way to report its state; the conditions mirror it in the standard form
+// expected by kubectl wait and GitOps health checks.
+const (
+	// ConditionTypeReady is True once a resource reached its desired state: a Backup or Restore
+	// is Completed, a Schedule is Enabled, a BackupStorageLocation is Available, a
+	// BackupRepository is Ready and a DataUpload is Completed.
+	ConditionTypeReady = "Ready"
+
+	// ConditionTypeProgressing is True while a Backup, Restore or DataUpload is being processed.
+	ConditionTypeProgressing = "Progressing"
+
+	// ConditionTypeReconciling has the same status as Progressing, and is the condition kstatus
+	// based tools like Flux look for to tell that a resource is in progress.
+	ConditionTypeReconciling = "Reconciling"
+
+	// ConditionTypeStalled is True when a resource can't reach its desired state without a change:
+	// a Backup or Restore is Failed, FailedValidation or PartiallyFailed, a Schedule is
+	// FailedValidation, a BackupStorageLocation is Unavailable, a BackupRepository is NotReady or
+	// a DataUpload is Failed. kstatus based tools report such resources as failed.
+	ConditionTypeStalled = "Stalled"
+
+	// ConditionTypeDataMoved is True once the data of a volume has been moved to the backup
+	// storage location by a DataUpload.
+	ConditionTypeDataMoved = "DataMoved"
+
+	// ConditionTypeReplicationComplete is True once a Backup with a mirror storage location is
+	// done and was written to its mirror storage location.
+	ConditionTypeReplicationComplete = "ReplicationComplete"
+
+	// ConditionTypeExpired is True once a Backup passed its expiration and is being garbage collected.
+	ConditionTypeExpired = "Expired"
+
+	// ConditionTypeCorrupted is True when the files of a Backup synced from a backup storage
+	// location don't match the digests recorded when the Backup was created.
+	ConditionTypeCorrupted = "Corrupted"
+
+	// ConditionTypeLogsExpired is True once the logs and results of a Backup were deleted because
+	// the backup logs TTL of its backup storage location passed before the Backup expired.
+	ConditionTypeLogsExpired = "LogsExpired"
+
+	// ConditionTypeVerified is True when the objects of a Backup matched its signed manifest the
+	// last time the Backup was verified.
+	ConditionTypeVerified = "Verified"
+
+	// ConditionTypeStorageLayoutMigrated is True once the files of a BackupStorageLocation were
+	// copied into the v2 storage layout and the location was switched to it, and False when the
+	// last migration requested failed.
+	ConditionTypeStorageLayoutMigrated = "StorageLayoutMigrated"
+
+	// ConditionReasonNew is the reason of the conditions of a resource that has not been processed yet.
+	ConditionReasonNew = "New"
+)
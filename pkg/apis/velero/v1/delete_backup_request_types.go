@@ -48,6 +48,39 @@ type DeleteBackupRequestStatus struct {
 	// +optional
 	// +nullable
 	Errors []string `json:"errors,omitempty"`
+
+	// HookResults are the results of the deletion hooks of the backup.
+	// +optional
+	// +nullable
+	HookResults []DeletionHookResult `json:"hookResults,omitempty"`
+}
+
+// DeletionHookPhase is the phase of a backup deletion in which a hook is executed.
+// +kubebuilder:validation:Enum=Pre;Post
+type DeletionHookPhase string
+
+const (
+	// DeletionHookPhasePre means the hook is executed before the data of the backup is deleted.
+	DeletionHookPhasePre DeletionHookPhase = "Pre"
+
+	// DeletionHookPhasePost means the hook is executed after the backup is deleted.
+	DeletionHookPhasePost DeletionHookPhase = "Post"
+)
+
+// DeletionHookResult is the result of a deletion hook.
+type DeletionHookResult struct {
+	// Name is the name of the hook.
+	Name string `json:"name"`
+
+	// Phase is the phase of the deletion in which the hook was executed.
+	Phase DeletionHookPhase `json:"phase"`
+
+	// Succeeded is whether the hook completed successfully.
+	Succeeded bool `json:"succeeded"`
+
+	// Error is the error of the hook, if it failed.
+	// +optional
+	Error string `json:"error,omitempty"`
 }
 
 // TODO(2.0) After converting all resources to use the runtime-controller client, the genclient and k8s:deepcopy markers will no longer be needed and should be removed.
@@ -25,7 +25,7 @@ type DownloadRequestSpec struct {
 }
 
 // DownloadTargetKind represents what type of file to download.
-// +kubebuilder:validation:Enum=BackupLog;BackupContents;BackupVolumeSnapshots;BackupItemOperations;BackupResourceList;BackupResults;RestoreLog;RestoreResults;RestoreResourceList;RestoreItemOperations;CSIBackupVolumeSnapshots;CSIBackupVolumeSnapshotContents;BackupVolumeInfos;RestoreVolumeInfo
+// +kubebuilder:validation:Enum=BackupLog;BackupContents;BackupVolumeSnapshots;BackupItemOperations;BackupResourceList;BackupResults;RestoreLog;RestoreResults;RestoreResourceList;RestoreItemOperations;CSIBackupVolumeSnapshots;CSIBackupVolumeSnapshotContents;BackupVolumeInfos;RestoreVolumeInfo;BackupSkippedItems;RestoreDriftReport;RestorePreviewReport;RestoreMappingReport
 type DownloadTargetKind string
 
 const (
@@ -43,6 +43,10 @@ const (
 	DownloadTargetKindCSIBackupVolumeSnapshotContents DownloadTargetKind = "CSIBackupVolumeSnapshotContents"
 	DownloadTargetKindBackupVolumeInfos               DownloadTargetKind = "BackupVolumeInfos"
 	DownloadTargetKindRestoreVolumeInfo               DownloadTargetKind = "RestoreVolumeInfo"
+	DownloadTargetKindBackupSkippedItems              DownloadTargetKind = "BackupSkippedItems"
+	DownloadTargetKindRestoreDriftReport              DownloadTargetKind = "RestoreDriftReport"
+	DownloadTargetKindRestorePreviewReport            DownloadTargetKind = "RestorePreviewReport"
+	DownloadTargetKindRestoreMappingReport            DownloadTargetKind = "RestoreMappingReport"
 )
 
 // DownloadTarget is the specification for what kind of file to download, and the name of the
@@ -79,6 +83,11 @@ type DownloadRequestStatus struct {
 	// +optional
 	DownloadURL string `json:"downloadURL,omitempty"`
 
+	// DataKey is the base64-encoded data key decrypting the target file when it's encrypted by
+	// its backup storage location. It only decrypts this file.
+	// +optional
+	DataKey string `json:"dataKey,omitempty"`
+
 	// Expiration is when this DownloadRequest expires and can be deleted by the system.
 	// +optional
 	// +nullable
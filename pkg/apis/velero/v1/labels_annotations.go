@@ -26,9 +26,28 @@ const (
 	// RestoreNameLabel is the label key used to identify a restore by name.
 	RestoreNameLabel = "velero.io/restore-name"
 
+	// RestoreProtectedByLabel is the label key used to identify the restore protecting
+	// a namespace from deletion while it's restored into.
+	RestoreProtectedByLabel = "velero.io/restore-protected-by"
+
+	// RestoreProtectionFinalizer is the finalizer keeping a namespace from being removed
+	// while a restore is restoring into it.
+	RestoreProtectionFinalizer = "velero.io/restore-protection"
+
+	// PreserveBackupsFinalizer is the finalizer keeping a schedule whose backups are owned by it
+	// from being removed until the owner references are removed from the backups, so that they
+	// aren't deleted by the garbage collector along with the schedule.
+	PreserveBackupsFinalizer = "velero.io/preserve-backups"
+
 	// ScheduleNameLabel is the label key used to identify a schedule by name.
 	ScheduleNameLabel = "velero.io/schedule-name"
 
+	// RestoreTestNameLabel is the label key used to identify a restore test by name.
+	RestoreTestNameLabel = "velero.io/restore-test-name"
+
+	// RetryOfBackupLabel is the label key used to identify the backup a backup retries.
+	RetryOfBackupLabel = "velero.io/retry-of-backup"
+
 	// RestoreUIDLabel is the label key used to identify a restore by uid.
 	RestoreUIDLabel = "velero.io/restore-uid"
 
@@ -47,6 +66,10 @@ const (
 	// location of a backup.
 	StorageLocationLabel = "velero.io/storage-location"
 
+	// TargetClusterLabel is the label key used to identify the target cluster
+	// of a backup or a restore processed by a server in the hub mode.
+	TargetClusterLabel = "velero.io/target-cluster"
+
 	// VolumeNamespaceLabel is the label key used to identify which
 	// namespace a repository stores backups for.
 	VolumeNamespaceLabel = "velero.io/volume-namespace"
@@ -83,6 +106,32 @@ const (
 	// AsyncOperationIDLabel is the label key used to identify the async operation ID
 	AsyncOperationIDLabel = "velero.io/async-operation-id"
 
+	// CancelOperationsAnnotation is the annotation key on a backup or restore carrying the
+	// comma-separated IDs of its async item operations which are requested to be canceled.
+	CancelOperationsAnnotation = "velero.io/cancel-operations"
+
+	// CredentialsProviderAnnotation is the annotation key on a secret holding credentials, e.g. the
+	// repository password or the credentials of a BSL, naming the external secret store its values
+	// reference rather than contain.
+	CredentialsProviderAnnotation = "velero.io/credentials-provider"
+
+	// MigrateStorageLayoutAnnotation is the annotation key on a backup storage location using the
+	// v1 storage layout requesting the files under its prefix to be copied into the v2 layout of
+	// the "<tenant>/<cluster>" of its value, and the location to be switched to it once copied.
+	MigrateStorageLayoutAnnotation = "velero.io/migrate-storage-layout"
+
+	// ResumeBackupAnnotation is the annotation key on a backup which was in progress when the
+	// server restarted, requesting it to resume from its last checkpoint.
+	ResumeBackupAnnotation = "velero.io/resume-backup"
+
+	// FailoverFromStorageLocationAnnotation is the annotation key on a scheduled backup written to
+	// a failover location, set to the unavailable backup storage location it was failed over from.
+	FailoverFromStorageLocationAnnotation = "velero.io/failover-from-storage-location"
+
+	// VerifyBackupAnnotation is the annotation key on a backup requesting the verification of
+	// its files against its signed manifest, removed once the Verified condition of the backup is set.
+	VerifyBackupAnnotation = "velero.io/verify"
+
 	// PVCNameLabel is the label key used to identify the PVC's namespace and name.
 	// The format is <namespace>/<name>.
 	PVCNamespaceNameLabel = "velero.io/pvc-namespace-name"
@@ -101,6 +150,14 @@ const (
 	// ExcludeFromBackupLabel is the label to exclude k8s resource from backup,
 	// even if the resource contains a matching selector label.
 	ExcludeFromBackupLabel = "velero.io/exclude-from-backup"
+
+	// ExcludeFromRestoreLabel is the label, or the annotation, excluding the k8s resource of a
+	// backup from the restores when its value is "true".
+	ExcludeFromRestoreLabel = "velero.io/exclude-from-restore"
+
+	// ClusterAPIPausedByRestoreAnnotation is the annotation key added to a Cluster API Cluster
+	// whose reconciliation was paused by a restore, so that it is resumed once the restore finishes.
+	ClusterAPIPausedByRestoreAnnotation = "velero.io/cluster-api-paused-by-restore"
 )
 
 type AsyncOperationIDPrefix string
@@ -114,6 +171,7 @@ type VeleroResourceUsage string
 
 const (
 	VeleroResourceUsageDataUploadResult VeleroResourceUsage = "DataUpload"
+	VeleroResourceUsageDiscoveryCache   VeleroResourceUsage = "DiscoveryCache"
 )
 
 // CSI related plugin actions' constant variable
@@ -127,6 +185,16 @@ const (
 	VolumeSnapshotClassDriverBackupAnnotationPrefix = "velero.io/csi-volumesnapshot-class"
 	VolumeSnapshotClassDriverPVCAnnotation          = "velero.io/csi-volumesnapshot-class"
 
+	// VolumeGroupSnapshotLabel is the default key of the label grouping the PVCs snapshotted
+	// together by a VolumeGroupSnapshot.
+	VolumeGroupSnapshotLabel = "velero.io/volume-group"
+	// VolumeGroupSnapshotClassSelectorLabel selects the VolumeGroupSnapshotClass of a CSI driver
+	// among several ones, like VolumeSnapshotClassSelectorLabel does for VolumeSnapshotClasses.
+	VolumeGroupSnapshotClassSelectorLabel = "velero.io/csi-volumegroupsnapshot-class"
+	// VolumeSnapshotSourcePVCAnnotation records the PVC a VolumeSnapshot taken as a member of a
+	// VolumeGroupSnapshot is the snapshot of, as its spec only references its VolumeSnapshotContent.
+	VolumeSnapshotSourcePVCAnnotation = "velero.io/source-pvc"
+
 	// There is no release w/ these constants exported. Using the strings for now.
 	// CSI Annotation volumesnapshotclass
 	// https://github.com/kubernetes-csi/external-snapshotter/blob/master/pkg/utils/util.go#L59-L60
@@ -148,4 +216,8 @@ const (
 
 	// DataUploadNameAnnotation is the label key for the DataUpload name
 	DataUploadNameAnnotation = "velero.io/data-upload-name"
+
+	// DataUploadRetriesAnnotation is the annotation key on a DataUpload for the number of times it
+	// was re-run after it failed, see the upload phase retries of the backup.
+	DataUploadRetriesAnnotation = "velero.io/data-upload-retries"
 )
@@ -48,6 +48,7 @@ func CustomResources() map[string]typeInfo {
 	return map[string]typeInfo{
 		"Backup":                 newTypeInfo("backups", &Backup{}, &BackupList{}),
 		"Restore":                newTypeInfo("restores", &Restore{}, &RestoreList{}),
+		"RestoreTest":            newTypeInfo("restoretests", &RestoreTest{}, &RestoreTestList{}),
 		"Schedule":               newTypeInfo("schedules", &Schedule{}, &ScheduleList{}),
 		"DownloadRequest":        newTypeInfo("downloadrequests", &DownloadRequest{}, &DownloadRequestList{}),
 		"DeleteBackupRequest":    newTypeInfo("deletebackuprequests", &DeleteBackupRequest{}, &DeleteBackupRequestList{}),
@@ -0,0 +1,205 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultScratchNamespacePrefix is the default prefix of the names of the scratch namespaces
+// a restore test restores the namespaces into.
+const DefaultScratchNamespacePrefix = "restore-test-"
+
+// RestoreTestSpec defines the specification for a Velero restore test.
+type RestoreTestSpec struct {
+	// Schedule is a Cron expression defining when to run the restore test.
+	Schedule string `json:"schedule"`
+
+	// ScheduleName is the name of the schedule whose latest completed backup is
+	// restored. If empty, the latest completed backup is restored.
+	// +optional
+	ScheduleName string `json:"scheduleName,omitempty"`
+
+	// IncludedNamespaces is a slice of namespace names to restore from the backup.
+	// Each of them is restored into a scratch namespace named after it.
+	IncludedNamespaces []string `json:"includedNamespaces"`
+
+	// ScratchNamespacePrefix is the prefix of the names of the scratch namespaces
+	// the namespaces are restored into. Defaults to "restore-test-".
+	// +optional
+	ScratchNamespacePrefix string `json:"scratchNamespacePrefix,omitempty"`
+
+	// ScratchCluster is the name of the Secret of a target cluster, in the Velero
+	// namespace, the namespaces are restored into under their own names instead of
+	// into scratch namespaces. It requires the server to run in the hub mode.
+	// +optional
+	ScratchCluster string `json:"scratchCluster,omitempty"`
+
+	// Hooks are the restore hooks run in the restored pods to verify them. The
+	// run of the restore test fails if the restore doesn't complete, e.g. when a
+	// hook whose onError is Fail fails.
+	// +optional
+	Hooks RestoreHooks `json:"hooks,omitempty"`
+
+	// Paused specifies whether the restore test is paused or not
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// RestoreTestPhase is a string representation of the lifecycle phase
+// of a Velero restore test
+// +kubebuilder:validation:Enum=New;Enabled;FailedValidation
+type RestoreTestPhase string
+
+const (
+	// RestoreTestPhaseNew means the restore test has been created but not
+	// yet processed by the RestoreTestController
+	RestoreTestPhaseNew RestoreTestPhase = "New"
+
+	// RestoreTestPhaseEnabled means the restore test has been validated and
+	// will now be run according to its schedule.
+	RestoreTestPhaseEnabled RestoreTestPhase = "Enabled"
+
+	// RestoreTestPhaseFailedValidation means the restore test has failed
+	// the controller's validations and therefore will not be run.
+	RestoreTestPhaseFailedValidation RestoreTestPhase = "FailedValidation"
+)
+
+// RestoreTestResult is the result of a run of a restore test.
+// +kubebuilder:validation:Enum=InProgress;Passed;Failed
+type RestoreTestResult string
+
+const (
+	// RestoreTestResultInProgress means the restore of the run is in progress.
+	RestoreTestResultInProgress RestoreTestResult = "InProgress"
+
+	// RestoreTestResultPassed means the restore of the run completed.
+	RestoreTestResultPassed RestoreTestResult = "Passed"
+
+	// RestoreTestResultFailed means the restore of the run didn't complete, or
+	// that there was no backup to restore.
+	RestoreTestResultFailed RestoreTestResult = "Failed"
+)
+
+// RestoreTestRun is a run of a restore test.
+type RestoreTestRun struct {
+	// Backup is the name of the restored backup.
+	// +optional
+	Backup string `json:"backup,omitempty"`
+
+	// Restore is the name of the restore of the run.
+	// +optional
+	Restore string `json:"restore,omitempty"`
+
+	// Result is the result of the run.
+	// +optional
+	Result RestoreTestResult `json:"result,omitempty"`
+
+	// Message is a human-readable message describing why the run failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// StartTimestamp records the time the run was started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp records the time the run was completed, once its
+	// scratch namespaces were cleaned up.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+}
+
+// RestoreTestStatus captures the current state of a Velero restore test
+type RestoreTestStatus struct {
+	// Phase is the current phase of the RestoreTest
+	// +optional
+	Phase RestoreTestPhase `json:"phase,omitempty"`
+
+	// ValidationErrors is a slice of all validation errors (if
+	// applicable)
+	// +optional
+	ValidationErrors []string `json:"validationErrors,omitempty"`
+
+	// LastRun is the last run of the restore test.
+	// +optional
+	// +nullable
+	LastRun *RestoreTestRun `json:"lastRun,omitempty"`
+
+	// LastPassed is the start time of the last run of the restore test which
+	// passed.
+	// +optional
+	// +nullable
+	LastPassed *metav1.Time `json:"lastPassed,omitempty"`
+
+	// ObservedGeneration is the generation of the RestoreTest the status was last updated for. Velero
+	// resources have no status subresource, so the generation also changes when the status does.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions describe the current state of the RestoreTest in a form which is common to all
+	// Velero resources, so that tools like kubectl wait and GitOps health checks can use them.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:generate=true
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase",description="Status of the restore test"
+// +kubebuilder:printcolumn:name="Schedule",type="string",JSONPath=".spec.schedule",description="A Cron expression defining when to run the restore test"
+// +kubebuilder:printcolumn:name="LastResult",type="string",JSONPath=".status.lastRun.result",description="The result of the last run of the restore test"
+// +kubebuilder:printcolumn:name="LastPassed",type="date",JSONPath=".status.lastPassed",description="The last time a run of the restore test passed"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Paused",type="boolean",JSONPath=".spec.paused"
+
+// RestoreTest is a Velero resource that periodically restores the latest
+// backup into scratch namespaces, verifies it and cleans it up, as a drill
+// proving the backups can be restored.
+type RestoreTest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata"`
+
+	// +optional
+	Spec RestoreTestSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status RestoreTestStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:generate=true
+// +kubebuilder:object:root=true
+
+// RestoreTestList is a list of RestoreTests.
+type RestoreTestList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []RestoreTest `json:"items"`
+}
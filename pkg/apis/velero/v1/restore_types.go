@@ -20,6 +20,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 // RestoreSpec defines the specification for a Velero restore.
@@ -59,6 +60,14 @@ type RestoreSpec struct {
 	// +nullable
 	ExcludedResources []string `json:"excludedResources,omitempty"`
 
+	// IncludedItems is a slice of individual items to restore. If not
+	// empty, only the items of the backup matching one of them are
+	// restored, on top of the other filters, along with the PVs the
+	// PVCs are bound to.
+	// +optional
+	// +nullable
+	IncludedItems []RestoreItemSelector `json:"includedItems,omitempty"`
+
 	// NamespaceMapping is a map of source namespace names
 	// to target namespace names to restore into. Any source
 	// namespaces not included in the map will be restored into
@@ -66,6 +75,20 @@ type RestoreSpec struct {
 	// +optional
 	NamespaceMapping map[string]string `json:"namespaceMapping,omitempty"`
 
+	// RewriteNamespaceReferences rewrites the references to the namespaces of the NamespaceMapping
+	// in the fields of the restored items, like the namespace of the service of a webhook
+	// configuration or the namespace selectors of a NetworkPolicy. Disabled by default.
+	// +optional
+	// +nullable
+	RewriteNamespaceReferences *bool `json:"rewriteNamespaceReferences,omitempty"`
+
+	// NamespaceReferenceRules specifies the reference to a configmap with the rules selecting
+	// the fields of the restored items referencing namespaces, on top of the default ones, when
+	// rewriting the references to the mapped namespaces.
+	// +optional
+	// +nullable
+	NamespaceReferenceRules *v1.TypedLocalObjectReference `json:"namespaceReferenceRules,omitempty"`
+
 	// LabelSelector is a metav1.LabelSelector to filter with
 	// when restoring individual objects from the backup. If empty
 	// or nil, all objects are included. Optional.
@@ -82,6 +105,13 @@ type RestoreSpec struct {
 	// +nullable
 	OrLabelSelectors []*metav1.LabelSelector `json:"orLabelSelectors,omitempty"`
 
+	// ExcludeLabelSelector is a metav1.LabelSelector whose matching objects
+	// of the backup aren't restored, even if they match LabelSelector or
+	// OrLabelSelectors. If nil, no object is excluded. Optional.
+	// +optional
+	// +nullable
+	ExcludeLabelSelector *metav1.LabelSelector `json:"excludeLabelSelector,omitempty"`
+
 	// RestorePVs specifies whether to restore all included
 	// PVs from snapshot
 	// +optional
@@ -129,8 +159,324 @@ type RestoreSpec struct {
 	// +optional
 	// +nullable
 	UploaderConfig *UploaderConfigForRestore `json:"uploaderConfig,omitempty"`
+
+	// QuotaReconciliation restores the ResourceQuotas and LimitRanges of the namespaces before
+	// their workloads, and reconciles the workloads restored afterwards with the quotas:
+	// Scale lowers the replicas of the workloads which would exceed a quota, Warn restores
+	// them as they are with a warning. Disabled by default.
+	// +optional
+	QuotaReconciliation QuotaReconciliationMode `json:"quotaReconciliation,omitempty"`
+
+	// ErrorBudget is the most items which may fail to be restored: the failed items are
+	// quarantined and the restore goes on, until more items failed, when the restore is aborted
+	// and ends as Failed. Nil, the default, means no limit.
+	// +optional
+	// +nullable
+	// +kubebuilder:validation:Minimum=0
+	ErrorBudget *int `json:"errorBudget,omitempty"`
+
+	// ProtectNamespaces places a finalizer and a label on the namespaces being restored into,
+	// so they can't be removed, nor be picked up by cleanup automation honoring the label,
+	// until the restore is done. Disabled by default.
+	// +optional
+	// +nullable
+	ProtectNamespaces *bool `json:"protectNamespaces,omitempty"`
+
+	// TargetCluster is the name of a Secret in the Velero namespace holding, under the key
+	// "kubeconfig", the kubeconfig of the cluster to restore into instead of the cluster Velero
+	// runs in. It's only honored by a server running in the hub mode.
+	// +optional
+	TargetCluster string `json:"targetCluster,omitempty"`
+
+	// ScaleDownConflictingWorkloads scales the existing Deployments and StatefulSets the restore
+	// overwrites down to zero replicas, and waits for their pods to terminate, before restoring
+	// the items, so the old pods don't hold the volumes of the restored pods. It requires the
+	// ExistingResourcePolicy update. Disabled by default.
+	// +optional
+	// +nullable
+	ScaleDownConflictingWorkloads *bool `json:"scaleDownConflictingWorkloads,omitempty"`
+
+	// VolumeDetachPolicy is how the restored pods wait for their ReadWriteOnce volumes to be
+	// detached from the nodes they're still attached to, before the pods are created.
+	// Empty, the default, means the pods are created without waiting.
+	// +optional
+	VolumeDetachPolicy VolumeDetachPolicy `json:"volumeDetachPolicy,omitempty"`
+
+	// ServerDryRun specifies whether the items are only submitted to the API server in dry-run
+	// mode, nothing being persisted. The rejected items are reported as errors and the fields the
+	// API server changes, by defaulting or by mutating webhooks, as warnings.
+	// +optional
+	// +nullable
+	ServerDryRun *bool `json:"serverDryRun,omitempty"`
+
+	// Preview specifies whether the restore only reports what it would do, running the filters,
+	// the resource modifiers, the restore item actions and the ExistingResourcePolicy but writing
+	// nothing to the cluster. The items it would create, patch or skip are listed in a file
+	// downloadable as RestorePreviewReport.
+	// +optional
+	// +nullable
+	Preview *bool `json:"preview,omitempty"`
+
+	// StagingStorageLocation is the BackupStorageLocation the files of the restore, like its log
+	// and results, are stored in instead of the location of its backup. It must be ReadWrite.
+	// When not set and the location of the backup is ReadOnly, the default location is used if
+	// it's ReadWrite.
+	// +optional
+	StagingStorageLocation string `json:"stagingStorageLocation,omitempty"`
+
+	// NamespacePriority is the ordered list of the namespaces, by their name in the backup or by
+	// glob patterns like "kube-*", restored before the others: all the resources of the namespaces
+	// matching the first entry are restored, then those of the namespaces matching the second one
+	// and so on, the namespaces matching no entry being restored last. The cluster-scoped resources
+	// are restored first.
+	// +optional
+	// +nullable
+	NamespacePriority []string `json:"namespacePriority,omitempty"`
+
+	// NamespaceMetadataPolicy is how the labels and annotations of the backed up namespaces are
+	// applied to the namespaces which already exist in the cluster: Merge adds them to the existing
+	// ones, the backed up values winning, Override replaces the existing labels with them and merges
+	// the annotations like Merge. Empty, the default, means the existing namespaces are left as they
+	// are. The namespaces which don't exist are always created with the backed up labels and annotations.
+	// +optional
+	NamespaceMetadataPolicy NamespaceMetadataPolicy `json:"namespaceMetadataPolicy,omitempty"`
+
+	// OrderByDependencies restores each item after the items it depends on: the PersistentVolume
+	// a PersistentVolumeClaim is bound to, and the Service of a webhook configuration along with
+	// the workloads the Service selects. The owners in the ownerReferences aren't dependencies, the
+	// resource priorities restoring the pods before their controllers. The resource priorities only
+	// order the items which don't depend on each other. The CustomResourceDefinitions are still
+	// restored first. Disabled by default.
+	// +optional
+	// +nullable
+	OrderByDependencies *bool `json:"orderByDependencies,omitempty"`
+
+	// DependencyWaitConditions are the conditions the restored items of the given resources must
+	// meet before the items depending on them are restored, when ordering by dependencies.
+	// +optional
+	// +nullable
+	DependencyWaitConditions []DependencyWaitCondition `json:"dependencyWaitConditions,omitempty"`
+
+	// PVCDataSourcePolicy is how the dataSource and dataSourceRef of the restored PVCs, naming the
+	// VolumeSnapshot, the PVC or the populator the volume was created from, are handled.
+	// Defaults to Auto.
+	// +optional
+	PVCDataSourcePolicy PVCDataSourcePolicy `json:"pvcDataSourcePolicy,omitempty"`
+
+	// PVCDataSourcePolicyByStorageClass are the data source policies of the restored PVCs of the
+	// storage classes, overriding PVCDataSourcePolicy.
+	// +optional
+	// +nullable
+	PVCDataSourcePolicyByStorageClass map[string]PVCDataSourcePolicy `json:"pvcDataSourcePolicyByStorageClass,omitempty"`
+
+	// ImmutableFieldPolicies are how the existing items of the resources, keyed by their group
+	// resource like services or jobs.batch, whose immutable fields differ from the backed up
+	// version are handled with the ExistingResourcePolicy update, instead of failing to be
+	// patched with a warning. The immutable fields, like the clusterIP of a Service, the storage
+	// class of a PVC or the selector of a Job, are known by Velero for the built-in resources.
+	// +optional
+	// +nullable
+	ImmutableFieldPolicies map[string]ImmutableFieldPolicy `json:"immutableFieldPolicies,omitempty"`
+
+	// GradualScale scales the restored Deployments up gradually: they're restored with the
+	// replicas of a step, then scaled up a step at a time, once their replicas are ready, to
+	// their backed up replicas. The restore completes once they're scaled up. The Deployments
+	// are restored with their backed up replicas when not set.
+	// +optional
+	// +nullable
+	GradualScale *GradualScale `json:"gradualScale,omitempty"`
+
+	// LoadBalancerPolicy is how the Services of type LoadBalancer are restored, overriding the
+	// LoadBalancerPolicy of the backup.
+	// +optional
+	// +nullable
+	LoadBalancerPolicy *LoadBalancerPolicy `json:"loadBalancerPolicy,omitempty"`
+}
+
+// LoadBalancerPolicy is how the Services of type LoadBalancer are restored.
+type LoadBalancerPolicy struct {
+	// Annotations is how the annotations of the cloud providers, the load balancer controllers
+	// and ExternalDNS, like service.beta.kubernetes.io/* or external-dns.alpha.kubernetes.io/*,
+	// are restored. Defaults to Preserve.
+	// +optional
+	Annotations LoadBalancerFieldPolicy `json:"annotations,omitempty"`
+
+	// StaticIPs is how the static addresses of the load balancers, the loadBalancerIP and the
+	// annotations requesting IPs or public IP resources, are restored. Defaults to Preserve.
+	// +optional
+	StaticIPs LoadBalancerFieldPolicy `json:"staticIPs,omitempty"`
+
+	// WaitForProvisioning waits, once the items are restored, for the load balancers of the
+	// restored Services to be provisioned, the Services whose load balancer isn't provisioned in
+	// time being reported as warnings.
+	// +optional
+	// +nullable
+	WaitForProvisioning *bool `json:"waitForProvisioning,omitempty"`
+
+	// Timeout is how long to wait for the load balancers to be provisioned. Defaults to the
+	// resource timeout of the server.
+	// +optional
+	// +nullable
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
 }
 
+// LoadBalancerFieldPolicy is how fields of the Services of type LoadBalancer are restored.
+// +kubebuilder:validation:Enum=Preserve;Strip
+type LoadBalancerFieldPolicy string
+
+const (
+	// LoadBalancerFieldPolicyPreserve restores the fields as they're backed up.
+	LoadBalancerFieldPolicyPreserve LoadBalancerFieldPolicy = "Preserve"
+
+	// LoadBalancerFieldPolicyStrip removes the fields, leaving them to the defaults of the cluster.
+	LoadBalancerFieldPolicyStrip LoadBalancerFieldPolicy = "Strip"
+)
+
+// LoadBalancerAddressMapping is the addresses of the load balancer of a restored Service in the
+// backup and in the cluster.
+type LoadBalancerAddressMapping struct {
+	// Namespace is the namespace the Service is restored into.
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the Service.
+	Name string `json:"name"`
+
+	// BackupAddresses are the IPs and hostnames of the load balancer in the backup.
+	// +optional
+	// +nullable
+	BackupAddresses []string `json:"backupAddresses,omitempty"`
+
+	// Addresses are the IPs and hostnames of the load balancer provisioned for the restored
+	// Service, empty if it isn't provisioned yet.
+	// +optional
+	// +nullable
+	Addresses []string `json:"addresses,omitempty"`
+}
+
+// GradualScale is how the restored Deployments are scaled up to their backed up replicas.
+type GradualScale struct {
+	// Step is the number of replicas the Deployments are scaled up by at a time.
+	// +kubebuilder:validation:Minimum=1
+	Step int32 `json:"step"`
+
+	// Interval is how long to wait, once the replicas of a step are ready, before scaling up by
+	// the next step.
+	// +optional
+	Interval metav1.Duration `json:"interval,omitempty"`
+
+	// Timeout is how long to wait for the replicas of a step to be ready, the Deployment being
+	// left at its current replicas with a warning after it. Defaults to the resource timeout of
+	// the server.
+	// +optional
+	// +nullable
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// ImmutableFieldPolicy is how an existing item whose immutable fields differ from the backed up
+// version is updated.
+// +kubebuilder:validation:Enum=Skip;Recreate;Fail
+type ImmutableFieldPolicy string
+
+const (
+	// ImmutableFieldPolicySkip keeps the in-cluster values of the immutable fields, the other
+	// fields being patched.
+	ImmutableFieldPolicySkip ImmutableFieldPolicy = "Skip"
+
+	// ImmutableFieldPolicyRecreate deletes the existing item and creates the backed up version.
+	// The PersistentVolumeClaims whose data the restore doesn't restore fail instead.
+	ImmutableFieldPolicyRecreate ImmutableFieldPolicy = "Recreate"
+
+	// ImmutableFieldPolicyFail fails to restore the item, naming the immutable fields which differ.
+	ImmutableFieldPolicyFail ImmutableFieldPolicy = "Fail"
+)
+
+// PVCDataSourcePolicy is how the data source of a restored PVC is handled.
+// +kubebuilder:validation:Enum=Auto;Strip;Preserve
+type PVCDataSourcePolicy string
+
+const (
+	// PVCDataSourcePolicyAuto removes the data source when Velero restores the data of the volume,
+	// the source neither exists in the cluster nor is restored, or the source is a PVC only restored
+	// by the same restore, and keeps it otherwise.
+	PVCDataSourcePolicyAuto PVCDataSourcePolicy = "Auto"
+
+	// PVCDataSourcePolicyStrip always removes the data source, the volume being provisioned empty
+	// unless Velero restores its data.
+	PVCDataSourcePolicyStrip PVCDataSourcePolicy = "Strip"
+
+	// PVCDataSourcePolicyPreserve always keeps the data source, the volume being provisioned from it.
+	PVCDataSourcePolicyPreserve PVCDataSourcePolicy = "Preserve"
+)
+
+// RestoreItemSelector selects an individual item of the backup to restore.
+type RestoreItemSelector struct {
+	// Namespace is the namespace of the item in the backup, empty for a
+	// cluster-scoped item.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Resource is the resource of the item, e.g. secrets or
+	// persistentvolumeclaims, which may be a short name or a kind.
+	Resource string `json:"resource"`
+
+	// Name is the name of the item.
+	Name string `json:"name"`
+}
+
+// DependencyWaitCondition is a status condition the restored items of a resource must meet before
+// the items depending on them are restored.
+type DependencyWaitCondition struct {
+	// Resource is the name of the resource of the items, e.g. deployments.apps.
+	Resource string `json:"resource"`
+
+	// Condition is the type of the status condition of the items which must be True, e.g. Available.
+	Condition string `json:"condition"`
+
+	// Timeout is how long to wait for the condition, the restore going on with a warning after it.
+	// Defaults to the resource timeout of the server.
+	// +optional
+	// +nullable
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// QuotaReconciliationMode is how the restored workloads which would exceed a ResourceQuota are handled.
+// +kubebuilder:validation:Enum=Scale;Warn
+type QuotaReconciliationMode string
+
+const (
+	// QuotaReconciliationScale lowers the replicas of the workloads which would exceed a ResourceQuota.
+	QuotaReconciliationScale QuotaReconciliationMode = "Scale"
+
+	// QuotaReconciliationWarn restores the workloads which would exceed a ResourceQuota with a warning.
+	QuotaReconciliationWarn QuotaReconciliationMode = "Warn"
+)
+
+// NamespaceMetadataPolicy is how the metadata of the backed up namespaces is applied to the existing ones.
+// +kubebuilder:validation:Enum=Merge;Override
+type NamespaceMetadataPolicy string
+
+const (
+	// NamespaceMetadataPolicyMerge adds the backed up labels and annotations to the existing ones.
+	NamespaceMetadataPolicyMerge NamespaceMetadataPolicy = "Merge"
+
+	// NamespaceMetadataPolicyOverride replaces the existing labels with the backed up ones, and adds the
+	// backed up annotations to the existing ones.
+	NamespaceMetadataPolicyOverride NamespaceMetadataPolicy = "Override"
+)
+
+// VolumeDetachPolicy is how the restored pods wait for their volumes to be detached.
+// +kubebuilder:validation:Enum=Wait;ForceDetach
+type VolumeDetachPolicy string
+
+const (
+	// VolumeDetachPolicyWait waits for the volumes to be detached before creating the pods.
+	VolumeDetachPolicyWait VolumeDetachPolicy = "Wait"
+
+	// VolumeDetachPolicyForceDetach detaches the volumes from the nodes where no pod uses them
+	// anymore by deleting their VolumeAttachments, then waits for the volumes to be detached.
+	VolumeDetachPolicyForceDetach VolumeDetachPolicy = "ForceDetach"
+)
+
 // UploaderConfigForRestore defines the configuration for the restore.
 type UploaderConfigForRestore struct {
 	// WriteSparseFiles is a flag to indicate whether write files sparsely or not.
@@ -140,6 +486,17 @@ type UploaderConfigForRestore struct {
 	// ParallelFilesDownload is the concurrency number setting for restore.
 	// +optional
 	ParallelFilesDownload int `json:"parallelFilesDownload,omitempty"`
+	// UIDMapping maps the user IDs owning the backed up files to the user IDs owning the
+	// restored files, e.g. "1000": "2000", for the pods of the target cluster running with
+	// other IDs. Only applies to the Filesystem volumes restored by the kopia uploader.
+	// +optional
+	// +nullable
+	UIDMapping map[string]string `json:"uidMapping,omitempty"`
+	// GIDMapping maps the group IDs owning the backed up files to the group IDs owning the
+	// restored files. Only applies to the Filesystem volumes restored by the kopia uploader.
+	// +optional
+	// +nullable
+	GIDMapping map[string]string `json:"gidMapping,omitempty"`
 }
 
 // RestoreHooks contains custom behaviors that should be executed during or post restore.
@@ -205,6 +562,9 @@ type RestoreResourceHook struct {
 
 	// Init defines an init restore hook.
 	Init *InitRestoreHook `json:"init,omitempty"`
+
+	// Verify defines a post-restore verification hook.
+	Verify *VerifyRestoreHook `json:"verify,omitempty"`
 }
 
 // ExecRestoreHook is a hook that uses pod exec API to execute a command inside a container in a pod
@@ -251,6 +611,100 @@ type InitRestoreHook struct {
 	Timeout metav1.Duration `json:"timeout,omitempty"`
 }
 
+// VerifyRestoreHook is a hook that checks a restored application is functional once its container
+// is Ready. Exactly one of SQL or HTTP must be specified.
+type VerifyRestoreHook struct {
+	// Container is the container in the pod to verify. If not specified, the pod's first container is used.
+	// +optional
+	Container string `json:"container,omitempty"`
+
+	// SQL defines a query to run against a database served by the container.
+	// +optional
+	// +nullable
+	SQL *SQLVerification `json:"sql,omitempty"`
+
+	// HTTP defines an HTTP health check against the pod.
+	// +optional
+	// +nullable
+	HTTP *HTTPVerification `json:"http,omitempty"`
+
+	// OnFailure specifies how a failed verification affects the restore. Warn records a warning,
+	// PartiallyFailed records an error and marks the restore PartiallyFailed. Defaults to Warn.
+	// +optional
+	OnFailure VerifyFailurePolicy `json:"onFailure,omitempty"`
+
+	// Timeout defines the maximum amount of time Velero should wait for the check to complete before
+	// considering the verification a failure.
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+
+	// WaitTimeout defines the maximum amount of time Velero should wait for the container to be Ready
+	// before running the check.
+	// +optional
+	WaitTimeout metav1.Duration `json:"waitTimeout,omitempty"`
+}
+
+// SQLVerification runs a query with the database's command line client inside the container.
+// The verification passes when the client exits successfully.
+type SQLVerification struct {
+	// Engine is the database engine, which selects the client used to run the query.
+	Engine SQLEngine `json:"engine"`
+
+	// Database is the name of the database to connect to.
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// User is the database user to connect as.
+	// +optional
+	User string `json:"user,omitempty"`
+
+	// Query is the SQL statement to execute. Statements that error (for example a division by zero on
+	// an empty table) fail the verification.
+	Query string `json:"query"`
+}
+
+// HTTPVerification issues an HTTP GET against the restored pod. Any status code
+// greater than or equal to 200 and less than 400 indicates success.
+type HTTPVerification struct {
+	// Port is the container port to connect to.
+	Port int32 `json:"port"`
+
+	// Path is the path to request on the HTTP server.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Scheme to use for connecting to the pod. Defaults to HTTP.
+	// +optional
+	Scheme VerifyHTTPScheme `json:"scheme,omitempty"`
+}
+
+// SQLEngine is the database engine targeted by a SQL verification.
+// +kubebuilder:validation:Enum=postgres;mysql
+type SQLEngine string
+
+const (
+	SQLEnginePostgres SQLEngine = "postgres"
+	SQLEngineMySQL    SQLEngine = "mysql"
+)
+
+// VerifyHTTPScheme is the scheme used by an HTTP verification.
+// +kubebuilder:validation:Enum=HTTP;HTTPS
+type VerifyHTTPScheme string
+
+const (
+	VerifyHTTPSchemeHTTP  VerifyHTTPScheme = "HTTP"
+	VerifyHTTPSchemeHTTPS VerifyHTTPScheme = "HTTPS"
+)
+
+// VerifyFailurePolicy specifies how a failed restore verification is reported.
+// +kubebuilder:validation:Enum=Warn;PartiallyFailed
+type VerifyFailurePolicy string
+
+const (
+	VerifyFailurePolicyWarn            VerifyFailurePolicy = "Warn"
+	VerifyFailurePolicyPartiallyFailed VerifyFailurePolicy = "PartiallyFailed"
+)
+
 // RestorePhase is a string representation of the lifecycle phase
 // of a Velero restore
 // +kubebuilder:validation:Enum=New;FailedValidation;InProgress;WaitingForPluginOperations;WaitingForPluginOperationsPartiallyFailed;Completed;PartiallyFailed;Failed;Finalizing;FinalizingPartiallyFailed
@@ -313,6 +767,16 @@ const (
 	// PolicyTypeUpdate means velero will try to attempt a patch on
 	// the changed resources.
 	PolicyTypeUpdate PolicyType = "update"
+
+	// PolicyTypeReconcile means velero will treat the backup as the desired state
+	// and server-side apply the drifted fields of the existing resources, leaving
+	// the fields owned by other field managers untouched.
+	PolicyTypeReconcile PolicyType = "reconcile"
+
+	// PolicyTypeSSA means velero will server-side apply the changed resources
+	// with a dedicated field manager, owning the fields it restores and leaving
+	// the fields owned by other field managers, like in-cluster controllers, untouched.
+	PolicyTypeSSA PolicyType = "ssa"
 )
 
 // RestoreStatus captures the current status of a Velero restore
@@ -380,6 +844,258 @@ type RestoreStatus struct {
 	// +optional
 	// +nullable
 	HookStatus *HookStatus `json:"hookStatus,omitempty"`
+
+	// VerificationStatus contains information about the results of post-restore verification hooks.
+	// +optional
+	// +nullable
+	VerificationStatus *VerificationStatus `json:"verificationStatus,omitempty"`
+
+	// QuotaCappedItems is the number of restored workloads which would exceed a ResourceQuota
+	// of their namespace, they are listed in the warnings of the restore.
+	// +optional
+	QuotaCappedItems int `json:"quotaCappedItems,omitempty"`
+
+	// ConvertedItems is the number of items of API versions the cluster doesn't serve anymore
+	// which were converted to the versions replacing them, they are listed in the warnings of
+	// the restore.
+	// +optional
+	ConvertedItems int `json:"convertedItems,omitempty"`
+
+	// UnconvertibleItems is the number of items of API versions the cluster doesn't serve anymore
+	// which couldn't be converted, they are listed in the warnings of the restore when their API
+	// was removed without replacement and in its errors otherwise.
+	// +optional
+	UnconvertibleItems int `json:"unconvertibleItems,omitempty"`
+
+	// APIVersionDrift summarizes the restored items whose API version differs from the one the
+	// source cluster preferred when they were backed up, by kind and pair of API versions. Each
+	// of these items is also listed in the restore log.
+	// +optional
+	// +nullable
+	APIVersionDrift []APIVersionDrift `json:"apiVersionDrift,omitempty"`
+
+	// QuarantinedItems lists the items which failed to be restored, up to the first 100 of them.
+	// +optional
+	// +nullable
+	QuarantinedItems []QuarantinedItem `json:"quarantinedItems,omitempty"`
+
+	// LoadBalancerAddresses are the addresses of the load balancers of the restored Services of
+	// type LoadBalancer in the backup and in the cluster, e.g. to update their DNS records.
+	// +optional
+	// +nullable
+	LoadBalancerAddresses []LoadBalancerAddressMapping `json:"loadBalancerAddresses,omitempty"`
+
+	// ErrorBudgetExceeded is true when more items failed than the ErrorBudget of the restore
+	// allows and the restore was aborted.
+	// +optional
+	ErrorBudgetExceeded bool `json:"errorBudgetExceeded,omitempty"`
+
+	// TargetCluster is the status of the target cluster the restore was made into, when it was
+	// processed by a server in the hub mode.
+	// +optional
+	// +nullable
+	TargetCluster *TargetClusterStatus `json:"targetCluster,omitempty"`
+
+	// ObservedGeneration is the generation of the Restore the status was last updated for. Velero
+	// resources have no status subresource, so the generation also changes when the status does.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions describe the current state of the Restore in a form which is common to all
+	// Velero resources, so that tools like kubectl wait and GitOps health checks can use them.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// VerificationStatus stores information about the results of post-restore verification hooks.
+type VerificationStatus struct {
+	// VerificationsAttempted is the total number of attempted verification hooks.
+	// +optional
+	VerificationsAttempted int `json:"verificationsAttempted,omitempty"`
+
+	// VerificationsFailed is the total number of verification hooks which failed.
+	// +optional
+	VerificationsFailed int `json:"verificationsFailed,omitempty"`
+}
+
+// APIVersionDrift is a number of items of a kind restored with another API version than the one
+// the source cluster preferred when they were backed up, because another version was chosen to
+// restore them, the version they were backed up in isn't served anymore or a plugin changed it.
+type APIVersionDrift struct {
+	// Kind is the kind of the items.
+	Kind string `json:"kind"`
+
+	// SourceAPIVersion is the API version the source cluster preferred for the items.
+	SourceAPIVersion string `json:"sourceAPIVersion"`
+
+	// AppliedAPIVersion is the API version the items were restored with.
+	AppliedAPIVersion string `json:"appliedAPIVersion"`
+
+	// Items is the number of items.
+	Items int `json:"items"`
+}
+
+// DriftedItem is an existing item of a restore with the reconcile ExistingResourcePolicy whose
+// fields drifted from the backup. The drifted items are listed in a file downloadable as
+// RestoreDriftReport.
+type DriftedItem struct {
+	// Resource is the group resource of the item.
+	Resource string `json:"resource"`
+
+	// Namespace is the namespace of the item, empty for a cluster-scoped item.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the item.
+	Name string `json:"name"`
+
+	// RepairedFields are the paths of the drifted fields set back to their backed up values.
+	// +optional
+	RepairedFields []string `json:"repairedFields,omitempty"`
+
+	// ConflictingFields are the paths of the drifted fields left as they are in the cluster,
+	// as other field managers own them.
+	// +optional
+	ConflictingFields []string `json:"conflictingFields,omitempty"`
+
+	// Error is the error applying the repaired fields, if any.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// PreviewAction is what a restore would do with an item.
+// +kubebuilder:validation:Enum=Create;Patch;Recreate;Skip
+type PreviewAction string
+
+const (
+	// PreviewActionCreate means the item would be created.
+	PreviewActionCreate PreviewAction = "Create"
+
+	// PreviewActionPatch means the existing item would be patched.
+	PreviewActionPatch PreviewAction = "Patch"
+
+	// PreviewActionRecreate means the existing item would be deleted and created again.
+	PreviewActionRecreate PreviewAction = "Recreate"
+
+	// PreviewActionSkip means the item wouldn't be restored.
+	PreviewActionSkip PreviewAction = "Skip"
+)
+
+// PreviewItem is an item of a restore with Preview set, with what the restore would do with it.
+// The items are listed in a file downloadable as RestorePreviewReport.
+type PreviewItem struct {
+	// Resource is the group resource of the item.
+	Resource string `json:"resource"`
+
+	// Namespace is the namespace of the item, empty for a cluster-scoped item.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the item.
+	Name string `json:"name"`
+
+	// Action is what the restore would do with the item.
+	Action PreviewAction `json:"action"`
+
+	// Reason is why the item would be patched or skipped.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// RestoreMappingReport maps the identifiers of the items in the backup to the ones of the items
+// the restore created or updated, for the automation keyed by them to be reconciled. It's stored
+// in a file downloadable as RestoreMappingReport.
+type RestoreMappingReport struct {
+	// Items are the UIDs of the items.
+	// +optional
+	Items []ItemUIDMapping `json:"items,omitempty"`
+
+	// PersistentVolumes are the PersistentVolumes bound to the PersistentVolumeClaims.
+	// +optional
+	PersistentVolumes []PersistentVolumeMapping `json:"persistentVolumes,omitempty"`
+
+	// LoadBalancers are the addresses of the load balancers of the Services of type LoadBalancer.
+	// +optional
+	LoadBalancers []LoadBalancerAddressMapping `json:"loadBalancers,omitempty"`
+
+	// NodePorts are the node ports of the Services.
+	// +optional
+	NodePorts []NodePortMapping `json:"nodePorts,omitempty"`
+}
+
+// ItemUIDMapping is the UID of an item in the backup and in the cluster.
+type ItemUIDMapping struct {
+	// Resource is the group resource of the item.
+	Resource string `json:"resource"`
+
+	// BackupNamespace is the namespace of the item in the backup, empty for a cluster-scoped item.
+	// +optional
+	BackupNamespace string `json:"backupNamespace,omitempty"`
+
+	// BackupName is the name of the item in the backup.
+	BackupName string `json:"backupName"`
+
+	// BackupUID is the UID of the item in the backup.
+	BackupUID types.UID `json:"backupUID"`
+
+	// Namespace is the namespace the item is restored into, empty for a cluster-scoped item.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the restored item.
+	Name string `json:"name"`
+
+	// UID is the UID of the restored item.
+	UID types.UID `json:"uid"`
+}
+
+// PersistentVolumeMapping is the PersistentVolume bound to a restored PersistentVolumeClaim in
+// the backup and in the cluster.
+type PersistentVolumeMapping struct {
+	// Namespace is the namespace the PersistentVolumeClaim is restored into.
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the PersistentVolumeClaim.
+	Name string `json:"name"`
+
+	// BackupPersistentVolume is the PersistentVolume bound to the PersistentVolumeClaim in the
+	// backup.
+	// +optional
+	BackupPersistentVolume string `json:"backupPersistentVolume,omitempty"`
+
+	// PersistentVolume is the PersistentVolume bound to the restored PersistentVolumeClaim, empty
+	// if it isn't bound yet.
+	// +optional
+	PersistentVolume string `json:"persistentVolume,omitempty"`
+}
+
+// NodePortMapping is the node port of a port of a restored Service in the backup and in the
+// cluster.
+type NodePortMapping struct {
+	// Namespace is the namespace the Service is restored into.
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the Service.
+	Name string `json:"name"`
+
+	// Port is the port of the Service.
+	Port int32 `json:"port"`
+
+	// Protocol is the protocol of the port.
+	// +optional
+	Protocol v1.Protocol `json:"protocol,omitempty"`
+
+	// BackupNodePort is the node port of the port in the backup.
+	BackupNodePort int32 `json:"backupNodePort"`
+
+	// NodePort is the node port of the port of the restored Service, empty if it isn't exposed
+	// on the nodes anymore.
+	// +optional
+	NodePort int32 `json:"nodePort,omitempty"`
 }
 
 // RestoreProgress stores information about the restore's execution progress
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -39,6 +40,13 @@ type ScheduleSpec struct {
 	// +nullable
 	UseOwnerReferencesInBackup *bool `json:"useOwnerReferencesInBackup,omitempty"`
 
+	// PreserveBackupsOnScheduleDelete specifies whether the backups owned by this Schedule, as
+	// useOwnerReferencesInBackup is set, are kept when it's deleted. The owner references are
+	// removed from the backups before the Schedule is deleted, rather than the backups being
+	// deleted along with it by the garbage collector.
+	// +optional
+	PreserveBackupsOnScheduleDelete bool `json:"preserveBackupsOnScheduleDelete,omitempty"`
+
 	// Paused specifies whether the schedule is paused or not
 	// +optional
 	Paused bool `json:"paused,omitempty"`
@@ -49,6 +57,98 @@ type ScheduleSpec struct {
 	// If empty, will follow server configuration (default: false).
 	// +optional
 	SkipImmediately *bool `json:"skipImmediately,omitempty"`
+
+	// StorageBudget is the most storage the backups of this schedule may use, approximated by the
+	// logical bytes of the volume data they stored through the file system backup and the data
+	// mover, before deduplication and compression, as if each backup were a full one. When exceeded,
+	// the oldest backups are deleted even though their TTL hasn't expired yet. The newest backup
+	// is always kept.
+	// +optional
+	// +nullable
+	StorageBudget *resource.Quantity `json:"storageBudget,omitempty"`
+
+	// Retention is the grandfather-father-son retention policy of the backups of this schedule.
+	// The backups it doesn't keep are deleted even though their TTL hasn't expired yet.
+	// +optional
+	// +nullable
+	Retention *RetentionPolicy `json:"retention,omitempty"`
+
+	// Jitter is the most the backups of this schedule are delayed after the time they're due at,
+	// so that the schedules due at the same time don't all create their backup at once. The delay
+	// of each backup is picked at random, and should be shorter than the interval of the schedule.
+	// +optional
+	Jitter metav1.Duration `json:"jitter,omitempty"`
+
+	// Timezone is the IANA name of the time zone the Schedule is evaluated in, e.g.
+	// Australia/Sydney, so that the backups stay due at the same local time across the daylight
+	// saving time changes. Defaults to the time zone of the Velero server.
+	// +optional
+	Timezone string `json:"timezone,omitempty"`
+
+	// DependsOn makes the backups of this schedule wait for the most recent backup of another
+	// schedule, created since the last backup of this schedule, to complete successfully before
+	// they start.
+	// +optional
+	// +nullable
+	DependsOn *ScheduleDependency `json:"dependsOn,omitempty"`
+
+	// VariablesConfigMap is the name of the ConfigMap, in the namespace of the schedule, whose
+	// data are variables expanded in the labels and annotations of the backups of this schedule,
+	// and in the name of their storage location, along with the date, schedule and clusterName
+	// variables, e.g. ${date} or ${gitSHA}. The variables aren't expanded when it's not set.
+	// +optional
+	VariablesConfigMap string `json:"variablesConfigMap,omitempty"`
+}
+
+// ScheduleDependency is the schedule whose most recent backup must complete successfully before
+// the backups of a schedule start, e.g. the schedule of the logical dump of a database before the
+// schedule of the snapshots of its volumes.
+type ScheduleDependency struct {
+	// Schedule is the name of the schedule depended on, in the same namespace.
+	Schedule string `json:"schedule"`
+
+	// Timeout is how long a due backup waits for the most recent backup of the schedule depended
+	// on to complete, one hour by default.
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+
+	// FailurePolicy is what happens to a due backup when the most recent backup of the schedule
+	// depended on failed or didn't complete before the timeout: Skip, the default, skips the
+	// backup until the next time the schedule is due, Run runs it anyway.
+	// +optional
+	FailurePolicy ScheduleDependencyFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// ScheduleDependencyFailurePolicy is what happens to the due backup of a schedule when the backup
+// of the schedule it depends on failed.
+// +kubebuilder:validation:Enum=Skip;Run
+type ScheduleDependencyFailurePolicy string
+
+const (
+	// ScheduleDependencyFailurePolicySkip skips the due backup.
+	ScheduleDependencyFailurePolicySkip ScheduleDependencyFailurePolicy = "Skip"
+
+	// ScheduleDependencyFailurePolicyRun runs the due backup anyway.
+	ScheduleDependencyFailurePolicyRun ScheduleDependencyFailurePolicy = "Run"
+)
+
+// RetentionPolicy keeps the newest backup of each of the last days, weeks and months which have
+// a backup, in UTC. The newest backup of the schedule is always kept.
+type RetentionPolicy struct {
+	// KeepDaily is the number of the last days whose newest backup is kept.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepDaily int `json:"keepDaily,omitempty"`
+
+	// KeepWeekly is the number of the last ISO weeks whose newest backup is kept.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepWeekly int `json:"keepWeekly,omitempty"`
+
+	// KeepMonthly is the number of the last months whose newest backup is kept.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepMonthly int `json:"keepMonthly,omitempty"`
 }
 
 // SchedulePhase is a string representation of the lifecycle phase
@@ -91,6 +191,19 @@ type ScheduleStatus struct {
 	// applicable)
 	// +optional
 	ValidationErrors []string `json:"validationErrors,omitempty"`
+	// ObservedGeneration is the generation of the Schedule the status was last updated for. Velero
+	// resources have no status subresource, so the generation also changes when the status does.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions describe the current state of the Schedule in a form which is common to all
+	// Velero resources, so that tools like kubectl wait and GitOps health checks can use them.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 }
 
 // TODO(2.0) After converting all resources to use the runtime-controller client, the genclient and k8s:deepcopy markers will no longer be needed and should be removed.
@@ -68,6 +68,26 @@ type VolumeSnapshotLocationSpec struct {
 	// Credential contains the credential information intended to be used with this location
 	// +optional
 	Credential *corev1api.SecretKeySelector `json:"credential,omitempty"`
+
+	// RateLimit limits the rate of the snapshot API calls Velero makes against this location,
+	// shared by all the backups and backup deletions running concurrently.
+	// +optional
+	// +nullable
+	RateLimit *SnapshotRateLimit `json:"rateLimit,omitempty"`
+}
+
+// SnapshotRateLimit defines the maximum rate of the snapshot API calls made against a
+// VolumeSnapshotLocation. A zero value means no limit.
+type SnapshotRateLimit struct {
+	// CreationsPerMinute is the maximum number of snapshots created per minute.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	CreationsPerMinute int `json:"creationsPerMinute,omitempty"`
+
+	// DeletionsPerMinute is the maximum number of snapshots deleted per minute.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	DeletionsPerMinute int `json:"deletionsPerMinute,omitempty"`
 }
 
 // VolumeSnapshotLocationPhase is the lifecycle phase of a Velero VolumeSnapshotLocation.
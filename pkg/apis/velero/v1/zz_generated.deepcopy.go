@@ -6,10 +6,26 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIVersionDrift) DeepCopyInto(out *APIVersionDrift) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIVersionDrift.
+func (in *APIVersionDrift) DeepCopy() *APIVersionDrift {
+	if in == nil {
+		return nil
+	}
+	out := new(APIVersionDrift)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Backup) DeepCopyInto(out *Backup) {
 	*out = *in
@@ -37,6 +53,60 @@ func (in *Backup) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupDeletionHook) DeepCopyInto(out *BackupDeletionHook) {
+	*out = *in
+	if in.PodSelector != nil {
+		in, out := &in.PodSelector, &out.PodSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(ExecHook)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupDeletionHook.
+func (in *BackupDeletionHook) DeepCopy() *BackupDeletionHook {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupDeletionHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupDeletionHooks) DeepCopyInto(out *BackupDeletionHooks) {
+	*out = *in
+	if in.PreHooks != nil {
+		in, out := &in.PreHooks, &out.PreHooks
+		*out = make([]BackupDeletionHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PostHooks != nil {
+		in, out := &in.PostHooks, &out.PostHooks
+		*out = make([]BackupDeletionHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupDeletionHooks.
+func (in *BackupDeletionHooks) DeepCopy() *BackupDeletionHooks {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupDeletionHooks)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupHooks) DeepCopyInto(out *BackupHooks) {
 	*out = *in
@@ -91,6 +161,21 @@ func (in *BackupList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupPhaseRetries) DeepCopyInto(out *BackupPhaseRetries) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupPhaseRetries.
+func (in *BackupPhaseRetries) DeepCopy() *BackupPhaseRetries {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupPhaseRetries)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupProgress) DeepCopyInto(out *BackupProgress) {
 	*out = *in
@@ -225,6 +310,13 @@ func (in *BackupRepositoryStatus) DeepCopyInto(out *BackupRepositoryStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRepositoryStatus.
@@ -371,6 +463,25 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 			}
 		}
 	}
+	if in.ExcludedItems != nil {
+		in, out := &in.ExcludedItems, &out.ExcludedItems
+		*out = make([]ItemFieldFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ListFieldSelectors != nil {
+		in, out := &in.ListFieldSelectors, &out.ListFieldSelectors
+		*out = make([]ResourceFieldSelector, len(*in))
+		copy(*out, *in)
+	}
+	if in.FieldProjections != nil {
+		in, out := &in.FieldProjections, &out.FieldProjections
+		*out = make([]FieldProjection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SnapshotVolumes != nil {
 		in, out := &in.SnapshotVolumes, &out.SnapshotVolumes
 		*out = new(bool)
@@ -383,6 +494,18 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		**out = **in
 	}
 	in.Hooks.DeepCopyInto(&out.Hooks)
+	if in.DeletionHooks != nil {
+		in, out := &in.DeletionHooks, &out.DeletionHooks
+		*out = new(BackupDeletionHooks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.VolumeSnapshotLocations != nil {
 		in, out := &in.VolumeSnapshotLocations, &out.VolumeSnapshotLocations
 		*out = make([]string, len(*in))
@@ -407,11 +530,32 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 	}
 	out.CSISnapshotTimeout = in.CSISnapshotTimeout
 	out.ItemOperationTimeout = in.ItemOperationTimeout
+	out.MaxDuration = in.MaxDuration
+	if in.ErrorBudget != nil {
+		in, out := &in.ErrorBudget, &out.ErrorBudget
+		*out = new(int)
+		**out = **in
+	}
+	if in.NamespacePhased != nil {
+		in, out := &in.NamespacePhased, &out.NamespacePhased
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IncludeClusterScopedDependencies != nil {
+		in, out := &in.IncludeClusterScopedDependencies, &out.IncludeClusterScopedDependencies
+		*out = new(bool)
+		**out = **in
+	}
 	if in.ResourcePolicy != nil {
 		in, out := &in.ResourcePolicy, &out.ResourcePolicy
 		*out = new(corev1.TypedLocalObjectReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ResourceModifier != nil {
+		in, out := &in.ResourceModifier, &out.ResourceModifier
+		*out = new(corev1.TypedLocalObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SnapshotMoveData != nil {
 		in, out := &in.SnapshotMoveData, &out.SnapshotMoveData
 		*out = new(bool)
@@ -422,6 +566,16 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		*out = new(UploaderConfigForBackup)
 		**out = **in
 	}
+	if in.PhaseRetries != nil {
+		in, out := &in.PhaseRetries, &out.PhaseRetries
+		*out = new(BackupPhaseRetries)
+		**out = **in
+	}
+	if in.LoadBalancerPolicy != nil {
+		in, out := &in.LoadBalancerPolicy, &out.LoadBalancerPolicy
+		*out = new(LoadBalancerPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSpec.
@@ -441,6 +595,10 @@ func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
 		in, out := &in.Expiration, &out.Expiration
 		*out = (*in).DeepCopy()
 	}
+	if in.ImmutableUntil != nil {
+		in, out := &in.ImmutableUntil, &out.ImmutableUntil
+		*out = (*in).DeepCopy()
+	}
 	if in.ValidationErrors != nil {
 		in, out := &in.ValidationErrors, &out.ValidationErrors
 		*out = make([]string, len(*in))
@@ -459,11 +617,62 @@ func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
 		*out = new(BackupProgress)
 		**out = **in
 	}
+	if in.IncompleteItemList != nil {
+		in, out := &in.IncompleteItemList, &out.IncompleteItemList
+		*out = make([]IncompleteItem, len(*in))
+		copy(*out, *in)
+	}
+	if in.QuarantinedItems != nil {
+		in, out := &in.QuarantinedItems, &out.QuarantinedItems
+		*out = make([]QuarantinedItem, len(*in))
+		copy(*out, *in)
+	}
+	if in.SkippedItems != nil {
+		in, out := &in.SkippedItems, &out.SkippedItems
+		*out = make([]SkipReasonCount, len(*in))
+		copy(*out, *in)
+	}
+	if in.VolumePolicyHits != nil {
+		in, out := &in.VolumePolicyHits, &out.VolumePolicyHits
+		*out = make([]VolumePolicyHit, len(*in))
+		copy(*out, *in)
+	}
+	if in.VolumePolicyConflicts != nil {
+		in, out := &in.VolumePolicyConflicts, &out.VolumePolicyConflicts
+		*out = make([]VolumePolicyConflict, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ArtifactDigests != nil {
+		in, out := &in.ArtifactDigests, &out.ArtifactDigests
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NamespaceResults != nil {
+		in, out := &in.NamespaceResults, &out.NamespaceResults
+		*out = make([]NamespaceResult, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetCluster != nil {
+		in, out := &in.TargetCluster, &out.TargetCluster
+		*out = new(TargetClusterStatus)
+		**out = **in
+	}
 	if in.HookStatus != nil {
 		in, out := &in.HookStatus, &out.HookStatus
 		*out = new(HookStatus)
 		**out = **in
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStatus.
@@ -561,6 +770,51 @@ func (in *BackupStorageLocationSpec) DeepCopyInto(out *BackupStorageLocationSpec
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.StorageBudget != nil {
+		in, out := &in.StorageBudget, &out.StorageBudget
+		*out = new(resource.Quantity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackupLogsTTL != nil {
+		in, out := &in.BackupLogsTTL, &out.BackupLogsTTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.StorageLayout != nil {
+		in, out := &in.StorageLayout, &out.StorageLayout
+		*out = new(StorageLayout)
+		**out = **in
+	}
+	if in.UploadVerification != nil {
+		in, out := &in.UploadVerification, &out.UploadVerification
+		*out = new(UploadVerification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthProbe != nil {
+		in, out := &in.HealthProbe, &out.HealthProbe
+		*out = new(HealthProbe)
+		**out = **in
+	}
+	if in.FailoverLocations != nil {
+		in, out := &in.FailoverLocations, &out.FailoverLocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(Encryption)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Signing != nil {
+		in, out := &in.Signing, &out.Signing
+		*out = new(Signing)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ObjectLock != nil {
+		in, out := &in.ObjectLock, &out.ObjectLock
+		*out = new(ObjectLock)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStorageLocationSpec.
@@ -584,6 +838,14 @@ func (in *BackupStorageLocationStatus) DeepCopyInto(out *BackupStorageLocationSt
 		in, out := &in.LastValidationTime, &out.LastValidationTime
 		*out = (*in).DeepCopy()
 	}
+	out.LastValidationLatency = in.LastValidationLatency
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStorageLocationStatus.
@@ -678,6 +940,11 @@ func (in *DeleteBackupRequestStatus) DeepCopyInto(out *DeleteBackupRequestStatus
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.HookResults != nil {
+		in, out := &in.HookResults, &out.HookResults
+		*out = make([]DeletionHookResult, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteBackupRequestStatus.
@@ -690,6 +957,41 @@ func (in *DeleteBackupRequestStatus) DeepCopy() *DeleteBackupRequestStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeletionHookResult) DeepCopyInto(out *DeletionHookResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeletionHookResult.
+func (in *DeletionHookResult) DeepCopy() *DeletionHookResult {
+	if in == nil {
+		return nil
+	}
+	out := new(DeletionHookResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DependencyWaitCondition) DeepCopyInto(out *DependencyWaitCondition) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DependencyWaitCondition.
+func (in *DependencyWaitCondition) DeepCopy() *DependencyWaitCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(DependencyWaitCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DownloadRequest) DeepCopyInto(out *DownloadRequest) {
 	*out = *in
@@ -799,6 +1101,51 @@ func (in *DownloadTarget) DeepCopy() *DownloadTarget {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftedItem) DeepCopyInto(out *DriftedItem) {
+	*out = *in
+	if in.RepairedFields != nil {
+		in, out := &in.RepairedFields, &out.RepairedFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConflictingFields != nil {
+		in, out := &in.ConflictingFields, &out.ConflictingFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftedItem.
+func (in *DriftedItem) DeepCopy() *DriftedItem {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftedItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Encryption) DeepCopyInto(out *Encryption) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Encryption.
+func (in *Encryption) DeepCopy() *Encryption {
+	if in == nil {
+		return nil
+	}
+	out := new(Encryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecHook) DeepCopyInto(out *ExecHook) {
 	*out = *in
@@ -848,91 +1195,323 @@ func (in *ExecRestoreHook) DeepCopy() *ExecRestoreHook {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HookStatus) DeepCopyInto(out *HookStatus) {
+func (in *FieldProjection) DeepCopyInto(out *FieldProjection) {
 	*out = *in
+	if in.ExcludedFields != nil {
+		in, out := &in.ExcludedFields, &out.ExcludedFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookStatus.
-func (in *HookStatus) DeepCopy() *HookStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldProjection.
+func (in *FieldProjection) DeepCopy() *FieldProjection {
 	if in == nil {
 		return nil
 	}
-	out := new(HookStatus)
+	out := new(FieldProjection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InitRestoreHook) DeepCopyInto(out *InitRestoreHook) {
+func (in *GradualScale) DeepCopyInto(out *GradualScale) {
 	*out = *in
-	if in.InitContainers != nil {
-		in, out := &in.InitContainers, &out.InitContainers
-		*out = make([]runtime.RawExtension, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	out.Interval = in.Interval
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
 	}
-	out.Timeout = in.Timeout
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InitRestoreHook.
-func (in *InitRestoreHook) DeepCopy() *InitRestoreHook {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GradualScale.
+func (in *GradualScale) DeepCopy() *GradualScale {
 	if in == nil {
 		return nil
 	}
-	out := new(InitRestoreHook)
+	out := new(GradualScale)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Metadata) DeepCopyInto(out *Metadata) {
+func (in *HTTPVerification) DeepCopyInto(out *HTTPVerification) {
 	*out = *in
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Metadata.
-func (in *Metadata) DeepCopy() *Metadata {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPVerification.
+func (in *HTTPVerification) DeepCopy() *HTTPVerification {
 	if in == nil {
 		return nil
 	}
-	out := new(Metadata)
+	out := new(HTTPVerification)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectStorageLocation) DeepCopyInto(out *ObjectStorageLocation) {
+func (in *HealthProbe) DeepCopyInto(out *HealthProbe) {
 	*out = *in
-	if in.CACert != nil {
-		in, out := &in.CACert, &out.CACert
-		*out = make([]byte, len(*in))
-		copy(*out, *in)
-	}
+	out.LatencyThreshold = in.LatencyThreshold
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageLocation.
-func (in *ObjectStorageLocation) DeepCopy() *ObjectStorageLocation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthProbe.
+func (in *HealthProbe) DeepCopy() *HealthProbe {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectStorageLocation)
+	out := new(HealthProbe)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PluginInfo) DeepCopyInto(out *PluginInfo) {
+func (in *HookStatus) DeepCopyInto(out *HookStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginInfo.
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookStatus.
+func (in *HookStatus) DeepCopy() *HookStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HookStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IncompleteItem) DeepCopyInto(out *IncompleteItem) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncompleteItem.
+func (in *IncompleteItem) DeepCopy() *IncompleteItem {
+	if in == nil {
+		return nil
+	}
+	out := new(IncompleteItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InitRestoreHook) DeepCopyInto(out *InitRestoreHook) {
+	*out = *in
+	if in.InitContainers != nil {
+		in, out := &in.InitContainers, &out.InitContainers
+		*out = make([]runtime.RawExtension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.Timeout = in.Timeout
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InitRestoreHook.
+func (in *InitRestoreHook) DeepCopy() *InitRestoreHook {
+	if in == nil {
+		return nil
+	}
+	out := new(InitRestoreHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ItemFieldFilter) DeepCopyInto(out *ItemFieldFilter) {
+	*out = *in
+	if in.OlderThan != nil {
+		in, out := &in.OlderThan, &out.OlderThan
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ItemFieldFilter.
+func (in *ItemFieldFilter) DeepCopy() *ItemFieldFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(ItemFieldFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ItemUIDMapping) DeepCopyInto(out *ItemUIDMapping) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ItemUIDMapping.
+func (in *ItemUIDMapping) DeepCopy() *ItemUIDMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(ItemUIDMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerAddressMapping) DeepCopyInto(out *LoadBalancerAddressMapping) {
+	*out = *in
+	if in.BackupAddresses != nil {
+		in, out := &in.BackupAddresses, &out.BackupAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerAddressMapping.
+func (in *LoadBalancerAddressMapping) DeepCopy() *LoadBalancerAddressMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerAddressMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerPolicy) DeepCopyInto(out *LoadBalancerPolicy) {
+	*out = *in
+	if in.WaitForProvisioning != nil {
+		in, out := &in.WaitForProvisioning, &out.WaitForProvisioning
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerPolicy.
+func (in *LoadBalancerPolicy) DeepCopy() *LoadBalancerPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Metadata) DeepCopyInto(out *Metadata) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Metadata.
+func (in *Metadata) DeepCopy() *Metadata {
+	if in == nil {
+		return nil
+	}
+	out := new(Metadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceResult) DeepCopyInto(out *NamespaceResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceResult.
+func (in *NamespaceResult) DeepCopy() *NamespaceResult {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePortMapping) DeepCopyInto(out *NodePortMapping) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePortMapping.
+func (in *NodePortMapping) DeepCopy() *NodePortMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePortMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLock) DeepCopyInto(out *ObjectLock) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLock.
+func (in *ObjectLock) DeepCopy() *ObjectLock {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStorageLocation) DeepCopyInto(out *ObjectStorageLocation) {
+	*out = *in
+	if in.CACert != nil {
+		in, out := &in.CACert, &out.CACert
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageLocation.
+func (in *ObjectStorageLocation) DeepCopy() *ObjectStorageLocation {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStorageLocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeMapping) DeepCopyInto(out *PersistentVolumeMapping) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeMapping.
+func (in *PersistentVolumeMapping) DeepCopy() *PersistentVolumeMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginInfo) DeepCopyInto(out *PluginInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginInfo.
 func (in *PluginInfo) DeepCopy() *PluginInfo {
 	if in == nil {
 		return nil
@@ -1161,6 +1740,51 @@ func (in *PodVolumeRestoreStatus) DeepCopy() *PodVolumeRestoreStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreviewItem) DeepCopyInto(out *PreviewItem) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreviewItem.
+func (in *PreviewItem) DeepCopy() *PreviewItem {
+	if in == nil {
+		return nil
+	}
+	out := new(PreviewItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuarantinedItem) DeepCopyInto(out *QuarantinedItem) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuarantinedItem.
+func (in *QuarantinedItem) DeepCopy() *QuarantinedItem {
+	if in == nil {
+		return nil
+	}
+	out := new(QuarantinedItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceFieldSelector) DeepCopyInto(out *ResourceFieldSelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceFieldSelector.
+func (in *ResourceFieldSelector) DeepCopy() *ResourceFieldSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceFieldSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Restore) DeepCopyInto(out *Restore) {
 	*out = *in
@@ -1210,6 +1834,21 @@ func (in *RestoreHooks) DeepCopy() *RestoreHooks {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreItemSelector) DeepCopyInto(out *RestoreItemSelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreItemSelector.
+func (in *RestoreItemSelector) DeepCopy() *RestoreItemSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreItemSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RestoreList) DeepCopyInto(out *RestoreList) {
 	*out = *in
@@ -1242,6 +1881,43 @@ func (in *RestoreList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreMappingReport) DeepCopyInto(out *RestoreMappingReport) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ItemUIDMapping, len(*in))
+		copy(*out, *in)
+	}
+	if in.PersistentVolumes != nil {
+		in, out := &in.PersistentVolumes, &out.PersistentVolumes
+		*out = make([]PersistentVolumeMapping, len(*in))
+		copy(*out, *in)
+	}
+	if in.LoadBalancers != nil {
+		in, out := &in.LoadBalancers, &out.LoadBalancers
+		*out = make([]LoadBalancerAddressMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodePorts != nil {
+		in, out := &in.NodePorts, &out.NodePorts
+		*out = make([]NodePortMapping, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreMappingReport.
+func (in *RestoreMappingReport) DeepCopy() *RestoreMappingReport {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreMappingReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RestoreProgress) DeepCopyInto(out *RestoreProgress) {
 	*out = *in
@@ -1270,6 +1946,11 @@ func (in *RestoreResourceHook) DeepCopyInto(out *RestoreResourceHook) {
 		*out = new(InitRestoreHook)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Verify != nil {
+		in, out := &in.Verify, &out.Verify
+		*out = new(VerifyRestoreHook)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreResourceHook.
@@ -1352,6 +2033,11 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.IncludedItems != nil {
+		in, out := &in.IncludedItems, &out.IncludedItems
+		*out = make([]RestoreItemSelector, len(*in))
+		copy(*out, *in)
+	}
 	if in.NamespaceMapping != nil {
 		in, out := &in.NamespaceMapping, &out.NamespaceMapping
 		*out = make(map[string]string, len(*in))
@@ -1359,6 +2045,16 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.RewriteNamespaceReferences != nil {
+		in, out := &in.RewriteNamespaceReferences, &out.RewriteNamespaceReferences
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NamespaceReferenceRules != nil {
+		in, out := &in.NamespaceReferenceRules, &out.NamespaceReferenceRules
+		*out = new(corev1.TypedLocalObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LabelSelector != nil {
 		in, out := &in.LabelSelector, &out.LabelSelector
 		*out = new(metav1.LabelSelector)
@@ -1375,6 +2071,11 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 			}
 		}
 	}
+	if in.ExcludeLabelSelector != nil {
+		in, out := &in.ExcludeLabelSelector, &out.ExcludeLabelSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RestorePVs != nil {
 		in, out := &in.RestorePVs, &out.RestorePVs
 		*out = new(bool)
@@ -1407,26 +2108,243 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 		*out = new(UploaderConfigForRestore)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ErrorBudget != nil {
+		in, out := &in.ErrorBudget, &out.ErrorBudget
+		*out = new(int)
+		**out = **in
+	}
+	if in.ProtectNamespaces != nil {
+		in, out := &in.ProtectNamespaces, &out.ProtectNamespaces
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ScaleDownConflictingWorkloads != nil {
+		in, out := &in.ScaleDownConflictingWorkloads, &out.ScaleDownConflictingWorkloads
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ServerDryRun != nil {
+		in, out := &in.ServerDryRun, &out.ServerDryRun
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Preview != nil {
+		in, out := &in.Preview, &out.Preview
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NamespacePriority != nil {
+		in, out := &in.NamespacePriority, &out.NamespacePriority
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OrderByDependencies != nil {
+		in, out := &in.OrderByDependencies, &out.OrderByDependencies
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DependencyWaitConditions != nil {
+		in, out := &in.DependencyWaitConditions, &out.DependencyWaitConditions
+		*out = make([]DependencyWaitCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PVCDataSourcePolicyByStorageClass != nil {
+		in, out := &in.PVCDataSourcePolicyByStorageClass, &out.PVCDataSourcePolicyByStorageClass
+		*out = make(map[string]PVCDataSourcePolicy, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ImmutableFieldPolicies != nil {
+		in, out := &in.ImmutableFieldPolicies, &out.ImmutableFieldPolicies
+		*out = make(map[string]ImmutableFieldPolicy, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.GradualScale != nil {
+		in, out := &in.GradualScale, &out.GradualScale
+		*out = new(GradualScale)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancerPolicy != nil {
+		in, out := &in.LoadBalancerPolicy, &out.LoadBalancerPolicy
+		*out = new(LoadBalancerPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreSpec.
+func (in *RestoreSpec) DeepCopy() *RestoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreStatus) DeepCopyInto(out *RestoreStatus) {
+	*out = *in
+	if in.ValidationErrors != nil {
+		in, out := &in.ValidationErrors, &out.ValidationErrors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(RestoreProgress)
+		**out = **in
+	}
+	if in.HookStatus != nil {
+		in, out := &in.HookStatus, &out.HookStatus
+		*out = new(HookStatus)
+		**out = **in
+	}
+	if in.VerificationStatus != nil {
+		in, out := &in.VerificationStatus, &out.VerificationStatus
+		*out = new(VerificationStatus)
+		**out = **in
+	}
+	if in.APIVersionDrift != nil {
+		in, out := &in.APIVersionDrift, &out.APIVersionDrift
+		*out = make([]APIVersionDrift, len(*in))
+		copy(*out, *in)
+	}
+	if in.QuarantinedItems != nil {
+		in, out := &in.QuarantinedItems, &out.QuarantinedItems
+		*out = make([]QuarantinedItem, len(*in))
+		copy(*out, *in)
+	}
+	if in.LoadBalancerAddresses != nil {
+		in, out := &in.LoadBalancerAddresses, &out.LoadBalancerAddresses
+		*out = make([]LoadBalancerAddressMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TargetCluster != nil {
+		in, out := &in.TargetCluster, &out.TargetCluster
+		*out = new(TargetClusterStatus)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreStatus.
+func (in *RestoreStatus) DeepCopy() *RestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreStatusSpec) DeepCopyInto(out *RestoreStatusSpec) {
+	*out = *in
+	if in.IncludedResources != nil {
+		in, out := &in.IncludedResources, &out.IncludedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedResources != nil {
+		in, out := &in.ExcludedResources, &out.ExcludedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreStatusSpec.
+func (in *RestoreStatusSpec) DeepCopy() *RestoreStatusSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreStatusSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreTest) DeepCopyInto(out *RestoreTest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreTest.
+func (in *RestoreTest) DeepCopy() *RestoreTest {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreTest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RestoreTest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreTestList) DeepCopyInto(out *RestoreTestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RestoreTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreSpec.
-func (in *RestoreSpec) DeepCopy() *RestoreSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreTestList.
+func (in *RestoreTestList) DeepCopy() *RestoreTestList {
 	if in == nil {
 		return nil
 	}
-	out := new(RestoreSpec)
+	out := new(RestoreTestList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RestoreTestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RestoreStatus) DeepCopyInto(out *RestoreStatus) {
+func (in *RestoreTestRun) DeepCopyInto(out *RestoreTestRun) {
 	*out = *in
-	if in.ValidationErrors != nil {
-		in, out := &in.ValidationErrors, &out.ValidationErrors
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 	if in.StartTimestamp != nil {
 		in, out := &in.StartTimestamp, &out.StartTimestamp
 		*out = (*in).DeepCopy()
@@ -1435,49 +2353,101 @@ func (in *RestoreStatus) DeepCopyInto(out *RestoreStatus) {
 		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
 		*out = (*in).DeepCopy()
 	}
-	if in.Progress != nil {
-		in, out := &in.Progress, &out.Progress
-		*out = new(RestoreProgress)
-		**out = **in
-	}
-	if in.HookStatus != nil {
-		in, out := &in.HookStatus, &out.HookStatus
-		*out = new(HookStatus)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreStatus.
-func (in *RestoreStatus) DeepCopy() *RestoreStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreTestRun.
+func (in *RestoreTestRun) DeepCopy() *RestoreTestRun {
 	if in == nil {
 		return nil
 	}
-	out := new(RestoreStatus)
+	out := new(RestoreTestRun)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RestoreStatusSpec) DeepCopyInto(out *RestoreStatusSpec) {
+func (in *RestoreTestSpec) DeepCopyInto(out *RestoreTestSpec) {
 	*out = *in
-	if in.IncludedResources != nil {
-		in, out := &in.IncludedResources, &out.IncludedResources
+	if in.IncludedNamespaces != nil {
+		in, out := &in.IncludedNamespaces, &out.IncludedNamespaces
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.ExcludedResources != nil {
-		in, out := &in.ExcludedResources, &out.ExcludedResources
+	in.Hooks.DeepCopyInto(&out.Hooks)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreTestSpec.
+func (in *RestoreTestSpec) DeepCopy() *RestoreTestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreTestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreTestStatus) DeepCopyInto(out *RestoreTestStatus) {
+	*out = *in
+	if in.ValidationErrors != nil {
+		in, out := &in.ValidationErrors, &out.ValidationErrors
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.LastRun != nil {
+		in, out := &in.LastRun, &out.LastRun
+		*out = new(RestoreTestRun)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastPassed != nil {
+		in, out := &in.LastPassed, &out.LastPassed
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreStatusSpec.
-func (in *RestoreStatusSpec) DeepCopy() *RestoreStatusSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreTestStatus.
+func (in *RestoreTestStatus) DeepCopy() *RestoreTestStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RestoreStatusSpec)
+	out := new(RestoreTestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetentionPolicy) DeepCopyInto(out *RetentionPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetentionPolicy.
+func (in *RetentionPolicy) DeepCopy() *RetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SQLVerification) DeepCopyInto(out *SQLVerification) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQLVerification.
+func (in *SQLVerification) DeepCopy() *SQLVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(SQLVerification)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1509,6 +2479,22 @@ func (in *Schedule) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleDependency) DeepCopyInto(out *ScheduleDependency) {
+	*out = *in
+	out.Timeout = in.Timeout
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleDependency.
+func (in *ScheduleDependency) DeepCopy() *ScheduleDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScheduleList) DeepCopyInto(out *ScheduleList) {
 	*out = *in
@@ -1555,6 +2541,22 @@ func (in *ScheduleSpec) DeepCopyInto(out *ScheduleSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.StorageBudget != nil {
+		in, out := &in.StorageBudget, &out.StorageBudget
+		*out = new(resource.Quantity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(RetentionPolicy)
+		**out = **in
+	}
+	out.Jitter = in.Jitter
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = new(ScheduleDependency)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleSpec.
@@ -1583,6 +2585,13 @@ func (in *ScheduleStatus) DeepCopyInto(out *ScheduleStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleStatus.
@@ -1693,6 +2702,82 @@ func (in *ServerStatusRequestStatus) DeepCopy() *ServerStatusRequestStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Signing) DeepCopyInto(out *Signing) {
+	*out = *in
+	in.Key.DeepCopyInto(&out.Key)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Signing.
+func (in *Signing) DeepCopy() *Signing {
+	if in == nil {
+		return nil
+	}
+	out := new(Signing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SkipReasonCount) DeepCopyInto(out *SkipReasonCount) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SkipReasonCount.
+func (in *SkipReasonCount) DeepCopy() *SkipReasonCount {
+	if in == nil {
+		return nil
+	}
+	out := new(SkipReasonCount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SkippedItem) DeepCopyInto(out *SkippedItem) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SkippedItem.
+func (in *SkippedItem) DeepCopy() *SkippedItem {
+	if in == nil {
+		return nil
+	}
+	out := new(SkippedItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotRateLimit) DeepCopyInto(out *SnapshotRateLimit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotRateLimit.
+func (in *SnapshotRateLimit) DeepCopy() *SnapshotRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageLayout) DeepCopyInto(out *StorageLayout) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageLayout.
+func (in *StorageLayout) DeepCopy() *StorageLayout {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageLayout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageType) DeepCopyInto(out *StorageType) {
 	*out = *in
@@ -1713,6 +2798,41 @@ func (in *StorageType) DeepCopy() *StorageType {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetClusterStatus) DeepCopyInto(out *TargetClusterStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetClusterStatus.
+func (in *TargetClusterStatus) DeepCopy() *TargetClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UploadVerification) DeepCopyInto(out *UploadVerification) {
+	*out = *in
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UploadVerification.
+func (in *UploadVerification) DeepCopy() *UploadVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(UploadVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UploaderConfigForBackup) DeepCopyInto(out *UploaderConfigForBackup) {
 	*out = *in
@@ -1736,6 +2856,20 @@ func (in *UploaderConfigForRestore) DeepCopyInto(out *UploaderConfigForRestore)
 		*out = new(bool)
 		**out = **in
 	}
+	if in.UIDMapping != nil {
+		in, out := &in.UIDMapping, &out.UIDMapping
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.GIDMapping != nil {
+		in, out := &in.GIDMapping, &out.GIDMapping
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UploaderConfigForRestore.
@@ -1748,6 +2882,83 @@ func (in *UploaderConfigForRestore) DeepCopy() *UploaderConfigForRestore {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerificationStatus) DeepCopyInto(out *VerificationStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerificationStatus.
+func (in *VerificationStatus) DeepCopy() *VerificationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VerificationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerifyRestoreHook) DeepCopyInto(out *VerifyRestoreHook) {
+	*out = *in
+	if in.SQL != nil {
+		in, out := &in.SQL, &out.SQL
+		*out = new(SQLVerification)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPVerification)
+		**out = **in
+	}
+	out.Timeout = in.Timeout
+	out.WaitTimeout = in.WaitTimeout
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerifyRestoreHook.
+func (in *VerifyRestoreHook) DeepCopy() *VerifyRestoreHook {
+	if in == nil {
+		return nil
+	}
+	out := new(VerifyRestoreHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumePolicyConflict) DeepCopyInto(out *VolumePolicyConflict) {
+	*out = *in
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumePolicyConflict.
+func (in *VolumePolicyConflict) DeepCopy() *VolumePolicyConflict {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumePolicyConflict)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumePolicyHit) DeepCopyInto(out *VolumePolicyHit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumePolicyHit.
+func (in *VolumePolicyHit) DeepCopy() *VolumePolicyHit {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumePolicyHit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeSnapshotLocation) DeepCopyInto(out *VolumeSnapshotLocation) {
 	*out = *in
@@ -1822,6 +3033,11 @@ func (in *VolumeSnapshotLocationSpec) DeepCopyInto(out *VolumeSnapshotLocationSp
 		*out = new(corev1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(SnapshotRateLimit)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotLocationSpec.
@@ -60,6 +60,12 @@ type DataUploadSpec struct {
 	// OperationTimeout specifies the time used to wait internal operations,
 	// before returning error as timeout.
 	OperationTimeout metav1.Duration `json:"operationTimeout"`
+
+	// Tags are a map of key-value pairs the snapshot of the volume is
+	// tagged with in the backup repository.
+	// +optional
+	// +nullable
+	Tags map[string]string `json:"tags,omitempty"`
 }
 
 type SnapshotType string
@@ -168,6 +174,25 @@ type DataUploadStatus struct {
 	// +optional
 	// +nullable
 	AcceptedTimestamp *metav1.Time `json:"acceptedTimestamp,omitempty"`
+
+	// WaitingForSnapshot tells the accepted DataUpload is waiting for its snapshot to be ready to
+	// tell whether the snapshot could be read directly.
+	// +optional
+	WaitingForSnapshot bool `json:"waitingForSnapshot,omitempty"`
+
+	// ObservedGeneration is the generation of the DataUpload the status was last updated for. Velero
+	// resources have no status subresource, so the generation also changes when the status does.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions describe the current state of the DataUpload in a form which is common to all
+	// Velero resources, so that tools like kubectl wait and GitOps health checks can use them.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 }
 
 // TODO(2.0) After converting all resources to use the runttime-controller client,
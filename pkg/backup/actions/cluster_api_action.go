@@ -0,0 +1,89 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actions
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// clusterAPIClusterNameLabel is the label Cluster API sets on the objects that belong to a Cluster,
+// including the kubeconfig, CA and bootstrap secrets it generates.
+const clusterAPIClusterNameLabel = "cluster.x-k8s.io/cluster-name"
+
+// ClusterAPIClusterAction implements ItemAction.
+type ClusterAPIClusterAction struct {
+	log          logrus.FieldLogger
+	secretClient corev1client.SecretsGetter
+}
+
+// NewClusterAPIClusterAction creates a new ItemAction for Cluster API Clusters.
+func NewClusterAPIClusterAction(logger logrus.FieldLogger, secretClient corev1client.SecretsGetter) *ClusterAPIClusterAction {
+	return &ClusterAPIClusterAction{
+		log:          logger,
+		secretClient: secretClient,
+	}
+}
+
+// AppliesTo returns a ResourceSelector that applies only to Cluster API Clusters.
+func (a *ClusterAPIClusterAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{kuberesource.ClusterAPIClusters.String()},
+	}, nil
+}
+
+// Execute adds the secrets belonging to the Cluster to the list of additional items to be
+// backed up, so that the cluster's certificates and bootstrap data are always restored with it.
+// Without them, Cluster API would generate new certificates for the workload cluster on restore.
+func (a *ClusterAPIClusterAction) Execute(item runtime.Unstructured, backup *v1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+	a.log.Info("Running ClusterAPIClusterAction")
+	defer a.log.Info("Done running ClusterAPIClusterAction")
+
+	objectMeta, err := meta.Accessor(item)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	selector := labels.SelectorFromSet(map[string]string{clusterAPIClusterNameLabel: objectMeta.GetName()})
+	secrets, err := a.secretClient.Secrets(objectMeta.GetNamespace()).List(context.TODO(), metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "error listing secrets of cluster %s/%s", objectMeta.GetNamespace(), objectMeta.GetName())
+	}
+
+	var additionalItems []velero.ResourceIdentifier
+	for _, secret := range secrets.Items {
+		a.log.Infof("Adding secret %s/%s to additionalItems", secret.Namespace, secret.Name)
+		additionalItems = append(additionalItems, velero.ResourceIdentifier{
+			GroupResource: kuberesource.Secrets,
+			Namespace:     secret.Namespace,
+			Name:          secret.Name,
+		})
+	}
+
+	return item, additionalItems, nil
+}
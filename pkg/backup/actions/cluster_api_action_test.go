@@ -0,0 +1,76 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestClusterAPIClusterActionAppliesTo(t *testing.T) {
+	a := NewClusterAPIClusterAction(velerotest.NewLogger(), fake.NewSimpleClientset().CoreV1())
+
+	actual, err := a.AppliesTo()
+	require.NoError(t, err)
+	assert.Equal(t, velero.ResourceSelector{IncludedResources: []string{"clusters.cluster.x-k8s.io"}}, actual)
+}
+
+func TestClusterAPIClusterActionExecute(t *testing.T) {
+	secret := func(ns, name, cluster string) *corev1api.Secret {
+		s := &corev1api.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: name}}
+		if cluster != "" {
+			s.Labels = map[string]string{clusterAPIClusterNameLabel: cluster}
+		}
+		return s
+	}
+
+	clientset := fake.NewSimpleClientset(
+		secret("capi", "c1-kubeconfig", "c1"),
+		secret("capi", "c1-ca", "c1"),
+		secret("capi", "c2-kubeconfig", "c2"),
+		secret("capi", "unrelated", ""),
+		secret("other", "c1-kubeconfig", "c1"),
+	)
+
+	cluster := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "cluster.x-k8s.io/v1beta1",
+		"kind":       "Cluster",
+		"metadata": map[string]any{
+			"namespace": "capi",
+			"name":      "c1",
+		},
+	}}
+
+	a := NewClusterAPIClusterAction(velerotest.NewLogger(), clientset.CoreV1())
+	item, additional, err := a.Execute(cluster, nil)
+	require.NoError(t, err)
+	assert.Equal(t, cluster, item)
+	assert.ElementsMatch(t, []velero.ResourceIdentifier{
+		{GroupResource: kuberesource.Secrets, Namespace: "capi", Name: "c1-kubeconfig"},
+		{GroupResource: kuberesource.Secrets, Namespace: "capi", Name: "c1-ca"},
+	}, additional)
+}
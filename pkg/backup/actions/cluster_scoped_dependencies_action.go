@@ -0,0 +1,108 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actions
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	networkingv1api "k8s.io/api/networking/v1"
+	rbacv1api "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+// ClusterScopedDependenciesAction implements ItemAction.
+type ClusterScopedDependenciesAction struct {
+	log logrus.FieldLogger
+}
+
+// NewClusterScopedDependenciesAction creates a new ItemAction for the namespaced items
+// referencing cluster-scoped resources.
+func NewClusterScopedDependenciesAction(logger logrus.FieldLogger) *ClusterScopedDependenciesAction {
+	return &ClusterScopedDependenciesAction{log: logger}
+}
+
+// AppliesTo returns a ResourceSelector that applies only to PVCs, RoleBindings and Ingresses.
+func (a *ClusterScopedDependenciesAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{
+			kuberesource.PersistentVolumeClaims.String(),
+			kuberesource.RoleBindings.String(),
+			kuberesource.Ingresses.String(),
+		},
+	}, nil
+}
+
+// Execute returns, when the backup includes the cluster-scoped dependencies, the StorageClass of
+// a PVC, the ClusterRole of a RoleBinding or the IngressClass of an Ingress, so that they're
+// backed up even when the cluster-scoped resources aren't included. The PriorityClass of a pod
+// is already returned by the PodAction.
+func (a *ClusterScopedDependenciesAction) Execute(item runtime.Unstructured, backup *v1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+	if !boolptr.IsSetToTrue(backup.Spec.IncludeClusterScopedDependencies) {
+		return item, nil, nil
+	}
+
+	a.log.Info("Executing ClusterScopedDependenciesAction")
+	defer a.log.Info("Done executing ClusterScopedDependenciesAction")
+
+	var additionalItems []velero.ResourceIdentifier
+	switch item.GetObjectKind().GroupVersionKind().Kind {
+	case "PersistentVolumeClaim":
+		pvc := new(corev1api.PersistentVolumeClaim)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), pvc); err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+		if pvc.Spec.StorageClassName != nil && *pvc.Spec.StorageClassName != "" {
+			a.log.Infof("Adding StorageClass %s to additionalItems", *pvc.Spec.StorageClassName)
+			additionalItems = append(additionalItems, velero.ResourceIdentifier{
+				GroupResource: kuberesource.StorageClasses,
+				Name:          *pvc.Spec.StorageClassName,
+			})
+		}
+	case "RoleBinding":
+		roleBinding := new(rbacv1api.RoleBinding)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), roleBinding); err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+		if roleBinding.RoleRef.Kind == "ClusterRole" && roleBinding.RoleRef.Name != "" {
+			a.log.Infof("Adding ClusterRole %s to additionalItems", roleBinding.RoleRef.Name)
+			additionalItems = append(additionalItems, velero.ResourceIdentifier{
+				GroupResource: kuberesource.ClusterRoles,
+				Name:          roleBinding.RoleRef.Name,
+			})
+		}
+	case "Ingress":
+		ingress := new(networkingv1api.Ingress)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), ingress); err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+		if ingress.Spec.IngressClassName != nil && *ingress.Spec.IngressClassName != "" {
+			a.log.Infof("Adding IngressClass %s to additionalItems", *ingress.Spec.IngressClassName)
+			additionalItems = append(additionalItems, velero.ResourceIdentifier{
+				GroupResource: kuberesource.IngressClasses,
+				Name:          *ingress.Spec.IngressClassName,
+			})
+		}
+	}
+
+	return item, additionalItems, nil
+}
@@ -0,0 +1,134 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestClusterScopedDependenciesActionExecute(t *testing.T) {
+	pvc := velerotest.UnstructuredOrDie(`
+	{
+		"apiVersion": "v1",
+		"kind": "PersistentVolumeClaim",
+		"metadata": {
+			"namespace": "ns",
+			"name": "data"
+		},
+		"spec": {
+			"storageClassName": "fast"
+		}
+	}
+	`)
+
+	tests := []struct {
+		name     string
+		item     runtime.Unstructured
+		include  bool
+		expected []velero.ResourceIdentifier
+	}{
+		{
+			name:    "the dependencies aren't returned unless the backup includes them",
+			item:    pvc,
+			include: false,
+		},
+		{
+			name:     "the StorageClass of a PVC is returned",
+			item:     pvc,
+			include:  true,
+			expected: []velero.ResourceIdentifier{{GroupResource: kuberesource.StorageClasses, Name: "fast"}},
+		},
+		{
+			name: "the ClusterRole of a RoleBinding is returned",
+			item: velerotest.UnstructuredOrDie(`
+			{
+				"apiVersion": "rbac.authorization.k8s.io/v1",
+				"kind": "RoleBinding",
+				"metadata": {
+					"namespace": "ns",
+					"name": "view"
+				},
+				"roleRef": {
+					"apiGroup": "rbac.authorization.k8s.io",
+					"kind": "ClusterRole",
+					"name": "view"
+				}
+			}
+			`),
+			include:  true,
+			expected: []velero.ResourceIdentifier{{GroupResource: kuberesource.ClusterRoles, Name: "view"}},
+		},
+		{
+			name: "the Role of a RoleBinding isn't returned",
+			item: velerotest.UnstructuredOrDie(`
+			{
+				"apiVersion": "rbac.authorization.k8s.io/v1",
+				"kind": "RoleBinding",
+				"metadata": {
+					"namespace": "ns",
+					"name": "edit"
+				},
+				"roleRef": {
+					"apiGroup": "rbac.authorization.k8s.io",
+					"kind": "Role",
+					"name": "edit"
+				}
+			}
+			`),
+			include: true,
+		},
+		{
+			name: "the IngressClass of an Ingress is returned",
+			item: velerotest.UnstructuredOrDie(`
+			{
+				"apiVersion": "networking.k8s.io/v1",
+				"kind": "Ingress",
+				"metadata": {
+					"namespace": "ns",
+					"name": "web"
+				},
+				"spec": {
+					"ingressClassName": "nginx"
+				}
+			}
+			`),
+			include:  true,
+			expected: []velero.ResourceIdentifier{{GroupResource: kuberesource.IngressClasses, Name: "nginx"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := NewClusterScopedDependenciesAction(velerotest.NewLogger())
+			backup := builder.ForBackup("velero", "backup").IncludeClusterScopedDependencies(test.include).Result()
+
+			updated, additionalItems, err := a.Execute(test.item, backup)
+			require.NoError(t, err)
+			assert.Equal(t, test.item, updated)
+			assert.Equal(t, test.expected, additionalItems)
+		})
+	}
+}
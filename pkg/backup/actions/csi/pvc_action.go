@@ -44,10 +44,12 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/utils/volumehelper"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v2"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
 	uploaderUtil "github.com/vmware-tanzu/velero/pkg/uploader/util"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/csi"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
+	veleroutil "github.com/vmware-tanzu/velero/pkg/util/velero"
 )
 
 // pvcBackupItemAction is a backup item action plugin for Velero.
@@ -143,11 +145,17 @@ func (p *pvcBackupItemAction) createVolumeSnapshot(
 		return nil, errors.Wrap(err, "error getting storage class")
 	}
 
+	policySnapshotClass, err := volumehelper.GetSnapshotClassWithBackup(&pvc, *backup, p.crClient, p.log)
+	if err != nil {
+		return nil, err
+	}
+
 	p.log.Debugf("Fetching VolumeSnapshotClass for %s", storageClass.Provisioner)
 	vsClass, err := csi.GetVolumeSnapshotClass(
 		storageClass.Provisioner,
 		backup,
 		&pvc,
+		policySnapshotClass,
 		p.log,
 		p.crClient,
 	)
@@ -180,7 +188,9 @@ func (p *pvcBackupItemAction) createVolumeSnapshot(
 		},
 	}
 
-	if err := p.crClient.Create(context.TODO(), vs); err != nil {
+	if err := veleroutil.RetryPhase(p.log, "snapshot", veleroutil.PhaseRetries(backup).Snapshot, veleroutil.IsTransientAPIError, func() error {
+		return p.crClient.Create(context.TODO(), vs)
+	}); err != nil {
 		return nil, errors.Wrapf(
 			err, "error creating volume snapshot",
 		)
@@ -247,7 +257,13 @@ func (p *pvcBackupItemAction) Execute(
 		return nil, nil, "", nil, err
 	}
 
-	vs, err := p.createVolumeSnapshot(pvc, backup)
+	var vs *snapshotv1api.VolumeSnapshot
+	labelKey := csi.GetVolumeGroupSnapshotLabelKey(backup)
+	if group := pvc.Labels[labelKey]; group != "" {
+		vs, err = p.getGroupVolumeSnapshot(pvc, backup, labelKey, group)
+	} else {
+		vs, err = p.createVolumeSnapshot(pvc, backup)
+	}
 	if err != nil {
 		return nil, nil, "", nil, err
 	}
@@ -281,13 +297,16 @@ func (p *pvcBackupItemAction) Execute(
 
 		// Wait until VS associated VSC snapshot handle created before
 		// returning with the Async operation for data mover.
-		_, err := csi.WaitUntilVSCHandleIsReady(
-			vs,
-			p.crClient,
-			p.log,
-			true,
-			backup.Spec.CSISnapshotTimeout.Duration,
-		)
+		err := veleroutil.RetryPhase(p.log, "snapshot", veleroutil.PhaseRetries(backup).Snapshot, veleroutil.IsTransientAPIError, func() error {
+			_, err := csi.WaitUntilVSCHandleIsReady(
+				vs,
+				p.crClient,
+				p.log,
+				true,
+				backup.Spec.CSISnapshotTimeout.Duration,
+			)
+			return err
+		})
 		if err != nil {
 			dataUploadLog.Errorf(
 				"Fail to wait VolumeSnapshot turned to ReadyToUse: %s",
@@ -299,6 +318,12 @@ func (p *pvcBackupItemAction) Execute(
 
 		dataUploadLog.Info("Starting data upload of backup")
 
+		// the snapshot of the data is tagged with the cluster it's taken in
+		clusterID, err := kubeutil.GetClusterID(context.Background(), p.crClient)
+		if err != nil {
+			dataUploadLog.WithError(err).Warn("Failed to get the cluster ID, the snapshot of the data isn't tagged with it")
+		}
+
 		dataUpload, err := createDataUpload(
 			context.Background(),
 			backup,
@@ -306,6 +331,7 @@ func (p *pvcBackupItemAction) Execute(
 			vs,
 			&pvc,
 			operationID,
+			clusterID,
 		)
 		if err != nil {
 			dataUploadLog.WithError(err).Error("failed to submit DataUpload")
@@ -394,6 +420,12 @@ func (p *pvcBackupItemAction) Progress(
 		return progress, nil
 	}
 
+	if retried, err := p.retryDataUpload(dataUpload, backup); err != nil {
+		p.log.WithError(err).Warnf("Failed to retry DataUpload %s/%s", dataUpload.Namespace, dataUpload.Name)
+	} else if retried {
+		return progress, nil
+	}
+
 	progress.Description = string(dataUpload.Status.Phase)
 	progress.OperationUnits = "Bytes"
 	progress.NCompleted = dataUpload.Status.Progress.BytesDone
@@ -420,6 +452,51 @@ func (p *pvcBackupItemAction) Progress(
 	return progress, nil
 }
 
+// retryDataUpload re-runs the failed or canceled DataUpload unless it was re-run as many times as
+// the upload phase of the backup allows, and returns whether it did, e.g. after the loss of the node
+// hosting the node-agent which uploaded the data. The snapshot exposed by the DataUpload is deleted
+// along with its failure, so the PVC is snapshotted again, on its own even if it was snapshotted
+// along with its volume group. The DataUpload keeps its name, which the backup refers to, and the
+// data it uploaded already is deduplicated by the repository.
+func (p *pvcBackupItemAction) retryDataUpload(dataUpload *velerov2alpha1.DataUpload, backup *velerov1api.Backup) (bool, error) {
+	if dataUpload.Status.Phase != velerov2alpha1.DataUploadPhaseFailed && dataUpload.Status.Phase != velerov2alpha1.DataUploadPhaseCanceled {
+		return false, nil
+	}
+	retries, _ := strconv.Atoi(dataUpload.Annotations[velerov1api.DataUploadRetriesAnnotation])
+	if retries >= veleroutil.PhaseRetries(backup).Upload {
+		return false, nil
+	}
+	log := p.log.WithField("dataUpload", dataUpload.Namespace+"/"+dataUpload.Name)
+	log.Warnf("DataUpload is %s: %s, retrying it (%d/%d)", dataUpload.Status.Phase, dataUpload.Status.Message, retries+1, veleroutil.PhaseRetries(backup).Upload)
+
+	pvc := new(corev1api.PersistentVolumeClaim)
+	if err := p.crClient.Get(context.TODO(), crclient.ObjectKey{Namespace: dataUpload.Spec.SourceNamespace, Name: dataUpload.Spec.SourcePVC}, pvc); err != nil {
+		return false, errors.Wrap(err, "error getting the PVC of the DataUpload")
+	}
+	vs, err := p.createVolumeSnapshot(*pvc, backup)
+	if err != nil {
+		return false, err
+	}
+	if _, err := csi.WaitUntilVSCHandleIsReady(vs, p.crClient, p.log, true, backup.Spec.CSISnapshotTimeout.Duration); err != nil {
+		csi.CleanupVolumeSnapshot(vs, p.crClient, p.log)
+		return false, errors.Wrap(err, "error waiting for the VolumeSnapshot to be ready")
+	}
+
+	updated := dataUpload.DeepCopy()
+	if updated.Annotations == nil {
+		updated.Annotations = make(map[string]string)
+	}
+	updated.Annotations[velerov1api.DataUploadRetriesAnnotation] = strconv.Itoa(retries + 1)
+	updated.Spec.Cancel = false
+	updated.Spec.CSISnapshot.VolumeSnapshot = vs.Name
+	updated.Status = velerov2alpha1.DataUploadStatus{}
+	if err := p.crClient.Patch(context.TODO(), updated, crclient.MergeFrom(dataUpload)); err != nil {
+		csi.CleanupVolumeSnapshot(vs, p.crClient, p.log)
+		return false, errors.Wrap(err, "error resetting the DataUpload")
+	}
+	return true, nil
+}
+
 func (p *pvcBackupItemAction) Cancel(operationID string, backup *velerov1api.Backup) error {
 	if operationID == "" {
 		return biav2.InvalidOperationIDError(operationID)
@@ -442,7 +519,14 @@ func newDataUpload(
 	vs *snapshotv1api.VolumeSnapshot,
 	pvc *corev1api.PersistentVolumeClaim,
 	operationID string,
+	clusterID string,
 ) *velerov2alpha1.DataUpload {
+	// the VolumeSnapshots taken by a VolumeGroupSnapshot have no class
+	snapshotClass := ""
+	if vs.Spec.VolumeSnapshotClassName != nil {
+		snapshotClass = *vs.Spec.VolumeSnapshotClassName
+	}
+
 	dataUpload := &velerov2alpha1.DataUpload{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: velerov2alpha1.SchemeGroupVersion.String(),
@@ -472,13 +556,14 @@ func newDataUpload(
 			CSISnapshot: &velerov2alpha1.CSISnapshotSpec{
 				VolumeSnapshot: vs.Name,
 				StorageClass:   *pvc.Spec.StorageClassName,
-				SnapshotClass:  *vs.Spec.VolumeSnapshotClassName,
+				SnapshotClass:  snapshotClass,
 			},
 			SourcePVC:             pvc.Name,
 			DataMover:             backup.Spec.DataMover,
 			BackupStorageLocation: backup.Spec.StorageLocation,
 			SourceNamespace:       pvc.Namespace,
 			OperationTimeout:      backup.Spec.CSISnapshotTimeout,
+			Tags:                  uploader.BackupSnapshotTags(backup, clusterID),
 		},
 	}
 
@@ -488,6 +573,14 @@ func newDataUpload(
 		dataUpload.Spec.DataMoverConfig[uploaderUtil.ParallelFilesUpload] = strconv.Itoa(backup.Spec.UploaderConfig.ParallelFilesUpload)
 	}
 
+	if backup.Spec.UploaderConfig != nil &&
+		backup.Spec.UploaderConfig.ChangedBlockTracking {
+		if dataUpload.Spec.DataMoverConfig == nil {
+			dataUpload.Spec.DataMoverConfig = make(map[string]string)
+		}
+		dataUpload.Spec.DataMoverConfig[uploaderUtil.ChangedBlockTracking] = strconv.FormatBool(true)
+	}
+
 	return dataUpload
 }
 
@@ -498,8 +591,9 @@ func createDataUpload(
 	vs *snapshotv1api.VolumeSnapshot,
 	pvc *corev1api.PersistentVolumeClaim,
 	operationID string,
+	clusterID string,
 ) (*velerov2alpha1.DataUpload, error) {
-	dataUpload := newDataUpload(backup, vs, pvc, operationID)
+	dataUpload := newDataUpload(backup, vs, pvc, operationID, clusterID)
 
 	err := crClient.Create(ctx, dataUpload)
 	if err != nil {
@@ -26,6 +26,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
 	v1 "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
@@ -62,6 +63,7 @@ func TestExecute(t *testing.T) {
 		expectedDataUpload *velerov2alpha1.DataUpload
 		expectedPVC        *corev1.PersistentVolumeClaim
 		resourcePolicy     *corev1.ConfigMap
+		expectedVSClass    string
 	}{
 		{
 			name:        "Skip PVC BIA when backup is in finalizing phase",
@@ -111,6 +113,7 @@ func TestExecute(t *testing.T) {
 					SourcePVC:        "testPVC",
 					SourceNamespace:  "velero",
 					OperationTimeout: metav1.Duration{Duration: 1 * time.Minute},
+					Tags:             map[string]string{"backup": "test", "backup-uid": ""},
 				},
 			},
 		},
@@ -138,6 +141,27 @@ func TestExecute(t *testing.T) {
 			vsClass:        builder.ForVolumeSnapshotClass("tescVSClass").Driver("hostpath").ObjectMeta(builder.WithLabels(velerov1api.VolumeSnapshotClassSelectorLabel, "")).Result(),
 			expectedErr:    nil,
 		},
+		{
+			name:            "Test ResourcePolicy with snapshotClass parameter",
+			backup:          builder.ForBackup("velero", "test").ResourcePolicies("resourcePolicy").Result(),
+			resourcePolicy:  builder.ForConfigMap("velero", "resourcePolicy").Data("policy", "{\"version\":\"v1\", \"volumePolicies\":[{\"conditions\":{\"csi\": {}},\"action\":{\"type\":\"snapshot\",\"parameters\":{\"snapshotClass\":\"goldVSClass\"}}}]}").Result(),
+			pvc:             builder.ForPersistentVolumeClaim("velero", "testPVC").VolumeName("testPV").StorageClass("testSC").Phase(corev1.ClaimBound).Result(),
+			pv:              builder.ForPersistentVolume("testPV").CSI("hostpath", "testVolume").Result(),
+			sc:              builder.ForStorageClass("testSC").Provisioner("hostpath").Result(),
+			vsClass:         builder.ForVolumeSnapshotClass("goldVSClass").Driver("hostpath").Result(),
+			expectedErr:     nil,
+			expectedVSClass: "goldVSClass",
+		},
+		{
+			name:           "Test ResourcePolicy with snapshotClass parameter of another driver",
+			backup:         builder.ForBackup("velero", "test").ResourcePolicies("resourcePolicy").Result(),
+			resourcePolicy: builder.ForConfigMap("velero", "resourcePolicy").Data("policy", "{\"version\":\"v1\", \"volumePolicies\":[{\"conditions\":{\"csi\": {}},\"action\":{\"type\":\"snapshot\",\"parameters\":{\"snapshotClass\":\"goldVSClass\"}}}]}").Result(),
+			pvc:            builder.ForPersistentVolumeClaim("velero", "testPVC").VolumeName("testPV").StorageClass("testSC").Phase(corev1.ClaimBound).Result(),
+			pv:             builder.ForPersistentVolume("testPV").CSI("hostpath", "testVolume").Result(),
+			sc:             builder.ForStorageClass("testSC").Provisioner("hostpath").Result(),
+			vsClass:        builder.ForVolumeSnapshotClass("goldVSClass").Driver("other").Result(),
+			expectedErr:    errors.New("failed to get VolumeSnapshotClass for StorageClass testSC: VolumeSnapshotClass goldVSClass of the volume policy is not for driver hostpath"),
+		},
 	}
 
 	for _, tc := range tests {
@@ -216,6 +240,13 @@ func TestExecute(t *testing.T) {
 				require.True(t, cmp.Equal(tc.expectedDataUpload, &dataUploadList.Items[0], cmpopts.IgnoreFields(velerov2alpha1.DataUpload{}, "ResourceVersion", "Name", "Spec.CSISnapshot.VolumeSnapshot")))
 			}
 
+			if tc.expectedVSClass != "" {
+				vsList := new(v1.VolumeSnapshotList)
+				require.NoError(t, crClient.List(context.Background(), vsList))
+				require.Len(t, vsList.Items, 1)
+				require.Equal(t, tc.expectedVSClass, *vsList.Items[0].Spec.VolumeSnapshotClassName)
+			}
+
 			if tc.expectedPVC != nil {
 				resultPVC := new(corev1.PersistentVolumeClaim)
 				runtime.DefaultUnstructuredConverter.FromUnstructured(resultUnstructed.UnstructuredContent(), resultPVC)
@@ -306,6 +337,72 @@ func TestProgress(t *testing.T) {
 	}
 }
 
+func TestProgressRetriesDataUpload(t *testing.T) {
+	crClient := velerotest.NewFakeControllerRuntimeClient(t,
+		builder.ForPersistentVolumeClaim("ns", "data").VolumeName("pv").StorageClass("sc").Phase(corev1.ClaimBound).Result(),
+		builder.ForStorageClass("sc").Provisioner("hostpath").Result(),
+		builder.ForVolumeSnapshotClass("vsclass").Driver("hostpath").ObjectMeta(builder.WithLabels(velerov1api.VolumeSnapshotClassSelectorLabel, "")).Result(),
+	)
+	pvcBIA := pvcBackupItemAction{log: logrus.New(), crClient: crClient}
+	backup := builder.ForBackup("velero", "test").SnapshotMoveData(true).CSISnapshotTimeout(time.Minute).PhaseRetries(1).Result()
+
+	dataUpload := &velerov2alpha1.DataUpload{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "test-data",
+			Labels:    map[string]string{velerov1api.AsyncOperationIDLabel: "du-test"},
+		},
+		Spec: velerov2alpha1.DataUploadSpec{
+			SnapshotType:    velerov2alpha1.SnapshotTypeCSI,
+			CSISnapshot:     &velerov2alpha1.CSISnapshotSpec{VolumeSnapshot: "failed-snapshot", StorageClass: "sc"},
+			SourcePVC:       "data",
+			SourceNamespace: "ns",
+			Cancel:          true,
+		},
+		Status: velerov2alpha1.DataUploadStatus{
+			Phase:   velerov2alpha1.DataUploadPhaseCanceled,
+			Message: "node-agent restarted",
+		},
+	}
+	require.NoError(t, crClient.Create(context.Background(), dataUpload))
+
+	// the CSI driver takes the new snapshot
+	go func() {
+		var vsList v1.VolumeSnapshotList
+		_ = wait.PollUntilContextTimeout(context.Background(), 100*time.Millisecond, 10*time.Second, true, func(ctx context.Context) (bool, error) {
+			if err := crClient.List(ctx, &vsList, &crclient.ListOptions{Namespace: "ns"}); err != nil {
+				return false, err
+			}
+			return len(vsList.Items) > 0, nil
+		})
+		vscName := "vsc"
+		vsList.Items[0].Status = &v1.VolumeSnapshotStatus{BoundVolumeSnapshotContentName: &vscName}
+		_ = crClient.Update(context.Background(), &vsList.Items[0])
+		handle := "handle"
+		_ = crClient.Create(context.Background(), builder.ForVolumeSnapshotContent(vscName).Status(&snapshotv1api.VolumeSnapshotContentStatus{SnapshotHandle: &handle}).Result())
+	}()
+
+	progress, err := pvcBIA.Progress("du-test", backup)
+	require.NoError(t, err)
+	require.False(t, progress.Completed)
+
+	retried := new(velerov2alpha1.DataUpload)
+	require.NoError(t, crClient.Get(context.Background(), crclient.ObjectKeyFromObject(dataUpload), retried))
+	require.Equal(t, "1", retried.Annotations[velerov1api.DataUploadRetriesAnnotation])
+	require.False(t, retried.Spec.Cancel)
+	require.NotEqual(t, "failed-snapshot", retried.Spec.CSISnapshot.VolumeSnapshot)
+	require.Empty(t, retried.Status.Phase)
+
+	// the DataUpload isn't retried once the retries are exhausted
+	retried.Status.Phase = velerov2alpha1.DataUploadPhaseFailed
+	retried.Status.Message = "node lost"
+	require.NoError(t, crClient.Update(context.Background(), retried))
+	progress, err = pvcBIA.Progress("du-test", backup)
+	require.NoError(t, err)
+	require.True(t, progress.Completed)
+	require.Equal(t, "node lost", progress.Err)
+}
+
 func TestCancel(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -0,0 +1,254 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"context"
+	"sort"
+
+	groupsnapshotv1alpha1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumegroupsnapshot/v1alpha1"
+	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	storagev1api "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/util/csi"
+	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// getGroupVolumeSnapshot returns the VolumeSnapshot of the PVC taken as a member of the
+// VolumeGroupSnapshot of its volume group. The first PVC of the group backed up creates the
+// VolumeGroupSnapshot, the others get the VolumeSnapshots it took for them. The PVCs of a group
+// are backed up one after another, in the same ItemBlock, so the group is only snapshotted once.
+func (p *pvcBackupItemAction) getGroupVolumeSnapshot(
+	pvc corev1api.PersistentVolumeClaim,
+	backup *velerov1api.Backup,
+	labelKey, group string,
+) (*snapshotv1api.VolumeSnapshot, error) {
+	vsList := new(snapshotv1api.VolumeSnapshotList)
+	if err := p.crClient.List(context.TODO(), vsList,
+		crclient.InNamespace(pvc.Namespace),
+		crclient.MatchingLabels{
+			velerov1api.BackupNameLabel: label.GetValidName(backup.Name),
+			labelKey:                    group,
+		},
+	); err != nil {
+		return nil, errors.Wrapf(err, "error listing the VolumeSnapshots of volume group %s", group)
+	}
+
+	if len(vsList.Items) == 0 {
+		members, err := p.createVolumeGroupSnapshot(pvc, backup, labelKey, group)
+		if err != nil {
+			return nil, err
+		}
+		vsList.Items = members
+	}
+
+	for i := range vsList.Items {
+		if csi.GetVolumeSnapshotSourcePVC(&vsList.Items[i]) == pvc.Name {
+			return &vsList.Items[i], nil
+		}
+	}
+	return nil, errors.Errorf(
+		"PVC %s/%s isn't a member of the VolumeGroupSnapshot of volume group %s",
+		pvc.Namespace, pvc.Name, group)
+}
+
+// createVolumeGroupSnapshot snapshots the PVCs of the volume group together and returns the
+// VolumeSnapshots of its members. The VolumeSnapshots are detached from the VolumeGroupSnapshot,
+// which is deleted once they're taken, so they're backed up, restored and deleted like the
+// VolumeSnapshots of the PVCs snapshotted alone.
+func (p *pvcBackupItemAction) createVolumeGroupSnapshot(
+	pvc corev1api.PersistentVolumeClaim,
+	backup *velerov1api.Backup,
+	labelKey, group string,
+) ([]snapshotv1api.VolumeSnapshot, error) {
+	storageClass := new(storagev1api.StorageClass)
+	if err := p.crClient.Get(
+		context.TODO(), crclient.ObjectKey{Name: *pvc.Spec.StorageClassName},
+		storageClass,
+	); err != nil {
+		return nil, errors.Wrap(err, "error getting storage class")
+	}
+
+	vgsClass, err := csi.GetVolumeGroupSnapshotClass(storageClass.Provisioner, p.crClient)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err, "failed to get VolumeGroupSnapshotClass for StorageClass %s",
+			storageClass.Name,
+		)
+	}
+	p.log.Infof("VolumeGroupSnapshotClass=%s", vgsClass.Name)
+
+	selector, err := p.volumeGroupSnapshotSelector(pvc, backup, labelKey, group)
+	if err != nil {
+		return nil, err
+	}
+
+	vgs := &groupsnapshotv1alpha1api.VolumeGroupSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "velero-" + group + "-",
+			Namespace:    pvc.Namespace,
+			Labels: map[string]string{
+				velerov1api.BackupNameLabel: label.GetValidName(backup.Name),
+			},
+		},
+		Spec: groupsnapshotv1alpha1api.VolumeGroupSnapshotSpec{
+			Source: groupsnapshotv1alpha1api.VolumeGroupSnapshotSource{
+				Selector: selector,
+			},
+			VolumeGroupSnapshotClassName: &vgsClass.Name,
+		},
+	}
+	if err := p.crClient.Create(context.TODO(), vgs); err != nil {
+		return nil, errors.Wrap(err, "error creating volume group snapshot")
+	}
+	p.log.Infof("Created VolumeGroupSnapshot %s/%s for volume group %s", vgs.Namespace, vgs.Name, group)
+
+	members, err := p.detachVolumeGroupSnapshotMembers(vgs, backup, labelKey, group)
+	if err != nil {
+		// deleting the VolumeGroupSnapshot deletes the snapshots it took so far
+		if deleteErr := p.crClient.Delete(context.TODO(), vgs); deleteErr != nil && !apierrors.IsNotFound(deleteErr) {
+			p.log.WithError(deleteErr).Errorf("Failed to delete VolumeGroupSnapshot %s/%s", vgs.Namespace, vgs.Name)
+		}
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// volumeGroupSnapshotSelector returns the selector of the VolumeGroupSnapshot of the volume
+// group, which only selects the PVCs of the group the backup includes. It returns an error if
+// no selector selects them all, e.g. if they match different orLabelSelectors of the backup.
+func (p *pvcBackupItemAction) volumeGroupSnapshotSelector(
+	pvc corev1api.PersistentVolumeClaim,
+	backup *velerov1api.Backup,
+	labelKey, group string,
+) (*metav1.LabelSelector, error) {
+	selector, err := csi.GetVolumeGroupSnapshotSelector(backup, labelKey, group, pvc.Labels)
+	if err != nil {
+		return nil, err
+	}
+	members, err := csi.GetVolumeGroupMembers(context.TODO(), p.crClient, backup, pvc.Namespace, labelKey, group)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing the selector of volume group %s", group)
+	}
+	pvcList := new(corev1api.PersistentVolumeClaimList)
+	if err := p.crClient.List(context.TODO(), pvcList,
+		crclient.InNamespace(pvc.Namespace),
+		crclient.MatchingLabelsSelector{Selector: parsed},
+	); err != nil {
+		return nil, errors.Wrapf(err, "error listing the PVCs of volume group %s", group)
+	}
+
+	selected := make(map[string]bool, len(pvcList.Items))
+	for _, item := range pvcList.Items {
+		selected[item.Name] = true
+	}
+	for _, member := range members {
+		if !selected[member.Name] {
+			return nil, errors.Errorf(
+				"the PVCs of volume group %s included in the backup can't be snapshotted together, PVC %s/%s matches another label selector of the backup",
+				group, member.Namespace, member.Name)
+		}
+	}
+	return selector, nil
+}
+
+// detachVolumeGroupSnapshotMembers waits for the VolumeGroupSnapshot to be ready, labels its
+// VolumeSnapshots with the backup and the volume group, records their PVCs, and deletes the
+// VolumeGroupSnapshot and its content while retaining the VolumeSnapshots.
+func (p *pvcBackupItemAction) detachVolumeGroupSnapshotMembers(
+	vgs *groupsnapshotv1alpha1api.VolumeGroupSnapshot,
+	backup *velerov1api.Backup,
+	labelKey, group string,
+) ([]snapshotv1api.VolumeSnapshot, error) {
+	vgs, err := csi.WaitVolumeGroupSnapshotReady(context.TODO(), p.crClient, vgs.Name, vgs.Namespace,
+		backup.Spec.CSISnapshotTimeout.Duration, p.log)
+	if err != nil {
+		return nil, err
+	}
+
+	vgsc := new(groupsnapshotv1alpha1api.VolumeGroupSnapshotContent)
+	if err := p.crClient.Get(context.TODO(),
+		crclient.ObjectKey{Name: *vgs.Status.BoundVolumeGroupSnapshotContentName}, vgsc); err != nil {
+		return nil, errors.Wrap(err, "error getting volume group snapshot content")
+	}
+
+	// the VolumeSnapshots of the members are owned by the VolumeGroupSnapshot and only reference
+	// their VolumeSnapshotContent, their PVCs are found from the volumes of the contents
+	snapshots, err := csi.GetVolumeGroupSnapshotMembers(context.TODO(), p.crClient, vgs)
+	if err != nil {
+		return nil, err
+	}
+	pvcNames := make([]string, 0, len(snapshots))
+	for pvcName := range snapshots {
+		pvcNames = append(pvcNames, pvcName)
+	}
+	sort.Strings(pvcNames)
+
+	members := make([]snapshotv1api.VolumeSnapshot, 0, len(snapshots))
+	for _, pvcName := range pvcNames {
+		vs := snapshots[pvcName]
+		originVS := vs.DeepCopy()
+		kubeutil.AddLabels(&vs.ObjectMeta, map[string]string{
+			velerov1api.BackupNameLabel: label.GetValidName(backup.Name),
+			labelKey:                    group,
+		})
+		kubeutil.AddAnnotations(&vs.ObjectMeta, map[string]string{
+			velerov1api.VolumeSnapshotSourcePVCAnnotation: pvcName,
+		})
+		ownerRefs := make([]metav1.OwnerReference, 0, len(vs.OwnerReferences))
+		for _, ownerRef := range vs.OwnerReferences {
+			if ownerRef.Kind != "VolumeGroupSnapshot" {
+				ownerRefs = append(ownerRefs, ownerRef)
+			}
+		}
+		vs.OwnerReferences = ownerRefs
+		if err := p.crClient.Patch(context.TODO(), vs, crclient.MergeFrom(originVS)); err != nil {
+			return nil, errors.Wrapf(err, "error detaching VolumeSnapshot %s/%s from VolumeGroupSnapshot %s",
+				vs.Namespace, vs.Name, vgs.Name)
+		}
+		members = append(members, *vs)
+	}
+
+	originVGSC := vgsc.DeepCopy()
+	vgsc.Spec.DeletionPolicy = snapshotv1api.VolumeSnapshotContentRetain
+	if err := p.crClient.Patch(context.TODO(), vgsc, crclient.MergeFrom(originVGSC)); err != nil {
+		return nil, errors.Wrapf(err, "error retaining volume group snapshot content %s", vgsc.Name)
+	}
+	if err := p.crClient.Delete(context.TODO(), vgs); err != nil && !apierrors.IsNotFound(err) {
+		p.log.WithError(err).Warnf("Failed to delete VolumeGroupSnapshot %s/%s", vgs.Namespace, vgs.Name)
+	}
+	if err := p.crClient.Delete(context.TODO(), vgsc); err != nil && !apierrors.IsNotFound(err) {
+		p.log.WithError(err).Warnf("Failed to delete VolumeGroupSnapshotContent %s", vgsc.Name)
+	}
+
+	p.log.Infof("Took the VolumeSnapshots of %d PVCs of volume group %s by VolumeGroupSnapshot %s/%s",
+		len(members), group, vgs.Namespace, vgs.Name)
+	return members, nil
+}
@@ -40,6 +40,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/csi"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
+	veleroutil "github.com/vmware-tanzu/velero/pkg/util/velero"
 )
 
 // volumeSnapshotBackupItemAction is a backup item action plugin to backup
@@ -84,16 +85,13 @@ func (p *volumeSnapshotBackupItemAction) Execute(
 		return nil, nil, "", nil, errors.WithStack(err)
 	}
 
-	volumeSnapshotClassName := ""
+	// the VolumeSnapshots taken as members of a VolumeGroupSnapshot have no class
+	additionalItems := []velero.ResourceIdentifier{}
 	if vs.Spec.VolumeSnapshotClassName != nil {
-		volumeSnapshotClassName = *vs.Spec.VolumeSnapshotClassName
-	}
-
-	additionalItems := []velero.ResourceIdentifier{
-		{
+		additionalItems = append(additionalItems, velero.ResourceIdentifier{
 			GroupResource: kuberesource.VolumeSnapshotClasses,
-			Name:          volumeSnapshotClassName,
-		},
+			Name:          *vs.Spec.VolumeSnapshotClassName,
+		})
 	}
 
 	// determine if we are backing up a VolumeSnapshot that was created by velero while
@@ -115,13 +113,18 @@ func (p *volumeSnapshotBackupItemAction) Execute(
 	p.log.Infof("Getting VolumesnapshotContent for Volumesnapshot %s/%s",
 		vs.Namespace, vs.Name)
 
-	vsc, err := csi.WaitUntilVSCHandleIsReady(
-		vs,
-		p.crClient,
-		p.log,
-		backupOngoing,
-		backup.Spec.CSISnapshotTimeout.Duration,
-	)
+	var vsc *snapshotv1api.VolumeSnapshotContent
+	err := veleroutil.RetryPhase(p.log, "snapshot", veleroutil.PhaseRetries(backup).Snapshot, veleroutil.IsTransientAPIError, func() error {
+		var err error
+		vsc, err = csi.WaitUntilVSCHandleIsReady(
+			vs,
+			p.crClient,
+			p.log,
+			backupOngoing,
+			backup.Spec.CSISnapshotTimeout.Duration,
+		)
+		return err
+	})
 	if err != nil {
 		csi.CleanupVolumeSnapshot(vs, p.crClient, p.log)
 		return nil, nil, "", nil, errors.WithStack(err)
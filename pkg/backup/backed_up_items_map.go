@@ -71,6 +71,20 @@ func (m *backedUpItemsMap) ResourceMap() map[string][]string {
 	return resources
 }
 
+// NamespaceLens returns the number of backed up items of each namespace.
+func (m *backedUpItemsMap) NamespaceLens() map[string]int {
+	m.RLock()
+	defer m.RUnlock()
+
+	lens := map[string]int{}
+	for i := range m.backedUpItems {
+		if i.namespace != "" {
+			lens[i.namespace]++
+		}
+	}
+	return lens
+}
+
 func (m *backedUpItemsMap) Len() int {
 	m.RLock()
 	defer m.RUnlock()
@@ -103,3 +117,11 @@ func (m *backedUpItemsMap) AddItemToTotal(key itemKey) {
 	defer m.Unlock()
 	m.totalItems[key] = struct{}{}
 }
+
+// RemoveItem removes the item, which turned out not to be backed up.
+func (m *backedUpItemsMap) RemoveItem(key itemKey) {
+	m.Lock()
+	defer m.Unlock()
+	delete(m.backedUpItems, key)
+	delete(m.totalItems, key)
+}
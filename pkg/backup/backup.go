@@ -44,6 +44,7 @@ import (
 
 	"github.com/vmware-tanzu/velero/internal/hook"
 	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
+	"github.com/vmware-tanzu/velero/internal/throttle"
 	"github.com/vmware-tanzu/velero/internal/volume"
 	"github.com/vmware-tanzu/velero/internal/volumehelper"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -52,6 +53,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/itemblock"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/itemquarantine"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
@@ -112,7 +114,10 @@ type Backupper interface {
 
 // kubernetesBackupper implements Backupper.
 type kubernetesBackupper struct {
-	kbClient                  kbclient.Client
+	kbClient kbclient.Client
+	// clusterClient is the client of the cluster backed up, which is the one of a target
+	// cluster rather than kbClient when the server runs in the hub mode.
+	clusterClient             kbclient.Client
 	dynamicFactory            client.DynamicFactory
 	discoveryHelper           discovery.Helper
 	podCommandExecutor        podexec.PodCommandExecutor
@@ -124,6 +129,9 @@ type kubernetesBackupper struct {
 	uploaderType              string
 	pluginManager             func(logrus.FieldLogger) clientmgmt.Manager
 	backupStoreGetter         persistence.ObjectBackupStoreGetter
+	snapshotThrottler         *volume.SnapshotThrottler
+	csiSnapshotLimiter        *volume.CSISnapshotLimiter
+	loadThrottler             *throttle.Throttler
 }
 
 func (i *itemKey) String() string {
@@ -143,6 +151,7 @@ func cohabitatingResources() map[string]*cohabitatingResource {
 // NewKubernetesBackupper creates a new kubernetesBackupper.
 func NewKubernetesBackupper(
 	kbClient kbclient.Client,
+	clusterClient kbclient.Client,
 	discoveryHelper discovery.Helper,
 	dynamicFactory client.DynamicFactory,
 	podCommandExecutor podexec.PodCommandExecutor,
@@ -153,9 +162,13 @@ func NewKubernetesBackupper(
 	uploaderType string,
 	pluginManager func(logrus.FieldLogger) clientmgmt.Manager,
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	snapshotThrottler *volume.SnapshotThrottler,
+	csiSnapshotLimiter *volume.CSISnapshotLimiter,
+	loadThrottler *throttle.Throttler,
 ) (Backupper, error) {
 	return &kubernetesBackupper{
 		kbClient:                  kbClient,
+		clusterClient:             clusterClient,
 		discoveryHelper:           discoveryHelper,
 		dynamicFactory:            dynamicFactory,
 		podCommandExecutor:        podCommandExecutor,
@@ -166,6 +179,9 @@ func NewKubernetesBackupper(
 		uploaderType:              uploaderType,
 		pluginManager:             pluginManager,
 		backupStoreGetter:         backupStoreGetter,
+		snapshotThrottler:         snapshotThrottler,
+		csiSnapshotLimiter:        csiSnapshotLimiter,
+		loadThrottler:             loadThrottler,
 	}, nil
 }
 
@@ -247,6 +263,13 @@ func (kb *kubernetesBackupper) BackupWithResolvers(
 		return errors.WithStack(err)
 	}
 
+	if backupRequest.ItemQuarantine == nil {
+		backupRequest.ItemQuarantine = itemquarantine.NewTracker(backupRequest.Spec.ErrorBudget)
+	}
+	if backupRequest.SkippedItems == nil {
+		backupRequest.SkippedItems = NewSkippedItemsTracker()
+	}
+
 	backupRequest.NamespaceIncludesExcludes = getNamespaceIncludesExcludes(backupRequest.Backup)
 	log.Infof("Including namespaces: %s", backupRequest.NamespaceIncludesExcludes.IncludesString())
 	log.Infof("Excluding namespaces: %s", backupRequest.NamespaceIncludesExcludes.ExcludesString())
@@ -256,7 +279,7 @@ func (kb *kubernetesBackupper) BackupWithResolvers(
 	// so that users are at least aware about the existence of argoCD managed ns in their backup
 	// Related Issue: https://github.com/vmware-tanzu/velero/issues/7905
 	if len(backupRequest.Spec.IncludedNamespaces) > 0 {
-		nsManagedByArgoCD := getNamespacesManagedByArgoCD(kb.kbClient, backupRequest.Spec.IncludedNamespaces, log)
+		nsManagedByArgoCD := getNamespacesManagedByArgoCD(kb.clusterClient, backupRequest.Spec.IncludedNamespaces, log)
 
 		if len(nsManagedByArgoCD) > 0 {
 			log.Warnf("backup operation may encounter complications and potentially produce undesirable results due to the inclusion of namespaces %v managed by ArgoCD in the backup.", nsManagedByArgoCD)
@@ -288,6 +311,24 @@ func (kb *kubernetesBackupper) BackupWithResolvers(
 		return err
 	}
 
+	backupRequest.itemFieldFilters, err = getItemFieldFilters(backupRequest.Spec.ExcludedItems, kb.discoveryHelper, time.Now())
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Debugf("Error from getItemFieldFilters")
+		return err
+	}
+
+	backupRequest.listFieldSelectors, err = getListFieldSelectors(backupRequest.Spec.ListFieldSelectors, kb.discoveryHelper)
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Debugf("Error from getListFieldSelectors")
+		return err
+	}
+
+	backupRequest.fieldProjections, err = getFieldProjections(backupRequest.Spec.FieldProjections, kb.discoveryHelper)
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Debugf("Error from getFieldProjections")
+		return err
+	}
+
 	backupRequest.ResolvedActions, err = backupItemActionResolver.ResolveActions(kb.discoveryHelper, log)
 	if err != nil {
 		log.WithError(errors.WithStack(err)).Debugf("Error from backupItemActionResolver.ResolveActions")
@@ -323,6 +364,15 @@ func (kb *kubernetesBackupper) BackupWithResolvers(
 		}
 	}
 
+	// write the items backed up before the server restarted, they aren't backed up again
+	if backupRequest.Checkpointer != nil && backupRequest.Annotations[velerov1api.ResumeBackupAnnotation] != "" {
+		resumed, err := backupRequest.Checkpointer.Resume(backupRequest, tw)
+		if err != nil {
+			return errors.Wrap(err, "error resuming the backup from its checkpoint")
+		}
+		log.Infof("Resuming the backup from its checkpoint of %d items", resumed)
+	}
+
 	// set up a temp dir for the itemCollector to use to temporarily
 	// store items as they're scraped from the API.
 	tempDir, err := os.MkdirTemp("", "")
@@ -339,6 +389,7 @@ func (kb *kubernetesBackupper) BackupWithResolvers(
 		cohabitatingResources: cohabitatingResources(),
 		dir:                   tempDir,
 		pageSize:              kb.clientPageSize,
+		loadThrottler:         kb.loadThrottler,
 	}
 
 	items := collector.getAllItems()
@@ -364,20 +415,23 @@ func (kb *kubernetesBackupper) BackupWithResolvers(
 		backupRequest:            backupRequest,
 		tarWriter:                tw,
 		dynamicFactory:           kb.dynamicFactory,
-		kbClient:                 kb.kbClient,
+		kbClient:                 kb.clusterClient,
 		discoveryHelper:          kb.discoveryHelper,
 		podVolumeBackupper:       podVolumeBackupper,
 		podVolumeSnapshotTracker: podvolume.NewTracker(),
 		volumeSnapshotterGetter:  volumeSnapshotterGetter,
+		snapshotThrottler:        kb.snapshotThrottler,
+		csiSnapshotLimiter:       kb.csiSnapshotLimiter,
 		itemHookHandler: &hook.DefaultItemHookHandler{
-			PodCommandExecutor: kb.podCommandExecutor,
+			PodCommandExecutor:    kb.podCommandExecutor,
+			DisruptionBudgetGuard: hook.NewDisruptionBudgetGuard(kb.clusterClient, backupRequest.Spec.Hooks.PodDisruptionBudgetPolicy),
 		},
 		hookTracker: hook.NewHookTracker(),
 		volumeHelperImpl: volumehelper.NewVolumeHelperImpl(
 			resourcePolicy,
 			backupRequest.Spec.SnapshotVolumes,
 			log,
-			kb.kbClient,
+			kb.clusterClient,
 			boolptr.IsSetToTrue(backupRequest.Spec.DefaultVolumesToFsBackup),
 			!backupRequest.ResourceIncludesExcludes.ShouldInclude(kuberesource.PersistentVolumeClaims.String()),
 		),
@@ -403,11 +457,14 @@ func (kb *kubernetesBackupper) BackupWithResolvers(
 	// progress updates on the 'update' channel. It patches
 	// the backup CR with progress updates at most every second,
 	// but it will not issue a patch if it hasn't received a new
-	// update since the previous patch. This goroutine exits
-	// when it receives on the 'quit' channel.
+	// update, nor the number of queued volume snapshots changed,
+	// since the previous patch. This goroutine exits when it
+	// receives on the 'quit' channel.
 	go func() {
 		ticker := time.NewTicker(1 * time.Second)
 		var lastUpdate *progressUpdate
+		patched := progressUpdate{totalItems: len(items)}
+		queuedVolumeSnapshots := 0
 		for {
 			select {
 			case <-quit:
@@ -416,6 +473,12 @@ func (kb *kubernetesBackupper) BackupWithResolvers(
 			case val := <-update:
 				lastUpdate = &val
 			case <-ticker.C:
+				if queued := int(itemBackupper.queuedVolumeSnapshots.Load()); queued != queuedVolumeSnapshots {
+					queuedVolumeSnapshots = queued
+					if lastUpdate == nil {
+						lastUpdate = &patched
+					}
+				}
 				if lastUpdate != nil {
 					updated := backupRequest.Backup.DeepCopy()
 					if updated.Status.Progress == nil {
@@ -423,10 +486,12 @@ func (kb *kubernetesBackupper) BackupWithResolvers(
 					}
 					updated.Status.Progress.TotalItems = lastUpdate.totalItems
 					updated.Status.Progress.ItemsBackedUp = lastUpdate.itemsBackedUp
+					updated.Status.Progress.QueuedVolumeSnapshots = queuedVolumeSnapshots
 					if err := kube.PatchResource(backupRequest.Backup, updated, kb.kbClient); err != nil {
 						log.WithError(errors.WithStack((err))).Warn("Got error trying to update backup's status.progress")
 					}
-					backupRequest.Status.Progress = &velerov1api.BackupProgress{TotalItems: lastUpdate.totalItems, ItemsBackedUp: lastUpdate.itemsBackedUp}
+					backupRequest.Status.Progress = &velerov1api.BackupProgress{TotalItems: lastUpdate.totalItems, ItemsBackedUp: lastUpdate.itemsBackedUp, QueuedVolumeSnapshots: queuedVolumeSnapshots}
+					patched = *lastUpdate
 					lastUpdate = nil
 				}
 			}
@@ -435,6 +500,11 @@ func (kb *kubernetesBackupper) BackupWithResolvers(
 
 	responseCtx, responseCancel := context.WithCancel(context.Background())
 
+	phased := namespacePhased(backupRequest.Backup)
+	if phased {
+		sortItemsByNamespacePhase(items)
+	}
+
 	backedUpGroupResources := map[schema.GroupResource]bool{}
 	// Maps items in the item list from GR+NamespacedName to a slice of pointers to kubernetesResources
 	// We need the slice value since if the EnableAPIGroupVersions feature flag is set, there may
@@ -468,11 +538,19 @@ func (kb *kubernetesBackupper) BackupWithResolvers(
 				func() {
 					defer wg.Done()
 					if response.err != nil {
-						log.WithError(errors.WithStack((response.err))).Error("Got error in BackupItemBlock.")
+						blockLog := log
+						if len(response.itemBlock.Items) > 0 {
+							// attribute the error to the namespace of the ItemBlock
+							blockLog = log.WithField("namespace", response.itemBlock.Items[0].Item.GetNamespace())
+						}
+						blockLog.WithError(errors.WithStack((response.err))).Error("Got error in BackupItemBlock.")
 					}
 					for _, backedUpGR := range response.resources {
 						backedUpGroupResources[backedUpGR] = true
 					}
+					if backupRequest.Checkpointer != nil {
+						backupRequest.Checkpointer.ItemBlockCompleted(response.itemBlock, backupRequest)
+					}
 					// We could eventually track which itemBlocks have finished
 					// using response.itemBlock
 
@@ -503,6 +581,33 @@ func (kb *kubernetesBackupper) BackupWithResolvers(
 	}()
 
 	for i := range items {
+		// stop backing up items once the backup window is over, the items not backed up yet
+		// are listed in the status of the backup, and logged as errors so the backup can't
+		// end as Completed
+		if MaxDurationExceeded(backupRequest.Backup, time.Now()) {
+			recordIncompleteItems(log, backupRequest, itemBlock, items[i:])
+			break
+		}
+
+		// stop backing up items once more items failed than the error budget allows,
+		// the backup is aborted
+		if backupRequest.ItemQuarantine.Exceeded() {
+			log.Errorf("%d items failed, exceeding the errorBudget of %d, aborting the backup", backupRequest.ItemQuarantine.Failed(), backupRequest.ItemQuarantine.Budget())
+			break
+		}
+
+		// slow down the backup while the cluster is overloaded
+		_ = kb.loadThrottler.Wait(responseCtx)
+
+		// in a namespace-phased backup, finish backing up the items of the previous
+		// namespace before starting with the next one
+		if phased && (i == 0 || namespacePhase(items[i]) != namespacePhase(items[i-1])) {
+			wg.Wait()
+			if phase := namespacePhase(items[i]); phase != "" {
+				log.WithField("namespace", phase).Info("Backing up namespace")
+			}
+		}
+
 		log.WithFields(map[string]any{
 			"progress":  "",
 			"resource":  items[i].groupResource.String(),
@@ -543,7 +648,9 @@ func (kb *kubernetesBackupper) BackupWithResolvers(
 		// 1) This is not the last item to be processed
 		// 2) Both current and next item are ordered resources
 		// 3) Both current and next item are for the same GroupResource
-		addNextToBlock := i < len(items)-1 && items[i].orderedResource && items[i+1].orderedResource && items[i].groupResource == items[i+1].groupResource
+		// 4) Both current and next item are in the same namespace phase, for a namespace-phased backup
+		addNextToBlock := i < len(items)-1 && items[i].orderedResource && items[i+1].orderedResource && items[i].groupResource == items[i+1].groupResource &&
+			(!phased || namespacePhase(items[i]) == namespacePhase(items[i+1]))
 		if itemBlock != nil && len(itemBlock.Items) > 0 && !addNextToBlock {
 			log.Infof("Backing Up Item Block including %s %s/%s (%v items in block)", items[i].groupResource.String(), items[i].namespace, items[i].name, len(itemBlock.Items))
 
@@ -586,8 +693,19 @@ func (kb *kubernetesBackupper) BackupWithResolvers(
 		}
 	}
 
-	processedPVBs := itemBackupper.podVolumeBackupper.WaitAllPodVolumesProcessed(log)
-	backupRequest.PodVolumeBackups = append(backupRequest.PodVolumeBackups, processedPVBs...)
+	// the pod volume backupper is nil when the server runs without node agents
+	if itemBackupper.podVolumeBackupper != nil {
+		processedPVBs := itemBackupper.podVolumeBackupper.WaitAllPodVolumesProcessed(log)
+		backupRequest.PodVolumeBackups = append(backupRequest.PodVolumeBackups, processedPVBs...)
+	}
+
+	if backupRequest.Checkpointer != nil {
+		resumedPVBs, err := backupRequest.Checkpointer.PodVolumeBackups(context.Background(), kb.kbClient, backupRequest.Backup)
+		if err != nil {
+			log.WithError(err).Error("Error getting the PodVolumeBackups of the pods backed up before the backup resumed")
+		}
+		backupRequest.PodVolumeBackups = append(backupRequest.PodVolumeBackups, resumedPVBs...)
+	}
 
 	// do a final update on progress since we may have just added some CRDs and may not have updated
 	// for the last few processed items.
@@ -616,12 +734,74 @@ func (kb *kubernetesBackupper) BackupWithResolvers(
 		log.Infof("Summary for skipped PVs: %s", skippedPVSummary)
 	}
 
+	if backupRequest.ResPolicies != nil {
+		for _, conflict := range backupRequest.ResPolicies.Conflicts() {
+			log.Warnf("Conflicting volume policies: %s", conflict)
+			backupRequest.Status.VolumePolicyConflicts = append(backupRequest.Status.VolumePolicyConflicts, velerov1api.VolumePolicyConflict{
+				Volume:   conflict.Volume,
+				Policies: conflict.Policies(),
+			})
+		}
+		for _, hit := range backupRequest.ResPolicies.Hits() {
+			backupRequest.Status.VolumePolicyHits = append(backupRequest.Status.VolumePolicyHits, velerov1api.VolumePolicyHit{
+				Policy:  hit.Policy,
+				Action:  string(hit.Action),
+				Matched: hit.Matched,
+				Applied: hit.Applied,
+			})
+		}
+	}
+
 	backupRequest.Status.Progress = &velerov1api.BackupProgress{TotalItems: backedUpItems, ItemsBackedUp: backedUpItems}
 	log.WithField("progress", "").Infof("Backed up a total of %d items", backedUpItems)
 
+	backupRequest.Status.SkippedItems = backupRequest.SkippedItems.Summary()
+	backupRequest.Status.QuarantinedItems = backupRequest.ItemQuarantine.Items()
+	if backupRequest.ItemQuarantine.Exceeded() {
+		backupRequest.Status.ErrorBudgetExceeded = true
+		return errors.Errorf("backup aborted, %d items failed, exceeding its errorBudget of %d", backupRequest.ItemQuarantine.Failed(), backupRequest.ItemQuarantine.Budget())
+	}
+
+	if CancelOnMaxDuration(backupRequest.Backup) {
+		return errors.Errorf("backup canceled, it exceeded its maxDuration of %s", backupRequest.Spec.MaxDuration.Duration)
+	}
 	return nil
 }
 
+// recordIncompleteItems records each item which won't be backed up because the backup exceeded its
+// MaxDuration in the status of the backup, and logs an error for it: the items of the ItemBlock
+// being built and the remaining items.
+func recordIncompleteItems(log logrus.FieldLogger, backupRequest *Request, itemBlock *BackupItemBlock, remaining []*kubernetesResource) {
+	log.Errorf("Backup exceeded its maxDuration of %s, skipping the items not backed up yet", backupRequest.Spec.MaxDuration.Duration)
+
+	logIncomplete := func(groupResource, namespace, name string) {
+		AddIncompleteItem(&backupRequest.Status, groupResource, namespace, name)
+		log.WithFields(logrus.Fields{
+			"resource":  groupResource,
+			"namespace": namespace,
+			"name":      name,
+		}).Error("Item not backed up, the backup exceeded its maxDuration")
+	}
+	if itemBlock != nil {
+		for _, item := range itemBlock.Items {
+			logIncomplete(item.Gr.String(), item.Item.GetNamespace(), item.Item.GetName())
+		}
+	}
+	seen := map[velero.ResourceIdentifier]bool{}
+	for _, item := range remaining {
+		key := velero.ResourceIdentifier{GroupResource: item.groupResource, Namespace: item.namespace, Name: item.name}
+		// items already in a processed ItemBlock or excluded aren't incomplete, and with the
+		// EnableAPIGroupVersions feature there may be an item per version of the resource
+		if item.inItemBlockOrExcluded || seen[key] {
+			continue
+		}
+		seen[key] = true
+		logIncomplete(item.groupResource.String(), item.namespace, item.name)
+	}
+
+	backupRequest.Status.MaxDurationExceeded = true
+}
+
 func (kb *kubernetesBackupper) executeItemBlockActions(
 	log logrus.FieldLogger,
 	obj runtime.Unstructured,
@@ -814,6 +994,10 @@ func (kb *kubernetesBackupper) handleItemBlockPostHooks(itemBlock *BackupItemBlo
 
 // wait all PVBs of the item block pods to be processed
 func (kb *kubernetesBackupper) waitUntilPVBsProcessed(ctx context.Context, log logrus.FieldLogger, itemBlock *BackupItemBlock, pods []itemblock.ItemBlockItem) error {
+	if itemBlock.itemBackupper.podVolumeBackupper == nil {
+		return nil
+	}
+
 	pvbMap := map[*velerov1api.PodVolumeBackup]bool{}
 	for _, pod := range pods {
 		namespace, name := pod.Item.GetNamespace(), pod.Item.GetName()
@@ -859,14 +1043,16 @@ func (kb *kubernetesBackupper) backupItem(log logrus.FieldLogger, gr schema.Grou
 		log.WithField("name", unstructured.GetName()).Infof("%d errors encountered backup up item", len(aggregate.Errors()))
 		// log each error separately so we get error location info in the log, and an
 		// accurate count of errors
-		for _, err = range aggregate.Errors() {
+		for _, err := range aggregate.Errors() {
 			log.WithError(err).WithField("name", unstructured.GetName()).Error("Error backing up item")
 		}
 
+		itemBackupper.backupRequest.ItemQuarantine.Add(gr, unstructured.GetNamespace(), unstructured.GetName(), err)
 		return false
 	}
 	if err != nil {
 		log.WithError(err).WithField("name", unstructured.GetName()).Error("Error backing up item")
+		itemBackupper.backupRequest.ItemQuarantine.Add(gr, unstructured.GetNamespace(), unstructured.GetName(), err)
 		return false
 	}
 	return backedUpItem
@@ -978,6 +1164,13 @@ func (kb *kubernetesBackupper) FinalizeBackup(
 	defer gzr.Close()
 	tr := tar.NewReader(gzr)
 
+	// the items updated by the async operations are written again
+	backupRequest.fieldProjections, err = getFieldProjections(backupRequest.Spec.FieldProjections, kb.discoveryHelper)
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Debugf("Error from getFieldProjections")
+		return err
+	}
+
 	backupRequest.ResolvedActions, err = backupItemActionResolver.ResolveActions(kb.discoveryHelper, log)
 	if err != nil {
 		log.WithError(errors.WithStack(err)).Debugf("Error from backupItemActionResolver.ResolveActions")
@@ -1000,6 +1193,7 @@ func (kb *kubernetesBackupper) FinalizeBackup(
 		cohabitatingResources: cohabitatingResources(),
 		dir:                   tempDir,
 		pageSize:              kb.clientPageSize,
+		loadThrottler:         kb.loadThrottler,
 	}
 
 	// Get item list from itemoperation.BackupOperation.Spec.PostOperationItems
@@ -1016,7 +1210,7 @@ func (kb *kubernetesBackupper) FinalizeBackup(
 		backupRequest:            backupRequest,
 		tarWriter:                tw,
 		dynamicFactory:           kb.dynamicFactory,
-		kbClient:                 kb.kbClient,
+		kbClient:                 kb.clusterClient,
 		discoveryHelper:          kb.discoveryHelper,
 		itemHookHandler:          &hook.NoOpItemHookHandler{},
 		podVolumeSnapshotTracker: podvolume.NewTracker(),
@@ -1091,7 +1285,7 @@ func (kb *kubernetesBackupper) FinalizeBackup(
 		return err
 	}
 
-	if err := putVolumeInfos(backupRequest.Name, volumeInfos, backupStore); err != nil {
+	if err := putVolumeInfos(backupRequest.Backup, volumeInfos, backupStore); err != nil {
 		log.WithError(err).Errorf("fail to put the VolumeInfos for backup %s", backupRequest.Name)
 		return err
 	}
@@ -1237,7 +1431,7 @@ func updateVolumeInfos(
 }
 
 func putVolumeInfos(
-	backupName string,
+	backup *velerov1api.Backup,
 	volumeInfos []*volume.BackupVolumeInfo,
 	backupStore persistence.BackupStore,
 ) error {
@@ -1253,7 +1447,11 @@ func putVolumeInfos(
 		return errors.Wrap(err, "error closing gzip writer")
 	}
 
-	return backupStore.PutBackupVolumeInfos(backupName, backupVolumeInfoBuf)
+	if err := persistence.SetBackupArtifactDigest(backup, velerov1api.DownloadTargetKindBackupVolumeInfos, backupVolumeInfoBuf); err != nil {
+		return err
+	}
+
+	return backupStore.PutBackupVolumeInfos(backup.Name, backupVolumeInfoBuf)
 }
 
 func getNamespacesManagedByArgoCD(kbClient kbclient.Client, includedNamespaces []string, log logrus.FieldLogger) []string {
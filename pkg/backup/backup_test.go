@@ -41,6 +41,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	"github.com/vmware-tanzu/velero/internal/volume"
 	"github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
@@ -50,6 +51,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/itemblock"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
@@ -130,6 +132,50 @@ func TestBackedUpItemsMatchesTarballContents(t *testing.T) {
 	assertTarballContents(t, backupFile, append(expectedFiles, "metadata/version")...)
 }
 
+// TestNamespacePhasedBackup verifies that a namespace-phased backup backs up
+// the items of all the namespaces.
+func TestNamespacePhasedBackup(t *testing.T) {
+	h := newHarness(t, nil)
+	defer h.itemBlockPool.Stop()
+
+	req := &Request{
+		Backup:           defaultBackup().NamespacePhased(true).Result(),
+		SkippedPVTracker: NewSkipPVTracker(),
+		BackedUpItems:    NewBackedUpItemsMap(),
+		ItemBlockChannel: h.itemBlockPool.GetInputChannel(),
+	}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	apiResources := []*test.APIResource{
+		test.Pods(
+			builder.ForPod("foo", "bar").Result(),
+			builder.ForPod("zoo", "raz").Result(),
+		),
+		test.Deployments(
+			builder.ForDeployment("foo", "bar").Result(),
+			builder.ForDeployment("zoo", "raz").Result(),
+		),
+	}
+	for _, resource := range apiResources {
+		h.addItems(t, resource)
+	}
+
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil, nil))
+
+	assertTarballContents(t, backupFile,
+		"metadata/version",
+		"resources/pods/namespaces/foo/bar.json",
+		"resources/pods/namespaces/zoo/raz.json",
+		"resources/deployments.apps/namespaces/foo/bar.json",
+		"resources/deployments.apps/namespaces/zoo/raz.json",
+		"resources/pods/v1-preferredversion/namespaces/foo/bar.json",
+		"resources/pods/v1-preferredversion/namespaces/zoo/raz.json",
+		"resources/deployments.apps/v1-preferredversion/namespaces/foo/bar.json",
+		"resources/deployments.apps/v1-preferredversion/namespaces/zoo/raz.json",
+	)
+	assert.Equal(t, map[string]int{"foo": 2, "zoo": 2}, req.BackedUpItems.NamespaceLens())
+}
+
 // TestBackupProgressIsUpdated verifies that after a backup has run, its
 // status.progress fields are updated to reflect the total number of items
 // backed up. It validates this by comparing their values to the length of
@@ -170,6 +216,298 @@ func TestBackupProgressIsUpdated(t *testing.T) {
 	assert.Equal(t, req.BackedUpItems.Len(), req.Status.Progress.ItemsBackedUp)
 }
 
+// TestBackupWithoutPodVolumeBackupper verifies that a backup taken by a server running
+// without node agents, i.e. with no pod volume backupper factory, completes.
+func TestBackupWithoutPodVolumeBackupper(t *testing.T) {
+	h := newHarness(t, nil)
+	defer h.itemBlockPool.Stop()
+	h.backupper.podVolumeBackupperFactory = nil
+
+	req := &Request{
+		Backup:           defaultBackup().Result(),
+		SkippedPVTracker: NewSkipPVTracker(),
+		BackedUpItems:    NewBackedUpItemsMap(),
+		ItemBlockChannel: h.itemBlockPool.GetInputChannel(),
+	}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	h.addItems(t, test.Pods(
+		builder.ForPod("foo", "bar").Volumes(builder.ForVolume("vol").PersistentVolumeClaimSource("pvc").Result()).Result(),
+		builder.ForPod("zoo", "raz").Result(),
+	))
+
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil, nil))
+
+	assert.Empty(t, req.PodVolumeBackups)
+	require.NotNil(t, req.Status.Progress)
+	assert.Equal(t, 2, req.Status.Progress.ItemsBackedUp)
+}
+
+// TestBackupSkippedItems verifies that the items which aren't included in a backup are
+// tracked with the reason why they are skipped, and summarized in its status.
+func TestBackupSkippedItems(t *testing.T) {
+	h := newHarness(t, nil)
+	defer h.itemBlockPool.Stop()
+	req := &Request{
+		Backup:           defaultBackup().IncludedNamespaces("foo", "zoo").ExcludedResources("deployments").Result(),
+		SkippedPVTracker: NewSkipPVTracker(),
+		BackedUpItems:    NewBackedUpItemsMap(),
+		ItemBlockChannel: h.itemBlockPool.GetInputChannel(),
+	}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	apiResources := []*test.APIResource{
+		test.Pods(
+			builder.ForPod("foo", "bar").Result(),
+			builder.ForPod("foo", "baz").ObjectMeta(builder.WithLabels(velerov1.ExcludeFromBackupLabel, "true")).Result(),
+			builder.ForPod("foo", "deleted").ObjectMeta(builder.WithDeletionTimestamp(time.Now()), builder.WithFinalizers("velero.io/test")).Result(),
+			builder.ForPod("zoo", "raz").Result(),
+			builder.ForPod("zoo", "started").Result(),
+			builder.ForPod("other", "qux").Result(),
+		),
+		test.Deployments(
+			builder.ForDeployment("foo", "bar").Result(),
+		),
+	}
+	for _, resource := range apiResources {
+		h.addItems(t, resource)
+	}
+
+	// the action excludes both pods of zoo, but it returned an additional item for zoo/started,
+	// whose backup had started already
+	vetoingAction := &pluggableAction{
+		selector: velero.ResourceSelector{IncludedNamespaces: []string{"zoo"}},
+		executeFunc: func(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, string, []velero.ResourceIdentifier, error) {
+			obj := item.(*unstructured.Unstructured).DeepCopy()
+			obj.SetLabels(map[string]string{velerov1.ExcludeFromBackupLabel: "true"})
+			if obj.GetName() == "started" {
+				return obj, []velero.ResourceIdentifier{{GroupResource: kuberesource.Pods, Namespace: "foo", Name: "bar"}}, "", nil, nil
+			}
+			return obj, nil, "", nil, nil
+		},
+	}
+
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, []biav2.BackupItemAction{vetoingAction}, nil, nil))
+
+	assertTarballContents(t, backupFile,
+		"metadata/version",
+		"resources/pods/namespaces/foo/bar.json",
+		"resources/pods/namespaces/zoo/started.json",
+		"resources/pods/v1-preferredversion/namespaces/foo/bar.json",
+		"resources/pods/v1-preferredversion/namespaces/zoo/started.json",
+	)
+	assert.Equal(t, []velerov1.SkippedItem{
+		{Resource: "deployments.apps", Reason: velerov1.SkipReasonResourceExcluded},
+		{Resource: "pods", Namespace: "foo", Name: "baz", Reason: velerov1.SkipReasonExcludeLabel},
+		{Resource: "pods", Namespace: "foo", Name: "deleted", Reason: velerov1.SkipReasonBeingDeleted},
+		{Resource: "pods", Namespace: "zoo", Name: "raz", Reason: velerov1.SkipReasonPlugin},
+	}, req.SkippedItems.Items())
+	assert.Equal(t, []velerov1.SkipReasonCount{
+		{Reason: velerov1.SkipReasonBeingDeleted, Count: 1},
+		{Reason: velerov1.SkipReasonExcludeLabel, Count: 1},
+		{Reason: velerov1.SkipReasonPlugin, Count: 1},
+		{Reason: velerov1.SkipReasonResourceExcluded, Count: 1},
+	}, req.Status.SkippedItems)
+}
+
+// TestBackupSkippedItemsByLabelSelector verifies that the items not matching the label
+// selectors of a backup are recorded as skipped.
+func TestBackupSkippedItemsByLabelSelector(t *testing.T) {
+	h := newHarness(t, nil)
+	defer h.itemBlockPool.Stop()
+	req := &Request{
+		Backup: defaultBackup().OrLabelSelector([]*metav1.LabelSelector{
+			{MatchLabels: map[string]string{"app": "a"}},
+			{MatchLabels: map[string]string{"app": "b"}},
+		}).Result(),
+		SkippedPVTracker: NewSkipPVTracker(),
+		BackedUpItems:    NewBackedUpItemsMap(),
+		ItemBlockChannel: h.itemBlockPool.GetInputChannel(),
+	}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	h.addItems(t, test.Pods(
+		builder.ForPod("foo", "a").ObjectMeta(builder.WithLabels("app", "a")).Result(),
+		builder.ForPod("foo", "b").ObjectMeta(builder.WithLabels("app", "b")).Result(),
+		builder.ForPod("foo", "c").ObjectMeta(builder.WithLabels("app", "c")).Result(),
+	))
+
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil, nil))
+
+	assertTarballContents(t, backupFile,
+		"metadata/version",
+		"resources/pods/namespaces/foo/a.json",
+		"resources/pods/namespaces/foo/b.json",
+		"resources/pods/v1-preferredversion/namespaces/foo/a.json",
+		"resources/pods/v1-preferredversion/namespaces/foo/b.json",
+	)
+	assert.Equal(t, []velerov1.SkippedItem{
+		{Resource: "pods", Namespace: "foo", Name: "c", Reason: velerov1.SkipReasonLabelSelector},
+	}, req.SkippedItems.Items())
+}
+
+// TestBackupSkippedItemsByPolicy verifies that the persistent volumes whose data a volume policy
+// skips are recorded as skipped.
+func TestBackupSkippedItemsByPolicy(t *testing.T) {
+	h := newHarness(t, nil)
+	defer h.itemBlockPool.Stop()
+	req := &Request{
+		Backup:           defaultBackup().Result(),
+		SkippedPVTracker: NewSkipPVTracker(),
+		BackedUpItems:    NewBackedUpItemsMap(),
+		ItemBlockChannel: h.itemBlockPool.GetInputChannel(),
+		ResPolicies:      new(resourcepolicies.Policies),
+	}
+	require.NoError(t, req.ResPolicies.BuildPolicy(&resourcepolicies.ResourcePolicies{
+		Version: "v1",
+		VolumePolicies: []resourcepolicies.VolumePolicy{
+			{
+				Action:     resourcepolicies.Action{Type: resourcepolicies.Skip},
+				Conditions: map[string]any{"storageClass": []string{"gp2"}},
+			},
+		},
+	}))
+	backupFile := bytes.NewBuffer([]byte{})
+
+	fakeClient := test.NewFakeControllerRuntimeClient(t,
+		builder.ForPersistentVolume("pv-1").StorageClass("gp2").Result(),
+		builder.ForPersistentVolume("pv-2").StorageClass("gp3").Result(),
+	)
+	h.backupper.kbClient = fakeClient
+	h.backupper.clusterClient = fakeClient
+	h.addItems(t, test.PVs(
+		builder.ForPersistentVolume("pv-1").StorageClass("gp2").Result(),
+		builder.ForPersistentVolume("pv-2").StorageClass("gp3").Result(),
+	))
+
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil, nil))
+
+	assertTarballContents(t, backupFile,
+		"metadata/version",
+		"resources/persistentvolumes/cluster/pv-1.json",
+		"resources/persistentvolumes/cluster/pv-2.json",
+		"resources/persistentvolumes/v1-preferredversion/cluster/pv-1.json",
+		"resources/persistentvolumes/v1-preferredversion/cluster/pv-2.json",
+	)
+	assert.Equal(t, []velerov1.SkippedItem{
+		{Resource: "persistentvolumes", Name: "pv-1", Reason: velerov1.SkipReasonPolicy},
+	}, req.SkippedItems.Items())
+}
+
+// TestBackupFieldProjections verifies that the fields removed by the field projections of a
+// backup are left out of the items written to the tarball.
+func TestBackupFieldProjections(t *testing.T) {
+	h := newHarness(t, nil)
+	defer h.itemBlockPool.Stop()
+	req := &Request{
+		Backup: defaultBackup().FieldProjections(
+			velerov1.FieldProjection{Resource: "*", ExcludedFields: []string{".metadata.managedFields"}},
+			velerov1.FieldProjection{Resource: "secrets", ExcludedFields: []string{".data"}},
+		).Result(),
+		SkippedPVTracker: NewSkipPVTracker(),
+		BackedUpItems:    NewBackedUpItemsMap(),
+		ItemBlockChannel: h.itemBlockPool.GetInputChannel(),
+	}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	managedFields := builder.WithManagedFields([]metav1.ManagedFieldsEntry{{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationApply}})
+	h.addItems(t, test.Secrets(
+		builder.ForSecret("foo", "creds").ObjectMeta(managedFields).Data(map[string][]byte{"password": []byte("secret")}).Result(),
+	))
+	h.addItems(t, test.Pods(
+		builder.ForPod("foo", "bar").ObjectMeta(managedFields).NodeName("node-1").Result(),
+	))
+
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil, nil))
+
+	assertTarballFileContents(t, backupFile, map[string]unstructuredObject{
+		"resources/secrets/namespaces/foo/creds.json": toUnstructuredOrFail(t, builder.ForSecret("foo", "creds").Result()),
+		"resources/pods/namespaces/foo/bar.json":      toUnstructuredOrFail(t, builder.ForPod("foo", "bar").NodeName("node-1").Result()),
+	})
+}
+
+// TestBackupResourceModifiers verifies that the items written to the tarball are modified by the
+// resource modifiers of a backup.
+func TestBackupResourceModifiers(t *testing.T) {
+	h := newHarness(t, nil)
+	defer h.itemBlockPool.Stop()
+	req := &Request{
+		Backup:           defaultBackup().ResourceModifier("redact").Result(),
+		SkippedPVTracker: NewSkipPVTracker(),
+		BackedUpItems:    NewBackedUpItemsMap(),
+		ItemBlockChannel: h.itemBlockPool.GetInputChannel(),
+		ResourceModifiers: &resourcemodifiers.ResourceModifiers{
+			Version: "v1",
+			ResourceModifierRules: []resourcemodifiers.ResourceModifierRule{{
+				Conditions: resourcemodifiers.Conditions{GroupResource: "secrets"},
+				Patches: []resourcemodifiers.JSONPatch{
+					{Operation: "replace", Path: "/data/password", Value: "cmVkYWN0ZWQ="},
+				},
+			}},
+		},
+	}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	h.addItems(t, test.Secrets(
+		builder.ForSecret("foo", "creds").Data(map[string][]byte{"password": []byte("secret")}).Result(),
+	))
+	h.addItems(t, test.Pods(
+		builder.ForPod("foo", "bar").NodeName("node-1").Result(),
+	))
+
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil, nil))
+
+	assertTarballFileContents(t, backupFile, map[string]unstructuredObject{
+		"resources/secrets/namespaces/foo/creds.json": toUnstructuredOrFail(t, builder.ForSecret("foo", "creds").Data(map[string][]byte{"password": []byte("redacted")}).Result()),
+		"resources/pods/namespaces/foo/bar.json":      toUnstructuredOrFail(t, builder.ForPod("foo", "bar").NodeName("node-1").Result()),
+	})
+}
+
+// TestBackupExcludedItems verifies that the items matching the excluded items filters of a
+// backup are skipped.
+func TestBackupExcludedItems(t *testing.T) {
+	h := newHarness(t, nil)
+	defer h.itemBlockPool.Stop()
+	req := &Request{
+		Backup: defaultBackup().ExcludedItems(
+			velerov1.ItemFieldFilter{Resource: "pods", FieldSelector: "status.phase=Succeeded"},
+			velerov1.ItemFieldFilter{Resource: "deployments", OlderThan: &metav1.Duration{Duration: time.Hour}},
+		).Result(),
+		SkippedPVTracker: NewSkipPVTracker(),
+		BackedUpItems:    NewBackedUpItemsMap(),
+		ItemBlockChannel: h.itemBlockPool.GetInputChannel(),
+	}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	apiResources := []*test.APIResource{
+		test.Pods(
+			builder.ForPod("foo", "running").Phase(corev1.PodRunning).Result(),
+			builder.ForPod("foo", "succeeded").Phase(corev1.PodSucceeded).Result(),
+		),
+		test.Deployments(
+			builder.ForDeployment("foo", "new").ObjectMeta(builder.WithCreationTimestamp(time.Now())).Result(),
+			builder.ForDeployment("foo", "old").ObjectMeta(builder.WithCreationTimestamp(time.Now().Add(-2*time.Hour))).Result(),
+		),
+	}
+	for _, resource := range apiResources {
+		h.addItems(t, resource)
+	}
+
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil, nil))
+
+	assertTarballContents(t, backupFile,
+		"metadata/version",
+		"resources/deployments.apps/namespaces/foo/new.json",
+		"resources/deployments.apps/v1-preferredversion/namespaces/foo/new.json",
+		"resources/pods/namespaces/foo/running.json",
+		"resources/pods/v1-preferredversion/namespaces/foo/running.json",
+	)
+	assert.Equal(t, []velerov1.SkippedItem{
+		{Resource: "deployments.apps", Namespace: "foo", Name: "old", Reason: velerov1.SkipReasonFieldSelector},
+		{Resource: "pods", Namespace: "foo", Name: "succeeded", Reason: velerov1.SkipReasonFieldSelector},
+	}, req.SkippedItems.Items())
+}
+
 // TestBackupOldResourceFiltering runs backups with different combinations
 // of resource filters (included/excluded resources, included/excluded
 // namespaces, label selectors, "include cluster resources" flag), and
@@ -1460,6 +1798,7 @@ func TestBackupItemActionsForSkippedPV(t *testing.T) {
 				fakeClient = test.NewFakeControllerRuntimeClient(t, tc.runtimeResources...)
 			)
 			h.backupper.kbClient = fakeClient
+			h.backupper.clusterClient = fakeClient
 
 			for _, resource := range tc.apiResources {
 				h.addItems(t, resource)
@@ -4316,10 +4655,12 @@ func newHarness(t *testing.T, itemBlockPool *ItemBlockWorkerPool) *harness {
 	if itemBlockPool == nil {
 		itemBlockPool = StartItemBlockWorkerPool(context.Background(), 1, log)
 	}
+	kbClient := test.NewFakeControllerRuntimeClient(t)
 	return &harness{
 		APIServer: apiServer,
 		backupper: &kubernetesBackupper{
-			kbClient:        test.NewFakeControllerRuntimeClient(t),
+			kbClient:        kbClient,
+			clusterClient:   kbClient,
 			dynamicFactory:  client.NewDynamicFactory(apiServer.DynamicClient),
 			discoveryHelper: discoveryHelper,
 
@@ -5701,13 +6042,16 @@ func TestUpdateVolumeInfos(t *testing.T) {
 }
 
 func TestPutVolumeInfos(t *testing.T) {
-	backupName := "backup-01"
+	backup := builder.ForBackup(velerov1.DefaultNamespace, "backup-01").Result()
+	backup.Status.ArtifactDigests = map[string]string{"backup-01.tar.gz": "digest"}
 
 	backupStore := new(persistencemocks.BackupStore)
 
-	backupStore.On("PutBackupVolumeInfos", mock.Anything, mock.Anything).Return(nil)
+	backupStore.On("PutBackupVolumeInfos", "backup-01", mock.Anything).Return(nil)
 
-	require.NoError(t, putVolumeInfos(backupName, []*volume.BackupVolumeInfo{}, backupStore))
+	require.NoError(t, putVolumeInfos(backup, []*volume.BackupVolumeInfo{}, backupStore))
+	assert.Len(t, backup.Status.ArtifactDigests["backup-01-volumeinfo.json.gz"], 64)
+	assert.Equal(t, "digest", backup.Status.ArtifactDigests["backup-01.tar.gz"])
 }
 
 type fakeSingleObjectBackupStoreGetter struct {
@@ -5723,3 +6067,35 @@ func (f *fakeSingleObjectBackupStoreGetter) Get(*velerov1.BackupStorageLocation,
 func NewFakeSingleObjectBackupStoreGetter(store persistence.BackupStore) persistence.ObjectBackupStoreGetter {
 	return &fakeSingleObjectBackupStoreGetter{store: store}
 }
+
+func TestRecordIncompleteItems(t *testing.T) {
+	backupRequest := &Request{
+		Backup: defaultBackup().MaxDuration(time.Hour, "").Result(),
+	}
+	itemBlock := &BackupItemBlock{
+		ItemBlock: itemblock.ItemBlock{
+			Items: []itemblock.ItemBlockItem{
+				{
+					Gr:   kuberesource.Pods,
+					Item: &unstructured.Unstructured{Object: toUnstructuredOrFail(t, builder.ForPod("ns-1", "pod-1").Result())},
+				},
+			},
+		},
+	}
+	remaining := []*kubernetesResource{
+		{groupResource: kuberesource.Pods, namespace: "ns-1", name: "pod-2"},
+		{groupResource: kuberesource.Pods, namespace: "ns-1", name: "pod-2"},
+		{groupResource: kuberesource.Pods, namespace: "ns-1", name: "pod-3", inItemBlockOrExcluded: true},
+		{groupResource: kuberesource.PersistentVolumes, name: "pv-1"},
+	}
+
+	recordIncompleteItems(logrus.StandardLogger(), backupRequest, itemBlock, remaining)
+
+	assert.True(t, backupRequest.Status.MaxDurationExceeded)
+	assert.Equal(t, 3, backupRequest.Status.IncompleteItems)
+	assert.Equal(t, []velerov1.IncompleteItem{
+		{Resource: "pods", Namespace: "ns-1", Name: "pod-1"},
+		{Resource: "pods", Namespace: "ns-1", Name: "pod-2"},
+		{Resource: "persistentvolumes", Name: "pv-1"},
+	}, backupRequest.Status.IncompleteItemList)
+}
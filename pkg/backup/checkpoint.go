@@ -0,0 +1,375 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/volume"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+)
+
+// Checkpoint is the progress of a backup in progress saved to its backup storage location, from
+// which the backup resumes if the server restarts before it completes.
+type Checkpoint struct {
+	// Segments is the number of tarballs of the items backed up before the checkpoint, one per
+	// checkpoint.
+	Segments int `json:"segments"`
+	// Items are the items of the ItemBlocks completed before the checkpoint.
+	Items []CheckpointItem `json:"items"`
+	// VolumeSnapshots are the native snapshots of the persistent volumes of the items.
+	VolumeSnapshots []*volume.Snapshot `json:"volumeSnapshots,omitempty"`
+	// ItemOperations are the async operations started by the backup item actions of the items.
+	ItemOperations []*itemoperation.BackupOperation `json:"itemOperations,omitempty"`
+}
+
+// CheckpointItem is an item backed up before a checkpoint.
+type CheckpointItem struct {
+	// Resource is the API version and kind of the item.
+	Resource      string `json:"resource"`
+	GroupResource string `json:"groupResource"`
+	Namespace     string `json:"namespace,omitempty"`
+	Name          string `json:"name"`
+}
+
+// Checkpointer saves the progress of a backup as checkpoints at most every interval, each one
+// once an ItemBlock completes, and resumes the backup from its last checkpoint. The checkpoints
+// are uploaded in the background, one at a time, so the ItemBlock workers don't wait for them.
+type Checkpointer struct {
+	store    persistence.BackupStore
+	backup   string
+	interval time.Duration
+	log      logrus.FieldLogger
+
+	lock       sync.Mutex
+	checkpoint Checkpoint
+	// pendingFiles and pendingItems are the files and items of the ItemBlocks completed since
+	// the last checkpoint.
+	pendingFiles []FileForArchive
+	pendingItems []CheckpointItem
+	lastSave     time.Time
+	resumed      bool
+	// saving is true while a checkpoint is uploaded.
+	saving  bool
+	uploads sync.WaitGroup
+}
+
+// NewCheckpointer returns a Checkpointer saving the checkpoints of the backup to the store.
+func NewCheckpointer(store persistence.BackupStore, backup string, interval time.Duration, log logrus.FieldLogger) *Checkpointer {
+	return &Checkpointer{
+		store:    store,
+		backup:   backup,
+		interval: interval,
+		log:      log,
+		lastSave: time.Now(),
+	}
+}
+
+// Resume writes the items backed up before the last checkpoint of the backup to the tarball and
+// restores the state of the backup request at that checkpoint, so the items aren't backed up
+// again. It returns the number of items restored, 0 if the backup has no checkpoint.
+func (c *Checkpointer) Resume(backupRequest *Request, tw tarWriter) (int, error) {
+	res, err := c.store.GetBackupCheckpoint(c.backup)
+	if err != nil {
+		return 0, errors.Wrap(err, "error getting the backup checkpoint")
+	}
+	if res == nil {
+		return 0, nil
+	}
+	defer res.Close()
+
+	var checkpoint Checkpoint
+	if err := decodeCheckpoint(res, &checkpoint); err != nil {
+		return 0, err
+	}
+
+	for segment := 0; segment < checkpoint.Segments; segment++ {
+		if err := c.copySegment(segment, tw); err != nil {
+			return 0, err
+		}
+	}
+
+	for _, item := range checkpoint.Items {
+		backupRequest.BackedUpItems.AddItem(itemKey{
+			resource:  item.Resource,
+			namespace: item.Namespace,
+			name:      item.Name,
+		})
+	}
+	backupRequest.VolumeSnapshots = append(backupRequest.VolumeSnapshots, checkpoint.VolumeSnapshots...)
+	itemOperations := backupRequest.GetItemOperationsList()
+	*itemOperations = append(*itemOperations, checkpoint.ItemOperations...)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.checkpoint = checkpoint
+	c.resumed = true
+
+	return len(checkpoint.Items), nil
+}
+
+// copySegment writes the files of a segment of the checkpoint to the tarball.
+func (c *Checkpointer) copySegment(segment int, tw tarWriter) error {
+	res, err := c.store.GetBackupCheckpointContents(c.backup, segment)
+	if err != nil {
+		return errors.Wrapf(err, "error getting segment %d of the backup checkpoint", segment)
+	}
+	defer res.Close()
+
+	gzr, err := gzip.NewReader(res)
+	if err != nil {
+		return errors.Wrapf(err, "error reading segment %d of the backup checkpoint", segment)
+	}
+	defer gzr.Close()
+
+	tw.Lock()
+	defer tw.Unlock()
+
+	tr := tar.NewReader(gzr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "error reading segment %d of the backup checkpoint", segment)
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return errors.WithStack(err)
+		}
+		if _, err := io.Copy(tw, tr); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+}
+
+// ItemBlockCompleted records the items the ItemBlock backed up, and starts saving a checkpoint
+// if the last one is older than the interval and no other one is being saved.
+func (c *Checkpointer) ItemBlockCompleted(itemBlock *BackupItemBlock, backupRequest *Request) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.pendingFiles = append(c.pendingFiles, itemBlock.checkpointFiles...)
+	c.pendingItems = append(c.pendingItems, itemBlock.checkpointItems...)
+
+	if c.saving || len(c.pendingItems) == 0 || time.Since(c.lastSave) < c.interval {
+		return
+	}
+
+	checkpoint := c.nextCheckpoint(backupRequest)
+	files, items := c.pendingFiles, c.pendingItems
+	c.pendingFiles = nil
+	c.pendingItems = nil
+	c.saving = true
+
+	c.uploads.Add(1)
+	go func() {
+		defer c.uploads.Done()
+		c.save(checkpoint, files, items)
+	}()
+}
+
+// nextCheckpoint returns the checkpoint of the items backed up so far, along with the snapshots
+// and operations of the items, which are copied under the lock of the request since the other
+// ItemBlocks keep appending to them.
+func (c *Checkpointer) nextCheckpoint(backupRequest *Request) Checkpoint {
+	checkpoint := Checkpoint{
+		Segments: c.checkpoint.Segments + 1,
+		Items:    make([]CheckpointItem, 0, len(c.checkpoint.Items)+len(c.pendingItems)),
+	}
+	checkpoint.Items = append(checkpoint.Items, c.checkpoint.Items...)
+	checkpoint.Items = append(checkpoint.Items, c.pendingItems...)
+
+	persistentVolumes := make(map[string]bool)
+	resources := make(map[CheckpointItem]bool)
+	for _, item := range checkpoint.Items {
+		if item.GroupResource == kuberesource.PersistentVolumes.String() {
+			persistentVolumes[item.Name] = true
+		}
+		resources[CheckpointItem{GroupResource: item.GroupResource, Namespace: item.Namespace, Name: item.Name}] = true
+	}
+
+	backupRequest.requestLock.Lock()
+	defer backupRequest.requestLock.Unlock()
+
+	for _, snapshot := range backupRequest.VolumeSnapshots {
+		if persistentVolumes[snapshot.Spec.PersistentVolumeName] {
+			checkpoint.VolumeSnapshots = append(checkpoint.VolumeSnapshots, snapshot)
+		}
+	}
+	for _, operation := range *backupRequest.GetItemOperationsList() {
+		identifier := operation.Spec.ResourceIdentifier
+		if resources[CheckpointItem{GroupResource: identifier.GroupResource.String(), Namespace: identifier.Namespace, Name: identifier.Name}] {
+			checkpoint.ItemOperations = append(checkpoint.ItemOperations, operation)
+		}
+	}
+	return checkpoint
+}
+
+// save uploads the checkpoint along with the files of its segment. If it fails, the files and
+// items of the segment are pending again, and are saved with the next checkpoint.
+func (c *Checkpointer) save(checkpoint Checkpoint, files []FileForArchive, items []CheckpointItem) {
+	err := c.upload(checkpoint, files)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.saving = false
+	if err != nil {
+		c.log.WithError(err).Warn("Error saving the backup checkpoint, the backup would resume from the previous one")
+		c.pendingFiles = append(files, c.pendingFiles...)
+		c.pendingItems = append(items, c.pendingItems...)
+		return
+	}
+
+	c.checkpoint = checkpoint
+	c.lastSave = time.Now()
+	c.log.Infof("Saved the backup checkpoint of %d items", len(checkpoint.Items))
+}
+
+func (c *Checkpointer) upload(checkpoint Checkpoint, files []FileForArchive) error {
+	contents, err := checkpointContents(files)
+	if err != nil {
+		return err
+	}
+
+	data := new(bytes.Buffer)
+	if err := encodeCheckpoint(data, &checkpoint); err != nil {
+		return err
+	}
+
+	if err := c.store.PutBackupCheckpoint(c.backup, checkpoint.Segments-1, contents, data); err != nil {
+		return errors.Wrap(err, "error uploading the backup checkpoint")
+	}
+	return nil
+}
+
+// Wait waits for the checkpoint being saved, if any.
+func (c *Checkpointer) Wait() {
+	c.uploads.Wait()
+}
+
+// PodVolumeBackups returns the PodVolumeBackups of the pods backed up before the checkpoint the
+// backup resumed from, nil if it didn't resume.
+func (c *Checkpointer) PodVolumeBackups(ctx context.Context, client kbclient.Client, backup *velerov1api.Backup) ([]*velerov1api.PodVolumeBackup, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if !c.resumed {
+		return nil, nil
+	}
+
+	pods := make(map[string]bool)
+	for _, item := range c.checkpoint.Items {
+		if item.GroupResource == kuberesource.Pods.String() {
+			pods[item.Namespace+"/"+item.Name] = true
+		}
+	}
+
+	pvbList := new(velerov1api.PodVolumeBackupList)
+	if err := client.List(ctx, pvbList, kbclient.InNamespace(backup.Namespace),
+		kbclient.MatchingLabels{velerov1api.BackupNameLabel: label.GetValidName(backup.Name)}); err != nil {
+		return nil, errors.Wrap(err, "error listing PodVolumeBackups")
+	}
+
+	var pvbs []*velerov1api.PodVolumeBackup
+	for i := range pvbList.Items {
+		pvb := &pvbList.Items[i]
+		if pods[pvb.Spec.Pod.Namespace+"/"+pvb.Spec.Pod.Name] {
+			pvbs = append(pvbs, pvb)
+		}
+	}
+	return pvbs, nil
+}
+
+// Delete deletes the checkpoints of the backup, once the checkpoint being saved, if any, is saved.
+func (c *Checkpointer) Delete() {
+	c.Wait()
+	if err := c.store.DeleteBackupCheckpoint(c.backup); err != nil {
+		c.log.WithError(err).Warn("Error deleting the backup checkpoint")
+	}
+}
+
+// checkpointItem returns the checkpoint item of an item backed up as the group resource.
+func checkpointItem(obj runtime.Unstructured, groupResource schema.GroupResource, namespace, name string) CheckpointItem {
+	return CheckpointItem{
+		Resource:      resourceKey(obj),
+		GroupResource: groupResource.String(),
+		Namespace:     namespace,
+		Name:          name,
+	}
+}
+
+// checkpointContents returns the gzipped tarball of the files.
+func checkpointContents(files []FileForArchive) (*bytes.Buffer, error) {
+	contents := new(bytes.Buffer)
+	gzw := gzip.NewWriter(contents)
+	tw := tar.NewWriter(gzw)
+	for _, file := range files {
+		if err := tw.WriteHeader(file.Header); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if _, err := tw.Write(file.FileBytes); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := gzw.Close(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return contents, nil
+}
+
+func encodeCheckpoint(w io.Writer, checkpoint *Checkpoint) error {
+	gzw := gzip.NewWriter(w)
+	if err := json.NewEncoder(gzw).Encode(checkpoint); err != nil {
+		return errors.Wrap(err, "error encoding the backup checkpoint")
+	}
+	return errors.WithStack(gzw.Close())
+}
+
+func decodeCheckpoint(r io.Reader, checkpoint *Checkpoint) error {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return errors.Wrap(err, "error reading the backup checkpoint")
+	}
+	defer gzr.Close()
+
+	if err := json.NewDecoder(gzr).Decode(checkpoint); err != nil {
+		return errors.Wrap(err, "error decoding the backup checkpoint")
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/internal/volume"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestCheckpointerSaveAndResume(t *testing.T) {
+	file := FileForArchive{
+		FilePath:  "resources/persistentvolumes/cluster/pv-1.json",
+		Header:    &tar.Header{Name: "resources/persistentvolumes/cluster/pv-1.json", Size: 2, Typeflag: tar.TypeReg, Mode: 0755},
+		FileBytes: []byte("{}"),
+	}
+	item := CheckpointItem{Resource: "v1/PersistentVolume", GroupResource: kuberesource.PersistentVolumes.String(), Name: "pv-1"}
+
+	request := &Request{
+		BackedUpItems: NewBackedUpItemsMap(),
+		VolumeSnapshots: []*volume.Snapshot{
+			{Spec: volume.SnapshotSpec{PersistentVolumeName: "pv-1"}},
+			{Spec: volume.SnapshotSpec{PersistentVolumeName: "pv-2"}},
+		},
+	}
+	operations := request.GetItemOperationsList()
+	*operations = append(*operations,
+		&itemoperation.BackupOperation{Spec: itemoperation.BackupOperationSpec{OperationID: "op-1", ResourceIdentifier: velero.ResourceIdentifier{GroupResource: kuberesource.PersistentVolumes, Name: "pv-1"}}},
+		&itemoperation.BackupOperation{Spec: itemoperation.BackupOperationSpec{OperationID: "op-2", ResourceIdentifier: velero.ResourceIdentifier{GroupResource: schema.GroupResource{Resource: "pods"}, Namespace: "ns-1", Name: "pod-1"}}},
+	)
+
+	var contents, checkpoint []byte
+	store := new(persistencemocks.BackupStore)
+	store.On("PutBackupCheckpoint", "backup-1", 0, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		contents, _ = io.ReadAll(args.Get(2).(io.Reader))
+		checkpoint, _ = io.ReadAll(args.Get(3).(io.Reader))
+	}).Return(nil)
+
+	checkpointer := NewCheckpointer(store, "backup-1", 0, velerotest.NewLogger())
+	checkpointer.ItemBlockCompleted(&BackupItemBlock{
+		checkpointFiles: []FileForArchive{file},
+		checkpointItems: []CheckpointItem{item},
+	}, request)
+	checkpointer.Wait()
+	store.AssertExpectations(t)
+
+	// only the snapshots and operations of the checkpointed items are saved
+	var saved Checkpoint
+	require.NoError(t, decodeCheckpoint(bytes.NewReader(checkpoint), &saved))
+	assert.Equal(t, 1, saved.Segments)
+	assert.Equal(t, []CheckpointItem{item}, saved.Items)
+	require.Len(t, saved.VolumeSnapshots, 1)
+	assert.Equal(t, "pv-1", saved.VolumeSnapshots[0].Spec.PersistentVolumeName)
+	require.Len(t, saved.ItemOperations, 1)
+	assert.Equal(t, "op-1", saved.ItemOperations[0].Spec.OperationID)
+
+	store.On("GetBackupCheckpoint", "backup-1").Return(io.NopCloser(bytes.NewReader(checkpoint)), nil)
+	store.On("GetBackupCheckpointContents", "backup-1", 0).Return(io.NopCloser(bytes.NewReader(contents)), nil)
+
+	resumedRequest := &Request{BackedUpItems: NewBackedUpItemsMap()}
+	tarball := new(bytes.Buffer)
+	gzw := gzip.NewWriter(tarball)
+	tw := NewTarWriter(tar.NewWriter(gzw))
+
+	resumed, err := NewCheckpointer(store, "backup-1", 0, velerotest.NewLogger()).Resume(resumedRequest, tw)
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+
+	assert.Equal(t, 1, resumed)
+	assert.True(t, resumedRequest.BackedUpItems.Has(itemKey{resource: "v1/PersistentVolume", name: "pv-1"}))
+	assert.Len(t, resumedRequest.VolumeSnapshots, 1)
+	assert.Len(t, *resumedRequest.GetItemOperationsList(), 1)
+
+	gzr, err := gzip.NewReader(tarball)
+	require.NoError(t, err)
+	tr := tar.NewReader(gzr)
+	header, err := tr.Next()
+	require.NoError(t, err)
+	assert.Equal(t, file.FilePath, header.Name)
+	data, err := io.ReadAll(tr)
+	require.NoError(t, err)
+	assert.Equal(t, file.FileBytes, data)
+}
+
+func TestCheckpointerSaveFailure(t *testing.T) {
+	items := []CheckpointItem{
+		{Resource: "v1/ConfigMap", GroupResource: "configmaps", Namespace: "ns-1", Name: "cm-1"},
+		{Resource: "v1/ConfigMap", GroupResource: "configmaps", Namespace: "ns-1", Name: "cm-2"},
+	}
+
+	var checkpoint []byte
+	store := new(persistencemocks.BackupStore)
+	store.On("PutBackupCheckpoint", "backup-1", 0, mock.Anything, mock.Anything).Return(errors.New("upload failed")).Once()
+	store.On("PutBackupCheckpoint", "backup-1", 0, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		checkpoint, _ = io.ReadAll(args.Get(3).(io.Reader))
+	}).Return(nil).Once()
+
+	request := &Request{BackedUpItems: NewBackedUpItemsMap()}
+	checkpointer := NewCheckpointer(store, "backup-1", 0, velerotest.NewLogger())
+	checkpointer.ItemBlockCompleted(&BackupItemBlock{checkpointItems: items[:1]}, request)
+	checkpointer.Wait()
+
+	// the items of the failed checkpoint are saved with the next one, in the same segment
+	checkpointer.ItemBlockCompleted(&BackupItemBlock{checkpointItems: items[1:]}, request)
+	checkpointer.Wait()
+	store.AssertExpectations(t)
+
+	var saved Checkpoint
+	require.NoError(t, decodeCheckpoint(bytes.NewReader(checkpoint), &saved))
+	assert.Equal(t, 1, saved.Segments)
+	assert.Equal(t, items, saved.Items)
+}
+
+func TestCheckpointerResumeWithoutCheckpoint(t *testing.T) {
+	store := new(persistencemocks.BackupStore)
+	store.On("GetBackupCheckpoint", "backup-1").Return(nil, nil)
+
+	request := &Request{BackedUpItems: NewBackedUpItemsMap()}
+	resumed, err := NewCheckpointer(store, "backup-1", 0, velerotest.NewLogger()).Resume(request, NewTarWriter(tar.NewWriter(io.Discard)))
+	require.NoError(t, err)
+	assert.Equal(t, 0, resumed)
+	assert.Equal(t, 0, request.BackedUpItems.Len())
+}
@@ -0,0 +1,151 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+// protectedFields are the fields identifying the items, which the field projections can't remove
+// since the items couldn't be restored without them.
+var protectedFields = []string{"", "apiVersion", "kind", "metadata", "metadata.name", "metadata.namespace"}
+
+// fieldProjection is a field projection of a backup, with its resource resolved and its fields
+// parsed.
+type fieldProjection struct {
+	resources *collections.IncludesExcludes
+	fields    [][]string
+}
+
+// ValidateFieldProjections returns the errors of the field projections of a backup.
+func ValidateFieldProjections(projections []velerov1api.FieldProjection) []error {
+	var errs []error
+	for i, projection := range projections {
+		if projection.Resource == "" {
+			errs = append(errs, errors.Errorf("field projection %d has no resource", i))
+		}
+		if len(projection.ExcludedFields) == 0 {
+			errs = append(errs, errors.Errorf("field projection %d has no excluded fields", i))
+		}
+		for _, field := range projection.ExcludedFields {
+			path, err := parseFieldPath(field)
+			if err != nil {
+				errs = append(errs, errors.Wrapf(err, "field projection %d has an invalid field %q", i, field))
+				continue
+			}
+			if slices.Contains(protectedFields, strings.Join(path, ".")) {
+				errs = append(errs, errors.Errorf("field projection %d can't exclude field %q, which identifies the items", i, field))
+			}
+		}
+	}
+	return errs
+}
+
+// getFieldProjections resolves the field projections of a backup.
+func getFieldProjections(projections []velerov1api.FieldProjection, discoveryHelper discovery.Helper) ([]fieldProjection, error) {
+	resolved := make([]fieldProjection, 0, len(projections))
+	for _, projection := range projections {
+		p := fieldProjection{
+			resources: collections.GetResourceIncludesExcludes(discoveryHelper, []string{projection.Resource}, nil),
+		}
+		for _, field := range projection.ExcludedFields {
+			path, err := parseFieldPath(field)
+			if err != nil {
+				return nil, errors.Wrapf(err, "error parsing field %q", field)
+			}
+			p.fields = append(p.fields, path)
+		}
+		resolved = append(resolved, p)
+	}
+	return resolved, nil
+}
+
+// projectItem returns the content of the item of the group resource without the fields the
+// projections remove. The item itself is left untouched.
+func projectItem(projections []fieldProjection, groupResource string, item map[string]any) map[string]any {
+	var projected map[string]any
+	for _, projection := range projections {
+		if !projection.resources.ShouldInclude(groupResource) {
+			continue
+		}
+		for _, path := range projection.fields {
+			if _, found, _ := unstructured.NestedFieldNoCopy(item, path...); !found {
+				continue
+			}
+			if projected == nil {
+				projected = runtime.DeepCopyJSON(item)
+			}
+			unstructured.RemoveNestedField(projected, path...)
+		}
+	}
+	if projected == nil {
+		return item
+	}
+	return projected
+}
+
+// parseFieldPath parses the JSONPath expression of a field of the items into the keys leading
+// to it. Only the child operators are supported, e.g. {.metadata.labels['app.kubernetes.io/name']}.
+func parseFieldPath(expression string) ([]string, error) {
+	s := strings.TrimSpace(expression)
+	if strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") {
+		s = s[1 : len(s)-1]
+	}
+	s = strings.TrimPrefix(s, "$")
+	if s == "" || s == "." {
+		return []string{}, nil
+	}
+
+	var path []string
+	for s != "" {
+		switch s[0] {
+		case '.':
+			end := strings.IndexAny(s[1:], ".[")
+			if end < 0 {
+				end = len(s) - 1
+			}
+			key := s[1 : end+1]
+			if key == "" || key == "*" {
+				return nil, errors.New("expected the name of a field after '.'")
+			}
+			path = append(path, key)
+			s = s[end+1:]
+		case '[':
+			if len(s) < 2 || (s[1] != '\'' && s[1] != '"') {
+				return nil, errors.New("only quoted field names are supported between brackets")
+			}
+			end := strings.Index(s[2:], string(s[1])+"]")
+			if end < 0 {
+				return nil, errors.New("unterminated brackets")
+			}
+			path = append(path, s[2:end+2])
+			s = s[end+4:]
+		default:
+			return nil, errors.Errorf("unexpected character %q", s[0])
+		}
+	}
+	return path, nil
+}
@@ -0,0 +1,107 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestParseFieldPath(t *testing.T) {
+	tests := []struct {
+		expression string
+		want       []string
+		wantErr    bool
+	}{
+		{expression: ".status", want: []string{"status"}},
+		{expression: "{.metadata.managedFields}", want: []string{"metadata", "managedFields"}},
+		{expression: "$.data", want: []string{"data"}},
+		{
+			expression: ".metadata.annotations['kubectl.kubernetes.io/last-applied-configuration']",
+			want:       []string{"metadata", "annotations", "kubectl.kubernetes.io/last-applied-configuration"},
+		},
+		{expression: `.metadata.labels["app"].foo`, want: []string{"metadata", "labels", "app", "foo"}},
+		{expression: ".", want: []string{}},
+		{expression: "status", wantErr: true},
+		{expression: ".spec..containers", wantErr: true},
+		{expression: ".spec.containers[0]", wantErr: true},
+		{expression: ".spec.*", wantErr: true},
+		{expression: ".metadata.labels['app", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.expression, func(t *testing.T) {
+			got, err := parseFieldPath(tc.expression)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestValidateFieldProjections(t *testing.T) {
+	assert.Empty(t, ValidateFieldProjections([]velerov1api.FieldProjection{
+		{Resource: "*", ExcludedFields: []string{".metadata.managedFields", ".status"}},
+	}))
+	assert.Len(t, ValidateFieldProjections([]velerov1api.FieldProjection{
+		{ExcludedFields: []string{".status"}},
+		{Resource: "secrets"},
+		{Resource: "secrets", ExcludedFields: []string{"data", ".metadata.name", "{.kind}"}},
+	}), 5)
+}
+
+func TestProjectItem(t *testing.T) {
+	projections, err := getFieldProjections([]velerov1api.FieldProjection{
+		{Resource: "*", ExcludedFields: []string{".metadata.managedFields"}},
+		{Resource: "secrets", ExcludedFields: []string{".data", ".metadata.annotations['kubectl.kubernetes.io/last-applied-configuration']"}},
+	}, test.NewFakeDiscoveryHelper(true, nil))
+	require.NoError(t, err)
+
+	secret := map[string]any{
+		"metadata": map[string]any{
+			"name":          "creds",
+			"managedFields": []any{map[string]any{"manager": "kubectl"}},
+			"annotations": map[string]any{
+				"kubectl.kubernetes.io/last-applied-configuration": "{}",
+				"team": "payments",
+			},
+		},
+		"data": map[string]any{"password": "c2VjcmV0"},
+	}
+	assert.Equal(t, map[string]any{
+		"metadata": map[string]any{
+			"name":        "creds",
+			"annotations": map[string]any{"team": "payments"},
+		},
+	}, projectItem(projections, "secrets", secret))
+	// the item itself is left untouched
+	assert.Contains(t, secret, "data")
+
+	configMap := map[string]any{
+		"metadata": map[string]any{"name": "settings"},
+		"data":     map[string]any{"key": "value"},
+	}
+	assert.Equal(t, configMap, projectItem(projections, "configmaps", configMap))
+}
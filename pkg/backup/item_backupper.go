@@ -22,11 +22,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	corev1api "k8s.io/api/core/v1"
+	storagev1api "k8s.io/api/storage/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -54,6 +56,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/podvolume"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	csiutil "github.com/vmware-tanzu/velero/pkg/util/csi"
+	veleroutil "github.com/vmware-tanzu/velero/pkg/util/velero"
 )
 
 const (
@@ -71,12 +74,18 @@ type itemBackupper struct {
 	podVolumeBackupper       podvolume.Backupper
 	podVolumeSnapshotTracker *podvolume.Tracker
 	volumeSnapshotterGetter  VolumeSnapshotterGetter
+	snapshotThrottler        *volume.SnapshotThrottler
+	csiSnapshotLimiter       *volume.CSISnapshotLimiter
 	kubernetesBackupper      *kubernetesBackupper
 
 	itemHookHandler                    hook.ItemHookHandler
 	snapshotLocationVolumeSnapshotters map[string]vsv1.VolumeSnapshotter
 	hookTracker                        *hook.HookTracker
 	volumeHelperImpl                   volumehelper.VolumeHelper
+
+	// queuedVolumeSnapshots is the number of volumes waiting for csiSnapshotLimiter to allow
+	// their CSI snapshot.
+	queuedVolumeSnapshots atomic.Int32
 }
 
 type FileForArchive struct {
@@ -107,6 +116,12 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 			return false, []FileForArchive{}, errors.WithStack(err)
 		}
 	}
+	if itemBlock != nil && ib.backupRequest.Checkpointer != nil {
+		if metadata, err := meta.Accessor(obj); err == nil {
+			itemBlock.checkpointFiles = append(itemBlock.checkpointFiles, files...)
+			itemBlock.checkpointItems = append(itemBlock.checkpointItems, checkpointItem(obj, groupResource, metadata.GetNamespace(), metadata.GetName()))
+		}
+	}
 	return true, []FileForArchive{}, nil
 }
 
@@ -117,6 +132,7 @@ func (ib *itemBackupper) itemInclusionChecks(log logrus.FieldLogger, mustInclude
 		if metadata.GetLabels()[velerov1api.ExcludeFromBackupLabel] == "true" {
 			log.Infof("Excluding item because it has label %s=true", velerov1api.ExcludeFromBackupLabel)
 			ib.trackSkippedPV(obj, groupResource, "", fmt.Sprintf("item has label %s=true", velerov1api.ExcludeFromBackupLabel), log)
+			ib.backupRequest.SkippedItems.Track(groupResource, metadata.GetNamespace(), metadata.GetName(), velerov1api.SkipReasonExcludeLabel)
 			return false
 		}
 		// NOTE: we have to re-check namespace & resource includes/excludes because it's possible that
@@ -124,6 +140,7 @@ func (ib *itemBackupper) itemInclusionChecks(log logrus.FieldLogger, mustInclude
 		namespace := metadata.GetNamespace()
 		if namespace != "" && !ib.backupRequest.NamespaceIncludesExcludes.ShouldInclude(namespace) {
 			log.Info("Excluding item because namespace is excluded")
+			ib.backupRequest.SkippedItems.Track(groupResource, namespace, metadata.GetName(), velerov1api.SkipReasonNamespaceExcluded)
 			return false
 		}
 
@@ -134,6 +151,7 @@ func (ib *itemBackupper) itemInclusionChecks(log logrus.FieldLogger, mustInclude
 		if namespace == "" && groupResource != kuberesource.Namespaces &&
 			ib.backupRequest.ResourceIncludesExcludes.ShouldExclude(groupResource.String()) {
 			log.Info("Excluding item because resource is cluster-scoped and is excluded by cluster filter.")
+			ib.backupRequest.SkippedItems.Track(groupResource, namespace, metadata.GetName(), velerov1api.SkipReasonResourceExcluded)
 			return false
 		}
 
@@ -141,12 +159,14 @@ func (ib *itemBackupper) itemInclusionChecks(log logrus.FieldLogger, mustInclude
 		// are not specified in included list.
 		if namespace != "" && !ib.backupRequest.ResourceIncludesExcludes.ShouldInclude(groupResource.String()) {
 			log.Info("Excluding item because resource is excluded")
+			ib.backupRequest.SkippedItems.Track(groupResource, namespace, metadata.GetName(), velerov1api.SkipReasonResourceExcluded)
 			return false
 		}
 	}
 
 	if metadata.GetDeletionTimestamp() != nil {
 		log.Info("Skipping item because it's being deleted.")
+		ib.backupRequest.SkippedItems.Track(groupResource, metadata.GetNamespace(), metadata.GetName(), velerov1api.SkipReasonBeingDeleted)
 		return false
 	}
 	return true
@@ -239,11 +259,20 @@ func (ib *itemBackupper) backupItemInternal(logger logrus.FieldLogger, obj runti
 	// the group version of the object.
 	versionPath := resourceVersion(obj)
 
-	updatedObj, additionalItemFiles, err := ib.executeActions(log, obj, groupResource, name, namespace, metadata, finalize, itemBlock)
+	updatedObj, additionalItemFiles, excluded, err := ib.executeActions(log, obj, groupResource, name, namespace, metadata, mustInclude, finalize, itemBlock)
 	if err != nil {
 		backupErrs = append(backupErrs, err)
 		return false, itemFiles, kubeerrs.NewAggregate(backupErrs)
 	}
+	if excluded {
+		ib.backupRequest.BackedUpItems.RemoveItem(key)
+		for _, volume := range pvbVolumes {
+			ib.podVolumeSnapshotTracker.Untrack(pod, volume)
+		}
+		ib.trackSkippedPV(obj, groupResource, "", fmt.Sprintf("a backup item action set label %s=true", velerov1api.ExcludeFromBackupLabel), log)
+		ib.backupRequest.SkippedItems.Track(groupResource, key.namespace, key.name, velerov1api.SkipReasonPlugin)
+		return false, itemFiles, nil
+	}
 
 	itemFiles = append(itemFiles, additionalItemFiles...)
 	obj = updatedObj
@@ -254,6 +283,8 @@ func (ib *itemBackupper) backupItemInternal(logger logrus.FieldLogger, obj runti
 	name = metadata.GetName()
 	namespace = metadata.GetNamespace()
 
+	ib.backupRequest.SkippedItems.Untrack(groupResource, key.namespace, key.name)
+
 	if groupResource == kuberesource.PersistentVolumes {
 		if err := ib.addVolumeInfo(obj, log); err != nil {
 			backupErrs = append(backupErrs, err)
@@ -300,7 +331,17 @@ func (ib *itemBackupper) backupItemInternal(logger logrus.FieldLogger, obj runti
 		return false, itemFiles, kubeerrs.NewAggregate(backupErrs)
 	}
 
-	itemBytes, err := json.Marshal(obj.UnstructuredContent())
+	content := obj.UnstructuredContent()
+	if ib.backupRequest.ResourceModifiers != nil {
+		// the item is modified once the actions are done with it, right before it's written
+		modified := (&unstructured.Unstructured{Object: content}).DeepCopy()
+		if errs := ib.backupRequest.ResourceModifiers.ApplyResourceModifierRules(modified, groupResource.String(), ib.kbClient.Scheme(), nil, log); len(errs) > 0 {
+			return false, itemFiles, errors.Wrap(kubeerrs.NewAggregate(errs), "error applying resource modifiers")
+		}
+		content = modified.Object
+	}
+
+	itemBytes, err := json.Marshal(projectItem(ib.backupRequest.fieldProjections, groupResource.String(), content))
 	if err != nil {
 		return false, itemFiles, errors.WithStack(err)
 	}
@@ -349,10 +390,13 @@ func (ib *itemBackupper) executeActions(
 	groupResource schema.GroupResource,
 	name, namespace string,
 	metadata metav1.Object,
-	finalize bool,
+	mustInclude, finalize bool,
 	itemBlock *BackupItemBlock,
-) (runtime.Unstructured, []FileForArchive, error) {
+) (runtime.Unstructured, []FileForArchive, bool, error) {
 	var itemFiles []FileForArchive
+	// an action may exclude the item by labeling it, until an action started an operation or
+	// returned additional items for it
+	started := false
 	for _, action := range ib.backupRequest.ResolvedActions {
 		if !action.ShouldUse(groupResource, namespace, metadata, log) {
 			continue
@@ -360,7 +404,7 @@ func (ib *itemBackupper) executeActions(
 		log.Info("Executing custom action")
 		actionName := action.Name()
 		if act, err := ib.getMatchAction(obj, groupResource, actionName); err != nil {
-			return nil, itemFiles, errors.WithStack(err)
+			return nil, itemFiles, false, errors.WithStack(err)
 		} else if act != nil && act.Type == resourcepolicies.Skip {
 			log.Infof("Skip executing Backup Item Action: %s of resource %s: %s/%s for the matched resource policies", actionName, groupResource, namespace, name)
 			ib.trackSkippedPV(obj, groupResource, "", "skipped due to resource policy ", log)
@@ -378,7 +422,7 @@ func (ib *itemBackupper) executeActions(
 			actionName == csiBIAPluginName {
 			snapshotVolume, err := ib.volumeHelperImpl.ShouldPerformSnapshot(obj, kuberesource.PersistentVolumeClaims)
 			if err != nil {
-				return nil, itemFiles, errors.WithStack(err)
+				return nil, itemFiles, false, errors.WithStack(err)
 			}
 
 			if !snapshotVolume {
@@ -391,11 +435,19 @@ func (ib *itemBackupper) executeActions(
 				)
 				continue
 			}
+
+			// the snapshot is created once the VolumeSnapshot returned as additional item is
+			// backed up, or once the action returns when the snapshot data is moved
+			release, err := ib.acquireCSISnapshot(log, obj)
+			if err != nil {
+				return nil, itemFiles, false, err
+			}
+			defer release()
 		}
 
 		updatedItem, additionalItemIdentifiers, operationID, postOperationItems, err := action.Execute(obj, ib.backupRequest.Backup)
 		if err != nil {
-			return nil, itemFiles, errors.Wrapf(err, "error executing custom action (groupResource=%s, namespace=%s, name=%s)", groupResource.String(), namespace, name)
+			return nil, itemFiles, false, errors.Wrapf(err, "error executing custom action (groupResource=%s, namespace=%s, name=%s)", groupResource.String(), namespace, name)
 		}
 
 		u := &unstructured.Unstructured{Object: updatedItem.UnstructuredContent()}
@@ -412,7 +464,17 @@ func (ib *itemBackupper) executeActions(
 			}
 		}
 
-		mustInclude := u.GetAnnotations()[velerov1api.MustIncludeAdditionalItemAnnotation] == "true" || finalize
+		if !mustInclude && !finalize && u.GetLabels()[velerov1api.ExcludeFromBackupLabel] == "true" &&
+			(&unstructured.Unstructured{Object: obj.UnstructuredContent()}).GetLabels()[velerov1api.ExcludeFromBackupLabel] != "true" {
+			if !started && operationID == "" && len(additionalItemIdentifiers) == 0 {
+				log.Infof("Excluding item because action %s set label %s=true", actionName, velerov1api.ExcludeFromBackupLabel)
+				return nil, itemFiles, true, nil
+			}
+			log.Warnf("Ignoring label %s=true set by action %s, because the backup of the item has already started", velerov1api.ExcludeFromBackupLabel, actionName)
+		}
+		started = started || operationID != "" || len(additionalItemIdentifiers) > 0
+
+		mustIncludeAdditionalItems := u.GetAnnotations()[velerov1api.MustIncludeAdditionalItemAnnotation] == "true" || finalize
 		// remove the annotation as it's for communication between BIA and velero server,
 		// we don't want the resource be restored with this annotation.
 		delete(u.GetAnnotations(), velerov1api.MustIncludeAdditionalItemAnnotation)
@@ -422,7 +484,7 @@ func (ib *itemBackupper) executeActions(
 		// ignore during finalize phase
 		if operationID != "" {
 			if finalize {
-				return nil, itemFiles, fmt.Errorf("backup Item Action created operation during finalize (groupResource=%s, namespace=%s, name=%s)", groupResource.String(), namespace, name)
+				return nil, itemFiles, false, fmt.Errorf("backup Item Action created operation during finalize (groupResource=%s, namespace=%s, name=%s)", groupResource.String(), namespace, name)
 			}
 			resourceIdentifier := velero.ResourceIdentifier{
 				GroupResource: groupResource,
@@ -444,8 +506,10 @@ func (ib *itemBackupper) executeActions(
 				},
 			}
 			newOperation.Spec.PostOperationItems = postOperationItems
+			ib.backupRequest.requestLock.Lock()
 			itemOperList := ib.backupRequest.GetItemOperationsList()
 			*itemOperList = append(*itemOperList, &newOperation)
+			ib.backupRequest.requestLock.Unlock()
 		}
 
 		for _, additionalItem := range additionalItemIdentifiers {
@@ -462,12 +526,12 @@ func (ib *itemBackupper) executeActions(
 
 				gvr, resource, err := ib.discoveryHelper.ResourceFor(additionalItem.GroupResource.WithVersion(""))
 				if err != nil {
-					return nil, itemFiles, err
+					return nil, itemFiles, false, err
 				}
 
 				client, err := ib.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, additionalItem.Namespace)
 				if err != nil {
-					return nil, itemFiles, err
+					return nil, itemFiles, false, err
 				}
 
 				item, err := client.Get(additionalItem.Name, metav1.GetOptions{})
@@ -481,7 +545,7 @@ func (ib *itemBackupper) executeActions(
 					continue
 				}
 				if err != nil {
-					return nil, itemFiles, errors.WithStack(err)
+					return nil, itemFiles, false, errors.WithStack(err)
 				}
 				itemList = append(itemList, itemblock.ItemBlockItem{
 					Gr:           additionalItem.GroupResource,
@@ -491,15 +555,51 @@ func (ib *itemBackupper) executeActions(
 			}
 
 			for _, item := range itemList {
-				_, additionalItemFiles, err := ib.backupItem(log, item.Item, additionalItem.GroupResource, item.PreferredGVR, mustInclude, finalize, itemBlock)
+				_, additionalItemFiles, err := ib.backupItem(log, item.Item, additionalItem.GroupResource, item.PreferredGVR, mustIncludeAdditionalItems, finalize, itemBlock)
 				if err != nil {
-					return nil, itemFiles, err
+					return nil, itemFiles, false, err
 				}
 				itemFiles = append(itemFiles, additionalItemFiles...)
 			}
 		}
 	}
-	return obj, itemFiles, nil
+	return obj, itemFiles, false, nil
+}
+
+// acquireCSISnapshot waits until csiSnapshotLimiter allows the CSI snapshot of the PVC, and returns
+// the function releasing it.
+func (ib *itemBackupper) acquireCSISnapshot(log logrus.FieldLogger, obj runtime.Unstructured) (func(), error) {
+	noop := func() {}
+	if ib.csiSnapshotLimiter == nil {
+		return noop, nil
+	}
+
+	pvc := new(corev1api.PersistentVolumeClaim)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pvc); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName == "" {
+		return noop, nil
+	}
+
+	storageClass := new(storagev1api.StorageClass)
+	if err := ib.kbClient.Get(context.Background(), kbClient.ObjectKey{Name: *pvc.Spec.StorageClassName}, storageClass); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, errors.Wrapf(err, "error getting storage class %s", *pvc.Spec.StorageClassName)
+		}
+		// the CSI plugin reports the missing storage class
+		return noop, nil
+	}
+	if !ib.csiSnapshotLimiter.Limited(storageClass.Name, storageClass.Provisioner) {
+		return noop, nil
+	}
+
+	return ib.csiSnapshotLimiter.Acquire(context.Background(), storageClass.Name, storageClass.Provisioner, func(delta int) {
+		if delta > 0 {
+			log.Infof("Too many CSI snapshots of storage class %s or driver %s are being created, waiting", storageClass.Name, storageClass.Provisioner)
+		}
+		ib.queuedVolumeSnapshots.Add(int32(delta))
+	})
 }
 
 // volumeSnapshotter instantiates and initializes a VolumeSnapshotter given a VolumeSnapshotLocation,
@@ -517,6 +617,7 @@ func (ib *itemBackupper) volumeSnapshotter(snapshotLocation *velerov1api.VolumeS
 	if err := bs.Init(snapshotLocation.Spec.Config); err != nil {
 		return nil, err
 	}
+	bs = ib.snapshotThrottler.Wrap(ib.backupRequest.ctx(), snapshotLocation, bs)
 
 	if ib.snapshotLocationVolumeSnapshotters == nil {
 		ib.snapshotLocationVolumeSnapshotters = make(map[string]vsv1.VolumeSnapshotter)
@@ -571,6 +672,11 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 			"not satisfy the criteria for VolumePolicy or the legacy snapshot way",
 			log,
 		)
+		if skipped, err := ib.skippedByPolicy(pv); err != nil {
+			log.WithError(err).Warnf("Error getting matched resource policies for pv %s", pv.Name)
+		} else if skipped {
+			ib.backupRequest.SkippedItems.Track(kuberesource.PersistentVolumes, "", pv.Name, velerov1api.SkipReasonPolicy)
+		}
 		return nil
 	}
 
@@ -611,6 +717,7 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 			log.Infof("skip snapshot of pv %s for the matched resource policies", pv.Name)
 			// at this point we are sure this object is PV therefore we'll call the tracker directly
 			ib.backupRequest.SkippedPVTracker.Track(pv.Name, volumeSnapshotApproach, "matched action is 'skip' in chosen resource policies")
+			ib.backupRequest.SkippedItems.Track(kuberesource.PersistentVolumes, "", pv.Name, velerov1api.SkipReasonPolicy)
 			return nil
 		}
 	}
@@ -671,12 +778,14 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 
 	log = log.WithField("volumeID", volumeID)
 
-	// create tags from the backup's labels
+	// create tags from the backup's labels and tags
 	tags := map[string]string{}
 	for k, v := range ib.backupRequest.GetLabels() {
 		tags[k] = v
 	}
-	tags["velero.io/backup"] = ib.backupRequest.Name
+	for k, v := range Tags(ib.backupRequest.Backup) {
+		tags[k] = v
+	}
 	tags["velero.io/pv"] = pv.Name
 
 	log.Info("Getting volume information")
@@ -691,7 +800,12 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 	var errs []error
 	log.Info("Untrack the PV %s from the skipped volumes, because it's backed by Velero native snapshot.", pv.Name)
 	ib.backupRequest.SkippedPVTracker.Untrack(pv.Name)
-	snapshotID, err := volumeSnapshotter.CreateSnapshot(snapshot.Spec.ProviderVolumeID, snapshot.Spec.VolumeAZ, tags)
+	var snapshotID string
+	err = veleroutil.RetryPhase(log, "snapshot", veleroutil.PhaseRetries(ib.backupRequest.Backup).Snapshot, veleroutil.IsTransientPluginError, func() error {
+		var err error
+		snapshotID, err = volumeSnapshotter.CreateSnapshot(snapshot.Spec.ProviderVolumeID, snapshot.Spec.VolumeAZ, tags)
+		return err
+	})
 	if err != nil {
 		errs = append(errs, errors.Wrap(err, "error taking snapshot of volume"))
 		snapshot.Status.Phase = volume.SnapshotPhaseFailed
@@ -699,7 +813,9 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 		snapshot.Status.Phase = volume.SnapshotPhaseCompleted
 		snapshot.Status.ProviderSnapshotID = snapshotID
 	}
+	ib.backupRequest.requestLock.Lock()
 	ib.backupRequest.VolumeSnapshots = append(ib.backupRequest.VolumeSnapshots, snapshot)
+	ib.backupRequest.requestLock.Unlock()
 
 	// nil errors are automatically removed
 	return kubeerrs.NewAggregate(errs)
@@ -728,6 +844,29 @@ func (ib *itemBackupper) getMatchAction(obj runtime.Unstructured, groupResource
 	return nil, nil
 }
 
+// skippedByPolicy returns true if a volume policy with the skip action matches the PV and its claim.
+func (ib *itemBackupper) skippedByPolicy(pv *corev1api.PersistentVolume) (bool, error) {
+	if ib.backupRequest.ResPolicies == nil {
+		return false, nil
+	}
+
+	var pvc *corev1api.PersistentVolumeClaim
+	if pv.Spec.ClaimRef != nil {
+		pvc = new(corev1api.PersistentVolumeClaim)
+		if err := ib.kbClient.Get(context.Background(), kbClient.ObjectKey{
+			Namespace: pv.Spec.ClaimRef.Namespace,
+			Name:      pv.Spec.ClaimRef.Name},
+			pvc); err != nil {
+			return false, errors.WithStack(err)
+		}
+	}
+	action, err := ib.backupRequest.ResPolicies.GetMatchAction(resourcepolicies.NewVolumeFilterData(pv, nil, pvc))
+	if err != nil {
+		return false, err
+	}
+	return action != nil && action.Type == resourcepolicies.Skip, nil
+}
+
 // trackSkippedPV tracks the skipped PV based on the object and the given approach and reason
 // this function will be called throughout the process of backup, it needs to handle any object
 func (ib *itemBackupper) trackSkippedPV(obj runtime.Unstructured, groupResource schema.GroupResource, approach string, reason string, log logrus.FieldLogger) {
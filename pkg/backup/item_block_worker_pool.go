@@ -28,7 +28,11 @@ type ItemBlockWorkerPool struct {
 	inputChannel chan ItemBlockInput
 	wg           *sync.WaitGroup
 	logger       logrus.FieldLogger
+	ctx          context.Context
 	cancelFunc   context.CancelFunc
+	// lock guards workers, the cancel funcs of the running workers.
+	lock    *sync.Mutex
+	workers []context.CancelFunc
 }
 
 type ItemBlockInput struct {
@@ -53,21 +57,44 @@ func StartItemBlockWorkerPool(ctx context.Context, workers int, log logrus.Field
 	ctx, cancelFunc := context.WithCancel(ctx)
 	wg := &sync.WaitGroup{}
 
-	for i := 0; i < workers; i++ {
-		logger := log.WithField("worker", i)
-		wg.Add(1)
-		go processItemBlockWorker(ctx, inputChannel, logger, wg)
-	}
-
 	pool := &ItemBlockWorkerPool{
 		inputChannel: inputChannel,
+		ctx:          ctx,
 		cancelFunc:   cancelFunc,
 		logger:       log,
 		wg:           wg,
+		lock:         &sync.Mutex{},
 	}
+	pool.Resize(workers)
 	return pool
 }
 
+// Resize starts or stops workers so that the given number of them run. The stopped workers
+// finish processing their current ItemBlock first, so the backups in progress carry on.
+func (p *ItemBlockWorkerPool) Resize(workers int) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for i := len(p.workers); i < workers; i++ {
+		ctx, cancelFunc := context.WithCancel(p.ctx)
+		p.workers = append(p.workers, cancelFunc)
+		logger := p.logger.WithField("worker", i)
+		p.wg.Add(1)
+		go processItemBlockWorker(ctx, p.inputChannel, logger, p.wg)
+	}
+	for len(p.workers) > max(workers, 0) {
+		p.workers[len(p.workers)-1]()
+		p.workers = p.workers[:len(p.workers)-1]
+	}
+}
+
+// Size returns the number of running workers.
+func (p *ItemBlockWorkerPool) Size() int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return len(p.workers)
+}
+
 func (p *ItemBlockWorkerPool) Stop() {
 	p.cancelFunc()
 	p.logger.Info("ItemBlock worker stopping")
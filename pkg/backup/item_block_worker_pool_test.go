@@ -0,0 +1,39 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResizeItemBlockWorkerPool(t *testing.T) {
+	pool := StartItemBlockWorkerPool(context.Background(), 2, logrus.StandardLogger())
+	assert.Equal(t, 2, pool.Size())
+
+	pool.Resize(5)
+	assert.Equal(t, 5, pool.Size())
+
+	pool.Resize(1)
+	assert.Equal(t, 1, pool.Size())
+
+	// Stop waits for the stopped workers as well as the running ones
+	pool.Stop()
+}
@@ -34,11 +34,14 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/tools/pager"
 
+	"github.com/vmware-tanzu/velero/internal/throttle"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	veleroutil "github.com/vmware-tanzu/velero/pkg/util/velero"
 )
 
 // itemCollector collects items from the Kubernetes API according to
@@ -52,6 +55,7 @@ type itemCollector struct {
 	dir                   string
 	pageSize              int
 	nsTracker             nsTracker
+	loadThrottler         *throttle.Throttler
 }
 
 // nsTracker is used to integrate several namespace filters together.
@@ -200,6 +204,16 @@ func (r *itemCollector) getItemsFromResourceIdentifiers(
 func (r *itemCollector) getAllItems() []*kubernetesResource {
 	resources := r.getItems(nil)
 
+	for _, resource := range resources {
+		if resource.groupResource == kuberesource.Namespaces && !r.nsTracker.isTracked(resource.name) {
+			reason := velerov1api.SkipReasonNamespaceExcluded
+			if r.nsTracker.namespaceFilter != nil && r.nsTracker.namespaceFilter.ShouldInclude(resource.name) {
+				reason = velerov1api.SkipReasonLabelSelector
+			}
+			r.backupRequest.SkippedItems.Track(resource.groupResource, "", resource.name, reason)
+		}
+	}
+
 	return r.nsTracker.filterNamespaces(resources)
 }
 
@@ -418,6 +432,7 @@ func (r *itemCollector) getResourceItems(
 
 	if !r.backupRequest.ResourceIncludesExcludes.ShouldInclude(gr.String()) {
 		log.Infof("Skipping resource because it's excluded")
+		r.backupRequest.SkippedItems.Track(gr, "", "", velerov1api.SkipReasonResourceExcluded)
 		return nil, nil
 	}
 
@@ -471,6 +486,12 @@ func (r *itemCollector) getResourceItems(
 		for i := range unstructuredItems {
 			item := &unstructuredItems[i]
 
+			if excludedByFieldFilters(r.backupRequest.itemFieldFilters, gr.String(), item) {
+				log.WithFields(logrus.Fields{"namespace": item.GetNamespace(), "name": item.GetName()}).Info("Skipping item because it matches an excluded items filter")
+				r.backupRequest.SkippedItems.Track(gr, item.GetNamespace(), item.GetName(), velerov1api.SkipReasonFieldSelector)
+				continue
+			}
+
 			path, err := r.writeToFile(item)
 			if err != nil {
 				log.WithError(err).Error("Error writing item to file")
@@ -516,53 +537,70 @@ func (r *itemCollector) listResourceByLabelsPerNamespace(
 		return nil, err
 	}
 
-	var orLabelSelectors []string
-	if r.backupRequest.Spec.OrLabelSelectors != nil {
-		for _, s := range r.backupRequest.Spec.OrLabelSelectors {
-			orLabelSelectors = append(orLabelSelectors, metav1.FormatLabelSelector(s))
-		}
-	} else {
-		orLabelSelectors = []string{}
+	selectors, err := r.labelSelectors()
+	if err != nil {
+		logger.WithError(err).Error("Error listing items")
+		return nil, err
 	}
 
-	logger.Info("Listing items")
-	unstructuredItems := make([]unstructured.Unstructured, 0)
-
-	// Listing items for orLabelSelectors
-	errListingForNS := false
-	for _, label := range orLabelSelectors {
-		unstructuredItems, err = r.listItemsForLabel(unstructuredItems, gr, label, resourceClient)
-		if err != nil {
-			errListingForNS = true
-		}
+	fieldSelector := fieldSelectorFor(r.backupRequest.listFieldSelectors, gr.String())
+	if fieldSelector != "" {
+		logger = logger.WithField("fieldSelector", fieldSelector)
 	}
 
-	if errListingForNS {
+	logger.Info("Listing items")
+	// the items are listed without the label selectors, which are matched here so the items
+	// not matching them are recorded as skipped
+	unstructuredItems, err := r.listItemsForLabel(make([]unstructured.Unstructured, 0), gr, "", fieldSelector, resourceClient)
+	if err != nil {
 		logger.WithError(err).Error("Error listing items")
 		return nil, err
 	}
 
-	var labelSelector string
-	if selector := r.backupRequest.Spec.LabelSelector; selector != nil {
-		labelSelector = metav1.FormatLabelSelector(selector)
+	if len(selectors) > 0 {
+		matchingItems := make([]unstructured.Unstructured, 0, len(unstructuredItems))
+		for _, item := range unstructuredItems {
+			if matchesAnySelector(selectors, item.GetLabels()) {
+				matchingItems = append(matchingItems, item)
+				continue
+			}
+			r.backupRequest.SkippedItems.Track(gr, item.GetNamespace(), item.GetName(), velerov1api.SkipReasonLabelSelector)
+		}
+		unstructuredItems = matchingItems
 	}
 
-	// Listing items for labelSelector (singular)
-	if len(orLabelSelectors) == 0 {
-		unstructuredItems, err = r.listItemsForLabel(
-			unstructuredItems,
-			gr,
-			labelSelector,
-			resourceClient,
-		)
+	logger.Infof("Retrieved %d items", len(unstructuredItems))
+	return unstructuredItems, nil
+}
+
+// labelSelectors returns the label selectors of the backup, the items matching any of which are
+// backed up. The OrLabelSelectors take precedence over the LabelSelector.
+func (r *itemCollector) labelSelectors() ([]labels.Selector, error) {
+	var labelSelectors []*metav1.LabelSelector
+	if len(r.backupRequest.Spec.OrLabelSelectors) > 0 {
+		labelSelectors = r.backupRequest.Spec.OrLabelSelectors
+	} else if r.backupRequest.Spec.LabelSelector != nil {
+		labelSelectors = []*metav1.LabelSelector{r.backupRequest.Spec.LabelSelector}
+	}
+
+	selectors := make([]labels.Selector, 0, len(labelSelectors))
+	for _, labelSelector := range labelSelectors {
+		selector, err := metav1.LabelSelectorAsSelector(labelSelector)
 		if err != nil {
-			logger.WithError(err).Error("Error listing items")
-			return nil, err
+			return nil, errors.Wrapf(err, "error parsing label selector %s", metav1.FormatLabelSelector(labelSelector))
 		}
+		selectors = append(selectors, selector)
 	}
+	return selectors, nil
+}
 
-	logger.Infof("Retrieved %d items", len(unstructuredItems))
-	return unstructuredItems, nil
+func matchesAnySelector(selectors []labels.Selector, itemLabels map[string]string) bool {
+	for _, selector := range selectors {
+		if selector.Matches(labels.Set(itemLabels)) {
+			return true
+		}
+	}
+	return false
 }
 
 func (r *itemCollector) writeToFile(item *unstructured.Unstructured) (string, error) {
@@ -664,18 +702,28 @@ func newCohabitatingResource(resource, group1, group2 string) *cohabitatingResou
 func (r *itemCollector) processPagerClientCalls(
 	gr schema.GroupResource,
 	label string,
+	fieldSelector string,
 	resourceClient client.Dynamic,
 ) (runtime.Object, error) {
 	// If limit is positive, use a pager to split list over multiple requests
 	// Use Velero's dynamic list function instead of the default
 	listPager := pager.New(pager.SimplePageFunc(func(opts metav1.ListOptions) (runtime.Object, error) {
+		if err := r.loadThrottler.Wait(context.Background()); err != nil {
+			return nil, err
+		}
 		return resourceClient.List(opts)
 	}))
 	// Use the page size defined in the server config
 	// TODO allow configuration of page buffer size
 	listPager.PageSize = int64(r.pageSize)
 	// Add each item to temporary slice
-	list, paginated, err := listPager.List(context.Background(), metav1.ListOptions{LabelSelector: label})
+	var list runtime.Object
+	var paginated bool
+	err := r.retryItemCollection(func() error {
+		var err error
+		list, paginated, err = listPager.List(context.Background(), metav1.ListOptions{LabelSelector: label, FieldSelector: fieldSelector})
+		return err
+	})
 
 	if err != nil {
 		r.log.WithError(errors.WithStack(err)).Error("Error listing resources")
@@ -693,11 +741,12 @@ func (r *itemCollector) listItemsForLabel(
 	unstructuredItems []unstructured.Unstructured,
 	gr schema.GroupResource,
 	label string,
+	fieldSelector string,
 	resourceClient client.Dynamic,
 ) ([]unstructured.Unstructured, error) {
 	if r.pageSize > 0 {
 		// process pager client calls
-		list, err := r.processPagerClientCalls(gr, label, resourceClient)
+		list, err := r.processPagerClientCalls(gr, label, fieldSelector, resourceClient)
 		if err != nil {
 			return unstructuredItems, err
 		}
@@ -717,7 +766,15 @@ func (r *itemCollector) listItemsForLabel(
 			return unstructuredItems, err
 		}
 	} else {
-		unstructuredList, err := resourceClient.List(metav1.ListOptions{LabelSelector: label})
+		var unstructuredList *unstructured.UnstructuredList
+		err := r.retryItemCollection(func() error {
+			if err := r.loadThrottler.Wait(context.Background()); err != nil {
+				return err
+			}
+			var err error
+			unstructuredList, err = resourceClient.List(metav1.ListOptions{LabelSelector: label, FieldSelector: fieldSelector})
+			return err
+		})
 		if err != nil {
 			r.log.WithError(errors.WithStack(err)).Error("Error listing items")
 			return unstructuredItems, err
@@ -727,6 +784,16 @@ func (r *itemCollector) listItemsForLabel(
 	return unstructuredItems, nil
 }
 
+// retryItemCollection lists items with list, re-listing them after the transient errors of the API
+// server as many times as the backup allows.
+func (r *itemCollector) retryItemCollection(list func() error) error {
+	var retries int
+	if r.backupRequest != nil {
+		retries = veleroutil.PhaseRetries(r.backupRequest.Backup).ItemCollection
+	}
+	return veleroutil.RetryPhase(r.log, "item collection", retries, veleroutil.IsTransientAPIError, list)
+}
+
 // collectNamespaces process namespace resource according to namespace filters.
 func (r *itemCollector) collectNamespaces(
 	resource metav1.APIResource,
@@ -741,7 +808,15 @@ func (r *itemCollector) collectNamespaces(
 		return nil, errors.WithStack(err)
 	}
 
-	unstructuredList, err := resourceClient.List(metav1.ListOptions{})
+	var unstructuredList *unstructured.UnstructuredList
+	err = r.retryItemCollection(func() error {
+		if err := r.loadThrottler.Wait(context.Background()); err != nil {
+			return err
+		}
+		var err error
+		unstructuredList, err = resourceClient.List(metav1.ListOptions{})
+		return err
+	})
 	if err != nil {
 		log.WithError(errors.WithStack(err)).Error("error list namespaces")
 		return nil, errors.WithStack(err)
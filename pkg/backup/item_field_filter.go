@@ -0,0 +1,121 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+// itemFieldFilter is an excluded items filter of a backup, with its resource resolved and its
+// field selector parsed.
+type itemFieldFilter struct {
+	resources *collections.IncludesExcludes
+	// selector is nil when the filter has no field selector.
+	selector fields.Selector
+	// createdBefore is zero when the filter has no age.
+	createdBefore time.Time
+}
+
+// ValidateItemFieldFilters returns the errors of the excluded items filters of a backup.
+func ValidateItemFieldFilters(filters []velerov1api.ItemFieldFilter) []error {
+	var errs []error
+	for i, filter := range filters {
+		if filter.Resource == "" {
+			errs = append(errs, errors.Errorf("excluded items filter %d has no resource", i))
+		}
+		if filter.FieldSelector == "" && filter.OlderThan == nil {
+			errs = append(errs, errors.Errorf("excluded items filter %d has neither a field selector nor an age", i))
+		}
+		if filter.FieldSelector != "" {
+			if _, err := fields.ParseSelector(filter.FieldSelector); err != nil {
+				errs = append(errs, errors.Wrapf(err, "excluded items filter %d has an invalid field selector", i))
+			}
+		}
+		if filter.OlderThan != nil && filter.OlderThan.Duration < 0 {
+			errs = append(errs, errors.Errorf("excluded items filter %d has a negative age", i))
+		}
+	}
+	return errs
+}
+
+// getItemFieldFilters resolves the excluded items filters of a backup, the ages of the items
+// being measured from now.
+func getItemFieldFilters(filters []velerov1api.ItemFieldFilter, discoveryHelper discovery.Helper, now time.Time) ([]itemFieldFilter, error) {
+	resolved := make([]itemFieldFilter, 0, len(filters))
+	for _, filter := range filters {
+		f := itemFieldFilter{
+			resources: collections.GetResourceIncludesExcludes(discoveryHelper, []string{filter.Resource}, nil),
+		}
+		if filter.FieldSelector != "" {
+			selector, err := fields.ParseSelector(filter.FieldSelector)
+			if err != nil {
+				return nil, errors.Wrapf(err, "error parsing field selector %q", filter.FieldSelector)
+			}
+			f.selector = selector
+		}
+		if filter.OlderThan != nil {
+			f.createdBefore = now.Add(-filter.OlderThan.Duration)
+		}
+		resolved = append(resolved, f)
+	}
+	return resolved, nil
+}
+
+// matches returns whether the item of the group resource is selected by the filter.
+func (f itemFieldFilter) matches(groupResource string, item *unstructured.Unstructured) bool {
+	if !f.resources.ShouldInclude(groupResource) {
+		return false
+	}
+	if !f.createdBefore.IsZero() && !item.GetCreationTimestamp().Time.Before(f.createdBefore) {
+		return false
+	}
+	return f.selector == nil || f.selector.Matches(itemFields(item, f.selector))
+}
+
+// itemFields returns the values of the fields of the item the selector requires, formatted as
+// strings. The fields the item doesn't have are left out.
+func itemFields(item *unstructured.Unstructured, selector fields.Selector) fields.Set {
+	set := fields.Set{}
+	for _, requirement := range selector.Requirements() {
+		value, found, err := unstructured.NestedFieldNoCopy(item.Object, strings.Split(requirement.Field, ".")...)
+		if err != nil || !found || value == nil {
+			continue
+		}
+		set[requirement.Field] = fmt.Sprint(value)
+	}
+	return set
+}
+
+// excludedByFieldFilters returns whether an excluded items filter of the backup selects the item.
+func excludedByFieldFilters(filters []itemFieldFilter, groupResource string, item *unstructured.Unstructured) bool {
+	for _, filter := range filters {
+		if filter.matches(groupResource, item) {
+			return true
+		}
+	}
+	return false
+}
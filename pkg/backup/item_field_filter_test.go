@@ -0,0 +1,117 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+func TestValidateItemFieldFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters []velerov1api.ItemFieldFilter
+		wantErr int
+	}{
+		{
+			name: "valid filters",
+			filters: []velerov1api.ItemFieldFilter{
+				{Resource: "pods", FieldSelector: "status.phase=Succeeded"},
+				{Resource: "events", OlderThan: &metav1.Duration{Duration: time.Hour}},
+			},
+		},
+		{
+			name:    "filter without a resource",
+			filters: []velerov1api.ItemFieldFilter{{FieldSelector: "status.phase=Succeeded"}},
+			wantErr: 1,
+		},
+		{
+			name:    "filter without a field selector or an age",
+			filters: []velerov1api.ItemFieldFilter{{Resource: "pods"}},
+			wantErr: 1,
+		},
+		{
+			name:    "invalid field selector",
+			filters: []velerov1api.ItemFieldFilter{{Resource: "pods", FieldSelector: "status.phase"}},
+			wantErr: 1,
+		},
+		{
+			name:    "negative age",
+			filters: []velerov1api.ItemFieldFilter{{Resource: "events", OlderThan: &metav1.Duration{Duration: -time.Hour}}},
+			wantErr: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Len(t, ValidateItemFieldFilters(tc.filters), tc.wantErr)
+		})
+	}
+}
+
+func TestItemFieldFilterMatches(t *testing.T) {
+	now := time.Now()
+	discoveryHelper := test.NewFakeDiscoveryHelper(true, nil)
+	filters, err := getItemFieldFilters([]velerov1api.ItemFieldFilter{
+		{Resource: "pods", FieldSelector: "status.phase=Succeeded", OlderThan: &metav1.Duration{Duration: time.Hour}},
+	}, discoveryHelper, now)
+	require.NoError(t, err)
+	require.Len(t, filters, 1)
+
+	toUnstructured := func(obj runtime.Object) *unstructured.Unstructured {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		require.NoError(t, err)
+		return &unstructured.Unstructured{Object: content}
+	}
+	old := builder.WithCreationTimestamp(now.Add(-2 * time.Hour))
+
+	assert.True(t, filters[0].matches("pods", toUnstructured(builder.ForPod("ns", "done").ObjectMeta(old).Phase("Succeeded").Result())))
+	assert.False(t, filters[0].matches("pods", toUnstructured(builder.ForPod("ns", "running").ObjectMeta(old).Phase("Running").Result())))
+	assert.False(t, filters[0].matches("pods", toUnstructured(builder.ForPod("ns", "new").ObjectMeta(builder.WithCreationTimestamp(now)).Phase("Succeeded").Result())))
+	assert.False(t, filters[0].matches("deployments.apps", toUnstructured(builder.ForDeployment("ns", "old").ObjectMeta(old).Result())))
+
+	// the filters without a field selector match on the age alone
+	filter := itemFieldFilter{
+		resources:     collections.NewIncludesExcludes().Includes("*"),
+		createdBefore: now,
+	}
+	assert.True(t, excludedByFieldFilters([]itemFieldFilter{filter}, "events", toUnstructured(builder.ForPod("ns", "old").ObjectMeta(old).Result())))
+}
+
+func TestItemFields(t *testing.T) {
+	item := &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "done"},
+		"spec":     map[string]any{"replicas": int64(2)},
+		"status":   map[string]any{"phase": "Succeeded"},
+	}}
+	selector := fields.ParseSelectorOrDie("status.phase=Succeeded,spec.replicas=2,spec.paused!=true")
+
+	assert.Equal(t, fields.Set{"status.phase": "Succeeded", "spec.replicas": "2"}, itemFields(item, selector))
+	assert.True(t, selector.Matches(itemFields(item, selector)))
+}
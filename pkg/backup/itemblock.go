@@ -32,6 +32,10 @@ type BackupItemBlock struct {
 	itemblock.ItemBlock
 	// This is a reference to the  shared itemBackupper for the backup
 	itemBackupper *itemBackupper
+	// checkpointFiles and checkpointItems are the files written and the items backed up by the
+	// ItemBlock, recorded when the backup saves checkpoints.
+	checkpointFiles []FileForArchive
+	checkpointItems []CheckpointItem
 }
 
 func NewBackupItemBlock(log logrus.FieldLogger, itemBackupper *itemBackupper) *BackupItemBlock {
@@ -0,0 +1,78 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/fields"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+// listFieldSelector is a list field selector of a backup, with its resource resolved.
+type listFieldSelector struct {
+	resources *collections.IncludesExcludes
+	selector  string
+}
+
+// ValidateListFieldSelectors returns the errors of the list field selectors of a backup.
+func ValidateListFieldSelectors(selectors []velerov1api.ResourceFieldSelector) []error {
+	var errs []error
+	for i, selector := range selectors {
+		if selector.Resource == "" {
+			errs = append(errs, errors.Errorf("list field selector %d has no resource", i))
+		}
+		if selector.FieldSelector == "" {
+			errs = append(errs, errors.Errorf("list field selector %d has no field selector", i))
+		} else if _, err := fields.ParseSelector(selector.FieldSelector); err != nil {
+			errs = append(errs, errors.Wrapf(err, "list field selector %d is invalid", i))
+		}
+	}
+	return errs
+}
+
+// getListFieldSelectors resolves the resources of the list field selectors of a backup.
+func getListFieldSelectors(selectors []velerov1api.ResourceFieldSelector, discoveryHelper discovery.Helper) ([]listFieldSelector, error) {
+	resolved := make([]listFieldSelector, 0, len(selectors))
+	for _, selector := range selectors {
+		parsed, err := fields.ParseSelector(selector.FieldSelector)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing field selector %q", selector.FieldSelector)
+		}
+		resolved = append(resolved, listFieldSelector{
+			resources: collections.GetResourceIncludesExcludes(discoveryHelper, []string{selector.Resource}, nil),
+			selector:  parsed.String(),
+		})
+	}
+	return resolved, nil
+}
+
+// fieldSelectorFor returns the field selector the items of the group resource are listed with, the
+// list field selectors of the resource being combined. It's empty when there's none.
+func fieldSelectorFor(selectors []listFieldSelector, groupResource string) string {
+	var matching []string
+	for _, selector := range selectors {
+		if selector.resources.ShouldInclude(groupResource) {
+			matching = append(matching, selector.selector)
+		}
+	}
+	return strings.Join(matching, ",")
+}
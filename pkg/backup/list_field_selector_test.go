@@ -0,0 +1,94 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestValidateListFieldSelectors(t *testing.T) {
+	tests := []struct {
+		name      string
+		selectors []velerov1api.ResourceFieldSelector
+		wantErr   int
+	}{
+		{
+			name: "valid selectors",
+			selectors: []velerov1api.ResourceFieldSelector{
+				{Resource: "secrets", FieldSelector: "type=kubernetes.io/tls"},
+				{Resource: "pods", FieldSelector: "status.phase!=Succeeded,status.phase!=Failed"},
+			},
+		},
+		{
+			name:      "selector without a resource",
+			selectors: []velerov1api.ResourceFieldSelector{{FieldSelector: "type=kubernetes.io/tls"}},
+			wantErr:   1,
+		},
+		{
+			name:      "selector without a field selector",
+			selectors: []velerov1api.ResourceFieldSelector{{Resource: "secrets"}},
+			wantErr:   1,
+		},
+		{
+			name:      "invalid field selector",
+			selectors: []velerov1api.ResourceFieldSelector{{Resource: "secrets", FieldSelector: "type"}},
+			wantErr:   1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Len(t, ValidateListFieldSelectors(tc.selectors), tc.wantErr)
+		})
+	}
+}
+
+func TestFieldSelectorFor(t *testing.T) {
+	discoveryHelper := test.NewFakeDiscoveryHelper(true, nil)
+	selectors, err := getListFieldSelectors([]velerov1api.ResourceFieldSelector{
+		{Resource: "secrets", FieldSelector: "type=kubernetes.io/tls"},
+		{Resource: "pods", FieldSelector: "status.phase!=Succeeded"},
+		{Resource: "pods", FieldSelector: "spec.nodeName=node-1"},
+	}, discoveryHelper)
+	require.NoError(t, err)
+
+	assert.Equal(t, "type=kubernetes.io/tls", fieldSelectorFor(selectors, "secrets"))
+	assert.Equal(t, "status.phase!=Succeeded,spec.nodeName=node-1", fieldSelectorFor(selectors, "pods"))
+	assert.Empty(t, fieldSelectorFor(selectors, "configmaps"))
+}
+
+func TestListItemsWithFieldSelector(t *testing.T) {
+	for _, pageSize := range []int{0, 10} {
+		resourceClient := &test.FakeDynamicClient{}
+		resourceClient.On("List", metav1.ListOptions{FieldSelector: "type=kubernetes.io/tls", Limit: int64(pageSize)}).
+			Return(&unstructured.UnstructuredList{}, nil)
+
+		r := &itemCollector{log: test.NewLogger(), pageSize: pageSize}
+		_, err := r.listItemsForLabel(nil, schema.GroupResource{Resource: "secrets"}, "", "type=kubernetes.io/tls", resourceClient)
+		require.NoError(t, err)
+		resourceClient.AssertExpectations(t)
+	}
+}
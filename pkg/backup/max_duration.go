@@ -0,0 +1,55 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"time"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// MaxDurationExceeded returns whether the backup has been running longer than its MaxDuration at now.
+func MaxDurationExceeded(backup *velerov1api.Backup, now time.Time) bool {
+	if backup.Spec.MaxDuration.Duration <= 0 || backup.Status.StartTimestamp == nil {
+		return false
+	}
+	return now.After(backup.Status.StartTimestamp.Add(backup.Spec.MaxDuration.Duration))
+}
+
+// CancelOnMaxDuration returns whether the backup is to be ended as Failed rather than
+// PartiallyFailed once it exceeded its MaxDuration.
+func CancelOnMaxDuration(backup *velerov1api.Backup) bool {
+	return backup.Status.MaxDurationExceeded && backup.Spec.MaxDurationAction == velerov1api.MaxDurationActionCancel
+}
+
+// MaxIncompleteItems is the most incomplete items listed in the status of a backup, so that the
+// status doesn't outgrow the size limit of the API server. All of them are counted regardless.
+const MaxIncompleteItems = 100
+
+// AddIncompleteItem counts the item which wasn't backed up, or whose asynchronous operation was
+// canceled, because the backup exceeded its MaxDuration, and lists it in the status of the backup
+// until MaxIncompleteItems are listed.
+func AddIncompleteItem(status *velerov1api.BackupStatus, groupResource, namespace, name string) {
+	status.IncompleteItems++
+	if len(status.IncompleteItemList) < MaxIncompleteItems {
+		status.IncompleteItemList = append(status.IncompleteItemList, velerov1api.IncompleteItem{
+			Resource:  groupResource,
+			Namespace: namespace,
+			Name:      name,
+		})
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestMaxDurationExceeded(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		backup *velerov1api.Backup
+		now    time.Time
+		want   bool
+	}{
+		{
+			name:   "no max duration",
+			backup: builder.ForBackup("velero", "backup").StartTimestamp(start).Result(),
+			now:    start.Add(24 * time.Hour),
+			want:   false,
+		},
+		{
+			name:   "not started",
+			backup: builder.ForBackup("velero", "backup").MaxDuration(time.Hour, "").Result(),
+			now:    start.Add(24 * time.Hour),
+			want:   false,
+		},
+		{
+			name:   "within max duration",
+			backup: builder.ForBackup("velero", "backup").MaxDuration(time.Hour, "").StartTimestamp(start).Result(),
+			now:    start.Add(30 * time.Minute),
+			want:   false,
+		},
+		{
+			name:   "max duration exceeded",
+			backup: builder.ForBackup("velero", "backup").MaxDuration(time.Hour, "").StartTimestamp(start).Result(),
+			now:    start.Add(90 * time.Minute),
+			want:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, MaxDurationExceeded(test.backup, test.now))
+		})
+	}
+}
+
+func TestCancelOnMaxDuration(t *testing.T) {
+	backup := builder.ForBackup("velero", "backup").MaxDuration(time.Hour, velerov1api.MaxDurationActionCancel).Result()
+	assert.False(t, CancelOnMaxDuration(backup))
+
+	backup.Status.MaxDurationExceeded = true
+	assert.True(t, CancelOnMaxDuration(backup))
+
+	backup.Spec.MaxDurationAction = velerov1api.MaxDurationActionPartiallyFail
+	assert.False(t, CancelOnMaxDuration(backup))
+}
+
+func TestAddIncompleteItem(t *testing.T) {
+	status := &velerov1api.BackupStatus{}
+	for i := 0; i < MaxIncompleteItems+2; i++ {
+		AddIncompleteItem(status, "pods", "ns-1", fmt.Sprintf("pod-%d", i))
+	}
+
+	assert.Equal(t, MaxIncompleteItems+2, status.IncompleteItems)
+	require.Len(t, status.IncompleteItemList, MaxIncompleteItems)
+	assert.Equal(t, velerov1api.IncompleteItem{Resource: "pods", Namespace: "ns-1", Name: "pod-0"}, status.IncompleteItemList[0])
+}
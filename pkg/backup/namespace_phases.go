@@ -0,0 +1,84 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"sort"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+// namespacePhased returns whether the namespaces of the backup are backed up as independent phases.
+func namespacePhased(backup *velerov1api.Backup) bool {
+	return boolptr.IsSetToTrue(backup.Spec.NamespacePhased)
+}
+
+// namespacePhase returns the namespace whose phase item belongs to, empty for the
+// cluster-scoped items other than the namespaces themselves.
+func namespacePhase(item *kubernetesResource) string {
+	if item.groupResource == kuberesource.Namespaces {
+		return item.name
+	}
+	return item.namespace
+}
+
+// sortItemsByNamespacePhase sorts items so the cluster-scoped items come first, followed by
+// the items of each namespace in turn. The order of the items of a phase is preserved.
+func sortItemsByNamespacePhase(items []*kubernetesResource) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return namespacePhase(items[i]) < namespacePhase(items[j])
+	})
+}
+
+// NamespaceResults returns the result of the backup of each namespace with backed up items,
+// errors or warnings, sorted by namespace.
+func NamespaceResults(backedUpItems *backedUpItemsMap, errs, warnings results.Result) []velerov1api.NamespaceResult {
+	byNamespace := map[string]*velerov1api.NamespaceResult{}
+	result := func(namespace string) *velerov1api.NamespaceResult {
+		if _, ok := byNamespace[namespace]; !ok {
+			byNamespace[namespace] = &velerov1api.NamespaceResult{
+				Namespace: namespace,
+				Phase:     velerov1api.NamespaceResultPhaseCompleted,
+			}
+		}
+		return byNamespace[namespace]
+	}
+
+	for namespace, count := range backedUpItems.NamespaceLens() {
+		result(namespace).ItemsBackedUp = count
+	}
+	for namespace, messages := range errs.Namespaces {
+		result(namespace).Errors = len(messages)
+		result(namespace).Phase = velerov1api.NamespaceResultPhaseFailed
+	}
+	for namespace, messages := range warnings.Namespaces {
+		result(namespace).Warnings = len(messages)
+	}
+
+	namespaceResults := make([]velerov1api.NamespaceResult, 0, len(byNamespace))
+	for _, r := range byNamespace {
+		namespaceResults = append(namespaceResults, *r)
+	}
+	sort.Slice(namespaceResults, func(i, j int) bool {
+		return namespaceResults[i].Namespace < namespaceResults[j].Namespace
+	})
+
+	return namespaceResults
+}
@@ -0,0 +1,75 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+func TestSortItemsByNamespacePhase(t *testing.T) {
+	items := []*kubernetesResource{
+		{groupResource: kuberesource.Pods, namespace: "ns-2", name: "pod-1"},
+		{groupResource: kuberesource.Pods, namespace: "ns-1", name: "pod-2"},
+		{groupResource: kuberesource.Pods, namespace: "ns-1", name: "pod-1"},
+		{groupResource: kuberesource.PersistentVolumes, name: "pv-1"},
+		{groupResource: kuberesource.Namespaces, name: "ns-2"},
+		{groupResource: kuberesource.Namespaces, name: "ns-1"},
+	}
+
+	sortItemsByNamespacePhase(items)
+
+	var sorted []string
+	for _, item := range items {
+		sorted = append(sorted, item.groupResource.String()+"/"+item.namespace+"/"+item.name)
+	}
+	assert.Equal(t, []string{
+		"persistentvolumes//pv-1",
+		"pods/ns-1/pod-2",
+		"pods/ns-1/pod-1",
+		"namespaces//ns-1",
+		"pods/ns-2/pod-1",
+		"namespaces//ns-2",
+	}, sorted)
+}
+
+func TestNamespaceResults(t *testing.T) {
+	backedUpItems := NewBackedUpItemsMap()
+	backedUpItems.AddItem(itemKey{resource: "v1/Pod", namespace: "ns-1", name: "pod-1"})
+	backedUpItems.AddItem(itemKey{resource: "v1/Pod", namespace: "ns-1", name: "pod-2"})
+	backedUpItems.AddItem(itemKey{resource: "v1/Pod", namespace: "ns-2", name: "pod-1"})
+	backedUpItems.AddItem(itemKey{resource: "v1/PersistentVolume", name: "pv-1"})
+
+	errs := results.Result{
+		Cluster:    []string{"cluster error"},
+		Namespaces: map[string][]string{"ns-2": {"error 1", "error 2"}, "ns-3": {"error"}},
+	}
+	warnings := results.Result{
+		Namespaces: map[string][]string{"ns-1": {"warning"}},
+	}
+
+	assert.Equal(t, []velerov1api.NamespaceResult{
+		{Namespace: "ns-1", Phase: velerov1api.NamespaceResultPhaseCompleted, ItemsBackedUp: 2, Warnings: 1},
+		{Namespace: "ns-2", Phase: velerov1api.NamespaceResultPhaseFailed, ItemsBackedUp: 1, Errors: 2},
+		{Namespace: "ns-3", Phase: velerov1api.NamespaceResultPhaseFailed, Errors: 1},
+	}, NamespaceResults(backedUpItems, errs, warnings))
+}
@@ -17,11 +17,16 @@ limitations under the License.
 package backup
 
 import (
+	"context"
+	"sync"
+
 	"github.com/vmware-tanzu/velero/internal/hook"
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	"github.com/vmware-tanzu/velero/internal/volume"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/itemquarantine"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 )
@@ -38,6 +43,7 @@ type Request struct {
 	*velerov1api.Backup
 
 	StorageLocation           *velerov1api.BackupStorageLocation
+	MirrorStorageLocation     *velerov1api.BackupStorageLocation
 	SnapshotLocations         []*velerov1api.VolumeSnapshotLocation
 	NamespaceIncludesExcludes *collections.IncludesExcludes
 	ResourceIncludesExcludes  collections.IncludesExcludesInterface
@@ -49,9 +55,35 @@ type Request struct {
 	BackedUpItems             *backedUpItemsMap
 	itemOperationsList        *[]*itemoperation.BackupOperation
 	ResPolicies               *resourcepolicies.Policies
+	ResourceModifiers         *resourcemodifiers.ResourceModifiers
 	SkippedPVTracker          *skipPVTracker
+	SkippedItems              *skippedItemsTracker
 	VolumesInformation        volume.BackupVolumesInformation
 	ItemBlockChannel          chan ItemBlockInput
+	ItemQuarantine            *itemquarantine.Tracker
+	// itemFieldFilters are the resolved excluded items filters of the backup.
+	itemFieldFilters []itemFieldFilter
+	// listFieldSelectors are the resolved list field selectors of the backup.
+	listFieldSelectors []listFieldSelector
+	// fieldProjections are the resolved field projections of the backup.
+	fieldProjections []fieldProjection
+	// Context is the context of the reconcile running the backup, the calls waiting for the
+	// backup to be allowed to proceed give up once it's done.
+	Context context.Context
+	// Checkpointer saves the progress of the backup to resume it from, nil if the backup
+	// doesn't save checkpoints.
+	Checkpointer *Checkpointer
+	// requestLock guards VolumeSnapshots and the item operations list, which the ItemBlocks
+	// backed up concurrently append to.
+	requestLock sync.Mutex
+}
+
+// ctx returns the Context of the request, or a context which is never done if it's not set.
+func (r *Request) ctx() context.Context {
+	if r.Context == nil {
+		return context.Background()
+	}
+	return r.Context
 }
 
 // BackupVolumesInformation contains the information needs by generating
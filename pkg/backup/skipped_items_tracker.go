@@ -0,0 +1,133 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+type skippedItemKey struct {
+	resource  string
+	namespace string
+	name      string
+}
+
+// skippedItemsTracker keeps track of the items which are not included in the backup and
+// the reason why they are skipped. A nil tracker tracks nothing.
+type skippedItemsTracker struct {
+	*sync.RWMutex
+	// items is a map of the skipped items to the reason why they are skipped, the first
+	// reason an item is skipped for being kept.
+	items map[skippedItemKey]velerov1api.SkipReason
+	// includedItems is a set of the items backed up, which are not tracked anymore.
+	includedItems map[skippedItemKey]struct{}
+}
+
+func NewSkippedItemsTracker() *skippedItemsTracker {
+	return &skippedItemsTracker{
+		RWMutex:       &sync.RWMutex{},
+		items:         make(map[skippedItemKey]velerov1api.SkipReason),
+		includedItems: make(map[skippedItemKey]struct{}),
+	}
+}
+
+// Track tracks the item skipped for the reason. A resource skipped as a whole is tracked
+// with an empty name.
+func (t *skippedItemsTracker) Track(groupResource schema.GroupResource, namespace, name string, reason velerov1api.SkipReason) {
+	if t == nil {
+		return
+	}
+	t.Lock()
+	defer t.Unlock()
+
+	key := skippedItemKey{resource: groupResource.String(), namespace: namespace, name: name}
+	// the data of a persistent volume skipped by a volume policy is tracked though the
+	// persistent volume is backed up
+	if _, ok := t.includedItems[key]; ok && reason != velerov1api.SkipReasonPolicy {
+		return
+	}
+	if _, ok := t.items[key]; !ok {
+		t.items[key] = reason
+	}
+}
+
+// Untrack removes the item, which is backed up, and keeps it from being tracked again.
+func (t *skippedItemsTracker) Untrack(groupResource schema.GroupResource, namespace, name string) {
+	if t == nil {
+		return
+	}
+	t.Lock()
+	defer t.Unlock()
+
+	key := skippedItemKey{resource: groupResource.String(), namespace: namespace, name: name}
+	t.includedItems[key] = struct{}{}
+	delete(t.items, key)
+}
+
+// Summary returns the numbers of the skipped items by reason, sorted by reason.
+func (t *skippedItemsTracker) Summary() []velerov1api.SkipReasonCount {
+	if t == nil {
+		return nil
+	}
+	t.RLock()
+	defer t.RUnlock()
+
+	counts := map[velerov1api.SkipReason]int{}
+	for _, reason := range t.items {
+		counts[reason]++
+	}
+	res := make([]velerov1api.SkipReasonCount, 0, len(counts))
+	for reason, count := range counts {
+		res = append(res, velerov1api.SkipReasonCount{Reason: reason, Count: count})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Reason < res[j].Reason })
+	return res
+}
+
+// Items returns the skipped items sorted by resource, namespace and name.
+func (t *skippedItemsTracker) Items() []velerov1api.SkippedItem {
+	if t == nil {
+		return nil
+	}
+	t.RLock()
+	defer t.RUnlock()
+
+	res := make([]velerov1api.SkippedItem, 0, len(t.items))
+	for key, reason := range t.items {
+		res = append(res, velerov1api.SkippedItem{
+			Resource:  key.resource,
+			Namespace: key.namespace,
+			Name:      key.name,
+			Reason:    reason,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Resource != res[j].Resource {
+			return res[i].Resource < res[j].Resource
+		}
+		if res[i].Namespace != res[j].Namespace {
+			return res[i].Namespace < res[j].Namespace
+		}
+		return res[i].Name < res[j].Name
+	})
+	return res
+}
@@ -0,0 +1,65 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+func TestSkippedItemsTracker(t *testing.T) {
+	tracker := NewSkippedItemsTracker()
+	tracker.Track(kuberesource.Pods, "ns-1", "pod-2", velerov1api.SkipReasonExcludeLabel)
+	tracker.Track(kuberesource.Pods, "ns-1", "pod-1", velerov1api.SkipReasonBeingDeleted)
+	// the first reason is kept
+	tracker.Track(kuberesource.Pods, "ns-1", "pod-1", velerov1api.SkipReasonNamespaceExcluded)
+	tracker.Track(kuberesource.Namespaces, "", "ns-2", velerov1api.SkipReasonExcludeLabel)
+	tracker.Track(kuberesource.Secrets, "", "", velerov1api.SkipReasonResourceExcluded)
+	// backed up items aren't tracked anymore
+	tracker.Track(kuberesource.Pods, "ns-1", "pod-3", velerov1api.SkipReasonExcludeLabel)
+	tracker.Untrack(kuberesource.Pods, "ns-1", "pod-3")
+	tracker.Track(kuberesource.Pods, "ns-1", "pod-3", velerov1api.SkipReasonFieldSelector)
+	// but the data of the backed up persistent volumes skipped by a volume policy is
+	tracker.Untrack(kuberesource.PersistentVolumes, "", "pv-1")
+	tracker.Track(kuberesource.PersistentVolumes, "", "pv-1", velerov1api.SkipReasonPolicy)
+
+	assert.Equal(t, []velerov1api.SkippedItem{
+		{Resource: "namespaces", Name: "ns-2", Reason: velerov1api.SkipReasonExcludeLabel},
+		{Resource: "persistentvolumes", Name: "pv-1", Reason: velerov1api.SkipReasonPolicy},
+		{Resource: "pods", Namespace: "ns-1", Name: "pod-1", Reason: velerov1api.SkipReasonBeingDeleted},
+		{Resource: "pods", Namespace: "ns-1", Name: "pod-2", Reason: velerov1api.SkipReasonExcludeLabel},
+		{Resource: "secrets", Reason: velerov1api.SkipReasonResourceExcluded},
+	}, tracker.Items())
+	assert.Equal(t, []velerov1api.SkipReasonCount{
+		{Reason: velerov1api.SkipReasonBeingDeleted, Count: 1},
+		{Reason: velerov1api.SkipReasonExcludeLabel, Count: 2},
+		{Reason: velerov1api.SkipReasonPolicy, Count: 1},
+		{Reason: velerov1api.SkipReasonResourceExcluded, Count: 1},
+	}, tracker.Summary())
+}
+
+func TestNilSkippedItemsTracker(t *testing.T) {
+	var tracker *skippedItemsTracker
+	tracker.Track(kuberesource.Pods, "ns-1", "pod-1", velerov1api.SkipReasonExcludeLabel)
+	tracker.Untrack(kuberesource.Pods, "ns-1", "pod-1")
+	assert.Nil(t, tracker.Items())
+	assert.Nil(t, tracker.Summary())
+}
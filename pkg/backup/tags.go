@@ -0,0 +1,40 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+const (
+	backupTagKey   = "velero.io/backup"
+	scheduleTagKey = "velero.io/schedule"
+)
+
+// Tags returns the tags set on the provider snapshots and the storage objects of the backup:
+// the tags of its spec along with the names of the backup and of its schedule.
+func Tags(backup *velerov1api.Backup) map[string]string {
+	tags := make(map[string]string, len(backup.Spec.Tags)+2)
+	for k, v := range backup.Spec.Tags {
+		tags[k] = v
+	}
+	tags[backupTagKey] = backup.Name
+	if schedule := backup.Labels[velerov1api.ScheduleNameLabel]; schedule != "" {
+		tags[scheduleTagKey] = schedule
+	}
+	return tags
+}
@@ -0,0 +1,58 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestTags(t *testing.T) {
+	tests := []struct {
+		name   string
+		backup *velerov1api.Backup
+		want   map[string]string
+	}{
+		{
+			name:   "backup without tags",
+			backup: builder.ForBackup("velero", "backup-1").Result(),
+			want:   map[string]string{"velero.io/backup": "backup-1"},
+		},
+		{
+			name: "scheduled backup with tags",
+			backup: builder.ForBackup("velero", "daily-20240101").
+				ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "daily")).
+				Tags(map[string]string{"cost-center": "1234", "velero.io/backup": "overridden"}).
+				Result(),
+			want: map[string]string{
+				"cost-center":        "1234",
+				"velero.io/backup":   "daily-20240101",
+				"velero.io/schedule": "daily",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, Tags(test.backup))
+		})
+	}
+}
@@ -198,6 +198,24 @@ func (b *BackupBuilder) OrLabelSelector(orSelectors []*metav1.LabelSelector) *Ba
 	return b
 }
 
+// ExcludedItems appends to the Backup's excluded items filters.
+func (b *BackupBuilder) ExcludedItems(filters ...velerov1api.ItemFieldFilter) *BackupBuilder {
+	b.object.Spec.ExcludedItems = append(b.object.Spec.ExcludedItems, filters...)
+	return b
+}
+
+// ListFieldSelectors appends to the Backup's list field selectors.
+func (b *BackupBuilder) ListFieldSelectors(selectors ...velerov1api.ResourceFieldSelector) *BackupBuilder {
+	b.object.Spec.ListFieldSelectors = append(b.object.Spec.ListFieldSelectors, selectors...)
+	return b
+}
+
+// FieldProjections appends to the Backup's field projections.
+func (b *BackupBuilder) FieldProjections(projections ...velerov1api.FieldProjection) *BackupBuilder {
+	b.object.Spec.FieldProjections = append(b.object.Spec.FieldProjections, projections...)
+	return b
+}
+
 // SnapshotVolumes sets the Backup's "snapshot volumes" flag.
 func (b *BackupBuilder) SnapshotVolumes(val bool) *BackupBuilder {
 	b.object.Spec.SnapshotVolumes = &val
@@ -246,6 +264,12 @@ func (b *BackupBuilder) Expiration(val time.Time) *BackupBuilder {
 	return b
 }
 
+// ImmutableUntil sets when the objects of the Backup can be deleted.
+func (b *BackupBuilder) ImmutableUntil(val time.Time) *BackupBuilder {
+	b.object.Status.ImmutableUntil = &metav1.Time{Time: val}
+	return b
+}
+
 // StartTimestamp sets the Backup's start timestamp.
 func (b *BackupBuilder) StartTimestamp(val time.Time) *BackupBuilder {
 	b.object.Status.StartTimestamp = &metav1.Time{Time: val}
@@ -282,24 +306,108 @@ func (b *BackupBuilder) ItemOperationTimeout(timeout time.Duration) *BackupBuild
 	return b
 }
 
+// MaxDuration sets the Backup's MaxDuration and MaxDurationAction.
+func (b *BackupBuilder) MaxDuration(maxDuration time.Duration, action velerov1api.MaxDurationAction) *BackupBuilder {
+	b.object.Spec.MaxDuration.Duration = maxDuration
+	b.object.Spec.MaxDurationAction = action
+	return b
+}
+
+// ErrorBudget sets the Backup's ErrorBudget.
+func (b *BackupBuilder) ErrorBudget(budget int) *BackupBuilder {
+	b.object.Spec.ErrorBudget = &budget
+	return b
+}
+
+// MirrorStorageLocation sets the Backup's MirrorStorageLocation and MirrorFailurePolicy.
+func (b *BackupBuilder) MirrorStorageLocation(location string, policy velerov1api.MirrorFailurePolicy) *BackupBuilder {
+	b.object.Spec.MirrorStorageLocation = location
+	b.object.Spec.MirrorFailurePolicy = policy
+	return b
+}
+
+// TargetCluster sets the Backup's TargetCluster.
+func (b *BackupBuilder) TargetCluster(name string) *BackupBuilder {
+	b.object.Spec.TargetCluster = name
+	return b
+}
+
+// Tags sets the Backup's tags.
+func (b *BackupBuilder) Tags(tags map[string]string) *BackupBuilder {
+	b.object.Spec.Tags = tags
+	return b
+}
+
+// ArtifactDigests sets the Backup's artifact digests.
+func (b *BackupBuilder) ArtifactDigests(digests map[string]string) *BackupBuilder {
+	b.object.Status.ArtifactDigests = digests
+	return b
+}
+
+// Signed sets whether the Backup is signed.
+func (b *BackupBuilder) Signed(signed bool) *BackupBuilder {
+	b.object.Status.Signed = signed
+	return b
+}
+
 // ResourcePolicies sets the Backup's resource polices.
 func (b *BackupBuilder) ResourcePolicies(name string) *BackupBuilder {
 	b.object.Spec.ResourcePolicy = &v1.TypedLocalObjectReference{Kind: resourcepolicies.ConfigmapRefType, Name: name}
 	return b
 }
 
+// ResourceModifier sets the Backup's resource modifier. The kind is spelled out rather than
+// taken from the resourcemodifiers package, which depends on the packages tested with the builder.
+func (b *BackupBuilder) ResourceModifier(name string) *BackupBuilder {
+	b.object.Spec.ResourceModifier = &v1.TypedLocalObjectReference{Kind: "configmap", Name: name}
+	return b
+}
+
+// InlineResourcePolicy sets the Backup's inline resource policies document.
+func (b *BackupBuilder) InlineResourcePolicy(document string) *BackupBuilder {
+	b.object.Spec.InlineResourcePolicy = document
+	return b
+}
+
 // SnapshotMoveData sets the Backup's "snapshot move data" flag.
 func (b *BackupBuilder) SnapshotMoveData(val bool) *BackupBuilder {
 	b.object.Spec.SnapshotMoveData = &val
 	return b
 }
 
+// NamespacePhased sets the Backup's "namespace phased" flag.
+func (b *BackupBuilder) NamespacePhased(val bool) *BackupBuilder {
+	b.object.Spec.NamespacePhased = &val
+	return b
+}
+
+// IncludeClusterScopedDependencies sets the Backup's "include cluster-scoped dependencies" flag.
+func (b *BackupBuilder) IncludeClusterScopedDependencies(val bool) *BackupBuilder {
+	b.object.Spec.IncludeClusterScopedDependencies = &val
+	return b
+}
+
+// VolumeGroupSnapshotLabelKey sets the Backup's volume group snapshot label key.
+func (b *BackupBuilder) VolumeGroupSnapshotLabelKey(key string) *BackupBuilder {
+	b.object.Spec.VolumeGroupSnapshotLabelKey = key
+	return b
+}
+
 // DataMover sets the Backup's data mover
 func (b *BackupBuilder) DataMover(name string) *BackupBuilder {
 	b.object.Spec.DataMover = name
 	return b
 }
 
+// ChangedBlockTracking sets whether the Backup's data mover moves only the changed blocks of block volumes
+func (b *BackupBuilder) ChangedBlockTracking(enabled bool) *BackupBuilder {
+	if b.object.Spec.UploaderConfig == nil {
+		b.object.Spec.UploaderConfig = &velerov1api.UploaderConfigForBackup{}
+	}
+	b.object.Spec.UploaderConfig.ChangedBlockTracking = enabled
+	return b
+}
+
 // ParallelFilesUpload sets the Backup's uploader parallel uploads
 func (b *BackupBuilder) ParallelFilesUpload(parallel int) *BackupBuilder {
 	if b.object.Spec.UploaderConfig == nil {
@@ -309,6 +417,22 @@ func (b *BackupBuilder) ParallelFilesUpload(parallel int) *BackupBuilder {
 	return b
 }
 
+// PhaseRetries sets how many times each phase of the Backup is re-run after it failed.
+func (b *BackupBuilder) PhaseRetries(retries int) *BackupBuilder {
+	b.object.Spec.PhaseRetries = &velerov1api.BackupPhaseRetries{
+		ItemCollection: retries,
+		Snapshot:       retries,
+		Upload:         retries,
+	}
+	return b
+}
+
+// LoadBalancerPolicy sets the Backup's policy of the Services of type LoadBalancer.
+func (b *BackupBuilder) LoadBalancerPolicy(policy *velerov1api.LoadBalancerPolicy) *BackupBuilder {
+	b.object.Spec.LoadBalancerPolicy = policy
+	return b
+}
+
 // WithStatus sets the Backup's status.
 func (b *BackupBuilder) WithStatus(status velerov1api.BackupStatus) *BackupBuilder {
 	b.object.Status = status
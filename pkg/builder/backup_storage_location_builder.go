@@ -111,12 +111,24 @@ func (b *BackupStorageLocationBuilder) ValidationFrequency(frequency time.Durati
 	return b
 }
 
+// BackupLogsTTL sets the BackupStorageLocation's backup logs TTL.
+func (b *BackupStorageLocationBuilder) BackupLogsTTL(ttl time.Duration) *BackupStorageLocationBuilder {
+	b.object.Spec.BackupLogsTTL = &metav1.Duration{Duration: ttl}
+	return b
+}
+
 // LastValidationTime sets the BackupStorageLocation's last validated time.
 func (b *BackupStorageLocationBuilder) LastValidationTime(lastValidated time.Time) *BackupStorageLocationBuilder {
 	b.object.Status.LastValidationTime = &metav1.Time{Time: lastValidated}
 	return b
 }
 
+// ValidationFailures sets the BackupStorageLocation's number of validations failed in a row.
+func (b *BackupStorageLocationBuilder) ValidationFailures(failures int32) *BackupStorageLocationBuilder {
+	b.object.Status.ValidationFailures = failures
+	return b
+}
+
 // Phase sets the BackupStorageLocation's status phase.
 func (b *BackupStorageLocationBuilder) Phase(phase velerov1api.BackupStorageLocationPhase) *BackupStorageLocationBuilder {
 	b.object.Status.Phase = phase
@@ -128,3 +140,52 @@ func (b *BackupStorageLocationBuilder) Credential(selector *corev1api.SecretKeyS
 	b.object.Spec.Credential = selector
 	return b
 }
+
+// StorageLayout sets the BackupStorageLocation's storage layout.
+func (b *BackupStorageLocationBuilder) StorageLayout(version velerov1api.StorageLayoutVersion, tenant, cluster string) *BackupStorageLocationBuilder {
+	b.object.Spec.StorageLayout = &velerov1api.StorageLayout{
+		Version: version,
+		Tenant:  tenant,
+		Cluster: cluster,
+	}
+	return b
+}
+
+// UploadVerification sets the BackupStorageLocation's verification of the uploads.
+func (b *BackupStorageLocationBuilder) UploadVerification(verification *velerov1api.UploadVerification) *BackupStorageLocationBuilder {
+	b.object.Spec.UploadVerification = verification
+	return b
+}
+
+// HealthProbe sets the BackupStorageLocation's health probe.
+func (b *BackupStorageLocationBuilder) HealthProbe(latencyThreshold time.Duration, failureThreshold int32) *BackupStorageLocationBuilder {
+	b.object.Spec.HealthProbe = &velerov1api.HealthProbe{
+		LatencyThreshold: metav1.Duration{Duration: latencyThreshold},
+		FailureThreshold: failureThreshold,
+	}
+	return b
+}
+
+// FailoverLocations sets the BackupStorageLocation's failover locations.
+func (b *BackupStorageLocationBuilder) FailoverLocations(locations ...string) *BackupStorageLocationBuilder {
+	b.object.Spec.FailoverLocations = locations
+	return b
+}
+
+// Encryption sets the BackupStorageLocation's encryption of the objects.
+func (b *BackupStorageLocationBuilder) Encryption(encryption *velerov1api.Encryption) *BackupStorageLocationBuilder {
+	b.object.Spec.Encryption = encryption
+	return b
+}
+
+// Signing sets the BackupStorageLocation's signing.
+func (b *BackupStorageLocationBuilder) Signing(signing *velerov1api.Signing) *BackupStorageLocationBuilder {
+	b.object.Spec.Signing = signing
+	return b
+}
+
+// ObjectLock sets the BackupStorageLocation's mode of the object locks.
+func (b *BackupStorageLocationBuilder) ObjectLock(mode velerov1api.ObjectLockMode) *BackupStorageLocationBuilder {
+	b.object.Spec.ObjectLock = &velerov1api.ObjectLock{Mode: mode}
+	return b
+}
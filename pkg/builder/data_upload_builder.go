@@ -109,6 +109,12 @@ func (d *DataUploadBuilder) DataMoverConfig(config map[string]string) *DataUploa
 	return d
 }
 
+// Tags sets the DataUpload's Tags.
+func (d *DataUploadBuilder) Tags(tags map[string]string) *DataUploadBuilder {
+	d.object.Spec.Tags = tags
+	return d
+}
+
 // CSISnapshot sets the DataUpload's CSISnapshot.
 func (d *DataUploadBuilder) CSISnapshot(cSISnapshot *velerov2alpha1api.CSISnapshotSpec) *DataUploadBuilder {
 	d.object.Spec.CSISnapshot = cSISnapshot
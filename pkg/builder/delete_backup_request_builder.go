@@ -73,3 +73,9 @@ func (b *DeleteBackupRequestBuilder) Errors(errors ...string) *DeleteBackupReque
 	b.object.Status.Errors = errors
 	return b
 }
+
+// HookResults sets the DeleteBackupRequest's hook results.
+func (b *DeleteBackupRequestBuilder) HookResults(results ...velerov1api.DeletionHookResult) *DeleteBackupRequestBuilder {
+	b.object.Status.HookResults = results
+	return b
+}
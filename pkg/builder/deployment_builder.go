@@ -55,3 +55,9 @@ func (b *DeploymentBuilder) ObjectMeta(opts ...ObjectMetaOpt) *DeploymentBuilder
 
 	return b
 }
+
+// Replicas sets the Deployment's replicas.
+func (b *DeploymentBuilder) Replicas(replicas int32) *DeploymentBuilder {
+	b.object.Spec.Replicas = &replicas
+	return b
+}
@@ -110,3 +110,9 @@ func (b *PersistentVolumeClaimBuilder) Selector(labelSelector *metav1.LabelSelec
 	b.object.Spec.Selector = labelSelector
 	return b
 }
+
+// VolumeMode sets the PersistentVolumeClaim's spec.VolumeMode.
+func (b *PersistentVolumeClaimBuilder) VolumeMode(volumeMode corev1api.PersistentVolumeMode) *PersistentVolumeClaimBuilder {
+	b.object.Spec.VolumeMode = &volumeMode
+	return b
+}
@@ -113,6 +113,12 @@ func (b *RestoreBuilder) LabelSelector(selector *metav1.LabelSelector) *RestoreB
 	return b
 }
 
+// ExcludeLabelSelector sets the Restore's exclude label selector.
+func (b *RestoreBuilder) ExcludeLabelSelector(selector *metav1.LabelSelector) *RestoreBuilder {
+	b.object.Spec.ExcludeLabelSelector = selector
+	return b
+}
+
 // OrLabelSelector sets the Restore's orLabelSelector set.
 func (b *RestoreBuilder) OrLabelSelector(orSelectors []*metav1.LabelSelector) *RestoreBuilder {
 	b.object.Spec.OrLabelSelectors = orSelectors
@@ -171,3 +177,123 @@ func (b *RestoreBuilder) ItemOperationTimeout(timeout time.Duration) *RestoreBui
 	b.object.Spec.ItemOperationTimeout.Duration = timeout
 	return b
 }
+
+// ErrorBudget sets the Restore's ErrorBudget.
+func (b *RestoreBuilder) ErrorBudget(budget int) *RestoreBuilder {
+	b.object.Spec.ErrorBudget = &budget
+	return b
+}
+
+// ScaleDownConflictingWorkloads sets the Restore's ScaleDownConflictingWorkloads flag.
+func (b *RestoreBuilder) ScaleDownConflictingWorkloads(val bool) *RestoreBuilder {
+	b.object.Spec.ScaleDownConflictingWorkloads = &val
+	return b
+}
+
+// Preview sets the Restore's Preview flag.
+func (b *RestoreBuilder) Preview(val bool) *RestoreBuilder {
+	b.object.Spec.Preview = &val
+	return b
+}
+
+// ServerDryRun sets the Restore's ServerDryRun flag.
+func (b *RestoreBuilder) ServerDryRun(val bool) *RestoreBuilder {
+	b.object.Spec.ServerDryRun = &val
+	return b
+}
+
+// NamespaceMetadataPolicy sets the Restore's namespace metadata policy.
+func (b *RestoreBuilder) NamespaceMetadataPolicy(policy velerov1api.NamespaceMetadataPolicy) *RestoreBuilder {
+	b.object.Spec.NamespaceMetadataPolicy = policy
+	return b
+}
+
+// PVCDataSourcePolicy sets the Restore's PVC data source policy.
+func (b *RestoreBuilder) PVCDataSourcePolicy(policy velerov1api.PVCDataSourcePolicy) *RestoreBuilder {
+	b.object.Spec.PVCDataSourcePolicy = policy
+	return b
+}
+
+// PVCDataSourcePolicyByStorageClass sets the Restore's PVC data source policy of the storage class.
+func (b *RestoreBuilder) PVCDataSourcePolicyByStorageClass(storageClass string, policy velerov1api.PVCDataSourcePolicy) *RestoreBuilder {
+	if b.object.Spec.PVCDataSourcePolicyByStorageClass == nil {
+		b.object.Spec.PVCDataSourcePolicyByStorageClass = make(map[string]velerov1api.PVCDataSourcePolicy)
+	}
+	b.object.Spec.PVCDataSourcePolicyByStorageClass[storageClass] = policy
+	return b
+}
+
+// ImmutableFieldPolicy sets the Restore's immutable field policy of the resource.
+func (b *RestoreBuilder) ImmutableFieldPolicy(resource string, policy velerov1api.ImmutableFieldPolicy) *RestoreBuilder {
+	if b.object.Spec.ImmutableFieldPolicies == nil {
+		b.object.Spec.ImmutableFieldPolicies = make(map[string]velerov1api.ImmutableFieldPolicy)
+	}
+	b.object.Spec.ImmutableFieldPolicies[resource] = policy
+	return b
+}
+
+// OrderByDependencies sets the Restore's OrderByDependencies flag.
+func (b *RestoreBuilder) OrderByDependencies(val bool) *RestoreBuilder {
+	b.object.Spec.OrderByDependencies = &val
+	return b
+}
+
+// RewriteNamespaceReferences sets the Restore's RewriteNamespaceReferences flag.
+func (b *RestoreBuilder) RewriteNamespaceReferences(val bool) *RestoreBuilder {
+	b.object.Spec.RewriteNamespaceReferences = &val
+	return b
+}
+
+// IncludedItems appends to the Restore's included items.
+func (b *RestoreBuilder) IncludedItems(items ...velerov1api.RestoreItemSelector) *RestoreBuilder {
+	b.object.Spec.IncludedItems = append(b.object.Spec.IncludedItems, items...)
+	return b
+}
+
+// DependencyWaitConditions appends to the Restore's dependency wait conditions.
+func (b *RestoreBuilder) DependencyWaitConditions(conditions ...velerov1api.DependencyWaitCondition) *RestoreBuilder {
+	b.object.Spec.DependencyWaitConditions = append(b.object.Spec.DependencyWaitConditions, conditions...)
+	return b
+}
+
+// NamespacePriority sets the Restore's namespace priority.
+func (b *RestoreBuilder) NamespacePriority(namespaces ...string) *RestoreBuilder {
+	b.object.Spec.NamespacePriority = append(b.object.Spec.NamespacePriority, namespaces...)
+	return b
+}
+
+// StagingStorageLocation sets the Restore's staging storage location.
+func (b *RestoreBuilder) StagingStorageLocation(name string) *RestoreBuilder {
+	b.object.Spec.StagingStorageLocation = name
+	return b
+}
+
+// TargetCluster sets the Restore's TargetCluster.
+func (b *RestoreBuilder) TargetCluster(name string) *RestoreBuilder {
+	b.object.Spec.TargetCluster = name
+	return b
+}
+
+// ProtectNamespaces sets the Restore's ProtectNamespaces flag.
+func (b *RestoreBuilder) ProtectNamespaces(val bool) *RestoreBuilder {
+	b.object.Spec.ProtectNamespaces = &val
+	return b
+}
+
+// VolumeDetachPolicy sets the Restore's volume detach policy.
+func (b *RestoreBuilder) VolumeDetachPolicy(policy velerov1api.VolumeDetachPolicy) *RestoreBuilder {
+	b.object.Spec.VolumeDetachPolicy = policy
+	return b
+}
+
+// QuotaReconciliation sets the Restore's quota reconciliation mode.
+func (b *RestoreBuilder) QuotaReconciliation(mode velerov1api.QuotaReconciliationMode) *RestoreBuilder {
+	b.object.Spec.QuotaReconciliation = mode
+	return b
+}
+
+// GradualScale sets the Restore's gradual scale policy of the Deployments.
+func (b *RestoreBuilder) GradualScale(gradualScale *velerov1api.GradualScale) *RestoreBuilder {
+	b.object.Spec.GradualScale = gradualScale
+	return b
+}
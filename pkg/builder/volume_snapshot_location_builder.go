@@ -70,3 +70,12 @@ func (b *VolumeSnapshotLocationBuilder) Credential(selector *corev1api.SecretKey
 	b.object.Spec.Credential = selector
 	return b
 }
+
+// RateLimit sets the VolumeSnapshotLocation's snapshot API rate limit.
+func (b *VolumeSnapshotLocationBuilder) RateLimit(creationsPerMinute, deletionsPerMinute int) *VolumeSnapshotLocationBuilder {
+	b.object.Spec.RateLimit = &velerov1api.SnapshotRateLimit{
+		CreationsPerMinute: creationsPerMinute,
+		DeletionsPerMinute: deletionsPerMinute,
+	}
+	return b
+}
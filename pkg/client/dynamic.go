@@ -65,6 +65,13 @@ type Creator interface {
 	Create(obj *unstructured.Unstructured) (*unstructured.Unstructured, error)
 }
 
+// DryRunCreator submits an object for creation in dry-run mode.
+type DryRunCreator interface {
+	// CreateDryRun submits an object for creation without persisting it and returns the object
+	// the API server would have created.
+	CreateDryRun(obj *unstructured.Unstructured) (*unstructured.Unstructured, error)
+}
+
 // Lister lists objects.
 type Lister interface {
 	// List lists all the objects of a given resource.
@@ -90,6 +97,13 @@ type Patcher interface {
 	Patch(name string, data []byte) (*unstructured.Unstructured, error)
 }
 
+// Applier applies an object with server-side apply.
+type Applier interface {
+	// Apply applies the object as the field manager without forcing the ownership of the
+	// fields owned by other field managers, and returns the applied object.
+	Apply(name string, obj *unstructured.Unstructured, fieldManager string) (*unstructured.Unstructured, error)
+}
+
 // Deletor deletes an object.
 type Deletor interface {
 	//Patch patches the named object using the provided patch bytes, which are expected to be in JSON merge patch format. The patched object is returned.
@@ -105,10 +119,12 @@ type StatusUpdater interface {
 // Dynamic contains client methods that Velero needs for backing up and restoring resources.
 type Dynamic interface {
 	Creator
+	DryRunCreator
 	Lister
 	Watcher
 	Getter
 	Patcher
+	Applier
 	Deletor
 	StatusUpdater
 }
@@ -124,6 +140,10 @@ func (d *dynamicResourceClient) Create(obj *unstructured.Unstructured) (*unstruc
 	return d.resourceClient.Create(context.TODO(), obj, metav1.CreateOptions{})
 }
 
+func (d *dynamicResourceClient) CreateDryRun(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	return d.resourceClient.Create(context.TODO(), obj, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+}
+
 func (d *dynamicResourceClient) List(options metav1.ListOptions) (*unstructured.UnstructuredList, error) {
 	return d.resourceClient.List(context.TODO(), options)
 }
@@ -140,6 +160,10 @@ func (d *dynamicResourceClient) Patch(name string, data []byte) (*unstructured.U
 	return d.resourceClient.Patch(context.TODO(), name, types.MergePatchType, data, metav1.PatchOptions{})
 }
 
+func (d *dynamicResourceClient) Apply(name string, obj *unstructured.Unstructured, fieldManager string) (*unstructured.Unstructured, error) {
+	return d.resourceClient.Apply(context.TODO(), name, obj, metav1.ApplyOptions{FieldManager: fieldManager})
+}
+
 func (d *dynamicResourceClient) Delete(name string, opts metav1.DeleteOptions) error {
 	return d.resourceClient.Delete(context.TODO(), name, opts)
 }
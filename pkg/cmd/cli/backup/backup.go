@@ -36,6 +36,9 @@ func NewCommand(f client.Factory) *cobra.Command {
 		NewDescribeCommand(f, "describe"),
 		NewDownloadCommand(f),
 		NewDeleteCommand(f, "delete"),
+		NewRetryCommand(f, "retry"),
+		NewLocateCommand(f, "locate"),
+		NewDiffCommand(f, "diff"),
 	)
 
 	return c
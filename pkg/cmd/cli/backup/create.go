@@ -19,11 +19,13 @@ package backup
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/tools/cache"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -34,8 +36,10 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
+	veleroutil "github.com/vmware-tanzu/velero/pkg/util/velero"
 )
 
 func NewCreateCommand(f client.Factory, use string) *cobra.Command {
@@ -66,12 +70,16 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
   velero backup create backup3 --snapshot-volumes=false -o yaml
 
   # Wait for a backup to complete before returning from the command.
-  velero backup create backup4 --wait`,
+  velero backup create backup4 --wait
+
+  # Print the action the resource policies give to each volume of the nginx namespace, without creating a backup.
+  velero backup create --include-namespaces nginx --resource-policies-configmap policies --dry-run-resource-policies`,
 	}
 
 	o.BindFlags(c.Flags())
 	o.BindWait(c.Flags())
 	o.BindFromSchedule(c.Flags())
+	o.BindDryRunResourcePolicies(c.Flags())
 	output.BindFlags(c.Flags())
 	output.ClearOutputFlagDefault(c)
 
@@ -83,7 +91,10 @@ type CreateOptions struct {
 	TTL                             time.Duration
 	SnapshotVolumes                 flag.OptionalBool
 	SnapshotMoveData                flag.OptionalBool
+	NamespacePhased                 flag.OptionalBool
+	IncludeClusterScopedDeps        flag.OptionalBool
 	DataMover                       string
+	VolumeGroupSnapshotLabelKey     string
 	DefaultVolumesToFsBackup        flag.OptionalBool
 	IncludeNamespaces               flag.StringArray
 	ExcludeNamespaces               flag.StringArray
@@ -99,15 +110,31 @@ type CreateOptions struct {
 	OrSelector                      flag.OrLabelSelector
 	IncludeClusterResources         flag.OptionalBool
 	Wait                            bool
+	DryRunResourcePolicies          bool
 	StorageLocation                 string
+	MirrorStorageLocation           string
+	MirrorFailurePolicy             string
+	TargetCluster                   string
+	Tags                            flag.Map
 	SnapshotLocations               []string
 	FromSchedule                    string
 	OrderedResources                string
 	CSISnapshotTimeout              time.Duration
 	ItemOperationTimeout            time.Duration
+	MaxDuration                     time.Duration
+	MaxDurationAction               string
+	ErrorBudget                     int
+	PhaseRetries                    int
 	ResPoliciesConfigmap            string
+	ResPoliciesFile                 string
+	ResourceModifierConfigMap       string
 	client                          kbclient.WithWatch
 	ParallelFilesUpload             int
+	ChangedBlockTracking            bool
+	LoadBalancerAnnotations         string
+	LoadBalancerStaticIPs           string
+	WaitForLoadBalancers            flag.OptionalBool
+	LoadBalancerTimeout             time.Duration
 }
 
 func NewCreateOptions() *CreateOptions {
@@ -115,8 +142,11 @@ func NewCreateOptions() *CreateOptions {
 		IncludeNamespaces:       flag.NewStringArray("*"),
 		Labels:                  flag.NewMap(),
 		Annotations:             flag.NewMap(),
+		Tags:                    flag.NewMap(),
 		SnapshotVolumes:         flag.NewOptionalBool(nil),
 		IncludeClusterResources: flag.NewOptionalBool(nil),
+		WaitForLoadBalancers:    flag.NewOptionalBool(nil),
+		ErrorBudget:             -1,
 	}
 }
 
@@ -133,12 +163,19 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.Var(&o.Labels, "labels", "Labels to apply to the backup.")
 	flags.Var(&o.Annotations, "annotations", "Annotations to apply to the backup.")
 	flags.StringVar(&o.StorageLocation, "storage-location", "", "Location in which to store the backup.")
+	flags.StringVar(&o.MirrorStorageLocation, "mirror-storage-location", "", "Second location in which to store the backup synchronously. Optional.")
+	flags.StringVar(&o.TargetCluster, "target-cluster", "", "Name of the secret holding the kubeconfig of the cluster to back up, for a server running in the hub mode. Optional.")
+	flags.Var(&o.Tags, "tags", "Tags to set on the provider snapshots of the backup and, if its storage location enables object tagging, on its objects in object storage. Optional.")
+	flags.StringVar(&o.MirrorFailurePolicy, "mirror-failure-policy", "", "How a backup which can't be stored in its mirror-storage-location ends, either 'Fail' (the default) or 'Warn' to keep it in its storage location with a warning.")
 	flags.StringSliceVar(&o.SnapshotLocations, "volume-snapshot-locations", o.SnapshotLocations, "List of locations (at most one per provider) where volume snapshots should be stored.")
 	flags.VarP(&o.Selector, "selector", "l", "Only back up resources matching this label selector.")
 	flags.Var(&o.OrSelector, "or-selector", "Backup resources matching at least one of the label selector from the list. Label selectors should be separated by ' or '. For example, foo=bar or app=nginx")
 	flags.StringVar(&o.OrderedResources, "ordered-resources", "", "Mapping Kinds to an ordered list of specific resources of that Kind.  Resource names are separated by commas and their names are in format 'namespace/resourcename'. For cluster scope resource, simply use resource name. Key-value pairs in the mapping are separated by semi-colon.  Example: 'pods=ns1/pod1,ns1/pod2;persistentvolumeclaims=ns1/pvc4,ns1/pvc8'.  Optional.")
 	flags.DurationVar(&o.CSISnapshotTimeout, "csi-snapshot-timeout", o.CSISnapshotTimeout, "How long to wait for CSI snapshot creation before timeout.")
 	flags.DurationVar(&o.ItemOperationTimeout, "item-operation-timeout", o.ItemOperationTimeout, "How long to wait for async plugin operations before timeout.")
+	flags.DurationVar(&o.MaxDuration, "max-duration", o.MaxDuration, "How long the backup may run, including its async plugin operations. When exceeded, the remaining items are skipped and the operations in progress are canceled. Optional.")
+	flags.StringVar(&o.MaxDurationAction, "max-duration-action", "", "How a backup exceeding its max-duration ends, either 'PartiallyFail' (the default) or 'Cancel' to fail it.")
+	flags.IntVar(&o.ErrorBudget, "error-budget", o.ErrorBudget, "How many items may fail to be backed up before the backup is aborted as Failed. Optional, no limit by default.")
 	f := flags.VarPF(&o.SnapshotVolumes, "snapshot-volumes", "", "Take snapshots of PersistentVolumes as part of the backup. If the parameter is not set, it is treated as setting to 'true'.")
 	// this allows the user to just specify "--snapshot-volumes" as shorthand for "--snapshot-volumes=true"
 	// like a normal bool flag
@@ -147,6 +184,12 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	f = flags.VarPF(&o.SnapshotMoveData, "snapshot-move-data", "", "Specify whether snapshot data should be moved")
 	f.NoOptDefVal = cmd.TRUE
 
+	f = flags.VarPF(&o.NamespacePhased, "namespace-phased", "", "Back up the namespaces one after another and record a result per namespace, so the failed namespaces can be retried with 'velero backup retry'.")
+	f.NoOptDefVal = cmd.TRUE
+
+	f = flags.VarPF(&o.IncludeClusterScopedDeps, "include-cluster-scoped-dependencies", "", "Back up the cluster-scoped resources the backed up namespaced items reference, like the StorageClasses of the PVCs, even when cluster-scoped resources aren't included.")
+	f.NoOptDefVal = cmd.TRUE
+
 	f = flags.VarPF(&o.IncludeClusterResources, "include-cluster-resources", "", "Include cluster-scoped resources in the backup. Cannot work with include-cluster-scoped-resources, exclude-cluster-scoped-resources, include-namespace-scoped-resources and exclude-namespace-scoped-resources.")
 	f.NoOptDefVal = cmd.TRUE
 
@@ -154,8 +197,19 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	f.NoOptDefVal = cmd.TRUE
 
 	flags.StringVar(&o.ResPoliciesConfigmap, "resource-policies-configmap", "", "Reference to the resource policies configmap that backup should use")
+	flags.StringVar(&o.ResPoliciesFile, "resource-policies-file", "", "Path to a resource policies document embedded in the backup, instead of referencing a configmap. Cannot work with resource-policies-configmap.")
+	flags.StringVar(&o.ResourceModifierConfigMap, "resource-modifier-configmap", "", "Reference to the resource modifier configmap applied to the backed up items before they're written to the backup")
 	flags.StringVar(&o.DataMover, "data-mover", "", "Specify the data mover to be used by the backup. If the parameter is not set or set as 'velero', the built-in data mover will be used")
+	flags.StringVar(&o.VolumeGroupSnapshotLabelKey, "volume-group-snapshot-label-key", "", "The key of the label grouping the CSI volumes snapshotted together by a VolumeGroupSnapshot. Optional, velero.io/volume-group by default.")
 	flags.IntVar(&o.ParallelFilesUpload, "parallel-files-upload", 0, "Number of files uploads simultaneously when running a backup. This is only applicable for the kopia uploader")
+	flags.IntVar(&o.PhaseRetries, "phase-retries", 0, "Number of times the item collection, snapshot and upload phases of the backup are re-run after they failed, e.g. because of an API server rollout or the loss of a node-agent")
+	flags.BoolVar(&o.ChangedBlockTracking, "changed-block-tracking", false, "Move only the blocks changed since the previous backup of the same PVC for the block volumes whose CSI driver serves the SnapshotMetadata API. This is only applicable for the built-in data mover")
+
+	flags.StringVar(&o.LoadBalancerAnnotations, "load-balancer-annotations", "", "Whether the restores of the backup 'Preserve' or 'Strip' the cloud provider, load balancer controller and ExternalDNS annotations of the services of type LoadBalancer. Optional, they are preserved by default.")
+	flags.StringVar(&o.LoadBalancerStaticIPs, "load-balancer-static-ips", "", "Whether the restores of the backup 'Preserve' or 'Strip' the static IPs requested by the services of type LoadBalancer. Optional, they are preserved by default.")
+	f = flags.VarPF(&o.WaitForLoadBalancers, "wait-for-load-balancers", "", "Have the restores of the backup wait for the load balancers of the restored services of type LoadBalancer to be provisioned.")
+	f.NoOptDefVal = cmd.TRUE
+	flags.DurationVar(&o.LoadBalancerTimeout, "load-balancer-timeout", o.LoadBalancerTimeout, "How long the restores of the backup wait for the load balancers to be provisioned with --wait-for-load-balancers. Defaults to the resource timeout of the server.")
 }
 
 // BindWait binds the wait flag separately so it is not called by other create
@@ -170,6 +224,12 @@ func (o *CreateOptions) BindFromSchedule(flags *pflag.FlagSet) {
 	flags.StringVar(&o.FromSchedule, "from-schedule", "", "Create a backup from the template of an existing schedule. Cannot be used with any other filters. Backup name is optional if used.")
 }
 
+// BindDryRunResourcePolicies binds the dry-run-resource-policies flag separately so it is not
+// called by other create commands that reuse CreateOptions's BindFlags method.
+func (o *CreateOptions) BindDryRunResourcePolicies(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.DryRunResourcePolicies, "dry-run-resource-policies", o.DryRunResourcePolicies, "Print the action the resource policies give to each volume of the included namespaces instead of creating the backup. Backup name is optional if used.")
+}
+
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
 	if err := output.ValidateFlags(c); err != nil {
 		return err
@@ -184,8 +244,8 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return err
 	}
 
-	// Ensure that unless FromSchedule is set, args contains a backup name
-	if o.FromSchedule == "" && len(args) != 1 {
+	// Ensure that unless FromSchedule or DryRunResourcePolicies is set, args contains a backup name
+	if o.FromSchedule == "" && !o.DryRunResourcePolicies && len(args) != 1 {
 		return fmt.Errorf("a backup name is required, unless you are creating based on a schedule")
 	}
 
@@ -194,6 +254,40 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return kubeerrs.NewAggregate(errs)
 	}
 
+	if o.ResPoliciesConfigmap != "" && o.ResPoliciesFile != "" {
+		return fmt.Errorf("resource-policies-configmap and resource-policies-file cannot be used together")
+	}
+
+	switch velerov1api.MaxDurationAction(o.MaxDurationAction) {
+	case "", velerov1api.MaxDurationActionPartiallyFail, velerov1api.MaxDurationActionCancel:
+	default:
+		return fmt.Errorf("invalid max-duration-action %q, valid values are %s and %s", o.MaxDurationAction, velerov1api.MaxDurationActionPartiallyFail, velerov1api.MaxDurationActionCancel)
+	}
+
+	if o.PhaseRetries < 0 {
+		return fmt.Errorf("phase-retries must not be negative")
+	}
+
+	for _, policy := range []struct{ flag, value string }{
+		{"load-balancer-annotations", o.LoadBalancerAnnotations},
+		{"load-balancer-static-ips", o.LoadBalancerStaticIPs},
+	} {
+		switch velerov1api.LoadBalancerFieldPolicy(policy.value) {
+		case "", velerov1api.LoadBalancerFieldPolicyPreserve, velerov1api.LoadBalancerFieldPolicyStrip:
+		default:
+			return fmt.Errorf("invalid %s %q, valid values are %s and %s", policy.flag, policy.value, velerov1api.LoadBalancerFieldPolicyPreserve, velerov1api.LoadBalancerFieldPolicyStrip)
+		}
+	}
+	if o.LoadBalancerTimeout != 0 && !boolptr.IsSetToTrue(o.WaitForLoadBalancers.Value) {
+		return fmt.Errorf("load-balancer-timeout requires wait-for-load-balancers")
+	}
+
+	switch velerov1api.MirrorFailurePolicy(o.MirrorFailurePolicy) {
+	case "", velerov1api.MirrorFailurePolicyFail, velerov1api.MirrorFailurePolicyWarn:
+	default:
+		return fmt.Errorf("invalid mirror-failure-policy %q, valid values are %s and %s", o.MirrorFailurePolicy, velerov1api.MirrorFailurePolicyFail, velerov1api.MirrorFailurePolicyWarn)
+	}
+
 	if o.oldAndNewFilterParametersUsedTogether() {
 		return fmt.Errorf("include-resources, exclude-resources and include-cluster-resources are old filter parameters.\n" +
 			"include-cluster-scoped-resources, exclude-cluster-scoped-resources, include-namespace-scoped-resources and exclude-namespace-scoped-resources are new filter parameters.\n" +
@@ -210,6 +304,19 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		}
 	}
 
+	if o.MirrorStorageLocation != "" {
+		if o.MirrorStorageLocation == o.StorageLocation {
+			return fmt.Errorf("mirror-storage-location must be different from storage-location")
+		}
+		location := &velerov1api.BackupStorageLocation{}
+		if err := o.client.Get(context.Background(), kbclient.ObjectKey{
+			Namespace: f.Namespace(),
+			Name:      o.MirrorStorageLocation,
+		}, location); err != nil {
+			return err
+		}
+	}
+
 	for _, loc := range o.SnapshotLocations {
 		snapshotLocation := new(velerov1api.VolumeSnapshotLocation)
 		if err := o.client.Get(context.Background(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: loc}, snapshotLocation); err != nil {
@@ -250,6 +357,10 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		return err
 	}
 
+	if o.DryRunResourcePolicies {
+		return o.dryRunResourcePolicies(backup, os.Stdout)
+	}
+
 	if printed, err := output.PrintWithFormat(c, backup); printed || err != nil {
 		return err
 	}
@@ -371,11 +482,19 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 		if err != nil {
 			return nil, err
 		}
+		now := time.Now().UTC()
 		if o.Name == "" {
-			o.Name = schedule.TimestampedName(time.Now().UTC())
+			o.Name = schedule.TimestampedName(now)
 		}
 		backupBuilder = builder.ForBackup(namespace, o.Name).
 			FromSchedule(schedule)
+		variables, err := veleroutil.ScheduleVariables(context.TODO(), o.client, schedule, now)
+		if err != nil {
+			return nil, err
+		}
+		if err := veleroutil.ExpandScheduleVariables(backupBuilder.Result(), variables); err != nil {
+			return nil, fmt.Errorf("error expanding the variables of the backup: %w", err)
+		}
 	} else {
 		backupBuilder = builder.ForBackup(namespace, o.Name).
 			IncludedNamespaces(o.IncludeNamespaces...).
@@ -390,10 +509,17 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 			OrLabelSelector(o.OrSelector.OrLabelSelectors).
 			TTL(o.TTL).
 			StorageLocation(o.StorageLocation).
+			MirrorStorageLocation(o.MirrorStorageLocation, velerov1api.MirrorFailurePolicy(o.MirrorFailurePolicy)).
+			TargetCluster(o.TargetCluster).
 			VolumeSnapshotLocations(o.SnapshotLocations...).
 			CSISnapshotTimeout(o.CSISnapshotTimeout).
 			ItemOperationTimeout(o.ItemOperationTimeout).
-			DataMover(o.DataMover)
+			MaxDuration(o.MaxDuration, velerov1api.MaxDurationAction(o.MaxDurationAction)).
+			DataMover(o.DataMover).
+			VolumeGroupSnapshotLabelKey(o.VolumeGroupSnapshotLabelKey)
+		if len(o.Tags.Data()) > 0 {
+			backupBuilder.Tags(o.Tags.Data())
+		}
 		if len(o.OrderedResources) > 0 {
 			orders, err := ParseOrderedResources(o.OrderedResources)
 			if err != nil {
@@ -408,6 +534,12 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 		if o.SnapshotMoveData.Value != nil {
 			backupBuilder.SnapshotMoveData(*o.SnapshotMoveData.Value)
 		}
+		if o.NamespacePhased.Value != nil {
+			backupBuilder.NamespacePhased(*o.NamespacePhased.Value)
+		}
+		if o.IncludeClusterScopedDeps.Value != nil {
+			backupBuilder.IncludeClusterScopedDependencies(*o.IncludeClusterScopedDeps.Value)
+		}
 		if o.IncludeClusterResources.Value != nil {
 			backupBuilder.IncludeClusterResources(*o.IncludeClusterResources.Value)
 		}
@@ -417,15 +549,67 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 		if o.ResPoliciesConfigmap != "" {
 			backupBuilder.ResourcePolicies(o.ResPoliciesConfigmap)
 		}
+		inlineResourcePolicy, err := o.InlineResourcePolicy()
+		if err != nil {
+			return nil, err
+		}
+		if inlineResourcePolicy != "" {
+			backupBuilder.InlineResourcePolicy(inlineResourcePolicy)
+		}
+		if o.ResourceModifierConfigMap != "" {
+			backupBuilder.ResourceModifier(o.ResourceModifierConfigMap)
+		}
 		if o.ParallelFilesUpload > 0 {
 			backupBuilder.ParallelFilesUpload(o.ParallelFilesUpload)
 		}
+		if o.ChangedBlockTracking {
+			backupBuilder.ChangedBlockTracking(o.ChangedBlockTracking)
+		}
+		if o.ErrorBudget >= 0 {
+			backupBuilder.ErrorBudget(o.ErrorBudget)
+		}
+		if o.PhaseRetries > 0 {
+			backupBuilder.PhaseRetries(o.PhaseRetries)
+		}
+		if policy := o.LoadBalancerPolicy(); policy != nil {
+			backupBuilder.LoadBalancerPolicy(policy)
+		}
 	}
 
 	backup := backupBuilder.ObjectMeta(builder.WithLabelsMap(o.Labels.Data()), builder.WithAnnotationsMap(o.Annotations.Data())).Result()
 	return backup, nil
 }
 
+// LoadBalancerPolicy returns the load balancer policy of the load balancer flags, nil if none of
+// them is set.
+func (o *CreateOptions) LoadBalancerPolicy() *velerov1api.LoadBalancerPolicy {
+	if o.LoadBalancerAnnotations == "" && o.LoadBalancerStaticIPs == "" && o.WaitForLoadBalancers.Value == nil {
+		return nil
+	}
+	policy := &velerov1api.LoadBalancerPolicy{
+		Annotations:         velerov1api.LoadBalancerFieldPolicy(o.LoadBalancerAnnotations),
+		StaticIPs:           velerov1api.LoadBalancerFieldPolicy(o.LoadBalancerStaticIPs),
+		WaitForProvisioning: o.WaitForLoadBalancers.Value,
+	}
+	if o.LoadBalancerTimeout > 0 {
+		policy.Timeout = &metav1.Duration{Duration: o.LoadBalancerTimeout}
+	}
+	return policy
+}
+
+// InlineResourcePolicy returns the resource policies document of the resource-policies-file flag,
+// empty if it's not set.
+func (o *CreateOptions) InlineResourcePolicy() (string, error) {
+	if o.ResPoliciesFile == "" {
+		return "", nil
+	}
+	data, err := os.ReadFile(o.ResPoliciesFile)
+	if err != nil {
+		return "", fmt.Errorf("error reading the resource policies file %s: %v", o.ResPoliciesFile, err)
+	}
+	return string(data), nil
+}
+
 func (o *CreateOptions) oldAndNewFilterParametersUsedTogether() bool {
 	haveOldResourceFilterParameters := len(o.IncludeResources) > 0 ||
 		len(o.ExcludeResources) > 0 ||
@@ -19,6 +19,8 @@ package backup
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
@@ -40,6 +42,7 @@ import (
 	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
 	"github.com/vmware-tanzu/velero/pkg/test"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 )
 
 func TestCreateOptions_BuildBackup(t *testing.T) {
@@ -87,6 +90,41 @@ func TestCreateOptions_BuildBackup(t *testing.T) {
 	}, backup.Spec.OrderedResources)
 }
 
+func TestCreateOptions_BuildBackupInlineResourcePolicy(t *testing.T) {
+	document := "version: v1\nvolumePolicies: []\n"
+	path := filepath.Join(t.TempDir(), "policies.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(document), 0600))
+
+	o := NewCreateOptions()
+	o.ResPoliciesFile = path
+	backup, err := o.BuildBackup(cmdtest.VeleroNameSpace)
+	require.NoError(t, err)
+	assert.Equal(t, document, backup.Spec.InlineResourcePolicy)
+	assert.Nil(t, backup.Spec.ResourcePolicy)
+
+	o.ResPoliciesFile = filepath.Join(t.TempDir(), "missing.yaml")
+	_, err = o.BuildBackup(cmdtest.VeleroNameSpace)
+	assert.ErrorContains(t, err, "error reading the resource policies file")
+}
+
+func TestCreateOptions_BuildBackupLoadBalancerPolicy(t *testing.T) {
+	o := NewCreateOptions()
+	backup, err := o.BuildBackup(cmdtest.VeleroNameSpace)
+	require.NoError(t, err)
+	assert.Nil(t, backup.Spec.LoadBalancerPolicy)
+
+	o.LoadBalancerStaticIPs = string(velerov1api.LoadBalancerFieldPolicyStrip)
+	require.NoError(t, o.WaitForLoadBalancers.Set("true"))
+	o.LoadBalancerTimeout = time.Minute
+	backup, err = o.BuildBackup(cmdtest.VeleroNameSpace)
+	require.NoError(t, err)
+	assert.Equal(t, &velerov1api.LoadBalancerPolicy{
+		StaticIPs:           velerov1api.LoadBalancerFieldPolicyStrip,
+		WaitForProvisioning: boolptr.True(),
+		Timeout:             &metav1.Duration{Duration: time.Minute},
+	}, backup.Spec.LoadBalancerPolicy)
+}
+
 func TestCreateOptions_ValidateFromScheduleFlag(t *testing.T) {
 	cmd := &cobra.Command{}
 	o := NewCreateOptions()
@@ -32,6 +32,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/completion"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/confirm"
 	"github.com/vmware-tanzu/velero/pkg/label"
 )
@@ -41,8 +42,9 @@ func NewDeleteCommand(f client.Factory, use string) *cobra.Command {
 	o := cli.NewDeleteOptions("backup")
 
 	c := &cobra.Command{
-		Use:   fmt.Sprintf("%s [NAMES]", use),
-		Short: "Delete backups",
+		Use:               fmt.Sprintf("%s [NAMES]", use),
+		ValidArgsFunction: completion.BackupNames(f),
+		Short:             "Delete backups",
 		Example: `  # Delete a backup named "backup-1".
   velero backup delete backup-1
 
@@ -20,15 +20,22 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
 	controllerclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/completion"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 	"github.com/vmware-tanzu/velero/pkg/label"
 )
@@ -39,6 +46,10 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 		details               bool
 		insecureSkipTLSVerify bool
 		outputFormat          = "plaintext"
+		sections              []string
+		cacheDir              string
+		verify                bool
+		verifyTimeout         = time.Minute
 	)
 
 	config, err := client.LoadConfig()
@@ -47,9 +58,14 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 	}
 	caCertFile := config.CACertFile()
 
+	if userCacheDir, err := os.UserCacheDir(); err == nil {
+		cacheDir = filepath.Join(userCacheDir, "velero", "backups")
+	}
+
 	c := &cobra.Command{
-		Use:   use + " [NAME1] [NAME2] [NAME...]",
-		Short: "Describe backups",
+		Use:               use + " [NAME1] [NAME2] [NAME...]",
+		ValidArgsFunction: completion.BackupNames(f),
+		Short:             "Describe backups",
 		Run: func(c *cobra.Command, args []string) {
 			kbClient, err := f.KubebuilderClient()
 			cmd.CheckError(err)
@@ -58,6 +74,21 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 				cmd.CheckError(fmt.Errorf("invalid output format '%s'. valid value are 'plaintext, json'", outputFormat))
 			}
 
+			for _, section := range sections {
+				if !slices.Contains(output.BackupSections, section) {
+					cmd.CheckError(fmt.Errorf("invalid section '%s'. valid values are '%s'", section, strings.Join(output.BackupSections, ", ")))
+				}
+			}
+
+			opts := output.BackupDescribeOptions{
+				Details:               details,
+				InsecureSkipTLSVerify: insecureSkipTLSVerify,
+				CACertFile:            caCertFile,
+				Sections:              sections,
+				CacheDir:              cacheDir,
+			}
+			output.PruneBackupArtifactsCache(cacheDir, output.BackupArtifactsCacheMaxAge)
+
 			backups := new(velerov1api.BackupList)
 			if len(args) > 0 {
 				for _, name := range args {
@@ -73,6 +104,14 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 				cmd.CheckError(err)
 			}
 
+			if verify {
+				for i := range backups.Items {
+					if err := verifyBackup(context.TODO(), kbClient, &backups.Items[i], verifyTimeout); err != nil {
+						fmt.Fprintf(os.Stderr, "error verifying backup %s: %v\n", backups.Items[i].Name, err)
+					}
+				}
+			}
+
 			first := true
 			for i, backup := range backups.Items {
 				deleteRequestList := new(velerov1api.DeleteBackupRequestList)
@@ -96,10 +135,10 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 				// structured output only applies to a single backup in case of OOM
 				// To describe the list of backups in structured format, users could iterate over the list and describe backup one after another.
 				if len(backups.Items) == 1 && outputFormat != "plaintext" {
-					s := output.DescribeBackupInSF(context.Background(), kbClient, &backups.Items[i], deleteRequestList.Items, podVolumeBackupList.Items, details, insecureSkipTLSVerify, caCertFile, outputFormat)
+					s := output.DescribeBackupInSF(context.Background(), kbClient, &backups.Items[i], deleteRequestList.Items, podVolumeBackupList.Items, opts, outputFormat)
 					fmt.Print(s)
 				} else {
-					s := output.DescribeBackup(context.Background(), kbClient, &backups.Items[i], deleteRequestList.Items, podVolumeBackupList.Items, details, insecureSkipTLSVerify, caCertFile)
+					s := output.DescribeBackup(context.Background(), kbClient, &backups.Items[i], deleteRequestList.Items, podVolumeBackupList.Items, opts)
 					if first {
 						first = false
 						fmt.Print(s)
@@ -116,7 +155,36 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 	c.Flags().BoolVar(&details, "details", details, "Display additional detail in the command output.")
 	c.Flags().BoolVar(&insecureSkipTLSVerify, "insecure-skip-tls-verify", insecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
 	c.Flags().StringVar(&caCertFile, "cacert", caCertFile, "Path to a certificate bundle to use when verifying TLS connections.")
+	c.Flags().StringSliceVar(&sections, "sections", sections, fmt.Sprintf("Only describe these sections of the backup downloaded from the object storage, in addition to its spec and status. Valid values are '%s'. Describe all of them if unset.", strings.Join(output.BackupSections, ", ")))
+	c.Flags().StringVar(&cacheDir, "cache-dir", cacheDir, "Directory caching the files of the finished backups downloaded from the object storage. Set to an empty string to disable caching.")
 	c.Flags().StringVarP(&outputFormat, "output", "o", outputFormat, "Output display format. Valid formats are 'plaintext, json'. 'json' only applies to a single backup")
+	c.Flags().BoolVar(&verify, "verify", verify, "Verify the files of the backups in the object storage against their signed manifests before describing them.")
+	c.Flags().DurationVar(&verifyTimeout, "verify-timeout", verifyTimeout, "How long to wait for the verification of each backup.")
 
 	return c
 }
+
+// verifyBackup requests the server to verify the files of the backup against its signed manifest, and waits
+// for the Verified condition of the backup to be updated.
+func verifyBackup(ctx context.Context, kbClient controllerclient.Client, backup *velerov1api.Backup, timeout time.Duration) error {
+	original := backup.DeepCopy()
+	if backup.Annotations == nil {
+		backup.Annotations = make(map[string]string)
+	}
+	backup.Annotations[velerov1api.VerifyBackupAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	if err := kbClient.Patch(ctx, backup, controllerclient.MergeFrom(original)); err != nil {
+		return errors.Wrap(err, "error requesting the verification")
+	}
+
+	err := wait.PollUntilContextTimeout(ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		if err := kbClient.Get(ctx, controllerclient.ObjectKeyFromObject(original), backup); err != nil {
+			return false, err
+		}
+		_, requested := backup.Annotations[velerov1api.VerifyBackupAnnotation]
+		return !requested, nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "error waiting for the verification, make sure the backup-verification controller of the server is enabled")
+	}
+	return nil
+}
@@ -22,16 +22,22 @@ import (
 	"os"
 	"os/exec"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/client-go/rest"
 	controllerclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
 	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
 	"github.com/vmware-tanzu/velero/pkg/features"
 	"github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/conditions"
 	veleroexec "github.com/vmware-tanzu/velero/pkg/util/exec"
 )
 
@@ -75,3 +81,29 @@ func TestNewDescribeCommand(t *testing.T) {
 	}
 	t.Fatalf("process ran with err %v, want backups by get()", err)
 }
+
+func TestVerifyBackup(t *testing.T) {
+	backup := builder.ForBackup(cmdtest.VeleroNameSpace, "bk-verify-1").Result()
+
+	// the server removes the annotation once the backup is verified
+	watchClient := test.NewFakeControllerRuntimeWatchClient(t, backup.DeepCopy())
+	kbClient := interceptor.NewClient(watchClient, interceptor.Funcs{
+		Patch: func(ctx context.Context, client controllerclient.WithWatch, obj controllerclient.Object, patch controllerclient.Patch, opts ...controllerclient.PatchOption) error {
+			if err := client.Patch(ctx, obj, patch, opts...); err != nil {
+				return err
+			}
+			verified := obj.DeepCopyObject().(*velerov1api.Backup)
+			assert.Contains(t, verified.Annotations, velerov1api.VerifyBackupAnnotation)
+			delete(verified.Annotations, velerov1api.VerifyBackupAnnotation)
+			conditions.SetBackupVerifiedCondition(verified, time.Now(), true, "SignaturesVerified", "the files of the backup match its signed manifest")
+			return client.Update(ctx, verified)
+		},
+	})
+	verified := backup.DeepCopy()
+	require.NoError(t, verifyBackup(context.Background(), kbClient, verified, time.Minute))
+	assert.True(t, meta.IsStatusConditionTrue(verified.Status.Conditions, velerov1api.ConditionTypeVerified))
+
+	// the verification times out when the server doesn't answer
+	unanswered := test.NewFakeControllerRuntimeClient(t, backup.DeepCopy())
+	assert.ErrorContains(t, verifyBackup(context.Background(), unanswered, backup.DeepCopy(), 10*time.Millisecond), "error waiting for the verification")
+}
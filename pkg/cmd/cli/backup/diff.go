@@ -0,0 +1,457 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/completion"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+)
+
+// diffIgnoredFields are the fields of the resources which change without the resources being
+// changed, left out of their comparison.
+var diffIgnoredFields = [][]string{
+	{"metadata", "creationTimestamp"},
+	{"metadata", "generation"},
+	{"metadata", "managedFields"},
+	{"metadata", "resourceVersion"},
+	{"metadata", "selfLink"},
+	{"metadata", "uid"},
+	{"status"},
+}
+
+// diffSecretFields are the paths of the fields of the Secrets holding their values, whose values
+// are redacted when they change. The last applied configuration holds the values as well.
+var diffSecretFields = []string{
+	"data.",
+	"stringData.",
+	"metadata.annotations.kubectl.kubernetes.io/last-applied-configuration",
+}
+
+// diffRedactedValue replaces the values of the fields of the Secrets.
+const diffRedactedValue = "<redacted>"
+
+func NewDiffCommand(f client.Factory, use string) *cobra.Command {
+	o := NewDiffOptions()
+
+	c := &cobra.Command{
+		Use:               use + " BACKUP_A BACKUP_B",
+		ValidArgsFunction: completion.BackupNames(f),
+		Short:             "Compare the resources of two backups",
+		Long: `Compare the resources of two backups, listing the resources added in BACKUP_B, the ones
+removed from it, and the ones changed between them. The status of the resources, and the metadata
+set by the API server such as the resource version, are left out of the comparison.
+
+The contents of both backups are downloaded from the object storage, the data of the volumes isn't.`,
+		Args: cobra.ExactArgs(2),
+		Example: `  # List the resources which changed between the backups backup-1 and backup-2.
+  velero backup diff backup-1 backup-2
+
+  # Also list the fields of the changed resources which changed.
+  velero backup diff backup-1 backup-2 --fields`,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Validate(c, args, f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type DiffOptions struct {
+	Names                 []string
+	Fields                bool
+	Timeout               time.Duration
+	InsecureSkipTLSVerify bool
+	CACertFile            string
+
+	client kbclient.Client
+}
+
+func NewDiffOptions() *DiffOptions {
+	o := &DiffOptions{Timeout: time.Minute}
+
+	config, err := client.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: Error reading config file: %v\n", err)
+	}
+	o.CACertFile = config.CACertFile()
+	return o
+}
+
+func (o *DiffOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.Fields, "fields", o.Fields, "List the fields which changed of the changed resources.")
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "Maximum time to wait to download the contents of each backup.")
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+	flags.StringVar(&o.CACertFile, "cacert", o.CACertFile, "Path to a certificate bundle to use when verifying TLS connections.")
+}
+
+func (o *DiffOptions) Complete(args []string, f client.Factory) error {
+	o.Names = args
+
+	client, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+	o.client = client
+
+	return nil
+}
+
+func (o *DiffOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
+	for _, name := range o.Names {
+		backup := new(velerov1api.Backup)
+		if err := o.client.Get(context.Background(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: name}, backup); err != nil {
+			return errors.Wrapf(err, "error getting backup %s", name)
+		}
+		if backup.Status.Phase != velerov1api.BackupPhaseCompleted && backup.Status.Phase != velerov1api.BackupPhasePartiallyFailed {
+			return errors.Errorf("backup %s is %s, only the backups which completed can be compared", name, backup.Status.Phase)
+		}
+	}
+	return nil
+}
+
+func (o *DiffOptions) Run(c *cobra.Command, f client.Factory) error {
+	resources := make([]map[diffKey]map[string]any, len(o.Names))
+	for i, name := range o.Names {
+		var err error
+		if resources[i], err = o.backupResources(f.Namespace(), name); err != nil {
+			return err
+		}
+	}
+
+	diffs := diffResources(resources[0], resources[1])
+	if len(diffs) == 0 {
+		fmt.Printf("The backups %s and %s contain the same resources.\n", o.Names[0], o.Names[1])
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "CHANGE\tKIND\tNAME")
+	counts := make(map[string]int)
+	for _, diff := range diffs {
+		counts[diff.change]++
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", diff.change, diff.key.kind(), diff.key.name())
+		if o.Fields {
+			for _, field := range diff.fields {
+				fmt.Fprintf(tw, "\t\t  %s\n", field)
+			}
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	fmt.Printf("\n%d added, %d removed, %d changed.\n", counts[diffAdded], counts[diffRemoved], counts[diffChanged])
+	return nil
+}
+
+// backupResources downloads the contents of the backup and returns its resources.
+func (o *DiffOptions) backupResources(namespace, name string) (map[diffKey]map[string]any, error) {
+	contents, err := os.CreateTemp("", name+"-data-*.tar.gz")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		contents.Close()
+		os.Remove(contents.Name())
+	}()
+
+	if err := downloadrequest.Stream(context.Background(), o.client, namespace, name, velerov1api.DownloadTargetKindBackupContents, contents, o.Timeout, o.InsecureSkipTLSVerify, o.CACertFile); err != nil {
+		return nil, errors.Wrapf(err, "error downloading the contents of backup %s", name)
+	}
+	if _, err := contents.Seek(0, io.SeekStart); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	resources, err := readBackupResources(contents)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading the contents of backup %s", name)
+	}
+	return resources, nil
+}
+
+// diffKey identifies a resource of a backup.
+type diffKey struct {
+	groupResource string
+	// kindName is the kind of the resource with its API group, only set to report the resource.
+	kindName  string
+	namespace string
+	resource  string
+}
+
+func (k diffKey) kind() string {
+	if k.kindName != "" {
+		return k.kindName
+	}
+	return k.groupResource
+}
+
+func (k diffKey) name() string {
+	if k.namespace == "" {
+		return k.resource
+	}
+	return k.namespace + "/" + k.resource
+}
+
+// readBackupResources returns the resources of the gzipped tarball of a backup. Only the files of
+// the resources in the directories without version are read, as the backups hold the preferred
+// version of every resource there.
+func readBackupResources(r io.Reader) (map[diffKey]map[string]any, error) {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer gzr.Close()
+
+	resources := make(map[diffKey]map[string]any)
+	tr := tar.NewReader(gzr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		key, ok := resourceFileKey(header.Name)
+		if !ok {
+			continue
+		}
+
+		obj := make(map[string]any)
+		if err := json.NewDecoder(tr).Decode(&obj); err != nil {
+			return nil, errors.Wrapf(err, "error decoding %s", header.Name)
+		}
+		resources[key] = obj
+	}
+	return resources, nil
+}
+
+// resourceFileKey returns the key of the resource of a file of a backup tarball, if it's the
+// file of a resource in a directory without version:
+// resources/<group resource>/namespaces/<namespace>/<name>.json or
+// resources/<group resource>/cluster/<name>.json.
+func resourceFileKey(path string) (diffKey, bool) {
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(parts) < 4 || parts[0] != velerov1api.ResourcesDir || !strings.HasSuffix(path, ".json") {
+		return diffKey{}, false
+	}
+	name := strings.TrimSuffix(parts[len(parts)-1], ".json")
+	switch {
+	case len(parts) == 5 && parts[2] == velerov1api.NamespaceScopedDir:
+		return diffKey{groupResource: parts[1], namespace: parts[3], resource: name}, true
+	case len(parts) == 4 && parts[2] == velerov1api.ClusterScopedDir:
+		return diffKey{groupResource: parts[1], resource: name}, true
+	}
+	return diffKey{}, false
+}
+
+// objectKind returns the kind of the object with its API group, such as Deployment.apps.
+func objectKind(obj map[string]any) string {
+	kind, _ := obj["kind"].(string)
+	apiVersion, _ := obj["apiVersion"].(string)
+	if kind == "" {
+		return ""
+	}
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil || gv.Group == "" {
+		return kind
+	}
+	return kind + "." + gv.Group
+}
+
+const (
+	diffAdded   = "added"
+	diffRemoved = "removed"
+	diffChanged = "changed"
+)
+
+// resourceDiff is a resource added, removed or changed between two backups.
+type resourceDiff struct {
+	change string
+	key    diffKey
+	// fields are the changed fields of a changed resource.
+	fields []string
+}
+
+// diffResources returns the resources added in b, removed from a, and changed between them,
+// sorted by kind and name.
+func diffResources(a, b map[diffKey]map[string]any) []resourceDiff {
+	var diffs []resourceDiff
+	for key, objA := range a {
+		objB, found := b[key]
+		if !found {
+			diffs = append(diffs, resourceDiff{change: diffRemoved, key: keyWithKind(key, objA)})
+			continue
+		}
+		if fields := diffFields(objA, objB, key.groupResource == "secrets"); len(fields) > 0 {
+			diffs = append(diffs, resourceDiff{change: diffChanged, key: keyWithKind(key, objB), fields: fields})
+		}
+	}
+	for key, objB := range b {
+		if _, found := a[key]; !found {
+			diffs = append(diffs, resourceDiff{change: diffAdded, key: keyWithKind(key, objB)})
+		}
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		if diffs[i].key.kind() != diffs[j].key.kind() {
+			return diffs[i].key.kind() < diffs[j].key.kind()
+		}
+		return diffs[i].key.name() < diffs[j].key.name()
+	})
+	return diffs
+}
+
+func keyWithKind(key diffKey, obj map[string]any) diffKey {
+	key.kindName = objectKind(obj)
+	return key
+}
+
+// diffFields returns the fields which changed between the objects, but the ignored ones, as
+// "path: old -> new" for the changed fields, "+ path: new" for the added ones and "- path: old"
+// for the removed ones, sorted by path. The values of the Secrets are redacted when secret is true.
+func diffFields(a, b map[string]any, secret bool) []string {
+	fieldsA, fieldsB := make(map[string]any), make(map[string]any)
+	flattenFields("", withoutIgnoredFields(a), fieldsA)
+	flattenFields("", withoutIgnoredFields(b), fieldsB)
+
+	value := func(path string, value any) string {
+		if secret && isSecretField(path) {
+			return diffRedactedValue
+		}
+		return fieldValue(value)
+	}
+
+	var fields []string
+	for path, valueA := range fieldsA {
+		valueB, found := fieldsB[path]
+		switch {
+		case !found:
+			fields = append(fields, fmt.Sprintf("- %s: %s", path, value(path, valueA)))
+		case !reflect.DeepEqual(valueA, valueB):
+			fields = append(fields, fmt.Sprintf("%s: %s -> %s", path, value(path, valueA), value(path, valueB)))
+		}
+	}
+	for path, valueB := range fieldsB {
+		if _, found := fieldsA[path]; !found {
+			fields = append(fields, fmt.Sprintf("+ %s: %s", path, value(path, valueB)))
+		}
+	}
+
+	sort.Slice(fields, func(i, j int) bool {
+		return strings.TrimLeft(fields[i], "+- ") < strings.TrimLeft(fields[j], "+- ")
+	})
+	return fields
+}
+
+// isSecretField returns whether the field of a Secret at the path is one of diffSecretFields.
+func isSecretField(path string) bool {
+	for _, secretField := range diffSecretFields {
+		if strings.HasPrefix(path, secretField) {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutIgnoredFields returns a copy of the object without diffIgnoredFields.
+func withoutIgnoredFields(obj map[string]any) map[string]any {
+	copied := make(map[string]any, len(obj))
+	for key, value := range obj {
+		copied[key] = value
+	}
+	if metadata, ok := obj["metadata"].(map[string]any); ok {
+		copiedMetadata := make(map[string]any, len(metadata))
+		for key, value := range metadata {
+			copiedMetadata[key] = value
+		}
+		copied["metadata"] = copiedMetadata
+	}
+
+	for _, path := range diffIgnoredFields {
+		parent := copied
+		for _, field := range path[:len(path)-1] {
+			parent, _ = parent[field].(map[string]any)
+		}
+		delete(parent, path[len(path)-1])
+	}
+	return copied
+}
+
+// flattenFields sets the leaf values of the value in fields, keyed by their path from the
+// prefix, such as spec.template.spec.containers[0].image.
+func flattenFields(prefix string, value any, fields map[string]any) {
+	switch v := value.(type) {
+	case map[string]any:
+		if len(v) == 0 && prefix != "" {
+			fields[prefix] = v
+		}
+		for key, child := range v {
+			path := key
+			if prefix != "" {
+				path = prefix + "." + key
+			}
+			flattenFields(path, child, fields)
+		}
+	case []any:
+		if len(v) == 0 {
+			fields[prefix] = v
+		}
+		for i, child := range v {
+			flattenFields(fmt.Sprintf("%s[%d]", prefix, i), child, fields)
+		}
+	default:
+		fields[prefix] = v
+	}
+}
+
+func fieldValue(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
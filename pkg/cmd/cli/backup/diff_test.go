@@ -0,0 +1,141 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestDiffResources(t *testing.T) {
+	backupA := velerotest.NewTarWriter(t).
+		AddItems("deployments.apps",
+			builder.ForDeployment("payments", "api").ObjectMeta(builder.WithLabels("tier", "web"), builder.WithResourceVersion("1")).Result(),
+			builder.ForDeployment("payments", "worker").Result(),
+		).
+		AddItems("namespaces", builder.ForNamespace("payments").Result()).
+		// the other versions of the resources are left out
+		Add("resources/deployments.apps/v1-preferredversion/namespaces/payments/api.json", builder.ForDeployment("payments", "api").Result()).
+		Done()
+	backupB := velerotest.NewTarWriter(t).
+		AddItems("deployments.apps",
+			builder.ForDeployment("payments", "api").ObjectMeta(builder.WithLabels("tier", "api"), builder.WithResourceVersion("2")).Result(),
+			builder.ForDeployment("payments", "cron").Result(),
+		).
+		AddItems("namespaces", builder.ForNamespace("payments").ObjectMeta(builder.WithResourceVersion("2")).Result()).
+		Done()
+
+	resourcesA, err := readBackupResources(backupA)
+	require.NoError(t, err)
+	require.Len(t, resourcesA, 3)
+	resourcesB, err := readBackupResources(backupB)
+	require.NoError(t, err)
+
+	diffs := diffResources(resourcesA, resourcesB)
+	require.Len(t, diffs, 3)
+
+	assert.Equal(t, diffChanged, diffs[0].change)
+	assert.Equal(t, "Deployment.apps", diffs[0].key.kind())
+	assert.Equal(t, "payments/api", diffs[0].key.name())
+	assert.Equal(t, []string{`metadata.labels.tier: "web" -> "api"`}, diffs[0].fields)
+
+	assert.Equal(t, diffAdded, diffs[1].change)
+	assert.Equal(t, "payments/cron", diffs[1].key.name())
+
+	assert.Equal(t, diffRemoved, diffs[2].change)
+	assert.Equal(t, "payments/worker", diffs[2].key.name())
+}
+
+func TestDiffFields(t *testing.T) {
+	a := map[string]any{
+		"metadata": map[string]any{"name": "api", "uid": "1"},
+		"spec": map[string]any{
+			"replicas":   float64(2),
+			"paused":     false,
+			"containers": []any{map[string]any{"image": "api:1"}},
+		},
+		"status": map[string]any{"replicas": float64(2)},
+	}
+	b := map[string]any{
+		"metadata": map[string]any{"name": "api", "uid": "2"},
+		"spec": map[string]any{
+			"replicas":   float64(3),
+			"containers": []any{map[string]any{"image": "api:2"}},
+			"strategy":   map[string]any{"type": "Recreate"},
+		},
+		"status": map[string]any{"replicas": float64(3)},
+	}
+
+	assert.Equal(t, []string{
+		`spec.containers[0].image: "api:1" -> "api:2"`,
+		`- spec.paused: false`,
+		`spec.replicas: 2 -> 3`,
+		`+ spec.strategy.type: "Recreate"`,
+	}, diffFields(a, b, false))
+
+	// the ignored fields of the objects are left untouched
+	assert.Equal(t, "1", a["metadata"].(map[string]any)["uid"])
+	assert.Empty(t, diffFields(a, a, false))
+}
+
+func TestDiffFieldsOfSecrets(t *testing.T) {
+	a := map[string]any{
+		"metadata": map[string]any{
+			"name":        "credentials",
+			"labels":      map[string]any{"app": "api"},
+			"annotations": map[string]any{"kubectl.kubernetes.io/last-applied-configuration": `{"data":{"password":"c2VjcmV0"}}`},
+		},
+		"data": map[string]any{"password": "c2VjcmV0", "user": "YWRtaW4="},
+	}
+	b := map[string]any{
+		"metadata": map[string]any{
+			"name":        "credentials",
+			"labels":      map[string]any{"app": "web"},
+			"annotations": map[string]any{"kubectl.kubernetes.io/last-applied-configuration": `{"data":{"password":"bmV3"}}`},
+		},
+		"data":       map[string]any{"password": "bmV3"},
+		"stringData": map[string]any{"token": "abc"},
+	}
+
+	assert.Equal(t, []string{
+		`data.password: <redacted> -> <redacted>`,
+		`- data.user: <redacted>`,
+		`metadata.annotations.kubectl.kubernetes.io/last-applied-configuration: <redacted> -> <redacted>`,
+		`metadata.labels.app: "api" -> "web"`,
+		`+ stringData.token: <redacted>`,
+	}, diffFields(a, b, true))
+}
+
+func TestResourceFileKey(t *testing.T) {
+	key, ok := resourceFileKey("resources/deployments.apps/namespaces/payments/api.json")
+	assert.True(t, ok)
+	assert.Equal(t, diffKey{groupResource: "deployments.apps", namespace: "payments", resource: "api"}, key)
+
+	key, ok = resourceFileKey("resources/clusterroles.rbac.authorization.k8s.io/cluster/admin.json")
+	assert.True(t, ok)
+	assert.Equal(t, diffKey{groupResource: "clusterroles.rbac.authorization.k8s.io", resource: "admin"}, key)
+
+	_, ok = resourceFileKey("resources/deployments.apps/v1-preferredversion/namespaces/payments/api.json")
+	assert.False(t, ok)
+	_, ok = resourceFileKey("metadata/version")
+	assert.False(t, ok)
+}
@@ -31,6 +31,7 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/completion"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
 )
 
@@ -43,10 +44,11 @@ func NewDownloadCommand(f client.Factory) *cobra.Command {
 	o.caCertFile = config.CACertFile()
 
 	c := &cobra.Command{
-		Use:   "download NAME",
-		Short: "Download all Kubernetes manifests for a backup",
-		Long:  "Download all Kubernetes manifests for a backup. Contents of persistent volume snapshots are not included.",
-		Args:  cobra.ExactArgs(1),
+		Use:               "download NAME",
+		ValidArgsFunction: completion.SingleArg(completion.BackupNames(f)),
+		Short:             "Download all Kubernetes manifests for a backup",
+		Long:              "Download all Kubernetes manifests for a backup. Contents of persistent volume snapshots are not included.",
+		Args:              cobra.ExactArgs(1),
 		Run: func(c *cobra.Command, args []string) {
 			cmd.CheckError(o.Complete(args))
 			cmd.CheckError(o.Validate(c, args, f))
@@ -0,0 +1,118 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+// dryRunResourcePolicies evaluates the volume policies of the backup against the PVCs of the
+// namespaces it includes, and their PVs, and prints the action each volume would get, without
+// creating the backup.
+func (o *CreateOptions) dryRunResourcePolicies(backup *velerov1api.Backup, w io.Writer) error {
+	if backup.Spec.ResourcePolicy == nil && backup.Spec.InlineResourcePolicy == "" {
+		return errors.New("the backup has no resource policies, use --resource-policies-configmap or --resource-policies-file to set them")
+	}
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	policies, err := resourcepolicies.GetResourcePoliciesFromBackup(*backup, o.client, logger)
+	if err != nil {
+		return err
+	}
+
+	pvcs := new(corev1api.PersistentVolumeClaimList)
+	if err := o.client.List(context.Background(), pvcs); err != nil {
+		return errors.Wrap(err, "error listing the PVCs")
+	}
+	sort.Slice(pvcs.Items, func(i, j int) bool {
+		if pvcs.Items[i].Namespace != pvcs.Items[j].Namespace {
+			return pvcs.Items[i].Namespace < pvcs.Items[j].Namespace
+		}
+		return pvcs.Items[i].Name < pvcs.Items[j].Name
+	})
+
+	namespaces := collections.NewIncludesExcludes().Includes(backup.Spec.IncludedNamespaces...).Excludes(backup.Spec.ExcludedNamespaces...)
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAMESPACE\tPVC\tPV\tACTION")
+	for i := range pvcs.Items {
+		pvc := &pvcs.Items[i]
+		if !namespaces.ShouldInclude(pvc.Namespace) {
+			continue
+		}
+
+		if pvc.Spec.VolumeName == "" {
+			fmt.Fprintf(tw, "%s\t%s\t<none>\t<not bound>\n", pvc.Namespace, pvc.Name)
+			continue
+		}
+
+		pv := new(corev1api.PersistentVolume)
+		if err := o.client.Get(context.Background(), kbclient.ObjectKey{Name: pvc.Spec.VolumeName}, pv); err != nil {
+			return errors.Wrapf(err, "error getting the PV %s of the PVC %s/%s", pvc.Spec.VolumeName, pvc.Namespace, pvc.Name)
+		}
+
+		action, err := policies.GetMatchAction(resourcepolicies.NewVolumeFilterData(pv, nil, pvc))
+		if err != nil {
+			return errors.Wrapf(err, "error evaluating the resource policies for the PVC %s/%s", pvc.Namespace, pvc.Name)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", pvc.Namespace, pvc.Name, pv.Name, describeAction(action))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	conflicts := policies.Conflicts()
+	if len(conflicts) > 0 {
+		fmt.Fprintln(w, "\nConflicts:")
+		for _, conflict := range conflicts {
+			fmt.Fprintf(w, "  %s\n", conflict)
+		}
+	}
+	return nil
+}
+
+// describeAction returns the type of the action followed by its parameters, if any.
+func describeAction(action *resourcepolicies.Action) string {
+	if action == nil {
+		return "<no matching policy>"
+	}
+	if len(action.Parameters) == 0 {
+		return string(action.Type)
+	}
+
+	var params []string
+	for key, value := range action.Parameters {
+		params = append(params, fmt.Sprintf("%s=%v", key, value))
+	}
+	sort.Strings(params)
+	return fmt.Sprintf("%s (%s)", action.Type, strings.Join(params, ", "))
+}
@@ -0,0 +1,97 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestDryRunResourcePolicies(t *testing.T) {
+	policies := `version: v1
+volumePolicies:
+- conditions:
+    storageClass:
+    - gp2
+  action:
+    type: skip
+- conditions:
+    csi:
+      driver: ebs.csi.aws.com
+  action:
+    type: snapshot
+    parameters:
+      snapshotClass: ebs
+`
+	client := velerotest.NewFakeControllerRuntimeClient(t,
+		builder.ForConfigMap(cmdtest.VeleroNameSpace, "policies").Data("policies.yaml", policies).Result(),
+		builder.ForPersistentVolume("pv-1").StorageClass("gp2").Result(),
+		builder.ForPersistentVolume("pv-2").CSI("ebs.csi.aws.com", "vol-2").Result(),
+		builder.ForPersistentVolume("pv-3").Result(),
+		builder.ForPersistentVolume("pv-4").Result(),
+		builder.ForPersistentVolumeClaim("app", "data-1").VolumeName("pv-1").Result(),
+		builder.ForPersistentVolumeClaim("app", "data-2").VolumeName("pv-2").Result(),
+		builder.ForPersistentVolumeClaim("app", "data-3").VolumeName("pv-3").Result(),
+		builder.ForPersistentVolumeClaim("app", "pending").Result(),
+		builder.ForPersistentVolumeClaim("other", "data-4").VolumeName("pv-4").Result(),
+	).(kbclient.WithWatch)
+
+	t.Run("the action of each volume of the included namespaces is printed", func(t *testing.T) {
+		o := NewCreateOptions()
+		o.client = client
+		o.IncludeNamespaces = []string{"app"}
+		o.ResPoliciesConfigmap = "policies"
+		backup, err := o.BuildBackup(cmdtest.VeleroNameSpace)
+		require.NoError(t, err)
+
+		out := new(bytes.Buffer)
+		require.NoError(t, o.dryRunResourcePolicies(backup, out))
+		assert.Equal(t, `NAMESPACE  PVC      PV      ACTION
+app        data-1   pv-1    skip
+app        data-2   pv-2    snapshot (snapshotClass=ebs)
+app        data-3   pv-3    <no matching policy>
+app        pending  <none>  <not bound>
+`, out.String())
+	})
+
+	t.Run("a backup without resource policies fails", func(t *testing.T) {
+		o := NewCreateOptions()
+		o.client = client
+		backup, err := o.BuildBackup(cmdtest.VeleroNameSpace)
+		require.NoError(t, err)
+
+		require.ErrorContains(t, o.dryRunResourcePolicies(backup, new(bytes.Buffer)), "the backup has no resource policies")
+	})
+
+	t.Run("a missing resource policies ConfigMap fails", func(t *testing.T) {
+		o := NewCreateOptions()
+		o.client = client
+		o.ResPoliciesConfigmap = "missing"
+		backup, err := o.BuildBackup(cmdtest.VeleroNameSpace)
+		require.NoError(t, err)
+
+		require.ErrorContains(t, o.dryRunResourcePolicies(backup, new(bytes.Buffer)), "fail to get ResourcePolicies")
+	})
+}
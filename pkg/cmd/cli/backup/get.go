@@ -27,6 +27,7 @@ import (
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/completion"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 )
 
@@ -34,8 +35,9 @@ func NewGetCommand(f client.Factory, use string) *cobra.Command {
 	var listOptions metav1.ListOptions
 
 	c := &cobra.Command{
-		Use:   use,
-		Short: "Get backups",
+		Use:               use,
+		ValidArgsFunction: completion.BackupNames(f),
+		Short:             "Get backups",
 		Run: func(c *cobra.Command, args []string) {
 			err := output.ValidateFlags(c)
 			cmd.CheckError(err)
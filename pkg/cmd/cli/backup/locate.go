@@ -0,0 +1,604 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/volume"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/repository"
+	repomanager "github.com/vmware-tanzu/velero/pkg/repository/manager"
+)
+
+func NewLocateCommand(f client.Factory, use string) *cobra.Command {
+	o := NewLocateOptions()
+
+	c := &cobra.Command{
+		Use:   use + " NAME",
+		Short: "Find the backups which can restore a resource of the cluster",
+		Long: `Find the most recent backups containing the resource NAME, and report whether the data of
+the volumes it mounts can be restored from them: the snapshots of the volumes were taken, and the
+snapshots of the volumes backed up by the file system backup or the data mover exist in their ready
+backup repositories. The volumes of a resource deleted from the cluster are read from the backups.`,
+		Args: cobra.ExactArgs(1),
+		Example: `  # Find the backups which can restore the deployment api of the namespace payments.
+  velero backup locate --kind deployment --resource-namespace payments api
+
+  # Find the backups which can restore the cluster role admin, among all the backups.
+  velero backup locate --kind clusterrole admin --max-backups 0`,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Validate(c, args, f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type LocateOptions struct {
+	Name                  string
+	Kind                  string
+	ResourceNamespace     string
+	MaxBackups            int
+	Timeout               time.Duration
+	InsecureSkipTLSVerify bool
+	CACertFile            string
+	CacheDir              string
+
+	client      kbclient.Client
+	repoManager repomanager.Manager
+	// closeRepoManager removes the credentials written to disk by the repository manager.
+	closeRepoManager func()
+	// repoSnapshots are the IDs of the snapshots of the backup repositories, keyed by the names
+	// of the repositories.
+	repoSnapshots map[string]sets.Set[string]
+}
+
+func NewLocateOptions() *LocateOptions {
+	o := &LocateOptions{MaxBackups: 10, Timeout: time.Minute}
+
+	config, err := client.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: Error reading config file: %v\n", err)
+	}
+	o.CACertFile = config.CACertFile()
+
+	if userCacheDir, err := os.UserCacheDir(); err == nil {
+		o.CacheDir = filepath.Join(userCacheDir, "velero", "backups")
+	}
+	return o
+}
+
+func (o *LocateOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.Kind, "kind", o.Kind, "Kind or resource of the resource to locate, such as 'deployment' or 'statefulsets.apps'. Required.")
+	flags.StringVar(&o.ResourceNamespace, "resource-namespace", o.ResourceNamespace, "Namespace of the resource to locate, unless it's cluster-scoped.")
+	flags.IntVar(&o.MaxBackups, "max-backups", o.MaxBackups, "Maximum number of the most recent backups to inspect, all of them if 0.")
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "Maximum time to wait to process the download requests of the backups of a resource deleted from the cluster.")
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+	flags.StringVar(&o.CACertFile, "cacert", o.CACertFile, "Path to a certificate bundle to use when verifying TLS connections.")
+	flags.StringVar(&o.CacheDir, "cache-dir", o.CacheDir, "Directory caching the files of the finished backups downloaded from the object storage. Set to an empty string to disable caching.")
+}
+
+func (o *LocateOptions) Complete(args []string, f client.Factory) error {
+	o.Name = args[0]
+
+	client, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+	o.client = client
+
+	return nil
+}
+
+func (o *LocateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
+	if o.Kind == "" {
+		return errors.New("--kind is required")
+	}
+	if o.MaxBackups < 0 {
+		return errors.New("--max-backups must not be negative")
+	}
+	return nil
+}
+
+func (o *LocateOptions) Run(c *cobra.Command, f client.Factory) error {
+	ctx := context.Background()
+
+	gvk, err := o.client.RESTMapper().KindFor(schema.ParseGroupResource(o.Kind).WithVersion(""))
+	if err != nil {
+		return errors.Wrapf(err, "error resolving the kind %s", o.Kind)
+	}
+
+	mapping, err := o.client.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return errors.Wrapf(err, "error resolving the resource of the kind %s", gvk.Kind)
+	}
+
+	// the volumes of a resource deleted from the cluster are read from every backup
+	var claims *volumeClaims
+	obj := new(unstructured.Unstructured)
+	obj.SetGroupVersionKind(gvk)
+	if err := o.client.Get(ctx, kbclient.ObjectKey{Namespace: o.ResourceNamespace, Name: o.Name}, obj); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "error getting the %s %s", gvk.Kind, o.resourceName())
+		}
+		fmt.Fprintf(os.Stderr, "WARNING: the %s %s doesn't exist in the cluster, the volumes it mounts are read from the backups\n", gvk.Kind, o.resourceName())
+	} else {
+		claims = newVolumeClaims(obj)
+	}
+
+	defer func() {
+		if o.closeRepoManager != nil {
+			o.closeRepoManager()
+		}
+	}()
+
+	backups, err := o.recentBackups(ctx, f.Namespace())
+	if err != nil {
+		return err
+	}
+
+	opts := output.BackupDescribeOptions{
+		InsecureSkipTLSVerify: o.InsecureSkipTLSVerify,
+		CACertFile:            o.CACertFile,
+		CacheDir:              o.CacheDir,
+	}
+	output.PruneBackupArtifactsCache(o.CacheDir, output.BackupArtifactsCacheMaxAge)
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "BACKUP\tCREATED\tRESTORABLE\tVOLUMES")
+	found := 0
+	for i := range backups {
+		backup := &backups[i]
+		artifacts := output.NewBackupArtifacts(o.client, backup, opts)
+
+		contained, err := backupContains(ctx, artifacts, gvk, o.ResourceNamespace, o.Name)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error getting the resource list of backup %s: %v\n", backup.Name, err)
+			continue
+		}
+		if !contained {
+			continue
+		}
+		found++
+
+		backupClaims := claims
+		if backupClaims == nil {
+			item, err := o.backupItem(backup, mapping.Resource.GroupResource(), o.ResourceNamespace, o.Name)
+			if err != nil {
+				fmt.Fprintf(tw, "%s\t%s\tunknown\t<error reading the %s from the backup: %v>\n", backup.Name, backup.CreationTimestamp, gvk.Kind, err)
+				continue
+			}
+			backupClaims = newVolumeClaims(item)
+		}
+
+		volumeInfos, err := backupVolumeInfos(ctx, artifacts)
+		if err != nil {
+			fmt.Fprintf(tw, "%s\t%s\tunknown\t<error getting the volume info: %v>\n", backup.Name, backup.CreationTimestamp, err)
+			continue
+		}
+
+		restorable := true
+		var volumes []string
+		for _, info := range volumeInfos {
+			name, ok := backupClaims.volume(info)
+			if !ok {
+				continue
+			}
+			ok, status := o.volumeRestorability(ctx, backup, info)
+			restorable = restorable && ok
+			volumes = append(volumes, fmt.Sprintf("%s: %s", name, status))
+		}
+		if len(volumes) == 0 {
+			volumes = append(volumes, "<none>")
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", backup.Name, backup.CreationTimestamp, yesNo(restorable), strings.Join(volumes, "; "))
+	}
+
+	if found == 0 {
+		fmt.Printf("None of the %d backups inspected contains the %s %s.\n", len(backups), gvk.Kind, o.resourceName())
+		return nil
+	}
+	return tw.Flush()
+}
+
+func (o *LocateOptions) resourceName() string {
+	if o.ResourceNamespace == "" {
+		return o.Name
+	}
+	return o.ResourceNamespace + "/" + o.Name
+}
+
+// recentBackups returns the backups which completed, at least partially, the most recent first,
+// up to MaxBackups of them.
+func (o *LocateOptions) recentBackups(ctx context.Context, namespace string) ([]velerov1api.Backup, error) {
+	list := new(velerov1api.BackupList)
+	if err := o.client.List(ctx, list, kbclient.InNamespace(namespace)); err != nil {
+		return nil, errors.Wrap(err, "error listing the backups")
+	}
+
+	var backups []velerov1api.Backup
+	for _, backup := range list.Items {
+		if backup.Status.Phase == velerov1api.BackupPhaseCompleted || backup.Status.Phase == velerov1api.BackupPhasePartiallyFailed {
+			backups = append(backups, backup)
+		}
+	}
+	sort.SliceStable(backups, func(i, j int) bool {
+		return backups[j].CreationTimestamp.Before(&backups[i].CreationTimestamp)
+	})
+	if o.MaxBackups > 0 && len(backups) > o.MaxBackups {
+		backups = backups[:o.MaxBackups]
+	}
+	return backups, nil
+}
+
+// volumeRestorability returns whether the data of the volume can be restored from the backup,
+// with the reason why.
+func (o *LocateOptions) volumeRestorability(ctx context.Context, backup *velerov1api.Backup, info *volume.BackupVolumeInfo) (bool, string) {
+	if info.Skipped {
+		return false, fmt.Sprintf("skipped (%s)", info.SkippedReason)
+	}
+	if info.Result == volume.VolumeResultFailed {
+		return false, fmt.Sprintf("the %s failed", info.BackupMethod)
+	}
+
+	switch info.BackupMethod {
+	case volume.NativeSnapshot:
+		if info.NativeSnapshotInfo == nil || info.NativeSnapshotInfo.SnapshotHandle == "" || info.NativeSnapshotInfo.Phase == volume.SnapshotPhaseFailed {
+			return false, "no native snapshot"
+		}
+		return true, "native snapshot " + info.NativeSnapshotInfo.SnapshotHandle
+	case volume.CSISnapshot:
+		if info.SnapshotDataMoved {
+			movement := info.SnapshotDataMovementInfo
+			if movement == nil || movement.SnapshotHandle == "" {
+				return false, "no data moved"
+			}
+			return o.repositorySnapshot(ctx, backup, info.PVCNamespace, movement.UploaderType, movement.SnapshotHandle,
+				withSize("data moved as snapshot "+movement.SnapshotHandle, movement.Size))
+		}
+		if info.CSISnapshotInfo == nil || info.CSISnapshotInfo.SnapshotHandle == "" {
+			return false, "no CSI snapshot"
+		}
+		if info.CSISnapshotInfo.ReadyToUse != nil && !*info.CSISnapshotInfo.ReadyToUse {
+			return false, "the CSI snapshot " + info.CSISnapshotInfo.SnapshotHandle + " isn't ready to use"
+		}
+		return true, withSize("CSI snapshot "+info.CSISnapshotInfo.SnapshotHandle, info.CSISnapshotInfo.Size)
+	case volume.PodVolumeBackup:
+		if info.PVBInfo == nil || info.PVBInfo.SnapshotHandle == "" {
+			return false, "no file system backup"
+		}
+		return o.repositorySnapshot(ctx, backup, info.PVBInfo.PodNamespace, info.PVBInfo.UploaderType, info.PVBInfo.SnapshotHandle,
+			withSize("file system backup "+info.PVBInfo.SnapshotHandle, info.PVBInfo.Size))
+	default:
+		return false, fmt.Sprintf("unknown backup method %q", info.BackupMethod)
+	}
+}
+
+// repositorySnapshot returns whether the snapshot holding the data of a volume of the namespace
+// backed up by the uploader exists in its ready backup repository, with the reason why.
+func (o *LocateOptions) repositorySnapshot(ctx context.Context, backup *velerov1api.Backup, volumeNamespace, uploaderType, snapshotID, status string) (bool, string) {
+	repos := new(velerov1api.BackupRepositoryList)
+	if err := o.client.List(ctx, repos, kbclient.InNamespace(backup.Namespace)); err != nil {
+		return false, fmt.Sprintf("%s, <error listing the backup repositories: %v>", status, err)
+	}
+
+	if uploaderType == "" {
+		uploaderType = velerov1api.BackupRepositoryTypeKopia
+	}
+	for i := range repos.Items {
+		repo := &repos.Items[i]
+		if repo.Spec.VolumeNamespace != volumeNamespace || repo.Spec.BackupStorageLocation != backup.Spec.StorageLocation || repo.Spec.RepositoryType != uploaderType {
+			continue
+		}
+		if repo.Status.Phase != velerov1api.BackupRepositoryPhaseReady {
+			return false, fmt.Sprintf("%s, the backup repository %s is %s", status, repo.Name, repo.Status.Phase)
+		}
+
+		snapshots, err := o.repositorySnapshots(ctx, repo)
+		if err != nil {
+			return false, fmt.Sprintf("%s, <error listing the snapshots of the backup repository %s: %v>", status, repo.Name, err)
+		}
+		if !snapshots.Has(snapshotID) {
+			return false, fmt.Sprintf("%s, missing from the backup repository %s", status, repo.Name)
+		}
+		return true, fmt.Sprintf("%s in the backup repository %s", status, repo.Name)
+	}
+	return false, fmt.Sprintf("%s, no backup repository", status)
+}
+
+// repositorySnapshots returns the IDs of the snapshots of the backup repository, listed once per
+// repository.
+func (o *LocateOptions) repositorySnapshots(ctx context.Context, repo *velerov1api.BackupRepository) (sets.Set[string], error) {
+	if snapshots, ok := o.repoSnapshots[repo.Name]; ok {
+		return snapshots, nil
+	}
+
+	if o.repoManager == nil {
+		logger := logrus.New()
+		logger.SetOutput(io.Discard)
+		manager, cleanup, err := repository.NewManager(o.client, repo.Namespace, logger)
+		if err != nil {
+			return nil, err
+		}
+		o.repoManager, o.closeRepoManager = manager, cleanup
+	}
+
+	list, err := o.repoManager.ListSnapshots(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	snapshots := sets.New[string]()
+	for _, snapshot := range list {
+		snapshots.Insert(snapshot.ID)
+	}
+
+	if o.repoSnapshots == nil {
+		o.repoSnapshots = make(map[string]sets.Set[string])
+	}
+	o.repoSnapshots[repo.Name] = snapshots
+	return snapshots, nil
+}
+
+// withSize appends the size of the data of a volume to its status, when it's known.
+func withSize(status string, size int64) string {
+	if size <= 0 {
+		return status
+	}
+	return fmt.Sprintf("%s (%s)", status, resource.NewQuantity(size, resource.BinarySI))
+}
+
+// backupItem downloads the contents of the backup and returns the resource, from the directory
+// of the resource without version.
+func (o *LocateOptions) backupItem(backup *velerov1api.Backup, groupResource schema.GroupResource, namespace, name string) (*unstructured.Unstructured, error) {
+	contents, err := os.CreateTemp("", backup.Name+"-data-*.tar.gz")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		contents.Close()
+		os.Remove(contents.Name())
+	}()
+
+	if err := downloadrequest.Stream(context.Background(), o.client, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupContents, contents, o.Timeout, o.InsecureSkipTLSVerify, o.CACertFile); err != nil {
+		return nil, errors.Wrap(err, "error downloading the contents of the backup")
+	}
+	if _, err := contents.Seek(0, io.SeekStart); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return readBackupItem(contents, groupResource.String(), namespace, name)
+}
+
+// readBackupItem returns the resource of the gzipped tarball of a backup.
+func readBackupItem(r io.Reader, groupResource, namespace, name string) (*unstructured.Unstructured, error) {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer gzr.Close()
+
+	want := diffKey{groupResource: groupResource, namespace: namespace, resource: name}
+	tr := tar.NewReader(gzr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil, errors.New("the resource isn't in the backup contents")
+		}
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		if key, ok := resourceFileKey(header.Name); !ok || key != want {
+			continue
+		}
+
+		obj := new(unstructured.Unstructured)
+		if err := json.NewDecoder(tr).Decode(&obj.Object); err != nil {
+			return nil, errors.Wrapf(err, "error decoding %s", header.Name)
+		}
+		return obj, nil
+	}
+}
+
+// backupContains returns whether the resource list of the backup contains the resource.
+func backupContains(ctx context.Context, artifacts *output.BackupArtifacts, gvk schema.GroupVersionKind, namespace, name string) (bool, error) {
+	buf := new(bytes.Buffer)
+	if err := artifacts.Stream(ctx, velerov1api.DownloadTargetKindBackupResourceList, buf); err != nil {
+		return false, err
+	}
+
+	var resourceList map[string][]string
+	if err := json.NewDecoder(buf).Decode(&resourceList); err != nil {
+		return false, errors.Wrap(err, "error reading the backup resource list")
+	}
+	return resourceListContains(resourceList, gvk, namespace, name), nil
+}
+
+// resourceListContains returns whether the resource list of a backup contains the resource.
+func resourceListContains(resourceList map[string][]string, gvk schema.GroupVersionKind, namespace, name string) bool {
+	item := name
+	if namespace != "" {
+		item = namespace + "/" + name
+	}
+	for key, items := range resourceList {
+		// the keys are the API versions of the resources followed by their kinds
+		separator := strings.LastIndex(key, "/")
+		if separator < 0 || key[separator+1:] != gvk.Kind {
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(key[:separator])
+		if err != nil || gv.Group != gvk.Group {
+			continue
+		}
+		if slices.Contains(items, item) {
+			return true
+		}
+	}
+	return false
+}
+
+// backupVolumeInfos returns the volume info of the backup, none for the backups taken before it
+// was recorded.
+func backupVolumeInfos(ctx context.Context, artifacts *output.BackupArtifacts) ([]*volume.BackupVolumeInfo, error) {
+	buf := new(bytes.Buffer)
+	if err := artifacts.Stream(ctx, velerov1api.DownloadTargetKindBackupVolumeInfos, buf); err != nil {
+		if err == downloadrequest.ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var volumeInfos []*volume.BackupVolumeInfo
+	if err := json.NewDecoder(buf).Decode(&volumeInfos); err != nil && err != io.EOF {
+		return nil, errors.Wrap(err, "error reading the backup volume info")
+	}
+	return volumeInfos, nil
+}
+
+// volumeClaims matches the PVCs of the volumes mounted by a resource, and its pods whose other
+// volumes were backed up by the file system backup.
+type volumeClaims struct {
+	namespace string
+	names     []string
+	// prefixes are the prefixes of the names of the PVCs created from the volume claim templates
+	// of a StatefulSet.
+	prefixes []string
+	// pod is the name of the resource when it's a pod, and podPrefix the prefix of the names of
+	// the pods of a workload.
+	pod       string
+	podPrefix string
+}
+
+// newVolumeClaims returns the volumeClaims of the PVCs the resource is, or its pods mount.
+func newVolumeClaims(obj *unstructured.Unstructured) *volumeClaims {
+	claims := &volumeClaims{}
+	if obj == nil {
+		return claims
+	}
+	claims.namespace = obj.GetNamespace()
+
+	switch obj.GetKind() {
+	case "PersistentVolumeClaim":
+		claims.names = append(claims.names, obj.GetName())
+		return claims
+	case "Pod":
+		claims.pod = obj.GetName()
+	default:
+		claims.podPrefix = obj.GetName() + "-"
+	}
+
+	for _, path := range [][]string{
+		{"spec", "volumes"},
+		{"spec", "template", "spec", "volumes"},
+		{"spec", "jobTemplate", "spec", "template", "spec", "volumes"},
+	} {
+		volumes, _, _ := unstructured.NestedSlice(obj.Object, path...)
+		for _, vol := range volumes {
+			volMap, ok := vol.(map[string]any)
+			if !ok {
+				continue
+			}
+			if claimName, _, _ := unstructured.NestedString(volMap, "persistentVolumeClaim", "claimName"); claimName != "" {
+				claims.names = append(claims.names, claimName)
+			}
+		}
+	}
+
+	templates, _, _ := unstructured.NestedSlice(obj.Object, "spec", "volumeClaimTemplates")
+	for _, template := range templates {
+		templateMap, ok := template.(map[string]any)
+		if !ok {
+			continue
+		}
+		if name, _, _ := unstructured.NestedString(templateMap, "metadata", "name"); name != "" {
+			claims.prefixes = append(claims.prefixes, fmt.Sprintf("%s-%s-", name, obj.GetName()))
+		}
+	}
+	return claims
+}
+
+// volume returns the name the volume is reported as, and whether it's a volume of the resource:
+// the name of its PVC, else the name of its pod and volume.
+func (c *volumeClaims) volume(info *volume.BackupVolumeInfo) (string, bool) {
+	if info.PVCName != "" {
+		return info.PVCName, c.match(info.PVCNamespace, info.PVCName)
+	}
+	if info.PVBInfo == nil || info.PVBInfo.PodNamespace != c.namespace || info.PVBInfo.PodName == "" {
+		return "", false
+	}
+	pod := info.PVBInfo.PodName
+	if pod != c.pod && (c.podPrefix == "" || !strings.HasPrefix(pod, c.podPrefix)) {
+		return "", false
+	}
+	return pod + "/" + info.PVBInfo.VolumeName, true
+}
+
+func (c *volumeClaims) match(namespace, name string) bool {
+	if name == "" || namespace != c.namespace {
+		return false
+	}
+	if slices.Contains(c.names, name) {
+		return true
+	}
+	for _, prefix := range c.prefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
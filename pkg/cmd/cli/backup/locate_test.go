@@ -0,0 +1,265 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/internal/volume"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	repomocks "github.com/vmware-tanzu/velero/pkg/repository/mocks"
+	"github.com/vmware-tanzu/velero/pkg/repository/provider"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestResourceListContains(t *testing.T) {
+	resourceList := map[string][]string{
+		"apps/v1/Deployment": {"payments/api", "payments/worker"},
+		"v1/Pod":             {"payments/api-1"},
+		"rbac.authorization.k8s.io/v1/ClusterRole": {"admin"},
+	}
+	deployment := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+
+	assert.True(t, resourceListContains(resourceList, deployment, "payments", "api"))
+	assert.False(t, resourceListContains(resourceList, deployment, "orders", "api"))
+	assert.False(t, resourceListContains(resourceList, schema.GroupVersionKind{Group: "extensions", Version: "v1beta1", Kind: "Deployment"}, "payments", "api"))
+	assert.True(t, resourceListContains(resourceList, schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"}, "", "admin"))
+}
+
+func TestVolumeClaims(t *testing.T) {
+	deployment := velerotest.UnstructuredOrDie(`
+	{
+		"apiVersion": "apps/v1",
+		"kind": "Deployment",
+		"metadata": {"namespace": "payments", "name": "api"},
+		"spec": {
+			"template": {
+				"spec": {
+					"volumes": [
+						{"name": "data", "persistentVolumeClaim": {"claimName": "api-data"}},
+						{"name": "tmp", "emptyDir": {}}
+					]
+				}
+			}
+		}
+	}
+	`)
+	claims := newVolumeClaims(deployment)
+	assert.True(t, claims.match("payments", "api-data"))
+	assert.False(t, claims.match("orders", "api-data"))
+	assert.False(t, claims.match("payments", "worker-data"))
+
+	statefulSet := velerotest.UnstructuredOrDie(`
+	{
+		"apiVersion": "apps/v1",
+		"kind": "StatefulSet",
+		"metadata": {"namespace": "payments", "name": "db"},
+		"spec": {
+			"volumeClaimTemplates": [
+				{"metadata": {"name": "data"}}
+			]
+		}
+	}
+	`)
+	claims = newVolumeClaims(statefulSet)
+	assert.True(t, claims.match("payments", "data-db-0"))
+	assert.False(t, claims.match("payments", "data-dbx-0"))
+
+	// the volumes of a resource which doesn't exist aren't known
+	assert.False(t, newVolumeClaims(nil).match("payments", "api-data"))
+}
+
+func TestVolumeClaimsVolume(t *testing.T) {
+	deployment := velerotest.UnstructuredOrDie(`
+	{
+		"apiVersion": "apps/v1",
+		"kind": "Deployment",
+		"metadata": {"namespace": "payments", "name": "api"},
+		"spec": {
+			"template": {
+				"spec": {
+					"volumes": [
+						{"name": "data", "persistentVolumeClaim": {"claimName": "api-data"}},
+						{"name": "tmp", "emptyDir": {}}
+					]
+				}
+			}
+		}
+	}
+	`)
+	claims := newVolumeClaims(deployment)
+
+	name, ok := claims.volume(&volume.BackupVolumeInfo{PVCNamespace: "payments", PVCName: "api-data"})
+	assert.True(t, ok)
+	assert.Equal(t, "api-data", name)
+
+	// the pod volumes without PVC are reported by pod
+	name, ok = claims.volume(&volume.BackupVolumeInfo{PVBInfo: &volume.PodVolumeInfo{PodNamespace: "payments", PodName: "api-7d9f-x2x4k", VolumeName: "tmp"}})
+	assert.True(t, ok)
+	assert.Equal(t, "api-7d9f-x2x4k/tmp", name)
+
+	_, ok = claims.volume(&volume.BackupVolumeInfo{PVBInfo: &volume.PodVolumeInfo{PodNamespace: "payments", PodName: "worker-5c8b-q9z7m", VolumeName: "tmp"}})
+	assert.False(t, ok)
+	_, ok = claims.volume(&volume.BackupVolumeInfo{PVBInfo: &volume.PodVolumeInfo{PodNamespace: "orders", PodName: "api-7d9f-x2x4k", VolumeName: "tmp"}})
+	assert.False(t, ok)
+}
+
+func TestReadBackupItem(t *testing.T) {
+	contents := velerotest.NewTarWriter(t).
+		AddItems("deployments.apps",
+			builder.ForDeployment("payments", "api").Result(),
+			builder.ForDeployment("payments", "worker").Result(),
+		).
+		Add("resources/deployments.apps/v1-preferredversion/namespaces/orders/api.json", builder.ForDeployment("orders", "api").Result()).
+		Done()
+
+	item, err := readBackupItem(bytes.NewReader(contents.Bytes()), "deployments.apps", "payments", "api")
+	require.NoError(t, err)
+	assert.Equal(t, "payments", item.GetNamespace())
+	assert.Equal(t, "api", item.GetName())
+
+	// only the directories without version are read
+	_, err = readBackupItem(bytes.NewReader(contents.Bytes()), "deployments.apps", "orders", "api")
+	assert.EqualError(t, err, "the resource isn't in the backup contents")
+}
+
+func newBackupRepository(name, volumeNamespace string, phase velerov1api.BackupRepositoryPhase) *velerov1api.BackupRepository {
+	return &velerov1api.BackupRepository{
+		ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: name},
+		Spec: velerov1api.BackupRepositorySpec{
+			VolumeNamespace:       volumeNamespace,
+			BackupStorageLocation: "default",
+			RepositoryType:        velerov1api.BackupRepositoryTypeKopia,
+		},
+		Status: velerov1api.BackupRepositoryStatus{Phase: phase},
+	}
+}
+
+func TestVolumeRestorability(t *testing.T) {
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").Result()
+	repoManager := new(repomocks.Manager)
+	repoManager.On("ListSnapshots", mock.Anything, mock.MatchedBy(func(repo *velerov1api.BackupRepository) bool {
+		return repo.Name == "payments-default-kopia"
+	})).Return([]provider.Snapshot{{ID: "snap-3"}, {ID: "snap-6"}}, nil).Once()
+	o := &LocateOptions{
+		client: velerotest.NewFakeControllerRuntimeClient(t,
+			newBackupRepository("payments-default-kopia", "payments", velerov1api.BackupRepositoryPhaseReady),
+			newBackupRepository("orders-default-kopia", "orders", velerov1api.BackupRepositoryPhaseNotReady),
+		),
+		repoManager: repoManager,
+	}
+
+	tests := []struct {
+		name       string
+		info       *volume.BackupVolumeInfo
+		restorable bool
+		status     string
+	}{
+		{
+			name:   "a skipped volume isn't restorable",
+			info:   &volume.BackupVolumeInfo{PVCNamespace: "payments", Skipped: true, SkippedReason: "skipped by the volume policies"},
+			status: "skipped (skipped by the volume policies)",
+		},
+		{
+			name: "a native snapshot is restorable",
+			info: &volume.BackupVolumeInfo{
+				PVCNamespace:       "payments",
+				BackupMethod:       volume.NativeSnapshot,
+				NativeSnapshotInfo: &volume.NativeSnapshotInfo{SnapshotHandle: "snap-1", Phase: volume.SnapshotPhaseCompleted},
+			},
+			restorable: true,
+			status:     "native snapshot snap-1",
+		},
+		{
+			name: "a CSI snapshot is restorable",
+			info: &volume.BackupVolumeInfo{
+				PVCNamespace:    "payments",
+				BackupMethod:    volume.CSISnapshot,
+				CSISnapshotInfo: &volume.CSISnapshotInfo{SnapshotHandle: "snap-0", Size: 10 * 1024 * 1024 * 1024},
+			},
+			restorable: true,
+			status:     "CSI snapshot snap-0 (10Gi)",
+		},
+		{
+			name: "a CSI snapshot not ready to use isn't restorable",
+			info: &volume.BackupVolumeInfo{
+				PVCNamespace:    "payments",
+				BackupMethod:    volume.CSISnapshot,
+				CSISnapshotInfo: &volume.CSISnapshotInfo{SnapshotHandle: "snap-2", ReadyToUse: new(bool)},
+			},
+			status: "the CSI snapshot snap-2 isn't ready to use",
+		},
+		{
+			name: "the data moved to a ready repository is restorable",
+			info: &volume.BackupVolumeInfo{
+				PVCNamespace:             "payments",
+				BackupMethod:             volume.CSISnapshot,
+				SnapshotDataMoved:        true,
+				SnapshotDataMovementInfo: &volume.SnapshotDataMovementInfo{SnapshotHandle: "snap-3", UploaderType: "kopia", Size: 512 * 1024 * 1024},
+			},
+			restorable: true,
+			status:     "data moved as snapshot snap-3 (512Mi) in the backup repository payments-default-kopia",
+		},
+		{
+			name: "the file system backup missing from its repository isn't restorable",
+			info: &volume.BackupVolumeInfo{
+				PVCNamespace: "payments",
+				BackupMethod: volume.PodVolumeBackup,
+				PVBInfo:      &volume.PodVolumeInfo{SnapshotHandle: "snap-7", UploaderType: "kopia", PodNamespace: "payments"},
+			},
+			status: "file system backup snap-7, missing from the backup repository payments-default-kopia",
+		},
+		{
+			name: "the file system backup to a repository not ready isn't restorable",
+			info: &volume.BackupVolumeInfo{
+				PVCNamespace: "orders",
+				BackupMethod: volume.PodVolumeBackup,
+				PVBInfo:      &volume.PodVolumeInfo{SnapshotHandle: "snap-4", UploaderType: "kopia", PodNamespace: "orders"},
+			},
+			status: "file system backup snap-4, the backup repository orders-default-kopia is NotReady",
+		},
+		{
+			name: "the file system backup without a repository isn't restorable",
+			info: &volume.BackupVolumeInfo{
+				PVCNamespace: "billing",
+				BackupMethod: volume.PodVolumeBackup,
+				PVBInfo:      &volume.PodVolumeInfo{SnapshotHandle: "snap-5", UploaderType: "kopia", PodNamespace: "billing"},
+			},
+			status: "file system backup snap-5, no backup repository",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			restorable, status := o.volumeRestorability(context.Background(), backup, test.info)
+			assert.Equal(t, test.restorable, restorable)
+			assert.Equal(t, test.status, status)
+		})
+	}
+
+	// the snapshots of a repository are listed once
+	repoManager.AssertExpectations(t)
+}
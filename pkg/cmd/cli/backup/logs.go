@@ -25,11 +25,14 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/completion"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
 )
 
@@ -64,7 +67,7 @@ func (l *LogsOptions) Run(c *cobra.Command, f client.Factory) error {
 	backup := new(velerov1api.Backup)
 	err := l.Client.Get(context.TODO(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: l.BackupName}, backup)
 	if apierrors.IsNotFound(err) {
-		return fmt.Errorf("backup %q does not exist", l.BackupName)
+		return l.retainedLogs(f.Namespace())
 	} else if err != nil {
 		return fmt.Errorf("error checking for backup %q: %v", l.BackupName, err)
 	}
@@ -77,10 +80,36 @@ func (l *LogsOptions) Run(c *cobra.Command, f client.Factory) error {
 			"until the backup has a phase of Completed or Failed and try again", l.BackupName)
 	}
 
+	if expired := meta.FindStatusCondition(backup.Status.Conditions, velerov1api.ConditionTypeLogsExpired); expired != nil && expired.Status == metav1.ConditionTrue {
+		return fmt.Errorf("logs for backup %q are no longer available: %s", l.BackupName, expired.Message)
+	}
+
 	err = downloadrequest.Stream(context.Background(), l.Client, f.Namespace(), l.BackupName, velerov1api.DownloadTargetKindBackupLog, os.Stdout, l.Timeout, l.InsecureSkipTLSVerify, l.CaCertFile)
 	return err
 }
 
+// retainedLogs streams the logs of the deleted backup kept by its location, when a location keeps
+// the logs of its backups longer than them.
+func (l *LogsOptions) retainedLogs(namespace string) error {
+	locations := new(velerov1api.BackupStorageLocationList)
+	if err := l.Client.List(context.TODO(), locations, kbclient.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("error listing backup storage locations: %v", err)
+	}
+	kept := false
+	for _, location := range locations.Items {
+		kept = kept || location.Spec.BackupLogsTTL != nil
+	}
+	if !kept {
+		return fmt.Errorf("backup %q does not exist", l.BackupName)
+	}
+
+	err := downloadrequest.Stream(context.Background(), l.Client, namespace, l.BackupName, velerov1api.DownloadTargetKindBackupLog, os.Stdout, l.Timeout, l.InsecureSkipTLSVerify, l.CaCertFile)
+	if err != nil {
+		return fmt.Errorf("backup %q does not exist, and its logs can't be downloaded from the backup storage locations keeping the logs of the deleted backups: %v", l.BackupName, err)
+	}
+	return nil
+}
+
 func (l *LogsOptions) Complete(args []string, f client.Factory) error {
 	if len(args) > 0 {
 		l.BackupName = args[0]
@@ -98,9 +127,10 @@ func NewLogsCommand(f client.Factory) *cobra.Command {
 	l := NewLogsOptions()
 
 	c := &cobra.Command{
-		Use:   "logs BACKUP",
-		Short: "Get backup logs",
-		Args:  cobra.ExactArgs(1),
+		Use:               "logs BACKUP",
+		ValidArgsFunction: completion.SingleArg(completion.BackupNames(f)),
+		Short:             "Get backup logs",
+		Args:              cobra.ExactArgs(1),
 		Run: func(c *cobra.Command, args []string) {
 			err := l.Complete(args, f)
 			cmd.CheckError(err)
@@ -33,6 +33,7 @@ import (
 	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
 	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/conditions"
 )
 
 func TestNewLogsCommand(t *testing.T) {
@@ -82,6 +83,27 @@ func TestNewLogsCommand(t *testing.T) {
 		require.ErrorContains(t, err, fmt.Sprintf("logs for backup \"%s\" are not available until it's finished processing", backupName))
 	})
 
+	t.Run("Backup logs expired test", func(t *testing.T) {
+		backupName := "bk-logs-expired"
+
+		f := &factorymocks.Factory{}
+
+		kbClient := velerotest.NewFakeControllerRuntimeClient(t)
+		backup := builder.ForBackup(cmdtest.VeleroNameSpace, backupName).Phase(velerov1api.BackupPhaseCompleted).Result()
+		conditions.SetBackupLogsExpiredCondition(backup, time.Now(), "Backup logs expired at 2026-01-02T03:04:05Z")
+		err := kbClient.Create(context.Background(), backup, &kbclient.CreateOptions{})
+		require.NoError(t, err)
+
+		f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+		f.On("KubebuilderClient").Return(kbClient, nil)
+
+		l := NewLogsOptions()
+		require.NoError(t, l.Complete([]string{backupName}, f))
+
+		err = l.Run(NewLogsCommand(f), f)
+		require.EqualError(t, err, `logs for backup "bk-logs-expired" are no longer available: Backup logs expired at 2026-01-02T03:04:05Z`)
+	})
+
 	t.Run("Backup not exist test", func(t *testing.T) {
 		backupName := "not-exist"
 		// create a factory
@@ -109,6 +131,24 @@ func TestNewLogsCommand(t *testing.T) {
 		c.Execute()
 	})
 
+	t.Run("Deleted backup without kept logs test", func(t *testing.T) {
+		f := &factorymocks.Factory{}
+
+		kbClient := velerotest.NewFakeControllerRuntimeClient(t,
+			builder.ForBackupStorageLocation(cmdtest.VeleroNameSpace, "default").Result(),
+		)
+
+		f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+		f.On("KubebuilderClient").Return(kbClient, nil)
+
+		l := NewLogsOptions()
+		require.NoError(t, l.Complete([]string{"deleted"}, f))
+
+		// no location keeps the logs of the deleted backups
+		err := l.Run(NewLogsCommand(f), f)
+		require.EqualError(t, err, `backup "deleted" does not exist`)
+	})
+
 	t.Run("Normal backup log test", func(t *testing.T) {
 		backupName := "bk-logs-1"
 
@@ -0,0 +1,167 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/completion"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+// failedNamespaces is the value of the --namespaces flag selecting the namespaces
+// whose backup failed.
+const failedNamespaces = "failed"
+
+func NewRetryCommand(f client.Factory, use string) *cobra.Command {
+	o := NewRetryOptions()
+
+	c := &cobra.Command{
+		Use:               use + " NAME",
+		ValidArgsFunction: completion.SingleArg(completion.BackupNames(f)),
+		Short:             "Retry the backup of namespaces of a backup",
+		Long: `Create a backup of some of the namespaces of an existing backup, with the same spec otherwise.
+
+By default, the namespaces retried are the ones whose backup failed in the namespace-phased backup NAME.`,
+		Args: cobra.ExactArgs(1),
+		Example: `  # Retry the namespaces whose backup failed in backup-1.
+  velero backup retry backup-1
+
+  # Retry the backup of namespaces ns1 and ns2 of backup-1.
+  velero backup retry backup-1 --namespaces ns1,ns2`,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Validate(c, args, f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type RetryOptions struct {
+	BackupName string
+	Name       string
+	Namespaces []string
+
+	client kbclient.Client
+}
+
+func NewRetryOptions() *RetryOptions {
+	return &RetryOptions{
+		Namespaces: []string{failedNamespaces},
+	}
+}
+
+func (o *RetryOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.Name, "name", o.Name, "Name of the backup to create. Defaults to NAME-retry-<timestamp>.")
+	flags.StringSliceVar(&o.Namespaces, "namespaces", o.Namespaces, "Namespaces to retry the backup of, or 'failed' for the namespaces whose backup failed in the namespace-phased backup NAME.")
+}
+
+func (o *RetryOptions) Complete(args []string, f client.Factory) error {
+	o.BackupName = args[0]
+	if o.Name == "" {
+		o.Name = fmt.Sprintf("%s-retry-%s", o.BackupName, time.Now().UTC().Format("20060102150405"))
+	}
+
+	client, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+	o.client = client
+
+	return nil
+}
+
+func (o *RetryOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
+	if len(o.Namespaces) == 0 {
+		return errors.New("--namespaces must list at least one namespace")
+	}
+
+	if len(o.Namespaces) > 1 {
+		for _, ns := range o.Namespaces {
+			if ns == failedNamespaces {
+				return errors.Errorf("--namespaces=%s can't be combined with other namespaces", failedNamespaces)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (o *RetryOptions) Run(c *cobra.Command, f client.Factory) error {
+	original := new(velerov1api.Backup)
+	if err := o.client.Get(context.TODO(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: o.BackupName}, original); err != nil {
+		return errors.Wrapf(err, "error getting backup %s", o.BackupName)
+	}
+
+	backup, err := o.BuildBackup(original)
+	if err != nil {
+		return err
+	}
+
+	if err := o.client.Create(context.TODO(), backup); err != nil {
+		return errors.WithStack(err)
+	}
+
+	fmt.Printf("Backup request %q submitted successfully, retrying namespaces %v of backup %q.\n", backup.Name, backup.Spec.IncludedNamespaces, o.BackupName)
+	fmt.Printf("Run `velero backup describe %s` or `velero backup logs %s` for more details.\n", backup.Name, backup.Name)
+	return nil
+}
+
+// BuildBackup returns the backup retrying the selected namespaces of original.
+func (o *RetryOptions) BuildBackup(original *velerov1api.Backup) (*velerov1api.Backup, error) {
+	namespaces := o.Namespaces
+	if len(namespaces) == 1 && namespaces[0] == failedNamespaces {
+		if !boolptr.IsSetToTrue(original.Spec.NamespacePhased) {
+			return nil, errors.Errorf("backup %s isn't namespace-phased, list the namespaces to retry with --namespaces", original.Name)
+		}
+
+		namespaces = nil
+		for _, result := range original.Status.NamespaceResults {
+			if result.Phase == velerov1api.NamespaceResultPhaseFailed {
+				namespaces = append(namespaces, result.Namespace)
+			}
+		}
+		if len(namespaces) == 0 {
+			return nil, errors.Errorf("no namespace failed in backup %s", original.Name)
+		}
+	}
+
+	backup := builder.ForBackup(original.Namespace, o.Name).
+		ObjectMeta(builder.WithLabels(velerov1api.RetryOfBackupLabel, label.GetValidName(original.Name))).
+		Result()
+	backup.Spec = *original.Spec.DeepCopy()
+	backup.Spec.IncludedNamespaces = namespaces
+	backup.Spec.ExcludedNamespaces = nil
+
+	return backup, nil
+}
@@ -0,0 +1,108 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
+)
+
+func TestRetryBuildBackup(t *testing.T) {
+	phased := builder.ForBackup(cmdtest.VeleroNameSpace, "backup-1").
+		IncludedNamespaces("*").
+		ExcludedNamespaces("kube-system").
+		StorageLocation("default").
+		NamespacePhased(true).
+		Result()
+	phased.Status.NamespaceResults = []velerov1api.NamespaceResult{
+		{Namespace: "ns-1", Phase: velerov1api.NamespaceResultPhaseCompleted},
+		{Namespace: "ns-2", Phase: velerov1api.NamespaceResultPhaseFailed},
+		{Namespace: "ns-3", Phase: velerov1api.NamespaceResultPhaseFailed},
+	}
+
+	tests := []struct {
+		name               string
+		original           *velerov1api.Backup
+		namespaces         []string
+		expectedNamespaces []string
+		expectedErr        string
+	}{
+		{
+			name:               "failed namespaces",
+			original:           phased,
+			namespaces:         []string{failedNamespaces},
+			expectedNamespaces: []string{"ns-2", "ns-3"},
+		},
+		{
+			name:               "listed namespaces",
+			original:           phased,
+			namespaces:         []string{"ns-1"},
+			expectedNamespaces: []string{"ns-1"},
+		},
+		{
+			name:        "failed namespaces of a backup not namespace-phased",
+			original:    builder.ForBackup(cmdtest.VeleroNameSpace, "backup-1").Result(),
+			namespaces:  []string{failedNamespaces},
+			expectedErr: "backup backup-1 isn't namespace-phased, list the namespaces to retry with --namespaces",
+		},
+		{
+			name:        "no failed namespace",
+			original:    builder.ForBackup(cmdtest.VeleroNameSpace, "backup-1").NamespacePhased(true).Result(),
+			namespaces:  []string{failedNamespaces},
+			expectedErr: "no namespace failed in backup backup-1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			o := NewRetryOptions()
+			o.Name = "backup-1-retry"
+			o.Namespaces = test.namespaces
+
+			backup, err := o.BuildBackup(test.original)
+			if test.expectedErr != "" {
+				require.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, "backup-1-retry", backup.Name)
+			assert.Equal(t, "backup-1", backup.Labels[velerov1api.RetryOfBackupLabel])
+			assert.Equal(t, test.expectedNamespaces, backup.Spec.IncludedNamespaces)
+			assert.Empty(t, backup.Spec.ExcludedNamespaces)
+			assert.Equal(t, "default", backup.Spec.StorageLocation)
+			assert.Empty(t, backup.Status)
+		})
+	}
+}
+
+func TestRetryValidate(t *testing.T) {
+	o := NewRetryOptions()
+	require.NoError(t, o.Validate(nil, nil, nil))
+
+	o.Namespaces = []string{failedNamespaces, "ns-1"}
+	require.Error(t, o.Validate(nil, nil, nil))
+
+	o.Namespaces = nil
+	require.Error(t, o.Validate(nil, nil, nil))
+}
@@ -21,12 +21,14 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -73,18 +75,60 @@ type CreateOptions struct {
 	Labels                                flag.Map
 	CACertFile                            string
 	AccessMode                            *flag.Enum
+	StorageBudget                         string
+	BackupLogsTTL                         time.Duration
+	ObjectTagging                         bool
+	StorageLayout                         *flag.Enum
+	Tenant                                string
+	Cluster                               string
+	UploadVerification                    *flag.Enum
+	UploadMaxRetries                      int
+	UploadProviderValidation              bool
+	ProbeLatencyThreshold                 time.Duration
+	ProbeFailureThreshold                 int32
+	FailoverLocations                     []string
+	EncryptionKey                         flag.Map
+	EncryptionKeyProvider                 *flag.Enum
+	EncryptionKeyID                       string
+	SigningKey                            flag.Map
+	ObjectLockMode                        *flag.Enum
 }
 
 func NewCreateOptions() *CreateOptions {
 	return &CreateOptions{
-		Credential: flag.NewMap(),
-		Config:     flag.NewMap(),
-		Labels:     flag.NewMap(),
+		Credential:    flag.NewMap(),
+		Config:        flag.NewMap(),
+		Labels:        flag.NewMap(),
+		EncryptionKey: flag.NewMap(),
+		SigningKey:    flag.NewMap(),
 		AccessMode: flag.NewEnum(
 			string(velerov1api.BackupStorageLocationAccessModeReadWrite),
 			string(velerov1api.BackupStorageLocationAccessModeReadWrite),
 			string(velerov1api.BackupStorageLocationAccessModeReadOnly),
 		),
+		StorageLayout: flag.NewEnum(
+			string(velerov1api.StorageLayoutV1),
+			string(velerov1api.StorageLayoutV1),
+			string(velerov1api.StorageLayoutV2),
+		),
+		UploadVerification: flag.NewEnum(
+			"",
+			string(velerov1api.ChecksumAlgorithmCRC32C),
+			string(velerov1api.ChecksumAlgorithmSHA256),
+		),
+		EncryptionKeyProvider: flag.NewEnum(
+			string(velerov1api.EncryptionKeyProviderSecret),
+			string(velerov1api.EncryptionKeyProviderSecret),
+			string(velerov1api.EncryptionKeyProviderAWSKMS),
+			string(velerov1api.EncryptionKeyProviderAzureKeyVault),
+			string(velerov1api.EncryptionKeyProviderGCPKMS),
+		),
+		ObjectLockMode: flag.NewEnum(
+			"",
+			string(velerov1api.ObjectLockModeGovernance),
+			string(velerov1api.ObjectLockModeCompliance),
+		),
+		UploadMaxRetries: 3,
 	}
 }
 
@@ -92,6 +136,8 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.Provider, "provider", o.Provider, "Name of the backup storage provider (e.g. aws, azure, gcp).")
 	flags.StringVar(&o.Bucket, "bucket", o.Bucket, "Name of the object storage bucket where backups should be stored.")
 	flags.Var(&o.Credential, "credential", "The credential to be used by this location as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. Optional, one value only.")
+	flags.Var(&o.EncryptionKey, "encryption-key", "The key encrypting the backup tarballs, the backup metadata and the logs written to this location as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret holding a base64-encoded 256-bit key. Optional, one value only.")
+	flags.Var(&o.SigningKey, "signing-key", "The key signing the files of the backups written to this location as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret holding the base64-encoded 32-byte seed of an Ed25519 private key. Optional, one value only.")
 	flags.BoolVar(&o.DefaultBackupStorageLocation, "default", o.DefaultBackupStorageLocation, "Sets this new location to be the new default backup storage location. Optional.")
 	flags.StringVar(&o.Prefix, "prefix", o.Prefix, "Prefix under which all Velero data should be stored within the bucket. Optional.")
 	flags.DurationVar(&o.BackupSyncPeriod, "backup-sync-period", o.BackupSyncPeriod, "How often to ensure all Velero backups in object storage exist as Backup API objects in the cluster. Optional. Set this to `0s` to disable sync. Default: 1 minute.")
@@ -99,11 +145,42 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.Var(&o.Config, "config", "Configuration key-value pairs.")
 	flags.Var(&o.Labels, "labels", "Labels to apply to the backup storage location.")
 	flags.StringVar(&o.CACertFile, "cacert", o.CACertFile, "File containing a certificate bundle to use when verifying TLS connections to the object store. Optional.")
+	flags.BoolVar(&o.ObjectTagging, "object-tagging", o.ObjectTagging, "Pass the tags of the backups to the object store plugin, under the \"tagging\" config key, to be set on the objects. Only for the plugins supporting that key. Optional.")
+	flags.StringVar(&o.StorageBudget, "storage-budget", o.StorageBudget, "The most storage the backups in the location may use, e.g. 2Ti. When exceeded, the oldest backups are deleted before their TTL expires. Optional.")
+	flags.DurationVar(&o.BackupLogsTTL, "backup-logs-ttl", o.BackupLogsTTL, "How long the logs and results of the backups in the location are kept after the backups started, independently of the TTL of the backups, e.g. 8760h0m0s. Optional. Default: the logs are deleted with the backups.")
 	flags.Var(
 		o.AccessMode,
 		"access-mode",
 		fmt.Sprintf("Access mode for the backup storage location. Valid values are %s", strings.Join(o.AccessMode.AllowedValues(), ",")),
 	)
+	flags.Var(
+		o.StorageLayout,
+		"storage-layout",
+		fmt.Sprintf("Layout of the files under the prefix. v2 stores them under a per-tenant and per-cluster prefix, with an index object listing the backups. Valid values are %s", strings.Join(o.StorageLayout.AllowedValues(), ",")),
+	)
+	flags.StringVar(&o.Tenant, "tenant", o.Tenant, "Tenant the files are stored for with the v2 storage layout. Optional. Default: default.")
+	flags.StringVar(&o.Cluster, "cluster", o.Cluster, "Cluster the files are stored for with the v2 storage layout. Optional. Default: default.")
+	flags.Var(
+		o.UploadVerification,
+		"upload-verification",
+		fmt.Sprintf("Checksum algorithm verifying the objects uploaded to the location, which are uploaded again when their checksum doesn't match. Optional. Valid values are %s", strings.Join(o.UploadVerification.AllowedValues(), ",")),
+	)
+	flags.Var(
+		o.ObjectLockMode,
+		"object-lock-mode",
+		fmt.Sprintf("Mode of the locks of the objects of the backups written to the location, locked until the backups expire. Requires a bucket with object locks enabled and an object store plugin supporting them. Optional. Valid values are %s", strings.Join(o.ObjectLockMode.AllowedValues(), ",")),
+	)
+	flags.Var(
+		o.EncryptionKeyProvider,
+		"encryption-key-provider",
+		fmt.Sprintf("Provider of the key encrypting the files written to this location. The Secret provider uses --encryption-key, the key management services use --encryption-key-id. Optional. Valid values are %s", strings.Join(o.EncryptionKeyProvider.AllowedValues(), ",")),
+	)
+	flags.StringVar(&o.EncryptionKeyID, "encryption-key-id", o.EncryptionKeyID, "The key of the key management service encrypting the files written to this location: the ARN of the AWS KMS key, the URL of the Azure Key Vault key, or the resource name of the GCP KMS crypto key. Requires --encryption-key-provider AWSKMS, AzureKeyVault or GCPKMS.")
+	flags.IntVar(&o.UploadMaxRetries, "upload-max-retries", o.UploadMaxRetries, "How many times an object whose checksum doesn't match is uploaded again. Requires --upload-verification.")
+	flags.DurationVar(&o.ProbeLatencyThreshold, "probe-latency-threshold", o.ProbeLatencyThreshold, "The longest a validation of the location may take, the slower validations failing. Optional. Default: no threshold.")
+	flags.Int32Var(&o.ProbeFailureThreshold, "probe-failure-threshold", o.ProbeFailureThreshold, "The number of validations in a row which must fail for the location to be marked unavailable. Optional. Default: 1.")
+	flags.StringSliceVar(&o.FailoverLocations, "failover-locations", o.FailoverLocations, "The backup storage locations the scheduled backups targeting this location are written to while it's unavailable, the first available one being used. Optional.")
+	flags.BoolVar(&o.UploadProviderValidation, "upload-provider-validation", o.UploadProviderValidation, "Pass the checksum algorithm to the object store plugin, under the \"checksumAlgorithm\" config key, for the provider to validate the uploads. Only for the plugins supporting that key. Requires --upload-verification.")
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -123,10 +200,61 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--backup-sync-period must be non-negative")
 	}
 
+	if o.BackupLogsTTL < 0 {
+		return errors.New("--backup-logs-ttl must be non-negative")
+	}
+
 	if len(o.Credential.Data()) > 1 {
 		return errors.New("--credential can only contain 1 key/value pair")
 	}
 
+	if len(o.EncryptionKey.Data()) > 1 {
+		return errors.New("--encryption-key can only contain 1 key/value pair")
+	}
+
+	if o.EncryptionKeyProvider.String() == string(velerov1api.EncryptionKeyProviderSecret) {
+		if o.EncryptionKeyID != "" {
+			return errors.New("--encryption-key-id requires --encryption-key-provider AWSKMS, AzureKeyVault or GCPKMS")
+		}
+	} else {
+		if o.EncryptionKeyID == "" {
+			return errors.Errorf("--encryption-key-provider %s requires --encryption-key-id", o.EncryptionKeyProvider.String())
+		}
+		if len(o.EncryptionKey.Data()) > 0 {
+			return errors.New("--encryption-key requires --encryption-key-provider Secret")
+		}
+	}
+
+	if len(o.SigningKey.Data()) > 1 {
+		return errors.New("--signing-key can only contain 1 key/value pair")
+	}
+
+	if (o.Tenant != "" || o.Cluster != "") && o.StorageLayout.String() != string(velerov1api.StorageLayoutV2) {
+		return errors.New("--tenant and --cluster require --storage-layout=v2")
+	}
+
+	if o.UploadVerification.String() == "" && (c.Flags().Changed("upload-max-retries") || o.UploadProviderValidation) {
+		return errors.New("--upload-max-retries and --upload-provider-validation require --upload-verification")
+	}
+
+	if o.UploadMaxRetries < 0 {
+		return errors.New("--upload-max-retries must be non-negative")
+	}
+
+	if o.ProbeLatencyThreshold < 0 || o.ProbeFailureThreshold < 0 {
+		return errors.New("--probe-latency-threshold and --probe-failure-threshold must be non-negative")
+	}
+
+	if slices.Contains(o.FailoverLocations, o.Name) {
+		return errors.New("--failover-locations must not contain the location itself")
+	}
+
+	if o.StorageBudget != "" {
+		if _, err := resource.ParseQuantity(o.StorageBudget); err != nil {
+			return errors.Wrap(err, "invalid --storage-budget")
+		}
+	}
+
 	return nil
 }
 
@@ -163,9 +291,10 @@ func (o *CreateOptions) BuildBackupStorageLocation(namespace string, setBackupSy
 					CACert: caCertData,
 				},
 			},
-			Config:     o.Config.Data(),
-			Default:    o.DefaultBackupStorageLocation,
-			AccessMode: velerov1api.BackupStorageLocationAccessMode(o.AccessMode.String()),
+			Config:        o.Config.Data(),
+			Default:       o.DefaultBackupStorageLocation,
+			AccessMode:    velerov1api.BackupStorageLocationAccessMode(o.AccessMode.String()),
+			ObjectTagging: o.ObjectTagging,
 		},
 	}
 
@@ -177,11 +306,72 @@ func (o *CreateOptions) BuildBackupStorageLocation(namespace string, setBackupSy
 		backupStorageLocation.Spec.ValidationFrequency = &metav1.Duration{Duration: o.ValidationFrequency}
 	}
 
+	if o.StorageBudget != "" {
+		storageBudget, err := resource.ParseQuantity(o.StorageBudget)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid storage budget")
+		}
+		backupStorageLocation.Spec.StorageBudget = &storageBudget
+	}
+
+	if o.BackupLogsTTL > 0 {
+		backupStorageLocation.Spec.BackupLogsTTL = &metav1.Duration{Duration: o.BackupLogsTTL}
+	}
+
+	if o.StorageLayout.String() == string(velerov1api.StorageLayoutV2) {
+		backupStorageLocation.Spec.StorageLayout = &velerov1api.StorageLayout{
+			Version: velerov1api.StorageLayoutV2,
+			Tenant:  o.Tenant,
+			Cluster: o.Cluster,
+		}
+	}
+
+	if o.UploadVerification.String() != "" {
+		backupStorageLocation.Spec.UploadVerification = &velerov1api.UploadVerification{
+			Algorithm:          velerov1api.ChecksumAlgorithm(o.UploadVerification.String()),
+			MaxRetries:         &o.UploadMaxRetries,
+			ProviderValidation: o.UploadProviderValidation,
+		}
+	}
+
+	if o.ProbeLatencyThreshold > 0 || o.ProbeFailureThreshold > 0 {
+		backupStorageLocation.Spec.HealthProbe = &velerov1api.HealthProbe{
+			LatencyThreshold: metav1.Duration{Duration: o.ProbeLatencyThreshold},
+			FailureThreshold: o.ProbeFailureThreshold,
+		}
+	}
+	backupStorageLocation.Spec.FailoverLocations = o.FailoverLocations
+
 	for secretName, secretKey := range o.Credential.Data() {
 		backupStorageLocation.Spec.Credential = builder.ForSecretKeySelector(secretName, secretKey).Result()
 		break
 	}
 
+	for secretName, secretKey := range o.EncryptionKey.Data() {
+		backupStorageLocation.Spec.Encryption = &velerov1api.Encryption{
+			KeyProvider: velerov1api.EncryptionKeyProviderSecret,
+			Key:         builder.ForSecretKeySelector(secretName, secretKey).Result(),
+		}
+		break
+	}
+	if o.EncryptionKeyID != "" {
+		backupStorageLocation.Spec.Encryption = &velerov1api.Encryption{
+			KeyProvider: velerov1api.EncryptionKeyProvider(o.EncryptionKeyProvider.String()),
+			KeyID:       o.EncryptionKeyID,
+		}
+	}
+
+	for secretName, secretKey := range o.SigningKey.Data() {
+		backupStorageLocation.Spec.Signing = &velerov1api.Signing{
+			Key: *builder.ForSecretKeySelector(secretName, secretKey).Result(),
+		}
+		break
+	}
+
+	if mode := o.ObjectLockMode.String(); mode != "" {
+		backupStorageLocation.Spec.ObjectLock = &velerov1api.ObjectLock{Mode: velerov1api.ObjectLockMode(mode)}
+	}
+
 	return backupStorageLocation, nil
 }
 
@@ -29,6 +29,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
 	veleroflag "github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
@@ -86,6 +87,66 @@ func TestBuildBackupStorageLocationSetsCredential(t *testing.T) {
 	}, bsl.Spec.Credential)
 }
 
+func TestBuildBackupStorageLocationSetsEncryption(t *testing.T) {
+	o := NewCreateOptions()
+
+	bsl, err := o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Nil(t, bsl.Spec.Encryption)
+
+	assert.NoError(t, o.EncryptionKey.Set("encryption=key"))
+	bsl, err = o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, &velerov1api.Encryption{
+		KeyProvider: velerov1api.EncryptionKeyProviderSecret,
+		Key: &v1.SecretKeySelector{
+			LocalObjectReference: v1.LocalObjectReference{Name: "encryption"},
+			Key:                  "key",
+		},
+	}, bsl.Spec.Encryption)
+
+	o = NewCreateOptions()
+	assert.NoError(t, o.EncryptionKeyProvider.Set(string(velerov1api.EncryptionKeyProviderAWSKMS)))
+	o.EncryptionKeyID = "arn:aws:kms:us-east-1:111122223333:key/a-key"
+	bsl, err = o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, &velerov1api.Encryption{
+		KeyProvider: velerov1api.EncryptionKeyProviderAWSKMS,
+		KeyID:       "arn:aws:kms:us-east-1:111122223333:key/a-key",
+	}, bsl.Spec.Encryption)
+}
+
+func TestBuildBackupStorageLocationSetsSigning(t *testing.T) {
+	o := NewCreateOptions()
+
+	bsl, err := o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Nil(t, bsl.Spec.Signing)
+
+	assert.NoError(t, o.SigningKey.Set("signing=key"))
+	bsl, err = o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, &velerov1api.Signing{
+		Key: v1.SecretKeySelector{
+			LocalObjectReference: v1.LocalObjectReference{Name: "signing"},
+			Key:                  "key",
+		},
+	}, bsl.Spec.Signing)
+}
+
+func TestBuildBackupStorageLocationSetsObjectLock(t *testing.T) {
+	o := NewCreateOptions()
+
+	bsl, err := o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Nil(t, bsl.Spec.ObjectLock)
+
+	assert.NoError(t, o.ObjectLockMode.Set("Compliance"))
+	bsl, err = o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, &velerov1api.ObjectLock{Mode: velerov1api.ObjectLockModeCompliance}, bsl.Spec.ObjectLock)
+}
+
 func TestBuildBackupStorageLocationSetsLabels(t *testing.T) {
 	o := NewCreateOptions()
 
@@ -97,6 +158,60 @@ func TestBuildBackupStorageLocationSetsLabels(t *testing.T) {
 	assert.Equal(t, map[string]string{"key": "value"}, bsl.Labels)
 }
 
+func TestBuildBackupStorageLocationSetsStorageLayout(t *testing.T) {
+	o := NewCreateOptions()
+
+	bsl, err := o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Nil(t, bsl.Spec.StorageLayout)
+
+	assert.NoError(t, o.StorageLayout.Set("v2"))
+	o.Tenant = "tenant-1"
+	o.Cluster = "cluster-1"
+
+	bsl, err = o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, &velerov1api.StorageLayout{
+		Version: velerov1api.StorageLayoutV2,
+		Tenant:  "tenant-1",
+		Cluster: "cluster-1",
+	}, bsl.Spec.StorageLayout)
+}
+
+func TestBuildBackupStorageLocationSetsUploadVerification(t *testing.T) {
+	o := NewCreateOptions()
+
+	bsl, err := o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Nil(t, bsl.Spec.UploadVerification)
+
+	assert.NoError(t, o.UploadVerification.Set("CRC32C"))
+	o.UploadMaxRetries = 5
+	o.UploadProviderValidation = true
+
+	bsl, err = o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	maxRetries := 5
+	assert.Equal(t, &velerov1api.UploadVerification{
+		Algorithm:          velerov1api.ChecksumAlgorithmCRC32C,
+		MaxRetries:         &maxRetries,
+		ProviderValidation: true,
+	}, bsl.Spec.UploadVerification)
+}
+
+func TestBuildBackupStorageLocationSetsBackupLogsTTL(t *testing.T) {
+	o := NewCreateOptions()
+
+	bsl, err := o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Nil(t, bsl.Spec.BackupLogsTTL)
+
+	o.BackupLogsTTL = 8760 * time.Hour
+	bsl, err = o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, &metav1.Duration{Duration: 8760 * time.Hour}, bsl.Spec.BackupLogsTTL)
+}
+
 func TestCreateCommand_Run(t *testing.T) {
 	// create a factory
 	f := &factorymocks.Factory{}
@@ -31,6 +31,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/completion"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/confirm"
 )
 
@@ -39,8 +40,9 @@ func NewDeleteCommand(f client.Factory, use string) *cobra.Command {
 	o := cli.NewDeleteOptions("backup-location")
 
 	c := &cobra.Command{
-		Use:   fmt.Sprintf("%s [NAMES]", use),
-		Short: "Delete backup storage locations",
+		Use:               fmt.Sprintf("%s [NAMES]", use),
+		ValidArgsFunction: completion.BackupStorageLocationNames(f),
+		Short:             "Delete backup storage locations",
 		Example: `  # Delete a backup storage location named "backup-location-1".
   velero backup-location delete backup-location-1
 
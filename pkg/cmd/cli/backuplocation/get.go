@@ -27,6 +27,7 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/completion"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 )
 
@@ -35,8 +36,9 @@ func NewGetCommand(f client.Factory, use string) *cobra.Command {
 	var showDefaultOnly bool
 
 	c := &cobra.Command{
-		Use:   use,
-		Short: "Get backup storage locations",
+		Use:               use,
+		ValidArgsFunction: completion.BackupStorageLocationNames(f),
+		Short:             "Get backup storage locations",
 		Run: func(c *cobra.Command, args []string) {
 			err := output.ValidateFlags(c)
 			cmd.CheckError(err)
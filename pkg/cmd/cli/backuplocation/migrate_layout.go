@@ -0,0 +1,132 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backuplocation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/completion"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+)
+
+// NewMigrateLayoutCommand creates and returns a new cobra command for migrating a backup-location
+// to the v2 storage layout.
+func NewMigrateLayoutCommand(f client.Factory, use string) *cobra.Command {
+	o := NewMigrateLayoutOptions()
+
+	c := &cobra.Command{
+		Use:               use + " NAME",
+		ValidArgsFunction: completion.SingleArg(completion.BackupStorageLocationNames(f)),
+		Short:             "Migrate a backup storage location to the v2 storage layout",
+		Long: `Migrate a backup storage location to the v2 storage layout.
+
+The Velero server copies the backups, restores and repositories of the flat v1 layout under the
+prefix of the location into the per-tenant and per-cluster prefix of the v2 layout, which must be
+empty, and switches the location to the v2 storage layout once all the files were copied and
+verified. The copies are deleted if the migration fails, and the files of the v1 layout aren't
+deleted. The result of the migration is reported in the StorageLayoutMigrated condition of the
+location.`,
+		Example: `  # Migrate the backup storage location "default" to the v2 storage layout of the cluster "cluster-1".
+  velero backup-location migrate-layout default --tenant team-1 --cluster cluster-1`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type MigrateLayoutOptions struct {
+	Name    string
+	Tenant  string
+	Cluster string
+}
+
+func NewMigrateLayoutOptions() *MigrateLayoutOptions {
+	return &MigrateLayoutOptions{}
+}
+
+func (o *MigrateLayoutOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.Tenant, "tenant", o.Tenant, "Tenant the files are stored for with the v2 storage layout. Optional. Default: the tenant of the location, or default.")
+	flags.StringVar(&o.Cluster, "cluster", o.Cluster, "Cluster the files are stored for with the v2 storage layout. Optional. Default: the cluster of the location, or default.")
+}
+
+func (o *MigrateLayoutOptions) Complete(args []string, f client.Factory) error {
+	o.Name = args[0]
+	return nil
+}
+
+func (o *MigrateLayoutOptions) Run(c *cobra.Command, f client.Factory) error {
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+
+	location := &velerov1api.BackupStorageLocation{}
+	err = kbClient.Get(context.Background(), kbclient.ObjectKey{
+		Namespace: f.Namespace(),
+		Name:      o.Name,
+	}, location)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if persistence.UsesStorageLayoutV2(location) {
+		return errors.Errorf("backup storage location %q already uses the v2 storage layout", o.Name)
+	}
+
+	tenant, cluster := o.Tenant, o.Cluster
+	if layout := location.Spec.StorageLayout; layout != nil {
+		if tenant == "" {
+			tenant = layout.Tenant
+		}
+		if cluster == "" {
+			cluster = layout.Cluster
+		}
+	}
+	if tenant == "" {
+		tenant = "default"
+	}
+	if cluster == "" {
+		cluster = "default"
+	}
+
+	if location.Annotations == nil {
+		location.Annotations = make(map[string]string)
+	}
+	location.Annotations[velerov1api.MigrateStorageLayoutAnnotation] = tenant + "/" + cluster
+
+	if err := kbClient.Update(context.Background(), location, &kbclient.UpdateOptions{}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	fmt.Printf("Backup storage location %q is being migrated to the v2 storage layout. Run `velero backup-location get %s -o yaml` to check its StorageLayoutMigrated condition.\n", o.Name, o.Name)
+	return nil
+}
@@ -0,0 +1,71 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backuplocation
+
+import (
+	"context"
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestMigrateLayoutCommand_Run(t *testing.T) {
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "bsl-1").
+		StorageLayout(velerov1api.StorageLayoutV1, "tenant-1", "").Result()
+	kbClient := velerotest.NewFakeControllerRuntimeClient(t, location)
+
+	f := &factorymocks.Factory{}
+	f.On("Namespace").Return(velerov1api.DefaultNamespace)
+	f.On("KubebuilderClient").Return(kbClient, nil)
+
+	c := NewMigrateLayoutCommand(f, "migrate-layout")
+	flags := new(flag.FlagSet)
+	o := NewMigrateLayoutOptions()
+	o.BindFlags(flags)
+	require.NoError(t, flags.Parse([]string{"--cluster", "cluster-1"}))
+
+	require.NoError(t, o.Complete([]string{"bsl-1"}, f))
+	require.NoError(t, o.Run(c, f))
+
+	updated := &velerov1api.BackupStorageLocation{}
+	require.NoError(t, kbClient.Get(context.Background(), kbclient.ObjectKeyFromObject(location), updated))
+	// the location is only switched to the v2 storage layout once its files were copied
+	assert.Equal(t, velerov1api.StorageLayoutV1, updated.Spec.StorageLayout.Version)
+	assert.Equal(t, "tenant-1/cluster-1", updated.Annotations[velerov1api.MigrateStorageLayoutAnnotation])
+}
+
+func TestMigrateLayoutCommand_RunMigratedLocation(t *testing.T) {
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "bsl-1").
+		StorageLayout(velerov1api.StorageLayoutV2, "tenant-1", "cluster-1").Result()
+	kbClient := velerotest.NewFakeControllerRuntimeClient(t, location)
+
+	f := &factorymocks.Factory{}
+	f.On("Namespace").Return(velerov1api.DefaultNamespace)
+	f.On("KubebuilderClient").Return(kbClient, nil)
+
+	o := NewMigrateLayoutOptions()
+	require.NoError(t, o.Complete([]string{"bsl-1"}, f))
+	assert.EqualError(t, o.Run(NewMigrateLayoutCommand(f, "migrate-layout"), f), `backup storage location "bsl-1" already uses the v2 storage layout`)
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/completion"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 )
@@ -41,9 +42,10 @@ func NewSetCommand(f client.Factory, use string) *cobra.Command {
 	o := NewSetOptions()
 
 	c := &cobra.Command{
-		Use:   use + " NAME",
-		Short: "Set specific features for a backup storage location",
-		Args:  cobra.ExactArgs(1),
+		Use:               use + " NAME",
+		ValidArgsFunction: completion.SingleArg(completion.BackupStorageLocationNames(f)),
+		Short:             "Set specific features for a backup storage location",
+		Args:              cobra.ExactArgs(1),
 		Run: func(c *cobra.Command, args []string) {
 			cmd.CheckError(o.Complete(args, f))
 			cmd.CheckError(o.Validate(c, args, f))
@@ -0,0 +1,190 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package completion
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1api "k8s.io/api/core/v1"
+	storagev1api "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+)
+
+// listTimeout bounds the time spent querying the cluster for a completion, so an unreachable
+// cluster doesn't hang the shell.
+const listTimeout = 5 * time.Second
+
+// Func completes the arguments, or the value of a flag, of a command.
+type Func func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective)
+
+// BackupNames completes the names of the backups.
+func BackupNames(f client.Factory) Func {
+	return names(f, func() kbclient.ObjectList { return new(velerov1api.BackupList) }, true)
+}
+
+// RestoreNames completes the names of the restores.
+func RestoreNames(f client.Factory) Func {
+	return names(f, func() kbclient.ObjectList { return new(velerov1api.RestoreList) }, true)
+}
+
+// ScheduleNames completes the names of the schedules.
+func ScheduleNames(f client.Factory) Func {
+	return names(f, func() kbclient.ObjectList { return new(velerov1api.ScheduleList) }, true)
+}
+
+// BackupStorageLocationNames completes the names of the backup storage locations.
+func BackupStorageLocationNames(f client.Factory) Func {
+	return names(f, func() kbclient.ObjectList { return new(velerov1api.BackupStorageLocationList) }, true)
+}
+
+// VolumeSnapshotLocationNames completes the names of the volume snapshot locations.
+func VolumeSnapshotLocationNames(f client.Factory) Func {
+	return names(f, func() kbclient.ObjectList { return new(velerov1api.VolumeSnapshotLocationList) }, true)
+}
+
+// NamespaceNames completes the names of the namespaces of the cluster.
+func NamespaceNames(f client.Factory) Func {
+	return names(f, func() kbclient.ObjectList { return new(corev1api.NamespaceList) }, false)
+}
+
+// StorageClassNames completes the names of the storage classes of the cluster.
+func StorageClassNames(f client.Factory) Func {
+	return names(f, func() kbclient.ObjectList { return new(storagev1api.StorageClassList) }, false)
+}
+
+// SingleArg completes only the first argument of a command taking a single name.
+func SingleArg(fn Func) Func {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return fn(cmd, args, toComplete)
+	}
+}
+
+// names completes the names of the objects of a list, in the Velero namespace if inVeleroNamespace.
+// The names already given as arguments are left out, and a value being completed in a
+// comma-separated list is completed after its last comma.
+func names(f client.Factory, newList func() kbclient.ObjectList, inVeleroNamespace bool) Func {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		kbClient, err := f.KubebuilderClient()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), listTimeout)
+		defer cancel()
+
+		list := newList()
+		var opts []kbclient.ListOption
+		if inVeleroNamespace {
+			opts = append(opts, kbclient.InNamespace(f.Namespace()))
+		}
+		if err := kbClient.List(ctx, list, opts...); err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+
+		var all []string
+		if err := meta.EachListItem(list, func(obj runtime.Object) error {
+			if accessor, ok := obj.(metav1.Object); ok {
+				all = append(all, accessor.GetName())
+			}
+			return nil
+		}); err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+
+		return filter(all, args, toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// filter returns the sorted names starting with the value being completed and not already given.
+func filter(all []string, args []string, toComplete string) []string {
+	given := make(map[string]struct{})
+	for _, arg := range args {
+		given[arg] = struct{}{}
+	}
+
+	prefix, value := "", toComplete
+	if i := strings.LastIndex(toComplete, ","); i >= 0 {
+		prefix, value = toComplete[:i+1], toComplete[i+1:]
+		for _, item := range strings.Split(toComplete[:i], ",") {
+			given[item] = struct{}{}
+		}
+	}
+
+	var completions []string
+	for _, name := range all {
+		if _, ok := given[name]; ok {
+			continue
+		}
+		if strings.HasPrefix(name, value) {
+			completions = append(completions, prefix+name)
+		}
+	}
+	sort.Strings(completions)
+	return completions
+}
+
+// flagCompletions are the completions of the flags, by flag name, taking the names of
+// Velero objects or cluster resources.
+func flagCompletions(f client.Factory) map[string]Func {
+	return map[string]Func{
+		"namespace":                 NamespaceNames(f),
+		"include-namespaces":        NamespaceNames(f),
+		"exclude-namespaces":        NamespaceNames(f),
+		"backup":                    BackupNames(f),
+		"restore":                   RestoreNames(f),
+		"from-backup":               BackupNames(f),
+		"from-schedule":             ScheduleNames(f),
+		"storage-location":          BackupStorageLocationNames(f),
+		"volume-snapshot-locations": VolumeSnapshotLocationNames(f),
+		"storage-class":             StorageClassNames(f),
+	}
+}
+
+// RegisterFlagCompletions registers the completions of the flags of cmd and all its
+// subcommands taking the names of Velero objects or cluster resources.
+func RegisterFlagCompletions(cmd *cobra.Command, f client.Factory) {
+	completions := flagCompletions(f)
+
+	var register func(c *cobra.Command)
+	register = func(c *cobra.Command) {
+		for name, fn := range completions {
+			// the persistent flags are registered on the command defining them only
+			if c.LocalNonPersistentFlags().Lookup(name) == nil && c.PersistentFlags().Lookup(name) == nil {
+				continue
+			}
+			// an error means the completion is already registered, which is fine
+			_ = c.RegisterFlagCompletionFunc(name, fn)
+		}
+		for _, sub := range c.Commands() {
+			register(sub)
+		}
+	}
+	register(cmd)
+}
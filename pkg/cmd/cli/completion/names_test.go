@@ -0,0 +1,121 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package completion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
+	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestNames(t *testing.T) {
+	client := velerotest.NewFakeControllerRuntimeClient(t)
+	for _, obj := range []kbclient.Object{
+		builder.ForBackup(cmdtest.VeleroNameSpace, "backup-1").Result(),
+		builder.ForBackup(cmdtest.VeleroNameSpace, "backup-2").Result(),
+		builder.ForBackup(cmdtest.VeleroNameSpace, "nightly").Result(),
+		builder.ForBackup("other", "backup-3").Result(),
+		builder.ForNamespace("ns-1").Result(),
+		builder.ForNamespace("ns-2").Result(),
+		builder.ForStorageClass("gp2").Result(),
+	} {
+		require.NoError(t, client.Create(context.Background(), obj))
+	}
+
+	f := &factorymocks.Factory{}
+	f.On("KubebuilderClient").Return(client, nil)
+	f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+
+	tests := []struct {
+		name       string
+		fn         Func
+		args       []string
+		toComplete string
+		expected   []string
+	}{
+		{
+			name:     "backups of the Velero namespace",
+			fn:       BackupNames(f),
+			expected: []string{"backup-1", "backup-2", "nightly"},
+		},
+		{
+			name:       "backups starting with the value being completed",
+			fn:         BackupNames(f),
+			toComplete: "back",
+			expected:   []string{"backup-1", "backup-2"},
+		},
+		{
+			name:     "backups already given are left out",
+			fn:       BackupNames(f),
+			args:     []string{"backup-1"},
+			expected: []string{"backup-2", "nightly"},
+		},
+		{
+			name: "single argument already given",
+			fn:   SingleArg(BackupNames(f)),
+			args: []string{"backup-1"},
+		},
+		{
+			name:       "namespaces in a comma-separated list",
+			fn:         NamespaceNames(f),
+			toComplete: "ns-1,",
+			expected:   []string{"ns-1,ns-2"},
+		},
+		{
+			name:     "storage classes",
+			fn:       StorageClassNames(f),
+			expected: []string{"gp2"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			completions, directive := tc.fn(&cobra.Command{}, tc.args, tc.toComplete)
+			assert.Equal(t, tc.expected, completions)
+			assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+		})
+	}
+}
+
+func TestRegisterFlagCompletions(t *testing.T) {
+	f := &factorymocks.Factory{}
+
+	root := &cobra.Command{Use: "velero"}
+	root.PersistentFlags().String("namespace", "", "")
+	sub := &cobra.Command{Use: "create", Run: func(*cobra.Command, []string) {}}
+	sub.Flags().String("from-backup", "", "")
+	sub.Flags().String("name", "", "")
+	root.AddCommand(sub)
+
+	RegisterFlagCompletions(root, f)
+
+	_, ok := root.GetFlagCompletionFunc("namespace")
+	assert.True(t, ok)
+	_, ok = sub.GetFlagCompletionFunc("from-backup")
+	assert.True(t, ok)
+	_, ok = sub.GetFlagCompletionFunc("name")
+	assert.False(t, ok)
+}
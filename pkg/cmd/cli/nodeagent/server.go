@@ -49,6 +49,7 @@ import (
 	snapshotv1client "github.com/kubernetes-csi/external-snapshotter/client/v7/clientset/versioned"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
+	"github.com/vmware-tanzu/velero/internal/throttle"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
 	"github.com/vmware-tanzu/velero/pkg/buildinfo"
@@ -58,6 +59,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/constant"
 	"github.com/vmware-tanzu/velero/pkg/controller"
 	"github.com/vmware-tanzu/velero/pkg/datapath"
+	"github.com/vmware-tanzu/velero/pkg/exposer"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/nodeagent"
 	"github.com/vmware-tanzu/velero/pkg/repository"
@@ -92,6 +94,7 @@ type nodeAgentServerConfig struct {
 	resourceTimeout         time.Duration
 	dataMoverPrepareTimeout time.Duration
 	nodeAgentConfig         string
+	metricsLabelLimit       int
 }
 
 func NewServerCommand(f client.Factory) *cobra.Command {
@@ -101,6 +104,7 @@ func NewServerCommand(f client.Factory) *cobra.Command {
 		metricsAddress:          defaultMetricsAddress,
 		resourceTimeout:         defaultResourceTimeout,
 		dataMoverPrepareTimeout: defaultDataMoverPrepareTimeout,
+		metricsLabelLimit:       metrics.DefaultDataMoverLabelLimit,
 	}
 
 	command := &cobra.Command{
@@ -129,6 +133,7 @@ func NewServerCommand(f client.Factory) *cobra.Command {
 	command.Flags().DurationVar(&config.dataMoverPrepareTimeout, "data-mover-prepare-timeout", config.dataMoverPrepareTimeout, "How long to wait for preparing a DataUpload/DataDownload. Default is 30 minutes.")
 	command.Flags().StringVar(&config.metricsAddress, "metrics-address", config.metricsAddress, "The address to expose prometheus metrics")
 	command.Flags().StringVar(&config.nodeAgentConfig, "node-agent-configmap", config.nodeAgentConfig, "The name of ConfigMap containing node-agent configurations.")
+	command.Flags().IntVar(&config.metricsLabelLimit, "data-mover-metrics-label-limit", config.metricsLabelLimit, "The maximum number of distinct storage classes and drivers the data mover metrics are labeled with, the others are labeled as \"other\". 0 drops the labels.")
 
 	return command
 }
@@ -259,7 +264,14 @@ func newNodeAgentServer(logger logrus.FieldLogger, factory client.Factory, confi
 	}
 
 	s.getDataPathConfigs()
-	s.dataPathMgr = datapath.NewManager(s.getDataPathConcurrentNum(defaultDataPathConcurrentNum))
+	concurrentNum := s.getDataPathConcurrentNum(defaultDataPathConcurrentNum)
+	s.dataPathMgr = datapath.NewManager(concurrentNum)
+	if s.dataPathConfigs != nil && s.dataPathConfigs.LoadThrottling != nil {
+		throttling := s.dataPathConfigs.LoadThrottling
+		s.logger.Infof("Load throttling enabled, CPU pressure threshold %v%%, IO pressure threshold %v%%", throttling.CPUPressureThreshold, throttling.IOPressureThreshold)
+		probe := throttle.NewNodePressureProbe(throttle.DefaultPressureDir, throttling.CPUPressureThreshold, throttling.IOPressureThreshold, s.logger)
+		s.dataPathMgr.SetLoadConcurrency(throttle.NewConcurrency(probe, concurrentNum, s.logger))
+	}
 
 	return s, nil
 }
@@ -281,6 +293,7 @@ func (s *nodeAgentServer) run() {
 		}
 	}()
 	s.metrics = metrics.NewNodeMetrics()
+	s.metrics.SetDataMoverLabelLimit(s.config.metricsLabelLimit)
 	s.metrics.RegisterAllMetrics()
 	s.metrics.InitMetricsForNode(s.nodeName)
 
@@ -305,14 +318,20 @@ func (s *nodeAgentServer) run() {
 
 	credentialGetter := &credentials.CredentialGetter{FromFile: credentialFileStore, FromSecret: credSecretStore}
 	repoEnsurer := repository.NewEnsurer(s.mgr.GetClient(), s.logger, s.config.resourceTimeout)
-	pvbReconciler := controller.NewPodVolumeBackupReconciler(s.mgr.GetClient(), s.dataPathMgr, repoEnsurer,
-		credentialGetter, s.nodeName, s.mgr.GetScheme(), s.metrics, s.logger)
+	var podVolumePaths []nodeagent.PodVolumePath
+	if s.dataPathConfigs != nil && len(s.dataPathConfigs.PodVolumePaths) > 0 {
+		podVolumePaths = s.dataPathConfigs.PodVolumePaths
+		s.logger.Infof("Using pod volume path configs %v", podVolumePaths)
+	}
+
+	pvbReconciler := controller.NewPodVolumeBackupReconciler(s.mgr.GetClient(), s.kubeClient, s.dataPathMgr, repoEnsurer,
+		credentialGetter, s.nodeName, podVolumePaths, s.mgr.GetScheme(), s.metrics, s.logger)
 
 	if err := pvbReconciler.SetupWithManager(s.mgr); err != nil {
 		s.logger.Fatal(err, "unable to create controller", "controller", constant.ControllerPodVolumeBackup)
 	}
 
-	if err = controller.NewPodVolumeRestoreReconciler(s.mgr.GetClient(), s.dataPathMgr, repoEnsurer, credentialGetter, s.logger).SetupWithManager(s.mgr); err != nil {
+	if err = controller.NewPodVolumeRestoreReconciler(s.mgr.GetClient(), s.kubeClient, s.dataPathMgr, repoEnsurer, credentialGetter, podVolumePaths, s.logger).SetupWithManager(s.mgr); err != nil {
 		s.logger.WithError(err).Fatal("Unable to create the pod volume restore controller")
 	}
 
@@ -338,6 +357,16 @@ func (s *nodeAgentServer) run() {
 		}
 	}
 
+	snapshotReaders := map[string]exposer.SnapshotBlockReader{}
+	if s.dataPathConfigs != nil && len(s.dataPathConfigs.DirectSnapshotReads) > 0 {
+		if readers, err := exposer.NewSnapshotBlockReaders(s.dataPathConfigs.DirectSnapshotReads); err != nil {
+			s.logger.WithError(err).Warn("Direct snapshot read configs are invalid, ignore")
+		} else {
+			snapshotReaders = readers
+			s.logger.Infof("Using direct snapshot read configs %v", s.dataPathConfigs.DirectSnapshotReads)
+		}
+	}
+
 	dataUploadReconciler := controller.NewDataUploadReconciler(
 		s.mgr.GetClient(),
 		s.mgr,
@@ -347,6 +376,9 @@ func (s *nodeAgentServer) run() {
 		loadAffinity,
 		backupPVCConfig,
 		podResources,
+		snapshotReaders,
+		repoEnsurer,
+		credentialGetter,
 		clock.RealClock{},
 		s.nodeName,
 		s.config.dataMoverPrepareTimeout,
@@ -477,6 +509,9 @@ func (s *nodeAgentServer) markInProgressCRsFailed() {
 	s.markInProgressPVBsFailed(client)
 
 	s.markInProgressPVRsFailed(client)
+
+	// the pods mounting the dedicated PVCs of the failed CRs aren't cleaned up by their data paths anymore
+	exposer.CleanUpOrphanPodVolumePVCs(s.ctx, client, s.kubeClient, s.namespace, s.nodeName, s.logger)
 }
 
 func (s *nodeAgentServer) markInProgressPVBsFailed(client ctrlclient.Client) {
@@ -0,0 +1,142 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operation
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/confirm"
+)
+
+func NewCancelCommand(f client.Factory, use string) *cobra.Command {
+	s := &Selector{}
+	o := confirm.NewConfirmOptionsWithDescription("Confirm cancel action")
+
+	config, err := client.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: Error reading config file: %v\n", err)
+	}
+	s.CACertFile = config.CACertFile()
+
+	c := &cobra.Command{
+		Use:   use + " OPERATION_ID [OPERATION_ID...]",
+		Short: "Cancel async item operations",
+		Long: `Cancel async item operations of backups and restores.
+
+The cancellation is requested through the velero.io/cancel-operations annotation of the backup or restore
+and carried out by the Velero server at its next check of the operations, which fails the operations
+and ends the backup or restore as PartiallyFailed.`,
+		Args: cobra.MinimumNArgs(1),
+		Example: `  # Cancel an operation of a running backup or restore.
+  velero operation cancel du-4e1b3f24-5b4c-4d6a-9c7e-1f0e0a2e6c11.6b2f9a8e-3c1d-4b7a16c3e
+
+  # Cancel an operation without prompting for confirmation.
+  velero operation cancel du-4e1b3f24-5b4c-4d6a-9c7e-1f0e0a2e6c11.6b2f9a8e-3c1d-4b7a16c3e --confirm`,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(s.Validate())
+
+			kbClient, err := f.KubebuilderClient()
+			cmd.CheckError(err)
+
+			operations, err := newCollector(kbClient, f.Namespace(), s).collect(context.Background(), s)
+			cmd.CheckError(err)
+
+			found, err := findOperations(operations, args)
+			cmd.CheckError(err)
+
+			for _, op := range found {
+				if !op.Active() {
+					cmd.CheckError(errors.Errorf("operation %s of %s %s is already %s", op.OperationID, strings.ToLower(op.OwnerKind), op.OwnerName, op.Status.Phase))
+				}
+			}
+
+			if !o.Confirm && !confirm.GetConfirmation() {
+				// Don't do anything unless we get confirmation
+				return
+			}
+
+			cmd.CheckError(requestCancel(context.Background(), kbClient, f.Namespace(), found))
+
+			for _, op := range found {
+				fmt.Printf("Request to cancel operation %s of %s %s submitted successfully.\n", op.OperationID, strings.ToLower(op.OwnerKind), op.OwnerName)
+			}
+		},
+	}
+
+	s.BindFlags(c.Flags())
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+// requestCancel adds the IDs of the operations to the cancel operations annotation of their backups and restores.
+func requestCancel(ctx context.Context, kbClient kbclient.Client, namespace string, operations []*Operation) error {
+	for _, op := range operations {
+		var obj kbclient.Object
+		if op.OwnerKind == OwnerKindBackup {
+			obj = new(velerov1api.Backup)
+		} else {
+			obj = new(velerov1api.Restore)
+		}
+
+		if err := kbClient.Get(ctx, kbclient.ObjectKey{Namespace: namespace, Name: op.OwnerName}, obj); err != nil {
+			return errors.Wrapf(err, "error getting %s %s", strings.ToLower(op.OwnerKind), op.OwnerName)
+		}
+
+		original := obj.DeepCopyObject().(kbclient.Object)
+
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+
+		var ids []string
+		if existing := annotations[velerov1api.CancelOperationsAnnotation]; existing != "" {
+			ids = strings.Split(existing, ",")
+		}
+
+		requested := false
+		for _, id := range ids {
+			if id == op.OperationID {
+				requested = true
+				break
+			}
+		}
+		if requested {
+			continue
+		}
+
+		annotations[velerov1api.CancelOperationsAnnotation] = strings.Join(append(ids, op.OperationID), ",")
+		obj.SetAnnotations(annotations)
+
+		if err := kbClient.Patch(ctx, obj, kbclient.MergeFrom(original)); err != nil {
+			return errors.Wrapf(err, "error requesting cancel of operation %s", op.OperationID)
+		}
+	}
+
+	return nil
+}
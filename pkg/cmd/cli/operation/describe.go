@@ -0,0 +1,144 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operation
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+)
+
+func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
+	s := &Selector{}
+
+	config, err := client.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: Error reading config file: %v\n", err)
+	}
+	s.CACertFile = config.CACertFile()
+
+	c := &cobra.Command{
+		Use:   use + " OPERATION_ID [OPERATION_ID...]",
+		Short: "Describe async item operations",
+		Args:  cobra.MinimumNArgs(1),
+		Example: `  # Describe an operation of a running backup or restore.
+  velero operation describe du-4e1b3f24-5b4c-4d6a-9c7e-1f0e0a2e6c11.6b2f9a8e-3c1d-4b7a16c3e
+
+  # Describe an operation of the finished backup-1.
+  velero operation describe du-4e1b3f24-5b4c-4d6a-9c7e-1f0e0a2e6c11.6b2f9a8e-3c1d-4b7a16c3e --backup backup-1`,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(s.Validate())
+
+			kbClient, err := f.KubebuilderClient()
+			cmd.CheckError(err)
+
+			operations, err := newCollector(kbClient, f.Namespace(), s).collect(context.Background(), s)
+			cmd.CheckError(err)
+
+			found, err := findOperations(operations, args)
+			cmd.CheckError(err)
+
+			for i, op := range found {
+				if i > 0 {
+					fmt.Print("\n\n")
+				}
+				fmt.Print(describeOperation(op))
+			}
+		},
+	}
+
+	s.BindFlags(c.Flags())
+
+	return c
+}
+
+// findOperations returns the operations with the given IDs in the order of the IDs.
+func findOperations(operations []*Operation, ids []string) ([]*Operation, error) {
+	var found []*Operation
+	for _, id := range ids {
+		if strings.TrimSpace(id) == "" {
+			return nil, errors.New("operation ID can't be empty")
+		}
+		matched := false
+		for _, op := range operations {
+			if op.OperationID == id {
+				found = append(found, op)
+				matched = true
+			}
+		}
+
+		if !matched {
+			return nil, errors.Errorf("operation %s is not found, use --backup, --restore or --all to search the operations of finished backups and restores", id)
+		}
+	}
+
+	return found, nil
+}
+
+func describeOperation(op *Operation) string {
+	return output.Describe(func(d *output.Describer) {
+		d.Printf("Operation ID:\t%s\n", op.OperationID)
+		d.Printf("%s:\t%s\n", op.OwnerKind, op.OwnerName)
+		if op.Action != "" {
+			d.Printf("Plugin:\t%s\n", op.Action)
+		}
+		d.Printf("Resource:\t%s\n", op.Resource)
+		d.Printf("Phase:\t%s\n", op.Status.Phase)
+		if op.Status.Error != "" {
+			d.Printf("Error:\t%s\n", op.Status.Error)
+		}
+		if op.Status.NTotal > 0 || op.Status.NCompleted > 0 {
+			d.Printf("Progress:\t%v of %v complete (%s)\n", op.Status.NCompleted, op.Status.NTotal, op.Status.OperationUnits)
+		}
+		if op.Status.Description != "" {
+			d.Printf("Progress description:\t%s\n", op.Status.Description)
+		}
+		if op.Status.Created != nil {
+			d.Printf("Created:\t%s\n", op.Status.Created.String())
+		}
+		if op.Status.Started != nil {
+			d.Printf("Started:\t%s\n", op.Status.Started.String())
+		}
+		if op.Status.Updated != nil {
+			d.Printf("Updated:\t%s\n", op.Status.Updated.String())
+		}
+
+		if dm := op.DataMovement; dm != nil {
+			d.Println()
+			d.Printf("Data Movement:\n")
+			d.Printf("\t%s:\t%s\n", dm.Kind, dm.Name)
+			d.Printf("\tPhase:\t%s\n", dm.Phase)
+			if dm.Node != "" {
+				d.Printf("\tNode:\t%s\n", dm.Node)
+			}
+			if dm.Progress.TotalBytes > 0 {
+				d.Printf("\tProgress:\t%d of %d bytes\n", dm.Progress.BytesDone, dm.Progress.TotalBytes)
+			}
+			if dm.Message != "" {
+				d.Printf("\tMessage:\t%s\n", dm.Message)
+			}
+		}
+	})
+}
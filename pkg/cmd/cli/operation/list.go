@@ -0,0 +1,163 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"sigs.k8s.io/yaml"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+)
+
+var operationColumns = []metav1.TableColumnDefinition{
+	// name needs Type and Format defined for the decorator to identify it:
+	// https://github.com/kubernetes/kubernetes/blob/v1.15.3/pkg/printers/tableprinter.go#L204
+	{Name: "Operation ID", Type: "string", Format: "name"},
+	{Name: "Owner"},
+	{Name: "Resource"},
+	{Name: "Phase"},
+	{Name: "Progress"},
+	{Name: "Data Movement"},
+	{Name: "Age"},
+}
+
+func NewListCommand(f client.Factory, use string) *cobra.Command {
+	s := &Selector{}
+
+	config, err := client.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: Error reading config file: %v\n", err)
+	}
+	s.CACertFile = config.CACertFile()
+
+	c := &cobra.Command{
+		Use:   use,
+		Short: "List async item operations",
+		Long: `List the async item operations of backups and restores.
+
+By default, the operations of the backups and restores still running their operations are listed.
+The operations are persisted in the backup storage periodically, while the progress of data movements
+is read from the DataUploads and DataDownloads directly.`,
+		Args: cobra.NoArgs,
+		Example: `  # List the operations of all the running backups and restores.
+  velero operation list
+
+  # List the operations of backup-1, including the finished ones.
+  velero operation list --backup backup-1`,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(output.ValidateFlags(c))
+			cmd.CheckError(s.Validate())
+
+			kbClient, err := f.KubebuilderClient()
+			cmd.CheckError(err)
+
+			operations, err := newCollector(kbClient, f.Namespace(), s).collect(context.Background(), s)
+			cmd.CheckError(err)
+
+			cmd.CheckError(printOperations(c, os.Stdout, operations, time.Now()))
+		},
+	}
+
+	s.BindFlags(c.Flags())
+	output.BindFlagsSimple(c.Flags())
+
+	return c
+}
+
+func printOperations(c *cobra.Command, w io.Writer, operations []*Operation, now time.Time) error {
+	switch format := output.GetOutputFlagValue(c); format {
+	case "json":
+		encoded, err := json.MarshalIndent(operations, "", "    ")
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		_, err = fmt.Fprintln(w, string(encoded))
+		return err
+	case "yaml":
+		encoded, err := yaml.Marshal(operations)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		_, err = fmt.Fprint(w, string(encoded))
+		return err
+	default:
+		if len(operations) == 0 {
+			_, err := fmt.Fprintln(w, "No operations found.")
+			return err
+		}
+
+		table := &metav1.Table{ColumnDefinitions: operationColumns}
+		for _, op := range operations {
+			table.Rows = append(table.Rows, printOperation(op, now))
+		}
+
+		printer, err := output.NewPrinter(c)
+		if err != nil {
+			return err
+		}
+		return printer.PrintObj(table, w)
+	}
+}
+
+func printOperation(op *Operation, now time.Time) metav1.TableRow {
+	id := op.OperationID
+	if id == "" {
+		id = "<none>"
+	}
+
+	progress := ""
+	if op.Status.NTotal > 0 || op.Status.NCompleted > 0 {
+		progress = fmt.Sprintf("%d/%d %s", op.Status.NCompleted, op.Status.NTotal, op.Status.OperationUnits)
+	}
+
+	dataMovement := ""
+	if op.DataMovement != nil {
+		dataMovement = fmt.Sprintf("%s %s (%s)", op.DataMovement.Kind, op.DataMovement.Name, op.DataMovement.Phase)
+		if op.DataMovement.Progress.TotalBytes > 0 {
+			progress = fmt.Sprintf("%d/%d Bytes", op.DataMovement.Progress.BytesDone, op.DataMovement.Progress.TotalBytes)
+		}
+	}
+
+	age := ""
+	if op.Status.Created != nil {
+		age = duration.ShortHumanDuration(now.Sub(op.Status.Created.Time))
+	}
+
+	return metav1.TableRow{
+		Cells: []any{
+			id,
+			fmt.Sprintf("%s/%s", op.OwnerKind, op.OwnerName),
+			op.Resource,
+			string(op.Status.Phase),
+			progress,
+			dataMovement,
+			age,
+		},
+	}
+}
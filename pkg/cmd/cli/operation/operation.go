@@ -0,0 +1,402 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/labels"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/label"
+)
+
+const (
+	OwnerKindBackup  = "Backup"
+	OwnerKindRestore = "Restore"
+
+	downloadRequestTimeout = 30 * time.Second
+)
+
+func NewCommand(f client.Factory) *cobra.Command {
+	c := &cobra.Command{
+		Use:     "operation",
+		Aliases: []string{"operations"},
+		Short:   "Work with async item operations",
+		Long: `Work with the async item operations started by BackupItemAction and RestoreItemAction plugins
+of backups and restores, including the data movements of CSI snapshot data movement.`,
+	}
+
+	c.AddCommand(
+		NewListCommand(f, "list"),
+		NewDescribeCommand(f, "describe"),
+		NewCancelCommand(f, "cancel"),
+	)
+
+	return c
+}
+
+// Operation is an async item operation of a backup or a restore.
+type Operation struct {
+	OwnerKind   string `json:"ownerKind"`
+	OwnerName   string `json:"ownerName"`
+	OperationID string `json:"operationID"`
+
+	// Action is the name of the BackupItemAction or RestoreItemAction plugin which started the operation.
+	Action string `json:"action,omitempty"`
+
+	// Resource is the item the operation is started for.
+	Resource string `json:"resource,omitempty"`
+
+	Status itemoperation.OperationStatus `json:"status"`
+
+	// DataMovement is the DataUpload or DataDownload carrying out the operation, if any.
+	DataMovement *DataMovement `json:"dataMovement,omitempty"`
+}
+
+// DataMovement is the live status of a DataUpload or DataDownload.
+type DataMovement struct {
+	Kind     string                           `json:"kind"`
+	Name     string                           `json:"name"`
+	Phase    string                           `json:"phase"`
+	Node     string                           `json:"node,omitempty"`
+	Message  string                           `json:"message,omitempty"`
+	Progress shared.DataMoveOperationProgress `json:"progress"`
+}
+
+// Active returns true if the operation is not finished yet.
+func (o *Operation) Active() bool {
+	return o.Status.Phase == itemoperation.OperationPhaseNew || o.Status.Phase == itemoperation.OperationPhaseInProgress
+}
+
+// Selector decides the backups and restores whose operations are collected.
+type Selector struct {
+	Backup                string
+	Restore               string
+	All                   bool
+	InsecureSkipTLSVerify bool
+	CACertFile            string
+}
+
+// BindFlags binds the selector to command line flags.
+func (s *Selector) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&s.Backup, "backup", s.Backup, "Only include the operations of this backup.")
+	flags.StringVar(&s.Restore, "restore", s.Restore, "Only include the operations of this restore.")
+	flags.BoolVar(&s.All, "all", s.All, "Include the operations of finished backups and restores. By default, only the backups and restores still running their operations are included.")
+	flags.BoolVar(&s.InsecureSkipTLSVerify, "insecure-skip-tls-verify", s.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+	flags.StringVar(&s.CACertFile, "cacert", s.CACertFile, "Path to a certificate bundle to use when verifying TLS connections.")
+}
+
+// Validate validates the selector.
+func (s *Selector) Validate() error {
+	if s.Backup != "" && s.Restore != "" {
+		return errors.New("only one of --backup and --restore can be specified")
+	}
+	return nil
+}
+
+type collector struct {
+	client    kbclient.Client
+	namespace string
+	stream    func(ctx context.Context, name string, kind velerov1api.DownloadTargetKind, w io.Writer) error
+}
+
+func newCollector(kbClient kbclient.Client, namespace string, s *Selector) *collector {
+	return &collector{
+		client:    kbClient,
+		namespace: namespace,
+		stream: func(ctx context.Context, name string, kind velerov1api.DownloadTargetKind, w io.Writer) error {
+			return downloadrequest.Stream(ctx, kbClient, namespace, name, kind, w, downloadRequestTimeout, s.InsecureSkipTLSVerify, s.CACertFile)
+		},
+	}
+}
+
+// collect returns the operations of the backups and restores picked by the selector, the
+// backups before the restores, each in the order of the owner name.
+func (c *collector) collect(ctx context.Context, s *Selector) ([]*Operation, error) {
+	var operations []*Operation
+
+	if s.Restore == "" {
+		backups, err := c.backups(ctx, s)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range backups {
+			ops, err := c.backupOperations(ctx, &backups[i])
+			if err != nil {
+				return nil, err
+			}
+			operations = append(operations, ops...)
+		}
+	}
+
+	if s.Backup == "" {
+		restores, err := c.restores(ctx, s)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range restores {
+			ops, err := c.restoreOperations(ctx, &restores[i])
+			if err != nil {
+				return nil, err
+			}
+			operations = append(operations, ops...)
+		}
+	}
+
+	return operations, nil
+}
+
+func (c *collector) backups(ctx context.Context, s *Selector) ([]velerov1api.Backup, error) {
+	if s.Backup != "" {
+		backup := new(velerov1api.Backup)
+		if err := c.client.Get(ctx, kbclient.ObjectKey{Namespace: c.namespace, Name: s.Backup}, backup); err != nil {
+			return nil, errors.Wrapf(err, "error getting backup %s", s.Backup)
+		}
+		return []velerov1api.Backup{*backup}, nil
+	}
+
+	list := new(velerov1api.BackupList)
+	if err := c.client.List(ctx, list, kbclient.InNamespace(c.namespace)); err != nil {
+		return nil, errors.Wrap(err, "error listing backups")
+	}
+
+	var backups []velerov1api.Backup
+	for _, backup := range list.Items {
+		if s.All || backupRunningOperations(&backup) {
+			backups = append(backups, backup)
+		}
+	}
+	sort.Slice(backups, func(i, j int) bool { return backups[i].Name < backups[j].Name })
+
+	return backups, nil
+}
+
+func (c *collector) restores(ctx context.Context, s *Selector) ([]velerov1api.Restore, error) {
+	if s.Restore != "" {
+		restore := new(velerov1api.Restore)
+		if err := c.client.Get(ctx, kbclient.ObjectKey{Namespace: c.namespace, Name: s.Restore}, restore); err != nil {
+			return nil, errors.Wrapf(err, "error getting restore %s", s.Restore)
+		}
+		return []velerov1api.Restore{*restore}, nil
+	}
+
+	list := new(velerov1api.RestoreList)
+	if err := c.client.List(ctx, list, kbclient.InNamespace(c.namespace)); err != nil {
+		return nil, errors.Wrap(err, "error listing restores")
+	}
+
+	var restores []velerov1api.Restore
+	for _, restore := range list.Items {
+		if s.All || restoreRunningOperations(&restore) {
+			restores = append(restores, restore)
+		}
+	}
+	sort.Slice(restores, func(i, j int) bool { return restores[i].Name < restores[j].Name })
+
+	return restores, nil
+}
+
+func backupRunningOperations(backup *velerov1api.Backup) bool {
+	switch backup.Status.Phase {
+	case velerov1api.BackupPhaseInProgress,
+		velerov1api.BackupPhaseWaitingForPluginOperations,
+		velerov1api.BackupPhaseWaitingForPluginOperationsPartiallyFailed:
+		return true
+	}
+	return false
+}
+
+func restoreRunningOperations(restore *velerov1api.Restore) bool {
+	switch restore.Status.Phase {
+	case velerov1api.RestorePhaseInProgress,
+		velerov1api.RestorePhaseWaitingForPluginOperations,
+		velerov1api.RestorePhaseWaitingForPluginOperationsPartiallyFailed:
+		return true
+	}
+	return false
+}
+
+func (c *collector) backupOperations(ctx context.Context, backup *velerov1api.Backup) ([]*Operation, error) {
+	var operations []*Operation
+
+	// the operations are persisted once the backup of the items finishes
+	if backup.Status.BackupItemOperationsAttempted > 0 && backup.Status.Phase != velerov1api.BackupPhaseInProgress {
+		var persisted []*itemoperation.BackupOperation
+		if err := c.download(ctx, backup.Name, velerov1api.DownloadTargetKindBackupItemOperations, &persisted); err != nil {
+			return nil, errors.Wrapf(err, "error getting operations of backup %s", backup.Name)
+		}
+
+		for _, op := range persisted {
+			operations = append(operations, &Operation{
+				OwnerKind:   OwnerKindBackup,
+				OwnerName:   backup.Name,
+				OperationID: op.Spec.OperationID,
+				Action:      op.Spec.BackupItemAction,
+				Resource:    fmt.Sprintf("%s %s", op.Spec.ResourceIdentifier.GroupResource, namespacedName(op.Spec.ResourceIdentifier.Namespace, op.Spec.ResourceIdentifier.Name)),
+				Status:      op.Status,
+			})
+		}
+	}
+
+	dataUploads := new(velerov2alpha1api.DataUploadList)
+	if err := c.client.List(ctx, dataUploads, &kbclient.ListOptions{
+		Namespace:     c.namespace,
+		LabelSelector: labels.SelectorFromSet(map[string]string{velerov1api.BackupNameLabel: label.GetValidName(backup.Name)}),
+	}); err != nil {
+		return nil, errors.Wrapf(err, "error listing DataUploads of backup %s", backup.Name)
+	}
+
+	sort.Slice(dataUploads.Items, func(i, j int) bool { return dataUploads.Items[i].Name < dataUploads.Items[j].Name })
+	for _, du := range dataUploads.Items {
+		operations = attachDataMovement(operations, OwnerKindBackup, backup.Name, du.Labels[velerov1api.AsyncOperationIDLabel],
+			fmt.Sprintf("persistentvolumeclaims %s", namespacedName(du.Spec.SourceNamespace, du.Spec.SourcePVC)),
+			&DataMovement{
+				Kind:     "DataUpload",
+				Name:     du.Name,
+				Phase:    string(du.Status.Phase),
+				Node:     du.Status.Node,
+				Message:  du.Status.Message,
+				Progress: du.Status.Progress,
+			})
+	}
+
+	return operations, nil
+}
+
+func (c *collector) restoreOperations(ctx context.Context, restore *velerov1api.Restore) ([]*Operation, error) {
+	var operations []*Operation
+
+	if restore.Status.RestoreItemOperationsAttempted > 0 && restore.Status.Phase != velerov1api.RestorePhaseInProgress {
+		var persisted []*itemoperation.RestoreOperation
+		if err := c.download(ctx, restore.Name, velerov1api.DownloadTargetKindRestoreItemOperations, &persisted); err != nil {
+			return nil, errors.Wrapf(err, "error getting operations of restore %s", restore.Name)
+		}
+
+		for _, op := range persisted {
+			operations = append(operations, &Operation{
+				OwnerKind:   OwnerKindRestore,
+				OwnerName:   restore.Name,
+				OperationID: op.Spec.OperationID,
+				Action:      op.Spec.RestoreItemAction,
+				Resource:    fmt.Sprintf("%s %s", op.Spec.ResourceIdentifier.GroupResource, namespacedName(op.Spec.ResourceIdentifier.Namespace, op.Spec.ResourceIdentifier.Name)),
+				Status:      op.Status,
+			})
+		}
+	}
+
+	dataDownloads := new(velerov2alpha1api.DataDownloadList)
+	if err := c.client.List(ctx, dataDownloads, &kbclient.ListOptions{
+		Namespace:     c.namespace,
+		LabelSelector: labels.SelectorFromSet(map[string]string{velerov1api.RestoreNameLabel: label.GetValidName(restore.Name)}),
+	}); err != nil {
+		return nil, errors.Wrapf(err, "error listing DataDownloads of restore %s", restore.Name)
+	}
+
+	sort.Slice(dataDownloads.Items, func(i, j int) bool { return dataDownloads.Items[i].Name < dataDownloads.Items[j].Name })
+	for _, dd := range dataDownloads.Items {
+		operations = attachDataMovement(operations, OwnerKindRestore, restore.Name, dd.Labels[velerov1api.AsyncOperationIDLabel],
+			fmt.Sprintf("persistentvolumeclaims %s", namespacedName(dd.Spec.TargetVolume.Namespace, dd.Spec.TargetVolume.PVC)),
+			&DataMovement{
+				Kind:     "DataDownload",
+				Name:     dd.Name,
+				Phase:    string(dd.Status.Phase),
+				Node:     dd.Status.Node,
+				Message:  dd.Status.Message,
+				Progress: dd.Status.Progress,
+			})
+	}
+
+	return operations, nil
+}
+
+// download gets the persisted item operations of a backup or a restore, the operations
+// are missing if the backup or restore was removed from the object storage.
+func (c *collector) download(ctx context.Context, name string, kind velerov1api.DownloadTargetKind, into any) error {
+	buf := new(bytes.Buffer)
+	if err := c.stream(ctx, name, kind, buf); err != nil {
+		if err == downloadrequest.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+
+	return json.NewDecoder(buf).Decode(into)
+}
+
+// attachDataMovement attaches the data movement to the operation with the same ID. The persisted
+// operations lag behind the data movements, so an operation is added for a data movement without a
+// persisted one, e.g., when the backup is still in progress.
+func attachDataMovement(operations []*Operation, ownerKind, ownerName, operationID, resource string, dm *DataMovement) []*Operation {
+	for _, op := range operations {
+		if operationID != "" && op.OperationID == operationID {
+			op.DataMovement = dm
+			return operations
+		}
+	}
+
+	op := &Operation{
+		OwnerKind:    ownerKind,
+		OwnerName:    ownerName,
+		OperationID:  operationID,
+		Resource:     resource,
+		DataMovement: dm,
+		Status: itemoperation.OperationStatus{
+			NCompleted:     dm.Progress.BytesDone,
+			NTotal:         dm.Progress.TotalBytes,
+			OperationUnits: "Bytes",
+		},
+	}
+
+	switch dm.Phase {
+	case string(velerov2alpha1api.DataUploadPhaseCompleted):
+		op.Status.Phase = itemoperation.OperationPhaseCompleted
+	case string(velerov2alpha1api.DataUploadPhaseFailed), string(velerov2alpha1api.DataUploadPhaseCanceled):
+		op.Status.Phase = itemoperation.OperationPhaseFailed
+		op.Status.Error = dm.Message
+	case "", string(velerov2alpha1api.DataUploadPhaseNew):
+		op.Status.Phase = itemoperation.OperationPhaseNew
+	default:
+		op.Status.Phase = itemoperation.OperationPhaseInProgress
+	}
+
+	return append(operations, op)
+}
+
+func namespacedName(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
@@ -0,0 +1,240 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operation
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestCollect(t *testing.T) {
+	waitingBackup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Phase(velerov1api.BackupPhaseWaitingForPluginOperations).Result()
+	waitingBackup.Status.BackupItemOperationsAttempted = 2
+
+	inProgressBackup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-2").Phase(velerov1api.BackupPhaseInProgress).Result()
+
+	completedBackup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-3").Phase(velerov1api.BackupPhaseCompleted).Result()
+	completedBackup.Status.BackupItemOperationsAttempted = 1
+
+	waitingRestore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Phase(velerov1api.RestorePhaseWaitingForPluginOperations).Result()
+	waitingRestore.Status.RestoreItemOperationsAttempted = 1
+
+	dataUploads := []runtime.Object{
+		builder.ForDataUpload(velerov1api.DefaultNamespace, "backup-1-abcde").
+			Labels(map[string]string{velerov1api.BackupNameLabel: "backup-1", velerov1api.AsyncOperationIDLabel: "du-1"}).
+			SourceNamespace("ns-1").SourcePVC("pvc-1").
+			Phase(velerov2alpha1api.DataUploadPhaseInProgress).
+			Progress(shared.DataMoveOperationProgress{BytesDone: 10, TotalBytes: 100}).Result(),
+		builder.ForDataUpload(velerov1api.DefaultNamespace, "backup-2-fghij").
+			Labels(map[string]string{velerov1api.BackupNameLabel: "backup-2", velerov1api.AsyncOperationIDLabel: "du-2"}).
+			SourceNamespace("ns-2").SourcePVC("pvc-2").
+			Phase(velerov2alpha1api.DataUploadPhaseAccepted).Result(),
+	}
+
+	persisted := map[string]any{
+		"backup-1": []*itemoperation.BackupOperation{
+			{
+				Spec: itemoperation.BackupOperationSpec{
+					BackupName:         "backup-1",
+					BackupItemAction:   "velero.io/csi-pvc-backupper",
+					OperationID:        "du-1",
+					ResourceIdentifier: velero.ResourceIdentifier{GroupResource: kuberesource.PersistentVolumeClaims, Namespace: "ns-1", Name: "pvc-1"},
+				},
+				Status: itemoperation.OperationStatus{Phase: itemoperation.OperationPhaseInProgress},
+			},
+			{
+				Spec: itemoperation.BackupOperationSpec{
+					BackupName:         "backup-1",
+					BackupItemAction:   "example.io/foo",
+					OperationID:        "foo-1",
+					ResourceIdentifier: velero.ResourceIdentifier{GroupResource: kuberesource.Pods, Namespace: "ns-1", Name: "pod-1"},
+				},
+				Status: itemoperation.OperationStatus{Phase: itemoperation.OperationPhaseCompleted},
+			},
+		},
+		"restore-1": []*itemoperation.RestoreOperation{
+			{
+				Spec: itemoperation.RestoreOperationSpec{
+					RestoreName:        "restore-1",
+					RestoreItemAction:  "example.io/bar",
+					OperationID:        "bar-1",
+					ResourceIdentifier: velero.ResourceIdentifier{GroupResource: kuberesource.Pods, Namespace: "ns-1", Name: "pod-1"},
+				},
+				Status: itemoperation.OperationStatus{Phase: itemoperation.OperationPhaseNew},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		selector *Selector
+		expected []string
+	}{
+		{
+			name:     "running backups and restores",
+			selector: &Selector{},
+			expected: []string{"Backup/backup-1/du-1", "Backup/backup-1/foo-1", "Backup/backup-2/du-2", "Restore/restore-1/bar-1"},
+		},
+		{
+			name:     "a finished backup",
+			selector: &Selector{Backup: "backup-3"},
+			expected: nil,
+		},
+		{
+			name:     "a restore",
+			selector: &Selector{Restore: "restore-1"},
+			expected: []string{"Restore/restore-1/bar-1"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			objs := append([]runtime.Object{waitingBackup, inProgressBackup, completedBackup, waitingRestore}, dataUploads...)
+			c := &collector{
+				client:    velerotest.NewFakeControllerRuntimeClient(t, objs...),
+				namespace: velerov1api.DefaultNamespace,
+				stream: func(ctx context.Context, name string, kind velerov1api.DownloadTargetKind, w io.Writer) error {
+					operations, found := persisted[name]
+					if !found {
+						return downloadrequest.ErrNotFound
+					}
+					return json.NewEncoder(w).Encode(operations)
+				},
+			}
+
+			operations, err := c.collect(context.Background(), test.selector)
+			require.NoError(t, err)
+
+			var actual []string
+			for _, op := range operations {
+				actual = append(actual, op.OwnerKind+"/"+op.OwnerName+"/"+op.OperationID)
+			}
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestAttachDataMovement(t *testing.T) {
+	operations := []*Operation{
+		{OwnerKind: OwnerKindBackup, OwnerName: "backup-1", OperationID: "du-1", Status: itemoperation.OperationStatus{Phase: itemoperation.OperationPhaseInProgress}},
+	}
+
+	operations = attachDataMovement(operations, OwnerKindBackup, "backup-1", "du-1", "persistentvolumeclaims ns-1/pvc-1",
+		&DataMovement{Kind: "DataUpload", Name: "du-name-1", Phase: "InProgress"})
+	require.Len(t, operations, 1)
+	assert.Equal(t, "du-name-1", operations[0].DataMovement.Name)
+
+	operations = attachDataMovement(operations, OwnerKindBackup, "backup-1", "du-2", "persistentvolumeclaims ns-1/pvc-2",
+		&DataMovement{Kind: "DataUpload", Name: "du-name-2", Phase: "Canceled", Message: "canceled by user", Progress: shared.DataMoveOperationProgress{BytesDone: 5, TotalBytes: 10}})
+	require.Len(t, operations, 2)
+	assert.Equal(t, &Operation{
+		OwnerKind:    OwnerKindBackup,
+		OwnerName:    "backup-1",
+		OperationID:  "du-2",
+		Resource:     "persistentvolumeclaims ns-1/pvc-2",
+		DataMovement: &DataMovement{Kind: "DataUpload", Name: "du-name-2", Phase: "Canceled", Message: "canceled by user", Progress: shared.DataMoveOperationProgress{BytesDone: 5, TotalBytes: 10}},
+		Status: itemoperation.OperationStatus{
+			Phase:          itemoperation.OperationPhaseFailed,
+			Error:          "canceled by user",
+			NCompleted:     5,
+			NTotal:         10,
+			OperationUnits: "Bytes",
+		},
+	}, operations[1])
+}
+
+func TestFindOperations(t *testing.T) {
+	operations := []*Operation{
+		{OwnerKind: OwnerKindBackup, OwnerName: "backup-1", OperationID: "op-1"},
+		{OwnerKind: OwnerKindRestore, OwnerName: "restore-1", OperationID: "op-2"},
+	}
+
+	found, err := findOperations(operations, []string{"op-2", "op-1"})
+	require.NoError(t, err)
+	assert.Equal(t, []*Operation{operations[1], operations[0]}, found)
+
+	_, err = findOperations(operations, []string{"op-3"})
+	assert.EqualError(t, err, "operation op-3 is not found, use --backup, --restore or --all to search the operations of finished backups and restores")
+
+	_, err = findOperations(append(operations, &Operation{OwnerKind: OwnerKindBackup, OwnerName: "backup-2"}), []string{""})
+	assert.EqualError(t, err, "operation ID can't be empty")
+}
+
+func TestRequestCancel(t *testing.T) {
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").
+		ObjectMeta(builder.WithAnnotations(velerov1api.CancelOperationsAnnotation, "op-0")).Result()
+	restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result()
+	client := velerotest.NewFakeControllerRuntimeClient(t, backup, restore)
+
+	err := requestCancel(context.Background(), client, velerov1api.DefaultNamespace, []*Operation{
+		{OwnerKind: OwnerKindBackup, OwnerName: "backup-1", OperationID: "op-1"},
+		{OwnerKind: OwnerKindBackup, OwnerName: "backup-1", OperationID: "op-0"},
+		{OwnerKind: OwnerKindRestore, OwnerName: "restore-1", OperationID: "op-2"},
+	})
+	require.NoError(t, err)
+
+	updatedBackup := new(velerov1api.Backup)
+	require.NoError(t, client.Get(context.Background(), kbclient.ObjectKey{Namespace: velerov1api.DefaultNamespace, Name: "backup-1"}, updatedBackup))
+	assert.Equal(t, "op-0,op-1", updatedBackup.Annotations[velerov1api.CancelOperationsAnnotation])
+	assert.True(t, itemoperation.CancelRequested(updatedBackup.Annotations, "op-1"))
+	assert.False(t, itemoperation.CancelRequested(updatedBackup.Annotations, ""))
+	assert.False(t, itemoperation.CancelRequested(nil, ""))
+
+	updatedRestore := new(velerov1api.Restore)
+	require.NoError(t, client.Get(context.Background(), kbclient.ObjectKey{Namespace: velerov1api.DefaultNamespace, Name: "restore-1"}, updatedRestore))
+	assert.Equal(t, "op-2", updatedRestore.Annotations[velerov1api.CancelOperationsAnnotation])
+}
+
+func TestPrintOperation(t *testing.T) {
+	now := metav1.Now()
+	created := metav1.NewTime(now.Add(-30 * time.Second))
+
+	row := printOperation(&Operation{
+		OwnerKind:   OwnerKindBackup,
+		OwnerName:   "backup-1",
+		OperationID: "du-1",
+		Resource:    "persistentvolumeclaims ns-1/pvc-1",
+		Status:      itemoperation.OperationStatus{Phase: itemoperation.OperationPhaseInProgress, Created: &created},
+		DataMovement: &DataMovement{
+			Kind:     "DataUpload",
+			Name:     "backup-1-abcde",
+			Phase:    "InProgress",
+			Progress: shared.DataMoveOperationProgress{BytesDone: 10, TotalBytes: 100},
+		},
+	}, now.Time)
+
+	assert.Equal(t, []any{"du-1", "Backup/backup-1", "persistentvolumeclaims ns-1/pvc-1", "InProgress", "10/100 Bytes", "DataUpload backup-1-abcde (InProgress)", "30s"}, row.Cells)
+}
@@ -0,0 +1,372 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/repository"
+	"github.com/vmware-tanzu/velero/pkg/datamover"
+	"github.com/vmware-tanzu/velero/pkg/podvolume/configs"
+	repomanager "github.com/vmware-tanzu/velero/pkg/repository/manager"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	"github.com/vmware-tanzu/velero/pkg/uploader/kopia"
+)
+
+var snapshotColumns = []metav1.TableColumnDefinition{
+	// name needs Type and Format defined for the decorator to identify it:
+	// https://github.com/kubernetes/kubernetes/blob/v1.15.3/pkg/printers/tableprinter.go#L204
+	{Name: "Snapshot ID", Type: "string", Format: "name"},
+	{Name: "Source"},
+	{Name: "PVC"},
+	{Name: "Size"},
+	{Name: "Created"},
+	{Name: "Backup"},
+	{Name: "Schedule"},
+	{Name: "Cluster"},
+	{Name: "Retention"},
+	{Name: "Tags"},
+}
+
+// Snapshot is a snapshot of a backup repository, taken by a PodVolumeBackup or a DataUpload.
+type Snapshot struct {
+	ID string `json:"id"`
+
+	// SourceKind and SourceName are the PodVolumeBackup or the DataUpload which took the snapshot,
+	// unset once it's deleted.
+	SourceKind string `json:"sourceKind,omitempty"`
+	SourceName string `json:"sourceName,omitempty"`
+
+	// PVC is the namespace and the name of the PersistentVolumeClaim of the snapshotted volume, if any.
+	PVC string `json:"pvc,omitempty"`
+
+	// Size is the size in bytes of the snapshotted data.
+	Size int64 `json:"size"`
+
+	// Created is when the snapshot completed.
+	Created *metav1.Time `json:"created,omitempty"`
+
+	Backup   string `json:"backup,omitempty"`
+	Schedule string `json:"schedule,omitempty"`
+	Cluster  string `json:"cluster,omitempty"`
+
+	// Retention is the kopia retention reason the Velero retention of the snapshot translates to.
+	Retention string `json:"retention,omitempty"`
+
+	// Tags are the tags of the snapshot in the repository.
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+func NewSnapshotsCommand(f client.Factory, use string) *cobra.Command {
+	var pvc string
+
+	c := &cobra.Command{
+		Use:   use + " NAME",
+		Short: "List the snapshots of a repository",
+		Long: `List the snapshots of a repository, as listed by the repository itself, with their size,
+when they were taken, the backup, the schedule and the cluster they're tagged with, and the pod
+volume backup or the data upload which took them while it exists.
+
+The repository is queried from the machine running the command, with the repository password and
+the credentials of the backup storage location read from the secrets of the Velero namespace, else
+the credentials of the environment of the command. Restic must be installed to list the snapshots
+of a restic repository.
+
+For a kopia repository, the retention is the kopia retention reason the current expiration of the
+backup of the snapshot translates to.`,
+		Args: cobra.ExactArgs(1),
+		Example: `  # List the snapshots of the repository default-kopia-abcd.
+  velero repo snapshots default-kopia-abcd
+
+  # List the snapshots of the PVC data in the namespace app.
+  velero repo snapshots app-default-kopia-abcd --pvc app/data`,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(output.ValidateFlags(c))
+			if pvc != "" && len(strings.Split(pvc, "/")) != 2 {
+				cmd.CheckError(errors.Errorf("pvc %q isn't formatted as namespace/name", pvc))
+			}
+
+			kbClient, err := f.KubebuilderClient()
+			cmd.CheckError(err)
+
+			logger := logrus.New()
+			logger.SetOutput(io.Discard)
+			repoManager, cleanup, err := repository.NewManager(kbClient, f.Namespace(), logger)
+			cmd.CheckError(err)
+			defer cleanup()
+
+			snapshots, err := collectSnapshots(context.Background(), kbClient, repoManager, f.Namespace(), args[0], time.Now())
+			cmd.CheckError(err)
+			if pvc != "" {
+				snapshots = filterSnapshotsByPVC(snapshots, pvc)
+			}
+
+			cmd.CheckError(printSnapshots(c, os.Stdout, snapshots))
+		},
+	}
+
+	c.Flags().StringVar(&pvc, "pvc", "", "Only list the snapshots of the PVC, formatted as namespace/name.")
+	output.BindFlagsSimple(c.Flags())
+
+	return c
+}
+
+// collectSnapshots returns the snapshots of the repository, as listed by the repository, ordered
+// by backup. The PodVolumeBackups and DataUploads which took them give their source and PVC while
+// they exist.
+func collectSnapshots(ctx context.Context, kbClient ctrlclient.Client, repoManager repomanager.Manager, namespace, name string, now time.Time) ([]*Snapshot, error) {
+	repo := new(velerov1api.BackupRepository)
+	if err := kbClient.Get(ctx, ctrlclient.ObjectKey{Namespace: namespace, Name: name}, repo); err != nil {
+		return nil, errors.Wrapf(err, "error getting repository %s", name)
+	}
+
+	list, err := repoManager.ListSnapshots(ctx, repo)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing the snapshots of repository %s", name)
+	}
+
+	sources, err := snapshotSources(ctx, kbClient, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	expirations, err := backupExpirations(ctx, kbClient, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*Snapshot, 0, len(list))
+	for _, repoSnapshot := range list {
+		snapshot := newSnapshot(repoSnapshot.ID, repoSnapshot.Tags)
+		snapshot.Size = repoSnapshot.Size
+		if created := repoSnapshot.EndTime; !created.IsZero() {
+			snapshot.Created = &metav1.Time{Time: created}
+		}
+
+		if source, ok := sources[repoSnapshot.ID]; ok {
+			snapshot.SourceKind, snapshot.SourceName, snapshot.PVC = source.kind, source.name, source.pvc
+			// the snapshots taken before they were tagged with their backup
+			if snapshot.Backup == "" {
+				snapshot.Backup = source.backup
+			}
+			// the repositories which don't record the sizes of the snapshots
+			if snapshot.Size == 0 {
+				snapshot.Size = source.size
+			}
+		}
+		// the expiration of the backup changes with its TTL, so it's read from the backup rather
+		// than recorded in the tags of the snapshot
+		if repo.Spec.RepositoryType == velerov1api.BackupRepositoryTypeKopia {
+			snapshot.Retention = kopia.RetentionReason(snapshot.Tags, []string{kopia.VeleroPin}, expirations[snapshot.Backup], now)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		if snapshots[i].Backup != snapshots[j].Backup {
+			return snapshots[i].Backup < snapshots[j].Backup
+		}
+		if snapshots[i].SourceName != snapshots[j].SourceName {
+			return snapshots[i].SourceName < snapshots[j].SourceName
+		}
+		return snapshots[i].ID < snapshots[j].ID
+	})
+	return snapshots, nil
+}
+
+// snapshotSource is the PodVolumeBackup or the DataUpload which took a snapshot.
+type snapshotSource struct {
+	kind   string
+	name   string
+	pvc    string
+	backup string
+	size   int64
+}
+
+// snapshotSources returns the completed PodVolumeBackups and DataUploads of the repository, keyed
+// by the IDs of their snapshots.
+func snapshotSources(ctx context.Context, kbClient ctrlclient.Client, repo *velerov1api.BackupRepository) (map[string]snapshotSource, error) {
+	sources := make(map[string]snapshotSource)
+
+	pvbs := new(velerov1api.PodVolumeBackupList)
+	if err := kbClient.List(ctx, pvbs, ctrlclient.InNamespace(repo.Namespace)); err != nil {
+		return nil, errors.Wrap(err, "error listing pod volume backups")
+	}
+	for _, pvb := range pvbs.Items {
+		if pvb.Status.Phase != velerov1api.PodVolumeBackupPhaseCompleted || pvb.Status.SnapshotID == "" ||
+			pvb.Spec.Pod.Namespace != repo.Spec.VolumeNamespace || pvb.Spec.BackupStorageLocation != repo.Spec.BackupStorageLocation ||
+			pvb.Spec.UploaderType != repo.Spec.RepositoryType {
+			continue
+		}
+		source := snapshotSource{
+			kind:   "PodVolumeBackup",
+			name:   pvb.Name,
+			backup: pvb.Labels[velerov1api.BackupNameLabel],
+			size:   pvb.Status.Progress.TotalBytes,
+		}
+		if pvcName := pvb.Annotations[configs.PVCNameAnnotation]; pvcName != "" {
+			source.pvc = pvb.Spec.Pod.Namespace + "/" + pvcName
+		}
+		sources[pvb.Status.SnapshotID] = source
+	}
+
+	dus := new(velerov2alpha1api.DataUploadList)
+	if err := kbClient.List(ctx, dus, ctrlclient.InNamespace(repo.Namespace)); err != nil {
+		return nil, errors.Wrap(err, "error listing data uploads")
+	}
+	for i := range dus.Items {
+		du := &dus.Items[i]
+		if du.Status.Phase != velerov2alpha1api.DataUploadPhaseCompleted || du.Status.SnapshotID == "" ||
+			du.Spec.SourceNamespace != repo.Spec.VolumeNamespace || du.Spec.BackupStorageLocation != repo.Spec.BackupStorageLocation ||
+			datamover.GetUploaderType(du.Spec.DataMover) != repo.Spec.RepositoryType {
+			continue
+		}
+		sources[du.Status.SnapshotID] = snapshotSource{
+			kind:   "DataUpload",
+			name:   du.Name,
+			pvc:    du.Spec.SourceNamespace + "/" + du.Spec.SourcePVC,
+			backup: du.Labels[velerov1api.BackupNameLabel],
+			size:   du.Status.Progress.TotalBytes,
+		}
+	}
+	return sources, nil
+}
+
+// backupExpirations returns the expirations of the backups, keyed by their names.
+func backupExpirations(ctx context.Context, kbClient ctrlclient.Client, namespace string) (map[string]*time.Time, error) {
+	backups := new(velerov1api.BackupList)
+	if err := kbClient.List(ctx, backups, ctrlclient.InNamespace(namespace)); err != nil {
+		return nil, errors.Wrap(err, "error listing backups")
+	}
+
+	expirations := make(map[string]*time.Time, len(backups.Items))
+	for _, backup := range backups.Items {
+		if backup.Status.Expiration != nil {
+			expirations[backup.Name] = &backup.Status.Expiration.Time
+		}
+	}
+	return expirations, nil
+}
+
+func newSnapshot(id string, tags map[string]string) *Snapshot {
+	return &Snapshot{
+		ID:       id,
+		Backup:   tags[uploader.SnapshotBackupTag],
+		Schedule: tags[uploader.SnapshotScheduleTag],
+		Cluster:  tags[uploader.SnapshotClusterTag],
+		Tags:     tags,
+	}
+}
+
+// filterSnapshotsByPVC returns the snapshots of the PVC, given as namespace/name.
+func filterSnapshotsByPVC(snapshots []*Snapshot, pvc string) []*Snapshot {
+	var filtered []*Snapshot
+	for _, snapshot := range snapshots {
+		if snapshot.PVC == pvc {
+			filtered = append(filtered, snapshot)
+		}
+	}
+	return filtered
+}
+
+func printSnapshots(c *cobra.Command, w io.Writer, snapshots []*Snapshot) error {
+	switch format := output.GetOutputFlagValue(c); format {
+	case "json":
+		encoded, err := json.MarshalIndent(snapshots, "", "    ")
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		_, err = fmt.Fprintln(w, string(encoded))
+		return err
+	case "yaml":
+		encoded, err := yaml.Marshal(snapshots)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		_, err = fmt.Fprint(w, string(encoded))
+		return err
+	default:
+		if len(snapshots) == 0 {
+			_, err := fmt.Fprintln(w, "No snapshots found.")
+			return err
+		}
+
+		table := &metav1.Table{ColumnDefinitions: snapshotColumns}
+		for _, snapshot := range snapshots {
+			table.Rows = append(table.Rows, printSnapshot(snapshot))
+		}
+
+		printer, err := output.NewPrinter(c)
+		if err != nil {
+			return err
+		}
+		return printer.PrintObj(table, w)
+	}
+}
+
+func printSnapshot(snapshot *Snapshot) metav1.TableRow {
+	// the tags shown in their own columns aren't repeated
+	var tags []string
+	for k, v := range snapshot.Tags {
+		switch k {
+		case uploader.SnapshotBackupTag, uploader.SnapshotScheduleTag, uploader.SnapshotClusterTag:
+			continue
+		}
+		tags = append(tags, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(tags)
+
+	var source string
+	if snapshot.SourceKind != "" {
+		source = fmt.Sprintf("%s/%s", snapshot.SourceKind, snapshot.SourceName)
+	}
+
+	return metav1.TableRow{
+		Cells: []any{
+			snapshot.ID,
+			source,
+			snapshot.PVC,
+			resource.NewQuantity(snapshot.Size, resource.BinarySI).String(),
+			snapshot.Created,
+			snapshot.Backup,
+			snapshot.Schedule,
+			snapshot.Cluster,
+			snapshot.Retention,
+			strings.Join(tags, ","),
+		},
+	}
+}
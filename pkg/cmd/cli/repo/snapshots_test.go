@@ -0,0 +1,141 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/podvolume/configs"
+	repomocks "github.com/vmware-tanzu/velero/pkg/repository/mocks"
+	"github.com/vmware-tanzu/velero/pkg/repository/provider"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestCollectSnapshots(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	repo := &velerov1api.BackupRepository{
+		ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "ns-1-default-kopia"},
+		Spec: velerov1api.BackupRepositorySpec{
+			VolumeNamespace:       "ns-1",
+			BackupStorageLocation: "default",
+			RepositoryType:        velerov1api.BackupRepositoryTypeKopia,
+		},
+	}
+
+	pvb := builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").PodNamespace("ns-1").BackupStorageLocation("default").
+		UploaderType("kopia").Phase(velerov1api.PodVolumeBackupPhaseCompleted).SnapshotID("snapshot-1").Result()
+	pvb.Annotations = map[string]string{configs.PVCNameAnnotation: "pvc-1"}
+	pvb.Status.Progress.TotalBytes = 1024
+	// another namespace
+	otherPVB := builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-2").PodNamespace("ns-2").BackupStorageLocation("default").
+		UploaderType("kopia").Phase(velerov1api.PodVolumeBackupPhaseCompleted).SnapshotID("snapshot-2").Result()
+	du := builder.ForDataUpload(velerov1api.DefaultNamespace, "du-1").SourceNamespace("ns-1").BackupStorageLocation("default").
+		Phase(velerov2alpha1api.DataUploadPhaseCompleted).SnapshotID("snapshot-3").SourcePVC("pvc-2").
+		Labels(map[string]string{velerov1api.BackupNameLabel: "backup-1"}).Result()
+	du.Status.Progress.TotalBytes = 2048
+	// its snapshot was deleted from the repository
+	deletedDU := builder.ForDataUpload(velerov1api.DefaultNamespace, "du-2").SourceNamespace("ns-1").BackupStorageLocation("default").
+		Phase(velerov2alpha1api.DataUploadPhaseCompleted).SnapshotID("snapshot-4").SourcePVC("pvc-3").Result()
+
+	created := now.Add(-time.Hour)
+	pvbTags := map[string]string{"backup": "backup-2", "schedule": "daily", "cluster": "cluster-1", "volume": "data"}
+	duTags := map[string]string{"snapshot-requester": "snapshot-data-upload"}
+	orphanTags := map[string]string{"backup": "backup-0"}
+	repoManager := new(repomocks.Manager)
+	repoManager.On("ListSnapshots", mock.Anything, mock.MatchedBy(func(r *velerov1api.BackupRepository) bool { return r.Name == repo.Name })).Return([]provider.Snapshot{
+		{ID: "snapshot-1", Tags: pvbTags, StartTime: created.Add(-time.Minute), EndTime: created, Size: 4096},
+		{ID: "snapshot-3", Tags: duTags},
+		// its PodVolumeBackup was deleted
+		{ID: "snapshot-5", Tags: orphanTags, EndTime: created, Size: 512},
+	}, nil)
+
+	// its TTL was extended after the snapshot was taken
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-2").Expiration(time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC)).Result()
+
+	kbClient := velerotest.NewFakeControllerRuntimeClient(t, repo, pvb, otherPVB, du, deletedDU, backup)
+	snapshots, err := collectSnapshots(context.Background(), kbClient, repoManager, velerov1api.DefaultNamespace, repo.Name, now)
+	require.NoError(t, err)
+
+	assert.Equal(t, []*Snapshot{
+		{
+			ID:        "snapshot-5",
+			Size:      512,
+			Created:   &metav1.Time{Time: created},
+			Backup:    "backup-0",
+			Retention: "velero-unknown-expiration",
+			Tags:      orphanTags,
+		},
+		{
+			ID:         "snapshot-3",
+			SourceKind: "DataUpload",
+			SourceName: "du-1",
+			PVC:        "ns-1/pvc-2",
+			Size:       2048,
+			Backup:     "backup-1",
+			Retention:  "velero-unknown-expiration",
+			Tags:       duTags,
+		},
+		{
+			ID:         "snapshot-1",
+			SourceKind: "PodVolumeBackup",
+			SourceName: "pvb-1",
+			PVC:        "ns-1/pvc-1",
+			Size:       4096,
+			Created:    &metav1.Time{Time: created},
+			Backup:     "backup-2",
+			Schedule:   "daily",
+			Cluster:    "cluster-1",
+			Retention:  "velero-until-2026-10-31T00:00:00Z",
+			Tags:       pvbTags,
+		},
+	}, snapshots)
+
+	_, err = collectSnapshots(context.Background(), kbClient, repoManager, velerov1api.DefaultNamespace, "missing", now)
+	require.Error(t, err)
+
+	filtered := filterSnapshotsByPVC(snapshots, "ns-1/pvc-1")
+	require.Len(t, filtered, 1)
+	assert.Equal(t, "snapshot-1", filtered[0].ID)
+	assert.Empty(t, filterSnapshotsByPVC(snapshots, "ns-2/pvc-1"))
+}
+
+func TestPrintSnapshot(t *testing.T) {
+	row := printSnapshot(&Snapshot{
+		ID:         "snapshot-1",
+		SourceKind: "PodVolumeBackup",
+		SourceName: "pvb-1",
+		PVC:        "ns-1/pvc-1",
+		Size:       2 * 1024 * 1024,
+		Backup:     "backup-1",
+		Tags:       map[string]string{"backup": "backup-1", "volume": "data", "ns": "ns-1"},
+	})
+	assert.Equal(t, []any{"snapshot-1", "PodVolumeBackup/pvb-1", "ns-1/pvc-1", "2Mi", (*metav1.Time)(nil), "backup-1", "", "", "", "ns=ns-1,volume=data"}, row.Cells)
+
+	// its PodVolumeBackup was deleted
+	row = printSnapshot(&Snapshot{ID: "snapshot-2", Backup: "backup-1", Tags: map[string]string{"backup": "backup-1"}})
+	assert.Equal(t, []any{"snapshot-2", "", "", "0", (*metav1.Time)(nil), "backup-1", "", "", "", ""}, row.Cells)
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -31,6 +32,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware-tanzu/velero/internal/namespacereferences"
 	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
@@ -90,11 +92,15 @@ type CreateOptions struct {
 	ExistingResourcePolicy    string
 	IncludeResources          flag.StringArray
 	ExcludeResources          flag.StringArray
+	Items                     flag.StringArray
 	StatusIncludeResources    flag.StringArray
 	StatusExcludeResources    flag.StringArray
 	NamespaceMappings         flag.Map
+	RewriteNamespaceRefs      flag.OptionalBool
+	NamespaceRefsConfigMap    string
 	Selector                  flag.LabelSelector
 	OrSelector                flag.OrLabelSelector
+	ExcludeSelector           flag.LabelSelector
 	IncludeClusterResources   flag.OptionalBool
 	Wait                      bool
 	AllowPartiallyFailed      flag.OptionalBool
@@ -102,6 +108,31 @@ type CreateOptions struct {
 	ResourceModifierConfigMap string
 	WriteSparseFiles          flag.OptionalBool
 	ParallelFilesDownload     int
+	UIDMapping                flag.Map
+	GIDMapping                flag.Map
+	QuotaReconciliation       string
+	VolumeDetachPolicy        string
+	ErrorBudget               int
+	ProtectNamespaces         flag.OptionalBool
+	TargetCluster             string
+	ScaleDownConflicting      flag.OptionalBool
+	ServerDryRun              flag.OptionalBool
+	Preview                   flag.OptionalBool
+	StagingStorageLocation    string
+	NamespacePriority         flag.StringArray
+	NamespaceMetadataPolicy   string
+	OrderByDependencies       flag.OptionalBool
+	DependencyWaitConditions  flag.StringArray
+	PVCDataSourcePolicy       string
+	PVCDataSourcePolicies     flag.Map
+	ImmutableFieldPolicies    flag.Map
+	GradualScaleStep          int32
+	GradualScaleInterval      time.Duration
+	GradualScaleTimeout       time.Duration
+	LoadBalancerAnnotations   string
+	LoadBalancerStaticIPs     string
+	WaitForLoadBalancers      flag.OptionalBool
+	LoadBalancerTimeout       time.Duration
 	client                    kbclient.WithWatch
 }
 
@@ -111,10 +142,22 @@ func NewCreateOptions() *CreateOptions {
 		Annotations:             flag.NewMap(),
 		IncludeNamespaces:       flag.NewStringArray("*"),
 		NamespaceMappings:       flag.NewMap().WithEntryDelimiter(',').WithKeyValueDelimiter(':'),
+		UIDMapping:              flag.NewMap().WithEntryDelimiter(',').WithKeyValueDelimiter(':'),
+		GIDMapping:              flag.NewMap().WithEntryDelimiter(',').WithKeyValueDelimiter(':'),
+		PVCDataSourcePolicies:   flag.NewMap().WithEntryDelimiter(',').WithKeyValueDelimiter(':'),
+		ImmutableFieldPolicies:  flag.NewMap().WithEntryDelimiter(',').WithKeyValueDelimiter(':'),
 		RestoreVolumes:          flag.NewOptionalBool(nil),
 		PreserveNodePorts:       flag.NewOptionalBool(nil),
 		IncludeClusterResources: flag.NewOptionalBool(nil),
 		WriteSparseFiles:        flag.NewOptionalBool(nil),
+		ErrorBudget:             -1,
+		ProtectNamespaces:       flag.NewOptionalBool(nil),
+		ScaleDownConflicting:    flag.NewOptionalBool(nil),
+		ServerDryRun:            flag.NewOptionalBool(nil),
+		Preview:                 flag.NewOptionalBool(nil),
+		RewriteNamespaceRefs:    flag.NewOptionalBool(nil),
+		OrderByDependencies:     flag.NewOptionalBool(nil),
+		WaitForLoadBalancers:    flag.NewOptionalBool(nil),
 	}
 }
 
@@ -123,18 +166,31 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.ScheduleName, "from-schedule", "", "Schedule to restore from")
 	flags.Var(&o.IncludeNamespaces, "include-namespaces", "Namespaces to include in the restore (use '*' for all namespaces)")
 	flags.Var(&o.ExcludeNamespaces, "exclude-namespaces", "Namespaces to exclude from the restore.")
+	flags.Var(&o.NamespacePriority, "namespace-priority", "Namespaces, or glob patterns like kube-*, whose resources are restored before those of the other namespaces, in the order given. Optional.")
+	flags.StringVar(&o.NamespaceMetadataPolicy, "namespace-metadata-policy", "", "How the labels and annotations of the backed up namespaces are applied to the namespaces which already exist. Valid values are Merge, adding them to the existing ones, and Override, replacing the existing labels and merging the annotations. Optional, the existing namespaces are left as they are by default.")
 	flags.Var(&o.NamespaceMappings, "namespace-mappings", "Namespace mappings from name in the backup to desired restored name in the form src1:dst1,src2:dst2,...")
+	f := flags.VarPF(&o.RewriteNamespaceRefs, "rewrite-namespace-references", "", "Rewrite the references to the mapped namespaces in the fields of the restored items, like the namespace of the service of a webhook configuration or the namespace selectors of a NetworkPolicy.")
+	f.NoOptDefVal = cmd.TRUE
+	flags.StringVar(&o.NamespaceRefsConfigMap, "namespace-reference-rules-configmap", "", "Reference to the configmap with the rules selecting more fields referencing namespaces to rewrite. Requires --rewrite-namespace-references.")
 	flags.Var(&o.Labels, "labels", "Labels to apply to the restore.")
 	flags.Var(&o.Annotations, "annotations", "Annotations to apply to the restore.")
 	flags.Var(&o.IncludeResources, "include-resources", "Resources to include in the restore, formatted as resource.group, such as storageclasses.storage.k8s.io (use '*' for all resources).")
+	flags.Var(&o.Items, "items", "Individual items to restore, formatted as namespace/resource/name, or resource/name for cluster-scoped items, such as app/secret/db-credentials. Only these items are restored. Optional.")
 	flags.Var(&o.ExcludeResources, "exclude-resources", "Resources to exclude from the restore, formatted as resource.group, such as storageclasses.storage.k8s.io.")
-	flags.StringVar(&o.ExistingResourcePolicy, "existing-resource-policy", "", "Restore Policy to be used during the restore workflow, can be - none or update")
+	flags.StringVar(&o.ExistingResourcePolicy, "existing-resource-policy", "", "Restore Policy to be used during the restore workflow, can be - none, update, reconcile or ssa")
+	flags.StringVar(&o.VolumeDetachPolicy, "volume-detach-policy", "", "Wait for the ReadWriteOnce volumes of the restored pods to be detached from the nodes they're still attached to before creating the pods. Valid values are Wait, and ForceDetach to also detach them from the nodes where no pod uses them anymore.")
+	flags.StringVar(&o.PVCDataSourcePolicy, "pvc-data-source-policy", "", "How the data sources of the restored PVCs, the VolumeSnapshots or PVCs they were cloned from, are handled. Valid values are Auto, Strip and Preserve. Optional, Auto removes them when Velero restores the data of the volumes or the sources neither exist nor are restored.")
+	flags.Var(&o.PVCDataSourcePolicies, "pvc-data-source-policy-by-storage-class", "Data source policies of the restored PVCs by storage class, overriding --pvc-data-source-policy, in the form sc1:Strip,sc2:Preserve,...")
+	flags.Var(&o.ImmutableFieldPolicies, "immutable-field-policies", "How the existing items whose immutable fields differ from the backed up version are updated, by resource, in the form services:Skip,jobs.batch:Recreate,persistentvolumeclaims:Fail. Valid policies are Skip, Recreate and Fail. Requires --existing-resource-policy=update.")
+	flags.StringVar(&o.QuotaReconciliation, "quota-reconciliation", "", "Restore the resource quotas and limit ranges first, and reconcile the restored workloads with them. Valid values are Scale, to scale the workloads down to fit the quotas, and Warn, to only report them.")
 	flags.Var(&o.StatusIncludeResources, "status-include-resources", "Resources to include in the restore status, formatted as resource.group, such as storageclasses.storage.k8s.io.")
 	flags.Var(&o.StatusExcludeResources, "status-exclude-resources", "Resources to exclude from the restore status, formatted as resource.group, such as storageclasses.storage.k8s.io.")
 	flags.VarP(&o.Selector, "selector", "l", "Only restore resources matching this label selector.")
 	flags.Var(&o.OrSelector, "or-selector", "Restore resources matching at least one of the label selector from the list. Label selectors should be separated by ' or '. For example, foo=bar or app=nginx")
+	flags.Var(&o.ExcludeSelector, "exclude-label-selector", "Don't restore resources matching this label selector, even if they match --selector or --or-selector.")
 	flags.DurationVar(&o.ItemOperationTimeout, "item-operation-timeout", o.ItemOperationTimeout, "How long to wait for async plugin operations before timeout.")
-	f := flags.VarPF(&o.RestoreVolumes, "restore-volumes", "", "Whether to restore volumes from snapshots.")
+	flags.IntVar(&o.ErrorBudget, "error-budget", o.ErrorBudget, "How many items may fail to be restored before the restore is aborted as Failed. Optional, no limit by default.")
+	f = flags.VarPF(&o.RestoreVolumes, "restore-volumes", "", "Whether to restore volumes from snapshots.")
 	// this allows the user to just specify "--restore-volumes" as shorthand for "--restore-volumes=true"
 	// like a normal bool flag
 	f.NoOptDefVal = cmd.TRUE
@@ -147,6 +203,35 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	f = flags.VarPF(&o.IncludeClusterResources, "include-cluster-resources", "", "Include cluster-scoped resources in the restore.")
 	f.NoOptDefVal = cmd.TRUE
 
+	flags.StringVar(&o.TargetCluster, "target-cluster", "", "Name of the secret holding the kubeconfig of the cluster to restore into, for a server running in the hub mode. Optional.")
+	flags.StringVar(&o.StagingStorageLocation, "staging-storage-location", "", "Name of the read-write backup storage location to store the log and results of the restore in, instead of the location of the backup. Optional, the default location is used when the location of the backup is read-only.")
+
+	f = flags.VarPF(&o.ProtectNamespaces, "protect-namespaces", "", "Protect the namespaces restored into from deletion until the restore is done.")
+	f.NoOptDefVal = cmd.TRUE
+
+	f = flags.VarPF(&o.ScaleDownConflicting, "scale-down-conflicting-workloads", "", "Scale the existing deployments and statefulsets the restore overwrites down, and wait for their pods to terminate, before restoring. Requires --existing-resource-policy=update.")
+	f.NoOptDefVal = cmd.TRUE
+
+	flags.Int32Var(&o.GradualScaleStep, "gradual-scale-step", o.GradualScaleStep, "Restore the deployments with this number of replicas at most, then scale them up by it at a time, once their replicas are ready, to their backed up replicas. Optional.")
+	flags.DurationVar(&o.GradualScaleInterval, "gradual-scale-interval", o.GradualScaleInterval, "How long to wait between the steps of --gradual-scale-step once the replicas are ready. Optional.")
+	flags.DurationVar(&o.GradualScaleTimeout, "gradual-scale-timeout", o.GradualScaleTimeout, "How long to wait for the replicas of a step of --gradual-scale-step to be ready. Defaults to the resource timeout of the server.")
+
+	flags.StringVar(&o.LoadBalancerAnnotations, "load-balancer-annotations", "", "Whether to 'Preserve' or 'Strip' the cloud provider, load balancer controller and ExternalDNS annotations of the services of type LoadBalancer. Defaults to the policy of the backup.")
+	flags.StringVar(&o.LoadBalancerStaticIPs, "load-balancer-static-ips", "", "Whether to 'Preserve' or 'Strip' the static IPs requested by the services of type LoadBalancer. Defaults to the policy of the backup.")
+	f = flags.VarPF(&o.WaitForLoadBalancers, "wait-for-load-balancers", "", "Wait for the load balancers of the restored services of type LoadBalancer to be provisioned before completing the restore.")
+	f.NoOptDefVal = cmd.TRUE
+	flags.DurationVar(&o.LoadBalancerTimeout, "load-balancer-timeout", o.LoadBalancerTimeout, "How long to wait for the load balancers to be provisioned with --wait-for-load-balancers. Defaults to the resource timeout of the server.")
+
+	f = flags.VarPF(&o.ServerDryRun, "server-dry-run", "", "Only submit the items to the API server in dry-run mode, nothing being persisted. The rejected items are reported as errors and the fields changed by the API server as warnings.")
+	f.NoOptDefVal = cmd.TRUE
+
+	f = flags.VarPF(&o.Preview, "preview", "", "Run the restore without writing anything to the cluster, reporting the items it would create, patch or skip and why. The report is shown by 'velero restore describe --details'.")
+	f.NoOptDefVal = cmd.TRUE
+
+	f = flags.VarPF(&o.OrderByDependencies, "order-by-dependencies", "", "Restore each item after the items it depends on, like the persistent volume of a claim or the service of a webhook configuration.")
+	f.NoOptDefVal = cmd.TRUE
+	flags.Var(&o.DependencyWaitConditions, "dependency-wait-condition", "Resource and status condition, like deployments.apps=Available, the restored items of the resource must meet before the items depending on them are restored, when ordering by dependencies. Can be repeated. Optional.")
+
 	f = flags.VarPF(&o.AllowPartiallyFailed, "allow-partially-failed", "", "If using --from-schedule, whether to consider PartiallyFailed backups when looking for the most recent one. This flag has no effect if not using --from-schedule.")
 	f.NoOptDefVal = cmd.TRUE
 
@@ -158,6 +243,8 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	f.NoOptDefVal = cmd.TRUE
 
 	flags.IntVar(&o.ParallelFilesDownload, "parallel-files-download", 0, "The number of restore operations to run in parallel. If set to 0, the default parallelism will be the number of CPUs for the node that node agent pod is running.")
+	flags.Var(&o.UIDMapping, "uid-mapping", "User ID mappings from the owners of the backed up files to the owners of the restored files in the form src1:dst1,src2:dst2,... Only applies to the Filesystem volumes restored by the kopia uploader.")
+	flags.Var(&o.GIDMapping, "gid-mapping", "Group ID mappings from the owners of the backed up files to the owners of the restored files in the form src1:dst1,src2:dst2,... Only applies to the Filesystem volumes restored by the kopia uploader.")
 }
 
 func (o *CreateOptions) Complete(args []string, f client.Factory) error {
@@ -204,7 +291,83 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 	}
 
 	if len(o.ExistingResourcePolicy) > 0 && !restore.IsResourcePolicyValid(o.ExistingResourcePolicy) {
-		return errors.New("existing-resource-policy has invalid value, it accepts only none, update as value")
+		return errors.New("existing-resource-policy has invalid value, it accepts only none, update, reconcile, ssa as value")
+	}
+
+	if boolptr.IsSetToTrue(o.ScaleDownConflicting.Value) && o.ExistingResourcePolicy != string(api.PolicyTypeUpdate) {
+		return errors.New("scale-down-conflicting-workloads requires existing-resource-policy update")
+	}
+
+	if len(o.ImmutableFieldPolicies.Data()) > 0 && o.ExistingResourcePolicy != string(api.PolicyTypeUpdate) {
+		return errors.New("immutable-field-policies requires existing-resource-policy update")
+	}
+	if _, err := parseImmutableFieldPolicies(o.ImmutableFieldPolicies.Data()); err != nil {
+		return err
+	}
+
+	if o.GradualScaleStep < 0 {
+		return errors.New("gradual-scale-step cannot be negative")
+	}
+	if o.GradualScaleStep == 0 && (o.GradualScaleInterval != 0 || o.GradualScaleTimeout != 0) {
+		return errors.New("gradual-scale-interval and gradual-scale-timeout require gradual-scale-step")
+	}
+
+	for _, policy := range []struct{ flag, value string }{
+		{"load-balancer-annotations", o.LoadBalancerAnnotations},
+		{"load-balancer-static-ips", o.LoadBalancerStaticIPs},
+	} {
+		switch api.LoadBalancerFieldPolicy(policy.value) {
+		case "", api.LoadBalancerFieldPolicyPreserve, api.LoadBalancerFieldPolicyStrip:
+		default:
+			return fmt.Errorf("invalid %s %q, valid values are %s and %s", policy.flag, policy.value, api.LoadBalancerFieldPolicyPreserve, api.LoadBalancerFieldPolicyStrip)
+		}
+	}
+	if o.LoadBalancerTimeout != 0 && !boolptr.IsSetToTrue(o.WaitForLoadBalancers.Value) {
+		return errors.New("load-balancer-timeout requires wait-for-load-balancers")
+	}
+
+	if o.NamespaceRefsConfigMap != "" && !boolptr.IsSetToTrue(o.RewriteNamespaceRefs.Value) {
+		return errors.New("namespace-reference-rules-configmap requires rewrite-namespace-references")
+	}
+
+	if boolptr.IsSetToTrue(o.Preview.Value) && boolptr.IsSetToTrue(o.ServerDryRun.Value) {
+		return errors.New("either preview or server-dry-run can be specified, but not both")
+	}
+
+	switch api.VolumeDetachPolicy(o.VolumeDetachPolicy) {
+	case "", api.VolumeDetachPolicyWait, api.VolumeDetachPolicyForceDetach:
+	default:
+		return errors.New("volume-detach-policy has invalid value, it accepts only Wait, ForceDetach as value")
+	}
+
+	switch api.NamespaceMetadataPolicy(o.NamespaceMetadataPolicy) {
+	case "", api.NamespaceMetadataPolicyMerge, api.NamespaceMetadataPolicyOverride:
+	default:
+		return errors.New("namespace-metadata-policy has invalid value, it accepts only Merge, Override as value")
+	}
+
+	switch api.PVCDataSourcePolicy(o.PVCDataSourcePolicy) {
+	case "", api.PVCDataSourcePolicyAuto, api.PVCDataSourcePolicyStrip, api.PVCDataSourcePolicyPreserve:
+	default:
+		return errors.New("pvc-data-source-policy has invalid value, it accepts only Auto, Strip, Preserve as value")
+	}
+
+	if _, err := parsePVCDataSourcePolicies(o.PVCDataSourcePolicies.Data()); err != nil {
+		return err
+	}
+
+	if _, err := parseDependencyWaitConditions(o.DependencyWaitConditions); err != nil {
+		return err
+	}
+
+	if _, err := parseItems(o.Items); err != nil {
+		return err
+	}
+
+	switch api.QuotaReconciliationMode(o.QuotaReconciliation) {
+	case "", api.QuotaReconciliationScale, api.QuotaReconciliationWarn:
+	default:
+		return errors.New("quota-reconciliation has invalid value, it accepts only Scale, Warn as value")
 	}
 
 	if o.ParallelFilesDownload < 0 {
@@ -299,7 +462,7 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		}
 	}
 
-	var resModifiers *corev1.TypedLocalObjectReference
+	var resModifiers, namespaceRefRules *corev1.TypedLocalObjectReference
 
 	if o.ResourceModifierConfigMap != "" {
 		resModifiers = &corev1.TypedLocalObjectReference{
@@ -310,6 +473,14 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		}
 	}
 
+	if o.NamespaceRefsConfigMap != "" {
+		namespaceRefRules = &corev1.TypedLocalObjectReference{
+			APIGroup: &corev1.SchemeGroupVersion.Group,
+			Kind:     namespacereferences.ConfigmapRefType,
+			Name:     o.NamespaceRefsConfigMap,
+		}
+	}
+
 	restore := &api.Restore{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace:   f.Namespace(),
@@ -328,6 +499,7 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 			NamespaceMapping:        o.NamespaceMappings.Data(),
 			LabelSelector:           o.Selector.LabelSelector,
 			OrLabelSelectors:        o.OrSelector.OrLabelSelectors,
+			ExcludeLabelSelector:    o.ExcludeSelector.LabelSelector,
 			RestorePVs:              o.RestoreVolumes.Value,
 			PreserveNodePorts:       o.PreserveNodePorts.Value,
 			IncludeClusterResources: o.IncludeClusterResources.Value,
@@ -338,10 +510,59 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 			UploaderConfig: &api.UploaderConfigForRestore{
 				WriteSparseFiles:      o.WriteSparseFiles.Value,
 				ParallelFilesDownload: o.ParallelFilesDownload,
+				UIDMapping:            o.UIDMapping.Data(),
+				GIDMapping:            o.GIDMapping.Data(),
 			},
+			QuotaReconciliation: api.QuotaReconciliationMode(o.QuotaReconciliation),
+			VolumeDetachPolicy:  api.VolumeDetachPolicy(o.VolumeDetachPolicy),
+			ProtectNamespaces:   o.ProtectNamespaces.Value,
+			TargetCluster:       o.TargetCluster,
+
+			ScaleDownConflictingWorkloads: o.ScaleDownConflicting.Value,
+			ServerDryRun:                  o.ServerDryRun.Value,
+			Preview:                       o.Preview.Value,
+			StagingStorageLocation:        o.StagingStorageLocation,
+			NamespacePriority:             o.NamespacePriority,
+			NamespaceMetadataPolicy:       api.NamespaceMetadataPolicy(o.NamespaceMetadataPolicy),
+			OrderByDependencies:           o.OrderByDependencies.Value,
+			RewriteNamespaceReferences:    o.RewriteNamespaceRefs.Value,
+			NamespaceReferenceRules:       namespaceRefRules,
 		},
 	}
 
+	// the conditions, the items and the data source and immutable field policies were validated
+	restore.Spec.DependencyWaitConditions, _ = parseDependencyWaitConditions(o.DependencyWaitConditions)
+	restore.Spec.IncludedItems, _ = parseItems(o.Items)
+
+	restore.Spec.PVCDataSourcePolicy = api.PVCDataSourcePolicy(o.PVCDataSourcePolicy)
+	restore.Spec.PVCDataSourcePolicyByStorageClass, _ = parsePVCDataSourcePolicies(o.PVCDataSourcePolicies.Data())
+	restore.Spec.ImmutableFieldPolicies, _ = parseImmutableFieldPolicies(o.ImmutableFieldPolicies.Data())
+
+	if o.GradualScaleStep > 0 {
+		restore.Spec.GradualScale = &api.GradualScale{
+			Step:     o.GradualScaleStep,
+			Interval: metav1.Duration{Duration: o.GradualScaleInterval},
+		}
+		if o.GradualScaleTimeout > 0 {
+			restore.Spec.GradualScale.Timeout = &metav1.Duration{Duration: o.GradualScaleTimeout}
+		}
+	}
+
+	if o.LoadBalancerAnnotations != "" || o.LoadBalancerStaticIPs != "" || o.WaitForLoadBalancers.Value != nil {
+		restore.Spec.LoadBalancerPolicy = &api.LoadBalancerPolicy{
+			Annotations:         api.LoadBalancerFieldPolicy(o.LoadBalancerAnnotations),
+			StaticIPs:           api.LoadBalancerFieldPolicy(o.LoadBalancerStaticIPs),
+			WaitForProvisioning: o.WaitForLoadBalancers.Value,
+		}
+		if o.LoadBalancerTimeout > 0 {
+			restore.Spec.LoadBalancerPolicy.Timeout = &metav1.Duration{Duration: o.LoadBalancerTimeout}
+		}
+	}
+
+	if o.ErrorBudget >= 0 {
+		restore.Spec.ErrorBudget = &o.ErrorBudget
+	}
+
 	if len([]string(o.StatusIncludeResources)) > 0 {
 		restore.Spec.RestoreStatus = &api.RestoreStatusSpec{
 			IncludedResources: o.StatusIncludeResources,
@@ -433,3 +654,71 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 
 	return nil
 }
+
+// parseDependencyWaitConditions parses the dependency wait conditions given as resource=condition.
+func parseDependencyWaitConditions(values []string) ([]api.DependencyWaitCondition, error) {
+	var conditions []api.DependencyWaitCondition
+	for _, value := range values {
+		resource, condition, ok := strings.Cut(value, "=")
+		if !ok || resource == "" || condition == "" {
+			return nil, errors.Errorf("dependency-wait-condition %q isn't formatted as resource=condition", value)
+		}
+		conditions = append(conditions, api.DependencyWaitCondition{Resource: resource, Condition: condition})
+	}
+	return conditions, nil
+}
+
+// parseItems parses the items given as namespace/resource/name, or resource/name for the
+// cluster-scoped items.
+func parseItems(values []string) ([]api.RestoreItemSelector, error) {
+	var items []api.RestoreItemSelector
+	for _, value := range values {
+		parts := strings.Split(value, "/")
+		var item api.RestoreItemSelector
+		switch len(parts) {
+		case 2:
+			item = api.RestoreItemSelector{Resource: parts[0], Name: parts[1]}
+		case 3:
+			item = api.RestoreItemSelector{Namespace: parts[0], Resource: parts[1], Name: parts[2]}
+		}
+		if item.Resource == "" || item.Name == "" || (len(parts) == 3 && item.Namespace == "") {
+			return nil, errors.Errorf("item %q isn't formatted as namespace/resource/name or resource/name", value)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// parsePVCDataSourcePolicies parses the data source policies given by storage class.
+func parsePVCDataSourcePolicies(values map[string]string) (map[string]api.PVCDataSourcePolicy, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	policies := make(map[string]api.PVCDataSourcePolicy, len(values))
+	for storageClass, value := range values {
+		switch policy := api.PVCDataSourcePolicy(value); policy {
+		case api.PVCDataSourcePolicyAuto, api.PVCDataSourcePolicyStrip, api.PVCDataSourcePolicyPreserve:
+			policies[storageClass] = policy
+		default:
+			return nil, errors.Errorf("pvc-data-source-policy-by-storage-class has invalid value %q for storage class %s, it accepts only Auto, Strip, Preserve as value", value, storageClass)
+		}
+	}
+	return policies, nil
+}
+
+// parseImmutableFieldPolicies parses the immutable field policies given by resource.
+func parseImmutableFieldPolicies(values map[string]string) (map[string]api.ImmutableFieldPolicy, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	policies := make(map[string]api.ImmutableFieldPolicy, len(values))
+	for resource, value := range values {
+		switch policy := api.ImmutableFieldPolicy(value); policy {
+		case api.ImmutableFieldPolicySkip, api.ImmutableFieldPolicyRecreate, api.ImmutableFieldPolicyFail:
+			policies[resource] = policy
+		default:
+			return nil, errors.Errorf("immutable-field-policies has invalid value %q for resource %s, it accepts only Skip, Recreate, Fail as value", value, resource)
+		}
+	}
+	return policies, nil
+}
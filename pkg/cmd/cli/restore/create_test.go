@@ -75,6 +75,7 @@ func TestCreateCommand(t *testing.T) {
 		statusExcludeResources := "job"
 		namespaceMappings := "a:b"
 		selector := "foo=bar"
+		excludeSelector := "poison=true"
 		includeClusterResources := "true"
 		allowPartiallyFailed := "true"
 		itemOperationTimeout := "10m0s"
@@ -99,6 +100,7 @@ func TestCreateCommand(t *testing.T) {
 		flags.Parse([]string{"--status-exclude-resources", statusExcludeResources})
 		flags.Parse([]string{"--namespace-mappings", namespaceMappings})
 		flags.Parse([]string{"--selector", selector})
+		flags.Parse([]string{"--exclude-label-selector", excludeSelector})
 		flags.Parse([]string{"--include-cluster-resources", includeClusterResources})
 		flags.Parse([]string{"--allow-partially-failed", allowPartiallyFailed})
 		flags.Parse([]string{"--item-operation-timeout", itemOperationTimeout})
@@ -137,6 +139,7 @@ func TestCreateCommand(t *testing.T) {
 		require.Equal(t, statusExcludeResources, o.StatusExcludeResources.String())
 		require.Equal(t, namespaceMappings, o.NamespaceMappings.String())
 		require.Equal(t, selector, o.Selector.String())
+		require.Equal(t, excludeSelector, o.ExcludeSelector.String())
 		require.Equal(t, includeClusterResources, o.IncludeClusterResources.String())
 		require.Equal(t, allowPartiallyFailed, o.AllowPartiallyFailed.String())
 		require.Equal(t, itemOperationTimeout, o.ItemOperationTimeout.String())
@@ -191,3 +194,69 @@ func TestCreateCommand(t *testing.T) {
 		require.Equal(t, "backups.velero.io \"not-exist\" not found", err.Error())
 	})
 }
+
+func TestParseDependencyWaitConditions(t *testing.T) {
+	conditions, err := parseDependencyWaitConditions([]string{"deployments.apps=Available", "certificates.cert-manager.io=Ready"})
+	require.NoError(t, err)
+	require.Equal(t, []velerov1api.DependencyWaitCondition{
+		{Resource: "deployments.apps", Condition: "Available"},
+		{Resource: "certificates.cert-manager.io", Condition: "Ready"},
+	}, conditions)
+
+	_, err = parseDependencyWaitConditions([]string{"deployments.apps"})
+	require.Error(t, err)
+	_, err = parseDependencyWaitConditions([]string{"=Available"})
+	require.Error(t, err)
+}
+
+func TestParseItems(t *testing.T) {
+	items, err := parseItems([]string{"app/secret/db-credentials", "app/pvc/data", "storageclasses.storage.k8s.io/fast"})
+	require.NoError(t, err)
+	require.Equal(t, []velerov1api.RestoreItemSelector{
+		{Namespace: "app", Resource: "secret", Name: "db-credentials"},
+		{Namespace: "app", Resource: "pvc", Name: "data"},
+		{Resource: "storageclasses.storage.k8s.io", Name: "fast"},
+	}, items)
+
+	for _, value := range []string{"db-credentials", "app/secret/", "/secret/db-credentials", "a/b/c/d"} {
+		_, err = parseItems([]string{value})
+		require.Error(t, err, value)
+	}
+}
+
+func TestParsePVCDataSourcePolicies(t *testing.T) {
+	policies, err := parsePVCDataSourcePolicies(map[string]string{"sc-1": "Strip", "sc-2": "Preserve"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]velerov1api.PVCDataSourcePolicy{
+		"sc-1": velerov1api.PVCDataSourcePolicyStrip,
+		"sc-2": velerov1api.PVCDataSourcePolicyPreserve,
+	}, policies)
+
+	policies, err = parsePVCDataSourcePolicies(nil)
+	require.NoError(t, err)
+	require.Nil(t, policies)
+
+	for _, value := range []string{"", "strip", "Keep"} {
+		_, err = parsePVCDataSourcePolicies(map[string]string{"sc-1": value})
+		require.Error(t, err, value)
+	}
+}
+
+func TestParseImmutableFieldPolicies(t *testing.T) {
+	policies, err := parseImmutableFieldPolicies(map[string]string{"services": "Skip", "jobs.batch": "Recreate", "persistentvolumeclaims": "Fail"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]velerov1api.ImmutableFieldPolicy{
+		"services":               velerov1api.ImmutableFieldPolicySkip,
+		"jobs.batch":             velerov1api.ImmutableFieldPolicyRecreate,
+		"persistentvolumeclaims": velerov1api.ImmutableFieldPolicyFail,
+	}, policies)
+
+	policies, err = parseImmutableFieldPolicies(nil)
+	require.NoError(t, err)
+	require.Nil(t, policies)
+
+	for _, value := range []string{"", "skip", "Replace"} {
+		_, err = parseImmutableFieldPolicies(map[string]string{"services": value})
+		require.Error(t, err, value)
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/completion"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/confirm"
 )
 
@@ -39,8 +40,9 @@ func NewDeleteCommand(f client.Factory, use string) *cobra.Command {
 	o := cli.NewDeleteOptions("restore")
 
 	c := &cobra.Command{
-		Use:   fmt.Sprintf("%s [NAMES]", use),
-		Short: "Delete restores",
+		Use:               fmt.Sprintf("%s [NAMES]", use),
+		ValidArgsFunction: completion.RestoreNames(f),
+		Short:             "Delete restores",
 		Example: `  # Delete a restore named "restore-1".
   velero restore delete restore-1
 
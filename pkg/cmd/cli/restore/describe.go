@@ -29,6 +29,7 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/completion"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 	"github.com/vmware-tanzu/velero/pkg/label"
 )
@@ -47,8 +48,9 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 	caCertFile := config.CACertFile()
 
 	c := &cobra.Command{
-		Use:   use + " [NAME1] [NAME2] [NAME...]",
-		Short: "Describe restores",
+		Use:               use + " [NAME1] [NAME2] [NAME...]",
+		ValidArgsFunction: completion.RestoreNames(f),
+		Short:             "Describe restores",
 		Run: func(c *cobra.Command, args []string) {
 			kbClient, err := f.KubebuilderClient()
 			cmd.CheckError(err)
@@ -29,6 +29,7 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/completion"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
 )
 
@@ -43,9 +44,10 @@ func NewLogsCommand(f client.Factory) *cobra.Command {
 	caCertFile := config.CACertFile()
 
 	c := &cobra.Command{
-		Use:   "logs RESTORE",
-		Short: "Get restore logs",
-		Args:  cobra.ExactArgs(1),
+		Use:               "logs RESTORE",
+		ValidArgsFunction: completion.SingleArg(completion.RestoreNames(f)),
+		Short:             "Get restore logs",
+		Args:              cobra.ExactArgs(1),
 		Run: func(c *cobra.Command, args []string) {
 			restoreName := args[0]
 
@@ -19,13 +19,16 @@ package schedule
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
@@ -85,7 +88,18 @@ type CreateOptions struct {
 	SkipOptions                *SkipOptions
 	Schedule                   string
 	UseOwnerReferencesInBackup bool
+	PreserveBackupsOnDelete    bool
 	Paused                     bool
+	StorageBudget              string
+	KeepDaily                  int
+	KeepWeekly                 int
+	KeepMonthly                int
+	Jitter                     time.Duration
+	Timezone                   string
+	DependsOn                  string
+	DependsOnTimeout           time.Duration
+	DependsOnFailurePolicy     string
+	VariablesConfigMap         string
 }
 
 func NewCreateOptions() *CreateOptions {
@@ -99,8 +113,19 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	o.BackupOptions.BindFlags(flags)
 	o.SkipOptions.BindFlags(flags)
 	flags.StringVar(&o.Schedule, "schedule", o.Schedule, "A cron expression specifying a recurring schedule for this backup to run")
+	flags.StringVar(&o.Timezone, "timezone", o.Timezone, "The IANA name of the time zone the schedule is evaluated in, e.g. Australia/Sydney. Defaults to the time zone of the Velero server. Optional.")
 	flags.BoolVar(&o.UseOwnerReferencesInBackup, "use-owner-references-in-backup", o.UseOwnerReferencesInBackup, "Specifies whether to use OwnerReferences on backups created by this Schedule. Notice: if set to true, when schedule is deleted, backups will be deleted too.")
+	flags.BoolVar(&o.PreserveBackupsOnDelete, "preserve-backups-on-schedule-delete", o.PreserveBackupsOnDelete, "Specifies whether the backups owned by this Schedule are kept when it's deleted, by removing its OwnerReferences from them first. Only used with --use-owner-references-in-backup.")
 	flags.BoolVar(&o.Paused, "paused", o.Paused, "Specifies whether the newly created schedule is paused or not.")
+	flags.StringVar(&o.StorageBudget, "storage-budget", o.StorageBudget, "The most storage the backups of the schedule may use, e.g. 500Gi. When exceeded, the oldest backups are deleted before their TTL expires. Optional.")
+	flags.IntVar(&o.KeepDaily, "keep-daily", o.KeepDaily, "The number of the last days whose newest backup is kept by the retention policy of the schedule. The backups the policy doesn't keep are deleted before their TTL expires. Optional.")
+	flags.IntVar(&o.KeepWeekly, "keep-weekly", o.KeepWeekly, "The number of the last weeks whose newest backup is kept by the retention policy of the schedule. Optional.")
+	flags.DurationVar(&o.Jitter, "jitter", o.Jitter, "The most the backups of the schedule are delayed at random after the time they're due at, so that the schedules due at the same time don't all create their backup at once. Optional.")
+	flags.IntVar(&o.KeepMonthly, "keep-monthly", o.KeepMonthly, "The number of the last months whose newest backup is kept by the retention policy of the schedule. Optional.")
+	flags.StringVar(&o.DependsOn, "depends-on", o.DependsOn, "The name of the schedule whose most recent backup must complete successfully before the backups of this schedule start. Optional.")
+	flags.DurationVar(&o.DependsOnTimeout, "depends-on-timeout", o.DependsOnTimeout, "How long a due backup waits for the backup of the schedule it depends on. Optional, one hour by default.")
+	flags.StringVar(&o.DependsOnFailurePolicy, "depends-on-failure-policy", o.DependsOnFailurePolicy, "What happens to a due backup when the backup of the schedule it depends on failed or timed out, either Skip or Run. Optional, Skip by default.")
+	flags.StringVar(&o.VariablesConfigMap, "variables-configmap", o.VariablesConfigMap, "The name of the ConfigMap whose data are variables expanded in the labels, annotations and storage location of the backups of the schedule, along with ${date}, ${schedule} and ${clusterName}. Nothing is expanded without it. Optional.")
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -108,6 +133,38 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--schedule is required")
 	}
 
+	if o.StorageBudget != "" {
+		if _, err := resource.ParseQuantity(o.StorageBudget); err != nil {
+			return errors.Wrap(err, "invalid --storage-budget")
+		}
+	}
+
+	if o.KeepDaily < 0 || o.KeepWeekly < 0 || o.KeepMonthly < 0 {
+		return errors.New("--keep-daily, --keep-weekly and --keep-monthly must not be negative")
+	}
+
+	if o.Jitter < 0 {
+		return errors.New("--jitter must not be negative")
+	}
+
+	if o.Timezone != "" {
+		if _, err := time.LoadLocation(o.Timezone); err != nil {
+			return errors.Wrap(err, "invalid --timezone")
+		}
+	}
+
+	if o.DependsOn == "" && (o.DependsOnTimeout != 0 || o.DependsOnFailurePolicy != "") {
+		return errors.New("--depends-on-timeout and --depends-on-failure-policy require --depends-on")
+	}
+	if o.DependsOnTimeout < 0 {
+		return errors.New("--depends-on-timeout must not be negative")
+	}
+	switch api.ScheduleDependencyFailurePolicy(o.DependsOnFailurePolicy) {
+	case "", api.ScheduleDependencyFailurePolicySkip, api.ScheduleDependencyFailurePolicyRun:
+	default:
+		return errors.Errorf("invalid --depends-on-failure-policy %q, it must be %s or %s", o.DependsOnFailurePolicy, api.ScheduleDependencyFailurePolicySkip, api.ScheduleDependencyFailurePolicyRun)
+	}
+
 	return o.BackupOptions.Validate(c, args, f)
 }
 
@@ -152,28 +209,88 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 				SnapshotVolumes:                  o.BackupOptions.SnapshotVolumes.Value,
 				TTL:                              metav1.Duration{Duration: o.BackupOptions.TTL},
 				StorageLocation:                  o.BackupOptions.StorageLocation,
+				MirrorStorageLocation:            o.BackupOptions.MirrorStorageLocation,
+				MirrorFailurePolicy:              api.MirrorFailurePolicy(o.BackupOptions.MirrorFailurePolicy),
+				TargetCluster:                    o.BackupOptions.TargetCluster,
 				VolumeSnapshotLocations:          o.BackupOptions.SnapshotLocations,
 				DefaultVolumesToFsBackup:         o.BackupOptions.DefaultVolumesToFsBackup.Value,
 				OrderedResources:                 orders,
 				CSISnapshotTimeout:               metav1.Duration{Duration: o.BackupOptions.CSISnapshotTimeout},
 				ItemOperationTimeout:             metav1.Duration{Duration: o.BackupOptions.ItemOperationTimeout},
+				MaxDuration:                      metav1.Duration{Duration: o.BackupOptions.MaxDuration},
+				MaxDurationAction:                api.MaxDurationAction(o.BackupOptions.MaxDurationAction),
 				DataMover:                        o.BackupOptions.DataMover,
 				SnapshotMoveData:                 o.BackupOptions.SnapshotMoveData.Value,
+				NamespacePhased:                  o.BackupOptions.NamespacePhased.Value,
+				IncludeClusterScopedDependencies: o.BackupOptions.IncludeClusterScopedDeps.Value,
+				VolumeGroupSnapshotLabelKey:      o.BackupOptions.VolumeGroupSnapshotLabelKey,
 			},
-			Schedule:                   o.Schedule,
-			UseOwnerReferencesInBackup: &o.UseOwnerReferencesInBackup,
-			Paused:                     o.Paused,
-			SkipImmediately:            o.SkipOptions.SkipImmediately.Value,
+			Schedule:                        o.Schedule,
+			Timezone:                        o.Timezone,
+			VariablesConfigMap:              o.VariablesConfigMap,
+			Jitter:                          metav1.Duration{Duration: o.Jitter},
+			UseOwnerReferencesInBackup:      &o.UseOwnerReferencesInBackup,
+			PreserveBackupsOnScheduleDelete: o.PreserveBackupsOnDelete,
+			Paused:                          o.Paused,
+			SkipImmediately:                 o.SkipOptions.SkipImmediately.Value,
 		},
 	}
 
+	if o.StorageBudget != "" {
+		storageBudget := resource.MustParse(o.StorageBudget)
+		schedule.Spec.StorageBudget = &storageBudget
+	}
+
+	if o.DependsOn != "" {
+		schedule.Spec.DependsOn = &api.ScheduleDependency{
+			Schedule:      o.DependsOn,
+			Timeout:       metav1.Duration{Duration: o.DependsOnTimeout},
+			FailurePolicy: api.ScheduleDependencyFailurePolicy(o.DependsOnFailurePolicy),
+		}
+	}
+
+	if o.KeepDaily > 0 || o.KeepWeekly > 0 || o.KeepMonthly > 0 {
+		schedule.Spec.Retention = &api.RetentionPolicy{
+			KeepDaily:   o.KeepDaily,
+			KeepWeekly:  o.KeepWeekly,
+			KeepMonthly: o.KeepMonthly,
+		}
+	}
+
+	if len(o.BackupOptions.Tags.Data()) > 0 {
+		schedule.Spec.Template.Tags = o.BackupOptions.Tags.Data()
+	}
+
+	if o.BackupOptions.ErrorBudget >= 0 {
+		schedule.Spec.Template.ErrorBudget = &o.BackupOptions.ErrorBudget
+	}
+
+	if o.BackupOptions.PhaseRetries > 0 {
+		schedule.Spec.Template.PhaseRetries = &api.BackupPhaseRetries{
+			ItemCollection: o.BackupOptions.PhaseRetries,
+			Snapshot:       o.BackupOptions.PhaseRetries,
+			Upload:         o.BackupOptions.PhaseRetries,
+		}
+	}
+
+	schedule.Spec.Template.LoadBalancerPolicy = o.BackupOptions.LoadBalancerPolicy()
+
 	if o.BackupOptions.ResPoliciesConfigmap != "" {
 		schedule.Spec.Template.ResourcePolicy = &v1.TypedLocalObjectReference{Kind: resourcepolicies.ConfigmapRefType, Name: o.BackupOptions.ResPoliciesConfigmap}
 	}
 
-	if o.BackupOptions.ParallelFilesUpload > 0 {
+	if schedule.Spec.Template.InlineResourcePolicy, err = o.BackupOptions.InlineResourcePolicy(); err != nil {
+		return err
+	}
+
+	if o.BackupOptions.ResourceModifierConfigMap != "" {
+		schedule.Spec.Template.ResourceModifier = &v1.TypedLocalObjectReference{Kind: resourcemodifiers.ConfigmapRefType, Name: o.BackupOptions.ResourceModifierConfigMap}
+	}
+
+	if o.BackupOptions.ParallelFilesUpload > 0 || o.BackupOptions.ChangedBlockTracking {
 		schedule.Spec.Template.UploaderConfig = &api.UploaderConfigForBackup{
-			ParallelFilesUpload: o.BackupOptions.ParallelFilesUpload,
+			ParallelFilesUpload:  o.BackupOptions.ParallelFilesUpload,
+			ChangedBlockTracking: o.BackupOptions.ChangedBlockTracking,
 		}
 	}
 
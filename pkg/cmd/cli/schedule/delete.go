@@ -31,6 +31,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/completion"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/confirm"
 )
 
@@ -39,8 +40,9 @@ func NewDeleteCommand(f client.Factory, use string) *cobra.Command {
 	o := cli.NewDeleteOptions("schedule")
 
 	c := &cobra.Command{
-		Use:   fmt.Sprintf("%s [NAMES]", use),
-		Short: "Delete schedules",
+		Use:               fmt.Sprintf("%s [NAMES]", use),
+		ValidArgsFunction: completion.ScheduleNames(f),
+		Short:             "Delete schedules",
 		Example: `  # Delete a schedule named "schedule-1".
   velero schedule delete schedule-1
 
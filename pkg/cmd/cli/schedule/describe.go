@@ -28,6 +28,7 @@ import (
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/completion"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 )
 
@@ -35,8 +36,9 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 	var listOptions metav1.ListOptions
 
 	c := &cobra.Command{
-		Use:   use + " [NAME1] [NAME2] [NAME...]",
-		Short: "Describe schedules",
+		Use:               use + " [NAME1] [NAME2] [NAME...]",
+		ValidArgsFunction: completion.ScheduleNames(f),
+		Short:             "Describe schedules",
 		Run: func(c *cobra.Command, args []string) {
 			crClient, err := f.KubebuilderClient()
 			cmd.CheckError(err)
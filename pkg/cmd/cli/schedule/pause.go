@@ -32,6 +32,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/completion"
 )
 
 // NewPauseCommand creates the command for pause
@@ -40,8 +41,9 @@ func NewPauseCommand(f client.Factory, use string) *cobra.Command {
 	pauseOpts := NewPauseOptions()
 
 	c := &cobra.Command{
-		Use:   use,
-		Short: "Pause schedules",
+		Use:               use,
+		ValidArgsFunction: completion.ScheduleNames(f),
+		Short:             "Pause schedules",
 		Example: `  # Pause a schedule named "schedule-1".
   velero schedule pause schedule-1
 
@@ -22,6 +22,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/completion"
 )
 
 // NewUnpauseCommand creates the command for unpause
@@ -29,8 +30,9 @@ func NewUnpauseCommand(f client.Factory, use string) *cobra.Command {
 	o := cli.NewSelectOptions("pause", "schedule")
 	pauseOpts := NewPauseOptions()
 	c := &cobra.Command{
-		Use:   use,
-		Short: "Unpause schedules",
+		Use:               use,
+		ValidArgsFunction: completion.ScheduleNames(f),
+		Short:             "Unpause schedules",
 		Example: `  # Unpause a schedule named "schedule-1".
   velero schedule unpause schedule-1
 
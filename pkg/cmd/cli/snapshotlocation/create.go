@@ -60,6 +60,9 @@ type CreateOptions struct {
 	Config     flag.Map
 	Labels     flag.Map
 	Credential flag.Map
+
+	CreationsPerMinute int
+	DeletionsPerMinute int
 }
 
 func NewCreateOptions() *CreateOptions {
@@ -75,6 +78,8 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.Var(&o.Config, "config", "Configuration key-value pairs.")
 	flags.Var(&o.Labels, "labels", "Labels to apply to the volume snapshot location.")
 	flags.Var(&o.Credential, "credential", "The credential to be used by this location as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. Optional, one value only.")
+	flags.IntVar(&o.CreationsPerMinute, "snapshot-creations-per-minute", o.CreationsPerMinute, "Maximum number of snapshots Velero creates per minute in this location, across all the backups running concurrently. Optional, no limit by default.")
+	flags.IntVar(&o.DeletionsPerMinute, "snapshot-deletions-per-minute", o.DeletionsPerMinute, "Maximum number of snapshots Velero deletes per minute in this location, across all the backup deletions running concurrently. Optional, no limit by default.")
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -90,6 +95,10 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--credential can only contain 1 key/value pair")
 	}
 
+	if o.CreationsPerMinute < 0 || o.DeletionsPerMinute < 0 {
+		return errors.New("--snapshot-creations-per-minute and --snapshot-deletions-per-minute must not be negative")
+	}
+
 	return nil
 }
 
@@ -114,6 +123,12 @@ func (o *CreateOptions) BuildVolumeSnapshotLocation(namespace string) *api.Volum
 		volumeSnapshotLocation.Spec.Credential = builder.ForSecretKeySelector(secretName, secretKey).Result()
 		break
 	}
+	if o.CreationsPerMinute > 0 || o.DeletionsPerMinute > 0 {
+		volumeSnapshotLocation.Spec.RateLimit = &api.SnapshotRateLimit{
+			CreationsPerMinute: o.CreationsPerMinute,
+			DeletionsPerMinute: o.DeletionsPerMinute,
+		}
+	}
 	return volumeSnapshotLocation
 }
 
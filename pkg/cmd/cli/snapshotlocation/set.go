@@ -30,6 +30,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/completion"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 )
@@ -38,9 +39,10 @@ func NewSetCommand(f client.Factory, use string) *cobra.Command {
 	o := NewSetOptions()
 
 	c := &cobra.Command{
-		Use:   use + " NAME",
-		Short: "Set specific features for a snapshot location",
-		Args:  cobra.ExactArgs(1),
+		Use:               use + " NAME",
+		ValidArgsFunction: completion.SingleArg(completion.VolumeSnapshotLocationNames(f)),
+		Short:             "Set specific features for a snapshot location",
+		Args:              cobra.ExactArgs(1),
 		Run: func(c *cobra.Command, args []string) {
 			cmd.CheckError(o.Complete(args, f))
 			cmd.CheckError(o.Validate(c, args, f))
@@ -55,6 +57,9 @@ func NewSetCommand(f client.Factory, use string) *cobra.Command {
 type SetOptions struct {
 	Name       string
 	Credential flag.Map
+
+	CreationsPerMinute int
+	DeletionsPerMinute int
 }
 
 func NewSetOptions() *SetOptions {
@@ -65,6 +70,8 @@ func NewSetOptions() *SetOptions {
 
 func (o *SetOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.Var(&o.Credential, "credential", "Sets the credential to be used by this location as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. Optional, one value only.")
+	flags.IntVar(&o.CreationsPerMinute, "snapshot-creations-per-minute", o.CreationsPerMinute, "Sets the maximum number of snapshots Velero creates per minute in this location, 0 for no limit.")
+	flags.IntVar(&o.DeletionsPerMinute, "snapshot-deletions-per-minute", o.DeletionsPerMinute, "Sets the maximum number of snapshots Velero deletes per minute in this location, 0 for no limit.")
 }
 
 func (o *SetOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -76,6 +83,10 @@ func (o *SetOptions) Validate(c *cobra.Command, args []string, f client.Factory)
 		return errors.New("--credential can only contain 1 key/value pair")
 	}
 
+	if o.CreationsPerMinute < 0 || o.DeletionsPerMinute < 0 {
+		return errors.New("--snapshot-creations-per-minute and --snapshot-deletions-per-minute must not be negative")
+	}
+
 	return nil
 }
 
@@ -104,6 +115,18 @@ func (o *SetOptions) Run(c *cobra.Command, f client.Factory) error {
 		break
 	}
 
+	if c.Flags().Changed("snapshot-creations-per-minute") || c.Flags().Changed("snapshot-deletions-per-minute") {
+		if location.Spec.RateLimit == nil {
+			location.Spec.RateLimit = &velerov1api.SnapshotRateLimit{}
+		}
+		if c.Flags().Changed("snapshot-creations-per-minute") {
+			location.Spec.RateLimit.CreationsPerMinute = o.CreationsPerMinute
+		}
+		if c.Flags().Changed("snapshot-deletions-per-minute") {
+			location.Spec.RateLimit.DeletionsPerMinute = o.DeletionsPerMinute
+		}
+	}
+
 	if err := kbClient.Update(context.Background(), location, &kbclient.UpdateOptions{}); err != nil {
 		return errors.WithStack(err)
 	}
@@ -2,9 +2,11 @@ package config
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/pflag"
 
@@ -23,6 +25,8 @@ const (
 
 	defaultBackupSyncPeriod           = time.Minute
 	defaultStoreValidationFrequency   = time.Minute
+	defaultStoreValidationJitter      = 0.1
+	defaultStoreValidationMaxBackoff  = time.Hour
 	defaultPodVolumeOperationTimeout  = 240 * time.Minute
 	defaultResourceTerminatingTimeout = 10 * time.Minute
 
@@ -55,6 +59,16 @@ const (
 	DefaultMaintenanceJobMemLimit    = "0"
 
 	DefaultItemBlockWorkerCount = 1
+
+	defaultMetricsRemoteWriteTimeout = 30 * time.Second
+
+	defaultPluginGRPCKeepaliveTimeout = 20 * time.Second
+
+	defaultAdaptiveThrottlingLatencyThreshold = time.Second
+	defaultAdaptiveThrottlingMaxDelay         = 2 * time.Second
+
+	// PluginGRPCCompressionGzip compresses the messages between Velero and its plugins with gzip.
+	PluginGRPCCompressionGzip = "gzip"
 )
 
 var (
@@ -65,14 +79,18 @@ var (
 		constant.ControllerBackupDeletion,
 		constant.ControllerBackupFinalizer,
 		constant.ControllerBackupSync,
+		constant.ControllerBackupVerification,
 		constant.ControllerDownloadRequest,
 		constant.ControllerGarbageCollection,
 		constant.ControllerBackupRepo,
 		constant.ControllerRestore,
 		constant.ControllerRestoreOperations,
+		constant.ControllerRestoreTest,
+		constant.ControllerRetention,
 		constant.ControllerSchedule,
 		constant.ControllerServerStatusRequest,
 		constant.ControllerRestoreFinalizer,
+		constant.ControllerStorageLayoutMigration,
 	}
 
 	/*
@@ -107,6 +125,8 @@ var (
 		  - CAPI Clusters come before ClusterResourceSets because failing to do so means the CAPI controller-manager will panic.
 		    Both Clusters and ClusterResourceSets need to come before ClusterResourceSetBinding in order to properly restore workload clusters.
 		    See https://github.com/kubernetes-sigs/cluster-api/issues/4105
+		  - CAPI MachineDeployments, MachineSets and Machines go after Clusters, so that the reconciliation of their
+		    Cluster has already been paused by the cluster-api-pause RestoreItemAction when they are created.
 		  - apps.kappctrl.k14s.io and packageinstalls.packaging.carvel.dev go after workloads(pod/replicaset/etc.), otherwise the controller may
 		    creates new workloads before restoring them
 	*/
@@ -138,6 +158,9 @@ var (
 		LowPriorities: []string{
 			"clusterbootstraps.run.tanzu.vmware.com",
 			"clusters.cluster.x-k8s.io",
+			"machinedeployments.cluster.x-k8s.io",
+			"machinesets.cluster.x-k8s.io",
+			"machines.cluster.x-k8s.io",
 			"clusterresourcesets.addons.cluster.x-k8s.io",
 			"apps.kappctrl.k14s.io",
 			"packageinstalls.packaging.carvel.dev",
@@ -154,6 +177,9 @@ type Config struct {
 	ResourceTerminatingTimeout     time.Duration
 	DefaultBackupTTL               time.Duration
 	StoreValidationFrequency       time.Duration
+	StoreValidationJitter          float64
+	StoreValidationMaxBackoff      time.Duration
+	StoreValidationConcurrency     int
 	DefaultCSISnapshotTimeout      time.Duration
 	DefaultItemOperationTimeout    time.Duration
 	ResourceTimeout                time.Duration
@@ -174,14 +200,94 @@ type Config struct {
 	UploaderType                   string
 	MaxConcurrentK8SConnections    int
 	DefaultSnapshotMoveData        bool
+	DefaultResourcePolicies        string
+	BackupCheckpointInterval       time.Duration
+	Agentless                      bool
+	Hub                            bool
 	DisableInformerCache           bool
 	ScheduleSkipImmediately        bool
+	MaxConcurrentScheduledBackups  int
 	CredentialsDirectory           string
 	BackupRepoConfig               string
 	RepoMaintenanceJobConfig       string
 	PodResources                   kube.PodResources
 	KeepLatestMaintenanceJobs      int
 	ItemBlockWorkerCount           int
+	MetricsRemoteWriteURL          string
+	MetricsRemoteWriteLabels       flag.Map
+	MetricsRemoteWriteTokenFile    string
+	MetricsRemoteWriteTimeout      time.Duration
+	CSISnapshotConcurrency         flag.Map
+	DiscoveryCacheConfigMap        string
+	RuntimeConfigMap               string
+	PluginGRPC                     PluginGRPCConfig
+	AdaptiveThrottling             AdaptiveThrottlingConfig
+}
+
+// PluginGRPCConfig is the configuration of the gRPC connections between Velero and its plugins.
+// The plugin processes are started with the flags of the server, so both ends of the connections
+// share it.
+type PluginGRPCConfig struct {
+	// MaxMessageSize is the largest message in bytes which may be sent or received, zero meaning
+	// the gRPC default of 4MiB.
+	MaxMessageSize int
+	// KeepaliveTime is how often a connection is pinged when idle, zero meaning never.
+	KeepaliveTime time.Duration
+	// KeepaliveTimeout is how long to wait for the reply to a ping before closing the connection.
+	KeepaliveTimeout time.Duration
+	// Compression is the compressor of the messages, either empty for none or gzip.
+	Compression string
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c PluginGRPCConfig) Validate() error {
+	if c.MaxMessageSize < 0 {
+		return errors.New("plugin-grpc-max-message-size must not be negative")
+	}
+	if c.KeepaliveTime < 0 || c.KeepaliveTimeout < 0 {
+		return errors.New("plugin-grpc-keepalive-time and plugin-grpc-keepalive-timeout must not be negative")
+	}
+	if c.Compression != "" && c.Compression != PluginGRPCCompressionGzip {
+		return errors.Errorf("invalid plugin-grpc-compression %q, the only supported compression is %s", c.Compression, PluginGRPCCompressionGzip)
+	}
+	return nil
+}
+
+// AdaptiveThrottlingConfig is the configuration of the adaptive throttling of the backups, which
+// slows down the collection and the backup of the items while the API server is overloaded.
+type AdaptiveThrottlingConfig struct {
+	// Enabled enables the adaptive throttling.
+	Enabled bool
+	// LatencyThreshold is the average latency of the API server over which it's considered
+	// overloaded, besides when it throttles the requests, zero meaning only the throttling counts.
+	LatencyThreshold time.Duration
+	// MaxDelay is the largest delay added before each request and each item.
+	MaxDelay time.Duration
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c AdaptiveThrottlingConfig) Validate() error {
+	if c.LatencyThreshold < 0 {
+		return errors.New("adaptive-throttling-latency-threshold must not be negative")
+	}
+	if c.Enabled && c.MaxDelay <= 0 {
+		return errors.New("adaptive-throttling-max-delay must be positive")
+	}
+	return nil
+}
+
+// CSISnapshotLimits returns how many CSI snapshots may be created at once, keyed by the names of
+// the storage classes or CSI drivers.
+func (c *Config) CSISnapshotLimits() (map[string]int, error) {
+	limits := make(map[string]int)
+	for name, value := range c.CSISnapshotConcurrency.Data() {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			return nil, errors.Errorf("invalid csi-snapshot-concurrency %q for %s, it must be a positive integer", value, name)
+		}
+		limits[name] = limit
+	}
+	return limits, nil
 }
 
 func GetDefaultConfig() *Config {
@@ -196,6 +302,9 @@ func GetDefaultConfig() *Config {
 		DefaultItemOperationTimeout:    defaultItemOperationTimeout,
 		ResourceTimeout:                resourceTimeout,
 		StoreValidationFrequency:       defaultStoreValidationFrequency,
+		StoreValidationJitter:          defaultStoreValidationJitter,
+		StoreValidationMaxBackoff:      defaultStoreValidationMaxBackoff,
+		StoreValidationConcurrency:     1,
 		PodVolumeOperationTimeout:      defaultPodVolumeOperationTimeout,
 		RestoreResourcePriorities:      defaultRestorePriorities,
 		ClientQPS:                      defaultClientQPS,
@@ -220,6 +329,16 @@ func GetDefaultConfig() *Config {
 		},
 		KeepLatestMaintenanceJobs: DefaultKeepLatestMaintenanceJobs,
 		ItemBlockWorkerCount:      DefaultItemBlockWorkerCount,
+		MetricsRemoteWriteLabels:  flag.NewMap(),
+		CSISnapshotConcurrency:    flag.NewMap(),
+		MetricsRemoteWriteTimeout: defaultMetricsRemoteWriteTimeout,
+		PluginGRPC: PluginGRPCConfig{
+			KeepaliveTimeout: defaultPluginGRPCKeepaliveTimeout,
+		},
+		AdaptiveThrottling: AdaptiveThrottlingConfig{
+			LatencyThreshold: defaultAdaptiveThrottlingLatencyThreshold,
+			MaxDelay:         defaultAdaptiveThrottlingMaxDelay,
+		},
 	}
 
 	return config
@@ -237,6 +356,9 @@ func (c *Config) BindFlags(flags *pflag.FlagSet) {
 	flags.Var(&c.RestoreResourcePriorities, "restore-resource-priorities", "Desired order of resource restores, the priority list contains two parts which are split by \"-\" element. The resources before \"-\" element are restored first as high priorities, the resources after \"-\" element are restored last as low priorities, and any resource not in the list will be restored alphabetically between the high and low priorities.")
 	flags.StringVar(&c.DefaultBackupLocation, "default-backup-storage-location", c.DefaultBackupLocation, "Name of the default backup storage location. DEPRECATED: this flag will be removed in v2.0. Use \"velero backup-location set --default\" instead.")
 	flags.DurationVar(&c.StoreValidationFrequency, "store-validation-frequency", c.StoreValidationFrequency, "How often to verify if the storage is valid. Optional. Set this to `0s` to disable sync. Default 1 minute.")
+	flags.Float64Var(&c.StoreValidationJitter, "store-validation-jitter", c.StoreValidationJitter, "Fraction of the validation frequency of the backup storage locations added to it, different for each location, so that the locations aren't all validated at once. Set this to 0 to disable the jitter.")
+	flags.DurationVar(&c.StoreValidationMaxBackoff, "store-validation-max-backoff", c.StoreValidationMaxBackoff, "Maximum interval between the validations of a backup storage location, whose validation frequency is doubled each time its validation fails again. Set this to `0s` to disable the backoff.")
+	flags.IntVar(&c.StoreValidationConcurrency, "store-validation-concurrency", c.StoreValidationConcurrency, "Maximum number of backup storage locations validated at the same time.")
 	flags.Float32Var(&c.ClientQPS, "client-qps", c.ClientQPS, "Maximum number of requests per second by the server to the Kubernetes API once the burst limit has been reached.")
 	flags.IntVar(&c.ClientBurst, "client-burst", c.ClientBurst, "Maximum number of requests by the server to the Kubernetes API in a short period of time.")
 	flags.IntVar(&c.ClientPageSize, "client-page-size", c.ClientPageSize, "Page size of requests by the server to the Kubernetes API when listing objects during a backup. Set to 0 to disable paging.")
@@ -252,8 +374,13 @@ func (c *Config) BindFlags(flags *pflag.FlagSet) {
 	flags.DurationVar(&c.ResourceTimeout, "resource-timeout", c.ResourceTimeout, "How long to wait for resource processes which are not covered by other specific timeout parameters. Default is 10 minutes.")
 	flags.IntVar(&c.MaxConcurrentK8SConnections, "max-concurrent-k8s-connections", c.MaxConcurrentK8SConnections, "Max concurrent connections number that Velero can create with kube-apiserver. Default is 30.")
 	flags.BoolVar(&c.DefaultSnapshotMoveData, "default-snapshot-move-data", c.DefaultSnapshotMoveData, "Move data by default for all snapshots supporting data movement.")
+	flags.StringVar(&c.DefaultResourcePolicies, "default-resource-policies-configmap", c.DefaultResourcePolicies, "Name of the ConfigMap of the resource policies applied to the backups which don't refer to any, in the Velero namespace. Optional.")
+	flags.DurationVar(&c.BackupCheckpointInterval, "backup-checkpoint-interval", c.BackupCheckpointInterval, "How often the progress of the backups in progress is saved to their backup storage location, so that they resume from it instead of failing when the server restarts. Optional, 0 disables the checkpoints.")
+	flags.BoolVar(&c.Agentless, "agentless", c.Agentless, "Run the server without the node-agent, typically outside of the cluster it backs up, which is reached through --kubeconfig. The file system backups and restores of pod volumes are skipped, and the snapshot data isn't moved.")
+	flags.BoolVar(&c.Hub, "hub", c.Hub, "Run the server as the hub of a fleet of clusters: the backups and restores with a target cluster are processed against the cluster reached through the kubeconfig stored in the secret of the server namespace they refer to, without the node-agent.")
 	flags.BoolVar(&c.DisableInformerCache, "disable-informer-cache", c.DisableInformerCache, "Disable informer cache for Get calls on restore. With this enabled, it will speed up restore in cases where there are backup resources which already exist in the cluster, but for very large clusters this will increase velero memory usage. Default is false (don't disable).")
 	flags.BoolVar(&c.ScheduleSkipImmediately, "schedule-skip-immediately", c.ScheduleSkipImmediately, "Skip the first scheduled backup immediately after creating a schedule. Default is false (don't skip).")
+	flags.IntVar(&c.MaxConcurrentScheduledBackups, "max-concurrent-scheduled-backups", c.MaxConcurrentScheduledBackups, "Max number of scheduled backups running at once. The schedules due while the limit is reached wait in a first in, first out queue. Optional, 0 means no limit.")
 	flags.Var(&c.DefaultVolumeSnapshotLocations, "default-volume-snapshot-locations", "List of unique volume providers and default volume snapshot location (provider1:location-01,provider2:location-02,...)")
 
 	flags.IntVar(
@@ -304,4 +431,86 @@ func (c *Config) BindFlags(flags *pflag.FlagSet) {
 		c.ItemBlockWorkerCount,
 		"Number of worker threads to process ItemBlocks. Default is one. Optional.",
 	)
+	flags.StringVar(
+		&c.MetricsRemoteWriteURL,
+		"metrics-remote-write-url",
+		c.MetricsRemoteWriteURL,
+		"Prometheus remote-write endpoint to push the outcome metrics of each completed backup to. Optional.",
+	)
+	flags.Var(
+		&c.MetricsRemoteWriteLabels,
+		"metrics-remote-write-labels",
+		"Labels added to the pushed backup outcome metrics to identify the cluster (key1=value1,key2=value2). Optional.",
+	)
+	flags.StringVar(
+		&c.MetricsRemoteWriteTokenFile,
+		"metrics-remote-write-bearer-token-file",
+		c.MetricsRemoteWriteTokenFile,
+		"File holding the bearer token used to authenticate to the Prometheus remote-write endpoint. Optional.",
+	)
+	flags.DurationVar(
+		&c.MetricsRemoteWriteTimeout,
+		"metrics-remote-write-timeout",
+		c.MetricsRemoteWriteTimeout,
+		"How long to wait for the Prometheus remote-write endpoint to accept pushed metrics. Default is 30 seconds.",
+	)
+	flags.Var(
+		&c.CSISnapshotConcurrency,
+		"csi-snapshot-concurrency",
+		"How many CSI snapshots may be created at once for each storage class or CSI driver (storageClassOrDriver1=count1,storageClassOrDriver2=count2), the volumes over the limit are queued. Optional.",
+	)
+	flags.StringVar(
+		&c.DiscoveryCacheConfigMap,
+		"discovery-cache-configmap",
+		c.DiscoveryCacheConfigMap,
+		"The name of the ConfigMap to persist the discovery results to, so the server starts from them after a restart and refreshes them asynchronously. Optional.",
+	)
+	flags.StringVar(
+		&c.RuntimeConfigMap,
+		"runtime-configmap",
+		c.RuntimeConfigMap,
+		"The name of the ConfigMap to change the log level, the feature flags and the number of ItemBlock workers of the server from while it runs, without restarting it. Optional.",
+	)
+	flags.IntVar(
+		&c.PluginGRPC.MaxMessageSize,
+		"plugin-grpc-max-message-size",
+		c.PluginGRPC.MaxMessageSize,
+		"The largest message in bytes exchanged with the plugins, raise it when backing up or restoring items larger than the gRPC default of 4MiB. Optional.",
+	)
+	flags.DurationVar(
+		&c.PluginGRPC.KeepaliveTime,
+		"plugin-grpc-keepalive-time",
+		c.PluginGRPC.KeepaliveTime,
+		"How often to ping the idle connections to the plugins. Default is 0, no pings. Optional.",
+	)
+	flags.DurationVar(
+		&c.PluginGRPC.KeepaliveTimeout,
+		"plugin-grpc-keepalive-timeout",
+		c.PluginGRPC.KeepaliveTimeout,
+		"How long to wait for the reply to a ping before closing the connection to a plugin. Default is 20 seconds.",
+	)
+	flags.StringVar(
+		&c.PluginGRPC.Compression,
+		"plugin-grpc-compression",
+		c.PluginGRPC.Compression,
+		"The compression of the messages exchanged with the plugins, either empty for none or gzip. Optional.",
+	)
+	flags.BoolVar(
+		&c.AdaptiveThrottling.Enabled,
+		"adaptive-throttling",
+		c.AdaptiveThrottling.Enabled,
+		"Slow down the collection and the backup of the items while the API server throttles the requests or responds slowly, and speed them up again once it's idle. Optional.",
+	)
+	flags.DurationVar(
+		&c.AdaptiveThrottling.LatencyThreshold,
+		"adaptive-throttling-latency-threshold",
+		c.AdaptiveThrottling.LatencyThreshold,
+		"The average latency of the API server over which the backups are slowed down when adaptive throttling is enabled, 0 meaning only the throttled requests count. Default is 1 second.",
+	)
+	flags.DurationVar(
+		&c.AdaptiveThrottling.MaxDelay,
+		"adaptive-throttling-max-delay",
+		c.AdaptiveThrottling.MaxDelay,
+		"The largest delay added before each request and each item when adaptive throttling is enabled. Default is 2 seconds.",
+	)
 }
@@ -17,3 +17,33 @@ func TestBindFlags(t *testing.T) {
 	config.BindFlags(pflag.CommandLine)
 	assert.Equal(t, "0", config.PodResources.CPULimit)
 }
+
+func TestPluginGRPCConfigValidate(t *testing.T) {
+	assert.NoError(t, GetDefaultConfig().PluginGRPC.Validate())
+	assert.NoError(t, PluginGRPCConfig{MaxMessageSize: 16 * 1024 * 1024, Compression: PluginGRPCCompressionGzip}.Validate())
+	assert.EqualError(t, PluginGRPCConfig{MaxMessageSize: -1}.Validate(), "plugin-grpc-max-message-size must not be negative")
+	assert.EqualError(t, PluginGRPCConfig{Compression: "zstd"}.Validate(), `invalid plugin-grpc-compression "zstd", the only supported compression is gzip`)
+}
+
+func TestAdaptiveThrottlingConfigValidate(t *testing.T) {
+	assert.NoError(t, GetDefaultConfig().AdaptiveThrottling.Validate())
+	assert.NoError(t, AdaptiveThrottlingConfig{}.Validate())
+	assert.EqualError(t, AdaptiveThrottlingConfig{LatencyThreshold: -1}.Validate(), "adaptive-throttling-latency-threshold must not be negative")
+	assert.EqualError(t, AdaptiveThrottlingConfig{Enabled: true}.Validate(), "adaptive-throttling-max-delay must be positive")
+}
+
+func TestCSISnapshotLimits(t *testing.T) {
+	config := GetDefaultConfig()
+	limits, err := config.CSISnapshotLimits()
+	assert.NoError(t, err)
+	assert.Empty(t, limits)
+
+	assert.NoError(t, config.CSISnapshotConcurrency.Set("gp3=2,ebs.csi.aws.com=4"))
+	limits, err = config.CSISnapshotLimits()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"gp3": 2, "ebs.csi.aws.com": 4}, limits)
+
+	assert.NoError(t, config.CSISnapshotConcurrency.Set("gp3=0"))
+	_, err = config.CSISnapshotLimits()
+	assert.EqualError(t, err, `invalid csi-snapshot-concurrency "0" for gp3, it must be a positive integer`)
+}
@@ -36,7 +36,9 @@ import (
 	plugincommon "github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
 	ria "github.com/vmware-tanzu/velero/pkg/restore/actions"
 	csiria "github.com/vmware-tanzu/velero/pkg/restore/actions/csi"
+	openshiftria "github.com/vmware-tanzu/velero/pkg/restore/actions/openshift"
 	"github.com/vmware-tanzu/velero/pkg/util/actionhelpers"
+	"github.com/vmware-tanzu/velero/pkg/util/swift"
 )
 
 func NewCommand(f client.Factory) *cobra.Command {
@@ -62,6 +64,14 @@ func NewCommand(f client.Factory) *cobra.Command {
 					"velero.io/service-account",
 					newServiceAccountBackupItemAction(f),
 				).
+				RegisterBackupItemAction(
+					"velero.io/cluster-api-cluster",
+					newClusterAPIClusterBackupItemAction(f),
+				).
+				RegisterBackupItemAction(
+					"velero.io/cluster-scoped-dependencies",
+					newClusterScopedDependenciesBackupItemAction,
+				).
 				RegisterRestoreItemAction(
 					"velero.io/job",
 					newJobRestoreItemAction,
@@ -98,6 +108,10 @@ func NewCommand(f client.Factory) *cobra.Command {
 					"velero.io/change-image-name",
 					newChangeImageNameRestoreItemAction(f),
 				).
+				RegisterRestoreItemAction(
+					"velero.io/change-image-pull-secret",
+					newChangeImagePullSecretRestoreItemAction(f),
+				).
 				RegisterRestoreItemAction(
 					"velero.io/role-bindings",
 					newRoleBindingItemAction,
@@ -130,6 +144,26 @@ func NewCommand(f client.Factory) *cobra.Command {
 					"velero.io/dataupload",
 					newDataUploadRetrieveAction(f),
 				).
+				RegisterRestoreItemAction(
+					"velero.io/cluster-api-pause",
+					newClusterAPIPauseRestoreItemAction,
+				).
+				RegisterRestoreItemAction(
+					"velero.io/openshift-build",
+					newOpenShiftBuildRestoreItemAction,
+				).
+				RegisterRestoreItemAction(
+					"velero.io/openshift-image-reference",
+					newOpenShiftImageReferenceRestoreItemAction,
+				).
+				RegisterRestoreItemAction(
+					"velero.io/openshift-scc",
+					newOpenShiftSCCRestoreItemAction,
+				).
+				RegisterRestoreItemAction(
+					"velero.io/openshift-project",
+					newOpenShiftProjectRestoreItemAction,
+				).
 				RegisterDeleteItemAction(
 					"velero.io/dataupload-delete",
 					newDateUploadDeleteItemAction(f),
@@ -181,6 +215,10 @@ func NewCommand(f client.Factory) *cobra.Command {
 				RegisterItemBlockAction(
 					"velero.io/service-account",
 					newServiceAccountItemBlockAction(f),
+				).
+				RegisterObjectStore(
+					"velero.io/swift",
+					newSwiftObjectStore,
 				)
 
 			if !features.IsEnabled(velerov1api.APIGroupVersionsFeatureFlag) {
@@ -209,6 +247,10 @@ func newPodBackupItemAction(logger logrus.FieldLogger) (any, error) {
 	return bia.NewPodAction(logger), nil
 }
 
+func newClusterScopedDependenciesBackupItemAction(logger logrus.FieldLogger) (any, error) {
+	return bia.NewClusterScopedDependenciesAction(logger), nil
+}
+
 func newServiceAccountBackupItemAction(f client.Factory) plugincommon.HandlerInitializer {
 	return func(logger logrus.FieldLogger) (any, error) {
 		// TODO(ncdc): consider a k8s style WantsKubernetesClientSet initialization approach
@@ -239,6 +281,17 @@ func newServiceAccountBackupItemAction(f client.Factory) plugincommon.HandlerIni
 	}
 }
 
+func newClusterAPIClusterBackupItemAction(f client.Factory) plugincommon.HandlerInitializer {
+	return func(logger logrus.FieldLogger) (any, error) {
+		clientset, err := f.KubeClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return bia.NewClusterAPIClusterAction(logger, clientset.CoreV1()), nil
+	}
+}
+
 func newRemapCRDVersionAction(f client.Factory) plugincommon.HandlerInitializer {
 	return func(logger logrus.FieldLogger) (any, error) {
 		config, err := f.ClientConfig()
@@ -336,6 +389,23 @@ func newChangeImageNameRestoreItemAction(f client.Factory) plugincommon.HandlerI
 		), nil
 	}
 }
+
+func newChangeImagePullSecretRestoreItemAction(f client.Factory) plugincommon.HandlerInitializer {
+	return func(logger logrus.FieldLogger) (any, error) {
+		client, err := f.KubeClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return ria.NewChangeImagePullSecretAction(
+			logger,
+			client.CoreV1().ConfigMaps(f.Namespace()),
+			client.CoreV1(),
+			f.Namespace(),
+		), nil
+	}
+}
+
 func newRoleBindingItemAction(logger logrus.FieldLogger) (any, error) {
 	return ria.NewRoleBindingAction(logger), nil
 }
@@ -377,6 +447,26 @@ func newSecretRestoreItemAction(f client.Factory) plugincommon.HandlerInitialize
 	}
 }
 
+func newClusterAPIPauseRestoreItemAction(logger logrus.FieldLogger) (any, error) {
+	return ria.NewClusterAPIPauseAction(logger), nil
+}
+
+func newOpenShiftBuildRestoreItemAction(logger logrus.FieldLogger) (any, error) {
+	return openshiftria.NewBuildAction(logger), nil
+}
+
+func newOpenShiftImageReferenceRestoreItemAction(logger logrus.FieldLogger) (any, error) {
+	return openshiftria.NewImageReferenceAction(logger), nil
+}
+
+func newOpenShiftSCCRestoreItemAction(logger logrus.FieldLogger) (any, error) {
+	return openshiftria.NewSecurityContextConstraintsAction(logger), nil
+}
+
+func newOpenShiftProjectRestoreItemAction(logger logrus.FieldLogger) (any, error) {
+	return openshiftria.NewProjectAction(logger), nil
+}
+
 func newDataUploadRetrieveAction(f client.Factory) plugincommon.HandlerInitializer {
 	return func(logger logrus.FieldLogger) (any, error) {
 		client, err := f.KubebuilderClient()
@@ -481,3 +571,7 @@ func newServiceAccountItemBlockAction(f client.Factory) plugincommon.HandlerInit
 		return action, nil
 	}
 }
+
+func newSwiftObjectStore(logger logrus.FieldLogger) (any, error) {
+	return swift.NewObjectStore(logger), nil
+}
@@ -57,6 +57,8 @@ import (
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/internal/hook"
 	"github.com/vmware-tanzu/velero/internal/storage"
+	"github.com/vmware-tanzu/velero/internal/throttle"
+	"github.com/vmware-tanzu/velero/internal/volume"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
 	"github.com/vmware-tanzu/velero/pkg/backup"
@@ -69,18 +71,22 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/controller"
 	velerodiscovery "github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/health"
 	"github.com/vmware-tanzu/velero/pkg/itemoperationmap"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/nodeagent"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/podvolume"
 	"github.com/vmware-tanzu/velero/pkg/repository"
 	repokey "github.com/vmware-tanzu/velero/pkg/repository/keys"
 	repomanager "github.com/vmware-tanzu/velero/pkg/repository/manager"
 	"github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/targetcluster"
 	"github.com/vmware-tanzu/velero/pkg/uploader"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
@@ -140,6 +146,9 @@ type server struct {
 	discoveryClient  discovery.AggregatedDiscoveryInterface
 	discoveryHelper  velerodiscovery.Helper
 	dynamicClient    dynamic.Interface
+	// loadThrottler slows down the backups while the API server is overloaded, nil when the
+	// adaptive throttling is disabled.
+	loadThrottler *throttle.Throttler
 	// controller-runtime client. the difference from the controller-manager's client
 	// is that the controller-manager's client is limited to list namespaced-scoped
 	// resources in the namespace where Velero is installed, or the cluster-scoped
@@ -148,6 +157,7 @@ type server struct {
 	ctx                   context.Context
 	cancelFunc            context.CancelFunc
 	logger                logrus.FieldLogger
+	rootLogger            *logrus.Logger
 	logLevel              logrus.Level
 	pluginRegistry        process.Registry
 	repoManager           repomanager.Manager
@@ -181,12 +191,28 @@ func newServer(f client.Factory, config *config.Config, logger *logrus.Logger) (
 		return nil, errors.New("client-page-size must not be negative")
 	}
 
+	if config.MaxConcurrentScheduledBackups < 0 {
+		return nil, errors.New("max-concurrent-scheduled-backups must not be negative")
+	}
+
+	if _, err := config.CSISnapshotLimits(); err != nil {
+		return nil, err
+	}
+
+	if err := config.PluginGRPC.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := config.AdaptiveThrottling.Validate(); err != nil {
+		return nil, err
+	}
+
 	kubeClient, err := f.KubeClient()
 	if err != nil {
 		return nil, err
 	}
 
-	dynamicClient, err := f.DynamicClient()
+	dynamicClient, loadThrottler, err := newDynamicClient(f, config.AdaptiveThrottling, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -302,10 +328,12 @@ func newServer(f client.Factory, config *config.Config, logger *logrus.Logger) (
 		kubeClient:            kubeClient,
 		discoveryClient:       discoveryClient,
 		dynamicClient:         dynamicClient,
+		loadThrottler:         loadThrottler,
 		crClient:              crClient,
 		ctx:                   ctx,
 		cancelFunc:            cancelFunc,
 		logger:                logger,
+		rootLogger:            logger,
 		logLevel:              logger.Level,
 		pluginRegistry:        pluginRegistry,
 		config:                config,
@@ -317,6 +345,30 @@ func newServer(f client.Factory, config *config.Config, logger *logrus.Logger) (
 	return s, nil
 }
 
+// newDynamicClient returns the dynamic client the backups list and get the items with. When the
+// adaptive throttling is enabled, its requests are observed to detect the API server is
+// overloaded and the returned throttler slows down the backups accordingly.
+func newDynamicClient(f client.Factory, throttling config.AdaptiveThrottlingConfig, logger logrus.FieldLogger) (dynamic.Interface, *throttle.Throttler, error) {
+	if !throttling.Enabled {
+		dynamicClient, err := f.DynamicClient()
+		return dynamicClient, nil, err
+	}
+
+	clientConfig, err := f.ClientConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	probe := throttle.NewAPIServerProbe(throttling.LatencyThreshold)
+	clientConfig.Wrap(probe.Wrap)
+	dynamicClient, err := dynamic.NewForConfig(clientConfig)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	logger.Infof("Adaptive throttling enabled, the backups are slowed down by up to %s per item while the API server is overloaded", throttling.MaxDelay)
+	return dynamicClient, throttle.NewThrottler(probe, throttling.MaxDelay, logger), nil
+}
+
 func (s *server) run() error {
 	signals.CancelOnShutdown(s.cancelFunc, s.logger)
 
@@ -364,7 +416,7 @@ func (s *server) setupBeforeControllerRun() error {
 		return errors.WithStack(err)
 	}
 
-	markInProgressCRsFailed(s.ctx, client, s.namespace, s.logger)
+	markInProgressCRsFailed(s.ctx, client, s.namespace, s.config.BackupCheckpointInterval > 0, s.logger)
 
 	if err := setDefaultBackupLocation(s.ctx, client, s.namespace, s.config.DefaultBackupLocation, s.logger); err != nil {
 		return err
@@ -417,7 +469,12 @@ func (s *server) namespaceExists(namespace string) error {
 // initDiscoveryHelper instantiates the server's discovery helper and spawns a
 // goroutine to call Refresh() every 5 minutes.
 func (s *server) initDiscoveryHelper() error {
-	discoveryHelper, err := velerodiscovery.NewHelper(s.discoveryClient, s.logger)
+	var cache velerodiscovery.Cache
+	if s.config.DiscoveryCacheConfigMap != "" {
+		cache = velerodiscovery.NewConfigMapCache(s.kubeClient.CoreV1(), s.namespace, s.config.DiscoveryCacheConfigMap)
+	}
+
+	discoveryHelper, err := velerodiscovery.NewHelperWithCache(s.discoveryClient, cache, s.logger)
 	if err != nil {
 		return err
 	}
@@ -473,6 +530,11 @@ func (s *server) veleroResourcesExist() error {
 }
 
 func (s *server) checkNodeAgent() {
+	if s.config.Agentless {
+		s.logger.Info("Running agentless, the node agent isn't used: the pod volume backups/restores are skipped and the snapshot data isn't moved")
+		return
+	}
+
 	// warn if node agent does not exist
 	if kube.WithLinuxNode(s.ctx, s.crClient, s.logger) {
 		if err := nodeagent.IsRunningOnLinux(s.ctx, s.kubeClient, s.namespace); err == nodeagent.ErrDaemonSetNotFound {
@@ -515,9 +577,13 @@ func (s *server) initRepoManager() error {
 func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string) error {
 	s.logger.Info("Starting controllers")
 
+	healthChecker := s.newHealthChecker()
+
 	go func() {
 		metricsMux := http.NewServeMux()
 		metricsMux.Handle("/metrics", promhttp.Handler())
+		metricsMux.Handle("/readyz", healthChecker.ReadyzHandler())
+		metricsMux.Handle("/healthz/detailed", healthChecker.DetailedHandler())
 		s.logger.Infof("Starting metric server at address [%s]", s.metricsAddress)
 		server := &http.Server{
 			Addr:              s.metricsAddress,
@@ -533,8 +599,22 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	// Initialize manual backup metrics
 	s.metrics.InitSchedule("")
 
+	if s.config.MetricsRemoteWriteURL != "" {
+		remoteWriter, err := metrics.NewRemoteWriter(metrics.RemoteWriteConfig{
+			URL:             s.config.MetricsRemoteWriteURL,
+			Labels:          s.config.MetricsRemoteWriteLabels.Data(),
+			BearerTokenFile: s.config.MetricsRemoteWriteTokenFile,
+			Timeout:         s.config.MetricsRemoteWriteTimeout,
+		}, s.logger)
+		if err != nil {
+			return err
+		}
+		s.metrics.SetRemoteWriter(remoteWriter)
+		s.logger.Infof("Pushing backup outcome metrics to %s", s.config.MetricsRemoteWriteURL)
+	}
+
 	newPluginManager := func(logger logrus.FieldLogger) clientmgmt.Manager {
-		return clientmgmt.NewManager(logger, s.logLevel, s.pluginRegistry)
+		return clientmgmt.NewManager(logger, s.logLevel, s.pluginRegistry, framework.GRPCDialOptions(s.config.PluginGRPC)...)
 	}
 
 	backupStoreGetter := persistence.NewObjectBackupStoreGetter(s.credentialFileStore)
@@ -547,29 +627,34 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	// and BSL controller is mandatory for Velero to work.
 	// Note: all runtime type controllers that can be disabled are grouped separately, below:
 	enabledRuntimeControllers := map[string]struct{}{
-		constant.ControllerBackup:              {},
-		constant.ControllerBackupDeletion:      {},
-		constant.ControllerBackupFinalizer:     {},
-		constant.ControllerBackupOperations:    {},
-		constant.ControllerBackupRepo:          {},
-		constant.ControllerBackupSync:          {},
-		constant.ControllerDownloadRequest:     {},
-		constant.ControllerGarbageCollection:   {},
-		constant.ControllerRestore:             {},
-		constant.ControllerRestoreOperations:   {},
-		constant.ControllerSchedule:            {},
-		constant.ControllerServerStatusRequest: {},
-		constant.ControllerRestoreFinalizer:    {},
+		constant.ControllerBackup:                 {},
+		constant.ControllerBackupDeletion:         {},
+		constant.ControllerBackupFinalizer:        {},
+		constant.ControllerBackupOperations:       {},
+		constant.ControllerBackupRepo:             {},
+		constant.ControllerBackupSync:             {},
+		constant.ControllerBackupVerification:     {},
+		constant.ControllerDownloadRequest:        {},
+		constant.ControllerGarbageCollection:      {},
+		constant.ControllerRestore:                {},
+		constant.ControllerRestoreOperations:      {},
+		constant.ControllerRestoreTest:            {},
+		constant.ControllerRetention:              {},
+		constant.ControllerSchedule:               {},
+		constant.ControllerServerStatusRequest:    {},
+		constant.ControllerRestoreFinalizer:       {},
+		constant.ControllerStorageLayoutMigration: {},
 	}
 
 	if s.config.RestoreOnly {
-		s.logger.Info("Restore only mode - not starting the backup, schedule, delete-backup, or GC controllers")
+		s.logger.Info("Restore only mode - not starting the backup, schedule, delete-backup, GC, or retention controllers")
 		s.config.DisabledControllers = append(s.config.DisabledControllers,
 			constant.ControllerBackup,
 			constant.ControllerBackupDeletion,
 			constant.ControllerBackupFinalizer,
 			constant.ControllerBackupOperations,
 			constant.ControllerGarbageCollection,
+			constant.ControllerRetention,
 			constant.ControllerSchedule,
 		)
 	}
@@ -585,44 +670,118 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		s.ctx,
 		s.mgr.GetClient(),
 		storage.DefaultBackupLocationInfo{
-			StorageLocation:           s.config.DefaultBackupLocation,
-			ServerValidationFrequency: s.config.StoreValidationFrequency,
+			StorageLocation:             s.config.DefaultBackupLocation,
+			ServerValidationFrequency:   s.config.StoreValidationFrequency,
+			ServerValidationJitter:      s.config.StoreValidationJitter,
+			ServerValidationMaxBackoff:  s.config.StoreValidationMaxBackoff,
+			ServerValidationConcurrency: s.config.StoreValidationConcurrency,
 		},
 		newPluginManager,
 		backupStoreGetter,
+		healthChecker,
 		s.logger,
 	)
 	if err := bslr.SetupWithManager(s.mgr); err != nil {
 		s.logger.Fatal(err, "unable to create controller", "controller", constant.ControllerBackupStorageLocation)
 	}
 
+	// The snapshot API rate limits are shared by the backup and backup deletion controllers.
+	snapshotThrottler := volume.NewSnapshotThrottler(s.metrics)
+
+	// The CSI snapshot limits are shared by all the backups, the configuration was validated by newServer.
+	var csiSnapshotLimiter *volume.CSISnapshotLimiter
+	if csiSnapshotLimits, _ := s.config.CSISnapshotLimits(); len(csiSnapshotLimits) > 0 {
+		csiSnapshotLimiter = volume.NewCSISnapshotLimiter(csiSnapshotLimits)
+	}
+
 	pvbInformer, err := s.mgr.GetCache().GetInformer(s.ctx, &velerov1api.PodVolumeBackup{})
 	if err != nil {
 		s.logger.Fatal(err, "fail to get controller-runtime informer from manager for PVB")
 	}
+	// the pod volumes are backed up by the node-agent, so they're skipped when it isn't used
+	newPodVolumeBackupperFactory := func() podvolume.BackupperFactory {
+		if s.config.Agentless {
+			return nil
+		}
+		return podvolume.NewBackupperFactory(s.repoLocker, s.repoEnsurer, s.crClient, pvbInformer, s.logger)
+	}
+
+	multiHookTracker := hook.NewMultiHookTracker()
+
+	// in the hub mode, the backups and restores with a target cluster are processed against that
+	// cluster, which is backed up and restored without the node-agent
+	var targetClusters targetcluster.Getter
+	if s.config.Hub {
+		targetClusters = targetcluster.NewGetter(s.crClient, s.namespace, s.mgr.GetScheme(), func(cluster *targetcluster.Cluster) error {
+			podCommandExecutor := podexec.NewPodCommandExecutor(cluster.RESTConfig, cluster.KubeClient.CoreV1().RESTClient())
+			backupper, err := backup.NewKubernetesBackupper(
+				s.crClient,
+				cluster.CRClient,
+				cluster.DiscoveryHelper,
+				client.NewDynamicFactory(cluster.DynamicClient),
+				podCommandExecutor,
+				nil,
+				s.config.PodVolumeOperationTimeout,
+				false,
+				s.config.ClientPageSize,
+				s.config.UploaderType,
+				newPluginManager,
+				backupStoreGetter,
+				snapshotThrottler,
+				csiSnapshotLimiter,
+				nil,
+			)
+			if err != nil {
+				return err
+			}
+			restorer, err := restore.NewKubernetesRestorer(
+				cluster.DiscoveryHelper,
+				client.NewDynamicFactory(cluster.DynamicClient),
+				s.config.RestoreResourcePriorities,
+				cluster.KubeClient.CoreV1().Namespaces(),
+				nil,
+				s.config.PodVolumeOperationTimeout,
+				s.config.ResourceTerminatingTimeout,
+				s.config.ResourceTimeout,
+				s.logger,
+				podCommandExecutor,
+				cluster.KubeClient.CoreV1().RESTClient(),
+				s.credentialFileStore,
+				s.mgr.GetClient(),
+				multiHookTracker,
+			)
+			if err != nil {
+				return err
+			}
+			cluster.Backupper = backupper
+			cluster.Restorer = restorer
+			return nil
+		}, s.logger)
+		s.logger.Info("Hub mode - backing up and restoring the target clusters of the backups and restores")
+	}
 
+	// setItemBlockWorkerCount is nil unless the backup controller is enabled
+	var setItemBlockWorkerCount func(int)
 	if _, ok := enabledRuntimeControllers[constant.ControllerBackup]; ok {
 		backupper, err := backup.NewKubernetesBackupper(
+			s.crClient,
 			s.crClient,
 			s.discoveryHelper,
 			client.NewDynamicFactory(s.dynamicClient),
 			podexec.NewPodCommandExecutor(s.kubeClientConfig, s.kubeClient.CoreV1().RESTClient()),
-			podvolume.NewBackupperFactory(
-				s.repoLocker,
-				s.repoEnsurer,
-				s.crClient,
-				pvbInformer,
-				s.logger,
-			),
+			newPodVolumeBackupperFactory(),
 			s.config.PodVolumeOperationTimeout,
 			s.config.DefaultVolumesToFsBackup,
 			s.config.ClientPageSize,
 			s.config.UploaderType,
 			newPluginManager,
 			backupStoreGetter,
+			snapshotThrottler,
+			csiSnapshotLimiter,
+			s.loadThrottler,
 		)
 		cmd.CheckError(err)
-		if err := controller.NewBackupReconciler(
+		backupReconciler := controller.NewBackupReconciler(
 			s.ctx,
 			s.discoveryHelper,
 			backupper,
@@ -644,11 +803,17 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.credentialFileStore,
 			s.config.MaxConcurrentK8SConnections,
 			s.config.DefaultSnapshotMoveData,
+			s.config.DefaultResourcePolicies,
+			s.config.BackupCheckpointInterval,
 			s.config.ItemBlockWorkerCount,
 			s.crClient,
-		).SetupWithManager(s.mgr); err != nil {
+			s.config.Agentless,
+			targetClusters,
+		)
+		if err := backupReconciler.SetupWithManager(s.mgr); err != nil {
 			s.logger.Fatal(err, "unable to create controller", "controller", constant.ControllerBackup)
 		}
+		setItemBlockWorkerCount = backupReconciler.SetItemBlockWorkerCount
 	}
 
 	if _, ok := enabledRuntimeControllers[constant.ControllerBackupDeletion]; ok {
@@ -663,6 +828,9 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			backupStoreGetter,
 			s.credentialFileStore,
 			s.repoEnsurer,
+			snapshotThrottler,
+			s.kubeClient,
+			podexec.NewPodCommandExecutor(s.kubeClientConfig, s.kubeClient.CoreV1().RESTClient()),
 		).SetupWithManager(s.mgr); err != nil {
 			s.logger.Fatal(err, "unable to create controller", "controller", constant.ControllerBackupDeletion)
 		}
@@ -686,23 +854,21 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 
 	if _, ok := enabledRuntimeControllers[constant.ControllerBackupFinalizer]; ok {
 		backupper, err := backup.NewKubernetesBackupper(
+			s.mgr.GetClient(),
 			s.mgr.GetClient(),
 			s.discoveryHelper,
 			client.NewDynamicFactory(s.dynamicClient),
 			podexec.NewPodCommandExecutor(s.kubeClientConfig, s.kubeClient.CoreV1().RESTClient()),
-			podvolume.NewBackupperFactory(
-				s.repoLocker,
-				s.repoEnsurer,
-				s.crClient,
-				pvbInformer,
-				s.logger,
-			),
+			newPodVolumeBackupperFactory(),
 			s.config.PodVolumeOperationTimeout,
 			s.config.DefaultVolumesToFsBackup,
 			s.config.ClientPageSize,
 			s.config.UploaderType,
 			newPluginManager,
 			backupStoreGetter,
+			snapshotThrottler,
+			csiSnapshotLimiter,
+			s.loadThrottler,
 		)
 		cmd.CheckError(err)
 		r := controller.NewBackupFinalizerReconciler(
@@ -716,6 +882,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.logger,
 			s.metrics,
 			s.config.ResourceTimeout,
+			targetClusters,
 		)
 		if err := r.SetupWithManager(s.mgr); err != nil {
 			s.logger.Fatal(err, "unable to create controller", "controller", constant.ControllerBackupFinalizer)
@@ -776,6 +943,39 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	if _, ok := enabledRuntimeControllers[constant.ControllerRestoreTest]; ok {
+		r := controller.NewRestoreTestReconciler(s.logger, s.mgr.GetClient(), targetClusters)
+		if err := r.SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", constant.ControllerRestoreTest)
+		}
+	}
+
+	if _, ok := enabledRuntimeControllers[constant.ControllerBackupVerification]; ok {
+		r := controller.NewBackupVerificationReconciler(
+			s.mgr.GetClient(),
+			clock.RealClock{},
+			newPluginManager,
+			backupStoreGetter,
+			s.logger,
+		)
+		if err := r.SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", constant.ControllerBackupVerification)
+		}
+	}
+
+	if _, ok := enabledRuntimeControllers[constant.ControllerStorageLayoutMigration]; ok {
+		r := controller.NewStorageLayoutMigrationReconciler(
+			s.mgr.GetClient(),
+			clock.RealClock{},
+			newPluginManager,
+			backupStoreGetter,
+			s.logger,
+		)
+		if err := r.SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", constant.ControllerStorageLayoutMigration)
+		}
+	}
+
 	if _, ok := enabledRuntimeControllers[constant.ControllerDownloadRequest]; ok {
 		r := controller.NewDownloadRequestReconciler(
 			s.mgr.GetClient(),
@@ -798,12 +998,21 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	if _, ok := enabledRuntimeControllers[constant.ControllerRetention]; ok {
+		r := controller.NewRetentionReconciler(s.logger, s.mgr.GetClient(), s.config.GarbageCollectionFrequency)
+		if err := r.SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", constant.ControllerRetention)
+		}
+	}
+
 	pvrInformer, err := s.mgr.GetCache().GetInformer(s.ctx, &velerov1api.PodVolumeRestore{})
 	if err != nil {
 		s.logger.Fatal(err, "fail to get controller-runtime informer from manager for PVR")
 	}
-
-	multiHookTracker := hook.NewMultiHookTracker()
+	var podVolumeRestorerFactory podvolume.RestorerFactory
+	if !s.config.Agentless {
+		podVolumeRestorerFactory = podvolume.NewRestorerFactory(s.repoLocker, s.repoEnsurer, s.kubeClient, s.crClient, pvrInformer, s.logger)
+	}
 
 	if _, ok := enabledRuntimeControllers[constant.ControllerRestore]; ok {
 		restorer, err := restore.NewKubernetesRestorer(
@@ -811,14 +1020,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			client.NewDynamicFactory(s.dynamicClient),
 			s.config.RestoreResourcePriorities,
 			s.kubeClient.CoreV1().Namespaces(),
-			podvolume.NewRestorerFactory(
-				s.repoLocker,
-				s.repoEnsurer,
-				s.kubeClient,
-				s.crClient,
-				pvrInformer,
-				s.logger,
-			),
+			podVolumeRestorerFactory,
 			s.config.PodVolumeOperationTimeout,
 			s.config.ResourceTerminatingTimeout,
 			s.config.ResourceTimeout,
@@ -847,6 +1049,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.config.DisableInformerCache,
 			s.crClient,
 			s.config.ResourceTimeout,
+			targetClusters,
 		)
 
 		if err = r.SetupWithManager(s.mgr); err != nil {
@@ -855,7 +1058,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	}
 
 	if _, ok := enabledRuntimeControllers[constant.ControllerSchedule]; ok {
-		if err := controller.NewScheduleReconciler(s.namespace, s.logger, s.mgr.GetClient(), s.metrics, s.config.ScheduleSkipImmediately).SetupWithManager(s.mgr); err != nil {
+		if err := controller.NewScheduleReconciler(s.namespace, s.logger, s.mgr.GetClient(), s.metrics, s.config.ScheduleSkipImmediately, s.config.MaxConcurrentScheduledBackups).SetupWithManager(s.mgr); err != nil {
 			s.logger.Fatal(err, "unable to create controller", "controller", constant.ControllerSchedule)
 		}
 	}
@@ -883,11 +1086,28 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.crClient,
 			multiHookTracker,
 			s.config.ResourceTimeout,
+			targetClusters,
 		).SetupWithManager(s.mgr); err != nil {
 			s.logger.Fatal(err, "unable to create controller", "controller", constant.ControllerRestoreFinalizer)
 		}
 	}
 
+	if s.config.RuntimeConfigMap != "" {
+		if err := controller.NewRuntimeConfigReconciler(
+			s.mgr.GetClient(),
+			s.config.RuntimeConfigMap,
+			controller.RuntimeConfig{
+				LogLevel:             s.logLevel,
+				Features:             features.All(),
+				ItemBlockWorkerCount: s.config.ItemBlockWorkerCount,
+			},
+			s.rootLogger,
+			setItemBlockWorkerCount,
+		).SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", constant.ControllerRuntimeConfig)
+		}
+	}
+
 	s.logger.Info("Server starting...")
 
 	if err := s.mgr.Start(s.ctx); err != nil {
@@ -896,6 +1116,35 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	return nil
 }
 
+// newHealthChecker returns the checker reporting the readiness of the server, which the
+// controllers add their own components to.
+func (s *server) newHealthChecker() *health.Checker {
+	checker := health.NewChecker(clock.RealClock{})
+	checker.AddCheck("controller-manager", func() error {
+		select {
+		case <-s.mgr.Elected():
+			return nil
+		default:
+			return errors.New("the controllers are not started")
+		}
+	})
+	checker.AddCheck("informer-sync", func() error {
+		ctx, cancel := context.WithTimeout(s.ctx, time.Second)
+		defer cancel()
+		if !s.mgr.GetCache().WaitForCacheSync(ctx) {
+			return errors.New("the informer caches are not synced")
+		}
+		return nil
+	})
+	checker.AddCheck("plugin-registry", func() error {
+		if len(s.pluginRegistry.List(common.PluginKindBackupItemAction)) == 0 {
+			return errors.New("no plugins are registered")
+		}
+		return nil
+	})
+	return checker
+}
+
 // removeControllers will remove any controller listed to be disabled from the list
 // of controllers to be initialized. It will check the runtime controllers. If a match
 // wasn't found and it returns an error.
@@ -931,9 +1180,14 @@ func (s *server) runProfiler() {
 }
 
 // if there is a restarting during the reconciling of backups/restores/etc, these CRs may be stuck in progress status
-// markInProgressCRsFailed tries to mark the in progress CRs as failed when starting the server to avoid the issue
-func markInProgressCRsFailed(ctx context.Context, client ctrlclient.Client, namespace string, log logrus.FieldLogger) {
-	markInProgressBackupsFailed(ctx, client, namespace, log)
+// markInProgressCRsFailed tries to mark the in progress CRs as failed when starting the server to avoid the issue.
+// When the backups save checkpoints, the in progress backups are resumed from their last checkpoint instead.
+func markInProgressCRsFailed(ctx context.Context, client ctrlclient.Client, namespace string, resumeBackups bool, log logrus.FieldLogger) {
+	if resumeBackups {
+		resumeInProgressBackups(ctx, client, namespace, log)
+	} else {
+		markInProgressBackupsFailed(ctx, client, namespace, log)
+	}
 
 	markInProgressRestoresFailed(ctx, client, namespace, log)
 }
@@ -963,6 +1217,34 @@ func markInProgressBackupsFailed(ctx context.Context, client ctrlclient.Client,
 	}
 }
 
+// resumeInProgressBackups requeues the in progress backups as new ones resuming from their last
+// checkpoint.
+func resumeInProgressBackups(ctx context.Context, client ctrlclient.Client, namespace string, log logrus.FieldLogger) {
+	backups := &velerov1api.BackupList{}
+	if err := client.List(ctx, backups, &ctrlclient.ListOptions{Namespace: namespace}); err != nil {
+		log.WithError(errors.WithStack(err)).Error("failed to list backups")
+		return
+	}
+
+	for i, backup := range backups.Items {
+		if backup.Status.Phase != velerov1api.BackupPhaseInProgress {
+			log.Debugf("the status of backup %q is %q, skip", backup.GetName(), backup.Status.Phase)
+			continue
+		}
+		updated := backup.DeepCopy()
+		if updated.Annotations == nil {
+			updated.Annotations = make(map[string]string)
+		}
+		updated.Annotations[velerov1api.ResumeBackupAnnotation] = "true"
+		updated.Status.Phase = velerov1api.BackupPhaseNew
+		if err := client.Patch(ctx, updated, ctrlclient.MergeFrom(&backups.Items[i])); err != nil {
+			log.WithError(errors.WithStack(err)).Errorf("failed to patch backup %q", backup.GetName())
+			continue
+		}
+		log.WithField("backup", backup.GetName()).Warnf("found a backup with status %q during the server starting, resuming it from its last checkpoint", backup.Status.Phase)
+	}
+}
+
 func markInProgressRestoresFailed(ctx context.Context, client ctrlclient.Client, namespace string, log logrus.FieldLogger) {
 	restores := &velerov1api.RestoreList{}
 	if err := client.List(ctx, restores, &ctrlclient.ListOptions{Namespace: namespace}); err != nil {
@@ -983,10 +1265,19 @@ func markInProgressRestoresFailed(ctx context.Context, client ctrlclient.Client,
 			continue
 		}
 		log.WithField("restore", restore.GetName()).Warn(updated.Status.FailureReason)
+		removeNamespaceProtection(ctx, client, &restores.Items[i], log)
 		markDataDownloadsCancel(ctx, client, restore, log)
 	}
 }
 
+// removeNamespaceProtection removes the protection of the namespaces restored into by a restore
+// which won't complete.
+func removeNamespaceProtection(ctx context.Context, client ctrlclient.Client, r *velerov1api.Restore, log logrus.FieldLogger) {
+	if err := restore.RemoveNamespaceProtection(ctx, client, r); err != nil {
+		log.WithField("restore", r.GetName()).WithError(errors.WithStack(err)).Error("failed to remove the protection of the restored namespaces")
+	}
+}
+
 func markDataUploadsCancel(ctx context.Context, client ctrlclient.Client, backup velerov1api.Backup, log logrus.FieldLogger) {
 	dataUploads := &velerov2alpha1api.DataUploadList{}
 
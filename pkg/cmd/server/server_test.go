@@ -283,6 +283,7 @@ func Test_veleroResourcesExist(t *testing.T) {
 			APIResources: []metav1.APIResource{
 				{Kind: "Backup"},
 				{Kind: "Restore"},
+				{Kind: "RestoreTest"},
 				{Kind: "Schedule"},
 				{Kind: "DownloadRequest"},
 				{Kind: "DeleteBackupRequest"},
@@ -345,6 +346,48 @@ func Test_markInProgressBackupsFailed(t *testing.T) {
 	assert.Equal(t, velerov1api.BackupPhaseCompleted, backup02.Status.Phase)
 }
 
+func Test_resumeInProgressBackups(t *testing.T) {
+	scheme := runtime.NewScheme()
+	velerov1api.AddToScheme(scheme)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithLists(&velerov1api.BackupList{
+			Items: []velerov1api.Backup{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "velero",
+						Name:      "backup01",
+					},
+					Status: velerov1api.BackupStatus{
+						Phase: velerov1api.BackupPhaseInProgress,
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "velero",
+						Name:      "backup02",
+					},
+					Status: velerov1api.BackupStatus{
+						Phase: velerov1api.BackupPhaseCompleted,
+					},
+				},
+			},
+		}).
+		Build()
+	resumeInProgressBackups(context.Background(), c, "velero", logrus.New())
+
+	backup01 := &velerov1api.Backup{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Namespace: "velero", Name: "backup01"}, backup01))
+	assert.Equal(t, velerov1api.BackupPhaseNew, backup01.Status.Phase)
+	assert.Equal(t, "true", backup01.Annotations[velerov1api.ResumeBackupAnnotation])
+
+	backup02 := &velerov1api.Backup{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Namespace: "velero", Name: "backup02"}, backup02))
+	assert.Equal(t, velerov1api.BackupPhaseCompleted, backup02.Status.Phase)
+	assert.Empty(t, backup02.Annotations[velerov1api.ResumeBackupAnnotation])
+}
+
 func Test_markInProgressRestoresFailed(t *testing.T) {
 	scheme := runtime.NewScheme()
 	velerov1api.AddToScheme(scheme)
@@ -17,10 +17,12 @@ limitations under the License.
 package downloadrequest
 
 import (
+	"bufio"
 	"compress/gzip"
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"net/http"
@@ -34,11 +36,16 @@ import (
 
 	veleroV1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
 )
 
 // ErrNotFound is exported for external packages to check for when a file is
 // not found
 var ErrNotFound = errors.New("file not found")
+
+// ErrEncrypted is returned when the file is encrypted on the client side by its backup storage
+// location and the Velero server didn't return its data key, e.g. when it's too old.
+var ErrEncrypted = errors.New("file is encrypted by the backup storage location and the Velero server didn't return its data key")
 var ErrDownloadRequestDownloadURLTimeout = errors.New("download request download url timeout, check velero server logs for errors. backup storage location may not be available")
 
 func Stream(
@@ -54,49 +61,51 @@ func Stream(
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	downloadURL, err := getDownloadURL(ctx, kbClient, namespace, name, kind)
+	status, err := getDownloadStatus(ctx, kbClient, namespace, name, kind)
 	if err != nil {
 		return err
 	}
 
-	if err := download(ctx, downloadURL, kind, w, insecureSkipTLSVerify, caCertFile); err != nil {
+	if err := download(ctx, status, kind, w, insecureSkipTLSVerify, caCertFile); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func getDownloadURL(
+// getDownloadStatus returns the status of a new download request for the file once it has the
+// download URL of the file.
+func getDownloadStatus(
 	ctx context.Context,
 	kbClient kbclient.Client,
 	namespace, name string,
 	kind veleroV1api.DownloadTargetKind,
-) (string, error) {
+) (*veleroV1api.DownloadRequestStatus, error) {
 	uuid, err := uuid.NewRandom()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	reqName := fmt.Sprintf("%s-%s", name, uuid.String())
 	created := builder.ForDownloadRequest(namespace, reqName).Target(kind, name).Result()
 
 	if err := kbClient.Create(ctx, created, &kbclient.CreateOptions{}); err != nil {
-		return "", errors.WithStack(err)
+		return nil, errors.WithStack(err)
 	}
 
 	for {
 		select {
 		case <-ctx.Done():
-			return "", ErrDownloadRequestDownloadURLTimeout
+			return nil, ErrDownloadRequestDownloadURLTimeout
 
 		case <-time.After(25 * time.Millisecond):
 			updated := &veleroV1api.DownloadRequest{}
 			if err := kbClient.Get(ctx, kbclient.ObjectKey{Name: created.Name, Namespace: namespace}, updated); err != nil {
-				return "", errors.WithStack(err)
+				return nil, errors.WithStack(err)
 			}
 
 			if updated.Status.DownloadURL != "" {
-				return updated.Status.DownloadURL, nil
+				return &updated.Status, nil
 			}
 		}
 	}
@@ -104,7 +113,7 @@ func getDownloadURL(
 
 func download(
 	ctx context.Context,
-	downloadURL string,
+	status *veleroV1api.DownloadRequestStatus,
 	kind veleroV1api.DownloadTargetKind,
 	w io.Writer,
 	insecureSkipTLSVerify bool,
@@ -143,7 +152,7 @@ func download(
 		ExpectContinueTimeout: defaultTransport.ExpectContinueTimeout,
 	}
 
-	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadURL, nil)
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, status.DownloadURL, nil)
 	if err != nil {
 		return err
 	}
@@ -172,10 +181,24 @@ func download(
 		return errors.Errorf("request failed: %v", string(body))
 	}
 
-	reader := resp.Body
+	body := bufio.NewReader(resp.Body)
+	var reader io.Reader = body
+	if persistence.IsEncryptedObject(body) {
+		if status.DataKey == "" {
+			return ErrEncrypted
+		}
+		dataKey, err := base64.StdEncoding.DecodeString(status.DataKey)
+		if err != nil {
+			return errors.Wrap(err, "the data key of the file isn't base64-encoded")
+		}
+		if reader, err = persistence.NewDecryptingReader(body, dataKey); err != nil {
+			return errors.Wrap(err, "error decrypting the file")
+		}
+	}
+
 	if kind != veleroV1api.DownloadTargetKindBackupContents {
 		// need to decompress logs
-		gzipReader, err := gzip.NewReader(resp.Body)
+		gzipReader, err := gzip.NewReader(reader)
 		if err != nil {
 			return err
 		}
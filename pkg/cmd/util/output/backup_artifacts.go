@@ -0,0 +1,294 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+)
+
+// The sections of a backup description which are downloaded from the object storage.
+const (
+	BackupSectionErrors     = "errors"
+	BackupSectionWarnings   = "warnings"
+	BackupSectionOperations = "operations"
+	BackupSectionResources  = "resources"
+	BackupSectionVolumes    = "volumes"
+	BackupSectionSkipped    = "skipped"
+)
+
+// BackupSections are the sections of a backup description which can be selected.
+var BackupSections = []string{
+	BackupSectionErrors,
+	BackupSectionWarnings,
+	BackupSectionOperations,
+	BackupSectionResources,
+	BackupSectionVolumes,
+	BackupSectionSkipped,
+}
+
+// notFoundSuffix marks the cached artifacts which were not found in the object storage.
+const notFoundSuffix = ".notfound"
+
+// BackupArtifactsCacheMaxAge is how long the artifacts of a backup are cached since they were last used.
+const BackupArtifactsCacheMaxAge = 7 * 24 * time.Hour
+
+// BackupDescribeOptions are the options of describing a backup.
+type BackupDescribeOptions struct {
+	// Details describes the operations and resources of the backup, and the details of its volumes.
+	Details               bool
+	InsecureSkipTLSVerify bool
+	CACertFile            string
+	// Sections are the sections described, all of them if empty.
+	Sections []string
+	// CacheDir is the directory caching the artifacts of the backups which are done,
+	// no artifact is cached if empty.
+	CacheDir string
+}
+
+// describes returns whether the section is described.
+func (o BackupDescribeOptions) describes(section string) bool {
+	return len(o.Sections) == 0 || slices.Contains(o.Sections, section)
+}
+
+// artifactKinds returns the kinds of the artifacts of backup used by its description.
+func (o BackupDescribeOptions) artifactKinds(backup *velerov1api.Backup) []velerov1api.DownloadTargetKind {
+	var kinds []velerov1api.DownloadTargetKind
+	if (o.describes(BackupSectionErrors) && backup.Status.Errors > 0) || (o.describes(BackupSectionWarnings) && backup.Status.Warnings > 0) {
+		kinds = append(kinds, velerov1api.DownloadTargetKindBackupResults)
+	}
+	if o.Details && o.describes(BackupSectionOperations) && backup.Status.BackupItemOperationsAttempted > 0 {
+		kinds = append(kinds, velerov1api.DownloadTargetKindBackupItemOperations)
+	}
+	if o.Details && o.describes(BackupSectionResources) {
+		kinds = append(kinds, velerov1api.DownloadTargetKindBackupResourceList)
+	}
+	if o.describes(BackupSectionVolumes) {
+		kinds = append(kinds, velerov1api.DownloadTargetKindBackupVolumeInfos)
+	}
+	if o.Details && o.describes(BackupSectionSkipped) && len(backup.Status.SkippedItems) > 0 {
+		kinds = append(kinds, velerov1api.DownloadTargetKindBackupSkippedItems)
+	}
+	return kinds
+}
+
+// BackupArtifacts fetches the artifacts of a backup from the object storage, each at most once.
+// The artifacts of a backup which is done are cached locally, keyed by the checksum of the backup,
+// since they don't change anymore.
+type BackupArtifacts struct {
+	kbClient              kbclient.Client
+	backup                *velerov1api.Backup
+	insecureSkipTLSVerify bool
+	caCertFile            string
+	cacheDir              string
+	artifacts             map[velerov1api.DownloadTargetKind]*backupArtifact
+	// cancels cancel the fetches still running when the artifacts are closed.
+	cancels []context.CancelFunc
+	fetches sync.WaitGroup
+}
+
+type backupArtifact struct {
+	done chan struct{}
+	data []byte
+	err  error
+}
+
+// NewBackupArtifacts returns the BackupArtifacts of backup.
+func NewBackupArtifacts(kbClient kbclient.Client, backup *velerov1api.Backup, opts BackupDescribeOptions) *BackupArtifacts {
+	a := &BackupArtifacts{
+		kbClient:              kbClient,
+		backup:                backup,
+		insecureSkipTLSVerify: opts.InsecureSkipTLSVerify,
+		caCertFile:            opts.CACertFile,
+		artifacts:             make(map[velerov1api.DownloadTargetKind]*backupArtifact),
+	}
+	if checksum := backupChecksum(backup); opts.CacheDir != "" && checksum != "" {
+		a.cacheDir = filepath.Join(opts.CacheDir, checksum)
+	}
+	return a
+}
+
+// backupChecksum returns the checksum identifying the artifacts of backup, or an empty string
+// if the backup isn't done, its artifacts may still change.
+func backupChecksum(backup *velerov1api.Backup) string {
+	switch backup.Status.Phase {
+	case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed:
+	default:
+		return ""
+	}
+
+	completed := ""
+	if backup.Status.CompletionTimestamp != nil {
+		completed = backup.Status.CompletionTimestamp.UTC().Format(time.RFC3339)
+	}
+
+	h := sha256.New()
+	for _, field := range []string{
+		backup.Namespace,
+		backup.Name,
+		string(backup.UID),
+		string(backup.Status.Phase),
+		completed,
+	} {
+		h.Write([]byte(field))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Prefetch starts fetching the artifacts of kinds in parallel.
+func (a *BackupArtifacts) Prefetch(ctx context.Context, kinds ...velerov1api.DownloadTargetKind) {
+	for _, kind := range kinds {
+		a.fetch(ctx, kind)
+	}
+}
+
+// Close cancels the fetches still running and waits for them to end. The artifacts can't be
+// fetched anymore once closed.
+func (a *BackupArtifacts) Close() {
+	for _, cancel := range a.cancels {
+		cancel()
+	}
+	a.fetches.Wait()
+}
+
+// Stream writes the artifact of kind to w, waiting for it to be fetched.
+func (a *BackupArtifacts) Stream(ctx context.Context, kind velerov1api.DownloadTargetKind, w io.Writer) error {
+	artifact := a.fetch(ctx, kind)
+	<-artifact.done
+	if artifact.err != nil {
+		return artifact.err
+	}
+	_, err := w.Write(artifact.data)
+	return err
+}
+
+func (a *BackupArtifacts) fetch(ctx context.Context, kind velerov1api.DownloadTargetKind) *backupArtifact {
+	if artifact, ok := a.artifacts[kind]; ok {
+		return artifact
+	}
+
+	artifact := &backupArtifact{done: make(chan struct{})}
+	a.artifacts[kind] = artifact
+
+	ctx, cancel := context.WithCancel(ctx)
+	a.cancels = append(a.cancels, cancel)
+	a.fetches.Add(1)
+	go func() {
+		defer a.fetches.Done()
+		defer close(artifact.done)
+
+		if data, ok, err := a.readCache(kind); ok {
+			artifact.data, artifact.err = data, err
+			return
+		}
+
+		buf := new(bytes.Buffer)
+		artifact.err = downloadrequest.Stream(ctx, a.kbClient, a.backup.Namespace, a.backup.Name, kind, buf, downloadRequestTimeout, a.insecureSkipTLSVerify, a.caCertFile)
+		artifact.data = buf.Bytes()
+		a.writeCache(kind, artifact.data, artifact.err)
+	}()
+
+	return artifact
+}
+
+// readCache returns the cached artifact of kind, and false if it isn't cached. The error is
+// ErrNotFound for an artifact cached as not found in the object storage.
+func (a *BackupArtifacts) readCache(kind velerov1api.DownloadTargetKind) ([]byte, bool, error) {
+	if a.cacheDir == "" {
+		return nil, false, nil
+	}
+	if _, err := os.Stat(filepath.Join(a.cacheDir, string(kind)+notFoundSuffix)); err == nil {
+		return nil, true, downloadrequest.ErrNotFound
+	}
+	data, err := os.ReadFile(filepath.Join(a.cacheDir, string(kind)))
+	if err != nil {
+		return nil, false, nil
+	}
+	// the artifacts used are kept in the cache
+	now := time.Now()
+	_ = os.Chtimes(a.cacheDir, now, now)
+	return data, true, nil
+}
+
+// writeCache caches the artifact of kind, on a best-effort basis: the artifact is downloaded
+// again next time if it can't be cached.
+func (a *BackupArtifacts) writeCache(kind velerov1api.DownloadTargetKind, data []byte, err error) {
+	if a.cacheDir == "" {
+		return
+	}
+
+	name := string(kind)
+	switch {
+	case err == nil:
+	case errors.Is(err, downloadrequest.ErrNotFound):
+		name, data = name+notFoundSuffix, nil
+	default:
+		return
+	}
+
+	if err := os.MkdirAll(a.cacheDir, 0700); err != nil {
+		return
+	}
+	tmp, err := os.CreateTemp(a.cacheDir, name+"-*")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		return
+	}
+	_ = os.Rename(tmp.Name(), filepath.Join(a.cacheDir, name))
+}
+
+// PruneBackupArtifactsCache removes from cacheDir the artifacts of the backups which weren't
+// used for maxAge, on a best-effort basis.
+func PruneBackupArtifactsCache(cacheDir string, maxAge time.Duration) {
+	if cacheDir == "" {
+		return
+	}
+	entries, err := os.ReadDir(cacheDir)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || !entry.IsDir() || time.Since(info.ModTime()) < maxAge {
+			continue
+		}
+		_ = os.RemoveAll(filepath.Join(cacheDir, entry.Name()))
+	}
+}
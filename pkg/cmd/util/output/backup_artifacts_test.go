@@ -0,0 +1,152 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestBackupDescribeOptionsArtifactKinds(t *testing.T) {
+	backup := builder.ForBackup("velero", "backup-1").Result()
+	backup.Status.Errors = 1
+	backup.Status.BackupItemOperationsAttempted = 2
+	backup.Status.SkippedItems = []velerov1api.SkipReasonCount{{Reason: velerov1api.SkipReasonExcludeLabel, Count: 1}}
+
+	tests := []struct {
+		name     string
+		opts     BackupDescribeOptions
+		expected []velerov1api.DownloadTargetKind
+	}{
+		{
+			name: "all sections without details",
+			opts: BackupDescribeOptions{},
+			expected: []velerov1api.DownloadTargetKind{
+				velerov1api.DownloadTargetKindBackupResults,
+				velerov1api.DownloadTargetKindBackupVolumeInfos,
+			},
+		},
+		{
+			name: "all sections with details",
+			opts: BackupDescribeOptions{Details: true},
+			expected: []velerov1api.DownloadTargetKind{
+				velerov1api.DownloadTargetKindBackupResults,
+				velerov1api.DownloadTargetKindBackupItemOperations,
+				velerov1api.DownloadTargetKindBackupResourceList,
+				velerov1api.DownloadTargetKindBackupVolumeInfos,
+				velerov1api.DownloadTargetKindBackupSkippedItems,
+			},
+		},
+		{
+			name:     "selected sections",
+			opts:     BackupDescribeOptions{Details: true, Sections: []string{BackupSectionVolumes, BackupSectionResources}},
+			expected: []velerov1api.DownloadTargetKind{velerov1api.DownloadTargetKindBackupResourceList, velerov1api.DownloadTargetKindBackupVolumeInfos},
+		},
+		{
+			name: "warnings selected without warnings",
+			opts: BackupDescribeOptions{Sections: []string{BackupSectionWarnings}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.opts.artifactKinds(backup))
+		})
+	}
+}
+
+func TestBackupChecksum(t *testing.T) {
+	completed := builder.ForBackup("velero", "backup-1").Phase(velerov1api.BackupPhaseCompleted).CompletionTimestamp(time.Now()).Result()
+	assert.NotEmpty(t, backupChecksum(completed))
+	assert.Equal(t, backupChecksum(completed), backupChecksum(completed.DeepCopy()))
+
+	recreated := completed.DeepCopy()
+	recreated.UID = "another-uid"
+	assert.NotEqual(t, backupChecksum(completed), backupChecksum(recreated))
+
+	inProgress := builder.ForBackup("velero", "backup-1").Phase(velerov1api.BackupPhaseInProgress).Result()
+	assert.Empty(t, backupChecksum(inProgress))
+}
+
+func TestBackupArtifactsCache(t *testing.T) {
+	backup := builder.ForBackup("velero", "backup-1").Phase(velerov1api.BackupPhaseCompleted).CompletionTimestamp(time.Now()).Result()
+	opts := BackupDescribeOptions{CacheDir: t.TempDir()}
+
+	// cache the artifacts as if downloaded by a previous describe
+	cached := NewBackupArtifacts(nil, backup, opts)
+	cached.writeCache(velerov1api.DownloadTargetKindBackupResourceList, []byte(`{"v1/Pod":["ns-1/pod-1"]}`), nil)
+	cached.writeCache(velerov1api.DownloadTargetKindBackupVolumeInfos, nil, downloadrequest.ErrNotFound)
+
+	// the cached artifacts are read without any download request
+	artifacts := NewBackupArtifacts(nil, backup, opts)
+	artifacts.Prefetch(context.Background(), velerov1api.DownloadTargetKindBackupResourceList, velerov1api.DownloadTargetKindBackupVolumeInfos)
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, artifacts.Stream(context.Background(), velerov1api.DownloadTargetKindBackupResourceList, buf))
+	assert.Equal(t, `{"v1/Pod":["ns-1/pod-1"]}`, buf.String())
+
+	err := artifacts.Stream(context.Background(), velerov1api.DownloadTargetKindBackupVolumeInfos, new(bytes.Buffer))
+	assert.ErrorIs(t, err, downloadrequest.ErrNotFound)
+
+	// the artifacts of a backup in progress aren't cached
+	inProgress := builder.ForBackup("velero", "backup-2").Phase(velerov1api.BackupPhaseInProgress).Result()
+	assert.Empty(t, NewBackupArtifacts(nil, inProgress, opts).cacheDir)
+}
+
+func TestBackupArtifactsClose(t *testing.T) {
+	backup := builder.ForBackup("velero", "backup-1").Phase(velerov1api.BackupPhaseInProgress).Result()
+
+	// the download request is never processed, closing the artifacts cancels its fetch
+	artifacts := NewBackupArtifacts(velerotest.NewFakeControllerRuntimeClient(t), backup, BackupDescribeOptions{})
+	artifacts.Prefetch(context.Background(), velerov1api.DownloadTargetKindBackupResourceList)
+
+	closed := make(chan struct{})
+	go func() {
+		artifacts.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(10 * time.Second):
+		t.Fatal("the fetch wasn't canceled")
+	}
+}
+
+func TestPruneBackupArtifactsCache(t *testing.T) {
+	cacheDir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(cacheDir, "old"), 0700))
+	require.NoError(t, os.Mkdir(filepath.Join(cacheDir, "recent"), 0700))
+	old := time.Now().Add(-2 * BackupArtifactsCacheMaxAge)
+	require.NoError(t, os.Chtimes(filepath.Join(cacheDir, "old"), old, old))
+
+	PruneBackupArtifactsCache(cacheDir, BackupArtifactsCacheMaxAge)
+
+	assert.NoDirExists(t, filepath.Join(cacheDir, "old"))
+	assert.DirExists(t, filepath.Join(cacheDir, "recent"))
+}
@@ -26,6 +26,7 @@ import (
 	"strings"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
@@ -40,7 +41,9 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 
 	"github.com/vmware-tanzu/velero/internal/volume"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	"github.com/vmware-tanzu/velero/pkg/util/csi"
 	"github.com/vmware-tanzu/velero/pkg/util/results"
 )
 
@@ -51,10 +54,13 @@ func DescribeBackup(
 	backup *velerov1api.Backup,
 	deleteRequests []velerov1api.DeleteBackupRequest,
 	podVolumeBackups []velerov1api.PodVolumeBackup,
-	details bool,
-	insecureSkipTLSVerify bool,
-	caCertFile string,
+	opts BackupDescribeOptions,
 ) string {
+	// the artifacts of the backup are downloaded in parallel, while the backup is described
+	artifacts := NewBackupArtifacts(kbClient, backup, opts)
+	defer artifacts.Close()
+	artifacts.Prefetch(ctx, opts.artifactKinds(backup)...)
+
 	return Describe(func(d *Describer) {
 		d.DescribeMetadata(backup.ObjectMeta)
 
@@ -88,7 +94,17 @@ func DescribeBackup(
 			DescribeResourcePolicies(d, backup.Spec.ResourcePolicy)
 		}
 
-		if backup.Spec.UploaderConfig != nil && backup.Spec.UploaderConfig.ParallelFilesUpload > 0 {
+		if backup.Spec.InlineResourcePolicy != "" {
+			d.Println()
+			DescribeInlineResourcePolicies(d, backup.Spec.InlineResourcePolicy)
+		}
+
+		if backup.Spec.ResourceModifier != nil {
+			d.Println()
+			DescribeResourceModifier(d, backup.Spec.ResourceModifier)
+		}
+
+		if backup.Spec.UploaderConfig != nil && (backup.Spec.UploaderConfig.ParallelFilesUpload > 0 || backup.Spec.UploaderConfig.ChangedBlockTracking) {
 			d.Println()
 			DescribeUploaderConfigForBackup(d, backup.Spec)
 		}
@@ -103,13 +119,13 @@ func DescribeBackup(
 		}
 
 		d.Println()
-		DescribeBackupResults(ctx, kbClient, d, backup, insecureSkipTLSVerify, caCertFile)
+		DescribeBackupResults(ctx, d, backup, artifacts, opts)
 
 		d.Println()
 		DescribeBackupSpec(d, backup.Spec)
 
 		d.Println()
-		DescribeBackupStatus(ctx, kbClient, d, backup, details, insecureSkipTLSVerify, caCertFile, podVolumeBackups)
+		DescribeBackupStatus(ctx, d, backup, artifacts, opts, podVolumeBackups)
 
 		if len(deleteRequests) > 0 {
 			d.Println()
@@ -125,10 +141,25 @@ func DescribeResourcePolicies(d *Describer, resPolicies *v1.TypedLocalObjectRefe
 	d.Printf("\tName:\t%s\n", resPolicies.Name)
 }
 
+// DescribeInlineResourcePolicies describes the inline resource policies document in human-readable format
+func DescribeInlineResourcePolicies(d *Describer, document string) {
+	d.Printf("Resource policies:\n")
+	d.Printf("\tType:\tinline\n")
+	d.Printf("\tDocument:\n")
+	for _, line := range strings.Split(strings.TrimRight(document, "\n"), "\n") {
+		d.Printf("\t  %s\n", line)
+	}
+}
+
 // DescribeUploaderConfigForBackup describes uploader config in human-readable format
 func DescribeUploaderConfigForBackup(d *Describer, spec velerov1api.BackupSpec) {
 	d.Printf("Uploader config:\n")
-	d.Printf("\tParallel files upload:\t%d\n", spec.UploaderConfig.ParallelFilesUpload)
+	if spec.UploaderConfig.ParallelFilesUpload > 0 {
+		d.Printf("\tParallel files upload:\t%d\n", spec.UploaderConfig.ParallelFilesUpload)
+	}
+	if spec.UploaderConfig.ChangedBlockTracking {
+		d.Printf("\tChanged block tracking:\t%t\n", spec.UploaderConfig.ChangedBlockTracking)
+	}
 }
 
 // DescribeBackupSpec describes a backup spec in human-readable format.
@@ -192,6 +223,9 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 		}
 		d.Printf("\tExcluded namespace-scoped:\t%s\n", s)
 	}
+	if boolptr.IsSetToTrue(spec.IncludeClusterScopedDependencies) {
+		d.Printf("\tCluster-scoped dependencies:\tincluded\n")
+	}
 
 	d.Println()
 	s = emptyDisplay
@@ -212,8 +246,53 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 	}
 	d.Printf("Or label selector:\t%s\n", s)
 
+	if len(spec.ExcludedItems) > 0 {
+		d.Println()
+		d.Printf("Excluded items:\n")
+		for _, filter := range spec.ExcludedItems {
+			conditions := []string{}
+			if filter.FieldSelector != "" {
+				conditions = append(conditions, filter.FieldSelector)
+			}
+			if filter.OlderThan != nil {
+				conditions = append(conditions, fmt.Sprintf("older than %s", filter.OlderThan.Duration))
+			}
+			d.Printf("\t%s:\t%s\n", filter.Resource, strings.Join(conditions, ", "))
+		}
+	}
+
+	if len(spec.ListFieldSelectors) > 0 {
+		d.Println()
+		d.Printf("List field selectors:\n")
+		for _, selector := range spec.ListFieldSelectors {
+			d.Printf("\t%s:\t%s\n", selector.Resource, selector.FieldSelector)
+		}
+	}
+
+	if len(spec.FieldProjections) > 0 {
+		d.Println()
+		d.Printf("Field projections:\n")
+		for _, projection := range spec.FieldProjections {
+			d.Printf("\t%s:\t%s\n", projection.Resource, strings.Join(projection.ExcludedFields, ", "))
+		}
+	}
+
 	d.Println()
 	d.Printf("Storage Location:\t%s\n", spec.StorageLocation)
+	if spec.MirrorStorageLocation != "" {
+		policy := spec.MirrorFailurePolicy
+		if policy == "" {
+			policy = velerov1api.MirrorFailurePolicyFail
+		}
+		d.Printf("Mirror Storage Location:\t%s (on failure: %s)\n", spec.MirrorStorageLocation, policy)
+	}
+	if spec.TargetCluster != "" {
+		d.Printf("Target Cluster:\t%s\n", spec.TargetCluster)
+	}
+
+	if len(spec.Tags) > 0 {
+		d.DescribeMap("Tags", spec.Tags)
+	}
 
 	d.Println()
 	d.Printf("Velero-Native Snapshot PVs:\t%s\n", BoolPointerString(spec.SnapshotVolumes, "false", "true", "auto"))
@@ -224,6 +303,9 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 		s = spec.DataMover
 	}
 	d.Printf("Data Mover:\t%s\n", s)
+	if spec.VolumeGroupSnapshotLabelKey != "" {
+		d.Printf("Volume Group Snapshot Label Key:\t%s\n", spec.VolumeGroupSnapshotLabelKey)
+	}
 
 	d.Println()
 	d.Printf("TTL:\t%s\n", spec.TTL.Duration)
@@ -231,12 +313,28 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 	d.Println()
 	d.Printf("CSISnapshotTimeout:\t%s\n", spec.CSISnapshotTimeout.Duration)
 	d.Printf("ItemOperationTimeout:\t%s\n", spec.ItemOperationTimeout.Duration)
+	if spec.MaxDuration.Duration > 0 {
+		action := spec.MaxDurationAction
+		if action == "" {
+			action = velerov1api.MaxDurationActionPartiallyFail
+		}
+		d.Printf("MaxDuration:\t%s (%s)\n", spec.MaxDuration.Duration, action)
+	}
+	if spec.ErrorBudget != nil {
+		d.Printf("ErrorBudget:\t%d items\n", *spec.ErrorBudget)
+	}
+	if spec.LoadBalancerPolicy != nil {
+		describeLoadBalancerPolicy(d, spec.LoadBalancerPolicy)
+	}
 
 	d.Println()
 	if len(spec.Hooks.Resources) == 0 {
 		d.Printf("Hooks:\t" + emptyDisplay + "\n")
 	} else {
 		d.Printf("Hooks:\n")
+		if spec.Hooks.PodDisruptionBudgetPolicy != "" {
+			d.Printf("\tPod Disruption Budget Policy:\t%s\n", spec.Hooks.PodDisruptionBudgetPolicy)
+		}
 		d.Printf("\tResources:\n")
 		for _, backupResourceHookSpec := range spec.Hooks.Resources {
 			d.Printf("\t\t%s:\n", backupResourceHookSpec.Name)
@@ -301,6 +399,13 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 		}
 	}
 
+	if spec.DeletionHooks != nil && len(spec.DeletionHooks.PreHooks)+len(spec.DeletionHooks.PostHooks) > 0 {
+		d.Println()
+		d.Printf("Deletion Hooks:\n")
+		describeDeletionHooks(d, "Pre", spec.DeletionHooks.PreHooks)
+		describeDeletionHooks(d, "Post", spec.DeletionHooks.PostHooks)
+	}
+
 	if spec.OrderedResources != nil {
 		d.Println()
 		d.Printf("OrderedResources:\n")
@@ -311,8 +416,8 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 }
 
 // DescribeBackupStatus describes a backup status in human-readable format.
-func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Describer, backup *velerov1api.Backup, details bool,
-	insecureSkipTLSVerify bool, caCertPath string, podVolumeBackups []velerov1api.PodVolumeBackup) {
+func DescribeBackupStatus(ctx context.Context, d *Describer, backup *velerov1api.Backup, artifacts *BackupArtifacts, opts BackupDescribeOptions,
+	podVolumeBackups []velerov1api.PodVolumeBackup) {
 	status := backup.Status
 
 	// Status.Version has been deprecated, use Status.FormatVersion
@@ -336,6 +441,9 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 	// if the controller hasn't processed this Backup yet, in which case this will
 	// just display `<nil>`, though this should be temporary.
 	d.Printf("Expiration:\t%s\n", status.Expiration)
+	if status.ImmutableUntil != nil {
+		d.Printf("Immutable Until:\t%s\n", status.ImmutableUntil)
+	}
 	d.Println()
 
 	if backup.Status.Progress != nil {
@@ -350,14 +458,72 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 		d.Println()
 	}
 
-	describeBackupItemOperations(ctx, kbClient, d, backup, details, insecureSkipTLSVerify, caCertPath)
+	if status.MaxDurationExceeded {
+		describeIncompleteItems(d, status.IncompleteItems, status.IncompleteItemList)
+		d.Println()
+	}
 
-	if details {
-		describeBackupResourceList(ctx, kbClient, d, backup, insecureSkipTLSVerify, caCertPath)
+	if len(status.QuarantinedItems) > 0 || status.ErrorBudgetExceeded {
+		describeQuarantinedItems(d, status.QuarantinedItems, status.ErrorBudgetExceeded)
+		d.Println()
+	}
+
+	if status.MirrorPhase != "" {
+		d.Printf("Mirror:\t%s\n", status.MirrorPhase)
+		if status.MirrorFailureReason != "" {
+			d.Printf("Mirror Failure Reason:\t%s\n", status.MirrorFailureReason)
+		}
+		d.Println()
+	}
+
+	if status.TargetCluster != nil {
+		describeTargetClusterStatus(d, status.TargetCluster)
+		d.Println()
+	}
+
+	if corrupted := meta.FindStatusCondition(status.Conditions, velerov1api.ConditionTypeCorrupted); corrupted != nil && corrupted.Status == metav1.ConditionTrue {
+		d.Printf("Corrupted:\t%s\n", corrupted.Message)
 		d.Println()
 	}
 
-	describeBackupVolumes(ctx, kbClient, d, backup, details, insecureSkipTLSVerify, caCertPath, podVolumeBackups)
+	if verified := meta.FindStatusCondition(status.Conditions, velerov1api.ConditionTypeVerified); verified != nil {
+		d.Printf("Verification:\t%s: %s\n", verified.Reason, verified.Message)
+		d.Println()
+	}
+
+	if len(status.NamespaceResults) > 0 {
+		d.Println("Namespace Results:")
+		for _, result := range status.NamespaceResults {
+			d.Printf("\t%s:\t%s (items backed up: %d, errors: %d, warnings: %d)\n", result.Namespace, result.Phase, result.ItemsBackedUp, result.Errors, result.Warnings)
+		}
+		d.Println()
+	}
+
+	if opts.describes(BackupSectionOperations) {
+		describeBackupItemOperations(ctx, d, backup, artifacts, opts.Details)
+	}
+
+	if opts.Details && opts.describes(BackupSectionResources) {
+		describeBackupResourceList(ctx, d, artifacts)
+		d.Println()
+	}
+
+	if opts.describes(BackupSectionVolumes) {
+		describeBackupVolumes(ctx, d, backup, artifacts, opts.Details, podVolumeBackups)
+		if len(status.VolumePolicyHits) > 0 {
+			d.Println()
+			describeVolumePolicyHits(d, status.VolumePolicyHits)
+		}
+		if len(status.VolumePolicyConflicts) > 0 {
+			d.Println()
+			describeVolumePolicyConflicts(d, status.VolumePolicyConflicts)
+		}
+	}
+
+	if opts.describes(BackupSectionSkipped) && len(status.SkippedItems) > 0 {
+		d.Println()
+		describeBackupSkippedItems(ctx, d, status.SkippedItems, artifacts, opts.Details)
+	}
 
 	if status.HookStatus != nil {
 		d.Println()
@@ -366,7 +532,7 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 	}
 }
 
-func describeBackupItemOperations(ctx context.Context, kbClient kbclient.Client, d *Describer, backup *velerov1api.Backup, details bool, insecureSkipTLSVerify bool, caCertPath string) {
+func describeBackupItemOperations(ctx context.Context, d *Describer, backup *velerov1api.Backup, artifacts *BackupArtifacts, details bool) {
 	status := backup.Status
 	if status.BackupItemOperationsAttempted > 0 {
 		if !details {
@@ -375,7 +541,7 @@ func describeBackupItemOperations(ctx context.Context, kbClient kbclient.Client,
 		}
 
 		buf := new(bytes.Buffer)
-		if err := downloadrequest.Stream(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupItemOperations, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		if err := artifacts.Stream(ctx, velerov1api.DownloadTargetKindBackupItemOperations, buf); err != nil {
 			d.Printf("Backup Item Operations:\t<error getting operation info: %v>\n", err)
 			return
 		}
@@ -393,9 +559,9 @@ func describeBackupItemOperations(ctx context.Context, kbClient kbclient.Client,
 	}
 }
 
-func describeBackupResourceList(ctx context.Context, kbClient kbclient.Client, d *Describer, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) {
+func describeBackupResourceList(ctx context.Context, d *Describer, artifacts *BackupArtifacts) {
 	buf := new(bytes.Buffer)
-	if err := downloadrequest.Stream(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupResourceList, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+	if err := artifacts.Stream(ctx, velerov1api.DownloadTargetKindBackupResourceList, buf); err != nil {
 		if err == downloadrequest.ErrNotFound {
 			// the backup resource list could be missing if (other reasons may exist as well):
 			//	- the backup was taken prior to v1.1; or
@@ -429,14 +595,71 @@ func describeBackupResourceList(ctx context.Context, kbClient kbclient.Client, d
 	}
 }
 
+func describeBackupSkippedItems(ctx context.Context, d *Describer, counts []velerov1api.SkipReasonCount, artifacts *BackupArtifacts, details bool) {
+	summary := make([]string, 0, len(counts))
+	for _, count := range counts {
+		summary = append(summary, fmt.Sprintf("%s: %d", count.Reason, count.Count))
+	}
+	if !details {
+		d.Printf("Skipped Items:\t%s (specify --details for more information)\n", strings.Join(summary, ", "))
+		return
+	}
+
+	buf := new(bytes.Buffer)
+	if err := artifacts.Stream(ctx, velerov1api.DownloadTargetKindBackupSkippedItems, buf); err != nil {
+		d.Printf("Skipped Items:\t%s <error getting skipped items: %v>\n", strings.Join(summary, ", "), err)
+		return
+	}
+
+	var items []velerov1api.SkippedItem
+	if err := json.NewDecoder(buf).Decode(&items); err != nil {
+		d.Printf("Skipped Items:\t%s <error reading skipped items: %v>\n", strings.Join(summary, ", "), err)
+		return
+	}
+
+	d.Println("Skipped Items:")
+	for _, item := range items {
+		name := item.Name
+		if name == "" {
+			name = "<all>"
+		} else if item.Namespace != "" {
+			name = item.Namespace + "/" + item.Name
+		}
+		d.Printf("\t%s %s:\t%s\n", item.Resource, name, item.Reason)
+	}
+}
+
+// describeVolumePolicyHits describes the numbers of volumes the volume policies of the backup
+// matched, flagging the policies which matched no volume.
+func describeVolumePolicyHits(d *Describer, hits []velerov1api.VolumePolicyHit) {
+	d.Println("Volume Policy Hits:")
+	for _, hit := range hits {
+		if hit.Matched == 0 {
+			d.Printf("\t%s (%s):\tno volume matched\n", hit.Policy, hit.Action)
+			continue
+		}
+		d.Printf("\t%s (%s):\t%d matched, %d applied\n", hit.Policy, hit.Action, hit.Matched, hit.Applied)
+	}
+}
+
+// describeVolumePolicyConflicts describes the volumes several volume policies of the backup
+// matched, and the policy which was applied to each.
+func describeVolumePolicyConflicts(d *Describer, conflicts []velerov1api.VolumePolicyConflict) {
+	d.Println("Volume Policy Conflicts:")
+	for _, conflict := range conflicts {
+		if len(conflict.Policies) == 0 {
+			continue
+		}
+		d.Printf("\t%s:\tmatched %s, %s applied\n", conflict.Volume, strings.Join(conflict.Policies, ", "), conflict.Policies[0])
+	}
+}
+
 func describeBackupVolumes(
 	ctx context.Context,
-	kbClient kbclient.Client,
 	d *Describer,
 	backup *velerov1api.Backup,
+	artifacts *BackupArtifacts,
 	details bool,
-	insecureSkipTLSVerify bool,
-	caCertPath string,
 	podVolumeBackupCRs []velerov1api.PodVolumeBackup,
 ) {
 	d.Println("Backup Volumes:")
@@ -446,15 +669,15 @@ func describeBackupVolumes(
 	legacyInfoSource := false
 
 	buf := new(bytes.Buffer)
-	err := downloadrequest.Stream(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupVolumeInfos, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath)
+	err := artifacts.Stream(ctx, velerov1api.DownloadTargetKindBackupVolumeInfos, buf)
 	if err == downloadrequest.ErrNotFound {
-		nativeSnapshots, err = retrieveNativeSnapshotLegacy(ctx, kbClient, backup, insecureSkipTLSVerify, caCertPath)
+		nativeSnapshots, err = retrieveNativeSnapshotLegacy(ctx, backup, artifacts)
 		if err != nil {
 			d.Printf("\t<error concluding native snapshot info: %v>\n", err)
 			return
 		}
 
-		csiSnapshots, err = retrieveCSISnapshotLegacy(ctx, kbClient, backup, insecureSkipTLSVerify, caCertPath)
+		csiSnapshots, err = retrieveCSISnapshotLegacy(ctx, backup, artifacts)
 		if err != nil {
 			d.Printf("\t<error concluding CSI snapshot info: %v>\n", err)
 			return
@@ -490,7 +713,7 @@ func describeBackupVolumes(
 	describePodVolumeBackups(d, details, podVolumeBackupCRs)
 }
 
-func retrieveNativeSnapshotLegacy(ctx context.Context, kbClient kbclient.Client, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) ([]*volume.BackupVolumeInfo, error) {
+func retrieveNativeSnapshotLegacy(ctx context.Context, backup *velerov1api.Backup, artifacts *BackupArtifacts) ([]*volume.BackupVolumeInfo, error) {
 	status := backup.Status
 	nativeSnapshots := []*volume.BackupVolumeInfo{}
 
@@ -499,7 +722,7 @@ func retrieveNativeSnapshotLegacy(ctx context.Context, kbClient kbclient.Client,
 	}
 
 	buf := new(bytes.Buffer)
-	if err := downloadrequest.Stream(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupVolumeSnapshots, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+	if err := artifacts.Stream(ctx, velerov1api.DownloadTargetKindBackupVolumeSnapshots, buf); err != nil {
 		return nativeSnapshots, errors.Wrapf(err, "error to download native snapshot info")
 	}
 
@@ -528,7 +751,7 @@ func retrieveNativeSnapshotLegacy(ctx context.Context, kbClient kbclient.Client,
 	return nativeSnapshots, nil
 }
 
-func retrieveCSISnapshotLegacy(ctx context.Context, kbClient kbclient.Client, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) ([]*volume.BackupVolumeInfo, error) {
+func retrieveCSISnapshotLegacy(ctx context.Context, backup *velerov1api.Backup, artifacts *BackupArtifacts) ([]*volume.BackupVolumeInfo, error) {
 	status := backup.Status
 	csiSnapshots := []*volume.BackupVolumeInfo{}
 
@@ -537,7 +760,7 @@ func retrieveCSISnapshotLegacy(ctx context.Context, kbClient kbclient.Client, ba
 	}
 
 	vsBuf := new(bytes.Buffer)
-	err := downloadrequest.Stream(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindCSIBackupVolumeSnapshots, vsBuf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath)
+	err := artifacts.Stream(ctx, velerov1api.DownloadTargetKindCSIBackupVolumeSnapshots, vsBuf)
 	if err != nil {
 		return csiSnapshots, errors.Wrapf(err, "error to download vs list")
 	}
@@ -548,7 +771,7 @@ func retrieveCSISnapshotLegacy(ctx context.Context, kbClient kbclient.Client, ba
 	}
 
 	vscBuf := new(bytes.Buffer)
-	err = downloadrequest.Stream(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindCSIBackupVolumeSnapshotContents, vscBuf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath)
+	err = artifacts.Stream(ctx, velerov1api.DownloadTargetKindCSIBackupVolumeSnapshotContents, vscBuf)
 	if err != nil {
 		return csiSnapshots, errors.Wrapf(err, "error to download vsc list")
 	}
@@ -580,12 +803,13 @@ func retrieveCSISnapshotLegacy(ctx context.Context, kbClient kbclient.Client, ba
 				continue
 			}
 
-			if vs.Spec.Source.PersistentVolumeClaimName == nil {
+			sourcePVC := csi.GetVolumeSnapshotSourcePVC(&vs)
+			if sourcePVC == "" {
 				continue
 			}
 
 			if *vs.Status.BoundVolumeSnapshotContentName == vsc.Name {
-				volInfo.PVCName = *vs.Spec.Source.PersistentVolumeClaimName
+				volInfo.PVCName = sourcePVC
 				volInfo.PVCNamespace = vs.Namespace
 			}
 		}
@@ -748,6 +972,36 @@ func DescribeDeleteBackupRequests(d *Describer, requests []velerov1api.DeleteBac
 				d.Printf("\t\t%s\n", err)
 			}
 		}
+		if len(req.Status.HookResults) > 0 {
+			d.Printf("\tHooks:\n")
+			for _, result := range req.Status.HookResults {
+				if result.Succeeded {
+					d.Printf("\t\t%s (%s):\tSucceeded\n", result.Name, result.Phase)
+				} else {
+					d.Printf("\t\t%s (%s):\tFailed: %s\n", result.Name, result.Phase, result.Error)
+				}
+			}
+		}
+	}
+}
+
+// describeDeletionHooks describes the deletion hooks of a phase in human-readable format.
+func describeDeletionHooks(d *Describer, phase string, hooks []velerov1api.BackupDeletionHook) {
+	for _, hook := range hooks {
+		d.Printf("\t%s:\n", hook.Name)
+		d.Printf("\t\tPhase:\t%s\n", phase)
+		d.Printf("\t\tNamespace:\t%s\n", hook.Namespace)
+		s := emptyDisplay
+		if hook.PodSelector != nil {
+			s = metav1.FormatLabelSelector(hook.PodSelector)
+		}
+		d.Printf("\t\tPod selector:\t%s\n", s)
+		if hook.Exec != nil {
+			d.Printf("\t\tContainer:\t%s\n", hook.Exec.Container)
+			d.Printf("\t\tCommand:\t%s\n", strings.Join(hook.Exec.Command, " "))
+			d.Printf("\t\tOn Error:\t%s\n", hook.Exec.OnError)
+			d.Printf("\t\tTimeout:\t%s\n", hook.Exec.Timeout.Duration)
+		}
 	}
 }
 
@@ -885,8 +1139,10 @@ func (v *volumesByPod) Sorted() []*podVolumeGroup {
 }
 
 // DescribeBackupResults describes errors and warnings in human-readable format.
-func DescribeBackupResults(ctx context.Context, kbClient kbclient.Client, d *Describer, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) {
-	if backup.Status.Warnings == 0 && backup.Status.Errors == 0 {
+func DescribeBackupResults(ctx context.Context, d *Describer, backup *velerov1api.Backup, artifacts *BackupArtifacts, opts BackupDescribeOptions) {
+	describeWarnings := opts.describes(BackupSectionWarnings) && backup.Status.Warnings > 0
+	describeErrors := opts.describes(BackupSectionErrors) && backup.Status.Errors > 0
+	if !describeWarnings && !describeErrors {
 		return
 	}
 
@@ -895,7 +1151,7 @@ func DescribeBackupResults(ctx context.Context, kbClient kbclient.Client, d *Des
 
 	// If err 'ErrNotFound' occurs, it means the backup bundle in the bucket has already been there before the backup-result file is introduced.
 	// We only display the count of errors and warnings in this case.
-	err := downloadrequest.Stream(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupResults, &buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath)
+	err := artifacts.Stream(ctx, velerov1api.DownloadTargetKindBackupResults, &buf)
 	if err == downloadrequest.ErrNotFound {
 		d.Printf("Errors:\t%d\n", backup.Status.Errors)
 		d.Printf("Warnings:\t%d\n", backup.Status.Warnings)
@@ -910,12 +1166,62 @@ func DescribeBackupResults(ctx context.Context, kbClient kbclient.Client, d *Des
 		return
 	}
 
-	if backup.Status.Warnings > 0 {
+	if describeWarnings {
 		d.Println()
 		describeResult(d, "Warnings", resultMap["warnings"])
 	}
-	if backup.Status.Errors > 0 {
+	if describeErrors {
 		d.Println()
 		describeResult(d, "Errors", resultMap["errors"])
 	}
 }
+
+// describeIncompleteItems describes the items which weren't backed up because the backup exceeded
+// its MaxDuration.
+func describeIncompleteItems(d *Describer, count int, items []velerov1api.IncompleteItem) {
+	d.Printf("Max duration exceeded:\t%d items not backed up\n", count)
+	if len(items) == 0 {
+		return
+	}
+	d.Println("Incomplete Items:")
+	for _, item := range items {
+		name := item.Name
+		if item.Namespace != "" {
+			name = item.Namespace + "/" + item.Name
+		}
+		d.Printf("\t%s %s\n", item.Resource, name)
+	}
+	if count > len(items) {
+		d.Printf("\t... and %d more\n", count-len(items))
+	}
+}
+
+// describeQuarantinedItems describes the items which failed to be backed up or restored.
+func describeQuarantinedItems(d *Describer, items []velerov1api.QuarantinedItem, budgetExceeded bool) {
+	if budgetExceeded {
+		d.Printf("Error budget exceeded:\ttrue\n")
+	}
+	if len(items) == 0 {
+		return
+	}
+	d.Println("Quarantined Items:")
+	for _, item := range items {
+		name := item.Name
+		if item.Namespace != "" {
+			name = item.Namespace + "/" + item.Name
+		}
+		d.Printf("\t%s %s:\t%s: %s\n", item.Resource, name, item.ErrorClass, item.Error)
+	}
+}
+
+// describeTargetClusterStatus describes the target cluster a backup or a restore was run against.
+func describeTargetClusterStatus(d *Describer, status *velerov1api.TargetClusterStatus) {
+	d.Printf("Target Cluster Status:\n")
+	d.Printf("\tName:\t%s\n", status.Name)
+	if status.Host != "" {
+		d.Printf("\tHost:\t%s\n", status.Host)
+	}
+	if status.ServerVersion != "" {
+		d.Printf("\tKubernetes Version:\t%s\n", status.ServerVersion)
+	}
+}
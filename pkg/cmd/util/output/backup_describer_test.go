@@ -69,6 +69,62 @@ func TestDescribeResourcePolicies(t *testing.T) {
 	assert.Equal(t, expect, d.buf.String())
 }
 
+func TestDescribeInlineResourcePolicies(t *testing.T) {
+	d := &Describer{
+		Prefix: "",
+		out:    &tabwriter.Writer{},
+		buf:    &bytes.Buffer{},
+	}
+	d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+	DescribeInlineResourcePolicies(d, "version: v1\nvolumePolicies: []\n")
+	d.out.Flush()
+	expect := `Resource policies:
+  Type:  inline
+  Document:
+    version: v1
+    volumePolicies: []
+`
+	assert.Equal(t, expect, d.buf.String())
+}
+
+func TestDescribeVolumePolicyHits(t *testing.T) {
+	input := []velerov1api.VolumePolicyHit{
+		{Policy: "volumePolicies[0]", Action: "snapshot", Matched: 3, Applied: 2},
+		{Policy: "volumePolicies[1]", Action: "skip"},
+	}
+	d := &Describer{
+		Prefix: "",
+		out:    &tabwriter.Writer{},
+		buf:    &bytes.Buffer{},
+	}
+	d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+	describeVolumePolicyHits(d, input)
+	d.out.Flush()
+	expect := `Volume Policy Hits:
+  volumePolicies[0] (snapshot):  3 matched, 2 applied
+  volumePolicies[1] (skip):      no volume matched
+`
+	assert.Equal(t, expect, d.buf.String())
+}
+
+func TestDescribeVolumePolicyConflicts(t *testing.T) {
+	input := []velerov1api.VolumePolicyConflict{
+		{Volume: "ns-1/pvc-1", Policies: []string{"volumePolicies[1]", "volumePolicies[0]"}},
+	}
+	d := &Describer{
+		Prefix: "",
+		out:    &tabwriter.Writer{},
+		buf:    &bytes.Buffer{},
+	}
+	d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+	describeVolumePolicyConflicts(d, input)
+	d.out.Flush()
+	expect := `Volume Policy Conflicts:
+  ns-1/pvc-1:  matched volumePolicies[1], volumePolicies[0], volumePolicies[1] applied
+`
+	assert.Equal(t, expect, d.buf.String())
+}
+
 func TestDescribeBackupSpec(t *testing.T) {
 	input1 := builder.ForBackup("test-ns", "test-backup-1").
 		IncludedNamespaces("inc-ns-1", "inc-ns-2").
@@ -628,7 +684,11 @@ func TestDescribeDeleteBackupRequests(t *testing.T) {
 		ObjectMeta(builder.WithCreationTimestamp(t1)).
 		BackupName("bak-1").
 		Phase(velerov1api.DeleteBackupRequestPhaseProcessed).
-		Errors("some error").Result()
+		Errors("some error").
+		HookResults(
+			velerov1api.DeletionHookResult{Name: "notify", Phase: velerov1api.DeletionHookPhasePre, Succeeded: true},
+			velerov1api.DeletionHookResult{Name: "release", Phase: velerov1api.DeletionHookPhasePre, Error: "exec failed"},
+		).Result()
 	t2, err2 := time.Parse("2006-Jan-02", "2023-Jun-25")
 	require.NoError(t, err2)
 	dbr2 := builder.ForDeleteBackupRequest("velero", "dbr2").
@@ -654,6 +714,9 @@ func TestDescribeDeleteBackupRequests(t *testing.T) {
   2023-06-26 00:00:00 +0000 UTC: Processed
   Errors:
     some error
+  Hooks:
+    notify (Pre):   Succeeded
+    release (Pre):  Failed: exec failed
 
   2023-06-25 00:00:00 +0000 UTC: InProgress
 `,
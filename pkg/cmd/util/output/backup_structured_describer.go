@@ -24,6 +24,7 @@ import (
 	"strings"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -41,11 +42,13 @@ func DescribeBackupInSF(
 	backup *velerov1api.Backup,
 	deleteRequests []velerov1api.DeleteBackupRequest,
 	podVolumeBackups []velerov1api.PodVolumeBackup,
-	details bool,
-	insecureSkipTLSVerify bool,
-	caCertFile string,
+	opts BackupDescribeOptions,
 	outputFormat string,
 ) string {
+	artifacts := NewBackupArtifacts(kbClient, backup, opts)
+	defer artifacts.Close()
+	artifacts.Prefetch(ctx, opts.artifactKinds(backup)...)
+
 	return DescribeInSF(func(d *StructuredDescriber) {
 		d.DescribeMetadata(backup.ObjectMeta)
 
@@ -55,16 +58,30 @@ func DescribeBackupInSF(
 			DescribeResourcePoliciesInSF(d, backup.Spec.ResourcePolicy)
 		}
 
+		if backup.Spec.InlineResourcePolicy != "" {
+			d.Describe("resourcePolicies", map[string]any{
+				"type":     "inline",
+				"document": backup.Spec.InlineResourcePolicy,
+			})
+		}
+
+		if backup.Spec.ResourceModifier != nil {
+			d.Describe("resourceModifier", map[string]any{
+				"type": backup.Spec.ResourceModifier.Kind,
+				"name": backup.Spec.ResourceModifier.Name,
+			})
+		}
+
 		status := backup.Status
 		if len(status.ValidationErrors) > 0 {
 			d.Describe("validationErrors", status.ValidationErrors)
 		}
 
-		DescribeBackupResultsInSF(ctx, kbClient, d, backup, insecureSkipTLSVerify, caCertFile)
+		DescribeBackupResultsInSF(ctx, d, backup, artifacts, opts)
 
 		DescribeBackupSpecInSF(d, backup.Spec)
 
-		DescribeBackupStatusInSF(ctx, kbClient, d, backup, details, insecureSkipTLSVerify, caCertFile, podVolumeBackups)
+		DescribeBackupStatusInSF(ctx, d, backup, artifacts, opts, podVolumeBackups)
 
 		if len(deleteRequests) > 0 {
 			DescribeDeleteBackupRequestsInSF(d, deleteRequests)
@@ -120,6 +137,17 @@ func DescribeBackupSpecInSF(d *StructuredDescriber, spec velerov1api.BackupSpec)
 
 	// describe storage location
 	backupSpecInfo["storageLocation"] = spec.StorageLocation
+	if spec.MirrorStorageLocation != "" {
+		backupSpecInfo["mirrorStorageLocation"] = spec.MirrorStorageLocation
+		backupSpecInfo["mirrorFailurePolicy"] = spec.MirrorFailurePolicy
+	}
+	if spec.TargetCluster != "" {
+		backupSpecInfo["targetCluster"] = spec.TargetCluster
+	}
+
+	if len(spec.Tags) > 0 {
+		backupSpecInfo["tags"] = spec.Tags
+	}
 
 	// describe snapshot volumes
 	backupSpecInfo["veleroNativeSnapshotPVs"] = BoolPointerString(spec.SnapshotVolumes, "false", "true", "auto")
@@ -222,8 +250,8 @@ func DescribeBackupSpecInSF(d *StructuredDescriber, spec velerov1api.BackupSpec)
 }
 
 // DescribeBackupStatusInSF describes a backup status in structured format.
-func DescribeBackupStatusInSF(ctx context.Context, kbClient kbclient.Client, d *StructuredDescriber, backup *velerov1api.Backup, details bool,
-	insecureSkipTLSVerify bool, caCertPath string, podVolumeBackups []velerov1api.PodVolumeBackup) {
+func DescribeBackupStatusInSF(ctx context.Context, d *StructuredDescriber, backup *velerov1api.Backup, artifacts *BackupArtifacts, opts BackupDescribeOptions,
+	podVolumeBackups []velerov1api.PodVolumeBackup) {
 	status := backup.Status
 	backupStatusInfo := make(map[string]any)
 
@@ -246,6 +274,9 @@ func DescribeBackupStatusInSF(ctx context.Context, kbClient kbclient.Client, d *
 	// if the controller hasn't processed this Backup yet, in which case this will
 	// just display `<nil>`, though this should be temporary.
 	backupStatusInfo["expiration"] = status.Expiration.String()
+	if status.ImmutableUntil != nil {
+		backupStatusInfo["immutableUntil"] = status.ImmutableUntil.String()
+	}
 
 	defer d.Describe("status", backupStatusInfo)
 
@@ -259,11 +290,59 @@ func DescribeBackupStatusInSF(ctx context.Context, kbClient kbclient.Client, d *
 		}
 	}
 
-	if details {
-		describeBackupResourceListInSF(ctx, kbClient, backupStatusInfo, backup, insecureSkipTLSVerify, caCertPath)
+	if opts.Details && opts.describes(BackupSectionResources) {
+		describeBackupResourceListInSF(ctx, backupStatusInfo, artifacts)
+	}
+
+	if opts.describes(BackupSectionVolumes) {
+		describeBackupVolumesInSF(ctx, backup, artifacts, opts.Details, podVolumeBackups, backupStatusInfo)
+	}
+
+	if opts.describes(BackupSectionSkipped) && len(backup.Status.SkippedItems) > 0 {
+		describeBackupSkippedItemsInSF(ctx, backup.Status.SkippedItems, artifacts, opts.Details, backupStatusInfo)
+	}
+
+	if status.MaxDurationExceeded {
+		backupStatusInfo["maxDurationExceeded"] = true
+		backupStatusInfo["incompleteItems"] = status.IncompleteItems
+		if len(status.IncompleteItemList) > 0 {
+			backupStatusInfo["incompleteItemList"] = status.IncompleteItemList
+		}
+	}
+
+	if status.ErrorBudgetExceeded {
+		backupStatusInfo["errorBudgetExceeded"] = true
+	}
+	if len(status.QuarantinedItems) > 0 {
+		backupStatusInfo["quarantinedItems"] = status.QuarantinedItems
+	}
+
+	if status.MirrorPhase != "" {
+		backupStatusInfo["mirrorPhase"] = status.MirrorPhase
+		if status.MirrorFailureReason != "" {
+			backupStatusInfo["mirrorFailureReason"] = status.MirrorFailureReason
+		}
+	}
+
+	if status.TargetCluster != nil {
+		backupStatusInfo["targetCluster"] = status.TargetCluster
 	}
 
-	describeBackupVolumesInSF(ctx, kbClient, backup, details, insecureSkipTLSVerify, caCertPath, podVolumeBackups, backupStatusInfo)
+	if corrupted := meta.FindStatusCondition(status.Conditions, velerov1api.ConditionTypeCorrupted); corrupted != nil && corrupted.Status == metav1.ConditionTrue {
+		backupStatusInfo["corrupted"] = corrupted.Message
+	}
+
+	if verified := meta.FindStatusCondition(status.Conditions, velerov1api.ConditionTypeVerified); verified != nil {
+		backupStatusInfo["verification"] = map[string]any{
+			"verified": verified.Status == metav1.ConditionTrue,
+			"reason":   verified.Reason,
+			"message":  verified.Message,
+		}
+	}
+
+	if len(status.NamespaceResults) > 0 {
+		backupStatusInfo["namespaceResults"] = status.NamespaceResults
+	}
 
 	if status.HookStatus != nil {
 		backupStatusInfo["hooksAttempted"] = status.HookStatus.HooksAttempted
@@ -271,12 +350,12 @@ func DescribeBackupStatusInSF(ctx context.Context, kbClient kbclient.Client, d *
 	}
 }
 
-func describeBackupResourceListInSF(ctx context.Context, kbClient kbclient.Client, backupStatusInfo map[string]any, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) {
+func describeBackupResourceListInSF(ctx context.Context, backupStatusInfo map[string]any, artifacts *BackupArtifacts) {
 	// In consideration of decoding structured output conveniently, the two separate fields were created here(in func describeBackupResourceList, there is only one field describing either error message or resource list)
 	// the field of 'errorGettingResourceList' gives specific error message when it fails to get resources list
 	// the field of 'resourceList' lists the rearranged resources
 	buf := new(bytes.Buffer)
-	if err := downloadrequest.Stream(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupResourceList, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+	if err := artifacts.Stream(ctx, velerov1api.DownloadTargetKindBackupResourceList, buf); err != nil {
 		if err == downloadrequest.ErrNotFound {
 			// the backup resource list could be missing if (other reasons may exist as well):
 			//	- the backup was taken prior to v1.1; or
@@ -298,8 +377,29 @@ func describeBackupResourceListInSF(ctx context.Context, kbClient kbclient.Clien
 	backupStatusInfo["resourceList"] = resourceList
 }
 
-func describeBackupVolumesInSF(ctx context.Context, kbClient kbclient.Client, backup *velerov1api.Backup, details bool,
-	insecureSkipTLSVerify bool, caCertPath string, podVolumeBackupCRs []velerov1api.PodVolumeBackup, backupStatusInfo map[string]any) {
+func describeBackupSkippedItemsInSF(ctx context.Context, counts []velerov1api.SkipReasonCount, artifacts *BackupArtifacts, details bool, backupStatusInfo map[string]any) {
+	skippedItems := map[string]any{"counts": counts}
+	defer func() { backupStatusInfo["skippedItems"] = skippedItems }()
+	if !details {
+		return
+	}
+
+	buf := new(bytes.Buffer)
+	if err := artifacts.Stream(ctx, velerov1api.DownloadTargetKindBackupSkippedItems, buf); err != nil {
+		skippedItems["errorGettingSkippedItems"] = fmt.Sprintf("<error getting skipped items: %v>", err)
+		return
+	}
+
+	var items []velerov1api.SkippedItem
+	if err := json.NewDecoder(buf).Decode(&items); err != nil {
+		skippedItems["errorGettingSkippedItems"] = fmt.Sprintf("<error reading skipped items: %v>", err)
+		return
+	}
+	skippedItems["items"] = items
+}
+
+func describeBackupVolumesInSF(ctx context.Context, backup *velerov1api.Backup, artifacts *BackupArtifacts, details bool,
+	podVolumeBackupCRs []velerov1api.PodVolumeBackup, backupStatusInfo map[string]any) {
 	backupVolumes := make(map[string]any)
 
 	nativeSnapshots := []*volume.BackupVolumeInfo{}
@@ -307,15 +407,15 @@ func describeBackupVolumesInSF(ctx context.Context, kbClient kbclient.Client, ba
 	legacyInfoSource := false
 
 	buf := new(bytes.Buffer)
-	err := downloadrequest.Stream(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupVolumeInfos, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath)
+	err := artifacts.Stream(ctx, velerov1api.DownloadTargetKindBackupVolumeInfos, buf)
 	if err == downloadrequest.ErrNotFound {
-		nativeSnapshots, err = retrieveNativeSnapshotLegacy(ctx, kbClient, backup, insecureSkipTLSVerify, caCertPath)
+		nativeSnapshots, err = retrieveNativeSnapshotLegacy(ctx, backup, artifacts)
 		if err != nil {
 			backupVolumes["errorConcludeNativeSnapshot"] = fmt.Sprintf("error concluding native snapshot info: %v", err)
 			return
 		}
 
-		csiSnapshots, err = retrieveCSISnapshotLegacy(ctx, kbClient, backup, insecureSkipTLSVerify, caCertPath)
+		csiSnapshots, err = retrieveCSISnapshotLegacy(ctx, backup, artifacts)
 		if err != nil {
 			backupVolumes["errorConcludeCSISnapshot"] = fmt.Sprintf("error concluding CSI snapshot info: %v", err)
 			return
@@ -470,6 +570,9 @@ func DescribeDeleteBackupRequestsInSF(d *StructuredDescriber, requests []velerov
 		if len(req.Status.Errors) > 0 {
 			deletionReq["errors"] = req.Status.Errors
 		}
+		if len(req.Status.HookResults) > 0 {
+			deletionReq["hookResults"] = req.Status.HookResults
+		}
 		deletionRequests = append(deletionRequests, deletionReq)
 	}
 	deletionAttempts["deleteBackupRequests"] = deletionRequests
@@ -529,8 +632,10 @@ func describePodVolumeBackupsInSF(backups []velerov1api.PodVolumeBackup, details
 }
 
 // DescribeBackupResultsInSF describes errors and warnings in structured format.
-func DescribeBackupResultsInSF(ctx context.Context, kbClient kbclient.Client, d *StructuredDescriber, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) {
-	if backup.Status.Warnings == 0 && backup.Status.Errors == 0 {
+func DescribeBackupResultsInSF(ctx context.Context, d *StructuredDescriber, backup *velerov1api.Backup, artifacts *BackupArtifacts, opts BackupDescribeOptions) {
+	describeWarnings := opts.describes(BackupSectionWarnings) && backup.Status.Warnings > 0
+	describeErrors := opts.describes(BackupSectionErrors) && backup.Status.Errors > 0
+	if !describeWarnings && !describeErrors {
 		return
 	}
 
@@ -545,7 +650,7 @@ func DescribeBackupResultsInSF(ctx context.Context, kbClient kbclient.Client, d
 
 	// If 'ErrNotFound' occurs, it means the backup bundle in the bucket has already been there before the backup-result file is introduced.
 	// We only display the count of errors and warnings in this case.
-	err := downloadrequest.Stream(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupResults, &buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath)
+	err := artifacts.Stream(ctx, velerov1api.DownloadTargetKindBackupResults, &buf)
 	if err == downloadrequest.ErrNotFound {
 		errors["count"] = backup.Status.Errors
 		warnings["count"] = backup.Status.Warnings
@@ -562,10 +667,10 @@ func DescribeBackupResultsInSF(ctx context.Context, kbClient kbclient.Client, d
 		return
 	}
 
-	if backup.Status.Warnings > 0 {
+	if describeWarnings {
 		describeResultInSF(warnings, resultMap["warnings"])
 	}
-	if backup.Status.Errors > 0 {
+	if describeErrors {
 		describeResultInSF(errors, resultMap["errors"])
 	}
 }
@@ -149,8 +149,22 @@ func DescribeRestore(
 
 		d.Printf("\tCluster-scoped:\t%s\n", BoolPointerString(restore.Spec.IncludeClusterResources, "excluded", "included", "auto"))
 
+		if len(restore.Spec.IncludedItems) > 0 {
+			d.Println()
+			d.Printf("Items:\n")
+			for _, item := range restore.Spec.IncludedItems {
+				d.Printf("\t%s\n", formatRestoreItemSelector(item))
+			}
+		}
+
 		d.Println()
 		d.DescribeMap("Namespace mappings", restore.Spec.NamespaceMapping)
+		if boolptr.IsSetToTrue(restore.Spec.RewriteNamespaceReferences) {
+			d.Printf("Rewrite Namespace References:\ttrue\n")
+			if restore.Spec.NamespaceReferenceRules != nil {
+				d.Printf("\tRules:\t%s %s\n", restore.Spec.NamespaceReferenceRules.Kind, restore.Spec.NamespaceReferenceRules.Name)
+			}
+		}
 
 		d.Println()
 		s = emptyDisplay
@@ -171,6 +185,11 @@ func DescribeRestore(
 		}
 		d.Printf("Or label selector:\t%s\n", s)
 
+		if restore.Spec.ExcludeLabelSelector != nil {
+			d.Println()
+			d.Printf("Exclude label selector:\t%s\n", metav1.FormatLabelSelector(restore.Spec.ExcludeLabelSelector))
+		}
+
 		d.Println()
 		d.Printf("Restore PVs:\t%s\n", BoolPointerString(restore.Spec.RestorePVs, "false", "true", "auto"))
 
@@ -201,6 +220,85 @@ func DescribeRestore(
 		}
 		d.Printf("Existing Resource Policy: \t%s\n", s)
 		d.Printf("ItemOperationTimeout:\t%s\n", restore.Spec.ItemOperationTimeout.Duration)
+		if restore.Spec.VolumeDetachPolicy != "" {
+			d.Printf("Volume Detach Policy:\t%s\n", restore.Spec.VolumeDetachPolicy)
+		}
+		if restore.Spec.PVCDataSourcePolicy != "" || len(restore.Spec.PVCDataSourcePolicyByStorageClass) > 0 {
+			s = string(velerov1api.PVCDataSourcePolicyAuto)
+			if restore.Spec.PVCDataSourcePolicy != "" {
+				s = string(restore.Spec.PVCDataSourcePolicy)
+			}
+			d.Printf("PVC Data Source Policy:\t%s\n", s)
+			storageClasses := make([]string, 0, len(restore.Spec.PVCDataSourcePolicyByStorageClass))
+			for storageClass := range restore.Spec.PVCDataSourcePolicyByStorageClass {
+				storageClasses = append(storageClasses, storageClass)
+			}
+			sort.Strings(storageClasses)
+			for _, storageClass := range storageClasses {
+				d.Printf("\t%s:\t%s\n", storageClass, restore.Spec.PVCDataSourcePolicyByStorageClass[storageClass])
+			}
+		}
+		if len(restore.Spec.ImmutableFieldPolicies) > 0 {
+			d.Printf("Immutable Field Policies:\n")
+			resources := make([]string, 0, len(restore.Spec.ImmutableFieldPolicies))
+			for resource := range restore.Spec.ImmutableFieldPolicies {
+				resources = append(resources, resource)
+			}
+			sort.Strings(resources)
+			for _, resource := range resources {
+				d.Printf("\t%s:\t%s\n", resource, restore.Spec.ImmutableFieldPolicies[resource])
+			}
+		}
+		if restore.Spec.QuotaReconciliation != "" {
+			d.Printf("Quota Reconciliation:\t%s\n", restore.Spec.QuotaReconciliation)
+		}
+		if restore.Spec.ErrorBudget != nil {
+			d.Printf("ErrorBudget:\t%d items\n", *restore.Spec.ErrorBudget)
+		}
+		if boolptr.IsSetToTrue(restore.Spec.ProtectNamespaces) {
+			d.Printf("Protect Namespaces:\ttrue\n")
+		}
+		if boolptr.IsSetToTrue(restore.Spec.ScaleDownConflictingWorkloads) {
+			d.Printf("Scale Down Conflicting Workloads:\ttrue\n")
+		}
+		if gradualScale := restore.Spec.GradualScale; gradualScale != nil {
+			d.Printf("Gradual Scale:\tstep %d, interval %s", gradualScale.Step, gradualScale.Interval.Duration)
+			if gradualScale.Timeout != nil {
+				d.Printf(", timeout %s", gradualScale.Timeout.Duration)
+			}
+			d.Printf("\n")
+		}
+		if restore.Spec.LoadBalancerPolicy != nil {
+			describeLoadBalancerPolicy(d, restore.Spec.LoadBalancerPolicy)
+		}
+		if boolptr.IsSetToTrue(restore.Spec.ServerDryRun) {
+			d.Printf("Server Dry Run:\ttrue\n")
+		}
+		if boolptr.IsSetToTrue(restore.Spec.Preview) {
+			d.Printf("Preview:\ttrue\n")
+		}
+		if restore.Spec.TargetCluster != "" {
+			d.Printf("Target Cluster:\t%s\n", restore.Spec.TargetCluster)
+		}
+		if restore.Spec.StagingStorageLocation != "" {
+			d.Printf("Staging Storage Location:\t%s\n", restore.Spec.StagingStorageLocation)
+		}
+		if len(restore.Spec.NamespacePriority) > 0 {
+			d.Printf("Namespace Priority:\t%s\n", strings.Join(restore.Spec.NamespacePriority, ", "))
+		}
+		if restore.Spec.NamespaceMetadataPolicy != "" {
+			d.Printf("Namespace Metadata Policy:\t%s\n", restore.Spec.NamespaceMetadataPolicy)
+		}
+		if boolptr.IsSetToTrue(restore.Spec.OrderByDependencies) {
+			d.Printf("Order By Dependencies:\ttrue\n")
+			for _, condition := range restore.Spec.DependencyWaitConditions {
+				timeout := "server resource timeout"
+				if condition.Timeout != nil {
+					timeout = condition.Timeout.Duration.String()
+				}
+				d.Printf("\tWait for %s:\t%s (timeout: %s)\n", condition.Resource, condition.Condition, timeout)
+			}
+		}
 
 		d.Println()
 		d.Printf("Preserve Service NodePorts:\t%s\n", BoolPointerString(restore.Spec.PreserveNodePorts, "false", "true", "auto"))
@@ -221,6 +319,53 @@ func DescribeRestore(
 			d.Printf("HooksFailed: \t%d\n", restore.Status.HookStatus.HooksFailed)
 		}
 
+		if restore.Status.VerificationStatus != nil {
+			d.Println()
+			d.Printf("VerificationsAttempted: \t%d\n", restore.Status.VerificationStatus.VerificationsAttempted)
+			d.Printf("VerificationsFailed: \t%d\n", restore.Status.VerificationStatus.VerificationsFailed)
+		}
+
+		if restore.Status.QuotaCappedItems > 0 {
+			d.Println()
+			d.Printf("Quota-capped items:\t%d (see the restore warnings)\n", restore.Status.QuotaCappedItems)
+		}
+
+		if restore.Status.ConvertedItems > 0 || restore.Status.UnconvertibleItems > 0 {
+			d.Println()
+			d.Printf("Converted items:\t%d (see the restore warnings)\n", restore.Status.ConvertedItems)
+			d.Printf("Unconvertible items:\t%d (see the restore warnings and errors)\n", restore.Status.UnconvertibleItems)
+		}
+
+		if len(restore.Status.APIVersionDrift) > 0 {
+			d.Println()
+			describeAPIVersionDrift(d, restore.Status.APIVersionDrift)
+		}
+
+		if restore.Status.TargetCluster != nil {
+			d.Println()
+			describeTargetClusterStatus(d, restore.Status.TargetCluster)
+		}
+
+		if len(restore.Status.LoadBalancerAddresses) > 0 {
+			d.Println()
+			describeLoadBalancerAddresses(d, restore.Status.LoadBalancerAddresses)
+		}
+
+		if len(restore.Status.QuarantinedItems) > 0 || restore.Status.ErrorBudgetExceeded {
+			d.Println()
+			describeQuarantinedItems(d, restore.Status.QuarantinedItems, restore.Status.ErrorBudgetExceeded)
+		}
+
+		if restore.Spec.ExistingResourcePolicy == velerov1api.PolicyTypeReconcile && details {
+			d.Println()
+			describeRestoreDriftReport(ctx, kbClient, d, restore, insecureSkipTLSVerify, caCertFile)
+		}
+
+		if boolptr.IsSetToTrue(restore.Spec.Preview) && details {
+			d.Println()
+			describeRestorePreviewReport(ctx, kbClient, d, restore, insecureSkipTLSVerify, caCertFile)
+		}
+
 		if details {
 			d.Println()
 			describeRestoreResourceList(ctx, kbClient, d, restore, insecureSkipTLSVerify, caCertFile)
@@ -228,6 +373,94 @@ func DescribeRestore(
 	})
 }
 
+// describeRestorePreviewReport describes what a restore with Preview set would do with its items.
+func describeRestorePreviewReport(ctx context.Context, kbClient kbclient.Client, d *Describer, restore *velerov1api.Restore, insecureSkipTLSVerify bool, caCertPath string) {
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(ctx, kbClient, restore.Namespace, restore.Name, velerov1api.DownloadTargetKindRestorePreviewReport, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		if errors.Is(err, downloadrequest.ErrNotFound) {
+			d.Printf("Preview:	<none>\n")
+		} else {
+			d.Printf("Preview:	<error getting preview report: %v>\n", err)
+		}
+		return
+	}
+
+	var report []velerov1api.PreviewItem
+	if err := json.NewDecoder(buf).Decode(&report); err != nil {
+		d.Printf("Preview:	<error reading preview report: %v>\n", err)
+		return
+	}
+	describePreviewItems(d, report)
+}
+
+// describePreviewItems describes the items of a preview report grouped by what the restore would
+// do with them.
+func describePreviewItems(d *Describer, report []velerov1api.PreviewItem) {
+	d.Printf("Preview:\n")
+	for _, action := range []velerov1api.PreviewAction{velerov1api.PreviewActionCreate, velerov1api.PreviewActionPatch, velerov1api.PreviewActionRecreate, velerov1api.PreviewActionSkip} {
+		var lines []string
+		for _, item := range report {
+			if item.Action != action {
+				continue
+			}
+			name := item.Name
+			if item.Namespace != "" {
+				name = fmt.Sprintf("%s/%s", item.Namespace, item.Name)
+			}
+			line := fmt.Sprintf("%s %s", item.Resource, name)
+			if item.Reason != "" {
+				line += ": " + item.Reason
+			}
+			lines = append(lines, line)
+		}
+		if len(lines) == 0 {
+			continue
+		}
+		d.Printf("\t%s (%d):\n", action, len(lines))
+		for _, line := range lines {
+			d.Printf("\t\t%s\n", line)
+		}
+	}
+}
+
+// describeRestoreDriftReport describes the items which drifted from the backup in a restore
+// with the reconcile ExistingResourcePolicy.
+func describeRestoreDriftReport(ctx context.Context, kbClient kbclient.Client, d *Describer, restore *velerov1api.Restore, insecureSkipTLSVerify bool, caCertPath string) {
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(ctx, kbClient, restore.Namespace, restore.Name, velerov1api.DownloadTargetKindRestoreDriftReport, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		if errors.Is(err, downloadrequest.ErrNotFound) {
+			d.Printf("Drifted items:	<none>\n")
+		} else {
+			d.Printf("Drifted items:	<error getting drift report: %v>\n", err)
+		}
+		return
+	}
+
+	var report []velerov1api.DriftedItem
+	if err := json.NewDecoder(buf).Decode(&report); err != nil {
+		d.Printf("Drifted items:	<error reading drift report: %v>\n", err)
+		return
+	}
+
+	d.Printf("Drifted items:\n")
+	for _, item := range report {
+		name := item.Name
+		if item.Namespace != "" {
+			name = fmt.Sprintf("%s/%s", item.Namespace, item.Name)
+		}
+		d.Printf("\t%s %s:\n", item.Resource, name)
+		if len(item.RepairedFields) > 0 {
+			d.Printf("\t\tRepaired:\t%s\n", strings.Join(item.RepairedFields, ", "))
+		}
+		if len(item.ConflictingFields) > 0 {
+			d.Printf("\t\tNot repaired, owned by other field managers:\t%s\n", strings.Join(item.ConflictingFields, ", "))
+		}
+		if item.Error != "" {
+			d.Printf("\t\tError:\t%s\n", item.Error)
+		}
+	}
+}
+
 // describeUploaderConfigForRestore describes uploader config in human-readable format
 func describeUploaderConfigForRestore(d *Describer, spec velerov1api.RestoreSpec) {
 	if spec.UploaderConfig != nil {
@@ -239,6 +472,12 @@ func describeUploaderConfigForRestore(d *Describer, spec velerov1api.RestoreSpec
 		if spec.UploaderConfig.ParallelFilesDownload > 0 {
 			d.Printf("\tParallel Restore:\t%d\n", spec.UploaderConfig.ParallelFilesDownload)
 		}
+		if len(spec.UploaderConfig.UIDMapping) > 0 {
+			d.DescribeMap("\tUID Mapping", spec.UploaderConfig.UIDMapping)
+		}
+		if len(spec.UploaderConfig.GIDMapping) > 0 {
+			d.DescribeMap("\tGID Mapping", spec.UploaderConfig.GIDMapping)
+		}
 	}
 }
 
@@ -487,9 +726,59 @@ func describeRestoreResourceList(ctx context.Context, kbClient kbclient.Client,
 	}
 }
 
+// describeAPIVersionDrift describes the items restored with another API version than the one the
+// source cluster preferred.
+func describeAPIVersionDrift(d *Describer, drift []velerov1api.APIVersionDrift) {
+	d.Println("API Version Drift:")
+	for _, item := range drift {
+		d.Printf("\t%s:\t%s -> %s (%d items)\n", item.Kind, item.SourceAPIVersion, item.AppliedAPIVersion, item.Items)
+	}
+}
+
+func describeLoadBalancerPolicy(d *Describer, policy *velerov1api.LoadBalancerPolicy) {
+	annotations, staticIPs := policy.Annotations, policy.StaticIPs
+	if annotations == "" {
+		annotations = velerov1api.LoadBalancerFieldPolicyPreserve
+	}
+	if staticIPs == "" {
+		staticIPs = velerov1api.LoadBalancerFieldPolicyPreserve
+	}
+	d.Printf("Load Balancer Policy:\tannotations %s, static IPs %s", annotations, staticIPs)
+	if boolptr.IsSetToTrue(policy.WaitForProvisioning) {
+		d.Printf(", wait for provisioning")
+		if policy.Timeout != nil {
+			d.Printf(" (timeout %s)", policy.Timeout.Duration)
+		}
+	}
+	d.Printf("\n")
+}
+
+func describeLoadBalancerAddresses(d *Describer, mappings []velerov1api.LoadBalancerAddressMapping) {
+	d.Println("Load Balancer Addresses:")
+	for _, mapping := range mappings {
+		backupAddresses, addresses := strings.Join(mapping.BackupAddresses, ", "), strings.Join(mapping.Addresses, ", ")
+		if backupAddresses == "" {
+			backupAddresses = emptyDisplay
+		}
+		if addresses == "" {
+			addresses = "<pending>"
+		}
+		d.Printf("\t%s/%s:\t%s -> %s\n", mapping.Namespace, mapping.Name, backupAddresses, addresses)
+	}
+}
+
 // DescribeResourceModifier describes resource policies in human-readable format
 func DescribeResourceModifier(d *Describer, resModifier *v1.TypedLocalObjectReference) {
 	d.Printf("Resource modifier:\n")
 	d.Printf("\tType:\t%s\n", resModifier.Kind)
 	d.Printf("\tName:\t%s\n", resModifier.Name)
 }
+
+// formatRestoreItemSelector returns the item as namespace/resource/name, or resource/name if it's
+// cluster-scoped.
+func formatRestoreItemSelector(item velerov1api.RestoreItemSelector) string {
+	if item.Namespace == "" {
+		return item.Resource + "/" + item.Name
+	}
+	return item.Namespace + "/" + item.Resource + "/" + item.Name
+}
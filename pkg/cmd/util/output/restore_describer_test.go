@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/vmware-tanzu/velero/internal/volume"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -415,3 +416,79 @@ func TestDescribeResourceModifier(t *testing.T) {
 	fmt.Println(d.buf.String())
 	require.Equal(t, expectOutput, d.buf.String())
 }
+
+func TestDescribeAPIVersionDrift(t *testing.T) {
+	d := &Describer{
+		Prefix: "",
+		out:    &tabwriter.Writer{},
+		buf:    &bytes.Buffer{},
+	}
+
+	d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+
+	describeAPIVersionDrift(d, []velerov1api.APIVersionDrift{
+		{Kind: "HorizontalPodAutoscaler", SourceAPIVersion: "autoscaling/v2beta2", AppliedAPIVersion: "autoscaling/v2", Items: 3},
+		{Kind: "Ingress", SourceAPIVersion: "extensions/v1beta1", AppliedAPIVersion: "networking.k8s.io/v1", Items: 1},
+	})
+	d.out.Flush()
+
+	expectOutput := `API Version Drift:
+  HorizontalPodAutoscaler:  autoscaling/v2beta2 -> autoscaling/v2 (3 items)
+  Ingress:                  extensions/v1beta1 -> networking.k8s.io/v1 (1 items)
+`
+	require.Equal(t, expectOutput, d.buf.String())
+}
+
+func TestDescribeLoadBalancerAddresses(t *testing.T) {
+	d := &Describer{
+		Prefix: "",
+		out:    &tabwriter.Writer{},
+		buf:    &bytes.Buffer{},
+	}
+
+	d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+
+	describeLoadBalancerPolicy(d, &velerov1api.LoadBalancerPolicy{
+		StaticIPs:           velerov1api.LoadBalancerFieldPolicyStrip,
+		WaitForProvisioning: boolptr.True(),
+		Timeout:             &metav1.Duration{Duration: time.Minute},
+	})
+	describeLoadBalancerAddresses(d, []velerov1api.LoadBalancerAddressMapping{
+		{Namespace: "ns-1", Name: "svc-1", BackupAddresses: []string{"10.0.0.1"}, Addresses: []string{"10.0.0.2", "lb.example.com"}},
+		{Namespace: "ns-1", Name: "svc-2", BackupAddresses: []string{"10.0.0.3"}},
+	})
+	d.out.Flush()
+
+	expectOutput := `Load Balancer Policy:  annotations Preserve, static IPs Strip, wait for provisioning (timeout 1m0s)
+Load Balancer Addresses:
+  ns-1/svc-1:  10.0.0.1 -> 10.0.0.2, lb.example.com
+  ns-1/svc-2:  10.0.0.3 -> <pending>
+`
+	require.Equal(t, expectOutput, d.buf.String())
+}
+
+func TestDescribePreviewItems(t *testing.T) {
+	d := &Describer{
+		Prefix: "",
+		out:    &tabwriter.Writer{},
+		buf:    &bytes.Buffer{},
+	}
+
+	d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+
+	describePreviewItems(d, []velerov1api.PreviewItem{
+		{Resource: "namespaces", Name: "ns-1", Action: velerov1api.PreviewActionCreate},
+		{Resource: "configmaps", Namespace: "ns-1", Name: "cm-1", Action: velerov1api.PreviewActionSkip, Reason: "the resource is excluded"},
+		{Resource: "services", Namespace: "ns-1", Name: "svc-1", Action: velerov1api.PreviewActionCreate},
+	})
+	d.out.Flush()
+
+	expectOutput := `Preview:
+  Create (2):
+    namespaces ns-1
+    services ns-1/svc-1
+  Skip (1):
+    configmaps ns-1/cm-1: the resource is excluded
+`
+	require.Equal(t, expectOutput, d.buf.String())
+}
@@ -48,7 +48,12 @@ func DescribeSchedule(schedule *v1.Schedule) string {
 			DescribeResourcePolicies(d, schedule.Spec.Template.ResourcePolicy)
 		}
 
-		if schedule.Spec.Template.UploaderConfig != nil && schedule.Spec.Template.UploaderConfig.ParallelFilesUpload > 0 {
+		if schedule.Spec.Template.InlineResourcePolicy != "" {
+			d.Println()
+			DescribeInlineResourcePolicies(d, schedule.Spec.Template.InlineResourcePolicy)
+		}
+
+		if schedule.Spec.Template.UploaderConfig != nil && (schedule.Spec.Template.UploaderConfig.ParallelFilesUpload > 0 || schedule.Spec.Template.UploaderConfig.ChangedBlockTracking) {
 			d.Println()
 			DescribeUploaderConfigForBackup(d, schedule.Spec.Template)
 		}
@@ -75,6 +80,35 @@ func DescribeSchedule(schedule *v1.Schedule) string {
 
 func DescribeScheduleSpec(d *Describer, spec v1.ScheduleSpec) {
 	d.Printf("Schedule:\t%s\n", spec.Schedule)
+	if spec.Timezone != "" {
+		d.Printf("Timezone:\t%s\n", spec.Timezone)
+	}
+	if spec.Jitter.Duration > 0 {
+		d.Printf("Jitter:\t%s\n", spec.Jitter.Duration)
+	}
+	if spec.PreserveBackupsOnScheduleDelete {
+		d.Printf("Preserve Backups On Delete:\t%t\n", spec.PreserveBackupsOnScheduleDelete)
+	}
+	if spec.VariablesConfigMap != "" {
+		d.Printf("Variables ConfigMap:\t%s\n", spec.VariablesConfigMap)
+	}
+	if spec.DependsOn != nil {
+		failurePolicy := spec.DependsOn.FailurePolicy
+		if failurePolicy == "" {
+			failurePolicy = v1.ScheduleDependencyFailurePolicySkip
+		}
+		timeout := "1h0m0s"
+		if spec.DependsOn.Timeout.Duration > 0 {
+			timeout = spec.DependsOn.Timeout.Duration.String()
+		}
+		d.Printf("Depends On:\t%s (timeout %s, on failure %s)\n", spec.DependsOn.Schedule, timeout, failurePolicy)
+	}
+	if spec.StorageBudget != nil {
+		d.Printf("Storage Budget:\t%s\n", spec.StorageBudget.String())
+	}
+	if spec.Retention != nil {
+		d.Printf("Retention:\t%d daily, %d weekly, %d monthly\n", spec.Retention.KeepDaily, spec.Retention.KeepWeekly, spec.Retention.KeepMonthly)
+	}
 
 	d.Println()
 	d.Println("Backup Template:")
@@ -0,0 +1,64 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	"github.com/vmware-tanzu/velero/pkg/repository"
+	repomanager "github.com/vmware-tanzu/velero/pkg/repository/manager"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+// NewManager returns a manager of the backup repositories of the namespace of Velero, for the
+// commands querying the repositories from the machine they run on. The credentials of the
+// backup storage locations and the repository password are read from the secrets of the
+// namespace, else from the environment of the command. The returned function removes the
+// credentials written to disk.
+func NewManager(client kbclient.Client, namespace string, log logrus.FieldLogger) (repomanager.Manager, func(), error) {
+	dir, err := os.MkdirTemp("", "velero-credentials-")
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error creating the credentials directory")
+	}
+	cleanup := func() { os.RemoveAll(dir) }
+
+	credentialFileStore, err := credentials.NewNamespacedFileStore(client, namespace, dir, filesystem.NewFileSystem())
+	if err != nil {
+		cleanup()
+		return nil, nil, errors.Wrap(err, "error creating the namespaced file store")
+	}
+
+	credentialSecretStore, err := credentials.NewNamespacedSecretStore(client, namespace)
+	if err != nil {
+		cleanup()
+		return nil, nil, errors.Wrap(err, "error creating the namespaced secret store")
+	}
+
+	return repomanager.NewManager(
+		namespace,
+		client,
+		repository.NewRepoLocker(),
+		credentialFileStore,
+		credentialSecretStore,
+		log,
+	), cleanup, nil
+}
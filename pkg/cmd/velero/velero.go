@@ -20,6 +20,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
@@ -39,6 +40,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/describe"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/get"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/install"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/operation"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/plugin"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/repo"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/restore"
@@ -126,8 +128,19 @@ operations can also be performed as 'velero backup get' and 'velero schedule cre
 		debug.NewCommand(f),
 		repomantenance.NewCommand(f),
 		datamover.NewCommand(f),
+		operation.NewCommand(f),
 	)
 
+	completion.RegisterFlagCompletions(c, f)
+
+	// when installed as a kubectl plugin, e.g. with krew, the usage and completions are
+	// displayed for "kubectl velero"
+	if plugin, ok := strings.CutPrefix(name, "kubectl-"); ok {
+		c.Annotations = map[string]string{
+			cobra.CommandDisplayNameAnnotation: "kubectl " + plugin,
+		}
+	}
+
 	// init and add the klog flags
 	klog.InitFlags(flag.CommandLine)
 	c.PersistentFlags().AddGoFlagSet(flag.CommandLine)
@@ -1,24 +1,29 @@
 package constant
 
 const (
-	ControllerBackup                = "backup"
-	ControllerBackupOperations      = "backup-operations"
-	ControllerBackupDeletion        = "backup-deletion"
-	ControllerBackupFinalizer       = "backup-finalizer"
-	ControllerBackupRepo            = "backup-repo"
-	ControllerBackupStorageLocation = "backup-storage-location"
-	ControllerBackupSync            = "backup-sync"
-	ControllerDataDownload          = "data-download"
-	ControllerDataUpload            = "data-upload"
-	ControllerDownloadRequest       = "download-request"
-	ControllerGarbageCollection     = "gc"
-	ControllerPodVolumeBackup       = "pod-volume-backup"
-	ControllerPodVolumeRestore      = "pod-volume-restore"
-	ControllerRestore               = "restore"
-	ControllerRestoreOperations     = "restore-operations"
-	ControllerSchedule              = "schedule"
-	ControllerServerStatusRequest   = "server-status-request"
-	ControllerRestoreFinalizer      = "restore-finalizer"
+	ControllerBackup                 = "backup"
+	ControllerBackupOperations       = "backup-operations"
+	ControllerBackupDeletion         = "backup-deletion"
+	ControllerBackupFinalizer        = "backup-finalizer"
+	ControllerBackupRepo             = "backup-repo"
+	ControllerBackupStorageLocation  = "backup-storage-location"
+	ControllerBackupSync             = "backup-sync"
+	ControllerBackupVerification     = "backup-verification"
+	ControllerDataDownload           = "data-download"
+	ControllerDataUpload             = "data-upload"
+	ControllerDownloadRequest        = "download-request"
+	ControllerGarbageCollection      = "gc"
+	ControllerPodVolumeBackup        = "pod-volume-backup"
+	ControllerPodVolumeRestore       = "pod-volume-restore"
+	ControllerRestore                = "restore"
+	ControllerRestoreOperations      = "restore-operations"
+	ControllerRestoreTest            = "restore-test"
+	ControllerRetention              = "retention"
+	ControllerRuntimeConfig          = "runtime-config"
+	ControllerSchedule               = "schedule"
+	ControllerServerStatusRequest    = "server-status-request"
+	ControllerStorageLayoutMigration = "storage-layout-migration"
+	ControllerRestoreFinalizer       = "restore-finalizer"
 
 	PluginCSIPVCRestoreRIA            = "velero.io/csi-pvc-restorer"
 	PluginCsiVolumeSnapshotRestoreRIA = "velero.io/csi-volumesnapshot-restorer"
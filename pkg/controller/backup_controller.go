@@ -21,6 +21,8 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 	"time"
 
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
@@ -37,6 +39,7 @@ import (
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	"github.com/vmware-tanzu/velero/internal/storage"
 	"github.com/vmware-tanzu/velero/internal/volume"
@@ -50,8 +53,10 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/targetcluster"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	"github.com/vmware-tanzu/velero/pkg/util/conditions"
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
@@ -85,9 +90,21 @@ type backupReconciler struct {
 	credentialFileStore         credentials.FileStore
 	maxConcurrentK8SConnections int
 	defaultSnapshotMoveData     bool
-	globalCRClient              kbclient.Client
-	itemBlockWorkerCount        int
-	workerPool                  *pkgbackup.ItemBlockWorkerPool
+	// defaultResourcePolicies is the name of the ConfigMap of the resource policies of the
+	// backups which don't refer to any.
+	defaultResourcePolicies string
+	// backupCheckpointInterval is how often the progress of a backup is saved to resume it from
+	// if the server restarts, 0 if it isn't.
+	backupCheckpointInterval time.Duration
+	// agentless is true when the server runs without the node-agent, so the data
+	// of the snapshots can't be moved.
+	agentless bool
+	// targetClusters is set when the server runs in the hub mode, to back up the target
+	// clusters of the backups.
+	targetClusters       targetcluster.Getter
+	globalCRClient       kbclient.Client
+	itemBlockWorkerCount int
+	workerPool           *pkgbackup.ItemBlockWorkerPool
 }
 
 func NewBackupReconciler(
@@ -112,8 +129,12 @@ func NewBackupReconciler(
 	credentialStore credentials.FileStore,
 	maxConcurrentK8SConnections int,
 	defaultSnapshotMoveData bool,
+	defaultResourcePolicies string,
+	backupCheckpointInterval time.Duration,
 	itemBlockWorkerCount int,
 	globalCRClient kbclient.Client,
+	agentless bool,
+	targetClusters targetcluster.Getter,
 ) *backupReconciler {
 	b := &backupReconciler{
 		ctx:                         ctx,
@@ -138,14 +159,23 @@ func NewBackupReconciler(
 		credentialFileStore:         credentialStore,
 		maxConcurrentK8SConnections: maxConcurrentK8SConnections,
 		defaultSnapshotMoveData:     defaultSnapshotMoveData,
+		defaultResourcePolicies:     defaultResourcePolicies,
+		backupCheckpointInterval:    backupCheckpointInterval,
 		itemBlockWorkerCount:        itemBlockWorkerCount,
 		globalCRClient:              globalCRClient,
+		agentless:                   agentless,
+		targetClusters:              targetClusters,
 		workerPool:                  pkgbackup.StartItemBlockWorkerPool(ctx, itemBlockWorkerCount, logger),
 	}
 	b.updateTotalBackupMetric()
 	return b
 }
 
+// SetItemBlockWorkerCount changes the number of workers processing the ItemBlocks of the backups.
+func (b *backupReconciler) SetItemBlockWorkerCount(count int) {
+	b.workerPool.Resize(count)
+}
+
 func (b *backupReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&velerov1api.Backup{}).
@@ -246,13 +276,20 @@ func (b *backupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 
 	log.Debug("Preparing backup request")
 	request := b.prepareBackupRequest(original, log)
+	request.Context = ctx
 	if len(request.Status.ValidationErrors) > 0 {
 		request.Status.Phase = velerov1api.BackupPhaseFailedValidation
 	} else {
 		request.Status.Phase = velerov1api.BackupPhaseInProgress
-		request.Status.StartTimestamp = &metav1.Time{Time: b.clock.Now()}
+		// a resumed backup keeps the start time of its first run
+		if request.Status.StartTimestamp == nil || request.Annotations[velerov1api.ResumeBackupAnnotation] == "" {
+			request.Status.StartTimestamp = &metav1.Time{Time: b.clock.Now()}
+		}
 	}
 
+	conditions.SetBackupConditions(request.Backup, b.clock.Now())
+	conditions.SetObservedGeneration(original, request.Backup)
+
 	// update status to
 	// BackupPhaseFailedValidation
 	// BackupPhaseInProgress
@@ -267,6 +304,7 @@ func (b *backupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		log.Debug("failed to validate backup status")
 		b.metrics.RegisterBackupValidationFailure(backupScheduleName)
 		b.metrics.RegisterBackupLastStatus(backupScheduleName, metrics.BackupLastStatusFailure)
+		pushBackupOutcome(request.Backup, b.metrics, b.clock.Now())
 
 		return ctrl.Result{}, nil
 	}
@@ -313,6 +351,10 @@ func (b *backupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		b.metrics.RegisterBackupValidationFailure(backupScheduleName)
 		b.metrics.RegisterBackupLastStatus(backupScheduleName, metrics.BackupLastStatusFailure)
 	}
+	switch request.Status.Phase {
+	case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed, velerov1api.BackupPhaseFailedValidation:
+		pushBackupOutcome(request.Backup, b.metrics, b.clock.Now())
+	}
 	log.Info("Updating backup's status")
 	// Phases were updated in runBackup()
 	// This patch with retry update Phase from InProgress to
@@ -321,6 +363,8 @@ func (b *backupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	// BackupPhaseFinalizing -> backup_finalizer_controller.go will now reconcile
 	// BackupPhaseFinalizingPartiallyFailed -> backup_finalizer_controller.go will now reconcile
 	// BackupPhaseFailed
+	conditions.SetBackupConditions(request.Backup, b.clock.Now())
+	conditions.SetObservedGeneration(original, request.Backup)
 	if err := kubeutil.PatchResourceWithRetriesOnErrors(b.resourceTimeout, original, request.Backup, b.kbClient); err != nil {
 		log.WithError(err).Errorf("error updating backup's status from %v to %v", original.Status.Phase, request.Backup.Status.Phase)
 	}
@@ -374,6 +418,17 @@ func (b *backupReconciler) prepareBackupRequest(backup *velerov1api.Backup, logg
 	if request.Spec.SnapshotMoveData == nil {
 		request.Spec.SnapshotMoveData = &b.defaultSnapshotMoveData
 	}
+	if b.agentless && boolptr.IsSetToTrue(request.Spec.SnapshotMoveData) {
+		logger.Warn("The server is agentless, the snapshot data of the backup won't be moved")
+		request.Spec.SnapshotMoveData = boolptr.False()
+	}
+
+	if request.Spec.ResourcePolicy == nil && request.Spec.InlineResourcePolicy == "" && b.defaultResourcePolicies != "" {
+		request.Spec.ResourcePolicy = &corev1api.TypedLocalObjectReference{
+			Kind: resourcepolicies.ConfigmapRefType,
+			Name: b.defaultResourcePolicies,
+		}
+	}
 
 	// find which storage location to use
 	var serverSpecified bool
@@ -417,6 +472,28 @@ func (b *backupReconciler) prepareBackupRequest(backup *velerov1api.Backup, logg
 			request.Status.ValidationErrors = append(request.Status.ValidationErrors,
 				fmt.Sprintf("backup can't be created because backup storage location %s is currently in read-only mode", request.StorageLocation.Name))
 		}
+
+		// the objects of the backup are locked until it expires
+		if request.StorageLocation.Spec.ObjectLock != nil && request.Spec.TTL.Duration > 0 {
+			request.Status.ImmutableUntil = request.Status.Expiration.DeepCopy()
+		}
+	}
+
+	if request.Spec.MirrorStorageLocation != "" {
+		mirrorLocation := &velerov1api.BackupStorageLocation{}
+		if err := b.kbClient.Get(context.Background(), kbclient.ObjectKey{
+			Namespace: request.Namespace,
+			Name:      request.Spec.MirrorStorageLocation,
+		}, mirrorLocation); err != nil {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("error getting mirror backup storage location %s: %v", request.Spec.MirrorStorageLocation, err))
+		} else if mirrorLocation.Name == request.Spec.StorageLocation {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors, "mirror backup storage location must be different from the backup storage location")
+		} else if mirrorLocation.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors,
+				fmt.Sprintf("backup can't be created because mirror backup storage location %s is currently in read-only mode", mirrorLocation.Name))
+		} else {
+			request.MirrorStorageLocation = mirrorLocation
+		}
 	}
 
 	// add the storage location as a label for easy filtering later.
@@ -425,6 +502,29 @@ func (b *backupReconciler) prepareBackupRequest(backup *velerov1api.Backup, logg
 	}
 	request.Labels[velerov1api.StorageLocationLabel] = label.GetValidName(request.Spec.StorageLocation)
 
+	// the target cluster is backed up in place of the cluster the server runs in, without the node-agent
+	discoveryHelper := b.discoveryHelper
+	clusterClient := b.kbClient
+	if request.Spec.TargetCluster != "" {
+		if cluster, err := getTargetCluster(b.ctx, b.targetClusters, request.Spec.TargetCluster); err != nil {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("error getting target cluster: %v", err))
+		} else {
+			discoveryHelper = cluster.DiscoveryHelper
+			clusterClient = cluster.CRClient
+			request.Labels[velerov1api.TargetClusterLabel] = label.GetValidName(request.Spec.TargetCluster)
+			request.Status.TargetCluster = cluster.Status()
+			if boolptr.IsSetToTrue(request.Spec.SnapshotMoveData) {
+				logger.Warn("The node-agent isn't used for target clusters, the snapshot data of the backup won't be moved")
+				request.Spec.SnapshotMoveData = boolptr.False()
+			}
+			// the pod volumes of a target cluster can't be backed up without its node-agent
+			if boolptr.IsSetToTrue(backup.Spec.DefaultVolumesToFsBackup) {
+				request.Status.ValidationErrors = append(request.Status.ValidationErrors, "file system backups of the pod volumes aren't supported for target clusters")
+			}
+			request.Spec.DefaultVolumesToFsBackup = boolptr.False()
+		}
+	}
+
 	// validate and get the backup's VolumeSnapshotLocations, and store the
 	// VolumeSnapshotLocation API objs on the request
 	if locs, errs := b.validateAndGetSnapshotLocations(request.Backup); len(errs) > 0 {
@@ -441,15 +541,15 @@ func (b *backupReconciler) prepareBackupRequest(backup *velerov1api.Backup, logg
 	if request.Annotations == nil {
 		request.Annotations = make(map[string]string)
 	}
-	request.Annotations[velerov1api.SourceClusterK8sGitVersionAnnotation] = b.discoveryHelper.ServerVersion().String()
-	request.Annotations[velerov1api.SourceClusterK8sMajorVersionAnnotation] = b.discoveryHelper.ServerVersion().Major
-	request.Annotations[velerov1api.SourceClusterK8sMinorVersionAnnotation] = b.discoveryHelper.ServerVersion().Minor
+	request.Annotations[velerov1api.SourceClusterK8sGitVersionAnnotation] = discoveryHelper.ServerVersion().String()
+	request.Annotations[velerov1api.SourceClusterK8sMajorVersionAnnotation] = discoveryHelper.ServerVersion().Major
+	request.Annotations[velerov1api.SourceClusterK8sMinorVersionAnnotation] = discoveryHelper.ServerVersion().Minor
 	request.Annotations[velerov1api.ResourceTimeoutAnnotation] = b.resourceTimeout.String()
 
 	// Add namespaces with label velero.io/exclude-from-backup=true into request.Spec.ExcludedNamespaces
 	// Essentially, adding the label velero.io/exclude-from-backup=true to a namespace would be equivalent to setting spec.ExcludedNamespaces
 	namespaces := corev1api.NamespaceList{}
-	if err := b.kbClient.List(context.Background(), &namespaces, kbclient.MatchingLabels{velerov1api.ExcludeFromBackupLabel: "true"}); err == nil {
+	if err := clusterClient.List(context.Background(), &namespaces, kbclient.MatchingLabels{velerov1api.ExcludeFromBackupLabel: "true"}); err == nil {
 		for _, ns := range namespaces.Items {
 			request.Spec.ExcludedNamespaces = append(request.Spec.ExcludedNamespaces, ns.Name)
 		}
@@ -481,6 +581,17 @@ func (b *backupReconciler) prepareBackupRequest(backup *velerov1api.Backup, logg
 		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid namespace-scoped included/excluded resource lists: %s", err))
 	}
 
+	// validate that the included resources exist, rather than silently matching nothing. The
+	// excluded resources may not exist in every cluster, e.g. in a schedule shared by clusters.
+	includedResources := slices.Concat(request.Spec.IncludedResources, request.Spec.IncludedClusterScopedResources, request.Spec.IncludedNamespaceScopedResources)
+	for _, err := range collections.ValidateResourceNames(discoveryHelper, includedResources) {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid included resources: %v", err))
+	}
+	excludedResources := slices.Concat(request.Spec.ExcludedResources, request.Spec.ExcludedClusterScopedResources, request.Spec.ExcludedNamespaceScopedResources)
+	for _, err := range collections.ValidateResourceNames(discoveryHelper, excludedResources) {
+		logger.WithError(err).Warn("Excluded resource not found in the cluster")
+	}
+
 	// validate the included/excluded namespaces
 	for _, err := range collections.ValidateNamespaceIncludesExcludes(request.Spec.IncludedNamespaces, request.Spec.ExcludedNamespaces) {
 		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded namespace lists: %v", err))
@@ -491,15 +602,62 @@ func (b *backupReconciler) prepareBackupRequest(backup *velerov1api.Backup, logg
 		request.Status.ValidationErrors = append(request.Status.ValidationErrors, "encountered labelSelector as well as orLabelSelectors in backup spec, only one can be specified")
 	}
 
+	// validate the excluded items filters
+	for _, err := range pkgbackup.ValidateItemFieldFilters(request.Spec.ExcludedItems) {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid excluded items: %v", err))
+	}
+
+	// validate the list field selectors
+	for _, err := range pkgbackup.ValidateListFieldSelectors(request.Spec.ListFieldSelectors) {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid list field selectors: %v", err))
+	}
+
+	// validate the field projections
+	for _, err := range pkgbackup.ValidateFieldProjections(request.Spec.FieldProjections) {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid field projections: %v", err))
+	}
+
+	// validate the deletion hooks
+	for _, err := range validateDeletionHooks(request.Spec.DeletionHooks) {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid deletion hooks: %v", err))
+	}
+
 	resourcePolicies, err := resourcepolicies.GetResourcePoliciesFromBackup(*request.Backup, b.kbClient, logger)
 	if err != nil {
 		request.Status.ValidationErrors = append(request.Status.ValidationErrors, err.Error())
 	}
 	request.ResPolicies = resourcePolicies
 
+	if request.Spec.ResourceModifier != nil {
+		resourceModifiers, err := getBackupResourceModifiers(request.Backup, b.kbClient)
+		if err != nil {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors, err.Error())
+		}
+		request.ResourceModifiers = resourceModifiers
+	}
+
 	return request
 }
 
+// getBackupResourceModifiers returns the resource modifiers of the ConfigMap the backup refers to.
+func getBackupResourceModifiers(backup *velerov1api.Backup, client kbclient.Client) (*resourcemodifiers.ResourceModifiers, error) {
+	if !strings.EqualFold(backup.Spec.ResourceModifier.Kind, resourcemodifiers.ConfigmapRefType) {
+		return nil, errors.Errorf("unsupported resource modifier kind %s, only %s is supported", backup.Spec.ResourceModifier.Kind, resourcemodifiers.ConfigmapRefType)
+	}
+	configMap := &corev1api.ConfigMap{}
+	if err := client.Get(context.Background(), kbclient.ObjectKey{Namespace: backup.Namespace, Name: backup.Spec.ResourceModifier.Name}, configMap); err != nil {
+		return nil, errors.Wrapf(err, "failed to get resource modifiers configmap %s/%s", backup.Namespace, backup.Spec.ResourceModifier.Name)
+	}
+	resourceModifiers, err := resourcemodifiers.GetResourceModifiersFromConfig(configMap)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing resource modifiers provided in configmap %s/%s", backup.Namespace, backup.Spec.ResourceModifier.Name)
+	}
+	if err := resourceModifiers.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "validation error in resource modifiers provided in configmap %s/%s", backup.Namespace, backup.Spec.ResourceModifier.Name)
+	}
+	return resourceModifiers, nil
+}
+
 // validateAndGetSnapshotLocations gets a collection of VolumeSnapshotLocation objects that
 // this backup will use (returned as a map of provider name -> VSL), and ensures:
 //   - each location name in .spec.volumeSnapshotLocations exists as a location
@@ -643,7 +801,7 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 		return err
 	}
 	backupLog.Info("Setting up backup store to check for backup existence")
-	backupStore, err := b.backupStoreGetter.Get(backup.StorageLocation, pluginManager, backupLog)
+	backupStore, err := b.backupStoreGetter.Get(persistence.LocationWithObjectTags(backup.StorageLocation, pkgbackup.Tags(backup.Backup)), pluginManager, backupLog)
 	if err != nil {
 		return err
 	}
@@ -661,8 +819,25 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 	backupItemActionsResolver := framework.NewBackupItemActionResolverV2(actions)
 	itemBlockActionResolver := framework.NewItemBlockActionResolver(ibActions)
 
+	if b.backupCheckpointInterval > 0 {
+		backup.Checkpointer = pkgbackup.NewCheckpointer(backupStore, backup.Name, b.backupCheckpointInterval, backupLog)
+		defer backup.Checkpointer.Delete()
+	}
+
+	backupper := b.backupper
+	csiClient, globalCSIClient := b.kbClient, b.globalCRClient
+	if backup.Spec.TargetCluster != "" {
+		cluster, err := getTargetCluster(b.ctx, b.targetClusters, backup.Spec.TargetCluster)
+		if err != nil {
+			return err
+		}
+		backupper = cluster.Backupper
+		// the CSI snapshots of the backup are taken in the target cluster
+		csiClient, globalCSIClient = cluster.CRClient, cluster.CRClient
+	}
+
 	var fatalErrs []error
-	if err := b.backupper.BackupWithResolvers(backupLog, backup, backupFile, backupItemActionsResolver, itemBlockActionResolver, pluginManager); err != nil {
+	if err := backupper.BackupWithResolvers(backupLog, backup, backupFile, backupItemActionsResolver, itemBlockActionResolver, pluginManager); err != nil {
 		fatalErrs = append(fatalErrs, err)
 	}
 
@@ -674,7 +849,7 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 			backup.Status.VolumeSnapshotsCompleted++
 		}
 	}
-	volumeSnapshots, volumeSnapshotContents, volumeSnapshotClasses := pkgbackup.GetBackupCSIResources(b.kbClient, b.globalCRClient, backup.Backup, backupLog)
+	volumeSnapshots, volumeSnapshotContents, volumeSnapshotClasses := pkgbackup.GetBackupCSIResources(csiClient, globalCSIClient, backup.Backup, backupLog)
 	// Update CSIVolumeSnapshotsAttempted
 	backup.Status.CSIVolumeSnapshotsAttempted = len(volumeSnapshots)
 
@@ -703,6 +878,10 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 		"errors":   backupErrors,
 	}
 
+	if boolptr.IsSetToTrue(backup.Spec.NamespacePhased) {
+		backup.Status.NamespaceResults = pkgbackup.NamespaceResults(backup.BackedUpItems, backupErrors, backupWarnings)
+	}
+
 	backupLog.DoneForPersist(b.logger.WithField(constant.ControllerBackup, kubeutil.NamespaceAndName(backup)))
 
 	// Assign finalize phase as close to end as possible so that any errors
@@ -737,7 +916,7 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 	// re-instantiate the backup store because credentials could have changed since the original
 	// instantiation, if this was a long-running backup
 	backupLog.Info("Setting up backup store to persist the backup")
-	backupStore, err = b.backupStoreGetter.Get(backup.StorageLocation, pluginManager, backupLog)
+	backupStore, err = b.backupStoreGetter.Get(persistence.LocationWithObjectTags(backup.StorageLocation, pkgbackup.Tags(backup.Backup)), pluginManager, backupLog)
 	if err != nil {
 		return err
 	}
@@ -745,6 +924,13 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 	if logFile, err := backupLog.GetPersistFile(); err != nil {
 		fatalErrs = append(fatalErrs, errors.Wrap(err, "error getting backup log file"))
 	} else {
+		// write the backup to the mirror storage location first, so that the backup in the
+		// storage location records whether it was mirrored
+		if backup.MirrorStorageLocation != nil {
+			if err := b.mirrorBackup(backup, backupFile, logFile, pluginManager, volumeSnapshots, volumeSnapshotContents, volumeSnapshotClasses, results, backupLog); err != nil {
+				fatalErrs = append(fatalErrs, err)
+			}
+		}
 		if errs := persistBackup(backup, backupFile, logFile, backupStore, volumeSnapshots, volumeSnapshotContents, volumeSnapshotClasses, results, b.globalCRClient, backupLog); len(errs) > 0 {
 			fatalErrs = append(fatalErrs, errs...)
 		}
@@ -757,6 +943,51 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 	return kerrors.NewAggregate(fatalErrs)
 }
 
+// mirrorBackup writes the backup to its mirror storage location. If it fails, the backup is
+// failed or gets a warning according to its mirror failure policy, a non-nil error being
+// returned in the former case.
+func (b *backupReconciler) mirrorBackup(backup *pkgbackup.Request,
+	backupFile, logFile *os.File,
+	pluginManager clientmgmt.Manager,
+	csiVolumeSnapshots []snapshotv1api.VolumeSnapshot,
+	csiVolumeSnapshotContents []snapshotv1api.VolumeSnapshotContent,
+	csiVolumeSnapshotClasses []snapshotv1api.VolumeSnapshotClass,
+	results map[string]results.Result,
+	logger logrus.FieldLogger,
+) error {
+	log := logger.WithField("mirrorStorageLocation", backup.MirrorStorageLocation.Name)
+	log.Info("Setting up backup store to mirror the backup")
+
+	backup.Status.MirrorPhase = velerov1api.MirrorPhaseCompleted
+	backup.Status.MirrorFailureReason = ""
+
+	var errs []error
+	if mirrorStore, err := b.backupStoreGetter.Get(persistence.LocationWithObjectTags(backup.MirrorStorageLocation, pkgbackup.Tags(backup.Backup)), pluginManager, logger); err != nil {
+		errs = append(errs, err)
+	} else {
+		errs = persistBackup(backup, backupFile, logFile, mirrorStore, csiVolumeSnapshots, csiVolumeSnapshotContents, csiVolumeSnapshotClasses, results, b.globalCRClient, logger)
+	}
+	if len(errs) == 0 {
+		log.Info("Backup mirrored")
+		return nil
+	}
+
+	err := errors.Wrapf(kerrors.NewAggregate(errs), "error writing backup to mirror backup storage location %s", backup.MirrorStorageLocation.Name)
+	backup.Status.MirrorPhase = velerov1api.MirrorPhaseFailed
+	backup.Status.MirrorFailureReason = err.Error()
+
+	if backup.Spec.MirrorFailurePolicy == velerov1api.MirrorFailurePolicyWarn {
+		log.WithError(err).Warn("Backup is kept in its backup storage location only")
+		backup.Status.Warnings++
+		return nil
+	}
+
+	backup.Status.Phase = velerov1api.BackupPhaseFailed
+	backup.Status.FailureReason = err.Error()
+	backup.Status.CompletionTimestamp = &metav1.Time{Time: b.clock.Now()}
+	return err
+}
+
 func recordBackupMetrics(log logrus.FieldLogger, backup *velerov1api.Backup, backupFile *os.File, serverMetrics *metrics.ServerMetrics, finalize bool) {
 	backupScheduleName := backup.GetLabels()[velerov1api.ScheduleNameLabel]
 
@@ -790,6 +1021,11 @@ func recordBackupMetrics(log logrus.FieldLogger, backup *velerov1api.Backup, bac
 		}
 		serverMetrics.RegisterBackupItemsErrorsGauge(backupScheduleName, backup.Status.Errors)
 
+		serverMetrics.ResetVolumePolicyHits(backupScheduleName)
+		for _, hit := range backup.Status.VolumePolicyHits {
+			serverMetrics.RegisterVolumePolicyHit(backupScheduleName, hit.Policy, hit.Action, hit.Matched, hit.Applied)
+		}
+
 		if backup.Status.Warnings > 0 {
 			serverMetrics.RegisterBackupWarning(backupScheduleName)
 		}
@@ -799,6 +1035,28 @@ func recordBackupMetrics(log logrus.FieldLogger, backup *velerov1api.Backup, bac
 	}
 }
 
+// pushBackupOutcome pushes the outcome of a backup in a terminal phase to the metrics
+// remote-write endpoint, if one is configured.
+func pushBackupOutcome(backup *velerov1api.Backup, serverMetrics *metrics.ServerMetrics, now time.Time) {
+	outcome := metrics.BackupOutcome{
+		Schedule:   backup.GetLabels()[velerov1api.ScheduleNameLabel],
+		Phase:      string(backup.Status.Phase),
+		Completion: now,
+		Errors:     backup.Status.Errors,
+		Warnings:   backup.Status.Warnings,
+	}
+	if backup.Status.StartTimestamp != nil {
+		outcome.Start = backup.Status.StartTimestamp.Time
+	}
+	if backup.Status.CompletionTimestamp != nil {
+		outcome.Completion = backup.Status.CompletionTimestamp.Time
+	}
+	if backup.Status.Progress != nil {
+		outcome.Items = backup.Status.Progress.ItemsBackedUp
+	}
+	serverMetrics.PushBackupOutcome(outcome)
+}
+
 func persistBackup(backup *pkgbackup.Request,
 	backupContents, backupLog *os.File,
 	backupStore persistence.BackupStore,
@@ -810,11 +1068,6 @@ func persistBackup(backup *pkgbackup.Request,
 	logger logrus.FieldLogger,
 ) []error {
 	persistErrs := []error{}
-	backupJSON := new(bytes.Buffer)
-
-	if err := encode.To(backup.Backup, "json", backupJSON); err != nil {
-		persistErrs = append(persistErrs, errors.Wrap(err, "error encoding backup"))
-	}
 
 	// Velero-native volume snapshots (as opposed to CSI ones)
 	nativeVolumeSnapshots, errs := encode.ToJSONGzip(backup.VolumeSnapshots, "native volumesnapshots list")
@@ -857,6 +1110,11 @@ func persistBackup(backup *pkgbackup.Request,
 		persistErrs = append(persistErrs, errs...)
 	}
 
+	skippedItems, errs := encode.ToJSONGzip(backup.SkippedItems.Items(), "backup skipped items list")
+	if errs != nil {
+		persistErrs = append(persistErrs, errs...)
+	}
+
 	backup.FillVolumesInformation()
 
 	volumeInfoJSON, errs := encode.ToJSONGzip(backup.VolumesInformation.Result(
@@ -870,23 +1128,8 @@ func persistBackup(backup *pkgbackup.Request,
 		persistErrs = append(persistErrs, errs...)
 	}
 
-	if len(persistErrs) > 0 {
-		// Don't upload the JSON files or backup tarball if encoding to json fails.
-		backupJSON = nil
-		backupContents = nil
-		nativeVolumeSnapshots = nil
-		backupItemOperations = nil
-		backupResourceList = nil
-		csiSnapshotJSON = nil
-		csiSnapshotContentsJSON = nil
-		csiSnapshotClassesJSON = nil
-		backupResult = nil
-		volumeInfoJSON = nil
-	}
-
 	backupInfo := persistence.BackupInfo{
 		Name:                      backup.Name,
-		Metadata:                  backupJSON,
 		Contents:                  backupContents,
 		Log:                       backupLog,
 		BackupResults:             backupResult,
@@ -898,7 +1141,39 @@ func persistBackup(backup *pkgbackup.Request,
 		CSIVolumeSnapshotContents: csiSnapshotContentsJSON,
 		CSIVolumeSnapshotClasses:  csiSnapshotClassesJSON,
 		BackupVolumeInfo:          volumeInfoJSON,
+		SkippedItems:              skippedItems,
 	}
+
+	// the digests of the other files are recorded in the metadata, so it's encoded last
+	if len(persistErrs) == 0 {
+		digests, err := persistence.BackupArtifactDigests(backupInfo)
+		if err != nil {
+			persistErrs = append(persistErrs, err)
+		}
+		backup.Status.ArtifactDigests = digests
+	}
+
+	backupJSON := new(bytes.Buffer)
+	if err := encode.To(backup.Backup, "json", backupJSON); err != nil {
+		persistErrs = append(persistErrs, errors.Wrap(err, "error encoding backup"))
+	}
+
+	if len(persistErrs) > 0 {
+		// Don't upload the JSON files or backup tarball if encoding to json fails.
+		backupJSON = nil
+		backupInfo.Contents = nil
+		backupInfo.VolumeSnapshots = nil
+		backupInfo.BackupItemOperations = nil
+		backupInfo.BackupResourceList = nil
+		backupInfo.CSIVolumeSnapshots = nil
+		backupInfo.CSIVolumeSnapshotContents = nil
+		backupInfo.CSIVolumeSnapshotClasses = nil
+		backupInfo.BackupResults = nil
+		backupInfo.BackupVolumeInfo = nil
+		backupInfo.SkippedItems = nil
+	}
+	backupInfo.Metadata = backupJSON
+
 	if err := backupStore.PutBackup(backupInfo); err != nil {
 		persistErrs = append(persistErrs, err)
 	}
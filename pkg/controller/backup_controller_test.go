@@ -34,6 +34,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -44,6 +46,7 @@ import (
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 	fakeClient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/builder"
@@ -58,6 +61,7 @@ import (
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
 	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v2"
 	ibav1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/itemblockaction/v1"
+	"github.com/vmware-tanzu/velero/pkg/targetcluster"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
@@ -168,7 +172,13 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 			name:           "invalid included/excluded resources fails validation",
 			backup:         defaultBackup().IncludedResources("foo").ExcludedResources("foo").Result(),
 			backupLocation: defaultBackupLocation,
-			expectedErrs:   []string{"Invalid included/excluded resource lists: excludes list cannot contain an item in the includes list: foo"},
+			expectedErrs:   []string{"Invalid included/excluded resource lists: excludes list cannot contain an item in the includes list: foo", `Invalid included resources: unknown resource "foo"`},
+		},
+		{
+			name:           "unknown included resources fail validation",
+			backup:         defaultBackup().IncludedNamespaceScopedResources("deploymnets", "po", "pod*").ExcludedNamespaceScopedResources("widgets").Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{`Invalid included resources: unknown resource "deploymnets", did you mean "deployments"?`},
 		},
 		{
 			name:           "invalid included/excluded namespaces fails validation",
@@ -210,6 +220,7 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 			)
 
 			apiServer := velerotest.NewAPIServer(t)
+			apiServer.DiscoveryClient.WithAPIResource(velerotest.Pods()).WithAPIResource(velerotest.Deployments())
 			discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
 			require.NoError(t, err)
 
@@ -359,6 +370,24 @@ func Test_prepareBackupRequest_BackupStorageLocation(t *testing.T) {
 			expectedSuccess:                  false,
 			expectedValidationError:          fmt.Sprintf("an existing backup storage location was not specified at backup creation time and the server default %s does not exist. Please address this issue (see `velero backup-location -h` for options) and create a new backup. Error: backupstoragelocations.velero.io \"%s\" not found", defaultBackupLocation, defaultBackupLocation),
 		},
+		{
+			name:                             "MirrorStorageLocation can't be found in ApiServer",
+			backup:                           builder.ForBackup("velero", "backup-1").MirrorStorageLocation("mirror-location", "").Result(),
+			backupLocationNameInBackup:       "test-backup-location",
+			backupLocationInAPIServer:        builder.ForBackupStorageLocation("velero", "test-backup-location").Result(),
+			defaultBackupLocationInAPIServer: builder.ForBackupStorageLocation("velero", "default-location").Result(),
+			expectedSuccess:                  false,
+			expectedValidationError:          "error getting mirror backup storage location mirror-location: backupstoragelocations.velero.io \"mirror-location\" not found",
+		},
+		{
+			name:                             "MirrorStorageLocation is the BackupLocation",
+			backup:                           builder.ForBackup("velero", "backup-1").MirrorStorageLocation("test-backup-location", "").Result(),
+			backupLocationNameInBackup:       "test-backup-location",
+			backupLocationInAPIServer:        builder.ForBackupStorageLocation("velero", "test-backup-location").Result(),
+			defaultBackupLocationInAPIServer: builder.ForBackupStorageLocation("velero", "default-location").Result(),
+			expectedSuccess:                  false,
+			expectedValidationError:          "mirror backup storage location must be different from the backup storage location",
+		},
 	}
 
 	for _, test := range tests {
@@ -429,6 +458,7 @@ func TestDefaultBackupTTL(t *testing.T) {
 		backupLocation     *velerov1api.BackupStorageLocation
 		expectedTTL        metav1.Duration
 		expectedExpiration metav1.Time
+		expectedImmutable  bool
 	}{
 		{
 			name:               "backup with no TTL specified",
@@ -442,6 +472,14 @@ func TestDefaultBackupTTL(t *testing.T) {
 			expectedTTL:        metav1.Duration{Duration: 1 * time.Hour},
 			expectedExpiration: metav1.NewTime(now.Add(1 * time.Hour)),
 		},
+		{
+			name:               "backup to a location with object locks is immutable until it expires",
+			backup:             defaultBackup().TTL(time.Hour).StorageLocation("default").Result(),
+			backupLocation:     builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").ObjectLock(velerov1api.ObjectLockModeCompliance).Result(),
+			expectedTTL:        metav1.Duration{Duration: 1 * time.Hour},
+			expectedExpiration: metav1.NewTime(now.Add(1 * time.Hour)),
+			expectedImmutable:  true,
+		},
 	}
 
 	for _, test := range tests {
@@ -476,6 +514,206 @@ func TestDefaultBackupTTL(t *testing.T) {
 			assert.NotNil(t, res)
 			assert.Equal(t, test.expectedTTL, res.Spec.TTL)
 			assert.Equal(t, test.expectedExpiration, *res.Status.Expiration)
+			if test.expectedImmutable {
+				assert.Equal(t, test.expectedExpiration, *res.Status.ImmutableUntil)
+			} else {
+				assert.Nil(t, res.Status.ImmutableUntil)
+			}
+		})
+	}
+}
+
+func TestAgentlessSnapshotMoveData(t *testing.T) {
+	tests := []struct {
+		name                    string
+		backup                  *velerov1api.Backup
+		agentless               bool
+		defaultSnapshotMoveData bool
+		expected                bool
+	}{
+		{
+			name:     "snapshot data is moved with the node-agent",
+			backup:   defaultBackup().SnapshotMoveData(true).Result(),
+			expected: true,
+		},
+		{
+			name:      "snapshot data isn't moved when agentless",
+			backup:    defaultBackup().SnapshotMoveData(true).Result(),
+			agentless: true,
+		},
+		{
+			name:                    "snapshot data isn't moved by default when agentless",
+			backup:                  defaultBackup().Result(),
+			agentless:               true,
+			defaultSnapshotMoveData: true,
+		},
+	}
+
+	for _, test := range tests {
+		formatFlag := logging.FormatText
+		logger := logging.DefaultLogger(logrus.DebugLevel, formatFlag)
+
+		t.Run(test.name, func(t *testing.T) {
+			apiServer := velerotest.NewAPIServer(t)
+			discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
+			require.NoError(t, err)
+			c := &backupReconciler{
+				logger:                  logger,
+				discoveryHelper:         discoveryHelper,
+				kbClient:                velerotest.NewFakeControllerRuntimeClient(t),
+				clock:                   &clock.RealClock{},
+				formatFlag:              formatFlag,
+				defaultSnapshotMoveData: test.defaultSnapshotMoveData,
+				agentless:               test.agentless,
+				workerPool:              pkgbackup.StartItemBlockWorkerPool(context.Background(), 1, logger),
+			}
+			defer c.workerPool.Stop()
+
+			res := c.prepareBackupRequest(test.backup, logger)
+			require.NotNil(t, res)
+			assert.Equal(t, test.expected, boolptr.IsSetToTrue(res.Spec.SnapshotMoveData))
+		})
+	}
+}
+
+func TestDefaultResourcePolicies(t *testing.T) {
+	tests := []struct {
+		name                    string
+		backup                  *velerov1api.Backup
+		defaultResourcePolicies string
+		expected                *corev1api.TypedLocalObjectReference
+	}{
+		{
+			name:   "no resource policies without a default",
+			backup: defaultBackup().Result(),
+		},
+		{
+			name:                    "the default resource policies apply to the backups without any",
+			backup:                  defaultBackup().Result(),
+			defaultResourcePolicies: "default-policies",
+			expected:                &corev1api.TypedLocalObjectReference{Kind: resourcepolicies.ConfigmapRefType, Name: "default-policies"},
+		},
+		{
+			name:                    "the resource policies of the backup take precedence over the default",
+			backup:                  defaultBackup().ResourcePolicies("policies").Result(),
+			defaultResourcePolicies: "default-policies",
+			expected:                &corev1api.TypedLocalObjectReference{Kind: resourcepolicies.ConfigmapRefType, Name: "policies"},
+		},
+	}
+
+	for _, test := range tests {
+		formatFlag := logging.FormatText
+		logger := logging.DefaultLogger(logrus.DebugLevel, formatFlag)
+
+		t.Run(test.name, func(t *testing.T) {
+			apiServer := velerotest.NewAPIServer(t)
+			discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
+			require.NoError(t, err)
+			c := &backupReconciler{
+				logger:                  logger,
+				discoveryHelper:         discoveryHelper,
+				kbClient:                velerotest.NewFakeControllerRuntimeClient(t),
+				clock:                   &clock.RealClock{},
+				formatFlag:              formatFlag,
+				defaultResourcePolicies: test.defaultResourcePolicies,
+				workerPool:              pkgbackup.StartItemBlockWorkerPool(context.Background(), 1, logger),
+			}
+			defer c.workerPool.Stop()
+
+			res := c.prepareBackupRequest(test.backup, logger)
+			require.NotNil(t, res)
+			assert.Equal(t, test.expected, res.Spec.ResourcePolicy)
+		})
+	}
+}
+
+type fakeTargetClusters map[string]*targetcluster.Cluster
+
+func (f fakeTargetClusters) Get(_ context.Context, name string) (*targetcluster.Cluster, error) {
+	if cluster, ok := f[name]; ok {
+		return cluster, nil
+	}
+	return nil, errors.Errorf("target cluster %s not found", name)
+}
+
+func TestPrepareBackupRequestTargetCluster(t *testing.T) {
+	tests := []struct {
+		name                  string
+		targetClusters        targetcluster.Getter
+		fsBackup              *bool
+		expectedValidationErr string
+		expectedLabel         string
+		expectedGitVersion    string
+	}{
+		{
+			name:                  "target cluster is rejected when the server doesn't run in the hub mode",
+			expectedValidationErr: "error getting target cluster: target cluster cluster-1 can't be used as the server doesn't run in the hub mode",
+		},
+		{
+			name:                  "unknown target cluster is rejected",
+			targetClusters:        fakeTargetClusters{},
+			expectedValidationErr: "error getting target cluster: target cluster cluster-1 not found",
+		},
+		{
+			name: "target cluster is labeled and its snapshot data isn't moved",
+			targetClusters: fakeTargetClusters{"cluster-1": &targetcluster.Cluster{
+				Name:            "cluster-1",
+				CRClient:        velerotest.NewFakeControllerRuntimeClient(t),
+				DiscoveryHelper: &velerotest.FakeDiscoveryHelper{ServerVersionData: &version.Info{Major: "1", Minor: "31", GitVersion: "v1.31.0"}},
+			}},
+			expectedLabel:      "cluster-1",
+			expectedGitVersion: "v1.31.0",
+		},
+		{
+			name:     "file system backup of a target cluster is rejected",
+			fsBackup: boolptr.True(),
+			targetClusters: fakeTargetClusters{"cluster-1": &targetcluster.Cluster{
+				Name:            "cluster-1",
+				CRClient:        velerotest.NewFakeControllerRuntimeClient(t),
+				DiscoveryHelper: &velerotest.FakeDiscoveryHelper{ServerVersionData: &version.Info{Major: "1", Minor: "31", GitVersion: "v1.31.0"}},
+			}},
+			expectedValidationErr: "file system backups of the pod volumes aren't supported for target clusters",
+		},
+	}
+
+	for _, test := range tests {
+		formatFlag := logging.FormatText
+		logger := logging.DefaultLogger(logrus.DebugLevel, formatFlag)
+
+		t.Run(test.name, func(t *testing.T) {
+			apiServer := velerotest.NewAPIServer(t)
+			discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
+			require.NoError(t, err)
+			c := &backupReconciler{
+				logger:          logger,
+				discoveryHelper: discoveryHelper,
+				kbClient:        velerotest.NewFakeControllerRuntimeClient(t),
+				clock:           &clock.RealClock{},
+				formatFlag:      formatFlag,
+				targetClusters:  test.targetClusters,
+				workerPool:      pkgbackup.StartItemBlockWorkerPool(context.Background(), 1, logger),
+
+				defaultVolumesToFsBackup: true,
+			}
+			defer c.workerPool.Stop()
+
+			backup := defaultBackup().TargetCluster("cluster-1").SnapshotMoveData(true).Result()
+			backup.Spec.DefaultVolumesToFsBackup = test.fsBackup
+			res := c.prepareBackupRequest(backup, logger)
+			require.NotNil(t, res)
+			if test.expectedValidationErr != "" {
+				assert.Contains(t, res.Status.ValidationErrors, test.expectedValidationErr)
+				return
+			}
+			for _, validationErr := range res.Status.ValidationErrors {
+				assert.NotContains(t, validationErr, "target cluster")
+			}
+			assert.Equal(t, test.expectedLabel, res.Labels[velerov1api.TargetClusterLabel])
+			assert.Equal(t, test.expectedGitVersion, res.Annotations[velerov1api.SourceClusterK8sGitVersionAnnotation])
+			assert.False(t, boolptr.IsSetToTrue(res.Spec.SnapshotMoveData))
+			assert.False(t, boolptr.IsSetToTrue(res.Spec.DefaultVolumesToFsBackup))
+			require.NotNil(t, res.Status.TargetCluster)
+			assert.Equal(t, velerov1api.TargetClusterStatus{Name: "cluster-1", ServerVersion: "v1.31.0"}, *res.Status.TargetCluster)
 		})
 	}
 }
@@ -1407,6 +1645,21 @@ func TestProcessBackupCompletions(t *testing.T) {
 			err = c.kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: test.backup.Namespace, Name: test.backup.Name}, res)
 			require.NoError(t, err)
 			res.ResourceVersion = ""
+			// the conditions mirror the phase, verify them separately from the rest of the backup
+			assert.Positive(t, res.Status.ObservedGeneration)
+			res.Status.ObservedGeneration = 0
+			assert.Equal(t, res.Status.Phase == velerov1api.BackupPhaseCompleted, meta.IsStatusConditionTrue(res.Status.Conditions, velerov1api.ConditionTypeReady))
+			assert.Equal(t, res.Status.Phase != velerov1api.BackupPhaseFailed && res.Status.Phase != velerov1api.BackupPhaseFailedValidation,
+				meta.IsStatusConditionTrue(res.Status.Conditions, velerov1api.ConditionTypeProgressing))
+			res.Status.Conditions = nil
+			// the digests of the files uploaded depend on their contents, verify them separately as well
+			if test.expectedResult.Status.Phase == velerov1api.BackupPhaseFailed {
+				assert.Empty(t, res.Status.ArtifactDigests)
+			} else {
+				assert.Len(t, res.Status.ArtifactDigests[test.backup.Name+".tar.gz"], 64)
+				assert.Len(t, res.Status.ArtifactDigests[test.backup.Name+"-logs.gz"], 64)
+			}
+			res.Status.ArtifactDigests = nil
 			assert.Equal(t, test.expectedResult, res)
 			// reset defaultBackupLocation resourceVersion
 			defaultBackupLocation.ObjectMeta.ResourceVersion = ""
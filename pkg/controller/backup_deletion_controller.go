@@ -33,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -51,6 +52,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	vsv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/volumesnapshotter/v1"
+	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/podvolume"
 	"github.com/vmware-tanzu/velero/pkg/repository"
 	repomanager "github.com/vmware-tanzu/velero/pkg/repository/manager"
@@ -76,6 +78,10 @@ type backupDeletionReconciler struct {
 	backupStoreGetter persistence.ObjectBackupStoreGetter
 	credentialStore   credentials.FileStore
 	repoEnsurer       *repository.Ensurer
+	snapshotThrottler *volume.SnapshotThrottler
+
+	kubeClient         kubernetes.Interface
+	podCommandExecutor podexec.PodCommandExecutor
 }
 
 // NewBackupDeletionReconciler creates a new backup deletion reconciler.
@@ -90,19 +96,25 @@ func NewBackupDeletionReconciler(
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
 	credentialStore credentials.FileStore,
 	repoEnsurer *repository.Ensurer,
+	snapshotThrottler *volume.SnapshotThrottler,
+	kubeClient kubernetes.Interface,
+	podCommandExecutor podexec.PodCommandExecutor,
 ) *backupDeletionReconciler {
 	return &backupDeletionReconciler{
-		Client:            client,
-		logger:            logger,
-		backupTracker:     backupTracker,
-		repoMgr:           repoMgr,
-		metrics:           metrics,
-		clock:             clock.RealClock{},
-		discoveryHelper:   helper,
-		newPluginManager:  newPluginManager,
-		backupStoreGetter: backupStoreGetter,
-		credentialStore:   credentialStore,
-		repoEnsurer:       repoEnsurer,
+		Client:             client,
+		logger:             logger,
+		backupTracker:      backupTracker,
+		repoMgr:            repoMgr,
+		metrics:            metrics,
+		clock:              clock.RealClock{},
+		discoveryHelper:    helper,
+		newPluginManager:   newPluginManager,
+		backupStoreGetter:  backupStoreGetter,
+		credentialStore:    credentialStore,
+		repoEnsurer:        repoEnsurer,
+		snapshotThrottler:  snapshotThrottler,
+		kubeClient:         kubeClient,
+		podCommandExecutor: podCommandExecutor,
 	}
 }
 
@@ -202,6 +214,12 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, err
 	}
 
+	// The objects of the backup are locked in the location, deleting them would fail
+	if persistence.IsImmutable(backup, r.clock.Now()) {
+		err := r.patchDeleteBackupRequestWithError(ctx, dbr, fmt.Errorf("cannot delete backup because it's immutable until %s in backup storage location %s", backup.Status.ImmutableUntil.UTC().Format(time.RFC3339), location.Name))
+		return ctrl.Result{}, err
+	}
+
 	// if the request object has no labels defined, initialize an empty map since
 	// we will be updating labels
 	if dbr.Labels == nil {
@@ -223,6 +241,21 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, err
 	}
 
+	var hookResults []velerov1api.DeletionHookResult
+	if backup.Spec.DeletionHooks != nil && len(backup.Spec.DeletionHooks.PreHooks) > 0 {
+		log.Info("Executing pre-deletion hooks")
+		hookResults, err = r.runDeletionHooks(ctx, backup.Spec.DeletionHooks.PreHooks, velerov1api.DeletionHookPhasePre, log)
+		if err != nil {
+			// Abort the deletion, the backup is kept as it was
+			_, err2 := r.patchDeleteBackupRequest(ctx, dbr, func(r *velerov1api.DeleteBackupRequest) {
+				r.Status.Phase = velerov1api.DeleteBackupRequestPhaseProcessed
+				r.Status.Errors = []string{err.Error()}
+				r.Status.HookResults = hookResults
+			})
+			return ctrl.Result{}, err2
+		}
+	}
+
 	// Set backup status to Deleting
 	backup, err = r.patchBackup(ctx, backup, func(b *velerov1api.Backup) {
 		b.Status.Phase = velerov1api.BackupPhaseDeleting
@@ -342,6 +375,14 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		} else {
 			for i := range duList.Items {
 				du := duList.Items[i]
+				// the CSI snapshot retained as the base of the changed block tracking backups
+				// of the volume is deleted along with its DataUpload
+				if _, retained := du.Annotations[velerov1api.VolumeSnapshotHandleAnnotation]; retained {
+					if err := csi.ReleaseRetainedVolumeSnapshotContent(ctx, r.Client, du.Name); err != nil {
+						errs = append(errs, errors.Wrapf(err, "error deleting the CSI snapshot retained by DataUpload %s", du.Name).Error())
+						continue
+					}
+				}
 				if err := r.Delete(ctx, &du); err != nil {
 					errs = append(errs, err.Error())
 				}
@@ -350,8 +391,27 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	}
 
 	if backupStore != nil {
-		log.Info("Removing backup from backup storage")
-		if err := backupStore.DeleteBackup(backup.Name); err != nil {
+		retained := true
+		if expiration, ok := backupLogsExpiration(location, backup); ok && expiration.After(r.clock.Now()) {
+			log.Infof("Keeping backup logs in backup storage until %s", expiration.UTC().Format(time.RFC3339))
+			if err := backupStore.RetainBackupLogs(backup.Name, expiration); err != nil {
+				// the backup is kept rather than losing its logs
+				errs = append(errs, errors.Wrap(err, "error keeping backup logs").Error())
+				retained = false
+			}
+		}
+
+		if retained {
+			log.Info("Removing backup from backup storage")
+			if err := backupStore.DeleteBackup(backup.Name); err != nil {
+				errs = append(errs, err.Error())
+			}
+		}
+	}
+
+	if backup.Spec.MirrorStorageLocation != "" {
+		log.Infof("Removing backup from mirror backup storage location %s", backup.Spec.MirrorStorageLocation)
+		if err := r.deleteMirroredBackup(ctx, backup, pluginManager, log); err != nil {
 			errs = append(errs, err.Error())
 		}
 	}
@@ -413,6 +473,13 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}
 
+	if len(errs) == 0 && backup.Spec.DeletionHooks != nil && len(backup.Spec.DeletionHooks.PostHooks) > 0 {
+		log.Info("Executing post-deletion hooks")
+		// The backup is already deleted, so the failures of the post-deletion hooks are only recorded in their results
+		postHookResults, _ := r.runDeletionHooks(ctx, backup.Spec.DeletionHooks.PostHooks, velerov1api.DeletionHookPhasePost, log)
+		hookResults = append(hookResults, postHookResults...)
+	}
+
 	if len(errs) == 0 {
 		r.metrics.RegisterBackupDeletionSuccess(backupScheduleName)
 	} else {
@@ -423,6 +490,7 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	if _, err := r.patchDeleteBackupRequest(ctx, dbr, func(r *velerov1api.DeleteBackupRequest) {
 		r.Status.Phase = velerov1api.DeleteBackupRequestPhaseProcessed
 		r.Status.Errors = errs
+		r.Status.HookResults = hookResults
 	}); err != nil {
 		return ctrl.Result{}, err
 	}
@@ -448,6 +516,30 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	return ctrl.Result{}, nil
 }
 
+// deleteMirroredBackup deletes the backup from its mirror storage location.
+func (r *backupDeletionReconciler) deleteMirroredBackup(ctx context.Context, backup *velerov1api.Backup, pluginManager clientmgmt.Manager, log logrus.FieldLogger) error {
+	location := &velerov1api.BackupStorageLocation{}
+	if err := r.Get(ctx, client.ObjectKey{
+		Namespace: backup.Namespace,
+		Name:      backup.Spec.MirrorStorageLocation,
+	}, location); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Warnf("Mirror backup storage location %s is not found, skipping the removal of the mirrored backup", backup.Spec.MirrorStorageLocation)
+			return nil
+		}
+		return errors.Wrapf(err, "error getting mirror backup storage location %s", backup.Spec.MirrorStorageLocation)
+	}
+	if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		return errors.Errorf("cannot delete backup because mirror backup storage location %s is currently in read-only mode", location.Name)
+	}
+
+	mirrorStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		return errors.Wrapf(err, "error getting a backup store for mirror backup storage location %s", location.Name)
+	}
+	return mirrorStore.DeleteBackup(backup.Name)
+}
+
 func (r *backupDeletionReconciler) volumeSnapshottersForVSL(
 	ctx context.Context,
 	namespace, vslName string,
@@ -476,7 +568,7 @@ func (r *backupDeletionReconciler) volumeSnapshottersForVSL(
 		return nil, errors.Wrapf(err, "error initializing volume snapshotter for volume snapshot location %s", vslName)
 	}
 
-	return volumeSnapshotter, nil
+	return r.snapshotThrottler.Wrap(ctx, vsl, volumeSnapshotter), nil
 }
 
 func (r *backupDeletionReconciler) deleteExistingDeletionRequests(ctx context.Context, req *velerov1api.DeleteBackupRequest, log logrus.FieldLogger) []error {
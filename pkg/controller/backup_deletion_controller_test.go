@@ -43,6 +43,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -102,6 +103,9 @@ func setupBackupDeletionControllerTest(t *testing.T, req *velerov1api.DeleteBack
 			NewFakeSingleObjectBackupStoreGetter(backupStore),
 			velerotest.NewFakeCredentialsFileStore("", nil),
 			nil,
+			nil,
+			nil, // kube client
+			nil, // pod command executor
 		),
 		req: ctrl.Request{NamespacedName: types.NamespacedName{Namespace: req.Namespace, Name: req.Name}},
 	}
@@ -268,6 +272,28 @@ func TestBackupDeletionControllerReconcile(t *testing.T) {
 		assert.Len(t, res.Status.Errors, 1)
 		assert.Equal(t, "cannot delete backup because backup storage location default is currently in read-only mode", res.Status.Errors[0])
 	})
+
+	t.Run("backup is immutable", func(t *testing.T) {
+		immutableUntil := time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").ImmutableUntil(immutableUntil).Result()
+		location := builder.ForBackupStorageLocation("velero", "default").ObjectLock(velerov1api.ObjectLockModeCompliance).Result()
+
+		td := setupBackupDeletionControllerTest(t, defaultTestDbr(), location, backup)
+
+		_, err := td.controller.Reconcile(context.TODO(), td.req)
+		require.NoError(t, err)
+
+		res := &velerov1api.DeleteBackupRequest{}
+		err = td.fakeClient.Get(ctx, td.req.NamespacedName, res)
+		require.NoError(t, err)
+		assert.Equal(t, "Processed", string(res.Status.Phase))
+		assert.Equal(t, []string{"cannot delete backup because it's immutable until 2100-01-01T00:00:00Z in backup storage location default"}, res.Status.Errors)
+
+		err = td.fakeClient.Get(ctx, types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "foo"}, backup)
+		require.NoError(t, err)
+		assert.NotEqual(t, velerov1api.BackupPhaseDeleting, backup.Status.Phase)
+		td.backupStore.AssertNotCalled(t, "DeleteBackup", mock.Anything)
+	})
 	t.Run("full delete, no errors", func(t *testing.T) {
 		input := defaultTestDbr()
 
@@ -576,6 +602,142 @@ func TestBackupDeletionControllerReconcile(t *testing.T) {
 		// Make sure snapshot was deleted
 		assert.Equal(t, 0, td.volumeSnapshotter.SnapshotsTaken.Len())
 	})
+	t.Run("failing pre-deletion hook aborts the deletion", func(t *testing.T) {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").Result()
+		backup.UID = "uid"
+		backup.Spec.DeletionHooks = &velerov1api.BackupDeletionHooks{
+			PreHooks: []velerov1api.BackupDeletionHook{
+				{
+					Name:        "release-locks",
+					Namespace:   "ns-1",
+					PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "locker"}},
+					Exec:        &velerov1api.ExecHook{Command: []string{"release"}},
+				},
+			},
+		}
+		location := builder.ForBackupStorageLocation("velero", "default").Result()
+
+		td := setupBackupDeletionControllerTest(t, defaultTestDbr(), backup, location)
+		podCommandExecutor := &velerotest.MockPodCommandExecutor{}
+		podCommandExecutor.On("ExecutePodCommand", mock.Anything, mock.Anything, "ns-1", "locker-1", "release-locks", mock.Anything).Return(errors.New("exec failed"))
+		td.controller.podCommandExecutor = podCommandExecutor
+		td.controller.kubeClient = kubefake.NewSimpleClientset(
+			builder.ForPod("ns-1", "locker-1").ObjectMeta(builder.WithLabels("app", "locker")).Phase(corev1api.PodRunning).Result(),
+		)
+
+		_, err := td.controller.Reconcile(context.TODO(), td.req)
+		require.NoError(t, err)
+
+		res := &velerov1api.DeleteBackupRequest{}
+		require.NoError(t, td.fakeClient.Get(ctx, td.req.NamespacedName, res))
+		assert.Equal(t, velerov1api.DeleteBackupRequestPhaseProcessed, res.Status.Phase)
+		require.Len(t, res.Status.Errors, 1)
+		assert.Contains(t, res.Status.Errors[0], "error executing Pre deletion hook release-locks")
+		assert.Equal(t, []velerov1api.DeletionHookResult{
+			{Name: "release-locks", Phase: velerov1api.DeletionHookPhasePre, Error: "exec failed"},
+		}, res.Status.HookResults)
+
+		// the backup is kept as it was
+		res2 := &velerov1api.Backup{}
+		require.NoError(t, td.fakeClient.Get(ctx, types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}, res2))
+		assert.NotEqual(t, velerov1api.BackupPhaseDeleting, res2.Status.Phase)
+		td.backupStore.AssertNotCalled(t, "DeleteBackup", mock.Anything)
+	})
+	t.Run("deletion hooks are executed before and after the backup is deleted", func(t *testing.T) {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").Result()
+		backup.UID = "uid"
+		hook := func(name string) velerov1api.BackupDeletionHook {
+			return velerov1api.BackupDeletionHook{
+				Name:        name,
+				Namespace:   "ns-1",
+				PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "cmdb"}},
+				Exec:        &velerov1api.ExecHook{Command: []string{name}, OnError: velerov1api.HookErrorModeContinue},
+			}
+		}
+		backup.Spec.DeletionHooks = &velerov1api.BackupDeletionHooks{
+			PreHooks:  []velerov1api.BackupDeletionHook{hook("pre-1"), hook("pre-2")},
+			PostHooks: []velerov1api.BackupDeletionHook{hook("post-1")},
+		}
+		location := builder.ForBackupStorageLocation("velero", "default").Result()
+
+		td := setupBackupDeletionControllerTest(t, defaultTestDbr(), backup, location)
+		podCommandExecutor := &velerotest.MockPodCommandExecutor{}
+		// a failing hook whose OnError is Continue doesn't abort the deletion
+		podCommandExecutor.On("ExecutePodCommand", mock.Anything, mock.Anything, "ns-1", "cmdb-2", "pre-1", mock.Anything).Return(errors.New("exec failed"))
+		podCommandExecutor.On("ExecutePodCommand", mock.Anything, mock.Anything, "ns-1", "cmdb-2", mock.Anything, mock.Anything).Return(nil)
+		td.controller.podCommandExecutor = podCommandExecutor
+		td.controller.kubeClient = kubefake.NewSimpleClientset(
+			builder.ForPod("ns-1", "cmdb-1").ObjectMeta(builder.WithLabels("app", "cmdb")).Phase(corev1api.PodPending).Result(),
+			builder.ForPod("ns-1", "cmdb-2").ObjectMeta(builder.WithLabels("app", "cmdb")).Phase(corev1api.PodRunning).Result(),
+			builder.ForPod("ns-1", "other").Phase(corev1api.PodRunning).Result(),
+		)
+		pluginManager := &pluginmocks.Manager{}
+		pluginManager.On("GetDeleteItemActions").Return([]velero.DeleteItemAction{}, nil)
+		pluginManager.On("CleanupClients")
+		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
+		td.backupStore.On("GetBackupVolumeSnapshots", backup.Name).Return(nil, nil)
+		td.backupStore.On("DeleteBackup", backup.Name).Return(nil)
+
+		_, err := td.controller.Reconcile(context.TODO(), td.req)
+		require.NoError(t, err)
+
+		var executed []string
+		for _, entry := range podCommandExecutor.HookExecutionLog {
+			executed = append(executed, entry.HookName)
+		}
+		assert.Equal(t, []string{"pre-1", "pre-2", "post-1"}, executed)
+		td.backupStore.AssertCalled(t, "DeleteBackup", backup.Name)
+
+		// backup CR should be deleted
+		err = td.fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}, &velerov1api.Backup{})
+		assert.True(t, apierrors.IsNotFound(err), "Expected not found error, but actual value of error: %v", err)
+	})
+	t.Run("backup logs are kept when they outlive the backup", func(t *testing.T) {
+		started := time.Now().Add(-time.Hour).Truncate(time.Second)
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").StartTimestamp(started).Result()
+		backup.UID = "uid"
+		location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").BackupLogsTTL(24 * time.Hour).Result()
+
+		td := setupBackupDeletionControllerTest(t, defaultTestDbr(), backup, location)
+		pluginManager := &pluginmocks.Manager{}
+		pluginManager.On("GetDeleteItemActions").Return([]velero.DeleteItemAction{}, nil)
+		pluginManager.On("CleanupClients")
+		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
+		td.backupStore.On("GetBackupVolumeSnapshots", backup.Name).Return(nil, nil)
+		td.backupStore.On("RetainBackupLogs", backup.Name, started.Add(24*time.Hour)).Return(nil)
+		td.backupStore.On("DeleteBackup", backup.Name).Return(nil)
+
+		_, err := td.controller.Reconcile(context.TODO(), td.req)
+		require.NoError(t, err)
+
+		td.backupStore.AssertCalled(t, "RetainBackupLogs", backup.Name, started.Add(24*time.Hour))
+		td.backupStore.AssertCalled(t, "DeleteBackup", backup.Name)
+		err = td.fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}, &velerov1api.Backup{})
+		assert.True(t, apierrors.IsNotFound(err), "Expected not found error, but actual value of error: %v", err)
+	})
+	t.Run("backup is kept when its logs can't be kept", func(t *testing.T) {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").StartTimestamp(time.Now()).Result()
+		backup.UID = "uid"
+		location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").BackupLogsTTL(24 * time.Hour).Result()
+
+		td := setupBackupDeletionControllerTest(t, defaultTestDbr(), backup, location)
+		pluginManager := &pluginmocks.Manager{}
+		pluginManager.On("GetDeleteItemActions").Return([]velero.DeleteItemAction{}, nil)
+		pluginManager.On("CleanupClients")
+		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
+		td.backupStore.On("GetBackupVolumeSnapshots", backup.Name).Return(nil, nil)
+		td.backupStore.On("RetainBackupLogs", backup.Name, mock.Anything).Return(errors.New("put failed"))
+
+		_, err := td.controller.Reconcile(context.TODO(), td.req)
+		require.NoError(t, err)
+
+		res := &velerov1api.DeleteBackupRequest{}
+		require.NoError(t, td.fakeClient.Get(ctx, td.req.NamespacedName, res))
+		require.Len(t, res.Status.Errors, 1)
+		assert.Contains(t, res.Status.Errors[0], "error keeping backup logs")
+		td.backupStore.AssertNotCalled(t, "DeleteBackup", mock.Anything)
+		require.NoError(t, td.fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}, &velerov1api.Backup{}))
+	})
 	t.Run("backup is still deleted if downloading tarball fails for DeleteItemAction plugins", func(t *testing.T) {
 		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").Result()
 		backup.UID = "uid"
@@ -1023,6 +1185,9 @@ func TestDeleteMovedSnapshots(t *testing.T) {
 				NewFakeSingleObjectBackupStoreGetter(backupStore),
 				velerotest.NewFakeCredentialsFileStore("", nil),
 				nil,
+				nil,
+				nil, // kube client
+				nil, // pod command executor
 			)
 
 			veleroBackup.Name = test.backupName
@@ -1045,3 +1210,58 @@ func TestDeleteMovedSnapshots(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDeletionHooks(t *testing.T) {
+	exec := &velerov1api.ExecHook{Command: []string{"/release-locks"}}
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "locker"}}
+
+	tests := []struct {
+		name         string
+		hooks        *velerov1api.BackupDeletionHooks
+		expectedErrs []string
+	}{
+		{
+			name: "no hooks",
+		},
+		{
+			name: "valid hooks",
+			hooks: &velerov1api.BackupDeletionHooks{
+				PreHooks:  []velerov1api.BackupDeletionHook{{Name: "pre", PodSelector: selector, Exec: exec}},
+				PostHooks: []velerov1api.BackupDeletionHook{{Name: "post", PodSelector: selector, Exec: exec}},
+			},
+		},
+		{
+			name: "hooks without a pod selector or a command",
+			hooks: &velerov1api.BackupDeletionHooks{
+				PreHooks:  []velerov1api.BackupDeletionHook{{Name: "pre", Exec: exec}},
+				PostHooks: []velerov1api.BackupDeletionHook{{Name: "post", PodSelector: &metav1.LabelSelector{}}},
+			},
+			expectedErrs: []string{
+				"Pre deletion hook pre has no pod selector",
+				"Post deletion hook post has no command",
+				"Post deletion hook post has no pod selector",
+			},
+		},
+		{
+			name: "hook with an invalid pod selector",
+			hooks: &velerov1api.BackupDeletionHooks{
+				PreHooks: []velerov1api.BackupDeletionHook{{
+					Name:        "pre",
+					PodSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Unknown"}}},
+					Exec:        exec,
+				}},
+			},
+			expectedErrs: []string{`Pre deletion hook pre has an invalid pod selector: "Unknown" is not a valid label selector operator`},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var errs []string
+			for _, err := range validateDeletionHooks(test.hooks) {
+				errs = append(errs, err.Error())
+			}
+			assert.Equal(t, test.expectedErrs, errs)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// runDeletionHooks executes the deletion hooks of the phase one after another and returns
+// their results. A failing hook whose OnError isn't Continue stops the execution of the
+// remaining hooks and its error is returned.
+func (r *backupDeletionReconciler) runDeletionHooks(ctx context.Context, hooks []velerov1api.BackupDeletionHook, phase velerov1api.DeletionHookPhase, log logrus.FieldLogger) ([]velerov1api.DeletionHookResult, error) {
+	var hookResults []velerov1api.DeletionHookResult
+	for i := range hooks {
+		hook := &hooks[i]
+		hookLog := log.WithField("hookName", hook.Name).WithField("hookPhase", phase)
+
+		err := r.runDeletionHook(ctx, hook, hookLog)
+		result := velerov1api.DeletionHookResult{
+			Name:      hook.Name,
+			Phase:     phase,
+			Succeeded: err == nil,
+		}
+		if err != nil {
+			hookLog.WithError(err).Error("Error executing deletion hook")
+			result.Error = err.Error()
+		}
+		hookResults = append(hookResults, result)
+
+		if err != nil && (hook.Exec == nil || hook.Exec.OnError != velerov1api.HookErrorModeContinue) {
+			return hookResults, errors.Wrapf(err, "error executing %s deletion hook %s", phase, hook.Name)
+		}
+	}
+	return hookResults, nil
+}
+
+// runDeletionHook executes the command of the hook in the first running pod, by name,
+// matching the pod selector of the hook.
+func (r *backupDeletionReconciler) runDeletionHook(ctx context.Context, hook *velerov1api.BackupDeletionHook, log logrus.FieldLogger) error {
+	if hook.Exec == nil {
+		return errors.New("exec is required")
+	}
+	if r.kubeClient == nil || r.podCommandExecutor == nil {
+		return errors.New("deletion hooks aren't supported by the server")
+	}
+
+	// a missing or empty selector would match every pod of the namespace
+	if isEmptyLabelSelector(hook.PodSelector) {
+		return errors.New("podSelector is required")
+	}
+	selector, err := metav1.LabelSelectorAsSelector(hook.PodSelector)
+	if err != nil {
+		return errors.Wrap(err, "error parsing the pod selector")
+	}
+	pods, err := r.kubeClient.CoreV1().Pods(hook.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return errors.Wrapf(err, "error listing pods in namespace %s", hook.Namespace)
+	}
+	sort.Slice(pods.Items, func(i, j int) bool {
+		return pods.Items[i].Name < pods.Items[j].Name
+	})
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase != corev1api.PodRunning {
+			continue
+		}
+		item, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		return r.podCommandExecutor.ExecutePodCommand(log, item, pod.Namespace, pod.Name, hook.Name, hook.Exec)
+	}
+	return errors.Errorf("no running pod in namespace %s matches the selector %s", hook.Namespace, selector)
+}
+
+// validateDeletionHooks returns the errors of the deletion hooks of a backup.
+func validateDeletionHooks(hooks *velerov1api.BackupDeletionHooks) []error {
+	if hooks == nil {
+		return nil
+	}
+
+	var errs []error
+	validate := func(phase velerov1api.DeletionHookPhase, hook *velerov1api.BackupDeletionHook) {
+		if hook.Exec == nil || len(hook.Exec.Command) == 0 {
+			errs = append(errs, errors.Errorf("%s deletion hook %s has no command", phase, hook.Name))
+		}
+		if isEmptyLabelSelector(hook.PodSelector) {
+			errs = append(errs, errors.Errorf("%s deletion hook %s has no pod selector", phase, hook.Name))
+			return
+		}
+		if _, err := metav1.LabelSelectorAsSelector(hook.PodSelector); err != nil {
+			errs = append(errs, errors.Wrapf(err, "%s deletion hook %s has an invalid pod selector", phase, hook.Name))
+		}
+	}
+	for i := range hooks.PreHooks {
+		validate(velerov1api.DeletionHookPhasePre, &hooks.PreHooks[i])
+	}
+	for i := range hooks.PostHooks {
+		validate(velerov1api.DeletionHookPhasePost, &hooks.PostHooks[i])
+	}
+	return errs
+}
+
+// isEmptyLabelSelector returns true if the selector is nil or has no requirements.
+func isEmptyLabelSelector(selector *metav1.LabelSelector) bool {
+	return selector == nil || (len(selector.MatchLabels) == 0 && len(selector.MatchExpressions) == 0)
+}
@@ -19,6 +19,8 @@ package controller
 import (
 	"bytes"
 	"context"
+	"fmt"
+	"io"
 	"os"
 	"time"
 
@@ -40,6 +42,8 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/targetcluster"
+	"github.com/vmware-tanzu/velero/pkg/util/conditions"
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
 )
 
@@ -55,6 +59,7 @@ type backupFinalizerReconciler struct {
 	backupStoreGetter persistence.ObjectBackupStoreGetter
 	log               logrus.FieldLogger
 	resourceTimeout   time.Duration
+	targetClusters    targetcluster.Getter
 }
 
 // NewBackupFinalizerReconciler initializes and returns backupFinalizerReconciler struct.
@@ -69,6 +74,7 @@ func NewBackupFinalizerReconciler(
 	log logrus.FieldLogger,
 	metrics *metrics.ServerMetrics,
 	resourceTimeout time.Duration,
+	targetClusters targetcluster.Getter,
 ) *backupFinalizerReconciler {
 	return &backupFinalizerReconciler{
 		client:            client,
@@ -80,6 +86,7 @@ func NewBackupFinalizerReconciler(
 		backupStoreGetter: backupStoreGetter,
 		log:               log,
 		metrics:           metrics,
+		targetClusters:    targetClusters,
 	}
 }
 
@@ -128,6 +135,8 @@ func (r *backupFinalizerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		// if this patch fails, there may not be another opportunity to update the backup object without external update event.
 		// so we retry
 		// This retries updating Finalzing/FinalizingPartiallyFailed to Completed/PartiallyFailed
+		conditions.SetBackupConditions(backup, r.clock.Now())
+		conditions.SetObservedGeneration(original, backup)
 		if err := client.RetryOnErrorMaxBackOff(r.resourceTimeout, func() error { return r.client.Patch(ctx, backup, kbclient.MergeFrom(original)) }); err != nil {
 			log.WithError(err).Error("Error updating backup")
 			return
@@ -144,7 +153,7 @@ func (r *backupFinalizerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	pluginManager := r.newPluginManager(log)
 	defer pluginManager.CleanupClients()
 
-	backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
+	backupStore, err := r.backupStoreGetter.Get(persistence.LocationWithObjectTags(location, pkgbackup.Tags(backup)), pluginManager, log)
 	if err != nil {
 		log.WithError(err).Error("Error getting a backup store")
 		return ctrl.Result{}, errors.WithStack(err)
@@ -164,6 +173,7 @@ func (r *backupFinalizerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		BackedUpItems:    pkgbackup.NewBackedUpItemsMap(),
 	}
 	var outBackupFile *os.File
+	finalizedStore := &finalizedBackupStore{BackupStore: backupStore}
 	if len(operations) > 0 {
 		log.Info("Setting up finalized backup temp file")
 		inBackupFile, err := downloadToTempFile(backup.Name, backupStore, log)
@@ -186,37 +196,80 @@ func (r *backupFinalizerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		}
 		backupItemActionsResolver := framework.NewBackupItemActionResolverV2(actions)
 
+		backupper := r.backupper
+		if backup.Spec.TargetCluster != "" {
+			cluster, err := getTargetCluster(ctx, r.targetClusters, backup.Spec.TargetCluster)
+			if err != nil {
+				log.WithError(err).Error("error getting target cluster")
+				return ctrl.Result{}, errors.WithStack(err)
+			}
+			backupper = cluster.Backupper
+		}
+
 		// Call itemBackupper.BackupItem for the list of items updated by async operations
-		err = r.backupper.FinalizeBackup(
+		err = backupper.FinalizeBackup(
 			log,
 			backupRequest,
 			inBackupFile,
 			outBackupFile,
 			backupItemActionsResolver,
 			operations,
-			backupStore,
+			finalizedStore,
 		)
 		if err != nil {
 			log.WithError(err).Error("error finalizing Backup")
 			return ctrl.Result{}, errors.WithStack(err)
 		}
+		if err := persistence.SetBackupArtifactDigest(backup, velerov1api.DownloadTargetKindBackupContents, outBackupFile); err != nil {
+			return ctrl.Result{}, err
+		}
 	}
 	backupScheduleName := backupRequest.GetLabels()[velerov1api.ScheduleNameLabel]
 	switch backup.Status.Phase {
 	case velerov1api.BackupPhaseFinalizing:
 		backup.Status.Phase = velerov1api.BackupPhaseCompleted
-		r.metrics.RegisterBackupSuccess(backupScheduleName)
-		r.metrics.RegisterBackupLastStatus(backupScheduleName, metrics.BackupLastStatusSucc)
 	case velerov1api.BackupPhaseFinalizingPartiallyFailed:
-		backup.Status.Phase = velerov1api.BackupPhasePartiallyFailed
-		r.metrics.RegisterBackupPartialFailure(backupScheduleName)
-		r.metrics.RegisterBackupLastStatus(backupScheduleName, metrics.BackupLastStatusFailure)
+		if pkgbackup.CancelOnMaxDuration(backup) {
+			backup.Status.Phase = velerov1api.BackupPhaseFailed
+			backup.Status.FailureReason = fmt.Sprintf("backup canceled, it exceeded its maxDuration of %s", backup.Spec.MaxDuration.Duration)
+		} else {
+			backup.Status.Phase = velerov1api.BackupPhasePartiallyFailed
+		}
 	}
 
 	backup.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
 	backup.Status.CSIVolumeSnapshotsCompleted = updateCSIVolumeSnapshotsCompleted(operations)
 
+	// the backup is complete only when its mirror is
+	if backup.Status.MirrorPhase == velerov1api.MirrorPhaseCompleted {
+		if err := r.mirrorFinalizedBackup(ctx, backup, outBackupFile, operations, finalizedStore, pluginManager, log); err != nil {
+			backup.Status.MirrorPhase = velerov1api.MirrorPhaseFailed
+			backup.Status.MirrorFailureReason = err.Error()
+			if backup.Spec.MirrorFailurePolicy == velerov1api.MirrorFailurePolicyWarn {
+				log.WithError(err).Warn("Backup is kept in its backup storage location only")
+				backup.Status.Warnings++
+			} else {
+				log.WithError(err).Error("Error mirroring backup")
+				backup.Status.Phase = velerov1api.BackupPhaseFailed
+				backup.Status.FailureReason = err.Error()
+			}
+		}
+	}
+
+	switch backup.Status.Phase {
+	case velerov1api.BackupPhaseCompleted:
+		r.metrics.RegisterBackupSuccess(backupScheduleName)
+		r.metrics.RegisterBackupLastStatus(backupScheduleName, metrics.BackupLastStatusSucc)
+	case velerov1api.BackupPhasePartiallyFailed:
+		r.metrics.RegisterBackupPartialFailure(backupScheduleName)
+		r.metrics.RegisterBackupLastStatus(backupScheduleName, metrics.BackupLastStatusFailure)
+	case velerov1api.BackupPhaseFailed:
+		r.metrics.RegisterBackupFailed(backupScheduleName)
+		r.metrics.RegisterBackupLastStatus(backupScheduleName, metrics.BackupLastStatusFailure)
+	}
+
 	recordBackupMetrics(log, backup, outBackupFile, r.metrics, true)
+	pushBackupOutcome(backup, r.metrics, r.clock.Now())
 
 	// update backup metadata in object store
 	backupJSON := new(bytes.Buffer)
@@ -233,9 +286,93 @@ func (r *backupFinalizerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			return ctrl.Result{}, errors.Wrap(err, "error uploading backup final contents")
 		}
 	}
+	// the manifest covers the files as they're finalized
+	switch err := backupStore.SignBackup(backup.Name); {
+	case err == persistence.ErrSigningNotEnabled:
+	case err != nil:
+		return ctrl.Result{}, errors.Wrap(err, "error signing backup")
+	default:
+		// a signed backup whose manifest goes missing is detected as tampered with
+		backup.Status.Signed = true
+	}
 	return ctrl.Result{}, nil
 }
 
+// mirrorFinalizedBackup writes the files of the backup rewritten since it was mirrored to its
+// mirror storage location: the item operations uploaded by the operations controller, and the
+// metadata, contents and volume information finalized.
+func (r *backupFinalizerReconciler) mirrorFinalizedBackup(ctx context.Context, backup *velerov1api.Backup, backupFile *os.File, operations []*itemoperation.BackupOperation,
+	backupStore *finalizedBackupStore, pluginManager clientmgmt.Manager, log logrus.FieldLogger) error {
+	location := &velerov1api.BackupStorageLocation{}
+	if err := r.client.Get(ctx, kbclient.ObjectKey{
+		Namespace: backup.Namespace,
+		Name:      backup.Spec.MirrorStorageLocation,
+	}, location); err != nil {
+		return errors.Wrapf(err, "error getting mirror backup storage location %s", backup.Spec.MirrorStorageLocation)
+	}
+
+	mirrorStore, err := r.backupStoreGetter.Get(persistence.LocationWithObjectTags(location, pkgbackup.Tags(backup)), pluginManager, log)
+	if err != nil {
+		return errors.Wrapf(err, "error getting a backup store for mirror backup storage location %s", location.Name)
+	}
+
+	// the item operations are uploaded again to both locations, so that their copies match the
+	// digest recorded in the metadata
+	if len(operations) > 0 {
+		itemOperations, errs := encode.ToJSONGzip(operations, "backup item operations list")
+		if errs != nil {
+			return errors.Wrap(errs[0], "error encoding item operations json")
+		}
+		if err := persistence.SetBackupArtifactDigest(backup, velerov1api.DownloadTargetKindBackupItemOperations, itemOperations); err != nil {
+			return err
+		}
+		if err := backupStore.PutBackupItemOperations(backup.Name, bytes.NewReader(itemOperations.Bytes())); err != nil {
+			return errors.Wrap(err, "error uploading item operations json")
+		}
+		if err := mirrorStore.PutBackupItemOperations(backup.Name, bytes.NewReader(itemOperations.Bytes())); err != nil {
+			return errors.Wrapf(err, "error uploading item operations json to mirror backup storage location %s", location.Name)
+		}
+	}
+	if backupStore.volumeInfos != nil {
+		if err := mirrorStore.PutBackupVolumeInfos(backup.Name, bytes.NewReader(backupStore.volumeInfos)); err != nil {
+			return errors.Wrapf(err, "error uploading backup volume info to mirror backup storage location %s", location.Name)
+		}
+	}
+
+	backupJSON := new(bytes.Buffer)
+	if err := encode.To(backup, "json", backupJSON); err != nil {
+		return errors.Wrap(err, "error encoding backup json")
+	}
+	if err := mirrorStore.PutBackupMetadata(backup.Name, backupJSON); err != nil {
+		return errors.Wrapf(err, "error uploading backup json to mirror backup storage location %s", location.Name)
+	}
+	if backupFile != nil {
+		if err := mirrorStore.PutBackupContents(backup.Name, backupFile); err != nil {
+			return errors.Wrapf(err, "error uploading backup final contents to mirror backup storage location %s", location.Name)
+		}
+	}
+	if err := mirrorStore.SignBackup(backup.Name); err != nil && err != persistence.ErrSigningNotEnabled {
+		return errors.Wrapf(err, "error signing backup in mirror backup storage location %s", location.Name)
+	}
+	return nil
+}
+
+// finalizedBackupStore keeps the volume information uploaded when the backup is finalized, to
+// write the same file to the mirror storage location of the backup.
+type finalizedBackupStore struct {
+	persistence.BackupStore
+	volumeInfos []byte
+}
+
+func (s *finalizedBackupStore) PutBackupVolumeInfos(name string, volumeInfo io.Reader) error {
+	data, err := io.ReadAll(volumeInfo)
+	if err != nil {
+		return errors.Wrap(err, "error reading backup volume info")
+	}
+	s.volumeInfos = data
+	return s.BackupStore.PutBackupVolumeInfos(name, bytes.NewReader(data))
+}
+
 func (r *backupFinalizerReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&velerov1api.Backup{}).
@@ -19,10 +19,13 @@ package controller
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"io"
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -40,8 +43,11 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
@@ -59,6 +65,7 @@ func mockBackupFinalizerReconciler(fakeClient kbclient.Client, fakeGlobalClient
 		logrus.StandardLogger(),
 		metrics.NewServerMetrics(),
 		10*time.Minute,
+		nil,
 	), backupper
 }
 func TestBackupFinalizerReconcile(t *testing.T) {
@@ -223,6 +230,7 @@ func TestBackupFinalizerReconcile(t *testing.T) {
 			backupStore.On("GetBackupContents", mock.Anything).Return(io.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
 			backupStore.On("PutBackupContents", mock.Anything, mock.Anything).Return(nil)
 			backupStore.On("PutBackupMetadata", mock.Anything, mock.Anything).Return(nil)
+			backupStore.On("SignBackup", mock.Anything).Return(nil)
 			backupStore.On("GetBackupVolumeInfos", mock.Anything).Return(nil, nil)
 			backupStore.On("PutBackupVolumeInfos", mock.Anything, mock.Anything).Return(nil)
 			pluginManager.On("GetBackupItemActionsV2").Return(nil, nil)
@@ -240,6 +248,188 @@ func TestBackupFinalizerReconcile(t *testing.T) {
 			require.NoError(t, err)
 			assert.Equal(t, test.expectPhase, backupAfter.Status.Phase)
 			assert.Equal(t, test.expectedCompletedVS, backupAfter.Status.CSIVolumeSnapshotsCompleted)
+			assert.Equal(t, !test.expectError, backupAfter.Status.Signed)
 		})
 	}
 }
+
+func TestBackupFinalizerReconcileMirror(t *testing.T) {
+	fakeClock := testclocks.NewFakeClock(time.Now())
+
+	tests := []struct {
+		name                string
+		policy              velerov1api.MirrorFailurePolicy
+		mirrorErr           error
+		expectPhase         velerov1api.BackupPhase
+		expectMirrorPhase   velerov1api.MirrorPhase
+		expectMirrorFailure string
+		expectWarnings      int
+	}{
+		{
+			name:              "mirrored backup is completed",
+			expectPhase:       velerov1api.BackupPhaseCompleted,
+			expectMirrorPhase: velerov1api.MirrorPhaseCompleted,
+		},
+		{
+			name:                "backup failing to be mirrored is failed",
+			mirrorErr:           errors.New("bucket unavailable"),
+			expectPhase:         velerov1api.BackupPhaseFailed,
+			expectMirrorPhase:   velerov1api.MirrorPhaseFailed,
+			expectMirrorFailure: "error uploading backup json to mirror backup storage location mirror: bucket unavailable",
+		},
+		{
+			name:                "backup failing to be mirrored gets a warning",
+			policy:              velerov1api.MirrorFailurePolicyWarn,
+			mirrorErr:           errors.New("bucket unavailable"),
+			expectPhase:         velerov1api.BackupPhaseCompleted,
+			expectMirrorPhase:   velerov1api.MirrorPhaseFailed,
+			expectMirrorFailure: "error uploading backup json to mirror backup storage location mirror: bucket unavailable",
+			expectWarnings:      1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").
+				StorageLocation("default").
+				MirrorStorageLocation("mirror", test.policy).
+				StartTimestamp(fakeClock.Now()).
+				Phase(velerov1api.BackupPhaseFinalizing).Result()
+			backup.Status.MirrorPhase = velerov1api.MirrorPhaseCompleted
+
+			fakeClient := velerotest.NewFakeControllerRuntimeClient(t,
+				backup,
+				builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result(),
+				builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "mirror").Result(),
+			)
+
+			pluginManager := &pluginmocks.Manager{}
+			pluginManager.On("CleanupClients").Return(nil)
+			primaryStore := &persistencemocks.BackupStore{}
+			primaryStore.On("GetBackupItemOperations", backup.Name).Return(nil, nil)
+			primaryStore.On("PutBackupMetadata", backup.Name, mock.Anything).Return(nil)
+			primaryStore.On("SignBackup", backup.Name).Return(persistence.ErrSigningNotEnabled)
+			mirrorStore := &persistencemocks.BackupStore{}
+			mirrorStore.On("PutBackupMetadata", backup.Name, mock.Anything).Return(test.mirrorErr)
+			mirrorStore.On("SignBackup", backup.Name).Return(persistence.ErrSigningNotEnabled).Maybe()
+
+			reconciler := NewBackupFinalizerReconciler(
+				fakeClient,
+				fakeClient,
+				fakeClock,
+				new(fakeBackupper),
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewBackupTracker(),
+				NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{"default": primaryStore, "mirror": mirrorStore}),
+				logrus.StandardLogger(),
+				metrics.NewServerMetrics(),
+				10*time.Minute,
+				nil,
+			)
+			_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}})
+			require.NoError(t, err)
+
+			backupAfter := velerov1api.Backup{}
+			require.NoError(t, fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}, &backupAfter))
+			assert.Equal(t, test.expectPhase, backupAfter.Status.Phase)
+			assert.Equal(t, test.expectMirrorPhase, backupAfter.Status.MirrorPhase)
+			assert.Equal(t, test.expectMirrorFailure, backupAfter.Status.MirrorFailureReason)
+			assert.Equal(t, test.expectWarnings, backupAfter.Status.Warnings)
+			// the locations don't sign the backups
+			assert.False(t, backupAfter.Status.Signed)
+			mirrorStore.AssertCalled(t, "PutBackupMetadata", backup.Name, mock.Anything)
+			primaryStore.AssertCalled(t, "PutBackupMetadata", backup.Name, mock.Anything)
+		})
+	}
+}
+
+func TestBackupFinalizerReconcileMirrorRewrittenFiles(t *testing.T) {
+	fakeClock := testclocks.NewFakeClock(time.Now())
+
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").
+		StorageLocation("default").
+		MirrorStorageLocation("mirror", velerov1api.MirrorFailurePolicyFail).
+		StartTimestamp(fakeClock.Now()).
+		Phase(velerov1api.BackupPhaseFinalizing).Result()
+	backup.Status.MirrorPhase = velerov1api.MirrorPhaseCompleted
+	backup.Status.ArtifactDigests = map[string]string{"backup-1-itemoperations.json.gz": "stale"}
+	operations := []*itemoperation.BackupOperation{
+		{
+			Spec: itemoperation.BackupOperationSpec{
+				BackupName:       backup.Name,
+				BackupItemAction: "foo",
+				OperationID:      "operation-1",
+			},
+			Status: itemoperation.OperationStatus{Phase: itemoperation.OperationPhaseCompleted},
+		},
+	}
+
+	fakeClient := velerotest.NewFakeControllerRuntimeClient(t,
+		backup,
+		builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result(),
+		builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "mirror").Result(),
+	)
+
+	pluginManager := &pluginmocks.Manager{}
+	pluginManager.On("CleanupClients").Return(nil)
+	pluginManager.On("GetBackupItemActionsV2").Return(nil, nil)
+
+	var primaryOperations, mirrorOperations []byte
+	primaryStore := &persistencemocks.BackupStore{}
+	primaryStore.On("GetBackupItemOperations", backup.Name).Return(operations, nil)
+	primaryStore.On("GetBackupContents", backup.Name).Return(io.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
+	primaryStore.On("PutBackupItemOperations", backup.Name, mock.Anything).Run(func(args mock.Arguments) {
+		primaryOperations, _ = io.ReadAll(args.Get(1).(io.Reader))
+	}).Return(nil)
+	primaryStore.On("PutBackupContents", backup.Name, mock.Anything).Return(nil)
+	primaryStore.On("PutBackupMetadata", backup.Name, mock.Anything).Return(nil)
+	primaryStore.On("SignBackup", backup.Name).Return(nil)
+	mirrorStore := &persistencemocks.BackupStore{}
+	mirrorStore.On("PutBackupItemOperations", backup.Name, mock.Anything).Run(func(args mock.Arguments) {
+		mirrorOperations, _ = io.ReadAll(args.Get(1).(io.Reader))
+	}).Return(nil)
+	mirrorStore.On("PutBackupContents", backup.Name, mock.Anything).Return(nil)
+	mirrorStore.On("PutBackupMetadata", backup.Name, mock.Anything).Return(nil)
+	mirrorStore.On("SignBackup", backup.Name).Return(nil)
+
+	backupper := new(fakeBackupper)
+	backupper.On("FinalizeBackup", mock.Anything, mock.Anything, mock.Anything, mock.Anything, framework.BackupItemActionResolverV2{}, mock.Anything).Return(nil)
+	reconciler := NewBackupFinalizerReconciler(
+		fakeClient,
+		fakeClient,
+		fakeClock,
+		backupper,
+		func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		NewBackupTracker(),
+		NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{"default": primaryStore, "mirror": mirrorStore}),
+		logrus.StandardLogger(),
+		metrics.NewServerMetrics(),
+		10*time.Minute,
+		nil,
+	)
+	_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}})
+	require.NoError(t, err)
+
+	backupAfter := velerov1api.Backup{}
+	require.NoError(t, fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}, &backupAfter))
+	assert.Equal(t, velerov1api.BackupPhaseCompleted, backupAfter.Status.Phase)
+	assert.Equal(t, velerov1api.MirrorPhaseCompleted, backupAfter.Status.MirrorPhase)
+	require.NotEmpty(t, mirrorOperations)
+	assert.Equal(t, primaryOperations, mirrorOperations)
+	digest := sha256.Sum256(mirrorOperations)
+	assert.Equal(t, hex.EncodeToString(digest[:]), backupAfter.Status.ArtifactDigests["backup-1-itemoperations.json.gz"])
+	mirrorStore.AssertCalled(t, "PutBackupContents", backup.Name, mock.Anything)
+	primaryStore.AssertCalled(t, "SignBackup", backup.Name)
+	mirrorStore.AssertCalled(t, "SignBackup", backup.Name)
+}
+
+func TestFinalizedBackupStoreKeepsVolumeInfos(t *testing.T) {
+	backupStore := &persistencemocks.BackupStore{}
+	backupStore.On("PutBackupVolumeInfos", "backup-1", mock.Anything).Return(nil)
+
+	store := &finalizedBackupStore{BackupStore: backupStore}
+	require.NoError(t, store.PutBackupVolumeInfos("backup-1", bytes.NewReader([]byte("volume-info"))))
+
+	assert.Equal(t, []byte("volume-info"), store.volumeInfos)
+	backupStore.AssertCalled(t, "PutBackupVolumeInfos", "backup-1", mock.Anything)
+}
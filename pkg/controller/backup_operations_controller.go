@@ -35,12 +35,14 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/constant"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/itemoperationmap"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/util/conditions"
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
@@ -167,7 +169,7 @@ func (c *backupOperationsReconciler) Reconcile(ctx context.Context, req ctrl.Req
 
 	pluginManager := c.newPluginManager(c.logger)
 	defer pluginManager.CleanupClients()
-	backupStore, err := c.backupStoreGetter.Get(loc, pluginManager, c.logger)
+	backupStore, err := c.backupStoreGetter.Get(persistence.LocationWithObjectTags(loc, pkgbackup.Tags(backup)), pluginManager, c.logger)
 	if err != nil {
 		return ctrl.Result{}, errors.Wrap(err, "error getting backup store")
 	}
@@ -252,6 +254,11 @@ func (c *backupOperationsReconciler) updateBackupAndOperationsJSON(
 		backup.Status.Phase == velerov1api.BackupPhaseFinalizingPartiallyFailed {
 		// update file store
 		if backupStore != nil {
+			// the operations are uploaded first so that the metadata records their digest
+			if err := c.itemOperationsMap.UploadProgressAndPutOperationsForBackup(backupStore, operations, backup); err != nil {
+				removeIfComplete = false
+				return err
+			}
 			backupJSON := new(bytes.Buffer)
 			if err := encode.To(backup, "json", backupJSON); err != nil {
 				removeIfComplete = false
@@ -262,12 +269,10 @@ func (c *backupOperationsReconciler) updateBackupAndOperationsJSON(
 				removeIfComplete = false
 				return errors.Wrap(err, "error uploading backup json")
 			}
-			if err := c.itemOperationsMap.UploadProgressAndPutOperationsForBackup(backupStore, operations, backup.Name); err != nil {
-				removeIfComplete = false
-				return err
-			}
 		}
 		// update backup
+		conditions.SetBackupConditions(backup, c.clock.Now())
+		conditions.SetObservedGeneration(original, backup)
 		err := c.Client.Patch(ctx, backup, client.MergeFrom(original))
 		if err != nil {
 			removeIfComplete = false
@@ -276,6 +281,7 @@ func (c *backupOperationsReconciler) updateBackupAndOperationsJSON(
 	} else if completionChanges {
 		// If backup is still incomplete and no new errors are found but there are some new operations
 		// completed, patch backup to reflect new completion numbers, but don't upload detailed json file
+		conditions.SetObservedGeneration(original, backup)
 		err := c.Client.Patch(ctx, backup, client.MergeFrom(original))
 		if err != nil {
 			return errors.Wrapf(err, "error updating Backup %s", backup.Name)
@@ -359,6 +365,16 @@ func getBackupItemOperationProgress(
 				completedCount++
 				continue
 			}
+			// cancel operation if requested by the user
+			if itemoperation.CancelRequested(backup.Annotations, operation.Spec.OperationID) {
+				_ = bia.Cancel(operation.Spec.OperationID, backup)
+				operation.Status.Phase = itemoperation.OperationPhaseFailed
+				operation.Status.Error = "Asynchronous action canceled by user"
+				errs = append(errs, wrapErrMsg(operation.Status.Error, bia))
+				changes = true
+				failedCount++
+				continue
+			}
 			// cancel operation if past timeout period
 			if operation.Status.Created.Time.Add(backup.Spec.ItemOperationTimeout.Duration).Before(time.Now()) {
 				_ = bia.Cancel(operation.Spec.OperationID, backup)
@@ -369,6 +385,19 @@ func getBackupItemOperationProgress(
 				failedCount++
 				continue
 			}
+			// cancel operation if the backup ran past its maxDuration, aborting the data movement
+			if pkgbackup.MaxDurationExceeded(backup, time.Now()) {
+				_ = bia.Cancel(operation.Spec.OperationID, backup)
+				operation.Status.Phase = itemoperation.OperationPhaseFailed
+				operation.Status.Error = fmt.Sprintf("Asynchronous action canceled, the backup exceeded its maxDuration of %s", backup.Spec.MaxDuration.Duration)
+				errs = append(errs, wrapErrMsg(operation.Status.Error, bia))
+				backup.Status.MaxDurationExceeded = true
+				pkgbackup.AddIncompleteItem(&backup.Status, operation.Spec.ResourceIdentifier.GroupResource.String(),
+					operation.Spec.ResourceIdentifier.Namespace, operation.Spec.ResourceIdentifier.Name)
+				changes = true
+				failedCount++
+				continue
+			}
 			if operation.Status.Phase == itemoperation.OperationPhaseNew &&
 				operation.Status.Started != nil {
 				operation.Status.Phase = itemoperation.OperationPhaseInProgress
@@ -83,6 +83,7 @@ func TestBackupOperationsReconcile(t *testing.T) {
 		operationErr      string
 		expectError       bool
 		expectPhase       velerov1api.BackupPhase
+		expectIncomplete  []velerov1api.IncompleteItem
 	}{
 		{
 			name: "WaitingForPluginOperations backup with completed operations is Finalizing",
@@ -266,6 +267,71 @@ func TestBackupOperationsReconcile(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "WaitingForPluginOperations backup with canceled operations is FinalizingPartiallyFailed",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-17").
+				StorageLocation("default").
+				ItemOperationTimeout(60*time.Minute).
+				ObjectMeta(builder.WithUID("foo-17"), builder.WithAnnotations(velerov1api.CancelOperationsAnnotation, "operation-16,operation-17")).
+				Phase(velerov1api.BackupPhaseWaitingForPluginOperations).Result(),
+			backupLocation:    defaultBackupLocation,
+			operationComplete: false,
+			expectPhase:       velerov1api.BackupPhaseFinalizingPartiallyFailed,
+			backupOperations: []*itemoperation.BackupOperation{
+				{
+					Spec: itemoperation.BackupOperationSpec{
+						BackupName:       "backup-17",
+						BackupUID:        "foo-17",
+						BackupItemAction: "foo-17",
+						ResourceIdentifier: velero.ResourceIdentifier{
+							GroupResource: kuberesource.Pods,
+							Namespace:     "ns-1",
+							Name:          "pod-1",
+						},
+						OperationID: "operation-17",
+					},
+					Status: itemoperation.OperationStatus{
+						Phase:   itemoperation.OperationPhaseNew,
+						Created: &metav1Now,
+					},
+				},
+			},
+		},
+		{
+			name: "WaitingForPluginOperations backup past its maxDuration is FinalizingPartiallyFailed with its operations canceled",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-18").
+				StorageLocation("default").
+				ItemOperationTimeout(60*time.Minute).
+				MaxDuration(30*time.Minute, "").
+				StartTimestamp(fakeClock.Now().Add(-time.Hour)).
+				ObjectMeta(builder.WithUID("foo-18")).
+				Phase(velerov1api.BackupPhaseWaitingForPluginOperations).Result(),
+			backupLocation:    defaultBackupLocation,
+			operationComplete: false,
+			expectPhase:       velerov1api.BackupPhaseFinalizingPartiallyFailed,
+			expectIncomplete: []velerov1api.IncompleteItem{
+				{Resource: "pods", Namespace: "ns-1", Name: "pod-1"},
+			},
+			backupOperations: []*itemoperation.BackupOperation{
+				{
+					Spec: itemoperation.BackupOperationSpec{
+						BackupName:       "backup-18",
+						BackupUID:        "foo-18",
+						BackupItemAction: "foo-18",
+						ResourceIdentifier: velero.ResourceIdentifier{
+							GroupResource: kuberesource.Pods,
+							Namespace:     "ns-1",
+							Name:          "pod-1",
+						},
+						OperationID: "operation-18",
+					},
+					Status: itemoperation.OperationStatus{
+						Phase:   itemoperation.OperationPhaseNew,
+						Created: &metav1Now,
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -294,6 +360,7 @@ func TestBackupOperationsReconcile(t *testing.T) {
 						Completed: test.operationComplete,
 						Err:       test.operationErr,
 					}, nil)
+				bia.On("Cancel", operation.Spec.OperationID, mock.Anything).Return(nil)
 				pluginManager.On("GetBackupItemActionV2", operation.Spec.BackupItemAction).Return(bia, nil)
 			}
 			_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: test.backup.Namespace, Name: test.backup.Name}})
@@ -308,6 +375,8 @@ func TestBackupOperationsReconcile(t *testing.T) {
 
 			require.NoError(t, err)
 			assert.Equal(t, test.expectPhase, backupAfter.Status.Phase)
+			assert.Equal(t, len(test.expectIncomplete), backupAfter.Status.IncompleteItems)
+			assert.Equal(t, test.expectIncomplete, backupAfter.Status.IncompleteItemList)
 		})
 	}
 }
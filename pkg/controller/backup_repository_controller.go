@@ -45,6 +45,7 @@ import (
 	repoconfig "github.com/vmware-tanzu/velero/pkg/repository/config"
 	"github.com/vmware-tanzu/velero/pkg/repository/maintenance"
 	repomanager "github.com/vmware-tanzu/velero/pkg/repository/manager"
+	"github.com/vmware-tanzu/velero/pkg/util/conditions"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 )
@@ -548,6 +549,8 @@ func repoReady() func(*velerov1api.BackupRepository) {
 func (r *BackupRepoReconciler) patchBackupRepository(ctx context.Context, req *velerov1api.BackupRepository, mutate func(*velerov1api.BackupRepository)) error {
 	original := req.DeepCopy()
 	mutate(req)
+	conditions.SetBackupRepositoryConditions(req, r.clock.Now())
+	conditions.SetObservedGeneration(original, req)
 	if err := r.Patch(ctx, req, client.MergeFrom(original)); err != nil {
 		return errors.Wrap(err, "error patching BackupRepository")
 	}
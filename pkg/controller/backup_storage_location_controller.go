@@ -18,22 +18,30 @@ package controller
 
 import (
 	"context"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/vmware-tanzu/velero/internal/storage"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/constant"
+	"github.com/vmware-tanzu/velero/pkg/health"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/util/conditions"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
@@ -53,6 +61,7 @@ type backupStorageLocationReconciler struct {
 	// replaced with fakes for testing.
 	newPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
 	backupStoreGetter persistence.ObjectBackupStoreGetter
+	healthChecker     *health.Checker
 
 	log logrus.FieldLogger
 }
@@ -64,6 +73,7 @@ func NewBackupStorageLocationReconciler(
 	defaultBackupLocationInfo storage.DefaultBackupLocationInfo,
 	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	healthChecker *health.Checker,
 	log logrus.FieldLogger) *backupStorageLocationReconciler {
 	return &backupStorageLocationReconciler{
 		ctx:                       ctx,
@@ -71,6 +81,7 @@ func NewBackupStorageLocationReconciler(
 		defaultBackupLocationInfo: defaultBackupLocationInfo,
 		newPluginManager:          newPluginManager,
 		backupStoreGetter:         backupStoreGetter,
+		healthChecker:             healthChecker,
 		log:                       log,
 	}
 }
@@ -118,17 +129,30 @@ func (r *backupStorageLocationReconciler) Reconcile(ctx context.Context, req ctr
 		original := location.DeepCopy()
 		defer func() {
 			location.Status.LastValidationTime = &metav1.Time{Time: time.Now().UTC()}
-			if err != nil {
+			if err != nil && location.Status.ValidationFailures+1 < storage.HealthProbeFailureThreshold(&location) {
+				location.Status.ValidationFailures++
+				log.WithError(err).Warnf("BackupStorageLocation failed %d validations in a row, marking it as unavailable after %d",
+					location.Status.ValidationFailures, storage.HealthProbeFailureThreshold(&location))
+				location.Status.Message = errors.Wrap(err, "BackupStorageLocation validation failed").Error()
+			} else if err != nil {
 				log.Info("BackupStorageLocation is invalid, marking as unavailable")
 				err = errors.Wrapf(err, "BackupStorageLocation %q is unavailable", location.Name)
 				unavailableErrors = append(unavailableErrors, err.Error())
 				location.Status.Phase = velerov1api.BackupStorageLocationPhaseUnavailable
 				location.Status.Message = err.Error()
+				location.Status.ValidationFailures++
+				if location.Status.ValidationFailures > 1 {
+					log.Infof("BackupStorageLocation failed %d validations in a row, validating it again in %s",
+						location.Status.ValidationFailures, storage.ValidationInterval(&location, r.defaultBackupLocationInfo))
+				}
 			} else {
 				log.Info("BackupStorageLocations is valid, marking as available")
 				location.Status.Phase = velerov1api.BackupStorageLocationPhaseAvailable
 				location.Status.Message = ""
+				location.Status.ValidationFailures = 0
 			}
+			conditions.SetBackupStorageLocationConditions(&location, location.Status.LastValidationTime.Time)
+			conditions.SetObservedGeneration(original, &location)
 			if err := r.client.Patch(r.ctx, &location, client.MergeFrom(original)); err != nil {
 				log.WithError(err).Error("Error updating BackupStorageLocation phase")
 			}
@@ -141,11 +165,19 @@ func (r *backupStorageLocationReconciler) Reconcile(ctx context.Context, req ctr
 		}
 
 		log.Info("Validating BackupStorageLocation")
+		start := time.Now()
 		err = backupStore.IsValid()
+		latency := time.Since(start).Round(time.Millisecond)
+		location.Status.LastValidationLatency = metav1.Duration{Duration: latency}
 		if err != nil {
 			log.WithError(err).Error("fail to validate backup store")
 			return
 		}
+		if probe := location.Spec.HealthProbe; probe != nil && probe.LatencyThreshold.Duration > 0 && latency > probe.LatencyThreshold.Duration {
+			err = errors.Errorf("the validation took %s, longer than the latency threshold of %s", latency, probe.LatencyThreshold.Duration)
+			log.WithError(err).Error("backup store is too slow")
+			return
+		}
 	}()
 
 	r.logReconciledPhase(defaultFound, locationList, unavailableErrors)
@@ -193,26 +225,76 @@ func (r *backupStorageLocationReconciler) logReconciledPhase(defaultFound bool,
 func (r *backupStorageLocationReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	gp := kube.NewGenericEventPredicate(func(object client.Object) bool {
 		location := object.(*velerov1api.BackupStorageLocation)
-		return storage.IsReadyToValidate(location.Spec.ValidationFrequency, location.Status.LastValidationTime, r.defaultBackupLocationInfo.ServerValidationFrequency, r.log.WithField("controller", constant.ControllerBackupStorageLocation))
+		return storage.IsLocationReadyToValidate(location, r.defaultBackupLocationInfo, time.Now().UTC())
 	})
+	option := kube.PeriodicalEnqueueSourceOption{
+		Predicates: []predicate.Predicate{gp},
+		OrderFunc:  bslValidationOrderFunc,
+	}
+	if r.healthChecker != nil {
+		// the server isn't ready if the locations stop being polled for validation
+		option.Heartbeat = r.healthChecker.AddHeartbeat(constant.ControllerBackupStorageLocation, 3*bslValidationEnqueuePeriod)
+	}
 	g := kube.NewPeriodicalEnqueueSource(
 		r.log.WithField("controller", constant.ControllerBackupStorageLocation),
 		mgr.GetClient(),
 		&velerov1api.BackupStorageLocationList{},
 		bslValidationEnqueuePeriod,
-		kube.PeriodicalEnqueueSourceOption{
-			Predicates: []predicate.Predicate{gp},
-		},
+		option,
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		// As the "status.LastValidationTime" field is always updated, this triggers new reconciling process, skip the update event that include no spec change to avoid the reconcile loop
 		For(&velerov1api.BackupStorageLocation{}, builder.WithPredicates(kube.SpecChangePredicate{})).
 		WatchesRawSource(g).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: max(r.defaultBackupLocationInfo.ServerValidationConcurrency, 1),
+		}).
 		Named(constant.ControllerBackupStorageLocation).
 		Complete(r)
 }
 
+// bslValidationOrderFunc returns a new list with the locations in the order they're validated: the
+// default location first, then the available locations and the locations never validated, then the
+// unavailable locations, each by their last validation time.
+func bslValidationOrderFunc(objList client.ObjectList) client.ObjectList {
+	locations := objList.(*velerov1api.BackupStorageLocationList).Items
+	rank := func(location *velerov1api.BackupStorageLocation) int {
+		switch {
+		case location.Spec.Default:
+			return 0
+		case location.Status.Phase == velerov1api.BackupStorageLocationPhaseUnavailable:
+			return 2
+		default:
+			return 1
+		}
+	}
+	lastValidation := func(location *velerov1api.BackupStorageLocation) time.Time {
+		if location.Status.LastValidationTime == nil {
+			return time.Time{}
+		}
+		return location.Status.LastValidationTime.Time
+	}
+
+	ordered := make([]runtime.Object, 0, len(locations))
+	for i := range locations {
+		ordered = append(ordered, &locations[i])
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		a, b := ordered[i].(*velerov1api.BackupStorageLocation), ordered[j].(*velerov1api.BackupStorageLocation)
+		if rank(a) != rank(b) {
+			return rank(a) < rank(b)
+		}
+		return lastValidation(a).Before(lastValidation(b))
+	})
+
+	result := &velerov1api.BackupStorageLocationList{}
+	if err := meta.SetList(result, ordered); err != nil {
+		return objList
+	}
+	return result
+}
+
 // ensureSingleDefaultBSL ensures that there is only one default BSL in the namespace.
 // the default BSL priority is as follows:
 // 1. follow the user's setting (the most recent validation BSL is the default BSL)
@@ -237,10 +319,12 @@ func (r *backupStorageLocationReconciler) ensureSingleDefaultBSL(locationList ve
 				continue
 			}
 			// For lack of a better way to compare timestamps, we use the CreationTimestamp
-			// it cloud not really find the most recent updated BSL, but it is good enough for now
+			// it cloud not really find the most recent updated BSL, but it is good enough for now.
+			// The name breaks the ties, so the concurrent reconciles keep the same default BSL.
 			bslTimestamp := bsl.CreationTimestamp
 			mostRecentTimestamp := mostRecentCreatedBSL.CreationTimestamp
-			if mostRecentTimestamp.Before(&bslTimestamp) {
+			if mostRecentTimestamp.Before(&bslTimestamp) ||
+				(mostRecentTimestamp.Equal(&bslTimestamp) && bsl.Name < mostRecentCreatedBSL.Name) {
 				mostRecentCreatedBSL = bsl
 			}
 		}
@@ -248,8 +332,7 @@ func (r *backupStorageLocationReconciler) ensureSingleDefaultBSL(locationList ve
 		// unset all other default BSLs
 		for _, bsl := range defaultBSLs {
 			if bsl.Name != mostRecentCreatedBSL.Name {
-				bsl.Spec.Default = false
-				if err := r.client.Update(r.ctx, bsl); err != nil {
+				if err := r.unsetDefaultBSL(bsl); err != nil {
 					return defaultFound, errors.Wrapf(err, "failed to unset default backup storage location %q", bsl.Name)
 				}
 				r.log.Debugf("update default backup storage location %q to false", bsl.Name)
@@ -262,3 +345,23 @@ func (r *backupStorageLocationReconciler) ensureSingleDefaultBSL(locationList ve
 	}
 	return defaultFound, nil
 }
+
+// unsetDefaultBSL unsets the default flag of the BSL with an optimistic lock, as the BSLs are
+// reconciled concurrently. On a conflict, the BSL is got again and unset if it's still a default.
+func (r *backupStorageLocationReconciler) unsetDefaultBSL(bsl *velerov1api.BackupStorageLocation) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if !bsl.Spec.Default {
+			return nil
+		}
+
+		original := bsl.DeepCopy()
+		bsl.Spec.Default = false
+		err := r.client.Patch(r.ctx, bsl, client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{}))
+		if apierrors.IsConflict(err) {
+			if err := r.client.Get(r.ctx, client.ObjectKeyFromObject(bsl), bsl); err != nil {
+				return err
+			}
+		}
+		return err
+	})
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -254,6 +255,131 @@ func TestEnsureSingleDefaultBSL(t *testing.T) {
 	}
 }
 
+func TestEnsureSingleDefaultBSLConflict(t *testing.T) {
+	assert.NoError(t, velerov1api.AddToScheme(scheme.Scheme))
+
+	location1 := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "location-1").Default(true).Result()
+	location2 := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "location-2").Default(true).Result()
+	r := &backupStorageLocationReconciler{
+		ctx:    context.Background(),
+		client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(location1, location2).Build(),
+		log:    velerotest.NewLogger(),
+	}
+
+	locations, err := storage.ListBackupStorageLocations(r.ctx, r.client, velerov1api.DefaultNamespace)
+	require.NoError(t, err)
+
+	// another reconcile updates location-2 in the meantime, so the listed one is stale
+	current := &velerov1api.BackupStorageLocation{}
+	require.NoError(t, r.client.Get(r.ctx, client.ObjectKeyFromObject(location2), current))
+	current.Labels = map[string]string{"foo": "bar"}
+	require.NoError(t, r.client.Update(r.ctx, current))
+
+	defaultFound, err := r.ensureSingleDefaultBSL(locations)
+	require.NoError(t, err)
+	assert.True(t, defaultFound)
+
+	require.NoError(t, r.client.Get(r.ctx, client.ObjectKeyFromObject(location1), current))
+	assert.True(t, current.Spec.Default)
+	require.NoError(t, r.client.Get(r.ctx, client.ObjectKeyFromObject(location2), current))
+	assert.False(t, current.Spec.Default)
+	assert.Equal(t, map[string]string{"foo": "bar"}, current.Labels)
+}
+
+func TestBSLReconcileValidationFailures(t *testing.T) {
+	tests := []struct {
+		name             string
+		location         *velerov1api.BackupStorageLocation
+		validationErr    error
+		validationDelay  time.Duration
+		expectedFailures int32
+		expectedPhase    velerov1api.BackupStorageLocationPhase
+	}{
+		{
+			name:             "failed validation is counted",
+			location:         builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "location-1").ValidationFailures(2).Result(),
+			validationErr:    errors.New("an error"),
+			expectedFailures: 3,
+			expectedPhase:    velerov1api.BackupStorageLocationPhaseUnavailable,
+		},
+		{
+			name:             "successful validation resets the count",
+			location:         builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "location-1").ValidationFailures(2).Result(),
+			expectedFailures: 0,
+			expectedPhase:    velerov1api.BackupStorageLocationPhaseAvailable,
+		},
+		{
+			name: "failed validation below the failure threshold",
+			location: builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "location-1").HealthProbe(0, 3).
+				Phase(velerov1api.BackupStorageLocationPhaseAvailable).ValidationFailures(1).Result(),
+			validationErr:    errors.New("an error"),
+			expectedFailures: 2,
+			expectedPhase:    velerov1api.BackupStorageLocationPhaseAvailable,
+		},
+		{
+			name: "failed validation reaching the failure threshold",
+			location: builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "location-1").HealthProbe(0, 3).
+				Phase(velerov1api.BackupStorageLocationPhaseAvailable).ValidationFailures(2).Result(),
+			validationErr:    errors.New("an error"),
+			expectedFailures: 3,
+			expectedPhase:    velerov1api.BackupStorageLocationPhaseUnavailable,
+		},
+		{
+			name: "validation slower than the latency threshold",
+			location: builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "location-1").HealthProbe(time.Millisecond, 0).
+				Phase(velerov1api.BackupStorageLocationPhaseAvailable).Result(),
+			validationDelay:  20 * time.Millisecond,
+			expectedFailures: 1,
+			expectedPhase:    velerov1api.BackupStorageLocationPhaseUnavailable,
+		},
+	}
+
+	pluginManager := &pluginmocks.Manager{}
+	pluginManager.On("CleanupClients").Return(nil)
+	require.NoError(t, velerov1api.AddToScheme(scheme.Scheme))
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backupStore := &persistencemocks.BackupStore{}
+			backupStore.On("IsValid").Return(test.validationErr).After(test.validationDelay)
+
+			r := &backupStorageLocationReconciler{
+				ctx:               context.Background(),
+				client:            fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(test.location).Build(),
+				newPluginManager:  func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				backupStoreGetter: NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{test.location.Name: backupStore}),
+				log:               velerotest.NewLogger(),
+			}
+
+			_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: test.location.Namespace, Name: test.location.Name}})
+			require.NoError(t, err)
+
+			location := &velerov1api.BackupStorageLocation{}
+			require.NoError(t, r.client.Get(context.TODO(), client.ObjectKeyFromObject(test.location), location))
+			assert.Equal(t, test.expectedFailures, location.Status.ValidationFailures)
+			assert.Equal(t, test.expectedPhase, location.Status.Phase)
+		})
+	}
+}
+
+func TestBSLValidationOrderFunc(t *testing.T) {
+	now := time.Now()
+	locations := &velerov1api.BackupStorageLocationList{
+		Items: []velerov1api.BackupStorageLocation{
+			*builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "failing").Phase(velerov1api.BackupStorageLocationPhaseUnavailable).LastValidationTime(now.Add(-time.Hour)).Result(),
+			*builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "recent").Phase(velerov1api.BackupStorageLocationPhaseAvailable).LastValidationTime(now).Result(),
+			*builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "new").Result(),
+			*builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "old").Phase(velerov1api.BackupStorageLocationPhaseAvailable).LastValidationTime(now.Add(-time.Minute)).Result(),
+			*builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Default(true).Phase(velerov1api.BackupStorageLocationPhaseUnavailable).LastValidationTime(now).Result(),
+		},
+	}
+
+	var names []string
+	for _, location := range bslValidationOrderFunc(locations).(*velerov1api.BackupStorageLocationList).Items {
+		names = append(names, location.Name)
+	}
+	assert.Equal(t, []string{"default", "new", "old", "recent", "failing"}, names)
+}
+
 func TestBSLReconcile(t *testing.T) {
 	tests := []struct {
 		name          string
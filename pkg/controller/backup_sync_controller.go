@@ -19,6 +19,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
@@ -40,6 +41,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/util/conditions"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -172,6 +174,20 @@ func (b *backupSyncReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			log.Debugf("%v Backup is past expiration, syncing for garbage collection", backup.Status.Phase)
 			backup.Status.Phase = velerov1api.BackupPhasePartiallyFailed
 		}
+
+		// the backups created before the digests were introduced have none to validate
+		if len(backup.Status.ArtifactDigests) > 0 {
+			mismatches, err := backupStore.VerifyBackupArtifacts(backupName, backup.Status.ArtifactDigests)
+			if err != nil {
+				log.WithError(errors.WithStack(err)).Error("Error verifying backup files against their digests")
+				continue
+			}
+			if len(mismatches) > 0 {
+				log.Errorf("Backup files don't match their digests, syncing the backup as corrupted: %s", strings.Join(mismatches, "; "))
+				conditions.SetBackupCorruptedCondition(backup, time.Now(), strings.Join(mismatches, "; "))
+			}
+		}
+
 		backup.Namespace = b.namespace
 		backup.ResourceVersion = ""
 
@@ -183,6 +199,9 @@ func (b *backupSyncReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			backup.Labels = make(map[string]string)
 		}
 		backup.Labels[velerov1api.StorageLocationLabel] = label.GetValidName(backup.Spec.StorageLocation)
+		// a mirrored backup is in two locations, the backup synced from one of them is
+		// tracked in that location only.
+		backup.Spec.MirrorStorageLocation = ""
 
 		//check for the ownership references. If they do not exist, remove them.
 		backup.ObjectMeta.OwnerReferences = b.filterBackupOwnerReferences(ctx, backup, log)
@@ -267,6 +286,10 @@ func (b *backupSyncReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	b.deleteOrphanedBackups(ctx, location.Name, backupStoreBackups, log)
 
+	if !persistence.IsReadOnly(location) {
+		b.expireBackupLogs(ctx, location, backupStore, log)
+	}
+
 	// update the location's last-synced time field
 	statusPatch := client.MergeFrom(location.DeepCopy())
 	location.Status.LastSyncedTime = &metav1.Time{Time: time.Now().UTC()}
@@ -366,6 +389,87 @@ func (b *backupSyncReconciler) deleteOrphanedBackups(ctx context.Context, locati
 	}
 }
 
+// expireBackupLogs deletes the logs and results of the backups of the location whose backup logs
+// TTL passed before the backups expired, and the logs kept in the location after the deletion of
+// their backups once they expire too.
+func (b *backupSyncReconciler) expireBackupLogs(ctx context.Context, location *velerov1api.BackupStorageLocation, backupStore persistence.BackupStore, log logrus.FieldLogger) {
+	now := time.Now()
+
+	if location.Spec.BackupLogsTTL != nil {
+		var backupList velerov1api.BackupList
+		if err := b.client.List(ctx, &backupList, &client.ListOptions{
+			Namespace: b.namespace,
+			LabelSelector: labels.Set(map[string]string{
+				velerov1api.StorageLocationLabel: label.GetValidName(location.Name),
+			}).AsSelector(),
+		}); err != nil {
+			log.WithError(errors.WithStack(err)).Error("Error listing backups from cluster")
+			return
+		}
+
+		for i := range backupList.Items {
+			backup := &backupList.Items[i]
+			switch backup.Status.Phase {
+			case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed:
+			default:
+				// the logs of the backups in progress aren't uploaded yet
+				continue
+			}
+			if meta.IsStatusConditionTrue(backup.Status.Conditions, velerov1api.ConditionTypeLogsExpired) {
+				continue
+			}
+			expiration, _ := backupLogsExpiration(location, backup)
+			if expiration.After(now) {
+				continue
+			}
+
+			log := log.WithField("backup", backup.Name)
+			if err := backupStore.DeleteBackupLogs(backup.Name); err != nil {
+				log.WithError(err).Error("Error deleting expired backup logs")
+				continue
+			}
+			log.Info("Deleted expired backup logs")
+
+			original := backup.DeepCopy()
+			conditions.SetBackupLogsExpiredCondition(backup, now, "Backup logs expired at "+expiration.UTC().Format(time.RFC3339))
+			conditions.SetObservedGeneration(original, backup)
+			if err := b.client.Patch(ctx, backup, client.MergeFrom(original)); err != nil {
+				log.WithError(errors.WithStack(err)).Error("Error patching backup conditions")
+			}
+		}
+	}
+
+	retained, err := backupStore.ListRetainedBackupLogs()
+	if err != nil {
+		log.WithError(err).Error("Error listing the logs of deleted backups in backup store")
+		return
+	}
+	for backup, expiration := range retained {
+		if expiration.After(now) {
+			continue
+		}
+		if err := backupStore.DeleteRetainedBackupLogs(backup); err != nil {
+			log.WithError(err).WithField("backup", backup).Error("Error deleting expired logs of deleted backup")
+			continue
+		}
+		log.WithField("backup", backup).Info("Deleted expired logs of deleted backup")
+	}
+}
+
+// backupLogsExpiration returns when the logs and results of the backup expire, which is only
+// the case when the backup logs TTL of its location is set.
+func backupLogsExpiration(location *velerov1api.BackupStorageLocation, backup *velerov1api.Backup) (time.Time, bool) {
+	if location.Spec.BackupLogsTTL == nil {
+		return time.Time{}, false
+	}
+
+	start := backup.CreationTimestamp.Time
+	if backup.Status.StartTimestamp != nil {
+		start = backup.Status.StartTimestamp.Time
+	}
+	return start.Add(location.Spec.BackupLogsTTL.Duration), true
+}
+
 func (b *backupSyncReconciler) deleteCSISnapshotsByBackup(ctx context.Context, backupName string, log logrus.FieldLogger) {
 	if !features.IsEnabled(velerov1api.CSIFeatureFlag) {
 		return
@@ -19,6 +19,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"testing"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -26,6 +27,8 @@ import (
 
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
 	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -161,6 +164,7 @@ var _ = Describe("Backup Sync Reconciler", func() {
 			backup               *velerov1api.Backup
 			podVolumeBackups     []*velerov1api.PodVolumeBackup
 			backupShouldSkipSync bool // backups waiting for plugin operations should not sync
+			artifactMismatches   []string
 		}
 
 		tests := []struct {
@@ -190,6 +194,22 @@ var _ = Describe("Backup Sync Reconciler", func() {
 					},
 				},
 			},
+			{
+				name:      "backups with files not matching their digests are synced as corrupted",
+				namespace: "ns-1",
+				location:  defaultLocation("ns-1"),
+				cloudBackups: []*cloudBackupData{
+					{
+						backup: builder.ForBackup("ns-1", "backup-1").
+							ArtifactDigests(map[string]string{"backup-1.tar.gz": "digest-1"}).Result(),
+					},
+					{
+						backup: builder.ForBackup("ns-1", "backup-2").
+							ArtifactDigests(map[string]string{"backup-2.tar.gz": "digest-2"}).Result(),
+						artifactMismatches: []string{"backup-2.tar.gz is missing"},
+					},
+				},
+			},
 			{
 				name:      "backups waiting for plugin operations aren't synced",
 				namespace: "ns-1",
@@ -432,8 +452,10 @@ var _ = Describe("Backup Sync Reconciler", func() {
 					backupStore.On("BackupExists", "bucket-1", backup.backup.Name).Return(true, nil)
 					backupStore.On("GetCSIVolumeSnapshotClasses", backup.backup.Name).Return([]*snapshotv1api.VolumeSnapshotClass{}, nil)
 					backupStore.On("GetCSIVolumeSnapshotContents", backup.backup.Name).Return([]*snapshotv1api.VolumeSnapshotContent{}, nil)
+					backupStore.On("VerifyBackupArtifacts", backup.backup.Name, backup.backup.Status.ArtifactDigests).Return(backup.artifactMismatches, nil)
 				}
 				backupStore.On("ListBackups").Return(backupNames, nil)
+				backupStore.On("ListRetainedBackupLogs").Return(map[string]time.Time{}, nil)
 			}
 
 			for _, existingBackup := range test.existingBackups {
@@ -497,6 +519,9 @@ var _ = Describe("Backup Sync Reconciler", func() {
 						}
 						Expect(locationName).To(BeEquivalentTo(obj.Labels[velerov1api.StorageLocationLabel]))
 						Expect(len(obj.Labels[velerov1api.StorageLocationLabel])).To(BeNumerically("<=", validation.DNS1035LabelMaxLength))
+
+						// verify that the backups whose files don't match their digests are flagged
+						Expect(meta.IsStatusConditionTrue(obj.Status.Conditions, velerov1api.ConditionTypeCorrupted)).To(Equal(len(cloudBackupData.artifactMismatches) > 0))
 					}
 				}
 
@@ -897,3 +922,41 @@ var _ = Describe("Backup Sync Reconciler", func() {
 		}
 	})
 })
+
+func TestExpireBackupLogs(t *testing.T) {
+	now := time.Now()
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").BackupLogsTTL(24 * time.Hour).Result()
+	backup := func(name string, phase velerov1api.BackupPhase, started time.Time) *velerov1api.Backup {
+		return builder.ForBackup(velerov1api.DefaultNamespace, name).
+			ObjectMeta(builder.WithLabels(velerov1api.StorageLocationLabel, "default")).
+			Phase(phase).StartTimestamp(started).Result()
+	}
+	client := velerotest.NewFakeControllerRuntimeClient(t,
+		backup("expired", velerov1api.BackupPhaseCompleted, now.Add(-25*time.Hour)),
+		backup("not-expired", velerov1api.BackupPhaseCompleted, now.Add(-time.Hour)),
+		backup("in-progress", velerov1api.BackupPhaseInProgress, now.Add(-25*time.Hour)),
+	)
+
+	backupStore := &persistencemocks.BackupStore{}
+	backupStore.On("DeleteBackupLogs", "expired").Return(nil)
+	backupStore.On("ListRetainedBackupLogs").Return(map[string]time.Time{
+		"deleted-expired":     now.Add(-time.Minute),
+		"deleted-not-expired": now.Add(time.Hour),
+	}, nil)
+	backupStore.On("DeleteRetainedBackupLogs", "deleted-expired").Return(nil)
+
+	r := &backupSyncReconciler{client: client, namespace: velerov1api.DefaultNamespace, logger: velerotest.NewLogger()}
+	r.expireBackupLogs(context.Background(), location, backupStore, r.logger)
+
+	backupStore.AssertExpectations(t)
+	backupStore.AssertNumberOfCalls(t, "DeleteBackupLogs", 1)
+	backupStore.AssertNumberOfCalls(t, "DeleteRetainedBackupLogs", 1)
+
+	expired := &velerov1api.Backup{}
+	require.NoError(t, client.Get(context.Background(), types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "expired"}, expired))
+	assert.True(t, meta.IsStatusConditionTrue(expired.Status.Conditions, velerov1api.ConditionTypeLogsExpired))
+
+	// the logs of a backup are deleted once
+	r.expireBackupLogs(context.Background(), location, backupStore, r.logger)
+	backupStore.AssertNumberOfCalls(t, "DeleteBackupLogs", 1)
+}
@@ -0,0 +1,151 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	clocks "k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/constant"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/util/conditions"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// backupVerificationReconciler verifies the files of the backups annotated with the verify
+// annotation against their signed manifests, and records the result in their Verified condition.
+type backupVerificationReconciler struct {
+	client            kbclient.Client
+	clock             clocks.Clock
+	newPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
+	backupStoreGetter persistence.ObjectBackupStoreGetter
+	logger            logrus.FieldLogger
+}
+
+// NewBackupVerificationReconciler initializes and returns backupVerificationReconciler struct.
+func NewBackupVerificationReconciler(
+	client kbclient.Client,
+	clock clocks.Clock,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	logger logrus.FieldLogger,
+) *backupVerificationReconciler {
+	return &backupVerificationReconciler{
+		client:            client,
+		clock:             clock,
+		newPluginManager:  newPluginManager,
+		backupStoreGetter: backupStoreGetter,
+		logger:            logger,
+	}
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=backups,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get
+
+func (r *backupVerificationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithFields(logrus.Fields{
+		"controller": constant.ControllerBackupVerification,
+		"backup":     req.NamespacedName,
+	})
+
+	backup := &velerov1api.Backup{}
+	if err := r.client.Get(ctx, req.NamespacedName, backup); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find Backup")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrap(err, "error getting backup")
+	}
+	if _, requested := backup.Annotations[velerov1api.VerifyBackupAnnotation]; !requested {
+		return ctrl.Result{}, nil
+	}
+
+	original := backup.DeepCopy()
+	r.verify(ctx, backup, log)
+	delete(backup.Annotations, velerov1api.VerifyBackupAnnotation)
+
+	if err := kube.PatchResource(original, backup, r.client); err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "error updating the verification of the backup")
+	}
+	return ctrl.Result{}, nil
+}
+
+// verify sets the Verified condition of the backup, and its Corrupted condition when its objects
+// don't match their signed manifests.
+func (r *backupVerificationReconciler) verify(ctx context.Context, backup *velerov1api.Backup, log logrus.FieldLogger) {
+	now := r.clock.Now()
+
+	location := &velerov1api.BackupStorageLocation{}
+	if err := r.client.Get(ctx, kbclient.ObjectKey{Namespace: backup.Namespace, Name: backup.Spec.StorageLocation}, location); err != nil {
+		conditions.SetBackupVerifiedCondition(backup, now, false, "VerificationError", fmt.Sprintf("error getting backup storage location %s: %v", backup.Spec.StorageLocation, err))
+		return
+	}
+
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		conditions.SetBackupVerifiedCondition(backup, now, false, "VerificationError", fmt.Sprintf("error getting the backup store: %v", err))
+		return
+	}
+
+	mismatches, err := backupStore.VerifyBackupSignatures(backup.Name)
+	switch {
+	case err == persistence.ErrSigningNotEnabled:
+		conditions.SetBackupVerifiedCondition(backup, now, false, "SigningNotEnabled", err.Error())
+	case err == persistence.ErrBackupNotSigned && backup.Status.Signed:
+		// the manifest may be deleted along with the files tampered with
+		message := "the backup was signed but its signed manifest is missing"
+		log.Warn(message)
+		conditions.SetBackupVerifiedCondition(backup, now, false, "ManifestMissing", message)
+		conditions.SetBackupCorruptedCondition(backup, now, message)
+	case err == persistence.ErrBackupNotSigned:
+		conditions.SetBackupVerifiedCondition(backup, now, false, "Unsigned", err.Error())
+	case err != nil:
+		conditions.SetBackupVerifiedCondition(backup, now, false, "VerificationError", err.Error())
+	case len(mismatches) > 0:
+		message := fmt.Sprintf("the files of the backup don't match its signed manifest: %s", strings.Join(mismatches, "; "))
+		log.Warn(message)
+		conditions.SetBackupVerifiedCondition(backup, now, false, "SignatureMismatch", message)
+		conditions.SetBackupCorruptedCondition(backup, now, message)
+	default:
+		conditions.SetBackupVerifiedCondition(backup, now, true, "SignaturesVerified", "the files of the backup match its signed manifest")
+	}
+}
+
+func (r *backupVerificationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&velerov1api.Backup{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(object kbclient.Object) bool {
+			_, requested := object.GetAnnotations()[velerov1api.VerifyBackupAnnotation]
+			return requested
+		}))).
+		Named(constant.ControllerBackupVerification).
+		Complete(r)
+}
@@ -0,0 +1,119 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclocks "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestBackupVerificationReconcile(t *testing.T) {
+	tests := []struct {
+		name              string
+		mismatches        []string
+		verifyErr         error
+		signed            bool
+		expectedVerified  metav1.ConditionStatus
+		expectedReason    string
+		expectedCorrupted bool
+	}{
+		{
+			name:             "backup matching its manifest is verified",
+			expectedVerified: metav1.ConditionTrue,
+			expectedReason:   "SignaturesVerified",
+		},
+		{
+			name:              "backup not matching its manifest is corrupted",
+			mismatches:        []string{"backup-1.tar.gz is missing"},
+			expectedVerified:  metav1.ConditionFalse,
+			expectedReason:    "SignatureMismatch",
+			expectedCorrupted: true,
+		},
+		{
+			name:             "backup without signatures is unsigned",
+			verifyErr:        persistence.ErrBackupNotSigned,
+			expectedVerified: metav1.ConditionFalse,
+			expectedReason:   "Unsigned",
+		},
+		{
+			name:              "signed backup whose manifest is deleted is corrupted",
+			verifyErr:         persistence.ErrBackupNotSigned,
+			signed:            true,
+			expectedVerified:  metav1.ConditionFalse,
+			expectedReason:    "ManifestMissing",
+			expectedCorrupted: true,
+		},
+		{
+			name:             "backup of a location which doesn't sign the backups",
+			verifyErr:        persistence.ErrSigningNotEnabled,
+			expectedVerified: metav1.ConditionFalse,
+			expectedReason:   "SigningNotEnabled",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Provider("myCloud").Bucket("bucket").Result()
+			backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").Signed(test.signed).
+				ObjectMeta(builder.WithAnnotations(velerov1api.VerifyBackupAnnotation, "true")).Result()
+			client := velerotest.NewFakeControllerRuntimeClient(t, location, backup)
+
+			backupStore := &persistencemocks.BackupStore{}
+			backupStore.On("VerifyBackupSignatures", "backup-1").Return(test.mismatches, test.verifyErr)
+			pluginManager := &pluginmocks.Manager{}
+			pluginManager.On("CleanupClients").Return(nil)
+
+			r := NewBackupVerificationReconciler(
+				client,
+				testclocks.NewFakeClock(time.Now()),
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeSingleObjectBackupStoreGetter(backupStore),
+				velerotest.NewLogger(),
+			)
+			_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: kbclient.ObjectKeyFromObject(backup)})
+			require.NoError(t, err)
+
+			updated := &velerov1api.Backup{}
+			require.NoError(t, client.Get(context.Background(), kbclient.ObjectKeyFromObject(backup), updated))
+			assert.NotContains(t, updated.Annotations, velerov1api.VerifyBackupAnnotation)
+
+			verified := meta.FindStatusCondition(updated.Status.Conditions, velerov1api.ConditionTypeVerified)
+			require.NotNil(t, verified)
+			assert.Equal(t, test.expectedVerified, verified.Status)
+			assert.Equal(t, test.expectedReason, verified.Reason)
+			assert.Equal(t, test.expectedCorrupted, meta.IsStatusConditionTrue(updated.Status.Conditions, velerov1api.ConditionTypeCorrupted))
+		})
+	}
+}
@@ -408,7 +408,11 @@ func (r *DataDownloadReconciler) OnDataDownloadCompleted(ctx context.Context, na
 		log.WithError(err).Error("error updating data download status")
 	} else {
 		log.Infof("Data download is marked as %s", dd.Status.Phase)
-		r.metrics.RegisterDataDownloadSuccess(r.nodeName)
+		labels := r.metricLabels(ctx, &dd)
+		r.metrics.RegisterDataDownloadSuccess(r.nodeName, labels)
+		if dd.Status.StartTimestamp != nil {
+			r.metrics.ObserveDataDownload(r.nodeName, labels, dd.Status.CompletionTimestamp.Sub(dd.Status.StartTimestamp.Time).Seconds(), result.Restore.TotalBytes)
+		}
 	}
 }
 
@@ -450,7 +454,7 @@ func (r *DataDownloadReconciler) OnDataDownloadCancelled(ctx context.Context, na
 		if err := r.client.Patch(ctx, &dd, client.MergeFrom(original)); err != nil {
 			log.WithError(err).Error("error updating data download status")
 		} else {
-			r.metrics.RegisterDataDownloadCancel(r.nodeName)
+			r.metrics.RegisterDataDownloadCancel(r.nodeName, r.metricLabels(ctx, &dd))
 		}
 	}
 }
@@ -480,7 +484,7 @@ func (r *DataDownloadReconciler) tryCancelAcceptedDataDownload(ctx context.Conte
 	}
 
 	// success update
-	r.metrics.RegisterDataDownloadCancel(r.nodeName)
+	r.metrics.RegisterDataDownloadCancel(r.nodeName, r.metricLabels(ctx, dd))
 	r.restoreExposer.CleanUp(ctx, getDataDownloadOwnerObject(dd))
 }
 
@@ -610,6 +614,16 @@ func (r *DataDownloadReconciler) prepareDataDownload(ssb *velerov2alpha1api.Data
 	ssb.Status.Node = r.nodeName
 }
 
+// metricLabels returns the labels of the metrics of the data download, by the storage class of the
+// restored PVC.
+func (r *DataDownloadReconciler) metricLabels(ctx context.Context, dd *velerov2alpha1api.DataDownload) metrics.DataMoverLabels {
+	pvc, err := r.kubeClient.CoreV1().PersistentVolumeClaims(dd.Spec.TargetVolume.Namespace).Get(ctx, dd.Spec.TargetVolume.PVC, metav1.GetOptions{})
+	if err != nil || pvc.Spec.StorageClassName == nil {
+		return metrics.DataMoverLabels{}
+	}
+	return dataMoverMetricLabels(ctx, r.kubeClient, *pvc.Spec.StorageClassName)
+}
+
 func (r *DataDownloadReconciler) errorOut(ctx context.Context, dd *velerov2alpha1api.DataDownload, err error, msg string, log logrus.FieldLogger) (ctrl.Result, error) {
 	if r.restoreExposer != nil {
 		r.restoreExposer.CleanUp(ctx, getDataDownloadOwnerObject(dd))
@@ -627,7 +641,7 @@ func (r *DataDownloadReconciler) updateStatusToFailed(ctx context.Context, dd *v
 	if patchErr := r.client.Patch(ctx, dd, client.MergeFrom(original)); patchErr != nil {
 		log.WithError(patchErr).Error("error updating DataDownload status")
 	} else {
-		r.metrics.RegisterDataDownloadFailure(r.nodeName)
+		r.metrics.RegisterDataDownloadFailure(r.nodeName, r.metricLabels(ctx, dd))
 	}
 
 	return err
@@ -691,7 +705,7 @@ func (r *DataDownloadReconciler) onPrepareTimeout(ctx context.Context, dd *veler
 
 	log.Info("Dataupload has been cleaned up")
 
-	r.metrics.RegisterDataDownloadFailure(r.nodeName)
+	r.metrics.RegisterDataDownloadFailure(r.nodeName, r.metricLabels(ctx, dd))
 }
 
 func (r *DataDownloadReconciler) exclusiveUpdateDataDownload(ctx context.Context, dd *velerov2alpha1api.DataDownload,
@@ -0,0 +1,41 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+)
+
+// dataMoverMetricLabels returns the labels of the data mover metrics of the storage class, whose
+// provisioner is the driver. The driver is left empty when the storage class can't be retrieved,
+// the metrics are only informational so the error isn't reported.
+func dataMoverMetricLabels(ctx context.Context, kubeClient kubernetes.Interface, storageClass string) metrics.DataMoverLabels {
+	labels := metrics.DataMoverLabels{StorageClass: storageClass}
+	if storageClass == "" {
+		return labels
+	}
+
+	if sc, err := kubeClient.StorageV1().StorageClasses().Get(ctx, storageClass, metav1.GetOptions{}); err == nil {
+		labels.Driver = sc.Provisioner
+	}
+	return labels
+}
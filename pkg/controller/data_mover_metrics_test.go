@@ -0,0 +1,40 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	storagev1api "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgofake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+)
+
+func TestDataMoverMetricLabels(t *testing.T) {
+	kubeClient := clientgofake.NewSimpleClientset(&storagev1api.StorageClass{
+		ObjectMeta:  metav1.ObjectMeta{Name: "fast"},
+		Provisioner: "ebs.csi.aws.com",
+	})
+
+	assert.Equal(t, metrics.DataMoverLabels{StorageClass: "fast", Driver: "ebs.csi.aws.com"}, dataMoverMetricLabels(context.Background(), kubeClient, "fast"))
+	assert.Equal(t, metrics.DataMoverLabels{StorageClass: "missing"}, dataMoverMetricLabels(context.Background(), kubeClient, "missing"))
+	assert.Equal(t, metrics.DataMoverLabels{}, dataMoverMetricLabels(context.Background(), kubeClient, ""))
+}
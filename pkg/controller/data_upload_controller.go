@@ -41,6 +41,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
@@ -50,8 +51,10 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/exposer"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/nodeagent"
+	"github.com/vmware-tanzu/velero/pkg/repository"
 	"github.com/vmware-tanzu/velero/pkg/uploader"
 	"github.com/vmware-tanzu/velero/pkg/util"
+	"github.com/vmware-tanzu/velero/pkg/util/conditions"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
@@ -59,6 +62,9 @@ const (
 	dataUploadDownloadRequestor = "snapshot-data-upload-download"
 	DataUploadDownloadFinalizer = "velero.io/data-upload-download-finalizer"
 	preparingMonitorFrequency   = time.Minute
+	// directSnapshotReadRequeueInterval is how often a data upload is reconciled while its snapshot
+	// isn't ready to be read directly.
+	directSnapshotReadRequeueInterval = 5 * time.Second
 )
 
 // DataUploadReconciler reconciles a DataUpload object
@@ -77,6 +83,9 @@ type DataUploadReconciler struct {
 	podResources        corev1.ResourceRequirements
 	preparingTimeout    time.Duration
 	metrics             *metrics.ServerMetrics
+	snapshotReaders     map[string]exposer.SnapshotBlockReader
+	repoEnsurer         *repository.Ensurer
+	credentialGetter    *credentials.CredentialGetter
 }
 
 func NewDataUploadReconciler(
@@ -88,6 +97,9 @@ func NewDataUploadReconciler(
 	loadAffinity *kube.LoadAffinity,
 	backupPVCConfig map[string]nodeagent.BackupPVC,
 	podResources corev1.ResourceRequirements,
+	snapshotReaders map[string]exposer.SnapshotBlockReader,
+	repoEnsurer *repository.Ensurer,
+	credentialGetter *credentials.CredentialGetter,
 	clock clocks.WithTickerAndDelayedExecution,
 	nodeName string,
 	preparingTimeout time.Duration,
@@ -115,6 +127,9 @@ func NewDataUploadReconciler(
 		podResources:     podResources,
 		preparingTimeout: preparingTimeout,
 		metrics:          metrics,
+		snapshotReaders:  snapshotReaders,
+		repoEnsurer:      repoEnsurer,
+		credentialGetter: credentialGetter,
 	}
 }
 
@@ -204,50 +219,13 @@ func (r *DataUploadReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			return ctrl.Result{}, nil
 		}
 
-		exposeParam, err := r.setupExposeParam(du)
-		if err != nil {
-			return r.errorOut(ctx, du, err, "failed to set exposer parameters", log)
-		}
-
-		// Expose() will trigger to create one pod whose volume is restored by a given volume snapshot,
-		// but the pod maybe is not in the same node of the current controller, so we need to return it here.
-		// And then only the controller who is in the same node could do the rest work.
-		if err := ep.Expose(ctx, getOwnerObject(du), exposeParam); err != nil {
-			if err := r.client.Get(ctx, req.NamespacedName, du); err != nil {
-				if !apierrors.IsNotFound(err) {
-					return ctrl.Result{}, errors.Wrap(err, "getting DataUpload")
-				}
-			}
-			if isDataUploadInFinalState(du) {
-				log.Warnf("expose snapshot with err %v but it may caused by clean up resources in cancel action", err)
-				r.cleanUp(ctx, du, log)
-				return ctrl.Result{}, nil
-			} else {
-				return r.errorOut(ctx, du, err, "error to expose snapshot", log)
-			}
-		}
-
-		log.Info("Snapshot is exposed")
-
-		// we need to get CR again for it may canceled by dataupload controller on other
-		// nodes when doing expose action, if detectd cancel action we need to clear up the internal
-		// resources created by velero during backup.
-		if err := r.client.Get(ctx, req.NamespacedName, du); err != nil {
-			if apierrors.IsNotFound(err) {
-				log.Debug("Unable to find DataUpload")
-				return ctrl.Result{}, nil
-			}
-			return ctrl.Result{}, errors.Wrap(err, "getting DataUpload")
-		}
-
-		// we need to clean up resources as resources created in Expose it may later than cancel action or prepare time
-		// and need to clean up resources again
-		if isDataUploadInFinalState(du) {
-			r.cleanUp(ctx, du, log)
+		return r.readOrExposeSnapshot(ctx, du, ep, req, log)
+	} else if du.Status.Phase == velerov2alpha1api.DataUploadPhaseAccepted {
+		if du.Status.WaitingForSnapshot && du.Status.AcceptedByNode == r.nodeName && !du.Spec.Cancel &&
+			(du.Status.AcceptedTimestamp == nil || time.Since(du.Status.AcceptedTimestamp.Time) < r.preparingTimeout) {
+			return r.readOrExposeSnapshot(ctx, du, ep, req, log)
 		}
 
-		return ctrl.Result{}, nil
-	} else if du.Status.Phase == velerov2alpha1api.DataUploadPhaseAccepted {
 		if du.Spec.Cancel {
 			// we don't want to update CR into cancel status forcely as it may conflict with CR update in Expose action
 			// we could retry when the CR requeue in periodcally
@@ -322,6 +300,8 @@ func (r *DataUploadReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		du.Status.Phase = velerov2alpha1api.DataUploadPhaseInProgress
 		du.Status.StartTimestamp = &metav1.Time{Time: r.Clock.Now()}
 		du.Status.NodeOS = velerov2alpha1api.NodeOS(*res.ByPod.NodeOS)
+		conditions.SetDataUploadConditions(du, r.Clock.Now())
+		conditions.SetObservedGeneration(original, du)
 		if err := r.client.Patch(ctx, du, client.MergeFrom(original)); err != nil {
 			log.WithError(err).Warnf("Failed to update dataupload %s to InProgress, will data path close and retry", du.Name)
 
@@ -357,6 +337,8 @@ func (r *DataUploadReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			// Update status to Canceling
 			original := du.DeepCopy()
 			du.Status.Phase = velerov2alpha1api.DataUploadPhaseCanceling
+			conditions.SetDataUploadConditions(du, r.Clock.Now())
+			conditions.SetObservedGeneration(original, du)
 			if err := r.client.Patch(ctx, du, client.MergeFrom(original)); err != nil {
 				log.WithError(err).Error("error updating data upload into canceling status")
 				return ctrl.Result{}, err
@@ -442,11 +424,17 @@ func (r *DataUploadReconciler) OnDataUploadCompleted(ctx context.Context, namesp
 		du.Status.Message = "volume was empty so no data was upload"
 	}
 
+	conditions.SetDataUploadConditions(&du, r.Clock.Now())
+	conditions.SetObservedGeneration(original, &du)
 	if err := r.client.Patch(ctx, &du, client.MergeFrom(original)); err != nil {
 		log.WithError(err).Error("error updating DataUpload status")
 	} else {
 		log.Info("Data upload completed")
-		r.metrics.RegisterDataUploadSuccess(r.nodeName)
+		labels := r.metricLabels(ctx, &du)
+		r.metrics.RegisterDataUploadSuccess(r.nodeName, labels)
+		if du.Status.StartTimestamp != nil {
+			r.metrics.ObserveDataUpload(r.nodeName, labels, du.Status.CompletionTimestamp.Sub(du.Status.StartTimestamp.Time).Seconds(), result.Backup.TotalBytes)
+		}
 	}
 }
 
@@ -484,10 +472,12 @@ func (r *DataUploadReconciler) OnDataUploadCancelled(ctx context.Context, namesp
 			du.Status.StartTimestamp = &metav1.Time{Time: r.Clock.Now()}
 		}
 		du.Status.CompletionTimestamp = &metav1.Time{Time: r.Clock.Now()}
+		conditions.SetDataUploadConditions(du, r.Clock.Now())
+		conditions.SetObservedGeneration(original, du)
 		if err := r.client.Patch(ctx, du, client.MergeFrom(original)); err != nil {
 			log.WithError(err).Error("error updating DataUpload status")
 		} else {
-			r.metrics.RegisterDataUploadCancel(r.nodeName)
+			r.metrics.RegisterDataUploadCancel(r.nodeName, r.metricLabels(ctx, du))
 		}
 	}
 }
@@ -516,11 +506,20 @@ func (r *DataUploadReconciler) tryCancelAcceptedDataUpload(ctx context.Context,
 	}
 
 	// success update
-	r.metrics.RegisterDataUploadCancel(r.nodeName)
+	r.metrics.RegisterDataUploadCancel(r.nodeName, r.metricLabels(ctx, du))
 	// cleans up any objects generated during the snapshot expose
 	r.cleanUp(ctx, du, log)
 }
 
+// metricLabels returns the labels of the metrics of the data upload, by the storage class of the
+// snapshotted PVC.
+func (r *DataUploadReconciler) metricLabels(ctx context.Context, du *velerov2alpha1api.DataUpload) metrics.DataMoverLabels {
+	if du.Spec.CSISnapshot == nil {
+		return metrics.DataMoverLabels{}
+	}
+	return dataMoverMetricLabels(ctx, r.kubeClient, du.Spec.CSISnapshot.StorageClass)
+}
+
 func (r *DataUploadReconciler) cleanUp(ctx context.Context, du *velerov2alpha1api.DataUpload, log logrus.FieldLogger) {
 	ep, ok := r.snapshotExposerList[du.Spec.SnapshotType]
 	if !ok {
@@ -546,6 +545,7 @@ func (r *DataUploadReconciler) OnDataUploadProgress(ctx context.Context, namespa
 
 	original := du.DeepCopy()
 	du.Status.Progress = shared.DataMoveOperationProgress{TotalBytes: progress.TotalBytes, BytesDone: progress.BytesDone}
+	conditions.SetObservedGeneration(original, &du)
 
 	if err := r.client.Patch(ctx, &du, client.MergeFrom(original)); err != nil {
 		log.WithError(err).Error("Failed to update progress")
@@ -689,15 +689,107 @@ func (r *DataUploadReconciler) updateStatusToFailed(ctx context.Context, du *vel
 		du.Status.SnapshotID = dataPathError.GetSnapshotID()
 	}
 	du.Status.CompletionTimestamp = &metav1.Time{Time: r.Clock.Now()}
+	conditions.SetDataUploadConditions(du, r.Clock.Now())
+	conditions.SetObservedGeneration(original, du)
 	if patchErr := r.client.Patch(ctx, du, client.MergeFrom(original)); patchErr != nil {
 		log.WithError(patchErr).Error("error updating DataUpload status")
 	} else {
-		r.metrics.RegisterDataUploadFailure(r.nodeName)
+		r.metrics.RegisterDataUploadFailure(r.nodeName, r.metricLabels(ctx, du))
 	}
 
 	return err
 }
 
+// readOrExposeSnapshot reads the snapshot of the accepted data upload directly if it can, or exposes it.
+// The snapshot is checked again later if it's not ready to tell whether it can be read directly.
+func (r *DataUploadReconciler) readOrExposeSnapshot(ctx context.Context, du *velerov2alpha1api.DataUpload, ep exposer.SnapshotExposer,
+	req ctrl.Request, log logrus.FieldLogger) (ctrl.Result, error) {
+	started, ready, err := r.startDirectSnapshotRead(ctx, du, log)
+	if !ready {
+		log.Debug("Volume snapshot is not ready yet")
+		if err := r.setWaitingForSnapshot(ctx, du, true, log); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: directSnapshotReadRequeueInterval}, nil
+	}
+
+	if err != nil {
+		return r.errorOut(ctx, du, err, "error to read snapshot directly", log)
+	} else if started {
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.setWaitingForSnapshot(ctx, du, false, log); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	exposeParam, err := r.setupExposeParam(du)
+	if err != nil {
+		return r.errorOut(ctx, du, err, "failed to set exposer parameters", log)
+	}
+
+	// Expose() will trigger to create one pod whose volume is restored by a given volume snapshot,
+	// but the pod maybe is not in the same node of the current controller, so we need to return it here.
+	// And then only the controller who is in the same node could do the rest work.
+	if err := ep.Expose(ctx, getOwnerObject(du), exposeParam); err != nil {
+		if err := r.client.Get(ctx, req.NamespacedName, du); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return ctrl.Result{}, errors.Wrap(err, "getting DataUpload")
+			}
+		}
+		if isDataUploadInFinalState(du) {
+			log.Warnf("expose snapshot with err %v but it may caused by clean up resources in cancel action", err)
+			r.cleanUp(ctx, du, log)
+			return ctrl.Result{}, nil
+		} else {
+			return r.errorOut(ctx, du, err, "error to expose snapshot", log)
+		}
+	}
+
+	log.Info("Snapshot is exposed")
+
+	// we need to get CR again for it may canceled by dataupload controller on other
+	// nodes when doing expose action, if detectd cancel action we need to clear up the internal
+	// resources created by velero during backup.
+	if err := r.client.Get(ctx, req.NamespacedName, du); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find DataUpload")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrap(err, "getting DataUpload")
+	}
+
+	// we need to clean up resources as resources created in Expose it may later than cancel action or prepare time
+	// and need to clean up resources again
+	if isDataUploadInFinalState(du) {
+		r.cleanUp(ctx, du, log)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// setWaitingForSnapshot records in the status whether the data upload is waiting for its snapshot to be ready,
+// so that the wait is resumed after the node-agent restarts.
+func (r *DataUploadReconciler) setWaitingForSnapshot(ctx context.Context, du *velerov2alpha1api.DataUpload, waiting bool, log logrus.FieldLogger) error {
+	if du.Status.WaitingForSnapshot == waiting {
+		return nil
+	}
+
+	if err := UpdateDataUploadWithRetry(ctx, r.client, types.NamespacedName{Namespace: du.Namespace, Name: du.Name}, log.WithField("dataupload", du.Name), func(dataUpload *velerov2alpha1api.DataUpload) bool {
+		if dataUpload.Status.WaitingForSnapshot == waiting {
+			return false
+		}
+
+		dataUpload.Status.WaitingForSnapshot = waiting
+		return true
+	}); err != nil {
+		return errors.Wrap(err, "error updating the snapshot waiting state of the data upload")
+	}
+
+	du.Status.WaitingForSnapshot = waiting
+	return nil
+}
+
 func (r *DataUploadReconciler) acceptDataUpload(ctx context.Context, du *velerov2alpha1api.DataUpload) (bool, error) {
 	r.logger.Infof("Accepting data upload %s", du.Name)
 
@@ -767,7 +859,7 @@ func (r *DataUploadReconciler) onPrepareTimeout(ctx context.Context, du *velerov
 		log.Info("Dataupload has been cleaned up")
 	}
 
-	r.metrics.RegisterDataUploadFailure(r.nodeName)
+	r.metrics.RegisterDataUploadFailure(r.nodeName, r.metricLabels(ctx, du))
 }
 
 func (r *DataUploadReconciler) exclusiveUpdateDataUpload(ctx context.Context, du *velerov2alpha1api.DataUpload,
@@ -915,7 +1007,10 @@ func UpdateDataUploadWithRetry(ctx context.Context, client client.Client, namesp
 			return false, errors.Wrap(err, "getting DataUpload")
 		}
 
+		original := du.DeepCopy()
 		if updateFunc(du) {
+			conditions.SetDataUploadConditions(du, time.Now())
+			conditions.SetObservedGeneration(original, du)
 			err := client.Update(ctx, du)
 			if err != nil {
 				if apierrors.IsConflict(err) {
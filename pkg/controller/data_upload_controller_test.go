@@ -244,6 +244,9 @@ func initDataUploaderReconcilerWithError(needError ...error) (*DataUploadReconci
 		nil,
 		map[string]nodeagent.BackupPVC{},
 		corev1.ResourceRequirements{},
+		nil,
+		nil,
+		nil,
 		testclocks.NewFakeClock(now),
 		"test-node",
 		time.Minute*5,
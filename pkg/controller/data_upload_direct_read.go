@@ -0,0 +1,172 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/datamover"
+	"github.com/vmware-tanzu/velero/pkg/datapath"
+	"github.com/vmware-tanzu/velero/pkg/exposer"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	"github.com/vmware-tanzu/velero/pkg/util/conditions"
+	"github.com/vmware-tanzu/velero/pkg/util/csi"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// findSnapshotBlockReader returns the reader and the snapshot handle if the snapshot of the data upload could be
+// read directly, that is, the source PVC is in block mode and a reader is configured for the CSI driver.
+// A nil reader means the snapshot should be exposed through the backupPVC and backupPod as usual. It doesn't wait
+// for the snapshot, false is returned if the snapshot isn't ready yet to tell whether it could be read directly.
+func (r *DataUploadReconciler) findSnapshotBlockReader(ctx context.Context, du *velerov2alpha1api.DataUpload, log logrus.FieldLogger) (exposer.SnapshotBlockReader, string, bool) {
+	if du.Spec.SnapshotType != velerov2alpha1api.SnapshotTypeCSI || len(r.snapshotReaders) == 0 {
+		return nil, "", true
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := r.client.Get(ctx, types.NamespacedName{Namespace: du.Spec.SourceNamespace, Name: du.Spec.SourcePVC}, pvc); err != nil {
+		log.WithError(err).Warnf("Failed to get PVC %s/%s, skip direct snapshot read", du.Spec.SourceNamespace, du.Spec.SourcePVC)
+		return nil, "", true
+	}
+
+	if pvc.Spec.VolumeMode == nil || *pvc.Spec.VolumeMode != corev1.PersistentVolumeBlock {
+		return nil, "", true
+	}
+
+	vs, err := r.csiSnapshotClient.VolumeSnapshots(du.Spec.SourceNamespace).Get(ctx, du.Spec.CSISnapshot.VolumeSnapshot, metav1.GetOptions{})
+	if err != nil {
+		log.WithError(err).Warn("Failed to get volume snapshot, skip direct snapshot read")
+		return nil, "", true
+	}
+
+	if vs.Status == nil || !boolptr.IsSetToTrue(vs.Status.ReadyToUse) {
+		return nil, "", false
+	}
+
+	vsc, err := csi.GetVolumeSnapshotContentForVolumeSnapshot(vs, r.csiSnapshotClient)
+	if err != nil {
+		log.WithError(err).Warn("Failed to get volume snapshot content, skip direct snapshot read")
+		return nil, "", true
+	}
+
+	reader, found := r.snapshotReaders[vsc.Spec.Driver]
+	if !found || vsc.Status == nil || vsc.Status.SnapshotHandle == nil {
+		return nil, "", true
+	}
+
+	return reader, *vsc.Status.SnapshotHandle, true
+}
+
+// startDirectSnapshotRead backs up the snapshot of the data upload by reading its block device from the current node
+// in the node-agent's own data path. It returns false if the direct read is not applicable or not available,
+// in which case the snapshot should be exposed as usual. ready is false if the snapshot isn't ready yet to tell.
+func (r *DataUploadReconciler) startDirectSnapshotRead(ctx context.Context, du *velerov2alpha1api.DataUpload, log logrus.FieldLogger) (started bool, ready bool, err error) {
+	reader, snapshotHandle, ready := r.findSnapshotBlockReader(ctx, du, log)
+	if reader == nil {
+		return false, ready, nil
+	}
+
+	devicePath, release, err := reader.Open(ctx, snapshotHandle)
+	if err != nil {
+		log.WithError(err).Warnf("Snapshot %s is not readable from node %s, fall back to expose it", snapshotHandle, r.nodeName)
+		return false, true, nil
+	}
+
+	log = log.WithField("device", devicePath)
+	log.Info("Snapshot is readable directly")
+
+	callbacks := datapath.Callbacks{
+		OnCompleted: func(ctx context.Context, namespace string, duName string, result datapath.Result) {
+			release()
+			r.OnDataUploadCompleted(ctx, namespace, duName, result)
+		},
+		OnFailed: func(ctx context.Context, namespace string, duName string, err error) {
+			release()
+			r.OnDataUploadFailed(ctx, namespace, duName, err)
+		},
+		OnCancelled: func(ctx context.Context, namespace string, duName string) {
+			release()
+			r.OnDataUploadCancelled(ctx, namespace, duName)
+		},
+		OnProgress: r.OnDataUploadProgress,
+	}
+
+	asyncBR, err := r.dataPathMgr.CreateFileSystemBR(du.Name, dataUploadDownloadRequestor, ctx, r.client, du.Namespace, callbacks, log)
+	if err != nil {
+		release()
+		log.WithError(err).Warn("Failed to create data path for direct snapshot read, fall back to expose the snapshot")
+		return false, true, nil
+	}
+
+	if err := asyncBR.Init(ctx, &datapath.FSBRInitParam{
+		BSLName:           du.Spec.BackupStorageLocation,
+		SourceNamespace:   du.Spec.SourceNamespace,
+		UploaderType:      datamover.GetUploaderType(du.Spec.DataMover),
+		RepositoryType:    velerov1api.BackupRepositoryTypeKopia,
+		RepoIdentifier:    "",
+		RepositoryEnsurer: r.repoEnsurer,
+		CredentialGetter:  r.credentialGetter,
+	}); err != nil {
+		r.closeDataPath(ctx, du.Name)
+		release()
+		return true, true, errors.Wrap(err, "error initializing data path")
+	}
+
+	original := du.DeepCopy()
+	du.Status.Phase = velerov2alpha1api.DataUploadPhaseInProgress
+	du.Status.Node = r.nodeName
+	du.Status.NodeOS = velerov2alpha1api.NodeOS(kube.NodeOSLinux)
+	du.Status.StartTimestamp = &metav1.Time{Time: r.Clock.Now()}
+	du.Status.WaitingForSnapshot = false
+	conditions.SetDataUploadConditions(du, r.Clock.Now())
+	conditions.SetObservedGeneration(original, du)
+	if err := r.client.Patch(ctx, du, client.MergeFrom(original)); err != nil {
+		r.closeDataPath(ctx, du.Name)
+		release()
+		return true, true, errors.Wrap(err, "error updating data upload to InProgress")
+	}
+
+	log.Info("Data upload is marked as in progress")
+
+	if err := asyncBR.StartBackup(datapath.AccessPoint{
+		ByPath:  devicePath,
+		VolMode: uploader.PersistentVolumeBlock,
+	}, du.Spec.DataMoverConfig, &datapath.FSBRStartParam{
+		RealSource:     datamover.GetRealSource(du.Spec.SourceNamespace, du.Spec.SourcePVC),
+		ParentSnapshot: "",
+		ForceFull:      false,
+		Tags:           datamover.GetSnapshotTags(du),
+	}); err != nil {
+		r.closeDataPath(ctx, du.Name)
+		release()
+		return true, true, errors.Wrapf(err, "error starting data path backup from device %s", devicePath)
+	}
+
+	log.Info("Direct snapshot read data path started")
+
+	return true, true, nil
+}
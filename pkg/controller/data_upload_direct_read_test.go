@@ -0,0 +1,222 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/datapath"
+	"github.com/vmware-tanzu/velero/pkg/exposer"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+type fakeSnapshotBlockReader struct {
+	devicePath string
+	openErr    error
+	opened     string
+	released   bool
+}
+
+func (f *fakeSnapshotBlockReader) Open(ctx context.Context, snapshotHandle string) (string, func(), error) {
+	if f.openErr != nil {
+		return "", nil, f.openErr
+	}
+
+	f.opened = snapshotHandle
+	return f.devicePath, func() { f.released = true }, nil
+}
+
+func TestStartDirectSnapshotRead(t *testing.T) {
+	blockMode := corev1.PersistentVolumeBlock
+
+	tests := []struct {
+		name            string
+		pvc             *corev1.PersistentVolumeClaim
+		driver          string
+		reader          *fakeSnapshotBlockReader
+		fsBR            *fakeDataUploadFSBR
+		notReady        bool
+		expectedStarted bool
+		expectedPhase   velerov2alpha1api.DataUploadPhase
+		expectedRelease bool
+		err             string
+	}{
+		{
+			name:          "filesystem mode PVC",
+			pvc:           builder.ForPersistentVolumeClaim("fake-ns", "test-pvc").Result(),
+			driver:        "fake-driver",
+			reader:        &fakeSnapshotBlockReader{devicePath: "/dev/fake"},
+			expectedPhase: velerov2alpha1api.DataUploadPhaseAccepted,
+		},
+		{
+			name:          "no reader for the driver",
+			pvc:           builder.ForPersistentVolumeClaim("fake-ns", "test-pvc").VolumeMode(blockMode).Result(),
+			driver:        "other-driver",
+			reader:        &fakeSnapshotBlockReader{devicePath: "/dev/fake"},
+			expectedPhase: velerov2alpha1api.DataUploadPhaseAccepted,
+		},
+		{
+			name:          "snapshot is not ready",
+			pvc:           builder.ForPersistentVolumeClaim("fake-ns", "test-pvc").VolumeMode(blockMode).Result(),
+			driver:        "fake-driver",
+			reader:        &fakeSnapshotBlockReader{devicePath: "/dev/fake"},
+			notReady:      true,
+			expectedPhase: velerov2alpha1api.DataUploadPhaseAccepted,
+		},
+		{
+			name:          "snapshot is not readable on the node",
+			pvc:           builder.ForPersistentVolumeClaim("fake-ns", "test-pvc").VolumeMode(blockMode).Result(),
+			driver:        "fake-driver",
+			reader:        &fakeSnapshotBlockReader{openErr: errors.New("fake-open-error")},
+			expectedPhase: velerov2alpha1api.DataUploadPhaseAccepted,
+		},
+		{
+			name:            "init data path fails",
+			pvc:             builder.ForPersistentVolumeClaim("fake-ns", "test-pvc").VolumeMode(blockMode).Result(),
+			driver:          "fake-driver",
+			reader:          &fakeSnapshotBlockReader{devicePath: "/dev/fake"},
+			fsBR:            &fakeDataUploadFSBR{initErr: errors.New("fake-init-error")},
+			expectedStarted: true,
+			expectedPhase:   velerov2alpha1api.DataUploadPhaseAccepted,
+			expectedRelease: true,
+			err:             "error initializing data path: fake-init-error",
+		},
+		{
+			name:            "start data path fails",
+			pvc:             builder.ForPersistentVolumeClaim("fake-ns", "test-pvc").VolumeMode(blockMode).Result(),
+			driver:          "fake-driver",
+			reader:          &fakeSnapshotBlockReader{devicePath: "/dev/fake"},
+			fsBR:            &fakeDataUploadFSBR{startErr: errors.New("fake-start-error")},
+			expectedStarted: true,
+			expectedPhase:   velerov2alpha1api.DataUploadPhaseInProgress,
+			expectedRelease: true,
+			err:             "error starting data path backup from device /dev/fake: fake-start-error",
+		},
+		{
+			name:            "succeed",
+			pvc:             builder.ForPersistentVolumeClaim("fake-ns", "test-pvc").VolumeMode(blockMode).Result(),
+			driver:          "fake-driver",
+			reader:          &fakeSnapshotBlockReader{devicePath: "/dev/fake"},
+			fsBR:            &fakeDataUploadFSBR{},
+			expectedStarted: true,
+			expectedPhase:   velerov2alpha1api.DataUploadPhaseInProgress,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			r, err := initDataUploaderReconciler()
+			require.NoError(t, err)
+
+			r.snapshotReaders = map[string]exposer.SnapshotBlockReader{"fake-driver": test.reader}
+
+			vsc, err := r.csiSnapshotClient.VolumeSnapshotContents().Get(ctx, "fake-vsc", metav1.GetOptions{})
+			require.NoError(t, err)
+			handle := "fake-snapshot-handle"
+			vsc.Spec.Driver = test.driver
+			vsc.Status.SnapshotHandle = &handle
+			_, err = r.csiSnapshotClient.VolumeSnapshotContents().Update(ctx, vsc, metav1.UpdateOptions{})
+			require.NoError(t, err)
+
+			if test.notReady {
+				vs, err := r.csiSnapshotClient.VolumeSnapshots("fake-ns").Get(ctx, "fake-volume-snapshot", metav1.GetOptions{})
+				require.NoError(t, err)
+				vs.Status.ReadyToUse = nil
+				_, err = r.csiSnapshotClient.VolumeSnapshots("fake-ns").Update(ctx, vs, metav1.UpdateOptions{})
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, r.client.Create(ctx, test.pvc))
+
+			du := dataUploadBuilder().Phase(velerov2alpha1api.DataUploadPhaseAccepted).Result()
+			require.NoError(t, r.client.Create(ctx, du))
+
+			datapath.FSBRCreator = func(string, string, kbclient.Client, string, datapath.Callbacks, logrus.FieldLogger) datapath.AsyncBR {
+				return test.fsBR
+			}
+
+			started, ready, err := r.startDirectSnapshotRead(ctx, du, velerotest.NewLogger())
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, test.expectedStarted, started)
+			assert.Equal(t, !test.notReady, ready)
+			assert.Equal(t, test.expectedRelease, test.reader.released)
+
+			current := &velerov2alpha1api.DataUpload{}
+			require.NoError(t, r.client.Get(ctx, kbclient.ObjectKey{Namespace: du.Namespace, Name: du.Name}, current))
+			assert.Equal(t, test.expectedPhase, current.Status.Phase)
+
+			if test.expectedStarted && test.err == "" {
+				assert.Equal(t, handle, test.reader.opened)
+				assert.Equal(t, r.nodeName, current.Status.Node)
+				assert.NotNil(t, r.dataPathMgr.GetAsyncBR(du.Name))
+			} else {
+				assert.Nil(t, r.dataPathMgr.GetAsyncBR(du.Name))
+			}
+		})
+	}
+}
+
+func TestReadOrExposeSnapshotNotReady(t *testing.T) {
+	ctx := context.Background()
+
+	r, err := initDataUploaderReconciler()
+	require.NoError(t, err)
+
+	r.snapshotReaders = map[string]exposer.SnapshotBlockReader{"fake-driver": &fakeSnapshotBlockReader{devicePath: "/dev/fake"}}
+
+	vs, err := r.csiSnapshotClient.VolumeSnapshots("fake-ns").Get(ctx, "fake-volume-snapshot", metav1.GetOptions{})
+	require.NoError(t, err)
+	vs.Status.ReadyToUse = nil
+	_, err = r.csiSnapshotClient.VolumeSnapshots("fake-ns").Update(ctx, vs, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	blockMode := corev1.PersistentVolumeBlock
+	require.NoError(t, r.client.Create(ctx, builder.ForPersistentVolumeClaim("fake-ns", "test-pvc").VolumeMode(blockMode).Result()))
+
+	du := dataUploadBuilder().Phase(velerov2alpha1api.DataUploadPhaseAccepted).Result()
+	require.NoError(t, r.client.Create(ctx, du))
+
+	// the reconcile doesn't wait for the snapshot, it's checked again later
+	result, err := r.readOrExposeSnapshot(ctx, du, nil, ctrl.Request{NamespacedName: kbclient.ObjectKeyFromObject(du)}, velerotest.NewLogger())
+	require.NoError(t, err)
+	assert.Equal(t, directSnapshotReadRequeueInterval, result.RequeueAfter)
+
+	// the waiting state is kept in the status so that it survives a restart
+	current := &velerov2alpha1api.DataUpload{}
+	require.NoError(t, r.client.Get(ctx, kbclient.ObjectKeyFromObject(du), current))
+	assert.True(t, current.Status.WaitingForSnapshot)
+	assert.Equal(t, velerov2alpha1api.DataUploadPhaseAccepted, current.Status.Phase)
+}
@@ -18,6 +18,7 @@ package controller
 
 import (
 	"context"
+	"encoding/base64"
 	"time"
 
 	"github.com/pkg/errors"
@@ -120,6 +121,8 @@ func (r *downloadRequestReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	// Process a brand new request.
 	if downloadRequest.Status.Phase == "" || downloadRequest.Status.Phase == velerov1api.DownloadRequestPhaseNew {
 		backupName := downloadRequest.Spec.Target.Name
+		// the files of a restore are in its staging storage location when it has one
+		var stagingLocation string
 		original := downloadRequest.DeepCopy()
 		defer func() {
 			// Always attempt to Patch the downloadRequest object and status for new DownloadRequest.
@@ -150,6 +153,7 @@ func (r *downloadRequestReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 				return ctrl.Result{}, errors.WithStack(err)
 			}
 			backupName = restore.Spec.BackupName
+			stagingLocation = restore.Spec.StagingStorageLocation
 		}
 
 		backup := &velerov1api.Backup{}
@@ -157,36 +161,58 @@ func (r *downloadRequestReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			Namespace: downloadRequest.Namespace,
 			Name:      backupName,
 		}, backup); err != nil {
-			if apierrors.IsNotFound(err) {
+			if !apierrors.IsNotFound(err) {
+				log.Warnf("fail to get backup for DownloadRequest %s. Retry later.", err.Error())
+				return ctrl.Result{}, errors.WithStack(err)
+			}
+			// the logs of a deleted backup may be kept in its location until they expire
+			if !isBackupLogsTarget(downloadRequest.Spec.Target.Kind) {
 				log.WithError(err).Error("fail to get backup for DownloadRequest")
 				return ctrl.Result{}, nil
 			}
-			log.Warnf("fail to get backup for DownloadRequest %s. Retry later.", err.Error())
-			return ctrl.Result{}, errors.WithStack(err)
+			backup = nil
 		}
 
-		location := &velerov1api.BackupStorageLocation{}
-		if err := r.client.Get(ctx, kbclient.ObjectKey{
-			Namespace: backup.Namespace,
-			Name:      backup.Spec.StorageLocation,
-		}, location); err != nil {
-			if apierrors.IsNotFound(err) {
-				log.Errorf("BSL for DownloadRequest cannot be found")
+		pluginManager := r.newPluginManager(log)
+		defer pluginManager.CleanupClients()
+
+		var backupStore persistence.BackupStore
+		if backup == nil {
+			var err error
+			if backupStore, err = r.retainedBackupLogsStore(ctx, downloadRequest.Namespace, backupName, pluginManager, log); err != nil {
+				log.Warnf("fail to find the BSL keeping the logs of the deleted backup for DownloadRequest %s. Retry later.", err.Error())
+				return ctrl.Result{}, errors.WithStack(err)
+			}
+			if backupStore == nil {
+				log.Error("fail to get backup for DownloadRequest, and no BSL keeps its logs")
 				return ctrl.Result{}, nil
 			}
-			log.Warnf("fail to get BSL for DownloadRequest: %s", err.Error())
-			return ctrl.Result{}, errors.WithStack(err)
-		}
+		} else {
+			locationName := backup.Spec.StorageLocation
+			if stagingLocation != "" {
+				locationName = stagingLocation
+			}
 
-		pluginManager := r.newPluginManager(log)
-		defer pluginManager.CleanupClients()
+			location := &velerov1api.BackupStorageLocation{}
+			if err := r.client.Get(ctx, kbclient.ObjectKey{
+				Namespace: backup.Namespace,
+				Name:      locationName,
+			}, location); err != nil {
+				if apierrors.IsNotFound(err) {
+					log.Errorf("BSL for DownloadRequest cannot be found")
+					return ctrl.Result{}, nil
+				}
+				log.Warnf("fail to get BSL for DownloadRequest: %s", err.Error())
+				return ctrl.Result{}, errors.WithStack(err)
+			}
 
-		backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
-		if err != nil {
-			log.WithError(err).Error("Error getting a backup store")
-			// Fail to get backup store is due to BSL setting issue or credential issue.
-			// It cannot be recovered. No need to retry.
-			return ctrl.Result{}, nil
+			var err error
+			if backupStore, err = r.backupStoreGetter.Get(location, pluginManager, log); err != nil {
+				log.WithError(err).Error("Error getting a backup store")
+				// Fail to get backup store is due to BSL setting issue or credential issue.
+				// It cannot be recovered. No need to retry.
+				return ctrl.Result{}, nil
+			}
 		}
 
 		// If this is a request for backup item operations, force upload of in-memory operations that
@@ -204,11 +230,24 @@ func (r *downloadRequestReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			_ = r.restoreItemOperationsMap.UpdateForRestore(backupStore, downloadRequest.Spec.Target.Name)
 		}
 
+		var err error
 		if downloadRequest.Status.DownloadURL, err = backupStore.GetDownloadURL(downloadRequest.Spec.Target); err != nil {
 			log.Warnf("fail to get Backup metadata file's download URL %s, retry later: %s", downloadRequest.Spec.Target, err)
 			return ctrl.Result{}, errors.WithStack(err)
 		}
 
+		// the file encrypted by the location is decrypted by the CLI with its data key, which
+		// only decrypts this file
+		dataKey, err := backupStore.GetDownloadDataKey(downloadRequest.Spec.Target)
+		if err != nil {
+			log.Warnf("fail to get the data key of the file %s, retry later: %s", downloadRequest.Spec.Target, err)
+			downloadRequest.Status.DownloadURL = ""
+			return ctrl.Result{}, errors.WithStack(err)
+		}
+		if dataKey != nil {
+			downloadRequest.Status.DataKey = base64.StdEncoding.EncodeToString(dataKey)
+		}
+
 		downloadRequest.Status.Phase = velerov1api.DownloadRequestPhaseProcessed
 
 		// Update the expiration again to extend the time we wait (the TTL) to start after successfully processing the URL.
@@ -218,6 +257,42 @@ func (r *downloadRequestReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	return ctrl.Result{}, nil
 }
 
+// isBackupLogsTarget returns whether the target is the log or results file of a backup, which are
+// kept in its location after the backup is deleted when the location sets backupLogsTTL.
+func isBackupLogsTarget(kind velerov1api.DownloadTargetKind) bool {
+	return kind == velerov1api.DownloadTargetKindBackupLog || kind == velerov1api.DownloadTargetKindBackupResults
+}
+
+// retainedBackupLogsStore returns the backup store of the location keeping the logs of the deleted
+// backup, nil if none keeps them.
+func (r *downloadRequestReconciler) retainedBackupLogsStore(ctx context.Context, namespace, backup string, pluginManager clientmgmt.Manager, log logrus.FieldLogger) (persistence.BackupStore, error) {
+	locations := &velerov1api.BackupStorageLocationList{}
+	if err := r.client.List(ctx, locations, kbclient.InNamespace(namespace)); err != nil {
+		return nil, errors.Wrap(err, "error listing the BSLs")
+	}
+
+	for i := range locations.Items {
+		location := &locations.Items[i]
+		if location.Spec.BackupLogsTTL == nil {
+			continue
+		}
+
+		backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
+		if err != nil {
+			log.WithError(err).Warnf("Error getting the backup store of BSL %s", location.Name)
+			continue
+		}
+		retained, err := backupStore.ListRetainedBackupLogs()
+		if err != nil {
+			return nil, errors.Wrapf(err, "error listing the backup logs kept in BSL %s", location.Name)
+		}
+		if _, ok := retained[backup]; ok {
+			return backupStore, nil
+		}
+	}
+	return nil, nil
+}
+
 func (r *downloadRequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	downloadRequestPredicate := kube.NewGenericEventPredicate(func(object kbclient.Object) bool {
 		downloadRequest := object.(*velerov1api.DownloadRequest)
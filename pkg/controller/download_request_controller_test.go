@@ -18,6 +18,7 @@ package controller
 
 import (
 	"context"
+	"encoding/base64"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -49,6 +50,8 @@ var _ = Describe("Download Request Reconciler", func() {
 		expired              bool
 		expectedReconcileErr string
 		expectGetsURL        bool
+		dataKey              []byte
+		retainedBackupLogs   map[string]time.Time
 		expectedRequeue      ctrl.Result
 	}
 
@@ -115,8 +118,13 @@ var _ = Describe("Download Request Reconciler", func() {
 				nil,
 			)
 
+			if test.backupLocation != nil && test.retainedBackupLogs != nil {
+				backupStores[test.backupLocation.Name].On("ListRetainedBackupLogs").Return(test.retainedBackupLogs, nil)
+			}
+
 			if test.backupLocation != nil && test.expectGetsURL {
 				backupStores[test.backupLocation.Name].On("GetDownloadURL", test.downloadRequest.Spec.Target).Return("a-url", nil)
+				backupStores[test.backupLocation.Name].On("GetDownloadDataKey", test.downloadRequest.Spec.Target).Return(test.dataKey, nil)
 			}
 
 			actualResult, err := r.Reconcile(context.Background(), ctrl.Request{
@@ -151,6 +159,11 @@ var _ = Describe("Download Request Reconciler", func() {
 			if test.expectGetsURL {
 				Expect(string(instance.Status.Phase)).To(Equal(string(velerov1api.DownloadRequestPhaseProcessed)))
 				Expect(instance.Status.DownloadURL).To(Equal("a-url"))
+				if test.dataKey != nil {
+					Expect(instance.Status.DataKey).To(Equal(base64.StdEncoding.EncodeToString(test.dataKey)))
+				} else {
+					Expect(instance.Status.DataKey).To(BeEmpty())
+				}
 				Expect(velerotest.TimesAreEqual(instance.Status.Expiration.Time, r.clock.Now().Add(signedURLTTL))).To(BeTrue())
 			}
 		},
@@ -191,6 +204,14 @@ var _ = Describe("Download Request Reconciler", func() {
 			expectGetsURL:   true,
 			expectedRequeue: ctrl.Result{},
 		}),
+		Entry("backup contents request for a file encrypted by the location gets a url and its data key", request{
+			downloadRequest: builder.ForDownloadRequest(velerov1api.DefaultNamespace, "a-download-request").Phase("").Target(velerov1api.DownloadTargetKindBackupContents, "a-backup").Result(),
+			backup:          defaultBackup(),
+			backupLocation:  builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "a-location").Provider("a-provider").Bucket("a-bucket").Result(),
+			expectGetsURL:   true,
+			dataKey:         []byte("a-data-key"),
+			expectedRequeue: ctrl.Result{},
+		}),
 		Entry("backup log request with phase '' gets a url", request{
 			downloadRequest: builder.ForDownloadRequest(velerov1api.DefaultNamespace, "a-download-request").Phase("").Target(velerov1api.DownloadTargetKindBackupLog, "a-backup").Result(),
 			backup:          defaultBackup(),
@@ -205,6 +226,19 @@ var _ = Describe("Download Request Reconciler", func() {
 			expectGetsURL:   true,
 			expectedRequeue: ctrl.Result{},
 		}),
+		Entry("backup log request for a deleted backup gets a url from the location keeping its logs", request{
+			downloadRequest:    builder.ForDownloadRequest(velerov1api.DefaultNamespace, "a-download-request").Phase("").Target(velerov1api.DownloadTargetKindBackupLog, "a-backup").Result(),
+			backupLocation:     builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "a-location").Provider("a-provider").Bucket("a-bucket").BackupLogsTTL(24 * time.Hour).Result(),
+			retainedBackupLogs: map[string]time.Time{"a-backup": time.Now().Add(time.Hour)},
+			expectGetsURL:      true,
+			expectedRequeue:    ctrl.Result{},
+		}),
+		Entry("backup log request for a deleted backup whose logs aren't kept returns nil", request{
+			downloadRequest:    builder.ForDownloadRequest(velerov1api.DefaultNamespace, "a-download-request").Phase("").Target(velerov1api.DownloadTargetKindBackupLog, "a-backup").Result(),
+			backupLocation:     builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "a-location").Provider("a-provider").Bucket("a-bucket").BackupLogsTTL(24 * time.Hour).Result(),
+			retainedBackupLogs: map[string]time.Time{"another-backup": time.Now().Add(time.Hour)},
+			expectedRequeue:    ctrl.Result{},
+		}),
 		Entry("restore log request with phase '' gets a url", request{
 			downloadRequest: builder.ForDownloadRequest(velerov1api.DefaultNamespace, "a-download-request").Phase("").Target(velerov1api.DownloadTargetKindRestoreLog, "a-backup-20170912150214").Result(),
 			restore:         builder.ForRestore(velerov1api.DefaultNamespace, "a-backup-20170912150214").Phase(velerov1api.RestorePhaseCompleted).Backup("a-backup").Result(),
@@ -221,6 +255,14 @@ var _ = Describe("Download Request Reconciler", func() {
 			expectGetsURL:   true,
 			expectedRequeue: ctrl.Result{},
 		}),
+		Entry("restore log request for restore with staging storage location gets a url from the staging location", request{
+			downloadRequest: builder.ForDownloadRequest(velerov1api.DefaultNamespace, "a-download-request").Phase("").Target(velerov1api.DownloadTargetKindRestoreLog, "a-backup-20170912150214").Result(),
+			restore:         builder.ForRestore(velerov1api.DefaultNamespace, "a-backup-20170912150214").Phase(velerov1api.RestorePhaseCompleted).Backup("a-backup").StagingStorageLocation("a-staging-location").Result(),
+			backup:          defaultBackup(),
+			backupLocation:  builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "a-staging-location").Provider("a-provider").Bucket("a-bucket").Result(),
+			expectGetsURL:   true,
+			expectedRequeue: ctrl.Result{},
+		}),
 		Entry("restore results request with phase '' gets a url", request{
 			downloadRequest: builder.ForDownloadRequest(velerov1api.DefaultNamespace, "a-download-request").Phase("").Target(velerov1api.DownloadTargetKindRestoreResults, "a-backup-20170912150214").Result(),
 			restore:         builder.ForRestore(velerov1api.DefaultNamespace, "a-backup-20170912150214").Phase(velerov1api.RestorePhaseCompleted).Backup("a-backup").Result(),
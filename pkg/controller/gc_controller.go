@@ -18,6 +18,7 @@ package controller
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -35,6 +36,8 @@ import (
 	veleroclient "github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/constant"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/util/conditions"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
@@ -44,6 +47,7 @@ const (
 	gcFailureBSLNotFound     = "BSLNotFound"
 	gcFailureBSLCannotGet    = "BSLCannotGet"
 	gcFailureBSLReadOnly     = "BSLReadOnly"
+	gcFailureImmutable       = "Immutable"
 )
 
 // gcReconciler creates DeleteBackupRequests for expired backups.
@@ -52,6 +56,9 @@ type gcReconciler struct {
 	logger    logrus.FieldLogger
 	clock     clocks.WithTickerAndDelayedExecution
 	frequency time.Duration
+
+	budgetLock   sync.Mutex
+	budgetUsages map[string]storageBudgetUsage
 }
 
 // NewGCReconciler constructs a new gcReconciler.
@@ -98,7 +105,10 @@ func (c *gcReconciler) SetupWithManager(mgr ctrl.Manager) error {
 // +kubebuilder:rbac:groups=velero.io,resources=backups/status,verbs=get
 // +kubebuilder:rbac:groups=velero.io,resources=deletebackuprequests,verbs=get;list;watch;create;
 // +kubebuilder:rbac:groups=velero.io,resources=deletebackuprequests/status,verbs=get
-// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=velero.io,resources=schedules,verbs=get;list;watch
+// +kubebuilder:rbac:groups=velero.io,resources=podvolumebackups,verbs=list;watch
+// +kubebuilder:rbac:groups=velero.io,resources=datauploads,verbs=list;watch
 
 func (c *gcReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := c.logger.WithField("gc backup", req.String())
@@ -113,6 +123,7 @@ func (c *gcReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Re
 		return ctrl.Result{}, errors.Wrapf(err, "error getting backup %s", req.String())
 	}
 	log.Debugf("backup: %s", backup.Name)
+	original := backup.DeepCopy()
 
 	log = c.logger.WithFields(
 		logrus.Fields{
@@ -122,13 +133,21 @@ func (c *gcReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Re
 	)
 
 	now := c.clock.Now()
+	var exceededBudget string
 	if backup.Status.Expiration == nil || backup.Status.Expiration.After(now) {
-		log.Debug("Backup has not expired yet, skipping")
-		return ctrl.Result{}, nil
+		var err error
+		if exceededBudget, err = c.exceededStorageBudget(ctx, backup); err != nil {
+			return ctrl.Result{}, err
+		}
+		if exceededBudget == "" {
+			log.Debug("Backup has not expired yet, skipping")
+			return ctrl.Result{}, nil
+		}
+		log.Infof("Backup:%s exceeds %s", backup.Name, exceededBudget)
+	} else {
+		log.Infof("Backup:%s has expired", backup.Name)
 	}
 
-	log.Infof("Backup:%s has expired", backup.Name)
-
 	if backup.Labels == nil {
 		backup.Labels = make(map[string]string)
 	}
@@ -159,12 +178,37 @@ func (c *gcReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Re
 		return ctrl.Result{}, nil
 	}
 
+	if persistence.IsImmutable(backup, now) {
+		log.Infof("Backup cannot be garbage-collected because it's immutable until %s", backup.Status.ImmutableUntil.UTC().Format(time.RFC3339))
+		backup.Labels[garbageCollectionFailure] = gcFailureImmutable
+		if err := c.Update(ctx, backup); err != nil {
+			log.WithError(err).Error("error updating backup labels")
+		}
+		return ctrl.Result{}, nil
+	}
+
 	// remove gc fail error label after this point
 	delete(backup.Labels, garbageCollectionFailure)
+	if exceededBudget != "" {
+		conditions.SetBackupStorageBudgetExceededCondition(backup, now, "Backup exceeds "+exceededBudget)
+	} else {
+		conditions.SetBackupExpiredCondition(backup, now)
+	}
+	conditions.SetObservedGeneration(original, backup)
 	if err := c.Update(ctx, backup); err != nil {
-		log.WithError(err).Error("error updating backup labels")
+		log.WithError(err).Error("error updating backup labels and conditions")
+	}
+
+	if err := requestBackupDeletion(ctx, c.Client, backup, log); err != nil {
+		return ctrl.Result{}, err
 	}
 
+	return ctrl.Result{}, nil
+}
+
+// requestBackupDeletion creates a DeleteBackupRequest for the backup, unless it already has a
+// pending one.
+func requestBackupDeletion(ctx context.Context, c client.Client, backup *velerov1api.Backup, log logrus.FieldLogger) error {
 	selector := client.MatchingLabels{
 		velerov1api.BackupNameLabel: label.GetValidName(backup.Name),
 		velerov1api.BackupUIDLabel:  string(backup.UID),
@@ -173,7 +217,7 @@ func (c *gcReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Re
 	dbrs := &velerov1api.DeleteBackupRequestList{}
 	if err := c.List(ctx, dbrs, selector); err != nil {
 		log.WithError(err).Error("error listing DeleteBackupRequests")
-		return ctrl.Result{}, errors.Wrap(err, "error listing existing DeleteBackupRequests for backup")
+		return errors.Wrap(err, "error listing existing DeleteBackupRequests for backup")
 	}
 	log.Debugf("length of dbrs:%d", len(dbrs.Items))
 
@@ -183,7 +227,7 @@ func (c *gcReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Re
 		switch dbr.Status.Phase {
 		case "", velerov1api.DeleteBackupRequestPhaseNew, velerov1api.DeleteBackupRequestPhaseInProgress:
 			log.Info("Backup already has a pending deletion request")
-			return ctrl.Result{}, nil
+			return nil
 		}
 	}
 
@@ -192,8 +236,8 @@ func (c *gcReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Re
 	ndbr.SetNamespace(backup.Namespace)
 	if err := veleroclient.CreateRetryGenerateName(c, ctx, ndbr); err != nil {
 		log.WithError(err).Error("error creating DeleteBackupRequests")
-		return ctrl.Result{}, errors.Wrap(err, "error creating DeleteBackupRequest")
+		return errors.Wrap(err, "error creating DeleteBackupRequest")
 	}
 
-	return ctrl.Result{}, nil
+	return nil
 }
@@ -22,6 +22,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -29,6 +31,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	veleroapishared "github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
@@ -145,3 +148,208 @@ func TestGCReconcile(t *testing.T) {
 		})
 	}
 }
+
+func TestGCReconcileStorageBudget(t *testing.T) {
+	fakeClock := testclocks.NewFakeClock(time.Now())
+
+	// backup-1 is the oldest and backup-3 the newest backup, each of them stores 60 bytes
+	backup := func(name string, age time.Duration, location string) *velerov1api.Backup {
+		return builder.ForBackup(velerov1api.DefaultNamespace, name).
+			ObjectMeta(
+				builder.WithLabels(velerov1api.ScheduleNameLabel, "daily"),
+				builder.WithCreationTimestamp(fakeClock.Now().Add(-age)),
+			).
+			StorageLocation(location).
+			Expiration(fakeClock.Now().Add(time.Hour)).
+			CompletionTimestamp(fakeClock.Now().Add(-age)).
+			Phase(velerov1api.BackupPhaseCompleted).
+			Result()
+	}
+	pvb := func(backupName string) *velerov1api.PodVolumeBackup {
+		pvb := builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, backupName+"-pvb").
+			ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, backupName)).Result()
+		pvb.Status.Progress.TotalBytes = 60
+		return pvb
+	}
+	budget := resource.MustParse("100")
+
+	tests := []struct {
+		name           string
+		schedule       *velerov1api.Schedule
+		location       *velerov1api.BackupStorageLocation
+		backupLocation string
+		expectDeleted  []string
+	}{
+		{
+			name:           "no budget",
+			schedule:       builder.ForSchedule(velerov1api.DefaultNamespace, "daily").Result(),
+			location:       builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result(),
+			backupLocation: "default",
+		},
+		{
+			name: "schedule budget exceeded",
+			schedule: func() *velerov1api.Schedule {
+				schedule := builder.ForSchedule(velerov1api.DefaultNamespace, "daily").Result()
+				schedule.Spec.StorageBudget = &budget
+				return schedule
+			}(),
+			location:       builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result(),
+			backupLocation: "default",
+			expectDeleted:  []string{"backup-1", "backup-2"},
+		},
+		{
+			name:     "location budget exceeded",
+			schedule: builder.ForSchedule(velerov1api.DefaultNamespace, "daily").Result(),
+			location: func() *velerov1api.BackupStorageLocation {
+				location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result()
+				location.Spec.StorageBudget = &budget
+				return location
+			}(),
+			backupLocation: "default",
+			expectDeleted:  []string{"backup-1", "backup-2"},
+		},
+		{
+			name:     "budget of another location",
+			schedule: builder.ForSchedule(velerov1api.DefaultNamespace, "daily").Result(),
+			location: func() *velerov1api.BackupStorageLocation {
+				location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result()
+				location.Spec.StorageBudget = &budget
+				return location
+			}(),
+			backupLocation: "other",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			initObjs := []runtime.Object{test.schedule, test.location}
+			for i, name := range []string{"backup-1", "backup-2", "backup-3"} {
+				initObjs = append(initObjs, backup(name, time.Duration(3-i)*time.Hour, test.backupLocation), pvb(name))
+			}
+			fakeClient := velerotest.NewFakeControllerRuntimeClient(t, initObjs...)
+			reconciler := mockGCReconciler(fakeClient, fakeClock, defaultGCFrequency)
+
+			for _, name := range []string{"backup-1", "backup-2", "backup-3"} {
+				_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: name}})
+				require.NoError(t, err)
+			}
+
+			dbrs := &velerov1api.DeleteBackupRequestList{}
+			require.NoError(t, fakeClient.List(context.TODO(), dbrs))
+			var deleted []string
+			for _, dbr := range dbrs.Items {
+				deleted = append(deleted, dbr.Spec.BackupName)
+			}
+			assert.ElementsMatch(t, test.expectDeleted, deleted)
+		})
+	}
+}
+
+func TestGCReconcileImmutableBackup(t *testing.T) {
+	fakeClock := testclocks.NewFakeClock(time.Now())
+
+	// the expiration of the backup was brought forward after its objects were locked
+	backup := defaultBackup().
+		StorageLocation("default").
+		Expiration(fakeClock.Now().Add(-time.Minute)).
+		ImmutableUntil(fakeClock.Now().Add(time.Hour)).
+		Result()
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").ObjectLock(velerov1api.ObjectLockModeCompliance).Result()
+
+	fakeClient := velerotest.NewFakeControllerRuntimeClient(t, backup, location)
+	reconciler := mockGCReconciler(fakeClient, fakeClock, defaultGCFrequency)
+	_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}})
+	require.NoError(t, err)
+
+	dbrs := &velerov1api.DeleteBackupRequestList{}
+	require.NoError(t, fakeClient.List(context.TODO(), dbrs))
+	assert.Empty(t, dbrs.Items)
+
+	require.NoError(t, fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}, backup))
+	assert.Equal(t, gcFailureImmutable, backup.Labels[garbageCollectionFailure])
+}
+
+func TestBackupLogicalBytes(t *testing.T) {
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Result()
+	pvb := builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "backup-1-pvb").
+		ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "backup-1")).Result()
+	pvb.Status.Progress = veleroapishared.DataMoveOperationProgress{TotalBytes: 60, BytesDone: 60}
+	du := builder.ForDataUpload(velerov1api.DefaultNamespace, "backup-1-du").
+		Labels(map[string]string{velerov1api.BackupNameLabel: "backup-1"}).Result()
+	// the data mover only uploaded the bytes changed since the last backup of the volume
+	du.Status.Progress = veleroapishared.DataMoveOperationProgress{TotalBytes: 100, BytesDone: 10}
+	otherPVB := builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "backup-2-pvb").
+		ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "backup-2")).Result()
+	otherPVB.Status.Progress.TotalBytes = 1000
+
+	tests := []struct {
+		name     string
+		objs     []runtime.Object
+		expected int64
+	}{
+		{
+			name:     "the volume data is counted as full backups",
+			objs:     []runtime.Object{backup, pvb, du, otherPVB},
+			expected: 160,
+		},
+		{
+			name:     "the data mover volumes of a synced backup aren't counted",
+			objs:     []runtime.Object{backup, pvb, otherPVB},
+			expected: 60,
+		},
+		{
+			name: "a backup without volume data is counted as empty",
+			objs: []runtime.Object{backup, otherPVB},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reconciler := mockGCReconciler(velerotest.NewFakeControllerRuntimeClient(t, test.objs...), testclocks.NewFakeClock(time.Now()), defaultGCFrequency)
+			bytes, err := reconciler.backupLogicalBytes(context.TODO(), backup)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, bytes)
+		})
+	}
+}
+
+func TestStorageBudgetExceededCache(t *testing.T) {
+	fakeClock := testclocks.NewFakeClock(time.Now())
+
+	backup := func(name string, age time.Duration) *velerov1api.Backup {
+		return builder.ForBackup(velerov1api.DefaultNamespace, name).
+			ObjectMeta(builder.WithCreationTimestamp(fakeClock.Now().Add(-age))).
+			CompletionTimestamp(fakeClock.Now().Add(-age)).
+			Phase(velerov1api.BackupPhaseCompleted).
+			Result()
+	}
+	pvb := func(backupName string, bytes int64) *velerov1api.PodVolumeBackup {
+		pvb := builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, backupName+"-pvb").
+			ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, backupName)).Result()
+		pvb.Status.Progress.TotalBytes = bytes
+		return pvb
+	}
+	budget := storageBudget{
+		key:     "location/velero/default/100",
+		budget:  resource.MustParse("100"),
+		applies: func(*velerov1api.Backup) bool { return true },
+	}
+
+	fakeClient := velerotest.NewFakeControllerRuntimeClient(t, backup("backup-1", 2*time.Hour), pvb("backup-1", 60), backup("backup-2", time.Hour))
+	reconciler := mockGCReconciler(fakeClient, fakeClock, defaultGCFrequency)
+
+	exceeded, err := reconciler.storageBudgetExceeded(context.TODO(), velerov1api.DefaultNamespace, budget)
+	require.NoError(t, err)
+	assert.Empty(t, exceeded)
+
+	// the cached result is used until it expires
+	require.NoError(t, fakeClient.Create(context.TODO(), pvb("backup-2", 60)))
+	exceeded, err = reconciler.storageBudgetExceeded(context.TODO(), velerov1api.DefaultNamespace, budget)
+	require.NoError(t, err)
+	assert.Empty(t, exceeded)
+
+	fakeClock.Step(storageBudgetUsageTTL)
+	exceeded, err = reconciler.storageBudgetExceeded(context.TODO(), velerov1api.DefaultNamespace, budget)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]struct{}{"backup-1": {}}, exceeded)
+}
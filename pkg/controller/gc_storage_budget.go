@@ -0,0 +1,207 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/label"
+)
+
+// storageBudgetUsageTTL is how long the backups exceeding a storage budget are reused for, so
+// the backups a budget applies to are summed up once per GC run rather than once per backup.
+const storageBudgetUsageTTL = time.Minute
+
+// storageBudget is the storage budget of a schedule or of a backup storage location, along with
+// the filter of the backups it applies to.
+type storageBudget struct {
+	// key identifies the budget in the cache of the backups exceeding it.
+	key         string
+	description string
+	budget      resource.Quantity
+	applies     func(*velerov1api.Backup) bool
+}
+
+// storageBudgetUsage is the backups exceeding a storage budget as of a point in time.
+type storageBudgetUsage struct {
+	computedAt time.Time
+	exceeded   map[string]struct{}
+}
+
+// exceededStorageBudget returns the description of the storage budget the backup exceeds, or an
+// empty string if it doesn't exceed any.
+func (c *gcReconciler) exceededStorageBudget(ctx context.Context, backup *velerov1api.Backup) (string, error) {
+	if backup.Status.CompletionTimestamp == nil || backup.Status.Phase == velerov1api.BackupPhaseDeleting {
+		return "", nil
+	}
+
+	budgets, err := c.storageBudgets(ctx, backup)
+	if err != nil || len(budgets) == 0 {
+		return "", err
+	}
+
+	for _, budget := range budgets {
+		exceeded, err := c.storageBudgetExceeded(ctx, backup.Namespace, budget)
+		if err != nil {
+			return "", err
+		}
+		if _, ok := exceeded[backup.Name]; ok {
+			return budget.description, nil
+		}
+	}
+	return "", nil
+}
+
+// storageBudgetExceeded returns the names of the backups exceeding the budget. The backups the
+// budget applies to are summed up from the newest to the oldest one, and the ones past the budget
+// exceed it. The newest backup never exceeds a budget. The result is cached per budget for
+// storageBudgetUsageTTL.
+func (c *gcReconciler) storageBudgetExceeded(ctx context.Context, namespace string, budget storageBudget) (map[string]struct{}, error) {
+	c.budgetLock.Lock()
+	defer c.budgetLock.Unlock()
+
+	now := c.clock.Now()
+	if usage, ok := c.budgetUsages[budget.key]; ok && now.Sub(usage.computedAt) < storageBudgetUsageTTL {
+		return usage.exceeded, nil
+	}
+
+	backupList := &velerov1api.BackupList{}
+	if err := c.List(ctx, backupList, client.InNamespace(namespace)); err != nil {
+		return nil, errors.Wrap(err, "error listing backups")
+	}
+	backups := backupList.Items
+	sort.Slice(backups, func(i, j int) bool {
+		if !backups[i].CreationTimestamp.Equal(&backups[j].CreationTimestamp) {
+			return backups[j].CreationTimestamp.Before(&backups[i].CreationTimestamp)
+		}
+		return backups[i].Name > backups[j].Name
+	})
+
+	exceeded := make(map[string]struct{})
+	var used int64
+	newest := true
+	for i := range backups {
+		b := &backups[i]
+		if b.Status.CompletionTimestamp == nil || b.Status.Phase == velerov1api.BackupPhaseDeleting || !budget.applies(b) {
+			continue
+		}
+		size, err := c.backupLogicalBytes(ctx, b)
+		if err != nil {
+			return nil, err
+		}
+		used += size
+		if !newest && used > budget.budget.Value() {
+			exceeded[b.Name] = struct{}{}
+		}
+		newest = false
+	}
+
+	if c.budgetUsages == nil {
+		c.budgetUsages = make(map[string]storageBudgetUsage)
+	}
+	for key, usage := range c.budgetUsages {
+		if now.Sub(usage.computedAt) >= storageBudgetUsageTTL {
+			delete(c.budgetUsages, key)
+		}
+	}
+	c.budgetUsages[budget.key] = storageBudgetUsage{computedAt: now, exceeded: exceeded}
+	return exceeded, nil
+}
+
+// storageBudgets returns the storage budgets of the schedule and of the storage location of
+// the backup.
+func (c *gcReconciler) storageBudgets(ctx context.Context, backup *velerov1api.Backup) ([]storageBudget, error) {
+	var budgets []storageBudget
+
+	if scheduleName := backup.Labels[velerov1api.ScheduleNameLabel]; scheduleName != "" {
+		schedule := &velerov1api.Schedule{}
+		err := c.Get(ctx, client.ObjectKey{Namespace: backup.Namespace, Name: scheduleName}, schedule)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, errors.Wrapf(err, "error getting schedule %s", scheduleName)
+		}
+		if err == nil && schedule.Spec.StorageBudget != nil {
+			budgets = append(budgets, storageBudget{
+				key:         fmt.Sprintf("schedule/%s/%s/%s", backup.Namespace, scheduleName, schedule.Spec.StorageBudget.String()),
+				description: fmt.Sprintf("the storage budget %s of schedule %s", schedule.Spec.StorageBudget.String(), scheduleName),
+				budget:      *schedule.Spec.StorageBudget,
+				applies: func(b *velerov1api.Backup) bool {
+					return b.Labels[velerov1api.ScheduleNameLabel] == scheduleName
+				},
+			})
+		}
+	}
+
+	location := &velerov1api.BackupStorageLocation{}
+	err := c.Get(ctx, client.ObjectKey{Namespace: backup.Namespace, Name: backup.Spec.StorageLocation}, location)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, errors.Wrapf(err, "error getting backup storage location %s", backup.Spec.StorageLocation)
+	}
+	if err == nil && location.Spec.StorageBudget != nil {
+		budgets = append(budgets, storageBudget{
+			key:         fmt.Sprintf("location/%s/%s/%s", backup.Namespace, location.Name, location.Spec.StorageBudget.String()),
+			description: fmt.Sprintf("the storage budget %s of backup storage location %s", location.Spec.StorageBudget.String(), location.Name),
+			budget:      *location.Spec.StorageBudget,
+			applies: func(b *velerov1api.Backup) bool {
+				return b.Spec.StorageLocation == location.Name
+			},
+		})
+	}
+
+	return budgets, nil
+}
+
+// backupLogicalBytes returns the logical bytes of the volume data the backup stored through the
+// file system backup and the data mover, that is the bytes before the repositories deduplicate
+// and compress them. It's an approximation of the storage the backup uses in the object store:
+//   - an incremental backup is counted as a full one, as the repositories don't report the bytes
+//     each snapshot adds, so the usage of the backups of unchanged volumes is overestimated
+//   - the backup tarball and the other metadata of the backup aren't counted, being small next to
+//     the volume data, nor are the snapshots kept by the storage providers
+//   - the data mover volumes of a backup synced from the location aren't counted as its
+//     DataUploads aren't synced, while its pod volume backups are
+func (c *gcReconciler) backupLogicalBytes(ctx context.Context, backup *velerov1api.Backup) (int64, error) {
+	selector := client.MatchingLabels{velerov1api.BackupNameLabel: label.GetValidName(backup.Name)}
+
+	var bytes int64
+	pvbs := &velerov1api.PodVolumeBackupList{}
+	if err := c.List(ctx, pvbs, client.InNamespace(backup.Namespace), selector); err != nil {
+		return 0, errors.Wrapf(err, "error listing pod volume backups of backup %s", backup.Name)
+	}
+	for _, pvb := range pvbs.Items {
+		bytes += pvb.Status.Progress.TotalBytes
+	}
+
+	dus := &velerov2alpha1api.DataUploadList{}
+	if err := c.List(ctx, dus, client.InNamespace(backup.Namespace), selector); err != nil {
+		return 0, errors.Wrapf(err, "error listing data uploads of backup %s", backup.Name)
+	}
+	for _, du := range dus.Items {
+		bytes += du.Status.Progress.TotalBytes
+	}
+
+	return bytes, nil
+}
@@ -29,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	clocks "k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -39,6 +40,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/datapath"
 	"github.com/vmware-tanzu/velero/pkg/exposer"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
 	"github.com/vmware-tanzu/velero/pkg/podvolume"
 	"github.com/vmware-tanzu/velero/pkg/repository"
 	"github.com/vmware-tanzu/velero/pkg/uploader"
@@ -48,10 +50,12 @@ import (
 const pVBRRequestor string = "pod-volume-backup-restore"
 
 // NewPodVolumeBackupReconciler creates the PodVolumeBackupReconciler instance
-func NewPodVolumeBackupReconciler(client client.Client, dataPathMgr *datapath.Manager, ensurer *repository.Ensurer, credentialGetter *credentials.CredentialGetter,
-	nodeName string, scheme *runtime.Scheme, metrics *metrics.ServerMetrics, logger logrus.FieldLogger) *PodVolumeBackupReconciler {
+func NewPodVolumeBackupReconciler(client client.Client, kubeClient kubernetes.Interface, dataPathMgr *datapath.Manager, ensurer *repository.Ensurer, credentialGetter *credentials.CredentialGetter,
+	nodeName string, podVolumePaths []nodeagent.PodVolumePath, scheme *runtime.Scheme, metrics *metrics.ServerMetrics, logger logrus.FieldLogger) *PodVolumeBackupReconciler {
 	return &PodVolumeBackupReconciler{
 		Client:            client,
+		kubeClient:        kubeClient,
+		podVolumePaths:    podVolumePaths,
 		logger:            logger.WithField("controller", "PodVolumeBackup"),
 		repositoryEnsurer: ensurer,
 		credentialGetter:  credentialGetter,
@@ -67,6 +71,8 @@ func NewPodVolumeBackupReconciler(client client.Client, dataPathMgr *datapath.Ma
 // PodVolumeBackupReconciler reconciles a PodVolumeBackup object
 type PodVolumeBackupReconciler struct {
 	client.Client
+	kubeClient        kubernetes.Interface
+	podVolumePaths    []nodeagent.PodVolumePath
 	scheme            *runtime.Scheme
 	clock             clocks.WithTickerAndDelayedExecution
 	metrics           *metrics.ServerMetrics
@@ -134,17 +140,6 @@ func (r *PodVolumeBackupReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		}
 	}
 
-	r.metrics.RegisterPodVolumeBackupEnqueue(r.nodeName)
-
-	// Update status to InProgress.
-	original := pvb.DeepCopy()
-	pvb.Status.Phase = velerov1api.PodVolumeBackupPhaseInProgress
-	pvb.Status.StartTimestamp = &metav1.Time{Time: r.clock.Now()}
-	if err := r.Client.Patch(ctx, &pvb, client.MergeFrom(original)); err != nil {
-		r.closeDataPath(ctx, pvb.Name)
-		return r.errorOut(ctx, &pvb, err, "error updating PodVolumeBackup status", log)
-	}
-
 	var pod corev1.Pod
 	podNamespacedName := client.ObjectKey{
 		Namespace: pvb.Spec.Pod.Namespace,
@@ -155,12 +150,38 @@ func (r *PodVolumeBackupReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return r.errorOut(ctx, &pvb, err, fmt.Sprintf("getting pod %s/%s", pvb.Spec.Pod.Namespace, pvb.Spec.Pod.Name), log)
 	}
 
-	path, err := exposer.GetPodVolumeHostPath(ctx, &pod, pvb.Spec.Volume, r.Client, r.fileSystem, log)
+	owner := corev1.ObjectReference{
+		APIVersion: velerov1api.SchemeGroupVersion.String(),
+		Kind:       "PodVolumeBackup",
+		Namespace:  pvb.Namespace,
+		Name:       pvb.Name,
+		UID:        pvb.UID,
+	}
+	path, err := exposer.GetPodVolumePath(ctx, &pod, pvb.Spec.Volume, owner, r.podVolumePaths, r.Client, r.kubeClient, r.fileSystem, log)
+	if err == exposer.ErrPodVolumePVCNotReady {
+		// the PVB stays new, so it's exposed again once the pod mounting the dedicated PVC runs
+		log.Debug("Waiting for the dedicated PVC to be mounted")
+		r.closeDataPath(ctx, pvb.Name)
+		return ctrl.Result{Requeue: true, RequeueAfter: time.Second * 5}, nil
+	}
 	if err != nil {
 		r.closeDataPath(ctx, pvb.Name)
+		r.cleanUpPodVolumePVC(ctx, pvb.Namespace, pvb.Name)
 		return r.errorOut(ctx, &pvb, err, "error exposing host path for pod volume", log)
 	}
 
+	r.metrics.RegisterPodVolumeBackupEnqueue(r.nodeName)
+
+	// Update status to InProgress.
+	original := pvb.DeepCopy()
+	pvb.Status.Phase = velerov1api.PodVolumeBackupPhaseInProgress
+	pvb.Status.StartTimestamp = &metav1.Time{Time: r.clock.Now()}
+	if err := r.Client.Patch(ctx, &pvb, client.MergeFrom(original)); err != nil {
+		r.closeDataPath(ctx, pvb.Name)
+		r.cleanUpPodVolumePVC(ctx, pvb.Namespace, pvb.Name)
+		return r.errorOut(ctx, &pvb, err, "error updating PodVolumeBackup status", log)
+	}
+
 	log.WithField("path", path.ByPath).Debugf("Found host path")
 
 	if err := fsBackup.Init(ctx, &datapath.FSBRInitParam{
@@ -173,6 +194,7 @@ func (r *PodVolumeBackupReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		CredentialGetter:  r.credentialGetter,
 	}); err != nil {
 		r.closeDataPath(ctx, pvb.Name)
+		r.cleanUpPodVolumePVC(ctx, pvb.Namespace, pvb.Name)
 		return r.errorOut(ctx, &pvb, err, "error to initialize data path", log)
 	}
 
@@ -198,6 +220,7 @@ func (r *PodVolumeBackupReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		Tags:           pvb.Spec.Tags,
 	}); err != nil {
 		r.closeDataPath(ctx, pvb.Name)
+		r.cleanUpPodVolumePVC(ctx, pvb.Namespace, pvb.Name)
 		return r.errorOut(ctx, &pvb, err, "error starting data path backup", log)
 	}
 
@@ -208,6 +231,7 @@ func (r *PodVolumeBackupReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 
 func (r *PodVolumeBackupReconciler) OnDataPathCompleted(ctx context.Context, namespace string, pvbName string, result datapath.Result) {
 	defer r.dataPathMgr.RemoveAsyncBR(pvbName)
+	defer r.cleanUpPodVolumePVC(ctx, namespace, pvbName)
 
 	log := r.logger.WithField("pvb", pvbName)
 
@@ -246,6 +270,7 @@ func (r *PodVolumeBackupReconciler) OnDataPathCompleted(ctx context.Context, nam
 
 func (r *PodVolumeBackupReconciler) OnDataPathFailed(ctx context.Context, namespace, pvbName string, err error) {
 	defer r.dataPathMgr.RemoveAsyncBR(pvbName)
+	defer r.cleanUpPodVolumePVC(ctx, namespace, pvbName)
 
 	log := r.logger.WithField("pvb", pvbName)
 
@@ -261,6 +286,7 @@ func (r *PodVolumeBackupReconciler) OnDataPathFailed(ctx context.Context, namesp
 
 func (r *PodVolumeBackupReconciler) OnDataPathCancelled(ctx context.Context, namespace string, pvbName string) {
 	defer r.dataPathMgr.RemoveAsyncBR(pvbName)
+	defer r.cleanUpPodVolumePVC(ctx, namespace, pvbName)
 
 	log := r.logger.WithField("pvb", pvbName)
 
@@ -365,6 +391,13 @@ func (r *PodVolumeBackupReconciler) closeDataPath(ctx context.Context, pvbName s
 	r.dataPathMgr.RemoveAsyncBR(pvbName)
 }
 
+// cleanUpPodVolumePVC deletes the dedicated PVC through which the data path may have accessed the pod volume
+func (r *PodVolumeBackupReconciler) cleanUpPodVolumePVC(ctx context.Context, namespace string, pvbName string) {
+	if len(r.podVolumePaths) > 0 {
+		exposer.CleanUpPodVolumePVC(ctx, r.kubeClient, namespace, pvbName, r.logger.WithField("pvb", pvbName))
+	}
+}
+
 func (r *PodVolumeBackupReconciler) errorOut(ctx context.Context, pvb *velerov1api.PodVolumeBackup, err error, msg string, log logrus.FieldLogger) (ctrl.Result, error) {
 	_ = UpdatePVBStatusToFailed(ctx, r.Client, pvb, err, msg, r.clock.Now(), log)
 
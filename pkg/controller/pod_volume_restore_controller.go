@@ -30,6 +30,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	clocks "k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -41,6 +42,7 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/datapath"
 	"github.com/vmware-tanzu/velero/pkg/exposer"
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
 	"github.com/vmware-tanzu/velero/pkg/podvolume"
 	"github.com/vmware-tanzu/velero/pkg/repository"
 	"github.com/vmware-tanzu/velero/pkg/restorehelper"
@@ -49,10 +51,12 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 )
 
-func NewPodVolumeRestoreReconciler(client client.Client, dataPathMgr *datapath.Manager, ensurer *repository.Ensurer,
-	credentialGetter *credentials.CredentialGetter, logger logrus.FieldLogger) *PodVolumeRestoreReconciler {
+func NewPodVolumeRestoreReconciler(client client.Client, kubeClient kubernetes.Interface, dataPathMgr *datapath.Manager, ensurer *repository.Ensurer,
+	credentialGetter *credentials.CredentialGetter, podVolumePaths []nodeagent.PodVolumePath, logger logrus.FieldLogger) *PodVolumeRestoreReconciler {
 	return &PodVolumeRestoreReconciler{
 		Client:            client,
+		kubeClient:        kubeClient,
+		podVolumePaths:    podVolumePaths,
 		logger:            logger.WithField("controller", "PodVolumeRestore"),
 		repositoryEnsurer: ensurer,
 		credentialGetter:  credentialGetter,
@@ -64,6 +68,8 @@ func NewPodVolumeRestoreReconciler(client client.Client, dataPathMgr *datapath.M
 
 type PodVolumeRestoreReconciler struct {
 	client.Client
+	kubeClient        kubernetes.Interface
+	podVolumePaths    []nodeagent.PodVolumePath
 	logger            logrus.FieldLogger
 	repositoryEnsurer *repository.Ensurer
 	credentialGetter  *credentials.CredentialGetter
@@ -127,20 +133,35 @@ func (c *PodVolumeRestoreReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		}
 	}
 
+	owner := corev1api.ObjectReference{
+		APIVersion: velerov1api.SchemeGroupVersion.String(),
+		Kind:       "PodVolumeRestore",
+		Namespace:  pvr.Namespace,
+		Name:       pvr.Name,
+		UID:        pvr.UID,
+	}
+	volumePath, err := exposer.GetPodVolumePath(ctx, pod, pvr.Spec.Volume, owner, c.podVolumePaths, c.Client, c.kubeClient, c.fileSystem, log)
+	if err == exposer.ErrPodVolumePVCNotReady {
+		// the PVR stays new, so it's exposed again once the pod mounting the dedicated PVC runs
+		log.Debug("Waiting for the dedicated PVC to be mounted")
+		c.closeDataPath(ctx, pvr.Name)
+		return ctrl.Result{Requeue: true, RequeueAfter: time.Second * 5}, nil
+	}
+	if err != nil {
+		c.closeDataPath(ctx, pvr.Name)
+		c.cleanUpPodVolumePVC(ctx, pvr.Namespace, pvr.Name)
+		return c.errorOut(ctx, pvr, err, "error exposing host path for pod volume", log)
+	}
+
 	original := pvr.DeepCopy()
 	pvr.Status.Phase = velerov1api.PodVolumeRestorePhaseInProgress
 	pvr.Status.StartTimestamp = &metav1.Time{Time: c.clock.Now()}
 	if err = c.Patch(ctx, pvr, client.MergeFrom(original)); err != nil {
 		c.closeDataPath(ctx, pvr.Name)
+		c.cleanUpPodVolumePVC(ctx, pvr.Namespace, pvr.Name)
 		return c.errorOut(ctx, pvr, err, "error to update status to in progress", log)
 	}
 
-	volumePath, err := exposer.GetPodVolumeHostPath(ctx, pod, pvr.Spec.Volume, c.Client, c.fileSystem, log)
-	if err != nil {
-		c.closeDataPath(ctx, pvr.Name)
-		return c.errorOut(ctx, pvr, err, "error exposing host path for pod volume", log)
-	}
-
 	log.WithField("path", volumePath.ByPath).Debugf("Found host path")
 
 	if err := fsRestore.Init(ctx, &datapath.FSBRInitParam{
@@ -153,11 +174,13 @@ func (c *PodVolumeRestoreReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		CredentialGetter:  c.credentialGetter,
 	}); err != nil {
 		c.closeDataPath(ctx, pvr.Name)
+		c.cleanUpPodVolumePVC(ctx, pvr.Namespace, pvr.Name)
 		return c.errorOut(ctx, pvr, err, "error to initialize data path", log)
 	}
 
 	if err := fsRestore.StartRestore(pvr.Spec.SnapshotID, volumePath, pvr.Spec.UploaderSettings); err != nil {
 		c.closeDataPath(ctx, pvr.Name)
+		c.cleanUpPodVolumePVC(ctx, pvr.Namespace, pvr.Name)
 		return c.errorOut(ctx, pvr, err, "error starting data path restore", log)
 	}
 
@@ -269,6 +292,7 @@ func getInitContainerIndex(pod *corev1api.Pod) int {
 
 func (c *PodVolumeRestoreReconciler) OnDataPathCompleted(ctx context.Context, namespace string, pvrName string, result datapath.Result) {
 	defer c.dataPathMgr.RemoveAsyncBR(pvrName)
+	defer c.cleanUpPodVolumePVC(ctx, namespace, pvrName)
 
 	log := c.logger.WithField("pvr", pvrName)
 
@@ -329,6 +353,7 @@ func (c *PodVolumeRestoreReconciler) OnDataPathCompleted(ctx context.Context, na
 
 func (c *PodVolumeRestoreReconciler) OnDataPathFailed(ctx context.Context, namespace string, pvrName string, err error) {
 	defer c.dataPathMgr.RemoveAsyncBR(pvrName)
+	defer c.cleanUpPodVolumePVC(ctx, namespace, pvrName)
 
 	log := c.logger.WithField("pvr", pvrName)
 
@@ -344,6 +369,7 @@ func (c *PodVolumeRestoreReconciler) OnDataPathFailed(ctx context.Context, names
 
 func (c *PodVolumeRestoreReconciler) OnDataPathCancelled(ctx context.Context, namespace string, pvrName string) {
 	defer c.dataPathMgr.RemoveAsyncBR(pvrName)
+	defer c.cleanUpPodVolumePVC(ctx, namespace, pvrName)
 
 	log := c.logger.WithField("pvr", pvrName)
 
@@ -374,6 +400,13 @@ func (c *PodVolumeRestoreReconciler) OnDataPathProgress(ctx context.Context, nam
 	}
 }
 
+// cleanUpPodVolumePVC deletes the dedicated PVC through which the data path may have accessed the pod volume
+func (c *PodVolumeRestoreReconciler) cleanUpPodVolumePVC(ctx context.Context, namespace string, pvrName string) {
+	if len(c.podVolumePaths) > 0 {
+		exposer.CleanUpPodVolumePVC(ctx, c.kubeClient, namespace, pvrName, c.logger.WithField("pvr", pvrName))
+	}
+}
+
 func (c *PodVolumeRestoreReconciler) closeDataPath(ctx context.Context, pvbName string) {
 	fsRestore := c.dataPathMgr.GetAsyncBR(pvbName)
 	if fsRestore != nil {
@@ -32,6 +32,7 @@ import (
 	"github.com/sirupsen/logrus"
 	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
@@ -43,18 +44,26 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"github.com/vmware-tanzu/velero/internal/hook"
+	"github.com/vmware-tanzu/velero/internal/namespacereferences"
 	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
+	"github.com/vmware-tanzu/velero/internal/storage"
 	"github.com/vmware-tanzu/velero/internal/volume"
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/constant"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/itemquarantine"
 	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/targetcluster"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	uploaderutil "github.com/vmware-tanzu/velero/pkg/uploader/util"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	"github.com/vmware-tanzu/velero/pkg/util/conditions"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 	"github.com/vmware-tanzu/velero/pkg/util/results"
@@ -111,6 +120,7 @@ type restoreReconciler struct {
 	backupStoreGetter persistence.ObjectBackupStoreGetter
 	globalCrClient    client.Client
 	resourceTimeout   time.Duration
+	targetClusters    targetcluster.Getter
 }
 
 type backupInfo struct {
@@ -133,6 +143,7 @@ func NewRestoreReconciler(
 	disableInformerCache bool,
 	globalCrClient client.Client,
 	resourceTimeout time.Duration,
+	targetClusters targetcluster.Getter,
 ) *restoreReconciler {
 	r := &restoreReconciler{
 		ctx:                         ctx,
@@ -154,6 +165,7 @@ func NewRestoreReconciler(
 
 		globalCrClient:  globalCrClient,
 		resourceTimeout: resourceTimeout,
+		targetClusters:  targetClusters,
 	}
 
 	// Move the periodical backup and restore metrics computing logic from controllers to here.
@@ -188,6 +200,11 @@ func (r *restoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	if !restore.DeletionTimestamp.IsZero() {
 		// check the finalizer and run clean-up
 		if controllerutil.ContainsFinalizer(restore, ExternalResourcesFinalizer) {
+			// the restore may be deleted before it's done, so its namespaces may still be protected
+			if err := pkgrestore.RemoveNamespaceProtection(ctx, restoreClusterClient(ctx, r.targetClusters, restore, r.kbClient, log), restore); err != nil {
+				log.Errorf("fail to remove the protection of the restored namespaces: %s", err.Error())
+				return ctrl.Result{}, err
+			}
 			if err := r.deleteExternalResources(restore); err != nil {
 				log.Errorf("fail to delete external resources: %s", err.Error())
 				return ctrl.Result{}, err
@@ -231,7 +248,7 @@ func (r *restoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	original := restore.DeepCopy()
 
 	// Validate the restore and fetch the backup
-	info, resourceModifiers := r.validateAndComplete(restore)
+	info, resourceModifiers, namespaceReferenceRules := r.validateAndComplete(restore)
 
 	// Register attempts after validation so we don't have to fetch the backup multiple times
 	backupScheduleName := restore.Spec.ScheduleName
@@ -249,6 +266,9 @@ func (r *restoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		restore.Spec.ItemOperationTimeout.Duration = r.defaultItemOperationTimeout
 	}
 
+	conditions.SetRestoreConditions(restore, r.clock.Now())
+	conditions.SetObservedGeneration(original, restore)
+
 	// patch to update status and persist to API
 	// This is patching from "" or New, no retry needed
 	err = kubeutil.PatchResource(original, restore, r.kbClient)
@@ -266,7 +286,7 @@ func (r *restoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return ctrl.Result{}, nil
 	}
 
-	if err := r.runValidatedRestore(restore, info, resourceModifiers); err != nil {
+	if err := r.runValidatedRestore(restore, info, resourceModifiers, namespaceReferenceRules); err != nil {
 		log.WithError(err).Debug("Restore failed")
 		restore.Status.Phase = api.RestorePhaseFailed
 		restore.Status.FailureReason = err.Error()
@@ -278,6 +298,9 @@ func (r *restoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		restore.Status.Phase == api.RestorePhasePartiallyFailed ||
 		restore.Status.Phase == api.RestorePhaseCompleted {
 		restore.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+		if err := pkgrestore.RemoveNamespaceProtection(ctx, restoreClusterClient(ctx, r.targetClusters, restore, r.kbClient, log), restore); err != nil {
+			log.WithError(err).Warn("Error removing the protection of the restored namespaces")
+		}
 	}
 	log.Debug("Updating restore's status")
 	// Phases were updated in runValidatedRestore
@@ -286,6 +309,8 @@ func (r *restoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	// WaitingForPluginOperationsPartiallyFailed
 	// Finalizing
 	// FinalizingPartiallyFailed
+	conditions.SetRestoreConditions(restore, r.clock.Now())
+	conditions.SetObservedGeneration(original, restore)
 	if err = kubeutil.PatchResourceWithRetriesOnErrors(r.resourceTimeout, original, restore, r.kbClient); err != nil {
 		log.WithError(errors.WithStack(err)).Infof("Error updating restore's status from %v to %v", original.Status.Phase, restore.Status.Phase)
 		// No need to re-enqueue here, because restore's already set to InProgress before.
@@ -302,7 +327,7 @@ func (r *restoreReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Complete(r)
 }
 
-func (r *restoreReconciler) validateAndComplete(restore *api.Restore) (backupInfo, *resourcemodifiers.ResourceModifiers) {
+func (r *restoreReconciler) validateAndComplete(restore *api.Restore) (backupInfo, *resourcemodifiers.ResourceModifiers, *namespacereferences.Rules) {
 	// add non-restorable resources to restore's excluded resources
 	excludedResources := sets.NewString(restore.Spec.ExcludedResources...)
 	for _, nonrestorable := range nonRestorableResources {
@@ -337,7 +362,7 @@ func (r *restoreReconciler) validateAndComplete(restore *api.Restore) (backupInf
 	// validate that exactly one of BackupName and ScheduleName have been specified
 	if !backupXorScheduleProvided(restore) {
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "Either a backup or schedule must be specified as a source for the restore, but not both")
-		return backupInfo{}, nil
+		return backupInfo{}, nil, nil
 	}
 
 	// validate Restore Init Hook's InitContainers
@@ -363,6 +388,53 @@ func (r *restoreReconciler) validateAndComplete(restore *api.Restore) (backupInf
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid ExistingResourcePolicy: %s", restore.Spec.ExistingResourcePolicy))
 	}
 
+	if err := pkgrestore.ValidateNamespacePriority(restore.Spec.NamespacePriority); err != nil {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, err.Error())
+	}
+
+	for _, err := range pkgrestore.ValidateDependencyWaitConditions(restore.Spec.DependencyWaitConditions) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid dependency wait conditions: %v", err))
+	}
+
+	for _, err := range pkgrestore.ValidateIncludedItems(restore.Spec.IncludedItems) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid included items: %v", err))
+	}
+
+	// the conflicting workloads are only scaled down when they're overwritten
+	if boolptr.IsSetToTrue(restore.Spec.ScaleDownConflictingWorkloads) && restore.Spec.ExistingResourcePolicy != api.PolicyTypeUpdate {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("ScaleDownConflictingWorkloads requires the ExistingResourcePolicy %s", api.PolicyTypeUpdate))
+	}
+
+	if restore.Spec.NamespaceReferenceRules != nil && !boolptr.IsSetToTrue(restore.Spec.RewriteNamespaceReferences) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "NamespaceReferenceRules requires RewriteNamespaceReferences")
+	}
+
+	if boolptr.IsSetToTrue(restore.Spec.Preview) && boolptr.IsSetToTrue(restore.Spec.ServerDryRun) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "Either Preview or ServerDryRun can be set, but not both")
+	}
+
+	if restore.Spec.UploaderConfig != nil {
+		if err := uploaderutil.ValidateIDMapping(restore.Spec.UploaderConfig.UIDMapping); err != nil {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid UIDMapping: %v", err))
+		}
+		if err := uploaderutil.ValidateIDMapping(restore.Spec.UploaderConfig.GIDMapping); err != nil {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid GIDMapping: %v", err))
+		}
+	}
+
+	// the target cluster is restored into in place of the cluster the server runs in
+	if restore.Spec.TargetCluster != "" {
+		if cluster, err := getTargetCluster(r.ctx, r.targetClusters, restore.Spec.TargetCluster); err != nil {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Error getting target cluster: %v", err))
+		} else {
+			restore.Status.TargetCluster = cluster.Status()
+			if restore.Labels == nil {
+				restore.Labels = make(map[string]string)
+			}
+			restore.Labels[api.TargetClusterLabel] = label.GetValidName(restore.Spec.TargetCluster)
+		}
+	}
+
 	// if ScheduleName is specified, fill in BackupName with the most recent successful backup from
 	// the schedule
 	if restore.Spec.ScheduleName != "" {
@@ -373,7 +445,7 @@ func (r *restoreReconciler) validateAndComplete(restore *api.Restore) (backupInf
 		backupList := &api.BackupList{}
 		if err := r.kbClient.List(context.Background(), backupList, &client.ListOptions{LabelSelector: selector}); err != nil {
 			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "Unable to list backups for schedule")
-			return backupInfo{}, nil
+			return backupInfo{}, nil, nil
 		}
 		if len(backupList.Items) == 0 {
 			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "No backups found for schedule")
@@ -383,14 +455,29 @@ func (r *restoreReconciler) validateAndComplete(restore *api.Restore) (backupInf
 			restore.Spec.BackupName = backup.Name
 		} else {
 			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "No completed backups found for schedule")
-			return backupInfo{}, nil
+			return backupInfo{}, nil, nil
 		}
 	}
 
 	info, err := r.fetchBackupInfo(restore.Spec.BackupName)
 	if err != nil {
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Error retrieving backup: %v", err))
-		return backupInfo{}, nil
+		return backupInfo{}, nil, nil
+	}
+
+	if corrupted := meta.FindStatusCondition(info.backup.Status.Conditions, api.ConditionTypeCorrupted); corrupted != nil && corrupted.Status == metav1.ConditionTrue {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Backup %s is corrupted: %s", info.backup.Name, corrupted.Message))
+		return backupInfo{}, nil, nil
+	}
+
+	if err := r.completeStagingStorageLocation(restore, info); err != nil {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, err.Error())
+		return backupInfo{}, nil, nil
+	}
+
+	if err := r.validateIDMappingUploaders(restore); err != nil {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, err.Error())
+		return backupInfo{}, nil, nil
 	}
 
 	// Fill in the ScheduleName so it's easier to consume for metrics.
@@ -404,20 +491,69 @@ func (r *restoreReconciler) validateAndComplete(restore *api.Restore) (backupInf
 		err := r.kbClient.Get(context.Background(), client.ObjectKey{Namespace: restore.Namespace, Name: restore.Spec.ResourceModifier.Name}, ResourceModifierConfigMap)
 		if err != nil {
 			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("failed to get resource modifiers configmap %s/%s", restore.Namespace, restore.Spec.ResourceModifier.Name))
-			return backupInfo{}, nil
+			return backupInfo{}, nil, nil
 		}
 		resourceModifiers, err = resourcemodifiers.GetResourceModifiersFromConfig(ResourceModifierConfigMap)
 		if err != nil {
 			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, errors.Wrapf(err, "Error in parsing resource modifiers provided in configmap %s/%s", restore.Namespace, restore.Spec.ResourceModifier.Name).Error())
-			return backupInfo{}, nil
+			return backupInfo{}, nil, nil
 		} else if err = resourceModifiers.Validate(); err != nil {
 			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, errors.Wrapf(err, "Validation error in resource modifiers provided in configmap %s/%s", restore.Namespace, restore.Spec.ResourceModifier.Name).Error())
-			return backupInfo{}, nil
+			return backupInfo{}, nil, nil
 		}
 		r.logger.Infof("Retrieved Resource modifiers provided in configmap %s/%s", restore.Namespace, restore.Spec.ResourceModifier.Name)
 	}
 
-	return info, resourceModifiers
+	var namespaceReferenceRules *namespacereferences.Rules
+	if restore.Spec.NamespaceReferenceRules != nil && strings.EqualFold(restore.Spec.NamespaceReferenceRules.Kind, namespacereferences.ConfigmapRefType) {
+		rulesConfigMap := &corev1api.ConfigMap{}
+		err := r.kbClient.Get(context.Background(), client.ObjectKey{Namespace: restore.Namespace, Name: restore.Spec.NamespaceReferenceRules.Name}, rulesConfigMap)
+		if err != nil {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("failed to get namespace reference rules configmap %s/%s", restore.Namespace, restore.Spec.NamespaceReferenceRules.Name))
+			return backupInfo{}, nil, nil
+		}
+		namespaceReferenceRules, err = namespacereferences.GetRulesFromConfig(rulesConfigMap)
+		if err != nil {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, errors.Wrapf(err, "Error in parsing namespace reference rules provided in configmap %s/%s", restore.Namespace, restore.Spec.NamespaceReferenceRules.Name).Error())
+			return backupInfo{}, nil, nil
+		} else if err = namespaceReferenceRules.Validate(); err != nil {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, errors.Wrapf(err, "Validation error in namespace reference rules provided in configmap %s/%s", restore.Namespace, restore.Spec.NamespaceReferenceRules.Name).Error())
+			return backupInfo{}, nil, nil
+		}
+		r.logger.Infof("Retrieved namespace reference rules provided in configmap %s/%s", restore.Namespace, restore.Spec.NamespaceReferenceRules.Name)
+	}
+
+	return info, resourceModifiers, namespaceReferenceRules
+}
+
+// validateIDMappingUploaders returns an error if the restore maps the user or group IDs of the
+// volumes backed up by the restic uploader, which doesn't support ID mappings, so the restore
+// fails validation rather than its PodVolumeRestores failing one by one.
+func (r *restoreReconciler) validateIDMappingUploaders(restore *api.Restore) error {
+	if restore.Spec.UploaderConfig == nil ||
+		(len(restore.Spec.UploaderConfig.UIDMapping) == 0 && len(restore.Spec.UploaderConfig.GIDMapping) == 0) {
+		return nil
+	}
+
+	podVolumeBackupList := &api.PodVolumeBackupList{}
+	if err := r.kbClient.List(context.Background(), podVolumeBackupList, &client.ListOptions{
+		LabelSelector: labels.Set(map[string]string{
+			api.BackupNameLabel: label.GetValidName(restore.Spec.BackupName),
+		}).AsSelector(),
+	}); err != nil {
+		return errors.Wrap(err, "error listing the PodVolumeBackups to validate the ID mappings")
+	}
+
+	var volumes []string
+	for _, pvb := range podVolumeBackupList.Items {
+		if pvb.Spec.UploaderType == uploader.ResticType {
+			volumes = append(volumes, pvb.Spec.Pod.Namespace+"/"+pvb.Spec.Pod.Name+":"+pvb.Spec.Volume)
+		}
+	}
+	if len(volumes) > 0 {
+		return errors.Errorf("UIDMapping and GIDMapping aren't supported by the restic uploader which backed up the volumes %s", strings.Join(volumes, ", "))
+	}
+	return nil
 }
 
 // backupXorScheduleProvided returns true if exactly one of BackupName and
@@ -451,7 +587,7 @@ func mostRecentCompletedBackup(backups []api.Backup) api.Backup {
 	})
 
 	for _, backup := range backups {
-		if backup.Status.Phase == api.BackupPhaseCompleted {
+		if backup.Status.Phase == api.BackupPhaseCompleted && !meta.IsStatusConditionTrue(backup.Status.Conditions, api.ConditionTypeCorrupted) {
 			return backup
 		}
 	}
@@ -486,11 +622,58 @@ func fetchBackupInfoInternal(kbClient client.Client, namespace, backupName strin
 	}, nil
 }
 
+// completeStagingStorageLocation checks the staging storage location of the restore is
+// read-write or, when it's not set and the location of the backup is read-only, sets it to the
+// default location if that one is read-write.
+func (r *restoreReconciler) completeStagingStorageLocation(restore *api.Restore, info backupInfo) error {
+	if restore.Spec.StagingStorageLocation == "" {
+		if !persistence.IsReadOnly(info.location) {
+			return nil
+		}
+		defaultLocations, err := storage.GetDefaultBackupStorageLocations(r.ctx, r.kbClient, restore.Namespace)
+		if err != nil {
+			return err
+		}
+		if len(defaultLocations.Items) == 1 && !persistence.IsReadOnly(&defaultLocations.Items[0]) {
+			restore.Spec.StagingStorageLocation = defaultLocations.Items[0].Name
+		}
+		return nil
+	}
+
+	location := &api.BackupStorageLocation{}
+	if err := r.kbClient.Get(r.ctx, client.ObjectKey{Namespace: restore.Namespace, Name: restore.Spec.StagingStorageLocation}, location); err != nil {
+		return errors.Wrapf(err, "error getting staging storage location %s", restore.Spec.StagingStorageLocation)
+	}
+	if persistence.IsReadOnly(location) {
+		return errors.Errorf("staging storage location %s is read-only", restore.Spec.StagingStorageLocation)
+	}
+	return nil
+}
+
+// fetchRestoreStorageLocation returns the location the files of the restore, like its log and
+// results, are stored in: its staging storage location when set, otherwise the location of its
+// backup.
+func fetchRestoreStorageLocation(kbClient client.Client, restore *api.Restore, info backupInfo) (*api.BackupStorageLocation, error) {
+	if restore.Spec.StagingStorageLocation == "" {
+		return info.location, nil
+	}
+
+	location := &api.BackupStorageLocation{}
+	if err := kbClient.Get(context.Background(), client.ObjectKey{
+		Namespace: restore.Namespace,
+		Name:      restore.Spec.StagingStorageLocation,
+	}, location); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return location, nil
+}
+
 // runValidatedRestore takes a validated restore API object and executes the restore process.
 // The log and results files are uploaded to backup storage. Any error returned from this function
 // means that the restore failed. This function updates the restore API object with warning and error
 // counts, but *does not* update its phase or patch it via the API.
-func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backupInfo, resourceModifiers *resourcemodifiers.ResourceModifiers) error {
+func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backupInfo, resourceModifiers *resourcemodifiers.ResourceModifiers,
+	namespaceReferenceRules *namespacereferences.Rules) error {
 	// instantiate the per-restore logger that will output both to a temp file
 	// (for upload to object storage) and to stdout.
 	restoreLog, err := logging.NewTempFileLogger(r.restoreLogLevel, r.logFormat, nil, logrus.Fields{"restore": kubeutil.NamespaceAndName(restore)})
@@ -507,6 +690,21 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 		return err
 	}
 
+	// the backup isn't restored when its files don't match its signed manifest
+	mismatches, err := backupStore.VerifyBackupSignatures(restore.Spec.BackupName)
+	switch {
+	case err == persistence.ErrSigningNotEnabled:
+	case err == persistence.ErrBackupNotSigned && info.backup.Status.Signed:
+		// the manifest may be deleted along with the files tampered with
+		return errors.Errorf("backup %s was signed but its signed manifest is missing", restore.Spec.BackupName)
+	case err == persistence.ErrBackupNotSigned:
+		restoreLog.Warnf("Backup %s has no signed manifest, its files aren't verified", restore.Spec.BackupName)
+	case err != nil:
+		return errors.Wrap(err, "error verifying the backup")
+	case len(mismatches) > 0:
+		return errors.Errorf("the files of backup %s don't match its signed manifest: %s", restore.Spec.BackupName, strings.Join(mismatches, "; "))
+	}
+
 	actions, err := pluginManager.GetRestoreItemActionsV2()
 	if err != nil {
 		return errors.Wrap(err, "error getting restore item actions")
@@ -568,13 +766,29 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 		VolumeSnapshots:               volumeSnapshots,
 		BackupReader:                  backupFile,
 		ResourceModifiers:             resourceModifiers,
+		NamespaceReferenceRules:       namespaceReferenceRules,
 		DisableInformerCache:          r.disableInformerCache,
 		CSIVolumeSnapshots:            csiVolumeSnapshots,
 		BackupVolumeInfoMap:           backupVolumeInfoMap,
 		RestoreVolumeInfoTracker:      volume.NewRestoreVolInfoTracker(restore, restoreLog, r.globalCrClient),
 		ResourceDeletionStatusTracker: kubeutil.NewResourceDeletionStatusTracker(),
+		ItemQuarantine:                itemquarantine.NewTracker(restore.Spec.ErrorBudget),
+	}
+	restorer := r.restorer
+	if restore.Spec.TargetCluster != "" {
+		cluster, err := getTargetCluster(r.ctx, r.targetClusters, restore.Spec.TargetCluster)
+		if err != nil {
+			return err
+		}
+		restorer = cluster.Restorer
+	}
+	restoreWarnings, restoreErrors := restorer.RestoreWithResolvers(restoreReq, actionsResolver, pluginManager)
+	// the restore fails validation when it includes resources neither in the cluster nor in the
+	// backup, which is only known once the backup is downloaded
+	if restore.Status.Phase == api.RestorePhaseFailedValidation {
+		r.metrics.RegisterRestoreValidationFailed(restore.Spec.ScheduleName)
+		return nil
 	}
-	restoreWarnings, restoreErrors := r.restorer.RestoreWithResolvers(restoreReq, actionsResolver, pluginManager)
 
 	// Iterate over restore item operations and update progress.
 	// Any errors on operations at this point should be added to restore errors.
@@ -618,16 +832,27 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 
 	restoreLog.DoneForPersist(r.logger)
 
+	// the files of the restore are stored in its staging location, if any, and aren't stored at
+	// all when the location is read-only
+	restoreLocation, err := fetchRestoreStorageLocation(r.kbClient, restore, info)
+	if err != nil {
+		return errors.Wrap(err, "error getting the location to persist log and results files")
+	}
+	persistFiles := !persistence.IsReadOnly(restoreLocation)
+	if !persistFiles {
+		r.logger.WithField("restore", kubeutil.NamespaceAndName(restore)).Warnf("Not persisting the log and results files, backup storage location %s is read-only and the restore has no staging storage location", restoreLocation.Name)
+	}
+
 	// re-instantiate the backup store because credentials could have changed since the original
 	// instantiation, if this was a long-running restore
-	backupStore, err = r.backupStoreGetter.Get(info.location, pluginManager, r.logger)
+	backupStore, err = r.backupStoreGetter.Get(restoreLocation, pluginManager, r.logger)
 	if err != nil {
 		return errors.Wrap(err, "error setting up backup store to persist log and results files")
 	}
 
 	if logReader, err := restoreLog.GetPersistFile(); err != nil {
 		restoreErrors.Velero = append(restoreErrors.Velero, fmt.Sprintf("error getting restore log reader: %v", err))
-	} else {
+	} else if persistFiles {
 		if err := backupStore.PutRestoreLog(restore.Spec.BackupName, restore.Name, logReader); err != nil {
 			restoreErrors.Velero = append(restoreErrors.Velero, fmt.Sprintf("error uploading log file to backup storage: %v", err))
 		}
@@ -651,21 +876,45 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 		"errors":   restoreErrors,
 	}
 
-	if err := putResults(restore, m, backupStore); err != nil {
-		r.logger.WithError(err).Error("Error uploading restore results to backup storage")
-	}
+	if persistFiles {
+		if err := putResults(restore, m, backupStore); err != nil {
+			r.logger.WithError(err).Error("Error uploading restore results to backup storage")
+		}
 
-	if err := putRestoredResourceList(restore, restoreReq.RestoredResourceList(), backupStore); err != nil {
-		r.logger.WithError(err).Error("Error uploading restored resource list to backup storage")
-	}
+		if err := putRestoredResourceList(restore, restoreReq.RestoredResourceList(), backupStore); err != nil {
+			r.logger.WithError(err).Error("Error uploading restored resource list to backup storage")
+		}
 
-	if err := putOperationsForRestore(restore, *restoreReq.GetItemOperationsList(), backupStore); err != nil {
-		r.logger.WithError(err).Error("Error uploading restore item action operation resource list to backup storage")
+		if err := putOperationsForRestore(restore, *restoreReq.GetItemOperationsList(), backupStore); err != nil {
+			r.logger.WithError(err).Error("Error uploading restore item action operation resource list to backup storage")
+		}
+
+		restoreReq.RestoreVolumeInfoTracker.Populate(context.TODO(), restoreReq.RestoredResourceList())
+		if err := putRestoreVolumeInfoList(restore, restoreReq.RestoreVolumeInfoTracker.Result(), backupStore); err != nil {
+			r.logger.WithError(err).Error("Error uploading restored volume info to backup storage")
+		}
+
+		if driftReport := *restoreReq.GetDriftReport(); len(driftReport) > 0 {
+			if err := putRestoreDriftReport(restore, driftReport, backupStore); err != nil {
+				r.logger.WithError(err).Error("Error uploading restore drift report to backup storage")
+			}
+		}
+
+		if boolptr.IsSetToTrue(restore.Spec.Preview) {
+			if err := putRestorePreviewReport(restore, *restoreReq.GetPreviewReport(), backupStore); err != nil {
+				r.logger.WithError(err).Error("Error uploading restore preview report to backup storage")
+			}
+		} else if !boolptr.IsSetToTrue(restore.Spec.ServerDryRun) {
+			if err := putRestoreMappingReport(restore, restoreReq.GetMappingReport(), backupStore); err != nil {
+				r.logger.WithError(err).Error("Error uploading restore mapping report to backup storage")
+			}
+		}
 	}
 
-	restoreReq.RestoreVolumeInfoTracker.Populate(context.TODO(), restoreReq.RestoredResourceList())
-	if err := putRestoreVolumeInfoList(restore, restoreReq.RestoreVolumeInfoTracker.Result(), backupStore); err != nil {
-		r.logger.WithError(err).Error("Error uploading restored volume info to backup storage")
+	restore.Status.QuarantinedItems = restoreReq.ItemQuarantine.Items()
+	if restoreReq.ItemQuarantine.Exceeded() {
+		restore.Status.ErrorBudgetExceeded = true
+		return errors.Errorf("restore aborted, %d items failed, exceeding its errorBudget of %d", restoreReq.ItemQuarantine.Failed(), restoreReq.ItemQuarantine.Budget())
 	}
 
 	if restore.Status.Errors > 0 {
@@ -728,11 +977,24 @@ func (r *restoreReconciler) deleteExternalResources(restore *api.Restore) error
 		return errors.Wrap(err, fmt.Sprintf("can't get backup info, backup: %s", restore.Spec.BackupName))
 	}
 
+	restoreLocation, err := fetchRestoreStorageLocation(r.kbClient, restore, backupInfo)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			r.logger.Errorf("got not found error: %v, skip deleting the restore files in object storage", err)
+			return nil
+		}
+		return errors.Wrap(err, fmt.Sprintf("can't get the staging storage location, restore: %s", restore.Name))
+	}
+	if persistence.IsReadOnly(restoreLocation) {
+		r.logger.Infof("Backup storage location %s is read-only, skip deleting the restore files in object storage", restoreLocation.Name)
+		return nil
+	}
+
 	// delete restore files in object storage
 	pluginManager := r.newPluginManager(r.logger)
 	defer pluginManager.CleanupClients()
 
-	backupStore, err := r.backupStoreGetter.Get(backupInfo.location, pluginManager, r.logger)
+	backupStore, err := r.backupStoreGetter.Get(restoreLocation, pluginManager, r.logger)
 	if err != nil {
 		return errors.Wrap(err, fmt.Sprintf("can't get backupStore, backup: %s", restore.Spec.BackupName))
 	}
@@ -820,6 +1082,54 @@ func putRestoreVolumeInfoList(restore *api.Restore, volInfoList []*volume.Restor
 	return store.PutRestoreVolumeInfo(restore.Name, buf)
 }
 
+func putRestoreDriftReport(restore *api.Restore, report []api.DriftedItem, store persistence.BackupStore) error {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	defer gzw.Close()
+
+	if err := json.NewEncoder(gzw).Encode(report); err != nil {
+		return errors.Wrap(err, "error encoding restore drift report to JSON")
+	}
+
+	if err := gzw.Close(); err != nil {
+		return errors.Wrap(err, "error closing gzip writer")
+	}
+
+	return store.PutRestoreDriftReport(restore.Name, buf)
+}
+
+func putRestorePreviewReport(restore *api.Restore, report []api.PreviewItem, store persistence.BackupStore) error {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	defer gzw.Close()
+
+	if err := json.NewEncoder(gzw).Encode(report); err != nil {
+		return errors.Wrap(err, "error encoding restore preview report to JSON")
+	}
+
+	if err := gzw.Close(); err != nil {
+		return errors.Wrap(err, "error closing gzip writer")
+	}
+
+	return store.PutRestorePreviewReport(restore.Name, buf)
+}
+
+func putRestoreMappingReport(restore *api.Restore, report *api.RestoreMappingReport, store persistence.BackupStore) error {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	defer gzw.Close()
+
+	if err := json.NewEncoder(gzw).Encode(report); err != nil {
+		return errors.Wrap(err, "error encoding restore mapping report to JSON")
+	}
+
+	if err := gzw.Close(); err != nil {
+		return errors.Wrap(err, "error closing gzip writer")
+	}
+
+	return store.PutRestoreMappingReport(restore.Name, buf)
+}
+
 func downloadToTempFile(backupName string, backupStore persistence.BackupStore, logger logrus.FieldLogger) (*os.File, error) {
 	readCloser, err := backupStore.GetBackupContents(backupName)
 	if err != nil {
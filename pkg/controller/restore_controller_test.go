@@ -41,6 +41,7 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
 	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
@@ -117,6 +118,7 @@ func TestFetchBackupInfo(t *testing.T) {
 				false,
 				fakeGlobalClient,
 				10*time.Minute,
+				nil,
 			)
 
 			if test.backupStoreError == nil {
@@ -198,6 +200,7 @@ func TestProcessQueueItemSkips(t *testing.T) {
 				false,
 				fakeGlobalClient,
 				10*time.Minute,
+				nil,
 			)
 
 			_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{
@@ -239,6 +242,10 @@ func TestRestoreReconcile(t *testing.T) {
 		expectedFinalPhase              string
 		addValidFinalizer               bool
 		emptyVolumeInfo                 bool
+		signatureMismatches             []string
+		verifySignaturesErr             error
+		expectedFailureReason           string
+		restorerValidationErrors        []string
 	}{
 		{
 			name:                     "restore with both namespace in both includedNamespaces and excludedNamespaces fails validation",
@@ -281,6 +288,15 @@ func TestRestoreReconcile(t *testing.T) {
 			expectedValidationErrors: []string{"encountered labelSelector as well as orLabelSelectors in restore spec, only one can be specified"},
 			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
 		},
+		{
+			name:                     "new restore with an invalid namespace priority fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).NamespacePriority("kube-system", "platform-[").Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedValidationErrors: []string{"invalid namespace priority platform-[: unexpected end of input"},
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+		},
 		{
 			name:                  "valid restore with schedule name gets executed",
 			location:              defaultStorageLocation,
@@ -314,6 +330,17 @@ func TestRestoreReconcile(t *testing.T) {
 			expectedRestoreErrors: 1,
 			expectedRestorerCall:  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseInProgress).Result(),
 		},
+		{
+			name:                     "restorer rejecting the included resources fails the validation of the restore",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			restorerValidationErrors: []string{`Invalid included resources: unknown resource "deploymnets", did you mean "deployments"?`},
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseInProgress),
+			expectedStartTime:        &timestamp,
+			expectedRestorerCall:     NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseInProgress).Result(),
+		},
 		{
 			name:                  "valid restore with none existingresourcepolicy gets executed",
 			location:              defaultStorageLocation,
@@ -429,6 +456,29 @@ func TestRestoreReconcile(t *testing.T) {
 			backupStoreGetBackupContentsErr: errors.New("Couldn't download backup"),
 			backup:                          defaultBackup().StorageLocation("default").Result(),
 		},
+		{
+			name:                  "backup not matching its signatures results in failed restore",
+			location:              defaultStorageLocation,
+			restore:               NewRestore(velerov1api.DefaultNamespace, "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).Result(),
+			expectedPhase:         string(velerov1api.RestorePhaseInProgress),
+			expectedFinalPhase:    string(velerov1api.RestorePhaseFailed),
+			expectedStartTime:     &timestamp,
+			expectedCompletedTime: &timestamp,
+			signatureMismatches:   []string{"backup-1.tar.gz has digest 01 instead of 02"},
+			backup:                defaultBackup().StorageLocation("default").Result(),
+		},
+		{
+			name:                  "signed backup whose manifest is deleted results in failed restore",
+			location:              defaultStorageLocation,
+			restore:               NewRestore(velerov1api.DefaultNamespace, "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).Result(),
+			expectedPhase:         string(velerov1api.RestorePhaseInProgress),
+			expectedFinalPhase:    string(velerov1api.RestorePhaseFailed),
+			expectedStartTime:     &timestamp,
+			expectedCompletedTime: &timestamp,
+			verifySignaturesErr:   persistence.ErrBackupNotSigned,
+			expectedFailureReason: "backup backup-1 was signed but its signed manifest is missing",
+			backup:                defaultBackup().StorageLocation("default").Signed(true).Result(),
+		},
 		{
 			name:              "restore attached with an expected finalizer gets cleaned up successfully",
 			location:          defaultStorageLocation,
@@ -501,6 +551,7 @@ func TestRestoreReconcile(t *testing.T) {
 				false,
 				fakeGlobalClient,
 				10*time.Minute,
+				nil,
 			)
 
 			r.clock = clocktesting.NewFakeClock(now)
@@ -535,14 +586,24 @@ func TestRestoreReconcile(t *testing.T) {
 				backupStore.On("GetCSIVolumeSnapshots", test.backup.Name).Return([]*snapshotv1api.VolumeSnapshot{}, nil)
 
 				restorer.On("RestoreWithResolvers", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-					mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(warnings, errors)
-
-				backupStore.On("PutRestoreLog", test.backup.Name, test.restore.Name, mock.Anything).Return(test.putRestoreLogErr)
-
-				backupStore.On("PutRestoreResults", test.backup.Name, test.restore.Name, mock.Anything).Return(nil)
-				backupStore.On("PutRestoredResourceList", test.restore.Name, mock.Anything).Return(nil)
-				backupStore.On("PutRestoreItemOperations", mock.Anything, mock.Anything).Return(nil)
-				backupStore.On("PutRestoreVolumeInfo", test.restore.Name, mock.Anything).Return(nil)
+					mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					restore := args.Get(1).(*velerov1api.Restore)
+					if len(test.restorerValidationErrors) > 0 {
+						restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, test.restorerValidationErrors...)
+						restore.Status.Phase = velerov1api.RestorePhaseFailedValidation
+					}
+				}).Return(warnings, errors)
+
+				// a restore failing validation doesn't persist its files
+				if len(test.restorerValidationErrors) == 0 {
+					backupStore.On("PutRestoreLog", test.backup.Name, test.restore.Name, mock.Anything).Return(test.putRestoreLogErr)
+
+					backupStore.On("PutRestoreResults", test.backup.Name, test.restore.Name, mock.Anything).Return(nil)
+					backupStore.On("PutRestoredResourceList", test.restore.Name, mock.Anything).Return(nil)
+					backupStore.On("PutRestoreItemOperations", mock.Anything, mock.Anything).Return(nil)
+					backupStore.On("PutRestoreVolumeInfo", test.restore.Name, mock.Anything).Return(nil)
+					backupStore.On("PutRestoreMappingReport", test.restore.Name, mock.Anything).Return(nil)
+				}
 				if test.emptyVolumeInfo == true {
 					backupStore.On("GetBackupVolumeInfos", test.backup.Name).Return(nil, nil)
 				} else {
@@ -570,6 +631,12 @@ func TestRestoreReconcile(t *testing.T) {
 				backupStore.On("GetBackupContents", test.restore.Spec.BackupName).Return(nil, test.backupStoreGetBackupContentsErr).Maybe()
 			}
 
+			if test.signatureMismatches != nil || test.verifySignaturesErr != nil {
+				backupStore.On("VerifyBackupSignatures", test.restore.Spec.BackupName).Return(test.signatureMismatches, test.verifySignaturesErr)
+			} else {
+				backupStore.On("VerifyBackupSignatures", mock.Anything).Return(nil, persistence.ErrSigningNotEnabled).Maybe()
+			}
+
 			if test.restore != nil {
 				pluginManager.On("GetRestoreItemActionsV2").Return(nil, nil)
 				pluginManager.On("CleanupClients")
@@ -587,6 +654,28 @@ func TestRestoreReconcile(t *testing.T) {
 
 			assert.Equal(t, test.expectedErr, err != nil, "got error %v", err)
 
+			if test.signatureMismatches != nil {
+				restore := &velerov1api.Restore{}
+				require.NoError(t, r.kbClient.Get(context.Background(), client.ObjectKeyFromObject(test.restore), restore))
+				assert.Equal(t, velerov1api.RestorePhaseFailed, restore.Status.Phase)
+				assert.Contains(t, restore.Status.FailureReason, "don't match its signed manifest: backup-1.tar.gz has digest 01 instead of 02")
+			}
+
+			if test.expectedFailureReason != "" {
+				restore := &velerov1api.Restore{}
+				require.NoError(t, r.kbClient.Get(context.Background(), client.ObjectKeyFromObject(test.restore), restore))
+				assert.Equal(t, velerov1api.RestorePhaseFailed, restore.Status.Phase)
+				assert.Contains(t, restore.Status.FailureReason, test.expectedFailureReason)
+			}
+
+			if len(test.restorerValidationErrors) > 0 {
+				restore := &velerov1api.Restore{}
+				require.NoError(t, r.kbClient.Get(context.Background(), client.ObjectKeyFromObject(test.restore), restore))
+				assert.Equal(t, velerov1api.RestorePhaseFailedValidation, restore.Status.Phase)
+				assert.Equal(t, test.restorerValidationErrors, restore.Status.ValidationErrors)
+				assert.Nil(t, restore.Status.CompletionTimestamp)
+			}
+
 			if test.expectedPhase == "" {
 				return
 			}
@@ -659,6 +748,45 @@ func TestRestoreReconcile(t *testing.T) {
 	}
 }
 
+func TestRestoreReconcileDeletedRemovesNamespaceProtection(t *testing.T) {
+	ns := builder.ForNamespace("ns-1").
+		ObjectMeta(builder.WithLabels(velerov1api.RestoreProtectedByLabel, "bar"), builder.WithFinalizers(velerov1api.RestoreProtectionFinalizer)).
+		Result()
+	restore := NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseInProgress).
+		ProtectNamespaces(true).
+		ObjectMeta(builder.WithFinalizers(ExternalResourcesFinalizer)).
+		Result()
+	fakeClient := velerotest.NewFakeControllerRuntimeClient(t, ns, restore)
+	// the fake client ignores the DeletionTimestamp when calling the Create(), so call Delete() here
+	require.NoError(t, fakeClient.Delete(context.Background(), restore))
+
+	r := NewRestoreReconciler(
+		context.Background(),
+		velerov1api.DefaultNamespace,
+		&fakeRestorer{kbClient: fakeClient},
+		fakeClient,
+		velerotest.NewLogger(),
+		logrus.InfoLevel,
+		func(logrus.FieldLogger) clientmgmt.Manager { return &pluginmocks.Manager{} },
+		NewFakeSingleObjectBackupStoreGetter(&persistencemocks.BackupStore{}),
+		metrics.NewServerMetrics(),
+		logging.FormatText,
+		60*time.Minute,
+		false,
+		velerotest.NewFakeControllerRuntimeClient(t),
+		10*time.Minute,
+		nil,
+	)
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(restore)})
+	require.NoError(t, err)
+
+	updated := &corev1.Namespace{}
+	require.NoError(t, fakeClient.Get(context.Background(), client.ObjectKey{Name: "ns-1"}, updated))
+	assert.Empty(t, updated.Finalizers)
+	assert.NotContains(t, updated.Labels, velerov1api.RestoreProtectedByLabel)
+}
+
 func TestValidateAndCompleteWhenScheduleNameSpecified(t *testing.T) {
 	formatFlag := logging.FormatText
 
@@ -685,6 +813,7 @@ func TestValidateAndCompleteWhenScheduleNameSpecified(t *testing.T) {
 		false,
 		fakeGlobalClient,
 		10*time.Minute,
+		nil,
 	)
 
 	restore := &velerov1api.Restore{
@@ -753,6 +882,180 @@ func TestValidateAndCompleteWhenScheduleNameSpecified(t *testing.T) {
 	r.validateAndComplete(restore)
 	assert.Nil(t, restore.Status.ValidationErrors)
 	assert.Equal(t, "foo", restore.Spec.BackupName)
+
+	// the most recent backup is corrupted: use the previous one
+	corrupted := defaultBackup().
+		ObjectMeta(
+			builder.WithName("corrupted"),
+			builder.WithLabels(velerov1api.ScheduleNameLabel, "schedule-1"),
+		).
+		StorageLocation("default").
+		Phase(velerov1api.BackupPhaseCompleted).
+		StartTimestamp(now.Add(time.Minute)).
+		Result()
+	corrupted.Status.Conditions = []metav1.Condition{{Type: velerov1api.ConditionTypeCorrupted, Status: metav1.ConditionTrue, Message: "corrupted.tar.gz is missing"}}
+	require.NoError(t, r.kbClient.Create(context.Background(), corrupted))
+
+	restore.Spec.BackupName = ""
+	r.validateAndComplete(restore)
+	assert.Nil(t, restore.Status.ValidationErrors)
+	assert.Equal(t, "foo", restore.Spec.BackupName)
+
+	// a corrupted backup can't be restored
+	restore = &velerov1api.Restore{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1api.DefaultNamespace,
+			Name:      "restore-2",
+		},
+		Spec: velerov1api.RestoreSpec{
+			BackupName: "corrupted",
+		},
+	}
+	r.validateAndComplete(restore)
+	assert.Equal(t, []string{"Backup corrupted is corrupted: corrupted.tar.gz is missing"}, restore.Status.ValidationErrors)
+}
+
+func TestValidateAndCompleteStagingStorageLocation(t *testing.T) {
+	readOnly := func() *builder.BackupStorageLocationBuilder {
+		return builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "read-only").Provider("myCloud").Bucket("bucket").
+			AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly)
+	}
+	readWrite := func() *builder.BackupStorageLocationBuilder {
+		return builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "read-write").Provider("myCloud").Bucket("bucket")
+	}
+
+	tests := []struct {
+		name            string
+		backupLocation  string
+		staging         string
+		locations       []*velerov1api.BackupStorageLocation
+		expectedStaging string
+		expectedErrs    []string
+	}{
+		{
+			name:           "read-write location of the backup",
+			backupLocation: "read-write",
+			locations:      []*velerov1api.BackupStorageLocation{readWrite().Result()},
+		},
+		{
+			name:            "read-only location of the backup is staged to the read-write default location",
+			backupLocation:  "read-only",
+			locations:       []*velerov1api.BackupStorageLocation{readOnly().Result(), readWrite().Default(true).Result()},
+			expectedStaging: "read-write",
+		},
+		{
+			name:           "read-only location of the backup without read-write default location",
+			backupLocation: "read-only",
+			locations:      []*velerov1api.BackupStorageLocation{readOnly().Default(true).Result()},
+		},
+		{
+			name:            "read-write staging location",
+			backupLocation:  "read-only",
+			staging:         "read-write",
+			locations:       []*velerov1api.BackupStorageLocation{readOnly().Result(), readWrite().Result()},
+			expectedStaging: "read-write",
+		},
+		{
+			name:            "read-only staging location",
+			backupLocation:  "read-write",
+			staging:         "read-only",
+			locations:       []*velerov1api.BackupStorageLocation{readOnly().Result(), readWrite().Result()},
+			expectedStaging: "read-only",
+			expectedErrs:    []string{"staging storage location read-only is read-only"},
+		},
+		{
+			name:            "missing staging location",
+			backupLocation:  "read-only",
+			staging:         "missing",
+			locations:       []*velerov1api.BackupStorageLocation{readOnly().Result()},
+			expectedStaging: "missing",
+			expectedErrs:    []string{`error getting staging storage location missing: backupstoragelocations.velero.io "missing" not found`},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeClient := velerotest.NewFakeControllerRuntimeClient(t, defaultBackup().StorageLocation(test.backupLocation).Result())
+			for _, location := range test.locations {
+				require.NoError(t, fakeClient.Create(context.Background(), location))
+			}
+
+			r := NewRestoreReconciler(
+				context.Background(),
+				velerov1api.DefaultNamespace,
+				nil,
+				fakeClient,
+				velerotest.NewLogger(),
+				logrus.DebugLevel,
+				func(logrus.FieldLogger) clientmgmt.Manager { return &pluginmocks.Manager{} },
+				NewFakeSingleObjectBackupStoreGetter(&persistencemocks.BackupStore{}),
+				metrics.NewServerMetrics(),
+				logging.FormatText,
+				60*time.Minute,
+				false,
+				velerotest.NewFakeControllerRuntimeClient(t),
+				10*time.Minute,
+				nil,
+			)
+
+			restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Backup("backup-1").
+				StagingStorageLocation(test.staging).Result()
+			r.validateAndComplete(restore)
+			assert.Equal(t, test.expectedErrs, restore.Status.ValidationErrors)
+			assert.Equal(t, test.expectedStaging, restore.Spec.StagingStorageLocation)
+		})
+	}
+}
+
+func TestValidateIDMappingUploaders(t *testing.T) {
+	pvb := func(name, uploaderType string) *velerov1api.PodVolumeBackup {
+		return builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, name).
+			ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "backup-1")).
+			PodNamespace("ns-1").PodName("pod-1").Volume(name).UploaderType(uploaderType).Result()
+	}
+
+	tests := []struct {
+		name           string
+		uploaderConfig *velerov1api.UploaderConfigForRestore
+		pvbs           []*velerov1api.PodVolumeBackup
+		expectedErr    string
+	}{
+		{
+			name: "no ID mappings",
+			pvbs: []*velerov1api.PodVolumeBackup{pvb("vol-1", "restic")},
+		},
+		{
+			name:           "ID mappings for kopia pod volume backups",
+			uploaderConfig: &velerov1api.UploaderConfigForRestore{UIDMapping: map[string]string{"1000": "2000"}},
+			pvbs:           []*velerov1api.PodVolumeBackup{pvb("vol-1", "kopia")},
+		},
+		{
+			name:           "ID mappings for restic pod volume backups",
+			uploaderConfig: &velerov1api.UploaderConfigForRestore{GIDMapping: map[string]string{"1000": "2000"}},
+			pvbs:           []*velerov1api.PodVolumeBackup{pvb("vol-1", "kopia"), pvb("vol-2", "restic")},
+			expectedErr:    "UIDMapping and GIDMapping aren't supported by the restic uploader which backed up the volumes ns-1/pod-1:vol-2",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeClient := velerotest.NewFakeControllerRuntimeClient(t)
+			for _, pvb := range test.pvbs {
+				require.NoError(t, fakeClient.Create(context.Background(), pvb))
+			}
+			r := &restoreReconciler{kbClient: fakeClient}
+
+			restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Backup("backup-1").Result()
+			restore.Spec.UploaderConfig = test.uploaderConfig
+
+			err := r.validateIDMappingUploaders(restore)
+			if test.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expectedErr)
+			}
+		})
+	}
 }
 
 func TestValidateAndCompleteWithResourceModifierSpecified(t *testing.T) {
@@ -781,6 +1084,7 @@ func TestValidateAndCompleteWithResourceModifierSpecified(t *testing.T) {
 		false,
 		fakeGlobalClient,
 		10*time.Minute,
+		nil,
 	)
 
 	restore := &velerov1api.Restore{
@@ -1026,10 +1330,10 @@ func (r *fakeRestorer) RestoreWithResolvers(req *pkgrestore.Request,
 	resolver framework.RestoreItemActionResolverV2,
 	volumeSnapshotterGetter pkgrestore.VolumeSnapshotterGetter,
 ) (results.Result, results.Result) {
+	r.calledWithArg = *req.Restore
+
 	res := r.Called(req.Log, req.Restore, req.Backup, req.BackupReader, resolver,
 		r.kbClient, volumeSnapshotterGetter)
 
-	r.calledWithArg = *req.Restore
-
 	return res.Get(0).(results.Result), res.Get(1).(results.Result)
 }
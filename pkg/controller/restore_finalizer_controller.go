@@ -19,6 +19,8 @@ package controller
 import (
 	"context"
 	"fmt"
+	"path"
+	"strings"
 	"sync"
 	"time"
 
@@ -28,6 +30,7 @@ import (
 	storagev1api "k8s.io/api/storage/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/utils/clock"
@@ -39,10 +42,14 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/constant"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/targetcluster"
+	"github.com/vmware-tanzu/velero/pkg/util/conditions"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/results"
 )
@@ -58,6 +65,7 @@ type restoreFinalizerReconciler struct {
 	crClient          client.Client
 	multiHookTracker  *hook.MultiHookTracker
 	resourceTimeout   time.Duration
+	targetClusters    targetcluster.Getter
 }
 
 func NewRestoreFinalizerReconciler(
@@ -70,6 +78,7 @@ func NewRestoreFinalizerReconciler(
 	crClient client.Client,
 	multiHookTracker *hook.MultiHookTracker,
 	resourceTimeout time.Duration,
+	targetClusters targetcluster.Getter,
 ) *restoreFinalizerReconciler {
 	return &restoreFinalizerReconciler{
 		Client:            client,
@@ -82,6 +91,7 @@ func NewRestoreFinalizerReconciler(
 		crClient:          crClient,
 		multiHookTracker:  multiHookTracker,
 		resourceTimeout:   resourceTimeout,
+		targetClusters:    targetClusters,
 	}
 }
 
@@ -150,7 +160,20 @@ func (r *restoreFinalizerReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return ctrl.Result{}, errors.Wrap(err, "error getting volumeInfo")
 	}
 
-	restoredResourceList, err := backupStore.GetRestoredResourceList(restore.Name)
+	restoreLocation, err := fetchRestoreStorageLocation(r.Client, restore, info)
+	if err != nil {
+		log.WithError(err).Error("error getting the staging storage location")
+		return ctrl.Result{}, errors.Wrap(err, "error getting the staging storage location")
+	}
+	restoreStore := backupStore
+	if restoreLocation != info.location {
+		if restoreStore, err = r.backupStoreGetter.Get(restoreLocation, pluginManager, r.logger); err != nil {
+			log.WithError(err).Error("error getting the backup store of the staging storage location")
+			return ctrl.Result{}, errors.Wrap(err, "error getting the backup store of the staging storage location")
+		}
+	}
+
+	restoredResourceList, err := restoreStore.GetRestoredResourceList(restore.Name)
 	if err != nil {
 		log.WithError(err).Error("error getting restoredResourceList")
 		return ctrl.Result{}, errors.Wrap(err, "error getting restoredResourceList")
@@ -158,20 +181,21 @@ func (r *restoreFinalizerReconciler) Reconcile(ctx context.Context, req ctrl.Req
 
 	restoredPVCList := volume.RestoredPVCFromRestoredResourceList(restoredResourceList)
 
-	restoreItemOperations, err := backupStore.GetRestoreItemOperations(restore.Name)
+	restoreItemOperations, err := restoreStore.GetRestoreItemOperations(restore.Name)
 	if err != nil {
 		log.WithError(err).Error("error getting itemOperationList")
 		return ctrl.Result{}, errors.Wrap(err, "error getting itemOperationList")
 	}
 
 	finalizerCtx := &finalizerContext{
-		logger:           log,
-		restore:          restore,
-		crClient:         r.crClient,
-		volumeInfo:       volumeInfo,
-		restoredPVCList:  restoredPVCList,
-		multiHookTracker: r.multiHookTracker,
-		resourceTimeout:  r.resourceTimeout,
+		logger:               log,
+		restore:              restore,
+		crClient:             r.crClient,
+		volumeInfo:           volumeInfo,
+		restoredPVCList:      restoredPVCList,
+		restoredResourceList: restoredResourceList,
+		multiHookTracker:     r.multiHookTracker,
+		resourceTimeout:      r.resourceTimeout,
 		restoreItemOperationList: restoreItemOperationList{
 			items: restoreItemOperations,
 		},
@@ -193,14 +217,23 @@ func (r *restoreFinalizerReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		restore.Status.Phase = velerov1api.RestorePhaseFinalizingPartiallyFailed
 	}
 
-	if warningCnt > 0 || errCnt > 0 {
-		err := r.updateResults(backupStore, restore, &warnings, &errs)
+	// the results aren't stored when the location is read-only
+	if (warningCnt > 0 || errCnt > 0) && !persistence.IsReadOnly(restoreLocation) {
+		err := r.updateResults(restoreStore, restore, &warnings, &errs)
 		if err != nil {
 			log.WithError(err).Error("error updating results")
 			return ctrl.Result{}, errors.Wrap(err, "error updating results")
 		}
 	}
 
+	// the PVCs restored by async operations or bound to their first consumer only get their
+	// volumes now
+	if len(restoredPVCList) > 0 && !persistence.IsReadOnly(restoreLocation) {
+		if err := r.updateMappingReport(ctx, restoreStore, restore); err != nil {
+			log.WithError(err).Warn("Error updating the persistent volumes of the restore mapping report")
+		}
+	}
+
 	finalPhase := velerov1api.RestorePhaseCompleted
 	if restore.Status.Phase == velerov1api.RestorePhaseFinalizingPartiallyFailed {
 		finalPhase = velerov1api.RestorePhasePartiallyFailed
@@ -236,6 +269,37 @@ func (r *restoreFinalizerReconciler) updateResults(backupStore persistence.Backu
 	return nil
 }
 
+// updateMappingReport fills in the PersistentVolumes the restored PersistentVolumeClaims of the
+// mapping report are bound to, which aren't known yet when the restore creates the claims.
+func (r *restoreFinalizerReconciler) updateMappingReport(ctx context.Context, restoreStore persistence.BackupStore, restore *velerov1api.Restore) error {
+	report, err := restoreStore.GetRestoreMappingReport(restore.Name)
+	if err != nil {
+		return errors.Wrap(err, "error getting restore mapping report")
+	}
+	if report == nil {
+		return nil
+	}
+
+	updated := false
+	for i := range report.PersistentVolumes {
+		mapping := &report.PersistentVolumes[i]
+		pvc := &v1.PersistentVolumeClaim{}
+		if err := r.crClient.Get(ctx, client.ObjectKey{Namespace: mapping.Namespace, Name: mapping.Name}, pvc); err != nil {
+			r.logger.WithError(err).Warnf("Error getting PVC %s/%s, its persistent volume isn't mapped", mapping.Namespace, mapping.Name)
+			continue
+		}
+		if pvc.Spec.VolumeName != "" && pvc.Spec.VolumeName != mapping.PersistentVolume {
+			mapping.PersistentVolume = pvc.Spec.VolumeName
+			updated = true
+		}
+	}
+	if !updated {
+		return nil
+	}
+
+	return putRestoreMappingReport(restore, report, restoreStore)
+}
+
 func (r *restoreFinalizerReconciler) finishProcessing(restorePhase velerov1api.RestorePhase, restore *velerov1api.Restore, original *velerov1api.Restore) error {
 	if restorePhase == velerov1api.RestorePhasePartiallyFailed {
 		restore.Status.Phase = velerov1api.RestorePhasePartiallyFailed
@@ -245,6 +309,11 @@ func (r *restoreFinalizerReconciler) finishProcessing(restorePhase velerov1api.R
 		r.metrics.RegisterRestoreSuccess(restore.Spec.ScheduleName)
 	}
 	restore.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+	if err := pkgrestore.RemoveNamespaceProtection(context.Background(), restoreClusterClient(context.Background(), r.targetClusters, restore, r.Client, r.logger), restore); err != nil {
+		r.logger.WithField("restore", kubeutil.NamespaceAndName(restore)).WithError(err).Warn("Error removing the protection of the restored namespaces")
+	}
+	conditions.SetRestoreConditions(restore, r.clock.Now())
+	conditions.SetObservedGeneration(original, restore)
 	// retry `Finalizing`/`FinalizingPartiallyFailed` to
 	// - `Completed`
 	// - `PartiallyFailed`
@@ -286,21 +355,28 @@ type finalizerContext struct {
 	crClient                 client.Client
 	volumeInfo               []*volume.BackupVolumeInfo
 	restoredPVCList          map[string]struct{}
+	restoredResourceList     map[string][]string
 	restoreItemOperationList restoreItemOperationList
 	multiHookTracker         *hook.MultiHookTracker
 	resourceTimeout          time.Duration
 }
 
-func (ctx *finalizerContext) execute() (results.Result, results.Result) { //nolint:unparam //temporarily ignore the lint report: result 0 is always nil (unparam)
+func (ctx *finalizerContext) execute() (results.Result, results.Result) {
 	warnings, errs := results.Result{}, results.Result{}
 
 	// implement finalization tasks
 	pdpErrs := ctx.patchDynamicPVWithVolumeInfo()
 	errs.Merge(&pdpErrs)
 
-	rehErrs := ctx.WaitRestoreExecHook()
+	rehWarnings, rehErrs := ctx.WaitRestoreExecHook()
+	warnings.Merge(&rehWarnings)
 	errs.Merge(&rehErrs)
 
+	// resume Cluster API reconciliation only after the hooks finished, as the hooks may still
+	// depend on the restored workload
+	rcaErrs := ctx.resumeClusterAPIClusters()
+	errs.Merge(&rcaErrs)
+
 	return warnings, errs
 }
 
@@ -461,8 +537,9 @@ func needPatch(newPV *v1.PersistentVolume, pvInfo *volume.PVInfo) bool {
 	return false
 }
 
-// WaitRestoreExecHook waits for restore exec hooks to finish then update the hook execution results
-func (ctx *finalizerContext) WaitRestoreExecHook() (errs results.Result) {
+// WaitRestoreExecHook waits for restore exec hooks to finish then update the hook execution results.
+// Failed verification hooks with the Warn policy are returned as warnings.
+func (ctx *finalizerContext) WaitRestoreExecHook() (warnings results.Result, errs results.Result) {
 	log := ctx.logger.WithField("restore", ctx.restore.Name)
 	log.Info("Waiting for restore exec hooks starts")
 
@@ -476,13 +553,16 @@ func (ctx *finalizerContext) WaitRestoreExecHook() (errs results.Result) {
 	})
 	if err != nil {
 		errs.Add(ctx.restore.Namespace, err)
-		return errs
+		return warnings, errs
 	}
 	log.Info("Done waiting for restore exec hooks starts")
 
 	for _, ei := range ctx.multiHookTracker.HookErrs(ctx.restore.Name) {
 		errs.Add(ei.Namespace, ei.Err)
 	}
+	for _, wi := range ctx.multiHookTracker.HookWarnings(ctx.restore.Name) {
+		warnings.Add(wi.Namespace, wi.Err)
+	}
 
 	// update hooks execution status
 	updated := ctx.restore.DeepCopy()
@@ -492,6 +572,14 @@ func (ctx *finalizerContext) WaitRestoreExecHook() (errs results.Result) {
 	updated.Status.HookStatus.HooksAttempted, updated.Status.HookStatus.HooksFailed = ctx.multiHookTracker.Stat(ctx.restore.Name)
 	log.Debugf("hookAttempted: %d, hookFailed: %d", updated.Status.HookStatus.HooksAttempted, updated.Status.HookStatus.HooksFailed)
 
+	if verificationsAttempted, verificationsFailed := ctx.multiHookTracker.VerificationStat(ctx.restore.Name); verificationsAttempted > 0 {
+		updated.Status.VerificationStatus = &velerov1api.VerificationStatus{
+			VerificationsAttempted: verificationsAttempted,
+			VerificationsFailed:    verificationsFailed,
+		}
+		log.Debugf("verificationsAttempted: %d, verificationsFailed: %d", verificationsAttempted, verificationsFailed)
+	}
+
 	if err := kubeutil.PatchResource(ctx.restore, updated, ctx.crClient); err != nil {
 		log.WithError(errors.WithStack((err))).Error("Updating restore status")
 		errs.Add(ctx.restore.Namespace, err)
@@ -500,5 +588,55 @@ func (ctx *finalizerContext) WaitRestoreExecHook() (errs results.Result) {
 	// delete the hook data for this restore
 	ctx.multiHookTracker.Delete(ctx.restore.Name)
 
+	return warnings, errs
+}
+
+// resumeClusterAPIClusters resumes the reconciliation of the Cluster API Clusters paused by the
+// cluster-api-pause restore item action. Clusters which were already paused in the backup don't
+// carry the annotation and are left untouched.
+func (ctx *finalizerContext) resumeClusterAPIClusters() (errs results.Result) {
+	for key, items := range ctx.restoredResourceList {
+		// the key of restoredResourceList is like "cluster.x-k8s.io/v1beta1/Cluster"
+		gv, kind := path.Split(key)
+		if kind != "Cluster" || !strings.HasPrefix(gv, kuberesource.ClusterAPIClusters.Group+"/") {
+			continue
+		}
+		gvk := schema.FromAPIVersionAndKind(strings.TrimSuffix(gv, "/"), kind)
+
+		for _, item := range items {
+			// the format of item is like "namespace/name(status)"
+			namespacedName, _, _ := strings.Cut(item, "(")
+			namespace, name, _ := strings.Cut(namespacedName, "/")
+			log := ctx.logger.WithField("cluster", namespacedName)
+
+			cluster := &unstructured.Unstructured{}
+			cluster.SetGroupVersionKind(gvk)
+			if err := ctx.crClient.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, cluster); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				log.WithError(err).Error("error getting cluster")
+				errs.Add(namespace, errors.Wrapf(err, "error getting cluster %s", namespacedName))
+				continue
+			}
+			if _, ok := cluster.GetAnnotations()[velerov1api.ClusterAPIPausedByRestoreAnnotation]; !ok {
+				continue
+			}
+
+			updated := cluster.DeepCopy()
+			annotations := updated.GetAnnotations()
+			delete(annotations, velerov1api.ClusterAPIPausedByRestoreAnnotation)
+			updated.SetAnnotations(annotations)
+			unstructured.RemoveNestedField(updated.Object, "spec", "paused")
+
+			if err := ctx.crClient.Patch(context.Background(), updated, client.MergeFrom(cluster)); err != nil {
+				log.WithError(err).Error("error resuming reconciliation of cluster")
+				errs.Add(namespace, errors.Wrapf(err, "error resuming reconciliation of cluster %s", namespacedName))
+				continue
+			}
+			log.Info("Resumed reconciliation of cluster")
+		}
+	}
+
 	return errs
 }
@@ -17,8 +17,11 @@ limitations under the License.
 package controller
 
 import (
+	"compress/gzip"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"syscall"
 	"testing"
 	"time"
@@ -29,6 +32,7 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	testclocks "k8s.io/utils/clock/testing"
@@ -142,6 +146,7 @@ func TestRestoreFinalizerReconcile(t *testing.T) {
 				fakeClient,
 				hook.NewMultiHookTracker(),
 				10*time.Minute,
+				nil,
 			)
 			r.clock = testclocks.NewFakeClock(now)
 
@@ -206,6 +211,7 @@ func TestUpdateResult(t *testing.T) {
 		fakeClient,
 		hook.NewMultiHookTracker(),
 		10*time.Minute,
+		nil,
 	)
 	restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result()
 	res := map[string]results.Result{"warnings": {}, "errors": {}}
@@ -217,6 +223,54 @@ func TestUpdateResult(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestUpdateMappingReport(t *testing.T) {
+	var (
+		fakeClient = velerotest.NewFakeControllerRuntimeClientBuilder(t).WithObjects(
+			builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-2").Result(),
+			builder.ForPersistentVolumeClaim("ns-1", "pvc-2").Result(),
+		).Build()
+		logger        = velerotest.NewLogger()
+		pluginManager = &pluginmocks.Manager{}
+		backupStore   = &persistencemocks.BackupStore{}
+	)
+
+	r := NewRestoreFinalizerReconciler(
+		logger,
+		velerov1api.DefaultNamespace,
+		fakeClient,
+		func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		NewFakeSingleObjectBackupStoreGetter(backupStore),
+		metrics.NewServerMetrics(),
+		fakeClient,
+		hook.NewMultiHookTracker(),
+		10*time.Minute,
+		nil,
+	)
+	restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result()
+	report := &velerov1api.RestoreMappingReport{
+		PersistentVolumes: []velerov1api.PersistentVolumeMapping{
+			{Namespace: "ns-1", Name: "pvc-1", BackupPersistentVolume: "pv-1"},
+			{Namespace: "ns-1", Name: "pvc-2", BackupPersistentVolume: "pv-3"},
+			{Namespace: "ns-1", Name: "pvc-3", BackupPersistentVolume: "pv-4"},
+		},
+	}
+
+	var updated velerov1api.RestoreMappingReport
+	backupStore.On("GetRestoreMappingReport", restore.Name).Return(report, nil)
+	backupStore.On("PutRestoreMappingReport", restore.Name, mock.Anything).Run(func(args mock.Arguments) {
+		gzr, err := gzip.NewReader(args.Get(1).(io.Reader))
+		require.NoError(t, err)
+		require.NoError(t, json.NewDecoder(gzr).Decode(&updated))
+	}).Return(nil)
+
+	require.NoError(t, r.updateMappingReport(context.Background(), backupStore, restore))
+	assert.Equal(t, []velerov1api.PersistentVolumeMapping{
+		{Namespace: "ns-1", Name: "pvc-1", BackupPersistentVolume: "pv-1", PersistentVolume: "pv-2"},
+		{Namespace: "ns-1", Name: "pvc-2", BackupPersistentVolume: "pv-3"},
+		{Namespace: "ns-1", Name: "pvc-3", BackupPersistentVolume: "pv-4"},
+	}, updated.PersistentVolumes)
+}
+
 func TestPatchDynamicPVWithVolumeInfo(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -550,7 +604,7 @@ func TestWaitRestoreExecHook(t *testing.T) {
 			}()
 		}
 
-		errs := ctx.WaitRestoreExecHook()
+		_, errs := ctx.WaitRestoreExecHook()
 		assert.Len(t, errs.Namespaces, tc.expectedHookErrs)
 
 		updated := &velerov1api.Restore{}
@@ -561,6 +615,50 @@ func TestWaitRestoreExecHook(t *testing.T) {
 	}
 }
 
+func TestResumeClusterAPIClusters(t *testing.T) {
+	newCluster := func(name string, paused bool, annotations map[string]string) *unstructured.Unstructured {
+		cluster := &unstructured.Unstructured{}
+		cluster.SetAPIVersion("cluster.x-k8s.io/v1beta1")
+		cluster.SetKind("Cluster")
+		cluster.SetNamespace("capi")
+		cluster.SetName(name)
+		cluster.SetAnnotations(annotations)
+		if paused {
+			require.NoError(t, unstructured.SetNestedField(cluster.Object, true, "spec", "paused"))
+		}
+		return cluster
+	}
+
+	fakeClient := velerotest.NewFakeControllerRuntimeClientBuilder(t).Build()
+	require.NoError(t, fakeClient.Create(context.Background(), newCluster("paused-by-restore", true, map[string]string{velerov1api.ClusterAPIPausedByRestoreAnnotation: "true", "foo": "bar"})))
+	require.NoError(t, fakeClient.Create(context.Background(), newCluster("paused-in-backup", true, nil)))
+
+	ctx := &finalizerContext{
+		logger:   velerotest.NewLogger(),
+		crClient: fakeClient,
+		restoredResourceList: map[string][]string{
+			"cluster.x-k8s.io/v1beta1/Cluster": {
+				"capi/paused-by-restore(created)",
+				"capi/paused-in-backup(created)",
+				"capi/deleted(created)",
+			},
+			"v1/Secret": {"capi/paused-by-restore-kubeconfig(created)"},
+		},
+	}
+
+	errs := ctx.resumeClusterAPIClusters()
+	assert.True(t, errs.IsEmpty())
+
+	for name, expectedPaused := range map[string]bool{"paused-by-restore": false, "paused-in-backup": true} {
+		cluster := newCluster(name, false, nil)
+		require.NoError(t, fakeClient.Get(context.Background(), crclient.ObjectKey{Namespace: "capi", Name: name}, cluster))
+		paused, _, err := unstructured.NestedBool(cluster.Object, "spec", "paused")
+		require.NoError(t, err)
+		assert.Equal(t, expectedPaused, paused, name)
+		assert.NotContains(t, cluster.GetAnnotations(), velerov1api.ClusterAPIPausedByRestoreAnnotation, name)
+	}
+}
+
 // test finishprocessing with mocks of kube client to simulate connection refused
 func Test_restoreFinalizerReconciler_finishProcessing(t *testing.T) {
 	type args struct {
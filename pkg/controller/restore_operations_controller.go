@@ -37,6 +37,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/util/conditions"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
@@ -143,16 +144,27 @@ func (r *restoreOperationsReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return ctrl.Result{}, errors.Wrap(err, "error getting backup info")
 	}
 
+	restoreLocation, err := fetchRestoreStorageLocation(r.Client, restore, info)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "error getting the staging storage location")
+	}
+
 	pluginManager := r.newPluginManager(r.logger)
 	defer pluginManager.CleanupClients()
-	backupStore, err := r.backupStoreGetter.Get(info.location, pluginManager, r.logger)
+	backupStore, err := r.backupStoreGetter.Get(restoreLocation, pluginManager, r.logger)
 	if err != nil {
 		return ctrl.Result{}, errors.Wrap(err, "error getting backup store")
 	}
 
+	// the operations aren't uploaded to a read-only location
+	uploadStore := backupStore
+	if persistence.IsReadOnly(restoreLocation) {
+		uploadStore = nil
+	}
+
 	operations, err := r.itemOperationsMap.GetOperationsForRestore(backupStore, restore.Name)
 	if err != nil {
-		err2 := r.updateRestoreAndOperationsJSON(ctx, original, restore, backupStore, &itemoperationmap.OperationsForRestore{ErrsSinceUpdate: []string{err.Error()}}, false, false)
+		err2 := r.updateRestoreAndOperationsJSON(ctx, original, restore, uploadStore, &itemoperationmap.OperationsForRestore{ErrsSinceUpdate: []string{err.Error()}}, false, false)
 		if err2 != nil {
 			return ctrl.Result{}, errors.Wrap(err2, "error updating Restore")
 		}
@@ -189,7 +201,7 @@ func (r *restoreOperationsReconciler) Reconcile(ctx context.Context, req ctrl.Re
 			restore.Status.Phase = velerov1api.RestorePhaseFinalizingPartiallyFailed
 		}
 	}
-	err = r.updateRestoreAndOperationsJSON(ctx, original, restore, backupStore, operations, changes, completionChanges)
+	err = r.updateRestoreAndOperationsJSON(ctx, original, restore, uploadStore, operations, changes, completionChanges)
 	if err != nil {
 		return ctrl.Result{}, errors.Wrap(err, "error updating Restore")
 	}
@@ -236,6 +248,8 @@ func (r *restoreOperationsReconciler) updateRestoreAndOperationsJSON(
 			}
 		}
 		// update restore
+		conditions.SetRestoreConditions(restore, r.clock.Now())
+		conditions.SetObservedGeneration(original, restore)
 		err := r.Client.Patch(ctx, restore, client.MergeFrom(original))
 		if err != nil {
 			removeIfComplete = false
@@ -244,6 +258,7 @@ func (r *restoreOperationsReconciler) updateRestoreAndOperationsJSON(
 	} else if completionChanges {
 		// If restore is still incomplete and no new errors are found but there are some new operations
 		// completed, patch restore to reflect new completion numbers, but don't upload detailed json file
+		conditions.SetObservedGeneration(original, restore)
 		err := r.Client.Patch(ctx, restore, client.MergeFrom(original))
 		if err != nil {
 			return errors.Wrapf(err, "error updating Restore %s", restore.Name)
@@ -325,6 +340,16 @@ func getRestoreItemOperationProgress(
 				completedCount++
 				continue
 			}
+			// cancel operation if requested by the user
+			if itemoperation.CancelRequested(restore.Annotations, operation.Spec.OperationID) {
+				_ = ria.Cancel(operation.Spec.OperationID, restore)
+				operation.Status.Phase = itemoperation.OperationPhaseFailed
+				operation.Status.Error = "Asynchronous action canceled by user"
+				errs = append(errs, operation.Status.Error)
+				changes = true
+				failedCount++
+				continue
+			}
 			// cancel operation if past timeout period
 			if operation.Status.Created.Time.Add(restore.Spec.ItemOperationTimeout.Duration).Before(time.Now()) {
 				_ = ria.Cancel(operation.Spec.OperationID, restore)
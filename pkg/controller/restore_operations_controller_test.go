@@ -272,6 +272,37 @@ func TestRestoreOperationsReconcile(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "WaitingForPluginOperations restore with canceled operations is FinalizingPartiallyFailed",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-17").
+				Backup("backup-1").
+				ItemOperationTimeout(60*time.Minute).
+				ObjectMeta(builder.WithUID("foo-17"), builder.WithAnnotations(velerov1api.CancelOperationsAnnotation, "operation-17")).
+				Phase(velerov1api.RestorePhaseWaitingForPluginOperations).Result(),
+			backup:            defaultBackup().StorageLocation("default").Result(),
+			backupLocation:    defaultBackupLocation,
+			operationComplete: false,
+			expectPhase:       velerov1api.RestorePhaseFinalizingPartiallyFailed,
+			restoreOperations: []*itemoperation.RestoreOperation{
+				{
+					Spec: itemoperation.RestoreOperationSpec{
+						RestoreName:       "restore-17",
+						RestoreUID:        "foo-17",
+						RestoreItemAction: "foo-17",
+						ResourceIdentifier: velero.ResourceIdentifier{
+							GroupResource: kuberesource.Pods,
+							Namespace:     "ns-1",
+							Name:          "pod-1",
+						},
+						OperationID: "operation-17",
+					},
+					Status: itemoperation.OperationStatus{
+						Phase:   itemoperation.OperationPhaseInProgress,
+						Created: &metav1Now,
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -300,6 +331,7 @@ func TestRestoreOperationsReconcile(t *testing.T) {
 						Completed: test.operationComplete,
 						Err:       test.operationErr,
 					}, nil)
+				ria.On("Cancel", operation.Spec.OperationID, mock.Anything).Return(nil)
 				restorePluginManager.On("GetRestoreItemActionV2", operation.Spec.RestoreItemAction).Return(ria, nil)
 			}
 
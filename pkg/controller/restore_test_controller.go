@@ -0,0 +1,440 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	cron "github.com/robfig/cron/v3"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	clocks "k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/constant"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/targetcluster"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	"github.com/vmware-tanzu/velero/pkg/util/conditions"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// restoreTestReconciler runs the restore tests on their schedule: it restores the latest completed
+// backup into scratch namespaces, of the cluster of the server or of a scratch cluster, records
+// whether the restore completed and deletes the scratch namespaces.
+type restoreTestReconciler struct {
+	client.Client
+	logger         logrus.FieldLogger
+	clock          clocks.WithTickerAndDelayedExecution
+	targetClusters targetcluster.Getter
+}
+
+// NewRestoreTestReconciler constructs a new restoreTestReconciler. targetClusters is nil unless
+// the server runs in the hub mode, the only one the restore tests with a scratch cluster can run in.
+func NewRestoreTestReconciler(logger logrus.FieldLogger, client client.Client, targetClusters targetcluster.Getter) *restoreTestReconciler {
+	return &restoreTestReconciler{
+		Client:         client,
+		logger:         logger,
+		clock:          clocks.RealClock{},
+		targetClusters: targetClusters,
+	}
+}
+
+// The restore tests are enqueued every minute, like the schedules, to start their runs when they're
+// due and to follow the restores of the runs in progress.
+func (r *restoreTestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	s := kube.NewPeriodicalEnqueueSource(r.logger.WithField("controller", constant.ControllerRestoreTest), mgr.GetClient(), &velerov1api.RestoreTestList{}, scheduleSyncPeriod, kube.PeriodicalEnqueueSourceOption{})
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&velerov1api.RestoreTest{}, builder.WithPredicates(kube.SpecChangePredicate{})).
+		WatchesRawSource(s).
+		Named(constant.ControllerRestoreTest).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=restoretests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=restoretests/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=restores,verbs=get;create;delete
+// +kubebuilder:rbac:groups=velero.io,resources=backups,verbs=list
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;create;delete
+
+func (r *restoreTestReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithField("restoreTest", req.String())
+
+	restoreTest := &velerov1api.RestoreTest{}
+	if err := r.Get(ctx, req.NamespacedName, restoreTest); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("restore test not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrapf(err, "error getting restore test %s", req.String())
+	}
+	original := restoreTest.DeepCopy()
+
+	cronSchedule, errs := validateRestoreTest(restoreTest)
+	if restoreTest.Spec.ScratchCluster != "" && r.targetClusters == nil {
+		errs = append(errs, "scratchCluster can't be used as the server doesn't run in the hub mode")
+	}
+	if len(errs) > 0 {
+		restoreTest.Status.Phase = velerov1api.RestoreTestPhaseFailedValidation
+		restoreTest.Status.ValidationErrors = errs
+	} else {
+		restoreTest.Status.Phase = velerov1api.RestoreTestPhaseEnabled
+		restoreTest.Status.ValidationErrors = nil
+	}
+	conditions.SetRestoreTestConditions(restoreTest, r.clock.Now())
+
+	var err error
+	if restoreTest.Status.Phase == velerov1api.RestoreTestPhaseEnabled {
+		if lastRun := restoreTest.Status.LastRun; lastRun != nil && lastRun.Result == velerov1api.RestoreTestResultInProgress {
+			err = r.checkRun(ctx, restoreTest, log)
+		} else if !restoreTest.Spec.Paused && r.isDue(restoreTest, cronSchedule) {
+			err = r.startRun(ctx, restoreTest, log)
+		}
+	}
+
+	if !equality.Semantic.DeepEqual(original.Status, restoreTest.Status) {
+		conditions.SetObservedGeneration(original, restoreTest)
+		if patchErr := r.Patch(ctx, restoreTest, client.MergeFrom(original)); patchErr != nil {
+			return ctrl.Result{}, errors.Wrapf(patchErr, "error updating restore test %s", req.String())
+		}
+	}
+
+	return ctrl.Result{}, err
+}
+
+// validateRestoreTest returns the parsed schedule of the restore test, and the errors of its spec.
+func validateRestoreTest(restoreTest *velerov1api.RestoreTest) (cron.Schedule, []string) {
+	var validationErrors []string
+
+	var schedule cron.Schedule
+	if restoreTest.Spec.Schedule == "" {
+		// cron.Parse panics if schedule is empty
+		validationErrors = append(validationErrors, "Schedule must be a non-empty valid Cron expression")
+	} else if res, err := cron.ParseStandard(restoreTest.Spec.Schedule); err != nil {
+		validationErrors = append(validationErrors, fmt.Sprintf("invalid schedule: %v", err))
+	} else {
+		schedule = res
+	}
+
+	if len(restoreTest.Spec.IncludedNamespaces) == 0 {
+		validationErrors = append(validationErrors, "includedNamespaces must list the namespaces to restore")
+	}
+	if restoreTest.Spec.ScratchCluster != "" && restoreTest.Spec.ScratchNamespacePrefix != "" {
+		validationErrors = append(validationErrors, "scratchNamespacePrefix can't be set along with scratchCluster")
+	}
+	for _, namespace := range restoreTest.Spec.IncludedNamespaces {
+		if strings.Contains(namespace, "*") {
+			validationErrors = append(validationErrors, fmt.Sprintf("includedNamespaces can't contain wildcards: %s", namespace))
+			continue
+		}
+		if restoreTest.Spec.ScratchCluster != "" {
+			continue
+		}
+		scratch := scratchNamespace(restoreTest, namespace)
+		if errs := validation.IsDNS1123Label(scratch); len(errs) > 0 {
+			validationErrors = append(validationErrors, fmt.Sprintf("invalid scratch namespace %s: %s", scratch, strings.Join(errs, "; ")))
+		}
+		if scratch == namespace {
+			validationErrors = append(validationErrors, fmt.Sprintf("the scratch namespace of %s must be a different namespace", namespace))
+		}
+	}
+
+	return schedule, validationErrors
+}
+
+// scratchNamespace returns the name of the scratch namespace the namespace is restored into, the
+// namespace itself when it's restored into a scratch cluster.
+func scratchNamespace(restoreTest *velerov1api.RestoreTest, namespace string) string {
+	if restoreTest.Spec.ScratchCluster != "" {
+		return namespace
+	}
+	prefix := restoreTest.Spec.ScratchNamespacePrefix
+	if prefix == "" {
+		prefix = velerov1api.DefaultScratchNamespacePrefix
+	}
+	return label.GetValidName(prefix + namespace)
+}
+
+// isDue returns whether the next run of the restore test is due.
+func (r *restoreTestReconciler) isDue(restoreTest *velerov1api.RestoreTest, cronSchedule cron.Schedule) bool {
+	lastRunTime := restoreTest.CreationTimestamp.Time
+	if lastRun := restoreTest.Status.LastRun; lastRun != nil && lastRun.StartTimestamp != nil {
+		lastRunTime = lastRun.StartTimestamp.Time
+	}
+	return r.clock.Now().After(cronSchedule.Next(lastRunTime))
+}
+
+// startRun starts a run of the restore test by restoring the latest completed backup, once the
+// scratch namespaces of the previous run are gone.
+func (r *restoreTestReconciler) startRun(ctx context.Context, restoreTest *velerov1api.RestoreTest, log logrus.FieldLogger) error {
+	scratchClient, err := r.scratchClient(ctx, restoreTest)
+	if err != nil {
+		return err
+	}
+
+	previousRestore := ""
+	if previous := restoreTest.Status.LastRun; previous != nil {
+		previousRestore = previous.Restore
+	}
+	deleting, err := r.deleteScratchNamespaces(ctx, scratchClient, restoreTest, previousRestore, log)
+	if err != nil {
+		return err
+	}
+	if deleting {
+		log.Info("Restore test is due, waiting for the scratch namespaces of the previous run to be deleted")
+		return nil
+	}
+
+	now := r.clock.Now()
+	run := &velerov1api.RestoreTestRun{StartTimestamp: &metav1.Time{Time: now}}
+	fail := func(message string) {
+		log.Warnf("Restore test failed, %s", message)
+		run.Result = velerov1api.RestoreTestResultFailed
+		run.Message = message
+		run.CompletionTimestamp = &metav1.Time{Time: now}
+		restoreTest.Status.LastRun = run
+	}
+
+	backup, err := r.latestBackup(ctx, restoreTest)
+	if err != nil {
+		return err
+	}
+	if backup == nil {
+		fail("no completed backup to restore")
+		return nil
+	}
+
+	// the restore of the previous run is kept until now so that it can be looked into
+	if previousRestore != "" {
+		if err := r.Delete(ctx, &velerov1api.Restore{ObjectMeta: metav1.ObjectMeta{Namespace: restoreTest.Namespace, Name: previousRestore}}); err != nil && !apierrors.IsNotFound(err) {
+			log.WithError(err).Warnf("Failed to delete restore %s of the previous run", previousRestore)
+		}
+	}
+
+	restoreName := fmt.Sprintf("%s-%s", restoreTest.Name, now.Format("20060102150405"))
+
+	// the scratch namespaces are created labelled with the name of the restore, so that only the
+	// namespaces of the runs are deleted, never an existing namespace with the same name
+	var created []*corev1api.Namespace
+	for _, namespace := range restoreTest.Spec.IncludedNamespaces {
+		ns := &corev1api.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: scratchNamespace(restoreTest, namespace),
+				Labels: map[string]string{
+					velerov1api.RestoreNameLabel:     label.GetValidName(restoreName),
+					velerov1api.RestoreTestNameLabel: label.GetValidName(restoreTest.Name),
+				},
+			},
+		}
+		err := scratchClient.Create(ctx, ns)
+		if err == nil {
+			created = append(created, ns)
+			continue
+		}
+		for _, ns := range created {
+			if err := scratchClient.Delete(ctx, ns); err != nil && !apierrors.IsNotFound(err) {
+				log.WithError(err).Warnf("Failed to delete scratch namespace %s", ns.Name)
+			}
+		}
+		if apierrors.IsAlreadyExists(err) {
+			fail(fmt.Sprintf("scratch namespace %s already exists and wasn't created by the restore test", ns.Name))
+			return nil
+		}
+		return errors.Wrapf(err, "error creating scratch namespace %s", ns.Name)
+	}
+
+	namespaceMapping := make(map[string]string, len(restoreTest.Spec.IncludedNamespaces))
+	for _, namespace := range restoreTest.Spec.IncludedNamespaces {
+		if scratch := scratchNamespace(restoreTest, namespace); scratch != namespace {
+			namespaceMapping[namespace] = scratch
+		}
+	}
+	restore := &velerov1api.Restore{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: restoreTest.Namespace,
+			Name:      restoreName,
+			Labels: map[string]string{
+				velerov1api.RestoreTestNameLabel: label.GetValidName(restoreTest.Name),
+			},
+		},
+		Spec: velerov1api.RestoreSpec{
+			BackupName:         backup.Name,
+			IncludedNamespaces: restoreTest.Spec.IncludedNamespaces,
+			NamespaceMapping:   namespaceMapping,
+			// the cluster-scoped resources, like the PersistentVolumes, aren't namespaced by
+			// the mapping and would overwrite the ones of the cluster
+			IncludeClusterResources: boolptr.False(),
+			TargetCluster:           restoreTest.Spec.ScratchCluster,
+			Hooks:                   restoreTest.Spec.Hooks,
+		},
+	}
+	if err := r.Create(ctx, restore); err != nil {
+		for _, ns := range created {
+			if err := scratchClient.Delete(ctx, ns); err != nil && !apierrors.IsNotFound(err) {
+				log.WithError(err).Warnf("Failed to delete scratch namespace %s", ns.Name)
+			}
+		}
+		return errors.Wrapf(err, "error creating restore of restore test %s", restoreTest.Name)
+	}
+	log.WithFields(logrus.Fields{"backup": backup.Name, "restore": restore.Name}).Info("Restore test started")
+
+	run.Backup = backup.Name
+	run.Restore = restore.Name
+	run.Result = velerov1api.RestoreTestResultInProgress
+	restoreTest.Status.LastRun = run
+	return nil
+}
+
+// checkRun records the result of the run in progress once its restore is done, and completes it
+// once its scratch namespaces are deleted.
+func (r *restoreTestReconciler) checkRun(ctx context.Context, restoreTest *velerov1api.RestoreTest, log logrus.FieldLogger) error {
+	run := restoreTest.Status.LastRun
+	log = log.WithField("restore", run.Restore)
+
+	restore := &velerov1api.Restore{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: restoreTest.Namespace, Name: run.Restore}, restore); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "error getting restore %s", run.Restore)
+		}
+		run.Message = fmt.Sprintf("restore %s not found", run.Restore)
+	} else {
+		switch restore.Status.Phase {
+		case velerov1api.RestorePhaseCompleted:
+		case velerov1api.RestorePhasePartiallyFailed, velerov1api.RestorePhaseFailed, velerov1api.RestorePhaseFailedValidation:
+			run.Message = restoreTestFailure(restore)
+		default:
+			log.Debug("Restore of the restore test is in progress")
+			return nil
+		}
+	}
+
+	scratchClient, err := r.scratchClient(ctx, restoreTest)
+	if err != nil {
+		return err
+	}
+	deleting, err := r.deleteScratchNamespaces(ctx, scratchClient, restoreTest, run.Restore, log)
+	if err != nil || deleting {
+		return err
+	}
+
+	run.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+	if run.Message != "" {
+		run.Result = velerov1api.RestoreTestResultFailed
+		log.Warnf("Restore test failed: %s", run.Message)
+	} else {
+		run.Result = velerov1api.RestoreTestResultPassed
+		restoreTest.Status.LastPassed = run.StartTimestamp.DeepCopy()
+		log.Info("Restore test passed")
+	}
+	return nil
+}
+
+// restoreTestFailure describes why the restore didn't complete.
+func restoreTestFailure(restore *velerov1api.Restore) string {
+	message := fmt.Sprintf("restore %s is %s", restore.Name, restore.Status.Phase)
+	switch {
+	case restore.Status.FailureReason != "":
+		message += ": " + restore.Status.FailureReason
+	case len(restore.Status.ValidationErrors) > 0:
+		message += ": " + strings.Join(restore.Status.ValidationErrors, "; ")
+	case restore.Status.Errors > 0:
+		message += fmt.Sprintf(" with %d errors", restore.Status.Errors)
+	}
+	return message
+}
+
+// scratchClient returns the client of the cluster the scratch namespaces of the restore test are
+// in.
+func (r *restoreTestReconciler) scratchClient(ctx context.Context, restoreTest *velerov1api.RestoreTest) (client.Client, error) {
+	if restoreTest.Spec.ScratchCluster == "" {
+		return r.Client, nil
+	}
+	cluster, err := getTargetCluster(ctx, r.targetClusters, restoreTest.Spec.ScratchCluster)
+	if err != nil {
+		return nil, err
+	}
+	return cluster.CRClient, nil
+}
+
+// deleteScratchNamespaces deletes the scratch namespaces created for the restore, and returns
+// whether some of them still exist. The namespaces with the same names which weren't created for
+// the restore are left as they are.
+func (r *restoreTestReconciler) deleteScratchNamespaces(ctx context.Context, scratchClient client.Client, restoreTest *velerov1api.RestoreTest, restoreName string, log logrus.FieldLogger) (bool, error) {
+	if restoreName == "" {
+		return false, nil
+	}
+	deleting := false
+	for _, namespace := range restoreTest.Spec.IncludedNamespaces {
+		name := scratchNamespace(restoreTest, namespace)
+		ns := &corev1api.Namespace{}
+		if err := scratchClient.Get(ctx, client.ObjectKey{Name: name}, ns); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return false, errors.Wrapf(err, "error getting scratch namespace %s", name)
+		}
+
+		if ns.Labels[velerov1api.RestoreNameLabel] != label.GetValidName(restoreName) {
+			continue
+		}
+
+		deleting = true
+		if ns.DeletionTimestamp != nil {
+			continue
+		}
+		log.Infof("Deleting scratch namespace %s", ns.Name)
+		if err := scratchClient.Delete(ctx, ns); err != nil && !apierrors.IsNotFound(err) {
+			return false, errors.Wrapf(err, "error deleting scratch namespace %s", ns.Name)
+		}
+	}
+	return deleting, nil
+}
+
+// latestBackup returns the latest completed backup of the schedule of the restore test, or of all
+// the backups if it has no schedule.
+func (r *restoreTestReconciler) latestBackup(ctx context.Context, restoreTest *velerov1api.RestoreTest) (*velerov1api.Backup, error) {
+	options := []client.ListOption{client.InNamespace(restoreTest.Namespace)}
+	if restoreTest.Spec.ScheduleName != "" {
+		options = append(options, client.MatchingLabels{velerov1api.ScheduleNameLabel: restoreTest.Spec.ScheduleName})
+	}
+	backups := &velerov1api.BackupList{}
+	if err := r.List(ctx, backups, options...); err != nil {
+		return nil, errors.Wrap(err, "error listing backups")
+	}
+
+	var latest *velerov1api.Backup
+	for i := range backups.Items {
+		backup := &backups.Items[i]
+		if backup.Status.Phase != velerov1api.BackupPhaseCompleted || backup.Status.StartTimestamp == nil {
+			continue
+		}
+		if latest == nil || backup.Status.StartTimestamp.After(latest.Status.StartTimestamp.Time) {
+			latest = backup
+		}
+	}
+	return latest, nil
+}
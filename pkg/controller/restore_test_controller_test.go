@@ -0,0 +1,226 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testclocks "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/targetcluster"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+func TestValidateRestoreTest(t *testing.T) {
+	tests := []struct {
+		name       string
+		spec       velerov1api.RestoreTestSpec
+		expectErrs int
+	}{
+		{
+			name: "valid",
+			spec: velerov1api.RestoreTestSpec{Schedule: "0 3 * * *", IncludedNamespaces: []string{"app"}},
+		},
+		{
+			name:       "invalid schedule",
+			spec:       velerov1api.RestoreTestSpec{Schedule: "every day", IncludedNamespaces: []string{"app"}},
+			expectErrs: 1,
+		},
+		{
+			name:       "no namespaces",
+			spec:       velerov1api.RestoreTestSpec{Schedule: "0 3 * * *"},
+			expectErrs: 1,
+		},
+		{
+			name:       "wildcard namespace",
+			spec:       velerov1api.RestoreTestSpec{Schedule: "0 3 * * *", IncludedNamespaces: []string{"app-*"}},
+			expectErrs: 1,
+		},
+		{
+			name: "scratch cluster",
+			spec: velerov1api.RestoreTestSpec{Schedule: "0 3 * * *", IncludedNamespaces: []string{"app"}, ScratchCluster: "scratch"},
+		},
+		{
+			name:       "scratch cluster with a scratch namespace prefix",
+			spec:       velerov1api.RestoreTestSpec{Schedule: "0 3 * * *", IncludedNamespaces: []string{"app"}, ScratchCluster: "scratch", ScratchNamespacePrefix: "test-"},
+			expectErrs: 1,
+		},
+		{
+			name:       "invalid scratch namespace",
+			spec:       velerov1api.RestoreTestSpec{Schedule: "0 3 * * *", IncludedNamespaces: []string{"app"}, ScratchNamespacePrefix: "Test_"},
+			expectErrs: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, errs := validateRestoreTest(&velerov1api.RestoreTest{Spec: test.spec})
+			assert.Len(t, errs, test.expectErrs)
+		})
+	}
+}
+
+func TestRestoreTestReconcile(t *testing.T) {
+	fakeClock := testclocks.NewFakeClock(time.Now())
+	now := fakeClock.Now()
+
+	restoreTest := &velerov1api.RestoreTest{
+		ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "drill"},
+		Spec: velerov1api.RestoreTestSpec{
+			Schedule:           "0 3 * * *",
+			ScheduleName:       "daily",
+			IncludedNamespaces: []string{"app"},
+		},
+	}
+	backup := func(name string, age time.Duration, phase velerov1api.BackupPhase) *velerov1api.Backup {
+		return builder.ForBackup(velerov1api.DefaultNamespace, name).
+			ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "daily")).
+			StartTimestamp(now.Add(-age)).
+			Phase(phase).
+			Result()
+	}
+	// not created by the restore test
+	existing := &corev1api.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "restore-test-app"}}
+
+	fakeClient := velerotest.NewFakeControllerRuntimeClient(t,
+		restoreTest,
+		backup("daily-1", 48*time.Hour, velerov1api.BackupPhaseCompleted),
+		backup("daily-2", 24*time.Hour, velerov1api.BackupPhaseCompleted),
+		backup("daily-3", time.Hour, velerov1api.BackupPhaseFailed),
+		existing,
+	)
+	reconciler := NewRestoreTestReconciler(velerotest.NewLogger(), fakeClient, nil)
+	reconciler.clock = fakeClock
+
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "drill"}}
+	reconcile := func() *velerov1api.RestoreTest {
+		_, err := reconciler.Reconcile(context.TODO(), req)
+		require.NoError(t, err)
+		result := &velerov1api.RestoreTest{}
+		require.NoError(t, fakeClient.Get(context.TODO(), req.NamespacedName, result))
+		return result
+	}
+
+	// the run fails rather than restoring into an existing namespace, which is left as it is
+	result := reconcile()
+	assert.Equal(t, velerov1api.RestoreTestPhaseEnabled, result.Status.Phase)
+	require.NotNil(t, result.Status.LastRun)
+	assert.Equal(t, velerov1api.RestoreTestResultFailed, result.Status.LastRun.Result)
+	assert.Equal(t, "scratch namespace restore-test-app already exists and wasn't created by the restore test", result.Status.LastRun.Message)
+	assert.Empty(t, result.Status.LastRun.Restore)
+	require.NoError(t, fakeClient.Get(context.TODO(), types.NamespacedName{Name: existing.Name}, &corev1api.Namespace{}))
+
+	require.NoError(t, fakeClient.Delete(context.TODO(), existing))
+	fakeClock.Step(25 * time.Hour)
+
+	// the latest completed backup is restored into the scratch namespace created for the restore
+	result = reconcile()
+	require.NotNil(t, result.Status.LastRun)
+	assert.Equal(t, velerov1api.RestoreTestResultInProgress, result.Status.LastRun.Result)
+	assert.Equal(t, "daily-2", result.Status.LastRun.Backup)
+	restore := &velerov1api.Restore{}
+	require.NoError(t, fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: result.Status.LastRun.Restore}, restore))
+	assert.Equal(t, "daily-2", restore.Spec.BackupName)
+	assert.Equal(t, map[string]string{"app": "restore-test-app"}, restore.Spec.NamespaceMapping)
+	assert.Equal(t, boolptr.False(), restore.Spec.IncludeClusterResources)
+	assert.Equal(t, "drill", restore.Labels[velerov1api.RestoreTestNameLabel])
+	scratch := &corev1api.Namespace{}
+	require.NoError(t, fakeClient.Get(context.TODO(), types.NamespacedName{Name: "restore-test-app"}, scratch))
+	assert.Equal(t, restore.Name, scratch.Labels[velerov1api.RestoreNameLabel])
+
+	// the run is in progress until the restore is done
+	result = reconcile()
+	assert.Equal(t, velerov1api.RestoreTestResultInProgress, result.Status.LastRun.Result)
+
+	restore.Status.Phase = velerov1api.RestorePhaseCompleted
+	require.NoError(t, fakeClient.Update(context.TODO(), restore))
+
+	// the run passes once the scratch namespace is deleted
+	result = reconcile()
+	assert.Equal(t, velerov1api.RestoreTestResultInProgress, result.Status.LastRun.Result)
+	assert.True(t, apierrors.IsNotFound(fakeClient.Get(context.TODO(), types.NamespacedName{Name: "restore-test-app"}, &corev1api.Namespace{})))
+	result = reconcile()
+	assert.Equal(t, velerov1api.RestoreTestResultPassed, result.Status.LastRun.Result)
+	assert.NotNil(t, result.Status.LastRun.CompletionTimestamp)
+	assert.NotNil(t, result.Status.LastPassed)
+	assert.Empty(t, result.Status.LastRun.Message)
+}
+
+func TestRestoreTestReconcileScratchCluster(t *testing.T) {
+	fakeClock := testclocks.NewFakeClock(time.Now())
+
+	restoreTest := &velerov1api.RestoreTest{
+		ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "drill"},
+		Spec: velerov1api.RestoreTestSpec{
+			Schedule:           "0 3 * * *",
+			IncludedNamespaces: []string{"app"},
+			ScratchCluster:     "scratch",
+		},
+	}
+	fakeClient := velerotest.NewFakeControllerRuntimeClient(t,
+		restoreTest,
+		builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StartTimestamp(fakeClock.Now().Add(-time.Hour)).Phase(velerov1api.BackupPhaseCompleted).Result(),
+	)
+	scratchClient := velerotest.NewFakeControllerRuntimeClient(t)
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "drill"}}
+
+	// the scratch clusters are only available in the hub mode
+	reconciler := NewRestoreTestReconciler(velerotest.NewLogger(), fakeClient, nil)
+	reconciler.clock = fakeClock
+	_, err := reconciler.Reconcile(context.TODO(), req)
+	require.NoError(t, err)
+	result := &velerov1api.RestoreTest{}
+	require.NoError(t, fakeClient.Get(context.TODO(), req.NamespacedName, result))
+	assert.Equal(t, velerov1api.RestoreTestPhaseFailedValidation, result.Status.Phase)
+
+	// the namespace is restored into the scratch cluster under its own name
+	reconciler.targetClusters = fakeTargetClusters{"scratch": &targetcluster.Cluster{Name: "scratch", CRClient: scratchClient}}
+	_, err = reconciler.Reconcile(context.TODO(), req)
+	require.NoError(t, err)
+	require.NoError(t, fakeClient.Get(context.TODO(), req.NamespacedName, result))
+	require.NotNil(t, result.Status.LastRun)
+	assert.Equal(t, velerov1api.RestoreTestResultInProgress, result.Status.LastRun.Result)
+
+	restore := &velerov1api.Restore{}
+	require.NoError(t, fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: result.Status.LastRun.Restore}, restore))
+	assert.Equal(t, "scratch", restore.Spec.TargetCluster)
+	assert.Empty(t, restore.Spec.NamespaceMapping)
+	require.NoError(t, scratchClient.Get(context.TODO(), types.NamespacedName{Name: "app"}, &corev1api.Namespace{}))
+	assert.True(t, apierrors.IsNotFound(fakeClient.Get(context.TODO(), types.NamespacedName{Name: "app"}, &corev1api.Namespace{})))
+}
+
+func TestRestoreTestFailure(t *testing.T) {
+	restore := builder.ForRestore(velerov1api.DefaultNamespace, "drill-1").Phase(velerov1api.RestorePhasePartiallyFailed).Result()
+	restore.Status.Errors = 2
+	assert.Equal(t, "restore drill-1 is PartiallyFailed with 2 errors", restoreTestFailure(restore))
+
+	restore.Status.Phase = velerov1api.RestorePhaseFailed
+	restore.Status.FailureReason = "backup not found"
+	assert.Equal(t, "restore drill-1 is Failed: backup not found", restoreTestFailure(restore))
+}
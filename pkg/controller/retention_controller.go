@@ -0,0 +1,213 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	clocks "k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/constant"
+	"github.com/vmware-tanzu/velero/pkg/util/conditions"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// retentionReconciler creates DeleteBackupRequests for the backups of the schedules which their
+// retention policy doesn't keep.
+type retentionReconciler struct {
+	client.Client
+	logger    logrus.FieldLogger
+	clock     clocks.WithTickerAndDelayedExecution
+	frequency time.Duration
+}
+
+// NewRetentionReconciler constructs a new retentionReconciler.
+func NewRetentionReconciler(
+	logger logrus.FieldLogger,
+	client client.Client,
+	frequency time.Duration,
+) *retentionReconciler {
+	r := &retentionReconciler{
+		Client:    client,
+		logger:    logger,
+		clock:     clocks.RealClock{},
+		frequency: frequency,
+	}
+	if r.frequency <= 0 {
+		r.frequency = defaultGCFrequency
+	}
+	return r
+}
+
+// The schedules are enqueued periodically to prune the backups they created since, and when their
+// spec changes so that a new retention policy is applied right away.
+func (r *retentionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	s := kube.NewPeriodicalEnqueueSource(r.logger.WithField("controller", constant.ControllerRetention), mgr.GetClient(), &velerov1api.ScheduleList{}, r.frequency, kube.PeriodicalEnqueueSourceOption{})
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&velerov1api.Schedule{}, builder.WithPredicates(kube.SpecChangePredicate{})).
+		WatchesRawSource(s).
+		Named(constant.ControllerRetention).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=schedules,verbs=get;list;watch
+// +kubebuilder:rbac:groups=velero.io,resources=backups,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=velero.io,resources=deletebackuprequests,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get
+
+func (r *retentionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithField("schedule", req.String())
+
+	schedule := &velerov1api.Schedule{}
+	if err := r.Get(ctx, req.NamespacedName, schedule); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("schedule not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrapf(err, "error getting schedule %s", req.String())
+	}
+	if schedule.Spec.Retention == nil {
+		return ctrl.Result{}, nil
+	}
+
+	backupList := &velerov1api.BackupList{}
+	if err := r.List(ctx, backupList, client.InNamespace(schedule.Namespace), client.MatchingLabels{
+		velerov1api.ScheduleNameLabel: schedule.Name,
+	}); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "error listing backups of schedule %s", schedule.Name)
+	}
+
+	unavailable := make(map[string]bool)
+	for _, backup := range prunedBackups(backupList.Items, *schedule.Spec.Retention) {
+		log := log.WithField("backup", backup.Name)
+
+		if _, found := unavailable[backup.Spec.StorageLocation]; !found {
+			loc := &velerov1api.BackupStorageLocation{}
+			if err := r.Get(ctx, client.ObjectKey{Namespace: backup.Namespace, Name: backup.Spec.StorageLocation}, loc); err != nil {
+				if !apierrors.IsNotFound(err) {
+					return ctrl.Result{}, errors.Wrapf(err, "error getting backup storage location %s", backup.Spec.StorageLocation)
+				}
+				// the gc-controller reports the backups whose location doesn't exist
+				unavailable[backup.Spec.StorageLocation] = true
+			} else {
+				unavailable[backup.Spec.StorageLocation] = loc.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly
+			}
+		}
+		if unavailable[backup.Spec.StorageLocation] {
+			log.Infof("Backup isn't kept by the retention policy but cannot be deleted because backup storage location %s is unavailable or read-only", backup.Spec.StorageLocation)
+			continue
+		}
+
+		log.Info("Backup isn't kept by the retention policy")
+		original := backup.DeepCopy()
+		conditions.SetBackupRetentionPolicyCondition(backup, r.clock.Now(), fmt.Sprintf("Backup isn't kept by the retention policy of schedule %s", schedule.Name))
+		conditions.SetObservedGeneration(original, backup)
+		if err := r.Update(ctx, backup); err != nil {
+			log.WithError(err).Error("error updating backup conditions")
+		}
+
+		if err := requestBackupDeletion(ctx, r.Client, backup, log); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// retentionPeriod is a period of the retention policy, which keeps the newest backup of the last
+// count periods identified by key.
+type retentionPeriod struct {
+	count int
+	key   func(time.Time) string
+}
+
+func retentionPeriods(policy velerov1api.RetentionPolicy) []retentionPeriod {
+	return []retentionPeriod{
+		{policy.KeepDaily, func(t time.Time) string { return t.Format("2006-01-02") }},
+		{policy.KeepWeekly, func(t time.Time) string {
+			year, week := t.ISOWeek()
+			return fmt.Sprintf("%d-W%02d", year, week)
+		}},
+		{policy.KeepMonthly, func(t time.Time) string { return t.Format("2006-01") }},
+	}
+}
+
+// prunedBackups returns the backups the retention policy doesn't keep. Only the Completed and
+// PartiallyFailed backups are subject to the policy, the other ones are left to their TTL. The
+// newest backup is always kept, and an empty policy doesn't prune any backup.
+func prunedBackups(backups []velerov1api.Backup, policy velerov1api.RetentionPolicy) []*velerov1api.Backup {
+	if policy == (velerov1api.RetentionPolicy{}) {
+		return nil
+	}
+
+	var candidates []*velerov1api.Backup
+	for i := range backups {
+		switch backups[i].Status.Phase {
+		case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed:
+			candidates = append(candidates, &backups[i])
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	// the backups synced from the storage are recreated, so their start time is the one they were
+	// taken at rather than their creation time
+	takenAt := func(backup *velerov1api.Backup) time.Time {
+		if backup.Status.StartTimestamp != nil {
+			return backup.Status.StartTimestamp.UTC()
+		}
+		return backup.CreationTimestamp.UTC()
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if ti, tj := takenAt(candidates[i]), takenAt(candidates[j]); !ti.Equal(tj) {
+			return ti.After(tj)
+		}
+		return candidates[i].Name > candidates[j].Name
+	})
+
+	kept := make([]bool, len(candidates))
+	kept[0] = true
+	for _, period := range retentionPeriods(policy) {
+		last, count := "", 0
+		for i := 0; i < len(candidates) && count < period.count; i++ {
+			if key := period.key(takenAt(candidates[i])); key != last {
+				last = key
+				count++
+				kept[i] = true
+			}
+		}
+	}
+
+	var pruned []*velerov1api.Backup
+	for i, backup := range candidates {
+		if !kept[i] {
+			pruned = append(pruned, backup)
+		}
+	}
+	return pruned
+}
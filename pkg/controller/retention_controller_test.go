@@ -0,0 +1,154 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	testclocks "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func retentionTestBackup(name string, takenAt time.Time, phase velerov1api.BackupPhase) *velerov1api.Backup {
+	return builder.ForBackup(velerov1api.DefaultNamespace, name).
+		ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "daily")).
+		StorageLocation("default").
+		StartTimestamp(takenAt).
+		Phase(phase).
+		Result()
+}
+
+func TestPrunedBackups(t *testing.T) {
+	// a backup at 01:00 UTC every day of the first quarter of 2026
+	var daily []velerov1api.Backup
+	for day := time.Date(2026, 1, 1, 1, 0, 0, 0, time.UTC); day.Month() <= time.March; day = day.AddDate(0, 0, 1) {
+		daily = append(daily, *retentionTestBackup("backup-"+day.Format("0102"), day, velerov1api.BackupPhaseCompleted))
+	}
+
+	tests := []struct {
+		name       string
+		backups    []velerov1api.Backup
+		policy     velerov1api.RetentionPolicy
+		expectKept []string
+	}{
+		{
+			name:    "grandfather-father-son",
+			backups: daily,
+			policy:  velerov1api.RetentionPolicy{KeepDaily: 7, KeepWeekly: 4, KeepMonthly: 3},
+			expectKept: []string{
+				// the last 7 days
+				"backup-0331", "backup-0330", "backup-0329", "backup-0328", "backup-0327", "backup-0326", "backup-0325",
+				// the Sundays ending the 2 other weeks
+				"backup-0322", "backup-0315",
+				// the ends of the 2 other months
+				"backup-0228", "backup-0131",
+			},
+		},
+		{
+			name:       "the newest backup of a day is kept",
+			backups:    daily,
+			policy:     velerov1api.RetentionPolicy{KeepMonthly: 1},
+			expectKept: []string{"backup-0331"},
+		},
+		{
+			name: "only the completed backups fill the periods",
+			backups: []velerov1api.Backup{
+				*retentionTestBackup("failed", time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC), velerov1api.BackupPhaseFailed),
+				*retentionTestBackup("in-progress", time.Date(2026, 3, 2, 2, 0, 0, 0, time.UTC), velerov1api.BackupPhaseInProgress),
+				*retentionTestBackup("partially-failed", time.Date(2026, 3, 1, 1, 0, 0, 0, time.UTC), velerov1api.BackupPhasePartiallyFailed),
+				*retentionTestBackup("completed", time.Date(2026, 2, 28, 1, 0, 0, 0, time.UTC), velerov1api.BackupPhaseCompleted),
+				*retentionTestBackup("older", time.Date(2026, 2, 27, 1, 0, 0, 0, time.UTC), velerov1api.BackupPhaseCompleted),
+			},
+			policy:     velerov1api.RetentionPolicy{KeepDaily: 2},
+			expectKept: []string{"failed", "in-progress", "partially-failed", "completed"},
+		},
+		{
+			name:       "empty policy",
+			backups:    daily,
+			policy:     velerov1api.RetentionPolicy{},
+			expectKept: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pruned := make(map[string]bool)
+			for _, backup := range prunedBackups(test.backups, test.policy) {
+				pruned[backup.Name] = true
+			}
+
+			if test.expectKept == nil {
+				assert.Empty(t, pruned)
+				return
+			}
+			var kept []string
+			for _, backup := range test.backups {
+				if !pruned[backup.Name] {
+					kept = append(kept, backup.Name)
+				}
+			}
+			assert.ElementsMatch(t, test.expectKept, kept)
+		})
+	}
+}
+
+func TestRetentionReconcile(t *testing.T) {
+	fakeClock := testclocks.NewFakeClock(time.Now())
+	now := fakeClock.Now().UTC()
+
+	schedule := builder.ForSchedule(velerov1api.DefaultNamespace, "daily").Result()
+	schedule.Spec.Retention = &velerov1api.RetentionPolicy{KeepDaily: 2}
+
+	initObjs := []runtime.Object{
+		schedule,
+		builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result(),
+		retentionTestBackup("backup-1", now.AddDate(0, 0, -2), velerov1api.BackupPhaseCompleted),
+		retentionTestBackup("backup-2", now.AddDate(0, 0, -1), velerov1api.BackupPhaseCompleted),
+		retentionTestBackup("backup-3", now, velerov1api.BackupPhaseCompleted),
+	}
+	fakeClient := velerotest.NewFakeControllerRuntimeClient(t, initObjs...)
+	reconciler := NewRetentionReconciler(velerotest.NewLogger(), fakeClient, defaultGCFrequency)
+	reconciler.clock = fakeClock
+
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "daily"}}
+	_, err := reconciler.Reconcile(context.TODO(), req)
+	require.NoError(t, err)
+	// a pending deletion request isn't created twice
+	_, err = reconciler.Reconcile(context.TODO(), req)
+	require.NoError(t, err)
+
+	dbrs := &velerov1api.DeleteBackupRequestList{}
+	require.NoError(t, fakeClient.List(context.TODO(), dbrs))
+	require.Len(t, dbrs.Items, 1)
+	assert.Equal(t, "backup-1", dbrs.Items[0].Spec.BackupName)
+
+	backup := &velerov1api.Backup{}
+	require.NoError(t, fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "backup-1"}, backup))
+	require.Len(t, backup.Status.Conditions, 1)
+	assert.Equal(t, velerov1api.ConditionTypeExpired, backup.Status.Conditions[0].Type)
+	assert.Equal(t, "RetentionPolicy", backup.Status.Conditions[0].Reason)
+}
@@ -0,0 +1,184 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/vmware-tanzu/velero/pkg/constant"
+	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+const (
+	// RuntimeConfigLogLevelKey is the key of the log level of the server in the ConfigMap of its
+	// runtime configuration.
+	RuntimeConfigLogLevelKey = "logLevel"
+	// RuntimeConfigFeaturesKey is the key of the comma-separated feature flags enabled on the
+	// server in the ConfigMap of its runtime configuration.
+	RuntimeConfigFeaturesKey = "features"
+	// RuntimeConfigItemBlockWorkerCountKey is the key of the number of workers processing the
+	// ItemBlocks of the backups in the ConfigMap of the runtime configuration of the server.
+	RuntimeConfigItemBlockWorkerCountKey = "itemBlockWorkerCount"
+)
+
+// RuntimeConfig is the configuration of the server which can be changed while it runs.
+type RuntimeConfig struct {
+	LogLevel             logrus.Level
+	Features             []string
+	ItemBlockWorkerCount int
+}
+
+// runtimeConfigReconciler applies the runtime configuration of the ConfigMap to the server as it
+// changes, falling back to the configuration of its flags for the keys the ConfigMap doesn't have
+// and when it's deleted.
+type runtimeConfigReconciler struct {
+	client                  client.Client
+	configMap               string
+	defaults                RuntimeConfig
+	current                 RuntimeConfig
+	logger                  *logrus.Logger
+	setItemBlockWorkerCount func(int)
+}
+
+// NewRuntimeConfigReconciler returns the reconciler of the ConfigMap of the runtime configuration
+// of the server, setting the level of the logger and the number of ItemBlock workers with
+// setItemBlockWorkerCount, which is nil when the backup controller is disabled.
+func NewRuntimeConfigReconciler(
+	client client.Client,
+	configMap string,
+	defaults RuntimeConfig,
+	logger *logrus.Logger,
+	setItemBlockWorkerCount func(int),
+) *runtimeConfigReconciler {
+	return &runtimeConfigReconciler{
+		client:                  client,
+		configMap:               configMap,
+		defaults:                defaults,
+		current:                 defaults,
+		logger:                  logger,
+		setItemBlockWorkerCount: setItemBlockWorkerCount,
+	}
+}
+
+func (r *runtimeConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1api.ConfigMap{}, builder.WithPredicates(kube.NewAllEventPredicate(func(object client.Object) bool {
+			return object.GetName() == r.configMap
+		}))).
+		Named(constant.ControllerRuntimeConfig).
+		// the configuration is applied by a single worker so the changes are applied in order
+		WithOptions(controller.Options{MaxConcurrentReconciles: 1}).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch
+
+func (r *runtimeConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithFields(logrus.Fields{
+		"controller": constant.ControllerRuntimeConfig,
+		"configMap":  req.NamespacedName,
+	})
+
+	configMap := &corev1api.ConfigMap{}
+	if err := r.client.Get(ctx, req.NamespacedName, configMap); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, errors.Wrapf(err, "error getting ConfigMap %s", req.NamespacedName)
+		}
+		log.Info("ConfigMap of the runtime configuration not found, applying the configuration of the flags")
+		configMap = nil
+	}
+
+	config, err := parseRuntimeConfig(configMap, r.defaults)
+	if err != nil {
+		// the ConfigMap is invalid until it's changed again, which triggers another reconciliation
+		log.WithError(err).Error("Invalid runtime configuration, keeping the current one")
+		return ctrl.Result{}, nil
+	}
+	r.apply(config, log)
+	return ctrl.Result{}, nil
+}
+
+// apply changes the configuration of the server which differs from the current one.
+func (r *runtimeConfigReconciler) apply(config RuntimeConfig, log logrus.FieldLogger) {
+	if config.LogLevel != r.current.LogLevel {
+		log.Infof("Setting log-level to %s", strings.ToUpper(config.LogLevel.String()))
+		r.logger.SetLevel(config.LogLevel)
+	}
+	if !slices.Equal(config.Features, r.current.Features) {
+		log.Infof("Setting feature flags to %v", config.Features)
+		features.NewFeatureFlagSet(config.Features...)
+	}
+	if config.ItemBlockWorkerCount != r.current.ItemBlockWorkerCount && r.setItemBlockWorkerCount != nil {
+		log.Infof("Setting the number of ItemBlock workers to %d", config.ItemBlockWorkerCount)
+		r.setItemBlockWorkerCount(config.ItemBlockWorkerCount)
+	}
+	r.current = config
+}
+
+// parseRuntimeConfig returns the runtime configuration of the ConfigMap, the defaults for the keys
+// it doesn't have or when it's nil.
+func parseRuntimeConfig(configMap *corev1api.ConfigMap, defaults RuntimeConfig) (RuntimeConfig, error) {
+	config := defaults
+	if configMap == nil {
+		return config, nil
+	}
+
+	if value, found := configMap.Data[RuntimeConfigLogLevelKey]; found {
+		level, err := logrus.ParseLevel(strings.TrimSpace(value))
+		if err != nil {
+			return RuntimeConfig{}, errors.Wrapf(err, "invalid %s", RuntimeConfigLogLevelKey)
+		}
+		config.LogLevel = level
+	}
+
+	if value, found := configMap.Data[RuntimeConfigFeaturesKey]; found {
+		config.Features = nil
+		for _, feature := range strings.Split(value, ",") {
+			if feature = strings.TrimSpace(feature); feature != "" {
+				config.Features = append(config.Features, feature)
+			}
+		}
+		slices.Sort(config.Features)
+		config.Features = slices.Compact(config.Features)
+	}
+
+	if value, found := configMap.Data[RuntimeConfigItemBlockWorkerCountKey]; found {
+		count, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			return RuntimeConfig{}, errors.Wrapf(err, "invalid %s", RuntimeConfigItemBlockWorkerCountKey)
+		}
+		if count < 1 {
+			return RuntimeConfig{}, errors.Errorf("invalid %s %d, it must be positive", RuntimeConfigItemBlockWorkerCountKey, count)
+		}
+		config.ItemBlockWorkerCount = count
+	}
+
+	return config, nil
+}
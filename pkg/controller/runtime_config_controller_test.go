@@ -0,0 +1,135 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/vmware-tanzu/velero/pkg/features"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestParseRuntimeConfig(t *testing.T) {
+	defaults := RuntimeConfig{LogLevel: logrus.InfoLevel, Features: []string{"EnableCSI"}, ItemBlockWorkerCount: 1}
+
+	tests := []struct {
+		name     string
+		data     map[string]string
+		expected RuntimeConfig
+		wantErr  bool
+	}{
+		{
+			name:     "empty ConfigMap",
+			expected: defaults,
+		},
+		{
+			name: "all the keys",
+			data: map[string]string{
+				RuntimeConfigLogLevelKey:             "debug",
+				RuntimeConfigFeaturesKey:             "EnableCSI, EnableAPIGroupVersions,EnableCSI",
+				RuntimeConfigItemBlockWorkerCountKey: "4",
+			},
+			expected: RuntimeConfig{LogLevel: logrus.DebugLevel, Features: []string{"EnableAPIGroupVersions", "EnableCSI"}, ItemBlockWorkerCount: 4},
+		},
+		{
+			name:     "empty features disable all of them",
+			data:     map[string]string{RuntimeConfigFeaturesKey: ""},
+			expected: RuntimeConfig{LogLevel: logrus.InfoLevel, ItemBlockWorkerCount: 1},
+		},
+		{
+			name:    "invalid log level",
+			data:    map[string]string{RuntimeConfigLogLevelKey: "verbose"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid number of workers",
+			data:    map[string]string{RuntimeConfigItemBlockWorkerCountKey: "0"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			config, err := parseRuntimeConfig(&corev1api.ConfigMap{Data: tc.data}, defaults)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, config)
+		})
+	}
+
+	config, err := parseRuntimeConfig(nil, defaults)
+	require.NoError(t, err)
+	assert.Equal(t, defaults, config)
+}
+
+func TestRuntimeConfigReconcile(t *testing.T) {
+	defer features.NewFeatureFlagSet()
+	features.NewFeatureFlagSet("EnableCSI")
+
+	configMap := &corev1api.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "runtime"},
+		Data: map[string]string{
+			RuntimeConfigLogLevelKey:             "debug",
+			RuntimeConfigFeaturesKey:             "EnableAPIGroupVersions",
+			RuntimeConfigItemBlockWorkerCountKey: "3",
+		},
+	}
+	client := velerotest.NewFakeControllerRuntimeClient(t, configMap)
+	logger := logrus.New()
+	var workers int
+	r := NewRuntimeConfigReconciler(
+		client,
+		"runtime",
+		RuntimeConfig{LogLevel: logrus.InfoLevel, Features: features.All(), ItemBlockWorkerCount: 1},
+		logger,
+		func(count int) { workers = count },
+	)
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "velero", Name: "runtime"}}
+
+	_, err := r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, logrus.DebugLevel, logger.GetLevel())
+	assert.Equal(t, []string{"EnableAPIGroupVersions"}, features.All())
+	assert.Equal(t, 3, workers)
+
+	// an invalid configuration is ignored
+	configMap.Data[RuntimeConfigLogLevelKey] = "verbose"
+	require.NoError(t, client.Update(context.Background(), configMap))
+	_, err = r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, logrus.DebugLevel, logger.GetLevel())
+
+	// the configuration of the flags is applied once the ConfigMap is deleted
+	require.NoError(t, client.Delete(context.Background(), configMap))
+	_, err = r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, logrus.InfoLevel, logger.GetLevel())
+	assert.Equal(t, []string{"EnableCSI"}, features.All())
+	assert.Equal(t, 1, workers)
+}
@@ -19,11 +19,15 @@ package controller
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	cron "github.com/robfig/cron/v3"
 	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -31,17 +35,26 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	bld "sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/constant"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	"github.com/vmware-tanzu/velero/pkg/util/conditions"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
+	veleroutil "github.com/vmware-tanzu/velero/pkg/util/velero"
 )
 
 const (
 	scheduleSyncPeriod = time.Minute
+
+	// defaultScheduleDependencyTimeout is how long a due backup waits for the backup of the
+	// schedule it depends on when the schedule doesn't set it.
+	defaultScheduleDependencyTimeout = time.Hour
 )
 
 type scheduleReconciler struct {
@@ -51,6 +64,8 @@ type scheduleReconciler struct {
 	clock           clocks.WithTickerAndDelayedExecution
 	metrics         *metrics.ServerMetrics
 	skipImmediately bool
+	// maxConcurrentBackups is the most scheduled backups run at once, zero meaning no limit.
+	maxConcurrentBackups int
 }
 
 func NewScheduleReconciler(
@@ -59,32 +74,29 @@ func NewScheduleReconciler(
 	client client.Client,
 	metrics *metrics.ServerMetrics,
 	skipImmediately bool,
+	maxConcurrentBackups int,
 ) *scheduleReconciler {
 	return &scheduleReconciler{
-		Client:          client,
-		namespace:       namespace,
-		logger:          logger,
-		clock:           clocks.RealClock{},
-		metrics:         metrics,
-		skipImmediately: skipImmediately,
+		Client:               client,
+		namespace:            namespace,
+		logger:               logger,
+		clock:                clocks.RealClock{},
+		metrics:              metrics,
+		skipImmediately:      skipImmediately,
+		maxConcurrentBackups: maxConcurrentBackups,
 	}
 }
 
 func (c *scheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	pred := kube.NewAllEventPredicate(func(obj client.Object) bool {
-		schedule := obj.(*velerov1.Schedule)
-		if pause := schedule.Spec.Paused; pause {
-			c.logger.Infof("schedule %s is paused, skip", schedule.Name)
-			return false
-		}
-		return true
-	})
+	// the paused schedules are reconciled too, so that the finalizer preserving their backups is
+	// kept up to date, but they don't create backups, see Reconcile
 	s := kube.NewPeriodicalEnqueueSource(c.logger.WithField("controller", constant.ControllerSchedule), mgr.GetClient(), &velerov1.ScheduleList{}, scheduleSyncPeriod,
-		kube.PeriodicalEnqueueSourceOption{
-			Predicates: []predicate.Predicate{pred},
-		})
+		kube.PeriodicalEnqueueSourceOption{})
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&velerov1.Schedule{}, bld.WithPredicates(kube.SpecChangePredicate{}, pred)).
+		For(&velerov1.Schedule{}, bld.WithPredicates(predicate.Or[client.Object](kube.SpecChangePredicate{}, predicate.Funcs{
+			// the deleted schedules release their backups
+			UpdateFunc: func(e event.UpdateEvent) bool { return !e.ObjectNew.GetDeletionTimestamp().IsZero() },
+		}))).
 		WatchesRawSource(s).
 		Complete(c)
 }
@@ -106,8 +118,22 @@ func (c *scheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		}
 		return ctrl.Result{}, errors.Wrapf(err, "error getting schedule %s", req.String())
 	}
+	if !schedule.DeletionTimestamp.IsZero() {
+		if err := c.releaseBackups(ctx, schedule, log); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "error releasing the backups of schedule %s", req.String())
+		}
+		return ctrl.Result{}, nil
+	}
 	c.metrics.InitSchedule(schedule.Name)
 
+	if err := c.updatePreserveBackupsFinalizer(ctx, schedule); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "error updating the finalizers of schedule %s", req.String())
+	}
+	if schedule.Spec.Paused {
+		log.Debug("Schedule is paused, skip")
+		return ctrl.Result{}, nil
+	}
+
 	original := schedule.DeepCopy()
 
 	if schedule.Spec.SkipImmediately == nil {
@@ -130,6 +156,7 @@ func (c *scheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		schedule.Status.Phase = velerov1.SchedulePhaseEnabled
 		schedule.Status.ValidationErrors = nil
 	}
+	conditions.SetScheduleConditions(schedule, c.clock.Now())
 
 	scheduleNeedsPatch := false
 	errStringArr := make([]string, 0)
@@ -142,12 +169,18 @@ func (c *scheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		scheduleNeedsPatch = true
 		errStringArr = append(errStringArr, fmt.Sprintf("spec.skipImmediately to %v", schedule.Spec.SkipImmediately))
 	}
+	// update conditions if they're changed, e.g. for schedules created before conditions were added
+	if !equality.Semantic.DeepEqual(original.Status.Conditions, schedule.Status.Conditions) {
+		scheduleNeedsPatch = true
+		errStringArr = append(errStringArr, "conditions")
+	}
 	// update status if it's changed
 	if original.Status.LastSkipped != schedule.Status.LastSkipped {
 		scheduleNeedsPatch = true
 		errStringArr = append(errStringArr, fmt.Sprintf("last skipped to %v", schedule.Status.LastSkipped))
 	}
 	if scheduleNeedsPatch {
+		conditions.SetObservedGeneration(original, schedule)
 		if err := c.Patch(ctx, schedule, client.MergeFrom(original)); err != nil {
 			return ctrl.Result{}, errors.Wrapf(err, "error updating %v for schedule %s", errStringArr, req.String())
 		}
@@ -163,6 +196,30 @@ func (c *scheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	// skip current backup creation to avoid running overlap backups.
 	// As the schedule must be validated before checking whether it's due, we cannot put the checking log in Predicate
 	if c.ifDue(schedule, cronSchedule) && !c.checkIfBackupInNewOrProgress(schedule) {
+		ready, skip, err := c.checkDependency(ctx, schedule, cronSchedule, log)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "error checking the schedule depended on by schedule %s", req.String())
+		}
+		if skip {
+			original := schedule.DeepCopy()
+			schedule.Status.LastSkipped = &metav1.Time{Time: c.clock.Now()}
+			if err := c.Patch(ctx, schedule, client.MergeFrom(original)); err != nil {
+				return ctrl.Result{}, errors.Wrapf(err, "error updating last skipped for schedule %s", req.String())
+			}
+			return ctrl.Result{}, nil
+		}
+		if !ready {
+			return ctrl.Result{}, nil
+		}
+
+		queued, err := c.queued(ctx, schedule, cronSchedule)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "error checking the running scheduled backups for schedule %s", req.String())
+		}
+		if queued {
+			log.Infof("Schedule is due, but %d scheduled backups are running or queued before it already, waiting", c.maxConcurrentBackups)
+			return ctrl.Result{}, nil
+		}
 		if err := c.submitBackup(ctx, schedule); err != nil {
 			return ctrl.Result{}, errors.Wrapf(err, "error submit backup for schedule %s", req.String())
 		}
@@ -196,7 +253,20 @@ func parseCronSchedule(itm *velerov1.Schedule, logger logrus.FieldLogger) (cron.
 			}
 		}()
 
-		if res, err := cron.ParseStandard(itm.Spec.Schedule); err != nil {
+		spec := itm.Spec.Schedule
+		if itm.Spec.Timezone != "" {
+			if strings.HasPrefix(spec, "TZ=") || strings.HasPrefix(spec, "CRON_TZ=") {
+				validationErrors = append(validationErrors, "the time zone must be set either by timezone or in the schedule, not both")
+				return
+			}
+			if _, err := time.LoadLocation(itm.Spec.Timezone); err != nil {
+				validationErrors = append(validationErrors, fmt.Sprintf("invalid timezone %q: %v", itm.Spec.Timezone, err))
+				return
+			}
+			spec = fmt.Sprintf("CRON_TZ=%s %s", itm.Spec.Timezone, spec)
+		}
+
+		if res, err := cron.ParseStandard(spec); err != nil {
 			log.WithError(errors.WithStack(err)).WithField("schedule", itm.Spec.Schedule).Debug("Error parsing schedule")
 			validationErrors = append(validationErrors, fmt.Sprintf("invalid schedule: %v", err))
 		} else {
@@ -204,6 +274,24 @@ func parseCronSchedule(itm *velerov1.Schedule, logger logrus.FieldLogger) (cron.
 		}
 	}()
 
+	if itm.Spec.Jitter.Duration < 0 {
+		validationErrors = append(validationErrors, "jitter must not be negative")
+	}
+
+	if dependsOn := itm.Spec.DependsOn; dependsOn != nil {
+		if dependsOn.Schedule == "" || dependsOn.Schedule == itm.Name {
+			validationErrors = append(validationErrors, "dependsOn.schedule must be the name of another schedule")
+		}
+		if dependsOn.Timeout.Duration < 0 {
+			validationErrors = append(validationErrors, "dependsOn.timeout must not be negative")
+		}
+		switch dependsOn.FailurePolicy {
+		case "", velerov1.ScheduleDependencyFailurePolicySkip, velerov1.ScheduleDependencyFailurePolicyRun:
+		default:
+			validationErrors = append(validationErrors, fmt.Sprintf("invalid dependsOn.failurePolicy %q", dependsOn.FailurePolicy))
+		}
+	}
+
 	if len(validationErrors) > 0 {
 		return nil, validationErrors
 	}
@@ -258,12 +346,23 @@ func (c *scheduleReconciler) submitBackup(ctx context.Context, schedule *velerov
 	// Don't attempt to "catch up" if there are any missed or failed runs - simply
 	// trigger a Backup if it's time.
 	backup := getBackup(schedule, now)
+	variables, err := veleroutil.ScheduleVariables(ctx, c.Client, schedule, now)
+	if err != nil {
+		return err
+	}
+	if err := veleroutil.ExpandScheduleVariables(backup, variables); err != nil {
+		return errors.Wrap(err, "error expanding the variables of the backup")
+	}
+	if err := c.failoverStorageLocation(ctx, backup); err != nil {
+		return err
+	}
 	if err := c.Create(ctx, backup); err != nil {
 		return errors.Wrap(err, "error creating Backup")
 	}
 
 	original := schedule.DeepCopy()
 	schedule.Status.LastBackup = &metav1.Time{Time: now}
+	conditions.SetObservedGeneration(original, schedule)
 
 	if err := c.Patch(ctx, schedule, client.MergeFrom(original)); err != nil {
 		return errors.Wrapf(err, "error updating Schedule's LastBackup time to %v", schedule.Status.LastBackup)
@@ -272,6 +371,55 @@ func (c *scheduleReconciler) submitBackup(ctx context.Context, schedule *velerov
 	return nil
 }
 
+// updatePreserveBackupsFinalizer adds the finalizer removing the owner references from the backups of
+// the schedule before it's deleted when they're owned by it and preserved, and removes it otherwise.
+func (c *scheduleReconciler) updatePreserveBackupsFinalizer(ctx context.Context, schedule *velerov1.Schedule) error {
+	preserve := schedule.Spec.PreserveBackupsOnScheduleDelete && boolptr.IsSetToTrue(schedule.Spec.UseOwnerReferencesInBackup)
+	if preserve == controllerutil.ContainsFinalizer(schedule, velerov1.PreserveBackupsFinalizer) {
+		return nil
+	}
+
+	original := schedule.DeepCopy()
+	if preserve {
+		controllerutil.AddFinalizer(schedule, velerov1.PreserveBackupsFinalizer)
+	} else {
+		controllerutil.RemoveFinalizer(schedule, velerov1.PreserveBackupsFinalizer)
+	}
+	return c.Patch(ctx, schedule, client.MergeFrom(original))
+}
+
+// releaseBackups removes the owner references to the deleted schedule from its backups, so that
+// they aren't deleted by the garbage collector, and then lets the schedule be removed.
+func (c *scheduleReconciler) releaseBackups(ctx context.Context, schedule *velerov1.Schedule, log logrus.FieldLogger) error {
+	if !controllerutil.ContainsFinalizer(schedule, velerov1.PreserveBackupsFinalizer) {
+		return nil
+	}
+
+	backupList := &velerov1.BackupList{}
+	if err := c.List(ctx, backupList, client.InNamespace(schedule.Namespace), client.MatchingLabels{velerov1.ScheduleNameLabel: schedule.Name}); err != nil {
+		return errors.Wrap(err, "error listing the backups of the schedule")
+	}
+	for i := range backupList.Items {
+		backup := &backupList.Items[i]
+		ownerReferences := slices.DeleteFunc(slices.Clone(backup.OwnerReferences), func(ref metav1.OwnerReference) bool {
+			return ref.UID == schedule.UID
+		})
+		if len(ownerReferences) == len(backup.OwnerReferences) {
+			continue
+		}
+		original := backup.DeepCopy()
+		backup.OwnerReferences = ownerReferences
+		if err := c.Patch(ctx, backup, client.MergeFrom(original)); err != nil {
+			return errors.Wrapf(err, "error removing the owner reference from backup %s", backup.Name)
+		}
+		log.Infof("Removed the owner reference to the deleted schedule from backup %s", backup.Name)
+	}
+
+	original := schedule.DeepCopy()
+	controllerutil.RemoveFinalizer(schedule, velerov1.PreserveBackupsFinalizer)
+	return c.Patch(ctx, schedule, client.MergeFrom(original))
+}
+
 func getNextRunTime(schedule *velerov1.Schedule, cronSchedule cron.Schedule, asOf time.Time) (bool, time.Time) {
 	var lastBackupTime time.Time
 	if schedule.Status.LastBackup != nil {
@@ -284,10 +432,167 @@ func getNextRunTime(schedule *velerov1.Schedule, cronSchedule cron.Schedule, asO
 	}
 
 	nextRunTime := cronSchedule.Next(lastBackupTime)
+	nextRunTime = nextRunTime.Add(scheduleJitter(schedule, nextRunTime))
 
 	return asOf.After(nextRunTime), nextRunTime
 }
 
+// scheduleJitter returns the delay of the backup of the schedule due at runTime. It's picked at
+// random below the jitter of the schedule, but is the same whenever the schedule is reconciled.
+func scheduleJitter(schedule *velerov1.Schedule, runTime time.Time) time.Duration {
+	if schedule.Spec.Jitter.Duration <= 0 {
+		return 0
+	}
+	h := fnv.New64a()
+	h.Write([]byte(fmt.Sprintf("%s/%s/%d", schedule.Namespace, schedule.Name, runTime.Unix())))
+	return time.Duration(h.Sum64() % uint64(schedule.Spec.Jitter.Duration))
+}
+
+// queued returns whether the backup of the due schedule has to wait for the running scheduled
+// backups to complete. The due schedules are queued first in, first out: the ones which became
+// due earlier are submitted before.
+func (c *scheduleReconciler) queued(ctx context.Context, schedule *velerov1.Schedule, cronSchedule cron.Schedule) (bool, error) {
+	if c.maxConcurrentBackups <= 0 {
+		return false, nil
+	}
+
+	backupList := &velerov1.BackupList{}
+	if err := c.List(ctx, backupList, client.InNamespace(c.namespace), client.HasLabels{velerov1.ScheduleNameLabel}); err != nil {
+		return false, errors.Wrap(err, "error listing scheduled backups")
+	}
+	running := 0
+	runningSchedules := make(map[string]bool)
+	for _, backup := range backupList.Items {
+		if backup.Status.Phase == velerov1.BackupPhaseNew || backup.Status.Phase == velerov1.BackupPhaseInProgress {
+			running++
+			runningSchedules[backup.Labels[velerov1.ScheduleNameLabel]] = true
+		}
+	}
+	if running >= c.maxConcurrentBackups {
+		return true, nil
+	}
+
+	scheduleList := &velerov1.ScheduleList{}
+	if err := c.List(ctx, scheduleList, client.InNamespace(c.namespace)); err != nil {
+		return false, errors.Wrap(err, "error listing schedules")
+	}
+	now := c.clock.Now()
+	_, dueAt := getNextRunTime(schedule, cronSchedule, now)
+	ahead := 0
+	for i := range scheduleList.Items {
+		other := &scheduleList.Items[i]
+		if other.Name == schedule.Name || other.Spec.Paused || other.Status.Phase != velerov1.SchedulePhaseEnabled ||
+			runningSchedules[other.Name] {
+			continue
+		}
+		otherCron, errs := parseCronSchedule(other, c.logger)
+		if len(errs) > 0 {
+			continue
+		}
+		isDue, otherDueAt := getNextRunTime(other, otherCron, now)
+		if isDue && (otherDueAt.Before(dueAt) || otherDueAt.Equal(dueAt) && other.Name < schedule.Name) {
+			ahead++
+		}
+	}
+	return running+ahead >= c.maxConcurrentBackups, nil
+}
+
+// checkDependency returns whether the backup of the due schedule is ready to start as the most
+// recent backup of the schedule it depends on completed successfully, or whether it has to be
+// skipped as that backup failed or didn't complete before the timeout.
+func (c *scheduleReconciler) checkDependency(ctx context.Context, schedule *velerov1.Schedule, cronSchedule cron.Schedule, log logrus.FieldLogger) (bool, bool, error) {
+	dependsOn := schedule.Spec.DependsOn
+	if dependsOn == nil {
+		return true, false, nil
+	}
+
+	backupList := &velerov1.BackupList{}
+	if err := c.List(ctx, backupList, client.InNamespace(schedule.Namespace), client.MatchingLabels{velerov1.ScheduleNameLabel: dependsOn.Schedule}); err != nil {
+		return false, false, errors.Wrap(err, "error listing the backups of the schedule depended on")
+	}
+	var latest *velerov1.Backup
+	for i := range backupList.Items {
+		backup := &backupList.Items[i]
+		if latest == nil || latest.CreationTimestamp.Before(&backup.CreationTimestamp) {
+			latest = backup
+		}
+	}
+	// the backup depended on must have been created since the last backup of the schedule, otherwise
+	// it's the one of the previous run, which the last backup already waited for
+	if latest != nil && schedule.Status.LastBackup != nil && !latest.CreationTimestamp.After(schedule.Status.LastBackup.Time) {
+		latest = nil
+	}
+
+	var failure string
+	switch {
+	case latest == nil:
+	case latest.Status.Phase == velerov1.BackupPhaseCompleted:
+		return true, false, nil
+	case latest.Status.Phase == velerov1.BackupPhasePartiallyFailed, latest.Status.Phase == velerov1.BackupPhaseFailed,
+		latest.Status.Phase == velerov1.BackupPhaseFailedValidation, latest.Status.Phase == velerov1.BackupPhaseDeleting:
+		failure = fmt.Sprintf("backup %s of schedule %s is %s", latest.Name, dependsOn.Schedule, latest.Status.Phase)
+	}
+
+	if failure == "" {
+		timeout := dependsOn.Timeout.Duration
+		if timeout == 0 {
+			timeout = defaultScheduleDependencyTimeout
+		}
+		_, dueAt := getNextRunTime(schedule, cronSchedule, c.clock.Now())
+		if c.clock.Now().Before(dueAt.Add(timeout)) {
+			log.Infof("Schedule is due, but waiting for the most recent backup of schedule %s to complete", dependsOn.Schedule)
+			return false, false, nil
+		}
+		failure = fmt.Sprintf("schedule %s has no backup completed after %s", dependsOn.Schedule, timeout)
+	}
+
+	if dependsOn.FailurePolicy == velerov1.ScheduleDependencyFailurePolicyRun {
+		log.Warnf("The backup depended on didn't complete successfully (%s), running the backup anyway", failure)
+		return true, false, nil
+	}
+	log.Warnf("The backup depended on didn't complete successfully (%s), skipping the backup", failure)
+	return false, true, nil
+}
+
+// failoverStorageLocation points the backup to the first available failover location of its
+// backup storage location while that one is unavailable.
+func (c *scheduleReconciler) failoverStorageLocation(ctx context.Context, backup *velerov1.Backup) error {
+	locationList := &velerov1.BackupStorageLocationList{}
+	if err := c.List(ctx, locationList, client.InNamespace(backup.Namespace)); err != nil {
+		return errors.Wrap(err, "error listing the backup storage locations")
+	}
+	locations := make(map[string]*velerov1.BackupStorageLocation)
+	var primary *velerov1.BackupStorageLocation
+	for i := range locationList.Items {
+		location := &locationList.Items[i]
+		locations[location.Name] = location
+		if location.Name == backup.Spec.StorageLocation || backup.Spec.StorageLocation == "" && location.Spec.Default {
+			primary = location
+		}
+	}
+	if primary == nil || primary.Status.Phase != velerov1.BackupStorageLocationPhaseUnavailable || len(primary.Spec.FailoverLocations) == 0 {
+		return nil
+	}
+
+	log := c.logger.WithFields(logrus.Fields{"backup": kube.NamespaceAndName(backup), "backupStorageLocation": primary.Name})
+	for _, name := range primary.Spec.FailoverLocations {
+		location, ok := locations[name]
+		if !ok || location.Status.Phase != velerov1.BackupStorageLocationPhaseAvailable ||
+			location.Spec.AccessMode == velerov1.BackupStorageLocationAccessModeReadOnly {
+			continue
+		}
+		log.Warnf("The backup storage location is unavailable, failing the backup over to backup storage location %s", name)
+		backup.Spec.StorageLocation = name
+		if backup.Annotations == nil {
+			backup.Annotations = make(map[string]string)
+		}
+		backup.Annotations[velerov1.FailoverFromStorageLocationAnnotation] = primary.Name
+		return nil
+	}
+	log.Warn("The backup storage location is unavailable, and none of its failover locations is available")
+	return nil
+}
+
 func getBackup(item *velerov1.Schedule, timestamp time.Time) *velerov1.Backup {
 	name := item.TimestampedName(timestamp)
 	return builder.
@@ -17,13 +17,16 @@ limitations under the License.
 package controller
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	cron "github.com/robfig/cron/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	testclocks "k8s.io/utils/clock/testing"
@@ -35,6 +38,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 )
 
 // Test reconcile function of schedule controller. Pause is not covered as event filter will not allow it through
@@ -160,7 +164,7 @@ func TestReconcileOfSchedule(t *testing.T) {
 				err      error
 			)
 
-			reconciler := NewScheduleReconciler("namespace", logger, client, metrics.NewServerMetrics(), test.reconcilerSkipImmediately)
+			reconciler := NewScheduleReconciler("namespace", logger, client, metrics.NewServerMetrics(), test.reconcilerSkipImmediately, 0)
 
 			if test.fakeClockTime != "" {
 				testTime, err = time.Parse("2006-01-02 15:04:05", test.fakeClockTime)
@@ -461,7 +465,7 @@ func TestCheckIfBackupInNewOrProgress(t *testing.T) {
 	err = client.Create(ctx, newBackup)
 	require.NoError(t, err, "fail to create backup in New phase in TestCheckIfBackupInNewOrProgress: %v", err)
 
-	reconciler := NewScheduleReconciler("ns", logger, client, metrics.NewServerMetrics(), false)
+	reconciler := NewScheduleReconciler("ns", logger, client, metrics.NewServerMetrics(), false, 0)
 	result := reconciler.checkIfBackupInNewOrProgress(testSchedule)
 	assert.True(t, result)
 
@@ -476,7 +480,340 @@ func TestCheckIfBackupInNewOrProgress(t *testing.T) {
 	err = client.Create(ctx, inProgressBackup)
 	require.NoError(t, err, "fail to create backup in InProgress phase in TestCheckIfBackupInNewOrProgress: %v", err)
 
-	reconciler = NewScheduleReconciler("namespace", logger, client, metrics.NewServerMetrics(), false)
+	reconciler = NewScheduleReconciler("namespace", logger, client, metrics.NewServerMetrics(), false, 0)
 	result = reconciler.checkIfBackupInNewOrProgress(testSchedule)
 	assert.True(t, result)
 }
+
+func TestScheduleJitter(t *testing.T) {
+	runTime := time.Date(2026, 1, 1, 3, 0, 0, 0, time.UTC)
+
+	s := builder.ForSchedule("velero", "schedule-1").CronSchedule("0 3 * * *").Result()
+	assert.Zero(t, scheduleJitter(s, runTime))
+
+	s.Spec.Jitter = metav1.Duration{Duration: 10 * time.Minute}
+	jitter := scheduleJitter(s, runTime)
+	assert.GreaterOrEqual(t, jitter, time.Duration(0))
+	assert.Less(t, jitter, 10*time.Minute)
+	// the jitter of a run is the same whenever it's computed
+	assert.Equal(t, jitter, scheduleJitter(s, runTime))
+
+	c, errs := parseCronSchedule(s, velerotest.NewLogger())
+	require.Empty(t, errs)
+	s.Status.LastBackup = &metav1.Time{Time: runTime.Add(-time.Hour)}
+	_, next := getNextRunTime(s, c, runTime)
+	assert.Equal(t, runTime.Add(jitter), next)
+
+	s.Spec.Jitter = metav1.Duration{Duration: -time.Minute}
+	_, errs = parseCronSchedule(s, velerotest.NewLogger())
+	assert.Equal(t, []string{"jitter must not be negative"}, errs)
+}
+
+func TestScheduleQueued(t *testing.T) {
+	require.NoError(t, velerov1.AddToScheme(scheme.Scheme))
+
+	now := time.Date(2026, 1, 1, 3, 30, 0, 0, time.UTC)
+	schedule := func(name, cronSchedule string) *velerov1.Schedule {
+		return builder.ForSchedule("velero", name).CronSchedule(cronSchedule).
+			Phase(velerov1.SchedulePhaseEnabled).LastBackupTime("2026-01-01 00:00:00").Result()
+	}
+	running := func(name, scheduleName string) *velerov1.Backup {
+		return builder.ForBackup("velero", name).
+			ObjectMeta(builder.WithLabels(velerov1.ScheduleNameLabel, scheduleName)).
+			Phase(velerov1.BackupPhaseInProgress).Result()
+	}
+
+	tests := []struct {
+		name         string
+		schedule     *velerov1.Schedule
+		objs         []runtime.Object
+		maxBackups   int
+		expectQueued bool
+	}{
+		{
+			name:     "no limit",
+			schedule: schedule("daily", "0 3 * * *"),
+			objs:     []runtime.Object{running("other-1", "other"), running("other-2", "other")},
+		},
+		{
+			name:       "limit not reached",
+			schedule:   schedule("daily", "0 3 * * *"),
+			objs:       []runtime.Object{running("other-1", "other")},
+			maxBackups: 2,
+		},
+		{
+			name:         "limit reached",
+			schedule:     schedule("daily", "0 3 * * *"),
+			objs:         []runtime.Object{running("other-1", "other"), running("other-2", "other")},
+			maxBackups:   2,
+			expectQueued: true,
+		},
+		{
+			name:     "a schedule due earlier is submitted first",
+			schedule: schedule("daily", "0 3 * * *"),
+			objs: []runtime.Object{
+				running("other-1", "other"),
+				schedule("earlier", "0 2 * * *"),
+				schedule("later", "15 3 * * *"),
+			},
+			maxBackups:   2,
+			expectQueued: true,
+		},
+		{
+			name:     "the schedules due later wait",
+			schedule: schedule("daily", "0 3 * * *"),
+			objs: []runtime.Object{
+				running("other-1", "other"),
+				schedule("later", "15 3 * * *"),
+				schedule("not-due", "0 4 * * *"),
+			},
+			maxBackups: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(append(test.objs, test.schedule)...).Build()
+			reconciler := NewScheduleReconciler("velero", velerotest.NewLogger(), client, metrics.NewServerMetrics(), false, test.maxBackups)
+			reconciler.clock = testclocks.NewFakeClock(now)
+
+			cronSchedule, errs := parseCronSchedule(test.schedule, velerotest.NewLogger())
+			require.Empty(t, errs)
+			queued, err := reconciler.queued(context.TODO(), test.schedule, cronSchedule)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectQueued, queued)
+		})
+	}
+}
+
+func TestParseCronScheduleTimezone(t *testing.T) {
+	s := builder.ForSchedule("velero", "schedule-1").CronSchedule("0 2 * * *").Result()
+	s.Spec.Timezone = "Australia/Sydney"
+	c, errs := parseCronSchedule(s, velerotest.NewLogger())
+	require.Empty(t, errs)
+
+	// 02:00 in Sydney is 16:00 UTC before daylight saving time starts on 2024-10-06
+	s.Status.LastBackup = &metav1.Time{Time: time.Date(2024, 10, 3, 16, 0, 0, 0, time.UTC)}
+	_, next := getNextRunTime(s, c, s.Status.LastBackup.Time)
+	assert.Equal(t, time.Date(2024, 10, 4, 16, 0, 0, 0, time.UTC), next.UTC())
+
+	// and 15:00 UTC after it started
+	s.Status.LastBackup = &metav1.Time{Time: time.Date(2024, 10, 6, 15, 0, 0, 0, time.UTC)}
+	_, next = getNextRunTime(s, c, s.Status.LastBackup.Time)
+	assert.Equal(t, time.Date(2024, 10, 7, 15, 0, 0, 0, time.UTC), next.UTC())
+
+	s.Spec.Timezone = "Mars/Olympus_Mons"
+	_, errs = parseCronSchedule(s, velerotest.NewLogger())
+	assert.Len(t, errs, 1)
+
+	s.Spec.Timezone = "UTC"
+	s.Spec.Schedule = "CRON_TZ=Europe/Paris 0 2 * * *"
+	_, errs = parseCronSchedule(s, velerotest.NewLogger())
+	assert.Equal(t, []string{"the time zone must be set either by timezone or in the schedule, not both"}, errs)
+}
+
+func TestScheduleCheckDependency(t *testing.T) {
+	require.NoError(t, velerov1.AddToScheme(scheme.Scheme))
+
+	// the schedule was due at 03:00
+	now := time.Date(2026, 1, 1, 3, 30, 0, 0, time.UTC)
+	backup := func(name string, created time.Time, phase velerov1.BackupPhase) *velerov1.Backup {
+		return builder.ForBackup("velero", name).
+			ObjectMeta(builder.WithLabels(velerov1.ScheduleNameLabel, "dump"), builder.WithCreationTimestamp(created)).
+			Phase(phase).Result()
+	}
+	yesterday := backup("dump-1", now.Add(-24*time.Hour), velerov1.BackupPhaseCompleted)
+
+	tests := []struct {
+		name          string
+		dependsOn     *velerov1.ScheduleDependency
+		objs          []runtime.Object
+		expectReady   bool
+		expectSkipped bool
+	}{
+		{
+			name:        "no dependency",
+			expectReady: true,
+		},
+		{
+			name:        "completed",
+			dependsOn:   &velerov1.ScheduleDependency{Schedule: "dump"},
+			objs:        []runtime.Object{yesterday, backup("dump-2", now.Add(-time.Hour), velerov1.BackupPhaseCompleted)},
+			expectReady: true,
+		},
+		{
+			name:      "in progress",
+			dependsOn: &velerov1.ScheduleDependency{Schedule: "dump"},
+			objs:      []runtime.Object{yesterday, backup("dump-2", now.Add(-time.Hour), velerov1.BackupPhaseInProgress)},
+		},
+		{
+			name:          "in progress after the timeout",
+			dependsOn:     &velerov1.ScheduleDependency{Schedule: "dump", Timeout: metav1.Duration{Duration: 10 * time.Minute}},
+			objs:          []runtime.Object{yesterday, backup("dump-2", now.Add(-time.Hour), velerov1.BackupPhaseInProgress)},
+			expectSkipped: true,
+		},
+		{
+			name:      "no backup",
+			dependsOn: &velerov1.ScheduleDependency{Schedule: "dump"},
+		},
+		{
+			name:      "completed before the last backup of the schedule",
+			dependsOn: &velerov1.ScheduleDependency{Schedule: "dump"},
+			objs:      []runtime.Object{backup("dump-0", now.Add(-25*time.Hour), velerov1.BackupPhaseCompleted)},
+		},
+		{
+			name:          "completed before the last backup of the schedule, after the timeout",
+			dependsOn:     &velerov1.ScheduleDependency{Schedule: "dump", Timeout: metav1.Duration{Duration: 10 * time.Minute}},
+			objs:          []runtime.Object{backup("dump-0", now.Add(-25*time.Hour), velerov1.BackupPhaseCompleted)},
+			expectSkipped: true,
+		},
+		{
+			name:          "failed",
+			dependsOn:     &velerov1.ScheduleDependency{Schedule: "dump"},
+			objs:          []runtime.Object{yesterday, backup("dump-2", now.Add(-time.Hour), velerov1.BackupPhasePartiallyFailed)},
+			expectSkipped: true,
+		},
+		{
+			name:        "failed, run anyway",
+			dependsOn:   &velerov1.ScheduleDependency{Schedule: "dump", FailurePolicy: velerov1.ScheduleDependencyFailurePolicyRun},
+			objs:        []runtime.Object{yesterday, backup("dump-2", now.Add(-time.Hour), velerov1.BackupPhaseFailed)},
+			expectReady: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schedule := builder.ForSchedule("velero", "volumes").CronSchedule("0 3 * * *").
+				Phase(velerov1.SchedulePhaseEnabled).LastBackupTime("2025-12-31 03:00:00").Result()
+			schedule.Spec.DependsOn = test.dependsOn
+
+			client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(append(test.objs, schedule)...).Build()
+			reconciler := NewScheduleReconciler("velero", velerotest.NewLogger(), client, metrics.NewServerMetrics(), false, 0)
+			reconciler.clock = testclocks.NewFakeClock(now)
+
+			cronSchedule, errs := parseCronSchedule(schedule, velerotest.NewLogger())
+			require.Empty(t, errs)
+			ready, skipped, err := reconciler.checkDependency(context.TODO(), schedule, cronSchedule, velerotest.NewLogger())
+			require.NoError(t, err)
+			assert.Equal(t, test.expectReady, ready)
+			assert.Equal(t, test.expectSkipped, skipped)
+		})
+	}
+}
+
+func TestParseCronScheduleDependsOn(t *testing.T) {
+	s := builder.ForSchedule("velero", "volumes").CronSchedule("0 3 * * *").Result()
+	s.Spec.DependsOn = &velerov1.ScheduleDependency{Schedule: "dump", FailurePolicy: velerov1.ScheduleDependencyFailurePolicyRun}
+	_, errs := parseCronSchedule(s, velerotest.NewLogger())
+	assert.Empty(t, errs)
+
+	s.Spec.DependsOn = &velerov1.ScheduleDependency{Schedule: "volumes", Timeout: metav1.Duration{Duration: -time.Minute}, FailurePolicy: "Retry"}
+	_, errs = parseCronSchedule(s, velerotest.NewLogger())
+	assert.Equal(t, []string{
+		"dependsOn.schedule must be the name of another schedule",
+		"dependsOn.timeout must not be negative",
+		`invalid dependsOn.failurePolicy "Retry"`,
+	}, errs)
+}
+
+func TestScheduleFailoverStorageLocation(t *testing.T) {
+	require.NoError(t, velerov1.AddToScheme(scheme.Scheme))
+
+	location := func(name string, phase velerov1.BackupStorageLocationPhase) *builder.BackupStorageLocationBuilder {
+		return builder.ForBackupStorageLocation("velero", name).Phase(phase)
+	}
+
+	tests := []struct {
+		name             string
+		storageLocation  string
+		objs             []runtime.Object
+		expectedLocation string
+	}{
+		{
+			name:            "available",
+			storageLocation: "primary",
+			objs: []runtime.Object{
+				location("primary", velerov1.BackupStorageLocationPhaseAvailable).FailoverLocations("secondary").Result(),
+				location("secondary", velerov1.BackupStorageLocationPhaseAvailable).Result(),
+			},
+			expectedLocation: "primary",
+		},
+		{
+			name:            "unavailable",
+			storageLocation: "primary",
+			objs: []runtime.Object{
+				location("primary", velerov1.BackupStorageLocationPhaseUnavailable).FailoverLocations("secondary", "tertiary").Result(),
+				location("secondary", velerov1.BackupStorageLocationPhaseUnavailable).Result(),
+				location("tertiary", velerov1.BackupStorageLocationPhaseAvailable).Result(),
+			},
+			expectedLocation: "tertiary",
+		},
+		{
+			name: "default unavailable",
+			objs: []runtime.Object{
+				location("primary", velerov1.BackupStorageLocationPhaseUnavailable).Default(true).FailoverLocations("secondary").Result(),
+				location("secondary", velerov1.BackupStorageLocationPhaseAvailable).Result(),
+			},
+			expectedLocation: "secondary",
+		},
+		{
+			name:            "no failover location available",
+			storageLocation: "primary",
+			objs: []runtime.Object{
+				location("primary", velerov1.BackupStorageLocationPhaseUnavailable).FailoverLocations("secondary", "missing").Result(),
+				location("secondary", velerov1.BackupStorageLocationPhaseAvailable).AccessMode(velerov1.BackupStorageLocationAccessModeReadOnly).Result(),
+			},
+			expectedLocation: "primary",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(test.objs...).Build()
+			reconciler := NewScheduleReconciler("velero", velerotest.NewLogger(), client, metrics.NewServerMetrics(), false, 0)
+
+			backup := builder.ForBackup("velero", "daily-1").StorageLocation(test.storageLocation).Result()
+			require.NoError(t, reconciler.failoverStorageLocation(context.TODO(), backup))
+			if test.expectedLocation == test.storageLocation {
+				assert.Equal(t, test.storageLocation, backup.Spec.StorageLocation)
+				assert.Empty(t, backup.Annotations)
+			} else {
+				assert.Equal(t, test.expectedLocation, backup.Spec.StorageLocation)
+				assert.Equal(t, "primary", backup.Annotations[velerov1.FailoverFromStorageLocationAnnotation])
+			}
+		})
+	}
+}
+
+func TestSchedulePreserveBackups(t *testing.T) {
+	require.NoError(t, velerov1.AddToScheme(scheme.Scheme))
+
+	schedule := builder.ForSchedule("velero", "daily").CronSchedule("0 3 * * *").Result()
+	schedule.UID = "schedule-uid"
+	schedule.Spec.UseOwnerReferencesInBackup = boolptr.True()
+	schedule.Spec.PreserveBackupsOnScheduleDelete = true
+	schedule.Spec.Paused = true
+	schedule.Status.LastBackup = &metav1.Time{Time: time.Now()}
+	owned := getBackup(schedule, time.Now())
+	owned.OwnerReferences = append(owned.OwnerReferences, metav1.OwnerReference{APIVersion: "v1", Kind: "ConfigMap", Name: "other", UID: "other-uid"})
+
+	client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(schedule, owned).Build()
+	reconciler := NewScheduleReconciler("velero", velerotest.NewLogger(), client, metrics.NewServerMetrics(), false, 0)
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "velero", Name: "daily"}}
+
+	// the finalizer is added to the schedule owning its backups, even though it's paused
+	_, err := reconciler.Reconcile(context.TODO(), req)
+	require.NoError(t, err)
+	require.NoError(t, client.Get(context.TODO(), req.NamespacedName, schedule))
+	assert.Equal(t, []string{velerov1.PreserveBackupsFinalizer}, schedule.Finalizers)
+
+	// the owner references to the deleted schedule are removed from its backups
+	require.NoError(t, client.Delete(context.TODO(), schedule))
+	_, err = reconciler.Reconcile(context.TODO(), req)
+	require.NoError(t, err)
+	assert.True(t, apierrors.IsNotFound(client.Get(context.TODO(), req.NamespacedName, schedule)))
+	backup := &velerov1.Backup{}
+	require.NoError(t, client.Get(context.TODO(), types.NamespacedName{Namespace: "velero", Name: owned.Name}, backup))
+	require.Len(t, backup.OwnerReferences, 1)
+	assert.Equal(t, "other", backup.OwnerReferences[0].Name)
+}
@@ -0,0 +1,154 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	clocks "k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/constant"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/util/conditions"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// storageLayoutMigrationReconciler copies the files of the backup storage locations annotated
+// with the migrate storage layout annotation into their v2 storage layout, and switches them to
+// it once the copy is verified. The migration is run once per annotation, and its result is
+// recorded in the StorageLayoutMigrated condition of the location.
+type storageLayoutMigrationReconciler struct {
+	client            kbclient.Client
+	clock             clocks.Clock
+	newPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
+	backupStoreGetter persistence.ObjectBackupStoreGetter
+	logger            logrus.FieldLogger
+}
+
+// NewStorageLayoutMigrationReconciler initializes and returns storageLayoutMigrationReconciler struct.
+func NewStorageLayoutMigrationReconciler(
+	client kbclient.Client,
+	clock clocks.Clock,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	logger logrus.FieldLogger,
+) *storageLayoutMigrationReconciler {
+	return &storageLayoutMigrationReconciler{
+		client:            client,
+		clock:             clock,
+		newPluginManager:  newPluginManager,
+		backupStoreGetter: backupStoreGetter,
+		logger:            logger,
+	}
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get;list;watch;update;patch
+
+func (r *storageLayoutMigrationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithFields(logrus.Fields{
+		"controller":            constant.ControllerStorageLayoutMigration,
+		"backupStorageLocation": req.NamespacedName,
+	})
+
+	location := &velerov1api.BackupStorageLocation{}
+	if err := r.client.Get(ctx, req.NamespacedName, location); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find BackupStorageLocation")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrap(err, "error getting backup storage location")
+	}
+	scope, requested := location.Annotations[velerov1api.MigrateStorageLayoutAnnotation]
+	if !requested {
+		return ctrl.Result{}, nil
+	}
+
+	original := location.DeepCopy()
+	r.migrate(location, scope, log)
+	delete(location.Annotations, velerov1api.MigrateStorageLayoutAnnotation)
+
+	if err := kube.PatchResource(original, location, r.client); err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "error updating the storage layout migration of the backup storage location")
+	}
+	return ctrl.Result{}, nil
+}
+
+// migrate copies the files of the v1 layout of the location into the v2 layout of the tenant
+// and the cluster of the scope, and switches the location to it once all the files were copied.
+func (r *storageLayoutMigrationReconciler) migrate(location *velerov1api.BackupStorageLocation, scope string, log logrus.FieldLogger) {
+	now := r.clock.Now()
+
+	if persistence.UsesStorageLayoutV2(location) {
+		conditions.SetBackupStorageLocationLayoutMigratedCondition(location, now, false, "AlreadyMigrated", "the location already uses the v2 storage layout")
+		return
+	}
+	if persistence.IsReadOnly(location) {
+		conditions.SetBackupStorageLocationLayoutMigratedCondition(location, now, false, "ReadOnly", "the files of a read-only location can't be copied")
+		return
+	}
+
+	target := location.DeepCopy()
+	tenant, cluster, _ := strings.Cut(scope, "/")
+	target.Spec.StorageLayout = &velerov1api.StorageLayout{
+		Version: velerov1api.StorageLayoutV2,
+		Tenant:  tenant,
+		Cluster: cluster,
+	}
+
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(target, pluginManager, log)
+	if err != nil {
+		conditions.SetBackupStorageLocationLayoutMigratedCondition(location, now, false, "MigrationError", fmt.Sprintf("error getting the backup store: %v", err))
+		return
+	}
+
+	log.Infof("Migrating the BackupStorageLocation to the v2 storage layout under %s", persistence.LocationPrefix(target))
+	migrated, err := backupStore.MigrateLayout()
+	if err != nil {
+		log.WithError(err).Error("Error migrating the BackupStorageLocation to the v2 storage layout")
+		conditions.SetBackupStorageLocationLayoutMigratedCondition(location, now, false, "MigrationError", err.Error())
+		return
+	}
+
+	log.Infof("Migrated %d backups to the v2 storage layout", migrated)
+	location.Spec.StorageLayout = target.Spec.StorageLayout
+	conditions.SetBackupStorageLocationLayoutMigratedCondition(location, now, true, "Migrated",
+		fmt.Sprintf("%d backups were copied to the v2 storage layout", migrated))
+}
+
+func (r *storageLayoutMigrationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&velerov1api.BackupStorageLocation{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(object kbclient.Object) bool {
+			_, requested := object.GetAnnotations()[velerov1api.MigrateStorageLayoutAnnotation]
+			return requested
+		}))).
+		Named(constant.ControllerStorageLayoutMigration).
+		Complete(r)
+}
@@ -0,0 +1,122 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclocks "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestStorageLayoutMigrationReconcile(t *testing.T) {
+	tests := []struct {
+		name             string
+		location         *velerov1api.BackupStorageLocation
+		migrateErr       error
+		expectMigrate    bool
+		expectedLayout   *velerov1api.StorageLayout
+		expectedMigrated metav1.ConditionStatus
+		expectedReason   string
+	}{
+		{
+			name: "location is switched to the v2 layout once migrated",
+			location: builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "location-1").
+				ObjectMeta(builder.WithAnnotations(velerov1api.MigrateStorageLayoutAnnotation, "tenant-1/cluster-1")).Result(),
+			expectMigrate: true,
+			expectedLayout: &velerov1api.StorageLayout{
+				Version: velerov1api.StorageLayoutV2,
+				Tenant:  "tenant-1",
+				Cluster: "cluster-1",
+			},
+			expectedMigrated: metav1.ConditionTrue,
+			expectedReason:   "Migrated",
+		},
+		{
+			name: "location isn't switched to the v2 layout when the migration fails",
+			location: builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "location-1").
+				ObjectMeta(builder.WithAnnotations(velerov1api.MigrateStorageLayoutAnnotation, "tenant-1/cluster-1")).Result(),
+			migrateErr:       errors.New("an error"),
+			expectMigrate:    true,
+			expectedMigrated: metav1.ConditionFalse,
+			expectedReason:   "MigrationError",
+		},
+		{
+			name: "v2 location isn't migrated",
+			location: builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "location-1").
+				ObjectMeta(builder.WithAnnotations(velerov1api.MigrateStorageLayoutAnnotation, "tenant-1/cluster-1")).
+				StorageLayout(velerov1api.StorageLayoutV2, "tenant-2", "cluster-2").Result(),
+			expectedLayout: &velerov1api.StorageLayout{
+				Version: velerov1api.StorageLayoutV2,
+				Tenant:  "tenant-2",
+				Cluster: "cluster-2",
+			},
+			expectedMigrated: metav1.ConditionFalse,
+			expectedReason:   "AlreadyMigrated",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := velerotest.NewFakeControllerRuntimeClient(t, test.location)
+
+			backupStore := &persistencemocks.BackupStore{}
+			if test.expectMigrate {
+				backupStore.On("MigrateLayout").Return(2, test.migrateErr)
+			}
+			pluginManager := &pluginmocks.Manager{}
+			pluginManager.On("CleanupClients").Return(nil)
+
+			r := NewStorageLayoutMigrationReconciler(
+				client,
+				testclocks.NewFakeClock(time.Now()),
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeSingleObjectBackupStoreGetter(backupStore),
+				velerotest.NewLogger(),
+			)
+			_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: kbclient.ObjectKeyFromObject(test.location)})
+			require.NoError(t, err)
+
+			updated := &velerov1api.BackupStorageLocation{}
+			require.NoError(t, client.Get(context.Background(), kbclient.ObjectKeyFromObject(test.location), updated))
+			assert.NotContains(t, updated.Annotations, velerov1api.MigrateStorageLayoutAnnotation)
+			assert.Equal(t, test.expectedLayout, updated.Spec.StorageLayout)
+
+			migrated := meta.FindStatusCondition(updated.Status.Conditions, velerov1api.ConditionTypeStorageLayoutMigrated)
+			require.NotNil(t, migrated)
+			assert.Equal(t, test.expectedMigrated, migrated.Status)
+			assert.Equal(t, test.expectedReason, migrated.Reason)
+			backupStore.AssertExpectations(t)
+		})
+	}
+}
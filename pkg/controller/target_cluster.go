@@ -0,0 +1,51 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/targetcluster"
+)
+
+// getTargetCluster returns the target cluster of a backup or a restore, target clusters are
+// only available when the server runs in the hub mode, i.e. targetClusters isn't nil.
+func getTargetCluster(ctx context.Context, targetClusters targetcluster.Getter, name string) (*targetcluster.Cluster, error) {
+	if targetClusters == nil {
+		return nil, errors.Errorf("target cluster %s can't be used as the server doesn't run in the hub mode", name)
+	}
+	return targetClusters.Get(ctx, name)
+}
+
+// restoreClusterClient returns the client of the cluster the restore is made into, falling back
+// to client, the one of the cluster the server runs in, when the target cluster can't be got.
+func restoreClusterClient(ctx context.Context, targetClusters targetcluster.Getter, restore *velerov1api.Restore, client kbclient.Client, log logrus.FieldLogger) kbclient.Client {
+	if restore.Spec.TargetCluster == "" {
+		return client
+	}
+	cluster, err := getTargetCluster(ctx, targetClusters, restore.Spec.TargetCluster)
+	if err != nil {
+		log.WithError(err).Warn("Error getting target cluster")
+		return client
+	}
+	return cluster.CRClient
+}
@@ -19,6 +19,7 @@ package datamover
 import (
 	"context"
 	"encoding/json"
+	"os"
 	"time"
 
 	"github.com/pkg/errors"
@@ -62,6 +63,10 @@ type BackupMicroService struct {
 	dataUpload       *velerov2alpha1api.DataUpload
 	sourceTargetPath datapath.AccessPoint
 
+	snapshotHandle    string
+	baseDataUpload    *velerov2alpha1api.DataUpload
+	changedBlocksFile string
+
 	resultSignal chan dataPathResult
 
 	duInformer cache.Informer
@@ -191,13 +196,16 @@ func (r *BackupMicroService) RunCancelableDataPath(ctx context.Context) (string,
 
 	log.Info("Async fs br init")
 
-	tags := map[string]string{
-		velerov1api.AsyncOperationIDLabel: du.Labels[velerov1api.AsyncOperationIDLabel],
+	tags := GetSnapshotTags(du)
+
+	parentSnapshot, uploaderCfg := r.prepareChangedBlockTracking(ctx, du, tags, log)
+	if r.changedBlocksFile != "" {
+		defer os.Remove(r.changedBlocksFile)
 	}
 
-	if err := fsBackup.StartBackup(r.sourceTargetPath, du.Spec.DataMoverConfig, &datapath.FSBRStartParam{
+	if err := fsBackup.StartBackup(r.sourceTargetPath, uploaderCfg, &datapath.FSBRStartParam{
 		RealSource:     GetRealSource(du.Spec.SourceNamespace, du.Spec.SourcePVC),
-		ParentSnapshot: "",
+		ParentSnapshot: parentSnapshot,
 		ForceFull:      false,
 		Tags:           tags,
 	}); err != nil {
@@ -250,6 +258,8 @@ func (r *BackupMicroService) OnDataUploadCompleted(ctx context.Context, namespac
 			err: errors.Wrapf(err, "Failed to marshal backup result %v", result.Backup),
 		}
 	} else {
+		r.retainChangedBlockTrackingSnapshot(ctx, log)
+
 		r.eventRecorder.Event(r.dataUpload, false, datapath.EventReasonCompleted, string(backupBytes))
 		r.resultSignal <- dataPathResult{
 			result: string(backupBytes),
@@ -0,0 +1,224 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamover
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	uploaderutil "github.com/vmware-tanzu/velero/pkg/uploader/util"
+	"github.com/vmware-tanzu/velero/pkg/util/csi"
+)
+
+// prepareChangedBlockTracking gets the blocks changed since the CSI snapshot of the last
+// completed DataUpload of the same volume, for changed block tracking backups of block volumes.
+// It returns the snapshot of that DataUpload as the parent and the uploader config passing the
+// changed blocks. If the changed blocks can't be got, the backup reads the whole volume.
+func (r *BackupMicroService) prepareChangedBlockTracking(ctx context.Context, du *velerov2alpha1api.DataUpload, tags map[string]string, log logrus.FieldLogger) (string, map[string]string) {
+	uploaderCfg := du.Spec.DataMoverConfig
+
+	if r.sourceTargetPath.VolMode != uploader.PersistentVolumeBlock {
+		return "", uploaderCfg
+	}
+
+	enabled, err := uploaderutil.GetChangedBlockTracking(uploaderCfg)
+	if err != nil || !enabled {
+		return "", uploaderCfg
+	}
+
+	vsc := new(snapshotv1api.VolumeSnapshotContent)
+	if err := r.client.Get(ctx, client.ObjectKey{Name: du.Name}, vsc); err != nil {
+		log.WithError(err).Warn("Failed to get the backup VolumeSnapshotContent, reading all the blocks")
+		return "", uploaderCfg
+	}
+
+	if vsc.Status == nil || vsc.Status.SnapshotHandle == nil {
+		log.Warnf("VolumeSnapshotContent %s has no snapshot handle, reading all the blocks", vsc.Name)
+		return "", uploaderCfg
+	}
+
+	r.snapshotHandle = *vsc.Status.SnapshotHandle
+	tags[uploader.SnapshotHandleTag] = r.snapshotHandle
+
+	base, err := r.findBaseDataUpload(ctx, du)
+	if err != nil {
+		log.WithError(err).Warn("Failed to find the base DataUpload, reading all the blocks")
+		return "", uploaderCfg
+	}
+
+	if base == nil {
+		log.Info("No base DataUpload, reading all the blocks")
+		return "", uploaderCfg
+	}
+
+	r.baseDataUpload = base
+	baseHandle := base.Annotations[velerov1api.VolumeSnapshotHandleAnnotation]
+
+	pod := new(corev1api.Pod)
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: du.Namespace, Name: du.Name}, pod); err != nil {
+		log.WithError(err).Warn("Failed to get the backup pod, reading all the blocks")
+		return "", uploaderCfg
+	}
+
+	blocks, err := csi.GetChangedBlocks(ctx, r.kubeClient, r.client, vsc.Spec.Driver, du.Namespace, pod.Spec.ServiceAccountName, baseHandle, du.Name)
+	if err != nil {
+		log.WithError(err).Warnf("Failed to get the blocks changed since CSI snapshot %s, reading all the blocks", baseHandle)
+		return "", uploaderCfg
+	}
+
+	changedBlocksFile, err := writeChangedBlocks(&uploader.ChangedBlocks{
+		BaseSnapshotHandle: baseHandle,
+		Blocks:             blocks,
+	})
+	if err != nil {
+		log.WithError(err).Warn("Failed to write the changed blocks, reading all the blocks")
+		return "", uploaderCfg
+	}
+
+	r.changedBlocksFile = changedBlocksFile
+
+	log.Infof("Got %d blocks changed since CSI snapshot %s of DataUpload %s", len(blocks), baseHandle, base.Name)
+
+	cfg := make(map[string]string, len(uploaderCfg)+1)
+	for k, v := range uploaderCfg {
+		cfg[k] = v
+	}
+	cfg[uploaderutil.ChangedBlocksFile] = changedBlocksFile
+
+	return base.Status.SnapshotID, cfg
+}
+
+// findBaseDataUpload returns the last completed DataUpload backing up the same volume to the
+// same BSL whose CSI snapshot was retained, nil if there is none.
+func (r *BackupMicroService) findBaseDataUpload(ctx context.Context, du *velerov2alpha1api.DataUpload) (*velerov2alpha1api.DataUpload, error) {
+	duList := new(velerov2alpha1api.DataUploadList)
+	if err := r.client.List(ctx, duList, client.InNamespace(du.Namespace)); err != nil {
+		return nil, errors.Wrap(err, "error listing DataUploads")
+	}
+
+	var base *velerov2alpha1api.DataUpload
+	for i := range duList.Items {
+		candidate := &duList.Items[i]
+		if candidate.Name == du.Name ||
+			candidate.Status.Phase != velerov2alpha1api.DataUploadPhaseCompleted ||
+			candidate.Status.SnapshotID == "" ||
+			candidate.Annotations[velerov1api.VolumeSnapshotHandleAnnotation] == "" ||
+			candidate.Spec.SourceNamespace != du.Spec.SourceNamespace ||
+			candidate.Spec.SourcePVC != du.Spec.SourcePVC ||
+			candidate.Spec.BackupStorageLocation != du.Spec.BackupStorageLocation {
+			continue
+		}
+
+		if candidate.Status.CompletionTimestamp == nil {
+			continue
+		}
+
+		if base == nil || base.Status.CompletionTimestamp.Before(candidate.Status.CompletionTimestamp) {
+			base = candidate
+		}
+	}
+
+	return base, nil
+}
+
+// retainChangedBlockTrackingSnapshot keeps the CSI snapshot of the completed DataUpload as the
+// base of the next backup of the volume and releases the previous base.
+func (r *BackupMicroService) retainChangedBlockTrackingSnapshot(ctx context.Context, log logrus.FieldLogger) {
+	if r.snapshotHandle == "" {
+		return
+	}
+
+	if err := csi.SetVolumeSnapshotContentDeletionPolicy(r.dataUploadName, r.client, snapshotv1api.VolumeSnapshotContentRetain); err != nil {
+		log.WithError(err).Warnf("Failed to retain VolumeSnapshotContent %s, the next backup reads all the blocks", r.dataUploadName)
+		return
+	}
+
+	if err := r.annotateSnapshotHandle(ctx); err != nil {
+		log.WithError(err).Warnf("Failed to annotate DataUpload %s with its snapshot handle, the next backup reads all the blocks", r.dataUploadName)
+		// the snapshot isn't retained as no DataUpload references it
+		if err := csi.SetVolumeSnapshotContentDeletionPolicy(r.dataUploadName, r.client, snapshotv1api.VolumeSnapshotContentDelete); err != nil {
+			log.WithError(err).Warnf("Failed to release VolumeSnapshotContent %s", r.dataUploadName)
+		}
+		return
+	}
+
+	log.Infof("Retained CSI snapshot %s for changed block tracking", r.snapshotHandle)
+
+	if r.baseDataUpload == nil {
+		return
+	}
+
+	if err := r.releaseBaseSnapshot(ctx, r.baseDataUpload); err != nil {
+		log.WithError(err).Warnf("Failed to release the CSI snapshot of DataUpload %s", r.baseDataUpload.Name)
+	}
+}
+
+// annotateSnapshotHandle annotates the DataUpload with the handle of its retained CSI snapshot.
+func (r *BackupMicroService) annotateSnapshotHandle(ctx context.Context) error {
+	du := new(velerov2alpha1api.DataUpload)
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: r.namespace, Name: r.dataUploadName}, du); err != nil {
+		return errors.Wrapf(err, "error getting DataUpload %s", r.dataUploadName)
+	}
+
+	original := du.DeepCopy()
+	if du.Annotations == nil {
+		du.Annotations = make(map[string]string)
+	}
+	du.Annotations[velerov1api.VolumeSnapshotHandleAnnotation] = r.snapshotHandle
+	return r.client.Patch(ctx, du, client.MergeFrom(original))
+}
+
+// releaseBaseSnapshot deletes the CSI snapshot retained by the base DataUpload.
+func (r *BackupMicroService) releaseBaseSnapshot(ctx context.Context, base *velerov2alpha1api.DataUpload) error {
+	if err := csi.ReleaseRetainedVolumeSnapshotContent(ctx, r.client, base.Name); err != nil {
+		return err
+	}
+
+	original := base.DeepCopy()
+	delete(base.Annotations, velerov1api.VolumeSnapshotHandleAnnotation)
+	return client.IgnoreNotFound(r.client.Patch(ctx, base, client.MergeFrom(original)))
+}
+
+func writeChangedBlocks(changed *uploader.ChangedBlocks) (string, error) {
+	data, err := json.Marshal(changed)
+	if err != nil {
+		return "", errors.Wrap(err, "error marshaling changed blocks")
+	}
+
+	file, err := os.CreateTemp("", "changed-blocks-")
+	if err != nil {
+		return "", errors.Wrap(err, "error creating changed blocks file")
+	}
+	defer file.Close()
+
+	if _, err := file.Write(data); err != nil {
+		os.Remove(file.Name())
+		return "", errors.Wrap(err, "error writing changed blocks file")
+	}
+
+	return file.Name(), nil
+}
@@ -0,0 +1,196 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamover
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	uploaderutil "github.com/vmware-tanzu/velero/pkg/uploader/util"
+)
+
+func TestFindBaseDataUpload(t *testing.T) {
+	now := time.Now()
+	completedDataUpload := func(name, pvc, bsl, handle string, completed time.Time) *velerov2alpha1api.DataUpload {
+		return builder.ForDataUpload(velerov1api.DefaultNamespace, name).
+			SourceNamespace("fake-ns").
+			SourcePVC(pvc).
+			BackupStorageLocation(bsl).
+			Phase(velerov2alpha1api.DataUploadPhaseCompleted).
+			SnapshotID("snapshot-" + name).
+			Annotations(map[string]string{velerov1api.VolumeSnapshotHandleAnnotation: handle}).
+			CompletionTimestamp(&metav1.Time{Time: completed}).
+			Result()
+	}
+
+	du := builder.ForDataUpload(velerov1api.DefaultNamespace, "du-current").
+		SourceNamespace("fake-ns").
+		SourcePVC("fake-pvc").
+		BackupStorageLocation("default").
+		Phase(velerov2alpha1api.DataUploadPhaseInProgress).
+		Result()
+
+	tests := []struct {
+		name     string
+		existing []runtime.Object
+		expected string
+	}{
+		{
+			name:     "no completed DataUpload",
+			existing: []runtime.Object{du},
+		},
+		{
+			name: "the DataUpload of another volume or BSL isn't a base",
+			existing: []runtime.Object{
+				du,
+				completedDataUpload("du-other-pvc", "other-pvc", "default", "handle-1", now),
+				completedDataUpload("du-other-bsl", "fake-pvc", "other-bsl", "handle-2", now),
+			},
+		},
+		{
+			name: "the DataUpload without retained snapshot isn't a base",
+			existing: []runtime.Object{
+				du,
+				completedDataUpload("du-not-retained", "fake-pvc", "default", "", now),
+			},
+		},
+		{
+			name: "the last completed DataUpload is the base",
+			existing: []runtime.Object{
+				du,
+				completedDataUpload("du-old", "fake-pvc", "default", "handle-1", now.Add(-2*time.Hour)),
+				completedDataUpload("du-last", "fake-pvc", "default", "handle-2", now.Add(-time.Hour)),
+			},
+			expected: "du-last",
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, velerov2alpha1api.AddToScheme(scheme))
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bs := &BackupMicroService{
+				client: clientFake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(test.existing...).Build(),
+				logger: velerotest.NewLogger(),
+			}
+
+			base, err := bs.findBaseDataUpload(context.TODO(), du)
+			require.NoError(t, err)
+			if test.expected == "" {
+				assert.Nil(t, base)
+			} else {
+				require.NotNil(t, base)
+				assert.Equal(t, test.expected, base.Name)
+			}
+		})
+	}
+}
+
+func TestRetainChangedBlockTrackingSnapshot(t *testing.T) {
+	vsc := func(name string, policy snapshotv1api.DeletionPolicy) *snapshotv1api.VolumeSnapshotContent {
+		return &snapshotv1api.VolumeSnapshotContent{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       snapshotv1api.VolumeSnapshotContentSpec{DeletionPolicy: policy},
+		}
+	}
+	base := builder.ForDataUpload(velerov1api.DefaultNamespace, "du-base").
+		Annotations(map[string]string{velerov1api.VolumeSnapshotHandleAnnotation: "handle-1"}).Result()
+	du := builder.ForDataUpload(velerov1api.DefaultNamespace, "du-current").Result()
+
+	tests := []struct {
+		name             string
+		existing         []runtime.Object
+		expectedPolicy   snapshotv1api.DeletionPolicy
+		expectedHandle   string
+		expectBaseExists bool
+	}{
+		{
+			name:           "the snapshot is retained and the base is released",
+			existing:       []runtime.Object{du, base, vsc("du-current", snapshotv1api.VolumeSnapshotContentDelete), vsc("du-base", snapshotv1api.VolumeSnapshotContentRetain)},
+			expectedPolicy: snapshotv1api.VolumeSnapshotContentRetain,
+			expectedHandle: "handle-2",
+		},
+		{
+			name:             "the snapshot isn't retained when the DataUpload can't reference it",
+			existing:         []runtime.Object{base, vsc("du-current", snapshotv1api.VolumeSnapshotContentDelete), vsc("du-base", snapshotv1api.VolumeSnapshotContentRetain)},
+			expectedPolicy:   snapshotv1api.VolumeSnapshotContentDelete,
+			expectBaseExists: true,
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, velerov2alpha1api.AddToScheme(scheme))
+	require.NoError(t, snapshotv1api.AddToScheme(scheme))
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bs := &BackupMicroService{
+				client:         clientFake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(test.existing...).Build(),
+				logger:         velerotest.NewLogger(),
+				namespace:      velerov1api.DefaultNamespace,
+				dataUploadName: "du-current",
+				snapshotHandle: "handle-2",
+				baseDataUpload: base.DeepCopy(),
+			}
+			bs.retainChangedBlockTrackingSnapshot(context.TODO(), bs.logger)
+
+			current := &snapshotv1api.VolumeSnapshotContent{}
+			require.NoError(t, bs.client.Get(context.TODO(), client.ObjectKey{Name: "du-current"}, current))
+			assert.Equal(t, test.expectedPolicy, current.Spec.DeletionPolicy)
+
+			err := bs.client.Get(context.TODO(), client.ObjectKey{Name: "du-base"}, &snapshotv1api.VolumeSnapshotContent{})
+			assert.Equal(t, test.expectBaseExists, err == nil)
+
+			if test.expectedHandle != "" {
+				updated := &velerov2alpha1api.DataUpload{}
+				require.NoError(t, bs.client.Get(context.TODO(), client.ObjectKeyFromObject(du), updated))
+				assert.Equal(t, test.expectedHandle, updated.Annotations[velerov1api.VolumeSnapshotHandleAnnotation])
+			}
+		})
+	}
+}
+
+func TestWriteChangedBlocks(t *testing.T) {
+	changed := &uploader.ChangedBlocks{
+		BaseSnapshotHandle: "fake-handle",
+		Blocks:             []uploader.BlockRange{{Offset: 0, Length: 4096}},
+	}
+
+	path, err := writeChangedBlocks(changed)
+	require.NoError(t, err)
+	defer os.Remove(path)
+
+	loaded, err := uploaderutil.GetChangedBlocks(map[string]string{uploaderutil.ChangedBlocksFile: path})
+	require.NoError(t, err)
+	assert.Equal(t, changed, loaded)
+}
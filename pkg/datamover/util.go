@@ -16,7 +16,12 @@ limitations under the License.
 
 package datamover
 
-import "fmt"
+import (
+	"fmt"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+)
 
 func GetUploaderType(dataMover string) string {
 	if dataMover == "" || dataMover == "velero" {
@@ -33,3 +38,14 @@ func IsBuiltInUploader(dataMover string) bool {
 func GetRealSource(sourceNamespace string, pvcName string) string {
 	return fmt.Sprintf("%s/%s", sourceNamespace, pvcName)
 }
+
+// GetSnapshotTags returns the tags of the snapshot of the data uploaded by the DataUpload: its
+// own tags and the ID of its async operation.
+func GetSnapshotTags(du *velerov2alpha1api.DataUpload) map[string]string {
+	tags := make(map[string]string, len(du.Spec.Tags)+1)
+	for k, v := range du.Spec.Tags {
+		tags[k] = v
+	}
+	tags[velerov1api.AsyncOperationIDLabel] = du.Labels[velerov1api.AsyncOperationIDLabel]
+	return tags
+}
@@ -25,6 +25,8 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/vmware-tanzu/velero/internal/throttle"
 )
 
 var ConcurrentLimitExceed error = errors.New("Concurrent number exceeds")
@@ -32,9 +34,10 @@ var FSBRCreator = newFileSystemBR
 var MicroServiceBRWatcherCreator = newMicroServiceBRWatcher
 
 type Manager struct {
-	cocurrentNum int
-	trackerLock  sync.Mutex
-	tracker      map[string]AsyncBR
+	cocurrentNum    int
+	loadConcurrency *throttle.Concurrency
+	trackerLock     sync.Mutex
+	tracker         map[string]AsyncBR
 }
 
 // NewManager creates the data path manager to manage concurrent data path instances
@@ -45,12 +48,27 @@ func NewManager(cocurrentNum int) *Manager {
 	}
 }
 
+// SetLoadConcurrency makes the concurrent number adapt to the load of the node, it must be called
+// before any data path instance is created
+func (m *Manager) SetLoadConcurrency(loadConcurrency *throttle.Concurrency) {
+	m.loadConcurrency = loadConcurrency
+}
+
+// concurrentLimit returns the concurrent number, adapted to the load of the node if configured
+func (m *Manager) concurrentLimit() int {
+	if m.loadConcurrency == nil {
+		return m.cocurrentNum
+	}
+
+	return m.loadConcurrency.Limit()
+}
+
 // CreateFileSystemBR creates a new file system backup/restore data path instance
 func (m *Manager) CreateFileSystemBR(jobName string, requestorType string, ctx context.Context, client client.Client, namespace string, callbacks Callbacks, log logrus.FieldLogger) (AsyncBR, error) {
 	m.trackerLock.Lock()
 	defer m.trackerLock.Unlock()
 
-	if len(m.tracker) >= m.cocurrentNum {
+	if len(m.tracker) >= m.concurrentLimit() {
 		return nil, ConcurrentLimitExceed
 	}
 
@@ -66,7 +84,7 @@ func (m *Manager) CreateMicroServiceBRWatcher(ctx context.Context, client client
 	defer m.trackerLock.Unlock()
 
 	if !resume {
-		if len(m.tracker) >= m.cocurrentNum {
+		if len(m.tracker) >= m.concurrentLimit() {
 			return nil, ConcurrentLimitExceed
 		}
 	}
@@ -18,9 +18,15 @@ package datapath
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/internal/throttle"
 )
 
 func TestCreateFileSystemBR(t *testing.T) {
@@ -84,3 +90,17 @@ func TestCreateMicroServiceBRWatcher(t *testing.T) {
 	ret = m.GetAsyncBR("job-1")
 	assert.Nil(t, ret)
 }
+
+func TestCreateFileSystemBRUnderPressure(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cpu"), []byte("some avg10=50.00 avg60=10.00 avg300=5.00 total=1000\n"), 0644))
+
+	m := NewManager(2)
+	m.SetLoadConcurrency(throttle.NewConcurrency(throttle.NewNodePressureProbe(dir, 20, 0, logrus.New()), 2, logrus.New()))
+
+	_, err := m.CreateFileSystemBR("job-1", "test", context.TODO(), nil, "velero", Callbacks{}, nil)
+	require.NoError(t, err)
+
+	_, err = m.CreateFileSystemBR("job-2", "test", context.TODO(), nil, "velero", Callbacks{}, nil)
+	assert.Equal(t, ConcurrentLimitExceed, err)
+}
@@ -0,0 +1,165 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/restmapper"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// configMapCacheKey is the key of the binary data of the ConfigMap holding
+// the gzipped JSON of the discovery results.
+const configMapCacheKey = "discovery.json.gz"
+
+// Results are the results of a discovery of the API resources of the cluster.
+type Results struct {
+	// GroupResources are the API groups and their resources, to build the REST mapper from.
+	GroupResources []*restmapper.APIGroupResources `json:"groupResources"`
+
+	// ServerResources are the API resources, in their preferred version only
+	// unless AllGroupVersions is true.
+	ServerResources []*metav1.APIResourceList `json:"serverResources"`
+
+	// AllGroupVersions is whether ServerResources lists all the versions of the API resources.
+	AllGroupVersions bool `json:"allGroupVersions"`
+
+	APIGroups     []metav1.APIGroup `json:"apiGroups"`
+	ServerVersion *version.Info     `json:"serverVersion"`
+}
+
+// Cache persists the discovery results so they can be reused after a restart of the server.
+type Cache interface {
+	// Load returns the persisted discovery results, or nil if there are none.
+	Load() (*Results, error)
+
+	// Save persists the discovery results.
+	Save(results *Results) error
+}
+
+type configMapCache struct {
+	client    corev1client.ConfigMapsGetter
+	namespace string
+	name      string
+}
+
+// NewConfigMapCache returns a Cache persisting the discovery results to the named ConfigMap.
+func NewConfigMapCache(client corev1client.ConfigMapsGetter, namespace, name string) Cache {
+	return &configMapCache{
+		client:    client,
+		namespace: namespace,
+		name:      name,
+	}
+}
+
+func (c *configMapCache) Load() (*Results, error) {
+	cm, err := c.client.ConfigMaps(c.namespace).Get(context.TODO(), c.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting ConfigMap %s/%s", c.namespace, c.name)
+	}
+
+	data, ok := cm.BinaryData[configMapCacheKey]
+	if !ok {
+		return nil, nil
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "error decompressing the cached discovery results")
+	}
+	defer reader.Close()
+
+	decompressed, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "error decompressing the cached discovery results")
+	}
+
+	results := new(Results)
+	if err := json.Unmarshal(decompressed, results); err != nil {
+		return nil, errors.Wrap(err, "error decoding the cached discovery results")
+	}
+
+	return results, nil
+}
+
+func (c *configMapCache) Save(results *Results) error {
+	encoded, err := json.Marshal(results)
+	if err != nil {
+		return errors.Wrap(err, "error encoding the discovery results")
+	}
+
+	buf := new(bytes.Buffer)
+	writer := gzip.NewWriter(buf)
+	if _, err := writer.Write(encoded); err != nil {
+		return errors.Wrap(err, "error compressing the discovery results")
+	}
+	if err := writer.Close(); err != nil {
+		return errors.Wrap(err, "error compressing the discovery results")
+	}
+
+	configMaps := c.client.ConfigMaps(c.namespace)
+
+	cm, err := configMaps.Get(context.TODO(), c.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		cm = &corev1api.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: c.namespace,
+				Name:      c.name,
+				Labels: map[string]string{
+					velerov1api.ResourceUsageLabel: string(velerov1api.VeleroResourceUsageDiscoveryCache),
+				},
+			},
+			BinaryData: map[string][]byte{configMapCacheKey: buf.Bytes()},
+		}
+		if _, err := configMaps.Create(context.TODO(), cm, metav1.CreateOptions{}); err != nil {
+			return errors.Wrapf(err, "error creating ConfigMap %s/%s", c.namespace, c.name)
+		}
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "error getting ConfigMap %s/%s", c.namespace, c.name)
+	}
+
+	if bytes.Equal(cm.BinaryData[configMapCacheKey], buf.Bytes()) {
+		return nil
+	}
+
+	if cm.BinaryData == nil {
+		cm.BinaryData = make(map[string][]byte)
+	}
+	cm.BinaryData[configMapCacheKey] = buf.Bytes()
+	if _, err := configMaps.Update(context.TODO(), cm, metav1.UpdateOptions{}); err != nil {
+		return errors.Wrapf(err, "error updating ConfigMap %s/%s", c.namespace, c.name)
+	}
+
+	return nil
+}
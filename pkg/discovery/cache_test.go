@@ -0,0 +1,120 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/discovery/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/restmapper"
+	clientgotesting "k8s.io/client-go/testing"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func testResults() *Results {
+	pods := metav1.APIResource{
+		Name:       "pods",
+		Namespaced: true,
+		Kind:       "Pod",
+		Verbs:      []string{"create", "delete", "get", "list"},
+	}
+
+	return &Results{
+		GroupResources: []*restmapper.APIGroupResources{
+			{
+				Group: metav1.APIGroup{
+					Versions:         []metav1.GroupVersionForDiscovery{{GroupVersion: "v1", Version: "v1"}},
+					PreferredVersion: metav1.GroupVersionForDiscovery{GroupVersion: "v1", Version: "v1"},
+				},
+				VersionedResources: map[string][]metav1.APIResource{"v1": {pods}},
+			},
+		},
+		ServerResources: []*metav1.APIResourceList{
+			{GroupVersion: "v1", APIResources: []metav1.APIResource{pods}},
+		},
+		APIGroups:     []metav1.APIGroup{{Name: ""}},
+		ServerVersion: &version.Info{Major: "1", Minor: "31"},
+	}
+}
+
+func TestConfigMapCache(t *testing.T) {
+	client := kubefake.NewSimpleClientset()
+	cache := NewConfigMapCache(client.CoreV1(), "velero", "discovery-cache")
+
+	results, err := cache.Load()
+	require.NoError(t, err)
+	assert.Nil(t, results)
+
+	require.NoError(t, cache.Save(testResults()))
+
+	cm, err := client.CoreV1().ConfigMaps("velero").Get(context.TODO(), "discovery-cache", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, string(velerov1api.VeleroResourceUsageDiscoveryCache), cm.Labels[velerov1api.ResourceUsageLabel])
+
+	results, err = cache.Load()
+	require.NoError(t, err)
+	assert.Equal(t, testResults(), results)
+
+	updated := testResults()
+	updated.ServerVersion.Minor = "32"
+	require.NoError(t, cache.Save(updated))
+
+	results, err = cache.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "32", results.ServerVersion.Minor)
+}
+
+func TestNewHelperWithCache(t *testing.T) {
+	fakeDiscoveryClient := &fake.FakeDiscovery{
+		Fake: &clientgotesting.Fake{},
+	}
+	discoveryClient := &velerotest.DiscoveryClient{FakeDiscovery: fakeDiscoveryClient}
+
+	cache := NewConfigMapCache(kubefake.NewSimpleClientset().CoreV1(), "velero", "discovery-cache")
+	require.NoError(t, cache.Save(testResults()))
+
+	// the helper starts from the cached results without querying the discovery API
+	h, err := NewHelperWithCache(discoveryClient, cache, logrus.New())
+	require.NoError(t, err)
+	assert.Empty(t, fakeDiscoveryClient.Actions())
+
+	gvr, resource, err := h.ResourceFor(schema.GroupVersionResource{Resource: "pods"})
+	require.NoError(t, err)
+	assert.Equal(t, schema.GroupVersionResource{Version: "v1", Resource: "pods"}, gvr)
+	assert.Equal(t, "Pod", resource.Kind)
+	assert.Equal(t, "31", h.ServerVersion().Minor)
+
+	// a refresh replaces the cached results with the discovered ones
+	fakeDiscoveryClient.FakedServerVersion = &version.Info{Major: "1", Minor: "32"}
+	require.NoError(t, h.Refresh())
+	assert.NotEmpty(t, fakeDiscoveryClient.Actions())
+	assert.Equal(t, "32", h.ServerVersion().Minor)
+
+	results, err := cache.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "32", results.ServerVersion.Minor)
+}
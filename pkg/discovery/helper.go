@@ -76,6 +76,7 @@ type serverResourcesInterface interface {
 type helper struct {
 	discoveryClient discovery.AggregatedDiscoveryInterface
 	logger          logrus.FieldLogger
+	cache           Cache
 
 	// lock guards mapper, resources and resourcesMap
 	lock          sync.RWMutex
@@ -90,10 +91,38 @@ type helper struct {
 var _ Helper = &helper{}
 
 func NewHelper(discoveryClient discovery.AggregatedDiscoveryInterface, logger logrus.FieldLogger) (Helper, error) {
+	return NewHelperWithCache(discoveryClient, nil, logger)
+}
+
+// NewHelperWithCache returns a Helper persisting its discovery results to cache on each
+// Refresh. If cache holds the results of a previous discovery, the Helper starts from them
+// rather than querying the discovery API, it's up to the caller to Refresh it afterwards.
+func NewHelperWithCache(discoveryClient discovery.AggregatedDiscoveryInterface, cache Cache, logger logrus.FieldLogger) (Helper, error) {
 	h := &helper{
 		discoveryClient: discoveryClient,
 		logger:          logger,
+		cache:           cache,
+	}
+
+	if cache != nil {
+		results, err := cache.Load()
+		switch {
+		case err != nil:
+			logger.WithError(err).Warn("Error loading the cached discovery results, running discovery")
+		case results == nil:
+			logger.Info("No cached discovery results found, running discovery")
+		case results.AllGroupVersions != features.IsEnabled(velerov1api.APIGroupVersionsFeatureFlag):
+			logger.Infof("The cached discovery results don't match the '%s' feature flag, running discovery", velerov1api.APIGroupVersionsFeatureFlag)
+		default:
+			if err := h.apply(results); err != nil {
+				logger.WithError(err).Warn("Error applying the cached discovery results, running discovery")
+				break
+			}
+			logger.Info("Using the cached discovery results")
+			return h, nil
+		}
 	}
+
 	if err := h.Refresh(); err != nil {
 		return nil, err
 	}
@@ -143,49 +172,87 @@ func (h *helper) KindFor(input schema.GroupVersionKind) (schema.GroupVersionReso
 }
 
 func (h *helper) Refresh() error {
-	h.lock.Lock()
-	defer h.lock.Unlock()
+	// query the discovery API without holding the lock, it can take minutes on
+	// clusters with many CRDs and the current results are still usable meanwhile
+	results, err := h.discover()
+	if err != nil {
+		return err
+	}
 
+	if err := h.apply(results); err != nil {
+		return err
+	}
+
+	if h.cache != nil {
+		if err := h.cache.Save(results); err != nil {
+			h.logger.WithError(err).Warn("Error persisting the discovery results")
+		}
+	}
+
+	return nil
+}
+
+// discover queries the discovery API.
+func (h *helper) discover() (*Results, error) {
 	groupResources, err := restmapper.GetAPIGroupResources(h.discoveryClient)
 	if err != nil {
-		return errors.WithStack(err)
+		return nil, errors.WithStack(err)
 	}
 
-	var serverResources []*metav1.APIResourceList
+	results := &Results{
+		GroupResources:   groupResources,
+		AllGroupVersions: features.IsEnabled(velerov1api.APIGroupVersionsFeatureFlag),
+	}
 
-	if features.IsEnabled(velerov1api.APIGroupVersionsFeatureFlag) {
+	if results.AllGroupVersions {
 		// ServerGroupsAndResources returns all APIGroup and APIResouceList - not only preferred versions
 		_, serverAllResources, err := refreshServerGroupsAndResources(h.discoveryClient, h.logger)
 		if err != nil {
-			return errors.WithStack(err)
+			return nil, errors.WithStack(err)
 		}
 		h.logger.Infof("The '%s' feature flag was specified, using all API group versions.", velerov1api.APIGroupVersionsFeatureFlag)
-		serverResources = serverAllResources
+		results.ServerResources = serverAllResources
 	} else {
 		// ServerPreferredResources() returns only preferred APIGroup - this is the default since no feature flag has been passed
 		serverPreferredResources, err := refreshServerPreferredResources(h.discoveryClient, h.logger)
 		if err != nil {
-			return errors.WithStack(err)
+			return nil, errors.WithStack(err)
 		}
-		serverResources = serverPreferredResources
+		results.ServerResources = serverPreferredResources
+	}
+
+	apiGroupList, err := h.discoveryClient.ServerGroups()
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
+	results.APIGroups = apiGroupList.Groups
 
-	h.resources = discovery.FilteredBy(
+	serverVersion, err := h.discoveryClient.ServerVersion()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	results.ServerVersion = serverVersion
+
+	return results, nil
+}
+
+// apply replaces the current set of resources of the helper with the ones of results.
+func (h *helper) apply(results *Results) error {
+	resources := discovery.FilteredBy(
 		And(filterByVerbs, skipSubresource),
-		serverResources,
+		results.ServerResources,
 	)
 
-	sortResources(h.resources)
+	sortResources(resources)
 
-	shortcutExpander, err := kcmdutil.NewShortcutExpander(restmapper.NewDiscoveryRESTMapper(groupResources), h.resources, h.logger)
+	shortcutExpander, err := kcmdutil.NewShortcutExpander(restmapper.NewDiscoveryRESTMapper(results.GroupResources), resources, h.logger)
 	if err != nil {
 		return errors.WithStack(err)
 	}
-	h.mapper = shortcutExpander
 
-	h.resourcesMap = make(map[schema.GroupVersionResource]metav1.APIResource)
-	h.kindMap = make(map[schema.GroupVersionKind]metav1.APIResource)
-	for _, resourceGroup := range h.resources {
+	resourcesMap := make(map[schema.GroupVersionResource]metav1.APIResource)
+	kindMap := make(map[schema.GroupVersionKind]metav1.APIResource)
+	for _, resourceGroup := range resources {
 		gv, err := schema.ParseGroupVersion(resourceGroup.GroupVersion)
 		if err != nil {
 			return errors.Wrapf(err, "unable to parse GroupVersion %s", resourceGroup.GroupVersion)
@@ -196,23 +263,20 @@ func (h *helper) Refresh() error {
 			gvk := gv.WithKind(resource.Kind)
 			resource.Group = gv.Group
 			resource.Version = gv.Version
-			h.resourcesMap[gvr] = resource
-			h.kindMap[gvk] = resource
+			resourcesMap[gvr] = resource
+			kindMap[gvk] = resource
 		}
 	}
 
-	apiGroupList, err := h.discoveryClient.ServerGroups()
-	if err != nil {
-		return errors.WithStack(err)
-	}
-	h.apiGroups = apiGroupList.Groups
-
-	serverVersion, err := h.discoveryClient.ServerVersion()
-	if err != nil {
-		return errors.WithStack(err)
-	}
+	h.lock.Lock()
+	defer h.lock.Unlock()
 
-	h.serverVersion = serverVersion
+	h.resources = resources
+	h.mapper = shortcutExpander
+	h.resourcesMap = resourcesMap
+	h.kindMap = kindMap
+	h.apiGroups = results.APIGroups
+	h.serverVersion = results.ServerVersion
 
 	return nil
 }
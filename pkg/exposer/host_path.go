@@ -52,6 +52,12 @@ func GetPodVolumeHostPath(ctx context.Context, pod *corev1.Pod, volumeName strin
 		return datapath.AccessPoint{}, errors.Wrapf(err, "error getting volume mode for volume %s in pod %s", volumeName, pod.Name)
 	}
 
+	return getVolumeHostPath(pod, volumeName, volDir, volMode, fs, logger)
+}
+
+// getVolumeHostPath returns the path on the host of the volume of the pod living in the volume directory
+func getVolumeHostPath(pod *corev1.Pod, volumeName string, volDir string, volMode uploader.PersistentVolumeMode,
+	fs filesystem.Interface, logger logrus.FieldLogger) (datapath.AccessPoint, error) {
 	volSubDir := "volumes"
 	if volMode == uploader.PersistentVolumeBlock {
 		volSubDir = "volumeDevices"
@@ -0,0 +1,306 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/datapath"
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+const (
+	// podVolumePVCVolumeName is the name of the volume of the dedicated PVC in the pod mounting it
+	podVolumePVCVolumeName = "data"
+
+	// podVolumePVCTimeout is how long the pod mounting the dedicated PVC may take to run
+	podVolumePVCTimeout = 5 * time.Minute
+)
+
+// ErrPodVolumePVCNotReady is returned by GetPodVolumePath while the pod mounting the dedicated PVC isn't running yet, the
+// caller retrying later rather than waiting for it
+var ErrPodVolumePVCNotReady = errors.New("the pod mounting the dedicated PVC is not running yet")
+
+// GetPodVolumePathConfig returns the config of the pod volume paths for the volumes of the storage class and the CSI driver,
+// the configs of the storage classes taking precedence over those of the drivers. It returns nil if none applies.
+func GetPodVolumePathConfig(configs []nodeagent.PodVolumePath, storageClass string, driver string) *nodeagent.PodVolumePath {
+	var driverConfig *nodeagent.PodVolumePath
+	for i := range configs {
+		if storageClass != "" && configs[i].StorageClass == storageClass {
+			return &configs[i]
+		}
+		if driverConfig == nil && driver != "" && configs[i].StorageClass == "" && configs[i].Driver == driver {
+			driverConfig = &configs[i]
+		}
+	}
+	return driverConfig
+}
+
+// GetPodVolumePath returns a path that can be accessed from the host for a given volume of a pod. It's the host path of the pod
+// volume, unless the storage class or the CSI driver of the PV of the volume is configured to be accessed through a dedicated
+// PVC. Then a copy of the PV is bound to a PVC named after the owner, mounted by a pod on the node of the pod, and the path is
+// the host path of the volume of that pod, which CleanUpPodVolumePVC deletes along with the PVC and the copy of the PV.
+// It returns ErrPodVolumePVCNotReady until the pod runs.
+func GetPodVolumePath(ctx context.Context, pod *corev1.Pod, volumeName string, owner corev1.ObjectReference, configs []nodeagent.PodVolumePath,
+	cli ctrlclient.Client, kubeClient kubernetes.Interface, fs filesystem.Interface, log logrus.FieldLogger) (datapath.AccessPoint, error) {
+	if len(configs) == 0 {
+		return GetPodVolumeHostPath(ctx, pod, volumeName, cli, fs, log)
+	}
+
+	_, pv, _, err := kube.GetPodPVCVolume(ctx, log, pod, volumeName, cli)
+	if err != nil {
+		if err == kube.ErrorPodVolumeIsNotPVC {
+			return GetPodVolumeHostPath(ctx, pod, volumeName, cli, fs, log)
+		}
+		return datapath.AccessPoint{}, errors.Wrapf(err, "error getting PV of volume %s in pod %s", volumeName, pod.Name)
+	}
+
+	var driver string
+	if pv.Spec.CSI != nil {
+		driver = pv.Spec.CSI.Driver
+	}
+	config := GetPodVolumePathConfig(configs, pv.Spec.StorageClassName, driver)
+	if config == nil || config.Access != nodeagent.PodVolumeAccessMountedPVC {
+		return GetPodVolumeHostPath(ctx, pod, volumeName, cli, fs, log)
+	}
+
+	logger := log.WithField("pod name", pod.Name).WithField("pod UID", pod.GetUID()).WithField("volume", volumeName)
+	logger.WithField("pv", pv.Name).Infof("Accessing volume through dedicated PVC %s/%s", owner.Namespace, owner.Name)
+
+	// the copy of the PV lives in the same directory as the PV, under its own name
+	volDir, err := getVolumeDirectory(ctx, logger, pod, volumeName, cli)
+	if err != nil {
+		return datapath.AccessPoint{}, errors.Wrapf(err, "error getting volume directory name for volume %s in pod %s", volumeName, pod.Name)
+	}
+	volMode, err := getVolumeMode(ctx, logger, pod, volumeName, cli)
+	if err != nil {
+		return datapath.AccessPoint{}, errors.Wrapf(err, "error getting volume mode for volume %s in pod %s", volumeName, pod.Name)
+	}
+
+	mountPod, err := mountPodVolumePVC(ctx, pod, pv, owner, config.MountOptions, kubeClient)
+	if err == ErrPodVolumePVCNotReady {
+		return datapath.AccessPoint{}, err
+	}
+	if err != nil {
+		return datapath.AccessPoint{}, errors.Wrapf(err, "error mounting a dedicated PVC for volume %s in pod %s", volumeName, pod.Name)
+	}
+
+	return getVolumeHostPath(mountPod, volumeName, owner.Name+strings.TrimPrefix(volDir, pv.Name), volMode, fs, logger)
+}
+
+// mountPodVolumePVC creates the copy of the PV, the dedicated PVC bound to it and the pod mounting the PVC on the node of
+// the pod, if they don't exist yet, and returns the pod if it runs. Otherwise it returns ErrPodVolumePVCNotReady, or an
+// error once the pod hasn't run for podVolumePVCTimeout.
+func mountPodVolumePVC(ctx context.Context, pod *corev1.Pod, pv *corev1.PersistentVolume, owner corev1.ObjectReference,
+	mountOptions []string, kubeClient kubernetes.Interface) (*corev1.Pod, error) {
+	ownerReferences := []metav1.OwnerReference{{
+		APIVersion: owner.APIVersion,
+		Kind:       owner.Kind,
+		Name:       owner.Name,
+		UID:        owner.UID,
+		Controller: boolptr.True(),
+	}}
+
+	// the copy is retained when deleted, the volume being the one of the pod
+	pvCopy := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        owner.Name,
+			Annotations: map[string]string{},
+		},
+		Spec: *pv.Spec.DeepCopy(),
+	}
+	for _, key := range []string{kube.KubeAnnDynamicallyProvisioned, kube.KubeAnnMigratedTo} {
+		if value, found := pv.Annotations[key]; found {
+			pvCopy.Annotations[key] = value
+		}
+	}
+	pvCopy.Spec.PersistentVolumeReclaimPolicy = corev1.PersistentVolumeReclaimRetain
+	pvCopy.Spec.ClaimRef = &corev1.ObjectReference{
+		Kind:      "PersistentVolumeClaim",
+		Namespace: owner.Namespace,
+		Name:      owner.Name,
+	}
+	if len(mountOptions) > 0 {
+		pvCopy.Spec.MountOptions = mountOptions
+	}
+	if _, err := kubeClient.CoreV1().PersistentVolumes().Create(ctx, pvCopy, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return nil, errors.Wrap(err, "error creating the copy of the PV")
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            owner.Name,
+			Namespace:       owner.Namespace,
+			OwnerReferences: ownerReferences,
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      pv.Spec.AccessModes,
+			StorageClassName: &pv.Spec.StorageClassName,
+			VolumeMode:       pv.Spec.VolumeMode,
+			VolumeName:       pvCopy.Name,
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: pv.Spec.Capacity[corev1.ResourceStorage],
+				},
+			},
+		},
+	}
+	if _, err := kubeClient.CoreV1().PersistentVolumeClaims(owner.Namespace).Create(ctx, pvc, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return nil, errors.Wrap(err, "error creating the dedicated PVC")
+	}
+
+	podInfo, err := getInheritedPodInfo(ctx, kubeClient, owner.Namespace, kube.NodeOSLinux)
+	if err != nil {
+		return nil, errors.Wrap(err, "error to get inherited pod info from node-agent")
+	}
+
+	var gracePeriod int64
+	volumeMounts, volumeDevices, _ := kube.MakePodPVCAttachment(podVolumePVCVolumeName, pv.Spec.VolumeMode, false)
+	mountPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            owner.Name,
+			Namespace:       owner.Namespace,
+			OwnerReferences: ownerReferences,
+		},
+		Spec: corev1.PodSpec{
+			NodeName: pod.Spec.NodeName,
+			Containers: []corev1.Container{
+				{
+					Name:            string(owner.UID),
+					Image:           podInfo.image,
+					ImagePullPolicy: corev1.PullNever,
+					Command:         []string{"/velero-helper", "pause"},
+					VolumeMounts:    volumeMounts,
+					VolumeDevices:   volumeDevices,
+				},
+			},
+			ServiceAccountName:            podInfo.serviceAccount,
+			TerminationGracePeriodSeconds: &gracePeriod,
+			Volumes: []corev1.Volume{{
+				Name: podVolumePVCVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: pvc.Name,
+					},
+				},
+			}},
+			RestartPolicy: corev1.RestartPolicyNever,
+		},
+	}
+	if _, err := kubeClient.CoreV1().Pods(owner.Namespace).Create(ctx, mountPod, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return nil, errors.Wrap(err, "error creating the pod mounting the dedicated PVC")
+	}
+
+	created, err := kubeClient.CoreV1().Pods(owner.Namespace).Get(ctx, mountPod.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting pod %s", mountPod.Name)
+	}
+	if kube.IsPodRunning(created) != nil {
+		if !created.CreationTimestamp.IsZero() && time.Since(created.CreationTimestamp.Time) > podVolumePVCTimeout {
+			return nil, errors.Errorf("the pod mounting the dedicated PVC didn't run in %v", podVolumePVCTimeout)
+		}
+		return nil, ErrPodVolumePVCNotReady
+	}
+
+	return created, nil
+}
+
+// CleanUpPodVolumePVC deletes the pod, the dedicated PVC and the copy of the PV created for the owner by GetPodVolumePath,
+// if any. The volume of the copy of the PV is retained.
+func CleanUpPodVolumePVC(ctx context.Context, kubeClient kubernetes.Interface, namespace string, name string, log logrus.FieldLogger) {
+	kube.DeletePodIfAny(ctx, kubeClient.CoreV1(), name, namespace, log)
+
+	if err := kubeClient.CoreV1().PersistentVolumeClaims(namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		log.WithError(err).Errorf("Failed to delete PVC %s/%s", namespace, name)
+	}
+
+	pv, err := kubeClient.CoreV1().PersistentVolumes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			log.WithError(err).Errorf("Failed to get PV %s", name)
+		}
+		return
+	}
+	// never delete a PV which isn't a copy retaining its volume
+	if pv.Spec.PersistentVolumeReclaimPolicy != corev1.PersistentVolumeReclaimRetain {
+		log.Warnf("Not deleting PV %s, whose volume isn't retained", name)
+		return
+	}
+	kube.DeletePVIfAny(ctx, kubeClient.CoreV1(), name, log)
+}
+
+// CleanUpOrphanPodVolumePVCs deletes the dedicated PVCs mounted on the node for the PodVolumeBackups and PodVolumeRestores
+// which don't exist or aren't new anymore, like those whose data paths were lost with a restart of the node-agent. The
+// new ones keep theirs, GetPodVolumePath reusing them.
+func CleanUpOrphanPodVolumePVCs(ctx context.Context, cli ctrlclient.Client, kubeClient kubernetes.Interface, namespace string, nodeName string,
+	log logrus.FieldLogger) {
+	pods, err := kubeClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.WithError(err).Error("Failed to list the pods mounting dedicated PVCs")
+		return
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Spec.NodeName != nodeName {
+			continue
+		}
+		owner := metav1.GetControllerOf(&pod)
+		if owner == nil || owner.APIVersion != velerov1api.SchemeGroupVersion.String() || owner.Name != pod.Name {
+			continue
+		}
+
+		var isNew bool
+		switch owner.Kind {
+		case "PodVolumeBackup":
+			pvb := new(velerov1api.PodVolumeBackup)
+			if err = cli.Get(ctx, ctrlclient.ObjectKey{Namespace: namespace, Name: owner.Name}, pvb); err == nil {
+				isNew = pvb.Status.Phase == "" || pvb.Status.Phase == velerov1api.PodVolumeBackupPhaseNew
+			}
+		case "PodVolumeRestore":
+			pvr := new(velerov1api.PodVolumeRestore)
+			if err = cli.Get(ctx, ctrlclient.ObjectKey{Namespace: namespace, Name: owner.Name}, pvr); err == nil {
+				isNew = pvr.Status.Phase == "" || pvr.Status.Phase == velerov1api.PodVolumeRestorePhaseNew
+			}
+		default:
+			continue
+		}
+		if err != nil && !apierrors.IsNotFound(err) {
+			log.WithError(err).Errorf("Failed to get the %s owning pod %s", owner.Kind, pod.Name)
+			continue
+		}
+		if isNew {
+			continue
+		}
+
+		log.Infof("Cleaning up the dedicated PVC of %s %s", owner.Kind, owner.Name)
+		CleanUpPodVolumePVC(ctx, kubeClient, namespace, pod.Name, log)
+	}
+}
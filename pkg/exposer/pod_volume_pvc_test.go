@@ -0,0 +1,223 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+func TestGetPodVolumePathConfig(t *testing.T) {
+	configs := []nodeagent.PodVolumePath{
+		{Driver: "fake-driver-1", Access: nodeagent.PodVolumeAccessMountedPVC},
+		{StorageClass: "fake-sc-1", Access: nodeagent.PodVolumeAccessHostPath},
+		{Driver: "fake-driver-2", StorageClass: "fake-sc-2", Access: nodeagent.PodVolumeAccessMountedPVC, MountOptions: []string{"nouuid"}},
+	}
+
+	tests := []struct {
+		name         string
+		storageClass string
+		driver       string
+		expected     *nodeagent.PodVolumePath
+	}{
+		{
+			name:     "config of the driver",
+			driver:   "fake-driver-1",
+			expected: &configs[0],
+		},
+		{
+			name:         "config of the storage class takes precedence",
+			storageClass: "fake-sc-1",
+			driver:       "fake-driver-1",
+			expected:     &configs[1],
+		},
+		{
+			name:   "configs of storage classes don't apply to the other storage classes of their driver",
+			driver: "fake-driver-2",
+		},
+		{
+			name:         "no config",
+			storageClass: "fake-sc-3",
+			driver:       "fake-driver-3",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, GetPodVolumePathConfig(configs, test.storageClass, test.driver))
+		})
+	}
+}
+
+func TestCleanUpPodVolumePVC(t *testing.T) {
+	retained := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "fake-pvb-1"},
+		Spec:       corev1.PersistentVolumeSpec{PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain},
+	}
+	deleted := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "fake-pvb-2"},
+		Spec:       corev1.PersistentVolumeSpec{PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimDelete},
+	}
+	kubeClient := fake.NewSimpleClientset(
+		builder.ForPod(velerov1api.DefaultNamespace, "fake-pvb-1").Result(),
+		builder.ForPersistentVolumeClaim(velerov1api.DefaultNamespace, "fake-pvb-1").Result(),
+		retained,
+		deleted,
+	)
+	ctx := context.Background()
+
+	CleanUpPodVolumePVC(ctx, kubeClient, velerov1api.DefaultNamespace, "fake-pvb-1", velerotest.NewLogger())
+
+	_, err := kubeClient.CoreV1().Pods(velerov1api.DefaultNamespace).Get(ctx, "fake-pvb-1", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+	_, err = kubeClient.CoreV1().PersistentVolumeClaims(velerov1api.DefaultNamespace).Get(ctx, "fake-pvb-1", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+	_, err = kubeClient.CoreV1().PersistentVolumes().Get(ctx, "fake-pvb-1", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	// a PV not retaining its volume isn't a copy, and is never deleted
+	CleanUpPodVolumePVC(ctx, kubeClient, velerov1api.DefaultNamespace, "fake-pvb-2", velerotest.NewLogger())
+	_, err = kubeClient.CoreV1().PersistentVolumes().Get(ctx, "fake-pvb-2", metav1.GetOptions{})
+	require.NoError(t, err)
+}
+
+func TestMountPodVolumePVC(t *testing.T) {
+	daemonSet := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "node-agent"},
+		Spec: appsv1.DaemonSetSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Image: "fake-image"}},
+				},
+			},
+		},
+	}
+	pod := builder.ForPod("fake-ns", "fake-pod").NodeName("fake-node").Result()
+	pv := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "fake-pv",
+			Annotations: map[string]string{kube.KubeAnnDynamicallyProvisioned: "fake-driver", "other": "value"},
+		},
+		Spec: corev1.PersistentVolumeSpec{
+			Capacity:                      corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimDelete,
+			StorageClassName:              "fake-sc",
+			ClaimRef:                      &corev1.ObjectReference{Namespace: "fake-ns", Name: "fake-pvc"},
+		},
+	}
+	owner := corev1.ObjectReference{
+		APIVersion: velerov1api.SchemeGroupVersion.String(),
+		Kind:       "PodVolumeBackup",
+		Namespace:  velerov1api.DefaultNamespace,
+		Name:       "fake-pvb",
+		UID:        "fake-uid",
+	}
+	kubeClient := fake.NewSimpleClientset(daemonSet)
+	ctx := context.Background()
+
+	// the pod mounting the PVC doesn't run yet
+	_, err := mountPodVolumePVC(ctx, pod, pv, owner, []string{"nouuid"}, kubeClient)
+	require.Equal(t, ErrPodVolumePVCNotReady, err)
+
+	pvCopy, err := kubeClient.CoreV1().PersistentVolumes().Get(ctx, "fake-pvb", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{kube.KubeAnnDynamicallyProvisioned: "fake-driver"}, pvCopy.Annotations)
+	assert.Equal(t, corev1.PersistentVolumeReclaimRetain, pvCopy.Spec.PersistentVolumeReclaimPolicy)
+	assert.Equal(t, &corev1.ObjectReference{Kind: "PersistentVolumeClaim", Namespace: velerov1api.DefaultNamespace, Name: "fake-pvb"}, pvCopy.Spec.ClaimRef)
+	assert.Equal(t, []string{"nouuid"}, pvCopy.Spec.MountOptions)
+
+	pvc, err := kubeClient.CoreV1().PersistentVolumeClaims(velerov1api.DefaultNamespace).Get(ctx, "fake-pvb", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "fake-pvb", pvc.Spec.VolumeName)
+	assert.Equal(t, "fake-uid", string(pvc.OwnerReferences[0].UID))
+
+	mountPod, err := kubeClient.CoreV1().Pods(velerov1api.DefaultNamespace).Get(ctx, "fake-pvb", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "fake-node", mountPod.Spec.NodeName)
+	assert.Equal(t, "fake-image", mountPod.Spec.Containers[0].Image)
+	assert.Equal(t, "fake-pvb", mountPod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+
+	// the existing resources are reused once the pod runs
+	mountPod.Status.Phase = corev1.PodRunning
+	_, err = kubeClient.CoreV1().Pods(velerov1api.DefaultNamespace).UpdateStatus(ctx, mountPod, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	running, err := mountPodVolumePVC(ctx, pod, pv, owner, nil, kubeClient)
+	require.NoError(t, err)
+	assert.Equal(t, "fake-pvb", running.Name)
+
+	// the pod didn't run in time
+	mountPod.Status.Phase = corev1.PodPending
+	mountPod.CreationTimestamp = metav1.NewTime(time.Now().Add(-podVolumePVCTimeout - time.Minute))
+	_, err = kubeClient.CoreV1().Pods(velerov1api.DefaultNamespace).Update(ctx, mountPod, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	_, err = mountPodVolumePVC(ctx, pod, pv, owner, nil, kubeClient)
+	require.EqualError(t, err, "the pod mounting the dedicated PVC didn't run in 5m0s")
+}
+
+func TestCleanUpOrphanPodVolumePVCs(t *testing.T) {
+	mountPod := func(kind string, name string, node string) *corev1.Pod {
+		return builder.ForPod(velerov1api.DefaultNamespace, name).NodeName(node).
+			ObjectMeta(builder.WithOwnerReference([]metav1.OwnerReference{{
+				APIVersion: velerov1api.SchemeGroupVersion.String(),
+				Kind:       kind,
+				Name:       name,
+				Controller: boolptr.True(),
+			}})).Result()
+	}
+	kubeClient := fake.NewSimpleClientset(
+		mountPod("PodVolumeBackup", "new-pvb", "fake-node"),
+		mountPod("PodVolumeBackup", "failed-pvb", "fake-node"),
+		mountPod("PodVolumeBackup", "deleted-pvb", "fake-node"),
+		mountPod("PodVolumeRestore", "in-progress-pvr", "fake-node"),
+		mountPod("PodVolumeBackup", "other-node-pvb", "other-node"),
+		builder.ForPod(velerov1api.DefaultNamespace, "velero").NodeName("fake-node").Result(),
+	)
+	cli := velerotest.NewFakeControllerRuntimeClient(t,
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "new-pvb").Phase(velerov1api.PodVolumeBackupPhaseNew).Result(),
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "failed-pvb").Phase(velerov1api.PodVolumeBackupPhaseFailed).Result(),
+		builder.ForPodVolumeRestore(velerov1api.DefaultNamespace, "in-progress-pvr").Phase(velerov1api.PodVolumeRestorePhaseInProgress).Result(),
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "other-node-pvb").Phase(velerov1api.PodVolumeBackupPhaseFailed).Result(),
+	)
+	ctx := context.Background()
+
+	CleanUpOrphanPodVolumePVCs(ctx, cli, kubeClient, velerov1api.DefaultNamespace, "fake-node", velerotest.NewLogger())
+
+	pods, err := kubeClient.CoreV1().Pods(velerov1api.DefaultNamespace).List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	var names []string
+	for _, pod := range pods.Items {
+		names = append(names, pod.Name)
+	}
+	assert.ElementsMatch(t, []string{"new-pvb", "other-node-pvb", "velero"}, names)
+}
@@ -0,0 +1,97 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
+)
+
+const snapshotHandlePlaceholder = "{snapshotHandle}"
+
+// SnapshotBlockReader exposes the data of a CSI snapshot to the current node as a block device,
+// so that the data could be moved without provisioning an intermediate PVC and pod
+type SnapshotBlockReader interface {
+	// Open makes the snapshot with the given handle readable and returns the path of the block device,
+	// the returned release func must be called once the data path finishes with the device
+	Open(ctx context.Context, snapshotHandle string) (string, func(), error)
+}
+
+type devicePathSnapshotReader struct {
+	template string
+	stat     func(string) (os.FileInfo, error)
+}
+
+// NewDevicePathSnapshotReader creates a SnapshotBlockReader for drivers which make their snapshots
+// available on the nodes as block devices under a predictable path
+func NewDevicePathSnapshotReader(template string) (SnapshotBlockReader, error) {
+	if !strings.Contains(template, snapshotHandlePlaceholder) {
+		return nil, errors.Errorf("device path template %s doesn't contain %s", template, snapshotHandlePlaceholder)
+	}
+
+	return &devicePathSnapshotReader{
+		template: template,
+		stat:     os.Stat,
+	}, nil
+}
+
+func (r *devicePathSnapshotReader) Open(ctx context.Context, snapshotHandle string) (string, func(), error) {
+	if snapshotHandle == "" || strings.Contains(snapshotHandle, "..") {
+		return "", nil, errors.Errorf("invalid snapshot handle %q", snapshotHandle)
+	}
+
+	devicePath := strings.ReplaceAll(r.template, snapshotHandlePlaceholder, snapshotHandle)
+
+	info, err := r.stat(devicePath)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "error to stat device %s", devicePath)
+	}
+
+	if info.Mode()&os.ModeDevice == 0 {
+		return "", nil, errors.Errorf("%s is not a block device", devicePath)
+	}
+
+	return devicePath, func() {}, nil
+}
+
+// NewSnapshotBlockReaders creates the SnapshotBlockReaders from node-agent configs, keyed by CSI driver name
+func NewSnapshotBlockReaders(configs []nodeagent.DirectSnapshotRead) (map[string]SnapshotBlockReader, error) {
+	readers := map[string]SnapshotBlockReader{}
+	for _, config := range configs {
+		if config.Driver == "" {
+			return nil, errors.New("driver is not specified for direct snapshot read")
+		}
+
+		if _, found := readers[config.Driver]; found {
+			return nil, errors.Errorf("duplicate direct snapshot read config for driver %s", config.Driver)
+		}
+
+		reader, err := NewDevicePathSnapshotReader(config.DevicePath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error to create snapshot reader for driver %s", config.Driver)
+		}
+
+		readers[config.Driver] = reader
+	}
+
+	return readers, nil
+}
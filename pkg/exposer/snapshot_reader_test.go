@@ -0,0 +1,164 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
+)
+
+type fakeFileInfo struct {
+	mode os.FileMode
+}
+
+func (f fakeFileInfo) Name() string       { return "fake" }
+func (f fakeFileInfo) Size() int64        { return 0 }
+func (f fakeFileInfo) Mode() os.FileMode  { return f.mode }
+func (f fakeFileInfo) ModTime() time.Time { return time.Time{} }
+func (f fakeFileInfo) IsDir() bool        { return false }
+func (f fakeFileInfo) Sys() any           { return nil }
+
+func TestDevicePathSnapshotReaderOpen(t *testing.T) {
+	tests := []struct {
+		name         string
+		handle       string
+		statFunc     func(string) (os.FileInfo, error)
+		expectedPath string
+		err          string
+	}{
+		{
+			name:   "empty handle",
+			handle: "",
+			err:    `invalid snapshot handle ""`,
+		},
+		{
+			name:   "handle escapes the template",
+			handle: "../sda",
+			err:    `invalid snapshot handle "../sda"`,
+		},
+		{
+			name:   "device doesn't exist",
+			handle: "snap-1",
+			statFunc: func(string) (os.FileInfo, error) {
+				return nil, errors.New("fake-stat-error")
+			},
+			err: "error to stat device /dev/fake-vg/snap-1: fake-stat-error",
+		},
+		{
+			name:   "not a device",
+			handle: "snap-1",
+			statFunc: func(string) (os.FileInfo, error) {
+				return fakeFileInfo{}, nil
+			},
+			err: "/dev/fake-vg/snap-1 is not a block device",
+		},
+		{
+			name:   "succeed",
+			handle: "snap-1",
+			statFunc: func(path string) (os.FileInfo, error) {
+				return fakeFileInfo{mode: os.ModeDevice}, nil
+			},
+			expectedPath: "/dev/fake-vg/snap-1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reader, err := NewDevicePathSnapshotReader("/dev/fake-vg/{snapshotHandle}")
+			require.NoError(t, err)
+
+			reader.(*devicePathSnapshotReader).stat = test.statFunc
+
+			path, release, err := reader.Open(context.Background(), test.handle)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedPath, path)
+			release()
+		})
+	}
+}
+
+func TestNewSnapshotBlockReaders(t *testing.T) {
+	tests := []struct {
+		name            string
+		configs         []nodeagent.DirectSnapshotRead
+		expectedDrivers []string
+		err             string
+	}{
+		{
+			name: "no config",
+		},
+		{
+			name: "missing driver",
+			configs: []nodeagent.DirectSnapshotRead{
+				{DevicePath: "/dev/{snapshotHandle}"},
+			},
+			err: "driver is not specified for direct snapshot read",
+		},
+		{
+			name: "invalid template",
+			configs: []nodeagent.DirectSnapshotRead{
+				{Driver: "fake-driver", DevicePath: "/dev/fake"},
+			},
+			err: "error to create snapshot reader for driver fake-driver: device path template /dev/fake doesn't contain {snapshotHandle}",
+		},
+		{
+			name: "duplicate driver",
+			configs: []nodeagent.DirectSnapshotRead{
+				{Driver: "fake-driver", DevicePath: "/dev/{snapshotHandle}"},
+				{Driver: "fake-driver", DevicePath: "/dev/mapper/{snapshotHandle}"},
+			},
+			err: "duplicate direct snapshot read config for driver fake-driver",
+		},
+		{
+			name: "succeed",
+			configs: []nodeagent.DirectSnapshotRead{
+				{Driver: "fake-driver-1", DevicePath: "/dev/{snapshotHandle}"},
+				{Driver: "fake-driver-2", DevicePath: "/dev/mapper/{snapshotHandle}"},
+			},
+			expectedDrivers: []string{"fake-driver-1", "fake-driver-2"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			readers, err := NewSnapshotBlockReaders(test.configs)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, readers, len(test.expectedDrivers))
+			for _, driver := range test.expectedDrivers {
+				assert.Contains(t, readers, driver)
+			}
+		})
+	}
+}
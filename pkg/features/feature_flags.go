@@ -18,6 +18,7 @@ package features
 
 import (
 	"strings"
+	"sync"
 
 	"k8s.io/apimachinery/pkg/util/sets"
 )
@@ -26,19 +27,27 @@ type featureFlagSet struct {
 	set sets.Set[string]
 }
 
+// lock guards featureFlags, which the server changes at runtime.
+var lock sync.RWMutex
+
 // featureFlags will store all the flags for this process until NewFeatureFlagSet is called.
 var featureFlags featureFlagSet
 
 // IsEnabled returns True if a specified flag is enabled.
 func IsEnabled(name string) bool {
+	lock.RLock()
+	defer lock.RUnlock()
 	return featureFlags.set.Has(name)
 }
 
 // Enable adds a given slice of feature names to the current feature list.
 func Enable(names ...string) {
+	lock.Lock()
+	defer lock.Unlock()
+
 	// Initialize the flag set so that users don't have to
 	if featureFlags.set == nil {
-		NewFeatureFlagSet()
+		featureFlags = featureFlagSet{set: sets.New[string]()}
 	}
 
 	featureFlags.set.Insert(names...)
@@ -46,11 +55,15 @@ func Enable(names ...string) {
 
 // Disable removes all feature flags in a given slice from the current feature list.
 func Disable(names ...string) {
+	lock.Lock()
+	defer lock.Unlock()
 	featureFlags.set.Delete(names...)
 }
 
 // All returns enabled features as a slice of strings.
 func All() []string {
+	lock.RLock()
+	defer lock.RUnlock()
 	return sets.List[string](featureFlags.set)
 }
 
@@ -63,6 +76,8 @@ func Serialize() string {
 // This must be called to properly initialize the set for tracking flags.
 // It is also useful for selectively controlling flags during tests.
 func NewFeatureFlagSet(flags ...string) {
+	lock.Lock()
+	defer lock.Unlock()
 	featureFlags = featureFlagSet{
 		set: sets.New[string](flags...),
 	}
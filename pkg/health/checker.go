@@ -0,0 +1,169 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package health tracks the health of the components of the Velero server, so that the server
+// isn't reported ready while its controllers can't make progress.
+package health
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/utils/clock"
+)
+
+// ComponentStatus is the health of one component of the server.
+type ComponentStatus struct {
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+	Message string `json:"message,omitempty"`
+	// LastHeartbeat is the last time a component reporting heartbeats reported one.
+	LastHeartbeat *time.Time `json:"lastHeartbeat,omitempty"`
+}
+
+// Report is the health of the server returned by the detailed health endpoint.
+type Report struct {
+	Healthy    bool              `json:"healthy"`
+	Components []ComponentStatus `json:"components"`
+}
+
+type component struct {
+	// check returns an error when the component is unhealthy.
+	check func() error
+	// timeout is the longest a component reporting heartbeats can go without one.
+	timeout       time.Duration
+	lastHeartbeat time.Time
+}
+
+// Checker tracks the health of the components of the server. A component is either checked when
+// the health is requested, or reports heartbeats and is unhealthy once it stops reporting them.
+type Checker struct {
+	clock      clock.PassiveClock
+	mu         sync.RWMutex
+	components map[string]*component
+}
+
+// NewChecker returns a Checker with no components.
+func NewChecker(clock clock.PassiveClock) *Checker {
+	return &Checker{
+		clock:      clock,
+		components: map[string]*component{},
+	}
+}
+
+// AddCheck adds a component whose health is checked by the function when the health is requested.
+func (c *Checker) AddCheck(name string, check func() error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.components[name] = &component{check: check}
+}
+
+// AddHeartbeat adds a component which is healthy as long as the returned function is called at
+// least once per timeout. The component is unhealthy until the first heartbeat.
+func (c *Checker) AddHeartbeat(name string, timeout time.Duration) func() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	comp := &component{timeout: timeout}
+	c.components[name] = comp
+	return func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		comp.lastHeartbeat = c.clock.Now()
+	}
+}
+
+// Report returns the health of all the components, sorted by name.
+func (c *Checker) Report() Report {
+	c.mu.RLock()
+	names := make([]string, 0, len(c.components))
+	components := make(map[string]component, len(c.components))
+	for name, comp := range c.components {
+		names = append(names, name)
+		components[name] = *comp
+	}
+	c.mu.RUnlock()
+	sort.Strings(names)
+
+	report := Report{Healthy: true}
+	now := c.clock.Now()
+	for _, name := range names {
+		comp := components[name]
+		status := ComponentStatus{Name: name, Healthy: true}
+		switch {
+		case comp.check != nil:
+			if err := comp.check(); err != nil {
+				status.Healthy = false
+				status.Message = err.Error()
+			}
+		case comp.lastHeartbeat.IsZero():
+			status.Healthy = false
+			status.Message = "no heartbeat yet"
+		default:
+			lastHeartbeat := comp.lastHeartbeat
+			status.LastHeartbeat = &lastHeartbeat
+			if since := now.Sub(lastHeartbeat); since > comp.timeout {
+				status.Healthy = false
+				status.Message = fmt.Sprintf("no heartbeat for %s", since.Round(time.Second))
+			}
+		}
+		report.Healthy = report.Healthy && status.Healthy
+		report.Components = append(report.Components, status)
+	}
+	return report
+}
+
+// Check returns an error naming the unhealthy components, if any.
+func (c *Checker) Check() error {
+	report := c.Report()
+	if report.Healthy {
+		return nil
+	}
+	var unhealthy []string
+	for _, status := range report.Components {
+		if !status.Healthy {
+			unhealthy = append(unhealthy, fmt.Sprintf("%s: %s", status.Name, status.Message))
+		}
+	}
+	return errors.Errorf("unhealthy components: %v", unhealthy)
+}
+
+// ReadyzHandler serves the readiness of the server, which is ready when all its components are healthy.
+func (c *Checker) ReadyzHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := c.Check(); err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, "ok")
+	})
+}
+
+// DetailedHandler serves the health of every component as JSON.
+func (c *Checker) DetailedHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := c.Report()
+		w.Header().Set("Content-Type", "application/json")
+		if !report.Healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(report)
+	})
+}
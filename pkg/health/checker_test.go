@@ -0,0 +1,90 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	testclocks "k8s.io/utils/clock/testing"
+)
+
+func TestChecker(t *testing.T) {
+	clock := testclocks.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	checker := NewChecker(clock)
+
+	var checkErr error
+	checker.AddCheck("informer-sync", func() error { return checkErr })
+	heartbeat := checker.AddHeartbeat("backup-storage-location", 30*time.Second)
+
+	// no heartbeat yet
+	report := checker.Report()
+	assert.False(t, report.Healthy)
+	require.Len(t, report.Components, 2)
+	assert.Equal(t, ComponentStatus{Name: "backup-storage-location", Message: "no heartbeat yet"}, report.Components[0])
+	assert.Equal(t, ComponentStatus{Name: "informer-sync", Healthy: true}, report.Components[1])
+
+	heartbeat()
+	require.NoError(t, checker.Check())
+
+	// the heartbeat is too old
+	clock.Step(time.Minute)
+	report = checker.Report()
+	assert.False(t, report.Healthy)
+	assert.Equal(t, "no heartbeat for 1m0s", report.Components[0].Message)
+
+	heartbeat()
+	checkErr = errors.New("the informer caches are not synced")
+	assert.EqualError(t, checker.Check(), "unhealthy components: [informer-sync: the informer caches are not synced]")
+}
+
+func TestHandlers(t *testing.T) {
+	checker := NewChecker(testclocks.NewFakeClock(time.Now()))
+	healthy := true
+	checker.AddCheck("plugin-registry", func() error {
+		if !healthy {
+			return errors.New("no plugins are registered")
+		}
+		return nil
+	})
+
+	rec := httptest.NewRecorder()
+	checker.ReadyzHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	healthy = false
+	rec = httptest.NewRecorder()
+	checker.ReadyzHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Contains(t, rec.Body.String(), "plugin-registry: no plugins are registered")
+
+	rec = httptest.NewRecorder()
+	checker.DetailedHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz/detailed", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	report := Report{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	assert.Equal(t, Report{
+		Components: []ComponentStatus{{Name: "plugin-registry", Message: "no plugins are registered"}},
+	}, report)
+}
@@ -24,6 +24,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/vmware-tanzu/velero/internal/velero"
 	"github.com/vmware-tanzu/velero/pkg/builder"
@@ -376,6 +377,16 @@ func Deployment(namespace string, opts ...podTemplateOption) *appsv1.Deployment
 								},
 							},
 							Resources: c.resources,
+							ReadinessProbe: &corev1.Probe{
+								ProbeHandler: corev1.ProbeHandler{
+									HTTPGet: &corev1.HTTPGetAction{
+										Path: "/readyz",
+										Port: intstr.FromString("metrics"),
+									},
+								},
+								PeriodSeconds:    10,
+								FailureThreshold: 3,
+							},
 						},
 					},
 					Volumes: []corev1.Volume{
@@ -31,6 +31,7 @@ import (
 	plugincommon "github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/util/actionhelpers"
+	"github.com/vmware-tanzu/velero/pkg/util/csi"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
@@ -105,6 +106,27 @@ func (a *PVCAction) GetRelatedItems(item runtime.Unstructured, backup *v1.Backup
 		}
 	}
 
+	// Adds the other PVCs of the volume group of this PVC the backup includes, so the PVCs
+	// snapshotted together by a VolumeGroupSnapshot are backed up one after another and the
+	// group is only snapshotted once.
+	if labelKey := csi.GetVolumeGroupSnapshotLabelKey(backup); pvc.Labels[labelKey] != "" {
+		members, err := csi.GetVolumeGroupMembers(context.Background(), a.crClient, backup, pvc.Namespace, labelKey, pvc.Labels[labelKey])
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			if member.Name == pvc.Name {
+				continue
+			}
+			a.log.Infof("Adding related PVC %s of volume group %s to PVC %s", member.Name, pvc.Labels[labelKey], pvc.Name)
+			relatedItems = append(relatedItems, velero.ResourceIdentifier{
+				GroupResource: kuberesource.PersistentVolumeClaims,
+				Namespace:     member.Namespace,
+				Name:          member.Name,
+			})
+		}
+	}
+
 	return relatedItems, nil
 }
 
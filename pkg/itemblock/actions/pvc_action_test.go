@@ -41,6 +41,7 @@ func TestBackupPVAction(t *testing.T) {
 		name            string
 		pvc             *corev1api.PersistentVolumeClaim
 		pods            []*corev1api.Pod
+		groupPVCs       []*corev1api.PersistentVolumeClaim
 		expectedErr     error
 		expectedRelated []velero.ResourceIdentifier
 	}{
@@ -124,6 +125,21 @@ func TestBackupPVAction(t *testing.T) {
 				{GroupResource: kuberesource.Pods, Namespace: "velero", Name: "testPod2"},
 			},
 		},
+		{
+			name: "Test with volume group",
+			pvc: builder.ForPersistentVolumeClaim("velero", "testPVC").ObjectMeta(builder.WithLabels(v1.VolumeGroupSnapshotLabel, "db")).
+				VolumeName("testPV").Phase(corev1api.ClaimBound).Result(),
+			groupPVCs: []*corev1api.PersistentVolumeClaim{
+				builder.ForPersistentVolumeClaim("velero", "testPVC2").ObjectMeta(builder.WithLabels(v1.VolumeGroupSnapshotLabel, "db")).Result(),
+				builder.ForPersistentVolumeClaim("velero", "testPVC3").ObjectMeta(builder.WithLabels(v1.VolumeGroupSnapshotLabel, "db", v1.ExcludeFromBackupLabel, "true")).Result(),
+				builder.ForPersistentVolumeClaim("velero", "testPVC4").ObjectMeta(builder.WithLabels(v1.VolumeGroupSnapshotLabel, "logs")).Result(),
+			},
+			expectedErr: nil,
+			expectedRelated: []velero.ResourceIdentifier{
+				{GroupResource: kuberesource.PersistentVolumes, Name: "testPV"},
+				{GroupResource: kuberesource.PersistentVolumeClaims, Namespace: "velero", Name: "testPVC2"},
+			},
+		},
 	}
 
 	backup := &v1.Backup{}
@@ -151,6 +167,9 @@ func TestBackupPVAction(t *testing.T) {
 			for _, pod := range tc.pods {
 				require.NoError(t, crClient.Create(context.Background(), pod))
 			}
+			for _, pvc := range tc.groupPVCs {
+				require.NoError(t, crClient.Create(context.Background(), pvc))
+			}
 
 			pvcMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tc.pvc)
 			require.NoError(t, err)
@@ -17,7 +17,11 @@ limitations under the License.
 package itemoperation
 
 import (
+	"strings"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
 
 // OperationPhase is the lifecycle phase of a Velero item operation
@@ -101,3 +105,17 @@ const (
 	// OperationPhaseFailed means the item operation ended with an error.
 	OperationPhaseFailed OperationPhase = "Failed"
 )
+
+// CancelRequested returns true if the operation ID is listed in the cancel operations annotation
+// of the backup or restore the operation belongs to. An empty operation ID is never listed.
+func CancelRequested(annotations map[string]string, operationID string) bool {
+	if operationID == "" {
+		return false
+	}
+	for _, id := range strings.Split(annotations[velerov1api.CancelOperationsAnnotation], ",") {
+		if strings.TrimSpace(id) == operationID {
+			return true
+		}
+	}
+	return false
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
@@ -76,21 +77,22 @@ func (m *BackupItemOperationsMap) DeleteOperationsForBackup(backupName string) {
 }
 
 // UploadProgressAndPutOperationsForBackup will upload the item operations for this backup to
-// the object store and update the map for this backup with the modified operations
+// the object store, record their digest in the backup and update the map for this backup with
+// the modified operations
 func (m *BackupItemOperationsMap) UploadProgressAndPutOperationsForBackup(
 	backupStore persistence.BackupStore,
 	operations *OperationsForBackup,
-	backupName string) error {
+	backup *velerov1api.Backup) error {
 	m.opsLock.Lock()
 	defer m.opsLock.Unlock()
 
 	if operations == nil {
 		return errors.New("nil operations passed in")
 	}
-	if err := operations.uploadProgress(backupStore, backupName); err != nil {
+	if err := operations.uploadProgress(backupStore, backup.Name, backup); err != nil {
 		return err
 	}
-	m.opsMap[backupName] = operations
+	m.opsMap[backup.Name] = operations
 	return nil
 }
 
@@ -107,7 +109,7 @@ func (m *BackupItemOperationsMap) UpdateForBackup(backupStore persistence.Backup
 	if !ok || (!operations.ChangesSinceUpdate && len(operations.ErrsSinceUpdate) == 0) {
 		return nil
 	}
-	if err := operations.uploadProgress(backupStore, backupName); err != nil {
+	if err := operations.uploadProgress(backupStore, backupName, nil); err != nil {
 		return err
 	}
 	return nil
@@ -148,17 +150,40 @@ func (m *OperationsForBackup) DeepCopyInto(out *OperationsForBackup) {
 	}
 }
 
-func (m *OperationsForBackup) uploadProgress(backupStore persistence.BackupStore, backupName string) error {
+// uploadProgress uploads the item operations, recording their digest in the backup if any. Without
+// a backup, the digest is recorded in the metadata of the backup in the object store.
+func (m *OperationsForBackup) uploadProgress(backupStore persistence.BackupStore, backupName string, backup *velerov1api.Backup) error {
 	if len(m.Operations) > 0 {
 		var backupItemOperations *bytes.Buffer
 		backupItemOperations, errs := encode.ToJSONGzip(m.Operations, "backup item operations list")
 		if errs != nil {
 			return errors.Wrap(errs[0], "error encoding item operations json")
 		}
+		var storedBackup *velerov1api.Backup
+		if backup == nil {
+			var err error
+			if storedBackup, err = backupStore.GetBackupMetadata(backupName); err != nil {
+				return errors.Wrap(err, "error getting backup metadata")
+			}
+			backup = storedBackup
+		}
+		if err := persistence.SetBackupArtifactDigest(backup, velerov1api.DownloadTargetKindBackupItemOperations, backupItemOperations); err != nil {
+			return err
+		}
 		err := backupStore.PutBackupItemOperations(backupName, backupItemOperations)
 		if err != nil {
 			return errors.Wrap(err, "error uploading item operations json")
 		}
+		// the metadata is only uploaded again for the backups with digests
+		if storedBackup != nil && storedBackup.Status.ArtifactDigests != nil {
+			backupJSON := new(bytes.Buffer)
+			if err := encode.To(storedBackup, "json", backupJSON); err != nil {
+				return errors.Wrap(err, "error encoding backup json")
+			}
+			if err := backupStore.PutBackupMetadata(backupName, backupJSON); err != nil {
+				return errors.Wrap(err, "error uploading backup json")
+			}
+		}
 	}
 	m.ChangesSinceUpdate = false
 	m.ErrsSinceUpdate = nil
@@ -0,0 +1,160 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package itemquarantine
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// MaxQuarantinedItems is the most items listed in the status of a backup or restore, so that
+// the status doesn't outgrow the size limit of the API server. All the failed items count
+// against the error budget regardless.
+const MaxQuarantinedItems = 100
+
+// Tracker quarantines the items which failed to be backed up or restored, and tells whether
+// the error budget of the backup or restore is exceeded. It's safe for concurrent use.
+type Tracker struct {
+	lock   sync.Mutex
+	budget *int
+	failed map[string]struct{}
+	items  []velerov1api.QuarantinedItem
+}
+
+// NewTracker returns a Tracker for the error budget, nil meaning no limit.
+func NewTracker(budget *int) *Tracker {
+	return &Tracker{
+		budget: budget,
+		failed: map[string]struct{}{},
+	}
+}
+
+// Add quarantines the item which failed with err, an item failing more than once counting
+// once, and returns whether the error budget is exceeded.
+func (t *Tracker) Add(groupResource schema.GroupResource, namespace, name string, err error) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	key := groupResource.String() + "/" + namespace + "/" + name
+	if _, found := t.failed[key]; !found {
+		t.failed[key] = struct{}{}
+		if len(t.items) < MaxQuarantinedItems {
+			t.items = append(t.items, velerov1api.QuarantinedItem{
+				Resource:   groupResource.String(),
+				Namespace:  namespace,
+				Name:       name,
+				ErrorClass: ClassifyError(err),
+				Error:      err.Error(),
+			})
+		}
+	}
+
+	return t.exceeded()
+}
+
+// Exceeded returns whether more items failed than the error budget allows.
+func (t *Tracker) Exceeded() bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.exceeded()
+}
+
+func (t *Tracker) exceeded() bool {
+	return t.budget != nil && len(t.failed) > *t.budget
+}
+
+// Failed returns the number of the failed items.
+func (t *Tracker) Failed() int {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return len(t.failed)
+}
+
+// Budget returns the error budget, -1 meaning no limit.
+func (t *Tracker) Budget() int {
+	if t.budget == nil {
+		return -1
+	}
+	return *t.budget
+}
+
+// Items returns the quarantined items, up to MaxQuarantinedItems of them.
+func (t *Tracker) Items() []velerov1api.QuarantinedItem {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if len(t.items) == 0 {
+		return nil
+	}
+	return append([]velerov1api.QuarantinedItem{}, t.items...)
+}
+
+// ClassifyError returns the class of the error an item failed with. The errors of the API
+// server are classified by their reason, the others, e.g. the messages restores collect, by
+// their message.
+func ClassifyError(err error) velerov1api.ItemErrorClass {
+	if aggregate, ok := err.(kubeerrs.Aggregate); ok && len(aggregate.Errors()) > 0 {
+		err = aggregate.Errors()[0]
+	}
+
+	switch {
+	case apierrors.IsNotFound(err):
+		return velerov1api.ItemErrorClassNotFound
+	case apierrors.IsForbidden(err), apierrors.IsUnauthorized(err):
+		return velerov1api.ItemErrorClassForbidden
+	case apierrors.IsAlreadyExists(err):
+		return velerov1api.ItemErrorClassAlreadyExists
+	case apierrors.IsConflict(err):
+		return velerov1api.ItemErrorClassConflict
+	case apierrors.IsInvalid(err), apierrors.IsBadRequest(err):
+		return velerov1api.ItemErrorClassInvalid
+	case apierrors.IsTimeout(err), apierrors.IsServerTimeout(err), errors.Is(err, context.DeadlineExceeded):
+		return velerov1api.ItemErrorClassTimeout
+	case apierrors.IsServiceUnavailable(err), apierrors.IsInternalError(err), apierrors.IsTooManyRequests(err):
+		return velerov1api.ItemErrorClassUnavailable
+	}
+
+	message := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(message, "not found"):
+		return velerov1api.ItemErrorClassNotFound
+	case strings.Contains(message, "forbidden"), strings.Contains(message, "unauthorized"):
+		return velerov1api.ItemErrorClassForbidden
+	case strings.Contains(message, "already exists"):
+		return velerov1api.ItemErrorClassAlreadyExists
+	case strings.Contains(message, "the object has been modified"):
+		return velerov1api.ItemErrorClassConflict
+	case strings.Contains(message, "is invalid"):
+		return velerov1api.ItemErrorClassInvalid
+	case strings.Contains(message, "timeout"), strings.Contains(message, "timed out"), strings.Contains(message, "deadline exceeded"):
+		return velerov1api.ItemErrorClassTimeout
+	case strings.Contains(message, "service unavailable"), strings.Contains(message, "too many requests"):
+		return velerov1api.ItemErrorClassUnavailable
+	}
+
+	return velerov1api.ItemErrorClassUnknown
+}
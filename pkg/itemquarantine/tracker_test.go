@@ -0,0 +1,126 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package itemquarantine
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+func TestTracker(t *testing.T) {
+	budget := 1
+	tracker := NewTracker(&budget)
+
+	forbidden := apierrors.NewForbidden(kuberesource.Pods, "pod-1", errors.New("denied"))
+	assert.False(t, tracker.Add(kuberesource.Pods, "ns-1", "pod-1", forbidden))
+	// an item failing again counts once
+	assert.False(t, tracker.Add(kuberesource.Pods, "ns-1", "pod-1", errors.New("failed again")))
+	assert.False(t, tracker.Exceeded())
+
+	assert.True(t, tracker.Add(kuberesource.PersistentVolumes, "", "pv-1", errors.New("error restoring persistentvolumes/pv-1: the server could not find the requested resource (not found)")))
+	assert.True(t, tracker.Exceeded())
+	assert.Equal(t, 2, tracker.Failed())
+
+	assert.Equal(t, []velerov1api.QuarantinedItem{
+		{Resource: "pods", Namespace: "ns-1", Name: "pod-1", ErrorClass: velerov1api.ItemErrorClassForbidden, Error: forbidden.Error()},
+		{Resource: "persistentvolumes", Name: "pv-1", ErrorClass: velerov1api.ItemErrorClassNotFound, Error: "error restoring persistentvolumes/pv-1: the server could not find the requested resource (not found)"},
+	}, tracker.Items())
+}
+
+func TestTrackerWithoutBudget(t *testing.T) {
+	tracker := NewTracker(nil)
+	for i := 0; i < MaxQuarantinedItems+10; i++ {
+		assert.False(t, tracker.Add(kuberesource.Pods, "ns-1", fmt.Sprintf("pod-%d", i), errors.New("failed")))
+	}
+
+	assert.False(t, tracker.Exceeded())
+	assert.Equal(t, MaxQuarantinedItems+10, tracker.Failed())
+	assert.Len(t, tracker.Items(), MaxQuarantinedItems)
+	assert.Equal(t, -1, tracker.Budget())
+}
+
+func TestClassifyError(t *testing.T) {
+	gr := schema.GroupResource{Group: "example.io", Resource: "foos"}
+
+	tests := []struct {
+		name     string
+		err      error
+		expected velerov1api.ItemErrorClass
+	}{
+		{
+			name:     "wrapped not found",
+			err:      errors.Wrap(apierrors.NewNotFound(gr, "foo"), "error getting foo"),
+			expected: velerov1api.ItemErrorClassNotFound,
+		},
+		{
+			name:     "already exists",
+			err:      apierrors.NewAlreadyExists(gr, "foo"),
+			expected: velerov1api.ItemErrorClassAlreadyExists,
+		},
+		{
+			name:     "conflict",
+			err:      apierrors.NewConflict(gr, "foo", errors.New("the object has been modified")),
+			expected: velerov1api.ItemErrorClassConflict,
+		},
+		{
+			name:     "invalid",
+			err:      apierrors.NewInvalid(schema.GroupKind{Group: "example.io", Kind: "Foo"}, "foo", nil),
+			expected: velerov1api.ItemErrorClassInvalid,
+		},
+		{
+			name:     "deadline exceeded",
+			err:      errors.Wrap(context.DeadlineExceeded, "error waiting for foo"),
+			expected: velerov1api.ItemErrorClassTimeout,
+		},
+		{
+			name:     "too many requests",
+			err:      apierrors.NewTooManyRequests("slow down", 1),
+			expected: velerov1api.ItemErrorClassUnavailable,
+		},
+		{
+			name:     "aggregate",
+			err:      kubeerrs.NewAggregate([]error{apierrors.NewForbidden(gr, "foo", errors.New("denied")), errors.New("other")}),
+			expected: velerov1api.ItemErrorClassForbidden,
+		},
+		{
+			name:     "message",
+			err:      errors.New(`error restoring foos.example.io/ns-1/foo: Foo.example.io "foo" is invalid: spec: Required value`),
+			expected: velerov1api.ItemErrorClassInvalid,
+		},
+		{
+			name:     "unknown",
+			err:      errors.New("plugin crashed"),
+			expected: velerov1api.ItemErrorClassUnknown,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, ClassifyError(test.err))
+		})
+	}
+}
@@ -21,8 +21,10 @@ import (
 )
 
 var (
+	ClusterAPIClusters        = schema.GroupResource{Group: "cluster.x-k8s.io", Resource: "clusters"}
 	ClusterRoleBindings       = schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "clusterrolebindings"}
 	ClusterRoles              = schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "clusterroles"}
+	ConfigMaps                = schema.GroupResource{Group: "", Resource: "configmaps"}
 	CustomResourceDefinitions = schema.GroupResource{Group: "apiextensions.k8s.io", Resource: "customresourcedefinitions"}
 	Jobs                      = schema.GroupResource{Group: "batch", Resource: "jobs"}
 	Namespaces                = schema.GroupResource{Group: "", Resource: "namespaces"}
@@ -36,4 +38,18 @@ var (
 	VolumeSnapshotContents    = schema.GroupResource{Group: "snapshot.storage.k8s.io", Resource: "volumesnapshotcontents"}
 	PriorityClasses           = schema.GroupResource{Group: "scheduling.k8s.io", Resource: "priorityclasses"}
 	DataUploads               = schema.GroupResource{Group: "velero.io", Resource: "datauploads"}
+	Deployments               = schema.GroupResource{Group: "apps", Resource: "deployments"}
+	ReplicaSets               = schema.GroupResource{Group: "apps", Resource: "replicasets"}
+	StatefulSets              = schema.GroupResource{Group: "apps", Resource: "statefulsets"}
+	ResourceQuotas            = schema.GroupResource{Group: "", Resource: "resourcequotas"}
+	LimitRanges               = schema.GroupResource{Group: "", Resource: "limitranges"}
+	RoleBindings              = schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "rolebindings"}
+	Ingresses                 = schema.GroupResource{Group: "networking.k8s.io", Resource: "ingresses"}
+	IngressClasses            = schema.GroupResource{Group: "networking.k8s.io", Resource: "ingressclasses"}
+	StorageClasses            = schema.GroupResource{Group: "storage.k8s.io", Resource: "storageclasses"}
+	Services                  = schema.GroupResource{Group: "", Resource: "services"}
+	DaemonSets                = schema.GroupResource{Group: "apps", Resource: "daemonsets"}
+
+	MutatingWebhookConfigurations   = schema.GroupResource{Group: "admissionregistration.k8s.io", Resource: "mutatingwebhookconfigurations"}
+	ValidatingWebhookConfigurations = schema.GroupResource{Group: "admissionregistration.k8s.io", Resource: "validatingwebhookconfigurations"}
 )
@@ -0,0 +1,75 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// OtherLabelValue is the value of a label whose number of distinct values reached its limit.
+	OtherLabelValue = "other"
+
+	// DefaultDataMoverLabelLimit is the default number of distinct storage classes and drivers
+	// the data mover metrics are broken down by.
+	DefaultDataMoverLabelLimit = 20
+)
+
+// labelLimiter bounds the number of distinct values of labels, so that their cardinality doesn't
+// grow with the number of e.g. storage classes of the cluster.
+type labelLimiter struct {
+	lock   sync.Mutex
+	max    int
+	values map[string]sets.Set[string]
+}
+
+func newLabelLimiter(max int) *labelLimiter {
+	return &labelLimiter{
+		max:    max,
+		values: make(map[string]sets.Set[string]),
+	}
+}
+
+// limit returns the value of the label when it's one of its first max distinct values, and
+// OtherLabelValue otherwise. The label is always empty when max is zero.
+func (l *labelLimiter) limit(label, value string) string {
+	if l == nil || value == "" {
+		return value
+	}
+	if l.max <= 0 {
+		return ""
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	values, found := l.values[label]
+	if !found {
+		values = sets.New[string]()
+		l.values[label] = values
+	}
+	if values.Has(value) {
+		return value
+	}
+	if values.Len() >= l.max {
+		return OtherLabelValue
+	}
+	values.Insert(value)
+	return value
+}
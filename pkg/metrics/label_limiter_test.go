@@ -0,0 +1,41 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLabelLimiter(t *testing.T) {
+	limiter := newLabelLimiter(2)
+	assert.Equal(t, "gp2", limiter.limit(storageClassMetricLabel, "gp2"))
+	assert.Equal(t, "gp3", limiter.limit(storageClassMetricLabel, "gp3"))
+	assert.Equal(t, OtherLabelValue, limiter.limit(storageClassMetricLabel, "io1"))
+	// the values already seen keep their own value
+	assert.Equal(t, "gp2", limiter.limit(storageClassMetricLabel, "gp2"))
+	// each label has its own limit
+	assert.Equal(t, "ebs.csi.aws.com", limiter.limit(driverMetricLabel, "ebs.csi.aws.com"))
+	assert.Equal(t, "", limiter.limit(driverMetricLabel, ""))
+
+	limiter = newLabelLimiter(0)
+	assert.Equal(t, "", limiter.limit(storageClassMetricLabel, "gp2"))
+
+	var nilLimiter *labelLimiter
+	assert.Equal(t, "gp2", nilLimiter.limit(storageClassMetricLabel, "gp2"))
+}
@@ -24,7 +24,19 @@ import (
 
 // ServerMetrics contains Prometheus metrics for the Velero server.
 type ServerMetrics struct {
-	metrics map[string]prometheus.Collector
+	metrics      map[string]prometheus.Collector
+	remoteWriter *RemoteWriter
+
+	// dataMoverLabels bounds the distinct storage classes and drivers of the data mover metrics.
+	dataMoverLabels *labelLimiter
+}
+
+// DataMoverLabels are the labels the data mover metrics are broken down by, besides the node.
+type DataMoverLabels struct {
+	// StorageClass is the storage class of the volume whose data is moved.
+	StorageClass string
+	// Driver is the CSI driver provisioning the volumes of the storage class.
+	Driver string
 }
 
 const (
@@ -47,6 +59,8 @@ const (
 	backupItemsErrorsGauge        = "backup_items_errors"
 	backupWarningTotal            = "backup_warning_total"
 	backupLastStatus              = "backup_last_status"
+	backupVolumePolicyMatched     = "backup_volume_policy_matched_volumes"
+	backupVolumePolicyApplied     = "backup_volume_policy_applied_volumes"
 	restoreTotal                  = "restore_total"
 	restoreAttemptTotal           = "restore_attempt_total"
 	restoreValidationFailedTotal  = "restore_validation_failed_total"
@@ -59,6 +73,8 @@ const (
 	csiSnapshotAttemptTotal       = "csi_snapshot_attempt_total"
 	csiSnapshotSuccessTotal       = "csi_snapshot_success_total"
 	csiSnapshotFailureTotal       = "csi_snapshot_failure_total"
+	snapshotAPIQueuedCalls        = "snapshot_api_queued_calls"
+	snapshotAPIWaitSeconds        = "snapshot_api_wait_seconds"
 
 	// pod volume metrics
 	podVolumeBackupEnqueueTotal           = "pod_volume_backup_enqueue_count"
@@ -74,12 +90,23 @@ const (
 	DataDownloadFailureTotal = "data_download_failure_total"
 	DataDownloadCancelTotal  = "data_download_cancel_total"
 
+	dataUploadDurationSeconds   = "data_upload_duration_seconds"
+	dataUploadBytesTotal        = "data_upload_bytes_total"
+	dataDownloadDurationSeconds = "data_download_duration_seconds"
+	dataDownloadBytesTotal      = "data_download_bytes_total"
+
 	// Labels
 	nodeMetricLabel         = "node"
 	podVolumeOperationLabel = "operation"
 	pvbNameLabel            = "pod_volume_backup"
 	scheduleLabel           = "schedule"
+	volumePolicyLabel       = "policy"
+	volumePolicyActionLabel = "action"
 	backupNameLabel         = "backupName"
+	locationLabel           = "location"
+	snapshotOperationLabel  = "operation"
+	storageClassMetricLabel = "storage_class"
+	driverMetricLabel       = "driver"
 
 	// metrics values
 	BackupLastStatusSucc    int64 = 1
@@ -228,6 +255,22 @@ func NewServerMetrics() *ServerMetrics {
 				},
 				[]string{scheduleLabel},
 			),
+			backupVolumePolicyMatched: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      backupVolumePolicyMatched,
+					Help:      "Number of volumes a volume policy of the resource policies matched in the last backup",
+				},
+				[]string{scheduleLabel, volumePolicyLabel, volumePolicyActionLabel},
+			),
+			backupVolumePolicyApplied: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      backupVolumePolicyApplied,
+					Help:      "Number of volumes the action of a volume policy of the resource policies was applied to in the last backup",
+				},
+				[]string{scheduleLabel, volumePolicyLabel, volumePolicyActionLabel},
+			),
 			restoreTotal: prometheus.NewGauge(
 				prometheus.GaugeOpts{
 					Namespace: metricNamespace,
@@ -323,6 +366,32 @@ func NewServerMetrics() *ServerMetrics {
 				},
 				[]string{scheduleLabel, backupNameLabel},
 			),
+			snapshotAPIQueuedCalls: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      snapshotAPIQueuedCalls,
+					Help:      "Number of snapshot API calls waiting for the rate limit of their volume snapshot location",
+				},
+				[]string{locationLabel, snapshotOperationLabel},
+			),
+			snapshotAPIWaitSeconds: prometheus.NewHistogramVec(
+				prometheus.HistogramOpts{
+					Namespace: metricNamespace,
+					Name:      snapshotAPIWaitSeconds,
+					Help:      "Time snapshot API calls waited for the rate limit of their volume snapshot location, in seconds",
+					Buckets: []float64{
+						toSeconds(1 * time.Second),
+						toSeconds(10 * time.Second),
+						toSeconds(30 * time.Second),
+						toSeconds(1 * time.Minute),
+						toSeconds(5 * time.Minute),
+						toSeconds(15 * time.Minute),
+						toSeconds(30 * time.Minute),
+						toSeconds(1 * time.Hour),
+					},
+				},
+				[]string{locationLabel, snapshotOperationLabel},
+			),
 		},
 	}
 }
@@ -379,7 +448,7 @@ func NewNodeMetrics() *ServerMetrics {
 					Name:      DataUploadSuccessTotal,
 					Help:      "Total number of successful uploaded snapshots",
 				},
-				[]string{nodeMetricLabel},
+				[]string{nodeMetricLabel, storageClassMetricLabel, driverMetricLabel},
 			),
 			DataUploadFailureTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
@@ -387,7 +456,7 @@ func NewNodeMetrics() *ServerMetrics {
 					Name:      DataUploadFailureTotal,
 					Help:      "Total number of failed uploaded snapshots",
 				},
-				[]string{nodeMetricLabel},
+				[]string{nodeMetricLabel, storageClassMetricLabel, driverMetricLabel},
 			),
 			DataUploadCancelTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
@@ -395,7 +464,7 @@ func NewNodeMetrics() *ServerMetrics {
 					Name:      DataUploadCancelTotal,
 					Help:      "Total number of canceled uploaded snapshots",
 				},
-				[]string{nodeMetricLabel},
+				[]string{nodeMetricLabel, storageClassMetricLabel, driverMetricLabel},
 			),
 			DataDownloadSuccessTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
@@ -403,7 +472,7 @@ func NewNodeMetrics() *ServerMetrics {
 					Name:      DataDownloadSuccessTotal,
 					Help:      "Total number of successful downloaded snapshots",
 				},
-				[]string{nodeMetricLabel},
+				[]string{nodeMetricLabel, storageClassMetricLabel, driverMetricLabel},
 			),
 			DataDownloadFailureTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
@@ -411,7 +480,7 @@ func NewNodeMetrics() *ServerMetrics {
 					Name:      DataDownloadFailureTotal,
 					Help:      "Total number of failed downloaded snapshots",
 				},
-				[]string{nodeMetricLabel},
+				[]string{nodeMetricLabel, storageClassMetricLabel, driverMetricLabel},
 			),
 			DataDownloadCancelTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
@@ -419,9 +488,74 @@ func NewNodeMetrics() *ServerMetrics {
 					Name:      DataDownloadCancelTotal,
 					Help:      "Total number of canceled downloaded snapshots",
 				},
-				[]string{nodeMetricLabel},
+				[]string{nodeMetricLabel, storageClassMetricLabel, driverMetricLabel},
+			),
+			dataUploadDurationSeconds: prometheus.NewHistogramVec(
+				prometheus.HistogramOpts{
+					Namespace: podVolumeMetricsNamespace,
+					Name:      dataUploadDurationSeconds,
+					Help:      "Time taken to upload the data of snapshots, in seconds",
+					Buckets:   dataMoverDurationBuckets,
+				},
+				[]string{nodeMetricLabel, storageClassMetricLabel, driverMetricLabel},
+			),
+			dataUploadBytesTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: podVolumeMetricsNamespace,
+					Name:      dataUploadBytesTotal,
+					Help:      "Total number of bytes of the uploaded snapshots",
+				},
+				[]string{nodeMetricLabel, storageClassMetricLabel, driverMetricLabel},
+			),
+			dataDownloadDurationSeconds: prometheus.NewHistogramVec(
+				prometheus.HistogramOpts{
+					Namespace: podVolumeMetricsNamespace,
+					Name:      dataDownloadDurationSeconds,
+					Help:      "Time taken to download the data of snapshots, in seconds",
+					Buckets:   dataMoverDurationBuckets,
+				},
+				[]string{nodeMetricLabel, storageClassMetricLabel, driverMetricLabel},
+			),
+			dataDownloadBytesTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: podVolumeMetricsNamespace,
+					Name:      dataDownloadBytesTotal,
+					Help:      "Total number of bytes of the downloaded snapshots",
+				},
+				[]string{nodeMetricLabel, storageClassMetricLabel, driverMetricLabel},
 			),
 		},
+		dataMoverLabels: newLabelLimiter(DefaultDataMoverLabelLimit),
+	}
+}
+
+// dataMoverDurationBuckets are the buckets of the durations of the data movements.
+var dataMoverDurationBuckets = []float64{
+	toSeconds(10 * time.Second),
+	toSeconds(30 * time.Second),
+	toSeconds(1 * time.Minute),
+	toSeconds(5 * time.Minute),
+	toSeconds(10 * time.Minute),
+	toSeconds(30 * time.Minute),
+	toSeconds(1 * time.Hour),
+	toSeconds(2 * time.Hour),
+	toSeconds(4 * time.Hour),
+	toSeconds(8 * time.Hour),
+}
+
+// SetDataMoverLabelLimit sets the number of distinct storage classes and drivers the data mover
+// metrics are broken down by, the others being reported as OtherLabelValue. Zero leaves the
+// storage class and driver labels empty.
+func (m *ServerMetrics) SetDataMoverLabelLimit(max int) {
+	m.dataMoverLabels = newLabelLimiter(max)
+}
+
+// dataMoverLabelValues returns the values of the labels of the data mover metrics.
+func (m *ServerMetrics) dataMoverLabelValues(node string, labels DataMoverLabels) []string {
+	return []string{
+		node,
+		m.dataMoverLabels.limit(storageClassMetricLabel, labels.StorageClass),
+		m.dataMoverLabels.limit(driverMetricLabel, labels.Driver),
 	}
 }
 
@@ -552,6 +686,7 @@ func (m *ServerMetrics) RemoveSchedule(scheduleName string) {
 	if c, ok := m.metrics[backupLastStatus].(*prometheus.GaugeVec); ok {
 		c.DeleteLabelValues(scheduleName)
 	}
+	m.ResetVolumePolicyHits(scheduleName)
 	if c, ok := m.metrics[restoreAttemptTotal].(*prometheus.CounterVec); ok {
 		c.DeleteLabelValues(scheduleName)
 	}
@@ -595,24 +730,8 @@ func (m *ServerMetrics) InitMetricsForNode(node string) {
 	if c, ok := m.metrics[podVolumeBackupDequeueTotal].(*prometheus.CounterVec); ok {
 		c.WithLabelValues(node).Add(0)
 	}
-	if c, ok := m.metrics[DataUploadSuccessTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(node).Add(0)
-	}
-	if c, ok := m.metrics[DataUploadFailureTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(node).Add(0)
-	}
-	if c, ok := m.metrics[DataUploadCancelTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(node).Add(0)
-	}
-	if c, ok := m.metrics[DataDownloadSuccessTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(node).Add(0)
-	}
-	if c, ok := m.metrics[DataDownloadFailureTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(node).Add(0)
-	}
-	if c, ok := m.metrics[DataDownloadCancelTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(node).Add(0)
-	}
+	// the data mover metrics aren't initialized as they're broken down by the storage classes
+	// and the drivers too, which are only known once data is moved
 }
 
 // RegisterPodVolumeBackupEnqueue records enqueuing of a PodVolumeBackup object.
@@ -630,44 +749,66 @@ func (m *ServerMetrics) RegisterPodVolumeBackupDequeue(node string) {
 }
 
 // RegisterDataUploadSuccess records successful uploaded snapshots.
-func (m *ServerMetrics) RegisterDataUploadSuccess(node string) {
+func (m *ServerMetrics) RegisterDataUploadSuccess(node string, labels DataMoverLabels) {
 	if c, ok := m.metrics[DataUploadSuccessTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(node).Inc()
+		c.WithLabelValues(m.dataMoverLabelValues(node, labels)...).Inc()
+	}
+}
+
+// ObserveDataUpload records the duration and the number of bytes of an uploaded snapshot.
+func (m *ServerMetrics) ObserveDataUpload(node string, labels DataMoverLabels, seconds float64, bytes int64) {
+	values := m.dataMoverLabelValues(node, labels)
+	if h, ok := m.metrics[dataUploadDurationSeconds].(*prometheus.HistogramVec); ok {
+		h.WithLabelValues(values...).Observe(seconds)
+	}
+	if c, ok := m.metrics[dataUploadBytesTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(values...).Add(float64(bytes))
 	}
 }
 
 // RegisterDataUploadFailure records failed uploaded snapshots.
-func (m *ServerMetrics) RegisterDataUploadFailure(node string) {
+func (m *ServerMetrics) RegisterDataUploadFailure(node string, labels DataMoverLabels) {
 	if c, ok := m.metrics[DataUploadFailureTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(node).Inc()
+		c.WithLabelValues(m.dataMoverLabelValues(node, labels)...).Inc()
 	}
 }
 
 // RegisterDataUploadCancel records canceled uploaded snapshots.
-func (m *ServerMetrics) RegisterDataUploadCancel(node string) {
+func (m *ServerMetrics) RegisterDataUploadCancel(node string, labels DataMoverLabels) {
 	if c, ok := m.metrics[DataUploadCancelTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(node).Inc()
+		c.WithLabelValues(m.dataMoverLabelValues(node, labels)...).Inc()
 	}
 }
 
 // RegisterDataDownloadSuccess records successful downloaded snapshots.
-func (m *ServerMetrics) RegisterDataDownloadSuccess(node string) {
+func (m *ServerMetrics) RegisterDataDownloadSuccess(node string, labels DataMoverLabels) {
 	if c, ok := m.metrics[DataDownloadSuccessTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(node).Inc()
+		c.WithLabelValues(m.dataMoverLabelValues(node, labels)...).Inc()
+	}
+}
+
+// ObserveDataDownload records the duration and the number of bytes of a downloaded snapshot.
+func (m *ServerMetrics) ObserveDataDownload(node string, labels DataMoverLabels, seconds float64, bytes int64) {
+	values := m.dataMoverLabelValues(node, labels)
+	if h, ok := m.metrics[dataDownloadDurationSeconds].(*prometheus.HistogramVec); ok {
+		h.WithLabelValues(values...).Observe(seconds)
+	}
+	if c, ok := m.metrics[dataDownloadBytesTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(values...).Add(float64(bytes))
 	}
 }
 
 // RegisterDataDownloadFailure records failed downloaded snapshots.
-func (m *ServerMetrics) RegisterDataDownloadFailure(node string) {
+func (m *ServerMetrics) RegisterDataDownloadFailure(node string, labels DataMoverLabels) {
 	if c, ok := m.metrics[DataDownloadFailureTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(node).Inc()
+		c.WithLabelValues(m.dataMoverLabelValues(node, labels)...).Inc()
 	}
 }
 
 // RegisterDataDownloadCancel records canceled downloaded snapshots.
-func (m *ServerMetrics) RegisterDataDownloadCancel(node string) {
+func (m *ServerMetrics) RegisterDataDownloadCancel(node string, labels DataMoverLabels) {
 	if c, ok := m.metrics[DataDownloadCancelTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(node).Inc()
+		c.WithLabelValues(m.dataMoverLabelValues(node, labels)...).Inc()
 	}
 }
 
@@ -799,6 +940,27 @@ func (m *ServerMetrics) RegisterBackupLastStatus(backupSchedule string, lastStat
 	}
 }
 
+// ResetVolumePolicyHits removes the numbers of volumes the volume policies matched recorded for the
+// schedule, so the policies removed from the resource policies since aren't reported anymore.
+func (m *ServerMetrics) ResetVolumePolicyHits(backupSchedule string) {
+	for _, name := range []string{backupVolumePolicyMatched, backupVolumePolicyApplied} {
+		if g, ok := m.metrics[name].(*prometheus.GaugeVec); ok {
+			g.DeletePartialMatch(prometheus.Labels{scheduleLabel: backupSchedule})
+		}
+	}
+}
+
+// RegisterVolumePolicyHit records the numbers of volumes a volume policy matched and was applied
+// to in the last backup of the schedule.
+func (m *ServerMetrics) RegisterVolumePolicyHit(backupSchedule, policy, action string, matched, applied int) {
+	if g, ok := m.metrics[backupVolumePolicyMatched].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(backupSchedule, policy, action).Set(float64(matched))
+	}
+	if g, ok := m.metrics[backupVolumePolicyApplied].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(backupSchedule, policy, action).Set(float64(applied))
+	}
+}
+
 // toSeconds translates a time.Duration value into a float64
 // representing the number of seconds in that duration.
 func toSeconds(d time.Duration) float64 {
@@ -888,3 +1050,20 @@ func (m *ServerMetrics) RegisterCSISnapshotFailures(backupSchedule, backupName s
 		c.WithLabelValues(backupSchedule, backupName).Add(float64(csiSnapshotsFailed))
 	}
 }
+
+// RegisterSnapshotAPICallQueued records a snapshot API call waiting for the rate limit of its location.
+func (m *ServerMetrics) RegisterSnapshotAPICallQueued(location, operation string) {
+	if g, ok := m.metrics[snapshotAPIQueuedCalls].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(location, operation).Inc()
+	}
+}
+
+// RegisterSnapshotAPICallDequeued records a snapshot API call allowed to proceed after waiting for seconds.
+func (m *ServerMetrics) RegisterSnapshotAPICallDequeued(location, operation string, seconds float64) {
+	if g, ok := m.metrics[snapshotAPIQueuedCalls].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(location, operation).Dec()
+	}
+	if h, ok := m.metrics[snapshotAPIWaitSeconds].(*prometheus.HistogramVec); ok {
+		h.WithLabelValues(location, operation).Observe(seconds)
+	}
+}
@@ -0,0 +1,241 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/s2"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const (
+	backupOutcomeCompletionTimestamp = "backup_outcome_completion_timestamp_seconds"
+	backupOutcomeDurationSeconds     = "backup_outcome_duration_seconds"
+	backupOutcomeItems               = "backup_outcome_items"
+	backupOutcomeErrors              = "backup_outcome_errors"
+	backupOutcomeWarnings            = "backup_outcome_warnings"
+
+	phaseLabel = "phase"
+
+	defaultRemoteWriteTimeout = 30 * time.Second
+)
+
+// RemoteWriteConfig configures the push of backup outcomes to a Prometheus remote-write endpoint.
+type RemoteWriteConfig struct {
+	// URL is the remote-write endpoint, e.g. https://prometheus.example.com/api/v1/write.
+	URL string
+	// Labels are added to every pushed sample to identify the cluster, e.g. cluster=prod-eu-1.
+	Labels map[string]string
+	// BearerTokenFile is the path of a file holding the token sent in the Authorization header.
+	BearerTokenFile string
+	// Timeout is the timeout of a push. Defaults to 30 seconds.
+	Timeout time.Duration
+}
+
+// BackupOutcome is the result of a completed backup pushed to the remote-write endpoint.
+type BackupOutcome struct {
+	Schedule   string
+	Phase      string
+	Start      time.Time
+	Completion time.Time
+	Items      int
+	Errors     int
+	Warnings   int
+}
+
+// RemoteWriter pushes backup outcomes to a Prometheus remote-write endpoint, so that the
+// backup health of many clusters can be aggregated without scraping each Velero server.
+type RemoteWriter struct {
+	config RemoteWriteConfig
+	client *http.Client
+	logger logrus.FieldLogger
+}
+
+// NewRemoteWriter returns a RemoteWriter for the config.
+func NewRemoteWriter(config RemoteWriteConfig, logger logrus.FieldLogger) (*RemoteWriter, error) {
+	if !strings.HasPrefix(config.URL, "http://") && !strings.HasPrefix(config.URL, "https://") {
+		return nil, errors.Errorf("invalid remote-write URL %q, it must start with http:// or https://", config.URL)
+	}
+	for name := range config.Labels {
+		if name == "__name__" || name == scheduleLabel || name == phaseLabel {
+			return nil, errors.Errorf("remote-write label %q is reserved", name)
+		}
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = defaultRemoteWriteTimeout
+	}
+
+	return &RemoteWriter{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+		logger: logger,
+	}, nil
+}
+
+// SetRemoteWriter enables the push of backup outcomes to a Prometheus remote-write endpoint.
+func (m *ServerMetrics) SetRemoteWriter(w *RemoteWriter) {
+	m.remoteWriter = w
+}
+
+// PushBackupOutcome pushes the outcome of a completed backup to the remote-write endpoint, if
+// one is configured. The push runs in the background and failures are only logged, so that an
+// unavailable endpoint doesn't delay or fail backups.
+func (m *ServerMetrics) PushBackupOutcome(outcome BackupOutcome) {
+	if m.remoteWriter == nil {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), m.remoteWriter.config.Timeout)
+		defer cancel()
+		if err := m.remoteWriter.PushBackupOutcome(ctx, outcome); err != nil {
+			m.remoteWriter.logger.WithError(err).Warn("Failed to push backup outcome to remote-write endpoint")
+		}
+	}()
+}
+
+// PushBackupOutcome sends the samples describing the outcome of a backup.
+func (w *RemoteWriter) PushBackupOutcome(ctx context.Context, outcome BackupOutcome) error {
+	labels := map[string]string{
+		scheduleLabel: outcome.Schedule,
+		phaseLabel:    outcome.Phase,
+	}
+	timestamp := outcome.Completion.UnixMilli()
+
+	var duration float64
+	if !outcome.Start.IsZero() {
+		duration = outcome.Completion.Sub(outcome.Start).Seconds()
+	}
+
+	series := []timeSeries{
+		w.newTimeSeries(backupOutcomeCompletionTimestamp, labels, float64(outcome.Completion.Unix()), timestamp),
+		w.newTimeSeries(backupOutcomeDurationSeconds, labels, duration, timestamp),
+		w.newTimeSeries(backupOutcomeItems, labels, float64(outcome.Items), timestamp),
+		w.newTimeSeries(backupOutcomeErrors, labels, float64(outcome.Errors), timestamp),
+		w.newTimeSeries(backupOutcomeWarnings, labels, float64(outcome.Warnings), timestamp),
+	}
+
+	return w.write(ctx, series)
+}
+
+func (w *RemoteWriter) write(ctx context.Context, series []timeSeries) error {
+	body := s2.EncodeSnappy(nil, encodeWriteRequest(series))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	req.Header.Set("Content-Encoding", "snappy")
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("User-Agent", "velero")
+	req.Header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
+
+	if w.config.BearerTokenFile != "" {
+		token, err := os.ReadFile(w.config.BearerTokenFile)
+		if err != nil {
+			return errors.Wrap(err, "error reading remote-write bearer token file")
+		}
+		req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "error pushing metrics to remote-write endpoint")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return errors.Errorf("remote-write endpoint returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+type label struct {
+	name  string
+	value string
+}
+
+type timeSeries struct {
+	labels    []label
+	value     float64
+	timestamp int64
+}
+
+func (w *RemoteWriter) newTimeSeries(name string, labels map[string]string, value float64, timestamp int64) timeSeries {
+	ts := timeSeries{
+		labels:    []label{{name: "__name__", value: fmt.Sprintf("%s_%s", metricNamespace, name)}},
+		value:     value,
+		timestamp: timestamp,
+	}
+	for n, v := range w.config.Labels {
+		ts.labels = append(ts.labels, label{name: n, value: v})
+	}
+	for n, v := range labels {
+		ts.labels = append(ts.labels, label{name: n, value: v})
+	}
+	// the remote-write protocol requires the labels to be sorted by name
+	sort.Slice(ts.labels, func(i, j int) bool { return ts.labels[i].name < ts.labels[j].name })
+	return ts
+}
+
+// encodeWriteRequest encodes the series as a prometheus.WriteRequest protobuf message:
+//
+//	message WriteRequest { repeated TimeSeries timeseries = 1; }
+//	message TimeSeries { repeated Label labels = 1; repeated Sample samples = 2; }
+//	message Label { string name = 1; string value = 2; }
+//	message Sample { double value = 1; int64 timestamp = 2; }
+func encodeWriteRequest(series []timeSeries) []byte {
+	var req []byte
+	for _, s := range series {
+		var ts []byte
+		for _, l := range s.labels {
+			var lb []byte
+			lb = protowire.AppendTag(lb, 1, protowire.BytesType)
+			lb = protowire.AppendString(lb, l.name)
+			lb = protowire.AppendTag(lb, 2, protowire.BytesType)
+			lb = protowire.AppendString(lb, l.value)
+
+			ts = protowire.AppendTag(ts, 1, protowire.BytesType)
+			ts = protowire.AppendBytes(ts, lb)
+		}
+
+		var sample []byte
+		sample = protowire.AppendTag(sample, 1, protowire.Fixed64Type)
+		sample = protowire.AppendFixed64(sample, math.Float64bits(s.value))
+		sample = protowire.AppendTag(sample, 2, protowire.VarintType)
+		sample = protowire.AppendVarint(sample, uint64(s.timestamp))
+
+		ts = protowire.AppendTag(ts, 2, protowire.BytesType)
+		ts = protowire.AppendBytes(ts, sample)
+
+		req = protowire.AppendTag(req, 1, protowire.BytesType)
+		req = protowire.AppendBytes(req, ts)
+	}
+	return req
+}
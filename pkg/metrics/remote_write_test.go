@@ -0,0 +1,178 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/s2"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// decodedSeries is a time series decoded from a WriteRequest, with labels as name=value pairs.
+type decodedSeries struct {
+	labels    map[string]string
+	value     float64
+	timestamp int64
+}
+
+// decodeFields returns the fields of a protobuf message by field number.
+func decodeFields(t *testing.T, b []byte) map[protowire.Number][]any {
+	t.Helper()
+	fields := map[protowire.Number][]any{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		require.GreaterOrEqual(t, n, 0)
+		b = b[n:]
+		switch typ {
+		case protowire.BytesType:
+			v, n := protowire.ConsumeBytes(b)
+			require.GreaterOrEqual(t, n, 0)
+			fields[num] = append(fields[num], v)
+			b = b[n:]
+		case protowire.Fixed64Type:
+			v, n := protowire.ConsumeFixed64(b)
+			require.GreaterOrEqual(t, n, 0)
+			fields[num] = append(fields[num], v)
+			b = b[n:]
+		case protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			require.GreaterOrEqual(t, n, 0)
+			fields[num] = append(fields[num], v)
+			b = b[n:]
+		default:
+			t.Fatalf("unexpected wire type %v", typ)
+		}
+	}
+	return fields
+}
+
+func decodeWriteRequest(t *testing.T, b []byte) []decodedSeries {
+	t.Helper()
+	var res []decodedSeries
+	for _, ts := range decodeFields(t, b)[1] {
+		tsFields := decodeFields(t, ts.([]byte))
+		series := decodedSeries{labels: map[string]string{}}
+		var lastName string
+		for _, l := range tsFields[1] {
+			lFields := decodeFields(t, l.([]byte))
+			name := string(lFields[1][0].([]byte))
+			assert.Less(t, lastName, name, "labels must be sorted by name")
+			lastName = name
+			series.labels[name] = string(lFields[2][0].([]byte))
+		}
+		require.Len(t, tsFields[2], 1)
+		sFields := decodeFields(t, tsFields[2][0].([]byte))
+		series.value = math.Float64frombits(sFields[1][0].(uint64))
+		series.timestamp = int64(sFields[2][0].(uint64))
+		res = append(res, series)
+	}
+	return res
+}
+
+func TestRemoteWriterPushBackupOutcome(t *testing.T) {
+	var (
+		headers http.Header
+		series  []decodedSeries
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		decoded, err := s2.Decode(nil, body)
+		require.NoError(t, err)
+		series = decodeWriteRequest(t, decoded)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("secret\n"), 0600))
+
+	writer, err := NewRemoteWriter(RemoteWriteConfig{
+		URL:             server.URL,
+		Labels:          map[string]string{"cluster": "prod-eu-1"},
+		BearerTokenFile: tokenFile,
+	}, logrus.New())
+	require.NoError(t, err)
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	completion := start.Add(90 * time.Second)
+	require.NoError(t, writer.PushBackupOutcome(context.Background(), BackupOutcome{
+		Schedule:   "daily",
+		Phase:      "PartiallyFailed",
+		Start:      start,
+		Completion: completion,
+		Items:      120,
+		Errors:     2,
+		Warnings:   3,
+	}))
+
+	assert.Equal(t, "snappy", headers.Get("Content-Encoding"))
+	assert.Equal(t, "application/x-protobuf", headers.Get("Content-Type"))
+	assert.Equal(t, "0.1.0", headers.Get("X-Prometheus-Remote-Write-Version"))
+	assert.Equal(t, "Bearer secret", headers.Get("Authorization"))
+
+	expected := map[string]float64{
+		"velero_backup_outcome_completion_timestamp_seconds": float64(completion.Unix()),
+		"velero_backup_outcome_duration_seconds":             90,
+		"velero_backup_outcome_items":                        120,
+		"velero_backup_outcome_errors":                       2,
+		"velero_backup_outcome_warnings":                     3,
+	}
+	require.Len(t, series, len(expected))
+	for _, s := range series {
+		name := s.labels["__name__"]
+		assert.Equal(t, expected[name], s.value, name)
+		assert.Equal(t, completion.UnixMilli(), s.timestamp, name)
+		assert.Equal(t, map[string]string{
+			"__name__": name,
+			"cluster":  "prod-eu-1",
+			"schedule": "daily",
+			"phase":    "PartiallyFailed",
+		}, s.labels)
+	}
+}
+
+func TestRemoteWriterErrors(t *testing.T) {
+	_, err := NewRemoteWriter(RemoteWriteConfig{URL: "prometheus:9090/api/v1/write"}, logrus.New())
+	require.Error(t, err)
+
+	_, err = NewRemoteWriter(RemoteWriteConfig{URL: "http://prometheus:9090/api/v1/write", Labels: map[string]string{"schedule": "x"}}, logrus.New())
+	require.Error(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "out of order sample", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	writer, err := NewRemoteWriter(RemoteWriteConfig{URL: server.URL}, logrus.New())
+	require.NoError(t, err)
+	err = writer.PushBackupOutcome(context.Background(), BackupOutcome{Completion: time.Now()})
+	require.ErrorContains(t, err, "status 400: out of order sample")
+}
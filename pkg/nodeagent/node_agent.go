@@ -82,6 +82,53 @@ type BackupPVC struct {
 	SPCNoRelabeling bool `json:"spcNoRelabeling,omitempty"`
 }
 
+type DirectSnapshotRead struct {
+	// Driver is the name of the CSI driver whose snapshots could be read directly
+	Driver string `json:"driver"`
+
+	// DevicePath is the template of the block device path through which a snapshot of the driver is readable
+	// from the node, the "{snapshotHandle}" placeholder is replaced with the handle of the snapshot
+	DevicePath string `json:"devicePath"`
+}
+
+// PodVolumeAccess is how fs-backup accesses the data of a pod volume.
+type PodVolumeAccess string
+
+const (
+	// PodVolumeAccessHostPath accesses the data through the host path of the pod volume, under the kubelet directory of the pod
+	PodVolumeAccessHostPath PodVolumeAccess = "hostPath"
+
+	// PodVolumeAccessMountedPVC accesses the data through a dedicated PVC, bound to a copy of the PV of the volume and mounted
+	// by a pod on the node of the pod
+	PodVolumeAccessMountedPVC PodVolumeAccess = "mountedPVC"
+)
+
+type PodVolumePath struct {
+	// Driver is the name of the CSI driver of the volumes the config applies to
+	Driver string `json:"driver,omitempty"`
+
+	// StorageClass is the name of the storage class of the volumes the config applies to, taking precedence over the configs
+	// of the drivers
+	StorageClass string `json:"storageClass,omitempty"`
+
+	// Access is how fs-backup accesses the data of the volumes, either "hostPath" or "mountedPVC"
+	Access PodVolumeAccess `json:"access"`
+
+	// MountOptions are the mount options of the copy of the PV the dedicated PVC is bound to, replacing those of the PV,
+	// ignored unless Access is "mountedPVC"
+	MountOptions []string `json:"mountOptions,omitempty"`
+}
+
+type LoadThrottling struct {
+	// CPUPressureThreshold is the share, in percent, of the last 10 seconds the tasks of the node were stalled
+	// waiting for CPU over which the node is considered under pressure, 0 meaning the CPU pressure isn't checked
+	CPUPressureThreshold float64 `json:"cpuPressureThreshold,omitempty"`
+
+	// IOPressureThreshold is the share, in percent, of the last 10 seconds the tasks of the node were stalled
+	// waiting for IO over which the node is considered under pressure, 0 meaning the IO pressure isn't checked
+	IOPressureThreshold float64 `json:"ioPressureThreshold,omitempty"`
+}
+
 type RestorePVC struct {
 	// IgnoreDelayBinding indicates to ignore delay binding the restorePVC when it is in WaitForFirstConsumer mode
 	IgnoreDelayBinding bool `json:"ignoreDelayBinding,omitempty"`
@@ -102,6 +149,16 @@ type Configs struct {
 
 	// PodResources is the resource config for various types of pods launched by node-agent, i.e., data mover pods.
 	PodResources *kube.PodResources `json:"podResources,omitempty"`
+
+	// DirectSnapshotReads is the config for the CSI drivers whose block snapshots are read directly by node-agent
+	// without the intermediate backupPVC and backupPod
+	DirectSnapshotReads []DirectSnapshotRead `json:"directSnapshotReads,omitempty"`
+
+	// LoadThrottling is the config for lowering the data path load concurrency while the node is under pressure
+	LoadThrottling *LoadThrottling `json:"loadThrottling,omitempty"`
+
+	// PodVolumePaths is the config, per CSI driver or storage class, of how fs-backup accesses the data of the pod volumes
+	PodVolumePaths []PodVolumePath `json:"podVolumePaths,omitempty"`
 }
 
 func IsRunningOnLinux(ctx context.Context, kubeClient kubernetes.Interface, namespace string) error {
@@ -0,0 +1,168 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// BackupArtifactDigests returns the SHA-256 digests of the files of the backup other than
+// its metadata, keyed by the names of the files in the backup's directory. The digests are
+// meant to be recorded in the status of the backup before its metadata is encoded.
+func BackupArtifactDigests(info BackupInfo) (map[string]string, error) {
+	layout := NewObjectStoreLayout("")
+	artifacts := map[string]io.Reader{
+		layout.getBackupContentsKey(info.Name):            info.Contents,
+		layout.getBackupLogKey(info.Name):                 info.Log,
+		layout.getPodVolumeBackupsKey(info.Name):          info.PodVolumeBackups,
+		layout.getBackupVolumeSnapshotsKey(info.Name):     info.VolumeSnapshots,
+		layout.getBackupItemOperationsKey(info.Name):      info.BackupItemOperations,
+		layout.getBackupResourceListKey(info.Name):        info.BackupResourceList,
+		layout.getCSIVolumeSnapshotKey(info.Name):         info.CSIVolumeSnapshots,
+		layout.getCSIVolumeSnapshotContentsKey(info.Name): info.CSIVolumeSnapshotContents,
+		layout.getCSIVolumeSnapshotClassesKey(info.Name):  info.CSIVolumeSnapshotClasses,
+		layout.getBackupResultsKey(info.Name):             info.BackupResults,
+		layout.getBackupVolumeInfoKey(info.Name):          info.BackupVolumeInfo,
+		layout.getBackupSkippedItemsKey(info.Name):        info.SkippedItems,
+	}
+
+	digests := make(map[string]string, len(artifacts))
+	for key, artifact := range artifacts {
+		digest, err := artifactDigest(artifact)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error computing the digest of %s", path.Base(key))
+		}
+		if digest != "" {
+			digests[path.Base(key)] = digest
+		}
+	}
+	return digests, nil
+}
+
+// SetBackupArtifactDigest records the digest of a file of the backup which is uploaded again
+// after the backup was first persisted. Nothing is recorded for the backups created before
+// the digests were introduced.
+func SetBackupArtifactDigest(backup *velerov1api.Backup, kind velerov1api.DownloadTargetKind, artifact io.Reader) error {
+	if backup.Status.ArtifactDigests == nil {
+		return nil
+	}
+
+	layout := NewObjectStoreLayout("")
+	var key string
+	switch kind {
+	case velerov1api.DownloadTargetKindBackupContents:
+		key = layout.getBackupContentsKey(backup.Name)
+	case velerov1api.DownloadTargetKindBackupItemOperations:
+		key = layout.getBackupItemOperationsKey(backup.Name)
+	case velerov1api.DownloadTargetKindBackupVolumeInfos:
+		key = layout.getBackupVolumeInfoKey(backup.Name)
+	default:
+		return errors.Errorf("unsupported backup artifact kind %q", kind)
+	}
+
+	digest, err := artifactDigest(artifact)
+	if err != nil {
+		return errors.Wrapf(err, "error computing the digest of %s", path.Base(key))
+	}
+	if digest == "" {
+		delete(backup.Status.ArtifactDigests, path.Base(key))
+	} else {
+		backup.Status.ArtifactDigests[path.Base(key)] = digest
+	}
+	return nil
+}
+
+// artifactDigest returns the hex-encoded SHA-256 digest of the artifact without consuming it,
+// or an empty string if there's no artifact.
+func artifactDigest(artifact io.Reader) (string, error) {
+	if f, ok := artifact.(*os.File); ok && f == nil {
+		return "", nil
+	}
+
+	hash := sha256.New()
+	switch a := artifact.(type) {
+	case nil:
+		return "", nil
+	case *bytes.Buffer:
+		if a == nil {
+			return "", nil
+		}
+		hash.Write(a.Bytes())
+	case io.ReadSeeker:
+		if err := seekToBeginning(a); err != nil {
+			return "", errors.WithStack(err)
+		}
+		if _, err := io.Copy(hash, a); err != nil {
+			return "", errors.WithStack(err)
+		}
+		if err := seekToBeginning(a); err != nil {
+			return "", errors.WithStack(err)
+		}
+	default:
+		return "", errors.Errorf("unable to compute the digest of a %T", artifact)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// VerifyBackupArtifacts compares the files of the backup in the object store with their
+// digests, and returns a description of each file which is missing or doesn't match. The log
+// and results files aren't reported when missing, since they may expire before the backup.
+func (s *objectBackupStore) VerifyBackupArtifacts(name string, digests map[string]string) ([]string, error) {
+	files := make([]string, 0, len(digests))
+	for file := range digests {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+
+	var mismatches []string
+	for _, file := range files {
+		res, err := tryGet(s.objectStore, s.bucket, path.Join(s.layout.getBackupDir(name), file))
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting %s", file)
+		}
+		if res == nil {
+			if isBackupLogsArtifact(name, file) {
+				// the logs of the backup expired before the backup itself
+				continue
+			}
+			mismatches = append(mismatches, fmt.Sprintf("%s is missing", file))
+			continue
+		}
+
+		hash := sha256.New()
+		_, err = io.Copy(hash, res)
+		res.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading %s", file)
+		}
+		if digest := hex.EncodeToString(hash.Sum(nil)); digest != digests[file] {
+			mismatches = append(mismatches, fmt.Sprintf("%s has digest %s instead of %s", file, digest, digests[file]))
+		}
+	}
+	return mismatches, nil
+}
@@ -0,0 +1,150 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+const (
+	retainedBackupLogsExpirationPrefix = "expires-"
+	retainedBackupLogsExpirationFormat = "20060102150405"
+)
+
+// isBackupLogsArtifact returns whether the file of the backup is its log or results file,
+// which may be deleted before the backup when the logs of its location expire first.
+func isBackupLogsArtifact(name, file string) bool {
+	layout := NewObjectStoreLayout("")
+	return file == path.Base(layout.getBackupLogKey(name)) || file == path.Base(layout.getBackupResultsKey(name))
+}
+
+// DeleteBackupLogs deletes the log and results files of the backup, keeping its other files.
+func (s *objectBackupStore) DeleteBackupLogs(name string) error {
+	var errs []error
+	for _, key := range []string{s.layout.getBackupLogKey(name), s.layout.getBackupResultsKey(name)} {
+		if err := s.objectStore.DeleteObject(s.bucket, key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.WithStack(kerrors.NewAggregate(errs))
+}
+
+// RetainBackupLogs copies the log and results files of the backup to the logs directory, where
+// they're kept until the expiration once the backup is deleted.
+func (s *objectBackupStore) RetainBackupLogs(name string, expiration time.Time) error {
+	// the expiration is saved first so that the logs are never listed without it
+	if err := s.objectStore.PutObject(s.bucket, s.layout.getRetainedBackupLogsExpirationKey(name, expiration), bytes.NewReader(nil)); err != nil {
+		return err
+	}
+
+	artifacts := map[string]string{
+		s.layout.getBackupLogKey(name):     s.layout.getRetainedBackupLogKey(name),
+		s.layout.getBackupResultsKey(name): s.layout.getRetainedBackupResultsKey(name),
+	}
+	for src, dst := range artifacts {
+		obj, err := tryGet(s.objectStore, s.bucket, src)
+		if err != nil {
+			return err
+		}
+		if obj == nil {
+			continue
+		}
+		err = s.objectStore.PutObject(s.bucket, dst, obj)
+		obj.Close()
+		if err != nil {
+			return errors.Wrapf(err, "error copying %s", path.Base(src))
+		}
+	}
+	return nil
+}
+
+// backupLogsDownloadKey returns the key of the log or results file of the backup, else the key of
+// its copy kept in the logs directory once the backup was deleted.
+func (s *objectBackupStore) backupLogsDownloadKey(key, retainedKey string) (string, error) {
+	exists, err := s.objectStore.ObjectExists(s.bucket, key)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	if exists {
+		return key, nil
+	}
+
+	retained, err := s.objectStore.ObjectExists(s.bucket, retainedKey)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	if retained {
+		return retainedKey, nil
+	}
+	return key, nil
+}
+
+// ListRetainedBackupLogs returns the expiration of the logs of the deleted backups kept in the
+// logs directory, keyed by the names of the backups.
+func (s *objectBackupStore) ListRetainedBackupLogs() (map[string]time.Time, error) {
+	dir := s.layout.subdirs["logs"]
+	keys, err := s.objectStore.ListObjects(s.bucket, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	expirations := make(map[string]time.Time)
+	for _, key := range keys {
+		backup, file, ok := strings.Cut(strings.TrimPrefix(key, dir), "/")
+		if !ok {
+			continue
+		}
+		// logs without an expiration aren't kept
+		if _, found := expirations[backup]; !found {
+			expirations[backup] = time.Time{}
+		}
+
+		if !strings.HasPrefix(file, retainedBackupLogsExpirationPrefix) {
+			continue
+		}
+		expiration, err := time.Parse(retainedBackupLogsExpirationFormat, strings.TrimPrefix(file, retainedBackupLogsExpirationPrefix))
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing the expiration of the logs of backup %s", backup)
+		}
+		if expiration.After(expirations[backup]) {
+			expirations[backup] = expiration
+		}
+	}
+	return expirations, nil
+}
+
+// DeleteRetainedBackupLogs deletes the logs of the deleted backup kept in the logs directory.
+func (s *objectBackupStore) DeleteRetainedBackupLogs(name string) error {
+	objects, err := s.objectStore.ListObjects(s.bucket, s.layout.getRetainedBackupLogsDir(name))
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, key := range objects {
+		if err := s.objectStore.DeleteObject(s.bucket, key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.WithStack(kerrors.NewAggregate(errs))
+}
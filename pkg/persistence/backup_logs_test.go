@@ -0,0 +1,83 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestRetainBackupLogs(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "velero")
+	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "velero/backups/backup-1/backup-1-logs.gz", newStringReadSeeker("logs")))
+	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "velero/backups/backup-1/backup-1.tar.gz", newStringReadSeeker("contents")))
+
+	expiration := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	require.NoError(t, harness.RetainBackupLogs("backup-1", expiration))
+	require.NoError(t, harness.DeleteBackup("backup-1"))
+
+	// the missing results aren't copied
+	keys, err := harness.objectStore.ListObjects(harness.bucket, "velero/")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		"velero/logs/backup-1/backup-1-logs.gz",
+		"velero/logs/backup-1/expires-20260102030405",
+	}, keys)
+	rc, err := harness.objectStore.GetObject(harness.bucket, "velero/logs/backup-1/backup-1-logs.gz")
+	require.NoError(t, err)
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "logs", string(data))
+
+	// the kept logs are downloaded from the logs directory once the backup is deleted
+	key, err := harness.getDownloadKey(velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupLog, Name: "backup-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "velero/logs/backup-1/backup-1-logs.gz", key)
+
+	// the logs directory is a valid top-level directory
+	require.NoError(t, harness.IsValid())
+
+	// logs without an expiration have expired
+	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "velero/logs/backup-2/backup-2-logs.gz", newStringReadSeeker("logs")))
+	retained, err := harness.ListRetainedBackupLogs()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]time.Time{"backup-1": expiration, "backup-2": {}}, retained)
+
+	require.NoError(t, harness.DeleteRetainedBackupLogs("backup-1"))
+	retained, err = harness.ListRetainedBackupLogs()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]time.Time{"backup-2": {}}, retained)
+}
+
+func TestDeleteBackupLogs(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+	for _, key := range []string{"backups/backup-1/backup-1-logs.gz", "backups/backup-1/backup-1-results.gz", "backups/backup-1/backup-1.tar.gz"} {
+		require.NoError(t, harness.objectStore.PutObject(harness.bucket, key, newStringReadSeeker("foo")))
+	}
+
+	require.NoError(t, harness.DeleteBackupLogs("backup-1"))
+
+	keys, err := harness.objectStore.ListObjects(harness.bucket, "backups/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backups/backup-1/backup-1.tar.gz"}, keys)
+}
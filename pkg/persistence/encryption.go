@@ -0,0 +1,374 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+const (
+	// encryptionKeySize is the size of the AES-256 keys.
+	encryptionKeySize = 32
+
+	// encryptionSegmentSize is the size of the plaintext segments the objects are encrypted by,
+	// so that they're encrypted and decrypted as they're streamed.
+	encryptionSegmentSize = 64 * 1024
+)
+
+// encryptedObjectMagic starts the encrypted objects, so that the objects written before the
+// encryption of the location was enabled are still read as they are.
+var encryptedObjectMagic = []byte("velero-encrypted-v1\n")
+
+// KeyWrapper wraps and unwraps the data keys encrypting the objects of a backup storage location.
+type KeyWrapper interface {
+	WrapKey(dataKey []byte) ([]byte, error)
+	UnwrapKey(wrappedKey []byte) ([]byte, error)
+}
+
+// newKeyWrapper returns the key wrapper of the key provider of the encryption. The key management
+// services are authenticated by the credentials file of the config of the location when it has one.
+func newKeyWrapper(encryption *velerov1api.Encryption, credentialStore credentials.FileStore, config map[string]string) (KeyWrapper, error) {
+	if encryption.KeyProvider != velerov1api.EncryptionKeyProviderSecret && encryption.KeyID == "" {
+		return nil, errors.Errorf("the %s key provider requires a key ID", encryption.KeyProvider)
+	}
+
+	switch encryption.KeyProvider {
+	case velerov1api.EncryptionKeyProviderSecret:
+		if encryption.Key == nil {
+			return nil, errors.New("the Secret key provider requires a key")
+		}
+		key, err := readSecretKey(credentialStore, encryption.Key, "encryption key")
+		if err != nil {
+			return nil, err
+		}
+		return newSecretKeyWrapper(key)
+	case velerov1api.EncryptionKeyProviderAWSKMS:
+		return newAWSKMSKeyWrapper(encryption.KeyID, config)
+	case velerov1api.EncryptionKeyProviderAzureKeyVault:
+		return newAzureKeyVaultKeyWrapper(encryption.KeyID, config)
+	case velerov1api.EncryptionKeyProviderGCPKMS:
+		return newGCPKMSKeyWrapper(encryption.KeyID, config)
+	default:
+		return nil, errors.Errorf("unsupported encryption key provider %q", encryption.KeyProvider)
+	}
+}
+
+// readSecretKey reads the base64-encoded key held by the key of a Secret.
+func readSecretKey(credentialStore credentials.FileStore, selector *corev1api.SecretKeySelector, name string) ([]byte, error) {
+	keyFile, err := credentialStore.Path(selector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get the %s", name)
+	}
+	data, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read the %s", name)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "the %s isn't base64-encoded", name)
+	}
+	return key, nil
+}
+
+// secretKeyWrapper wraps the data keys with AES-256-GCM using a key stored in a Secret.
+type secretKeyWrapper struct {
+	aead cipher.AEAD
+}
+
+func newSecretKeyWrapper(key []byte) (*secretKeyWrapper, error) {
+	if len(key) != encryptionKeySize {
+		return nil, errors.Errorf("the encryption key is %d bytes long instead of %d", len(key), encryptionKeySize)
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return &secretKeyWrapper{aead: aead}, nil
+}
+
+func (w *secretKeyWrapper) WrapKey(dataKey []byte) ([]byte, error) {
+	nonce := make([]byte, w.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return w.aead.Seal(nonce, nonce, dataKey, nil), nil
+}
+
+func (w *secretKeyWrapper) UnwrapKey(wrappedKey []byte) ([]byte, error) {
+	if len(wrappedKey) < w.aead.NonceSize() {
+		return nil, errors.New("the wrapped data key is truncated")
+	}
+	nonce, sealed := wrappedKey[:w.aead.NonceSize()], wrappedKey[w.aead.NonceSize():]
+	dataKey, err := w.aead.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to unwrap the data key, the encryption key may have changed")
+	}
+	return dataKey, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return aead, nil
+}
+
+// IsEncryptedObject returns whether the object read by the reader is encrypted, without
+// consuming it.
+func IsEncryptedObject(reader *bufio.Reader) bool {
+	magic, _ := reader.Peek(len(encryptedObjectMagic))
+	return bytes.Equal(magic, encryptedObjectMagic)
+}
+
+// isEncryptedArtifact returns whether the object is encrypted when the location is: the backup
+// tarballs, the backup metadata and the logs are, the other files are left for the CLI to read.
+func isEncryptedArtifact(key string) bool {
+	name := path.Base(key)
+	return name == "velero-backup.json" || strings.HasSuffix(name, ".tar.gz") || strings.HasSuffix(name, "-logs.gz")
+}
+
+// encryptingObjectStore encrypts the backup tarballs, the backup metadata and the logs it puts,
+// and decrypts the encrypted objects it gets.
+//
+// An encrypted object is made of encryptedObjectMagic, the length of the wrapped data key on 2
+// bytes, the wrapped data key, and the segments of the object each sealed by the data key. The
+// nonce of a segment is its index, and its additional data tells whether it's the last one, so
+// that the segments can't be reordered and the object can't be truncated.
+type encryptingObjectStore struct {
+	velero.ObjectStore
+	keyWrapper KeyWrapper
+}
+
+func (s *encryptingObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	if !isEncryptedArtifact(key) {
+		return s.ObjectStore.PutObject(bucket, key, body)
+	}
+
+	encrypted, err := s.encrypt(body)
+	if err != nil {
+		return errors.Wrapf(err, "error encrypting object %s", key)
+	}
+	return s.ObjectStore.PutObject(bucket, key, encrypted)
+}
+
+func (s *encryptingObjectStore) GetObject(bucket, key string) (io.ReadCloser, error) {
+	rc, err := s.ObjectStore.GetObject(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := bufio.NewReader(rc)
+	if !IsEncryptedObject(reader) {
+		return &decryptedObject{Reader: reader, Closer: rc}, nil
+	}
+
+	decrypted, err := s.decrypt(reader)
+	if err != nil {
+		rc.Close()
+		return nil, errors.Wrapf(err, "error decrypting object %s", key)
+	}
+	return &decryptedObject{Reader: decrypted, Closer: rc}, nil
+}
+
+func (s *encryptingObjectStore) encrypt(body io.Reader) (io.Reader, error) {
+	dataKey := make([]byte, encryptionKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	wrappedKey, err := s.keyWrapper.WrapKey(dataKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "error wrapping the data key")
+	}
+
+	header := bytes.NewBuffer(append([]byte{}, encryptedObjectMagic...))
+	binary.Write(header, binary.BigEndian, uint16(len(wrappedKey))) //nolint:errcheck // writing to a bytes.Buffer doesn't fail
+	header.Write(wrappedKey)
+
+	return io.MultiReader(header, &segmentReader{
+		source:     body,
+		sourceSize: encryptionSegmentSize,
+		aead:       aead,
+	}), nil
+}
+
+func (s *encryptingObjectStore) decrypt(reader io.Reader) (io.Reader, error) {
+	wrappedKey, err := readWrappedKey(reader)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := s.keyWrapper.UnwrapKey(wrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	return newDecryptingReader(reader, dataKey)
+}
+
+// dataKey returns the data key of the object, nil if the object isn't encrypted.
+func (s *encryptingObjectStore) dataKey(bucket, key string) ([]byte, error) {
+	rc, err := s.ObjectStore.GetObject(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	reader := bufio.NewReader(rc)
+	if !IsEncryptedObject(reader) {
+		return nil, nil
+	}
+	wrappedKey, err := readWrappedKey(reader)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading object %s", key)
+	}
+	dataKey, err := s.keyWrapper.UnwrapKey(wrappedKey)
+	return dataKey, errors.Wrapf(err, "error unwrapping the data key of object %s", key)
+}
+
+// readWrappedKey reads the header of the encrypted object up to its wrapped data key.
+func readWrappedKey(reader io.Reader) ([]byte, error) {
+	header := make([]byte, len(encryptedObjectMagic)+2)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return nil, errors.Wrap(err, "error reading the header")
+	}
+	wrappedKey := make([]byte, binary.BigEndian.Uint16(header[len(encryptedObjectMagic):]))
+	if _, err := io.ReadFull(reader, wrappedKey); err != nil {
+		return nil, errors.Wrap(err, "error reading the wrapped data key")
+	}
+	return wrappedKey, nil
+}
+
+// newDecryptingReader returns the reader of the segments of the encrypted object following its
+// header, opened by its data key.
+func newDecryptingReader(reader io.Reader, dataKey []byte) (io.Reader, error) {
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return &segmentReader{
+		source:     reader,
+		sourceSize: encryptionSegmentSize + aead.Overhead(),
+		aead:       aead,
+		open:       true,
+	}, nil
+}
+
+// NewDecryptingReader returns the reader of the content of the encrypted object read by the
+// reader, decrypted by the data key the object was encrypted with, such as the data key of a
+// DownloadRequest.
+func NewDecryptingReader(reader io.Reader, dataKey []byte) (io.Reader, error) {
+	if _, err := readWrappedKey(reader); err != nil {
+		return nil, err
+	}
+	return newDecryptingReader(reader, dataKey)
+}
+
+// segmentReader seals the segments of its source as they're read, or opens them if open is set.
+type segmentReader struct {
+	source     io.Reader
+	sourceSize int
+	aead       cipher.AEAD
+	open       bool
+
+	// next is read ahead of the current segment, to find out whether it's the last one
+	next  []byte
+	out   []byte
+	index uint64
+	done  bool
+}
+
+func (r *segmentReader) Read(p []byte) (int, error) {
+	for len(r.out) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.nextSegment(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.out)
+	r.out = r.out[n:]
+	return n, nil
+}
+
+func (r *segmentReader) nextSegment() error {
+	// read one byte more than a segment, to find out whether another segment follows
+	buf := make([]byte, r.sourceSize+1)
+	n := copy(buf, r.next)
+	read, err := io.ReadFull(r.source, buf[n:])
+	n += read
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return errors.WithStack(err)
+	}
+
+	segment, last := buf[:n], n <= r.sourceSize
+	if !last {
+		segment, r.next = buf[:r.sourceSize], buf[r.sourceSize:n]
+	} else {
+		r.next = nil
+	}
+
+	nonce := make([]byte, r.aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce[len(nonce)-8:], r.index)
+	additionalData := []byte{0}
+	if last {
+		additionalData[0] = 1
+	}
+
+	if r.open {
+		out, err := r.aead.Open(nil, nonce, segment, additionalData)
+		if err != nil {
+			return errors.Wrap(err, "the encrypted object is corrupted or truncated")
+		}
+		r.out = out
+	} else {
+		r.out = r.aead.Seal(nil, nonce, segment, additionalData)
+	}
+	r.index++
+	r.done = last
+	return nil
+}
+
+// decryptedObject reads the decrypted content of an object and closes the object.
+type decryptedObject struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,340 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/pkg/errors"
+	cloudkms "google.golang.org/api/cloudkms/v1"
+	"google.golang.org/api/option"
+
+	"github.com/vmware-tanzu/velero/pkg/util/azure"
+)
+
+// kmsRequestTimeout is how long a request to a key management service may take.
+const kmsRequestTimeout = time.Minute
+
+// credentialsFileConfigKey is the key of the object store config holding the credentials file
+// of the location, also used to authenticate to the key management services.
+const credentialsFileConfigKey = "credentialsFile"
+
+// postJSON posts the JSON-encoded request to the URL once prepared by prepare, and decodes the
+// JSON response into response.
+func postJSON(client *http.Client, rawURL string, request, response any, prepare func(*http.Request, []byte) error) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), kmsRequestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rawURL, bytes.NewReader(body))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := prepare(req, body); err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("request to %s failed with status %d: %s", req.URL.Host, resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	return errors.Wrap(json.Unmarshal(respBody, response), "error decoding the response")
+}
+
+// awsKMSKeyWrapper wraps the data keys with an AWS KMS key, with the Encrypt and Decrypt actions
+// of the AWS KMS API.
+type awsKMSKeyWrapper struct {
+	keyID       string
+	region      string
+	endpoint    string
+	credentials aws.CredentialsProvider
+	signer      *v4.Signer
+	client      *http.Client
+}
+
+// newAWSKMSKeyWrapper returns the wrapper of the AWS KMS key, authenticated by the credentials
+// file of the location or the default credentials of the Velero server.
+func newAWSKMSKeyWrapper(keyID string, config map[string]string) (*awsKMSKeyWrapper, error) {
+	var opts []func(*awsconfig.LoadOptions) error
+	credentialsFile := config[credentialsFileConfigKey]
+	if credentialsFile == "" {
+		credentialsFile = os.Getenv("AWS_SHARED_CREDENTIALS_FILE")
+	}
+	if credentialsFile != "" {
+		opts = append(opts, awsconfig.WithSharedCredentialsFiles([]string{credentialsFile}),
+			awsconfig.WithSharedConfigFiles([]string{credentialsFile}))
+	}
+	if profile := config["profile"]; profile != "" {
+		opts = append(opts, awsconfig.WithSharedConfigProfile(profile))
+	}
+	// the region of a key ARN prevails over the region of the location
+	if parsed, err := arn.Parse(keyID); err == nil {
+		opts = append(opts, awsconfig.WithRegion(parsed.Region))
+	} else if region := config["region"]; region != "" {
+		opts = append(opts, awsconfig.WithRegion(region))
+	}
+
+	cfg, err := awsconfig.LoadDefaultConfig(context.Background(), opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "error loading the AWS config")
+	}
+	if cfg.Region == "" {
+		return nil, errors.New("the region of the AWS KMS key is unknown, use the ARN of the key")
+	}
+
+	return &awsKMSKeyWrapper{
+		keyID:       keyID,
+		region:      cfg.Region,
+		endpoint:    fmt.Sprintf("https://kms.%s.amazonaws.com/", cfg.Region),
+		credentials: cfg.Credentials,
+		signer:      v4.NewSigner(),
+		client:      &http.Client{},
+	}, nil
+}
+
+func (w *awsKMSKeyWrapper) WrapKey(dataKey []byte) ([]byte, error) {
+	var response struct {
+		CiphertextBlob []byte
+	}
+	if err := w.call("Encrypt", map[string]any{"KeyId": w.keyID, "Plaintext": dataKey}, &response); err != nil {
+		return nil, errors.Wrap(err, "error encrypting the data key with AWS KMS")
+	}
+	return response.CiphertextBlob, nil
+}
+
+func (w *awsKMSKeyWrapper) UnwrapKey(wrappedKey []byte) ([]byte, error) {
+	var response struct {
+		Plaintext []byte
+	}
+	if err := w.call("Decrypt", map[string]any{"KeyId": w.keyID, "CiphertextBlob": wrappedKey}, &response); err != nil {
+		return nil, errors.Wrap(err, "unable to unwrap the data key with AWS KMS")
+	}
+	return response.Plaintext, nil
+}
+
+// call calls the action of the AWS KMS API, signing the request with Signature Version 4.
+func (w *awsKMSKeyWrapper) call(action string, request, response any) error {
+	return postJSON(w.client, w.endpoint, request, response, func(req *http.Request, body []byte) error {
+		req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+		req.Header.Set("X-Amz-Target", "TrentService."+action)
+
+		credentials, err := w.credentials.Retrieve(req.Context())
+		if err != nil {
+			return errors.Wrap(err, "error getting the AWS credentials")
+		}
+		payloadHash := sha256.Sum256(body)
+		return errors.WithStack(w.signer.SignHTTP(req.Context(), credentials, req, hex.EncodeToString(payloadHash[:]), "kms", w.region, time.Now()))
+	})
+}
+
+// azureKeyVaultKeyWrapper wraps the data keys with RSA-OAEP-256 by an RSA key of Azure Key Vault,
+// with its wrapkey and unwrapkey operations.
+//
+// The wrapped key starts with the length of the identifier of the version of the key which wrapped
+// it on 2 bytes, followed by the identifier, so that the key can be rotated.
+type azureKeyVaultKeyWrapper struct {
+	// keyURL is the URL of the key, without its version if it's not pinned
+	keyURL string
+	// unversionedKeyURL is the URL of the key without its version, which prefixes the identifiers
+	// of all its versions
+	unversionedKeyURL string
+	scope             string
+	credential        azcore.TokenCredential
+	client            *http.Client
+}
+
+// azureKeyVaultAPIVersion is the version of the Azure Key Vault API the keys are called with.
+const azureKeyVaultAPIVersion = "7.4"
+
+// newAzureKeyVaultKeyWrapper returns the wrapper of the Key Vault key, authenticated by the
+// credentials file of the location or the identity of the Velero server.
+func newAzureKeyVaultKeyWrapper(keyURL string, config map[string]string) (*azureKeyVaultKeyWrapper, error) {
+	parsed, err := url.Parse(keyURL)
+	if err != nil || parsed.Scheme != "https" {
+		return nil, errors.Errorf("the Azure Key Vault key %q isn't a key URL like https://<vault>.vault.azure.net/keys/<name>", keyURL)
+	}
+	segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	if len(segments) < 2 || len(segments) > 3 || segments[0] != "keys" {
+		return nil, errors.Errorf("the Azure Key Vault key %q isn't a key URL like https://<vault>.vault.azure.net/keys/<name>", keyURL)
+	}
+	// the scope of the tokens is the domain of the vaults of the cloud, like https://vault.azure.net
+	_, domain, found := strings.Cut(parsed.Host, ".")
+	if !found {
+		return nil, errors.Errorf("the Azure Key Vault key %q isn't a key URL like https://<vault>.vault.azure.net/keys/<name>", keyURL)
+	}
+
+	creds, err := azure.LoadCredentials(config)
+	if err != nil {
+		return nil, err
+	}
+	options, err := azure.GetClientOptions(config, creds)
+	if err != nil {
+		return nil, err
+	}
+	credential, err := azure.NewCredential(config, creds, options)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting the Azure credential")
+	}
+
+	return &azureKeyVaultKeyWrapper{
+		keyURL:            strings.TrimSuffix(keyURL, "/"),
+		unversionedKeyURL: "https://" + parsed.Host + "/keys/" + segments[1],
+		scope:             "https://" + domain + "/.default",
+		credential:        credential,
+		client:            &http.Client{},
+	}, nil
+}
+
+func (w *azureKeyVaultKeyWrapper) WrapKey(dataKey []byte) ([]byte, error) {
+	var response struct {
+		KeyID string `json:"kid"`
+		Value string `json:"value"`
+	}
+	if err := w.call(w.keyURL+"/wrapkey", dataKey, &response); err != nil {
+		return nil, errors.Wrap(err, "error wrapping the data key with Azure Key Vault")
+	}
+	value, err := base64.RawURLEncoding.DecodeString(response.Value)
+	if err != nil {
+		return nil, errors.Wrap(err, "error decoding the data key wrapped by Azure Key Vault")
+	}
+
+	wrappedKey := binary.BigEndian.AppendUint16(nil, uint16(len(response.KeyID)))
+	wrappedKey = append(wrappedKey, response.KeyID...)
+	return append(wrappedKey, value...), nil
+}
+
+func (w *azureKeyVaultKeyWrapper) UnwrapKey(wrappedKey []byte) ([]byte, error) {
+	if len(wrappedKey) < 2 || len(wrappedKey) < 2+int(binary.BigEndian.Uint16(wrappedKey)) {
+		return nil, errors.New("the wrapped data key is truncated")
+	}
+	keyIDLength := int(binary.BigEndian.Uint16(wrappedKey))
+	keyID, value := string(wrappedKey[2:2+keyIDLength]), wrappedKey[2+keyIDLength:]
+
+	// the token must only be sent to the configured key, whichever version wrapped the data key
+	if !strings.HasPrefix(keyID, w.unversionedKeyURL+"/") {
+		return nil, errors.Errorf("the data key was wrapped by the Azure Key Vault key %q instead of %q, the encryption key may have changed", keyID, w.unversionedKeyURL)
+	}
+
+	var response struct {
+		Value string `json:"value"`
+	}
+	if err := w.call(keyID+"/unwrapkey", value, &response); err != nil {
+		return nil, errors.Wrap(err, "unable to unwrap the data key with Azure Key Vault")
+	}
+	dataKey, err := base64.RawURLEncoding.DecodeString(response.Value)
+	if err != nil {
+		return nil, errors.Wrap(err, "error decoding the data key unwrapped by Azure Key Vault")
+	}
+	return dataKey, nil
+}
+
+// call calls the operation of the key with the value, authenticated by a bearer token.
+func (w *azureKeyVaultKeyWrapper) call(operationURL string, value []byte, response any) error {
+	request := map[string]string{
+		"alg":   "RSA-OAEP-256",
+		"value": base64.RawURLEncoding.EncodeToString(value),
+	}
+	return postJSON(w.client, operationURL+"?api-version="+azureKeyVaultAPIVersion, request, response, func(req *http.Request, _ []byte) error {
+		token, err := w.credential.GetToken(req.Context(), policy.TokenRequestOptions{Scopes: []string{w.scope}})
+		if err != nil {
+			return errors.Wrap(err, "error getting the Azure token")
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token.Token)
+		return nil
+	})
+}
+
+// gcpKMSKeyWrapper wraps the data keys with a GCP KMS symmetric crypto key.
+type gcpKMSKeyWrapper struct {
+	keyName string
+	service *cloudkms.Service
+}
+
+// newGCPKMSKeyWrapper returns the wrapper of the crypto key, authenticated by the credentials
+// file of the location or the default credentials of the Velero server.
+func newGCPKMSKeyWrapper(keyName string, config map[string]string, opts ...option.ClientOption) (*gcpKMSKeyWrapper, error) {
+	if !strings.HasPrefix(keyName, "projects/") || !strings.Contains(keyName, "/cryptoKeys/") {
+		return nil, errors.Errorf("the GCP KMS key %q isn't a crypto key resource name like projects/<project>/locations/<location>/keyRings/<key ring>/cryptoKeys/<key>", keyName)
+	}
+	if credentialsFile := config[credentialsFileConfigKey]; credentialsFile != "" {
+		opts = append(opts, option.WithCredentialsFile(credentialsFile))
+	}
+
+	service, err := cloudkms.NewService(context.Background(), opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating the GCP KMS client")
+	}
+	return &gcpKMSKeyWrapper{keyName: keyName, service: service}, nil
+}
+
+func (w *gcpKMSKeyWrapper) WrapKey(dataKey []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), kmsRequestTimeout)
+	defer cancel()
+	response, err := w.service.Projects.Locations.KeyRings.CryptoKeys.Encrypt(w.keyName, &cloudkms.EncryptRequest{
+		Plaintext: base64.StdEncoding.EncodeToString(dataKey),
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrap(err, "error wrapping the data key with GCP KMS")
+	}
+	wrappedKey, err := base64.StdEncoding.DecodeString(response.Ciphertext)
+	return wrappedKey, errors.Wrap(err, "error decoding the data key wrapped by GCP KMS")
+}
+
+func (w *gcpKMSKeyWrapper) UnwrapKey(wrappedKey []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), kmsRequestTimeout)
+	defer cancel()
+	response, err := w.service.Projects.Locations.KeyRings.CryptoKeys.Decrypt(w.keyName, &cloudkms.DecryptRequest{
+		Ciphertext: base64.StdEncoding.EncodeToString(wrappedKey),
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to unwrap the data key with GCP KMS")
+	}
+	dataKey, err := base64.StdEncoding.DecodeString(response.Plaintext)
+	return dataKey, errors.Wrap(err, "error decoding the data key unwrapped by GCP KMS")
+}
@@ -0,0 +1,370 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awscredentials "github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func newTestKeyWrapper(t *testing.T, b byte) KeyWrapper {
+	t.Helper()
+	keyWrapper, err := newSecretKeyWrapper(bytes.Repeat([]byte{b}, encryptionKeySize))
+	require.NoError(t, err)
+	return keyWrapper
+}
+
+func TestEncryptingObjectStore(t *testing.T) {
+	tests := []struct {
+		name string
+		size int
+	}{
+		{name: "empty object", size: 0},
+		{name: "single segment", size: 100},
+		{name: "exactly one segment", size: encryptionSegmentSize},
+		{name: "several segments", size: 2*encryptionSegmentSize + 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			objectStore := newInMemoryObjectStore("bucket")
+			store := &encryptingObjectStore{ObjectStore: objectStore, keyWrapper: newTestKeyWrapper(t, 1)}
+			content := bytes.Repeat([]byte("0123456789"), tc.size/10+1)[:tc.size]
+
+			require.NoError(t, store.PutObject("bucket", "backups/b/b.tar.gz", bytes.NewReader(content)))
+			stored := objectStore.Data["bucket"]["backups/b/b.tar.gz"]
+			assert.True(t, bytes.HasPrefix(stored, encryptedObjectMagic))
+			if tc.size > 0 {
+				assert.NotContains(t, string(stored), "0123456789")
+			}
+
+			rc, err := store.GetObject("bucket", "backups/b/b.tar.gz")
+			require.NoError(t, err)
+			defer rc.Close()
+			decrypted, err := io.ReadAll(rc)
+			require.NoError(t, err)
+			assert.Equal(t, content, decrypted)
+		})
+	}
+}
+
+func TestEncryptingObjectStoreArtifacts(t *testing.T) {
+	objectStore := newInMemoryObjectStore("bucket")
+	store := &encryptingObjectStore{ObjectStore: objectStore, keyWrapper: newTestKeyWrapper(t, 1)}
+
+	for _, key := range []string{"backups/b/velero-backup.json", "backups/b/b-logs.gz", "restores/r/restore-r-logs.gz", "backups/b/b-checkpoint-1.tar.gz"} {
+		require.NoError(t, store.PutObject("bucket", key, strings.NewReader("contents")))
+		assert.True(t, bytes.HasPrefix(objectStore.Data["bucket"][key], encryptedObjectMagic), key)
+	}
+	// the other files are left for the CLI to read
+	require.NoError(t, store.PutObject("bucket", "backups/b/b-results.gz", strings.NewReader("contents")))
+	assert.Equal(t, []byte("contents"), objectStore.Data["bucket"]["backups/b/b-results.gz"])
+
+	// the objects written before the encryption was enabled are read as they are
+	require.NoError(t, objectStore.PutObject("bucket", "backups/a/a.tar.gz", strings.NewReader("plain")))
+	rc, err := store.GetObject("bucket", "backups/a/a.tar.gz")
+	require.NoError(t, err)
+	plain, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("plain"), plain)
+}
+
+func TestEncryptingObjectStoreTampering(t *testing.T) {
+	objectStore := newInMemoryObjectStore("bucket")
+	store := &encryptingObjectStore{ObjectStore: objectStore, keyWrapper: newTestKeyWrapper(t, 1)}
+	content := bytes.Repeat([]byte{'x'}, 2*encryptionSegmentSize)
+	require.NoError(t, store.PutObject("bucket", "backups/b/b.tar.gz", bytes.NewReader(content)))
+	stored := objectStore.Data["bucket"]["backups/b/b.tar.gz"]
+
+	read := func(store *encryptingObjectStore) error {
+		rc, err := store.GetObject("bucket", "backups/b/b.tar.gz")
+		if err != nil {
+			return err
+		}
+		defer rc.Close()
+		_, err = io.ReadAll(rc)
+		return err
+	}
+
+	// truncated after the first segment
+	objectStore.Data["bucket"]["backups/b/b.tar.gz"] = stored[:len(stored)-encryptionSegmentSize-16]
+	assert.ErrorContains(t, read(store), "corrupted or truncated")
+
+	// corrupted
+	corrupted := bytes.Clone(stored)
+	corrupted[len(corrupted)-1] ^= 0xff
+	objectStore.Data["bucket"]["backups/b/b.tar.gz"] = corrupted
+	assert.ErrorContains(t, read(store), "corrupted or truncated")
+
+	// encrypted by another key
+	objectStore.Data["bucket"]["backups/b/b.tar.gz"] = stored
+	assert.ErrorContains(t, read(&encryptingObjectStore{ObjectStore: objectStore, keyWrapper: newTestKeyWrapper(t, 2)}), "unable to unwrap the data key")
+}
+
+func TestEncryptionLocation(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, encryptionKeySize))+"\n"), 0600))
+
+	objectStore := newInMemoryObjectStore("bucket")
+	location := builder.ForBackupStorageLocation("velero", "default").Provider("provider-1").Bucket("bucket").
+		Encryption(&velerov1api.Encryption{KeyProvider: velerov1api.EncryptionKeyProviderSecret, Key: builder.ForSecretKeySelector("encryption", "key").Result()}).Result()
+
+	store, err := NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore(keyFile, nil)).Get(location, objectStoreGetter{"provider-1": objectStore}, velerotest.NewLogger())
+	require.NoError(t, err)
+	assert.IsType(t, &encryptingObjectStore{}, store.(*objectBackupStore).objectStore)
+
+	// the key must be 256-bit long
+	require.NoError(t, os.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString([]byte("short"))), 0600))
+	_, err = NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore(keyFile, nil)).Get(location, objectStoreGetter{"provider-1": objectStore}, velerotest.NewLogger())
+	assert.ErrorContains(t, err, "the encryption key is 5 bytes long instead of 32")
+
+	location.Spec.Encryption.Key = nil
+	_, err = NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore(keyFile, nil)).Get(location, objectStoreGetter{"provider-1": objectStore}, velerotest.NewLogger())
+	assert.ErrorContains(t, err, "the Secret key provider requires a key")
+}
+
+func TestGetDownloadDataKey(t *testing.T) {
+	objectStore := newInMemoryObjectStore("bucket")
+	encrypting := &encryptingObjectStore{ObjectStore: objectStore, keyWrapper: newTestKeyWrapper(t, 1)}
+	store := &objectBackupStore{objectStore: encrypting, encrypting: encrypting, bucket: "bucket", layout: NewObjectStoreLayout("")}
+
+	content := bytes.Repeat([]byte("log line\n"), encryptionSegmentSize/4)
+	require.NoError(t, store.PutBackupContents("b", bytes.NewReader(content)))
+	target := velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupContents, Name: "b"}
+
+	// the data key decrypts the object as it's downloaded
+	dataKey, err := store.GetDownloadDataKey(target)
+	require.NoError(t, err)
+	require.Len(t, dataKey, encryptionKeySize)
+	decrypting, err := NewDecryptingReader(bytes.NewReader(objectStore.Data["bucket"]["backups/b/b.tar.gz"]), dataKey)
+	require.NoError(t, err)
+	decrypted, err := io.ReadAll(decrypting)
+	require.NoError(t, err)
+	assert.Equal(t, content, decrypted)
+
+	// the objects which aren't encrypted have no data key
+	require.NoError(t, objectStore.PutObject("bucket", "backups/a/a.tar.gz", strings.NewReader("plain")))
+	dataKey, err = store.GetDownloadDataKey(velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupContents, Name: "a"})
+	require.NoError(t, err)
+	assert.Nil(t, dataKey)
+
+	dataKey, err = store.GetDownloadDataKey(velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupResourceList, Name: "b"})
+	require.NoError(t, err)
+	assert.Nil(t, dataKey)
+
+	// nor do the objects of the locations which aren't encrypted
+	dataKey, err = (&objectBackupStore{objectStore: objectStore, bucket: "bucket", layout: NewObjectStoreLayout("")}).GetDownloadDataKey(target)
+	require.NoError(t, err)
+	assert.Nil(t, dataKey)
+}
+
+func TestKeyWrapperRequiresKeyID(t *testing.T) {
+	for _, provider := range []velerov1api.EncryptionKeyProvider{velerov1api.EncryptionKeyProviderAWSKMS, velerov1api.EncryptionKeyProviderAzureKeyVault, velerov1api.EncryptionKeyProviderGCPKMS} {
+		_, err := newKeyWrapper(&velerov1api.Encryption{KeyProvider: provider}, nil, nil)
+		assert.ErrorContains(t, err, "requires a key ID", provider)
+	}
+}
+
+// reversed returns the bytes in the reverse order, standing for the encryption of the fake key
+// management services.
+func reversed(b []byte) []byte {
+	r := bytes.Clone(b)
+	slices.Reverse(r)
+	return r
+}
+
+func TestAWSKMSKeyWrapper(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access-key/"))
+		assert.Contains(t, r.Header.Get("Authorization"), "/us-west-2/kms/aws4_request")
+		assert.Equal(t, "application/x-amz-json-1.1", r.Header.Get("Content-Type"))
+
+		var request struct {
+			KeyID          string `json:"KeyId"`
+			Plaintext      []byte
+			CiphertextBlob []byte
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		if request.KeyID != "arn:aws:kms:us-west-2:111122223333:key/a-key" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"__type":"NotFoundException"}`))
+			return
+		}
+		switch r.Header.Get("X-Amz-Target") {
+		case "TrentService.Encrypt":
+			json.NewEncoder(w).Encode(map[string]any{"CiphertextBlob": reversed(request.Plaintext)})
+		case "TrentService.Decrypt":
+			json.NewEncoder(w).Encode(map[string]any{"Plaintext": reversed(request.CiphertextBlob)})
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	newWrapper := func(keyID string) *awsKMSKeyWrapper {
+		return &awsKMSKeyWrapper{
+			keyID:       keyID,
+			region:      "us-west-2",
+			endpoint:    server.URL,
+			credentials: awscredentials.NewStaticCredentialsProvider("access-key", "secret-key", ""),
+			signer:      v4.NewSigner(),
+			client:      server.Client(),
+		}
+	}
+	wrapper := newWrapper("arn:aws:kms:us-west-2:111122223333:key/a-key")
+
+	wrappedKey, err := wrapper.WrapKey([]byte("data-key"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("yek-atad"), wrappedKey)
+	dataKey, err := wrapper.UnwrapKey(wrappedKey)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data-key"), dataKey)
+
+	_, err = newWrapper("arn:aws:kms:us-west-2:111122223333:key/another-key").UnwrapKey(wrappedKey)
+	assert.ErrorContains(t, err, "NotFoundException")
+}
+
+type fakeTokenCredential struct{}
+
+func (fakeTokenCredential) GetToken(_ context.Context, options policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: strings.Join(options.Scopes, ","), ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+func TestAzureKeyVaultKeyWrapper(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer https://vault.azure.net/.default", r.Header.Get("Authorization"))
+		assert.Equal(t, azureKeyVaultAPIVersion, r.URL.Query().Get("api-version"))
+
+		var request struct {
+			Alg   string `json:"alg"`
+			Value string `json:"value"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		assert.Equal(t, "RSA-OAEP-256", request.Alg)
+		value, err := base64.RawURLEncoding.DecodeString(request.Value)
+		require.NoError(t, err)
+
+		switch r.URL.Path {
+		case "/keys/a-key/wrapkey":
+			// the version of the key wrapping the data keys is returned along with them
+			json.NewEncoder(w).Encode(map[string]string{"kid": "https://" + r.Host + "/keys/a-key/v1", "value": base64.RawURLEncoding.EncodeToString(reversed(value))})
+		case "/keys/a-key/v1/unwrapkey":
+			json.NewEncoder(w).Encode(map[string]string{"kid": "https://" + r.Host + "/keys/a-key/v1", "value": base64.RawURLEncoding.EncodeToString(reversed(value))})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	newWrapper := func(key string) *azureKeyVaultKeyWrapper {
+		return &azureKeyVaultKeyWrapper{
+			keyURL:            server.URL + "/keys/" + key,
+			unversionedKeyURL: server.URL + "/keys/" + key,
+			scope:             "https://vault.azure.net/.default",
+			credential:        fakeTokenCredential{},
+			client:            server.Client(),
+		}
+	}
+	wrapper := newWrapper("a-key")
+
+	wrappedKey, err := wrapper.WrapKey([]byte("data-key"))
+	require.NoError(t, err)
+	dataKey, err := wrapper.UnwrapKey(wrappedKey)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data-key"), dataKey)
+
+	// the tokens aren't sent to another key
+	_, err = newWrapper("another-key").UnwrapKey(wrappedKey)
+	assert.ErrorContains(t, err, "the encryption key may have changed")
+
+	_, err = wrapper.UnwrapKey(wrappedKey[:10])
+	assert.ErrorContains(t, err, "the wrapped data key is truncated")
+}
+
+func TestNewAzureKeyVaultKeyWrapper(t *testing.T) {
+	t.Setenv("AZURE_CREDENTIALS_FILE", "")
+	t.Setenv("AZURE_FEDERATED_TOKEN_FILE", "")
+
+	wrapper, err := newAzureKeyVaultKeyWrapper("https://a-vault.vault.azure.cn/keys/a-key/v1", map[string]string{})
+	require.NoError(t, err)
+	assert.Equal(t, "https://a-vault.vault.azure.cn/keys/a-key", wrapper.unversionedKeyURL)
+	assert.Equal(t, "https://vault.azure.cn/.default", wrapper.scope)
+
+	_, err = newAzureKeyVaultKeyWrapper("https://a-vault.vault.azure.net/secrets/a-secret", map[string]string{})
+	assert.ErrorContains(t, err, "isn't a key URL")
+}
+
+func TestGCPKMSKeyWrapper(t *testing.T) {
+	const keyName = "projects/a-project/locations/global/keyRings/a-key-ring/cryptoKeys/a-key"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Plaintext  string `json:"plaintext"`
+			Ciphertext string `json:"ciphertext"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		switch r.URL.Path {
+		case "/v1/" + keyName + ":encrypt":
+			plaintext, err := base64.StdEncoding.DecodeString(request.Plaintext)
+			require.NoError(t, err)
+			json.NewEncoder(w).Encode(map[string]string{"ciphertext": base64.StdEncoding.EncodeToString(reversed(plaintext))})
+		case "/v1/" + keyName + ":decrypt":
+			ciphertext, err := base64.StdEncoding.DecodeString(request.Ciphertext)
+			require.NoError(t, err)
+			json.NewEncoder(w).Encode(map[string]string{"plaintext": base64.StdEncoding.EncodeToString(reversed(ciphertext))})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	wrapper, err := newGCPKMSKeyWrapper(keyName, nil, option.WithEndpoint(server.URL+"/"), option.WithoutAuthentication())
+	require.NoError(t, err)
+
+	wrappedKey, err := wrapper.WrapKey([]byte("data-key"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("yek-atad"), wrappedKey)
+	dataKey, err := wrapper.UnwrapKey(wrappedKey)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data-key"), dataKey)
+
+	_, err = newGCPKMSKeyWrapper("a-key", nil)
+	assert.ErrorContains(t, err, "isn't a crypto key resource name")
+}
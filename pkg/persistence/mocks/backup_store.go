@@ -27,6 +27,8 @@ import (
 
 	results "github.com/vmware-tanzu/velero/pkg/util/results"
 
+	time "time"
+
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 
 	volume "github.com/vmware-tanzu/velero/internal/volume"
@@ -85,6 +87,42 @@ func (_m *BackupStore) DeleteBackup(name string) error {
 	return r0
 }
 
+// DeleteBackupCheckpoint provides a mock function with given fields: name
+func (_m *BackupStore) DeleteBackupCheckpoint(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBackupCheckpoint")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteBackupLogs provides a mock function with given fields: name
+func (_m *BackupStore) DeleteBackupLogs(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBackupLogs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteRestore provides a mock function with given fields: name
 func (_m *BackupStore) DeleteRestore(name string) error {
 	ret := _m.Called(name)
@@ -103,6 +141,84 @@ func (_m *BackupStore) DeleteRestore(name string) error {
 	return r0
 }
 
+// DeleteRetainedBackupLogs provides a mock function with given fields: name
+func (_m *BackupStore) DeleteRetainedBackupLogs(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRetainedBackupLogs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetBackupCheckpoint provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupCheckpoint(name string) (io.ReadCloser, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBackupCheckpoint")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (io.ReadCloser, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) io.ReadCloser); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBackupCheckpointContents provides a mock function with given fields: name, segment
+func (_m *BackupStore) GetBackupCheckpointContents(name string, segment int) (io.ReadCloser, error) {
+	ret := _m.Called(name, segment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBackupCheckpointContents")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) (io.ReadCloser, error)); ok {
+		return rf(name, segment)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) io.ReadCloser); ok {
+		r0 = rf(name, segment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(name, segment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBackupContents provides a mock function with given fields: name
 func (_m *BackupStore) GetBackupContents(name string) (io.ReadCloser, error) {
 	ret := _m.Called(name)
@@ -343,6 +459,36 @@ func (_m *BackupStore) GetCSIVolumeSnapshots(name string) ([]*volumesnapshotv1.V
 	return r0, r1
 }
 
+// GetDownloadDataKey provides a mock function with given fields: target
+func (_m *BackupStore) GetDownloadDataKey(target v1.DownloadTarget) ([]byte, error) {
+	ret := _m.Called(target)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDownloadDataKey")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(v1.DownloadTarget) ([]byte, error)); ok {
+		return rf(target)
+	}
+	if rf, ok := ret.Get(0).(func(v1.DownloadTarget) []byte); ok {
+		r0 = rf(target)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(v1.DownloadTarget) error); ok {
+		r1 = rf(target)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDownloadURL provides a mock function with given fields: target
 func (_m *BackupStore) GetDownloadURL(target v1.DownloadTarget) (string, error) {
 	ret := _m.Called(target)
@@ -461,6 +607,36 @@ func (_m *BackupStore) GetRestoreResults(name string) (map[string]results.Result
 	return r0, r1
 }
 
+// GetRestoreMappingReport provides a mock function with given fields: name
+func (_m *BackupStore) GetRestoreMappingReport(name string) (*v1.RestoreMappingReport, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRestoreMappingReport")
+	}
+
+	var r0 *v1.RestoreMappingReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*v1.RestoreMappingReport, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) *v1.RestoreMappingReport); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.RestoreMappingReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRestoredResourceList provides a mock function with given fields: name
 func (_m *BackupStore) GetRestoredResourceList(name string) (map[string][]string, error) {
 	ret := _m.Called(name)
@@ -539,6 +715,64 @@ func (_m *BackupStore) ListBackups() ([]string, error) {
 	return r0, r1
 }
 
+// ListRetainedBackupLogs provides a mock function with given fields:
+func (_m *BackupStore) ListRetainedBackupLogs() (map[string]time.Time, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRetainedBackupLogs")
+	}
+
+	var r0 map[string]time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (map[string]time.Time, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() map[string]time.Time); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]time.Time)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MigrateLayout provides a mock function with given fields:
+func (_m *BackupStore) MigrateLayout() (int, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MigrateLayout")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PutBackup provides a mock function with given fields: info
 func (_m *BackupStore) PutBackup(info persistence.BackupInfo) error {
 	ret := _m.Called(info)
@@ -557,6 +791,24 @@ func (_m *BackupStore) PutBackup(info persistence.BackupInfo) error {
 	return r0
 }
 
+// PutBackupCheckpoint provides a mock function with given fields: backup, segment, contents, checkpoint
+func (_m *BackupStore) PutBackupCheckpoint(backup string, segment int, contents io.Reader, checkpoint io.Reader) error {
+	ret := _m.Called(backup, segment, contents, checkpoint)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutBackupCheckpoint")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int, io.Reader, io.Reader) error); ok {
+		r0 = rf(backup, segment, contents, checkpoint)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutBackupContents provides a mock function with given fields: backup, backupContents
 func (_m *BackupStore) PutBackupContents(backup string, backupContents io.Reader) error {
 	ret := _m.Called(backup, backupContents)
@@ -629,6 +881,60 @@ func (_m *BackupStore) PutBackupVolumeInfos(name string, volumeInfo io.Reader) e
 	return r0
 }
 
+// PutRestoreDriftReport provides a mock function with given fields: restore, report
+func (_m *BackupStore) PutRestoreDriftReport(restore string, report io.Reader) error {
+	ret := _m.Called(restore, report)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutRestoreDriftReport")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(restore, report)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutRestorePreviewReport provides a mock function with given fields: restore, report
+func (_m *BackupStore) PutRestorePreviewReport(restore string, report io.Reader) error {
+	ret := _m.Called(restore, report)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutRestorePreviewReport")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(restore, report)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutRestoreMappingReport provides a mock function with given fields: restore, report
+func (_m *BackupStore) PutRestoreMappingReport(restore string, report io.Reader) error {
+	ret := _m.Called(restore, report)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutRestoreMappingReport")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(restore, report)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutRestoreItemOperations provides a mock function with given fields: restore, restoreItemOperations
 func (_m *BackupStore) PutRestoreItemOperations(restore string, restoreItemOperations io.Reader) error {
 	ret := _m.Called(restore, restoreItemOperations)
@@ -719,6 +1025,102 @@ func (_m *BackupStore) PutRestoredResourceList(restore string, _a1 io.Reader) er
 	return r0
 }
 
+// RetainBackupLogs provides a mock function with given fields: name, expiration
+func (_m *BackupStore) RetainBackupLogs(name string, expiration time.Time) error {
+	ret := _m.Called(name, expiration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetainBackupLogs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) error); ok {
+		r0 = rf(name, expiration)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VerifyBackupArtifacts provides a mock function with given fields: name, digests
+func (_m *BackupStore) VerifyBackupArtifacts(name string, digests map[string]string) ([]string, error) {
+	ret := _m.Called(name, digests)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyBackupArtifacts")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, map[string]string) ([]string, error)); ok {
+		return rf(name, digests)
+	}
+	if rf, ok := ret.Get(0).(func(string, map[string]string) []string); ok {
+		r0 = rf(name, digests)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, map[string]string) error); ok {
+		r1 = rf(name, digests)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SignBackup provides a mock function with given fields: name
+func (_m *BackupStore) SignBackup(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignBackup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VerifyBackupSignatures provides a mock function with given fields: name
+func (_m *BackupStore) VerifyBackupSignatures(name string) ([]string, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyBackupSignatures")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]string, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewBackupStore creates a new instance of BackupStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewBackupStore(t interface {
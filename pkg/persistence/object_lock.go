@@ -0,0 +1,126 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// lockingObjectStore writes the objects of the backups locked until the backups are immutable,
+// for the locations with object locks. The checkpoints of the backups in progress, which are
+// overwritten and deleted, and the objects out of the backup directories aren't locked.
+type lockingObjectStore struct {
+	velero.ObjectStore
+	lockStore velero.ObjectLockStore
+	mode      velerov1api.ObjectLockMode
+	layout    *ObjectStoreLayout
+
+	// getBackup returns the metadata of the backup, for the backups whose retention isn't known.
+	getBackup func(name string) (*velerov1api.Backup, error)
+
+	lock        sync.Mutex
+	retainUntil map[string]time.Time
+}
+
+// newLockingObjectStore returns the object store locking the objects of the backups with the
+// mode, the object store plugin being required to support object locks.
+func newLockingObjectStore(objectStore velero.ObjectStore, objectLock *velerov1api.ObjectLock, layout *ObjectStoreLayout) (*lockingObjectStore, error) {
+	lockStore, ok := objectStore.(velero.ObjectLockStore)
+	if !ok {
+		return nil, errors.New("the object store plugin of the location doesn't support object locks")
+	}
+	return &lockingObjectStore{
+		ObjectStore: objectStore,
+		lockStore:   lockStore,
+		mode:        objectLock.Mode,
+		layout:      layout,
+		retainUntil: map[string]time.Time{},
+	}, nil
+}
+
+// setRetention records when the objects of the backup can be deleted from its metadata, read
+// from the beginning of the reader.
+func (s *lockingObjectStore) setRetention(name string, metadata io.Reader) error {
+	if metadata == nil {
+		return nil
+	}
+	if err := seekToBeginning(metadata); err != nil {
+		return errors.WithStack(err)
+	}
+	backup := new(velerov1api.Backup)
+	if err := json.NewDecoder(metadata).Decode(backup); err != nil {
+		return errors.Wrapf(err, "error decoding the metadata of backup %s", name)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.retainUntil[name] = immutableUntil(backup)
+	return nil
+}
+
+// retention returns when the objects of the backup can be deleted, zero if they aren't locked.
+func (s *lockingObjectStore) retention(name string) (time.Time, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if until, found := s.retainUntil[name]; found {
+		return until, nil
+	}
+	backup, err := s.getBackup(name)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "error getting the retention of backup %s", name)
+	}
+	s.retainUntil[name] = immutableUntil(backup)
+	return s.retainUntil[name], nil
+}
+
+func immutableUntil(backup *velerov1api.Backup) time.Time {
+	if backup.Status.ImmutableUntil == nil {
+		return time.Time{}
+	}
+	return backup.Status.ImmutableUntil.Time
+}
+
+func (s *lockingObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	backup, file := s.layout.getBackupOfKey(key)
+	if backup == "" || strings.HasPrefix(file, backup+"-checkpoint") {
+		return s.ObjectStore.PutObject(bucket, key, body)
+	}
+
+	retainUntil, err := s.retention(backup)
+	if err != nil {
+		return err
+	}
+	if retainUntil.IsZero() {
+		return s.ObjectStore.PutObject(bucket, key, body)
+	}
+	return s.lockStore.PutLockedObject(bucket, key, body, string(s.mode), retainUntil)
+}
+
+// IsImmutable returns whether the objects of the backup are still locked in its location.
+func IsImmutable(backup *velerov1api.Backup, now time.Time) bool {
+	return backup.Status.ImmutableUntil != nil && backup.Status.ImmutableUntil.After(now)
+}
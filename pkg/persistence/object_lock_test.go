@@ -0,0 +1,102 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// lockingInMemoryObjectStore is an in-memory object store recording the retention of the
+// locked objects.
+type lockingInMemoryObjectStore struct {
+	*inMemoryObjectStore
+	retainUntil map[string]time.Time
+}
+
+func (s *lockingInMemoryObjectStore) PutLockedObject(bucket, key string, body io.Reader, mode string, retainUntil time.Time) error {
+	s.retainUntil[key] = retainUntil
+	return s.PutObject(bucket, key, body)
+}
+
+func TestLockingLocation(t *testing.T) {
+	immutableUntil := time.Date(2030, 1, 1, 0, 0, 0, 0, time.Local)
+	metadata := new(bytes.Buffer)
+	require.NoError(t, json.NewEncoder(metadata).Encode(builder.ForBackup("velero", "backup-1").ImmutableUntil(immutableUntil).Result()))
+
+	objectStore := &lockingInMemoryObjectStore{inMemoryObjectStore: newInMemoryObjectStore("bucket"), retainUntil: map[string]time.Time{}}
+	location := builder.ForBackupStorageLocation("velero", "default").Provider("provider-1").Bucket("bucket").
+		ObjectLock(velerov1api.ObjectLockModeCompliance).Result()
+	store, err := NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil)).
+		Get(location, objectStoreGetter{"provider-1": objectStore}, velerotest.NewLogger())
+	require.NoError(t, err)
+
+	require.NoError(t, store.PutBackup(BackupInfo{
+		Name:     "backup-1",
+		Metadata: bytes.NewReader(metadata.Bytes()),
+		Contents: strings.NewReader("contents"),
+		Log:      strings.NewReader("log"),
+	}))
+	require.NoError(t, store.PutBackupCheckpoint("backup-1", 0, strings.NewReader("contents"), strings.NewReader("checkpoint")))
+	require.NoError(t, store.PutRestoreLog("backup-1", "restore-1", strings.NewReader("log")))
+
+	assert.Equal(t, map[string]time.Time{
+		"backups/backup-1/velero-backup.json": immutableUntil,
+		"backups/backup-1/backup-1.tar.gz":    immutableUntil,
+		"backups/backup-1/backup-1-logs.gz":   immutableUntil,
+	}, objectStore.retainUntil)
+
+	// the retention of the backup put by another server is read from its metadata
+	store, err = NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil)).
+		Get(location, objectStoreGetter{"provider-1": objectStore}, velerotest.NewLogger())
+	require.NoError(t, err)
+	require.NoError(t, store.PutBackupVolumeInfos("backup-1", strings.NewReader("volume infos")))
+	assert.Equal(t, immutableUntil, objectStore.retainUntil["backups/backup-1/backup-1-volumeinfo.json.gz"])
+
+	// the objects of the backups which aren't immutable aren't locked
+	metadata.Reset()
+	require.NoError(t, json.NewEncoder(metadata).Encode(builder.ForBackup("velero", "backup-2").Result()))
+	require.NoError(t, store.PutBackupMetadata("backup-2", bytes.NewReader(metadata.Bytes())))
+	assert.NotContains(t, objectStore.retainUntil, "backups/backup-2/velero-backup.json")
+	assert.Contains(t, objectStore.Data["bucket"], "backups/backup-2/velero-backup.json")
+}
+
+func TestLockingLocationUnsupportedPlugin(t *testing.T) {
+	location := builder.ForBackupStorageLocation("velero", "default").Provider("provider-1").Bucket("bucket").
+		ObjectLock(velerov1api.ObjectLockModeGovernance).Result()
+	_, err := NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil)).
+		Get(location, objectStoreGetter{"provider-1": newInMemoryObjectStore("bucket")}, velerotest.NewLogger())
+	require.ErrorContains(t, err, "doesn't support object locks")
+}
+
+func TestIsImmutable(t *testing.T) {
+	now := time.Now()
+	assert.False(t, IsImmutable(builder.ForBackup("velero", "backup-1").Result(), now))
+	assert.False(t, IsImmutable(builder.ForBackup("velero", "backup-1").ImmutableUntil(now.Add(-time.Minute)).Result(), now))
+	assert.True(t, IsImmutable(builder.ForBackup("velero", "backup-1").ImmutableUntil(now.Add(time.Minute)).Result(), now))
+}
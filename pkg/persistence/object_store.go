@@ -20,6 +20,8 @@ import (
 	"compress/gzip"
 	"encoding/json"
 	"io"
+	"net/url"
+	"slices"
 	"strings"
 	"time"
 
@@ -52,7 +54,8 @@ type BackupInfo struct {
 	CSIVolumeSnapshots,
 	CSIVolumeSnapshotContents,
 	CSIVolumeSnapshotClasses,
-	BackupVolumeInfo io.Reader
+	BackupVolumeInfo,
+	SkippedItems io.Reader
 }
 
 // BackupStore defines operations for creating, retrieving, and deleting
@@ -77,11 +80,40 @@ type BackupStore interface {
 	PutBackupVolumeInfos(name string, volumeInfo io.Reader) error
 	GetBackupVolumeInfos(name string) ([]*volume.BackupVolumeInfo, error)
 	GetRestoreResults(name string) (map[string]results.Result, error)
+	// VerifyBackupArtifacts returns a description of each file of the backup which is missing
+	// or doesn't match its digest.
+	VerifyBackupArtifacts(name string, digests map[string]string) ([]string, error)
+	// SignBackup writes the signed manifest of the files of the backup once it's finalized.
+	// ErrSigningNotEnabled is returned when the location doesn't sign the backups.
+	SignBackup(name string) error
+	// VerifyBackupSignatures returns a description of each file of the backup which is missing,
+	// isn't in its signed manifest or doesn't match it.
+	VerifyBackupSignatures(name string) ([]string, error)
+	// PutBackupCheckpoint saves a checkpoint of a backup in progress: the tarball of the items
+	// backed up since the previous checkpoint as its segment, then the checkpoint itself.
+	PutBackupCheckpoint(backup string, segment int, contents, checkpoint io.Reader) error
+	// GetBackupCheckpoint returns the last checkpoint of a backup in progress, nil if none.
+	GetBackupCheckpoint(name string) (io.ReadCloser, error)
+	GetBackupCheckpointContents(name string, segment int) (io.ReadCloser, error)
+	DeleteBackupCheckpoint(name string) error
+	// MigrateLayout copies the files of the flat v1 layout under the prefix of a location using
+	// the v2 storage layout into its empty v2 layout, verifies the copies, and returns the number
+	// of backups copied. The copies are deleted when the migration fails.
+	MigrateLayout() (int, error)
 
 	// BackupExists checks if the backup metadata file exists in object storage.
 	BackupExists(bucket, backupName string) (bool, error)
 
 	DeleteBackup(name string) error
+	// DeleteBackupLogs deletes the log and results files of the backup, keeping its other files.
+	DeleteBackupLogs(name string) error
+	// RetainBackupLogs copies the log and results files of the backup to the logs directory of the
+	// location, where they're kept until the expiration once the backup is deleted.
+	RetainBackupLogs(name string, expiration time.Time) error
+	// ListRetainedBackupLogs returns the expiration of the logs kept in the logs directory,
+	// keyed by the names of their backups.
+	ListRetainedBackupLogs() (map[string]time.Time, error)
+	DeleteRetainedBackupLogs(name string) error
 
 	PutRestoreLog(backup, restore string, log io.Reader) error
 	PutRestoreResults(backup, restore string, results io.Reader) error
@@ -89,10 +121,18 @@ type BackupStore interface {
 	PutRestoreItemOperations(restore string, restoreItemOperations io.Reader) error
 	GetRestoreItemOperations(name string) ([]*itemoperation.RestoreOperation, error)
 	PutRestoreVolumeInfo(restore string, volumeInfo io.Reader) error
+	PutRestoreDriftReport(restore string, report io.Reader) error
+	PutRestorePreviewReport(restore string, report io.Reader) error
+	PutRestoreMappingReport(restore string, report io.Reader) error
+	// GetRestoreMappingReport returns the mapping report of the restore, nil if it has none.
+	GetRestoreMappingReport(name string) (*velerov1api.RestoreMappingReport, error)
 	DeleteRestore(name string) error
 	GetRestoredResourceList(name string) (map[string][]string, error)
 
 	GetDownloadURL(target velerov1api.DownloadTarget) (string, error)
+	// GetDownloadDataKey returns the data key decrypting the object of the download target when
+	// it's encrypted by the location, nil otherwise.
+	GetDownloadDataKey(target velerov1api.DownloadTarget) ([]byte, error)
 }
 
 // DownloadURLTTL is how long a download URL is valid for.
@@ -102,7 +142,16 @@ type objectBackupStore struct {
 	objectStore velero.ObjectStore
 	bucket      string
 	layout      *ObjectStoreLayout
-	logger      logrus.FieldLogger
+	// flatLayout is the v1 layout under the prefix of a location using the v2 storage layout,
+	// whose backups are listed from an index object. It's nil for the v1 storage layout.
+	flatLayout *ObjectStoreLayout
+	logger     logrus.FieldLogger
+	// locking is the object store locking the objects of the backups, nil if the location
+	// doesn't lock them.
+	locking *lockingObjectStore
+	// encrypting is the object store encrypting the objects, nil if the location doesn't
+	// encrypt them.
+	encrypting *encryptingObjectStore
 }
 
 // ObjectStoreGetter is a type that can get a velero.ObjectStore
@@ -126,6 +175,34 @@ func NewObjectBackupStoreGetter(credentialStore credentials.FileStore) ObjectBac
 	return &objectBackupStoreGetter{credentialStore: credentialStore}
 }
 
+// ObjectTaggingConfigKey is the configuration key under which the tags of the objects are passed
+// to the object store plugins, encoded as a URL query string.
+const ObjectTaggingConfigKey = "tagging"
+
+// LocationWithObjectTags returns the location along with the tags to set on the objects written
+// to it, if the location enables object tagging. The tags are added to the ones already
+// configured for the location, which is left unchanged.
+func LocationWithObjectTags(location *velerov1api.BackupStorageLocation, tags map[string]string) *velerov1api.BackupStorageLocation {
+	if !location.Spec.ObjectTagging || len(tags) == 0 {
+		return location
+	}
+
+	values, err := url.ParseQuery(location.Spec.Config[ObjectTaggingConfigKey])
+	if err != nil {
+		values = url.Values{}
+	}
+	for k, v := range tags {
+		values.Set(k, v)
+	}
+
+	location = location.DeepCopy()
+	if location.Spec.Config == nil {
+		location.Spec.Config = make(map[string]string)
+	}
+	location.Spec.Config[ObjectTaggingConfigKey] = values.Encode()
+	return location
+}
+
 func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocation, objectStoreGetter ObjectStoreGetter, logger logrus.FieldLogger) (BackupStore, error) {
 	if location.Spec.ObjectStorage == nil {
 		return nil, errors.New("backup storage location does not use object storage")
@@ -137,7 +214,7 @@ func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocatio
 
 	// trim off any leading/trailing slashes
 	bucket := strings.Trim(location.Spec.ObjectStorage.Bucket, "/")
-	prefix := strings.Trim(location.Spec.ObjectStorage.Prefix, "/")
+	prefix := LocationPrefix(location)
 
 	// if there are any slashes in the middle of 'bucket', the user
 	// probably put <bucket>/<prefix> in the bucket field, which we
@@ -178,6 +255,10 @@ func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocatio
 		objectStoreConfig["credentialsFile"] = credsFile
 	}
 
+	if verification := location.Spec.UploadVerification; verification != nil && verification.ProviderValidation {
+		objectStoreConfig[ChecksumAlgorithmConfigKey] = string(verification.Algorithm)
+	}
+
 	objectStore, err := objectStoreGetter.GetObjectStore(location.Spec.Provider)
 	if err != nil {
 		return nil, err
@@ -192,12 +273,59 @@ func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocatio
 		"prefix": prefix,
 	}))
 
-	return &objectBackupStore{
+	// the objects are locked by the plugin as they're put, their uploads being verified then
+	layout := NewObjectStoreLayout(prefix)
+	var locking *lockingObjectStore
+	if location.Spec.ObjectLock != nil && !IsReadOnly(location) {
+		if locking, err = newLockingObjectStore(objectStore, location.Spec.ObjectLock, layout); err != nil {
+			return nil, err
+		}
+		objectStore = locking
+	}
+
+	if IsReadOnly(location) {
+		objectStore = &readOnlyObjectStore{ObjectStore: objectStore}
+	} else if location.Spec.UploadVerification != nil {
+		if objectStore, err = newVerifyingObjectStore(objectStore, location.Spec.UploadVerification, log); err != nil {
+			return nil, err
+		}
+	}
+
+	// the objects are encrypted before their uploads are verified
+	var encrypting *encryptingObjectStore
+	if location.Spec.Encryption != nil {
+		keyWrapper, err := newKeyWrapper(location.Spec.Encryption, b.credentialStore, objectStoreConfig)
+		if err != nil {
+			return nil, errors.Wrap(err, "error getting the encryption key of the location")
+		}
+		encrypting = &encryptingObjectStore{ObjectStore: objectStore, keyWrapper: keyWrapper}
+		objectStore = encrypting
+	}
+
+	// the manifests are signed with the digests of the objects before they're encrypted
+	if location.Spec.Signing != nil {
+		privateKey, err := newSigningKey(location.Spec.Signing, b.credentialStore)
+		if err != nil {
+			return nil, errors.Wrap(err, "error getting the signing key of the location")
+		}
+		objectStore = &signingObjectStore{ObjectStore: objectStore, layout: layout, privateKey: privateKey}
+	}
+
+	store := &objectBackupStore{
 		objectStore: objectStore,
 		bucket:      bucket,
-		layout:      NewObjectStoreLayout(prefix),
+		layout:      layout,
 		logger:      log,
-	}, nil
+		locking:     locking,
+		encrypting:  encrypting,
+	}
+	if locking != nil {
+		locking.getBackup = store.GetBackupMetadata
+	}
+	if UsesStorageLayoutV2(location) {
+		store.flatLayout = NewObjectStoreLayout(strings.Trim(location.Spec.ObjectStorage.Prefix, "/"))
+	}
+	return store, nil
 }
 
 func (s *objectBackupStore) IsValid() error {
@@ -226,30 +354,18 @@ func (s *objectBackupStore) IsValid() error {
 }
 
 func (s *objectBackupStore) ListBackups() ([]string, error) {
-	prefixes, err := s.objectStore.ListCommonPrefixes(s.bucket, s.layout.subdirs["backups"], "/")
-	if err != nil {
-		return nil, err
+	if s.flatLayout != nil {
+		return s.listIndexedBackups()
 	}
-	if len(prefixes) == 0 {
-		return []string{}, nil
-	}
-
-	output := make([]string, 0, len(prefixes))
-
-	for _, prefix := range prefixes {
-		// values returned from a call to ObjectStore's
-		// ListCommonPrefixes method return the *full* prefix, inclusive
-		// of s.backupsPrefix, and include the delimiter ("/") as a suffix. Trim
-		// each of those off to get the backup name.
-		backupName := strings.TrimSuffix(strings.TrimPrefix(prefix, s.layout.subdirs["backups"]), "/")
-
-		output = append(output, backupName)
-	}
-
-	return output, nil
+	return s.listBackupDirs()
 }
 
 func (s *objectBackupStore) PutBackup(info BackupInfo) error {
+	// the log is put first, locked as long as the backup
+	if err := s.setBackupRetention(info.Name, info.Metadata); err != nil {
+		return err
+	}
+
 	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupLogKey(info.Name), info.Log); err != nil {
 		// Uploading the log file is best-effort; if it fails, we log the error but it doesn't impact the
 		// backup's status.
@@ -278,6 +394,7 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		s.layout.getCSIVolumeSnapshotClassesKey(info.Name):  info.CSIVolumeSnapshotClasses,
 		s.layout.getBackupResultsKey(info.Name):             info.BackupResults,
 		s.layout.getBackupVolumeInfoKey(info.Name):          info.BackupVolumeInfo,
+		s.layout.getBackupSkippedItemsKey(info.Name):        info.SkippedItems,
 	}
 
 	for key, reader := range backupObjs {
@@ -294,6 +411,13 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		}
 	}
 
+	if s.flatLayout != nil {
+		if err := s.updateIndex(func(backups []string) []string { return append(backups, info.Name) }); err != nil {
+			// the backup is complete, and is indexed again when the layout is migrated
+			s.logger.WithError(err).WithField("backup", info.Name).Error("Error adding the backup to the index object")
+		}
+	}
+
 	return nil
 }
 
@@ -328,6 +452,9 @@ func (s *objectBackupStore) GetBackupMetadata(name string) (*velerov1api.Backup,
 }
 
 func (s *objectBackupStore) PutBackupMetadata(backup string, backupMetadata io.Reader) error {
+	if err := s.setBackupRetention(backup, backupMetadata); err != nil {
+		return err
+	}
 	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupMetadataKey(backup), backupMetadata)
 }
 
@@ -549,6 +676,36 @@ func (s *objectBackupStore) BackupExists(bucket, backupName string) (bool, error
 	return s.objectStore.ObjectExists(bucket, s.layout.getBackupMetadataKey(backupName))
 }
 
+func (s *objectBackupStore) PutBackupCheckpoint(backup string, segment int, contents, checkpoint io.Reader) error {
+	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupCheckpointContentsKey(backup, segment), contents); err != nil {
+		return err
+	}
+	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupCheckpointKey(backup), checkpoint)
+}
+
+func (s *objectBackupStore) GetBackupCheckpoint(name string) (io.ReadCloser, error) {
+	return tryGet(s.objectStore, s.bucket, s.layout.getBackupCheckpointKey(name))
+}
+
+func (s *objectBackupStore) GetBackupCheckpointContents(name string, segment int) (io.ReadCloser, error) {
+	return s.objectStore.GetObject(s.bucket, s.layout.getBackupCheckpointContentsKey(name, segment))
+}
+
+func (s *objectBackupStore) DeleteBackupCheckpoint(name string) error {
+	objects, err := s.objectStore.ListObjects(s.bucket, strings.TrimSuffix(s.layout.getBackupCheckpointKey(name), ".json.gz"))
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, key := range objects {
+		if err := s.objectStore.DeleteObject(s.bucket, key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.WithStack(kerrors.NewAggregate(errs))
+}
+
 func (s *objectBackupStore) DeleteBackup(name string) error {
 	objects, err := s.objectStore.ListObjects(s.bucket, s.layout.getBackupDir(name))
 	if err != nil {
@@ -565,6 +722,14 @@ func (s *objectBackupStore) DeleteBackup(name string) error {
 		}
 	}
 
+	if s.flatLayout != nil {
+		if err := s.updateIndex(func(backups []string) []string {
+			return slices.DeleteFunc(backups, func(backup string) bool { return backup == name })
+		}); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	return errors.WithStack(kerrors.NewAggregate(errs))
 }
 
@@ -607,6 +772,18 @@ func (s *objectBackupStore) PutRestoreVolumeInfo(restore string, volumeInfo io.R
 	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getRestoreVolumeInfoKey(restore), volumeInfo)
 }
 
+func (s *objectBackupStore) PutRestoreDriftReport(restore string, report io.Reader) error {
+	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getRestoreDriftReportKey(restore), report)
+}
+
+func (s *objectBackupStore) PutRestorePreviewReport(restore string, report io.Reader) error {
+	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getRestorePreviewReportKey(restore), report)
+}
+
+func (s *objectBackupStore) PutRestoreMappingReport(restore string, report io.Reader) error {
+	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getRestoreMappingReportKey(restore), report)
+}
+
 func (s *objectBackupStore) PutBackupItemOperations(backup string, backupItemOperations io.Reader) error {
 	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupItemOperationsKey(backup), backupItemOperations)
 }
@@ -615,41 +792,69 @@ func (s *objectBackupStore) PutBackupContents(backup string, backupContents io.R
 	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupContentsKey(backup), backupContents)
 }
 
-func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (string, error) {
+// getDownloadKey returns the key of the object of the download target.
+func (s *objectBackupStore) getDownloadKey(target velerov1api.DownloadTarget) (string, error) {
 	switch target.Kind {
 	case velerov1api.DownloadTargetKindBackupContents:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupContentsKey(target.Name), DownloadURLTTL)
+		return s.layout.getBackupContentsKey(target.Name), nil
 	case velerov1api.DownloadTargetKindBackupLog:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupLogKey(target.Name), DownloadURLTTL)
+		return s.backupLogsDownloadKey(s.layout.getBackupLogKey(target.Name), s.layout.getRetainedBackupLogKey(target.Name))
 	case velerov1api.DownloadTargetKindBackupVolumeSnapshots:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupVolumeSnapshotsKey(target.Name), DownloadURLTTL)
+		return s.layout.getBackupVolumeSnapshotsKey(target.Name), nil
 	case velerov1api.DownloadTargetKindBackupItemOperations:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupItemOperationsKey(target.Name), DownloadURLTTL)
+		return s.layout.getBackupItemOperationsKey(target.Name), nil
 	case velerov1api.DownloadTargetKindRestoreItemOperations:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreItemOperationsKey(target.Name), DownloadURLTTL)
+		return s.layout.getRestoreItemOperationsKey(target.Name), nil
 	case velerov1api.DownloadTargetKindBackupResourceList:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupResourceListKey(target.Name), DownloadURLTTL)
+		return s.layout.getBackupResourceListKey(target.Name), nil
 	case velerov1api.DownloadTargetKindRestoreLog:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreLogKey(target.Name), DownloadURLTTL)
+		return s.layout.getRestoreLogKey(target.Name), nil
 	case velerov1api.DownloadTargetKindRestoreResults:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreResultsKey(target.Name), DownloadURLTTL)
+		return s.layout.getRestoreResultsKey(target.Name), nil
 	case velerov1api.DownloadTargetKindRestoreResourceList:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreResourceListKey(target.Name), DownloadURLTTL)
+		return s.layout.getRestoreResourceListKey(target.Name), nil
 	case velerov1api.DownloadTargetKindCSIBackupVolumeSnapshots:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getCSIVolumeSnapshotKey(target.Name), DownloadURLTTL)
+		return s.layout.getCSIVolumeSnapshotKey(target.Name), nil
 	case velerov1api.DownloadTargetKindCSIBackupVolumeSnapshotContents:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getCSIVolumeSnapshotContentsKey(target.Name), DownloadURLTTL)
+		return s.layout.getCSIVolumeSnapshotContentsKey(target.Name), nil
 	case velerov1api.DownloadTargetKindBackupResults:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupResultsKey(target.Name), DownloadURLTTL)
+		return s.backupLogsDownloadKey(s.layout.getBackupResultsKey(target.Name), s.layout.getRetainedBackupResultsKey(target.Name))
 	case velerov1api.DownloadTargetKindBackupVolumeInfos:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupVolumeInfoKey(target.Name), DownloadURLTTL)
+		return s.layout.getBackupVolumeInfoKey(target.Name), nil
 	case velerov1api.DownloadTargetKindRestoreVolumeInfo:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreVolumeInfoKey(target.Name), DownloadURLTTL)
+		return s.layout.getRestoreVolumeInfoKey(target.Name), nil
+	case velerov1api.DownloadTargetKindBackupSkippedItems:
+		return s.layout.getBackupSkippedItemsKey(target.Name), nil
+	case velerov1api.DownloadTargetKindRestoreDriftReport:
+		return s.layout.getRestoreDriftReportKey(target.Name), nil
+	case velerov1api.DownloadTargetKindRestorePreviewReport:
+		return s.layout.getRestorePreviewReportKey(target.Name), nil
+	case velerov1api.DownloadTargetKindRestoreMappingReport:
+		return s.layout.getRestoreMappingReportKey(target.Name), nil
 	default:
 		return "", errors.Errorf("unsupported download target kind %q", target.Kind)
 	}
 }
 
+func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (string, error) {
+	key, err := s.getDownloadKey(target)
+	if err != nil {
+		return "", err
+	}
+	return s.objectStore.CreateSignedURL(s.bucket, key, DownloadURLTTL)
+}
+
+func (s *objectBackupStore) GetDownloadDataKey(target velerov1api.DownloadTarget) ([]byte, error) {
+	key, err := s.getDownloadKey(target)
+	if err != nil {
+		return nil, err
+	}
+	if s.encrypting == nil || !isEncryptedArtifact(key) {
+		return nil, nil
+	}
+	return s.encrypting.dataKey(s.bucket, key)
+}
+
 func (s *objectBackupStore) GetRestoredResourceList(name string) (map[string][]string, error) {
 	list := make(map[string][]string)
 
@@ -669,6 +874,24 @@ func (s *objectBackupStore) GetRestoredResourceList(name string) (map[string][]s
 	return list, nil
 }
 
+func (s *objectBackupStore) GetRestoreMappingReport(name string) (*velerov1api.RestoreMappingReport, error) {
+	res, err := tryGet(s.objectStore, s.bucket, s.layout.getRestoreMappingReportKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	defer res.Close()
+
+	report := new(velerov1api.RestoreMappingReport)
+	if err := decode(res, report); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
 func seekToBeginning(r io.Reader) error {
 	seeker, ok := r.(io.Seeker)
 	if !ok {
@@ -679,6 +902,15 @@ func seekToBeginning(r io.Reader) error {
 	return err
 }
 
+// setBackupRetention records the retention of the objects of the backup from its metadata, when
+// the location locks them.
+func (s *objectBackupStore) setBackupRetention(backup string, metadata io.Reader) error {
+	if s.locking == nil {
+		return nil
+	}
+	return s.locking.setRetention(backup, metadata)
+}
+
 func seekAndPutObject(objectStore velero.ObjectStore, bucket, key string, file io.Reader) error {
 	if file == nil {
 		return nil
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"path"
 	"strings"
+	"time"
 )
 
 // ObjectStoreLayout defines how Velero's persisted files map to
@@ -41,6 +42,8 @@ func NewObjectStoreLayout(prefix string) *ObjectStoreLayout {
 		"metadata": path.Join(prefix, "metadata") + "/",
 		"plugins":  path.Join(prefix, "plugins") + "/",
 		"kopia":    path.Join(prefix, "kopia") + "/",
+		"tenants":  path.Join(prefix, "tenants") + "/",
+		"logs":     path.Join(prefix, "logs") + "/",
 	}
 
 	return &ObjectStoreLayout{
@@ -61,6 +64,10 @@ func (l *ObjectStoreLayout) isValidSubdir(name string) bool {
 	return ok
 }
 
+func (l *ObjectStoreLayout) getIndexKey() string {
+	return path.Join(l.rootPrefix, "index.json")
+}
+
 func (l *ObjectStoreLayout) getBackupDir(backup string) string {
 	return path.Join(l.subdirs["backups"], backup) + "/"
 }
@@ -133,6 +140,62 @@ func (l *ObjectStoreLayout) getBackupVolumeInfoKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-volumeinfo.json.gz", backup))
 }
 
+func (l *ObjectStoreLayout) getBackupSkippedItemsKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-skipped-items.json.gz", backup))
+}
+
+func (l *ObjectStoreLayout) getBackupCheckpointKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-checkpoint.json.gz", backup))
+}
+
+func (l *ObjectStoreLayout) getBackupCheckpointContentsKey(backup string, segment int) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-checkpoint-%d.tar.gz", backup, segment))
+}
+
+// getBackupOfKey returns the name of the backup whose directory holds the object, and the path
+// of the object in the directory, or an empty name if the object isn't in a backup directory.
+func (l *ObjectStoreLayout) getBackupOfKey(key string) (string, string) {
+	rest, found := strings.CutPrefix(key, l.subdirs["backups"])
+	if !found {
+		return "", ""
+	}
+	backup, file, found := strings.Cut(rest, "/")
+	if !found || backup == "" || file == "" {
+		return "", ""
+	}
+	return backup, file
+}
+
+func (l *ObjectStoreLayout) getRetainedBackupLogsDir(backup string) string {
+	return path.Join(l.subdirs["logs"], backup) + "/"
+}
+
+func (l *ObjectStoreLayout) getRetainedBackupLogKey(backup string) string {
+	return path.Join(l.subdirs["logs"], backup, fmt.Sprintf("%s-logs.gz", backup))
+}
+
+func (l *ObjectStoreLayout) getRetainedBackupResultsKey(backup string) string {
+	return path.Join(l.subdirs["logs"], backup, fmt.Sprintf("%s-results.gz", backup))
+}
+
+// getRetainedBackupLogsExpirationKey returns the key of the empty object recording when the
+// retained logs of the backup expire, so that they can be pruned by listing the keys only.
+func (l *ObjectStoreLayout) getRetainedBackupLogsExpirationKey(backup string, expiration time.Time) string {
+	return path.Join(l.subdirs["logs"], backup, retainedBackupLogsExpirationPrefix+expiration.UTC().Format(retainedBackupLogsExpirationFormat))
+}
+
+func (l *ObjectStoreLayout) getRestoreDriftReportKey(restore string) string {
+	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-drift-report.json.gz", restore))
+}
+
+func (l *ObjectStoreLayout) getRestorePreviewReportKey(restore string) string {
+	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-preview-report.json.gz", restore))
+}
+
+func (l *ObjectStoreLayout) getRestoreMappingReportKey(restore string) string {
+	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-mapping-report.json.gz", restore))
+}
+
 func (l *ObjectStoreLayout) getRestoreVolumeInfoKey(restore string) string {
 	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("%s-volumeinfo.json.gz", restore))
 }
@@ -570,6 +570,56 @@ func TestGetBackupContents(t *testing.T) {
 	assert.Equal(t, "foo", string(data))
 }
 
+func TestBackupArtifactDigests(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	info := BackupInfo{
+		Name:            "test-backup",
+		Contents:        strings.NewReader("foo"),
+		Log:             strings.NewReader("log"),
+		BackupResults:   bytes.NewBufferString("results"),
+		VolumeSnapshots: strings.NewReader("snapshots"),
+	}
+	digests, err := BackupArtifactDigests(info)
+	require.NoError(t, err)
+	assert.Len(t, digests, 4)
+	assert.Equal(t, "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae", digests["test-backup.tar.gz"])
+	assert.Contains(t, digests, "test-backup-logs.gz")
+	assert.Contains(t, digests, "test-backup-results.gz")
+
+	// computing the digests doesn't consume the artifacts
+	info.Metadata = newStringReadSeeker("{}")
+	require.NoError(t, harness.PutBackup(info))
+
+	mismatches, err := harness.VerifyBackupArtifacts("test-backup", digests)
+	require.NoError(t, err)
+	assert.Empty(t, mismatches)
+
+	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup.tar.gz", newStringReadSeeker("bar")))
+	require.NoError(t, harness.objectStore.DeleteObject(harness.bucket, "backups/test-backup/test-backup-volumesnapshots.json.gz"))
+	// the logs and results may expire before the backup
+	require.NoError(t, harness.DeleteBackupLogs("test-backup"))
+	mismatches, err = harness.VerifyBackupArtifacts("test-backup", digests)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"test-backup-volumesnapshots.json.gz is missing",
+		"test-backup.tar.gz has digest fcde2b2edba56bf408601fb721fe9b5c338d10ee429ea04fae5511b68fbf8fb9 instead of 2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+	}, mismatches)
+}
+
+func TestSetBackupArtifactDigest(t *testing.T) {
+	// no digests are recorded for the backups created before they were introduced
+	backup := builder.ForBackup("velero", "test-backup").Result()
+	require.NoError(t, SetBackupArtifactDigest(backup, velerov1api.DownloadTargetKindBackupContents, newStringReadSeeker("foo")))
+	assert.Nil(t, backup.Status.ArtifactDigests)
+
+	backup.Status.ArtifactDigests = map[string]string{"test-backup.tar.gz": "old"}
+	require.NoError(t, SetBackupArtifactDigest(backup, velerov1api.DownloadTargetKindBackupContents, newStringReadSeeker("foo")))
+	assert.Equal(t, "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae", backup.Status.ArtifactDigests["test-backup.tar.gz"])
+
+	require.EqualError(t, SetBackupArtifactDigest(backup, velerov1api.DownloadTargetKindBackupLog, newStringReadSeeker("foo")), `unsupported backup artifact kind "BackupLog"`)
+}
+
 func TestDeleteBackup(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -746,6 +796,9 @@ func TestGetDownloadURL(t *testing.T) {
 				velerov1api.DownloadTargetKindRestoreResults:        "restores/my-backup/restore-my-backup-results.gz",
 				velerov1api.DownloadTargetKindRestoreItemOperations: "restores/my-backup/restore-my-backup-itemoperations.json.gz",
 				velerov1api.DownloadTargetKindRestoreResourceList:   "restores/my-backup/restore-my-backup-resource-list.json.gz",
+				velerov1api.DownloadTargetKindRestoreDriftReport:    "restores/my-backup/restore-my-backup-drift-report.json.gz",
+				velerov1api.DownloadTargetKindRestorePreviewReport:  "restores/my-backup/restore-my-backup-preview-report.json.gz",
+				velerov1api.DownloadTargetKindRestoreMappingReport:  "restores/my-backup/restore-my-backup-mapping-report.json.gz",
 			},
 		},
 		{
@@ -773,7 +826,8 @@ func TestGetDownloadURL(t *testing.T) {
 			name:       "",
 			targetName: "my-backup",
 			expectedKeyByKind: map[velerov1api.DownloadTargetKind]string{
-				velerov1api.DownloadTargetKindBackupVolumeInfos: "backups/my-backup/my-backup-volumeinfo.json.gz",
+				velerov1api.DownloadTargetKindBackupVolumeInfos:  "backups/my-backup/my-backup-volumeinfo.json.gz",
+				velerov1api.DownloadTargetKindBackupSkippedItems: "backups/my-backup/my-backup-skipped-items.json.gz",
 			},
 		},
 	}
@@ -911,6 +965,24 @@ func (osg objectStoreGetter) GetObjectStore(provider string) (velero.ObjectStore
 // TestNewObjectBackupStore runs the NewObjectBackupStoreGetter constructor and ensures
 // that it provides a BackupStore with a correctly constructed ObjectBackupStore or
 // that an appropriate error is returned.
+func TestLocationWithObjectTags(t *testing.T) {
+	tags := map[string]string{"velero.io/backup": "backup-1", "cost center": "1234"}
+
+	location := builder.ForBackupStorageLocation("velero", "default").Result()
+	assert.Same(t, location, LocationWithObjectTags(location, tags), "object tagging isn't enabled")
+
+	location.Spec.ObjectTagging = true
+	location.Spec.Config = map[string]string{ObjectTaggingConfigKey: "team=storage", "region": "us-east-1"}
+	tagged := LocationWithObjectTags(location, tags)
+	assert.Equal(t, map[string]string{
+		ObjectTaggingConfigKey: "cost+center=1234&team=storage&velero.io%2Fbackup=backup-1",
+		"region":               "us-east-1",
+	}, tagged.Spec.Config)
+	assert.Equal(t, "team=storage", location.Spec.Config[ObjectTaggingConfigKey], "the location is left unchanged")
+
+	assert.Same(t, location, LocationWithObjectTags(location, nil), "no tags")
+}
+
 func TestNewObjectBackupStoreGetter(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -1203,6 +1275,35 @@ func TestGetRestoredResourceList(t *testing.T) {
 	assert.EqualValues(t, list["pod"], res["pod"])
 }
 
+func TestGetRestoreMappingReport(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	// file not found should not error
+	res, err := harness.GetRestoreMappingReport("test-restore")
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+
+	// file containing invalid data should error
+	harness.objectStore.PutObject(harness.bucket, "restores/test-restore/restore-test-restore-mapping-report.json.gz", newStringReadSeeker("foo"))
+	_, err = harness.GetRestoreMappingReport("test-restore")
+	assert.Error(t, err)
+
+	// file containing gzipped json data should return correctly
+	report := &velerov1api.RestoreMappingReport{
+		PersistentVolumes: []velerov1api.PersistentVolumeMapping{{Namespace: "test-ns", Name: "pvc-1", BackupPersistentVolume: "pv-1"}},
+	}
+	obj := new(bytes.Buffer)
+	gzw := gzip.NewWriter(obj)
+
+	require.NoError(t, json.NewEncoder(gzw).Encode(report))
+	require.NoError(t, gzw.Close())
+	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "restores/test-restore/restore-test-restore-mapping-report.json.gz", obj))
+	res, err = harness.GetRestoreMappingReport("test-restore")
+
+	assert.NoError(t, err)
+	assert.Equal(t, report, res)
+}
+
 func TestPutBackupVolumeInfos(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -0,0 +1,49 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// ErrReadOnlyLocation is returned for the writes to the object store of a read-only backup
+// storage location.
+var ErrReadOnlyLocation = errors.New("backup storage location is read-only")
+
+// IsReadOnly returns whether the location is read-only.
+func IsReadOnly(location *velerov1api.BackupStorageLocation) bool {
+	return location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly
+}
+
+// readOnlyObjectStore refuses all the writes to the object store of a read-only backup storage
+// location, so that no code path can modify it whatever the permissions of its credentials.
+type readOnlyObjectStore struct {
+	velero.ObjectStore
+}
+
+func (s *readOnlyObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	return errors.Wrapf(ErrReadOnlyLocation, "error putting object %s", key)
+}
+
+func (s *readOnlyObjectStore) DeleteObject(bucket, key string) error {
+	return errors.Wrapf(ErrReadOnlyLocation, "error deleting object %s", key)
+}
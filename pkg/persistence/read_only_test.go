@@ -0,0 +1,53 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestReadOnlyLocation(t *testing.T) {
+	objectStore := newInMemoryObjectStore("bucket")
+	require.NoError(t, objectStore.PutObject("bucket", "backups/backup-1/velero-backup.json", strings.NewReader("{}")))
+
+	location := builder.ForBackupStorageLocation("velero", "default").Provider("provider-1").Bucket("bucket").
+		AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result()
+	store, err := NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil)).
+		Get(location, objectStoreGetter{"provider-1": objectStore}, velerotest.NewLogger())
+	require.NoError(t, err)
+
+	backups, err := store.ListBackups()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backup-1"}, backups)
+
+	err = store.PutBackupMetadata("backup-2", strings.NewReader("{}"))
+	require.ErrorIs(t, err, ErrReadOnlyLocation)
+	err = store.PutRestoreLog("backup-1", "restore-1", strings.NewReader("log"))
+	require.ErrorIs(t, err, ErrReadOnlyLocation)
+	err = store.DeleteBackup("backup-1")
+	require.ErrorIs(t, err, ErrReadOnlyLocation)
+
+	assert.Len(t, objectStore.Data["bucket"], 1)
+}
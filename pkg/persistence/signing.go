@@ -0,0 +1,304 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"path"
+	"slices"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+var (
+	// ErrSigningNotEnabled is returned when verifying a backup of a location which doesn't sign
+	// the backups.
+	ErrSigningNotEnabled = errors.New("the backup storage location doesn't sign the backups")
+
+	// ErrBackupNotSigned is returned when verifying a backup which has no signed manifest, e.g.
+	// written before the signing of its location was enabled.
+	ErrBackupNotSigned = errors.New("the backup has no signed manifest")
+)
+
+// signedManifestFile is the file of the signed manifest in the directory of a backup.
+const signedManifestFile = "velero-backup-manifest.json"
+
+// signedManifest lists the SHA-256 digest of each file of a backup, keyed by the path of the
+// file in the backup's directory, and is signed along with the name of the backup so that it
+// can't be reused for another backup.
+type signedManifest struct {
+	Backup    string            `json:"backup"`
+	Files     map[string]string `json:"files"`
+	Signature []byte            `json:"signature,omitempty"`
+}
+
+// signedContent returns the content of the manifest covered by its signature.
+func (m *signedManifest) signedContent() ([]byte, error) {
+	content, err := json.Marshal(&signedManifest{Backup: m.Backup, Files: m.Files})
+	return content, errors.WithStack(err)
+}
+
+// newSigningKey returns the private key of the signing of the location.
+func newSigningKey(signing *velerov1api.Signing, credentialStore credentials.FileStore) (ed25519.PrivateKey, error) {
+	seed, err := readSecretKey(credentialStore, &signing.Key, "signing key")
+	if err != nil {
+		return nil, err
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, errors.Errorf("the signing key is %d bytes long instead of %d", len(seed), ed25519.SeedSize)
+	}
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+// signingObjectStore writes the signed manifest of the files of a backup once the backup is
+// finalized, and keeps it up to date with the files Velero puts or deletes in the directory of
+// the backup afterwards, e.g. when its logs are deleted. The digests are computed over the
+// objects before they're encrypted.
+type signingObjectStore struct {
+	velero.ObjectStore
+	layout     *ObjectStoreLayout
+	privateKey ed25519.PrivateKey
+}
+
+func (s *signingObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	backup, file := s.layout.getBackupOfKey(key)
+	if backup == "" || file == signedManifestFile {
+		return s.ObjectStore.PutObject(bucket, key, body)
+	}
+
+	manifest, err := s.getManifest(bucket, backup)
+	if err != nil {
+		return err
+	}
+	if manifest == nil {
+		return s.ObjectStore.PutObject(bucket, key, body)
+	}
+
+	hash := sha256.New()
+	if err := s.ObjectStore.PutObject(bucket, key, io.TeeReader(body, hash)); err != nil {
+		return err
+	}
+	manifest.Files[file] = hex.EncodeToString(hash.Sum(nil))
+	return s.putManifest(bucket, manifest)
+}
+
+func (s *signingObjectStore) DeleteObject(bucket, key string) error {
+	backup, file := s.layout.getBackupOfKey(key)
+	if backup == "" || file == signedManifestFile {
+		return s.ObjectStore.DeleteObject(bucket, key)
+	}
+
+	if err := s.ObjectStore.DeleteObject(bucket, key); err != nil {
+		return err
+	}
+	// the manifest is already gone when it's deleted before the file, e.g. when all the files
+	// of the backup are deleted
+	manifest, err := s.getManifest(bucket, backup)
+	if err != nil || manifest == nil {
+		return err
+	}
+	if _, found := manifest.Files[file]; !found {
+		return nil
+	}
+	delete(manifest.Files, file)
+	return s.putManifest(bucket, manifest)
+}
+
+// sign writes the signed manifest of the files in the directory of the backup.
+func (s *signingObjectStore) sign(bucket, backup string) error {
+	keys, err := s.ObjectStore.ListObjects(bucket, s.layout.getBackupDir(backup))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	manifest := &signedManifest{Backup: backup, Files: make(map[string]string, len(keys))}
+	for _, key := range keys {
+		_, file := s.layout.getBackupOfKey(key)
+		if file == "" || file == signedManifestFile {
+			continue
+		}
+		if manifest.Files[file], err = s.objectDigest(bucket, key); err != nil {
+			return err
+		}
+	}
+	return s.putManifest(bucket, manifest)
+}
+
+func (s *signingObjectStore) putManifest(bucket string, manifest *signedManifest) error {
+	content, err := manifest.signedContent()
+	if err != nil {
+		return err
+	}
+	manifest.Signature = ed25519.Sign(s.privateKey, content)
+
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	key := path.Join(s.layout.getBackupDir(manifest.Backup), signedManifestFile)
+	return errors.Wrap(s.ObjectStore.PutObject(bucket, key, bytes.NewReader(data)), "error putting the signed manifest")
+}
+
+// getManifest returns the signed manifest of the backup, nil if there's none, or an error if
+// it's not the one of the backup or isn't signed by the key of the location.
+func (s *signingObjectStore) getManifest(bucket, backup string) (*signedManifest, error) {
+	data, err := s.readManifest(bucket, backup)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	return s.checkManifest(data, backup)
+}
+
+// readManifest returns the content of the signed manifest of the backup, nil if there's none.
+func (s *signingObjectStore) readManifest(bucket, backup string) ([]byte, error) {
+	res, err := tryGet(s.ObjectStore, bucket, path.Join(s.layout.getBackupDir(backup), signedManifestFile))
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting the signed manifest")
+	}
+	if res == nil {
+		return nil, nil
+	}
+	defer res.Close()
+
+	data, err := io.ReadAll(res)
+	return data, errors.Wrap(err, "error reading the signed manifest")
+}
+
+// checkManifest decodes the signed manifest, and returns an error if it's not the one of the
+// backup or isn't signed by the key of the location.
+func (s *signingObjectStore) checkManifest(data []byte, backup string) (*signedManifest, error) {
+	manifest := new(signedManifest)
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, errors.Wrap(err, "the signed manifest is corrupted")
+	}
+	content, err := manifest.signedContent()
+	if err != nil {
+		return nil, err
+	}
+	if !ed25519.Verify(s.privateKey.Public().(ed25519.PublicKey), content, manifest.Signature) {
+		return nil, errors.New("the signature of the manifest is invalid")
+	}
+	if manifest.Backup != backup {
+		return nil, errors.Errorf("the signed manifest is the one of backup %s", manifest.Backup)
+	}
+	if manifest.Files == nil {
+		manifest.Files = make(map[string]string)
+	}
+	return manifest, nil
+}
+
+// verify compares the objects in the directory of the backup with its signed manifest, and
+// returns a description of each object which isn't in the manifest, is missing or doesn't
+// match its digest. The signature of the manifest being invalid is returned as the only
+// mismatch.
+func (s *signingObjectStore) verify(bucket, backup string) ([]string, error) {
+	data, err := s.readManifest(bucket, backup)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, ErrBackupNotSigned
+	}
+	manifest, err := s.checkManifest(data, backup)
+	if err != nil {
+		return []string{err.Error()}, nil
+	}
+
+	keys, err := s.ObjectStore.ListObjects(bucket, s.layout.getBackupDir(backup))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	sort.Strings(keys)
+
+	var mismatches []string
+	files := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		_, file := s.layout.getBackupOfKey(key)
+		if file == "" || file == signedManifestFile {
+			continue
+		}
+		files[file] = true
+
+		signedDigest, found := manifest.Files[file]
+		if !found {
+			mismatches = append(mismatches, fmt.Sprintf("%s isn't in the signed manifest", file))
+			continue
+		}
+		digest, err := s.objectDigest(bucket, key)
+		if err != nil {
+			return nil, err
+		}
+		if digest != signedDigest {
+			mismatches = append(mismatches, fmt.Sprintf("%s has digest %s instead of %s", file, digest, signedDigest))
+		}
+	}
+
+	for _, file := range slices.Sorted(maps.Keys(manifest.Files)) {
+		if !files[file] {
+			mismatches = append(mismatches, fmt.Sprintf("%s is missing", file))
+		}
+	}
+	return mismatches, nil
+}
+
+func (s *signingObjectStore) objectDigest(bucket, key string) (string, error) {
+	rc, err := s.ObjectStore.GetObject(bucket, key)
+	if err != nil {
+		return "", errors.Wrapf(err, "error getting %s", path.Base(key))
+	}
+	defer rc.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, rc); err != nil {
+		return "", errors.Wrapf(err, "error reading %s", path.Base(key))
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// SignBackup writes the signed manifest of the files of the backup, once it's finalized.
+// ErrSigningNotEnabled is returned when the location doesn't sign the backups.
+func (s *objectBackupStore) SignBackup(name string) error {
+	signer, ok := s.objectStore.(*signingObjectStore)
+	if !ok {
+		return ErrSigningNotEnabled
+	}
+	return signer.sign(s.bucket, name)
+}
+
+// VerifyBackupSignatures compares the files of the backup with its signed manifest, and returns
+// a description of each file which isn't in the manifest, is missing or doesn't match, or of the
+// manifest being invalid. ErrSigningNotEnabled is returned when the location doesn't sign the
+// backups, and ErrBackupNotSigned when the backup has no signed manifest.
+func (s *objectBackupStore) VerifyBackupSignatures(name string) ([]string, error) {
+	signer, ok := s.objectStore.(*signingObjectStore)
+	if !ok {
+		return nil, ErrSigningNotEnabled
+	}
+	return signer.verify(s.bucket, name)
+}
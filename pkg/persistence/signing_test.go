@@ -0,0 +1,184 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func newTestSigningStore(objectStore *inMemoryObjectStore, b byte) *objectBackupStore {
+	layout := NewObjectStoreLayout("")
+	return &objectBackupStore{
+		objectStore: &signingObjectStore{
+			ObjectStore: objectStore,
+			layout:      layout,
+			privateKey:  ed25519.NewKeyFromSeed(bytes.Repeat([]byte{b}, ed25519.SeedSize)),
+		},
+		bucket: "bucket",
+		layout: layout,
+		logger: velerotest.NewLogger(),
+	}
+}
+
+func TestSigningObjectStore(t *testing.T) {
+	objectStore := newInMemoryObjectStore("bucket")
+	store := newTestSigningStore(objectStore, 1)
+
+	require.NoError(t, store.objectStore.PutObject("bucket", "backups/b/velero-backup.json", strings.NewReader("metadata")))
+	require.NoError(t, store.objectStore.PutObject("bucket", "backups/b/b.tar.gz", strings.NewReader("contents")))
+	require.NoError(t, store.objectStore.PutObject("bucket", "backups/b/b-logs.gz", strings.NewReader("logs")))
+	require.NoError(t, store.objectStore.PutObject("bucket", "restores/r/restore-r-logs.gz", strings.NewReader("logs")))
+
+	// the backup is only signed once it's finalized
+	_, err := store.VerifyBackupSignatures("b")
+	assert.Equal(t, ErrBackupNotSigned, err)
+	require.NoError(t, store.SignBackup("b"))
+
+	manifest := new(signedManifest)
+	require.NoError(t, json.Unmarshal(objectStore.Data["bucket"]["backups/b/"+signedManifestFile], manifest))
+	assert.Equal(t, "b", manifest.Backup)
+	assert.Equal(t, []string{"b-logs.gz", "b.tar.gz", "velero-backup.json"}, slices.Sorted(maps.Keys(manifest.Files)))
+	assert.Len(t, objectStore.Data["bucket"], 5)
+
+	mismatches, err := store.VerifyBackupSignatures("b")
+	require.NoError(t, err)
+	assert.Empty(t, mismatches)
+
+	// the manifest follows the files Velero deletes or puts afterwards
+	require.NoError(t, store.objectStore.DeleteObject("bucket", "backups/b/b-logs.gz"))
+	require.NoError(t, store.objectStore.PutObject("bucket", "backups/b/b-results.gz", strings.NewReader("results")))
+	mismatches, err = store.VerifyBackupSignatures("b")
+	require.NoError(t, err)
+	assert.Empty(t, mismatches)
+
+	// deleting each listed object of the backup doesn't fail on the manifest already deleted
+	keys, err := objectStore.ListObjects("bucket", "backups/b/")
+	require.NoError(t, err)
+	for _, key := range keys {
+		require.NoError(t, store.objectStore.DeleteObject("bucket", key))
+	}
+	assert.Len(t, objectStore.Data["bucket"], 1)
+	_, err = store.VerifyBackupSignatures("b")
+	assert.Equal(t, ErrBackupNotSigned, err)
+}
+
+func TestSigningObjectStoreTampering(t *testing.T) {
+	objectStore := newInMemoryObjectStore("bucket")
+	store := newTestSigningStore(objectStore, 1)
+	put := func(backup, file, content string) {
+		require.NoError(t, store.objectStore.PutObject("bucket", "backups/"+backup+"/"+file, strings.NewReader(content)))
+	}
+	put("b", "velero-backup.json", "metadata")
+	put("b", "b.tar.gz", "contents")
+	put("b", "b-results.gz", "results")
+	require.NoError(t, store.SignBackup("b"))
+	signed := maps.Clone(objectStore.Data["bucket"])
+
+	// modified, added and removed behind Velero's back
+	objectStore.Data["bucket"]["backups/b/b.tar.gz"] = []byte("tampered")
+	objectStore.Data["bucket"]["backups/b/b-extra.gz"] = []byte("extra")
+	delete(objectStore.Data["bucket"], "backups/b/b-results.gz")
+
+	mismatches, err := store.VerifyBackupSignatures("b")
+	require.NoError(t, err)
+	require.Len(t, mismatches, 3)
+	assert.Equal(t, "b-extra.gz isn't in the signed manifest", mismatches[0])
+	assert.Contains(t, mismatches[1], "b.tar.gz has digest")
+	assert.Equal(t, "b-results.gz is missing", mismatches[2])
+
+	// the manifest isn't updated by Velero once it's tampered with
+	assert.ErrorContains(t, newTestSigningStore(objectStore, 2).objectStore.DeleteObject("bucket", "backups/b/velero-backup.json"), "the signature of the manifest is invalid")
+
+	// signed by another key
+	objectStore.Data["bucket"] = maps.Clone(signed)
+	mismatches, err = newTestSigningStore(objectStore, 2).VerifyBackupSignatures("b")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"the signature of the manifest is invalid"}, mismatches)
+
+	// replayed from another backup along with its files
+	put("a", "velero-backup.json", "metadata")
+	put("a", "a.tar.gz", "contents")
+	require.NoError(t, store.SignBackup("a"))
+	objectStore.Data["bucket"]["backups/b/"+signedManifestFile] = objectStore.Data["bucket"]["backups/a/"+signedManifestFile]
+	mismatches, err = store.VerifyBackupSignatures("b")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"the signed manifest is the one of backup a"}, mismatches)
+
+	// a tampered manifest doesn't match its signature
+	objectStore.Data["bucket"] = maps.Clone(signed)
+	manifest := new(signedManifest)
+	require.NoError(t, json.Unmarshal(objectStore.Data["bucket"]["backups/b/"+signedManifestFile], manifest))
+	delete(manifest.Files, "b-results.gz")
+	data, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	objectStore.Data["bucket"]["backups/b/"+signedManifestFile] = data
+	mismatches, err = store.VerifyBackupSignatures("b")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"the signature of the manifest is invalid"}, mismatches)
+}
+
+func TestSigningLocation(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, ed25519.SeedSize))+"\n"), 0600))
+
+	objectStore := newInMemoryObjectStore("bucket")
+	location := builder.ForBackupStorageLocation("velero", "default").Provider("provider-1").Bucket("bucket").
+		Encryption(&velerov1api.Encryption{KeyProvider: velerov1api.EncryptionKeyProviderSecret, Key: builder.ForSecretKeySelector("encryption", "key").Result()}).
+		Signing(&velerov1api.Signing{Key: *builder.ForSecretKeySelector("signing", "key").Result()}).Result()
+
+	store, err := NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore(keyFile, nil)).Get(location, objectStoreGetter{"provider-1": objectStore}, velerotest.NewLogger())
+	require.NoError(t, err)
+	assert.IsType(t, &signingObjectStore{}, store.(*objectBackupStore).objectStore)
+
+	// the digests are the ones of the objects before they're encrypted
+	require.NoError(t, store.PutBackupMetadata("b", strings.NewReader("metadata")))
+	require.NoError(t, store.(*objectBackupStore).objectStore.PutObject("bucket", "backups/b/b.tar.gz", strings.NewReader("contents")))
+	require.NoError(t, store.SignBackup("b"))
+	assert.True(t, bytes.HasPrefix(objectStore.Data["bucket"]["backups/b/velero-backup.json"], encryptedObjectMagic))
+	mismatches, err := store.VerifyBackupSignatures("b")
+	require.NoError(t, err)
+	assert.Empty(t, mismatches)
+
+	// the seed must be 32-byte long
+	require.NoError(t, os.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString([]byte("short"))), 0600))
+	location.Spec.Encryption = nil
+	_, err = NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore(keyFile, nil)).Get(location, objectStoreGetter{"provider-1": objectStore}, velerotest.NewLogger())
+	assert.ErrorContains(t, err, "the signing key is 5 bytes long instead of 32")
+
+	location.Spec.Signing = nil
+	store, err = NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore(keyFile, nil)).Get(location, objectStoreGetter{"provider-1": objectStore}, velerotest.NewLogger())
+	require.NoError(t, err)
+	_, err = store.VerifyBackupSignatures("b")
+	assert.Equal(t, ErrSigningNotEnabled, err)
+	assert.Equal(t, ErrSigningNotEnabled, store.SignBackup("b"))
+}
@@ -0,0 +1,253 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// defaultLayoutScope is the tenant and the cluster of the v2 storage layout when they aren't set.
+const defaultLayoutScope = "default"
+
+// indexLock serializes the updates of the index objects of the v2 storage layout.
+var indexLock sync.Mutex
+
+// backupIndex is the content of the index object of the v2 storage layout.
+type backupIndex struct {
+	Backups []string `json:"backups"`
+}
+
+// LocationPrefix returns the prefix the files of the location are stored under, without
+// leading and trailing slashes. It's the per-tenant and per-cluster prefix under the prefix of
+// the location for the v2 storage layout.
+func LocationPrefix(location *velerov1api.BackupStorageLocation) string {
+	var prefix string
+	if location.Spec.ObjectStorage != nil {
+		prefix = strings.Trim(location.Spec.ObjectStorage.Prefix, "/")
+	}
+	if !UsesStorageLayoutV2(location) {
+		return prefix
+	}
+
+	tenant, cluster := location.Spec.StorageLayout.Tenant, location.Spec.StorageLayout.Cluster
+	if tenant == "" {
+		tenant = defaultLayoutScope
+	}
+	if cluster == "" {
+		cluster = defaultLayoutScope
+	}
+	return path.Join(prefix, "tenants", tenant, "clusters", cluster)
+}
+
+// UsesStorageLayoutV2 returns whether the location uses the v2 storage layout.
+func UsesStorageLayoutV2(location *velerov1api.BackupStorageLocation) bool {
+	return location.Spec.StorageLayout != nil && location.Spec.StorageLayout.Version == velerov1api.StorageLayoutV2
+}
+
+// listIndexedBackups returns the backups of the index object, or of the backups directory
+// when there's no index object yet.
+func (s *objectBackupStore) listIndexedBackups() ([]string, error) {
+	index, err := s.getIndex()
+	if err != nil {
+		return nil, err
+	}
+	if index == nil {
+		return s.listBackupDirs()
+	}
+	return index.Backups, nil
+}
+
+// updateIndex applies the update to the backups of the index object, which is created from
+// the backups directory if it doesn't exist yet.
+func (s *objectBackupStore) updateIndex(update func([]string) []string) error {
+	indexLock.Lock()
+	defer indexLock.Unlock()
+
+	backups, err := s.listIndexedBackups()
+	if err != nil {
+		return err
+	}
+	return s.putIndex(update(backups))
+}
+
+func (s *objectBackupStore) getIndex() (*backupIndex, error) {
+	res, err := tryGet(s.objectStore, s.bucket, s.layout.getIndexKey())
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	defer res.Close()
+
+	index := new(backupIndex)
+	if err := json.NewDecoder(res).Decode(index); err != nil {
+		return nil, errors.Wrap(err, "error decoding the index object")
+	}
+	return index, nil
+}
+
+func (s *objectBackupStore) putIndex(backups []string) error {
+	slices.Sort(backups)
+	data, err := json.Marshal(backupIndex{Backups: slices.Compact(backups)})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return s.objectStore.PutObject(s.bucket, s.layout.getIndexKey(), bytes.NewReader(data))
+}
+
+// listBackupDirs returns the backups of the backups directory.
+func (s *objectBackupStore) listBackupDirs() ([]string, error) {
+	return s.listSubdirs(s.layout.subdirs["backups"])
+}
+
+// listSubdirs returns the names of the subdirectories of the directory.
+func (s *objectBackupStore) listSubdirs(dir string) ([]string, error) {
+	prefixes, err := s.objectStore.ListCommonPrefixes(s.bucket, dir, "/")
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		// values returned from a call to ObjectStore's
+		// ListCommonPrefixes method return the *full* prefix, inclusive
+		// of the directory, and include the delimiter ("/") as a suffix. Trim
+		// each of those off to get the name.
+		names = append(names, strings.TrimSuffix(strings.TrimPrefix(prefix, dir), "/"))
+	}
+	return names, nil
+}
+
+// migratedSubdirs are the directories of the v1 layout copied into the v2 layout by MigrateLayout.
+var migratedSubdirs = []string{"backups", "restores", "restic", "kopia", "logs"}
+
+// MigrateLayout copies the files of the flat v1 layout under the prefix of the location into
+// its v2 layout, which must be empty, verifies the copies and indexes the backups. The copies
+// are deleted if any of them fails, so that the location can be switched to the v2 layout only
+// once all its files were copied. The files of the v1 layout aren't deleted. It returns the
+// number of backups copied.
+func (s *objectBackupStore) MigrateLayout() (int, error) {
+	if s.flatLayout == nil {
+		return 0, errors.New("the backup storage location doesn't use the v2 storage layout")
+	}
+
+	existing, err := s.objectStore.ListObjects(s.bucket, s.layout.rootPrefix)
+	if err != nil {
+		return 0, errors.Wrap(err, "error listing the files of the v2 layout")
+	}
+	if len(existing) > 0 {
+		return 0, errors.Errorf("the v2 layout under %q already has files", s.layout.rootPrefix)
+	}
+
+	backups, err := s.listSubdirs(s.flatLayout.subdirs["backups"])
+	if err != nil {
+		return 0, errors.Wrap(err, "error listing the backups of the v1 layout")
+	}
+	slices.Sort(backups)
+	backups = slices.Compact(backups)
+
+	var copied []string
+	for _, subdir := range migratedSubdirs {
+		keys, err := s.copyObjects(s.flatLayout.subdirs[subdir], s.layout.subdirs[subdir])
+		copied = append(copied, keys...)
+		if err != nil {
+			s.deleteObjects(copied)
+			return 0, errors.Wrapf(err, "error copying the %s directory", subdir)
+		}
+	}
+
+	indexLock.Lock()
+	defer indexLock.Unlock()
+	if err := s.putIndex(backups); err != nil {
+		s.deleteObjects(copied)
+		return 0, err
+	}
+	return len(backups), nil
+}
+
+// copyObjects copies the objects under the source prefix to the destination prefix, and checks
+// that each copy has the digest of its source. It returns the keys of the copies, including the
+// one which failed.
+func (s *objectBackupStore) copyObjects(src, dst string) ([]string, error) {
+	keys, err := s.objectStore.ListObjects(s.bucket, src)
+	if err != nil {
+		return nil, err
+	}
+
+	copied := make([]string, 0, len(keys))
+	for _, key := range keys {
+		dstKey := dst + strings.TrimPrefix(key, src)
+
+		obj, err := s.objectStore.GetObject(s.bucket, key)
+		if err != nil {
+			return copied, err
+		}
+		hash := sha256.New()
+		copied = append(copied, dstKey)
+		err = s.objectStore.PutObject(s.bucket, dstKey, io.TeeReader(obj, hash))
+		obj.Close()
+		if err != nil {
+			return copied, err
+		}
+
+		digest, err := s.objectDigest(dstKey)
+		if err != nil {
+			return copied, err
+		}
+		if expected := hex.EncodeToString(hash.Sum(nil)); digest != expected {
+			return copied, errors.Errorf("the copy of %s has digest %s instead of %s", key, digest, expected)
+		}
+	}
+	return copied, nil
+}
+
+// objectDigest returns the hex-encoded SHA-256 digest of the object.
+func (s *objectBackupStore) objectDigest(key string) (string, error) {
+	obj, err := s.objectStore.GetObject(s.bucket, key)
+	if err != nil {
+		return "", err
+	}
+	defer obj.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, obj); err != nil {
+		return "", errors.WithStack(err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// deleteObjects deletes the objects, logging the ones which can't be.
+func (s *objectBackupStore) deleteObjects(keys []string) {
+	for _, key := range keys {
+		if err := s.objectStore.DeleteObject(s.bucket, key); err != nil {
+			s.logger.WithError(err).WithField("key", key).Warn("Unable to delete the copy of a file of the v1 layout")
+		}
+	}
+}
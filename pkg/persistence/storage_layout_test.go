@@ -0,0 +1,183 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func newStorageLayoutV2TestHarness(prefix string) *objectBackupStoreTestHarness {
+	harness := newObjectBackupStoreTestHarness("foo", prefix+"/tenants/tenant-1/clusters/cluster-1/")
+	harness.flatLayout = NewObjectStoreLayout(prefix)
+	return harness
+}
+
+func TestLocationPrefix(t *testing.T) {
+	tests := []struct {
+		name     string
+		location *velerov1api.BackupStorageLocation
+		expected string
+	}{
+		{
+			name:     "v1 layout",
+			location: builder.ForBackupStorageLocation("velero", "default").Bucket("bucket").Prefix("/velero/").Result(),
+			expected: "velero",
+		},
+		{
+			name: "v2 layout",
+			location: builder.ForBackupStorageLocation("velero", "default").Bucket("bucket").Prefix("velero").
+				StorageLayout(velerov1api.StorageLayoutV2, "tenant-1", "cluster-1").Result(),
+			expected: "velero/tenants/tenant-1/clusters/cluster-1",
+		},
+		{
+			name: "v2 layout without prefix, tenant and cluster",
+			location: builder.ForBackupStorageLocation("velero", "default").Bucket("bucket").
+				StorageLayout(velerov1api.StorageLayoutV2, "", "").Result(),
+			expected: "tenants/default/clusters/default",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, LocationPrefix(tc.location))
+		})
+	}
+}
+
+func TestStorageLayoutV2Index(t *testing.T) {
+	harness := newStorageLayoutV2TestHarness("velero")
+
+	// the backups directory is listed while there's no index object
+	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "velero/tenants/tenant-1/clusters/cluster-1/backups/backup-1/velero-backup.json", strings.NewReader("{}")))
+	backups, err := harness.ListBackups()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backup-1"}, backups)
+
+	require.NoError(t, harness.PutBackup(BackupInfo{
+		Name:     "backup-2",
+		Metadata: strings.NewReader("{}"),
+		Contents: strings.NewReader("contents"),
+	}))
+	backups, err = harness.ListBackups()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backup-1", "backup-2"}, backups)
+	assert.Contains(t, harness.objectStore.Data[harness.bucket], "velero/tenants/tenant-1/clusters/cluster-1/index.json")
+
+	// the backups of the index object are listed without listing the backups directory
+	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "velero/tenants/tenant-1/clusters/cluster-1/backups/backup-3/velero-backup.json", strings.NewReader("{}")))
+	backups, err = harness.ListBackups()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backup-1", "backup-2"}, backups)
+
+	require.NoError(t, harness.DeleteBackup("backup-1"))
+	backups, err = harness.ListBackups()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backup-2"}, backups)
+}
+
+// failingPutObjectStore is an in-memory object store failing to put the object with the key.
+type failingPutObjectStore struct {
+	*inMemoryObjectStore
+	key string
+}
+
+func (o *failingPutObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	if key == o.key {
+		return errors.New("an error")
+	}
+	return o.inMemoryObjectStore.PutObject(bucket, key, body)
+}
+
+func TestMigrateLayout(t *testing.T) {
+	harness := newStorageLayoutV2TestHarness("velero")
+	for key, value := range map[string]string{
+		"velero/backups/backup-1/velero-backup.json":          "backup-1",
+		"velero/backups/backup-1/backup-1.tar.gz":             "contents-1",
+		"velero/backups/backup-2/velero-backup.json":          "backup-2",
+		"velero/restores/restore-1/restore-restore-1-logs.gz": "logs",
+		"velero/kopia/ns-1/kopia.repository":                  "repository",
+	} {
+		require.NoError(t, harness.objectStore.PutObject(harness.bucket, key, strings.NewReader(value)))
+	}
+
+	migrated, err := harness.MigrateLayout()
+	require.NoError(t, err)
+	assert.Equal(t, 2, migrated)
+
+	for key, expected := range map[string]string{
+		"velero/tenants/tenant-1/clusters/cluster-1/backups/backup-1/velero-backup.json":          "backup-1",
+		"velero/tenants/tenant-1/clusters/cluster-1/backups/backup-1/backup-1.tar.gz":             "contents-1",
+		"velero/tenants/tenant-1/clusters/cluster-1/backups/backup-2/velero-backup.json":          "backup-2",
+		"velero/tenants/tenant-1/clusters/cluster-1/restores/restore-1/restore-restore-1-logs.gz": "logs",
+		"velero/tenants/tenant-1/clusters/cluster-1/kopia/ns-1/kopia.repository":                  "repository",
+		"velero/backups/backup-1/velero-backup.json":                                              "backup-1",
+	} {
+		obj, err := harness.objectStore.GetObject(harness.bucket, key)
+		require.NoError(t, err, key)
+		data, err := io.ReadAll(obj)
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(data), key)
+	}
+
+	backups, err := harness.ListBackups()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backup-1", "backup-2"}, backups)
+
+	// the files of the v2 layout aren't merged with the ones of the v1 layout
+	_, err = harness.MigrateLayout()
+	assert.EqualError(t, err, `the v2 layout under "velero/tenants/tenant-1/clusters/cluster-1/" already has files`)
+
+	_, err = newObjectBackupStoreTestHarness("foo", "velero").MigrateLayout()
+	assert.EqualError(t, err, "the backup storage location doesn't use the v2 storage layout")
+}
+
+func TestMigrateLayoutDeletesCopiesOnFailure(t *testing.T) {
+	harness := newStorageLayoutV2TestHarness("velero")
+	for key, value := range map[string]string{
+		"velero/backups/backup-1/velero-backup.json":          "backup-1",
+		"velero/restores/restore-1/restore-restore-1-logs.gz": "logs",
+	} {
+		require.NoError(t, harness.objectStore.PutObject(harness.bucket, key, strings.NewReader(value)))
+	}
+	harness.objectBackupStore.objectStore = &failingPutObjectStore{
+		inMemoryObjectStore: harness.objectStore,
+		key:                 "velero/tenants/tenant-1/clusters/cluster-1/restores/restore-1/restore-restore-1-logs.gz",
+	}
+
+	_, err := harness.MigrateLayout()
+	assert.EqualError(t, err, "error copying the restores directory: an error")
+
+	keys, err := harness.objectStore.ListObjects(harness.bucket, "velero/tenants/")
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+}
+
+func TestIsValidStorageLayoutV2(t *testing.T) {
+	harness := newStorageLayoutV2TestHarness("velero")
+	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "velero/tenants/tenant-1/clusters/cluster-1/index.json", bytes.NewReader([]byte(`{"backups":[]}`))))
+	assert.NoError(t, harness.IsValid())
+}
@@ -0,0 +1,160 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"hash/crc32"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// ChecksumAlgorithmConfigKey is the configuration key under which the checksum algorithm of the
+// uploads is passed to the object store plugins, when the provider validates them itself.
+const ChecksumAlgorithmConfigKey = "checksumAlgorithm"
+
+// defaultUploadMaxRetries is how many times an object whose checksum doesn't match is uploaded
+// again when the location doesn't set it.
+const defaultUploadMaxRetries = 3
+
+// checksumAlgorithms are the hash functions of the checksum algorithms.
+var checksumAlgorithms = map[velerov1api.ChecksumAlgorithm]func() hash.Hash{
+	velerov1api.ChecksumAlgorithmCRC32C: func() hash.Hash { return crc32.New(crc32.MakeTable(crc32.Castagnoli)) },
+	velerov1api.ChecksumAlgorithmSHA256: sha256.New,
+}
+
+// verifyingObjectStore verifies the checksum of each object it puts, putting the object again
+// when it doesn't match the checksum of its body, in case it was corrupted on the way to the
+// object store, e.g. by a proxy.
+type verifyingObjectStore struct {
+	velero.ObjectStore
+	algorithm  velerov1api.ChecksumAlgorithm
+	maxRetries int
+	logger     logrus.FieldLogger
+}
+
+// newVerifyingObjectStore returns the object store verifying the uploads to it as the location
+// requires.
+func newVerifyingObjectStore(objectStore velero.ObjectStore, verification *velerov1api.UploadVerification, logger logrus.FieldLogger) (*verifyingObjectStore, error) {
+	if _, found := checksumAlgorithms[verification.Algorithm]; !found {
+		return nil, errors.Errorf("unsupported checksum algorithm %q", verification.Algorithm)
+	}
+	maxRetries := defaultUploadMaxRetries
+	if verification.MaxRetries != nil {
+		maxRetries = *verification.MaxRetries
+	}
+	return &verifyingObjectStore{
+		ObjectStore: objectStore,
+		algorithm:   verification.Algorithm,
+		maxRetries:  maxRetries,
+		logger:      logger,
+	}, nil
+}
+
+func (s *verifyingObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	// the body is read again for each retry
+	seeker, ok := body.(io.ReadSeeker)
+	if !ok {
+		file, err := spoolBody(body)
+		if err != nil {
+			return errors.Wrapf(err, "error buffering object %s", key)
+		}
+		defer func() {
+			file.Close()
+			os.Remove(file.Name())
+		}()
+		seeker = file
+	}
+	start, err := seeker.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	for attempt := 0; ; attempt++ {
+		if _, err := seeker.Seek(start, io.SeekStart); err != nil {
+			return errors.WithStack(err)
+		}
+		h := checksumAlgorithms[s.algorithm]()
+		if err := s.ObjectStore.PutObject(bucket, key, io.TeeReader(seeker, h)); err != nil {
+			return err
+		}
+		expected := hex.EncodeToString(h.Sum(nil))
+
+		actual, err := s.objectChecksum(bucket, key)
+		if err != nil {
+			return errors.Wrapf(err, "error getting the checksum of object %s", key)
+		}
+		if actual == expected {
+			return nil
+		}
+
+		if attempt == s.maxRetries {
+			return errors.Errorf("%s checksum %s of object %s doesn't match the checksum %s of its content after %d attempts", s.algorithm, actual, key, expected, attempt+1)
+		}
+		s.logger.WithField("key", key).Warnf("%s checksum %s of the object doesn't match the checksum %s of its content, uploading it again", s.algorithm, actual, expected)
+	}
+}
+
+// objectChecksum returns the checksum of the object, from the object store if it can return it,
+// or else computed from its content.
+func (s *verifyingObjectStore) objectChecksum(bucket, key string) (string, error) {
+	if getter, ok := s.ObjectStore.(velero.ChecksumGetter); ok {
+		checksum, err := getter.GetObjectChecksum(bucket, key, string(s.algorithm))
+		if !errors.Is(err, velero.ErrChecksumNotSupported) {
+			return checksum, err
+		}
+	}
+
+	rc, err := s.ObjectStore.GetObject(bucket, key)
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	h := checksumAlgorithms[s.algorithm]()
+	if _, err := io.Copy(h, rc); err != nil {
+		return "", errors.WithStack(err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// spoolBody copies the body to a temporary file, for it to be read again.
+func spoolBody(body io.Reader) (*os.File, error) {
+	file, err := os.CreateTemp("", "velero-upload-")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if _, err := io.Copy(file, body); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return nil, errors.WithStack(err)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return nil, errors.WithStack(err)
+	}
+	return file, nil
+}
@@ -0,0 +1,162 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// corruptingObjectStore flips a byte of the first objects it puts, as a faulty proxy would.
+type corruptingObjectStore struct {
+	*inMemoryObjectStore
+	corruptions int
+	puts        int
+}
+
+func (o *corruptingObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	o.puts++
+	obj, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	if o.corruptions > 0 && len(obj) > 0 {
+		o.corruptions--
+		obj[0] ^= 0xff
+	}
+	return o.inMemoryObjectStore.PutObject(bucket, key, bytes.NewReader(obj))
+}
+
+func TestVerifyingObjectStore(t *testing.T) {
+	tests := []struct {
+		name        string
+		algorithm   velerov1api.ChecksumAlgorithm
+		corruptions int
+		body        io.Reader
+		wantPuts    int
+		wantErr     bool
+	}{
+		{
+			name:      "upload matching its checksum",
+			algorithm: velerov1api.ChecksumAlgorithmSHA256,
+			body:      strings.NewReader("contents"),
+			wantPuts:  1,
+		},
+		{
+			name:        "corrupted upload is retried",
+			algorithm:   velerov1api.ChecksumAlgorithmCRC32C,
+			corruptions: 2,
+			body:        strings.NewReader("contents"),
+			wantPuts:    3,
+		},
+		{
+			name:        "unseekable body is buffered for the retries",
+			algorithm:   velerov1api.ChecksumAlgorithmSHA256,
+			corruptions: 1,
+			body:        io.MultiReader(strings.NewReader("con"), strings.NewReader("tents")),
+			wantPuts:    2,
+		},
+		{
+			name:        "upload corrupted beyond the retries fails",
+			algorithm:   velerov1api.ChecksumAlgorithmSHA256,
+			corruptions: 5,
+			body:        strings.NewReader("contents"),
+			wantPuts:    4,
+			wantErr:     true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			objectStore := &corruptingObjectStore{inMemoryObjectStore: newInMemoryObjectStore("bucket"), corruptions: tc.corruptions}
+			store, err := newVerifyingObjectStore(objectStore, &velerov1api.UploadVerification{Algorithm: tc.algorithm}, velerotest.NewLogger())
+			require.NoError(t, err)
+
+			err = store.PutObject("bucket", "key", tc.body)
+			assert.Equal(t, tc.wantPuts, objectStore.puts)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []byte("contents"), objectStore.Data["bucket"]["key"])
+		})
+	}
+}
+
+// checksumObjectStore returns the checksums of the objects, like a plugin implementing
+// velero.ChecksumGetter, or velero.ErrChecksumNotSupported when checksum is empty.
+type checksumObjectStore struct {
+	*inMemoryObjectStore
+	checksum string
+	gets     int
+}
+
+func (o *checksumObjectStore) GetObjectChecksum(bucket, key, algorithm string) (string, error) {
+	if o.checksum == "" {
+		return "", velero.ErrChecksumNotSupported
+	}
+	return o.checksum, nil
+}
+
+func (o *checksumObjectStore) GetObject(bucket, key string) (io.ReadCloser, error) {
+	o.gets++
+	return o.inMemoryObjectStore.GetObject(bucket, key)
+}
+
+func TestVerifyingObjectStoreChecksumGetter(t *testing.T) {
+	// sha256 of "contents"
+	const checksum = "d1b2a59fbea7e20077af9f91b27e95e865061b270be03ff539ab3b73587882e8"
+
+	objectStore := &checksumObjectStore{inMemoryObjectStore: newInMemoryObjectStore("bucket"), checksum: checksum}
+	store, err := newVerifyingObjectStore(objectStore, &velerov1api.UploadVerification{Algorithm: velerov1api.ChecksumAlgorithmSHA256}, velerotest.NewLogger())
+	require.NoError(t, err)
+	require.NoError(t, store.PutObject("bucket", "key", strings.NewReader("contents")))
+	assert.Equal(t, 0, objectStore.gets)
+
+	// the checksum is computed from the content when the object store can't return it
+	objectStore.checksum = ""
+	require.NoError(t, store.PutObject("bucket", "key", strings.NewReader("contents")))
+	assert.Equal(t, 1, objectStore.gets)
+}
+
+func TestUploadVerificationLocation(t *testing.T) {
+	objectStore := newInMemoryObjectStore("bucket")
+	location := builder.ForBackupStorageLocation("velero", "default").Provider("provider-1").Bucket("bucket").
+		UploadVerification(&velerov1api.UploadVerification{Algorithm: velerov1api.ChecksumAlgorithmCRC32C, ProviderValidation: true}).Result()
+	getter := NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil))
+
+	store, err := getter.Get(location, objectStoreGetter{"provider-1": objectStore}, velerotest.NewLogger())
+	require.NoError(t, err)
+	assert.IsType(t, &verifyingObjectStore{}, store.(*objectBackupStore).objectStore)
+	assert.Equal(t, "CRC32C", objectStore.Config[ChecksumAlgorithmConfigKey])
+
+	location.Spec.UploadVerification = &velerov1api.UploadVerification{Algorithm: "MD5"}
+	_, err = getter.Get(location, objectStoreGetter{"provider-1": objectStore}, velerotest.NewLogger())
+	require.Error(t, err)
+	assert.NotContains(t, objectStore.Config, ChecksumAlgorithmConfigKey)
+}
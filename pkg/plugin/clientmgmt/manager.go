@@ -23,6 +23,7 @@ import (
 	"sync"
 
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
 
 	biav1cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/backupitemaction/v1"
 	biav2cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/backupitemaction/v2"
@@ -105,14 +106,14 @@ type manager struct {
 	restartableProcesses map[string]process.RestartableProcess
 }
 
-// NewManager constructs a manager for getting plugins.
-func NewManager(logger logrus.FieldLogger, level logrus.Level, registry process.Registry) Manager {
+// NewManager constructs a manager for getting plugins, connecting to them with the gRPC dialOptions.
+func NewManager(logger logrus.FieldLogger, level logrus.Level, registry process.Registry, dialOptions ...grpc.DialOption) Manager {
 	return &manager{
 		logger:   logger,
 		logLevel: level,
 		registry: registry,
 
-		restartableProcessFactory: process.NewRestartableProcessFactory(),
+		restartableProcessFactory: process.NewRestartableProcessFactory(dialOptions...),
 
 		restartableProcesses: make(map[string]process.RestartableProcess),
 	}
@@ -24,7 +24,9 @@ import (
 	hclog "github.com/hashicorp/go-hclog"
 	hcplugin "github.com/hashicorp/go-plugin"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
 
+	"github.com/vmware-tanzu/velero/pkg/features"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/framework/backupitemaction/v2"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
@@ -38,22 +40,29 @@ type clientBuilder struct {
 	commandArgs  []string
 	clientLogger logrus.FieldLogger
 	pluginLogger hclog.Logger
+	dialOptions  []grpc.DialOption
 }
 
 // newClientBuilder returns a new clientBuilder with commandName to name. If the command matches the currently running
 // process (i.e. velero), this also sets commandArgs to the internal Velero command to run plugins.
-func newClientBuilder(command string, logger logrus.FieldLogger, logLevel logrus.Level) *clientBuilder {
+// The dialOptions are the options of the gRPC connection to the plugin process.
+func newClientBuilder(command string, logger logrus.FieldLogger, logLevel logrus.Level, dialOptions ...grpc.DialOption) *clientBuilder {
 	b := &clientBuilder{
 		commandName:  command,
 		clientLogger: logger,
 		pluginLogger: newLogrusAdapter(logger, logLevel),
+		dialOptions:  dialOptions,
 	}
 	if command == os.Args[0] {
 		// For plugins compiled into the velero executable, we need to run "velero run-plugins"
 		b.commandArgs = []string{"run-plugins"}
 	}
 	// exclude "velero" and "server" from "velero server --flags ..."
-	b.commandArgs = append(b.commandArgs, os.Args[2:]...)
+	b.commandArgs = append(b.commandArgs, removeFeaturesFlag(os.Args[2:])...)
+	// the feature flags of the server may have been changed since it started
+	if enabled := features.Serialize(); enabled != "" {
+		b.commandArgs = append(b.commandArgs, "--features="+enabled)
+	}
 
 	return b
 }
@@ -77,8 +86,9 @@ func (b *clientBuilder) clientConfig() *hcplugin.ClientConfig {
 			string(common.PluginKindDeleteItemAction):    framework.NewDeleteItemActionPlugin(common.ClientLogger(b.clientLogger)),
 			string(common.PluginKindItemBlockAction):     ibav1.NewItemBlockActionPlugin(common.ClientLogger(b.clientLogger)),
 		},
-		Logger: b.pluginLogger,
-		Cmd:    exec.Command(b.commandName, b.commandArgs...), //nolint:gosec // Internal call. No need to check the command line.
+		Logger:          b.pluginLogger,
+		Cmd:             exec.Command(b.commandName, b.commandArgs...), //nolint:gosec // Internal call. No need to check the command line.
+		GRPCDialOptions: b.dialOptions,
 	}
 }
 
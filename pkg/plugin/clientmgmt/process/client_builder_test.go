@@ -25,6 +25,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/vmware-tanzu/velero/pkg/cmd/server/config"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/framework/backupitemaction/v2"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
@@ -71,3 +72,10 @@ func TestClientConfig(t *testing.T) {
 	cc := cb.clientConfig()
 	assert.Equal(t, expected, cc)
 }
+
+func TestClientConfigWithDialOptions(t *testing.T) {
+	dialOptions := framework.GRPCDialOptions(config.PluginGRPCConfig{MaxMessageSize: 16 * 1024 * 1024})
+	cb := newClientBuilder("velero", test.NewLogger(), logrus.InfoLevel, dialOptions...)
+
+	assert.Len(t, cb.clientConfig().GRPCDialOptions, 1)
+}
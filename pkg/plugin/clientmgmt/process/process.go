@@ -17,9 +17,12 @@ limitations under the License.
 package process
 
 import (
+	"strings"
+
 	plugin "github.com/hashicorp/go-plugin"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
 )
@@ -50,8 +53,8 @@ type process struct {
 	protocolClient plugin.ClientProtocol
 }
 
-func newProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (Process, error) {
-	builder := newClientBuilder(command, logger.WithField("cmd", command), logLevel)
+func newProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level, dialOptions ...grpc.DialOption) (Process, error) {
+	builder := newClientBuilder(command, logger.WithField("cmd", command), logLevel, dialOptions...)
 
 	// This creates a new go-plugin Client that has its own unique exec.Cmd for launching the plugin process.
 	client := builder.client()
@@ -71,7 +74,8 @@ func newProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level
 }
 
 // removeFeaturesFlag looks for and removes the '--features' arg
-// as well as the arg immediately following it (the flag value).
+// as well as the arg immediately following it (the flag value),
+// or the '--features=value' arg.
 func removeFeaturesFlag(args []string) []string {
 	var commandArgs []string
 	var featureFlag bool
@@ -83,6 +87,10 @@ func removeFeaturesFlag(args []string) []string {
 			continue
 		}
 
+		if strings.HasPrefix(arg, "--features=") {
+			continue
+		}
+
 		// if the last arg we saw was the flag name, then
 		// this arg is the value for the flag, so skip it
 		if featureFlag {
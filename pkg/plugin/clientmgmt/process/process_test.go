@@ -164,6 +164,11 @@ func Test_removeFeaturesFlag(t *testing.T) {
 			commandArgs: []string{"--log-level", "debug", "--features", "EnableCSI", "--another-flag", "foo"},
 			want:        []string{"--log-level", "debug", "--another-flag", "foo"},
 		},
+		{
+			name:        "when --features has its value in the same arg, it's properly removed",
+			commandArgs: []string{"--log-level", "debug", "--features=EnableCSI", "--another-flag", "foo"},
+			want:        []string{"--log-level", "debug", "--another-flag", "foo"},
+		},
 	}
 
 	for _, tc := range tests {
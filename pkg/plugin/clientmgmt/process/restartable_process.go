@@ -21,6 +21,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
 )
 
 type RestartableProcessFactory interface {
@@ -28,14 +29,17 @@ type RestartableProcessFactory interface {
 }
 
 type restartableProcessFactory struct {
+	dialOptions []grpc.DialOption
 }
 
-func NewRestartableProcessFactory() RestartableProcessFactory {
-	return &restartableProcessFactory{}
+// NewRestartableProcessFactory returns a factory of the restartable processes connecting to their
+// plugins with the gRPC dialOptions.
+func NewRestartableProcessFactory(dialOptions ...grpc.DialOption) RestartableProcessFactory {
+	return &restartableProcessFactory{dialOptions: dialOptions}
 }
 
 func (rpf *restartableProcessFactory) NewRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error) {
-	return newRestartableProcess(command, logger, logLevel)
+	return newRestartableProcess(command, logger, logLevel, rpf.dialOptions...)
 }
 
 type RestartableProcess interface {
@@ -50,9 +54,10 @@ type RestartableProcess interface {
 // to restart a plugin process if it is terminated for any reason. If this happens, all plugins are reinitialized using
 // the original configuration data.
 type restartableProcess struct {
-	command  string
-	logger   logrus.FieldLogger
-	logLevel logrus.Level
+	command     string
+	logger      logrus.FieldLogger
+	logLevel    logrus.Level
+	dialOptions []grpc.DialOption
 
 	// lock guards all of the fields below
 	lock           sync.RWMutex
@@ -69,11 +74,12 @@ type Reinitializer interface {
 }
 
 // newRestartableProcess creates a new restartableProcess for the given command and options.
-func newRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error) {
+func newRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level, dialOptions ...grpc.DialOption) (RestartableProcess, error) {
 	p := &restartableProcess{
 		command:        command,
 		logger:         logger,
 		logLevel:       logLevel,
+		dialOptions:    dialOptions,
 		plugins:        make(map[KindAndName]any),
 		reinitializers: make(map[KindAndName]Reinitializer),
 	}
@@ -109,7 +115,7 @@ func (p *restartableProcess) resetLH() error {
 		return errors.Errorf("unable to restart plugin process: exceeded maximum number of reset failures")
 	}
 
-	process, err := newProcess(p.command, p.logger, p.logLevel)
+	process, err := newProcess(p.command, p.logger, p.logLevel, p.dialOptions...)
 	if err != nil {
 		p.resetFailures++
 		return err
@@ -121,6 +121,34 @@ func (r *restartableObjectStore) PutObject(bucket string, key string, body io.Re
 	return delegate.PutObject(bucket, key, body)
 }
 
+// PutLockedObject restarts the plugin's process if needed, then delegates the call to the object
+// store, which must support object locks.
+func (r *restartableObjectStore) PutLockedObject(bucket, key string, body io.Reader, mode string, retainUntil time.Time) error {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return err
+	}
+	locker, ok := delegate.(velero.ObjectLockStore)
+	if !ok {
+		return errors.Errorf("plugin %T doesn't support object locks", delegate)
+	}
+	return locker.PutLockedObject(bucket, key, body, mode, retainUntil)
+}
+
+// GetObjectChecksum restarts the plugin's process if needed, then delegates the call to the object
+// store, or returns velero.ErrChecksumNotSupported when it can't return the checksums.
+func (r *restartableObjectStore) GetObjectChecksum(bucket, key, algorithm string) (string, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return "", err
+	}
+	getter, ok := delegate.(velero.ChecksumGetter)
+	if !ok {
+		return "", velero.ErrChecksumNotSupported
+	}
+	return getter.GetObjectChecksum(bucket, key, algorithm)
+}
+
 // ObjectExists restarts the plugin's process if needed, then delegates the call.
 func (r *restartableObjectStore) ObjectExists(bucket, key string) (bool, error) {
 	delegate, err := r.getDelegate()
@@ -204,6 +204,18 @@ func TestRestartableObjectStoreDelegatedFunctions(t *testing.T) {
 			ExpectedErrorOutputs:    []any{errors.Errorf("reset error")},
 			ExpectedDelegateOutputs: []any{errors.Errorf("delegate error")},
 		},
+		restartabletest.RestartableDelegateTest{
+			Function:                "PutLockedObject",
+			Inputs:                  []any{"bucket", "key", strings.NewReader("body"), "Compliance", time.Unix(1700000000, 0)},
+			ExpectedErrorOutputs:    []any{errors.Errorf("reset error")},
+			ExpectedDelegateOutputs: []any{errors.Errorf("delegate error")},
+		},
+		restartabletest.RestartableDelegateTest{
+			Function:                "GetObjectChecksum",
+			Inputs:                  []any{"bucket", "key", "SHA256"},
+			ExpectedErrorOutputs:    []any{"", errors.Errorf("reset error")},
+			ExpectedDelegateOutputs: []any{"checksum", errors.Errorf("delegate error")},
+		},
 		restartabletest.RestartableDelegateTest{
 			Function:                "GetObject",
 			Inputs:                  []any{"bucket", "key"},
@@ -0,0 +1,76 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	plugin "github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/keepalive"
+
+	"github.com/vmware-tanzu/velero/pkg/cmd/server/config"
+)
+
+// GRPCDialOptions returns the options of the gRPC connections of Velero to its plugins.
+func GRPCDialOptions(c config.PluginGRPCConfig) []grpc.DialOption {
+	var callOptions []grpc.CallOption
+	if c.MaxMessageSize > 0 {
+		callOptions = append(callOptions, grpc.MaxCallRecvMsgSize(c.MaxMessageSize), grpc.MaxCallSendMsgSize(c.MaxMessageSize))
+	}
+	if c.Compression == config.PluginGRPCCompressionGzip {
+		callOptions = append(callOptions, grpc.UseCompressor(gzip.Name))
+	}
+
+	var options []grpc.DialOption
+	if len(callOptions) > 0 {
+		options = append(options, grpc.WithDefaultCallOptions(callOptions...))
+	}
+	if c.KeepaliveTime > 0 {
+		options = append(options, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                c.KeepaliveTime,
+			Timeout:             c.KeepaliveTimeout,
+			PermitWithoutStream: true,
+		}))
+	}
+
+	return options
+}
+
+// GRPCServerOptions returns the options of the gRPC server of the plugins, matching the options of
+// the connections of Velero to them.
+func GRPCServerOptions(c config.PluginGRPCConfig) []grpc.ServerOption {
+	var options []grpc.ServerOption
+	if c.MaxMessageSize > 0 {
+		options = append(options, grpc.MaxRecvMsgSize(c.MaxMessageSize), grpc.MaxSendMsgSize(c.MaxMessageSize))
+	}
+	if c.KeepaliveTime > 0 {
+		// by default, the server closes the connections pinged more often than every 5 minutes
+		options = append(options, grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             c.KeepaliveTime,
+			PermitWithoutStream: true,
+		}))
+	}
+
+	return options
+}
+
+// grpcServer returns the function creating the gRPC server of the plugins with the options of c.
+func grpcServer(c config.PluginGRPCConfig) func([]grpc.ServerOption) *grpc.Server {
+	return func(opts []grpc.ServerOption) *grpc.Server {
+		return plugin.DefaultGRPCServer(append(opts, GRPCServerOptions(c)...))
+	}
+}
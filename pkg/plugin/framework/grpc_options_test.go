@@ -0,0 +1,65 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/velero/pkg/cmd/server/config"
+)
+
+func TestGRPCOptions(t *testing.T) {
+	tests := []struct {
+		name          string
+		config        config.PluginGRPCConfig
+		dialOptions   int
+		serverOptions int
+	}{
+		{
+			name:   "defaults",
+			config: config.GetDefaultConfig().PluginGRPC,
+		},
+		{
+			name:          "max message size",
+			config:        config.PluginGRPCConfig{MaxMessageSize: 16 * 1024 * 1024},
+			dialOptions:   1,
+			serverOptions: 2,
+		},
+		{
+			name:        "compression",
+			config:      config.PluginGRPCConfig{Compression: config.PluginGRPCCompressionGzip},
+			dialOptions: 1,
+		},
+		{
+			name:          "all",
+			config:        config.PluginGRPCConfig{MaxMessageSize: 1024, KeepaliveTime: time.Minute, KeepaliveTimeout: 20 * time.Second, Compression: config.PluginGRPCCompressionGzip},
+			dialOptions:   2,
+			serverOptions: 3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Len(t, GRPCDialOptions(test.config), test.dialOptions)
+			assert.Len(t, GRPCServerOptions(test.config), test.serverOptions)
+			assert.NotNil(t, grpcServer(test.config)(nil))
+		})
+	}
+}
@@ -23,9 +23,12 @@ import (
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
 const byteChunkSize = 16384
@@ -70,6 +73,16 @@ func (c *ObjectStoreGRPCClient) Init(config map[string]string) error {
 // PutObject creates a new object using the data in body within the specified
 // object storage bucket with the given key.
 func (c *ObjectStoreGRPCClient) PutObject(bucket, key string, body io.Reader) error {
+	return c.putObject(&proto.PutObjectRequest{Plugin: c.Plugin, Bucket: bucket, Key: key}, body)
+}
+
+// PutLockedObject creates a new object like PutObject, locked in the mode until retainUntil.
+func (c *ObjectStoreGRPCClient) PutLockedObject(bucket, key string, body io.Reader, mode string, retainUntil time.Time) error {
+	return c.putObject(&proto.PutObjectRequest{Plugin: c.Plugin, Bucket: bucket, Key: key, LockMode: mode, RetainUntil: retainUntil.Unix()}, body)
+}
+
+// putObject sends the body in chunks over the gRPC stream, each one along with the fields of req.
+func (c *ObjectStoreGRPCClient) putObject(req *proto.PutObjectRequest, body io.Reader) error {
 	stream, err := c.grpcClient.PutObject(context.Background())
 	if err != nil {
 		return common.FromGRPCError(err)
@@ -93,7 +106,7 @@ func (c *ObjectStoreGRPCClient) PutObject(bucket, key string, body io.Reader) er
 			return errors.WithStack(err)
 		}
 
-		if err := stream.Send(&proto.PutObjectRequest{Plugin: c.Plugin, Bucket: bucket, Key: key, Body: chunk[0:n]}); err != nil {
+		if err := stream.Send(&proto.PutObjectRequest{Plugin: req.Plugin, Bucket: req.Bucket, Key: req.Key, LockMode: req.LockMode, RetainUntil: req.RetainUntil, Body: chunk[0:n]}); err != nil {
 			return common.FromGRPCError(err)
 		}
 	}
@@ -221,3 +234,28 @@ func (c *ObjectStoreGRPCClient) CreateSignedURL(bucket, key string, ttl time.Dur
 
 	return res.Url, nil
 }
+
+// GetObjectChecksum returns the checksum of the object computed by the plugin with the algorithm,
+// or velero.ErrChecksumNotSupported when the plugin can't return it, including when it was built
+// against a version of Velero without the GetObjectChecksum method.
+func (c *ObjectStoreGRPCClient) GetObjectChecksum(bucket, key, algorithm string) (string, error) {
+	req := &proto.GetObjectChecksumRequest{
+		Plugin:    c.Plugin,
+		Bucket:    bucket,
+		Key:       key,
+		Algorithm: algorithm,
+	}
+
+	res, err := c.grpcClient.GetObjectChecksum(context.Background(), req)
+	if status.Code(err) == codes.Unimplemented {
+		return "", velero.ErrChecksumNotSupported
+	}
+	if err != nil {
+		return "", common.FromGRPCError(err)
+	}
+	if res.Checksum == "" {
+		return "", velero.ErrChecksumNotSupported
+	}
+
+	return res.Checksum, nil
+}
@@ -93,6 +93,8 @@ func (s *ObjectStoreGRPCServer) PutObject(stream proto.ObjectStore_PutObjectServ
 
 	bucket := firstChunk.Bucket
 	key := firstChunk.Key
+	lockMode := firstChunk.LockMode
+	retainUntil := firstChunk.RetainUntil
 
 	receive := func() ([]byte, error) {
 		if firstChunk != nil {
@@ -118,7 +120,17 @@ func (s *ObjectStoreGRPCServer) PutObject(stream proto.ObjectStore_PutObjectServ
 		return nil
 	}
 
-	if err := impl.PutObject(bucket, key, &StreamReadCloser{receive: receive, close: close}); err != nil {
+	body := &StreamReadCloser{receive: receive, close: close}
+	if retainUntil != 0 {
+		locker, ok := impl.(velero.ObjectLockStore)
+		if !ok {
+			return common.NewGRPCError(errors.Errorf("plugin %s doesn't support object locks", firstChunk.Plugin))
+		}
+		err = locker.PutLockedObject(bucket, key, body, lockMode, time.Unix(retainUntil, 0))
+	} else {
+		err = impl.PutObject(bucket, key, body)
+	}
+	if err != nil {
 		return common.NewGRPCError(err)
 	}
 
@@ -271,3 +283,32 @@ func (s *ObjectStoreGRPCServer) CreateSignedURL(ctx context.Context, req *proto.
 
 	return &proto.CreateSignedURLResponse{Url: url}, nil
 }
+
+// GetObjectChecksum returns the checksum of the object computed by the plugin with the algorithm,
+// or an empty checksum when the plugin can't return it.
+func (s *ObjectStoreGRPCServer) GetObjectChecksum(ctx context.Context, req *proto.GetObjectChecksumRequest) (response *proto.GetObjectChecksumResponse, err error) {
+	defer func() {
+		if recoveredErr := common.HandlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	getter, ok := impl.(velero.ChecksumGetter)
+	if !ok {
+		return &proto.GetObjectChecksumResponse{}, nil
+	}
+	checksum, err := getter.GetObjectChecksum(req.Bucket, req.Key, req.Algorithm)
+	if errors.Is(err, velero.ErrChecksumNotSupported) {
+		return &proto.GetObjectChecksumResponse{}, nil
+	}
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	return &proto.GetObjectChecksumResponse{Checksum: checksum}, nil
+}
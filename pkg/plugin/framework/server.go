@@ -295,6 +295,6 @@ func (s *server) Serve() {
 			string(common.PluginKindDeleteItemAction):    s.deleteItemAction,
 			string(common.PluginKindItemBlockAction):     s.itemBlockAction,
 		},
-		GRPCServer: plugin.DefaultGRPCServer,
+		GRPCServer: grpcServer(s.config.PluginGRPC),
 	})
 }
@@ -27,6 +27,7 @@ const (
 	ObjectStore_ListObjects_FullMethodName        = "/generated.ObjectStore/ListObjects"
 	ObjectStore_DeleteObject_FullMethodName       = "/generated.ObjectStore/DeleteObject"
 	ObjectStore_CreateSignedURL_FullMethodName    = "/generated.ObjectStore/CreateSignedURL"
+	ObjectStore_GetObjectChecksum_FullMethodName  = "/generated.ObjectStore/GetObjectChecksum"
 )
 
 // ObjectStoreClient is the client API for ObjectStore service.
@@ -41,6 +42,7 @@ type ObjectStoreClient interface {
 	ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (*ListObjectsResponse, error)
 	DeleteObject(ctx context.Context, in *DeleteObjectRequest, opts ...grpc.CallOption) (*Empty, error)
 	CreateSignedURL(ctx context.Context, in *CreateSignedURLRequest, opts ...grpc.CallOption) (*CreateSignedURLResponse, error)
+	GetObjectChecksum(ctx context.Context, in *GetObjectChecksumRequest, opts ...grpc.CallOption) (*GetObjectChecksumResponse, error)
 }
 
 type objectStoreClient struct {
@@ -171,6 +173,15 @@ func (c *objectStoreClient) CreateSignedURL(ctx context.Context, in *CreateSigne
 	return out, nil
 }
 
+func (c *objectStoreClient) GetObjectChecksum(ctx context.Context, in *GetObjectChecksumRequest, opts ...grpc.CallOption) (*GetObjectChecksumResponse, error) {
+	out := new(GetObjectChecksumResponse)
+	err := c.cc.Invoke(ctx, ObjectStore_GetObjectChecksum_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ObjectStoreServer is the server API for ObjectStore service.
 // All implementations should embed UnimplementedObjectStoreServer
 // for forward compatibility
@@ -183,6 +194,7 @@ type ObjectStoreServer interface {
 	ListObjects(context.Context, *ListObjectsRequest) (*ListObjectsResponse, error)
 	DeleteObject(context.Context, *DeleteObjectRequest) (*Empty, error)
 	CreateSignedURL(context.Context, *CreateSignedURLRequest) (*CreateSignedURLResponse, error)
+	GetObjectChecksum(context.Context, *GetObjectChecksumRequest) (*GetObjectChecksumResponse, error)
 }
 
 // UnimplementedObjectStoreServer should be embedded to have forward compatible implementations.
@@ -213,6 +225,9 @@ func (UnimplementedObjectStoreServer) DeleteObject(context.Context, *DeleteObjec
 func (UnimplementedObjectStoreServer) CreateSignedURL(context.Context, *CreateSignedURLRequest) (*CreateSignedURLResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSignedURL not implemented")
 }
+func (UnimplementedObjectStoreServer) GetObjectChecksum(context.Context, *GetObjectChecksumRequest) (*GetObjectChecksumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetObjectChecksum not implemented")
+}
 
 // UnsafeObjectStoreServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ObjectStoreServer will
@@ -380,6 +395,24 @@ func _ObjectStore_CreateSignedURL_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ObjectStore_GetObjectChecksum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObjectChecksumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStoreServer).GetObjectChecksum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ObjectStore_GetObjectChecksum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStoreServer).GetObjectChecksum(ctx, req.(*GetObjectChecksumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ObjectStore_ServiceDesc is the grpc.ServiceDesc for ObjectStore service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -411,6 +444,10 @@ var ObjectStore_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSignedURL",
 			Handler:    _ObjectStore_CreateSignedURL_Handler,
 		},
+		{
+			MethodName: "GetObjectChecksum",
+			Handler:    _ObjectStore_GetObjectChecksum_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -18,6 +18,7 @@ package volumehelper
 
 import (
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	crclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -26,6 +27,7 @@ import (
 	"github.com/vmware-tanzu/velero/internal/volumehelper"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
 // ShouldPerformSnapshotWithBackup is used for third-party plugins.
@@ -60,3 +62,36 @@ func ShouldPerformSnapshotWithBackup(
 
 	return volumeHelperImpl.ShouldPerformSnapshot(unstructured, groupResource)
 }
+
+// GetSnapshotClassWithBackup returns the VolumeSnapshotClass selected by the
+// snapshotClass parameter of the snapshot action of the volume policy
+// matching the PVC, empty if there's none.
+func GetSnapshotClassWithBackup(
+	pvc *corev1api.PersistentVolumeClaim,
+	backup velerov1api.Backup,
+	crClient crclient.Client,
+	logger logrus.FieldLogger,
+) (string, error) {
+	resourcePolicies, err := resourcepolicies.GetResourcePoliciesFromBackup(
+		backup,
+		crClient,
+		logger,
+	)
+	if err != nil || resourcePolicies == nil {
+		return "", err
+	}
+
+	pv, err := kubeutil.GetPVForPVC(pvc, crClient)
+	if err != nil {
+		return "", err
+	}
+
+	action, err := resourcePolicies.GetMatchAction(resourcepolicies.NewVolumeFilterData(pv, nil, pvc))
+	if err != nil {
+		return "", err
+	}
+	if action == nil || action.Type != resourcepolicies.Snapshot {
+		return "", nil
+	}
+	return action.SnapshotClass(), nil
+}
@@ -129,6 +129,27 @@ func (_m *ObjectStore) ListObjects(bucket string, prefix string) ([]string, erro
 	return r0, r1
 }
 
+// GetObjectChecksum provides a mock function with given fields: bucket, key, algorithm
+func (_m *ObjectStore) GetObjectChecksum(bucket string, key string, algorithm string) (string, error) {
+	ret := _m.Called(bucket, key, algorithm)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string, string) string); ok {
+		r0 = rf(bucket, key, algorithm)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(bucket, key, algorithm)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ObjectExists provides a mock function with given fields: bucket, key
 func (_m *ObjectStore) ObjectExists(bucket string, key string) (bool, error) {
 	ret := _m.Called(bucket, key)
@@ -150,6 +171,20 @@ func (_m *ObjectStore) ObjectExists(bucket string, key string) (bool, error) {
 	return r0, r1
 }
 
+// PutLockedObject provides a mock function with given fields: bucket, key, body, mode, retainUntil
+func (_m *ObjectStore) PutLockedObject(bucket string, key string, body io.Reader, mode string, retainUntil time.Time) error {
+	ret := _m.Called(bucket, key, body, mode, retainUntil)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, io.Reader, string, time.Time) error); ok {
+		r0 = rf(bucket, key, body, mode, retainUntil)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutObject provides a mock function with given fields: bucket, key, body
 func (_m *ObjectStore) PutObject(bucket string, key string, body io.Reader) error {
 	ret := _m.Called(bucket, key, body)
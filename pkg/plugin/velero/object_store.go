@@ -17,6 +17,7 @@ limitations under the License.
 package velero
 
 import (
+	"errors"
 	"io"
 	"time"
 )
@@ -63,3 +64,25 @@ type ObjectStore interface {
 	// CreateSignedURL creates a pre-signed URL for the given bucket and key that expires after ttl.
 	CreateSignedURL(bucket, key string, ttl time.Duration) (string, error)
 }
+
+// ObjectLockStore is implemented by the ObjectStores of the buckets with object locks, like S3
+// Object Lock, which write objects that can't be overwritten nor deleted until they're released.
+type ObjectLockStore interface {
+	// PutLockedObject creates a new object like PutObject, locked in the given mode, e.g.
+	// Governance or Compliance, until retainUntil.
+	PutLockedObject(bucket, key string, body io.Reader, mode string, retainUntil time.Time) error
+}
+
+// ErrChecksumNotSupported is returned by the ChecksumGetters which can't return the checksum of
+// an object computed with an algorithm, like the plugins built against the versions of Velero
+// without the GetObjectChecksum method.
+var ErrChecksumNotSupported = errors.New("object checksum not supported")
+
+// ChecksumGetter is implemented by the ObjectStores which can return the checksum of an object
+// without it being downloaded. The checksums of the objects of the other ObjectStores are
+// computed from their content.
+type ChecksumGetter interface {
+	// GetObjectChecksum returns the hex-encoded checksum of the object computed with the
+	// algorithm, e.g. CRC32C or SHA256, or ErrChecksumNotSupported.
+	GetObjectChecksum(bucket, key, algorithm string) (string, error)
+}
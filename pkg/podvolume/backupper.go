@@ -63,6 +63,8 @@ type backupper struct {
 	repoEnsurer         *repository.Ensurer
 	crClient            ctrlclient.Client
 	uploaderType        string
+	clusterID           string
+	clusterIDOnce       sync.Once
 	pvbInformer         ctrlcache.Informer
 	handlerRegistration cache.ResourceEventHandlerRegistration
 	wg                  sync.WaitGroup
@@ -70,6 +72,13 @@ type backupper struct {
 	// the PVBs based on specific properties quickly because of the embedded indexes.
 	// The statuses of the PVBs are got updated when Informer receives update events.
 	pvbIndexer cache.Indexer
+
+	// uploadRetries is how many times a failed PVB is re-created, retriedLock guards the
+	// number of times the PVB of each pod volume was re-created and the names of the failed PVBs.
+	uploadRetries int
+	retriedLock   sync.Mutex
+	retries       map[string]int
+	retried       sets.Set[string]
 }
 
 type skippedPVC struct {
@@ -153,6 +162,11 @@ func newBackupper(
 		pvbIndexer: cache.NewIndexer(podVolumeBackupKey, cache.Indexers{
 			indexNamePod: podIndexFunc,
 		}),
+		retries: make(map[string]int),
+		retried: sets.New[string](),
+	}
+	if backup.Spec.PhaseRetries != nil {
+		b.uploadRetries = backup.Spec.PhaseRetries.Upload
 	}
 
 	b.handlerRegistration, _ = pvbInformer.AddEventHandler(
@@ -173,6 +187,10 @@ func newBackupper(
 					return
 				}
 
+				if pvb.Status.Phase == velerov1api.PodVolumeBackupPhaseFailed && b.retryPodVolumeBackup(pvb, log) {
+					return
+				}
+
 				// the Indexer inserts PVB directly if the PVB to be updated doesn't exist
 				if err := b.pvbIndexer.Update(pvb); err != nil {
 					log.WithError(err).Errorf("failed to update PVB %s/%s in indexer", pvb.Namespace, pvb.Name)
@@ -185,11 +203,67 @@ func newBackupper(
 	return b
 }
 
+// retryPodVolumeBackup re-creates the failed PVB unless its pod volume was retried as many times as
+// the backup allows, and returns whether it did. The uploads are idempotent, the data the failed PVB
+// uploaded already is deduplicated by the repository. The PVB stays tracked until the re-created one
+// is processed.
+func (b *backupper) retryPodVolumeBackup(failed *velerov1api.PodVolumeBackup, log logrus.FieldLogger) bool {
+	b.retriedLock.Lock()
+	defer b.retriedLock.Unlock()
+
+	// the events of the PVBs which were re-created already are ignored
+	if b.retried.Has(failed.Name) {
+		return true
+	}
+
+	key, _ := podVolumeBackupKey(failed)
+	if b.retries[key] >= b.uploadRetries {
+		return false
+	}
+	b.retries[key]++
+	b.retried.Insert(failed.Name)
+
+	log = log.WithFields(logrus.Fields{
+		"podVolumeBackup": failed.Name,
+		"pod":             failed.Spec.Pod.Namespace + "/" + failed.Spec.Pod.Name,
+		"volume":          failed.Spec.Volume,
+	})
+	log.Warnf("Pod volume backup failed: %s, retrying it (%d/%d)", failed.Status.Message, b.retries[key], b.uploadRetries)
+
+	copied := failed.DeepCopy()
+	volumeBackup := &velerov1api.PodVolumeBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       copied.Namespace,
+			GenerateName:    copied.GenerateName,
+			OwnerReferences: copied.OwnerReferences,
+			Labels:          copied.Labels,
+			Annotations:     copied.Annotations,
+		},
+		Spec: copied.Spec,
+	}
+	// the re-created PVB replaces the failed one in the indexer before it's created, see BackupPodVolumes
+	if err := b.pvbIndexer.Update(volumeBackup); err != nil {
+		log.WithError(err).Error("Failed to add the re-created PodVolumeBackup to the indexer")
+		return false
+	}
+	// the event handler mustn't wait for the API server
+	go func() {
+		if err := veleroclient.CreateRetryGenerateName(b.crClient, b.ctx, volumeBackup); err != nil {
+			log.WithError(err).Error("Failed to re-create the PodVolumeBackup")
+			if err := b.pvbIndexer.Update(failed); err != nil {
+				log.WithError(err).Error("Failed to update the PodVolumeBackup in the indexer")
+			}
+			b.wg.Done()
+		}
+	}()
+	return true
+}
+
 func resultsKey(ns, name string) string {
 	return fmt.Sprintf("%s/%s", ns, name)
 }
 
-func (b *backupper) getMatchAction(resPolicies *resourcepolicies.Policies, pvc *corev1api.PersistentVolumeClaim, volume *corev1api.Volume) (*resourcepolicies.Action, error) {
+func (b *backupper) getMatchAction(resPolicies *resourcepolicies.Policies, pod *corev1api.Pod, pvc *corev1api.PersistentVolumeClaim, volume *corev1api.Volume) (*resourcepolicies.Action, error) {
 	if pvc != nil {
 		pv := new(corev1api.PersistentVolume)
 		err := b.crClient.Get(context.TODO(), ctrlclient.ObjectKey{Name: pvc.Spec.VolumeName}, pv)
@@ -197,11 +271,13 @@ func (b *backupper) getMatchAction(resPolicies *resourcepolicies.Policies, pvc *
 			return nil, errors.Wrapf(err, "error getting pv for pvc %s", pvc.Spec.VolumeName)
 		}
 		vfd := resourcepolicies.NewVolumeFilterData(pv, nil, pvc)
+		vfd.Pod = pod
 		return resPolicies.GetMatchAction(vfd)
 	}
 
 	if volume != nil {
 		vfd := resourcepolicies.NewVolumeFilterData(nil, volume, pvc)
+		vfd.Pod = pod
 		return resPolicies.GetMatchAction(vfd)
 	}
 
@@ -210,6 +286,20 @@ func (b *backupper) getMatchAction(resPolicies *resourcepolicies.Policies, pvc *
 
 var funcGetRepositoryType = getRepositoryType
 
+// getClusterID returns the ID of the cluster the snapshots are tagged with, got once for the
+// backup.
+func (b *backupper) getClusterID(log logrus.FieldLogger) string {
+	b.clusterIDOnce.Do(func() {
+		clusterID, err := kube.GetClusterID(b.ctx, b.crClient)
+		if err != nil {
+			log.WithError(err).Warn("Failed to get the cluster ID, the pod volume snapshots aren't tagged with it")
+			return
+		}
+		b.clusterID = clusterID
+	})
+	return b.clusterID
+}
+
 func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.Pod, volumesToBackup []string, resPolicies *resourcepolicies.Policies, log logrus.FieldLogger) ([]*velerov1api.PodVolumeBackup, *PVCBackupSummary, []error) {
 	if len(volumesToBackup) == 0 {
 		return nil, nil, nil
@@ -315,7 +405,7 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 		}
 
 		if resPolicies != nil {
-			if action, err := b.getMatchAction(resPolicies, pvc, &volume); err != nil {
+			if action, err := b.getMatchAction(resPolicies, pod, pvc, &volume); err != nil {
 				errs = append(errs, errors.Wrapf(err, "error getting pv for pvc %s", pvc.Spec.VolumeName))
 				continue
 			} else if action != nil && action.Type == resourcepolicies.Skip {
@@ -355,7 +445,7 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 			continue
 		}
 
-		volumeBackup := newPodVolumeBackup(backup, pod, volume, repoIdentifier, b.uploaderType, pvc)
+		volumeBackup := newPodVolumeBackup(backup, pod, volume, repoIdentifier, b.uploaderType, b.getClusterID(log), pvc)
 		// the PVB must be added into the indexer before creating it in API server otherwise unexpected behavior may happen:
 		// the PVB may be handled very quickly by the controller and the informer handler will insert the PVB before "b.pvbIndexer.Add(volumeBackup)" runs,
 		// this causes the PVB inserted by "b.pvbIndexer.Add(volumeBackup)" overrides the PVB in the indexer while the PVB inserted by "b.pvbIndexer.Add(volumeBackup)"
@@ -477,7 +567,7 @@ func isHostPathVolume(volume *corev1api.Volume, pvc *corev1api.PersistentVolumeC
 	return pv.Spec.HostPath != nil, nil
 }
 
-func newPodVolumeBackup(backup *velerov1api.Backup, pod *corev1api.Pod, volume corev1api.Volume, repoIdentifier, uploaderType string, pvc *corev1api.PersistentVolumeClaim) *velerov1api.PodVolumeBackup {
+func newPodVolumeBackup(backup *velerov1api.Backup, pod *corev1api.Pod, volume corev1api.Volume, repoIdentifier, uploaderType, clusterID string, pvc *corev1api.PersistentVolumeClaim) *velerov1api.PodVolumeBackup {
 	pvb := &velerov1api.PodVolumeBackup{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace:    backup.Namespace,
@@ -504,21 +594,19 @@ func newPodVolumeBackup(backup *velerov1api.Backup, pod *corev1api.Pod, volume c
 				Name:      pod.Name,
 				UID:       pod.UID,
 			},
-			Volume: volume.Name,
-			Tags: map[string]string{
-				"backup":     backup.Name,
-				"backup-uid": string(backup.UID),
-				"pod":        pod.Name,
-				"pod-uid":    string(pod.UID),
-				"ns":         pod.Namespace,
-				"volume":     volume.Name,
-			},
+			Volume:                volume.Name,
+			Tags:                  uploader.BackupSnapshotTags(backup, clusterID),
 			BackupStorageLocation: backup.Spec.StorageLocation,
 			RepoIdentifier:        repoIdentifier,
 			UploaderType:          uploaderType,
 		},
 	}
 
+	pvb.Spec.Tags["pod"] = pod.Name
+	pvb.Spec.Tags["pod-uid"] = string(pod.UID)
+	pvb.Spec.Tags["ns"] = pod.Namespace
+	pvb.Spec.Tags["volume"] = volume.Name
+
 	if pvc != nil {
 		// this annotation is used in pkg/restore to identify if a PVC
 		// has a pod volume backup.
@@ -827,6 +827,67 @@ func TestWaitAllPodVolumesProcessed(t *testing.T) {
 	}
 }
 
+func TestRetryPodVolumeBackup(t *testing.T) {
+	pvb := builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").
+		PodNamespace("pod-namespace").PodName("pod-name").Volume("volume").Result()
+	pvb.GenerateName = "pvb-"
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, velerov1api.AddToScheme(scheme))
+	client := ctrlfake.NewClientBuilder().WithScheme(scheme).WithObjects(pvb).Build()
+
+	lw := kube.InternalLW{
+		Client:     client,
+		Namespace:  velerov1api.DefaultNamespace,
+		ObjectList: new(velerov1api.PodVolumeBackupList),
+	}
+	informer := cache.NewSharedIndexInformer(&lw, &velerov1api.PodVolumeBackup{}, 0, cache.Indexers{})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go informer.Run(ctx.Done())
+	require.True(t, cache.WaitForCacheSync(ctx.Done(), informer.HasSynced))
+
+	backup := &velerov1api.Backup{Spec: velerov1api.BackupSpec{PhaseRetries: &velerov1api.BackupPhaseRetries{Upload: 1}}}
+	backuper := newBackupper(ctx, logrus.New(), nil, nil, informer, client, "", backup)
+	backuper.pvbIndexer.Add(pvb)
+	backuper.wg.Add(1)
+
+	// fail fails the PVB like the node-agent does
+	fail := func(name string) {
+		failed := &velerov1api.PodVolumeBackup{}
+		require.NoError(t, client.Get(context.Background(), ctrlclient.ObjectKey{Namespace: velerov1api.DefaultNamespace, Name: name}, failed))
+		failed.Status.Phase = velerov1api.PodVolumeBackupPhaseFailed
+		failed.Status.Message = "node-agent restarted"
+		require.NoError(t, client.Update(context.Background(), failed))
+	}
+	recreated := func() *velerov1api.PodVolumeBackup {
+		var found *velerov1api.PodVolumeBackup
+		require.Eventually(t, func() bool {
+			list := &velerov1api.PodVolumeBackupList{}
+			require.NoError(t, client.List(context.Background(), list))
+			for i := range list.Items {
+				if list.Items[i].Status.Phase == "" {
+					found = &list.Items[i]
+					return true
+				}
+			}
+			return false
+		}, 5*time.Second, 10*time.Millisecond)
+		return found
+	}
+
+	// the failed PVB is re-created once
+	fail(pvb.Name)
+	retried := recreated()
+	assert.Equal(t, pvb.Spec, retried.Spec)
+	fail(retried.Name)
+
+	pvbs := backuper.WaitAllPodVolumesProcessed(logrus.New())
+	require.Len(t, pvbs, 1)
+	assert.Equal(t, retried.Name, pvbs[0].Name)
+	assert.Equal(t, velerov1api.PodVolumeBackupPhaseFailed, pvbs[0].Status.Phase)
+}
+
 func TestPVCBackupSummary(t *testing.T) {
 	pbs := NewPVCBackupSummary()
 	pbs.pvcMap["vol-1"] = builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result()
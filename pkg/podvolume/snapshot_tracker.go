@@ -59,6 +59,12 @@ func (t *Tracker) Take(pod *corev1api.Pod, volumeName string) {
 	t.recordStatus(pod, volumeName, pvcSnapshotStatusTaken, pvcSnapshotStatusTracked)
 }
 
+// Untrack indicates a volume from a pod tracked to be snapshotted by pod volume backup isn't
+// going to be anymore.
+func (t *Tracker) Untrack(pod *corev1api.Pod, volumeName string) {
+	t.recordStatus(pod, volumeName, pvcSnapshotStatusNotTracked, pvcSnapshotStatusTracked)
+}
+
 // Optout indicates a volume from a pod has been opted out by pod's annotation
 func (t *Tracker) Optout(pod *corev1api.Pod, volumeName string) {
 	t.recordStatus(pod, volumeName, pvcSnapshotStatusOptedout, pvcSnapshotStatusNotTracked)
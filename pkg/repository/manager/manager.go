@@ -59,6 +59,9 @@ type Manager interface {
 	// available snapshots in a repo.
 	BatchForget(context.Context, *velerov1api.BackupRepository, []string) []error
 
+	// ListSnapshots lists the snapshots of a repo.
+	ListSnapshots(context.Context, *velerov1api.BackupRepository) ([]provider.Snapshot, error)
+
 	// DefaultMaintenanceFrequency returns the default maintenance frequency from the specific repo
 	DefaultMaintenanceFrequency(repo *velerov1api.BackupRepository) (time.Duration, error)
 }
@@ -221,6 +224,26 @@ func (m *manager) BatchForget(ctx context.Context, repo *velerov1api.BackupRepos
 	return prd.BatchForget(context.Background(), snapshots, param)
 }
 
+func (m *manager) ListSnapshots(ctx context.Context, repo *velerov1api.BackupRepository) ([]provider.Snapshot, error) {
+	m.repoLocker.Lock(repo.Name)
+	defer m.repoLocker.Unlock(repo.Name)
+
+	prd, err := m.getRepositoryProvider(repo)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	param, err := m.assembleRepoParam(repo)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := prd.BoostRepoConnect(ctx, param); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return prd.ListSnapshots(ctx, param)
+}
+
 func (m *manager) DefaultMaintenanceFrequency(repo *velerov1api.BackupRepository) (time.Duration, error) {
 	prd, err := m.getRepositoryProvider(repo)
 	if err != nil {
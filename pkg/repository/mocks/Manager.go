@@ -7,6 +7,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	provider "github.com/vmware-tanzu/velero/pkg/repository/provider"
+
 	time "time"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -101,6 +103,36 @@ func (_m *Manager) Forget(_a0 context.Context, _a1 *v1.BackupRepository, _a2 str
 	return r0
 }
 
+// ListSnapshots provides a mock function with given fields: _a0, _a1
+func (_m *Manager) ListSnapshots(_a0 context.Context, _a1 *v1.BackupRepository) ([]provider.Snapshot, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSnapshots")
+	}
+
+	var r0 []provider.Snapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.BackupRepository) ([]provider.Snapshot, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.BackupRepository) []provider.Snapshot); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]provider.Snapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1.BackupRepository) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // InitRepo provides a mock function with given fields: repo
 func (_m *Manager) InitRepo(repo *v1.BackupRepository) error {
 	ret := _m.Called(repo)
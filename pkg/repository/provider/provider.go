@@ -29,6 +29,19 @@ type RepoParam struct {
 	BackupRepo     *velerov1api.BackupRepository
 }
 
+// Snapshot describes a snapshot of a backup repository
+type Snapshot struct {
+	// ID is the ID of the snapshot in the repository
+	ID string
+	// Tags are the tags the snapshot was taken with
+	Tags map[string]string
+	// StartTime and EndTime are the times the snapshot was taken between
+	StartTime time.Time
+	EndTime   time.Time
+	// Size is the total size of the files of the snapshot, 0 when the repository doesn't record it
+	Size int64
+}
+
 // Provider defines the methods to manipulate a backup repository
 type Provider interface {
 	// InitRepo is to initialize a repository from a new storage place
@@ -60,6 +73,9 @@ type Provider interface {
 	// BatchForget is to delete a list of snapshots from the repository
 	BatchForget(ctx context.Context, snapshotIDs []string, param RepoParam) []error
 
+	// ListSnapshots lists the snapshots of the repository
+	ListSnapshots(ctx context.Context, param RepoParam) ([]Snapshot, error)
+
 	// DefaultMaintenanceFrequency returns the default frequency to run maintenance
 	DefaultMaintenanceFrequency(ctx context.Context, param RepoParam) time.Duration
 }
@@ -90,6 +90,24 @@ func (r *resticRepositoryProvider) BatchForget(ctx context.Context, snapshotIDs
 	return errs
 }
 
+func (r *resticRepositoryProvider) ListSnapshots(ctx context.Context, param RepoParam) ([]Snapshot, error) {
+	snapshots, err := r.svc.Snapshots(param.BackupLocation, param.BackupRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]Snapshot, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		list = append(list, Snapshot{
+			ID:        snapshot.ID,
+			Tags:      snapshot.TagMap(),
+			StartTime: snapshot.Time,
+			EndTime:   snapshot.Time,
+		})
+	}
+	return list, nil
+}
+
 func (r *resticRepositoryProvider) DefaultMaintenanceFrequency(ctx context.Context, param RepoParam) time.Duration {
 	return r.svc.DefaultMaintenanceFrequency()
 }
@@ -27,11 +27,14 @@ import (
 	"time"
 
 	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/repo/manifest"
+	"github.com/kopia/kopia/snapshot"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
 	repoconfig "github.com/vmware-tanzu/velero/pkg/repository/config"
 	repokey "github.com/vmware-tanzu/velero/pkg/repository/keys"
 	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
@@ -65,6 +68,7 @@ var funcTable = localFuncTable{
 const (
 	repoOpDescMaintain = "repo maintenance"
 	repoOpDescForget   = "forget"
+	repoOpDescList     = "list snapshots"
 
 	repoConnectDesc = "unified repo"
 )
@@ -372,6 +376,65 @@ func (urp *unifiedRepoProvider) BatchForget(ctx context.Context, snapshotIDs []s
 	return errs
 }
 
+func (urp *unifiedRepoProvider) ListSnapshots(ctx context.Context, param RepoParam) ([]Snapshot, error) {
+	log := urp.log.WithFields(logrus.Fields{
+		"BSL name":  param.BackupLocation.Name,
+		"repo name": param.BackupRepo.Name,
+		"repo UID":  param.BackupRepo.UID,
+	})
+
+	log.Debug("Start to list snapshots")
+
+	repoOption, err := udmrepo.NewRepoOptions(
+		udmrepo.WithPassword(urp, param),
+		udmrepo.WithConfigFile(urp.workPath, string(param.BackupRepo.UID)),
+		udmrepo.WithDescription(repoOpDescList),
+	)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error to get repo options")
+	}
+
+	bkRepo, err := urp.repoService.Open(ctx, *repoOption)
+	if err != nil {
+		return nil, errors.Wrap(err, "error to open backup repo")
+	}
+
+	defer func() {
+		c := bkRepo.Close(ctx)
+		if c != nil {
+			log.WithError(c).Error("Failed to close repo")
+		}
+	}()
+
+	metadata, err := bkRepo.FindManifests(ctx, udmrepo.ManifestFilter{
+		Labels: map[string]string{manifest.TypeLabelKey: snapshot.ManifestType},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error to find snapshot manifests")
+	}
+
+	snapshots := make([]Snapshot, 0, len(metadata))
+	for _, md := range metadata {
+		mani := new(snapshot.Manifest)
+		if err := bkRepo.GetManifest(ctx, md.ID, &udmrepo.RepoManifest{Payload: mani}); err != nil {
+			return nil, errors.Wrapf(err, "error to get snapshot manifest %s", md.ID)
+		}
+
+		snapshots = append(snapshots, Snapshot{
+			ID:        string(md.ID),
+			Tags:      mani.Tags,
+			StartTime: mani.StartTime.ToTime(),
+			EndTime:   mani.EndTime.ToTime(),
+			Size:      mani.Stats.TotalFileSize,
+		})
+	}
+
+	log.Debugf("Listed %d snapshots", len(snapshots))
+
+	return snapshots, nil
+}
+
 func (urp *unifiedRepoProvider) DefaultMaintenanceFrequency(ctx context.Context, param RepoParam) time.Duration {
 	return urp.repoService.DefaultMaintenanceFrequency()
 }
@@ -527,7 +590,7 @@ func getStorageVariables(backupLocation *velerov1api.BackupStorageLocation, repo
 	prefix := strings.Trim(config["prefix"], "/")
 	if backupLocation.Spec.ObjectStorage != nil {
 		bucket = strings.Trim(backupLocation.Spec.ObjectStorage.Bucket, "/")
-		prefix = strings.Trim(backupLocation.Spec.ObjectStorage.Prefix, "/")
+		prefix = persistence.LocationPrefix(backupLocation)
 	}
 
 	prefix = path.Join(prefix, repoBackend, repoName) + "/"
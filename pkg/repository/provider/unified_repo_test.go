@@ -21,9 +21,12 @@ import (
 	"encoding/base64"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/kopia/kopia/fs"
 	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/snapshot"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -1093,6 +1096,43 @@ func TestBatchForget(t *testing.T) {
 	}
 }
 
+func TestListSnapshots(t *testing.T) {
+	repoService := new(reposervicenmocks.BackupRepoService)
+	backupRepo := new(reposervicenmocks.BackupRepo)
+	repoService.On("Open", mock.Anything, mock.Anything).Return(backupRepo, nil)
+	backupRepo.On("FindManifests", mock.Anything, udmrepo.ManifestFilter{
+		Labels: map[string]string{"type": "snapshot"},
+	}).Return([]*udmrepo.ManifestEntryMetadata{{ID: "snap-1"}}, nil)
+	backupRepo.On("GetManifest", mock.Anything, udmrepo.ID("snap-1"), mock.Anything).Run(func(args mock.Arguments) {
+		mani := args.Get(2).(*udmrepo.RepoManifest).Payload.(*snapshot.Manifest)
+		mani.StartTime = fs.UTCTimestamp(time.Date(2026, 10, 1, 2, 0, 0, 0, time.UTC).UnixNano())
+		mani.Stats.TotalFileSize = 1024
+		mani.Tags = map[string]string{"backup": "backup-1"}
+	}).Return(nil)
+	backupRepo.On("Close", mock.Anything).Return(nil)
+
+	secretStore := new(credmock.SecretStore)
+	secretStore.On("Get", mock.Anything, mock.Anything).Return("fake-password", nil)
+	urp := unifiedRepoProvider{
+		credentialGetter: velerocredentials.CredentialGetter{
+			FromSecret: secretStore,
+		},
+		repoService: repoService,
+		log:         velerotest.NewLogger(),
+	}
+
+	snapshots, err := urp.ListSnapshots(context.Background(), RepoParam{
+		BackupLocation: &velerov1api.BackupStorageLocation{},
+		BackupRepo:     &velerov1api.BackupRepository{},
+	})
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	assert.Equal(t, "snap-1", snapshots[0].ID)
+	assert.Equal(t, map[string]string{"backup": "backup-1"}, snapshots[0].Tags)
+	assert.True(t, snapshots[0].StartTime.Equal(time.Date(2026, 10, 1, 2, 0, 0, 0, time.UTC)))
+	assert.Equal(t, int64(1024), snapshots[0].Size)
+}
+
 func TestInitRepo(t *testing.T) {
 	bsl := velerov1api.BackupStorageLocation{
 		ObjectMeta: v1.ObjectMeta{
@@ -17,7 +17,9 @@ limitations under the License.
 package restic
 
 import (
+	"encoding/json"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -72,14 +74,54 @@ func (r *RepositoryService) Forget(bsl *velerov1api.BackupStorageLocation, repo
 	return r.exec(restic.ForgetCommand(repo.Spec.ResticIdentifier, snapshotID), bsl)
 }
 
+// Snapshot is a snapshot of a restic repository, as listed by the snapshots command.
+type Snapshot struct {
+	ID   string    `json:"id"`
+	Time time.Time `json:"time"`
+	// Tags are the tags of the snapshot, formatted as key=value by Velero.
+	Tags []string `json:"tags"`
+}
+
+// TagMap returns the tags of the snapshot keyed by their names.
+func (s Snapshot) TagMap() map[string]string {
+	tags := make(map[string]string, len(s.Tags))
+	for _, tag := range s.Tags {
+		key, value, _ := strings.Cut(tag, "=")
+		tags[key] = value
+	}
+	return tags
+}
+
+func (r *RepositoryService) Snapshots(bsl *velerov1api.BackupStorageLocation, repo *velerov1api.BackupRepository) ([]Snapshot, error) {
+	snapshotsCmd := restic.SnapshotsCommand(repo.Spec.ResticIdentifier)
+	snapshotsCmd.ExtraFlags = append(snapshotsCmd.ExtraFlags, "--json")
+
+	stdout, err := r.run(snapshotsCmd, bsl)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshots []Snapshot
+	if err := json.Unmarshal([]byte(stdout), &snapshots); err != nil {
+		return nil, errors.Wrap(err, "error decoding the restic snapshots")
+	}
+	return snapshots, nil
+}
+
 func (r *RepositoryService) DefaultMaintenanceFrequency() time.Duration {
 	return restic.DefaultMaintenanceFrequency
 }
 
 func (r *RepositoryService) exec(cmd *restic.Command, bsl *velerov1api.BackupStorageLocation) error {
+	_, err := r.run(cmd, bsl)
+	return err
+}
+
+// run runs the restic command against the repository of the location, returning its stdout.
+func (r *RepositoryService) run(cmd *restic.Command, bsl *velerov1api.BackupStorageLocation) (string, error) {
 	file, err := r.credentialsFileStore.Path(repokey.RepoKeySelector())
 	if err != nil {
-		return err
+		return "", err
 	}
 	// ignore error since there's nothing we can do and it's a temp file.
 	defer os.Remove(file)
@@ -91,7 +133,7 @@ func (r *RepositoryService) exec(cmd *restic.Command, bsl *velerov1api.BackupSto
 	if bsl.Spec.ObjectStorage != nil && bsl.Spec.ObjectStorage.CACert != nil {
 		caCertFile, err = restic.TempCACertFile(bsl.Spec.ObjectStorage.CACert, bsl.Name, r.fileSystem)
 		if err != nil {
-			return errors.Wrap(err, "error creating temp cacert file")
+			return "", errors.Wrap(err, "error creating temp cacert file")
 		}
 		// ignore error since there's nothing we can do and it's a temp file.
 		defer os.Remove(caCertFile)
@@ -100,7 +142,7 @@ func (r *RepositoryService) exec(cmd *restic.Command, bsl *velerov1api.BackupSto
 
 	env, err := restic.CmdEnv(bsl, r.credentialsFileStore)
 	if err != nil {
-		return err
+		return "", err
 	}
 	cmd.Env = env
 
@@ -120,8 +162,8 @@ func (r *RepositoryService) exec(cmd *restic.Command, bsl *velerov1api.BackupSto
 		"stderr":     stderr,
 	}).Debugf("Ran restic command")
 	if err != nil {
-		return errors.Wrapf(err, "error running command=%s, stdout=%s, stderr=%s", cmd.String(), stdout, stderr)
+		return "", errors.Wrapf(err, "error running command=%s, stdout=%s, stderr=%s", cmd.String(), stdout, stderr)
 	}
 
-	return nil
+	return stdout, nil
 }
@@ -0,0 +1,227 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actions
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// ChangeImagePullSecretAction swaps the image pull secrets of the source cluster for the
+// ones of the target cluster if a mapping is found in the plugin's config map. The mapped
+// pull secrets aren't restored, and the imagePullSecrets of the pods, the workloads and
+// the service accounts referencing them are updated to reference the mapped secrets.
+//
+// The keys of the config map are the names of the source secrets, optionally prefixed by
+// the namespace they are restored into as "<namespace>/<name>", and the values are the
+// names of the target secrets. If a target secret doesn't exist in the namespace, it's
+// copied from the secret with the same name in the Velero namespace.
+type ChangeImagePullSecretAction struct {
+	logger          logrus.FieldLogger
+	configMapClient corev1client.ConfigMapInterface
+	secretClient    corev1client.SecretsGetter
+	namespace       string
+}
+
+// NewChangeImagePullSecretAction is the constructor for ChangeImagePullSecretAction.
+func NewChangeImagePullSecretAction(
+	logger logrus.FieldLogger,
+	configMapClient corev1client.ConfigMapInterface,
+	secretClient corev1client.SecretsGetter,
+	namespace string,
+) *ChangeImagePullSecretAction {
+	return &ChangeImagePullSecretAction{
+		logger:          logger,
+		configMapClient: configMapClient,
+		secretClient:    secretClient,
+		namespace:       namespace,
+	}
+}
+
+// AppliesTo returns the resources that ChangeImagePullSecretAction should
+// be run for.
+func (a *ChangeImagePullSecretAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{"secrets", "serviceaccounts", "deployments", "statefulsets", "daemonsets", "replicasets", "replicationcontrollers", "jobs", "cronjobs", "pods"},
+	}, nil
+}
+
+// Execute skips the mapped pull secrets and updates the item's imagePullSecrets if a
+// mapping is found in the config map for the plugin.
+func (a *ChangeImagePullSecretAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	a.logger.Info("Executing ChangeImagePullSecretAction")
+	defer a.logger.Info("Done executing ChangeImagePullSecretAction")
+
+	config, err := common.GetPluginConfig(common.PluginKindRestoreItemAction, "velero.io/change-image-pull-secret", a.configMapClient)
+	if err != nil {
+		return nil, err
+	}
+
+	if config == nil || len(config.Data) == 0 {
+		a.logger.Debug("No image pull secret mappings found")
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	obj, ok := input.Item.(*unstructured.Unstructured)
+	if !ok {
+		return nil, errors.Errorf("object was of unexpected type %T", input.Item)
+	}
+
+	log := a.logger.WithFields(map[string]any{
+		"kind":      obj.GetKind(),
+		"namespace": obj.GetNamespace(),
+		"name":      obj.GetName(),
+	})
+
+	var fields []string
+	switch obj.GetKind() {
+	case "Secret":
+		secretType, _, _ := unstructured.NestedString(obj.UnstructuredContent(), "type")
+		if secretType != string(corev1.SecretTypeDockerConfigJson) && secretType != string(corev1.SecretTypeDockercfg) {
+			return velero.NewRestoreItemActionExecuteOutput(obj), nil
+		}
+		target, found := imagePullSecretMapping(config, obj.GetNamespace(), obj.GetName())
+		if !found {
+			return velero.NewRestoreItemActionExecuteOutput(obj), nil
+		}
+		log.Infof("Skipping the restore of the image pull secret mapped to %s", target)
+		if err := a.ensureSecret(log, obj.GetNamespace(), target); err != nil {
+			return nil, err
+		}
+		return velero.NewRestoreItemActionExecuteOutput(obj).WithoutRestore(), nil
+	case "ServiceAccount":
+		fields = []string{"imagePullSecrets"}
+	case "Pod":
+		fields = []string{"spec", "imagePullSecrets"}
+	case "CronJob":
+		fields = []string{"spec", "jobTemplate", "spec", "template", "spec", "imagePullSecrets"}
+	default:
+		fields = []string{"spec", "template", "spec", "imagePullSecrets"}
+	}
+
+	if err := a.replaceImagePullSecrets(log, obj, config, fields...); err != nil {
+		return nil, err
+	}
+	return velero.NewRestoreItemActionExecuteOutput(obj), nil
+}
+
+func (a *ChangeImagePullSecretAction) replaceImagePullSecrets(log logrus.FieldLogger, obj *unstructured.Unstructured, config *corev1.ConfigMap, fields ...string) error {
+	secrets, found, err := unstructured.NestedSlice(obj.UnstructuredContent(), fields...)
+	if err != nil {
+		return errors.Wrap(err, "error getting item's imagePullSecrets")
+	}
+	if !found || len(secrets) == 0 {
+		return nil
+	}
+
+	updated := false
+	seen := map[string]struct{}{}
+	var result []any
+	for _, secret := range secrets {
+		ref, ok := secret.(map[string]any)
+		if !ok {
+			result = append(result, secret)
+			continue
+		}
+		name, _ := ref["name"].(string)
+		if target, found := imagePullSecretMapping(config, obj.GetNamespace(), name); found {
+			log.Infof("Updating item's image pull secret from %s to %s", name, target)
+			if err := a.ensureSecret(log, obj.GetNamespace(), target); err != nil {
+				return err
+			}
+			name = target
+			ref["name"] = target
+			updated = true
+		}
+		// several source secrets may be mapped to the same target secret
+		if _, dup := seen[name]; dup {
+			continue
+		}
+		seen[name] = struct{}{}
+		result = append(result, ref)
+	}
+
+	if !updated {
+		return nil
+	}
+	if err := unstructured.SetNestedSlice(obj.UnstructuredContent(), result, fields...); err != nil {
+		return errors.Wrap(err, "unable to set item's imagePullSecrets")
+	}
+	return nil
+}
+
+// ensureSecret makes sure the target secret exists in the namespace, copying it from the
+// Velero namespace if it doesn't.
+func (a *ChangeImagePullSecretAction) ensureSecret(log logrus.FieldLogger, namespace, name string) error {
+	if namespace == "" || namespace == a.namespace {
+		return nil
+	}
+
+	if _, err := a.secretClient.Secrets(namespace).Get(context.TODO(), name, metav1.GetOptions{}); err == nil {
+		return nil
+	} else if !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "error getting image pull secret %s/%s", namespace, name)
+	}
+
+	source, err := a.secretClient.Secrets(a.namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		log.Warnf("Image pull secret %s is found neither in namespace %s nor in namespace %s", name, namespace, a.namespace)
+		return nil
+	} else if err != nil {
+		return errors.Wrapf(err, "error getting image pull secret %s/%s", a.namespace, name)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			Labels:    source.Labels,
+		},
+		Type: source.Type,
+		Data: source.Data,
+	}
+	if _, err := a.secretClient.Secrets(namespace).Create(context.TODO(), secret, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return errors.Wrapf(err, "error creating image pull secret %s/%s", namespace, name)
+	}
+	log.Infof("Copied image pull secret %s from namespace %s", name, a.namespace)
+	return nil
+}
+
+// imagePullSecretMapping returns the target secret the source secret is mapped to, the
+// mapping of the secret in the namespace taking precedence.
+func imagePullSecretMapping(config *corev1.ConfigMap, namespace, name string) (string, bool) {
+	if name == "" {
+		return "", false
+	}
+	if target, found := config.Data[namespace+"/"+name]; found && target != "" {
+		return target, true
+	}
+	if target, found := config.Data[name]; found && target != "" {
+		return target, true
+	}
+	return "", false
+}
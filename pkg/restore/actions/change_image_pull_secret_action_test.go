@@ -0,0 +1,174 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actions
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+func TestChangeImagePullSecretActionExecute(t *testing.T) {
+	configMap := builder.ForConfigMap("velero", "change-image-pull-secret").
+		ObjectMeta(builder.WithLabels("velero.io/plugin-config", "", "velero.io/change-image-pull-secret", "RestoreItemAction")).
+		Data("source-registry", "dr-registry", "ns-2/source-registry", "ns-2-registry", "legacy-registry", "dr-registry").
+		Result()
+
+	drSecret := builder.ForSecret("velero", "dr-registry").Data(map[string][]byte{".dockerconfigjson": []byte("{}")}).Result()
+	drSecret.Type = corev1.SecretTypeDockerConfigJson
+
+	pullSecret := builder.ForSecret("ns-1", "source-registry").Data(map[string][]byte{".dockerconfigjson": []byte("{}")}).Result()
+	pullSecret.Type = corev1.SecretTypeDockerConfigJson
+
+	tests := []struct {
+		name        string
+		item        any
+		configMap   *corev1.ConfigMap
+		want        any
+		wantSkipped bool
+		wantCopied  string
+	}{
+		{
+			name:      "no config map",
+			item:      builder.ForPod("ns-1", "pod-1").Result(),
+			configMap: nil,
+			want:      builder.ForPod("ns-1", "pod-1").Result(),
+		},
+		{
+			name:        "a mapped pull secret is skipped and the target secret is copied",
+			item:        pullSecret,
+			configMap:   configMap,
+			want:        pullSecret,
+			wantSkipped: true,
+			wantCopied:  "ns-1/dr-registry",
+		},
+		{
+			name:      "a secret of another type is restored",
+			item:      builder.ForSecret("ns-1", "source-registry").Data(map[string][]byte{"password": []byte("abc")}).Result(),
+			configMap: configMap,
+			want:      builder.ForSecret("ns-1", "source-registry").Data(map[string][]byte{"password": []byte("abc")}).Result(),
+		},
+		{
+			name: "the pull secrets of a pod are swapped and deduplicated",
+			item: &corev1.Pod{
+				TypeMeta:   metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "pod-1"},
+				Spec: corev1.PodSpec{ImagePullSecrets: []corev1.LocalObjectReference{
+					{Name: "source-registry"}, {Name: "legacy-registry"}, {Name: "other-registry"},
+				}},
+			},
+			configMap: configMap,
+			want: &corev1.Pod{
+				TypeMeta:   metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "pod-1"},
+				Spec: corev1.PodSpec{ImagePullSecrets: []corev1.LocalObjectReference{
+					{Name: "dr-registry"}, {Name: "other-registry"},
+				}},
+			},
+			wantCopied: "ns-1/dr-registry",
+		},
+		{
+			name: "the mapping of the namespace takes precedence",
+			item: &corev1.ServiceAccount{
+				TypeMeta:         metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"},
+				ObjectMeta:       metav1.ObjectMeta{Namespace: "ns-2", Name: "default"},
+				ImagePullSecrets: []corev1.LocalObjectReference{{Name: "source-registry"}},
+			},
+			configMap: configMap,
+			want: &corev1.ServiceAccount{
+				TypeMeta:         metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"},
+				ObjectMeta:       metav1.ObjectMeta{Namespace: "ns-2", Name: "default"},
+				ImagePullSecrets: []corev1.LocalObjectReference{{Name: "ns-2-registry"}},
+			},
+		},
+		{
+			name:       "the pull secrets of a cronjob are swapped",
+			item:       cronJobWithPullSecrets("source-registry"),
+			configMap:  configMap,
+			want:       cronJobWithPullSecrets("dr-registry"),
+			wantCopied: "ns-1/dr-registry",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			clientset := fake.NewSimpleClientset(drSecret)
+			a := NewChangeImagePullSecretAction(
+				logrus.StandardLogger(),
+				clientset.CoreV1().ConfigMaps("velero"),
+				clientset.CoreV1(),
+				"velero",
+			)
+
+			if tc.configMap != nil {
+				_, err := clientset.CoreV1().ConfigMaps(tc.configMap.Namespace).Create(context.TODO(), tc.configMap, metav1.CreateOptions{})
+				require.NoError(t, err)
+			}
+
+			unstructuredMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.item)
+			require.NoError(t, err)
+			input := &velero.RestoreItemActionExecuteInput{
+				Item: &unstructured.Unstructured{Object: unstructuredMap},
+			}
+
+			res, err := a.Execute(input)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantSkipped, res.SkipRestore)
+
+			wantUnstructured, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.want)
+			require.NoError(t, err)
+			assert.Equal(t, &unstructured.Unstructured{Object: wantUnstructured}, res.UpdatedItem)
+
+			if tc.wantCopied != "" {
+				namespace, name, _ := strings.Cut(tc.wantCopied, "/")
+				copied, err := clientset.CoreV1().Secrets(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+				require.NoError(t, err)
+				assert.Equal(t, drSecret.Data, copied.Data)
+				assert.Equal(t, corev1.SecretTypeDockerConfigJson, copied.Type)
+			}
+		})
+	}
+}
+
+func cronJobWithPullSecrets(name string) *batchv1.CronJob {
+	return &batchv1.CronJob{
+		TypeMeta:   metav1.TypeMeta{Kind: "CronJob", APIVersion: "batch/v1"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "cronjob-1"},
+		Spec: batchv1.CronJobSpec{
+			JobTemplate: batchv1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{ImagePullSecrets: []corev1.LocalObjectReference{{Name: name}}},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actions
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// ClusterAPIPauseAction pauses the reconciliation of restored Cluster API Clusters, so that
+// Cluster API doesn't create or delete infrastructure while the rest of the cluster's objects
+// are being restored. The restore finalizer resumes the reconciliation once the restore is done.
+type ClusterAPIPauseAction struct {
+	logger logrus.FieldLogger
+}
+
+func NewClusterAPIPauseAction(logger logrus.FieldLogger) *ClusterAPIPauseAction {
+	return &ClusterAPIPauseAction{logger: logger}
+}
+
+func (a *ClusterAPIPauseAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{kuberesource.ClusterAPIClusters.String()},
+	}, nil
+}
+
+func (a *ClusterAPIPauseAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	a.logger.Info("Executing ClusterAPIPauseAction")
+	defer a.logger.Info("Done executing ClusterAPIPauseAction")
+
+	cluster := input.Item.(*unstructured.Unstructured)
+
+	paused, _, err := unstructured.NestedBool(cluster.Object, "spec", "paused")
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting spec.paused of cluster")
+	}
+	// a cluster paused in the backup stays paused after the restore
+	if paused {
+		return velero.NewRestoreItemActionExecuteOutput(cluster), nil
+	}
+
+	if err := unstructured.SetNestedField(cluster.Object, true, "spec", "paused"); err != nil {
+		return nil, errors.Wrap(err, "error setting spec.paused of cluster")
+	}
+
+	annotations := cluster.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[velerov1api.ClusterAPIPausedByRestoreAnnotation] = "true"
+	cluster.SetAnnotations(annotations)
+
+	a.logger.Infof("Paused reconciliation of cluster %s/%s until the restore is finished", cluster.GetNamespace(), cluster.GetName())
+
+	return velero.NewRestoreItemActionExecuteOutput(cluster), nil
+}
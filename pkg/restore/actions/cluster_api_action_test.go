@@ -0,0 +1,85 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestClusterAPIPauseActionExecute(t *testing.T) {
+	newCluster := func(spec map[string]any, annotations map[string]any) *unstructured.Unstructured {
+		metadata := map[string]any{"namespace": "capi", "name": "c1"}
+		if annotations != nil {
+			metadata["annotations"] = annotations
+		}
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "cluster.x-k8s.io/v1beta1",
+			"kind":       "Cluster",
+			"metadata":   metadata,
+			"spec":       spec,
+		}}
+	}
+
+	tests := []struct {
+		name     string
+		item     *unstructured.Unstructured
+		expected *unstructured.Unstructured
+	}{
+		{
+			name: "running cluster is paused and annotated",
+			item: newCluster(map[string]any{"controlPlaneEndpoint": map[string]any{"host": "1.2.3.4"}}, nil),
+			expected: newCluster(
+				map[string]any{"controlPlaneEndpoint": map[string]any{"host": "1.2.3.4"}, "paused": true},
+				map[string]any{velerov1api.ClusterAPIPausedByRestoreAnnotation: "true"},
+			),
+		},
+		{
+			name: "cluster with paused false is paused and annotated",
+			item: newCluster(map[string]any{"paused": false}, map[string]any{"foo": "bar"}),
+			expected: newCluster(
+				map[string]any{"paused": true},
+				map[string]any{"foo": "bar", velerov1api.ClusterAPIPausedByRestoreAnnotation: "true"},
+			),
+		},
+		{
+			name:     "cluster paused in the backup is not annotated",
+			item:     newCluster(map[string]any{"paused": true}, nil),
+			expected: newCluster(map[string]any{"paused": true}, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			action := NewClusterAPIPauseAction(velerotest.NewLogger())
+
+			res, err := action.Execute(&velero.RestoreItemActionExecuteInput{
+				Item:           tc.item,
+				ItemFromBackup: tc.item.DeepCopy(),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, res.UpdatedItem)
+		})
+	}
+}
@@ -177,7 +177,7 @@ func (p *pvcRestoreItemAction) Execute(
 				string(velerov1api.AsyncOperationIDPrefixDataDownload) +
 					string(input.Restore.UID) + "." + string(pvcFromBackup.UID))
 			dataDownload, err := restoreFromDataUploadResult(
-				context.Background(), input.Restore, backup, &pvc, newNamespace,
+				context.Background(), input.Restore, backup, &pvcFromBackup, &pvc, newNamespace,
 				operationID, p.crClient)
 			if err != nil {
 				logger.Errorf("Fail to restore from DataUploadResult: %s", err.Error())
@@ -504,7 +504,7 @@ func restoreFromDataUploadResult(
 	ctx context.Context,
 	restore *velerov1api.Restore,
 	backup *velerov1api.Backup,
-	pvc *corev1api.PersistentVolumeClaim,
+	pvcFromBackup, pvc *corev1api.PersistentVolumeClaim,
 	newNamespace, operationID string,
 	crClient crclient.Client,
 ) (*velerov2alpha1.DataDownload, error) {
@@ -513,6 +513,10 @@ func restoreFromDataUploadResult(
 		return nil, errors.Wrapf(err, "fail get DataUploadResult for restore: %s",
 			restore.Name)
 	}
+	if err := checkDataDownloadCompatibility(restore, dataUploadResult, pvcFromBackup, pvc); err != nil {
+		return nil, errors.Wrapf(err, "fail to restore PVC %s/%s from DataUploadResult",
+			pvc.Namespace, pvc.Name)
+	}
 	pvc.Spec.VolumeName = ""
 	if pvc.Spec.Selector == nil {
 		pvc.Spec.Selector = &metav1.LabelSelector{}
@@ -540,6 +544,42 @@ func restoreFromDataUploadResult(
 	return dataDownload, nil
 }
 
+// checkDataDownloadCompatibility returns an error if the data captured by the DataUpload
+// can't be restored into the PVC, e.g. when the PVC is moved to the storage class of
+// another provider with a different volume mode, before any DataDownload is created.
+func checkDataDownloadCompatibility(
+	restore *velerov1api.Restore,
+	dataUploadResult *velerov2alpha1.DataUploadResult,
+	pvcFromBackup, pvc *corev1api.PersistentVolumeClaim,
+) error {
+	sourceMode := pvcVolumeMode(pvcFromBackup)
+	targetMode := pvcVolumeMode(pvc)
+	if sourceMode != targetMode {
+		return errors.Errorf("the data is backed up from a %s volume and can't be restored into a %s volume",
+			sourceMode, targetMode)
+	}
+
+	if restore.Spec.UploaderConfig == nil ||
+		(len(restore.Spec.UploaderConfig.UIDMapping) == 0 && len(restore.Spec.UploaderConfig.GIDMapping) == 0) {
+		return nil
+	}
+	if targetMode == corev1api.PersistentVolumeBlock {
+		return errors.New("the ID mappings only apply to Filesystem volumes")
+	}
+	if dataUploadResult.NodeOS == velerov2alpha1.NodeOSWindows {
+		return errors.New("the ID mappings don't apply to the volumes of Windows workloads")
+	}
+	return nil
+}
+
+// pvcVolumeMode returns the volume mode of the PVC, Filesystem if unset.
+func pvcVolumeMode(pvc *corev1api.PersistentVolumeClaim) corev1api.PersistentVolumeMode {
+	if pvc.Spec.VolumeMode == nil {
+		return corev1api.PersistentVolumeFilesystem
+	}
+	return *pvc.Spec.VolumeMode
+}
+
 func (p *pvcRestoreItemAction) isResourceExist(
 	pvc corev1api.PersistentVolumeClaim,
 	restore velerov1api.Restore,
@@ -665,3 +665,73 @@ func TestNewPvcRestoreItemAction(t *testing.T) {
 	_, err1 := plugin1(logger)
 	require.NoError(t, err1)
 }
+
+func TestCheckDataDownloadCompatibility(t *testing.T) {
+	block := corev1api.PersistentVolumeBlock
+	filesystem := corev1api.PersistentVolumeFilesystem
+
+	tests := []struct {
+		name          string
+		uploaderCfg   *velerov1api.UploaderConfigForRestore
+		nodeOS        velerov2alpha1.NodeOS
+		sourceMode    *corev1api.PersistentVolumeMode
+		targetMode    *corev1api.PersistentVolumeMode
+		expectedError string
+	}{
+		{
+			name: "unset volume modes are Filesystem",
+		},
+		{
+			name:       "same volume modes",
+			sourceMode: &block,
+			targetMode: &block,
+		},
+		{
+			name:          "Filesystem data restored into a Block volume",
+			targetMode:    &block,
+			expectedError: "the data is backed up from a Filesystem volume and can't be restored into a Block volume",
+		},
+		{
+			name:          "Block data restored into a Filesystem volume",
+			sourceMode:    &block,
+			targetMode:    &filesystem,
+			expectedError: "the data is backed up from a Block volume and can't be restored into a Filesystem volume",
+		},
+		{
+			name:        "ID mappings for a Filesystem volume",
+			uploaderCfg: &velerov1api.UploaderConfigForRestore{UIDMapping: map[string]string{"1000": "2000"}},
+			nodeOS:      velerov2alpha1.NodeOSLinux,
+		},
+		{
+			name:          "ID mappings for a Block volume",
+			uploaderCfg:   &velerov1api.UploaderConfigForRestore{GIDMapping: map[string]string{"1000": "2000"}},
+			sourceMode:    &block,
+			targetMode:    &block,
+			expectedError: "the ID mappings only apply to Filesystem volumes",
+		},
+		{
+			name:          "ID mappings for a Windows volume",
+			uploaderCfg:   &velerov1api.UploaderConfigForRestore{UIDMapping: map[string]string{"1000": "2000"}},
+			nodeOS:        velerov2alpha1.NodeOSWindows,
+			expectedError: "the ID mappings don't apply to the volumes of Windows workloads",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			restore := builder.ForRestore("velero", "restore").Result()
+			restore.Spec.UploaderConfig = tc.uploaderCfg
+			pvcFromBackup := builder.ForPersistentVolumeClaim("ns", "pvc").Result()
+			pvcFromBackup.Spec.VolumeMode = tc.sourceMode
+			pvc := builder.ForPersistentVolumeClaim("ns", "pvc").Result()
+			pvc.Spec.VolumeMode = tc.targetMode
+
+			err := checkDataDownloadCompatibility(restore, &velerov2alpha1.DataUploadResult{NodeOS: tc.nodeOS}, pvcFromBackup, pvc)
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
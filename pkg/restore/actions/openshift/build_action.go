@@ -0,0 +1,74 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openshift
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+const (
+	// buildNameAnnotation is set by OpenShift on the pods running a build.
+	buildNameAnnotation = "openshift.io/build.name"
+	buildConfigKind     = "BuildConfig"
+)
+
+// BuildAction skips the builds started by a BuildConfig and the pods running builds. The
+// BuildConfig is restored instead and starts new builds when its triggers fire; restoring
+// completed builds would only show stale history, and restoring a running build's pod would
+// run it again outside of the build controller.
+type BuildAction struct {
+	logger logrus.FieldLogger
+}
+
+func NewBuildAction(logger logrus.FieldLogger) *BuildAction {
+	return &BuildAction{logger: logger}
+}
+
+func (a *BuildAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{"builds.build.openshift.io", "pods"},
+	}, nil
+}
+
+func (a *BuildAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	metadata, err := meta.Accessor(input.Item)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	log := a.logger.WithField("item", metadata.GetNamespace()+"/"+metadata.GetName())
+
+	if input.Item.GetObjectKind().GroupVersionKind().Kind == "Pod" {
+		if build, ok := metadata.GetAnnotations()[buildNameAnnotation]; ok {
+			log.Infof("Skipping restore of pod running build %s", build)
+			return velero.NewRestoreItemActionExecuteOutput(input.Item).WithoutRestore(), nil
+		}
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	for _, owner := range metadata.GetOwnerReferences() {
+		if owner.Kind == buildConfigKind {
+			log.Infof("Skipping restore of build started by BuildConfig %s", owner.Name)
+			return velero.NewRestoreItemActionExecuteOutput(input.Item).WithoutRestore(), nil
+		}
+	}
+
+	return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+}
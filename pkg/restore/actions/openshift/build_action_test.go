@@ -0,0 +1,94 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openshift
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestBuildActionExecute(t *testing.T) {
+	tests := []struct {
+		name         string
+		item         map[string]any
+		expectedSkip bool
+	}{
+		{
+			name: "build started by a BuildConfig is skipped",
+			item: map[string]any{
+				"apiVersion": "build.openshift.io/v1",
+				"kind":       "Build",
+				"metadata": map[string]any{
+					"namespace": "ns-1",
+					"name":      "app-1",
+					"ownerReferences": []any{
+						map[string]any{"apiVersion": "build.openshift.io/v1", "kind": "BuildConfig", "name": "app", "uid": "1"},
+					},
+				},
+			},
+			expectedSkip: true,
+		},
+		{
+			name: "build started manually is restored",
+			item: map[string]any{
+				"apiVersion": "build.openshift.io/v1",
+				"kind":       "Build",
+				"metadata":   map[string]any{"namespace": "ns-1", "name": "manual"},
+			},
+		},
+		{
+			name: "build pod is skipped",
+			item: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"metadata": map[string]any{
+					"namespace":   "ns-1",
+					"name":        "app-1-build",
+					"annotations": map[string]any{buildNameAnnotation: "app-1"},
+				},
+			},
+			expectedSkip: true,
+		},
+		{
+			name: "application pod is restored",
+			item: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"metadata":   map[string]any{"namespace": "ns-1", "name": "app-1-abcde"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			item := &unstructured.Unstructured{Object: tc.item}
+			res, err := NewBuildAction(velerotest.NewLogger()).Execute(&velero.RestoreItemActionExecuteInput{
+				Item:           item,
+				ItemFromBackup: item.DeepCopy(),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedSkip, res.SkipRestore)
+			assert.Equal(t, item, res.UpdatedItem)
+		})
+	}
+}
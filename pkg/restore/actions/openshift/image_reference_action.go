@@ -0,0 +1,237 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openshift
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// internalRegistryHosts are the service hostnames of the OpenShift internal image registry,
+// which serves the images of an ImageStream under "<host>/<namespace>/<imagestream>".
+var internalRegistryHosts = []string{
+	"image-registry.openshift-image-registry.svc:5000",
+	"docker-registry.default.svc:5000",
+}
+
+// ImageReferenceAction updates the references to ImageStreams of remapped namespaces, so that
+// restored workloads, DeploymentConfigs, BuildConfigs and ImageStreams use the ImageStreams
+// restored into the target namespace instead of the ones in the source namespace.
+type ImageReferenceAction struct {
+	logger logrus.FieldLogger
+}
+
+func NewImageReferenceAction(logger logrus.FieldLogger) *ImageReferenceAction {
+	return &ImageReferenceAction{logger: logger}
+}
+
+func (a *ImageReferenceAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{
+			"deployments", "statefulsets", "daemonsets", "replicasets", "replicationcontrollers", "jobs", "cronjobs", "pods",
+			"deploymentconfigs.apps.openshift.io", "buildconfigs.build.openshift.io", "imagestreams.image.openshift.io",
+		},
+	}, nil
+}
+
+func (a *ImageReferenceAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	if len(input.Restore.Spec.NamespaceMapping) == 0 {
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	obj, ok := input.Item.(*unstructured.Unstructured)
+	if !ok {
+		return nil, errors.Errorf("object was of unexpected type %T", input.Item)
+	}
+	r := &imageReferenceRemapper{
+		log: a.logger.WithFields(logrus.Fields{
+			"kind":      obj.GetKind(),
+			"namespace": obj.GetNamespace(),
+			"name":      obj.GetName(),
+		}),
+		namespaceMapping: input.Restore.Spec.NamespaceMapping,
+	}
+
+	var err error
+	switch obj.GetKind() {
+	case "Pod":
+		err = r.remapPodSpec(obj.Object, "spec")
+	case "CronJob":
+		err = r.remapPodSpec(obj.Object, "spec", "jobTemplate", "spec", "template", "spec")
+	case "DeploymentConfig":
+		if err = r.remapPodSpec(obj.Object, "spec", "template", "spec"); err == nil {
+			err = r.remapObjectReferences(obj.Object, []string{"spec", "triggers"}, "imageChangeParams", "from")
+		}
+	case "BuildConfig":
+		err = r.remapBuildConfig(obj.Object)
+	case "ImageStream":
+		err = r.remapObjectReferences(obj.Object, []string{"spec", "tags"}, "from")
+	default:
+		err = r.remapPodSpec(obj.Object, "spec", "template", "spec")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return velero.NewRestoreItemActionExecuteOutput(obj), nil
+}
+
+type imageReferenceRemapper struct {
+	log              logrus.FieldLogger
+	namespaceMapping map[string]string
+}
+
+// remapImage returns the image with the namespace path segment remapped if the image is served
+// by the internal registry from a remapped namespace.
+func (r *imageReferenceRemapper) remapImage(image string) (string, bool) {
+	for _, host := range internalRegistryHosts {
+		rest, ok := strings.CutPrefix(image, host+"/")
+		if !ok {
+			continue
+		}
+		namespace, repository, found := strings.Cut(rest, "/")
+		if !found {
+			return image, false
+		}
+		if target, ok := r.namespaceMapping[namespace]; ok {
+			return host + "/" + target + "/" + repository, true
+		}
+	}
+	return image, false
+}
+
+func (r *imageReferenceRemapper) remapPodSpec(obj map[string]any, podSpecPath ...string) error {
+	for _, field := range []string{"containers", "initContainers"} {
+		fields := append(append([]string{}, podSpecPath...), field)
+		containers, found, err := unstructured.NestedSlice(obj, fields...)
+		if err != nil {
+			return errors.Wrapf(err, "error getting %s", strings.Join(fields, "."))
+		}
+		if !found {
+			continue
+		}
+
+		updated := false
+		for _, c := range containers {
+			container, ok := c.(map[string]any)
+			if !ok {
+				continue
+			}
+			image, _ := container["image"].(string)
+			if newImage, ok := r.remapImage(image); ok {
+				r.log.Infof("Updating image %s to %s", image, newImage)
+				container["image"] = newImage
+				updated = true
+			}
+		}
+
+		if updated {
+			if err := unstructured.SetNestedSlice(obj, containers, fields...); err != nil {
+				return errors.Wrapf(err, "error setting %s", strings.Join(fields, "."))
+			}
+		}
+	}
+	return nil
+}
+
+// remapObjectReference remaps the namespace of an ObjectReference to an ImageStreamTag,
+// ImageStreamImage or DockerImage. References without a namespace are relative to the
+// item's namespace and follow the item into its target namespace.
+func (r *imageReferenceRemapper) remapObjectReference(ref map[string]any) bool {
+	kind, _ := ref["kind"].(string)
+	switch kind {
+	case "ImageStreamTag", "ImageStreamImage":
+		namespace, _ := ref["namespace"].(string)
+		if target, ok := r.namespaceMapping[namespace]; ok {
+			r.log.Infof("Updating %s reference namespace %s to %s", kind, namespace, target)
+			ref["namespace"] = target
+			return true
+		}
+	case "DockerImage":
+		name, _ := ref["name"].(string)
+		if newName, ok := r.remapImage(name); ok {
+			r.log.Infof("Updating image %s to %s", name, newName)
+			ref["name"] = newName
+			return true
+		}
+	}
+	return false
+}
+
+// remapObjectReferences remaps the references found at refPath in each element of the list at listPath.
+func (r *imageReferenceRemapper) remapObjectReferences(obj map[string]any, listPath []string, refPath ...string) error {
+	list, found, err := unstructured.NestedSlice(obj, listPath...)
+	if err != nil {
+		return errors.Wrapf(err, "error getting %s", strings.Join(listPath, "."))
+	}
+	if !found {
+		return nil
+	}
+
+	updated := false
+	for _, e := range list {
+		element, ok := e.(map[string]any)
+		if !ok {
+			continue
+		}
+		ref, found, err := unstructured.NestedMap(element, refPath...)
+		if err != nil || !found {
+			continue
+		}
+		if r.remapObjectReference(ref) {
+			if err := unstructured.SetNestedMap(element, ref, refPath...); err != nil {
+				return errors.Wrapf(err, "error setting %s", strings.Join(refPath, "."))
+			}
+			updated = true
+		}
+	}
+
+	if updated {
+		if err := unstructured.SetNestedSlice(obj, list, listPath...); err != nil {
+			return errors.Wrapf(err, "error setting %s", strings.Join(listPath, "."))
+		}
+	}
+	return nil
+}
+
+func (r *imageReferenceRemapper) remapBuildConfig(obj map[string]any) error {
+	for _, refPath := range [][]string{
+		{"spec", "output", "to"},
+		{"spec", "strategy", "sourceStrategy", "from"},
+		{"spec", "strategy", "dockerStrategy", "from"},
+		{"spec", "strategy", "customStrategy", "from"},
+	} {
+		ref, found, err := unstructured.NestedMap(obj, refPath...)
+		if err != nil {
+			return errors.Wrapf(err, "error getting %s", strings.Join(refPath, "."))
+		}
+		if !found {
+			continue
+		}
+		if r.remapObjectReference(ref) {
+			if err := unstructured.SetNestedMap(obj, ref, refPath...); err != nil {
+				return errors.Wrapf(err, "error setting %s", strings.Join(refPath, "."))
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openshift
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestImageReferenceActionExecute(t *testing.T) {
+	const registry = "image-registry.openshift-image-registry.svc:5000"
+
+	tests := []struct {
+		name             string
+		namespaceMapping map[string]string
+		item             map[string]any
+		expected         map[string]any
+	}{
+		{
+			name: "no namespace mapping",
+			item: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"spec": map[string]any{
+					"containers": []any{map[string]any{"name": "app", "image": registry + "/ns-1/app:latest"}},
+				},
+			},
+			expected: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"spec": map[string]any{
+					"containers": []any{map[string]any{"name": "app", "image": registry + "/ns-1/app:latest"}},
+				},
+			},
+		},
+		{
+			name:             "pod images from the internal registry of remapped namespaces are updated",
+			namespaceMapping: map[string]string{"ns-1": "ns-2"},
+			item: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"spec": map[string]any{
+					"initContainers": []any{map[string]any{"name": "init", "image": "docker-registry.default.svc:5000/ns-1/init@sha256:abc"}},
+					"containers": []any{
+						map[string]any{"name": "app", "image": registry + "/ns-1/app:latest"},
+						map[string]any{"name": "other", "image": registry + "/ns-3/other:latest"},
+						map[string]any{"name": "external", "image": "quay.io/ns-1/app:latest"},
+					},
+				},
+			},
+			expected: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"spec": map[string]any{
+					"initContainers": []any{map[string]any{"name": "init", "image": "docker-registry.default.svc:5000/ns-2/init@sha256:abc"}},
+					"containers": []any{
+						map[string]any{"name": "app", "image": registry + "/ns-2/app:latest"},
+						map[string]any{"name": "other", "image": registry + "/ns-3/other:latest"},
+						map[string]any{"name": "external", "image": "quay.io/ns-1/app:latest"},
+					},
+				},
+			},
+		},
+		{
+			name:             "deployment config template and triggers are updated",
+			namespaceMapping: map[string]string{"ns-1": "ns-2"},
+			item: map[string]any{
+				"apiVersion": "apps.openshift.io/v1",
+				"kind":       "DeploymentConfig",
+				"spec": map[string]any{
+					"template": map[string]any{"spec": map[string]any{
+						"containers": []any{map[string]any{"name": "app", "image": registry + "/ns-1/app@sha256:abc"}},
+					}},
+					"triggers": []any{
+						map[string]any{"type": "ConfigChange"},
+						map[string]any{"type": "ImageChange", "imageChangeParams": map[string]any{
+							"from": map[string]any{"kind": "ImageStreamTag", "namespace": "ns-1", "name": "app:latest"},
+						}},
+					},
+				},
+			},
+			expected: map[string]any{
+				"apiVersion": "apps.openshift.io/v1",
+				"kind":       "DeploymentConfig",
+				"spec": map[string]any{
+					"template": map[string]any{"spec": map[string]any{
+						"containers": []any{map[string]any{"name": "app", "image": registry + "/ns-2/app@sha256:abc"}},
+					}},
+					"triggers": []any{
+						map[string]any{"type": "ConfigChange"},
+						map[string]any{"type": "ImageChange", "imageChangeParams": map[string]any{
+							"from": map[string]any{"kind": "ImageStreamTag", "namespace": "ns-2", "name": "app:latest"},
+						}},
+					},
+				},
+			},
+		},
+		{
+			name:             "build config output and strategy are updated",
+			namespaceMapping: map[string]string{"ns-1": "ns-2"},
+			item: map[string]any{
+				"apiVersion": "build.openshift.io/v1",
+				"kind":       "BuildConfig",
+				"spec": map[string]any{
+					"output": map[string]any{"to": map[string]any{"kind": "ImageStreamTag", "namespace": "ns-1", "name": "app:latest"}},
+					"strategy": map[string]any{"sourceStrategy": map[string]any{
+						"from": map[string]any{"kind": "ImageStreamTag", "namespace": "openshift", "name": "python:3.11"},
+					}},
+				},
+			},
+			expected: map[string]any{
+				"apiVersion": "build.openshift.io/v1",
+				"kind":       "BuildConfig",
+				"spec": map[string]any{
+					"output": map[string]any{"to": map[string]any{"kind": "ImageStreamTag", "namespace": "ns-2", "name": "app:latest"}},
+					"strategy": map[string]any{"sourceStrategy": map[string]any{
+						"from": map[string]any{"kind": "ImageStreamTag", "namespace": "openshift", "name": "python:3.11"},
+					}},
+				},
+			},
+		},
+		{
+			name:             "image stream tags are updated",
+			namespaceMapping: map[string]string{"ns-1": "ns-2"},
+			item: map[string]any{
+				"apiVersion": "image.openshift.io/v1",
+				"kind":       "ImageStream",
+				"spec": map[string]any{
+					"tags": []any{
+						map[string]any{"name": "latest", "from": map[string]any{"kind": "DockerImage", "name": registry + "/ns-1/app:v1"}},
+						map[string]any{"name": "stable", "from": map[string]any{"kind": "ImageStreamTag", "name": "latest"}},
+					},
+				},
+			},
+			expected: map[string]any{
+				"apiVersion": "image.openshift.io/v1",
+				"kind":       "ImageStream",
+				"spec": map[string]any{
+					"tags": []any{
+						map[string]any{"name": "latest", "from": map[string]any{"kind": "DockerImage", "name": registry + "/ns-2/app:v1"}},
+						map[string]any{"name": "stable", "from": map[string]any{"kind": "ImageStreamTag", "name": "latest"}},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			restore := builder.ForRestore("velero", "restore").Result()
+			restore.Spec.NamespaceMapping = tc.namespaceMapping
+			item := &unstructured.Unstructured{Object: tc.item}
+
+			res, err := NewImageReferenceAction(velerotest.NewLogger()).Execute(&velero.RestoreItemActionExecuteInput{
+				Item:           item,
+				ItemFromBackup: item.DeepCopy(),
+				Restore:        restore,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, &unstructured.Unstructured{Object: tc.expected}, res.UpdatedItem)
+		})
+	}
+}
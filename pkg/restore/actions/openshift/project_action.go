@@ -0,0 +1,54 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openshift
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// ProjectAction skips Projects. A Project is a view of its Namespace and can't be created
+// directly, so the project is restored by restoring the Namespace, which carries the project's
+// display name, description, requester and the UID, SELinux and supplemental group ranges
+// assigned to it. Keeping those ranges lets the restored pods access the data on the restored
+// volumes with the same UIDs as before.
+type ProjectAction struct {
+	logger logrus.FieldLogger
+}
+
+func NewProjectAction(logger logrus.FieldLogger) *ProjectAction {
+	return &ProjectAction{logger: logger}
+}
+
+func (a *ProjectAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{"projects.project.openshift.io"},
+	}, nil
+}
+
+func (a *ProjectAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	metadata, err := meta.Accessor(input.Item)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	a.logger.Infof("Skipping restore of project %s, it is restored with its namespace", metadata.GetName())
+	return velero.NewRestoreItemActionExecuteOutput(input.Item).WithoutRestore(), nil
+}
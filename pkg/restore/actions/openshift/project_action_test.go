@@ -0,0 +1,46 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openshift
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestProjectActionExecute(t *testing.T) {
+	item := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "project.openshift.io/v1",
+		"kind":       "Project",
+		"metadata": map[string]any{
+			"name":        "ns-1",
+			"annotations": map[string]any{"openshift.io/display-name": "Namespace 1"},
+		},
+	}}
+
+	res, err := NewProjectAction(velerotest.NewLogger()).Execute(&velero.RestoreItemActionExecuteInput{
+		Item:           item,
+		ItemFromBackup: item.DeepCopy(),
+	})
+	require.NoError(t, err)
+	assert.True(t, res.SkipRestore)
+}
@@ -0,0 +1,106 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openshift
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+const (
+	serviceAccountUserPrefix  = "system:serviceaccount:"
+	serviceAccountGroupPrefix = "system:serviceaccounts:"
+)
+
+// SecurityContextConstraintsAction updates the service accounts and service account groups
+// granted a SecurityContextConstraints to the restore's namespace mapping, so that the
+// workloads restored into a remapped namespace keep the SCC they ran with.
+type SecurityContextConstraintsAction struct {
+	logger logrus.FieldLogger
+}
+
+func NewSecurityContextConstraintsAction(logger logrus.FieldLogger) *SecurityContextConstraintsAction {
+	return &SecurityContextConstraintsAction{logger: logger}
+}
+
+func (a *SecurityContextConstraintsAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{"securitycontextconstraints.security.openshift.io"},
+	}, nil
+}
+
+func (a *SecurityContextConstraintsAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	if len(input.Restore.Spec.NamespaceMapping) == 0 {
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	scc, ok := input.Item.(*unstructured.Unstructured)
+	if !ok {
+		return nil, errors.Errorf("object was of unexpected type %T", input.Item)
+	}
+	log := a.logger.WithField("securitycontextconstraints", scc.GetName())
+
+	users, _, err := unstructured.NestedStringSlice(scc.Object, "users")
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting users of SecurityContextConstraints")
+	}
+	for i, user := range users {
+		// service account users are like "system:serviceaccount:<namespace>:<name>"
+		namespace, name, found := strings.Cut(strings.TrimPrefix(user, serviceAccountUserPrefix), ":")
+		if !strings.HasPrefix(user, serviceAccountUserPrefix) || !found {
+			continue
+		}
+		if target, ok := input.Restore.Spec.NamespaceMapping[namespace]; ok {
+			users[i] = serviceAccountUserPrefix + target + ":" + name
+			log.Infof("Updating user %s to %s", user, users[i])
+		}
+	}
+
+	groups, _, err := unstructured.NestedStringSlice(scc.Object, "groups")
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting groups of SecurityContextConstraints")
+	}
+	for i, group := range groups {
+		// service account groups are like "system:serviceaccounts:<namespace>"
+		namespace, ok := strings.CutPrefix(group, serviceAccountGroupPrefix)
+		if !ok {
+			continue
+		}
+		if target, ok := input.Restore.Spec.NamespaceMapping[namespace]; ok {
+			groups[i] = serviceAccountGroupPrefix + target
+			log.Infof("Updating group %s to %s", group, groups[i])
+		}
+	}
+
+	if users != nil {
+		if err := unstructured.SetNestedStringSlice(scc.Object, users, "users"); err != nil {
+			return nil, errors.Wrap(err, "error setting users of SecurityContextConstraints")
+		}
+	}
+	if groups != nil {
+		if err := unstructured.SetNestedStringSlice(scc.Object, groups, "groups"); err != nil {
+			return nil, errors.Wrap(err, "error setting groups of SecurityContextConstraints")
+		}
+	}
+
+	return velero.NewRestoreItemActionExecuteOutput(scc), nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openshift
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestSecurityContextConstraintsActionExecute(t *testing.T) {
+	newSCC := func(users, groups []any) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "security.openshift.io/v1",
+			"kind":       "SecurityContextConstraints",
+			"metadata":   map[string]any{"name": "app-scc"},
+			"users":      users,
+			"groups":     groups,
+		}}
+	}
+
+	tests := []struct {
+		name             string
+		namespaceMapping map[string]string
+		item             *unstructured.Unstructured
+		expected         *unstructured.Unstructured
+	}{
+		{
+			name:     "no namespace mapping",
+			item:     newSCC([]any{"system:serviceaccount:ns-1:app"}, []any{"system:serviceaccounts:ns-1"}),
+			expected: newSCC([]any{"system:serviceaccount:ns-1:app"}, []any{"system:serviceaccounts:ns-1"}),
+		},
+		{
+			name:             "service accounts of remapped namespaces are updated",
+			namespaceMapping: map[string]string{"ns-1": "ns-2"},
+			item: newSCC(
+				[]any{"system:serviceaccount:ns-1:app", "system:serviceaccount:ns-3:app", "system:admin"},
+				[]any{"system:serviceaccounts:ns-1", "system:serviceaccounts:ns-3", "system:authenticated"},
+			),
+			expected: newSCC(
+				[]any{"system:serviceaccount:ns-2:app", "system:serviceaccount:ns-3:app", "system:admin"},
+				[]any{"system:serviceaccounts:ns-2", "system:serviceaccounts:ns-3", "system:authenticated"},
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			restore := builder.ForRestore("velero", "restore").Result()
+			restore.Spec.NamespaceMapping = tc.namespaceMapping
+
+			res, err := NewSecurityContextConstraintsAction(velerotest.NewLogger()).Execute(&velero.RestoreItemActionExecuteInput{
+				Item:           tc.item,
+				ItemFromBackup: tc.item.DeepCopy(),
+				Restore:        restore,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, res.UpdatedItem)
+		})
+	}
+}
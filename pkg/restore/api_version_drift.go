@@ -0,0 +1,78 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"sort"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+type apiVersionDriftKey struct {
+	kind    string
+	source  string
+	applied string
+}
+
+// sourceAPIVersion returns the API version the source cluster preferred for the item read from
+// the backup, which isn't the item's own one when another version was chosen to restore it.
+func (ctx *restoreContext) sourceAPIVersion(obj *unstructured.Unstructured, groupResource schema.GroupResource) string {
+	if cgv, ok := ctx.chosenGrpVersToRestore[groupResource.String()]; ok && cgv.SourcePreferredVersion != "" {
+		return schema.GroupVersion{Group: cgv.Group, Version: cgv.SourcePreferredVersion}.String()
+	}
+	return obj.GetAPIVersion()
+}
+
+// trackAPIVersionDrift logs and counts the restored item if it was restored with another API
+// version than the one the source cluster preferred.
+func (ctx *restoreContext) trackAPIVersionDrift(log logrus.FieldLogger, resourceID, kind, source, applied string) {
+	if source == applied {
+		return
+	}
+
+	log.Infof("Restored %s with the API version %s instead of %s", resourceID, applied, source)
+	ctx.apiVersionDrift[apiVersionDriftKey{kind: kind, source: source, applied: applied}]++
+}
+
+// apiVersionDriftSummary returns the restored items counted by trackAPIVersionDrift, by kind and
+// pair of API versions.
+func (ctx *restoreContext) apiVersionDriftSummary() []velerov1api.APIVersionDrift {
+	var summary []velerov1api.APIVersionDrift
+	for key, items := range ctx.apiVersionDrift {
+		summary = append(summary, velerov1api.APIVersionDrift{
+			Kind:              key.kind,
+			SourceAPIVersion:  key.source,
+			AppliedAPIVersion: key.applied,
+			Items:             items,
+		})
+	}
+
+	sort.Slice(summary, func(i, j int) bool {
+		if summary[i].Kind != summary[j].Kind {
+			return summary[i].Kind < summary[j].Kind
+		}
+		if summary[i].SourceAPIVersion != summary[j].SourceAPIVersion {
+			return summary[i].SourceAPIVersion < summary[j].SourceAPIVersion
+		}
+		return summary[i].AppliedAPIVersion < summary[j].AppliedAPIVersion
+	})
+	return summary
+}
@@ -0,0 +1,65 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestSourceAPIVersion(t *testing.T) {
+	ctx := &restoreContext{
+		chosenGrpVersToRestore: map[string]ChosenGroupVersion{
+			"horizontalpodautoscalers.autoscaling": {Group: "autoscaling", Version: "v2", Dir: "v2", SourcePreferredVersion: "v2beta2"},
+			"services":                             {Group: "", Version: "v1", Dir: "v1-preferredversion", SourcePreferredVersion: "v1"},
+		},
+	}
+
+	hpa := &unstructured.Unstructured{}
+	hpa.SetAPIVersion("autoscaling/v2")
+	assert.Equal(t, "autoscaling/v2beta2", ctx.sourceAPIVersion(hpa, schema.GroupResource{Group: "autoscaling", Resource: "horizontalpodautoscalers"}))
+
+	service := &unstructured.Unstructured{}
+	service.SetAPIVersion("v1")
+	assert.Equal(t, "v1", ctx.sourceAPIVersion(service, schema.GroupResource{Resource: "services"}))
+
+	// without a chosen version, the item was read in the version the source cluster preferred
+	deployment := &unstructured.Unstructured{}
+	deployment.SetAPIVersion("apps/v1")
+	assert.Equal(t, "apps/v1", ctx.sourceAPIVersion(deployment, schema.GroupResource{Group: "apps", Resource: "deployments"}))
+}
+
+func TestTrackAPIVersionDrift(t *testing.T) {
+	ctx := &restoreContext{apiVersionDrift: make(map[apiVersionDriftKey]int)}
+	log := velerotest.NewLogger()
+
+	ctx.trackAPIVersionDrift(log, "ingresses.extensions/ns/a", "Ingress", "extensions/v1beta1", "networking.k8s.io/v1")
+	ctx.trackAPIVersionDrift(log, "horizontalpodautoscalers.autoscaling/ns/a", "HorizontalPodAutoscaler", "autoscaling/v2beta2", "autoscaling/v2")
+	ctx.trackAPIVersionDrift(log, "ingresses.extensions/ns/b", "Ingress", "extensions/v1beta1", "networking.k8s.io/v1")
+	ctx.trackAPIVersionDrift(log, "deployments.apps/ns/a", "Deployment", "apps/v1", "apps/v1")
+
+	assert.Equal(t, []velerov1api.APIVersionDrift{
+		{Kind: "HorizontalPodAutoscaler", SourceAPIVersion: "autoscaling/v2beta2", AppliedAPIVersion: "autoscaling/v2", Items: 1},
+		{Kind: "Ingress", SourceAPIVersion: "extensions/v1beta1", AppliedAPIVersion: "networking.k8s.io/v1", Items: 2},
+	}, ctx.apiVersionDriftSummary())
+}
@@ -0,0 +1,194 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conversion converts the items of the API versions removed from Kubernetes, found in
+// old backups, to the versions which replaced them.
+package conversion
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// Conversion converts the items of a removed API version to the version which replaced it.
+type Conversion struct {
+	// To is the version the items are converted to.
+	To schema.GroupVersionKind
+
+	// Resource is the resource of the version the items are converted to.
+	Resource schema.GroupResource
+
+	// convert converts the content of the item in place, the API version being set afterwards.
+	convert func(obj *unstructured.Unstructured) error
+}
+
+// Convert returns a copy of the item converted to the version the conversion targets.
+func (c *Conversion) Convert(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	converted := obj.DeepCopy()
+	if c.convert != nil {
+		if err := c.convert(converted); err != nil {
+			return nil, errors.Wrapf(err, "error converting %s %s to %s", obj.GetKind(), obj.GetName(), c.To.GroupVersion())
+		}
+	}
+	converted.SetAPIVersion(c.To.GroupVersion().String())
+	converted.SetKind(c.To.Kind)
+	return converted, nil
+}
+
+// RemovedError is returned for the items of an API removed from Kubernetes without replacement.
+type RemovedError struct {
+	GroupVersionKind schema.GroupVersionKind
+	Advisory         string
+}
+
+func (e *RemovedError) Error() string {
+	return fmt.Sprintf("%s %s can't be restored: %s", e.GroupVersionKind.GroupVersion(), e.GroupVersionKind.Kind, e.Advisory)
+}
+
+var (
+	ingressV1   = schema.GroupVersionKind{Group: "networking.k8s.io", Version: "v1", Kind: "Ingress"}
+	cronJobV1   = schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "CronJob"}
+	pspAdvisory = "PodSecurityPolicy was removed in Kubernetes v1.25, label the namespaces for the Pod Security Admission instead"
+	conversions = map[schema.GroupVersionKind]*Conversion{
+		{Group: "extensions", Version: "v1beta1", Kind: "Ingress"}: {
+			To:       ingressV1,
+			Resource: schema.GroupResource{Group: "networking.k8s.io", Resource: "ingresses"},
+			convert:  convertIngress,
+		},
+		{Group: "networking.k8s.io", Version: "v1beta1", Kind: "Ingress"}: {
+			To:       ingressV1,
+			Resource: schema.GroupResource{Group: "networking.k8s.io", Resource: "ingresses"},
+			convert:  convertIngress,
+		},
+		{Group: "batch", Version: "v1beta1", Kind: "CronJob"}: {
+			To:       cronJobV1,
+			Resource: schema.GroupResource{Group: "batch", Resource: "cronjobs"},
+		},
+	}
+	removed = map[schema.GroupVersionKind]string{
+		{Group: "policy", Version: "v1beta1", Kind: "PodSecurityPolicy"}:     pspAdvisory,
+		{Group: "extensions", Version: "v1beta1", Kind: "PodSecurityPolicy"}: pspAdvisory,
+	}
+)
+
+// For returns the conversion of the items of the API version, nil if there's none. A
+// RemovedError is returned for the API versions removed without replacement.
+func For(gvk schema.GroupVersionKind) (*Conversion, error) {
+	if advisory, ok := removed[gvk]; ok {
+		return nil, &RemovedError{GroupVersionKind: gvk, Advisory: advisory}
+	}
+	return conversions[gvk], nil
+}
+
+// convertIngress converts an extensions/v1beta1 or networking.k8s.io/v1beta1 Ingress to
+// networking.k8s.io/v1: the backends reference the services by a nested service field, the
+// default backend is renamed and the path type becomes required.
+func convertIngress(obj *unstructured.Unstructured) error {
+	if backend, found, err := unstructured.NestedMap(obj.Object, "spec", "backend"); err != nil {
+		return err
+	} else if found {
+		converted, err := convertIngressBackend(backend)
+		if err != nil {
+			return errors.Wrap(err, "spec.backend")
+		}
+		unstructured.RemoveNestedField(obj.Object, "spec", "backend")
+		if err := unstructured.SetNestedMap(obj.Object, converted, "spec", "defaultBackend"); err != nil {
+			return err
+		}
+	}
+
+	rules, _, err := unstructured.NestedSlice(obj.Object, "spec", "rules")
+	if err != nil {
+		return err
+	}
+	for i := range rules {
+		rule, ok := rules[i].(map[string]any)
+		if !ok {
+			return errors.Errorf("spec.rules[%d] isn't an object", i)
+		}
+		paths, _, err := unstructured.NestedSlice(rule, "http", "paths")
+		if err != nil {
+			return err
+		}
+		for j := range paths {
+			path, ok := paths[j].(map[string]any)
+			if !ok {
+				return errors.Errorf("spec.rules[%d].http.paths[%d] isn't an object", i, j)
+			}
+			if _, ok := path["pathType"]; !ok {
+				path["pathType"] = "ImplementationSpecific"
+			}
+			if backend, ok := path["backend"].(map[string]any); ok {
+				converted, err := convertIngressBackend(backend)
+				if err != nil {
+					return errors.Wrapf(err, "spec.rules[%d].http.paths[%d].backend", i, j)
+				}
+				path["backend"] = converted
+			}
+		}
+		if len(paths) > 0 {
+			if err := unstructured.SetNestedSlice(rule, paths, "http", "paths"); err != nil {
+				return err
+			}
+		}
+	}
+	if len(rules) > 0 {
+		return unstructured.SetNestedSlice(obj.Object, rules, "spec", "rules")
+	}
+	return nil
+}
+
+// convertIngressBackend converts a v1beta1 backend, referencing a service by its serviceName
+// and servicePort fields, to a v1 one.
+func convertIngressBackend(backend map[string]any) (map[string]any, error) {
+	if _, ok := backend["resource"]; ok {
+		return backend, nil
+	}
+
+	name, ok := backend["serviceName"].(string)
+	if !ok || name == "" {
+		return nil, errors.New("the backend has neither a service name nor a resource")
+	}
+
+	var port intstr.IntOrString
+	switch value := backend["servicePort"].(type) {
+	case int64:
+		port = intstr.FromInt32(int32(value))
+	case float64:
+		port = intstr.FromInt32(int32(value))
+	case string:
+		port = intstr.Parse(value)
+	default:
+		return nil, errors.Errorf("the service port %v of the backend isn't valid", value)
+	}
+
+	servicePort := map[string]any{}
+	if port.Type == intstr.Int {
+		servicePort["number"] = int64(port.IntVal)
+	} else {
+		servicePort["name"] = port.StrVal
+	}
+	return map[string]any{
+		"service": map[string]any{
+			"name": name,
+			"port": servicePort,
+		},
+	}, nil
+}
@@ -0,0 +1,112 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestFor(t *testing.T) {
+	conv, err := For(schema.GroupVersionKind{Group: "batch", Version: "v1beta1", Kind: "CronJob"})
+	require.NoError(t, err)
+	assert.Equal(t, cronJobV1, conv.To)
+
+	conv, err = For(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"})
+	require.NoError(t, err)
+	assert.Nil(t, conv)
+
+	_, err = For(schema.GroupVersionKind{Group: "policy", Version: "v1beta1", Kind: "PodSecurityPolicy"})
+	var removedErr *RemovedError
+	require.ErrorAs(t, err, &removedErr)
+	assert.Equal(t, pspAdvisory, removedErr.Advisory)
+}
+
+func TestConvertIngress(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        map[string]any
+		expected    map[string]any
+		expectedErr string
+	}{
+		{
+			name: "backends and paths are converted",
+			spec: map[string]any{
+				"backend": map[string]any{"serviceName": "default", "servicePort": int64(80)},
+				"rules": []any{
+					map[string]any{
+						"host": "foo.example.com",
+						"http": map[string]any{"paths": []any{
+							map[string]any{"path": "/", "backend": map[string]any{"serviceName": "foo", "servicePort": "http"}},
+							map[string]any{"path": "/bar", "pathType": "Prefix", "backend": map[string]any{"serviceName": "bar", "servicePort": "8080"}},
+						}},
+					},
+				},
+			},
+			expected: map[string]any{
+				"defaultBackend": map[string]any{"service": map[string]any{"name": "default", "port": map[string]any{"number": int64(80)}}},
+				"rules": []any{
+					map[string]any{
+						"host": "foo.example.com",
+						"http": map[string]any{"paths": []any{
+							map[string]any{"path": "/", "pathType": "ImplementationSpecific", "backend": map[string]any{"service": map[string]any{"name": "foo", "port": map[string]any{"name": "http"}}}},
+							map[string]any{"path": "/bar", "pathType": "Prefix", "backend": map[string]any{"service": map[string]any{"name": "bar", "port": map[string]any{"number": int64(8080)}}}},
+						}},
+					},
+				},
+			},
+		},
+		{
+			name:     "resource backend is kept",
+			spec:     map[string]any{"backend": map[string]any{"resource": map[string]any{"kind": "StorageBucket", "name": "static"}}},
+			expected: map[string]any{"defaultBackend": map[string]any{"resource": map[string]any{"kind": "StorageBucket", "name": "static"}}},
+		},
+		{
+			name:        "backend without service is unconvertible",
+			spec:        map[string]any{"backend": map[string]any{"servicePort": int64(80)}},
+			expectedErr: "error converting Ingress ingress-1 to networking.k8s.io/v1: spec.backend: the backend has neither a service name nor a resource",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "extensions/v1beta1",
+				"kind":       "Ingress",
+				"metadata":   map[string]any{"name": "ingress-1", "namespace": "ns-1"},
+				"spec":       tc.spec,
+			}}
+
+			conv, err := For(obj.GroupVersionKind())
+			require.NoError(t, err)
+			converted, err := conv.Convert(obj)
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, "networking.k8s.io/v1", converted.GetAPIVersion())
+			assert.Equal(t, tc.expected, converted.Object["spec"])
+			assert.Equal(t, "extensions/v1beta1", obj.GetAPIVersion())
+		})
+	}
+}
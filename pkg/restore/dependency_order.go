@@ -0,0 +1,309 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"container/heap"
+	go_context "context"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+// ValidateDependencyWaitConditions returns the errors of the dependency wait conditions of a restore.
+func ValidateDependencyWaitConditions(conditions []velerov1api.DependencyWaitCondition) []error {
+	var errs []error
+	for i, condition := range conditions {
+		if condition.Resource == "" {
+			errs = append(errs, errors.Errorf("dependency wait condition %d has no resource", i))
+		}
+		if condition.Condition == "" {
+			errs = append(errs, errors.Errorf("dependency wait condition %d has no condition", i))
+		}
+		if condition.Timeout != nil && condition.Timeout.Duration < 0 {
+			errs = append(errs, errors.Errorf("dependency wait condition %d has a negative timeout", i))
+		}
+	}
+	return errs
+}
+
+// dependencyNode is an item to restore in the dependency graph of the restore.
+type dependencyNode struct {
+	resource string
+	// namespace is the namespace of the item in the backup.
+	namespace string
+	item      restoreableItem
+	obj       *unstructured.Unstructured
+	// dependents are the nodes restored after this one.
+	dependents   []int
+	dependencies int
+}
+
+// dependencyKey identifies an item by its resource, namespace and name.
+type dependencyKey struct {
+	resource  string
+	namespace string
+	name      string
+}
+
+// orderByDependencies reorders the items to restore so each of them is restored after the items
+// it depends on, the order of the collection being kept between the items which don't depend on
+// each other. The items of a cycle of dependencies are restored in the order of the collection.
+// The items with dependents whose resource has a dependency wait condition are marked so the
+// restore waits for the condition after restoring them. The owners in the ownerReferences of an
+// item aren't dependencies of it: the resource priorities restore the pods before their
+// ReplicaSets and Deployments, so the controllers adopt the restored pods instead of creating new
+// ones.
+func (ctx *restoreContext) orderByDependencies(collection []restoreableResource) []restoreableResource {
+	var nodes []*dependencyNode
+	for _, resource := range collection {
+		// the namespaces are sorted for the order not to depend on the iteration of the map, each
+		// resource of the collection holding a single namespace after orderByNamespacePriority
+		for _, namespace := range slices.Sorted(maps.Keys(resource.selectedItemsByNamespace)) {
+			for _, item := range resource.selectedItemsByNamespace[namespace] {
+				node := &dependencyNode{resource: resource.resource, namespace: namespace, item: item}
+				if obj, err := archive.Unmarshal(ctx.fileSystem, item.path); err != nil {
+					ctx.log.WithError(err).Warnf("Error reading %s to order it by its dependencies", item.path)
+				} else {
+					node.obj = obj
+				}
+				nodes = append(nodes, node)
+			}
+		}
+	}
+
+	index := map[dependencyKey]int{}
+	for i, node := range nodes {
+		index[dependencyKey{resource: node.resource, namespace: node.namespace, name: node.item.name}] = i
+	}
+	for i, node := range nodes {
+		if node.obj == nil {
+			continue
+		}
+		for _, key := range itemDependencies(node, nodes) {
+			if dependency, found := index[key]; found && dependency != i && !slices.Contains(nodes[dependency].dependents, i) {
+				nodes[dependency].dependents = append(nodes[dependency].dependents, i)
+				nodes[i].dependencies++
+			}
+		}
+	}
+
+	ordered := ctx.sortByDependencies(nodes)
+
+	waitConditions := ctx.restore.Spec.DependencyWaitConditions
+	conditionResources := make([]*collections.IncludesExcludes, len(waitConditions))
+	for i, condition := range waitConditions {
+		conditionResources[i] = collections.GetResourceIncludesExcludes(ctx.discoveryHelper, []string{condition.Resource}, nil)
+	}
+
+	// consecutive items of the same resource and namespace are restored together
+	var result []restoreableResource
+	for _, node := range ordered {
+		if len(node.dependents) > 0 {
+			for i, resources := range conditionResources {
+				if resources.ShouldInclude(node.resource) {
+					node.item.waitCondition = &waitConditions[i]
+					break
+				}
+			}
+		}
+		if last := len(result) - 1; last >= 0 && result[last].resource == node.resource {
+			if items, found := result[last].selectedItemsByNamespace[node.namespace]; found {
+				result[last].selectedItemsByNamespace[node.namespace] = append(items, node.item)
+				result[last].totalItems++
+				continue
+			}
+		}
+		result = append(result, restoreableResource{
+			resource:                 node.resource,
+			selectedItemsByNamespace: map[string][]restoreableItem{node.namespace: {node.item}},
+			totalItems:               1,
+		})
+	}
+	return result
+}
+
+// sortByDependencies returns the nodes sorted so each of them comes after the nodes it depends
+// on, the nodes which don't depend on each other being kept in their order. A cycle of
+// dependencies is broken at its first node.
+func (ctx *restoreContext) sortByDependencies(nodes []*dependencyNode) []*dependencyNode {
+	ordered := make([]*dependencyNode, 0, len(nodes))
+	ready := &indexHeap{}
+	for i, node := range nodes {
+		if node.dependencies == 0 {
+			heap.Push(ready, i)
+		}
+	}
+	visited := make([]bool, len(nodes))
+	next := 0
+	for len(ordered) < len(nodes) {
+		if ready.Len() == 0 {
+			// break the cycle at its first item in the order of the collection
+			for visited[next] {
+				next++
+			}
+			ctx.log.Warnf("Restoring %s %s despite a cycle in its dependencies", nodes[next].resource, nodes[next].item.name)
+			nodes[next].dependencies = 0
+			heap.Push(ready, next)
+		}
+		i := heap.Pop(ready).(int)
+		if visited[i] {
+			continue
+		}
+		visited[i] = true
+		ordered = append(ordered, nodes[i])
+		for _, dependent := range nodes[i].dependents {
+			nodes[dependent].dependencies--
+			if nodes[dependent].dependencies == 0 && !visited[dependent] {
+				heap.Push(ready, dependent)
+			}
+		}
+	}
+	return ordered
+}
+
+// itemDependencies returns the keys of the items the item of the node depends on.
+func itemDependencies(node *dependencyNode, nodes []*dependencyNode) []dependencyKey {
+	var keys []dependencyKey
+	switch node.resource {
+	case kuberesource.PersistentVolumeClaims.String():
+		if volumeName, _, _ := unstructured.NestedString(node.obj.Object, "spec", "volumeName"); volumeName != "" {
+			keys = append(keys, dependencyKey{resource: kuberesource.PersistentVolumes.String(), name: volumeName})
+		}
+	case kuberesource.MutatingWebhookConfigurations.String(), kuberesource.ValidatingWebhookConfigurations.String():
+		webhooks, _, _ := unstructured.NestedSlice(node.obj.Object, "webhooks")
+		for _, webhook := range webhooks {
+			webhookMap, ok := webhook.(map[string]any)
+			if !ok {
+				continue
+			}
+			namespace, _, _ := unstructured.NestedString(webhookMap, "clientConfig", "service", "namespace")
+			name, _, _ := unstructured.NestedString(webhookMap, "clientConfig", "service", "name")
+			if name == "" {
+				continue
+			}
+			keys = append(keys, dependencyKey{resource: kuberesource.Services.String(), namespace: namespace, name: name})
+			keys = append(keys, serviceWorkloads(nodes, namespace, name)...)
+		}
+	}
+	return keys
+}
+
+// serviceWorkloads returns the keys of the workloads whose pods the Service selects, the webhooks
+// served by the Service only answering once they run.
+func serviceWorkloads(nodes []*dependencyNode, namespace, name string) []dependencyKey {
+	var selector labels.Selector
+	for _, node := range nodes {
+		if node.obj != nil && node.resource == kuberesource.Services.String() && node.namespace == namespace && node.item.name == name {
+			selectorMap, found, _ := unstructured.NestedStringMap(node.obj.Object, "spec", "selector")
+			if found && len(selectorMap) > 0 {
+				selector = labels.SelectorFromSet(selectorMap)
+			}
+			break
+		}
+	}
+	if selector == nil {
+		return nil
+	}
+
+	var keys []dependencyKey
+	for _, node := range nodes {
+		if node.obj == nil || node.namespace != namespace {
+			continue
+		}
+		switch node.resource {
+		case kuberesource.Deployments.String(), kuberesource.StatefulSets.String(), kuberesource.DaemonSets.String():
+		default:
+			continue
+		}
+		podLabels, _, _ := unstructured.NestedStringMap(node.obj.Object, "spec", "template", "metadata", "labels")
+		if selector.Matches(labels.Set(podLabels)) {
+			keys = append(keys, dependencyKey{resource: node.resource, namespace: namespace, name: node.item.name})
+		}
+	}
+	return keys
+}
+
+// waitForDependencyCondition waits for the restored item to meet the dependency wait condition of
+// its resource, for the timeout of the condition.
+func (ctx *restoreContext) waitForDependencyCondition(obj *unstructured.Unstructured, groupResource schema.GroupResource, namespace string, condition *velerov1api.DependencyWaitCondition) error {
+	resourceClient, err := ctx.getResourceClient(groupResource, obj, namespace)
+	if err != nil {
+		return errors.Wrapf(err, "error getting a client to wait for %s %s", groupResource, obj.GetName())
+	}
+	timeout := ctx.resourceTimeout
+	if condition.Timeout != nil {
+		timeout = condition.Timeout.Duration
+	}
+
+	log := ctx.log.WithField("resource", groupResource.String()).WithField("name", obj.GetName())
+	log.Infof("Waiting for condition %s of the item before restoring the items depending on it", condition.Condition)
+	err = wait.PollUntilContextTimeout(go_context.Background(), time.Second, timeout, true, func(go_context.Context) (bool, error) {
+		restored, err := resourceClient.Get(obj.GetName(), metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return hasTrueCondition(restored, condition.Condition), nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "condition %s of %s %s not met before restoring the items depending on it", condition.Condition, groupResource, obj.GetName())
+	}
+	return nil
+}
+
+// hasTrueCondition returns whether the status condition of the type of the object is True.
+func hasTrueCondition(obj *unstructured.Unstructured, conditionType string) bool {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, condition := range conditions {
+		conditionMap, ok := condition.(map[string]any)
+		if !ok {
+			continue
+		}
+		if conditionMap["type"] == conditionType {
+			return conditionMap["status"] == string(metav1.ConditionTrue)
+		}
+	}
+	return false
+}
+
+// indexHeap is a min-heap of the indexes of the items ready to be restored, so they're restored
+// in the order of the collection.
+type indexHeap []int
+
+func (h indexHeap) Len() int           { return len(h) }
+func (h indexHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h indexHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *indexHeap) Push(x any)        { *h = append(*h, x.(int)) }
+func (h *indexHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
@@ -0,0 +1,198 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// dependencyTestCollection returns the collection of the items, given as resource, namespace and
+// content, each item being a resource of its own, and writes them to the file system.
+func dependencyTestCollection(t *testing.T, fs *test.FakeFileSystem, items ...map[string]any) []restoreableResource {
+	t.Helper()
+	var collection []restoreableResource
+	for _, item := range items {
+		resource, namespace := item["resource"].(string), item["namespace"].(string)
+		obj := item["object"].(map[string]any)
+		name := obj["metadata"].(map[string]any)["name"].(string)
+		path := "/restore/" + resource + "/" + namespace + "/" + name + ".json"
+		data, err := json.Marshal(obj)
+		require.NoError(t, err)
+		fs.WithFile(path, data)
+
+		collection = append(collection, restoreableResource{
+			resource:                 resource,
+			selectedItemsByNamespace: map[string][]restoreableItem{namespace: {{path: path, targetNamespace: namespace, name: name}}},
+			totalItems:               1,
+		})
+	}
+	return collection
+}
+
+func dependencyTestItem(resource, namespace, apiVersion, kind, name string, fields map[string]any) map[string]any {
+	obj := map[string]any{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata":   map[string]any{"name": name, "namespace": namespace},
+	}
+	for k, v := range fields {
+		obj[k] = v
+	}
+	return map[string]any{"resource": resource, "namespace": namespace, "object": obj}
+}
+
+func restoredOrder(collection []restoreableResource) []string {
+	var names []string
+	for _, resource := range collection {
+		for _, items := range resource.selectedItemsByNamespace {
+			for _, item := range items {
+				names = append(names, item.name)
+			}
+		}
+	}
+	return names
+}
+
+func TestOrderByDependencies(t *testing.T) {
+	fs := test.NewFakeFileSystem()
+	collection := dependencyTestCollection(t, fs,
+		dependencyTestItem("validatingwebhookconfigurations.admissionregistration.k8s.io", "", "admissionregistration.k8s.io/v1", "ValidatingWebhookConfiguration", "hook", map[string]any{
+			"webhooks": []any{map[string]any{"clientConfig": map[string]any{"service": map[string]any{"namespace": "ns-1", "name": "webhook-svc"}}}},
+		}),
+		dependencyTestItem("persistentvolumeclaims", "ns-1", "v1", "PersistentVolumeClaim", "pvc-1", map[string]any{
+			"spec": map[string]any{"volumeName": "pv-1"},
+		}),
+		dependencyTestItem("services", "ns-1", "v1", "Service", "webhook-svc", map[string]any{
+			"spec": map[string]any{"selector": map[string]any{"app": "webhook"}},
+		}),
+		dependencyTestItem("deployments.apps", "ns-1", "apps/v1", "Deployment", "webhook", map[string]any{
+			"spec": map[string]any{"template": map[string]any{"metadata": map[string]any{"labels": map[string]any{"app": "webhook"}}}},
+		}),
+		dependencyTestItem("deployments.apps", "ns-1", "apps/v1", "Deployment", "other", nil),
+		dependencyTestItem("persistentvolumes", "", "v1", "PersistentVolume", "pv-1", nil),
+	)
+	// the owners aren't dependencies, the pod stays before the ReplicaSet owning it, and the
+	// ReplicaSet before the Deployment owning it
+	pod := dependencyTestItem("pods", "ns-1", "v1", "Pod", "webhook-abc-1", nil)
+	pod["object"].(map[string]any)["metadata"].(map[string]any)["ownerReferences"] = []any{
+		map[string]any{"apiVersion": "apps/v1", "kind": "ReplicaSet", "name": "webhook-abc", "uid": "2"},
+	}
+	owned := dependencyTestItem("replicasets.apps", "ns-1", "apps/v1", "ReplicaSet", "webhook-abc", nil)
+	owned["object"].(map[string]any)["metadata"].(map[string]any)["ownerReferences"] = []any{
+		map[string]any{"apiVersion": "apps/v1", "kind": "Deployment", "name": "webhook", "uid": "1"},
+	}
+	collection = append(dependencyTestCollection(t, fs, pod, owned), collection...)
+
+	ctx := &restoreContext{
+		log:             test.NewLogger(),
+		fileSystem:      fs,
+		discoveryHelper: test.NewFakeDiscoveryHelper(true, nil),
+		restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").DependencyWaitConditions(
+			velerov1api.DependencyWaitCondition{Resource: "deployments.apps", Condition: "Available"},
+		).Result(),
+	}
+
+	ordered := ctx.orderByDependencies(collection)
+	assert.Equal(t, []string{"webhook-abc-1", "webhook-abc", "webhook-svc", "webhook", "hook", "other", "pv-1", "pvc-1"}, restoredOrder(ordered))
+
+	var waited []string
+	for _, resource := range ordered {
+		for _, items := range resource.selectedItemsByNamespace {
+			for _, item := range items {
+				if item.waitCondition != nil {
+					waited = append(waited, item.name)
+				}
+			}
+		}
+	}
+	// the other Deployment has no dependents
+	assert.Equal(t, []string{"webhook"}, waited)
+}
+
+func TestOrderByDependenciesKeepsNamespacePriority(t *testing.T) {
+	fs := test.NewFakeFileSystem()
+	collection := dependencyTestCollection(t, fs,
+		dependencyTestItem("persistentvolumes", "", "v1", "PersistentVolume", "pv-1", nil),
+		dependencyTestItem("persistentvolumeclaims", "ns-1", "v1", "PersistentVolumeClaim", "pvc-a", nil),
+		dependencyTestItem("persistentvolumeclaims", "ns-2", "v1", "PersistentVolumeClaim", "pvc-b", map[string]any{
+			"spec": map[string]any{"volumeName": "pv-1"},
+		}),
+		dependencyTestItem("configmaps", "ns-1", "v1", "ConfigMap", "cm-a", nil),
+		dependencyTestItem("configmaps", "ns-2", "v1", "ConfigMap", "cm-b", nil),
+	)
+
+	ctx := &restoreContext{
+		log:             test.NewLogger(),
+		fileSystem:      fs,
+		discoveryHelper: test.NewFakeDiscoveryHelper(true, nil),
+		restore:         builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").NamespacePriority("ns-2").Result(),
+	}
+
+	ordered := ctx.orderByDependencies(orderByNamespacePriority(collection, ctx.restore.Spec.NamespacePriority))
+	assert.Equal(t, []string{"pv-1", "pvc-b", "cm-b", "pvc-a", "cm-a"}, restoredOrder(ordered))
+}
+
+func TestSortByDependenciesCycle(t *testing.T) {
+	// a and b depend on each other, c on nothing
+	nodes := []*dependencyNode{
+		{resource: "configmaps", namespace: "ns-1", item: restoreableItem{name: "a"}, dependents: []int{1}, dependencies: 1},
+		{resource: "configmaps", namespace: "ns-1", item: restoreableItem{name: "b"}, dependents: []int{0}, dependencies: 1},
+		{resource: "configmaps", namespace: "ns-1", item: restoreableItem{name: "c"}},
+	}
+
+	ctx := &restoreContext{log: test.NewLogger()}
+
+	var names []string
+	for _, node := range ctx.sortByDependencies(nodes) {
+		names = append(names, node.item.name)
+	}
+	assert.Equal(t, []string{"c", "a", "b"}, names)
+}
+
+func TestValidateDependencyWaitConditions(t *testing.T) {
+	assert.Empty(t, ValidateDependencyWaitConditions([]velerov1api.DependencyWaitCondition{
+		{Resource: "deployments.apps", Condition: "Available", Timeout: &metav1.Duration{Duration: time.Minute}},
+	}))
+	assert.Len(t, ValidateDependencyWaitConditions([]velerov1api.DependencyWaitCondition{
+		{Condition: "Available"},
+		{Resource: "deployments.apps"},
+		{Resource: "deployments.apps", Condition: "Available", Timeout: &metav1.Duration{Duration: -time.Minute}},
+	}), 3)
+}
+
+func TestHasTrueCondition(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"status": map[string]any{"conditions": []any{
+			map[string]any{"type": "Progressing", "status": "True"},
+			map[string]any{"type": "Available", "status": "False"},
+		}},
+	}}
+	assert.True(t, hasTrueCondition(obj, "Progressing"))
+	assert.False(t, hasTrueCondition(obj, "Available"))
+	assert.False(t, hasTrueCondition(obj, "ReplicaFailure"))
+}
@@ -0,0 +1,351 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/podvolume"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+// deploymentRevisionAnnotation is the revision of a Deployment, and of each of its ReplicaSets
+// in the rollout history.
+const deploymentRevisionAnnotation = "deployment.kubernetes.io/revision"
+
+// gradualScalePollInterval is how often the ready replicas of the Deployments scaled up
+// gradually are checked.
+var gradualScalePollInterval = time.Second
+
+// gradualDeployment is a restored Deployment scaled up gradually from the replicas it's restored
+// with to its backed up replicas.
+type gradualDeployment struct {
+	itemKey  itemKey
+	name     string
+	start    int64
+	replicas int64
+}
+
+// controllerName returns the name of the controller of the object of the apps group with the
+// kind, empty if none.
+func controllerName(obj *unstructured.Unstructured, kind string) string {
+	owner := metav1.GetControllerOf(obj)
+	if owner == nil || owner.Kind != kind {
+		return ""
+	}
+	if gv, err := schema.ParseGroupVersion(owner.APIVersion); err != nil || gv.Group != appsv1.GroupName {
+		return ""
+	}
+	return owner.Name
+}
+
+// ownerDeploymentName returns the name of the Deployment controlling the backed up ReplicaSet,
+// or the ReplicaSet of the backed up pod, empty if none. The ReplicaSets of a Deployment are
+// named after it and the pod-template-hash label of their pods.
+func ownerDeploymentName(obj *unstructured.Unstructured, groupResource schema.GroupResource) string {
+	switch groupResource {
+	case kuberesource.ReplicaSets:
+		return controllerName(obj, "Deployment")
+	case kuberesource.Pods:
+		replicaSet, hash := controllerName(obj, "ReplicaSet"), obj.GetLabels()[appsv1.DefaultDeploymentUniqueLabelKey]
+		if replicaSet == "" || hash == "" {
+			return ""
+		}
+		if name, found := strings.CutSuffix(replicaSet, "-"+hash); found {
+			return name
+		}
+	}
+	return ""
+}
+
+// appsClient returns the client of the resource of the apps group in the namespace.
+func (ctx *restoreContext) appsClient(groupResource schema.GroupResource, namespace string) (client.Dynamic, error) {
+	gvr, resource, err := ctx.discoveryHelper.ResourceFor(groupResource.WithVersion(""))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting resource %s", groupResource)
+	}
+	resourceClient, err := ctx.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, namespace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting client for %s", groupResource)
+	}
+	return resourceClient, nil
+}
+
+// existingDeployment returns the Deployment which existed in the cluster before the restore,
+// nil if none. It's looked up once, the Deployment being restored after its ReplicaSets and
+// pods by the default resource priorities but before them when ordering by dependencies.
+func (ctx *restoreContext) existingDeployment(namespace, name string) (*unstructured.Unstructured, error) {
+	key := namespace + "/" + name
+	if deployment, found := ctx.existingDeployments[key]; found {
+		return deployment, nil
+	}
+
+	deploymentClient, err := ctx.appsClient(kuberesource.Deployments, namespace)
+	if err != nil {
+		return nil, err
+	}
+	deployment, err := deploymentClient.Get(name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		deployment = nil
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "error getting Deployment %s", key)
+	}
+
+	if ctx.existingDeployments == nil {
+		ctx.existingDeployments = map[string]*unstructured.Unstructured{}
+	}
+	ctx.existingDeployments[key] = deployment
+	return deployment, nil
+}
+
+// deploymentRevision returns the revision of the Deployment or the ReplicaSet, 0 if none.
+func deploymentRevision(obj *unstructured.Unstructured) int64 {
+	revision, err := strconv.ParseInt(obj.GetAnnotations()[deploymentRevisionAnnotation], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return revision
+}
+
+// reconcileDeploymentHistory prepares the backed up Deployment, and the ReplicaSets and pods of
+// the Deployments, so the Deployments existing in the cluster don't roll out or orphan their
+// ReplicaSets once restored over:
+//   - the pods of the ReplicaSets of an existing Deployment are left to their ReplicaSets
+//   - the ReplicaSets of an existing Deployment which exist too are left to it, the others are
+//     restored without replicas, with revisions following the one of the Deployment, to be kept
+//     in its history, and adopted if the Deployment rolls back to them
+//   - an existing Deployment keeps its revision
+//   - the pods of a Deployment which doesn't exist aren't restored when the restore scales the
+//     Deployments up gradually, its ReplicaSets creating them a step at a time, unless their
+//     volumes are restored by the file system backup
+//
+// It returns the reason the item is skipped, empty if it's restored.
+func (ctx *restoreContext) reconcileDeploymentHistory(obj *unstructured.Unstructured, groupResource schema.GroupResource, namespace string, itemKey itemKey) (string, error) {
+	if groupResource == kuberesource.Deployments {
+		existing, err := ctx.existingDeployment(namespace, obj.GetName())
+		if err != nil {
+			return "", err
+		}
+		if existing != nil {
+			annotations := obj.GetAnnotations()
+			if revision, found := existing.GetAnnotations()[deploymentRevisionAnnotation]; found {
+				if annotations == nil {
+					annotations = map[string]string{}
+				}
+				annotations[deploymentRevisionAnnotation] = revision
+			} else {
+				delete(annotations, deploymentRevisionAnnotation)
+			}
+			obj.SetAnnotations(annotations)
+		}
+		return "", ctx.scaleDeploymentGradually(obj, existing, itemKey)
+	}
+
+	deploymentName := ownerDeploymentName(obj, groupResource)
+	if deploymentName == "" {
+		return "", nil
+	}
+	existing, err := ctx.existingDeployment(namespace, deploymentName)
+	if err != nil {
+		return "", err
+	}
+
+	if groupResource == kuberesource.Pods {
+		if existing != nil {
+			return fmt.Sprintf("its Deployment %s exists and manages its pods", deploymentName), nil
+		}
+		if ctx.restore.Spec.GradualScale != nil {
+			pod := new(corev1api.Pod)
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pod); err != nil {
+				return "", errors.Wrap(err, "error converting the pod")
+			}
+			if len(podvolume.GetVolumeBackupsForPod(ctx.podVolumeBackups, pod, obj.GetNamespace())) == 0 {
+				return fmt.Sprintf("its Deployment %s is scaled up gradually and its ReplicaSets create its pods", deploymentName), nil
+			}
+		}
+		return "", nil
+	}
+
+	if existing == nil {
+		// the ReplicaSets of the Deployments scaled up gradually start with a step at most, or
+		// with their pods whose volumes are restored
+		if gradualScale := ctx.restore.Spec.GradualScale; gradualScale != nil {
+			start := max(int64(gradualScale.Step), ctx.podsWithVolumeBackups(obj.GetNamespace(), obj.GetName()))
+			if replicas, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas"); found && replicas > start {
+				if err := unstructured.SetNestedField(obj.Object, start, "spec", "replicas"); err != nil {
+					return "", errors.Wrap(err, "error setting the replicas of the ReplicaSet")
+				}
+			}
+		}
+		return "", nil
+	}
+
+	replicaSetClient, err := ctx.appsClient(kuberesource.ReplicaSets, namespace)
+	if err != nil {
+		return "", err
+	}
+	if _, err := replicaSetClient.Get(obj.GetName(), metav1.GetOptions{}); err == nil {
+		return fmt.Sprintf("its Deployment %s exists and manages it", deploymentName), nil
+	} else if !apierrors.IsNotFound(err) {
+		return "", errors.Wrapf(err, "error getting ReplicaSet %s/%s", namespace, obj.GetName())
+	}
+
+	if err := unstructured.SetNestedField(obj.Object, int64(0), "spec", "replicas"); err != nil {
+		return "", errors.Wrap(err, "error setting the replicas of the ReplicaSet")
+	}
+	if revision := deploymentRevision(obj); revision > 0 {
+		annotations := obj.GetAnnotations()
+		annotations[deploymentRevisionAnnotation] = strconv.FormatInt(deploymentRevision(existing)+revision, 10)
+		obj.SetAnnotations(annotations)
+	}
+	return "", nil
+}
+
+// podsWithVolumeBackups returns the number of the backed up pods in the namespace named after the
+// Deployment or the ReplicaSet whose volumes are restored by the file system backup.
+func (ctx *restoreContext) podsWithVolumeBackups(namespace, owner string) int64 {
+	pods := map[string]struct{}{}
+	for _, pvb := range ctx.podVolumeBackups {
+		if pvb.Spec.Pod.Namespace == namespace && strings.HasPrefix(pvb.Spec.Pod.Name, owner+"-") && pvb.Status.SnapshotID != "" {
+			pods[pvb.Spec.Pod.Name] = struct{}{}
+		}
+	}
+	return int64(len(pods))
+}
+
+// scaleDeploymentGradually restores the Deployment with the replicas of a step when the restore
+// scales the Deployments up gradually, recording its backed up replicas to scale it up to. It's
+// restored with more replicas when more of its pods are restored, for their volumes, so they aren't
+// removed by its ReplicaSets. An existing Deployment isn't scaled down: it's restored with its
+// current replicas when they're more than a step.
+func (ctx *restoreContext) scaleDeploymentGradually(obj, existing *unstructured.Unstructured, itemKey itemKey) error {
+	gradualScale := ctx.restore.Spec.GradualScale
+	if gradualScale == nil || ctx.dryRun() {
+		return nil
+	}
+
+	replicas, found, err := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+	if err != nil {
+		return errors.Wrap(err, "error getting the replicas of the Deployment")
+	}
+	if !found {
+		// the replicas of a Deployment default to one
+		replicas = 1
+	}
+	start := max(int64(gradualScale.Step), ctx.podsWithVolumeBackups(obj.GetNamespace(), obj.GetName()))
+	if existing != nil {
+		current, found, _ := unstructured.NestedInt64(existing.Object, "spec", "replicas")
+		if !found {
+			current = 1
+		}
+		start = max(start, current)
+	}
+	if replicas <= start {
+		return nil
+	}
+
+	if err := unstructured.SetNestedField(obj.Object, start, "spec", "replicas"); err != nil {
+		return errors.Wrap(err, "error setting the replicas of the Deployment")
+	}
+	ctx.gradualDeployments = append(ctx.gradualDeployments, gradualDeployment{itemKey: itemKey, name: obj.GetName(), start: start, replicas: replicas})
+	return nil
+}
+
+// scaleUpDeploymentsGradually scales the restored Deployments up a step at a time to their backed
+// up replicas. The Deployments failing to be scaled up are reported as warnings.
+func (ctx *restoreContext) scaleUpDeploymentsGradually() results.Result {
+	warnings := results.Result{}
+	lock := sync.Mutex{}
+	wg := sync.WaitGroup{}
+
+	for _, deployment := range ctx.gradualDeployments {
+		// the Deployments the restore didn't create or update are left as they are
+		if action := ctx.restoredItems[deployment.itemKey].action; action != ItemRestoreResultCreated && action != ItemRestoreResultUpdated {
+			continue
+		}
+
+		wg.Add(1)
+		go func(deployment gradualDeployment) {
+			defer wg.Done()
+			if err := ctx.scaleUpDeploymentGradually(deployment); err != nil {
+				lock.Lock()
+				warnings.Add(deployment.itemKey.namespace, err)
+				lock.Unlock()
+			}
+		}(deployment)
+	}
+	wg.Wait()
+
+	return warnings
+}
+
+// scaleUpDeploymentGradually scales the Deployment up by a step once the replicas of the current
+// step are ready, waiting for the interval between the steps, until it reaches its replicas.
+func (ctx *restoreContext) scaleUpDeploymentGradually(deployment gradualDeployment) error {
+	gradualScale := ctx.restore.Spec.GradualScale
+	timeout := ctx.resourceTimeout
+	if gradualScale.Timeout != nil {
+		timeout = gradualScale.Timeout.Duration
+	}
+	namespace, step := deployment.itemKey.namespace, int64(gradualScale.Step)
+	log := ctx.log.WithField("namespace", namespace).WithField("name", deployment.name)
+
+	deploymentClient, err := ctx.appsClient(kuberesource.Deployments, namespace)
+	if err != nil {
+		return err
+	}
+
+	for replicas := deployment.start; replicas < deployment.replicas; {
+		err := wait.PollUntilContextTimeout(go_context.Background(), gradualScalePollInterval, timeout, true, func(go_context.Context) (bool, error) {
+			res, err := deploymentClient.Get(deployment.name, metav1.GetOptions{})
+			if err != nil {
+				return false, errors.Wrap(err, "error getting Deployment")
+			}
+			generation, _, _ := unstructured.NestedInt64(res.Object, "status", "observedGeneration")
+			ready, _, _ := unstructured.NestedInt64(res.Object, "status", "readyReplicas")
+			return generation >= res.GetGeneration() && ready >= replicas, nil
+		})
+		if err != nil {
+			return errors.Wrapf(err, "error waiting for the %d replicas of Deployment %s/%s to be ready, it's left at them instead of %d", replicas, namespace, deployment.name, deployment.replicas)
+		}
+		time.Sleep(gradualScale.Interval.Duration)
+
+		replicas = min(replicas+step, deployment.replicas)
+		if _, err := deploymentClient.Patch(deployment.name, []byte(fmt.Sprintf(`{"spec":{"replicas":%d}}`, replicas))); err != nil {
+			return errors.Wrapf(err, "error scaling Deployment %s/%s up to %d replicas", namespace, deployment.name, replicas)
+		}
+		log.Infof("Scaled the restored Deployment up to %d replicas of %d", replicas, deployment.replicas)
+	}
+	return nil
+}
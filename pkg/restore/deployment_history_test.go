@@ -0,0 +1,280 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+func controlledBy(kind, name string) builder.ObjectMetaOpt {
+	return builder.WithOwnerReference([]metav1.OwnerReference{{APIVersion: "apps/v1", Kind: kind, Name: name, Controller: ptr.To(true)}})
+}
+
+func newReplicaSet(name, deployment, revision string, replicas int32) *appsv1.ReplicaSet {
+	return &appsv1.ReplicaSet{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "ReplicaSet"},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "ns-1",
+			Name:            name,
+			Annotations:     map[string]string{deploymentRevisionAnnotation: revision},
+			OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: deployment, Controller: ptr.To(true)}},
+		},
+		Spec: appsv1.ReplicaSetSpec{Replicas: &replicas},
+	}
+}
+
+func toUnstructured(t *testing.T, obj runtime.Object) *unstructured.Unstructured {
+	t.Helper()
+	res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: res}
+}
+
+func TestOwnerDeploymentName(t *testing.T) {
+	tests := []struct {
+		name          string
+		obj           runtime.Object
+		groupResource schema.GroupResource
+		expected      string
+	}{
+		{
+			name:          "replica set of a deployment",
+			obj:           newReplicaSet("deploy-1-abc", "deploy-1", "1", 1),
+			groupResource: kuberesource.ReplicaSets,
+			expected:      "deploy-1",
+		},
+		{
+			name: "pod of the replica set of a deployment",
+			obj: builder.ForPod("ns-1", "deploy-1-abc-xyz").
+				ObjectMeta(builder.WithLabels(appsv1.DefaultDeploymentUniqueLabelKey, "abc"), controlledBy("ReplicaSet", "deploy-1-abc")).Result(),
+			groupResource: kuberesource.Pods,
+			expected:      "deploy-1",
+		},
+		{
+			name:          "pod of a replica set without pod template hash",
+			obj:           builder.ForPod("ns-1", "rs-1-xyz").ObjectMeta(controlledBy("ReplicaSet", "rs-1")).Result(),
+			groupResource: kuberesource.Pods,
+		},
+		{
+			name:          "pod of a stateful set",
+			obj:           builder.ForPod("ns-1", "sts-1-0").ObjectMeta(controlledBy("StatefulSet", "sts-1")).Result(),
+			groupResource: kuberesource.Pods,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ownerDeploymentName(toUnstructured(t, tc.obj), tc.groupResource))
+		})
+	}
+}
+
+func TestReconcileDeploymentHistory(t *testing.T) {
+	h := newHarness(t)
+	h.AddItems(t, test.Deployments(builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithAnnotations(deploymentRevisionAnnotation, "3")).Result()))
+	h.AddItems(t, test.Deployments(builder.ForDeployment("ns-1", "deploy-3").Replicas(4).Result()))
+	h.AddItems(t, test.ReplicaSets(newReplicaSet("deploy-1-aaa", "deploy-1", "3", 2)))
+
+	ctx := &restoreContext{
+		log:             h.log,
+		restore:         builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").GradualScale(&velerov1api.GradualScale{Step: 2}).Result(),
+		discoveryHelper: h.restorer.discoveryHelper,
+		dynamicFactory:  h.restorer.dynamicFactory,
+		podVolumeBackups: []*velerov1api.PodVolumeBackup{
+			builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").PodNamespace("ns-1").PodName("deploy-2-aaa-data").Volume("data").SnapshotID("snapshot-1").Result(),
+			builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-2").PodNamespace("ns-1").PodName("deploy-2-aaa-data2").Volume("data").SnapshotID("snapshot-2").Result(),
+			builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-3").PodNamespace("ns-1").PodName("deploy-2-aaa-data3").Volume("data").SnapshotID("snapshot-3").Result(),
+		},
+	}
+
+	tests := []struct {
+		name             string
+		obj              runtime.Object
+		groupResource    schema.GroupResource
+		expectedReason   string
+		expectedReplicas int64
+		expectedRevision string
+	}{
+		{
+			name: "pod of an existing deployment is skipped",
+			obj: builder.ForPod("ns-1", "deploy-1-aaa-xyz").
+				ObjectMeta(builder.WithLabels(appsv1.DefaultDeploymentUniqueLabelKey, "aaa"), controlledBy("ReplicaSet", "deploy-1-aaa")).Result(),
+			groupResource:  kuberesource.Pods,
+			expectedReason: "its Deployment deploy-1 exists and manages its pods",
+		},
+		{
+			name: "pod of a deployment which doesn't exist is left to its replica set scaled up gradually",
+			obj: builder.ForPod("ns-1", "deploy-2-aaa-xyz").
+				ObjectMeta(builder.WithLabels(appsv1.DefaultDeploymentUniqueLabelKey, "aaa"), controlledBy("ReplicaSet", "deploy-2-aaa")).Result(),
+			groupResource:  kuberesource.Pods,
+			expectedReason: "its Deployment deploy-2 is scaled up gradually and its ReplicaSets create its pods",
+		},
+		{
+			name: "pod of a deployment which doesn't exist is restored when its volumes are",
+			obj: builder.ForPod("ns-1", "deploy-2-aaa-data").
+				ObjectMeta(builder.WithLabels(appsv1.DefaultDeploymentUniqueLabelKey, "aaa"), controlledBy("ReplicaSet", "deploy-2-aaa")).Result(),
+			groupResource: kuberesource.Pods,
+		},
+		{
+			name:           "existing replica set of an existing deployment is skipped",
+			obj:            newReplicaSet("deploy-1-aaa", "deploy-1", "1", 2),
+			groupResource:  kuberesource.ReplicaSets,
+			expectedReason: "its Deployment deploy-1 exists and manages it",
+		},
+		{
+			name:             "replica set of an existing deployment is restored without replicas after its revision",
+			obj:              newReplicaSet("deploy-1-bbb", "deploy-1", "2", 3),
+			groupResource:    kuberesource.ReplicaSets,
+			expectedReplicas: 0,
+			expectedRevision: "5",
+		},
+		{
+			name:             "replica set of a deployment which doesn't exist is restored with a step",
+			obj:              newReplicaSet("deploy-2-bbb", "deploy-2", "2", 3),
+			groupResource:    kuberesource.ReplicaSets,
+			expectedReplicas: 2,
+			expectedRevision: "2",
+		},
+		{
+			name:             "replica set of a deployment which doesn't exist is restored with its pods whose volumes are restored",
+			obj:              newReplicaSet("deploy-2-aaa", "deploy-2", "2", 5),
+			groupResource:    kuberesource.ReplicaSets,
+			expectedReplicas: 3,
+			expectedRevision: "2",
+		},
+		{
+			name:             "existing deployment keeps its revision",
+			obj:              builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithAnnotations(deploymentRevisionAnnotation, "7")).Replicas(5).Result(),
+			groupResource:    kuberesource.Deployments,
+			expectedReplicas: 2,
+			expectedRevision: "3",
+		},
+		{
+			name:             "existing deployment isn't scaled down below its current replicas",
+			obj:              builder.ForDeployment("ns-1", "deploy-3").Replicas(6).Result(),
+			groupResource:    kuberesource.Deployments,
+			expectedReplicas: 4,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := toUnstructured(t, tc.obj)
+			reason, err := ctx.reconcileDeploymentHistory(obj, tc.groupResource, "ns-1", itemKey{namespace: "ns-1", name: obj.GetName()})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedReason, reason)
+			if tc.groupResource == kuberesource.Pods || reason != "" {
+				return
+			}
+			replicas, _, err := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedReplicas, replicas)
+			assert.Equal(t, tc.expectedRevision, obj.GetAnnotations()[deploymentRevisionAnnotation])
+		})
+	}
+
+	assert.Equal(t, []gradualDeployment{
+		{itemKey: itemKey{namespace: "ns-1", name: "deploy-1"}, name: "deploy-1", start: 2, replicas: 5},
+		{itemKey: itemKey{namespace: "ns-1", name: "deploy-3"}, name: "deploy-3", start: 4, replicas: 6},
+	}, ctx.gradualDeployments)
+}
+
+func TestScaleUpDeploymentsGradually(t *testing.T) {
+	defer func(interval time.Duration) { gradualScalePollInterval = interval }(gradualScalePollInterval)
+	gradualScalePollInterval = time.Millisecond
+
+	tests := []struct {
+		name             string
+		readyReplicas    int64
+		action           string
+		expectWarnings   bool
+		expectedReplicas int64
+	}{
+		{
+			name:             "deployment is scaled up to its replicas",
+			readyReplicas:    5,
+			action:           ItemRestoreResultCreated,
+			expectedReplicas: 5,
+		},
+		{
+			name:             "deployment whose replicas aren't ready is left at them",
+			readyReplicas:    1,
+			action:           ItemRestoreResultUpdated,
+			expectWarnings:   true,
+			expectedReplicas: 2,
+		},
+		{
+			name:             "deployment which isn't restored is left as it is",
+			readyReplicas:    5,
+			action:           ItemRestoreResultSkipped,
+			expectedReplicas: 2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.AddItems(t, test.Deployments(builder.ForDeployment("ns-1", "deploy-1").Replicas(2).Result()))
+			deployments := h.DynamicClient.Resource(test.Deployments().GVR()).Namespace("ns-1")
+			deployment, err := deployments.Get(context.TODO(), "deploy-1", metav1.GetOptions{})
+			require.NoError(t, err)
+			require.NoError(t, unstructured.SetNestedField(deployment.Object, tc.readyReplicas, "status", "readyReplicas"))
+			_, err = deployments.Update(context.TODO(), deployment, metav1.UpdateOptions{})
+			require.NoError(t, err)
+
+			key := itemKey{resource: "apps/v1/Deployment", namespace: "ns-1", name: "deploy-1"}
+			ctx := &restoreContext{
+				log: h.log,
+				restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").
+					GradualScale(&velerov1api.GradualScale{Step: 2, Timeout: &metav1.Duration{Duration: 10 * time.Millisecond}}).Result(),
+				discoveryHelper:    h.restorer.discoveryHelper,
+				dynamicFactory:     h.restorer.dynamicFactory,
+				restoredItems:      map[itemKey]restoredItemStatus{key: {action: tc.action}},
+				gradualDeployments: []gradualDeployment{{itemKey: key, name: "deploy-1", start: 2, replicas: 5}},
+			}
+			warnings := ctx.scaleUpDeploymentsGradually()
+			if tc.expectWarnings {
+				assert.NotEqual(t, results.Result{}, warnings)
+			} else {
+				assert.Equal(t, results.Result{}, warnings)
+			}
+
+			deployment, err = deployments.Get(context.TODO(), "deploy-1", metav1.GetOptions{})
+			require.NoError(t, err)
+			replicas, _, err := unstructured.NestedInt64(deployment.Object, "spec", "replicas")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedReplicas, replicas)
+		})
+	}
+}
@@ -0,0 +1,283 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/vmware-tanzu/velero/internal/volume"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// immutableField is a field of the items of a resource which can't be changed once they're created.
+type immutableField struct {
+	path []string
+
+	// normalize, if set, returns the in-cluster value of the field as the backed up one would be
+	// if they're the same, e.g. without the labels the restore item actions remove.
+	normalize func(value any) any
+
+	// changeable, if set, returns whether the in-cluster value may still be changed to the backed
+	// up one, e.g. when a PVC is expanded.
+	changeable func(fromCluster, desired any) bool
+
+	// immutable, if set, returns whether the field of the in-cluster item is immutable.
+	immutable func(fromCluster *unstructured.Unstructured) bool
+}
+
+// jobControllerUIDLabels are the labels of the selector and the pod template of the Jobs which
+// the JobAction removes, as they're set again when the Job is created.
+var jobControllerUIDLabels = []string{"controller-uid", "batch.kubernetes.io/controller-uid"}
+
+var workloadSelector = immutableField{path: []string{"spec", "selector"}}
+
+// immutableFields are the immutable fields of the built-in resources.
+var immutableFields = map[schema.GroupResource][]immutableField{
+	kuberesource.Services: {
+		{path: []string{"spec", "clusterIP"}},
+		{path: []string{"spec", "clusterIPs"}},
+	},
+	kuberesource.PersistentVolumeClaims: {
+		{path: []string{"spec", "storageClassName"}},
+		{path: []string{"spec", "volumeName"}},
+		{path: []string{"spec", "volumeMode"}},
+		{path: []string{"spec", "accessModes"}},
+		{path: []string{"spec", "selector"}},
+		{path: []string{"spec", "dataSource"}},
+		{path: []string{"spec", "dataSourceRef"}},
+		{path: []string{"spec", "resources", "requests", "storage"}, changeable: isExpanded},
+	},
+	kuberesource.Jobs: {
+		{path: []string{"spec", "selector"}, normalize: withoutLabels([]string{"matchLabels"}, jobControllerUIDLabels)},
+		{path: []string{"spec", "template"}, normalize: withoutLabels([]string{"metadata", "labels"}, jobControllerUIDLabels)},
+		{path: []string{"spec", "completionMode"}},
+	},
+	kuberesource.Deployments: {workloadSelector},
+	kuberesource.ReplicaSets: {workloadSelector},
+	kuberesource.DaemonSets:  {workloadSelector},
+	kuberesource.StatefulSets: {
+		workloadSelector,
+		{path: []string{"spec", "serviceName"}},
+		{path: []string{"spec", "podManagementPolicy"}},
+		{path: []string{"spec", "volumeClaimTemplates"}},
+	},
+	kuberesource.ConfigMaps: {
+		{path: []string{"data"}, immutable: isImmutable},
+		{path: []string{"binaryData"}, immutable: isImmutable},
+	},
+	kuberesource.Secrets: {
+		{path: []string{"type"}},
+		{path: []string{"data"}, immutable: isImmutable},
+	},
+}
+
+// isImmutable returns whether the ConfigMap or the Secret is immutable.
+func isImmutable(fromCluster *unstructured.Unstructured) bool {
+	immutable, _, _ := unstructured.NestedBool(fromCluster.Object, "immutable")
+	return immutable
+}
+
+// isExpanded returns whether the requested storage of the PVC grows, the PVCs being expandable
+// but not shrinkable.
+func isExpanded(fromCluster, desired any) bool {
+	current, err := resource.ParseQuantity(toString(fromCluster))
+	if err != nil {
+		return false
+	}
+	requested, err := resource.ParseQuantity(toString(desired))
+	if err != nil {
+		return false
+	}
+	return requested.Cmp(current) >= 0
+}
+
+func toString(value any) string {
+	s, _ := value.(string)
+	return s
+}
+
+// withoutLabels returns a normalize function removing the labels from the label map at the path
+// of the value, and the map when it's left empty.
+func withoutLabels(path []string, labels []string) func(any) any {
+	return func(value any) any {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return value
+		}
+		object = runtime.DeepCopyJSON(object)
+		values, found, _ := unstructured.NestedMap(object, path...)
+		if !found {
+			return object
+		}
+		for _, label := range labels {
+			delete(values, label)
+		}
+		if len(values) == 0 {
+			unstructured.RemoveNestedField(object, path...)
+		} else {
+			_ = unstructured.SetNestedMap(object, values, path...)
+		}
+		return object
+	}
+}
+
+// applicableImmutableFields returns the immutable fields of the in-cluster item.
+func applicableImmutableFields(groupResource schema.GroupResource, fromCluster *unstructured.Unstructured) []immutableField {
+	var fields []immutableField
+	for _, field := range immutableFields[groupResource] {
+		if field.immutable == nil || field.immutable(fromCluster) {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// immutableFieldConflicts returns the paths of the immutable fields which are set in the backed
+// up item and can't be changed to their backed up values in the in-cluster item.
+func immutableFieldConflicts(groupResource schema.GroupResource, fromCluster, obj *unstructured.Unstructured) []string {
+	var conflicts []string
+	for _, field := range applicableImmutableFields(groupResource, fromCluster) {
+		desired, found, _ := unstructured.NestedFieldNoCopy(obj.Object, field.path...)
+		if !found {
+			continue
+		}
+		current, _, _ := unstructured.NestedFieldNoCopy(fromCluster.Object, field.path...)
+		if field.changeable != nil && field.changeable(current, desired) {
+			continue
+		}
+		if field.normalize != nil {
+			current = field.normalize(current)
+		}
+		if !equality.Semantic.DeepEqual(current, desired) {
+			conflicts = append(conflicts, strings.Join(field.path, "."))
+		}
+	}
+	return conflicts
+}
+
+// preserveImmutableFields sets the immutable fields of the backed up item to their in-cluster
+// values, unless they can be changed, so that patching the in-cluster item leaves them unchanged.
+func preserveImmutableFields(groupResource schema.GroupResource, fromCluster, obj *unstructured.Unstructured) {
+	for _, field := range applicableImmutableFields(groupResource, fromCluster) {
+		current, found, _ := unstructured.NestedFieldCopy(fromCluster.Object, field.path...)
+		if field.changeable != nil {
+			if desired, set, _ := unstructured.NestedFieldNoCopy(obj.Object, field.path...); set && field.changeable(current, desired) {
+				continue
+			}
+		}
+		if found {
+			_ = unstructured.SetNestedField(obj.Object, current, field.path...)
+		} else {
+			unstructured.RemoveNestedField(obj.Object, field.path...)
+		}
+	}
+}
+
+// immutableFieldPolicy returns the policy of the existing items of the resource whose immutable
+// fields differ from the backed up version, empty if there's none.
+func (ctx *restoreContext) immutableFieldPolicy(groupResource schema.GroupResource) velerov1api.ImmutableFieldPolicy {
+	return ctx.restore.Spec.ImmutableFieldPolicies[groupResource.String()]
+}
+
+// processImmutableFields applies the immutable field policy of the resource to the existing item
+// being updated. It returns the backed up item to patch the in-cluster one with, its immutable
+// fields set to their in-cluster values, or nil if the item was recreated or failed.
+func (ctx *restoreContext) processImmutableFields(fromCluster, obj *unstructured.Unstructured, groupResource schema.GroupResource, policy velerov1api.ImmutableFieldPolicy, resourceClient client.Dynamic) (*unstructured.Unstructured, error) {
+	if conflicts := immutableFieldConflicts(groupResource, fromCluster, obj); len(conflicts) > 0 {
+		switch policy {
+		case velerov1api.ImmutableFieldPolicyFail:
+			return nil, errors.Errorf("the immutable fields %s of %s %s differ from the backed up version", strings.Join(conflicts, ", "), obj.GetKind(), kube.NamespaceAndName(obj))
+		case velerov1api.ImmutableFieldPolicyRecreate:
+			if groupResource == kuberesource.PersistentVolumeClaims && !ctx.pvcDataRestored(obj) {
+				return nil, errors.Errorf("the immutable fields %s of %s %s differ from the backed up version, but it isn't recreated as the restore doesn't restore its data", strings.Join(conflicts, ", "), obj.GetKind(), kube.NamespaceAndName(obj))
+			}
+			ctx.log.Infof("recreating %s %s as its immutable fields %s differ from the backed up version", obj.GetKind(), kube.NamespaceAndName(obj), strings.Join(conflicts, ", "))
+			if err := ctx.recreateItem(obj, resourceClient); err != nil {
+				return nil, err
+			}
+			return nil, nil
+		default:
+			ctx.log.Infof("keeping the in-cluster values of the immutable fields %s of %s %s", strings.Join(conflicts, ", "), obj.GetKind(), kube.NamespaceAndName(obj))
+		}
+	}
+
+	desired := obj.DeepCopy()
+	preserveImmutableFields(groupResource, fromCluster, desired)
+	return desired, nil
+}
+
+// pvcDataRestored returns whether the restore restores the data of the PVC from a snapshot, a
+// data mover or a pod volume backup, so that recreating the PVC doesn't lose its data.
+func (ctx *restoreContext) pvcDataRestored(obj *unstructured.Unstructured) bool {
+	for _, volumeInfo := range ctx.backupVolumeInfoMap {
+		namespace := volumeInfo.PVCNamespace
+		if mapped, ok := ctx.restore.Spec.NamespaceMapping[namespace]; ok {
+			namespace = mapped
+		}
+		if namespace != obj.GetNamespace() || volumeInfo.PVCName != obj.GetName() {
+			continue
+		}
+		switch volumeInfo.BackupMethod {
+		case volume.PodVolumeBackup:
+			return true
+		case volume.NativeSnapshot, volume.CSISnapshot:
+			return !boolptr.IsSetToFalse(ctx.restore.Spec.RestorePVs)
+		}
+	}
+	return false
+}
+
+// recreateItem deletes the in-cluster item along with its dependents, waits for it to be gone and
+// creates the backed up item.
+func (ctx *restoreContext) recreateItem(obj *unstructured.Unstructured, resourceClient client.Dynamic) error {
+	propagation := metav1.DeletePropagationBackground
+	if err := resourceClient.Delete(obj.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagation}); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "error deleting %s %s", obj.GetKind(), kube.NamespaceAndName(obj))
+	}
+
+	err := wait.PollUntilContextTimeout(go_context.Background(), time.Second, ctx.resourceTerminatingTimeout, true, func(go_context.Context) (bool, error) {
+		_, err := resourceClient.Get(obj.GetName(), metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, errors.WithStack(err)
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error waiting for %s %s to be deleted", obj.GetKind(), kube.NamespaceAndName(obj))
+	}
+
+	if _, err := resourceClient.Create(obj); err != nil {
+		return errors.Wrapf(err, "error creating %s %s again", obj.GetKind(), kube.NamespaceAndName(obj))
+	}
+	return nil
+}
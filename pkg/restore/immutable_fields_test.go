@@ -0,0 +1,286 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/velero/internal/volume"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+func newImmutableTestService(clusterIP string, port int64) *unstructured.Unstructured {
+	spec := map[string]any{
+		"ports": []any{map[string]any{"port": port}},
+	}
+	if clusterIP != "" {
+		spec["clusterIP"] = clusterIP
+	}
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata":   map[string]any{"name": "svc-1", "namespace": "ns-1"},
+		"spec":       spec,
+	}}
+}
+
+func newImmutableTestPVC(storageClass, storage string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "PersistentVolumeClaim",
+		"metadata":   map[string]any{"name": "pvc-1", "namespace": "ns-1"},
+		"spec": map[string]any{
+			"storageClassName": storageClass,
+			"resources":        map[string]any{"requests": map[string]any{"storage": storage}},
+		},
+	}}
+}
+
+func newImmutableTestJob(labels map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "batch/v1",
+		"kind":       "Job",
+		"metadata":   map[string]any{"name": "job-1", "namespace": "ns-1"},
+		"spec": map[string]any{
+			"selector": map[string]any{"matchLabels": labels},
+			"template": map[string]any{
+				"metadata": map[string]any{"labels": labels},
+			},
+		},
+	}}
+}
+
+func TestImmutableFieldConflicts(t *testing.T) {
+	withUID := map[string]any{"job-name": "job-1", "batch.kubernetes.io/controller-uid": "uid"}
+	withoutUID := map[string]any{"job-name": "job-1"}
+
+	immutableConfigMap := previewTestConfigMap(map[string]any{"key": "other"})
+	immutableConfigMap.Object["immutable"] = true
+
+	tests := []struct {
+		name        string
+		fromCluster *unstructured.Unstructured
+		obj         *unstructured.Unstructured
+		expected    []string
+	}{
+		{
+			name:        "Service whose clusterIP changed",
+			fromCluster: newImmutableTestService("10.0.0.1", 80),
+			obj:         newImmutableTestService("None", 80),
+			expected:    []string{"spec.clusterIP"},
+		},
+		{
+			name:        "Service whose clusterIP was reset",
+			fromCluster: newImmutableTestService("10.0.0.1", 80),
+			obj:         newImmutableTestService("", 8080),
+		},
+		{
+			name:        "PVC whose storage class changed and storage shrunk",
+			fromCluster: newImmutableTestPVC("fast", "10Gi"),
+			obj:         newImmutableTestPVC("slow", "5Gi"),
+			expected:    []string{"spec.storageClassName", "spec.resources.requests.storage"},
+		},
+		{
+			name:        "PVC whose storage is expanded",
+			fromCluster: newImmutableTestPVC("fast", "10Gi"),
+			obj:         newImmutableTestPVC("fast", "20Gi"),
+		},
+		{
+			name:        "Job without the controller-uid labels",
+			fromCluster: newImmutableTestJob(withUID),
+			obj:         newImmutableTestJob(withoutUID),
+		},
+		{
+			name:        "Job whose selector changed",
+			fromCluster: newImmutableTestJob(withUID),
+			obj:         newImmutableTestJob(map[string]any{"job-name": "job-2"}),
+			expected:    []string{"spec.selector", "spec.template"},
+		},
+		{
+			name:        "mutable ConfigMap",
+			fromCluster: previewTestConfigMap(map[string]any{"key": "other"}),
+			obj:         previewTestConfigMap(map[string]any{"key": "value"}),
+		},
+		{
+			name:        "immutable ConfigMap",
+			fromCluster: immutableConfigMap,
+			obj:         previewTestConfigMap(map[string]any{"key": "value"}),
+			expected:    []string{"data"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gr := kuberesource.Services
+			switch tc.obj.GetKind() {
+			case "PersistentVolumeClaim":
+				gr = kuberesource.PersistentVolumeClaims
+			case "Job":
+				gr = kuberesource.Jobs
+			case "ConfigMap":
+				gr = kuberesource.ConfigMaps
+			}
+			assert.Equal(t, tc.expected, immutableFieldConflicts(gr, tc.fromCluster, tc.obj))
+		})
+	}
+}
+
+func TestPreserveImmutableFields(t *testing.T) {
+	obj := newImmutableTestService("None", 8080)
+	preserveImmutableFields(kuberesource.Services, newImmutableTestService("10.0.0.1", 80), obj)
+	assert.Equal(t, newImmutableTestService("10.0.0.1", 8080), obj)
+
+	// the expanded storage is kept
+	obj = newImmutableTestPVC("slow", "20Gi")
+	preserveImmutableFields(kuberesource.PersistentVolumeClaims, newImmutableTestPVC("fast", "10Gi"), obj)
+	assert.Equal(t, newImmutableTestPVC("fast", "20Gi"), obj)
+
+	obj = newImmutableTestJob(map[string]any{"job-name": "job-1"})
+	withUID := map[string]any{"job-name": "job-1", "batch.kubernetes.io/controller-uid": "uid"}
+	preserveImmutableFields(kuberesource.Jobs, newImmutableTestJob(withUID), obj)
+	assert.Equal(t, newImmutableTestJob(withUID), obj)
+}
+
+func TestProcessUpdateResourcePolicyImmutableFields(t *testing.T) {
+	tests := []struct {
+		name           string
+		policy         velerov1api.ImmutableFieldPolicy
+		expectedCalls  []string
+		expectedErrors int
+	}{
+		{
+			name:          "the immutable fields are kept with the Skip policy",
+			policy:        velerov1api.ImmutableFieldPolicySkip,
+			expectedCalls: []string{"Patch"},
+		},
+		{
+			name:          "the item is recreated with the Recreate policy",
+			policy:        velerov1api.ImmutableFieldPolicyRecreate,
+			expectedCalls: []string{"Delete", "Get", "Create"},
+		},
+		{
+			name:           "the item fails with the Fail policy",
+			policy:         velerov1api.ImmutableFieldPolicyFail,
+			expectedErrors: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fromCluster := newImmutableTestService("10.0.0.1", 80)
+			obj := newImmutableTestService("None", 8080)
+
+			resourceClient := &velerotest.FakeDynamicClient{}
+			resourceClient.On("Patch", "svc-1", mock.Anything).Return(obj, nil)
+			resourceClient.On("Delete", "svc-1", mock.Anything).Return(nil)
+			resourceClient.On("Get", "svc-1", mock.Anything).Return((*unstructured.Unstructured)(nil), apierrors.NewNotFound(kuberesource.Services, "svc-1"))
+			resourceClient.On("Create", obj).Return(obj, nil)
+
+			ctx := &restoreContext{
+				log:                        velerotest.NewLogger(),
+				restore:                    builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").ImmutableFieldPolicy("services", tc.policy).Result(),
+				resourceTerminatingTimeout: time.Second,
+			}
+			warnings, errs := ctx.processUpdateResourcePolicy(fromCluster, fromCluster.DeepCopy(), obj, kuberesource.Services, "ns-1", resourceClient)
+
+			assert.True(t, warnings.IsEmpty())
+			assert.Len(t, errs.Namespaces["ns-1"], tc.expectedErrors)
+			var calls []string
+			for _, call := range resourceClient.Calls {
+				calls = append(calls, call.Method)
+			}
+			assert.Equal(t, tc.expectedCalls, calls)
+			if tc.policy == velerov1api.ImmutableFieldPolicySkip {
+				patch := string(resourceClient.Calls[0].Arguments.Get(1).([]byte))
+				assert.Contains(t, patch, `"ports":[{"port":8080}]`)
+				assert.NotContains(t, patch, "clusterIP")
+			}
+		})
+	}
+}
+
+func TestProcessImmutableFieldsRecreatePVC(t *testing.T) {
+	tests := []struct {
+		name           string
+		volumeInfos    map[string]volume.BackupVolumeInfo
+		restorePVs     *bool
+		expectedCalls  []string
+		expectedErrors int
+	}{
+		{
+			name:           "the PVC whose data isn't restored isn't recreated",
+			volumeInfos:    map[string]volume.BackupVolumeInfo{"pv-1": {PVCName: "pvc-1", PVCNamespace: "ns-1", PVName: "pv-1"}},
+			expectedErrors: 1,
+		},
+		{
+			name:           "the PVC whose snapshot isn't restored isn't recreated",
+			volumeInfos:    map[string]volume.BackupVolumeInfo{"pv-1": {PVCName: "pvc-1", PVCNamespace: "ns-1", PVName: "pv-1", BackupMethod: volume.CSISnapshot}},
+			restorePVs:     boolptr.False(),
+			expectedErrors: 1,
+		},
+		{
+			name:          "the PVC whose snapshot is restored is recreated",
+			volumeInfos:   map[string]volume.BackupVolumeInfo{"pv-1": {PVCName: "pvc-1", PVCNamespace: "ns-1", PVName: "pv-1", BackupMethod: volume.CSISnapshot}},
+			expectedCalls: []string{"Delete", "Get", "Create"},
+		},
+		{
+			name:          "the PVC whose pod volume backup is restored is recreated",
+			volumeInfos:   map[string]volume.BackupVolumeInfo{"pv-1": {PVCName: "pvc-1", PVCNamespace: "ns-1", PVName: "pv-1", BackupMethod: volume.PodVolumeBackup}},
+			expectedCalls: []string{"Delete", "Get", "Create"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fromCluster := newImmutableTestPVC("fast", "10Gi")
+			obj := newImmutableTestPVC("slow", "10Gi")
+
+			resourceClient := &velerotest.FakeDynamicClient{}
+			resourceClient.On("Delete", "pvc-1", mock.Anything).Return(nil)
+			resourceClient.On("Get", "pvc-1", mock.Anything).Return((*unstructured.Unstructured)(nil), apierrors.NewNotFound(kuberesource.PersistentVolumeClaims, "pvc-1"))
+			resourceClient.On("Create", obj).Return(obj, nil)
+
+			restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").ImmutableFieldPolicy("persistentvolumeclaims", velerov1api.ImmutableFieldPolicyRecreate).Result()
+			restore.Spec.RestorePVs = tc.restorePVs
+			ctx := &restoreContext{
+				log:                        velerotest.NewLogger(),
+				restore:                    restore,
+				backupVolumeInfoMap:        tc.volumeInfos,
+				resourceTerminatingTimeout: time.Second,
+			}
+			warnings, errs := ctx.processUpdateResourcePolicy(fromCluster, fromCluster.DeepCopy(), obj, kuberesource.PersistentVolumeClaims, "ns-1", resourceClient)
+
+			assert.True(t, warnings.IsEmpty())
+			assert.Len(t, errs.Namespaces["ns-1"], tc.expectedErrors)
+			var calls []string
+			for _, call := range resourceClient.Calls {
+				calls = append(calls, call.Method)
+			}
+			assert.Equal(t, tc.expectedCalls, calls)
+		})
+	}
+}
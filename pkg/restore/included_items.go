@@ -0,0 +1,109 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+// ValidateIncludedItems returns the errors of the included items of a restore.
+func ValidateIncludedItems(items []velerov1api.RestoreItemSelector) []error {
+	var errs []error
+	for i, item := range items {
+		if item.Resource == "" {
+			errs = append(errs, errors.Errorf("included item %d has no resource", i))
+		}
+		if item.Name == "" {
+			errs = append(errs, errors.Errorf("included item %d has no name", i))
+		}
+	}
+	return errs
+}
+
+// resolveIncludedItems returns the namespaces and names, joined by a slash, of the included items
+// by their resolved group resource. It's nil if no item is included, every item being restored then.
+func resolveIncludedItems(helper discovery.Helper, items []velerov1api.RestoreItemSelector, log logrus.FieldLogger) map[string]sets.Set[string] {
+	if len(items) == 0 {
+		return nil
+	}
+
+	resolved := make(map[string]sets.Set[string])
+	for _, item := range items {
+		groupResource := schema.ParseGroupResource(item.Resource)
+		// the resource may be a short name or a kind, like pvc or Secret
+		gvr, _, err := helper.ResourceFor(groupResource.WithVersion(""))
+		if err != nil {
+			log.WithField("resource", item.Resource).Warn("Included item's resource cannot be resolved via discovery, matching it by name")
+		} else {
+			groupResource = gvr.GroupResource()
+		}
+
+		if resolved[groupResource.String()] == nil {
+			resolved[groupResource.String()] = sets.New[string]()
+		}
+		resolved[groupResource.String()].Insert(item.Namespace + "/" + item.Name)
+	}
+	return resolved
+}
+
+// isItemIncluded returns whether the item of the resource, in the namespace of the backup, is one of
+// the items included in the restore, if any.
+func (ctx *restoreContext) isItemIncluded(resource, namespace, name string) bool {
+	if ctx.includedItems == nil {
+		return true
+	}
+	return ctx.includedItems[resource].Has(namespace + "/" + name)
+}
+
+// includeBoundPersistentVolumes adds the PVs the included PVCs are bound to in the backup to the
+// included items, a PVC being restored with its PV.
+func (ctx *restoreContext) includeBoundPersistentVolumes() {
+	pvcs := ctx.includedItems[kuberesource.PersistentVolumeClaims.String()]
+	if pvcs.Len() == 0 {
+		return
+	}
+
+	pvs := ctx.includedItems[kuberesource.PersistentVolumes.String()]
+	if pvs == nil {
+		pvs = sets.New[string]()
+		ctx.includedItems[kuberesource.PersistentVolumes.String()] = pvs
+	}
+	for _, pvc := range sets.List(pvcs) {
+		namespace, name, _ := strings.Cut(pvc, "/")
+		itemPath := archive.GetItemFilePath(ctx.restoreDir, kuberesource.PersistentVolumeClaims.String(), namespace, name)
+		obj, err := archive.Unmarshal(ctx.fileSystem, itemPath)
+		if err != nil {
+			// the PVC isn't in the backup
+			continue
+		}
+		if volumeName, _, _ := unstructured.NestedString(obj.Object, "spec", "volumeName"); volumeName != "" {
+			ctx.log.Infof("Including PV %s bound to the included PVC %s", volumeName, pvc)
+			pvs.Insert("/" + volumeName)
+		}
+	}
+}
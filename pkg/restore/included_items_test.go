@@ -0,0 +1,36 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestValidateIncludedItems(t *testing.T) {
+	assert.Empty(t, ValidateIncludedItems([]velerov1api.RestoreItemSelector{
+		{Namespace: "ns-1", Resource: "secrets", Name: "secret-1"},
+		{Resource: "persistentvolumes", Name: "pv-1"},
+	}))
+	assert.Len(t, ValidateIncludedItems([]velerov1api.RestoreItemSelector{
+		{Namespace: "ns-1", Name: "secret-1"},
+		{Namespace: "ns-1", Resource: "secrets"},
+	}), 2)
+}
@@ -0,0 +1,224 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+// loadBalancerAnnotationPrefixes are the prefixes of the annotations of the cloud providers, the
+// load balancer controllers and ExternalDNS on the Services of type LoadBalancer.
+var loadBalancerAnnotationPrefixes = []string{
+	"service.beta.kubernetes.io/",
+	"service.kubernetes.io/",
+	"cloud.google.com/",
+	"networking.gke.io/",
+	"loadbalancer.openstack.org/",
+	"metallb.universe.tf/",
+	"metallb.io/",
+	"lbipam.cilium.io/",
+	"io.cilium/",
+	"external-dns.alpha.kubernetes.io/",
+}
+
+// loadBalancerStaticIPAnnotations are the annotations requesting static IPs, or public IP
+// resources, for the load balancers.
+var loadBalancerStaticIPAnnotations = []string{
+	"service.beta.kubernetes.io/azure-load-balancer-ipv4",
+	"service.beta.kubernetes.io/azure-load-balancer-ipv6",
+	"service.beta.kubernetes.io/azure-pip-name",
+	"service.beta.kubernetes.io/azure-pip-name-ipv6",
+	"service.beta.kubernetes.io/aws-load-balancer-eip-allocations",
+	"service.beta.kubernetes.io/aws-load-balancer-private-ipv4-addresses",
+	"networking.gke.io/load-balancer-ip-addresses",
+	"loadbalancer.openstack.org/load-balancer-address",
+	"metallb.universe.tf/loadBalancerIPs",
+	"metallb.io/loadBalancerIPs",
+	"lbipam.cilium.io/ips",
+	"io.cilium/lb-ipam-ips",
+}
+
+// loadBalancerPollInterval is how often the load balancers of the restored Services are checked.
+var loadBalancerPollInterval = time.Second
+
+// loadBalancerService is a restored Service of type LoadBalancer.
+type loadBalancerService struct {
+	itemKey         itemKey
+	name            string
+	backupAddresses []string
+}
+
+// loadBalancerPolicy returns the load balancer policy of the restore, or of its backup if the
+// restore doesn't set one, nil if neither does.
+func (ctx *restoreContext) loadBalancerPolicy() *velerov1api.LoadBalancerPolicy {
+	if ctx.restore.Spec.LoadBalancerPolicy != nil {
+		return ctx.restore.Spec.LoadBalancerPolicy
+	}
+	if ctx.backup != nil {
+		return ctx.backup.Spec.LoadBalancerPolicy
+	}
+	return nil
+}
+
+// loadBalancerAddresses returns the IPs and hostnames of the load balancer of the Service.
+func loadBalancerAddresses(service *corev1api.Service) []string {
+	var addresses []string
+	for _, ingress := range service.Status.LoadBalancer.Ingress {
+		if ingress.IP != "" {
+			addresses = append(addresses, ingress.IP)
+		}
+		if ingress.Hostname != "" {
+			addresses = append(addresses, ingress.Hostname)
+		}
+	}
+	return addresses
+}
+
+// prepareLoadBalancerService strips the provider annotations and the static IPs of the backed up
+// Service of type LoadBalancer according to the load balancer policy, recording the addresses of
+// its load balancer in the backup. The other Services are left as they are.
+func (ctx *restoreContext) prepareLoadBalancerService(obj *unstructured.Unstructured, itemKey itemKey) error {
+	service := new(corev1api.Service)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), service); err != nil {
+		return errors.Wrap(err, "error converting the Service")
+	}
+	if service.Spec.Type != corev1api.ServiceTypeLoadBalancer {
+		return nil
+	}
+	ctx.loadBalancerServices = append(ctx.loadBalancerServices, loadBalancerService{
+		itemKey:         itemKey,
+		name:            obj.GetName(),
+		backupAddresses: loadBalancerAddresses(service),
+	})
+
+	policy := ctx.loadBalancerPolicy()
+	if policy == nil {
+		return nil
+	}
+
+	annotations := obj.GetAnnotations()
+	for key := range annotations {
+		switch {
+		case policy.Annotations == velerov1api.LoadBalancerFieldPolicyStrip && hasAnyPrefix(key, loadBalancerAnnotationPrefixes):
+			delete(annotations, key)
+		case policy.StaticIPs == velerov1api.LoadBalancerFieldPolicyStrip && slices.Contains(loadBalancerStaticIPAnnotations, key):
+			delete(annotations, key)
+		}
+	}
+	obj.SetAnnotations(annotations)
+
+	if policy.StaticIPs == velerov1api.LoadBalancerFieldPolicyStrip {
+		unstructured.RemoveNestedField(obj.Object, "spec", "loadBalancerIP")
+	}
+	return nil
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// reportLoadBalancerAddresses records, in the status of the restore, the addresses of the load
+// balancers of the Services of type LoadBalancer the restore created or updated, in the backup and
+// in the cluster. It waits for the load balancers to be provisioned first if the load balancer
+// policy says so, the Services whose load balancer isn't provisioned in time being reported as
+// warnings.
+func (ctx *restoreContext) reportLoadBalancerAddresses() results.Result {
+	warnings := results.Result{}
+
+	waitForProvisioning, timeout := false, ctx.resourceTimeout
+	if policy := ctx.loadBalancerPolicy(); policy != nil {
+		waitForProvisioning = boolptr.IsSetToTrue(policy.WaitForProvisioning)
+		if policy.Timeout != nil {
+			timeout = policy.Timeout.Duration
+		}
+	}
+
+	var deadline time.Time
+	if waitForProvisioning {
+		deadline = time.Now().Add(timeout)
+	}
+	for _, service := range ctx.loadBalancerServices {
+		// the Services the restore didn't create or update are left out
+		if !ctx.restoredOrUpdated(service.itemKey) {
+			continue
+		}
+
+		addresses, err := ctx.getLoadBalancerAddresses(service, deadline)
+		if err != nil {
+			warnings.Add(service.itemKey.namespace, err)
+		}
+		ctx.restore.Status.LoadBalancerAddresses = append(ctx.restore.Status.LoadBalancerAddresses, velerov1api.LoadBalancerAddressMapping{
+			Namespace:       service.itemKey.namespace,
+			Name:            service.name,
+			BackupAddresses: service.backupAddresses,
+			Addresses:       addresses,
+		})
+		if len(addresses) > 0 {
+			ctx.log.Infof("The load balancer of Service %s/%s is at %s, it was at %s in the backup", service.itemKey.namespace, service.name,
+				strings.Join(addresses, ","), strings.Join(service.backupAddresses, ","))
+		}
+	}
+
+	return warnings
+}
+
+// getLoadBalancerAddresses returns the addresses of the load balancer of the restored Service,
+// waiting until the deadline for it to be provisioned unless the deadline is zero.
+func (ctx *restoreContext) getLoadBalancerAddresses(service loadBalancerService, deadline time.Time) ([]string, error) {
+	var addresses []string
+	getAddresses := func(go_context.Context) (bool, error) {
+		res, err := ctx.getRestoredCoreItem(kuberesource.Services, service.itemKey.namespace, service.name)
+		if err != nil {
+			return false, err
+		}
+		restored := new(corev1api.Service)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(res.UnstructuredContent(), restored); err != nil {
+			return false, errors.Wrap(err, "error converting the Service")
+		}
+		addresses = loadBalancerAddresses(restored)
+		return len(addresses) > 0, nil
+	}
+
+	if deadline.IsZero() {
+		_, err := getAddresses(go_context.Background())
+		return addresses, err
+	}
+	err := wait.PollUntilContextTimeout(go_context.Background(), loadBalancerPollInterval, max(time.Until(deadline), 0), true, getAddresses)
+	if err != nil {
+		return addresses, errors.Wrapf(err, "error waiting for the load balancer of Service %s/%s to be provisioned", service.itemKey.namespace, service.name)
+	}
+	return addresses, nil
+}
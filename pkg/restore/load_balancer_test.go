@@ -0,0 +1,216 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+func newLoadBalancerService(serviceType corev1api.ServiceType) *corev1api.Service {
+	service := builder.ForService("ns-1", "svc-1").ObjectMeta(builder.WithAnnotations(
+		"service.beta.kubernetes.io/aws-load-balancer-type", "nlb",
+		"service.beta.kubernetes.io/aws-load-balancer-eip-allocations", "eipalloc-1",
+		"external-dns.alpha.kubernetes.io/hostname", "app.example.com",
+		"app", "foo",
+	)).Result()
+	service.Spec.Type = serviceType
+	service.Spec.LoadBalancerIP = "10.0.0.1"
+	service.Status.LoadBalancer.Ingress = []corev1api.LoadBalancerIngress{{IP: "10.0.0.1"}, {Hostname: "lb.example.com"}}
+	return service
+}
+
+func TestPrepareLoadBalancerService(t *testing.T) {
+	tests := []struct {
+		name                string
+		service             *corev1api.Service
+		restorePolicy       *velerov1api.LoadBalancerPolicy
+		backupPolicy        *velerov1api.LoadBalancerPolicy
+		expectedAnnotations []string
+		expectedIP          string
+		expectedRecorded    bool
+	}{
+		{
+			name:                "load balancer is preserved without policy",
+			service:             newLoadBalancerService(corev1api.ServiceTypeLoadBalancer),
+			expectedAnnotations: []string{"app", "external-dns.alpha.kubernetes.io/hostname", "service.beta.kubernetes.io/aws-load-balancer-eip-allocations", "service.beta.kubernetes.io/aws-load-balancer-type"},
+			expectedIP:          "10.0.0.1",
+			expectedRecorded:    true,
+		},
+		{
+			name:                "annotations are stripped",
+			service:             newLoadBalancerService(corev1api.ServiceTypeLoadBalancer),
+			restorePolicy:       &velerov1api.LoadBalancerPolicy{Annotations: velerov1api.LoadBalancerFieldPolicyStrip},
+			expectedAnnotations: []string{"app"},
+			expectedIP:          "10.0.0.1",
+			expectedRecorded:    true,
+		},
+		{
+			name:                "static IPs of the backup policy are stripped",
+			service:             newLoadBalancerService(corev1api.ServiceTypeLoadBalancer),
+			backupPolicy:        &velerov1api.LoadBalancerPolicy{StaticIPs: velerov1api.LoadBalancerFieldPolicyStrip},
+			expectedAnnotations: []string{"app", "external-dns.alpha.kubernetes.io/hostname", "service.beta.kubernetes.io/aws-load-balancer-type"},
+			expectedRecorded:    true,
+		},
+		{
+			name:                "restore policy overrides the backup one",
+			service:             newLoadBalancerService(corev1api.ServiceTypeLoadBalancer),
+			restorePolicy:       &velerov1api.LoadBalancerPolicy{StaticIPs: velerov1api.LoadBalancerFieldPolicyPreserve},
+			backupPolicy:        &velerov1api.LoadBalancerPolicy{StaticIPs: velerov1api.LoadBalancerFieldPolicyStrip},
+			expectedAnnotations: []string{"app", "external-dns.alpha.kubernetes.io/hostname", "service.beta.kubernetes.io/aws-load-balancer-eip-allocations", "service.beta.kubernetes.io/aws-load-balancer-type"},
+			expectedIP:          "10.0.0.1",
+			expectedRecorded:    true,
+		},
+		{
+			name:                "other services are left as they are",
+			service:             newLoadBalancerService(corev1api.ServiceTypeClusterIP),
+			restorePolicy:       &velerov1api.LoadBalancerPolicy{Annotations: velerov1api.LoadBalancerFieldPolicyStrip, StaticIPs: velerov1api.LoadBalancerFieldPolicyStrip},
+			expectedAnnotations: []string{"app", "external-dns.alpha.kubernetes.io/hostname", "service.beta.kubernetes.io/aws-load-balancer-eip-allocations", "service.beta.kubernetes.io/aws-load-balancer-type"},
+			expectedIP:          "10.0.0.1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Result()
+			backup.Spec.LoadBalancerPolicy = tc.backupPolicy
+			restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result()
+			restore.Spec.LoadBalancerPolicy = tc.restorePolicy
+			ctx := &restoreContext{backup: backup, restore: restore}
+
+			obj := toUnstructured(t, tc.service)
+			key := itemKey{resource: "v1/Service", namespace: "ns-1", name: "svc-1"}
+			require.NoError(t, ctx.prepareLoadBalancerService(obj, key))
+
+			var annotations []string
+			for annotation := range obj.GetAnnotations() {
+				annotations = append(annotations, annotation)
+			}
+			assert.ElementsMatch(t, tc.expectedAnnotations, annotations)
+			ip, _, err := unstructured.NestedString(obj.Object, "spec", "loadBalancerIP")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedIP, ip)
+
+			if tc.expectedRecorded {
+				assert.Equal(t, []loadBalancerService{{itemKey: key, name: "svc-1", backupAddresses: []string{"10.0.0.1", "lb.example.com"}}}, ctx.loadBalancerServices)
+			} else {
+				assert.Empty(t, ctx.loadBalancerServices)
+			}
+		})
+	}
+}
+
+func TestReportLoadBalancerAddresses(t *testing.T) {
+	defer func(interval time.Duration) { loadBalancerPollInterval = interval }(loadBalancerPollInterval)
+	loadBalancerPollInterval = time.Millisecond
+
+	tests := []struct {
+		name              string
+		ingress           []corev1api.LoadBalancerIngress
+		waitFor           bool
+		action            string
+		expectWarnings    bool
+		expectedAddresses []velerov1api.LoadBalancerAddressMapping
+	}{
+		{
+			name:    "provisioned load balancer is reported",
+			ingress: []corev1api.LoadBalancerIngress{{IP: "10.0.0.2"}},
+			waitFor: true,
+			action:  ItemRestoreResultCreated,
+			expectedAddresses: []velerov1api.LoadBalancerAddressMapping{
+				{Namespace: "ns-1", Name: "svc-1", BackupAddresses: []string{"10.0.0.1"}, Addresses: []string{"10.0.0.2"}},
+			},
+		},
+		{
+			name:           "load balancer not provisioned in time is reported as a warning",
+			waitFor:        true,
+			action:         ItemRestoreResultUpdated,
+			expectWarnings: true,
+			expectedAddresses: []velerov1api.LoadBalancerAddressMapping{
+				{Namespace: "ns-1", Name: "svc-1", BackupAddresses: []string{"10.0.0.1"}},
+			},
+		},
+		{
+			name:   "load balancer isn't waited for without policy",
+			action: ItemRestoreResultCreated,
+			expectedAddresses: []velerov1api.LoadBalancerAddressMapping{
+				{Namespace: "ns-1", Name: "svc-1", BackupAddresses: []string{"10.0.0.1"}},
+			},
+		},
+		{
+			name:    "service which isn't restored is left out",
+			ingress: []corev1api.LoadBalancerIngress{{IP: "10.0.0.2"}},
+			waitFor: true,
+			action:  ItemRestoreResultSkipped,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.AddItems(t, test.Services(builder.ForService("ns-1", "svc-1").Result()))
+			services := h.DynamicClient.Resource(test.Services().GVR()).Namespace("ns-1")
+			service, err := services.Get(context.TODO(), "svc-1", metav1.GetOptions{})
+			require.NoError(t, err)
+			var ingress []any
+			for _, i := range tc.ingress {
+				ingress = append(ingress, map[string]any{"ip": i.IP})
+			}
+			require.NoError(t, unstructured.SetNestedSlice(service.Object, ingress, "status", "loadBalancer", "ingress"))
+			_, err = services.Update(context.TODO(), service, metav1.UpdateOptions{})
+			require.NoError(t, err)
+
+			restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result()
+			if tc.waitFor {
+				restore.Spec.LoadBalancerPolicy = &velerov1api.LoadBalancerPolicy{
+					WaitForProvisioning: ptr.To(true),
+					Timeout:             &metav1.Duration{Duration: 10 * time.Millisecond},
+				}
+			}
+			key := itemKey{resource: "v1/Service", namespace: "ns-1", name: "svc-1"}
+			ctx := &restoreContext{
+				log:                  h.log,
+				restore:              restore,
+				discoveryHelper:      h.restorer.discoveryHelper,
+				dynamicFactory:       h.restorer.dynamicFactory,
+				restoredItems:        map[itemKey]restoredItemStatus{key: {action: tc.action}},
+				loadBalancerServices: []loadBalancerService{{itemKey: key, name: "svc-1", backupAddresses: []string{"10.0.0.1"}}},
+			}
+
+			warnings := ctx.reportLoadBalancerAddresses()
+			if tc.expectWarnings {
+				assert.NotEqual(t, results.Result{}, warnings)
+			} else {
+				assert.Equal(t, results.Result{}, warnings)
+			}
+			assert.Equal(t, tc.expectedAddresses, restore.Status.LoadBalancerAddresses)
+		})
+	}
+}
@@ -0,0 +1,195 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+// itemUID is the UID of a restored item in the backup and in the cluster.
+type itemUID struct {
+	itemKey itemKey
+	mapping velerov1api.ItemUIDMapping
+}
+
+// mappedClaim is a restored PersistentVolumeClaim with the PersistentVolume it's bound to in the
+// backup.
+type mappedClaim struct {
+	itemKey      itemKey
+	name         string
+	backupVolume string
+}
+
+// mappedService is a restored Service with the node ports of its ports in the backup.
+type mappedService struct {
+	itemKey   itemKey
+	name      string
+	nodePorts []velerov1api.NodePortMapping
+}
+
+// mapItemUID records the UID of the item in the backup and of the item created, or found, in the
+// cluster.
+func (ctx *restoreContext) mapItemUID(itemKey itemKey, groupResource schema.GroupResource, backupNamespace string, backupUID k8stypes.UID, restored *unstructured.Unstructured) {
+	ctx.itemUIDs = append(ctx.itemUIDs, itemUID{
+		itemKey: itemKey,
+		mapping: velerov1api.ItemUIDMapping{
+			Resource:        groupResource.String(),
+			BackupNamespace: backupNamespace,
+			BackupName:      itemKey.name,
+			BackupUID:       backupUID,
+			Namespace:       restored.GetNamespace(),
+			Name:            restored.GetName(),
+			UID:             restored.GetUID(),
+		},
+	})
+}
+
+// mapPersistentVolumeClaim records the PersistentVolume the backed up PersistentVolumeClaim is
+// bound to.
+func (ctx *restoreContext) mapPersistentVolumeClaim(obj *unstructured.Unstructured, itemKey itemKey) {
+	volume, _, _ := unstructured.NestedString(obj.Object, "spec", "volumeName")
+	ctx.mappedClaims = append(ctx.mappedClaims, mappedClaim{itemKey: itemKey, name: obj.GetName(), backupVolume: volume})
+}
+
+// mapServiceNodePorts records the node ports of the backed up Service, if it has any.
+func (ctx *restoreContext) mapServiceNodePorts(obj *unstructured.Unstructured, itemKey itemKey) {
+	service := new(corev1api.Service)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), service); err != nil {
+		ctx.log.WithError(err).Warnf("Error converting Service %s/%s, its node ports aren't mapped", itemKey.namespace, obj.GetName())
+		return
+	}
+
+	var nodePorts []velerov1api.NodePortMapping
+	for _, port := range service.Spec.Ports {
+		if port.NodePort == 0 {
+			continue
+		}
+		nodePorts = append(nodePorts, velerov1api.NodePortMapping{
+			Namespace:      itemKey.namespace,
+			Name:           obj.GetName(),
+			Port:           port.Port,
+			Protocol:       portProtocol(port),
+			BackupNodePort: port.NodePort,
+		})
+	}
+	if len(nodePorts) > 0 {
+		ctx.mappedServices = append(ctx.mappedServices, mappedService{itemKey: itemKey, name: obj.GetName(), nodePorts: nodePorts})
+	}
+}
+
+func portProtocol(port corev1api.ServicePort) corev1api.Protocol {
+	if port.Protocol == "" {
+		return corev1api.ProtocolTCP
+	}
+	return port.Protocol
+}
+
+// restoredOrUpdated returns whether the restore created or updated the item.
+func (ctx *restoreContext) restoredOrUpdated(itemKey itemKey) bool {
+	action := ctx.restoredItems[itemKey].action
+	return action == ItemRestoreResultCreated || action == ItemRestoreResultUpdated
+}
+
+// buildMappingReport maps the UIDs, the PersistentVolumes, the load balancer addresses and the
+// node ports of the items in the backup to the ones of the items the restore created or updated.
+// The PersistentVolumes and the node ports are read from the cluster, the ones which can't be
+// read being reported as warnings. The PersistentVolumes of the claims bound after the restore
+// created them, e.g. by the async operations, are filled in when the restore is finalized.
+func (ctx *restoreContext) buildMappingReport() results.Result {
+	warnings := results.Result{}
+	report := ctx.mappingReport
+
+	for _, item := range ctx.itemUIDs {
+		if ctx.restoredOrUpdated(item.itemKey) {
+			report.Items = append(report.Items, item.mapping)
+		}
+	}
+
+	for _, claim := range ctx.mappedClaims {
+		if !ctx.restoredOrUpdated(claim.itemKey) {
+			continue
+		}
+		mapping := velerov1api.PersistentVolumeMapping{
+			Namespace:              claim.itemKey.namespace,
+			Name:                   claim.name,
+			BackupPersistentVolume: claim.backupVolume,
+		}
+		restored, err := ctx.getRestoredCoreItem(kuberesource.PersistentVolumeClaims, claim.itemKey.namespace, claim.name)
+		if err != nil {
+			warnings.Add(claim.itemKey.namespace, err)
+		} else {
+			mapping.PersistentVolume, _, _ = unstructured.NestedString(restored.Object, "spec", "volumeName")
+		}
+		report.PersistentVolumes = append(report.PersistentVolumes, mapping)
+	}
+
+	report.LoadBalancers = ctx.restore.Status.LoadBalancerAddresses
+
+	for _, service := range ctx.mappedServices {
+		if !ctx.restoredOrUpdated(service.itemKey) {
+			continue
+		}
+		restored, err := ctx.getRestoredCoreItem(kuberesource.Services, service.itemKey.namespace, service.name)
+		if err != nil {
+			warnings.Add(service.itemKey.namespace, err)
+			report.NodePorts = append(report.NodePorts, service.nodePorts...)
+			continue
+		}
+		restoredService := new(corev1api.Service)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(restored.UnstructuredContent(), restoredService); err != nil {
+			warnings.Add(service.itemKey.namespace, errors.Wrap(err, "error converting the Service"))
+			report.NodePorts = append(report.NodePorts, service.nodePorts...)
+			continue
+		}
+		for _, mapping := range service.nodePorts {
+			for _, port := range restoredService.Spec.Ports {
+				if port.Port == mapping.Port && portProtocol(port) == mapping.Protocol {
+					mapping.NodePort = port.NodePort
+					break
+				}
+			}
+			report.NodePorts = append(report.NodePorts, mapping)
+		}
+	}
+
+	return warnings
+}
+
+// getRestoredCoreItem gets the restored item of the namespaced resource of the core API group
+// from the cluster.
+func (ctx *restoreContext) getRestoredCoreItem(groupResource schema.GroupResource, namespace, name string) (*unstructured.Unstructured, error) {
+	resource := metav1.APIResource{Name: groupResource.Resource, Namespaced: true}
+	resourceClient, err := ctx.dynamicFactory.ClientForGroupVersionResource(corev1api.SchemeGroupVersion, resource, namespace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting client for %s", groupResource)
+	}
+	restored, err := resourceClient.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting %s %s/%s", groupResource, namespace, name)
+	}
+	return restored, nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+func newNodePortService(namespace, name string, nodePorts ...int32) *corev1api.Service {
+	service := builder.ForService(namespace, name).Result()
+	service.Spec.Type = corev1api.ServiceTypeNodePort
+	for i, nodePort := range nodePorts {
+		service.Spec.Ports = append(service.Spec.Ports, corev1api.ServicePort{Port: 80 + int32(i), NodePort: nodePort})
+	}
+	return service
+}
+
+func TestMapServiceNodePorts(t *testing.T) {
+	ctx := &restoreContext{log: test.NewLogger()}
+	key := itemKey{resource: "v1/Service", namespace: "ns-2", name: "svc-1"}
+
+	ctx.mapServiceNodePorts(toUnstructured(t, newNodePortService("ns-1", "svc-1", 30080, 30081)), key)
+	ctx.mapServiceNodePorts(toUnstructured(t, builder.ForService("ns-1", "svc-2").Result()), itemKey{resource: "v1/Service", namespace: "ns-2", name: "svc-2"})
+
+	assert.Equal(t, []mappedService{{
+		itemKey: key,
+		name:    "svc-1",
+		nodePorts: []velerov1api.NodePortMapping{
+			{Namespace: "ns-2", Name: "svc-1", Port: 80, Protocol: corev1api.ProtocolTCP, BackupNodePort: 30080},
+			{Namespace: "ns-2", Name: "svc-1", Port: 81, Protocol: corev1api.ProtocolTCP, BackupNodePort: 30081},
+		},
+	}}, ctx.mappedServices)
+}
+
+func TestBuildMappingReport(t *testing.T) {
+	h := newHarness(t)
+	h.AddItems(t, test.Services(newNodePortService("ns-2", "svc-1", 31080)))
+	h.AddItems(t, test.PVCs(builder.ForPersistentVolumeClaim("ns-2", "pvc-1").VolumeName("pv-2").Result()))
+	h.AddItems(t, test.PVCs(builder.ForPersistentVolumeClaim("ns-2", "pvc-2").Result()))
+
+	serviceKey := itemKey{resource: "v1/Service", namespace: "ns-2", name: "svc-1"}
+	claimKey := itemKey{resource: "v1/PersistentVolumeClaim", namespace: "ns-2", name: "pvc-1"}
+	skippedClaimKey := itemKey{resource: "v1/PersistentVolumeClaim", namespace: "ns-2", name: "pvc-2"}
+	loadBalancers := []velerov1api.LoadBalancerAddressMapping{{Namespace: "ns-2", Name: "svc-3", BackupAddresses: []string{"10.0.0.1"}, Addresses: []string{"10.0.0.2"}}}
+
+	ctx := &restoreContext{
+		log:             h.log,
+		restore:         builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result(),
+		discoveryHelper: h.restorer.discoveryHelper,
+		dynamicFactory:  h.restorer.dynamicFactory,
+		mappingReport:   &velerov1api.RestoreMappingReport{},
+		restoredItems: map[itemKey]restoredItemStatus{
+			serviceKey:      {action: ItemRestoreResultCreated},
+			claimKey:        {action: ItemRestoreResultUpdated},
+			skippedClaimKey: {action: ItemRestoreResultSkipped},
+		},
+	}
+	ctx.restore.Status.LoadBalancerAddresses = loadBalancers
+
+	service, err := h.DynamicClient.Resource(test.Services().GVR()).Namespace("ns-2").Get(context.TODO(), "svc-1", metav1.GetOptions{})
+	require.NoError(t, err)
+	ctx.mapItemUID(serviceKey, kuberesource.Services, "ns-1", "uid-1", service)
+	ctx.mapItemUID(skippedClaimKey, kuberesource.PersistentVolumeClaims, "ns-1", "uid-2", &unstructured.Unstructured{})
+	ctx.mapServiceNodePorts(toUnstructured(t, newNodePortService("ns-1", "svc-1", 30080)), serviceKey)
+	ctx.mapPersistentVolumeClaim(toUnstructured(t, builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result()), claimKey)
+	ctx.mapPersistentVolumeClaim(toUnstructured(t, builder.ForPersistentVolumeClaim("ns-1", "pvc-2").VolumeName("pv-3").Result()), skippedClaimKey)
+
+	warnings := ctx.buildMappingReport()
+	assert.Equal(t, results.Result{}, warnings)
+	assert.Equal(t, &velerov1api.RestoreMappingReport{
+		Items: []velerov1api.ItemUIDMapping{
+			{Resource: "services", BackupNamespace: "ns-1", BackupName: "svc-1", BackupUID: "uid-1", Namespace: "ns-2", Name: "svc-1", UID: service.GetUID()},
+		},
+		PersistentVolumes: []velerov1api.PersistentVolumeMapping{
+			{Namespace: "ns-2", Name: "pvc-1", BackupPersistentVolume: "pv-1", PersistentVolume: "pv-2"},
+		},
+		LoadBalancers: loadBalancers,
+		NodePorts: []velerov1api.NodePortMapping{
+			{Namespace: "ns-2", Name: "svc-1", Port: 80, Protocol: corev1api.ProtocolTCP, BackupNodePort: 30080, NodePort: 31080},
+		},
+	}, ctx.mappingReport)
+}
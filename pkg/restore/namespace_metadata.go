@@ -0,0 +1,96 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"maps"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+)
+
+// reconcileNamespaceMetadata applies the labels and annotations of the backed up namespace to
+// the existing namespace it's restored into, according to the namespace metadata policy of the
+// restore. It's a no-op without a policy, when the restore just created the namespace from the
+// backed up one or when the backup doesn't hold the namespace. Each namespace is reconciled once.
+func (ctx *restoreContext) reconcileNamespaceMetadata(backupName, name string, created bool) error {
+	policy := ctx.restore.Spec.NamespaceMetadataPolicy
+	if policy == "" || ctx.reconciledNamespaces.Has(name) {
+		return nil
+	}
+	ctx.reconciledNamespaces.Insert(name)
+	if created {
+		return nil
+	}
+
+	backupNS, err := archive.Unmarshal(ctx.fileSystem, archive.GetItemFilePath(ctx.restoreDir, "namespaces", "", backupName))
+	if err != nil {
+		ctx.log.Debugf("Namespace %s isn't in the backup, not reconciling the metadata of namespace %s", backupName, name)
+		return nil
+	}
+
+	ns, err := ctx.namespaceClient.Get(go_context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "error getting namespace %s to reconcile its metadata", name)
+	}
+
+	updated := ns.DeepCopy()
+	switch policy {
+	case velerov1api.NamespaceMetadataPolicyMerge:
+		updated.Labels = mergeStringMaps(updated.Labels, backupNS.GetLabels())
+		updated.Annotations = mergeStringMaps(updated.Annotations, backupNS.GetAnnotations())
+	case velerov1api.NamespaceMetadataPolicyOverride:
+		// the existing annotations are kept, as they're often set by the cluster, e.g. by OpenShift
+		updated.Labels = backupNS.GetLabels()
+		updated.Annotations = mergeStringMaps(updated.Annotations, backupNS.GetAnnotations())
+	default:
+		return errors.Errorf("unknown namespace metadata policy %s", policy)
+	}
+	// the label holding the name of the namespace is kept as the API server sets it
+	if value, ok := ns.Labels[corev1api.LabelMetadataName]; ok {
+		if updated.Labels == nil {
+			updated.Labels = map[string]string{}
+		}
+		updated.Labels[corev1api.LabelMetadataName] = value
+	}
+
+	if maps.Equal(ns.Labels, updated.Labels) && maps.Equal(ns.Annotations, updated.Annotations) {
+		return nil
+	}
+	if _, err := ctx.namespaceClient.Update(go_context.TODO(), updated, metav1.UpdateOptions{}); err != nil {
+		return errors.Wrapf(err, "error reconciling the metadata of namespace %s", name)
+	}
+
+	ctx.log.Infof("Reconciled the metadata of namespace %s with namespace %s of the backup by the %s policy", name, backupName, policy)
+	return nil
+}
+
+// mergeStringMaps returns the entries of existing overwritten by those of backedUp.
+func mergeStringMaps(existing, backedUp map[string]string) map[string]string {
+	if len(backedUp) == 0 {
+		return existing
+	}
+	merged := make(map[string]string, len(existing)+len(backedUp))
+	maps.Copy(merged, existing)
+	maps.Copy(merged, backedUp)
+	return merged
+}
@@ -0,0 +1,124 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// TestRestoreNamespaceMetadataPolicy verifies that the labels and annotations of the backed
+// up namespaces are applied to the existing namespaces according to the namespace metadata
+// policy of the restore.
+func TestRestoreNamespaceMetadataPolicy(t *testing.T) {
+	tests := []struct {
+		name                string
+		policy              velerov1api.NamespaceMetadataPolicy
+		namespaceMapping    map[string]string
+		expectedLabels      map[string]string
+		expectedAnnotations map[string]string
+	}{
+		{
+			name: "existing namespaces are left as they are by default",
+			expectedLabels: map[string]string{
+				"pod-security.kubernetes.io/enforce": "baseline",
+				"team":                               "a",
+			},
+			expectedAnnotations: map[string]string{"owner": "team-a"},
+		},
+		{
+			name:   "the backed up metadata is added to the existing one by the Merge policy",
+			policy: velerov1api.NamespaceMetadataPolicyMerge,
+			expectedLabels: map[string]string{
+				"pod-security.kubernetes.io/enforce": "restricted",
+				"istio-injection":                    "enabled",
+				"team":                               "a",
+			},
+			expectedAnnotations: map[string]string{"owner": "team-a", "scheduler.alpha.kubernetes.io/node-selector": "pool=apps"},
+		},
+		{
+			name:   "the existing labels are replaced and the annotations merged by the Override policy",
+			policy: velerov1api.NamespaceMetadataPolicyOverride,
+			expectedLabels: map[string]string{
+				"pod-security.kubernetes.io/enforce": "restricted",
+				"istio-injection":                    "enabled",
+			},
+			expectedAnnotations: map[string]string{"owner": "team-a", "scheduler.alpha.kubernetes.io/node-selector": "pool=apps"},
+		},
+		{
+			name:             "the metadata of the backed up namespace is applied to the remapped namespace",
+			policy:           velerov1api.NamespaceMetadataPolicyOverride,
+			namespaceMapping: map[string]string{"ns-backup": "ns-1"},
+			expectedLabels: map[string]string{
+				"pod-security.kubernetes.io/enforce": "restricted",
+				"istio-injection":                    "enabled",
+			},
+			expectedAnnotations: map[string]string{"owner": "team-a", "scheduler.alpha.kubernetes.io/node-selector": "pool=apps"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.DiscoveryClient.WithAPIResource(test.Namespaces())
+			h.DiscoveryClient.WithAPIResource(test.Pods())
+			require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+			existing := builder.ForNamespace("ns-1").ObjectMeta(
+				builder.WithLabels("pod-security.kubernetes.io/enforce", "baseline", "team", "a"),
+				builder.WithAnnotations("owner", "team-a"),
+			).Result()
+			_, err := h.KubeClient.CoreV1().Namespaces().Create(context.TODO(), existing, metav1.CreateOptions{})
+			require.NoError(t, err)
+
+			backupNamespace := "ns-1"
+			if len(tc.namespaceMapping) > 0 {
+				backupNamespace = "ns-backup"
+			}
+			restore := defaultRestore().NamespaceMetadataPolicy(tc.policy).Result()
+			restore.Spec.NamespaceMapping = tc.namespaceMapping
+
+			data := &Request{
+				Log:     h.log,
+				Restore: restore,
+				Backup:  defaultBackup().Result(),
+				BackupReader: test.NewTarWriter(t).
+					AddItems("namespaces", builder.ForNamespace(backupNamespace).ObjectMeta(
+						builder.WithLabels("pod-security.kubernetes.io/enforce", "restricted", "istio-injection", "enabled"),
+						builder.WithAnnotations("scheduler.alpha.kubernetes.io/node-selector", "pool=apps"),
+					).Result()).
+					AddItems("pods", builder.ForPod(backupNamespace, "pod-1").Result(), builder.ForPod(backupNamespace, "pod-2").Result()).
+					Done(),
+			}
+			warnings, errs := h.restorer.Restore(data, nil, nil)
+			assertEmptyResults(t, warnings, errs)
+
+			ns, err := h.KubeClient.CoreV1().Namespaces().Get(context.TODO(), "ns-1", metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedLabels, ns.Labels)
+			assert.Equal(t, tc.expectedAnnotations, ns.Annotations)
+		})
+	}
+}
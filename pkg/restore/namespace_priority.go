@@ -0,0 +1,77 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"slices"
+
+	"github.com/gobwas/glob"
+	"github.com/pkg/errors"
+)
+
+// ValidateNamespacePriority returns an error if an entry of the namespace priority of a restore
+// isn't a valid glob pattern.
+func ValidateNamespacePriority(namespacePriority []string) error {
+	for _, pattern := range namespacePriority {
+		if _, err := glob.Compile(pattern); err != nil {
+			return errors.Wrapf(err, "invalid namespace priority %s", pattern)
+		}
+	}
+	return nil
+}
+
+// orderByNamespacePriority reorders the resources to restore, each of them holding the items of
+// a single namespace, so the namespaces matching the first entry of the namespace priority are
+// restored first, then those matching the second entry and so on, the namespaces matching no
+// entry being restored last. The cluster-scoped resources are restored first, and the order of
+// the resources of a same priority is kept.
+func orderByNamespacePriority(collection []restoreableResource, namespacePriority []string) []restoreableResource {
+	if len(namespacePriority) == 0 {
+		return collection
+	}
+
+	var matchers []glob.Glob
+	for _, pattern := range namespacePriority {
+		// the patterns were validated with the restore
+		if g, err := glob.Compile(pattern); err == nil {
+			matchers = append(matchers, g)
+		}
+	}
+
+	priority := func(resource restoreableResource) int {
+		for namespace := range resource.selectedItemsByNamespace {
+			// the cluster-scoped resources, such as the namespaces, the CRDs and the PVs, are
+			// restored before any namespace, as the namespaced items depend on them and the
+			// resource priorities restore them first without the namespace priority
+			if namespace == "" {
+				return -1
+			}
+			for i, g := range matchers {
+				if g.Match(namespace) {
+					return i
+				}
+			}
+		}
+		return len(matchers)
+	}
+
+	ordered := slices.Clone(collection)
+	slices.SortStableFunc(ordered, func(a, b restoreableResource) int {
+		return priority(a) - priority(b)
+	})
+	return ordered
+}
@@ -0,0 +1,94 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateNamespacePriority(t *testing.T) {
+	require.NoError(t, ValidateNamespacePriority(nil))
+	require.NoError(t, ValidateNamespacePriority([]string{"kube-*", "cert-manager"}))
+	require.ErrorContains(t, ValidateNamespacePriority([]string{"platform-[a"}), "invalid namespace priority platform-[a")
+}
+
+func TestOrderByNamespacePriority(t *testing.T) {
+	resource := func(resource, namespace string) restoreableResource {
+		return restoreableResource{
+			resource:                 resource,
+			selectedItemsByNamespace: map[string][]restoreableItem{namespace: {{name: "item"}}},
+		}
+	}
+	collection := []restoreableResource{
+		resource("namespaces", ""),
+		resource("configmaps", "app-1"),
+		resource("configmaps", "kube-public"),
+		resource("configmaps", "cert-manager"),
+		resource("deployments.apps", "app-1"),
+		resource("deployments.apps", "cert-manager"),
+		resource("deployments.apps", "kube-system"),
+		resource("clusterroles.rbac.authorization.k8s.io", ""),
+	}
+
+	tests := []struct {
+		name              string
+		namespacePriority []string
+		expected          []string
+	}{
+		{
+			name: "no namespace priority keeps the order",
+			expected: []string{
+				"namespaces/", "configmaps/app-1", "configmaps/kube-public", "configmaps/cert-manager",
+				"deployments.apps/app-1", "deployments.apps/cert-manager", "deployments.apps/kube-system", "clusterroles.rbac.authorization.k8s.io/",
+			},
+		},
+		{
+			name:              "namespaces are restored in the order of the priority",
+			namespacePriority: []string{"kube-*", "cert-manager"},
+			expected: []string{
+				"namespaces/", "clusterroles.rbac.authorization.k8s.io/",
+				"configmaps/kube-public", "deployments.apps/kube-system",
+				"configmaps/cert-manager", "deployments.apps/cert-manager",
+				"configmaps/app-1", "deployments.apps/app-1",
+			},
+		},
+		{
+			name:              "cluster-scoped resources are restored before the namespaces matching every entry",
+			namespacePriority: []string{"*"},
+			expected: []string{
+				"namespaces/", "clusterroles.rbac.authorization.k8s.io/",
+				"configmaps/app-1", "configmaps/kube-public", "configmaps/cert-manager",
+				"deployments.apps/app-1", "deployments.apps/cert-manager", "deployments.apps/kube-system",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual []string
+			for _, r := range orderByNamespacePriority(collection, tc.namespacePriority) {
+				for namespace := range r.selectedItemsByNamespace {
+					actual = append(actual, r.resource+"/"+namespace)
+				}
+			}
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
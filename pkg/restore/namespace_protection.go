@@ -0,0 +1,94 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"slices"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+// protectNamespace places the finalizer and the label protecting the namespace from deletion
+// while it's restored into, if the restore protects its namespaces. Each namespace is
+// protected once.
+func (ctx *restoreContext) protectNamespace(name string) error {
+	if !boolptr.IsSetToTrue(ctx.restore.Spec.ProtectNamespaces) || ctx.protectedNamespaces.Has(name) {
+		return nil
+	}
+
+	ns, err := ctx.namespaceClient.Get(go_context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "error getting namespace %s to protect it", name)
+	}
+	if ns.DeletionTimestamp != nil {
+		return errors.Errorf("namespace %s is being deleted and can't be protected", name)
+	}
+
+	updated := ns.DeepCopy()
+	if !slices.Contains(updated.Finalizers, velerov1api.RestoreProtectionFinalizer) {
+		updated.Finalizers = append(updated.Finalizers, velerov1api.RestoreProtectionFinalizer)
+	}
+	if updated.Labels == nil {
+		updated.Labels = map[string]string{}
+	}
+	updated.Labels[velerov1api.RestoreProtectedByLabel] = label.GetValidName(ctx.restore.Name)
+	if _, err := ctx.namespaceClient.Update(go_context.TODO(), updated, metav1.UpdateOptions{}); err != nil {
+		return errors.Wrapf(err, "error protecting namespace %s", name)
+	}
+
+	ctx.log.Infof("Protected namespace %s from deletion until the restore is done", name)
+	ctx.protectedNamespaces.Insert(name)
+	return nil
+}
+
+// RemoveNamespaceProtection removes the finalizer and the label the restore placed on the
+// namespaces it restored into. It's a no-op if the restore doesn't protect its namespaces.
+func RemoveNamespaceProtection(ctx go_context.Context, client crclient.Client, restore *velerov1api.Restore) error {
+	if !boolptr.IsSetToTrue(restore.Spec.ProtectNamespaces) {
+		return nil
+	}
+
+	namespaces := &corev1api.NamespaceList{}
+	if err := client.List(ctx, namespaces, crclient.MatchingLabels{
+		velerov1api.RestoreProtectedByLabel: label.GetValidName(restore.Name),
+	}); err != nil {
+		return errors.Wrap(err, "error listing the protected namespaces")
+	}
+
+	var errs []error
+	for i := range namespaces.Items {
+		ns := &namespaces.Items[i]
+		updated := ns.DeepCopy()
+		updated.Finalizers = slices.DeleteFunc(updated.Finalizers, func(f string) bool {
+			return f == velerov1api.RestoreProtectionFinalizer
+		})
+		delete(updated.Labels, velerov1api.RestoreProtectedByLabel)
+		if err := client.Patch(ctx, updated, crclient.MergeFrom(ns)); err != nil {
+			errs = append(errs, errors.Wrapf(err, "error removing the protection of namespace %s", ns.Name))
+		}
+	}
+	return kubeerrs.NewAggregate(errs)
+}
@@ -0,0 +1,105 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// TestRestoreProtectNamespaces verifies that the namespaces restored into are protected
+// from deletion when the restore protects its namespaces, and only then.
+func TestRestoreProtectNamespaces(t *testing.T) {
+	tests := []struct {
+		name    string
+		restore *velerov1api.Restore
+		protect bool
+	}{
+		{
+			name:    "namespaces aren't protected by default",
+			restore: defaultRestore().Result(),
+		},
+		{
+			name:    "namespaces are protected when the restore protects them",
+			restore: defaultRestore().ProtectNamespaces(true).Result(),
+			protect: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.DiscoveryClient.WithAPIResource(test.Pods())
+			require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+			data := &Request{
+				Log:     h.log,
+				Restore: tc.restore,
+				Backup:  defaultBackup().Result(),
+				BackupReader: test.NewTarWriter(t).
+					AddItems("pods", builder.ForPod("ns-1", "pod-1").Result(), builder.ForPod("ns-1", "pod-2").Result()).
+					Done(),
+			}
+			warnings, errs := h.restorer.Restore(data, nil, nil)
+			assertEmptyResults(t, warnings, errs)
+
+			ns, err := h.KubeClient.CoreV1().Namespaces().Get(context.TODO(), "ns-1", metav1.GetOptions{})
+			require.NoError(t, err)
+			if tc.protect {
+				assert.Equal(t, []string{velerov1api.RestoreProtectionFinalizer}, ns.Finalizers)
+				assert.Equal(t, tc.restore.Name, ns.Labels[velerov1api.RestoreProtectedByLabel])
+			} else {
+				assert.Empty(t, ns.Finalizers)
+				assert.NotContains(t, ns.Labels, velerov1api.RestoreProtectedByLabel)
+			}
+		})
+	}
+}
+
+func TestRemoveNamespaceProtection(t *testing.T) {
+	protected := builder.ForNamespace("ns-1").
+		ObjectMeta(builder.WithLabels(velerov1api.RestoreProtectedByLabel, "restore-1", "app", "foo"), builder.WithFinalizers("foo", velerov1api.RestoreProtectionFinalizer)).
+		Result()
+	protectedByOther := builder.ForNamespace("ns-2").
+		ObjectMeta(builder.WithLabels(velerov1api.RestoreProtectedByLabel, "restore-2"), builder.WithFinalizers(velerov1api.RestoreProtectionFinalizer)).
+		Result()
+	client := test.NewFakeControllerRuntimeClient(t, protected, protectedByOther)
+
+	// nothing is removed if the restore doesn't protect its namespaces
+	require.NoError(t, RemoveNamespaceProtection(context.TODO(), client, builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result()))
+	ns := &corev1api.Namespace{}
+	require.NoError(t, client.Get(context.TODO(), crclient.ObjectKey{Name: "ns-1"}, ns))
+	assert.Equal(t, []string{"foo", velerov1api.RestoreProtectionFinalizer}, ns.Finalizers)
+
+	require.NoError(t, RemoveNamespaceProtection(context.TODO(), client, builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").ProtectNamespaces(true).Result()))
+	require.NoError(t, client.Get(context.TODO(), crclient.ObjectKey{Name: "ns-1"}, ns))
+	assert.Equal(t, []string{"foo"}, ns.Finalizers)
+	assert.Equal(t, map[string]string{"app": "foo"}, ns.Labels)
+
+	require.NoError(t, client.Get(context.TODO(), crclient.ObjectKey{Name: "ns-2"}, ns))
+	assert.Equal(t, []string{velerov1api.RestoreProtectionFinalizer}, ns.Finalizers)
+}
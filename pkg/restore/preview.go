@@ -0,0 +1,161 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+// dryRun returns whether the restore writes nothing to the cluster, either as it's a server
+// dry-run or a preview.
+func (ctx *restoreContext) dryRun() bool {
+	return boolptr.IsSetToTrue(ctx.restore.Spec.ServerDryRun) || boolptr.IsSetToTrue(ctx.restore.Spec.Preview)
+}
+
+// addPreviewItem adds what the restore would do with the item to the preview report, if the
+// restore is a preview.
+func (ctx *restoreContext) addPreviewItem(resource, namespace, name string, action velerov1api.PreviewAction, reason string) {
+	if !boolptr.IsSetToTrue(ctx.restore.Spec.Preview) || ctx.previewReport == nil {
+		return
+	}
+	*ctx.previewReport = append(*ctx.previewReport, velerov1api.PreviewItem{
+		Resource:  resource,
+		Namespace: namespace,
+		Name:      name,
+		Action:    action,
+		Reason:    reason,
+	})
+}
+
+// previewNamespace checks whether the namespace the items are restored into exists, reporting
+// it as created if it doesn't. The namespace isn't created, so previewing again reports it again
+// under its target name rather than another one.
+func (ctx *restoreContext) previewNamespace(ns *corev1api.Namespace) error {
+	if _, ok := ctx.previewNamespaces[ns.Name]; ok {
+		return nil
+	}
+
+	_, err := ctx.namespaceClient.Get(go_context.Background(), ns.Name, metav1.GetOptions{})
+	switch {
+	case err == nil:
+		ctx.previewNamespaces[ns.Name] = true
+		ctx.addPreviewItem(kuberesource.Namespaces.String(), "", ns.Name, velerov1api.PreviewActionSkip, "already exists in the cluster")
+	case apierrors.IsNotFound(err):
+		ctx.previewNamespaces[ns.Name] = false
+		ctx.addPreviewItem(kuberesource.Namespaces.String(), "", ns.Name, velerov1api.PreviewActionCreate, "")
+	default:
+		return errors.Wrapf(err, "error getting namespace %s", ns.Name)
+	}
+	return nil
+}
+
+// previewItem reports what the restore would do with the item, as it's in the cluster: create it
+// if it doesn't exist, or else patch or skip it as the ExistingResourcePolicy decides.
+func (ctx *restoreContext) previewItem(obj *unstructured.Unstructured, groupResource schema.GroupResource, resourceClient client.Dynamic, namespace string) error {
+	resource := groupResource.String()
+
+	// the items of the namespaces the restore would create don't exist
+	if namespace != "" && !ctx.previewNamespaces[namespace] {
+		ctx.addPreviewItem(resource, namespace, obj.GetName(), velerov1api.PreviewActionCreate, "")
+		return nil
+	}
+
+	fromCluster, err := resourceClient.Get(obj.GetName(), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		ctx.addPreviewItem(resource, namespace, obj.GetName(), velerov1api.PreviewActionCreate, "")
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "error getting %s %s", resource, obj.GetName())
+	}
+
+	action, reason, err := previewExistingItem(fromCluster, obj, groupResource, ctx.restore.Spec.ExistingResourcePolicy, ctx.immutableFieldPolicy(groupResource))
+	if err != nil {
+		return errors.Wrapf(err, "error comparing %s %s with the backed up version", resource, obj.GetName())
+	}
+	if action == velerov1api.PreviewActionRecreate && groupResource == kuberesource.PersistentVolumeClaims && !ctx.pvcDataRestored(obj) {
+		action, reason = velerov1api.PreviewActionSkip, "would fail as the immutable fields differ from the backed up version and the restore doesn't restore the data of the PersistentVolumeClaim to recreate"
+	}
+	ctx.addPreviewItem(resource, namespace, obj.GetName(), action, reason)
+	return nil
+}
+
+// previewExistingItem returns what the restore would do with the item existing in the cluster,
+// and why, the way restoreItem decides it.
+func previewExistingItem(fromCluster, obj *unstructured.Unstructured, groupResource schema.GroupResource, policy velerov1api.PolicyType, immutableFieldPolicy velerov1api.ImmutableFieldPolicy) (velerov1api.PreviewAction, string, error) {
+	fromCluster, err := resetMetadataAndStatus(fromCluster.DeepCopy())
+	if err != nil {
+		return "", "", err
+	}
+	labels := obj.GetLabels()
+	addRestoreLabels(fromCluster, labels[velerov1api.RestoreNameLabel], labels[velerov1api.BackupNameLabel])
+
+	if equality.Semantic.DeepEqual(fromCluster, obj) {
+		return velerov1api.PreviewActionSkip, "already exists in the cluster and is the same as the backed up version", nil
+	}
+
+	if groupResource == kuberesource.ServiceAccounts {
+		desired, err := mergeServiceAccounts(fromCluster, obj)
+		if err != nil {
+			return "", "", err
+		}
+		patchBytes, err := generatePatch(fromCluster, desired)
+		if err != nil {
+			return "", "", err
+		}
+		if patchBytes == nil {
+			return velerov1api.PreviewActionSkip, "already exists in the cluster and is the same as the backed up version", nil
+		}
+		return velerov1api.PreviewActionPatch, "the backed up version is merged into the existing ServiceAccount", nil
+	}
+
+	switch policy {
+	case velerov1api.PolicyTypeUpdate:
+		if conflicts := immutableFieldConflicts(groupResource, fromCluster, obj); len(conflicts) > 0 {
+			switch immutableFieldPolicy {
+			case velerov1api.ImmutableFieldPolicyRecreate:
+				return velerov1api.PreviewActionRecreate, fmt.Sprintf("the immutable fields %s differ from the backed up version, immutableFieldPolicy is Recreate", strings.Join(conflicts, ", ")), nil
+			case velerov1api.ImmutableFieldPolicyFail:
+				return velerov1api.PreviewActionSkip, fmt.Sprintf("would fail as the immutable fields %s differ from the backed up version, immutableFieldPolicy is Fail", strings.Join(conflicts, ", ")), nil
+			}
+		}
+		return velerov1api.PreviewActionPatch, "already exists in the cluster and is different than the backed up version, existingResourcePolicy is update", nil
+	case velerov1api.PolicyTypeReconcile:
+		return velerov1api.PreviewActionPatch, "already exists in the cluster and drifted from the backed up version, existingResourcePolicy is reconcile", nil
+	case velerov1api.PolicyTypeSSA:
+		return velerov1api.PreviewActionPatch, "already exists in the cluster and is different than the backed up version, existingResourcePolicy is ssa", nil
+	case velerov1api.PolicyTypeNone:
+		return velerov1api.PreviewActionSkip, "already exists in the cluster and is different than the backed up version, existingResourcePolicy is none", nil
+	default:
+		return velerov1api.PreviewActionSkip, "already exists in the cluster and is different than the backed up version, no existingResourcePolicy", nil
+	}
+}
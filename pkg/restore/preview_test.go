@@ -0,0 +1,233 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func previewTestConfigMap(data map[string]any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]any{"name": "cm-1", "namespace": "ns-1"},
+		"data":       data,
+	}}
+	addRestoreLabels(obj, "restore-1", "backup-1")
+	return obj
+}
+
+func TestPreviewItem(t *testing.T) {
+	backedUp := previewTestConfigMap(map[string]any{"key": "value"})
+	same := previewTestConfigMap(map[string]any{"key": "value"})
+	same.SetUID("uid")
+	same.SetResourceVersion("1")
+	changed := previewTestConfigMap(map[string]any{"key": "other"})
+	immutable := previewTestConfigMap(map[string]any{"key": "other"})
+	immutable.Object["immutable"] = true
+
+	tests := []struct {
+		name           string
+		policy         velerov1api.PolicyType
+		immutable      velerov1api.ImmutableFieldPolicy
+		namespaces     map[string]bool
+		fromCluster    *unstructured.Unstructured
+		getErr         error
+		expectedAction velerov1api.PreviewAction
+		expectedReason string
+		expectedErr    bool
+	}{
+		{
+			name:           "item in a namespace the restore creates is created",
+			namespaces:     map[string]bool{"ns-1": false},
+			expectedAction: velerov1api.PreviewActionCreate,
+		},
+		{
+			name:           "missing item is created",
+			namespaces:     map[string]bool{"ns-1": true},
+			getErr:         apierrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, "cm-1"),
+			expectedAction: velerov1api.PreviewActionCreate,
+		},
+		{
+			name:           "item the same as the backed up version is skipped",
+			namespaces:     map[string]bool{"ns-1": true},
+			fromCluster:    same,
+			expectedAction: velerov1api.PreviewActionSkip,
+			expectedReason: "already exists in the cluster and is the same as the backed up version",
+		},
+		{
+			name:           "changed item is skipped without existingResourcePolicy",
+			namespaces:     map[string]bool{"ns-1": true},
+			fromCluster:    changed,
+			expectedAction: velerov1api.PreviewActionSkip,
+			expectedReason: "already exists in the cluster and is different than the backed up version, no existingResourcePolicy",
+		},
+		{
+			name:           "changed item is patched with the update existingResourcePolicy",
+			policy:         velerov1api.PolicyTypeUpdate,
+			namespaces:     map[string]bool{"ns-1": true},
+			fromCluster:    changed,
+			expectedAction: velerov1api.PreviewActionPatch,
+			expectedReason: "already exists in the cluster and is different than the backed up version, existingResourcePolicy is update",
+		},
+		{
+			name:           "item whose immutable fields changed is recreated with the Recreate immutableFieldPolicy",
+			policy:         velerov1api.PolicyTypeUpdate,
+			immutable:      velerov1api.ImmutableFieldPolicyRecreate,
+			namespaces:     map[string]bool{"ns-1": true},
+			fromCluster:    immutable,
+			expectedAction: velerov1api.PreviewActionRecreate,
+			expectedReason: "the immutable fields data differ from the backed up version, immutableFieldPolicy is Recreate",
+		},
+		{
+			name:           "item whose immutable fields changed is skipped with the Fail immutableFieldPolicy",
+			policy:         velerov1api.PolicyTypeUpdate,
+			immutable:      velerov1api.ImmutableFieldPolicyFail,
+			namespaces:     map[string]bool{"ns-1": true},
+			fromCluster:    immutable,
+			expectedAction: velerov1api.PreviewActionSkip,
+			expectedReason: "would fail as the immutable fields data differ from the backed up version, immutableFieldPolicy is Fail",
+		},
+		{
+			name:        "error getting the item",
+			namespaces:  map[string]bool{"ns-1": true},
+			getErr:      apierrors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, "cm-1", assert.AnError),
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resourceClient := &test.FakeDynamicClient{}
+			if tc.fromCluster != nil || tc.getErr != nil {
+				fromCluster := tc.fromCluster
+				if fromCluster == nil {
+					fromCluster = &unstructured.Unstructured{}
+				}
+				resourceClient.On("Get", "cm-1", mock.Anything).Return(fromCluster, tc.getErr)
+			}
+
+			restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Preview(true).ExistingResourcePolicy(string(tc.policy))
+			if tc.immutable != "" {
+				restore.ImmutableFieldPolicy("configmaps", tc.immutable)
+			}
+			report := []velerov1api.PreviewItem{}
+			ctx := &restoreContext{
+				restore:           restore.Result(),
+				previewNamespaces: tc.namespaces,
+				previewReport:     &report,
+			}
+			err := ctx.previewItem(backedUp, schema.GroupResource{Resource: "configmaps"}, resourceClient, "ns-1")
+			resourceClient.AssertExpectations(t)
+			if tc.expectedErr {
+				require.Error(t, err)
+				assert.Empty(t, report)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []velerov1api.PreviewItem{{
+				Resource:  "configmaps",
+				Namespace: "ns-1",
+				Name:      "cm-1",
+				Action:    tc.expectedAction,
+				Reason:    tc.expectedReason,
+			}}, report)
+		})
+	}
+}
+
+func TestPreviewNamespace(t *testing.T) {
+	kubeClient := kubefake.NewSimpleClientset(builder.ForNamespace("ns-1").Result())
+	report := []velerov1api.PreviewItem{}
+	ctx := &restoreContext{
+		restore:           builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Preview(true).Result(),
+		namespaceClient:   kubeClient.CoreV1().Namespaces(),
+		previewNamespaces: make(map[string]bool),
+		previewReport:     &report,
+	}
+
+	require.NoError(t, ctx.previewNamespace(builder.ForNamespace("ns-1").Result()))
+	require.NoError(t, ctx.previewNamespace(builder.ForNamespace("ns-2").Result()))
+	require.NoError(t, ctx.previewNamespace(builder.ForNamespace("ns-2").Result()))
+	assert.Equal(t, map[string]bool{"ns-1": true, "ns-2": false}, ctx.previewNamespaces)
+	assert.Equal(t, []velerov1api.PreviewItem{
+		{Resource: "namespaces", Name: "ns-1", Action: velerov1api.PreviewActionSkip, Reason: "already exists in the cluster"},
+		{Resource: "namespaces", Name: "ns-2", Action: velerov1api.PreviewActionCreate},
+	}, report)
+
+	// nothing is created
+	for _, action := range kubeClient.Actions() {
+		assert.NotEqual(t, "create", action.GetVerb())
+	}
+}
+
+// TestRestorePreviewRepeated verifies that previewing a restore again creates no namespace and
+// reports the namespaces it would create under the same names.
+func TestRestorePreviewRepeated(t *testing.T) {
+	h := newHarness(t)
+	h.DiscoveryClient.WithAPIResource(test.Namespaces())
+	h.DiscoveryClient.WithAPIResource(test.Pods())
+	require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+	expected := []velerov1api.PreviewItem{
+		{Resource: "namespaces", Name: "ns-2", Action: velerov1api.PreviewActionCreate},
+		{Resource: "pods", Namespace: "ns-2", Name: "pod-1", Action: velerov1api.PreviewActionCreate},
+		{Resource: "pods", Namespace: "ns-2", Name: "pod-2", Action: velerov1api.PreviewActionCreate},
+	}
+	for range 2 {
+		data := &Request{
+			Log:     h.log,
+			Restore: defaultRestore().Preview(true).NamespaceMappings("ns-1", "ns-2").Result(),
+			Backup:  defaultBackup().Result(),
+			BackupReader: test.NewTarWriter(t).
+				AddItems("namespaces", builder.ForNamespace("ns-1").Result()).
+				AddItems("pods", builder.ForPod("ns-1", "pod-1").Result(), builder.ForPod("ns-1", "pod-2").Result()).
+				Done(),
+		}
+		warnings, errs := h.restorer.Restore(data, nil, nil)
+		assertEmptyResults(t, warnings, errs)
+		assert.Equal(t, expected, *data.GetPreviewReport())
+
+		namespaces, err := h.KubeClient.CoreV1().Namespaces().List(context.TODO(), metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, namespaces.Items)
+	}
+}
+
+func TestAddPreviewItemOnlyInPreview(t *testing.T) {
+	report := []velerov1api.PreviewItem{}
+	ctx := &restoreContext{
+		restore:       builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result(),
+		previewReport: &report,
+	}
+	ctx.addPreviewItem("configmaps", "ns-1", "cm-1", velerov1api.PreviewActionSkip, "the resource is excluded")
+	assert.Empty(t, report)
+}
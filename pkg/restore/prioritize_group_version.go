@@ -39,6 +39,8 @@ type ChosenGroupVersion struct {
 	Group   string
 	Version string
 	Dir     string
+	// SourcePreferredVersion is the version the source cluster preferred.
+	SourcePreferredVersion string
 }
 
 // chooseAPIVersionsToRestore will choose a version to restore based on a user-
@@ -54,9 +56,10 @@ OUTER:
 		// Default to the source preferred version if no other common version
 		// can be found.
 		cgv := ChosenGroupVersion{
-			Group:   sg.Name,
-			Version: sg.PreferredVersion.Version,
-			Dir:     sg.PreferredVersion.Version + velerov1api.PreferredVersionDir,
+			Group:                  sg.Name,
+			Version:                sg.PreferredVersion.Version,
+			Dir:                    sg.PreferredVersion.Version + velerov1api.PreferredVersionDir,
+			SourcePreferredVersion: sg.PreferredVersion.Version,
 		}
 
 		tg := findAPIGroup(targetGVs, sg.Name)
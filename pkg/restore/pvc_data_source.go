@@ -0,0 +1,219 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/podvolume/configs"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+// selectedItemKey returns the key of an item selected for the restore, by its resource and its
+// namespace and name in the backup.
+func selectedItemKey(resource, namespace, name string) string {
+	return resource + "/" + namespace + "/" + name
+}
+
+// selectedItemKeys returns the keys of the items of the collection.
+func selectedItemKeys(collection []restoreableResource) sets.Set[string] {
+	keys := sets.New[string]()
+	for _, resource := range collection {
+		for namespace, items := range resource.selectedItemsByNamespace {
+			for _, item := range items {
+				keys.Insert(selectedItemKey(resource.resource, namespace, item.name))
+			}
+		}
+	}
+	return keys
+}
+
+// pvcDataSourcePolicy returns the data source policy of the restored PVC of the storage class.
+func (ctx *restoreContext) pvcDataSourcePolicy(storageClass string) velerov1api.PVCDataSourcePolicy {
+	if policy, found := ctx.restore.Spec.PVCDataSourcePolicyByStorageClass[storageClass]; found && storageClass != "" {
+		return policy
+	}
+	if ctx.restore.Spec.PVCDataSourcePolicy != "" {
+		return ctx.restore.Spec.PVCDataSourcePolicy
+	}
+	return velerov1api.PVCDataSourcePolicyAuto
+}
+
+// resetPVCDataSource applies the data source policy to the dataSource and the dataSourceRef of the
+// PVC, which the volume was created from in the backed up cluster. They're kept when a restore item
+// action replaced them, like the CSI one does with the VolumeSnapshot the volume is restored from.
+// It returns a warning when a data source is removed as it neither exists nor is restored.
+func (ctx *restoreContext) resetPVCDataSource(obj, itemFromBackup *unstructured.Unstructured, log logrus.FieldLogger) (string, error) {
+	pvc := new(corev1api.PersistentVolumeClaim)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pvc); err != nil {
+		return "", errors.WithStack(err)
+	}
+	pvcFromBackup := new(corev1api.PersistentVolumeClaim)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(itemFromBackup.UnstructuredContent(), pvcFromBackup); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	if pvcFromBackup.Spec.DataSource == nil && pvcFromBackup.Spec.DataSourceRef == nil {
+		return "", nil
+	}
+	if !equality.Semantic.DeepEqual(pvc.Spec.DataSource, pvcFromBackup.Spec.DataSource) ||
+		!equality.Semantic.DeepEqual(pvc.Spec.DataSourceRef, pvcFromBackup.Spec.DataSourceRef) {
+		log.Debug("Keeping the data source of the PVC set by a restore item action")
+		return "", nil
+	}
+
+	source := pvcDataSourceRef(pvcFromBackup)
+	var storageClass string
+	if pvc.Spec.StorageClassName != nil {
+		storageClass = *pvc.Spec.StorageClassName
+	}
+	policy := ctx.pvcDataSourcePolicy(storageClass)
+	log = log.WithFields(logrus.Fields{
+		"dataSource": source.Kind + "/" + source.Name,
+		"policy":     policy,
+	})
+
+	switch policy {
+	case velerov1api.PVCDataSourcePolicyPreserve:
+		log.Info("Keeping the data source of the PVC")
+		return "", nil
+	case velerov1api.PVCDataSourcePolicyStrip:
+		log.Info("Removing the data source of the PVC")
+		removePVCDataSource(obj)
+		return "", nil
+	}
+
+	if ctx.restoresPVCData(pvc, pvcFromBackup) {
+		log.Info("Removing the data source of the PVC, whose data Velero restores")
+		removePVCDataSource(obj)
+		return "", nil
+	}
+
+	exists, restored, err := ctx.pvcDataSourceExists(pvcFromBackup.Namespace, source)
+	if err != nil {
+		log.WithError(err).Warn("Error checking the data source of the PVC exists, keeping it")
+		return "", nil
+	}
+	if exists {
+		log.Info("Keeping the data source of the PVC, which exists")
+		return "", nil
+	}
+	if restored && isPVCDataSource(source) {
+		// the clone would be provisioned from the restored PVC before its data is restored, if ever
+		log.Info("Removing the data source of the PVC, which is a PVC restored by the same restore")
+		removePVCDataSource(obj)
+		return "the data source " + source.Kind + "/" + source.Name + " of the PVC is restored by the same restore, the volume is provisioned without its data", nil
+	}
+	if restored {
+		log.Info("Keeping the data source of the PVC, which is restored")
+		return "", nil
+	}
+
+	log.Info("Removing the data source of the PVC, which neither exists nor is restored")
+	removePVCDataSource(obj)
+	return "the data source " + source.Kind + "/" + source.Name + " of the PVC neither exists nor is restored, the volume is provisioned without its data", nil
+}
+
+// restoresPVCData returns whether Velero restores the data of the volume of the PVC, the PVC
+// being bound to a restored PV or its data being restored by a pod volume restore, from a CSI
+// VolumeSnapshot or by a data mover.
+func (ctx *restoreContext) restoresPVCData(pvc, pvcFromBackup *corev1api.PersistentVolumeClaim) bool {
+	if pvc.Spec.VolumeName != "" {
+		return true
+	}
+	if !boolptr.IsSetToFalse(ctx.restore.Spec.RestorePVs) {
+		for _, key := range []string{velerov1api.VolumeSnapshotLabel, velerov1api.DataUploadNameAnnotation} {
+			if _, found := pvcFromBackup.Annotations[key]; found {
+				return true
+			}
+		}
+	}
+	for _, pvb := range ctx.podVolumeBackups {
+		if pvb.Spec.Pod.Namespace == pvcFromBackup.Namespace && pvb.GetAnnotations()[configs.PVCNameAnnotation] == pvcFromBackup.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// pvcDataSourceExists returns whether the data source, in the namespace of the PVC in the backup
+// unless it names its own, exists in the cluster, else whether it's restored.
+func (ctx *restoreContext) pvcDataSourceExists(namespace string, source corev1api.TypedObjectReference) (exists bool, restored bool, err error) {
+	if source.Namespace != nil && *source.Namespace != "" {
+		namespace = *source.Namespace
+	}
+	var group string
+	if source.APIGroup != nil {
+		group = *source.APIGroup
+	}
+
+	gvr, apiResource, err := ctx.discoveryHelper.KindFor(schema.GroupVersionKind{Group: group, Kind: source.Kind})
+	if err != nil {
+		// the CRDs of the backup are restored first, so the source can't be restored either
+		return false, false, nil
+	}
+	restored = ctx.selectedItems.Has(selectedItemKey(gvr.GroupResource().String(), namespace, source.Name))
+
+	targetNamespace := namespace
+	if target, found := ctx.restore.Spec.NamespaceMapping[namespace]; found {
+		targetNamespace = target
+	}
+	resourceClient, err := ctx.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), apiResource, targetNamespace)
+	if err != nil {
+		return false, restored, errors.Wrapf(err, "error getting client for %s", gvr.GroupResource())
+	}
+	if _, err := resourceClient.Get(source.Name, metav1.GetOptions{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, restored, nil
+		}
+		return false, restored, errors.Wrapf(err, "error getting %s %s/%s", gvr.GroupResource(), targetNamespace, source.Name)
+	}
+	return true, restored, nil
+}
+
+// isPVCDataSource returns whether the data source is a PVC, the PVC being a clone.
+func isPVCDataSource(source corev1api.TypedObjectReference) bool {
+	return source.Kind == "PersistentVolumeClaim" && (source.APIGroup == nil || *source.APIGroup == "")
+}
+
+// pvcDataSourceRef returns the data source of the PVC, from the dataSourceRef when set as it may
+// name another namespace.
+func pvcDataSourceRef(pvc *corev1api.PersistentVolumeClaim) corev1api.TypedObjectReference {
+	if pvc.Spec.DataSourceRef != nil {
+		return *pvc.Spec.DataSourceRef
+	}
+	return corev1api.TypedObjectReference{
+		APIGroup: pvc.Spec.DataSource.APIGroup,
+		Kind:     pvc.Spec.DataSource.Kind,
+		Name:     pvc.Spec.DataSource.Name,
+	}
+}
+
+func removePVCDataSource(obj *unstructured.Unstructured) {
+	unstructured.RemoveNestedField(obj.Object, "spec", "dataSource")
+	unstructured.RemoveNestedField(obj.Object, "spec", "dataSourceRef")
+}
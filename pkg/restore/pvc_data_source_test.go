@@ -0,0 +1,164 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestResetPVCDataSource(t *testing.T) {
+	clone := &corev1api.TypedLocalObjectReference{Kind: "PersistentVolumeClaim", Name: "source"}
+	snapshotGroup := "snapshot.storage.k8s.io"
+
+	tests := []struct {
+		name            string
+		restore         *velerov1api.Restore
+		pvc             *corev1api.PersistentVolumeClaim
+		actionSource    *corev1api.TypedLocalObjectReference
+		existing        []*corev1api.PersistentVolumeClaim
+		selectedItems   []string
+		expectedSource  bool
+		expectedWarning bool
+	}{
+		{
+			name:           "PVC without data source is left as it is",
+			restore:        builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result(),
+			pvc:            builder.ForPersistentVolumeClaim("ns-1", "pvc-1").Result(),
+			expectedSource: false,
+		},
+		{
+			name:           "data source set by a restore item action is kept",
+			restore:        builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").PVCDataSourcePolicy(velerov1api.PVCDataSourcePolicyStrip).Result(),
+			pvc:            builder.ForPersistentVolumeClaim("ns-1", "pvc-1").DataSource(clone).Result(),
+			actionSource:   &corev1api.TypedLocalObjectReference{APIGroup: &snapshotGroup, Kind: "VolumeSnapshot", Name: "velero-pvc-1"},
+			expectedSource: true,
+		},
+		{
+			name:           "Preserve keeps the data source",
+			restore:        builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").PVCDataSourcePolicy(velerov1api.PVCDataSourcePolicyPreserve).Result(),
+			pvc:            builder.ForPersistentVolumeClaim("ns-1", "pvc-1").DataSource(clone).Result(),
+			expectedSource: true,
+		},
+		{
+			name:           "Strip removes the data source",
+			restore:        builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").PVCDataSourcePolicy(velerov1api.PVCDataSourcePolicyStrip).Result(),
+			pvc:            builder.ForPersistentVolumeClaim("ns-1", "pvc-1").DataSource(clone).Result(),
+			existing:       []*corev1api.PersistentVolumeClaim{builder.ForPersistentVolumeClaim("ns-1", "source").Result()},
+			expectedSource: false,
+		},
+		{
+			name: "policy of the storage class overrides the one of the restore",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").PVCDataSourcePolicy(velerov1api.PVCDataSourcePolicyStrip).
+				PVCDataSourcePolicyByStorageClass("sc-1", velerov1api.PVCDataSourcePolicyPreserve).Result(),
+			pvc:            builder.ForPersistentVolumeClaim("ns-1", "pvc-1").StorageClass("sc-1").DataSource(clone).Result(),
+			expectedSource: true,
+		},
+		{
+			name:           "Auto removes the data source of a PVC whose data is restored",
+			restore:        builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result(),
+			pvc:            builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").DataSource(clone).Result(),
+			existing:       []*corev1api.PersistentVolumeClaim{builder.ForPersistentVolumeClaim("ns-1", "source").Result()},
+			expectedSource: false,
+		},
+		{
+			name:           "Auto keeps the data source existing in the cluster",
+			restore:        builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result(),
+			pvc:            builder.ForPersistentVolumeClaim("ns-1", "pvc-1").DataSource(clone).Result(),
+			existing:       []*corev1api.PersistentVolumeClaim{builder.ForPersistentVolumeClaim("ns-1", "source").Result()},
+			expectedSource: true,
+		},
+		{
+			name:           "Auto looks for the data source in the mapped namespace",
+			restore:        builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").NamespaceMappings("ns-1", "ns-2").Result(),
+			pvc:            builder.ForPersistentVolumeClaim("ns-1", "pvc-1").DataSource(clone).Result(),
+			existing:       []*corev1api.PersistentVolumeClaim{builder.ForPersistentVolumeClaim("ns-2", "source").Result()},
+			expectedSource: true,
+		},
+		{
+			name:            "Auto removes the data source of a clone whose source PVC is restored with a warning",
+			restore:         builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result(),
+			pvc:             builder.ForPersistentVolumeClaim("ns-1", "pvc-1").DataSource(clone).Result(),
+			selectedItems:   []string{selectedItemKey("persistentvolumeclaims", "ns-1", "source")},
+			expectedSource:  false,
+			expectedWarning: true,
+		},
+		{
+			name:           "Auto keeps the data source of a clone whose source PVC exists and is restored",
+			restore:        builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result(),
+			pvc:            builder.ForPersistentVolumeClaim("ns-1", "pvc-1").DataSource(clone).Result(),
+			existing:       []*corev1api.PersistentVolumeClaim{builder.ForPersistentVolumeClaim("ns-1", "source").Result()},
+			selectedItems:  []string{selectedItemKey("persistentvolumeclaims", "ns-1", "source")},
+			expectedSource: true,
+		},
+		{
+			name:            "Auto removes the missing data source with a warning",
+			restore:         builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result(),
+			pvc:             builder.ForPersistentVolumeClaim("ns-1", "pvc-1").DataSource(clone).Result(),
+			expectedSource:  false,
+			expectedWarning: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			existing := make([]metav1.Object, 0, len(tc.existing))
+			for _, pvc := range tc.existing {
+				existing = append(existing, pvc)
+			}
+			h.AddItems(t, test.PVCs(existing...))
+
+			content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.pvc)
+			require.NoError(t, err)
+			itemFromBackup := &unstructured.Unstructured{Object: content}
+			obj := itemFromBackup.DeepCopy()
+			if tc.actionSource != nil {
+				require.NoError(t, unstructured.SetNestedMap(obj.Object, map[string]any{
+					"apiGroup": *tc.actionSource.APIGroup,
+					"kind":     tc.actionSource.Kind,
+					"name":     tc.actionSource.Name,
+				}, "spec", "dataSource"))
+			}
+
+			ctx := &restoreContext{
+				restore:         tc.restore,
+				discoveryHelper: h.restorer.discoveryHelper,
+				dynamicFactory:  h.restorer.dynamicFactory,
+				selectedItems:   sets.New(tc.selectedItems...),
+			}
+			warning, err := ctx.resetPVCDataSource(obj, itemFromBackup, h.log)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedWarning, warning != "")
+
+			_, found, err := unstructured.NestedMap(obj.Object, "spec", "dataSource")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedSource, found)
+		})
+	}
+}
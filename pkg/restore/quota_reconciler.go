@@ -0,0 +1,378 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/types"
+)
+
+// quotaResources are the quota resources accounted for the restored workloads.
+var quotaResources = []corev1api.ResourceName{
+	corev1api.ResourcePods,
+	corev1api.ResourceCPU,
+	corev1api.ResourceMemory,
+	corev1api.ResourceRequestsCPU,
+	corev1api.ResourceRequestsMemory,
+	corev1api.ResourceLimitsCPU,
+	corev1api.ResourceLimitsMemory,
+}
+
+// quotaPriorities returns priorities with the ResourceQuotas and LimitRanges restored right
+// after the namespaces, so they are enforced on the workloads restored afterwards.
+func quotaPriorities(priorities types.Priorities) types.Priorities {
+	quotaResources := []string{kuberesource.ResourceQuotas.String(), kuberesource.LimitRanges.String()}
+
+	var highPriorities []string
+	inserted := false
+	for _, resource := range priorities.HighPriorities {
+		if slices.Contains(quotaResources, resource) {
+			continue
+		}
+		highPriorities = append(highPriorities, resource)
+		if resource == kuberesource.Namespaces.String() {
+			highPriorities = append(highPriorities, quotaResources...)
+			inserted = true
+		}
+	}
+	if !inserted {
+		highPriorities = append(quotaResources, highPriorities...)
+	}
+
+	var lowPriorities []string
+	for _, resource := range priorities.LowPriorities {
+		if !slices.Contains(quotaResources, resource) {
+			lowPriorities = append(lowPriorities, resource)
+		}
+	}
+
+	return types.Priorities{HighPriorities: highPriorities, LowPriorities: lowPriorities}
+}
+
+// namespaceQuotas are the ResourceQuotas and LimitRanges of a namespace, with the usage of
+// the ResourceQuotas updated as the workloads of the namespace are restored.
+type namespaceQuotas struct {
+	quotas      []*corev1api.ResourceQuota
+	limitRanges []*corev1api.LimitRange
+}
+
+// quotaReconciler reconciles the restored workloads with the ResourceQuotas of their namespace.
+type quotaReconciler struct {
+	mode       velerov1api.QuotaReconciliationMode
+	list       func(resource, namespace string) (*unstructured.UnstructuredList, error)
+	namespaces map[string]*namespaceQuotas
+}
+
+func newQuotaReconciler(mode velerov1api.QuotaReconciliationMode, dynamicFactory client.DynamicFactory) *quotaReconciler {
+	return &quotaReconciler{
+		mode: mode,
+		list: func(resource, namespace string) (*unstructured.UnstructuredList, error) {
+			c, err := dynamicFactory.ClientForGroupVersionResource(corev1api.SchemeGroupVersion, metav1.APIResource{Name: resource, Namespaced: true}, namespace)
+			if err != nil {
+				return nil, err
+			}
+			return c.List(metav1.ListOptions{})
+		},
+		namespaces: make(map[string]*namespaceQuotas),
+	}
+}
+
+// reconcile accounts for the usage of obj, a workload about to be restored to namespace,
+// in the ResourceQuotas of the namespace. If obj would exceed a quota, it's scaled down to
+// fit, in Scale mode, and the returned message describes why it's quota-capped.
+func (r *quotaReconciler) reconcile(obj *unstructured.Unstructured, groupResource schema.GroupResource, namespace string, log logrus.FieldLogger) (string, error) {
+	replicasPath, podSpecPath, ok := workloadPaths(obj, groupResource)
+	if !ok {
+		return "", nil
+	}
+
+	quotas, err := r.namespaceQuotas(namespace)
+	if err != nil {
+		return "", err
+	}
+	if len(quotas.quotas) == 0 {
+		return "", nil
+	}
+
+	replicas := int64(1)
+	if replicasPath != nil {
+		if val, found, err := unstructured.NestedInt64(obj.Object, replicasPath...); err != nil {
+			return "", errors.Wrapf(err, "error getting the replicas of %s", groupResource)
+		} else if found {
+			replicas = val
+		}
+	}
+	if replicas <= 0 {
+		return "", nil
+	}
+
+	podSpecMap, found, err := unstructured.NestedMap(obj.Object, podSpecPath...)
+	if err != nil || !found {
+		return "", errors.Errorf("error getting the pod spec of %s", groupResource)
+	}
+	podSpec := new(corev1api.PodSpec)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(podSpecMap, podSpec); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	usage := podUsage(podSpec, quotas.limitRanges)
+
+	fitting, exceeded := replicas, ""
+	for _, quota := range quotas.quotas {
+		if fit := fittingReplicas(quota, usage); fit < fitting {
+			fitting, exceeded = fit, quota.Name
+		}
+	}
+
+	message := ""
+	if fitting < replicas {
+		switch {
+		case r.mode == velerov1api.QuotaReconciliationScale && replicasPath != nil:
+			if err := unstructured.SetNestedField(obj.Object, fitting, replicasPath...); err != nil {
+				return "", errors.WithStack(err)
+			}
+			message = fmt.Sprintf("%s %s/%s scaled from %d to %d replicas to fit ResourceQuota %s", groupResource, namespace, obj.GetName(), replicas, fitting, exceeded)
+			replicas = fitting
+		default:
+			message = fmt.Sprintf("%s %s/%s with %d replicas exceeds ResourceQuota %s, only %d replicas fit", groupResource, namespace, obj.GetName(), replicas, exceeded, fitting)
+		}
+		log.Warn(message)
+	}
+
+	for _, quota := range quotas.quotas {
+		addUsage(quota, usage, replicas)
+	}
+
+	return message, nil
+}
+
+// workloadPaths returns the paths of the replicas, nil for a pod, and of the pod spec of obj,
+// and false if obj isn't a workload accounted for. The pods and ReplicaSets owned by another
+// workload aren't, their owner is.
+func workloadPaths(obj *unstructured.Unstructured, groupResource schema.GroupResource) ([]string, []string, bool) {
+	switch groupResource {
+	case kuberesource.Pods:
+		if len(obj.GetOwnerReferences()) > 0 {
+			return nil, nil, false
+		}
+		return nil, []string{"spec"}, true
+	case kuberesource.ReplicaSets:
+		if len(obj.GetOwnerReferences()) > 0 {
+			return nil, nil, false
+		}
+		return []string{"spec", "replicas"}, []string{"spec", "template", "spec"}, true
+	case kuberesource.Deployments, kuberesource.StatefulSets:
+		return []string{"spec", "replicas"}, []string{"spec", "template", "spec"}, true
+	default:
+		return nil, nil, false
+	}
+}
+
+func (r *quotaReconciler) namespaceQuotas(namespace string) (*namespaceQuotas, error) {
+	if quotas, ok := r.namespaces[namespace]; ok {
+		return quotas, nil
+	}
+
+	quotas := new(namespaceQuotas)
+
+	list, err := r.list(kuberesource.ResourceQuotas.Resource, namespace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing the ResourceQuotas of namespace %s", namespace)
+	}
+	for i := range list.Items {
+		quota := new(corev1api.ResourceQuota)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.Items[i].Object, quota); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		// the scopes of a quota select the pods by properties not known before they're
+		// created, such as their QoS class, skip the scoped quotas rather than guess
+		if len(quota.Spec.Scopes) > 0 || quota.Spec.ScopeSelector != nil {
+			continue
+		}
+		if quota.Status.Used == nil {
+			quota.Status.Used = corev1api.ResourceList{}
+		}
+		quotas.quotas = append(quotas.quotas, quota)
+	}
+	sort.Slice(quotas.quotas, func(i, j int) bool { return quotas.quotas[i].Name < quotas.quotas[j].Name })
+
+	list, err = r.list(kuberesource.LimitRanges.Resource, namespace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing the LimitRanges of namespace %s", namespace)
+	}
+	for i := range list.Items {
+		limitRange := new(corev1api.LimitRange)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.Items[i].Object, limitRange); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		quotas.limitRanges = append(quotas.limitRanges, limitRange)
+	}
+
+	r.namespaces[namespace] = quotas
+	return quotas, nil
+}
+
+// podUsage returns the usage of a pod in the quota resources, the requests and limits
+// missing from its containers being defaulted from the LimitRanges as at admission.
+func podUsage(podSpec *corev1api.PodSpec, limitRanges []*corev1api.LimitRange) corev1api.ResourceList {
+	containersUsage := func(containers []corev1api.Container) (corev1api.ResourceList, corev1api.ResourceList) {
+		requests, limits := corev1api.ResourceList{}, corev1api.ResourceList{}
+		for _, container := range containers {
+			for _, name := range []corev1api.ResourceName{corev1api.ResourceCPU, corev1api.ResourceMemory} {
+				request, hasRequest := container.Resources.Requests[name]
+				limit, hasLimit := container.Resources.Limits[name]
+				if !hasLimit {
+					limit, hasLimit = limitRangeDefault(limitRanges, name, false)
+				}
+				if !hasRequest {
+					// the request of a container defaults to its limit
+					if hasLimit {
+						request, hasRequest = limit, true
+					} else {
+						request, hasRequest = limitRangeDefault(limitRanges, name, true)
+					}
+				}
+				if hasRequest {
+					sum := requests[name]
+					sum.Add(request)
+					requests[name] = sum
+				}
+				if hasLimit {
+					sum := limits[name]
+					sum.Add(limit)
+					limits[name] = sum
+				}
+			}
+		}
+		return requests, limits
+	}
+
+	requests, limits := containersUsage(podSpec.Containers)
+
+	// the init containers run one after another, before the containers
+	for _, container := range podSpec.InitContainers {
+		initRequests, initLimits := containersUsage([]corev1api.Container{container})
+		for name, quantity := range initRequests {
+			if quantity.Cmp(requests[name]) > 0 {
+				requests[name] = quantity
+			}
+		}
+		for name, quantity := range initLimits {
+			if quantity.Cmp(limits[name]) > 0 {
+				limits[name] = quantity
+			}
+		}
+	}
+
+	usage := corev1api.ResourceList{
+		corev1api.ResourcePods: resource.MustParse("1"),
+	}
+	if cpu, ok := requests[corev1api.ResourceCPU]; ok {
+		usage[corev1api.ResourceCPU] = cpu
+		usage[corev1api.ResourceRequestsCPU] = cpu
+	}
+	if memory, ok := requests[corev1api.ResourceMemory]; ok {
+		usage[corev1api.ResourceMemory] = memory
+		usage[corev1api.ResourceRequestsMemory] = memory
+	}
+	if cpu, ok := limits[corev1api.ResourceCPU]; ok {
+		usage[corev1api.ResourceLimitsCPU] = cpu
+	}
+	if memory, ok := limits[corev1api.ResourceMemory]; ok {
+		usage[corev1api.ResourceLimitsMemory] = memory
+	}
+
+	return usage
+}
+
+// limitRangeDefault returns the default request, or limit, of a container for the resource.
+func limitRangeDefault(limitRanges []*corev1api.LimitRange, name corev1api.ResourceName, request bool) (resource.Quantity, bool) {
+	for _, limitRange := range limitRanges {
+		for _, item := range limitRange.Spec.Limits {
+			if item.Type != corev1api.LimitTypeContainer {
+				continue
+			}
+			defaults := item.Default
+			if request {
+				defaults = item.DefaultRequest
+			}
+			if quantity, ok := defaults[name]; ok {
+				return quantity, true
+			}
+		}
+	}
+	return resource.Quantity{}, false
+}
+
+// fittingReplicas returns the number of pods of usage which fit in the remaining resources of quota.
+func fittingReplicas(quota *corev1api.ResourceQuota, usage corev1api.ResourceList) int64 {
+	fitting := int64(math.MaxInt64)
+	for _, name := range quotaResources {
+		hard, ok := quota.Spec.Hard[name]
+		if !ok {
+			continue
+		}
+		perPod, ok := usage[name]
+		if !ok || perPod.IsZero() {
+			continue
+		}
+		used := quota.Status.Used[name]
+		remaining := hard.AsApproximateFloat64() - used.AsApproximateFloat64()
+		fit := int64(0)
+		if remaining > 0 {
+			fit = int64(math.Floor(remaining / perPod.AsApproximateFloat64()))
+		}
+		if fit < fitting {
+			fitting = fit
+		}
+	}
+	return fitting
+}
+
+// addUsage adds the usage of replicas pods to the used resources of quota.
+func addUsage(quota *corev1api.ResourceQuota, usage corev1api.ResourceList, replicas int64) {
+	for _, name := range quotaResources {
+		if _, ok := quota.Spec.Hard[name]; !ok {
+			continue
+		}
+		perPod, ok := usage[name]
+		if !ok {
+			continue
+		}
+		used := quota.Status.Used[name]
+		for i := int64(0); i < replicas; i++ {
+			used.Add(perPod)
+		}
+		quota.Status.Used[name] = used
+	}
+}
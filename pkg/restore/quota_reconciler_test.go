@@ -0,0 +1,257 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1api "k8s.io/api/apps/v1"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/types"
+)
+
+func TestQuotaPriorities(t *testing.T) {
+	tests := []struct {
+		name       string
+		priorities types.Priorities
+		expected   types.Priorities
+	}{
+		{
+			name: "quotas are restored right after the namespaces",
+			priorities: types.Priorities{
+				HighPriorities: []string{"customresourcedefinitions", "namespaces", "storageclasses", "pods"},
+				LowPriorities:  []string{"clusterbootstraps.cluster.x-k8s.io"},
+			},
+			expected: types.Priorities{
+				HighPriorities: []string{"customresourcedefinitions", "namespaces", "resourcequotas", "limitranges", "storageclasses", "pods"},
+				LowPriorities:  []string{"clusterbootstraps.cluster.x-k8s.io"},
+			},
+		},
+		{
+			name: "quotas prioritized elsewhere are moved",
+			priorities: types.Priorities{
+				HighPriorities: []string{"namespaces", "pods", "limitranges"},
+				LowPriorities:  []string{"resourcequotas"},
+			},
+			expected: types.Priorities{
+				HighPriorities: []string{"namespaces", "resourcequotas", "limitranges", "pods"},
+			},
+		},
+		{
+			name: "quotas are restored first without namespaces",
+			priorities: types.Priorities{
+				HighPriorities: []string{"pods"},
+			},
+			expected: types.Priorities{
+				HighPriorities: []string{"resourcequotas", "limitranges", "pods"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, quotaPriorities(tc.priorities))
+		})
+	}
+}
+
+func TestQuotaReconcilerReconcile(t *testing.T) {
+	quota := &corev1api.ResourceQuota{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ResourceQuota"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "compute"},
+		Spec: corev1api.ResourceQuotaSpec{
+			Hard: corev1api.ResourceList{
+				corev1api.ResourcePods:         resource.MustParse("10"),
+				corev1api.ResourceRequestsCPU:  resource.MustParse("2"),
+				corev1api.ResourceLimitsMemory: resource.MustParse("4Gi"),
+			},
+		},
+		Status: corev1api.ResourceQuotaStatus{
+			Used: corev1api.ResourceList{
+				corev1api.ResourceRequestsCPU: resource.MustParse("500m"),
+			},
+		},
+	}
+	scopedQuota := &corev1api.ResourceQuota{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ResourceQuota"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "best-effort"},
+		Spec: corev1api.ResourceQuotaSpec{
+			Hard:   corev1api.ResourceList{corev1api.ResourcePods: resource.MustParse("0")},
+			Scopes: []corev1api.ResourceQuotaScope{corev1api.ResourceQuotaScopeBestEffort},
+		},
+	}
+	limitRange := &corev1api.LimitRange{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "LimitRange"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "defaults"},
+		Spec: corev1api.LimitRangeSpec{
+			Limits: []corev1api.LimitRangeItem{
+				{
+					Type:           corev1api.LimitTypeContainer,
+					Default:        corev1api.ResourceList{corev1api.ResourceMemory: resource.MustParse("1Gi")},
+					DefaultRequest: corev1api.ResourceList{corev1api.ResourceCPU: resource.MustParse("250m")},
+				},
+			},
+		},
+	}
+
+	container := func(cpu string) corev1api.Container {
+		c := corev1api.Container{Name: "c"}
+		if cpu != "" {
+			c.Resources.Requests = corev1api.ResourceList{corev1api.ResourceCPU: resource.MustParse(cpu)}
+		}
+		return c
+	}
+	deployment := func(name string, replicas int32, cpu string) *unstructured.Unstructured {
+		d := &appsv1api.Deployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: name},
+			Spec: appsv1api.DeploymentSpec{
+				Replicas: &replicas,
+				Template: corev1api.PodTemplateSpec{
+					Spec: corev1api.PodSpec{Containers: []corev1api.Container{container(cpu)}},
+				},
+			},
+		}
+		return toUnstructuredOrFail(t, d)
+	}
+	pod := func(name string, cpu string, owned bool) *unstructured.Unstructured {
+		p := &corev1api.Pod{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: name},
+			Spec:       corev1api.PodSpec{Containers: []corev1api.Container{container(cpu)}},
+		}
+		if owned {
+			p.OwnerReferences = []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "rs-1"}}
+		}
+		return toUnstructuredOrFail(t, p)
+	}
+
+	newReconciler := func(mode velerov1api.QuotaReconciliationMode) *quotaReconciler {
+		return &quotaReconciler{
+			mode: mode,
+			list: func(resource, namespace string) (*unstructured.UnstructuredList, error) {
+				list := new(unstructured.UnstructuredList)
+				if namespace != "ns-1" {
+					return list, nil
+				}
+				switch resource {
+				case kuberesource.ResourceQuotas.Resource:
+					list.Items = append(list.Items, *toUnstructuredOrFail(t, quota), *toUnstructuredOrFail(t, scopedQuota))
+				case kuberesource.LimitRanges.Resource:
+					list.Items = append(list.Items, *toUnstructuredOrFail(t, limitRange))
+				}
+				return list, nil
+			},
+			namespaces: make(map[string]*namespaceQuotas),
+		}
+	}
+
+	replicas := func(obj *unstructured.Unstructured) int64 {
+		val, _, err := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+		require.NoError(t, err)
+		return val
+	}
+
+	t.Run("workloads fitting the quotas are unchanged", func(t *testing.T) {
+		r := newReconciler(velerov1api.QuotaReconciliationScale)
+		obj := deployment("deploy-1", 3, "500m")
+
+		message, err := r.reconcile(obj, kuberesource.Deployments, "ns-1", velerotest.NewLogger())
+		require.NoError(t, err)
+		assert.Empty(t, message)
+		assert.Equal(t, int64(3), replicas(obj))
+	})
+
+	t.Run("workloads exceeding the quotas are scaled down in Scale mode", func(t *testing.T) {
+		r := newReconciler(velerov1api.QuotaReconciliationScale)
+
+		// 1.5 CPU remaining, 3 replicas of 500m fit
+		obj := deployment("deploy-1", 5, "500m")
+		message, err := r.reconcile(obj, kuberesource.Deployments, "ns-1", velerotest.NewLogger())
+		require.NoError(t, err)
+		assert.Contains(t, message, "scaled from 5 to 3 replicas to fit ResourceQuota compute")
+		assert.Equal(t, int64(3), replicas(obj))
+
+		// the quota is now used up by the first deployment
+		obj = deployment("deploy-2", 1, "500m")
+		message, err = r.reconcile(obj, kuberesource.Deployments, "ns-1", velerotest.NewLogger())
+		require.NoError(t, err)
+		assert.Contains(t, message, "scaled from 1 to 0 replicas")
+		assert.Equal(t, int64(0), replicas(obj))
+	})
+
+	t.Run("workloads exceeding the quotas are reported in Warn mode", func(t *testing.T) {
+		r := newReconciler(velerov1api.QuotaReconciliationWarn)
+		obj := deployment("deploy-1", 5, "500m")
+
+		message, err := r.reconcile(obj, kuberesource.Deployments, "ns-1", velerotest.NewLogger())
+		require.NoError(t, err)
+		assert.Contains(t, message, "with 5 replicas exceeds ResourceQuota compute, only 3 replicas fit")
+		assert.Equal(t, int64(5), replicas(obj))
+	})
+
+	t.Run("the LimitRange defaults are accounted", func(t *testing.T) {
+		r := newReconciler(velerov1api.QuotaReconciliationScale)
+
+		// defaulted to 250m CPU and 1Gi memory, the 4Gi of memory limits fit 4 replicas
+		obj := deployment("deploy-1", 8, "")
+		message, err := r.reconcile(obj, kuberesource.Deployments, "ns-1", velerotest.NewLogger())
+		require.NoError(t, err)
+		assert.Contains(t, message, "scaled from 8 to 4 replicas")
+		assert.Equal(t, int64(4), replicas(obj))
+	})
+
+	t.Run("standalone pods are reported, owned pods are skipped", func(t *testing.T) {
+		r := newReconciler(velerov1api.QuotaReconciliationScale)
+
+		message, err := r.reconcile(pod("pod-1", "4", true), kuberesource.Pods, "ns-1", velerotest.NewLogger())
+		require.NoError(t, err)
+		assert.Empty(t, message)
+
+		message, err = r.reconcile(pod("pod-2", "4", false), kuberesource.Pods, "ns-1", velerotest.NewLogger())
+		require.NoError(t, err)
+		assert.Contains(t, message, "exceeds ResourceQuota compute, only 0 replicas fit")
+	})
+
+	t.Run("namespaces without quotas are skipped", func(t *testing.T) {
+		r := newReconciler(velerov1api.QuotaReconciliationScale)
+		obj := deployment("deploy-1", 50, "4")
+
+		message, err := r.reconcile(obj, kuberesource.Deployments, "ns-2", velerotest.NewLogger())
+		require.NoError(t, err)
+		assert.Empty(t, message)
+		assert.Equal(t, int64(50), replicas(obj))
+	})
+}
+
+func toUnstructuredOrFail(t *testing.T, obj any) *unstructured.Unstructured {
+	t.Helper()
+
+	res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: res}
+}
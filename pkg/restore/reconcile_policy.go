@@ -0,0 +1,245 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+// restoreFieldManager is the field manager the existing items are server-side applied as by the
+// restores with the reconcile or ssa ExistingResourcePolicy, so they share the ownership of the
+// fields velero restored.
+const restoreFieldManager = "velero-restore"
+
+// reconcileIgnoredFields are the fields which aren't compared between the backed up and the
+// in-cluster versions of an item.
+var reconcileIgnoredFields = []string{
+	"apiVersion",
+	"kind",
+	"metadata.name",
+	"metadata.namespace",
+	"status",
+}
+
+// serverSetMetadataFields are the metadata fields set by the API server, which are neither
+// applied nor compared between the backed up and the in-cluster versions of an item.
+var serverSetMetadataFields = []string{
+	"creationTimestamp",
+	"generation",
+	"managedFields",
+	"resourceVersion",
+	"selfLink",
+	"uid",
+}
+
+// processReconcileResourcePolicy server-side applies the backed up item, so the fields of the
+// in-cluster item which drifted from it are set back to their backed up values. The drifted
+// fields owned by other field managers are left as they are in the cluster and reported as
+// warnings. It returns whether any field was repaired.
+func (ctx *restoreContext) processReconcileResourcePolicy(fromCluster, obj *unstructured.Unstructured, resource, namespace string, resourceClient client.Dynamic) (warnings, errs results.Result, repaired bool) {
+	drifted := driftedFields(sanitizedItem(obj).Object, fromCluster.Object, nil)
+	if len(drifted) == 0 {
+		ctx.log.Infof("%s %s hasn't drifted from the backup", obj.GetKind(), kube.NamespaceAndName(obj))
+		return warnings, errs, false
+	}
+
+	item := velerov1api.DriftedItem{
+		Resource:  resource,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	}
+	defer func() {
+		*ctx.driftReport = append(*ctx.driftReport, item)
+	}()
+
+	applied, conflicts, err := applyBackedUpItem(obj, drifted, resourceClient)
+	if len(conflicts) > 0 {
+		item.ConflictingFields = conflicts
+		warnings.Add(namespace, fmt.Errorf("drifted fields %s of %s %s not repaired, they are owned by other field managers",
+			strings.Join(conflicts, ", "), obj.GetKind(), obj.GetName()))
+	}
+	if err != nil {
+		ctx.log.Warnf("error applying the drifted fields of %s %s: %v", obj.GetKind(), kube.NamespaceAndName(obj), err)
+		item.Error = err.Error()
+		warnings.Add(namespace, err)
+		return warnings, errs, false
+	}
+	if len(applied) == 0 {
+		return warnings, errs, false
+	}
+
+	for _, path := range applied {
+		item.RepairedFields = append(item.RepairedFields, fieldPath(path))
+	}
+	ctx.log.Infof("Repaired the drifted fields %s of %s %s", strings.Join(item.RepairedFields, ", "), obj.GetKind(), kube.NamespaceAndName(obj))
+
+	return warnings, errs, true
+}
+
+// processSSAResourcePolicy server-side applies the backed up item as the restoreFieldManager, so
+// velero owns the fields it restores. The fields owned by other field managers with different
+// values, like the ones mutated by the controllers of the cluster, are left as they are and reported
+// as warnings. It returns whether the item was applied.
+func (ctx *restoreContext) processSSAResourcePolicy(fromCluster, obj *unstructured.Unstructured, namespace string, resourceClient client.Dynamic) (warnings, errs results.Result, applied bool) {
+	fields, conflicts, err := applyBackedUpItem(obj, driftedFields(sanitizedItem(obj).Object, fromCluster.Object, nil), resourceClient)
+	if len(conflicts) > 0 {
+		warnings.Add(namespace, fmt.Errorf("fields %s of %s %s not applied, they are owned by other field managers",
+			strings.Join(conflicts, ", "), obj.GetKind(), obj.GetName()))
+	}
+	if err != nil {
+		ctx.log.Warnf("error server-side applying %s %s: %v", obj.GetKind(), kube.NamespaceAndName(obj), err)
+		warnings.Add(namespace, err)
+		return warnings, errs, false
+	}
+	if len(fields) == 0 {
+		return warnings, errs, false
+	}
+
+	ctx.log.Infof("Server-side applied %s %s", obj.GetKind(), kube.NamespaceAndName(obj))
+	return warnings, errs, true
+}
+
+// applyBackedUpItem server-side applies the backed up item without forcing the conflicts, and
+// again without the drifted fields owned by other field managers when there are some. It returns
+// the drifted fields which were applied and the conflicting ones.
+func applyBackedUpItem(obj *unstructured.Unstructured, drifted [][]string, resourceClient client.Dynamic) ([][]string, []string, error) {
+	// applying the whole backed up item shares the ownership of the fields with the same values
+	// instead of dropping the fields applied by previous restores from the ones velero owns
+	_, err := resourceClient.Apply(obj.GetName(), applyConfiguration(obj, nil), restoreFieldManager)
+	if !apierrors.IsConflict(err) {
+		if err != nil {
+			return nil, nil, err
+		}
+		return drifted, nil, nil
+	}
+
+	conflicts := conflictingFields(err)
+	var conflicting [][]string
+	applied := slices.DeleteFunc(slices.Clone(drifted), func(path []string) bool {
+		if isConflicting(path, conflicts) {
+			conflicting = append(conflicting, path)
+			return true
+		}
+		return false
+	})
+	if len(applied) == 0 {
+		return nil, conflicts, nil
+	}
+	if _, err := resourceClient.Apply(obj.GetName(), applyConfiguration(obj, conflicting), restoreFieldManager); err != nil {
+		return nil, conflicts, err
+	}
+	return applied, conflicts, nil
+}
+
+// driftedFields returns the paths of the fields of the backed up object whose values are
+// missing or different in the in-cluster one. Maps are compared field by field, any other value
+// as a whole.
+func driftedFields(backedUp, inCluster map[string]any, prefix []string) [][]string {
+	var drifted [][]string
+	for key, value := range backedUp {
+		path := append(slices.Clone(prefix), key)
+		if slices.Contains(reconcileIgnoredFields, strings.Join(path, ".")) {
+			continue
+		}
+
+		inClusterValue, ok := inCluster[key]
+		if !ok {
+			drifted = append(drifted, path)
+			continue
+		}
+		backedUpMap, backedUpIsMap := value.(map[string]any)
+		inClusterMap, inClusterIsMap := inClusterValue.(map[string]any)
+		if backedUpIsMap && inClusterIsMap {
+			drifted = append(drifted, driftedFields(backedUpMap, inClusterMap, path)...)
+			continue
+		}
+		if !reflect.DeepEqual(value, inClusterValue) {
+			drifted = append(drifted, path)
+		}
+	}
+	sort.Slice(drifted, func(i, j int) bool {
+		return fieldPath(drifted[i]) < fieldPath(drifted[j])
+	})
+	return drifted
+}
+
+// applyConfiguration returns the apply configuration of the backed up object without the
+// excluded fields.
+func applyConfiguration(obj *unstructured.Unstructured, excluded [][]string) *unstructured.Unstructured {
+	applied := sanitizedItem(obj)
+	for _, path := range excluded {
+		unstructured.RemoveNestedField(applied.Object, path...)
+	}
+	return applied
+}
+
+// sanitizedItem returns a copy of the backed up object without its status and the metadata set
+// by the API server, which is both what is applied and what is compared with the in-cluster
+// object.
+func sanitizedItem(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	sanitized := obj.DeepCopy()
+	unstructured.RemoveNestedField(sanitized.Object, "status")
+	for _, field := range serverSetMetadataFields {
+		unstructured.RemoveNestedField(sanitized.Object, "metadata", field)
+	}
+	return sanitized
+}
+
+// conflictingFields returns the paths of the fields of a server-side apply conflict.
+func conflictingFields(err error) []string {
+	var fields []string
+	if status, ok := err.(apierrors.APIStatus); ok && status.Status().Details != nil {
+		for _, cause := range status.Status().Details.Causes {
+			if cause.Type == metav1.CauseTypeFieldManagerConflict && !slices.Contains(fields, cause.Field) {
+				fields = append(fields, cause.Field)
+			}
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// isConflicting returns whether a conflict is on the field or inside it, e.g. on an element of
+// a list field.
+func isConflicting(path []string, conflicts []string) bool {
+	field := fieldPath(path)
+	for _, conflict := range conflicts {
+		if conflict == field || strings.HasPrefix(conflict, field+".") || strings.HasPrefix(conflict, field+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldPath formats the path of a field the way the API server reports the fields of
+// server-side apply conflicts.
+func fieldPath(path []string) string {
+	return "." + strings.Join(path, ".")
+}
@@ -0,0 +1,293 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func newReconcileDeployment(replicas int64, image string, labels map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]any{
+			"name":      "app",
+			"namespace": "ns-1",
+			"labels":    labels,
+		},
+		"spec": map[string]any{
+			"replicas": replicas,
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{
+						map[string]any{"name": "app", "image": image},
+					},
+				},
+			},
+		},
+		"status": map[string]any{
+			"replicas": replicas,
+		},
+	}}
+}
+
+func TestDriftedFields(t *testing.T) {
+	backedUp := newReconcileDeployment(3, "app:v1", map[string]any{"app": "app", "tier": "web"})
+	inCluster := newReconcileDeployment(1, "app:v2", map[string]any{"app": "app"})
+
+	var paths []string
+	for _, path := range driftedFields(backedUp.Object, inCluster.Object, nil) {
+		paths = append(paths, fieldPath(path))
+	}
+	assert.Equal(t, []string{
+		".metadata.labels.tier",
+		".spec.replicas",
+		".spec.template.spec.containers",
+	}, paths)
+
+	assert.Empty(t, driftedFields(backedUp.Object, backedUp.DeepCopy().Object, nil))
+}
+
+func withServerSetMetadata(obj *unstructured.Unstructured, uid string, resourceVersion string) *unstructured.Unstructured {
+	obj.SetUID(types.UID(uid))
+	obj.SetResourceVersion(resourceVersion)
+	obj.SetGeneration(2)
+	obj.SetCreationTimestamp(metav1.Now())
+	obj.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationUpdate}})
+	return obj
+}
+
+func TestApplyConfiguration(t *testing.T) {
+	backedUp := withServerSetMetadata(newReconcileDeployment(3, "app:v1", map[string]any{"app": "app"}), "uid-1", "1")
+
+	applied := applyConfiguration(backedUp, [][]string{{"spec", "replicas"}})
+
+	for _, field := range serverSetMetadataFields {
+		_, found, _ := unstructured.NestedFieldNoCopy(applied.Object, "metadata", field)
+		assert.False(t, found, field)
+	}
+	_, found, _ := unstructured.NestedFieldNoCopy(applied.Object, "status")
+	assert.False(t, found)
+	_, found, _ = unstructured.NestedFieldNoCopy(applied.Object, "spec", "replicas")
+	assert.False(t, found)
+	assert.Equal(t, "app", applied.GetLabels()["app"])
+
+	// the backed up object is left untouched
+	assert.Equal(t, "uid-1", string(backedUp.GetUID()))
+	assert.NotEmpty(t, backedUp.GetManagedFields())
+}
+
+func TestIsConflicting(t *testing.T) {
+	conflicts := []string{`.spec.template.spec.containers[name="app"].image`, ".spec.replicas"}
+
+	assert.True(t, isConflicting([]string{"spec", "replicas"}, conflicts))
+	assert.True(t, isConflicting([]string{"spec", "template", "spec", "containers"}, conflicts))
+	assert.False(t, isConflicting([]string{"metadata", "labels", "tier"}, conflicts))
+}
+
+func TestProcessReconcileResourcePolicy(t *testing.T) {
+	conflict := apierrors.NewApplyConflict([]metav1.StatusCause{
+		{
+			Type:  metav1.CauseTypeFieldManagerConflict,
+			Field: ".spec.replicas",
+		},
+	}, `Apply failed with 1 conflict: conflict with "hpa-controller": .spec.replicas`)
+
+	tests := []struct {
+		name              string
+		inCluster         *unstructured.Unstructured
+		applyErrs         []error
+		expectedRepaired  bool
+		expectedReport    []velerov1api.DriftedItem
+		expectedWarnings  int
+		expectedApplyCall int
+	}{
+		{
+			name:      "an item which hasn't drifted isn't applied",
+			inCluster: newReconcileDeployment(3, "app:v1", map[string]any{"app": "app"}),
+		},
+		{
+			name:      "an item which only differs in the metadata set by the API server hasn't drifted",
+			inCluster: withServerSetMetadata(newReconcileDeployment(3, "app:v1", map[string]any{"app": "app"}), "uid-2", "2"),
+		},
+		{
+			name:              "the drifted fields are repaired",
+			inCluster:         newReconcileDeployment(1, "app:v2", map[string]any{"app": "app"}),
+			applyErrs:         []error{nil},
+			expectedRepaired:  true,
+			expectedApplyCall: 1,
+			expectedReport: []velerov1api.DriftedItem{
+				{
+					Resource:       "deployments.apps",
+					Namespace:      "ns-1",
+					Name:           "app",
+					RepairedFields: []string{".spec.replicas", ".spec.template.spec.containers"},
+				},
+			},
+		},
+		{
+			name:              "the drifted fields owned by other field managers aren't repaired",
+			inCluster:         newReconcileDeployment(1, "app:v2", map[string]any{"app": "app"}),
+			applyErrs:         []error{conflict, nil},
+			expectedRepaired:  true,
+			expectedWarnings:  1,
+			expectedApplyCall: 2,
+			expectedReport: []velerov1api.DriftedItem{
+				{
+					Resource:          "deployments.apps",
+					Namespace:         "ns-1",
+					Name:              "app",
+					RepairedFields:    []string{".spec.template.spec.containers"},
+					ConflictingFields: []string{".spec.replicas"},
+				},
+			},
+		},
+		{
+			name:              "an item with only conflicting drifted fields isn't applied again",
+			inCluster:         newReconcileDeployment(1, "app:v1", map[string]any{"app": "app"}),
+			applyErrs:         []error{conflict},
+			expectedWarnings:  1,
+			expectedApplyCall: 1,
+			expectedReport: []velerov1api.DriftedItem{
+				{
+					Resource:          "deployments.apps",
+					Namespace:         "ns-1",
+					Name:              "app",
+					ConflictingFields: []string{".spec.replicas"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			backedUp := withServerSetMetadata(newReconcileDeployment(3, "app:v1", map[string]any{"app": "app"}), "uid-1", "1")
+
+			resourceClient := &velerotest.FakeDynamicClient{}
+			for _, err := range tc.applyErrs {
+				resourceClient.On("Apply", "app", mock.MatchedBy(func(obj *unstructured.Unstructured) bool {
+					return obj.GetUID() == "" && obj.GetResourceVersion() == "" && obj.GetManagedFields() == nil
+				}), restoreFieldManager).Return(backedUp, err).Once()
+			}
+
+			report := []velerov1api.DriftedItem{}
+			ctx := &restoreContext{
+				log:         velerotest.NewLogger(),
+				driftReport: &report,
+			}
+
+			gr := schema.GroupResource{Group: "apps", Resource: "deployments"}
+			warnings, errs, repaired := ctx.processReconcileResourcePolicy(tc.inCluster, backedUp, gr.String(), "ns-1", resourceClient)
+
+			assert.Equal(t, tc.expectedRepaired, repaired)
+			assert.True(t, errs.IsEmpty())
+			assert.Len(t, warnings.Namespaces["ns-1"], tc.expectedWarnings)
+			resourceClient.AssertNumberOfCalls(t, "Apply", tc.expectedApplyCall)
+			if tc.expectedReport == nil {
+				assert.Empty(t, report)
+			} else {
+				assert.Equal(t, tc.expectedReport, report)
+			}
+		})
+	}
+}
+
+func TestProcessSSAResourcePolicy(t *testing.T) {
+	conflict := apierrors.NewApplyConflict([]metav1.StatusCause{
+		{
+			Type:  metav1.CauseTypeFieldManagerConflict,
+			Field: ".spec.replicas",
+		},
+	}, `Apply failed with 1 conflict: conflict with "hpa-controller": .spec.replicas`)
+
+	tests := []struct {
+		name              string
+		inCluster         *unstructured.Unstructured
+		applyErrs         []error
+		expectedApplied   bool
+		expectedWarnings  int
+		expectedApplyCall int
+	}{
+		{
+			name:              "the item is applied",
+			inCluster:         newReconcileDeployment(1, "app:v2", map[string]any{"app": "app"}),
+			applyErrs:         []error{nil},
+			expectedApplied:   true,
+			expectedApplyCall: 1,
+		},
+		{
+			name:              "an item which only differs in the metadata set by the API server isn't reported as applied",
+			inCluster:         withServerSetMetadata(newReconcileDeployment(3, "app:v1", map[string]any{"app": "app"}), "uid-2", "2"),
+			applyErrs:         []error{nil},
+			expectedApplyCall: 1,
+		},
+		{
+			name:              "the item is applied again without the fields owned by other field managers",
+			inCluster:         newReconcileDeployment(1, "app:v2", map[string]any{"app": "app"}),
+			applyErrs:         []error{conflict, nil},
+			expectedApplied:   true,
+			expectedWarnings:  1,
+			expectedApplyCall: 2,
+		},
+		{
+			name:              "an item whose changed fields all conflict isn't applied again",
+			inCluster:         newReconcileDeployment(1, "app:v1", map[string]any{"app": "app"}),
+			applyErrs:         []error{conflict},
+			expectedWarnings:  1,
+			expectedApplyCall: 1,
+		},
+		{
+			name:              "error applying the item",
+			inCluster:         newReconcileDeployment(1, "app:v1", map[string]any{"app": "app"}),
+			applyErrs:         []error{apierrors.NewForbidden(schema.GroupResource{Group: "apps", Resource: "deployments"}, "app", assert.AnError)},
+			expectedWarnings:  1,
+			expectedApplyCall: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			backedUp := withServerSetMetadata(newReconcileDeployment(3, "app:v1", map[string]any{"app": "app"}), "uid-1", "1")
+
+			resourceClient := &velerotest.FakeDynamicClient{}
+			for _, err := range tc.applyErrs {
+				resourceClient.On("Apply", "app", mock.MatchedBy(func(obj *unstructured.Unstructured) bool {
+					return obj.GetUID() == "" && obj.GetResourceVersion() == "" && obj.GetManagedFields() == nil
+				}), restoreFieldManager).Return(backedUp, err).Once()
+			}
+
+			ctx := &restoreContext{log: velerotest.NewLogger()}
+			warnings, errs, applied := ctx.processSSAResourcePolicy(tc.inCluster, backedUp, "ns-1", resourceClient)
+
+			assert.Equal(t, tc.expectedApplied, applied)
+			assert.True(t, errs.IsEmpty())
+			assert.Len(t, warnings.Namespaces["ns-1"], tc.expectedWarnings)
+			resourceClient.AssertNumberOfCalls(t, "Apply", tc.expectedApplyCall)
+		})
+	}
+}
@@ -25,10 +25,12 @@ import (
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/velero/internal/namespacereferences"
 	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	"github.com/vmware-tanzu/velero/internal/volume"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/itemquarantine"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
@@ -60,12 +62,17 @@ type Request struct {
 	BackupReader                  io.Reader
 	RestoredItems                 map[itemKey]restoredItemStatus
 	itemOperationsList            *[]*itemoperation.RestoreOperation
+	driftReport                   *[]velerov1api.DriftedItem
+	previewReport                 *[]velerov1api.PreviewItem
+	mappingReport                 *velerov1api.RestoreMappingReport
 	ResourceModifiers             *resourcemodifiers.ResourceModifiers
+	NamespaceReferenceRules       *namespacereferences.Rules
 	DisableInformerCache          bool
 	CSIVolumeSnapshots            []*snapshotv1api.VolumeSnapshot
 	BackupVolumeInfoMap           map[string]volume.BackupVolumeInfo
 	RestoreVolumeInfoTracker      *volume.RestoreVolumeInfoTracker
 	ResourceDeletionStatusTracker kube.ResourceDeletionStatusTracker
+	ItemQuarantine                *itemquarantine.Tracker
 }
 
 type restoredItemStatus struct {
@@ -82,6 +89,35 @@ func (r *Request) GetItemOperationsList() *[]*itemoperation.RestoreOperation {
 	return r.itemOperationsList
 }
 
+// GetDriftReport returns the items which drifted from the backup in a restore with the
+// reconcile ExistingResourcePolicy, initializing it if necessary
+func (r *Request) GetDriftReport() *[]velerov1api.DriftedItem {
+	if r.driftReport == nil {
+		report := []velerov1api.DriftedItem{}
+		r.driftReport = &report
+	}
+	return r.driftReport
+}
+
+// GetPreviewReport returns the items of a restore with Preview set with what the restore would
+// do with them, initializing it if necessary
+func (r *Request) GetPreviewReport() *[]velerov1api.PreviewItem {
+	if r.previewReport == nil {
+		report := []velerov1api.PreviewItem{}
+		r.previewReport = &report
+	}
+	return r.previewReport
+}
+
+// GetMappingReport returns the identifiers of the items in the backup mapped to the ones of the
+// restored items, initializing it if necessary
+func (r *Request) GetMappingReport() *velerov1api.RestoreMappingReport {
+	if r.mappingReport == nil {
+		r.mappingReport = &velerov1api.RestoreMappingReport{}
+	}
+	return r.mappingReport
+}
+
 // RestoredResourceList returns the list of restored resources grouped by the API
 // Version and Kind
 func (r *Request) RestoredResourceList() map[string][]string {
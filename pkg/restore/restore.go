@@ -25,6 +25,7 @@ import (
 	"os/signal"
 	"path/filepath"
 	"reflect"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -53,6 +54,7 @@ import (
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/internal/hook"
+	"github.com/vmware-tanzu/velero/internal/namespacereferences"
 	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	"github.com/vmware-tanzu/velero/internal/volume"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -61,6 +63,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/features"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/itemquarantine"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
@@ -70,6 +73,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/podvolume"
 	"github.com/vmware-tanzu/velero/pkg/podvolume/configs"
+	"github.com/vmware-tanzu/velero/pkg/restore/conversion"
 	"github.com/vmware-tanzu/velero/pkg/types"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
@@ -211,6 +215,13 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		return results.Result{}, results.Result{Velero: []string{err.Error()}}
 	}
 
+	var excludeSelector labels.Selector
+	if req.Restore.Spec.ExcludeLabelSelector != nil {
+		if excludeSelector, err = metav1.LabelSelectorAsSelector(req.Restore.Spec.ExcludeLabelSelector); err != nil {
+			return results.Result{}, results.Result{Velero: []string{err.Error()}}
+		}
+	}
+
 	// Get resource includes-excludes.
 	resourceIncludesExcludes := collections.GetResourceIncludesExcludes(
 		kr.discoveryHelper,
@@ -263,12 +274,22 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 			return results.Result{}, results.Result{Velero: []string{err.Error()}}
 		}
 	}
+	if podVolumeRestorer == nil {
+		// the restored pods would wait for their volumes to be restored, which doesn't happen
+		// without a pod volume restorer, so they're restored as they are
+		resolvedActions = slices.DeleteFunc(resolvedActions, func(action framework.RestoreItemResolvedActionV2) bool {
+			return action.Name() == podVolumeRestoreActionName
+		})
+	}
 
 	waitExecHookHandler := &hook.DefaultWaitExecHookHandler{
 		PodCommandExecutor: kr.podCommandExecutor,
 		ListWatchFactory: &hook.DefaultListWatchFactory{
 			PodsGetter: kr.podGetter,
 		},
+		RestoreHookVerifier: &hook.DefaultRestoreHookVerifier{
+			PodCommandExecutor: kr.podCommandExecutor,
+		},
 	}
 
 	hooksWaitExecutor, err := newHooksWaitExecutor(req.Restore, waitExecHookHandler)
@@ -289,6 +310,25 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 
 	req.RestoredItems = make(map[itemKey]restoredItemStatus)
 
+	resourcePriorities := kr.resourcePriorities
+	var quotaReconciler *quotaReconciler
+	if req.Restore.Spec.QuotaReconciliation != "" {
+		resourcePriorities = quotaPriorities(resourcePriorities)
+		quotaReconciler = newQuotaReconciler(req.Restore.Spec.QuotaReconciliation, kr.dynamicFactory)
+	}
+
+	if req.ItemQuarantine == nil {
+		req.ItemQuarantine = itemquarantine.NewTracker(req.Restore.Spec.ErrorBudget)
+	}
+
+	var namespaceReferenceRewriter *namespacereferences.Rewriter
+	if boolptr.IsSetToTrue(req.Restore.Spec.RewriteNamespaceReferences) && len(req.Restore.Spec.NamespaceMapping) > 0 {
+		namespaceReferenceRewriter, err = namespacereferences.NewRewriter(req.Restore.Spec.NamespaceMapping, req.NamespaceReferenceRules)
+		if err != nil {
+			return results.Result{}, results.Result{Velero: []string{err.Error()}}
+		}
+	}
+
 	restoreCtx := &restoreContext{
 		backup:                         req.Backup,
 		backupReader:                   req.BackupReader,
@@ -297,9 +337,12 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		resourceStatusIncludesExcludes: restoreStatusIncludesExcludes,
 		namespaceIncludesExcludes:      namespaceIncludesExcludes,
 		resourceMustHave:               sets.New[string](resourceMustHave...),
+		includedItems:                  resolveIncludedItems(kr.discoveryHelper, req.Restore.Spec.IncludedItems, req.Log),
 		chosenGrpVersToRestore:         make(map[string]ChosenGroupVersion),
+		apiVersionDrift:                make(map[apiVersionDriftKey]int),
 		selector:                       selector,
 		OrSelectors:                    OrSelectors,
+		excludeSelector:                excludeSelector,
 		log:                            req.Log,
 		dynamicFactory:                 kr.dynamicFactory,
 		fileSystem:                     kr.fileSystem,
@@ -320,21 +363,35 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		renamedPVs:                     make(map[string]string),
 		pvRenamer:                      kr.pvRenamer,
 		discoveryHelper:                kr.discoveryHelper,
-		resourcePriorities:             kr.resourcePriorities,
+		resourcePriorities:             resourcePriorities,
 		kbClient:                       kr.kbClient,
 		itemOperationsList:             req.GetItemOperationsList(),
+		driftReport:                    req.GetDriftReport(),
+		previewReport:                  req.GetPreviewReport(),
+		mappingReport:                  req.GetMappingReport(),
 		resourceModifiers:              req.ResourceModifiers,
+		namespaceReferenceRewriter:     namespaceReferenceRewriter,
 		disableInformerCache:           req.DisableInformerCache,
 		multiHookTracker:               kr.multiHookTracker,
 		backupVolumeInfoMap:            req.BackupVolumeInfoMap,
 		restoreVolumeInfoTracker:       req.RestoreVolumeInfoTracker,
 		hooksWaitExecutor:              hooksWaitExecutor,
 		resourceDeletionStatusTracker:  req.ResourceDeletionStatusTracker,
+		quotaReconciler:                quotaReconciler,
+		itemQuarantine:                 req.ItemQuarantine,
+		protectedNamespaces:            sets.New[string](),
+		reconciledNamespaces:           sets.New[string](),
+		serverDryRunNamespaces:         make(map[string]bool),
+		previewNamespaces:              make(map[string]bool),
 	}
 
 	return restoreCtx.execute()
 }
 
+// podVolumeRestoreActionName is the name of the restore item action adding the init container
+// which waits for the pod volumes to be restored.
+const podVolumeRestoreActionName = "velero.io/pod-volume-restore"
+
 type restoreContext struct {
 	backup                         *velerov1api.Backup
 	backupReader                   io.Reader
@@ -344,9 +401,12 @@ type restoreContext struct {
 	resourceStatusIncludesExcludes *collections.IncludesExcludes
 	namespaceIncludesExcludes      *collections.IncludesExcludes
 	resourceMustHave               sets.Set[string]
+	includedItems                  map[string]sets.Set[string]
+	selectedItems                  sets.Set[string]
 	chosenGrpVersToRestore         map[string]ChosenGroupVersion
 	selector                       labels.Selector
 	OrSelectors                    []labels.Selector
+	excludeSelector                labels.Selector
 	log                            logrus.FieldLogger
 	dynamicFactory                 client.DynamicFactory
 	fileSystem                     filesystem.Interface
@@ -362,23 +422,45 @@ type restoreContext struct {
 	csiVolumeSnapshots             []*snapshotv1api.VolumeSnapshot
 	podVolumeBackups               []*velerov1api.PodVolumeBackup
 	resourceTerminatingTimeout     time.Duration
-	resourceTimeout                time.Duration
-	resourceClients                map[resourceClientKey]client.Dynamic
-	dynamicInformerFactory         *informerFactoryWithContext
-	restoredItems                  map[itemKey]restoredItemStatus
-	renamedPVs                     map[string]string
-	pvRenamer                      func(string) (string, error)
-	discoveryHelper                discovery.Helper
-	resourcePriorities             types.Priorities
-	kbClient                       crclient.Client
-	itemOperationsList             *[]*itemoperation.RestoreOperation
-	resourceModifiers              *resourcemodifiers.ResourceModifiers
-	disableInformerCache           bool
-	multiHookTracker               *hook.MultiHookTracker
-	backupVolumeInfoMap            map[string]volume.BackupVolumeInfo
-	restoreVolumeInfoTracker       *volume.RestoreVolumeInfoTracker
-	hooksWaitExecutor              *hooksWaitExecutor
-	resourceDeletionStatusTracker  kube.ResourceDeletionStatusTracker
+	// scaledDownWorkloads are the existing workloads scaled down before they're overwritten.
+	scaledDownWorkloads []scaledDownWorkload
+	// volumeDetachDeadline is when the restore stops waiting for the volumes of the restored
+	// pods to be detached, set when it first waits for them.
+	volumeDetachDeadline          time.Time
+	resourceTimeout               time.Duration
+	resourceClients               map[resourceClientKey]client.Dynamic
+	dynamicInformerFactory        *informerFactoryWithContext
+	restoredItems                 map[itemKey]restoredItemStatus
+	apiVersionDrift               map[apiVersionDriftKey]int
+	renamedPVs                    map[string]string
+	pvRenamer                     func(string) (string, error)
+	discoveryHelper               discovery.Helper
+	resourcePriorities            types.Priorities
+	kbClient                      crclient.Client
+	itemOperationsList            *[]*itemoperation.RestoreOperation
+	driftReport                   *[]velerov1api.DriftedItem
+	previewReport                 *[]velerov1api.PreviewItem
+	mappingReport                 *velerov1api.RestoreMappingReport
+	resourceModifiers             *resourcemodifiers.ResourceModifiers
+	namespaceReferenceRewriter    *namespacereferences.Rewriter
+	disableInformerCache          bool
+	multiHookTracker              *hook.MultiHookTracker
+	backupVolumeInfoMap           map[string]volume.BackupVolumeInfo
+	restoreVolumeInfoTracker      *volume.RestoreVolumeInfoTracker
+	hooksWaitExecutor             *hooksWaitExecutor
+	resourceDeletionStatusTracker kube.ResourceDeletionStatusTracker
+	quotaReconciler               *quotaReconciler
+	itemQuarantine                *itemquarantine.Tracker
+	protectedNamespaces           sets.Set[string]
+	reconciledNamespaces          sets.Set[string]
+	serverDryRunNamespaces        map[string]bool
+	previewNamespaces             map[string]bool
+	existingDeployments           map[string]*unstructured.Unstructured
+	gradualDeployments            []gradualDeployment
+	loadBalancerServices          []loadBalancerService
+	itemUIDs                      []itemUID
+	mappedClaims                  []mappedClaim
+	mappedServices                []mappedService
 }
 
 type resourceClientKey struct {
@@ -392,6 +474,28 @@ type informerFactoryWithContext struct {
 	cancel  go_context.CancelFunc
 }
 
+// validateResourceNames returns the errors of the resources which are neither discovered in the
+// cluster nor in the backup, by their name or their fully-qualified name.
+func (ctx *restoreContext) validateResourceNames(backupResources map[string]*archive.ResourceItems, resources []string) []error {
+	var unknown []string
+	for _, item := range resources {
+		if _, found := backupResources[item]; found {
+			continue
+		}
+		inBackup := false
+		for resource := range backupResources {
+			if strings.SplitN(resource, ".", 2)[0] == item {
+				inBackup = true
+				break
+			}
+		}
+		if !inBackup {
+			unknown = append(unknown, item)
+		}
+	}
+	return collections.ValidateResourceNames(ctx.discoveryHelper, unknown)
+}
+
 // getOrderedResources returns an ordered list of resource identifiers to restore,
 // based on the provided resource priorities and backup contents. The returned list
 // begins with all of the high prioritized resources (in order), ends with all of
@@ -472,6 +576,22 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 		errs.AddVeleroError(errors.Wrap(err, "error parsing backup contents"))
 		return warnings, errs
 	}
+	ctx.includeBoundPersistentVolumes()
+
+	// validate that the included resources exist in the cluster or in the backup, rather than
+	// silently matching nothing. The resources of the backup may not exist yet, as the restore
+	// may restore their CRDs. The restore fails validation, as it can't be validated before the
+	// backup is downloaded.
+	if resourceErrs := ctx.validateResourceNames(backupResources, ctx.restore.Spec.IncludedResources); len(resourceErrs) > 0 {
+		for _, err := range resourceErrs {
+			ctx.restore.Status.ValidationErrors = append(ctx.restore.Status.ValidationErrors, fmt.Sprintf("Invalid included resources: %v", err))
+		}
+		ctx.restore.Status.Phase = velerov1api.RestorePhaseFailedValidation
+		return warnings, errs
+	}
+	for _, err := range ctx.validateResourceNames(backupResources, ctx.restore.Spec.ExcludedResources) {
+		ctx.log.WithError(err).Warn("Excluded resource not found in the cluster or the backup")
+	}
 
 	// TODO: Remove outer feature flag check to make this feature a default in Velero.
 	if features.IsEnabled(velerov1api.APIGroupVersionsFeatureFlag) {
@@ -537,6 +657,9 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 	}
 
 	for _, selectedResource := range crdResourceCollection {
+		if ctx.itemQuarantine.Exceeded() {
+			break
+		}
 		var w, e results.Result
 		// Restore this resource, the update channel is set to nil, to avoid misleading value of "totalItems"
 		// more details see #5990
@@ -578,6 +701,11 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 	)
 	warnings.Merge(&w)
 	errs.Merge(&e)
+	selectedResourceCollection = orderByNamespacePriority(selectedResourceCollection, ctx.restore.Spec.NamespacePriority)
+	if boolptr.IsSetToTrue(ctx.restore.Spec.OrderByDependencies) {
+		selectedResourceCollection = ctx.orderByDependencies(selectedResourceCollection)
+	}
+	ctx.selectedItems = selectedItemKeys(selectedResourceCollection)
 
 	// initialize informer caches for selected resources if enabled
 	if !ctx.disableInformerCache {
@@ -606,6 +734,14 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 		ctx.dynamicInformerFactory.factory.WaitForCacheSync(ctx.dynamicInformerFactory.context.Done())
 	}
 
+	// scale the existing workloads the restore overwrites down, so their pods release the
+	// volumes before the restored pods need them
+	if boolptr.IsSetToTrue(ctx.restore.Spec.ScaleDownConflictingWorkloads) && ctx.restore.Spec.ExistingResourcePolicy == velerov1api.PolicyTypeUpdate &&
+		!ctx.dryRun() {
+		w := ctx.scaleDownConflictingWorkloads(selectedResourceCollection)
+		warnings.Merge(&w)
+	}
+
 	// reset processedItems and totalItems before processing full resource list
 	processedItems = 0
 	totalItems = 0
@@ -614,6 +750,12 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 	}
 
 	for _, selectedResource := range selectedResourceCollection {
+		// stop restoring items once more items failed than the error budget allows,
+		// the restore is aborted
+		if ctx.itemQuarantine.Exceeded() {
+			ctx.log.Errorf("%d items failed, exceeding the errorBudget of %d, aborting the restore", ctx.itemQuarantine.Failed(), ctx.itemQuarantine.Budget())
+			break
+		}
 		var w, e results.Result
 		// Restore this resource
 		processedItems, w, e = ctx.processSelectedResource(
@@ -627,6 +769,12 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 		errs.Merge(&e)
 	}
 
+	// scale the workloads which were scaled down but not overwritten back up
+	if len(ctx.scaledDownWorkloads) > 0 {
+		w := ctx.restoreScaledDownWorkloads()
+		warnings.Merge(&w)
+	}
+
 	// Close the progress update channel.
 	quit <- struct{}{}
 
@@ -645,6 +793,8 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 		}
 	}()
 
+	ctx.restore.Status.APIVersionDrift = ctx.apiVersionDriftSummary()
+
 	// Do a final progress update as stopping the ticker might have left last few
 	// updates from taking place.
 	updated := ctx.restore.DeepCopy()
@@ -682,6 +832,22 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 	}
 	ctx.log.Info("Done waiting for all pod volume restores to complete")
 
+	if len(ctx.gradualDeployments) > 0 {
+		ctx.log.Info("Scaling the restored Deployments up gradually")
+		w := ctx.scaleUpDeploymentsGradually()
+		warnings.Merge(&w)
+	}
+
+	if len(ctx.loadBalancerServices) > 0 && !ctx.dryRun() {
+		w := ctx.reportLoadBalancerAddresses()
+		warnings.Merge(&w)
+	}
+
+	if !ctx.dryRun() {
+		w := ctx.buildMappingReport()
+		warnings.Merge(&w)
+	}
+
 	return warnings, errs
 }
 
@@ -722,30 +888,50 @@ func (ctx *restoreContext) processSelectedResource(
 					archive.GetItemFilePath(ctx.restoreDir, "namespaces", "", namespace),
 					targetNS,
 				)
-				_, nsCreated, err := kube.EnsureNamespaceExistsAndIsReady(
-					ns,
-					ctx.namespaceClient,
-					ctx.resourceTerminatingTimeout,
-					ctx.resourceDeletionStatusTracker,
-				)
-				if err != nil {
-					errs.AddVeleroError(err)
-					continue
-				}
+				if boolptr.IsSetToTrue(ctx.restore.Spec.Preview) {
+					if err := ctx.previewNamespace(ns); err != nil {
+						errs.Add(targetNS, err)
+						continue
+					}
+					existingNamespaces.Insert(targetNS)
+				} else if boolptr.IsSetToTrue(ctx.restore.Spec.ServerDryRun) {
+					if err := ctx.serverDryRunNamespace(ns); err != nil {
+						errs.Add(targetNS, err)
+						continue
+					}
+					existingNamespaces.Insert(targetNS)
+				} else {
+					_, nsCreated, err := kube.EnsureNamespaceExistsAndIsReady(
+						ns,
+						ctx.namespaceClient,
+						ctx.resourceTerminatingTimeout,
+						ctx.resourceDeletionStatusTracker,
+					)
+					if err != nil {
+						errs.AddVeleroError(err)
+						continue
+					}
+					if err := ctx.reconcileNamespaceMetadata(namespace, targetNS, nsCreated); err != nil {
+						warnings.Add(targetNS, err)
+					}
+					if err := ctx.protectNamespace(targetNS); err != nil {
+						warnings.Add(targetNS, err)
+					}
 
-				// Add the newly created namespace to the list of restored items.
-				if nsCreated {
-					itemKey := itemKey{
-						resource:  resourceKey(ns),
-						namespace: ns.Namespace,
-						name:      ns.Name,
+					// Add the newly created namespace to the list of restored items.
+					if nsCreated {
+						itemKey := itemKey{
+							resource:  resourceKey(ns),
+							namespace: ns.Namespace,
+							name:      ns.Name,
+						}
+						ctx.restoredItems[itemKey] = restoredItemStatus{action: ItemRestoreResultCreated, itemExists: true}
 					}
-					ctx.restoredItems[itemKey] = restoredItemStatus{action: ItemRestoreResultCreated, itemExists: true}
-				}
 
-				// Keep track of namespaces that we know exist so we don't
-				// have to try to create them multiple times.
-				existingNamespaces.Insert(targetNS)
+					// Keep track of namespaces that we know exist so we don't
+					// have to try to create them multiple times.
+					existingNamespaces.Insert(targetNS)
+				}
 			}
 			// For namespaces resources we don't need to following steps
 			if groupResource == kuberesource.Namespaces {
@@ -754,22 +940,38 @@ func (ctx *restoreContext) processSelectedResource(
 
 			obj, err := archive.Unmarshal(ctx.fileSystem, selectedItem.path)
 			if err != nil {
-				errs.Add(
-					selectedItem.targetNamespace,
-					fmt.Errorf(
-						"error decoding %q: %v",
-						strings.Replace(selectedItem.path, ctx.restoreDir+"/", "", -1),
-						err,
-					),
+				err = fmt.Errorf(
+					"error decoding %q: %v",
+					strings.Replace(selectedItem.path, ctx.restoreDir+"/", "", -1),
+					err,
 				)
+				errs.Add(selectedItem.targetNamespace, err)
+				if ctx.itemQuarantine.Add(groupResource, selectedItem.targetNamespace, selectedItem.name, err) {
+					return processedItems, warnings, errs
+				}
 				continue
 			}
 
+			var restoredObj *unstructured.Unstructured
+			if selectedItem.waitCondition != nil {
+				restoredObj = obj.DeepCopy()
+			}
 			w, e, _ := ctx.restoreItem(obj, groupResource, targetNS)
 			warnings.Merge(&w)
 			errs.Merge(&e)
 			processedItems++
 
+			// the items depending on this one are restored once it meets the wait condition
+			if restoredObj != nil && e.IsEmpty() && !ctx.dryRun() {
+				if err := ctx.waitForDependencyCondition(restoredObj, groupResource, targetNS, selectedItem.waitCondition); err != nil {
+					warnings.Add(targetNS, err)
+				}
+			}
+
+			if !e.IsEmpty() && ctx.itemQuarantine.Add(groupResource, targetNS, obj.GetName(), firstError(e)) {
+				return processedItems, warnings, errs
+			}
+
 			// totalItems keeps the count of items previously known. There
 			// may be additional items restored by plugins. We want to include
 			// the additional items by looking at restoredItems at the same
@@ -794,6 +996,23 @@ func (ctx *restoreContext) processSelectedResource(
 	return processedItems, warnings, errs
 }
 
+// firstError returns the first of the errors an item failed to be restored with, in the
+// results they are collected as messages.
+func firstError(errs results.Result) error {
+	if len(errs.Velero) > 0 {
+		return errors.New(errs.Velero[0])
+	}
+	if len(errs.Cluster) > 0 {
+		return errors.New(errs.Cluster[0])
+	}
+	for _, messages := range errs.Namespaces {
+		if len(messages) > 0 {
+			return errors.New(messages[0])
+		}
+	}
+	return errors.New("unknown error")
+}
+
 // getNamespace returns a namespace API object that we should attempt to
 // create before restoring anything into it. It will come from the backup
 // tarball if it exists, else will be a new one. If from the tarball, it
@@ -1110,6 +1329,15 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	// that's excluded.
 	if !ctx.resourceIncludesExcludes.ShouldInclude(groupResource.String()) && !ctx.resourceMustHave.Has(groupResource.String()) {
 		restoreLogger.Info("Not restoring item because resource is excluded")
+		ctx.addPreviewItem(groupResource.String(), namespace, backupResourceName, velerov1api.PreviewActionSkip, "the resource is excluded")
+		return warnings, errs, itemExists
+	}
+
+	// Check if the item is excluded from the restore, here too since this method may be
+	// getting called for an additional item.
+	if reason := ctx.excludedFromRestore(obj); reason != "" && !ctx.resourceMustHave.Has(groupResource.String()) {
+		restoreLogger.Infof("Not restoring item because %s", reason)
+		ctx.addPreviewItem(groupResource.String(), namespace, backupResourceName, velerov1api.PreviewActionSkip, reason)
 		return warnings, errs, itemExists
 	}
 
@@ -1122,6 +1350,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	if namespace != "" {
 		if !ctx.namespaceIncludesExcludes.ShouldInclude(obj.GetNamespace()) && !ctx.resourceMustHave.Has(groupResource.String()) {
 			restoreLogger.Info("Not restoring item because namespace is excluded")
+			ctx.addPreviewItem(groupResource.String(), namespace, backupResourceName, velerov1api.PreviewActionSkip, "the namespace is excluded")
 			return warnings, errs, itemExists
 		}
 
@@ -1129,23 +1358,42 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		// namespace into which the resource is being restored into exists.
 		// This is the *remapped* namespace that we are ensuring exists.
 		nsToEnsure := getNamespace(restoreLogger, archive.GetItemFilePath(ctx.restoreDir, "namespaces", "", obj.GetNamespace()), namespace)
-		_, nsCreated, err := kube.EnsureNamespaceExistsAndIsReady(nsToEnsure, ctx.namespaceClient, ctx.resourceTerminatingTimeout, ctx.resourceDeletionStatusTracker)
-		if err != nil {
-			errs.AddVeleroError(err)
-			return warnings, errs, itemExists
-		}
-		// Add the newly created namespace to the list of restored items.
-		if nsCreated {
-			itemKey := itemKey{
-				resource:  resourceKey(nsToEnsure),
-				namespace: nsToEnsure.Namespace,
-				name:      nsToEnsure.Name,
+		if boolptr.IsSetToTrue(ctx.restore.Spec.Preview) {
+			if err := ctx.previewNamespace(nsToEnsure); err != nil {
+				errs.Add(namespace, err)
+				return warnings, errs, itemExists
+			}
+		} else if boolptr.IsSetToTrue(ctx.restore.Spec.ServerDryRun) {
+			if err := ctx.serverDryRunNamespace(nsToEnsure); err != nil {
+				errs.Add(namespace, err)
+				return warnings, errs, itemExists
+			}
+		} else {
+			_, nsCreated, err := kube.EnsureNamespaceExistsAndIsReady(nsToEnsure, ctx.namespaceClient, ctx.resourceTerminatingTimeout, ctx.resourceDeletionStatusTracker)
+			if err != nil {
+				errs.AddVeleroError(err)
+				return warnings, errs, itemExists
+			}
+			if err := ctx.reconcileNamespaceMetadata(obj.GetNamespace(), namespace, nsCreated); err != nil {
+				warnings.Add(namespace, err)
+			}
+			if err := ctx.protectNamespace(namespace); err != nil {
+				warnings.Add(namespace, err)
+			}
+			// Add the newly created namespace to the list of restored items.
+			if nsCreated {
+				itemKey := itemKey{
+					resource:  resourceKey(nsToEnsure),
+					namespace: nsToEnsure.Namespace,
+					name:      nsToEnsure.Name,
+				}
+				ctx.restoredItems[itemKey] = restoredItemStatus{action: ItemRestoreResultCreated, itemExists: true}
 			}
-			ctx.restoredItems[itemKey] = restoredItemStatus{action: ItemRestoreResultCreated, itemExists: true}
 		}
 	} else {
 		if boolptr.IsSetToFalse(ctx.restore.Spec.IncludeClusterResources) {
 			restoreLogger.Info("Not restoring item because it's cluster-scoped")
+			ctx.addPreviewItem(groupResource.String(), namespace, backupResourceName, velerov1api.PreviewActionSkip, "the cluster-scoped resources are excluded")
 			return warnings, errs, itemExists
 		}
 	}
@@ -1153,6 +1401,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	// Make a copy of object retrieved from backup to make it available unchanged
 	//inside restore actions.
 	itemFromBackup := obj.DeepCopy()
+	sourceAPIVersion := ctx.sourceAPIVersion(obj, groupResource)
 
 	complete, err := isCompleted(obj, groupResource)
 	if err != nil {
@@ -1161,9 +1410,29 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	}
 	if complete {
 		restoreLogger.Infof("%s is complete - skipping", kube.NamespaceAndName(obj))
+		ctx.addPreviewItem(groupResource.String(), namespace, backupResourceName, velerov1api.PreviewActionSkip, "it's complete")
 		return warnings, errs, itemExists
 	}
 
+	// Convert the item to the API version replacing its own one if the cluster doesn't serve it
+	// anymore.
+	converted, convertedGroupResource, err := ctx.convertDeprecatedAPIVersion(obj)
+	if err != nil {
+		ctx.restore.Status.UnconvertibleItems++
+		if _, ok := err.(*conversion.RemovedError); ok {
+			warnings.Add(namespace, err)
+		} else {
+			errs.Add(namespace, err)
+		}
+		return warnings, errs, itemExists
+	}
+	if converted != nil {
+		restoreLogger.Infof("Converted %s from %s to %s", resourceID, obj.GetAPIVersion(), converted.GetAPIVersion())
+		warnings.Add(namespace, fmt.Errorf("converted %s from %s to %s", resourceID, obj.GetAPIVersion(), converted.GetAPIVersion()))
+		ctx.restore.Status.ConvertedItems++
+		obj, groupResource = converted, convertedGroupResource
+	}
+
 	// Check if we've already restored this itemKey.
 	itemKey := itemKey{
 		resource:  resourceKey(obj),
@@ -1176,6 +1445,12 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		return warnings, errs, itemExists
 	}
 	ctx.restoredItems[itemKey] = restoredItemStatus{itemExists: itemExists}
+	// registered before the deferred function setting the restore result of the item so it runs after it
+	defer func() {
+		if action := ctx.restoredItems[itemKey].action; action == ItemRestoreResultCreated || action == ItemRestoreResultUpdated {
+			ctx.trackAPIVersionDrift(restoreLogger, resourceID, obj.GetKind(), sourceAPIVersion, obj.GetAPIVersion())
+		}
+	}()
 	defer func() {
 		itemStatus := ctx.restoredItems[itemKey]
 		// the action field is set explicitly
@@ -1197,9 +1472,41 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	// to the interface.
 	if groupResource == kuberesource.Pods && obj.GetAnnotations()[v1.MirrorPodAnnotationKey] != "" {
 		restoreLogger.Infof("Not restoring pod because it's a mirror pod")
+		ctx.addPreviewItem(groupResource.String(), namespace, backupResourceName, velerov1api.PreviewActionSkip, "it's a mirror pod")
 		return warnings, errs, itemExists
 	}
 
+	// the ownerReferences are read before they're reset
+	if groupResource == kuberesource.Deployments || groupResource == kuberesource.ReplicaSets || groupResource == kuberesource.Pods {
+		reason, err := ctx.reconcileDeploymentHistory(obj, groupResource, namespace, itemKey)
+		if err != nil {
+			errs.Add(namespace, errors.Wrapf(err, "error reconciling the Deployment history of %s", resourceID))
+			return warnings, errs, itemExists
+		}
+		if reason != "" {
+			restoreLogger.Infof("Not restoring %s because %s", resourceID, reason)
+			ctx.addPreviewItem(groupResource.String(), namespace, backupResourceName, velerov1api.PreviewActionSkip, reason)
+			return warnings, errs, itemExists
+		}
+	}
+
+	// the load balancer addresses of the backup are read before the status is reset
+	if groupResource == kuberesource.Services {
+		if err := ctx.prepareLoadBalancerService(obj, itemKey); err != nil {
+			errs.Add(namespace, errors.Wrapf(err, "error applying the load balancer policy to %s", resourceID))
+			return warnings, errs, itemExists
+		}
+	}
+
+	// the identifiers of the backup are read before the restore item actions change them
+	backupNamespace, backupUID := obj.GetNamespace(), obj.GetUID()
+	switch groupResource {
+	case kuberesource.Services:
+		ctx.mapServiceNodePorts(obj, itemKey)
+	case kuberesource.PersistentVolumeClaims:
+		ctx.mapPersistentVolumeClaim(obj, itemKey)
+	}
+
 	if groupResource == kuberesource.PersistentVolumes {
 		resourceClient, err := ctx.getResourceClient(groupResource, obj, namespace)
 		if err != nil {
@@ -1212,6 +1519,10 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 			switch volumeInfo.BackupMethod {
 			case volume.NativeSnapshot:
+				if ctx.dryRun() {
+					restoreLogger.Info("Not restoring the snapshot of the persistent volume in a dry-run.")
+					break
+				}
 				obj, err = ctx.handlePVHasNativeSnapshot(obj, resourceClient)
 				if err != nil {
 					errs.Add(namespace, err)
@@ -1224,6 +1535,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 				// Return early because we don't want to restore the PV itself, we
 				// want to dynamically re-provision it.
+				ctx.addPreviewItem(groupResource.String(), namespace, backupResourceName, velerov1api.PreviewActionSkip, "dynamically re-provisioned")
 				return warnings, errs, itemExists
 
 			case volume.CSISnapshot:
@@ -1232,6 +1544,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 				// Return early because we don't want to restore the PV itself, we
 				// want to dynamically re-provision it.
+				ctx.addPreviewItem(groupResource.String(), namespace, backupResourceName, velerov1api.PreviewActionSkip, "dynamically re-provisioned")
 				return warnings, errs, itemExists
 
 			// When the PV data is skipped from backup, it's BackupVolumeInfo BackupMethod
@@ -1243,6 +1556,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 					// Return early because we don't want to restore the PV itself, we
 					// want to dynamically re-provision it.
+					ctx.addPreviewItem(groupResource.String(), namespace, backupResourceName, velerov1api.PreviewActionSkip, "dynamically re-provisioned")
 					return warnings, errs, itemExists
 				} else {
 					obj, err = ctx.handleSkippedPVHasRetainPolicy(obj, restoreLogger)
@@ -1259,6 +1573,10 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 			switch {
 			case hasSnapshot(backupResourceName, ctx.volumeSnapshots):
+				if ctx.dryRun() {
+					restoreLogger.Info("Not restoring the snapshot of the persistent volume in a dry-run.")
+					break
+				}
 				obj, err = ctx.handlePVHasNativeSnapshot(obj, resourceClient)
 				if err != nil {
 					errs.Add(namespace, err)
@@ -1271,6 +1589,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 				// Return early because we don't want to restore the PV itself, we
 				// want to dynamically re-provision it.
+				ctx.addPreviewItem(groupResource.String(), namespace, backupResourceName, velerov1api.PreviewActionSkip, "dynamically re-provisioned")
 				return warnings, errs, itemExists
 
 			case hasCSIVolumeSnapshot(ctx, obj):
@@ -1281,6 +1600,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 				// Return early because we don't want to restore the PV itself, we
 				// want to dynamically re-provision it.
+				ctx.addPreviewItem(groupResource.String(), namespace, backupResourceName, velerov1api.PreviewActionSkip, "dynamically re-provisioned")
 				return warnings, errs, itemExists
 
 			case hasDeleteReclaimPolicy(obj.Object):
@@ -1289,6 +1609,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 				// Return early because we don't want to restore the PV itself, we
 				// want to dynamically re-provision it.
+				ctx.addPreviewItem(groupResource.String(), namespace, backupResourceName, velerov1api.PreviewActionSkip, "dynamically re-provisioned")
 				return warnings, errs, itemExists
 
 			default:
@@ -1315,6 +1636,11 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			continue
 		}
 
+		if ctx.dryRun() && slices.Contains(serverDryRunSkippedActionResources, groupResource) {
+			restoreLogger.Infof("Skip action %s for resource %s:%s/%s in a dry-run.", action.Name(), groupResource.String(), obj.GetNamespace(), obj.GetName())
+			continue
+		}
+
 		// If the EnableCSI feature is not enabled, but the executing action is from CSI plugin, skip the action.
 		if csiutil.ShouldSkipAction(action.Name()) {
 			restoreLogger.Infof("Skip action %s for resource %s:%s/%s, because the CSI feature is not enabled. Feature setting is %s.",
@@ -1359,6 +1685,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		}
 		if executeOutput.SkipRestore {
 			restoreLogger.Infof("Skipping restore because a registered plugin discarded it")
+			ctx.addPreviewItem(groupResource.String(), namespace, backupResourceName, velerov1api.PreviewActionSkip, fmt.Sprintf("the restore item action %s discarded it", action.Name()))
 			return warnings, errs, itemExists
 		}
 		unstructuredObj, ok := executeOutput.UpdatedItem.(*unstructured.Unstructured)
@@ -1406,11 +1733,14 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			errs.Merge(&e)
 		}
 		executeOutput.AdditionalItems = filteredAdditionalItems
-		available, err := ctx.itemsAvailable(action, executeOutput)
-		if err != nil {
-			errs.Add(namespace, errors.Wrapf(err, "error verifying additional items are ready to use"))
-		} else if !available {
-			errs.Add(namespace, fmt.Errorf("additional items for %s are not ready to use", resourceID))
+		// the additional items aren't created in a dry-run
+		if !ctx.dryRun() {
+			available, err := ctx.itemsAvailable(action, executeOutput)
+			if err != nil {
+				errs.Add(namespace, errors.Wrapf(err, "error verifying additional items are ready to use"))
+			} else if !available {
+				errs.Add(namespace, fmt.Errorf("additional items for %s are not ready to use", resourceID))
+			}
 		}
 	}
 
@@ -1448,10 +1778,29 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				return warnings, errs, itemExists
 			}
 		}
+
+		warning, err := ctx.resetPVCDataSource(obj, itemFromBackup, restoreLogger)
+		if err != nil {
+			errs.Add(namespace, err)
+			return warnings, errs, itemExists
+		}
+		if warning != "" {
+			warnings.Add(namespace, errors.New(warning))
+		}
+	}
+
+	if ctx.namespaceReferenceRewriter != nil {
+		if rewritten := ctx.namespaceReferenceRewriter.Rewrite(obj, groupResource.String()); rewritten > 0 {
+			restoreLogger.Infof("Rewrote %d references to the mapped namespaces", rewritten)
+		}
 	}
 
 	if ctx.resourceModifiers != nil {
-		if errList := ctx.resourceModifiers.ApplyResourceModifierRules(obj, groupResource.String(), ctx.kbClient.Scheme(), restoreLogger); errList != nil {
+		if errList := ctx.resourceModifiers.ApplyResourceModifierRules(obj, groupResource.String(), ctx.kbClient.Scheme(), &resourcemodifiers.TemplateData{
+			RestoreName:      ctx.restore.Name,
+			TargetCluster:    ctx.restore.Spec.TargetCluster,
+			NamespaceMapping: ctx.restore.Spec.NamespaceMapping,
+		}, restoreLogger); errList != nil {
 			for _, err := range errList {
 				errs.Add(namespace, err)
 			}
@@ -1465,6 +1814,16 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		obj.SetNamespace(namespace)
 	}
 
+	if ctx.quotaReconciler != nil && namespace != "" {
+		message, err := ctx.quotaReconciler.reconcile(obj, groupResource, namespace, restoreLogger)
+		if err != nil {
+			warnings.Add(namespace, errors.Wrap(err, "error reconciling with the resource quotas"))
+		} else if message != "" {
+			warnings.Add(namespace, errors.New(message))
+			ctx.restore.Status.QuotaCappedItems++
+		}
+	}
+
 	// Label the resource with the restore's name and the restored backup's name
 	// for easy identification of all cluster resources created by this restore
 	// and which backup they came from.
@@ -1494,6 +1853,33 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		return warnings, errs, itemExists
 	}
 
+	if boolptr.IsSetToTrue(ctx.restore.Spec.Preview) {
+		if err := ctx.previewItem(obj, newGR, resourceClient, obj.GetNamespace()); err != nil {
+			errs.Add(namespace, err)
+		}
+		return warnings, errs, itemExists
+	}
+
+	if boolptr.IsSetToTrue(ctx.restore.Spec.ServerDryRun) {
+		w, e := ctx.serverDryRunItem(obj, resourceClient, obj.GetNamespace(), restoreLogger)
+		warnings.Merge(&w)
+		errs.Merge(&e)
+		return warnings, errs, itemExists
+	}
+
+	// wait for the volumes the pod mounts to be detached from the nodes they're still attached
+	// to, the pod is restored anyway if they aren't
+	if newGR == kuberesource.Pods && ctx.restore.Spec.VolumeDetachPolicy != "" {
+		pod := new(v1.Pod)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pod); err != nil {
+			errs.Add(namespace, err)
+			return warnings, errs, itemExists
+		}
+		if err := ctx.waitForVolumesDetached(pod, restoreLogger); err != nil {
+			warnings.Add(namespace, err)
+		}
+	}
+
 	restoreLogger.Infof("Attempting to restore %s: %s.", obj.GroupVersionKind().Kind, obj.GetName())
 
 	// check if we want to treat the error as a warning, in some cases the creation call might not get executed due to object API validations
@@ -1514,6 +1900,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		if restoreErr == nil {
 			itemExists = true
 			ctx.restoredItems[itemKey] = restoredItemStatus{action: ItemRestoreResultCreated, itemExists: itemExists}
+			ctx.mapItemUID(itemKey, newGR, backupNamespace, backupUID, createdObj)
 		}
 	}
 
@@ -1544,6 +1931,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		itemStatus := ctx.restoredItems[itemKey]
 		itemStatus.itemExists = itemExists
 		ctx.restoredItems[itemKey] = itemStatus
+		ctx.mapItemUID(itemKey, newGR, backupNamespace, backupUID, fromCluster)
 		// Remove insubstantial metadata.
 		fromCluster, err = resetMetadataAndStatus(fromCluster)
 		if err != nil {
@@ -1612,13 +2000,29 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 						// existingResourcePolicy is set as update, attempt patch on the resource and add warning if it fails
 					} else if resourcePolicy == velerov1api.PolicyTypeUpdate {
 						// processing update as existingResourcePolicy
-						warningsFromUpdateRP, errsFromUpdateRP := ctx.processUpdateResourcePolicy(fromCluster, fromClusterWithLabels, obj, namespace, resourceClient)
+						warningsFromUpdateRP, errsFromUpdateRP := ctx.processUpdateResourcePolicy(fromCluster, fromClusterWithLabels, obj, newGR, namespace, resourceClient)
 						if warningsFromUpdateRP.IsEmpty() && errsFromUpdateRP.IsEmpty() {
 							itemStatus.action = ItemRestoreResultUpdated
 							ctx.restoredItems[itemKey] = itemStatus
 						}
 						warnings.Merge(&warningsFromUpdateRP)
 						errs.Merge(&errsFromUpdateRP)
+					} else if resourcePolicy == velerov1api.PolicyTypeReconcile {
+						warningsFromReconcile, errsFromReconcile, repaired := ctx.processReconcileResourcePolicy(fromCluster, obj, newGR.String(), namespace, resourceClient)
+						if repaired {
+							itemStatus.action = ItemRestoreResultUpdated
+							ctx.restoredItems[itemKey] = itemStatus
+						}
+						warnings.Merge(&warningsFromReconcile)
+						errs.Merge(&errsFromReconcile)
+					} else if resourcePolicy == velerov1api.PolicyTypeSSA {
+						warningsFromSSA, errsFromSSA, applied := ctx.processSSAResourcePolicy(fromCluster, obj, namespace, resourceClient)
+						if applied {
+							itemStatus.action = ItemRestoreResultUpdated
+							ctx.restoredItems[itemKey] = itemStatus
+						}
+						warnings.Merge(&warningsFromSSA)
+						errs.Merge(&errsFromSSA)
 					}
 				} else {
 					// Preserved Velero behavior when existingResourcePolicy is not specified by the user
@@ -1982,11 +2386,12 @@ func hasCSIVolumeSnapshot(ctx *restoreContext, unstructuredPV *unstructured.Unst
 
 	for _, vs := range ctx.csiVolumeSnapshots {
 		// In some error cases, the VSs' source PVC could be nil. Skip them.
-		if vs.Spec.Source.PersistentVolumeClaimName == nil {
+		sourcePVC := csiutil.GetVolumeSnapshotSourcePVC(vs)
+		if sourcePVC == "" {
 			continue
 		}
 
-		if pv.Spec.ClaimRef.Name == *vs.Spec.Source.PersistentVolumeClaimName &&
+		if pv.Spec.ClaimRef.Name == sourcePVC &&
 			pv.Spec.ClaimRef.Namespace == vs.Namespace {
 			return true
 		}
@@ -2175,6 +2580,9 @@ type restoreableItem struct {
 	targetNamespace string
 	name            string
 	version         string // used for initializing informer cache
+	// waitCondition is the condition the restored item must meet before the items depending on
+	// it are restored, when ordering by dependencies.
+	waitCondition *velerov1api.DependencyWaitCondition
 }
 
 // getOrderedResourceCollection iterates over list of ordered resource
@@ -2316,6 +2724,10 @@ func (ctx *restoreContext) getSelectedRestoreableItems(resource string, original
 	}
 
 	for _, item := range items {
+		if !ctx.resourceMustHave.Has(resource) && !ctx.isItemIncluded(resource, originalNamespace, item) {
+			continue
+		}
+
 		itemPath := archive.GetItemFilePath(ctx.restoreDir, resourceForPath, originalNamespace, item)
 
 		obj, err := archive.Unmarshal(ctx.fileSystem, itemPath)
@@ -2356,6 +2768,11 @@ func (ctx *restoreContext) getSelectedRestoreableItems(resource string, original
 				ctx.log.Infof("restore orSelector labels did not match, skipping restore of item: %s", skipItem, item)
 				continue
 			}
+
+			if reason := ctx.excludedFromRestore(obj); reason != "" {
+				ctx.log.Infof("Skipping restore of item %s because %s", item, reason)
+				continue
+			}
 		}
 
 		selectedItem := restoreableItem{
@@ -2371,6 +2788,22 @@ func (ctx *restoreContext) getSelectedRestoreableItems(resource string, original
 	return restorable, warnings, errs
 }
 
+// excludedFromRestore returns why the item of the backup is excluded from the restore by the
+// velero.io/exclude-from-restore label or annotation or by the exclude label selector, or an
+// empty string if it isn't.
+func (ctx *restoreContext) excludedFromRestore(obj *unstructured.Unstructured) string {
+	if obj.GetLabels()[velerov1api.ExcludeFromRestoreLabel] == "true" {
+		return fmt.Sprintf("the item has the label %s=true", velerov1api.ExcludeFromRestoreLabel)
+	}
+	if obj.GetAnnotations()[velerov1api.ExcludeFromRestoreLabel] == "true" {
+		return fmt.Sprintf("the item has the annotation %s=true", velerov1api.ExcludeFromRestoreLabel)
+	}
+	if ctx.excludeSelector != nil && ctx.excludeSelector.Matches(labels.Set(obj.GetLabels())) {
+		return "the item matches the exclude label selector"
+	}
+	return ""
+}
+
 // removeRestoreLabels removes the restore name and the
 // restored backup's name.
 func removeRestoreLabels(obj metav1.Object) {
@@ -2414,12 +2847,27 @@ func (ctx *restoreContext) updateBackupRestoreLabels(fromCluster, fromClusterWit
 }
 
 // function to process existingResourcePolicy as update, tries to patch the diff between in-cluster and restore obj first
-// if the patch fails then tries to update the backup/restore labels for the in-cluster version
-func (ctx *restoreContext) processUpdateResourcePolicy(fromCluster, fromClusterWithLabels, obj *unstructured.Unstructured, namespace string, resourceClient client.Dynamic) (warnings, errs results.Result) {
+// if the patch fails then tries to update the backup/restore labels for the in-cluster version.
+// The immutable fields are handled first according to the immutable field policy of the resource, if any.
+func (ctx *restoreContext) processUpdateResourcePolicy(fromCluster, fromClusterWithLabels, obj *unstructured.Unstructured, groupResource schema.GroupResource, namespace string, resourceClient client.Dynamic) (warnings, errs results.Result) {
 	ctx.log.Infof("restore API has existingResourcePolicy defined as update , executing restore workflow accordingly for changed resource %s %s ", obj.GroupVersionKind().Kind, kube.NamespaceAndName(fromCluster))
-	ctx.log.Infof("attempting patch on %s %q", fromCluster.GetKind(), fromCluster.GetName())
 	// remove restore labels so that we apply the latest backup/restore names on the object via patch
 	removeRestoreLabels(fromCluster)
+
+	if policy := ctx.immutableFieldPolicy(groupResource); policy != "" {
+		desired, err := ctx.processImmutableFields(fromCluster, obj, groupResource, policy, resourceClient)
+		if err != nil {
+			ctx.log.Errorf("error handling the immutable fields of %s %s: %v", obj.GroupVersionKind().Kind, kube.NamespaceAndName(obj), err)
+			errs.Add(namespace, err)
+			return warnings, errs
+		}
+		if desired == nil {
+			return warnings, errs
+		}
+		obj = desired
+	}
+
+	ctx.log.Infof("attempting patch on %s %q", fromCluster.GetKind(), fromCluster.GetName())
 	patchBytes, err := generatePatch(fromCluster, obj)
 	if err != nil {
 		ctx.log.Errorf("error generating patch for %s %s: %v", obj.GroupVersionKind().Kind, kube.NamespaceAndName(obj), err)
@@ -2589,3 +3037,26 @@ func determineRestoreStatus(
 
 	return shouldRestoreStatus
 }
+
+// convertDeprecatedAPIVersion returns the item converted to the API version replacing its own one
+// when the cluster doesn't serve it anymore, nil if it doesn't need or have a conversion.
+func (ctx *restoreContext) convertDeprecatedAPIVersion(obj *unstructured.Unstructured) (*unstructured.Unstructured, schema.GroupResource, error) {
+	gvk := obj.GroupVersionKind()
+	if gvr, _, err := ctx.discoveryHelper.KindFor(gvk); err == nil && gvr.Version == gvk.Version {
+		return nil, schema.GroupResource{}, nil
+	}
+
+	conv, err := conversion.For(gvk)
+	if err != nil || conv == nil {
+		return nil, schema.GroupResource{}, err
+	}
+	if _, _, err := ctx.discoveryHelper.KindFor(conv.To); err != nil {
+		return nil, schema.GroupResource{}, errors.Errorf("neither %s nor %s of %s %s is served by the cluster", gvk.GroupVersion(), conv.To.GroupVersion(), gvk.Kind, obj.GetName())
+	}
+
+	converted, err := conv.Convert(obj)
+	if err != nil {
+		return nil, schema.GroupResource{}, err
+	}
+	return converted, conv.Resource, nil
+}
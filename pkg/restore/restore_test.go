@@ -315,6 +315,58 @@ func TestRestoreResourceFiltering(t *testing.T) {
 				test.Pods(): {"ns-1/pod-1", "ns-2/pod-2"},
 			},
 		},
+		{
+			name: "included items filter only restores those items",
+			restore: defaultRestore().IncludedItems(
+				velerov1api.RestoreItemSelector{Namespace: "ns-2", Resource: "po", Name: "pod-2"},
+				velerov1api.RestoreItemSelector{Resource: "persistentvolumes", Name: "pv-1"},
+				velerov1api.RestoreItemSelector{Namespace: "ns-1", Resource: "persistentvolumes", Name: "pv-2"},
+			).Result(),
+			backup: defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").Result(),
+					builder.ForPod("ns-2", "pod-2").Result(),
+				).
+				AddItems("persistentvolumes",
+					builder.ForPersistentVolume("pv-1").Result(),
+					builder.ForPersistentVolume("pv-2").Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+				test.PVs(),
+			},
+			want: map[*test.APIResource][]string{
+				test.Pods(): {"ns-2/pod-2"},
+				test.PVs():  {"/pv-1"},
+			},
+		},
+		{
+			name: "included PVC is restored with its bound PV",
+			restore: defaultRestore().IncludedItems(
+				velerov1api.RestoreItemSelector{Namespace: "ns-1", Resource: "persistentvolumeclaims", Name: "pvc-1"},
+			).Result(),
+			backup: defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("persistentvolumeclaims",
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result(),
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-2").VolumeName("pv-2").Result(),
+				).
+				AddItems("persistentvolumes",
+					builder.ForPersistentVolume("pv-1").Result(),
+					builder.ForPersistentVolume("pv-2").Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.PVCs(),
+				test.PVs(),
+			},
+			want: map[*test.APIResource][]string{
+				test.PVCs(): {"ns-1/pvc-1"},
+				test.PVs():  {"/pv-1"},
+			},
+		},
 		{
 			name:    "excluded resources filter only restores resources not of those types",
 			restore: defaultRestore().ExcludedResources("pvs").Result(),
@@ -480,6 +532,35 @@ func TestRestoreResourceFiltering(t *testing.T) {
 				test.PVs():         {"/pv-2"},
 			},
 		},
+		{
+			name:    "exclude label selector and exclude-from-restore label and annotation skip resources",
+			restore: defaultRestore().LabelSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"a": "b"}}).ExcludeLabelSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"poison": "true"}}).Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("a", "b")).Result(),
+					builder.ForPod("ns-2", "pod-2").ObjectMeta(builder.WithLabels("a", "b", "poison", "true")).Result(),
+				).
+				AddItems("deployments.apps",
+					builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithLabels("a", "b", velerov1api.ExcludeFromRestoreLabel, "true")).Result(),
+					builder.ForDeployment("ns-2", "deploy-2").ObjectMeta(builder.WithLabels("a", "b")).Result(),
+				).
+				AddItems("persistentvolumes",
+					builder.ForPersistentVolume("pv-1").ObjectMeta(builder.WithLabels("a", "b"), builder.WithAnnotations(velerov1api.ExcludeFromRestoreLabel, "true")).Result(),
+					builder.ForPersistentVolume("pv-2").ObjectMeta(builder.WithLabels("a", "b"), builder.WithAnnotations(velerov1api.ExcludeFromRestoreLabel, "false")).Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+				test.Deployments(),
+				test.PVs(),
+			},
+			want: map[*test.APIResource][]string{
+				test.Pods():        {"ns-1/pod-1"},
+				test.Deployments(): {"ns-2/deploy-2"},
+				test.PVs():         {"/pv-2"},
+			},
+		},
 		{
 			name:    "should include cluster-scoped resources if restoring subset of namespaces and IncludeClusterResources=true",
 			restore: defaultRestore().IncludedNamespaces("ns-1").IncludeClusterResources(true).Result(),
@@ -682,33 +763,6 @@ func TestRestoreResourceFiltering(t *testing.T) {
 				test.PVs():         {"/pv-1", "/pv-2"},
 			},
 		},
-		{
-			name:    "unresolvable included resources are ignored",
-			restore: defaultRestore().IncludedResources("pods", "unresolvable").Result(),
-			backup:  defaultBackup().Result(),
-			tarball: test.NewTarWriter(t).
-				AddItems("pods",
-					builder.ForPod("ns-1", "pod-1").Result(),
-					builder.ForPod("ns-2", "pod-2").Result(),
-				).
-				AddItems("deployments.apps",
-					builder.ForDeployment("ns-1", "deploy-1").Result(),
-					builder.ForDeployment("ns-2", "deploy-2").Result(),
-				).
-				AddItems("persistentvolumes",
-					builder.ForPersistentVolume("pv-1").Result(),
-					builder.ForPersistentVolume("pv-2").Result(),
-				).
-				Done(),
-			apiResources: []*test.APIResource{
-				test.Pods(),
-				test.Deployments(),
-				test.PVs(),
-			},
-			want: map[*test.APIResource][]string{
-				test.Pods(): {"ns-1/pod-1", "ns-2/pod-2"},
-			},
-		},
 		{
 			name:    "unresolvable excluded resources are ignored",
 			restore: defaultRestore().ExcludedResources("deployments", "unresolvable").Result(),
@@ -784,6 +838,74 @@ func TestRestoreResourceFiltering(t *testing.T) {
 	}
 }
 
+// TestRestoreUnknownResources runs restores including resources which are neither in the cluster
+// nor in the backup, and verifies that they fail validation without restoring anything.
+func TestRestoreUnknownResources(t *testing.T) {
+	tests := []struct {
+		name                 string
+		restore              *velerov1api.Restore
+		apiResources         []*test.APIResource
+		want                 map[*test.APIResource][]string
+		wantPhase            velerov1api.RestorePhase
+		wantValidationErrors []string
+	}{
+		{
+			name:                 "unresolvable included resource fails the validation of the restore",
+			restore:              defaultRestore().IncludedResources("pods", "unresolvable").Result(),
+			apiResources:         []*test.APIResource{test.Pods()},
+			want:                 map[*test.APIResource][]string{test.Pods(): {}},
+			wantPhase:            velerov1api.RestorePhaseFailedValidation,
+			wantValidationErrors: []string{`Invalid included resources: unknown resource "unresolvable"`},
+		},
+		{
+			name:                 "unknown included resource fails the validation of the restore with suggestions",
+			restore:              defaultRestore().IncludedResources("deploymnets", "po").Result(),
+			apiResources:         []*test.APIResource{test.Pods(), test.Deployments()},
+			want:                 map[*test.APIResource][]string{test.Pods(): {}},
+			wantPhase:            velerov1api.RestorePhaseFailedValidation,
+			wantValidationErrors: []string{`Invalid included resources: unknown resource "deploymnets", did you mean "deployments"?`},
+		},
+		{
+			name:         "included resource of the backup not in the cluster is restored",
+			restore:      defaultRestore().IncludedResources("pods", "widgets").ExcludedResources("gadgets").Result(),
+			apiResources: []*test.APIResource{test.Pods()},
+			want:         map[*test.APIResource][]string{test.Pods(): {"ns-1/pod-1"}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+
+			for _, r := range tc.apiResources {
+				h.DiscoveryClient.WithAPIResource(r)
+			}
+			require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+			data := &Request{
+				Log:     h.log,
+				Restore: tc.restore,
+				Backup:  defaultBackup().Result(),
+				BackupReader: test.NewTarWriter(t).
+					AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).
+					AddItems("widgets.example.com", builder.ForConfigMap("ns-1", "widget-1").Result()).
+					Done(),
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // restoreItemActions
+				nil, // volume snapshotter getter
+			)
+
+			assert.Empty(t, warnings.Velero)
+			assert.Empty(t, errs.Velero)
+			assert.Equal(t, tc.wantPhase, data.Restore.Status.Phase)
+			assert.Equal(t, tc.wantValidationErrors, data.Restore.Status.ValidationErrors)
+			assertAPIContents(t, h, tc.want)
+		})
+	}
+}
+
 // TestRestoreNamespaceMapping runs restores with namespace mappings specified,
 // and verifies that the set of items created in the API are in the correct
 // namespaces. Validation is done by looking at the namespaces/names of the items
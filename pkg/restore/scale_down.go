@@ -0,0 +1,237 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+// scaleDownWorkloads are the workloads which are scaled down before they're overwritten.
+var scaleDownWorkloads = []schema.GroupResource{kuberesource.Deployments, kuberesource.StatefulSets}
+
+// scaledDownWorkload is an existing workload scaled down before it's overwritten, with its
+// replicas before.
+type scaledDownWorkload struct {
+	gvr       schema.GroupVersionResource
+	resource  metav1.APIResource
+	namespace string
+	name      string
+	replicas  int64
+}
+
+// workloadPods are the pods of a workload scaled down, which are waited for to terminate.
+type workloadPods struct {
+	groupResource schema.GroupResource
+	namespace     string
+	name          string
+	podClient     client.Dynamic
+	selector      string
+}
+
+// scaleDownConflictingWorkloads scales the existing workloads the restore overwrites down to
+// zero replicas and waits for their pods to terminate, so the pods don't hold the volumes the
+// restored pods use. The pods of all the workloads are waited for at once, under a single
+// timeout. The workloads failing to be scaled down are reported as warnings.
+func (ctx *restoreContext) scaleDownConflictingWorkloads(selectedResources []restoreableResource) results.Result {
+	warnings := results.Result{}
+	var pods []*workloadPods
+
+	for _, selectedResource := range selectedResources {
+		groupResource := schema.ParseGroupResource(selectedResource.resource)
+		if !slices.Contains(scaleDownWorkloads, groupResource) {
+			continue
+		}
+		gvr, resource, err := ctx.discoveryHelper.ResourceFor(groupResource.WithVersion(""))
+		if err != nil {
+			warnings.AddVeleroError(errors.Wrapf(err, "error getting resource %s to scale down the existing workloads", groupResource))
+			continue
+		}
+
+		for _, items := range selectedResource.selectedItemsByNamespace {
+			for _, item := range items {
+				workloadPods, err := ctx.scaleDownWorkload(gvr, resource, item.targetNamespace, item.name)
+				if err != nil {
+					warnings.Add(item.targetNamespace, err)
+					continue
+				}
+				if workloadPods != nil {
+					pods = append(pods, workloadPods)
+				}
+			}
+		}
+	}
+
+	timeoutCtx, cancel := go_context.WithTimeout(go_context.Background(), ctx.resourceTerminatingTimeout)
+	defer cancel()
+
+	errs := make([]error, len(pods))
+	var wg sync.WaitGroup
+	for i, workloadPods := range pods {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = ctx.waitWorkloadPodsTerminated(timeoutCtx, workloadPods)
+		}()
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			warnings.Add(pods[i].namespace, err)
+		}
+	}
+
+	return warnings
+}
+
+// scaleDownWorkload scales the workload down to zero replicas, if it exists, and returns its
+// pods to wait for to terminate. Nil is returned if the workload doesn't exist.
+func (ctx *restoreContext) scaleDownWorkload(gvr schema.GroupVersionResource, resource metav1.APIResource, namespace, name string) (*workloadPods, error) {
+	client, err := ctx.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, namespace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting client for %s", gvr.GroupResource())
+	}
+
+	workload, err := client.Get(name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting %s %s/%s to scale it down", gvr.GroupResource(), namespace, name)
+	}
+
+	log := ctx.log.WithField("namespace", namespace).WithField("name", name).WithField("resource", gvr.GroupResource().String())
+	if replicas, found, _ := unstructured.NestedInt64(workload.Object, "spec", "replicas"); !found || replicas > 0 {
+		if !found {
+			// the replicas of the Deployments and StatefulSets default to 1
+			replicas = 1
+		}
+		if _, err := client.Patch(name, []byte(`{"spec":{"replicas":0}}`)); err != nil {
+			return nil, errors.Wrapf(err, "error scaling %s %s/%s down", gvr.GroupResource(), namespace, name)
+		}
+		ctx.scaledDownWorkloads = append(ctx.scaledDownWorkloads, scaledDownWorkload{
+			gvr:       gvr,
+			resource:  resource,
+			namespace: namespace,
+			name:      name,
+			replicas:  replicas,
+		})
+		log.Info("Scaled the existing workload down before overwriting it")
+	}
+
+	selectorMap, found, err := unstructured.NestedMap(workload.Object, "spec", "selector")
+	if err != nil || !found {
+		return nil, errors.Errorf("%s %s/%s has no pod selector", gvr.GroupResource(), namespace, name)
+	}
+	labelSelector := &metav1.LabelSelector{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(selectorMap, labelSelector); err != nil {
+		return nil, errors.Wrapf(err, "error parsing the pod selector of %s %s/%s", gvr.GroupResource(), namespace, name)
+	}
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing the pod selector of %s %s/%s", gvr.GroupResource(), namespace, name)
+	}
+
+	podClient, err := ctx.dynamicFactory.ClientForGroupVersionResource(schema.GroupVersion{Version: "v1"}, metav1.APIResource{Name: "pods", Namespaced: true}, namespace)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting pod client")
+	}
+
+	return &workloadPods{
+		groupResource: gvr.GroupResource(),
+		namespace:     namespace,
+		name:          name,
+		podClient:     podClient,
+		selector:      selector.String(),
+	}, nil
+}
+
+// waitWorkloadPodsTerminated waits for the pods of the workload scaled down to terminate, until
+// the context is done.
+func (ctx *restoreContext) waitWorkloadPodsTerminated(timeoutCtx go_context.Context, workloadPods *workloadPods) error {
+	err := wait.PollUntilContextCancel(timeoutCtx, time.Second, true, func(go_context.Context) (bool, error) {
+		pods, err := workloadPods.podClient.List(metav1.ListOptions{LabelSelector: workloadPods.selector})
+		if err != nil {
+			return false, errors.Wrap(err, "error listing pods")
+		}
+		return len(pods.Items) == 0, nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error waiting for the pods of %s %s/%s to terminate", workloadPods.groupResource, workloadPods.namespace, workloadPods.name)
+	}
+	ctx.log.WithField("namespace", workloadPods.namespace).WithField("name", workloadPods.name).WithField("resource", workloadPods.groupResource.String()).
+		Info("The pods of the existing workload terminated")
+	return nil
+}
+
+// restoreScaledDownWorkloads scales the workloads scaled down before they're overwritten back to
+// their replicas if the restore didn't overwrite them, because it failed to or was aborted. The
+// workloads failing to be scaled back are reported as warnings.
+func (ctx *restoreContext) restoreScaledDownWorkloads() results.Result {
+	warnings := results.Result{}
+
+	for _, workload := range ctx.scaledDownWorkloads {
+		if ctx.workloadOverwritten(workload) {
+			continue
+		}
+
+		client, err := ctx.dynamicFactory.ClientForGroupVersionResource(workload.gvr.GroupVersion(), workload.resource, workload.namespace)
+		if err != nil {
+			warnings.Add(workload.namespace, errors.Wrapf(err, "error getting client for %s", workload.gvr.GroupResource()))
+			continue
+		}
+		patch := fmt.Sprintf(`{"spec":{"replicas":%d}}`, workload.replicas)
+		if _, err := client.Patch(workload.name, []byte(patch)); err != nil && !apierrors.IsNotFound(err) {
+			warnings.Add(workload.namespace, errors.Wrapf(err, "error scaling %s %s/%s back to %d replicas", workload.gvr.GroupResource(), workload.namespace, workload.name, workload.replicas))
+			continue
+		}
+		ctx.log.WithField("namespace", workload.namespace).WithField("name", workload.name).WithField("resource", workload.gvr.GroupResource().String()).
+			Infof("Scaled the workload which wasn't overwritten back to %d replicas", workload.replicas)
+	}
+
+	return warnings
+}
+
+// workloadOverwritten returns whether the restore created or updated the workload.
+func (ctx *restoreContext) workloadOverwritten(workload scaledDownWorkload) bool {
+	for key, status := range ctx.restoredItems {
+		if key.namespace != workload.namespace || key.name != workload.name || !strings.HasSuffix(key.resource, "/"+workload.resource.Kind) {
+			continue
+		}
+		if status.action == ItemRestoreResultCreated || status.action == ItemRestoreResultUpdated {
+			return true
+		}
+	}
+	return false
+}
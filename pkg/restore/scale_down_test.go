@@ -0,0 +1,194 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+func TestScaleDownConflictingWorkloads(t *testing.T) {
+	deployment := builder.ForDeployment("ns-1", "deploy-1").Result()
+	replicas := int32(2)
+	deployment.Spec.Replicas = &replicas
+	deployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}
+
+	tests := []struct {
+		name            string
+		pods            []metav1.Object
+		restoredItems   []restoreableItem
+		expectWarnings  bool
+		expectedReplica int64
+	}{
+		{
+			name:            "existing deployment is scaled down",
+			pods:            []metav1.Object{builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("app", "bar")).Result()},
+			restoredItems:   []restoreableItem{{targetNamespace: "ns-1", name: "deploy-1"}},
+			expectedReplica: 0,
+		},
+		{
+			name:            "deployment which doesn't exist is skipped",
+			restoredItems:   []restoreableItem{{targetNamespace: "ns-1", name: "deploy-2"}},
+			expectedReplica: 2,
+		},
+		{
+			name:            "pods not terminating are reported as warnings",
+			pods:            []metav1.Object{builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("app", "foo")).Result()},
+			restoredItems:   []restoreableItem{{targetNamespace: "ns-1", name: "deploy-1"}},
+			expectWarnings:  true,
+			expectedReplica: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.AddItems(t, test.Deployments(deployment))
+			h.AddItems(t, test.Pods(tc.pods...))
+
+			ctx := &restoreContext{
+				log:                        h.log,
+				discoveryHelper:            h.restorer.discoveryHelper,
+				dynamicFactory:             h.restorer.dynamicFactory,
+				resourceTerminatingTimeout: time.Second,
+			}
+			warnings := ctx.scaleDownConflictingWorkloads([]restoreableResource{{
+				resource:                 "deployments.apps",
+				selectedItemsByNamespace: map[string][]restoreableItem{"ns-1": tc.restoredItems},
+			}})
+			if tc.expectWarnings {
+				assert.NotEqual(t, results.Result{}, warnings)
+			} else {
+				assert.Equal(t, results.Result{}, warnings)
+			}
+
+			res, err := h.DynamicClient.Resource(test.Deployments().GVR()).Namespace("ns-1").Get(context.TODO(), "deploy-1", metav1.GetOptions{})
+			require.NoError(t, err)
+			actual, _, err := unstructured.NestedInt64(res.Object, "spec", "replicas")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedReplica, actual)
+		})
+	}
+}
+
+func TestScaleDownConflictingWorkloadsWaitsInParallel(t *testing.T) {
+	var deployments []metav1.Object
+	for _, name := range []string{"deploy-1", "deploy-2", "deploy-3"} {
+		deployment := builder.ForDeployment("ns-1", name).Result()
+		deployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": name}}
+		deployments = append(deployments, deployment)
+	}
+
+	h := newHarness(t)
+	h.AddItems(t, test.Deployments(deployments...))
+	h.AddItems(t, test.Pods(
+		builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("app", "deploy-1")).Result(),
+		builder.ForPod("ns-1", "pod-2").ObjectMeta(builder.WithLabels("app", "deploy-2")).Result(),
+		builder.ForPod("ns-1", "pod-3").ObjectMeta(builder.WithLabels("app", "deploy-3")).Result(),
+	))
+
+	ctx := &restoreContext{
+		log:                        h.log,
+		discoveryHelper:            h.restorer.discoveryHelper,
+		dynamicFactory:             h.restorer.dynamicFactory,
+		resourceTerminatingTimeout: time.Second,
+	}
+	start := time.Now()
+	warnings := ctx.scaleDownConflictingWorkloads([]restoreableResource{{
+		resource: "deployments.apps",
+		selectedItemsByNamespace: map[string][]restoreableItem{"ns-1": {
+			{targetNamespace: "ns-1", name: "deploy-1"},
+			{targetNamespace: "ns-1", name: "deploy-2"},
+			{targetNamespace: "ns-1", name: "deploy-3"},
+		}},
+	}})
+
+	// the pods of all the workloads are waited for under the single timeout
+	assert.Less(t, time.Since(start), 2*time.Second)
+	assert.Len(t, warnings.Namespaces["ns-1"], 3)
+}
+
+func TestRestoreScaledDownWorkloads(t *testing.T) {
+	deployment := builder.ForDeployment("ns-1", "deploy-1").Result()
+	replicas := int32(2)
+	deployment.Spec.Replicas = &replicas
+	deployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}
+	key := itemKey{resource: "apps/v1/Deployment", namespace: "ns-1", name: "deploy-1"}
+
+	tests := []struct {
+		name            string
+		action          string
+		expectedReplica int64
+	}{
+		{
+			name:            "overwritten deployment keeps the replicas of the backup",
+			action:          ItemRestoreResultUpdated,
+			expectedReplica: 0,
+		},
+		{
+			name:            "deployment which failed to be overwritten is scaled back",
+			action:          ItemRestoreResultFailed,
+			expectedReplica: 2,
+		},
+		{
+			name:            "deployment which wasn't restored, the restore being aborted, is scaled back",
+			expectedReplica: 2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.AddItems(t, test.Deployments(deployment))
+
+			ctx := &restoreContext{
+				log:                        h.log,
+				discoveryHelper:            h.restorer.discoveryHelper,
+				dynamicFactory:             h.restorer.dynamicFactory,
+				resourceTerminatingTimeout: time.Second,
+				restoredItems:              map[itemKey]restoredItemStatus{},
+			}
+			warnings := ctx.scaleDownConflictingWorkloads([]restoreableResource{{
+				resource:                 "deployments.apps",
+				selectedItemsByNamespace: map[string][]restoreableItem{"ns-1": {{targetNamespace: "ns-1", name: "deploy-1"}}},
+			}})
+			require.Equal(t, results.Result{}, warnings)
+			if tc.action != "" {
+				ctx.restoredItems[key] = restoredItemStatus{action: tc.action}
+			}
+
+			warnings = ctx.restoreScaledDownWorkloads()
+			assert.Equal(t, results.Result{}, warnings)
+
+			res, err := h.DynamicClient.Resource(test.Deployments().GVR()).Namespace("ns-1").Get(context.TODO(), "deploy-1", metav1.GetOptions{})
+			require.NoError(t, err)
+			actual, _, err := unstructured.NestedInt64(res.Object, "spec", "replicas")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedReplica, actual)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+// serverDryRunSkippedActionResources are the resources whose restore item actions aren't run
+// in a server dry-run, as they create volumes or data movement operations.
+var serverDryRunSkippedActionResources = []schema.GroupResource{
+	kuberesource.PersistentVolumeClaims,
+	kuberesource.VolumeSnapshots,
+	kuberesource.VolumeSnapshotContents,
+}
+
+// serverDryRunIgnoredFields are the fields the API server always sets, which aren't reported as
+// changed in a server dry-run.
+var serverDryRunIgnoredFields = []string{
+	"metadata.uid",
+	"metadata.resourceVersion",
+	"metadata.creationTimestamp",
+	"metadata.generation",
+	"metadata.managedFields",
+	"status",
+}
+
+// serverDryRunNamespace checks the namespace the items are restored into exists or, if it
+// doesn't, submits its creation to the API server in dry-run mode.
+func (ctx *restoreContext) serverDryRunNamespace(ns *corev1api.Namespace) error {
+	if _, ok := ctx.serverDryRunNamespaces[ns.Name]; ok {
+		return nil
+	}
+
+	_, err := ctx.namespaceClient.Get(go_context.Background(), ns.Name, metav1.GetOptions{})
+	if err == nil {
+		ctx.serverDryRunNamespaces[ns.Name] = true
+		return nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "error getting namespace %s", ns.Name)
+	}
+
+	ctx.serverDryRunNamespaces[ns.Name] = false
+	created := ns.DeepCopy()
+	created.ResourceVersion = ""
+	if _, err := ctx.namespaceClient.Create(go_context.Background(), created, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}}); err != nil {
+		return errors.Wrapf(err, "dry-run of namespace %s rejected", ns.Name)
+	}
+	return nil
+}
+
+// serverDryRunItem submits the creation of the item to the API server in dry-run mode. The
+// rejections are reported as errors and the fields the API server changes, by defaulting or by
+// mutating webhooks, as warnings.
+func (ctx *restoreContext) serverDryRunItem(obj *unstructured.Unstructured, resourceClient client.Dynamic, namespace string, log logrus.FieldLogger) (results.Result, results.Result) {
+	warnings, errs := results.Result{}, results.Result{}
+	kind := obj.GroupVersionKind().Kind
+
+	log.Infof("Submitting %s: %s to the API server in dry-run mode.", kind, obj.GetName())
+	returned, err := resourceClient.CreateDryRun(obj)
+	switch {
+	case apierrors.IsAlreadyExists(err):
+		log.Infof("%s: %s already exists", kind, obj.GetName())
+	case apierrors.IsNotFound(err) && namespace != "" && !ctx.serverDryRunNamespaces[namespace]:
+		// the namespace was only created in dry-run mode, so the API server can't validate the
+		// items restored into it
+		warnings.Add(namespace, fmt.Errorf("dry-run of %s %s not validated, namespace %s doesn't exist", kind, obj.GetName(), namespace))
+	case err != nil:
+		errs.Add(namespace, errors.Wrapf(err, "dry-run of %s %s rejected", kind, obj.GetName()))
+	default:
+		if changed := changedFields(obj.Object, returned.Object, ""); len(changed) > 0 {
+			warnings.Add(namespace, fmt.Errorf("the API server changes the fields %s of %s %s", strings.Join(changed, ", "), kind, obj.GetName()))
+		}
+	}
+
+	return warnings, errs
+}
+
+// changedFields returns the paths of the fields of the returned object which were added or
+// changed compared to the submitted one.
+func changedFields(submitted, returned map[string]any, prefix string) []string {
+	var changed []string
+	for key, value := range returned {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		if slices.Contains(serverDryRunIgnoredFields, path) {
+			continue
+		}
+
+		submittedValue, ok := submitted[key]
+		if !ok {
+			changed = append(changed, path)
+			continue
+		}
+		submittedMap, submittedIsMap := submittedValue.(map[string]any)
+		returnedMap, returnedIsMap := value.(map[string]any)
+		if submittedIsMap && returnedIsMap {
+			changed = append(changed, changedFields(submittedMap, returnedMap, path)...)
+			continue
+		}
+		if !reflect.DeepEqual(submittedValue, value) {
+			changed = append(changed, path)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
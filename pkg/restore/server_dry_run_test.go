@@ -0,0 +1,125 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestServerDryRunItem(t *testing.T) {
+	submitted := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata":   map[string]any{"name": "svc-1", "namespace": "ns-1"},
+		"spec":       map[string]any{"ports": []any{map[string]any{"port": int64(80)}}},
+	}}
+	defaulted := submitted.DeepCopy()
+	defaulted.SetUID("uid")
+	defaulted.SetResourceVersion("1")
+	require.NoError(t, unstructured.SetNestedField(defaulted.Object, "ClusterIP", "spec", "type"))
+
+	tests := []struct {
+		name             string
+		returned         *unstructured.Unstructured
+		err              error
+		namespaces       map[string]bool
+		expectedWarnings []string
+		expectedErrs     []string
+	}{
+		{
+			name:       "item accepted unchanged",
+			returned:   submitted,
+			namespaces: map[string]bool{"ns-1": true},
+		},
+		{
+			name:             "fields changed by the API server are reported as warnings",
+			returned:         defaulted,
+			namespaces:       map[string]bool{"ns-1": true},
+			expectedWarnings: []string{"the API server changes the fields spec.type of Service svc-1"},
+		},
+		{
+			name:       "existing item is skipped",
+			err:        apierrors.NewAlreadyExists(schema.GroupResource{Resource: "services"}, "svc-1"),
+			namespaces: map[string]bool{"ns-1": true},
+		},
+		{
+			name:         "rejected item is reported as an error",
+			err:          apierrors.NewForbidden(schema.GroupResource{Resource: "services"}, "svc-1", assert.AnError),
+			namespaces:   map[string]bool{"ns-1": true},
+			expectedErrs: []string{`dry-run of Service svc-1 rejected: services "svc-1" is forbidden: ` + assert.AnError.Error()},
+		},
+		{
+			name:             "item in a namespace only created in dry-run mode is reported as a warning",
+			err:              apierrors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, "ns-1"),
+			namespaces:       map[string]bool{"ns-1": false},
+			expectedWarnings: []string{"dry-run of Service svc-1 not validated, namespace ns-1 doesn't exist"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resourceClient := &test.FakeDynamicClient{}
+			returned := tc.returned
+			if returned == nil {
+				returned = &unstructured.Unstructured{}
+			}
+			resourceClient.On("CreateDryRun", mock.Anything).Return(returned, tc.err)
+
+			ctx := &restoreContext{serverDryRunNamespaces: tc.namespaces}
+			warnings, errs := ctx.serverDryRunItem(submitted, resourceClient, "ns-1", logrus.StandardLogger())
+
+			assert.Equal(t, tc.expectedWarnings, warnings.Namespaces["ns-1"])
+			assert.Equal(t, tc.expectedErrs, errs.Namespaces["ns-1"])
+			resourceClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestServerDryRunNamespace(t *testing.T) {
+	kubeClient := kubefake.NewSimpleClientset(builder.ForNamespace("ns-1").Result())
+	ctx := &restoreContext{
+		namespaceClient:        kubeClient.CoreV1().Namespaces(),
+		serverDryRunNamespaces: make(map[string]bool),
+	}
+
+	require.NoError(t, ctx.serverDryRunNamespace(builder.ForNamespace("ns-1").Result()))
+	require.NoError(t, ctx.serverDryRunNamespace(builder.ForNamespace("ns-2").Result()))
+	assert.Equal(t, map[string]bool{"ns-1": true, "ns-2": false}, ctx.serverDryRunNamespaces)
+
+	var dryRunCreates int
+	for _, action := range kubeClient.Actions() {
+		if action.GetVerb() == "create" {
+			dryRunCreates++
+			assert.Equal(t, []string{metav1.DryRunAll}, action.(clienttesting.CreateActionImpl).CreateOptions.DryRun)
+		}
+	}
+	assert.Equal(t, 1, dryRunCreates)
+}
@@ -0,0 +1,215 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	storagev1api "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+)
+
+var volumeAttachmentResource = metav1.APIResource{Name: "volumeattachments", Namespaced: false}
+
+// waitForVolumesDetached waits for the ReadWriteOnce volumes of the pod being restored to be
+// detached from the nodes they're still attached to, so the restored pod doesn't fail with a
+// multi-attach error. With the ForceDetach policy, the attachments to the nodes where no pod
+// uses the volume anymore are deleted first. The volumes of the pod are waited for together,
+// and all the waits of the restore share one deadline, so the restore isn't delayed by more
+// than the resource terminating timeout whatever the number of pods.
+func (ctx *restoreContext) waitForVolumesDetached(pod *corev1api.Pod, log logrus.FieldLogger) error {
+	attachmentClient, err := ctx.dynamicFactory.ClientForGroupVersionResource(storagev1api.SchemeGroupVersion, volumeAttachmentResource, "")
+	if err != nil {
+		return errors.Wrap(err, "error getting volume attachment client")
+	}
+
+	// the claims of the persistent volumes still attached
+	attached := map[string]string{}
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim == nil {
+			continue
+		}
+		pvName, err := ctx.singleAttachVolumeName(pod.Namespace, volume.PersistentVolumeClaim.ClaimName)
+		if err != nil {
+			return err
+		}
+		if pvName == "" {
+			continue
+		}
+
+		attachments, err := listVolumeAttachments(attachmentClient, pvName)
+		if err != nil {
+			return err
+		}
+		if len(attachments) == 0 {
+			continue
+		}
+		for _, attachment := range attachments {
+			log.Infof("Persistent volume %s of claim %s is still attached to node %s", pvName, volume.PersistentVolumeClaim.ClaimName, attachment.Spec.NodeName)
+		}
+
+		if ctx.restore.Spec.VolumeDetachPolicy == velerov1api.VolumeDetachPolicyForceDetach {
+			if err := ctx.forceDetach(attachmentClient, attachments, pod.Namespace, volume.PersistentVolumeClaim.ClaimName, log); err != nil {
+				return err
+			}
+		}
+		attached[pvName] = volume.PersistentVolumeClaim.ClaimName
+	}
+	if len(attached) == 0 {
+		return nil
+	}
+
+	if ctx.volumeDetachDeadline.IsZero() {
+		ctx.volumeDetachDeadline = time.Now().Add(ctx.resourceTerminatingTimeout)
+	}
+	timeout := time.Until(ctx.volumeDetachDeadline)
+	if timeout <= 0 {
+		return errors.Errorf("timed out waiting for the persistent volumes %s to be detached, the deadline of the restore passed", strings.Join(slices.Sorted(maps.Keys(attached)), ", "))
+	}
+
+	err = wait.PollUntilContextTimeout(go_context.Background(), time.Second, timeout, true, func(go_context.Context) (bool, error) {
+		attachments, err := listAllVolumeAttachments(attachmentClient)
+		if err != nil {
+			return false, err
+		}
+		for pvName, claimName := range attached {
+			if _, found := attachments[pvName]; !found {
+				log.Infof("Persistent volume %s of claim %s is detached", pvName, claimName)
+				delete(attached, pvName)
+			}
+		}
+		return len(attached) == 0, nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error waiting for the persistent volumes %s to be detached", strings.Join(slices.Sorted(maps.Keys(attached)), ", "))
+	}
+
+	return nil
+}
+
+// singleAttachVolumeName returns the name of the persistent volume bound to the claim, if the
+// volume can be attached to a single node, or an empty string otherwise.
+func (ctx *restoreContext) singleAttachVolumeName(namespace, claimName string) (string, error) {
+	pvcClient, err := ctx.dynamicFactory.ClientForGroupVersionResource(schema.GroupVersion{Version: "v1"}, metav1.APIResource{Name: "persistentvolumeclaims", Namespaced: true}, namespace)
+	if err != nil {
+		return "", errors.Wrap(err, "error getting pvc client")
+	}
+	obj, err := pvcClient.Get(claimName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", errors.Wrapf(err, "error getting claim %s/%s", namespace, claimName)
+	}
+
+	pvc := new(corev1api.PersistentVolumeClaim)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pvc); err != nil {
+		return "", errors.WithStack(err)
+	}
+	if pvc.Spec.VolumeName == "" {
+		return "", nil
+	}
+	if !slices.Contains(pvc.Spec.AccessModes, corev1api.ReadWriteOnce) && !slices.Contains(pvc.Spec.AccessModes, corev1api.ReadWriteOncePod) {
+		return "", nil
+	}
+	return pvc.Spec.VolumeName, nil
+}
+
+// forceDetach deletes the attachments of the claim's volume to the nodes where no pod uses the
+// claim anymore. The attachments used by running pods are kept.
+func (ctx *restoreContext) forceDetach(attachmentClient client.Dynamic, attachments []*storagev1api.VolumeAttachment, namespace, claimName string, log logrus.FieldLogger) error {
+	podClient, err := ctx.dynamicFactory.ClientForGroupVersionResource(schema.GroupVersion{Version: "v1"}, metav1.APIResource{Name: "pods", Namespaced: true}, namespace)
+	if err != nil {
+		return errors.Wrap(err, "error getting pod client")
+	}
+	list, err := podClient.List(metav1.ListOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "error listing pods in namespace %s", namespace)
+	}
+
+	usedOnNodes := map[string]struct{}{}
+	for _, item := range list.Items {
+		pod := new(corev1api.Pod)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), pod); err != nil {
+			return errors.WithStack(err)
+		}
+		if pod.Status.Phase == corev1api.PodSucceeded || pod.Status.Phase == corev1api.PodFailed {
+			continue
+		}
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == claimName {
+				usedOnNodes[pod.Spec.NodeName] = struct{}{}
+			}
+		}
+	}
+
+	for _, attachment := range attachments {
+		if _, used := usedOnNodes[attachment.Spec.NodeName]; used {
+			log.Warnf("Volume attachment %s is used by a pod on node %s, not detaching it", attachment.Name, attachment.Spec.NodeName)
+			continue
+		}
+		if err := attachmentClient.Delete(attachment.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "error deleting volume attachment %s", attachment.Name)
+		}
+		log.Infof("Forced the detach of the stale volume attachment %s from node %s", attachment.Name, attachment.Spec.NodeName)
+	}
+	return nil
+}
+
+// listVolumeAttachments returns the attachments of the persistent volume.
+func listVolumeAttachments(attachmentClient client.Dynamic, pvName string) ([]*storagev1api.VolumeAttachment, error) {
+	attachments, err := listAllVolumeAttachments(attachmentClient)
+	if err != nil {
+		return nil, err
+	}
+	return attachments[pvName], nil
+}
+
+// listAllVolumeAttachments returns the attachments of the persistent volumes, by volume name.
+func listAllVolumeAttachments(attachmentClient client.Dynamic) (map[string][]*storagev1api.VolumeAttachment, error) {
+	list, err := attachmentClient.List(metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing volume attachments")
+	}
+
+	attachments := map[string][]*storagev1api.VolumeAttachment{}
+	for _, item := range list.Items {
+		attachment := new(storagev1api.VolumeAttachment)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), attachment); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if attachment.Spec.Source.PersistentVolumeName != nil {
+			pvName := *attachment.Spec.Source.PersistentVolumeName
+			attachments[pvName] = append(attachments[pvName], attachment)
+		}
+	}
+	return attachments, nil
+}
@@ -0,0 +1,166 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	storagev1api "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestWaitForVolumesDetached(t *testing.T) {
+	pvName := "pv-1"
+	attachment := &storagev1api.VolumeAttachment{
+		ObjectMeta: metav1.ObjectMeta{Name: "attachment-1"},
+		Spec: storagev1api.VolumeAttachmentSpec{
+			Attacher: "csi.example.com",
+			NodeName: "node-1",
+			Source:   storagev1api.VolumeAttachmentSource{PersistentVolumeName: &pvName},
+		},
+	}
+	rwoPVC := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName(pvName).Result()
+	rwoPVC.Spec.AccessModes = []corev1api.PersistentVolumeAccessMode{corev1api.ReadWriteOnce}
+	rwxPVC := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName(pvName).Result()
+	rwxPVC.Spec.AccessModes = []corev1api.PersistentVolumeAccessMode{corev1api.ReadWriteMany}
+	runningPod := builder.ForPod("ns-1", "pod-0").
+		Volumes(builder.ForVolume("data").PersistentVolumeClaimSource("pvc-1").Result()).
+		NodeName("node-1").
+		Phase(corev1api.PodRunning).
+		Result()
+
+	tests := []struct {
+		name             string
+		policy           velerov1api.VolumeDetachPolicy
+		pvc              *corev1api.PersistentVolumeClaim
+		attachments      []metav1.Object
+		pods             []metav1.Object
+		expectErr        bool
+		expectAttachment bool
+	}{
+		{
+			name:   "volume which isn't attached is skipped",
+			policy: velerov1api.VolumeDetachPolicyWait,
+			pvc:    rwoPVC,
+		},
+		{
+			name:             "volume which can be attached to several nodes is skipped",
+			policy:           velerov1api.VolumeDetachPolicyWait,
+			pvc:              rwxPVC,
+			attachments:      []metav1.Object{attachment},
+			expectAttachment: true,
+		},
+		{
+			name:             "waiting for a volume which stays attached times out",
+			policy:           velerov1api.VolumeDetachPolicyWait,
+			pvc:              rwoPVC,
+			attachments:      []metav1.Object{attachment},
+			expectErr:        true,
+			expectAttachment: true,
+		},
+		{
+			name:        "stale attachment is detached by force",
+			policy:      velerov1api.VolumeDetachPolicyForceDetach,
+			pvc:         rwoPVC,
+			attachments: []metav1.Object{attachment},
+		},
+		{
+			name:             "attachment used by a running pod isn't detached by force",
+			policy:           velerov1api.VolumeDetachPolicyForceDetach,
+			pvc:              rwoPVC,
+			attachments:      []metav1.Object{attachment},
+			pods:             []metav1.Object{runningPod},
+			expectErr:        true,
+			expectAttachment: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.AddItems(t, test.PVCs(tc.pvc))
+			h.AddItems(t, test.VolumeAttachments(tc.attachments...))
+			h.AddItems(t, test.Pods(tc.pods...))
+
+			ctx := &restoreContext{
+				log:                        h.log,
+				restore:                    defaultRestore().VolumeDetachPolicy(tc.policy).Result(),
+				dynamicFactory:             h.restorer.dynamicFactory,
+				resourceTerminatingTimeout: time.Second,
+			}
+			pod := builder.ForPod("ns-1", "pod-1").Volumes(builder.ForVolume("data").PersistentVolumeClaimSource("pvc-1").Result()).Result()
+			err := ctx.waitForVolumesDetached(pod, h.log)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			_, err = h.DynamicClient.Resource(test.VolumeAttachments().GVR()).Get(context.TODO(), "attachment-1", metav1.GetOptions{})
+			if tc.expectAttachment {
+				require.NoError(t, err)
+			} else {
+				assert.True(t, apierrors.IsNotFound(err))
+			}
+		})
+	}
+}
+
+func TestWaitForVolumesDetachedDeadline(t *testing.T) {
+	h := newHarness(t)
+	var attachments []metav1.Object
+	var volumes []*corev1api.Volume
+	for _, name := range []string{"1", "2"} {
+		pvName := "pv-" + name
+		pvc := builder.ForPersistentVolumeClaim("ns-1", "pvc-"+name).VolumeName(pvName).Result()
+		pvc.Spec.AccessModes = []corev1api.PersistentVolumeAccessMode{corev1api.ReadWriteOnce}
+		h.AddItems(t, test.PVCs(pvc))
+		attachments = append(attachments, &storagev1api.VolumeAttachment{
+			ObjectMeta: metav1.ObjectMeta{Name: "attachment-" + name},
+			Spec: storagev1api.VolumeAttachmentSpec{
+				Attacher: "csi.example.com",
+				NodeName: "node-1",
+				Source:   storagev1api.VolumeAttachmentSource{PersistentVolumeName: &pvName},
+			},
+		})
+		volumes = append(volumes, builder.ForVolume("data-"+name).PersistentVolumeClaimSource("pvc-"+name).Result())
+	}
+	h.AddItems(t, test.VolumeAttachments(attachments...))
+
+	ctx := &restoreContext{
+		log:                        h.log,
+		restore:                    defaultRestore().VolumeDetachPolicy(velerov1api.VolumeDetachPolicyWait).Result(),
+		dynamicFactory:             h.restorer.dynamicFactory,
+		resourceTerminatingTimeout: time.Second,
+	}
+
+	// the volumes of a pod are waited for together, and the next pods don't wait past the deadline
+	start := time.Now()
+	require.Error(t, ctx.waitForVolumesDetached(builder.ForPod("ns-1", "pod-1").Volumes(volumes...).Result(), h.log))
+	require.Error(t, ctx.waitForVolumesDetached(builder.ForPod("ns-1", "pod-2").Volumes(volumes...).Result(), h.log))
+	assert.Less(t, time.Since(start), 2*time.Second)
+}
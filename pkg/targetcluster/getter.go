@@ -0,0 +1,177 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package targetcluster provides the clients of the clusters a server in the hub mode
+// backs up and restores, other than the cluster the server runs in.
+package targetcluster
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubediscovery "k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
+)
+
+// KubeconfigKey is the key of the kubeconfig in the Secret of a target cluster.
+const KubeconfigKey = "kubeconfig"
+
+// Cluster is a target cluster along with its clients.
+type Cluster struct {
+	Name            string
+	RESTConfig      *rest.Config
+	KubeClient      kubernetes.Interface
+	DynamicClient   dynamic.Interface
+	CRClient        kbclient.Client
+	DiscoveryHelper discovery.Helper
+
+	// Backupper and Restorer back up and restore the cluster, they're set by the
+	// build function of the Getter.
+	Backupper pkgbackup.Backupper
+	Restorer  pkgrestore.Restorer
+}
+
+// Status returns the status of the cluster recorded in the backups and restores run against it.
+func (c *Cluster) Status() *velerov1api.TargetClusterStatus {
+	status := &velerov1api.TargetClusterStatus{Name: c.Name}
+	if c.RESTConfig != nil {
+		status.Host = c.RESTConfig.Host
+	}
+	if c.DiscoveryHelper != nil && c.DiscoveryHelper.ServerVersion() != nil {
+		status.ServerVersion = c.DiscoveryHelper.ServerVersion().String()
+	}
+	return status
+}
+
+// Getter gets the target clusters by the names of their Secrets.
+type Getter interface {
+	Get(ctx context.Context, name string) (*Cluster, error)
+}
+
+type cachedCluster struct {
+	resourceVersion string
+	cluster         *Cluster
+}
+
+type getter struct {
+	client    kbclient.Client
+	namespace string
+	scheme    *runtime.Scheme
+	build     func(*Cluster) error
+	logger    logrus.FieldLogger
+
+	lock     sync.Mutex
+	clusters map[string]cachedCluster
+}
+
+// NewGetter returns a Getter reading the kubeconfigs of the target clusters from the Secrets
+// in namespace. The clusters are cached until their Secrets change, build completes a newly
+// created cluster, e.g. with its backupper and restorer.
+func NewGetter(client kbclient.Client, namespace string, scheme *runtime.Scheme, build func(*Cluster) error, logger logrus.FieldLogger) Getter {
+	return &getter{
+		client:    client,
+		namespace: namespace,
+		scheme:    scheme,
+		build:     build,
+		logger:    logger,
+		clusters:  make(map[string]cachedCluster),
+	}
+}
+
+func (g *getter) Get(ctx context.Context, name string) (*Cluster, error) {
+	secret := &corev1api.Secret{}
+	if err := g.client.Get(ctx, kbclient.ObjectKey{Namespace: g.namespace, Name: name}, secret); err != nil {
+		return nil, errors.Wrapf(err, "error getting the secret of target cluster %s", name)
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if cached, ok := g.clusters[name]; ok && cached.resourceVersion == secret.ResourceVersion {
+		if err := cached.cluster.DiscoveryHelper.Refresh(); err != nil {
+			return nil, errors.Wrapf(err, "error refreshing the discovery of target cluster %s", name)
+		}
+		return cached.cluster, nil
+	}
+
+	kubeconfig, ok := secret.Data[KubeconfigKey]
+	if !ok {
+		return nil, errors.Errorf("secret of target cluster %s has no %s key", name, KubeconfigKey)
+	}
+	cluster, err := g.newCluster(name, kubeconfig)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error creating the clients of target cluster %s", name)
+	}
+
+	g.clusters[name] = cachedCluster{resourceVersion: secret.ResourceVersion, cluster: cluster}
+	return cluster, nil
+}
+
+func (g *getter) newCluster(name string, kubeconfig []byte) (*Cluster, error) {
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	kubeClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	crClient, err := kbclient.New(config, kbclient.Options{Scheme: g.scheme})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	discoveryClient, err := kubediscovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	discoveryHelper, err := discovery.NewHelper(discoveryClient, g.logger.WithField("targetCluster", name))
+	if err != nil {
+		return nil, err
+	}
+
+	cluster := &Cluster{
+		Name:            name,
+		RESTConfig:      config,
+		KubeClient:      kubeClient,
+		DynamicClient:   dynamicClient,
+		CRClient:        crClient,
+		DiscoveryHelper: discoveryHelper,
+	}
+	if g.build != nil {
+		if err := g.build(cluster); err != nil {
+			return nil, err
+		}
+	}
+	return cluster, nil
+}
@@ -0,0 +1,74 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package targetcluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestGetterGet(t *testing.T) {
+	tests := []struct {
+		name        string
+		secret      *corev1api.Secret
+		expectedErr string
+	}{
+		{
+			name:        "secret of the target cluster doesn't exist",
+			expectedErr: "error getting the secret of target cluster cluster-1",
+		},
+		{
+			name: "secret of the target cluster has no kubeconfig",
+			secret: &corev1api.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "cluster-1"},
+				Data:       map[string][]byte{"config": []byte("")},
+			},
+			expectedErr: "secret of target cluster cluster-1 has no kubeconfig key",
+		},
+		{
+			name: "kubeconfig of the target cluster is invalid",
+			secret: &corev1api.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "cluster-1"},
+				Data:       map[string][]byte{KubeconfigKey: []byte("invalid")},
+			},
+			expectedErr: "error creating the clients of target cluster cluster-1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var objects []runtime.Object
+			if test.secret != nil {
+				objects = append(objects, test.secret)
+			}
+			client := velerotest.NewFakeControllerRuntimeClient(t, objects...)
+
+			getter := NewGetter(client, "velero", client.Scheme(), nil, velerotest.NewLogger())
+			_, err := getter.Get(context.Background(), "cluster-1")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+}
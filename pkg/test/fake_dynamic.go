@@ -59,6 +59,11 @@ func (c *FakeDynamicClient) Create(obj *unstructured.Unstructured) (*unstructure
 	return args.Get(0).(*unstructured.Unstructured), args.Error(1)
 }
 
+func (c *FakeDynamicClient) CreateDryRun(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	args := c.Called(obj)
+	return args.Get(0).(*unstructured.Unstructured), args.Error(1)
+}
+
 func (c *FakeDynamicClient) Watch(options metav1.ListOptions) (watch.Interface, error) {
 	args := c.Called(options)
 	return args.Get(0).(watch.Interface), args.Error(1)
@@ -74,9 +79,14 @@ func (c *FakeDynamicClient) Patch(name string, data []byte) (*unstructured.Unstr
 	return args.Get(0).(*unstructured.Unstructured), args.Error(1)
 }
 
+func (c *FakeDynamicClient) Apply(name string, obj *unstructured.Unstructured, fieldManager string) (*unstructured.Unstructured, error) {
+	args := c.Called(name, obj, fieldManager)
+	return args.Get(0).(*unstructured.Unstructured), args.Error(1)
+}
+
 func (c *FakeDynamicClient) Delete(name string, opts metav1.DeleteOptions) error {
 	args := c.Called(name, opts)
-	return args.Error(1)
+	return args.Error(0)
 }
 
 func (c *FakeDynamicClient) UpdateStatus(obj *unstructured.Unstructured, opts metav1.UpdateOptions) (*unstructured.Unstructured, error) {
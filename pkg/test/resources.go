@@ -103,6 +103,18 @@ func Deployments(items ...metav1.Object) *APIResource {
 	}
 }
 
+func ReplicaSets(items ...metav1.Object) *APIResource {
+	return &APIResource{
+		Group:      "apps",
+		Version:    "v1",
+		Name:       "replicasets",
+		ShortName:  "rs",
+		Kind:       "ReplicaSet",
+		Namespaced: true,
+		Items:      items,
+	}
+}
+
 func ExtensionsDeployments(items ...metav1.Object) *APIResource {
 	return &APIResource{
 		Group:      "extensions",
@@ -220,3 +232,14 @@ func DataUploads(items ...metav1.Object) *APIResource {
 		Items:      items,
 	}
 }
+
+func VolumeAttachments(items ...metav1.Object) *APIResource {
+	return &APIResource{
+		Group:      "storage.k8s.io",
+		Version:    "v1",
+		Name:       "volumeattachments",
+		Namespaced: false,
+		Items:      items,
+		Kind:       "VolumeAttachment",
+	}
+}
@@ -20,39 +20,79 @@ limitations under the License.
 package kopia
 
 import (
+	"io"
 	"os"
 	"syscall"
+	"time"
 
 	"github.com/kopia/kopia/fs"
 	"github.com/kopia/kopia/fs/virtualfs"
 	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/uploader"
 )
 
 const ErrNotPermitted = "operation not permitted"
 
 func getLocalBlockEntry(sourcePath string) (fs.Entry, error) {
+	source, device, err := openBlockDevice(sourcePath)
+	if err != nil {
+		return nil, err
+	}
+
+	sf := virtualfs.StreamingFileFromReader(source, device)
+	return virtualfs.NewStaticDirectory(source, []fs.Entry{sf}), nil
+}
+
+// getSegmentedBlockEntry returns the block device as a directory of segments, reusing from the
+// parent segments those without changed blocks. The returned device is to be closed once the
+// snapshot is taken.
+func getSegmentedBlockEntry(sourcePath string, parentSegments map[string]fs.Entry, changed *uploader.ChangedBlocks) (fs.Entry, io.Closer, error) {
+	source, device, err := openBlockDevice(sourcePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	size, err := device.Seek(0, io.SeekEnd)
+	if err != nil {
+		device.Close()
+		return nil, nil, errors.Wrapf(err, "unable to get the size of the source device %s", source)
+	}
+
+	backupTime := time.Now()
+	var segments []fs.Entry
+	for offset := int64(0); offset < size; offset += segmentSize {
+		length := min(segmentSize, size-offset)
+		modTime := segmentModTime(offset, length, parentSegments, changed, backupTime)
+		segments = append(segments, virtualfs.StreamingFileWithModTimeFromReader(
+			segmentName(offset), modTime, io.NopCloser(io.NewSectionReader(device, offset, length))))
+	}
+
+	return virtualfs.NewStaticDirectory(source, segments), device, nil
+}
+
+func openBlockDevice(sourcePath string) (string, *os.File, error) {
 	source, err := resolveSymlink(sourcePath)
 	if err != nil {
-		return nil, errors.Wrap(err, "resolveSymlink")
+		return "", nil, errors.Wrap(err, "resolveSymlink")
 	}
 
 	fileInfo, err := os.Lstat(source)
 	if err != nil {
-		return nil, errors.Wrapf(err, "unable to get the source device information %s", source)
+		return "", nil, errors.Wrapf(err, "unable to get the source device information %s", source)
 	}
 
 	if (fileInfo.Sys().(*syscall.Stat_t).Mode & syscall.S_IFMT) != syscall.S_IFBLK {
-		return nil, errors.Errorf("source path %s is not a block device", source)
+		return "", nil, errors.Errorf("source path %s is not a block device", source)
 	}
 
 	device, err := os.Open(source)
 	if err != nil {
 		if os.IsPermission(err) || err.Error() == ErrNotPermitted {
-			return nil, errors.Wrapf(err, "no permission to open the source device %s, make sure that node agent is running in privileged mode", source)
+			return "", nil, errors.Wrapf(err, "no permission to open the source device %s, make sure that node agent is running in privileged mode", source)
 		}
-		return nil, errors.Wrapf(err, "unable to open the source device %s", source)
+		return "", nil, errors.Wrapf(err, "unable to open the source device %s", source)
 	}
 
-	sf := virtualfs.StreamingFileFromReader(source, device)
-	return virtualfs.NewStaticDirectory(source, []fs.Entry{sf}), nil
+	return source, device, nil
 }
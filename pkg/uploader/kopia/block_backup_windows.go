@@ -21,10 +21,17 @@ package kopia
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/kopia/kopia/fs"
+
+	"github.com/vmware-tanzu/velero/pkg/uploader"
 )
 
 func getLocalBlockEntry(sourcePath string) (fs.Entry, error) {
 	return nil, fmt.Errorf("block mode is not supported for Windows")
 }
+
+func getSegmentedBlockEntry(sourcePath string, parentSegments map[string]fs.Entry, changed *uploader.ChangedBlocks) (fs.Entry, io.Closer, error) {
+	return nil, nil, fmt.Errorf("block mode is not supported for Windows")
+}
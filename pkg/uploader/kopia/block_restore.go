@@ -48,9 +48,9 @@ func (o *BlockOutput) WriteFile(ctx context.Context, relativePath string, remote
 	}
 	defer remoteReader.Close()
 
-	targetFile, err := os.Create(o.targetFileName)
+	targetFile, err := o.openTarget(remoteFile.Name())
 	if err != nil {
-		return errors.Wrapf(err, "failed to open file %s", o.targetFileName)
+		return err
 	}
 	defer targetFile.Close()
 
@@ -83,6 +83,29 @@ func (o *BlockOutput) WriteFile(ctx context.Context, relativePath string, remote
 	return nil
 }
 
+// openTarget opens the target device for writing the remote file, at the offset of the segment
+// for the segments of the block devices backed up with changed block tracking.
+func (o *BlockOutput) openTarget(remoteFileName string) (*os.File, error) {
+	offset, isSegment := parseSegmentName(remoteFileName)
+	if !isSegment {
+		targetFile, err := os.Create(o.targetFileName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to open file %s", o.targetFileName)
+		}
+		return targetFile, nil
+	}
+
+	targetFile, err := os.OpenFile(o.targetFileName, os.O_WRONLY, 0)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open file %s", o.targetFileName)
+	}
+	if _, err := targetFile.Seek(offset, io.SeekStart); err != nil {
+		targetFile.Close()
+		return nil, errors.Wrapf(err, "failed to seek file %s to offset %d", o.targetFileName, offset)
+	}
+	return targetFile, nil
+}
+
 func (o *BlockOutput) BeginDirectory(ctx context.Context, relativePath string, e fs.Directory) error {
 	var err error
 	o.targetFileName, err = filepath.EvalSymlinks(o.TargetPath)
@@ -0,0 +1,127 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopia
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kopia/kopia/fs"
+	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/repo/manifest"
+	"github.com/kopia/kopia/snapshot/snapshotfs"
+	"github.com/sirupsen/logrus"
+
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	uploaderutil "github.com/vmware-tanzu/velero/pkg/uploader/util"
+)
+
+// With changed block tracking, a block volume is backed up as a directory of segment files of
+// segmentSize bytes, named after their offsets. The segments holding no changed block are
+// given the modification time they had in the parent snapshot, so kopia reuses them from the
+// parent without reading them from the device.
+const (
+	segmentSize       = 16 << 20
+	segmentNamePrefix = "segment-"
+)
+
+func segmentName(offset int64) string {
+	return fmt.Sprintf("%s%016x", segmentNamePrefix, offset)
+}
+
+// parseSegmentName returns the offset of the segment with the name, false if the name isn't
+// one of a segment.
+func parseSegmentName(name string) (int64, bool) {
+	hex, found := strings.CutPrefix(name, segmentNamePrefix)
+	if !found {
+		return 0, false
+	}
+	offset, err := strconv.ParseInt(hex, 16, 64)
+	if err != nil {
+		return 0, false
+	}
+	return offset, true
+}
+
+// segmentModTime returns the modification time of the segment: the one it had in the parent
+// snapshot if it's unchanged since, the backup time otherwise.
+func segmentModTime(offset, length int64, parentSegments map[string]fs.Entry, changed *uploader.ChangedBlocks, backupTime time.Time) time.Time {
+	parent, found := parentSegments[segmentName(offset)]
+	if !found || changed == nil || parent.Size() != length || changed.Overlaps(offset, length) {
+		return backupTime
+	}
+	return parent.ModTime()
+}
+
+// getParentSegments returns the segments of the parent snapshot by name, provided it was taken
+// from the CSI snapshot the changed blocks are relative to. None otherwise, so every segment is
+// read from the device.
+func getParentSegments(ctx context.Context, rep repo.Repository, parentSnapshot string, changed *uploader.ChangedBlocks, log logrus.FieldLogger) map[string]fs.Entry {
+	if changed == nil || parentSnapshot == "" {
+		return nil
+	}
+
+	man, err := loadSnapshotFunc(ctx, rep, manifest.ID(parentSnapshot))
+	if err != nil {
+		log.WithError(err).Warnf("Failed to load parent snapshot %s, reading all the blocks", parentSnapshot)
+		return nil
+	}
+
+	if handle := man.Tags[uploader.SnapshotHandleTag]; handle != changed.BaseSnapshotHandle {
+		log.Warnf("Parent snapshot %s was taken from CSI snapshot %q instead of %q, reading all the blocks", parentSnapshot, handle, changed.BaseSnapshotHandle)
+		return nil
+	}
+
+	root, err := snapshotfs.SnapshotRoot(rep, man)
+	if err != nil {
+		log.WithError(err).Warnf("Failed to get the root of parent snapshot %s, reading all the blocks", parentSnapshot)
+		return nil
+	}
+
+	dir, ok := root.(fs.Directory)
+	if !ok {
+		log.Warnf("The root of parent snapshot %s isn't a directory, reading all the blocks", parentSnapshot)
+		return nil
+	}
+
+	segments := make(map[string]fs.Entry)
+	if err := fs.IterateEntries(ctx, dir, func(_ context.Context, e fs.Entry) error {
+		if _, found := parseSegmentName(e.Name()); found {
+			segments[e.Name()] = e
+		}
+		return nil
+	}); err != nil {
+		log.WithError(err).Warnf("Failed to list the segments of parent snapshot %s, reading all the blocks", parentSnapshot)
+		return nil
+	}
+
+	return segments
+}
+
+// loadChangedBlocks returns the changed blocks of the uploader config, nil if there are none
+// or they can't be read.
+func loadChangedBlocks(uploaderCfg map[string]string, log logrus.FieldLogger) *uploader.ChangedBlocks {
+	changed, err := uploaderutil.GetChangedBlocks(uploaderCfg)
+	if err != nil {
+		log.WithError(err).Warn("Failed to get the changed blocks, reading all the blocks")
+		return nil
+	}
+	return changed
+}
@@ -0,0 +1,115 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopia
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kopia/kopia/fs"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+)
+
+type fakeSegmentEntry struct {
+	fs.Entry
+	size    int64
+	modTime time.Time
+}
+
+func (e *fakeSegmentEntry) Size() int64 {
+	return e.size
+}
+
+func (e *fakeSegmentEntry) ModTime() time.Time {
+	return e.modTime
+}
+
+func TestParseSegmentName(t *testing.T) {
+	offset, isSegment := parseSegmentName(segmentName(3 * segmentSize))
+	assert.True(t, isSegment)
+	assert.Equal(t, int64(3*segmentSize), offset)
+
+	_, isSegment = parseSegmentName("/dev/sdb")
+	assert.False(t, isSegment)
+
+	_, isSegment = parseSegmentName(segmentNamePrefix + "invalid")
+	assert.False(t, isSegment)
+}
+
+func TestSegmentModTime(t *testing.T) {
+	parentTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	backupTime := parentTime.Add(24 * time.Hour)
+	parentSegments := map[string]fs.Entry{
+		segmentName(0):           &fakeSegmentEntry{size: segmentSize, modTime: parentTime},
+		segmentName(segmentSize): &fakeSegmentEntry{size: 4096, modTime: parentTime},
+	}
+	changed := &uploader.ChangedBlocks{
+		BaseSnapshotHandle: "snap-1",
+		Blocks:             []uploader.BlockRange{{Offset: 2 * segmentSize, Length: 4096}},
+	}
+
+	tests := []struct {
+		name     string
+		offset   int64
+		length   int64
+		changed  *uploader.ChangedBlocks
+		expected time.Time
+	}{
+		{
+			name:     "an unchanged segment keeps the time of the parent",
+			offset:   0,
+			length:   segmentSize,
+			changed:  changed,
+			expected: parentTime,
+		},
+		{
+			name:     "a segment is read without changed blocks to rely on",
+			offset:   0,
+			length:   segmentSize,
+			expected: backupTime,
+		},
+		{
+			name:     "a segment resized since the parent is read",
+			offset:   segmentSize,
+			length:   segmentSize,
+			changed:  changed,
+			expected: backupTime,
+		},
+		{
+			name:     "a segment missing from the parent is read",
+			offset:   2 * segmentSize,
+			length:   segmentSize,
+			changed:  &uploader.ChangedBlocks{},
+			expected: backupTime,
+		},
+		{
+			name:     "a segment with changed blocks is read",
+			offset:   0,
+			length:   segmentSize,
+			changed:  &uploader.ChangedBlocks{Blocks: []uploader.BlockRange{{Offset: 512, Length: 512}}},
+			expected: backupTime,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, segmentModTime(tc.offset, tc.length, parentSegments, tc.changed, backupTime))
+		})
+	}
+}
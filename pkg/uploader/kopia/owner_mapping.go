@@ -0,0 +1,65 @@
+//go:build !windows
+// +build !windows
+
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopia
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// remapOwners changes the owners of the files restored under root according to the user and
+// group ID mappings, the IDs not mapped are kept.
+func remapOwners(root string, uidMapping, gidMapping map[uint32]uint32) error {
+	return filepath.WalkDir(root, func(path string, _ fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		info, err := os.Lstat(path)
+		if err != nil {
+			return errors.Wrapf(err, "error getting the owner of %s", path)
+		}
+		stat, ok := info.Sys().(*syscall.Stat_t)
+		if !ok {
+			return errors.Errorf("unable to get the owner of %s", path)
+		}
+
+		uid, uidMapped := uidMapping[stat.Uid]
+		gid, gidMapped := gidMapping[stat.Gid]
+		if !uidMapped && !gidMapped {
+			return nil
+		}
+		if !uidMapped {
+			uid = stat.Uid
+		}
+		if !gidMapped {
+			gid = stat.Gid
+		}
+
+		if err := os.Lchown(path, int(uid), int(gid)); err != nil {
+			return errors.Wrapf(err, "error changing the owner of %s", path)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,60 @@
+//go:build !windows
+// +build !windows
+
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopia
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemapOwners(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("changing the owners of files requires root")
+	}
+
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "dir"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "dir", "mapped"), nil, 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "kept"), nil, 0o644))
+	require.NoError(t, os.Lchown(filepath.Join(root, "dir", "mapped"), 1000, 1000))
+	require.NoError(t, os.Lchown(filepath.Join(root, "kept"), 1001, 1001))
+
+	require.NoError(t, remapOwners(root, map[uint32]uint32{1000: 2000}, map[uint32]uint32{1000: 3000}))
+
+	owner := func(path string) (uint32, uint32) {
+		info, err := os.Lstat(filepath.Join(root, path))
+		require.NoError(t, err)
+		stat := info.Sys().(*syscall.Stat_t)
+		return stat.Uid, stat.Gid
+	}
+
+	uid, gid := owner("dir/mapped")
+	assert.Equal(t, uint32(2000), uid)
+	assert.Equal(t, uint32(3000), gid)
+
+	uid, gid = owner("kept")
+	assert.Equal(t, uint32(1001), uid)
+	assert.Equal(t, uint32(1001), gid)
+}
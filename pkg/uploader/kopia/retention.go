@@ -0,0 +1,59 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopia
+
+import (
+	"slices"
+	"time"
+
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+)
+
+const (
+	// VeleroPin pins the snapshots taken by Velero, so the kopia retention policies never expire
+	// them, Velero deleting them along with their backups.
+	VeleroPin = "velero-pin"
+
+	// VeleroRetentionReason is the kopia retention reason of the snapshots of the backups which
+	// haven't expired, followed by their expiration.
+	VeleroRetentionReason = "velero-until-"
+
+	// VeleroExpiredReason is the kopia retention reason of the snapshots of the expired backups,
+	// which are kept until Velero deletes them along with their backups.
+	VeleroExpiredReason = "velero-expired"
+
+	// VeleroUnknownExpirationReason is the kopia retention reason of the snapshots taken by Velero
+	// whose backups don't exist or don't expire.
+	VeleroUnknownExpirationReason = "velero-unknown-expiration"
+)
+
+// RetentionReason translates the Velero retention of a snapshot, the current expiration of its
+// backup, into a kopia retention reason, the way the kopia retention policies explain why they
+// keep a snapshot. The expiration is nil when the backup doesn't exist. It returns an empty reason
+// for the snapshots Velero doesn't manage.
+func RetentionReason(tags map[string]string, pins []string, expiration *time.Time, now time.Time) string {
+	if !slices.Contains(pins, VeleroPin) && tags[uploader.SnapshotRequesterTag] == "" {
+		return ""
+	}
+	if expiration == nil || expiration.IsZero() {
+		return VeleroUnknownExpirationReason
+	}
+	if !now.Before(*expiration) {
+		return VeleroExpiredReason
+	}
+	return VeleroRetentionReason + expiration.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,68 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopia
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+)
+
+func TestRetentionReason(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	expiration := time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC)
+	expired := time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		tags       map[string]string
+		pins       []string
+		expiration *time.Time
+		expected   string
+	}{
+		{
+			name:       "snapshot not taken by Velero",
+			expiration: &expiration,
+			expected:   "",
+		},
+		{
+			name:       "backup not expired",
+			pins:       []string{VeleroPin},
+			expiration: &expiration,
+			expected:   "velero-until-2026-10-31T00:00:00Z",
+		},
+		{
+			name:       "backup expired",
+			tags:       map[string]string{uploader.SnapshotRequesterTag: "pod-volume-backup-restore"},
+			expiration: &expired,
+			expected:   VeleroExpiredReason,
+		},
+		{
+			name:     "backup not found",
+			pins:     []string{VeleroPin},
+			expected: VeleroUnknownExpirationReason,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, RetentionReason(tc.tags, tc.pins, tc.expiration, now))
+		})
+	}
+}
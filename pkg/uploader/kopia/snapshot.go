@@ -19,6 +19,7 @@ package kopia
 import (
 	"context"
 	"fmt"
+	"io"
 	"math"
 	"os"
 	"path/filepath"
@@ -174,7 +175,25 @@ func Backup(ctx context.Context, fsUploader SnapshotUploader, repoWriter repo.Re
 
 	var sourceEntry fs.Entry
 
-	if volMode == uploader.PersistentVolumeBlock {
+	kopiaCtx := kopia.SetupKopiaLog(ctx, log)
+
+	changedBlockTracking, err := uploaderutil.GetChangedBlockTracking(uploaderCfg)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "unable to get changed block tracking config")
+	}
+
+	if volMode == uploader.PersistentVolumeBlock && changedBlockTracking {
+		changed := loadChangedBlocks(uploaderCfg, log)
+		parentSegments := getParentSegments(kopiaCtx, repoWriter, parentSnapshot, changed, log)
+		log.Infof("Backing up block device by segments, %d segments of the parent snapshot to reuse", len(parentSegments))
+
+		var device io.Closer
+		sourceEntry, device, err = getSegmentedBlockEntry(source, parentSegments, changed)
+		if err != nil {
+			return nil, false, errors.Wrap(err, "unable to get local block device entry")
+		}
+		defer device.Close()
+	} else if volMode == uploader.PersistentVolumeBlock {
 		sourceEntry, err = getLocalBlockEntry(source)
 		if err != nil {
 			return nil, false, errors.Wrap(err, "unable to get local block device entry")
@@ -186,8 +205,6 @@ func Backup(ctx context.Context, fsUploader SnapshotUploader, repoWriter repo.Re
 		}
 	}
 
-	kopiaCtx := kopia.SetupKopiaLog(ctx, log)
-
 	snapID, snapshotSize, err := SnapshotSource(kopiaCtx, repoWriter, fsUploader, sourceInfo, sourceEntry, forceFull, parentSnapshot, tags, uploaderCfg, log, "Kopia Uploader")
 	snapshotInfo := &uploader.SnapshotInfo{
 		ID:   snapID,
@@ -284,7 +301,7 @@ func SnapshotSource(
 	manifest.Tags = snapshotTags
 
 	manifest.Description = description
-	manifest.Pins = []string{"velero-pin"}
+	manifest.Pins = []string{VeleroPin}
 
 	if _, err = saveSnapshotFunc(ctx, rep, manifest); err != nil {
 		return "", 0, errors.Wrapf(err, "Failed to save kopia manifest %v", manifest.ID)
@@ -408,6 +425,7 @@ func Restore(ctx context.Context, rep repo.RepositoryWriter, progress *Progress,
 	}
 
 	restoreConcurrency := runtime.NumCPU()
+	var uidMapping, gidMapping map[uint32]uint32
 
 	if len(uploaderCfg) > 0 {
 		writeSparseFiles, err := uploaderutil.GetWriteSparseFiles(uploaderCfg)
@@ -425,6 +443,16 @@ func Restore(ctx context.Context, rep repo.RepositoryWriter, progress *Progress,
 		if concurrency > 0 {
 			restoreConcurrency = concurrency
 		}
+
+		if uidMapping, err = uploaderutil.GetIDMapping(uploaderCfg, uploaderutil.UIDMapping); err != nil {
+			return 0, 0, errors.Wrap(err, "failed to get uploader config")
+		}
+		if gidMapping, err = uploaderutil.GetIDMapping(uploaderCfg, uploaderutil.GIDMapping); err != nil {
+			return 0, 0, errors.Wrap(err, "failed to get uploader config")
+		}
+		if (len(uidMapping) > 0 || len(gidMapping) > 0) && volMode == uploader.PersistentVolumeBlock {
+			return 0, 0, errors.New("the ID mappings only apply to Filesystem volumes")
+		}
 	}
 
 	log.Debugf("Restore filesystem output %v, concurrency %d", fsOutput, restoreConcurrency)
@@ -453,5 +481,12 @@ func Restore(ctx context.Context, rep repo.RepositoryWriter, progress *Progress,
 	if err != nil {
 		return 0, 0, errors.Wrapf(err, "Failed to copy snapshot data to the target")
 	}
+
+	if len(uidMapping) > 0 || len(gidMapping) > 0 {
+		log.Infof("Remapping the owners of the restored files, user IDs %v, group IDs %v", uidMapping, gidMapping)
+		if err := remapOwners(path, uidMapping, gidMapping); err != nil {
+			return 0, 0, errors.Wrap(err, "failed to remap the owners of the restored files")
+		}
+	}
 	return stat.RestoredTotalFileSize, stat.RestoredFileCount, nil
 }
@@ -250,5 +250,12 @@ func (rp *resticProvider) parseRestoreExtraFlags(uploaderCfg map[string]string)
 		return extraFlags, errors.New("restic does not support parallel restore")
 	}
 
+	if _, ok := uploaderCfg[uploaderutil.UIDMapping]; ok {
+		return extraFlags, errors.New("restic does not support ID mapping")
+	}
+	if _, ok := uploaderCfg[uploaderutil.GIDMapping]; ok {
+		return extraFlags, errors.New("restic does not support ID mapping")
+	}
+
 	return extraFlags, nil
 }
@@ -18,7 +18,10 @@ package uploader
 
 import (
 	"fmt"
+	"sort"
 	"strings"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
 
 const (
@@ -26,8 +29,34 @@ const (
 	KopiaType            = "kopia"
 	SnapshotRequesterTag = "snapshot-requester"
 	SnapshotUploaderTag  = "snapshot-uploader"
+	// SnapshotHandleTag records the handle of the CSI snapshot a block volume is backed up from
+	SnapshotHandleTag = "snapshot-handle"
+	// SnapshotBackupTag, SnapshotBackupUIDTag and SnapshotScheduleTag record the backup a snapshot is
+	// taken for and its schedule
+	SnapshotBackupTag    = "backup"
+	SnapshotBackupUIDTag = "backup-uid"
+	SnapshotScheduleTag  = "schedule"
+	// SnapshotClusterTag records the ID of the cluster a snapshot is taken in
+	SnapshotClusterTag = "cluster"
 )
 
+// BackupSnapshotTags returns the tags of the snapshots taken for the backup in the cluster with
+// the ID: the backup, its schedule and the cluster, so the snapshots can be related to the backup
+// outside of Velero. The expiration of the backup isn't recorded, since it changes with its TTL.
+func BackupSnapshotTags(backup *velerov1api.Backup, clusterID string) map[string]string {
+	tags := map[string]string{
+		SnapshotBackupTag:    backup.Name,
+		SnapshotBackupUIDTag: string(backup.UID),
+	}
+	if schedule := backup.Labels[velerov1api.ScheduleNameLabel]; schedule != "" {
+		tags[SnapshotScheduleTag] = schedule
+	}
+	if clusterID != "" {
+		tags[SnapshotClusterTag] = clusterID
+	}
+	return tags
+}
+
 type PersistentVolumeMode string
 
 const (
@@ -57,6 +86,28 @@ type SnapshotInfo struct {
 	Size int64  `json:"Size"`
 }
 
+// BlockRange is a range of bytes of a block volume
+type BlockRange struct {
+	Offset int64 `json:"offset"`
+	Length int64 `json:"length"`
+}
+
+// ChangedBlocks are the blocks of a block volume changed since the CSI snapshot with the base
+// handle, the one the previous backup of the volume was taken from
+type ChangedBlocks struct {
+	BaseSnapshotHandle string       `json:"baseSnapshotHandle"`
+	Blocks             []BlockRange `json:"blocks"`
+}
+
+// Overlaps returns true if any of the changed blocks overlaps the range of the given offset and
+// length. The blocks are expected to be sorted by offset.
+func (c *ChangedBlocks) Overlaps(offset, length int64) bool {
+	i := sort.Search(len(c.Blocks), func(i int) bool {
+		return c.Blocks[i].Offset+c.Blocks[i].Length > offset
+	})
+	return i < len(c.Blocks) && c.Blocks[i].Offset < offset+length
+}
+
 // Progress which defined two variables to record progress
 type Progress struct {
 	TotalBytes int64 `json:"totalBytes,omitempty"`
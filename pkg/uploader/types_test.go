@@ -2,8 +2,12 @@ package uploader
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
 
 func TestValidateUploaderType(t *testing.T) {
@@ -45,3 +49,56 @@ func TestValidateUploaderType(t *testing.T) {
 		})
 	}
 }
+
+func TestChangedBlocksOverlaps(t *testing.T) {
+	changed := &ChangedBlocks{
+		Blocks: []BlockRange{
+			{Offset: 4096, Length: 4096},
+			{Offset: 65536, Length: 1024},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		offset int64
+		length int64
+		want   bool
+	}{
+		{"range before the blocks", 0, 4096, false},
+		{"range covering a block", 0, 8192, true},
+		{"range inside a block", 5000, 10, true},
+		{"range between the blocks", 8192, 57344, false},
+		{"range ending in a block", 60000, 6000, true},
+		{"range after the blocks", 66560, 4096, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, changed.Overlaps(tt.offset, tt.length))
+		})
+	}
+}
+
+func TestBackupSnapshotTags(t *testing.T) {
+	backup := &velerov1api.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "daily-20261001",
+			UID:    "uid-1",
+			Labels: map[string]string{velerov1api.ScheduleNameLabel: "daily"},
+		},
+		Status: velerov1api.BackupStatus{
+			Expiration: &metav1.Time{Time: time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+	assert.Equal(t, map[string]string{
+		SnapshotBackupTag:    "daily-20261001",
+		SnapshotBackupUIDTag: "uid-1",
+		SnapshotScheduleTag:  "daily",
+		SnapshotClusterTag:   "cluster-1",
+	}, BackupSnapshotTags(backup, "cluster-1"))
+
+	// no schedule nor cluster
+	assert.Equal(t, map[string]string{
+		SnapshotBackupTag:    "backup-1",
+		SnapshotBackupUIDTag: "",
+	}, BackupSnapshotTags(&velerov1api.Backup{ObjectMeta: metav1.ObjectMeta{Name: "backup-1"}}, ""))
+}
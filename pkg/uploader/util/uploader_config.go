@@ -17,17 +17,26 @@ limitations under the License.
 package util
 
 import (
+	"encoding/json"
+	"os"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
 )
 
 const (
-	ParallelFilesUpload = "ParallelFilesUpload"
-	WriteSparseFiles    = "WriteSparseFiles"
-	RestoreConcurrency  = "ParallelFilesDownload"
+	ParallelFilesUpload  = "ParallelFilesUpload"
+	WriteSparseFiles     = "WriteSparseFiles"
+	RestoreConcurrency   = "ParallelFilesDownload"
+	UIDMapping           = "UIDMapping"
+	GIDMapping           = "GIDMapping"
+	ChangedBlockTracking = "ChangedBlockTracking"
+	ChangedBlocksFile    = "ChangedBlocksFile"
 )
 
 func StoreBackupConfig(config *velerov1api.UploaderConfigForBackup) map[string]string {
@@ -47,9 +56,73 @@ func StoreRestoreConfig(config *velerov1api.UploaderConfigForRestore) map[string
 	if config.ParallelFilesDownload > 0 {
 		data[RestoreConcurrency] = strconv.Itoa(config.ParallelFilesDownload)
 	}
+
+	if len(config.UIDMapping) > 0 {
+		data[UIDMapping] = storeIDMapping(config.UIDMapping)
+	}
+	if len(config.GIDMapping) > 0 {
+		data[GIDMapping] = storeIDMapping(config.GIDMapping)
+	}
 	return data
 }
 
+// storeIDMapping returns the ID mapping in the form src1:dst1,src2:dst2,...
+func storeIDMapping(mapping map[string]string) string {
+	entries := make([]string, 0, len(mapping))
+	for src, dst := range mapping {
+		entries = append(entries, src+":"+dst)
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ",")
+}
+
+// ValidateIDMapping returns an error if the IDs of the mapping aren't valid user or group IDs.
+func ValidateIDMapping(mapping map[string]string) error {
+	for src, dst := range mapping {
+		if _, err := parseID(src); err != nil {
+			return err
+		}
+		if _, err := parseID(dst); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseID(id string) (uint32, error) {
+	parsed, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return 0, errors.Errorf("invalid ID %q in the ID mapping", id)
+	}
+	return uint32(parsed), nil
+}
+
+// GetIDMapping returns the user or group ID mapping stored under key, none if unset.
+func GetIDMapping(uploaderCfg map[string]string, key string) (map[uint32]uint32, error) {
+	stored, ok := uploaderCfg[key]
+	if !ok || stored == "" {
+		return nil, nil
+	}
+
+	mapping := make(map[uint32]uint32)
+	for _, entry := range strings.Split(stored, ",") {
+		src, dst, found := strings.Cut(entry, ":")
+		if !found {
+			return nil, errors.Errorf("failed to parse %s config: invalid entry %q", key, entry)
+		}
+		srcID, err := parseID(src)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %s config", key)
+		}
+		dstID, err := parseID(dst)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %s config", key)
+		}
+		mapping[srcID] = dstID
+	}
+	return mapping, nil
+}
+
 func GetParallelFilesUpload(uploaderCfg map[string]string) (int, error) {
 	parallelFilesUpload, ok := uploaderCfg[ParallelFilesUpload]
 	if ok {
@@ -62,6 +135,38 @@ func GetParallelFilesUpload(uploaderCfg map[string]string) (int, error) {
 	return 0, nil
 }
 
+func GetChangedBlockTracking(uploaderCfg map[string]string) (bool, error) {
+	changedBlockTracking, ok := uploaderCfg[ChangedBlockTracking]
+	if ok {
+		changedBlockTrackingBool, err := strconv.ParseBool(changedBlockTracking)
+		if err != nil {
+			return false, errors.Wrap(err, "failed to parse ChangedBlockTracking config")
+		}
+		return changedBlockTrackingBool, nil
+	}
+	return false, nil
+}
+
+// GetChangedBlocks returns the changed blocks read from the file stored under ChangedBlocksFile,
+// nil if unset.
+func GetChangedBlocks(uploaderCfg map[string]string) (*uploader.ChangedBlocks, error) {
+	path, ok := uploaderCfg[ChangedBlocksFile]
+	if !ok || path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read changed blocks file %s", path)
+	}
+
+	changed := new(uploader.ChangedBlocks)
+	if err := json.Unmarshal(data, changed); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse changed blocks file %s", path)
+	}
+	return changed, nil
+}
+
 func GetWriteSparseFiles(uploaderCfg map[string]string) (bool, error) {
 	writeSparseFiles, ok := uploaderCfg[WriteSparseFiles]
 	if ok {
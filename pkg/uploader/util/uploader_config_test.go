@@ -17,12 +17,17 @@ limitations under the License.
 package util
 
 import (
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
 )
 
 func TestStoreBackupConfig(t *testing.T) {
@@ -88,6 +93,18 @@ func TestStoreRestoreConfig(t *testing.T) {
 				WriteSparseFiles:   "false",
 			},
 		},
+		{
+			name: "ID mappings are set",
+			config: &velerov1api.UploaderConfigForRestore{
+				UIDMapping: map[string]string{"1001": "2001", "1000": "2000"},
+				GIDMapping: map[string]string{"100": "200"},
+			},
+			expectedData: map[string]string{
+				WriteSparseFiles: "false",
+				UIDMapping:       "1000:2000,1001:2001",
+				GIDMapping:       "100:200",
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -143,6 +160,57 @@ func TestGetParallelFilesUpload(t *testing.T) {
 	}
 }
 
+func TestGetChangedBlocks(t *testing.T) {
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "valid.json")
+	require.NoError(t, os.WriteFile(valid, []byte(`{"baseSnapshotHandle":"snap-1","blocks":[{"offset":0,"length":4096}]}`), 0600))
+	invalid := filepath.Join(dir, "invalid.json")
+	require.NoError(t, os.WriteFile(invalid, []byte("invalid"), 0600))
+
+	tests := []struct {
+		name           string
+		uploaderCfg    map[string]string
+		expectedResult *uploader.ChangedBlocks
+		expectedError  string
+	}{
+		{
+			name:        "Missing ChangedBlocksFile",
+			uploaderCfg: map[string]string{},
+		},
+		{
+			name:        "Valid ChangedBlocksFile",
+			uploaderCfg: map[string]string{ChangedBlocksFile: valid},
+			expectedResult: &uploader.ChangedBlocks{
+				BaseSnapshotHandle: "snap-1",
+				Blocks:             []uploader.BlockRange{{Offset: 0, Length: 4096}},
+			},
+		},
+		{
+			name:          "Invalid ChangedBlocksFile",
+			uploaderCfg:   map[string]string{ChangedBlocksFile: invalid},
+			expectedError: "failed to parse changed blocks file",
+		},
+		{
+			name:          "Nonexistent ChangedBlocksFile",
+			uploaderCfg:   map[string]string{ChangedBlocksFile: filepath.Join(dir, "nonexistent.json")},
+			expectedError: "failed to read changed blocks file",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := GetChangedBlocks(test.uploaderCfg)
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResult, result)
+		})
+	}
+}
+
 func TestGetWriteSparseFiles(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -240,3 +308,50 @@ func TestGetRestoreConcurrency(t *testing.T) {
 		})
 	}
 }
+
+func TestGetIDMapping(t *testing.T) {
+	testCases := []struct {
+		name             string
+		uploaderCfg      map[string]string
+		expectedResult   map[uint32]uint32
+		expectedErrorMsg string
+	}{
+		{
+			name:           "Valid Configuration",
+			uploaderCfg:    map[string]string{UIDMapping: "1000:2000,1001:2001"},
+			expectedResult: map[uint32]uint32{1000: 2000, 1001: 2001},
+		},
+		{
+			name:        "Missing Configuration",
+			uploaderCfg: map[string]string{},
+		},
+		{
+			name:             "Invalid Entry",
+			uploaderCfg:      map[string]string{UIDMapping: "1000"},
+			expectedErrorMsg: "failed to parse UIDMapping config: invalid entry \"1000\"",
+		},
+		{
+			name:             "Invalid ID",
+			uploaderCfg:      map[string]string{UIDMapping: "1000:-1"},
+			expectedErrorMsg: "failed to parse UIDMapping config: invalid ID \"-1\" in the ID mapping",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := GetIDMapping(tc.uploaderCfg, UIDMapping)
+
+			if tc.expectedErrorMsg != "" {
+				if err == nil || err.Error() != tc.expectedErrorMsg {
+					t.Errorf("Expected error message %s, but got %v", tc.expectedErrorMsg, err)
+				}
+			} else if err != nil {
+				t.Errorf("Expected no error, but got %v", err)
+			}
+
+			if !reflect.DeepEqual(result, tc.expectedResult) {
+				t.Errorf("Expected result %v, but got %v", tc.expectedResult, result)
+			}
+		})
+	}
+}
@@ -27,8 +27,8 @@ import (
 )
 
 // NewCredential constructs a Credential that tries the config credential, workload identity credential
-// and managed identity credential according to the provided creds.
-func NewCredential(creds map[string]string, options policy.ClientOptions) (azcore.TokenCredential, error) {
+// and managed identity credential according to the provided BSL/VSL config and creds.
+func NewCredential(locationCfg, creds map[string]string, options policy.ClientOptions) (azcore.TokenCredential, error) {
 	additionalTenants := []string{}
 	if tenants := creds[CredentialKeyAdditionallyAllowedTenants]; tenants != "" {
 		additionalTenants = strings.Split(tenants, ";")
@@ -42,25 +42,47 @@ func NewCredential(creds map[string]string, options policy.ClientOptions) (azcor
 		return newConfigCredential(creds, configCredentialOptions{
 			ClientOptions:              options,
 			AdditionallyAllowedTenants: additionalTenants,
+			DisableInstanceDiscovery:   isADFS(options.Cloud.ActiveDirectoryAuthorityHost),
 		})
 	}
 
-	// workload identity credential
-	if len(os.Getenv("AZURE_FEDERATED_TOKEN_FILE")) > 0 {
+	// the client ID set in the BSL/VSL config lets the locations of the same install use different
+	// identities federated with the service account of Velero
+	clientID := GetFromLocationConfigOrCredential(locationCfg, creds, BSLConfigClientID, CredentialKeyClientID)
+
+	// workload identity credential, the client ID, tenant ID and token file which aren't set in the
+	// BSL/VSL config or the credentials defaulting to the environment variables injected by the
+	// workload identity webhook
+	if tokenFile := getFederatedTokenFile(locationCfg, creds); tokenFile != "" {
 		return azidentity.NewWorkloadIdentityCredential(&azidentity.WorkloadIdentityCredentialOptions{
 			AdditionallyAllowedTenants: additionalTenants,
 			ClientOptions:              options,
+			ClientID:                   clientID,
+			DisableInstanceDiscovery:   isADFS(options.Cloud.ActiveDirectoryAuthorityHost),
+			TenantID:                   GetFromLocationConfigOrCredential(locationCfg, creds, BSLConfigTenantID, CredentialKeyTenantID),
+			TokenFilePath:              tokenFile,
 		})
 	}
 
 	// managed identity credential
-	o := &azidentity.ManagedIdentityCredentialOptions{ClientOptions: options, ID: azidentity.ClientID(creds[CredentialKeyClientID])}
+	o := &azidentity.ManagedIdentityCredentialOptions{ClientOptions: options, ID: azidentity.ClientID(clientID)}
 	return azidentity.NewManagedIdentityCredential(o)
 }
 
+// getFederatedTokenFile returns the file of the projected service account token federated with
+// the identity, from the BSL/VSL config, the credentials or the environment variable injected by
+// the workload identity webhook. It's empty when the workload identity isn't used.
+func getFederatedTokenFile(locationCfg, creds map[string]string) string {
+	if tokenFile := GetFromLocationConfigOrCredential(locationCfg, creds, BSLConfigFederatedTokenFile, CredentialKeyFederatedTokenFile); tokenFile != "" {
+		return tokenFile
+	}
+	return os.Getenv(CredentialKeyFederatedTokenFile)
+}
+
 type configCredentialOptions struct {
 	azcore.ClientOptions
 	AdditionallyAllowedTenants []string
+	DisableInstanceDiscovery   bool
 }
 
 // newConfigCredential works similar as the azidentity.EnvironmentCredential but reads the credentials from a map
@@ -81,6 +103,7 @@ func newConfigCredential(creds map[string]string, options configCredentialOption
 		return azidentity.NewClientSecretCredential(tenantID, clientID, clientSecret, &azidentity.ClientSecretCredentialOptions{
 			AdditionallyAllowedTenants: options.AdditionallyAllowedTenants,
 			ClientOptions:              options.ClientOptions,
+			DisableInstanceDiscovery:   options.DisableInstanceDiscovery,
 		})
 	}
 
@@ -106,6 +129,7 @@ func newConfigCredential(creds map[string]string, options configCredentialOption
 		o := &azidentity.ClientCertificateCredentialOptions{
 			AdditionallyAllowedTenants: options.AdditionallyAllowedTenants,
 			ClientOptions:              options.ClientOptions,
+			DisableInstanceDiscovery:   options.DisableInstanceDiscovery,
 		}
 		if v, ok := creds[CredentialKeySendCertChain]; ok {
 			o.SendCertificateChain = v == "1" || strings.ToLower(v) == "true"
@@ -120,6 +144,7 @@ func newConfigCredential(creds map[string]string, options configCredentialOption
 				&azidentity.UsernamePasswordCredentialOptions{
 					AdditionallyAllowedTenants: options.AdditionallyAllowedTenants,
 					ClientOptions:              options.ClientOptions,
+					DisableInstanceDiscovery:   options.DisableInstanceDiscovery,
 				})
 		}
 		return nil, errors.Errorf("%s is required", CredentialKeyPassword)
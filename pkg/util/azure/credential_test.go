@@ -34,7 +34,7 @@ func TestNewCredential(t *testing.T) {
 		CredentialKeyClientID:     "clientid",
 		CredentialKeyClientSecret: "secret",
 	}
-	_, err := NewCredential(creds, options)
+	_, err := NewCredential(nil, creds, options)
 	require.Error(t, err)
 
 	// valid client secret credential
@@ -43,7 +43,7 @@ func TestNewCredential(t *testing.T) {
 		CredentialKeyClientID:     "clientid",
 		CredentialKeyClientSecret: "secret",
 	}
-	tokenCredential, err := NewCredential(creds, options)
+	tokenCredential, err := NewCredential(nil, creds, options)
 	require.NoError(t, err)
 	assert.IsType(t, &azidentity.ClientSecretCredential{}, tokenCredential)
 
@@ -55,7 +55,7 @@ func TestNewCredential(t *testing.T) {
 		CredentialKeyClientID:          "clientid",
 		CredentialKeyClientCertificate: certData,
 	}
-	tokenCredential, err = NewCredential(creds, options)
+	tokenCredential, err = NewCredential(nil, creds, options)
 	require.NoError(t, err)
 	assert.IsType(t, &azidentity.ClientCertificateCredential{}, tokenCredential)
 
@@ -64,18 +64,43 @@ func TestNewCredential(t *testing.T) {
 	os.Setenv(CredentialKeyClientID, "clientid")
 	os.Setenv("AZURE_FEDERATED_TOKEN_FILE", "/tmp/token")
 	creds = map[string]string{}
-	tokenCredential, err = NewCredential(creds, options)
+	tokenCredential, err = NewCredential(nil, creds, options)
 	require.NoError(t, err)
 	assert.IsType(t, &azidentity.WorkloadIdentityCredential{}, tokenCredential)
 	os.Clearenv()
 
+	// workload identity credentials of the locations bound to different client IDs, without
+	// environment variables
+	for _, clientID := range []string{"clientid-1", "clientid-2"} {
+		locationCfg := map[string]string{
+			BSLConfigTenantID:           "tenantid",
+			BSLConfigClientID:           clientID,
+			BSLConfigFederatedTokenFile: "/var/run/secrets/azure/tokens/azure-identity-token",
+		}
+		tokenCredential, err = NewCredential(locationCfg, map[string]string{}, options)
+		require.NoError(t, err)
+		assert.IsType(t, &azidentity.WorkloadIdentityCredential{}, tokenCredential)
+	}
+
+	// workload identity credential without tenant ID
+	_, err = NewCredential(map[string]string{BSLConfigClientID: "clientid", BSLConfigFederatedTokenFile: "/tmp/token"}, map[string]string{}, options)
+	require.Error(t, err)
+
 	// managed identity credential
 	creds = map[string]string{}
-	tokenCredential, err = NewCredential(creds, options)
+	tokenCredential, err = NewCredential(nil, creds, options)
 	require.NoError(t, err)
 	assert.IsType(t, &azidentity.ManagedIdentityCredential{}, tokenCredential)
 }
 
+func TestGetFederatedTokenFile(t *testing.T) {
+	t.Setenv(CredentialKeyFederatedTokenFile, "/env/token")
+
+	assert.Equal(t, "/config/token", getFederatedTokenFile(map[string]string{BSLConfigFederatedTokenFile: "/config/token"}, map[string]string{CredentialKeyFederatedTokenFile: "/creds/token"}))
+	assert.Equal(t, "/creds/token", getFederatedTokenFile(map[string]string{}, map[string]string{CredentialKeyFederatedTokenFile: "/creds/token"}))
+	assert.Equal(t, "/env/token", getFederatedTokenFile(nil, map[string]string{}))
+}
+
 func Test_newConfigCredential(t *testing.T) {
 	options := configCredentialOptions{}
 
@@ -41,8 +41,20 @@ const (
 	BSLConfigStorageAccountURI           = "storageAccountURI"
 	BSLConfigUseAAD                      = "useAAD"
 	BSLConfigActiveDirectoryAuthorityURI = "activeDirectoryAuthorityURI"
+	BSLConfigCloudName                   = "cloudName"
+	BSLConfigResourceManagerEndpoint     = "resourceManagerEndpoint"
+	BSLConfigResourceManagerAudience     = "resourceManagerAudience"
+	BSLConfigResourceManagerAPIVersion   = "resourceManagerAPIVersion"
+	BSLConfigStorageEndpointSuffix       = "storageEndpointSuffix"
+	BSLConfigTenantID                    = "tenantId"
+	BSLConfigClientID                    = "clientId"
+	BSLConfigFederatedTokenFile          = "federatedTokenFile"
 
 	serviceNameBlob cloud.ServiceName = "blob"
+
+	// azureStackStorageAPIVersion is the storage resource provider API version of the latest
+	// Azure Stack Hub profile, 2020-09-01-hybrid, the one of the SDK not being supported
+	azureStackStorageAPIVersion = "2019-06-01"
 )
 
 func init() {
@@ -112,7 +124,7 @@ func NewStorageClient(log logrus.FieldLogger, config map[string]string) (*azblob
 
 	// auth with Azure AD
 	log.Info("auth with Azure AD")
-	cred, err := NewCredential(creds, clientOptions)
+	cred, err := NewCredential(bslCfg, creds, clientOptions)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -255,11 +267,18 @@ func newStorageAccountManagemenClient(bslCfg map[string]string, creds map[string
 		return nil, err
 	}
 
-	cred, err := NewCredential(creds, clientOptions)
+	cred, err := NewCredential(bslCfg, creds, clientOptions)
 	if err != nil {
 		return nil, errors.WithMessage(err, "failed to create Azure AD credential")
 	}
 
+	// the API version is only overridden for the resource manager, the data plane and the
+	// credential keeping theirs
+	clientOptions.APIVersion = bslCfg[BSLConfigResourceManagerAPIVersion]
+	if clientOptions.APIVersion == "" && isAzureStack(bslCfg, creds) {
+		clientOptions.APIVersion = azureStackStorageAPIVersion
+	}
+
 	subID := GetFromLocationConfigOrCredential(bslCfg, creds, BSLConfigSubscriptionID, CredentialKeySubscriptionID)
 	if subID == "" {
 		return nil, errors.New("subscription ID is required in BSL or credential to create the storage account client")
@@ -128,6 +128,19 @@ func Test_getStorageAccountURI(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "https://.blob.core.windows.net", uri)
 
+	// no URI specified, on Azure Stack Hub
+	bslCfg = map[string]string{
+		BSLConfigStorageAccount:              "account",
+		BSLConfigStorageAccountAccessKeyName: "KEY",
+		BSLConfigCloudName:                   "AzureStackCloud",
+		BSLConfigResourceManagerEndpoint:     "https://management.local.azurestack.external",
+		BSLConfigResourceManagerAudience:     "audience",
+		BSLConfigActiveDirectoryAuthorityURI: "https://login.microsoftonline.com/",
+	}
+	uri, err = getStorageAccountURI(log, bslCfg, creds)
+	require.NoError(t, err)
+	assert.Equal(t, "https://account.blob.local.azurestack.external", uri)
+
 	// no URI specified, auth with AAD, resource group isn't specified
 	bslCfg = map[string]string{
 		BSLConfigSubscriptionID: "subscriptionid",
@@ -20,11 +20,14 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
@@ -49,6 +52,7 @@ const (
 	CredentialKeySendCertChain              = "AZURE_CLIENT_SEND_CERTIFICATE_CHAIN" // #nosec
 	CredentialKeyUsername                   = "AZURE_USERNAME"                      // #nosec
 	CredentialKeyPassword                   = "AZURE_PASSWORD"                      // #nosec
+	CredentialKeyFederatedTokenFile         = "AZURE_FEDERATED_TOKEN_FILE"          // #nosec
 
 	credentialFile = "credentialsFile"
 )
@@ -79,79 +83,224 @@ func LoadCredentials(config map[string]string) (map[string]string, error) {
 func GetClientOptions(locationCfg, creds map[string]string) (policy.ClientOptions, error) {
 	options := policy.ClientOptions{}
 
+	httpClient, err := newHTTPClient(locationCfg)
+	if err != nil {
+		return options, err
+	}
+	if httpClient != nil {
+		options.Transport = httpClient
+	}
+
 	cloudCfg, err := getCloudConfiguration(locationCfg, creds)
 	if err != nil {
 		return options, err
 	}
 	options.Cloud = cloudCfg
 
-	if locationCfg["caCert"] != "" {
-		certPool, _ := x509.SystemCertPool()
-		if certPool == nil {
-			certPool = x509.NewCertPool()
-		}
-		var caCert []byte
-		// As this function is used in both repository and plugin, the caCert isn't encoded
-		// when passing to the plugin while is encoded when works with repository, use one
-		// config item to distinguish these two cases
-		if locationCfg["caCertEncoded"] != "" {
-			caCert, err = base64.StdEncoding.DecodeString(locationCfg["caCert"])
-			if err != nil {
-				return options, err
-			}
-		} else {
-			caCert = []byte(locationCfg["caCert"])
-		}
+	return options, nil
+}
 
-		certPool.AppendCertsFromPEM(caCert)
-
-		// https://github.com/Azure/azure-sdk-for-go/blob/sdk/azcore/v1.6.1/sdk/azcore/runtime/transport_default_http_client.go#L19
-		transport := &http.Transport{
-			Proxy: http.ProxyFromEnvironment,
-			DialContext: (&net.Dialer{
-				Timeout:   30 * time.Second,
-				KeepAlive: 30 * time.Second,
-			}).DialContext,
-			ForceAttemptHTTP2:     true,
-			MaxIdleConns:          100,
-			IdleConnTimeout:       90 * time.Second,
-			TLSHandshakeTimeout:   10 * time.Second,
-			ExpectContinueTimeout: 1 * time.Second,
-			TLSClientConfig: &tls.Config{
-				MinVersion: tls.VersionTLS12,
-				RootCAs:    certPool,
-			},
-		}
-		options.Transport = &http.Client{
-			Transport: transport,
+// newHTTPClient returns the HTTP client trusting the CA certificate of the BSL/VSL config, nil
+// if there's none
+func newHTTPClient(locationCfg map[string]string) (*http.Client, error) {
+	if locationCfg["caCert"] == "" {
+		return nil, nil
+	}
+
+	certPool, _ := x509.SystemCertPool()
+	if certPool == nil {
+		certPool = x509.NewCertPool()
+	}
+	var caCert []byte
+	// As this function is used in both repository and plugin, the caCert isn't encoded
+	// when passing to the plugin while is encoded when works with repository, use one
+	// config item to distinguish these two cases
+	if locationCfg["caCertEncoded"] != "" {
+		var err error
+		caCert, err = base64.StdEncoding.DecodeString(locationCfg["caCert"])
+		if err != nil {
+			return nil, err
 		}
+	} else {
+		caCert = []byte(locationCfg["caCert"])
 	}
 
-	return options, nil
+	certPool.AppendCertsFromPEM(caCert)
+
+	// https://github.com/Azure/azure-sdk-for-go/blob/sdk/azcore/v1.6.1/sdk/azcore/runtime/transport_default_http_client.go#L19
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		TLSClientConfig: &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			RootCAs:    certPool,
+		},
+	}
+	return &http.Client{
+		Transport: transport,
+	}, nil
+}
+
+// getCloudName returns the upper-cased name of the cloud from the BSL/VSL config, or from the
+// credentials if it isn't set in the config
+func getCloudName(locationCfg, creds map[string]string) string {
+	return strings.ToUpper(GetFromLocationConfigOrCredential(locationCfg, creds, BSLConfigCloudName, CredentialKeyCloudName))
+}
+
+// isAzureStack returns whether the BSL/VSL is on Azure Stack Hub
+func isAzureStack(locationCfg, creds map[string]string) bool {
+	name := getCloudName(locationCfg, creds)
+	return name == "AZURESTACKCLOUD" || name == "AZURESTACKHUB"
 }
 
 // getCloudConfiguration based on the BSL/VSL config and credentials
 func getCloudConfiguration(locationCfg, creds map[string]string) (cloud.Configuration, error) {
-	name := creds[CredentialKeyCloudName]
-	activeDirectoryAuthorityURI := locationCfg[BSLConfigActiveDirectoryAuthorityURI]
+	name := getCloudName(locationCfg, creds)
 
 	var cfg cloud.Configuration
-	switch strings.ToUpper(name) {
+	switch name {
 	case "", "AZURECLOUD", "AZUREPUBLICCLOUD":
 		cfg = cloud.AzurePublic
 	case "AZURECHINACLOUD":
 		cfg = cloud.AzureChina
 	case "AZUREUSGOVERNMENT", "AZUREUSGOVERNMENTCLOUD":
 		cfg = cloud.AzureGovernment
+	case "AZURESTACKCLOUD", "AZURESTACKHUB":
+		return getAzureStackConfiguration(locationCfg)
 	default:
 		return cloud.Configuration{}, errors.New(fmt.Sprintf("unknown cloud: %s", name))
 	}
-	if activeDirectoryAuthorityURI != "" {
+
+	// the endpoints set in the config override the ones of the cloud, the services of the
+	// clouds of the SDK being copied not to change them for the other locations
+	if activeDirectoryAuthorityURI := locationCfg[BSLConfigActiveDirectoryAuthorityURI]; activeDirectoryAuthorityURI != "" {
 		cfg.ActiveDirectoryAuthorityHost = activeDirectoryAuthorityURI
 	}
+	endpoint, audience, suffix := locationCfg[BSLConfigResourceManagerEndpoint], locationCfg[BSLConfigResourceManagerAudience], locationCfg[BSLConfigStorageEndpointSuffix]
+	if endpoint == "" && audience == "" && suffix == "" {
+		return cfg, nil
+	}
+	services := make(map[cloud.ServiceName]cloud.ServiceConfiguration, len(cfg.Services))
+	for name, service := range cfg.Services {
+		services[name] = service
+	}
+	resourceManager := services[cloud.ResourceManager]
+	if endpoint != "" {
+		resourceManager.Endpoint = endpoint
+	}
+	if audience != "" {
+		resourceManager.Audience = audience
+	}
+	services[cloud.ResourceManager] = resourceManager
+	if suffix != "" {
+		services[serviceNameBlob] = cloud.ServiceConfiguration{Endpoint: "blob." + suffix}
+	}
+	cfg.Services = services
 	return cfg, nil
 }
 
+// azureStackMetadata is the part of the metadata of the endpoints of Azure Stack Hub Velero uses
+type azureStackMetadata struct {
+	Authentication struct {
+		LoginEndpoint string   `json:"loginEndpoint"`
+		Audiences     []string `json:"audiences"`
+	} `json:"authentication"`
+}
+
+// azureStackMetadataCache caches the metadata of the resource manager endpoints of Azure Stack Hub
+var azureStackMetadataCache sync.Map
+
+// getAzureStackConfiguration returns the configuration of the Azure Stack Hub of the resource
+// manager endpoint of the BSL/VSL config. The AAD or ADFS authority and the audience of the
+// resource manager are read from the metadata of the endpoint when not set in the config, the
+// suffix of the storage endpoints is the domain of the resource manager endpoint when not set.
+func getAzureStackConfiguration(locationCfg map[string]string) (cloud.Configuration, error) {
+	endpoint := strings.TrimSuffix(locationCfg[BSLConfigResourceManagerEndpoint], "/")
+	if endpoint == "" {
+		return cloud.Configuration{}, errors.Errorf("%s is required with Azure Stack Hub", BSLConfigResourceManagerEndpoint)
+	}
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil || endpointURL.Hostname() == "" {
+		return cloud.Configuration{}, errors.Errorf("invalid %s: %s", BSLConfigResourceManagerEndpoint, endpoint)
+	}
+
+	authority, audience := locationCfg[BSLConfigActiveDirectoryAuthorityURI], locationCfg[BSLConfigResourceManagerAudience]
+	if authority == "" || audience == "" {
+		metadata, err := getAzureStackMetadata(endpoint, locationCfg)
+		if err != nil {
+			return cloud.Configuration{}, err
+		}
+		if authority == "" {
+			authority = metadata.Authentication.LoginEndpoint
+		}
+		if audience == "" && len(metadata.Authentication.Audiences) > 0 {
+			audience = metadata.Authentication.Audiences[0]
+		}
+	}
+
+	// the endpoints of Azure Stack Hub are named like management.<region>.<FQDN> and
+	// <account>.blob.<region>.<FQDN>
+	suffix := locationCfg[BSLConfigStorageEndpointSuffix]
+	if suffix == "" {
+		suffix = strings.TrimPrefix(endpointURL.Hostname(), "management.")
+	}
+
+	return cloud.Configuration{
+		ActiveDirectoryAuthorityHost: authority,
+		Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+			cloud.ResourceManager: {Endpoint: endpoint, Audience: audience},
+			serviceNameBlob:       {Endpoint: "blob." + suffix},
+		},
+	}, nil
+}
+
+// getAzureStackMetadata gets the metadata of the resource manager endpoint of Azure Stack Hub
+func getAzureStackMetadata(endpoint string, locationCfg map[string]string) (*azureStackMetadata, error) {
+	if metadata, ok := azureStackMetadataCache.Load(endpoint); ok {
+		return metadata.(*azureStackMetadata), nil
+	}
+
+	httpClient, err := newHTTPClient(locationCfg)
+	if err != nil {
+		return nil, err
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	res, err := httpClient.Get(endpoint + "/metadata/endpoints?api-version=2015-01-01")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the metadata of the Azure Stack Hub endpoint %s", endpoint)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to get the metadata of the Azure Stack Hub endpoint %s: %s", endpoint, res.Status)
+	}
+
+	metadata := &azureStackMetadata{}
+	if err := json.NewDecoder(res.Body).Decode(metadata); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode the metadata of the Azure Stack Hub endpoint %s", endpoint)
+	}
+	if metadata.Authentication.LoginEndpoint == "" {
+		return nil, errors.Errorf("no login endpoint in the metadata of the Azure Stack Hub endpoint %s", endpoint)
+	}
+	azureStackMetadataCache.Store(endpoint, metadata)
+	return metadata, nil
+}
+
+// isADFS returns whether the authority is an AD FS one, like the AD FS of the disconnected
+// Azure Stack Hubs, which doesn't support the instance discovery of Microsoft Entra ID
+func isADFS(authority string) bool {
+	return strings.HasSuffix(strings.TrimSuffix(strings.ToLower(authority), "/"), "/adfs")
+}
+
 // GetFromLocationConfigOrCredential returns the value of the specified key from BSL/VSL config or credentials
 // as some common configuration items can be set in BSL/VSL config or credential file(such as the subscription ID or resource group)
 // Reading from BSL/VSL config takes first.
@@ -17,6 +17,9 @@ limitations under the License.
 package azure
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
@@ -90,6 +93,18 @@ func TestGetClientOptions(t *testing.T) {
 func Test_getCloudConfiguration(t *testing.T) {
 	publicCloudWithADURI := cloud.AzurePublic
 	publicCloudWithADURI.ActiveDirectoryAuthorityHost = "https://example.com"
+	chinaCloudWithEndpoints := cloud.AzureChina
+	chinaCloudWithEndpoints.Services = map[cloud.ServiceName]cloud.ServiceConfiguration{
+		cloud.ResourceManager: {Endpoint: "https://management.example.com", Audience: cloud.AzureChina.Services[cloud.ResourceManager].Audience},
+		serviceNameBlob:       {Endpoint: "blob.example.com"},
+	}
+	azureStack := cloud.Configuration{
+		ActiveDirectoryAuthorityHost: "https://adfs.local.azurestack.external/adfs",
+		Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+			cloud.ResourceManager: {Endpoint: "https://management.local.azurestack.external", Audience: "https://management.adfs.azurestack.local/guid"},
+			serviceNameBlob:       {Endpoint: "blob.local.azurestack.external"},
+		},
+	}
 	cases := []struct {
 		name     string
 		bslCfg   map[string]string
@@ -179,6 +194,48 @@ func Test_getCloudConfiguration(t *testing.T) {
 			err:      false,
 			expected: publicCloudWithADURI,
 		},
+		{
+			name: "cloud name of the config overrides the one of the credential",
+			bslCfg: map[string]string{
+				BSLConfigCloudName: "AzureChinaCloud",
+			},
+			creds: map[string]string{
+				CredentialKeyCloudName: "AZUREUSGOVERNMENT",
+			},
+			err:      false,
+			expected: cloud.AzureChina,
+		},
+		{
+			name: "endpoints provided",
+			bslCfg: map[string]string{
+				BSLConfigCloudName:               "AzureChinaCloud",
+				BSLConfigResourceManagerEndpoint: "https://management.example.com",
+				BSLConfigStorageEndpointSuffix:   "example.com",
+			},
+			creds:    map[string]string{},
+			err:      false,
+			expected: chinaCloudWithEndpoints,
+		},
+		{
+			name: "azure stack hub",
+			bslCfg: map[string]string{
+				BSLConfigCloudName:                   "AzureStackCloud",
+				BSLConfigResourceManagerEndpoint:     "https://management.local.azurestack.external/",
+				BSLConfigResourceManagerAudience:     "https://management.adfs.azurestack.local/guid",
+				BSLConfigActiveDirectoryAuthorityURI: "https://adfs.local.azurestack.external/adfs",
+			},
+			creds:    map[string]string{},
+			err:      false,
+			expected: azureStack,
+		},
+		{
+			name: "azure stack hub without resource manager endpoint",
+			bslCfg: map[string]string{
+				BSLConfigCloudName: "AzureStackHub",
+			},
+			creds: map[string]string{},
+			err:   true,
+		},
 	}
 
 	for _, c := range cases {
@@ -190,6 +247,44 @@ func Test_getCloudConfiguration(t *testing.T) {
 			}
 		})
 	}
+
+	// the clouds of the SDK aren't changed by the endpoints of the config
+	assert.Equal(t, "blob.core.chinacloudapi.cn", cloud.AzureChina.Services[serviceNameBlob].Endpoint)
+}
+
+func Test_getAzureStackConfiguration(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "/metadata/endpoints", r.URL.Path)
+		fmt.Fprint(w, `{"authentication":{"loginEndpoint":"https://login.microsoftonline.com/","audiences":["https://management.example.onmicrosoft.com/guid"]}}`)
+	}))
+	defer server.Close()
+
+	bslCfg := map[string]string{
+		BSLConfigCloudName:               "AzureStackCloud",
+		BSLConfigResourceManagerEndpoint: server.URL,
+		BSLConfigStorageEndpointSuffix:   "local.azurestack.external",
+	}
+	for range 2 {
+		cfg, err := getCloudConfiguration(bslCfg, map[string]string{})
+		require.NoError(t, err)
+		assert.Equal(t, cloud.Configuration{
+			ActiveDirectoryAuthorityHost: "https://login.microsoftonline.com/",
+			Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+				cloud.ResourceManager: {Endpoint: server.URL, Audience: "https://management.example.onmicrosoft.com/guid"},
+				serviceNameBlob:       {Endpoint: "blob.local.azurestack.external"},
+			},
+		}, cfg)
+	}
+	// the metadata is cached
+	assert.Equal(t, 1, requests)
+}
+
+func Test_isADFS(t *testing.T) {
+	assert.True(t, isADFS("https://adfs.local.azurestack.external/adfs/"))
+	assert.True(t, isADFS("https://adfs.local.azurestack.external/ADFS"))
+	assert.False(t, isADFS("https://login.microsoftonline.com/"))
 }
 
 func TestGetFromLocationConfigOrCredential(t *testing.T) {
@@ -471,12 +471,12 @@ func generateFilter(filter globStringSet, resources []string, mapFunc func(strin
 }
 
 // GetResourceIncludesExcludes takes the lists of resources to include and exclude, uses the
-// discovery helper to resolve them to fully-qualified group-resource names, and returns an
-// IncludesExcludes list.
+// discovery helper to resolve them to fully-qualified group-resource names, expanding the
+// categories of resources such as all, and returns an IncludesExcludes list.
 func GetResourceIncludesExcludes(helper discovery.Helper, includes, excludes []string) *IncludesExcludes {
 	resources := generateIncludesExcludes(
-		includes,
-		excludes,
+		expandResourceCategories(helper, includes),
+		expandResourceCategories(helper, excludes),
 		func(item string) string {
 			gvr, _, err := helper.ResourceFor(schema.ParseGroupResource(item).WithVersion(""))
 			if err != nil {
@@ -512,10 +512,10 @@ func GetGlobalResourceIncludesExcludes(helper discovery.Helper, logger logrus.Fi
 // but it's used for scoped Includes/Excludes, and can handle both cluster-scoped and namespace-scoped resources.
 func GetScopeResourceIncludesExcludes(helper discovery.Helper, logger logrus.FieldLogger, namespaceIncludes, namespaceExcludes, clusterIncludes, clusterExcludes []string, nsIncludesExcludes IncludesExcludes) *ScopeIncludesExcludes {
 	ret := generateScopedIncludesExcludes(
-		namespaceIncludes,
-		namespaceExcludes,
-		clusterIncludes,
-		clusterExcludes,
+		expandResourceCategories(helper, namespaceIncludes),
+		expandResourceCategories(helper, namespaceExcludes),
+		expandResourceCategories(helper, clusterIncludes),
+		expandResourceCategories(helper, clusterExcludes),
 		func(item string, namespaced bool) string {
 			gvr, resource, err := helper.ResourceFor(schema.ParseGroupResource(item).WithVersion(""))
 			if err != nil {
@@ -0,0 +1,192 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collections
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+)
+
+// maxResourceSuggestions is the most names suggested for an unknown resource.
+const maxResourceSuggestions = 3
+
+// isResourcePattern returns whether the item of a resource list is a glob pattern rather than
+// the name of a resource.
+func isResourcePattern(item string) bool {
+	return strings.ContainsAny(item, "*?[")
+}
+
+// resourceCategories returns the group-resources of the discovered resources by the categories
+// they belong to, e.g. all.
+func resourceCategories(helper discovery.Helper) map[string][]string {
+	categories := make(map[string][]string)
+	for _, resourceList := range helper.Resources() {
+		gv, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, resource := range resourceList.APIResources {
+			gr := schema.GroupResource{Group: gv.Group, Resource: resource.Name}
+			for _, category := range resource.Categories {
+				categories[category] = append(categories[category], gr.String())
+			}
+		}
+	}
+	return categories
+}
+
+// expandResourceCategories replaces the categories in the resource list, e.g. all, with the
+// resources they group. An item resolving to a resource is kept as it is, whether or not it's
+// also the name of a category.
+func expandResourceCategories(helper discovery.Helper, resources []string) []string {
+	var categories map[string][]string
+	expanded := make([]string, 0, len(resources))
+	for _, item := range resources {
+		if item == "*" || isResourcePattern(item) {
+			expanded = append(expanded, item)
+			continue
+		}
+		if _, _, err := helper.ResourceFor(schema.ParseGroupResource(item).WithVersion("")); err == nil {
+			expanded = append(expanded, item)
+			continue
+		}
+
+		if categories == nil {
+			categories = resourceCategories(helper)
+		}
+		if grs, found := categories[strings.ToLower(item)]; found {
+			expanded = append(expanded, grs...)
+			continue
+		}
+		expanded = append(expanded, item)
+	}
+	return expanded
+}
+
+// ValidateResourceNames checks that the items of the resource list are the names, short names
+// or singular names of the discovered resources, categories of resources or patterns, and returns
+// an error suggesting the closest names for each unknown resource.
+func ValidateResourceNames(helper discovery.Helper, resources []string) []error {
+	var errs []error
+	var categories map[string][]string
+	for _, item := range resources {
+		if item == "" || item == "*" || isResourcePattern(item) {
+			continue
+		}
+		if _, _, err := helper.ResourceFor(schema.ParseGroupResource(item).WithVersion("")); err == nil {
+			continue
+		}
+		if categories == nil {
+			categories = resourceCategories(helper)
+		}
+		if _, found := categories[strings.ToLower(item)]; found {
+			continue
+		}
+
+		msg := fmt.Sprintf("unknown resource %q", item)
+		if suggestions := suggestResourceNames(helper, item); len(suggestions) > 0 {
+			msg += fmt.Sprintf(", did you mean %s?", strings.Join(suggestions, ", "))
+		}
+		errs = append(errs, errors.New(msg))
+	}
+	return errs
+}
+
+// suggestResourceNames returns the names of the discovered resources and categories closest to
+// the unknown resource, the closest first. The short and singular names of the resources are
+// matched as well, but the resources are suggested by their names only.
+func suggestResourceNames(helper discovery.Helper, item string) []string {
+	item = strings.ToLower(item)
+	distances := make(map[string]int)
+	suggest := func(suggestion string, names ...string) {
+		for _, name := range names {
+			if name == "" {
+				continue
+			}
+			// a typo is at most a third of the name, e.g. 2 edits for deployments
+			distance := editDistance(item, name)
+			if distance > max(1, len(name)/3) {
+				continue
+			}
+			if current, found := distances[suggestion]; !found || distance < current {
+				distances[suggestion] = distance
+			}
+		}
+	}
+	for _, resourceList := range helper.Resources() {
+		gv, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, resource := range resourceList.APIResources {
+			if strings.Contains(item, ".") {
+				// the group is part of the name
+				qualified := schema.GroupResource{Group: gv.Group, Resource: resource.Name}.String()
+				suggest(qualified, qualified)
+				continue
+			}
+			suggest(resource.Name, append([]string{resource.Name, resource.SingularName}, resource.ShortNames...)...)
+			for _, category := range resource.Categories {
+				suggest(category, category)
+			}
+		}
+	}
+
+	suggestions := make([]string, 0, len(distances))
+	for suggestion := range distances {
+		suggestions = append(suggestions, suggestion)
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		if distances[suggestions[i]] != distances[suggestions[j]] {
+			return distances[suggestions[i]] < distances[suggestions[j]]
+		}
+		return suggestions[i] < suggestions[j]
+	})
+
+	var quoted []string
+	for i := 0; i < len(suggestions) && i < maxResourceSuggestions; i++ {
+		quoted = append(quoted, fmt.Sprintf("%q", suggestions[i]))
+	}
+	return quoted
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
@@ -0,0 +1,77 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collections
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func newResourceNamesHelper(t *testing.T) discovery.Helper {
+	t.Helper()
+
+	apiServer := test.NewAPIServer(t)
+	apiServer.DiscoveryClient.
+		WithAPIResource(test.Pods()).
+		WithAPIResource(test.Deployments()).
+		WithAPIResource(test.ConfigMaps()).
+		WithAPIResource(test.PVs())
+	// the resources of the all category, as kubectl get all
+	for _, resourceList := range apiServer.DiscoveryClient.Resources {
+		for i, resource := range resourceList.APIResources {
+			if resource.Name == "pods" || resource.Name == "deployments" {
+				resourceList.APIResources[i].Categories = []string{"all"}
+			}
+		}
+	}
+
+	helper, err := discovery.NewHelper(apiServer.DiscoveryClient, test.NewLogger())
+	require.NoError(t, err)
+	return helper
+}
+
+func TestGetResourceIncludesExcludesAliases(t *testing.T) {
+	helper := newResourceNamesHelper(t)
+
+	resources := GetResourceIncludesExcludes(helper, []string{"deploy", "configmap", "pv"}, nil)
+	assert.ElementsMatch(t, []string{"deployments.apps", "configmaps", "persistentvolumes"}, resources.GetIncludes())
+
+	resources = GetResourceIncludesExcludes(helper, []string{"all"}, []string{"po"})
+	assert.ElementsMatch(t, []string{"pods", "deployments.apps"}, resources.GetIncludes())
+	assert.Equal(t, []string{"pods"}, resources.GetExcludes())
+	assert.True(t, resources.ShouldInclude("deployments.apps"))
+	assert.False(t, resources.ShouldInclude("pods"))
+	assert.False(t, resources.ShouldInclude("configmaps"))
+}
+
+func TestValidateResourceNames(t *testing.T) {
+	helper := newResourceNamesHelper(t)
+
+	assert.Empty(t, ValidateResourceNames(helper, []string{"*", "pods", "po", "pod", "deploy", "deployments.apps", "all", "config*"}))
+
+	errs := ValidateResourceNames(helper, []string{"deploymnet", "configmps", "widgets", "deploymets.apps"})
+	require.Len(t, errs, 4)
+	assert.EqualError(t, errs[0], `unknown resource "deploymnet", did you mean "deployments"?`)
+	assert.EqualError(t, errs[1], `unknown resource "configmps", did you mean "configmaps"?`)
+	assert.EqualError(t, errs[2], `unknown resource "widgets"`)
+	assert.EqualError(t, errs[3], `unknown resource "deploymets.apps", did you mean "deployments.apps"?`)
+}
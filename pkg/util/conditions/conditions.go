@@ -0,0 +1,290 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conditions sets the standard status conditions of Velero resources from their phases.
+package conditions
+
+import (
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+)
+
+// state is the state of a resource derived from its phase.
+type state struct {
+	ready       bool
+	progressing bool
+	stalled     bool
+}
+
+// SetBackupConditions sets the conditions of the backup from its phase.
+func SetBackupConditions(backup *velerov1api.Backup, now time.Time) {
+	var s state
+	switch backup.Status.Phase {
+	case "", velerov1api.BackupPhaseNew, velerov1api.BackupPhaseInProgress,
+		velerov1api.BackupPhaseWaitingForPluginOperations, velerov1api.BackupPhaseWaitingForPluginOperationsPartiallyFailed,
+		velerov1api.BackupPhaseFinalizing, velerov1api.BackupPhaseFinalizingPartiallyFailed:
+		s.progressing = true
+	case velerov1api.BackupPhaseCompleted:
+		s.ready = true
+	case velerov1api.BackupPhaseFailed, velerov1api.BackupPhaseFailedValidation, velerov1api.BackupPhasePartiallyFailed:
+		s.stalled = true
+	}
+	message := backup.Status.FailureReason
+	if len(backup.Status.ValidationErrors) > 0 {
+		message = strings.Join(backup.Status.ValidationErrors, "; ")
+	}
+
+	setState(&backup.Status.Conditions, backup.Generation, now, string(backup.Status.Phase), message, s, true)
+
+	// a backup with a mirror storage location is replicated once it's written there and done
+	if backup.Spec.MirrorStorageLocation != "" {
+		replicated := backup.Status.MirrorPhase == velerov1api.MirrorPhaseCompleted && !s.progressing
+		set(&backup.Status.Conditions, backup.Generation, now, string(backup.Status.MirrorPhase), backup.Status.MirrorFailureReason,
+			velerov1api.ConditionTypeReplicationComplete, replicated)
+	}
+}
+
+// SetBackupExpiredCondition sets the Expired condition of a backup whose TTL has passed.
+func SetBackupExpiredCondition(backup *velerov1api.Backup, now time.Time) {
+	message := ""
+	if backup.Status.Expiration != nil {
+		message = "Backup expired at " + backup.Status.Expiration.UTC().Format(time.RFC3339)
+	}
+	set(&backup.Status.Conditions, backup.Generation, now, "TTLExpired", message, velerov1api.ConditionTypeExpired, true)
+}
+
+// SetBackupStorageBudgetExceededCondition sets the Expired condition of a backup deleted before
+// its TTL expired because it exceeds the storage budget of its schedule or storage location.
+func SetBackupStorageBudgetExceededCondition(backup *velerov1api.Backup, now time.Time, message string) {
+	set(&backup.Status.Conditions, backup.Generation, now, "StorageBudgetExceeded", message, velerov1api.ConditionTypeExpired, true)
+}
+
+// SetBackupRetentionPolicyCondition sets the Expired condition of a backup deleted before its
+// TTL expired because the retention policy of its schedule doesn't keep it.
+func SetBackupRetentionPolicyCondition(backup *velerov1api.Backup, now time.Time, message string) {
+	set(&backup.Status.Conditions, backup.Generation, now, "RetentionPolicy", message, velerov1api.ConditionTypeExpired, true)
+}
+
+// SetBackupCorruptedCondition sets the Corrupted condition of a backup whose files don't match
+// their recorded digests.
+func SetBackupCorruptedCondition(backup *velerov1api.Backup, now time.Time, message string) {
+	set(&backup.Status.Conditions, backup.Generation, now, "ArtifactDigestMismatch", message, velerov1api.ConditionTypeCorrupted, true)
+}
+
+// SetBackupVerifiedCondition sets the Verified condition of a backup, True if the objects of the
+// backup matched their signatures. The reason tells why the backup couldn't be verified.
+func SetBackupVerifiedCondition(backup *velerov1api.Backup, now time.Time, verified bool, reason, message string) {
+	set(&backup.Status.Conditions, backup.Generation, now, reason, message, velerov1api.ConditionTypeVerified, verified)
+}
+
+// SetBackupLogsExpiredCondition sets the LogsExpired condition of a backup whose logs and results
+// were deleted before the backup itself.
+func SetBackupLogsExpiredCondition(backup *velerov1api.Backup, now time.Time, message string) {
+	set(&backup.Status.Conditions, backup.Generation, now, "BackupLogsTTLExpired", message, velerov1api.ConditionTypeLogsExpired, true)
+}
+
+// SetRestoreConditions sets the conditions of the restore from its phase.
+func SetRestoreConditions(restore *velerov1api.Restore, now time.Time) {
+	var s state
+	switch restore.Status.Phase {
+	case "", velerov1api.RestorePhaseNew, velerov1api.RestorePhaseInProgress,
+		velerov1api.RestorePhaseWaitingForPluginOperations, velerov1api.RestorePhaseWaitingForPluginOperationsPartiallyFailed,
+		velerov1api.RestorePhaseFinalizing, velerov1api.RestorePhaseFinalizingPartiallyFailed:
+		s.progressing = true
+	case velerov1api.RestorePhaseCompleted:
+		s.ready = true
+	case velerov1api.RestorePhaseFailed, velerov1api.RestorePhaseFailedValidation, velerov1api.RestorePhasePartiallyFailed:
+		s.stalled = true
+	}
+	message := restore.Status.FailureReason
+	if len(restore.Status.ValidationErrors) > 0 {
+		message = strings.Join(restore.Status.ValidationErrors, "; ")
+	}
+
+	setState(&restore.Status.Conditions, restore.Generation, now, string(restore.Status.Phase), message, s, true)
+}
+
+// SetScheduleConditions sets the conditions of the schedule from its phase.
+func SetScheduleConditions(schedule *velerov1api.Schedule, now time.Time) {
+	s := state{
+		ready:   schedule.Status.Phase == velerov1api.SchedulePhaseEnabled,
+		stalled: schedule.Status.Phase == velerov1api.SchedulePhaseFailedValidation,
+	}
+	setState(&schedule.Status.Conditions, schedule.Generation, now, string(schedule.Status.Phase),
+		strings.Join(schedule.Status.ValidationErrors, "; "), s, false)
+}
+
+// SetRestoreTestConditions sets the conditions of the restore test from its phase.
+func SetRestoreTestConditions(restoreTest *velerov1api.RestoreTest, now time.Time) {
+	s := state{
+		ready:   restoreTest.Status.Phase == velerov1api.RestoreTestPhaseEnabled,
+		stalled: restoreTest.Status.Phase == velerov1api.RestoreTestPhaseFailedValidation,
+	}
+	setState(&restoreTest.Status.Conditions, restoreTest.Generation, now, string(restoreTest.Status.Phase),
+		strings.Join(restoreTest.Status.ValidationErrors, "; "), s, false)
+}
+
+// SetBackupStorageLocationConditions sets the conditions of the location from its phase.
+func SetBackupStorageLocationConditions(location *velerov1api.BackupStorageLocation, now time.Time) {
+	s := state{
+		ready:   location.Status.Phase == velerov1api.BackupStorageLocationPhaseAvailable,
+		stalled: location.Status.Phase == velerov1api.BackupStorageLocationPhaseUnavailable,
+	}
+	setState(&location.Status.Conditions, location.Generation, now, string(location.Status.Phase), location.Status.Message, s, false)
+}
+
+// SetBackupStorageLocationLayoutMigratedCondition sets the StorageLayoutMigrated condition of a
+// location, True if its files were copied into the v2 storage layout it was switched to.
+func SetBackupStorageLocationLayoutMigratedCondition(location *velerov1api.BackupStorageLocation, now time.Time, migrated bool, reason, message string) {
+	set(&location.Status.Conditions, location.Generation, now, reason, message, velerov1api.ConditionTypeStorageLayoutMigrated, migrated)
+}
+
+// SetBackupRepositoryConditions sets the conditions of the repository from its phase.
+func SetBackupRepositoryConditions(repo *velerov1api.BackupRepository, now time.Time) {
+	s := state{
+		ready:   repo.Status.Phase == velerov1api.BackupRepositoryPhaseReady,
+		stalled: repo.Status.Phase == velerov1api.BackupRepositoryPhaseNotReady,
+	}
+	setState(&repo.Status.Conditions, repo.Generation, now, string(repo.Status.Phase), repo.Status.Message, s, false)
+}
+
+// SetDataUploadConditions sets the conditions of the DataUpload from its phase.
+func SetDataUploadConditions(du *velerov2alpha1api.DataUpload, now time.Time) {
+	var s state
+	switch du.Status.Phase {
+	case "", velerov2alpha1api.DataUploadPhaseNew, velerov2alpha1api.DataUploadPhaseAccepted, velerov2alpha1api.DataUploadPhasePrepared,
+		velerov2alpha1api.DataUploadPhaseInProgress, velerov2alpha1api.DataUploadPhaseCanceling:
+		s.progressing = true
+	case velerov2alpha1api.DataUploadPhaseCompleted:
+		s.ready = true
+	case velerov2alpha1api.DataUploadPhaseFailed:
+		s.stalled = true
+	}
+
+	setState(&du.Status.Conditions, du.Generation, now, string(du.Status.Phase), du.Status.Message, s, true)
+	set(&du.Status.Conditions, du.Generation, now, string(du.Status.Phase), du.Status.Message, velerov1api.ConditionTypeDataMoved, s.ready)
+}
+
+// SetObservedGeneration records in the status of obj the generation it will have once the changes
+// made to it since original are stored. Velero resources have no status subresource, so the API
+// server increments the generation when anything but the metadata changes, including the status.
+// Nothing is recorded if obj has no such changes, so that storing it doesn't change the generation.
+func SetObservedGeneration(original, obj client.Object) {
+	if !changed(original, obj) {
+		return
+	}
+	generation := obj.GetGeneration() + 1
+
+	var observed *int64
+	var conditions []metav1.Condition
+	switch o := obj.(type) {
+	case *velerov1api.Backup:
+		observed, conditions = &o.Status.ObservedGeneration, o.Status.Conditions
+	case *velerov1api.Restore:
+		observed, conditions = &o.Status.ObservedGeneration, o.Status.Conditions
+	case *velerov1api.Schedule:
+		observed, conditions = &o.Status.ObservedGeneration, o.Status.Conditions
+	case *velerov1api.BackupStorageLocation:
+		observed, conditions = &o.Status.ObservedGeneration, o.Status.Conditions
+	case *velerov1api.BackupRepository:
+		observed, conditions = &o.Status.ObservedGeneration, o.Status.Conditions
+	case *velerov2alpha1api.DataUpload:
+		observed, conditions = &o.Status.ObservedGeneration, o.Status.Conditions
+	default:
+		return
+	}
+
+	*observed = generation
+	for i := range conditions {
+		conditions[i].ObservedGeneration = generation
+	}
+}
+
+// changed returns whether obj has changes other than to its metadata and observed generations
+// compared to original.
+func changed(original, obj client.Object) bool {
+	a, err := withoutGenerations(original)
+	if err != nil {
+		return true
+	}
+	b, err := withoutGenerations(obj)
+	if err != nil {
+		return true
+	}
+	return !equality.Semantic.DeepEqual(a, b)
+}
+
+func withoutGenerations(obj client.Object) (map[string]any, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	delete(u, "metadata")
+	delete(u, "apiVersion")
+	delete(u, "kind")
+	unstructured.RemoveNestedField(u, "status", "observedGeneration")
+	conditions, _, _ := unstructured.NestedSlice(u, "status", "conditions")
+	for _, c := range conditions {
+		if condition, ok := c.(map[string]any); ok {
+			delete(condition, "observedGeneration")
+		}
+	}
+	if conditions != nil {
+		_ = unstructured.SetNestedSlice(u, conditions, "status", "conditions")
+	}
+	return u, nil
+}
+
+// setState sets the Ready, Stalled and Reconciling conditions from the state, and the Progressing
+// condition if the resource is processed once like a Backup or a Restore.
+func setState(conditions *[]metav1.Condition, generation int64, now time.Time, reason, message string, s state, processed bool) {
+	set(conditions, generation, now, reason, message, velerov1api.ConditionTypeReady, s.ready)
+	set(conditions, generation, now, reason, message, velerov1api.ConditionTypeStalled, s.stalled)
+	set(conditions, generation, now, reason, message, velerov1api.ConditionTypeReconciling, s.progressing)
+	if processed {
+		set(conditions, generation, now, reason, message, velerov1api.ConditionTypeProgressing, s.progressing)
+	}
+}
+
+// set sets the condition, using the phase as its reason. The transition time is only updated
+// when the status of the condition changes.
+func set(conditions *[]metav1.Condition, generation int64, now time.Time, reason, message, conditionType string, status bool) {
+	if reason == "" {
+		reason = velerov1api.ConditionReasonNew
+	}
+	condition := metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: generation,
+		LastTransitionTime: metav1.NewTime(now),
+		Reason:             reason,
+		Message:            message,
+	}
+	if status {
+		condition.Status = metav1.ConditionTrue
+	}
+	meta.SetStatusCondition(conditions, condition)
+}
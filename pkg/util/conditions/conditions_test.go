@@ -0,0 +1,180 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestSetBackupConditions(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Result()
+
+	SetBackupConditions(backup, start)
+	ready := meta.FindStatusCondition(backup.Status.Conditions, velerov1api.ConditionTypeReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionFalse, ready.Status)
+	assert.Equal(t, velerov1api.ConditionReasonNew, ready.Reason)
+	assert.True(t, meta.IsStatusConditionTrue(backup.Status.Conditions, velerov1api.ConditionTypeProgressing))
+	assert.True(t, meta.IsStatusConditionTrue(backup.Status.Conditions, velerov1api.ConditionTypeReconciling))
+	assert.True(t, meta.IsStatusConditionFalse(backup.Status.Conditions, velerov1api.ConditionTypeStalled))
+
+	// the transition time is kept while the status of the condition doesn't change
+	backup.Status.Phase = velerov1api.BackupPhaseInProgress
+	SetBackupConditions(backup, start.Add(time.Minute))
+	progressing := meta.FindStatusCondition(backup.Status.Conditions, velerov1api.ConditionTypeProgressing)
+	assert.Equal(t, start, progressing.LastTransitionTime.Time)
+	assert.Equal(t, string(velerov1api.BackupPhaseInProgress), progressing.Reason)
+
+	backup.Status.Phase = velerov1api.BackupPhaseCompleted
+	SetBackupConditions(backup, start.Add(2*time.Minute))
+	ready = meta.FindStatusCondition(backup.Status.Conditions, velerov1api.ConditionTypeReady)
+	assert.Equal(t, metav1.ConditionTrue, ready.Status)
+	assert.Equal(t, start.Add(2*time.Minute), ready.LastTransitionTime.Time)
+	assert.True(t, meta.IsStatusConditionFalse(backup.Status.Conditions, velerov1api.ConditionTypeProgressing))
+
+	backup.Status.Phase = velerov1api.BackupPhaseFailedValidation
+	backup.Status.ValidationErrors = []string{"invalid TTL", "invalid label selector"}
+	SetBackupConditions(backup, start)
+	ready = meta.FindStatusCondition(backup.Status.Conditions, velerov1api.ConditionTypeReady)
+	assert.Equal(t, metav1.ConditionFalse, ready.Status)
+	assert.Equal(t, "invalid TTL; invalid label selector", ready.Message)
+	assert.True(t, meta.IsStatusConditionTrue(backup.Status.Conditions, velerov1api.ConditionTypeStalled))
+	assert.True(t, meta.IsStatusConditionFalse(backup.Status.Conditions, velerov1api.ConditionTypeReconciling))
+}
+
+func TestSetBackupReplicationCompleteCondition(t *testing.T) {
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Phase(velerov1api.BackupPhaseCompleted).Result()
+
+	SetBackupConditions(backup, time.Now())
+	assert.Nil(t, meta.FindStatusCondition(backup.Status.Conditions, velerov1api.ConditionTypeReplicationComplete))
+
+	backup.Spec.MirrorStorageLocation = "mirror"
+	backup.Status.Phase = velerov1api.BackupPhaseFinalizing
+	backup.Status.MirrorPhase = velerov1api.MirrorPhaseCompleted
+	SetBackupConditions(backup, time.Now())
+	assert.True(t, meta.IsStatusConditionFalse(backup.Status.Conditions, velerov1api.ConditionTypeReplicationComplete))
+
+	backup.Status.Phase = velerov1api.BackupPhaseCompleted
+	SetBackupConditions(backup, time.Now())
+	assert.True(t, meta.IsStatusConditionTrue(backup.Status.Conditions, velerov1api.ConditionTypeReplicationComplete))
+
+	backup.Status.Phase = velerov1api.BackupPhaseFailed
+	backup.Status.MirrorPhase = velerov1api.MirrorPhaseFailed
+	backup.Status.MirrorFailureReason = "bucket not found"
+	SetBackupConditions(backup, time.Now())
+	replicated := meta.FindStatusCondition(backup.Status.Conditions, velerov1api.ConditionTypeReplicationComplete)
+	require.NotNil(t, replicated)
+	assert.Equal(t, metav1.ConditionFalse, replicated.Status)
+	assert.Equal(t, string(velerov1api.MirrorPhaseFailed), replicated.Reason)
+	assert.Equal(t, "bucket not found", replicated.Message)
+}
+
+func TestSetBackupExpiredCondition(t *testing.T) {
+	expiration := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Expiration(expiration).Result()
+
+	SetBackupExpiredCondition(backup, expiration.Add(time.Hour))
+	expired := meta.FindStatusCondition(backup.Status.Conditions, velerov1api.ConditionTypeExpired)
+	require.NotNil(t, expired)
+	assert.Equal(t, metav1.ConditionTrue, expired.Status)
+	assert.Equal(t, "TTLExpired", expired.Reason)
+	assert.Equal(t, "Backup expired at 2024-01-01T00:00:00Z", expired.Message)
+}
+
+func TestSetRestoreConditions(t *testing.T) {
+	restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Phase(velerov1api.RestorePhaseFailed).Result()
+	restore.Status.FailureReason = "backup not found"
+
+	SetRestoreConditions(restore, time.Now())
+	ready := meta.FindStatusCondition(restore.Status.Conditions, velerov1api.ConditionTypeReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionFalse, ready.Status)
+	assert.Equal(t, string(velerov1api.RestorePhaseFailed), ready.Reason)
+	assert.Equal(t, "backup not found", ready.Message)
+	assert.True(t, meta.IsStatusConditionFalse(restore.Status.Conditions, velerov1api.ConditionTypeProgressing))
+}
+
+func TestSetReadyConditions(t *testing.T) {
+	now := time.Now()
+
+	schedule := builder.ForSchedule(velerov1api.DefaultNamespace, "schedule-1").Phase(velerov1api.SchedulePhaseEnabled).Result()
+	SetScheduleConditions(schedule, now)
+	assert.True(t, meta.IsStatusConditionTrue(schedule.Status.Conditions, velerov1api.ConditionTypeReady))
+
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Phase(velerov1api.BackupStorageLocationPhaseUnavailable).Result()
+	location.Status.Message = "bucket not found"
+	SetBackupStorageLocationConditions(location, now)
+	ready := meta.FindStatusCondition(location.Status.Conditions, velerov1api.ConditionTypeReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionFalse, ready.Status)
+	assert.Equal(t, "bucket not found", ready.Message)
+	assert.True(t, meta.IsStatusConditionTrue(location.Status.Conditions, velerov1api.ConditionTypeStalled))
+	// locations aren't processed once, so they have no Progressing condition
+	assert.Nil(t, meta.FindStatusCondition(location.Status.Conditions, velerov1api.ConditionTypeProgressing))
+
+	repo := &velerov1api.BackupRepository{Status: velerov1api.BackupRepositoryStatus{Phase: velerov1api.BackupRepositoryPhaseReady}}
+	SetBackupRepositoryConditions(repo, now)
+	assert.True(t, meta.IsStatusConditionTrue(repo.Status.Conditions, velerov1api.ConditionTypeReady))
+}
+
+func TestSetDataUploadConditions(t *testing.T) {
+	du := &velerov2alpha1api.DataUpload{Status: velerov2alpha1api.DataUploadStatus{Phase: velerov2alpha1api.DataUploadPhaseInProgress}}
+	SetDataUploadConditions(du, time.Now())
+	assert.True(t, meta.IsStatusConditionTrue(du.Status.Conditions, velerov1api.ConditionTypeProgressing))
+	assert.True(t, meta.IsStatusConditionFalse(du.Status.Conditions, velerov1api.ConditionTypeDataMoved))
+
+	du.Status.Phase = velerov2alpha1api.DataUploadPhaseCompleted
+	SetDataUploadConditions(du, time.Now())
+	assert.True(t, meta.IsStatusConditionTrue(du.Status.Conditions, velerov1api.ConditionTypeReady))
+	assert.True(t, meta.IsStatusConditionFalse(du.Status.Conditions, velerov1api.ConditionTypeProgressing))
+	assert.True(t, meta.IsStatusConditionTrue(du.Status.Conditions, velerov1api.ConditionTypeDataMoved))
+}
+
+func TestSetObservedGeneration(t *testing.T) {
+	original := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Phase(velerov1api.BackupPhaseNew).Result()
+	original.Generation = 3
+
+	// the phase changes, so storing the backup increments the generation
+	backup := original.DeepCopy()
+	backup.Status.Phase = velerov1api.BackupPhaseInProgress
+	SetBackupConditions(backup, time.Now())
+	SetObservedGeneration(original, backup)
+	assert.Equal(t, int64(4), backup.Status.ObservedGeneration)
+	for _, c := range backup.Status.Conditions {
+		assert.Equal(t, int64(4), c.ObservedGeneration, c.Type)
+	}
+
+	// only the metadata changes, so storing the backup keeps the generation
+	original = backup.DeepCopy()
+	original.Generation = 4
+	backup = original.DeepCopy()
+	backup.Labels = map[string]string{"foo": "bar"}
+	SetBackupConditions(backup, time.Now())
+	SetObservedGeneration(original, backup)
+	assert.Equal(t, original.Status, backup.Status)
+}
@@ -0,0 +1,153 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	"github.com/vmware-tanzu/velero/pkg/util/csi/snapshotmetadata"
+)
+
+// The SnapshotMetadataService resources advertise the SnapshotMetadata gRPC services of the
+// CSI drivers supporting changed block tracking, named after the drivers.
+var snapshotMetadataServiceGVK = schema.GroupVersionKind{
+	Group:   "cbt.storage.k8s.io",
+	Version: "v1alpha1",
+	Kind:    "SnapshotMetadataService",
+}
+
+// SnapshotMetadataService is the endpoint of the SnapshotMetadata service of a CSI driver.
+type SnapshotMetadataService struct {
+	Address  string
+	CACert   []byte
+	Audience string
+}
+
+// GetSnapshotMetadataService returns the SnapshotMetadata service of the CSI driver, an error if
+// the driver doesn't serve one.
+func GetSnapshotMetadataService(ctx context.Context, crClient crclient.Client, driver string) (*SnapshotMetadataService, error) {
+	sms := new(unstructured.Unstructured)
+	sms.SetGroupVersionKind(snapshotMetadataServiceGVK)
+	if err := crClient.Get(ctx, crclient.ObjectKey{Name: driver}, sms); err != nil {
+		return nil, errors.Wrapf(err, "error getting SnapshotMetadataService of CSI driver %s", driver)
+	}
+
+	address, _, _ := unstructured.NestedString(sms.Object, "spec", "address")
+	audience, _, _ := unstructured.NestedString(sms.Object, "spec", "audience")
+	encodedCACert, _, _ := unstructured.NestedString(sms.Object, "spec", "caCert")
+	if address == "" || audience == "" || encodedCACert == "" {
+		return nil, errors.Errorf("SnapshotMetadataService of CSI driver %s is incomplete", driver)
+	}
+	caCert, err := base64.StdEncoding.DecodeString(encodedCACert)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decoding the CA certificate of SnapshotMetadataService of CSI driver %s", driver)
+	}
+
+	return &SnapshotMetadataService{
+		Address:  address,
+		CACert:   caCert,
+		Audience: audience,
+	}, nil
+}
+
+// GetChangedBlocks returns the blocks of the volume changed between the CSI snapshot with the
+// base handle and the target VolumeSnapshot, sorted by offset, from the SnapshotMetadata service
+// of the CSI driver. The service account authenticates to the service.
+func GetChangedBlocks(
+	ctx context.Context,
+	kubeClient kubernetes.Interface,
+	crClient crclient.Client,
+	driver, namespace, serviceAccount, baseSnapshotHandle, targetSnapshotName string,
+) ([]uploader.BlockRange, error) {
+	service, err := GetSnapshotMetadataService(ctx, crClient, driver)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := kubeClient.CoreV1().ServiceAccounts(namespace).CreateToken(ctx, serviceAccount, &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			Audiences: []string{service.Audience},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error creating token of service account %s/%s for SnapshotMetadataService", namespace, serviceAccount)
+	}
+
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(service.CACert) {
+		return nil, errors.Errorf("invalid CA certificate of SnapshotMetadataService of CSI driver %s", driver)
+	}
+	conn, err := grpc.NewClient(service.Address, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+		RootCAs:    rootCAs,
+		MinVersion: tls.VersionTLS12,
+	})))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error connecting to SnapshotMetadataService %s", service.Address)
+	}
+	defer conn.Close()
+
+	return getMetadataDelta(ctx, snapshotmetadata.NewSnapshotMetadataClient(conn), &snapshotmetadata.GetMetadataDeltaRequest{
+		SecurityToken:      token.Status.Token,
+		Namespace:          namespace,
+		BaseSnapshotId:     baseSnapshotHandle,
+		TargetSnapshotName: targetSnapshotName,
+	})
+}
+
+// getMetadataDelta returns the blocks of the metadata delta streamed by the SnapshotMetadata
+// service, sorted by offset.
+func getMetadataDelta(ctx context.Context, client snapshotmetadata.SnapshotMetadataClient, request *snapshotmetadata.GetMetadataDeltaRequest) ([]uploader.BlockRange, error) {
+	stream, err := client.GetMetadataDelta(ctx, request)
+	if err != nil {
+		return nil, errors.Wrap(err, "error calling SnapshotMetadataService")
+	}
+
+	var blocks []uploader.BlockRange
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "error receiving metadata delta")
+		}
+		for _, block := range response.BlockMetadata {
+			blocks = append(blocks, uploader.BlockRange{Offset: block.ByteOffset, Length: block.SizeBytes})
+		}
+	}
+
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].Offset < blocks[j].Offset
+	})
+	return blocks, nil
+}
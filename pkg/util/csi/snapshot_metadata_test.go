@@ -0,0 +1,87 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	"github.com/vmware-tanzu/velero/pkg/util/csi/snapshotmetadata"
+)
+
+// fakeSnapshotMetadataClient streams the responses of the metadata delta.
+type fakeSnapshotMetadataClient struct {
+	snapshotmetadata.SnapshotMetadataClient
+	request   *snapshotmetadata.GetMetadataDeltaRequest
+	responses []*snapshotmetadata.GetMetadataDeltaResponse
+}
+
+func (c *fakeSnapshotMetadataClient) GetMetadataDelta(_ context.Context, request *snapshotmetadata.GetMetadataDeltaRequest, _ ...grpc.CallOption) (snapshotmetadata.SnapshotMetadata_GetMetadataDeltaClient, error) {
+	c.request = request
+	return &fakeMetadataDeltaStream{responses: c.responses}, nil
+}
+
+type fakeMetadataDeltaStream struct {
+	grpc.ClientStream
+	responses []*snapshotmetadata.GetMetadataDeltaResponse
+}
+
+func (s *fakeMetadataDeltaStream) Recv() (*snapshotmetadata.GetMetadataDeltaResponse, error) {
+	if len(s.responses) == 0 {
+		return nil, io.EOF
+	}
+	response := s.responses[0]
+	s.responses = s.responses[1:]
+	return response, nil
+}
+
+func TestGetMetadataDelta(t *testing.T) {
+	client := &fakeSnapshotMetadataClient{
+		responses: []*snapshotmetadata.GetMetadataDeltaResponse{
+			{
+				BlockMetadataType:   snapshotmetadata.BlockMetadataType_VARIABLE_LENGTH,
+				VolumeCapacityBytes: 1 << 30,
+				BlockMetadata:       []*snapshotmetadata.BlockMetadata{{ByteOffset: 1 << 20, SizeBytes: 65536}},
+			},
+			{
+				BlockMetadataType:   snapshotmetadata.BlockMetadataType_VARIABLE_LENGTH,
+				VolumeCapacityBytes: 1 << 30,
+				BlockMetadata:       []*snapshotmetadata.BlockMetadata{{ByteOffset: 0, SizeBytes: 4096}},
+			},
+		},
+	}
+	request := &snapshotmetadata.GetMetadataDeltaRequest{
+		SecurityToken:      "token",
+		Namespace:          "velero",
+		BaseSnapshotId:     "snap-handle-1",
+		TargetSnapshotName: "du-1",
+	}
+
+	blocks, err := getMetadataDelta(context.Background(), client, request)
+	require.NoError(t, err)
+	assert.Equal(t, request, client.request)
+	assert.Equal(t, []uploader.BlockRange{
+		{Offset: 0, Length: 4096},
+		{Offset: 1 << 20, Length: 65536},
+	}, blocks)
+}
@@ -0,0 +1,320 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"context"
+	"time"
+
+	groupsnapshotv1alpha1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumegroupsnapshot/v1alpha1"
+	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+// GetVolumeGroupSnapshotLabelKey returns the key of the label grouping the PVCs of the backup
+// snapshotted together.
+func GetVolumeGroupSnapshotLabelKey(backup *velerov1api.Backup) string {
+	if backup.Spec.VolumeGroupSnapshotLabelKey != "" {
+		return backup.Spec.VolumeGroupSnapshotLabelKey
+	}
+	return velerov1api.VolumeGroupSnapshotLabel
+}
+
+// GetVolumeGroupMembers returns the PVCs of the volume group in the namespace which the backup
+// includes, that is the ones matching its label selectors and not excluded by label.
+func GetVolumeGroupMembers(
+	ctx context.Context,
+	crClient crclient.Client,
+	backup *velerov1api.Backup,
+	namespace, labelKey, group string,
+) ([]corev1api.PersistentVolumeClaim, error) {
+	pvcList := new(corev1api.PersistentVolumeClaimList)
+	if err := crClient.List(ctx, pvcList,
+		crclient.InNamespace(namespace),
+		crclient.MatchingLabels{labelKey: group},
+	); err != nil {
+		return nil, errors.Wrapf(err, "error listing the PVCs of volume group %s", group)
+	}
+
+	var members []corev1api.PersistentVolumeClaim
+	for _, pvc := range pvcList.Items {
+		included, err := includedByLabels(backup, pvc.Labels)
+		if err != nil {
+			return nil, err
+		}
+		if included {
+			members = append(members, pvc)
+		}
+	}
+	return members, nil
+}
+
+// GetVolumeGroupSnapshotSelector returns the selector of the VolumeGroupSnapshot of the volume
+// group of a PVC, which only selects the PVCs of the group the backup includes: the ones matching
+// the label selector of the backup, or the one of its orLabelSelectors the PVC matches, and not
+// excluded by label.
+func GetVolumeGroupSnapshotSelector(
+	backup *velerov1api.Backup,
+	labelKey, group string,
+	pvcLabels map[string]string,
+) (*metav1.LabelSelector, error) {
+	selector := &metav1.LabelSelector{
+		MatchLabels: map[string]string{labelKey: group},
+		MatchExpressions: []metav1.LabelSelectorRequirement{{
+			Key:      velerov1api.ExcludeFromBackupLabel,
+			Operator: metav1.LabelSelectorOpNotIn,
+			Values:   []string{"true"},
+		}},
+	}
+
+	backupSelector := backup.Spec.LabelSelector
+	for _, orSelector := range backup.Spec.OrLabelSelectors {
+		matched, err := matchesLabelSelector(orSelector, pvcLabels)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			backupSelector = orSelector
+			break
+		}
+	}
+	if backupSelector != nil {
+		for key, value := range backupSelector.MatchLabels {
+			if existing, ok := selector.MatchLabels[key]; ok && existing != value {
+				return nil, errors.Errorf("the label selector of the backup doesn't match volume group %s", group)
+			}
+			selector.MatchLabels[key] = value
+		}
+		selector.MatchExpressions = append(selector.MatchExpressions, backupSelector.MatchExpressions...)
+	}
+	return selector, nil
+}
+
+// includedByLabels returns whether the backup includes an item with the labels.
+func includedByLabels(backup *velerov1api.Backup, itemLabels map[string]string) (bool, error) {
+	if itemLabels[velerov1api.ExcludeFromBackupLabel] == "true" {
+		return false, nil
+	}
+	if len(backup.Spec.OrLabelSelectors) > 0 {
+		for _, orSelector := range backup.Spec.OrLabelSelectors {
+			matched, err := matchesLabelSelector(orSelector, itemLabels)
+			if err != nil || matched {
+				return matched, err
+			}
+		}
+		return false, nil
+	}
+	if backup.Spec.LabelSelector == nil {
+		return true, nil
+	}
+	return matchesLabelSelector(backup.Spec.LabelSelector, itemLabels)
+}
+
+func matchesLabelSelector(selector *metav1.LabelSelector, itemLabels map[string]string) (bool, error) {
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return false, errors.Wrap(err, "error parsing the label selector of the backup")
+	}
+	return s.Matches(labels.Set(itemLabels)), nil
+}
+
+// GetVolumeGroupSnapshotClass returns the VolumeGroupSnapshotClass for the CSI driver: the one
+// with the 'velero.io/csi-volumegroupsnapshot-class' label, or the only one of the driver.
+func GetVolumeGroupSnapshotClass(
+	provisioner string,
+	crClient crclient.Client,
+) (*groupsnapshotv1alpha1api.VolumeGroupSnapshotClass, error) {
+	classes := new(groupsnapshotv1alpha1api.VolumeGroupSnapshotClassList)
+	if err := crClient.List(context.TODO(), classes); err != nil {
+		return nil, errors.Wrap(err, "error listing VolumeGroupSnapshotClass")
+	}
+
+	n := 0
+	var groupClass groupsnapshotv1alpha1api.VolumeGroupSnapshotClass
+	for _, class := range classes.Items {
+		if class.Driver != provisioner {
+			continue
+		}
+		if _, ok := class.Labels[velerov1api.VolumeGroupSnapshotClassSelectorLabel]; ok {
+			return &class, nil
+		}
+		n++
+		groupClass = class
+	}
+	if n == 1 {
+		return &groupClass, nil
+	}
+	return nil, errors.Errorf(
+		"failed to get VolumeGroupSnapshotClass for provisioner %s, ensure that the desired VolumeGroupSnapshot class has the %s label",
+		provisioner, velerov1api.VolumeGroupSnapshotClassSelectorLabel)
+}
+
+// WaitVolumeGroupSnapshotReady waits for the VolumeGroupSnapshot to be ready to use, with the
+// VolumeSnapshots of its members created, until the timeout.
+func WaitVolumeGroupSnapshotReady(
+	ctx context.Context,
+	crClient crclient.Client,
+	vgsName, vgsNamespace string,
+	timeout time.Duration,
+	log logrus.FieldLogger,
+) (*groupsnapshotv1alpha1api.VolumeGroupSnapshot, error) {
+	vgs := new(groupsnapshotv1alpha1api.VolumeGroupSnapshot)
+	err := wait.PollUntilContextTimeout(ctx, waitInternal, timeout, true, func(ctx context.Context) (bool, error) {
+		if err := crClient.Get(ctx, crclient.ObjectKey{Namespace: vgsNamespace, Name: vgsName}, vgs); err != nil {
+			return false, errors.Wrapf(err, "error getting VolumeGroupSnapshot %s/%s", vgsNamespace, vgsName)
+		}
+		if vgs.Status == nil {
+			return false, nil
+		}
+		if vgs.Status.Error != nil && vgs.Status.Error.Message != nil {
+			log.Warnf("VolumeGroupSnapshot %s/%s has error: %s", vgsNamespace, vgsName, *vgs.Status.Error.Message)
+		}
+		return boolptr.IsSetToTrue(vgs.Status.ReadyToUse) &&
+			vgs.Status.BoundVolumeGroupSnapshotContentName != nil &&
+			len(vgs.Status.VolumeSnapshotRefList) > 0, nil
+	})
+	if err != nil {
+		if wait.Interrupted(err) {
+			err = errors.Errorf("timeout to wait VolumeGroupSnapshot %s/%s ready to use", vgsNamespace, vgsName)
+		}
+		return nil, err
+	}
+	return vgs, nil
+}
+
+// GetVolumeGroupSnapshotMembers returns the VolumeSnapshots of the members of the
+// VolumeGroupSnapshot, the ones it owns, keyed by the names of their PVCs. The PVC of a
+// VolumeSnapshot is its source PVC if known, else the PVC of the volume its VolumeSnapshotContent
+// is the snapshot of, else the only PVC selected by the VolumeGroupSnapshot left unmatched.
+func GetVolumeGroupSnapshotMembers(
+	ctx context.Context,
+	crClient crclient.Client,
+	vgs *groupsnapshotv1alpha1api.VolumeGroupSnapshot,
+) (map[string]*snapshotv1api.VolumeSnapshot, error) {
+	vsList := new(snapshotv1api.VolumeSnapshotList)
+	if err := crClient.List(ctx, vsList, crclient.InNamespace(vgs.Namespace)); err != nil {
+		return nil, errors.Wrapf(err, "error listing the VolumeSnapshots of VolumeGroupSnapshot %s/%s", vgs.Namespace, vgs.Name)
+	}
+	var snapshots []*snapshotv1api.VolumeSnapshot
+	for i := range vsList.Items {
+		for _, ownerRef := range vsList.Items[i].OwnerReferences {
+			if ownerRef.UID == vgs.UID {
+				snapshots = append(snapshots, &vsList.Items[i])
+				break
+			}
+		}
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(vgs.Spec.Source.Selector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing the selector of VolumeGroupSnapshot %s/%s", vgs.Namespace, vgs.Name)
+	}
+	pvcList := new(corev1api.PersistentVolumeClaimList)
+	if err := crClient.List(ctx, pvcList,
+		crclient.InNamespace(vgs.Namespace),
+		crclient.MatchingLabelsSelector{Selector: selector},
+	); err != nil {
+		return nil, errors.Wrapf(err, "error listing the PVCs of VolumeGroupSnapshot %s/%s", vgs.Namespace, vgs.Name)
+	}
+	volumePVCs := make(map[string]string)
+	for _, pvc := range pvcList.Items {
+		if pvc.Spec.VolumeName == "" {
+			continue
+		}
+		pv := new(corev1api.PersistentVolume)
+		if err := crClient.Get(ctx, crclient.ObjectKey{Name: pvc.Spec.VolumeName}, pv); err != nil || pv.Spec.CSI == nil {
+			continue
+		}
+		volumePVCs[pv.Spec.CSI.VolumeHandle] = pvc.Name
+	}
+
+	members := make(map[string]*snapshotv1api.VolumeSnapshot, len(snapshots))
+	var unmatched []*snapshotv1api.VolumeSnapshot
+	for _, vs := range snapshots {
+		pvcName := GetVolumeSnapshotSourcePVC(vs)
+		if pvcName == "" {
+			pvcName, err = volumeSnapshotContentPVC(ctx, crClient, vs, volumePVCs)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if pvcName == "" {
+			unmatched = append(unmatched, vs)
+			continue
+		}
+		members[pvcName] = vs
+	}
+
+	if len(unmatched) > 0 {
+		var left []string
+		for _, pvc := range pvcList.Items {
+			if _, matched := members[pvc.Name]; !matched {
+				left = append(left, pvc.Name)
+			}
+		}
+		if len(unmatched) > 1 || len(left) != 1 {
+			return nil, errors.Errorf("unable to find the PVC of VolumeSnapshot %s/%s of VolumeGroupSnapshot %s",
+				vgs.Namespace, unmatched[0].Name, vgs.Name)
+		}
+		members[left[0]] = unmatched[0]
+	}
+	return members, nil
+}
+
+// volumeSnapshotContentPVC returns the PVC of the volume the VolumeSnapshotContent of the
+// VolumeSnapshot is the snapshot of, empty if unknown.
+func volumeSnapshotContentPVC(
+	ctx context.Context,
+	crClient crclient.Client,
+	vs *snapshotv1api.VolumeSnapshot,
+	volumePVCs map[string]string,
+) (string, error) {
+	contentName := vs.Spec.Source.VolumeSnapshotContentName
+	if vs.Status != nil && vs.Status.BoundVolumeSnapshotContentName != nil {
+		contentName = vs.Status.BoundVolumeSnapshotContentName
+	}
+	if contentName == nil {
+		return "", nil
+	}
+	vsc := new(snapshotv1api.VolumeSnapshotContent)
+	if err := crClient.Get(ctx, crclient.ObjectKey{Name: *contentName}, vsc); err != nil {
+		return "", errors.Wrapf(err, "error getting VolumeSnapshotContent %s of VolumeSnapshot %s/%s", *contentName, vs.Namespace, vs.Name)
+	}
+	if vsc.Spec.Source.VolumeHandle == nil {
+		return "", nil
+	}
+	return volumePVCs[*vsc.Spec.Source.VolumeHandle], nil
+}
+
+// GetVolumeSnapshotSourcePVC returns the name of the PVC the VolumeSnapshot is the snapshot of,
+// from its spec or, for the members of a VolumeGroupSnapshot, from the annotation Velero records
+// it in. Empty if unknown.
+func GetVolumeSnapshotSourcePVC(vs *snapshotv1api.VolumeSnapshot) string {
+	if vs.Spec.Source.PersistentVolumeClaimName != nil {
+		return *vs.Spec.Source.PersistentVolumeClaimName
+	}
+	return vs.Annotations[velerov1api.VolumeSnapshotSourcePVCAnnotation]
+}
@@ -0,0 +1,400 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	groupsnapshotv1alpha1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumegroupsnapshot/v1alpha1"
+	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+func TestGetVolumeGroupSnapshotClass(t *testing.T) {
+	fooClass := &groupsnapshotv1alpha1api.VolumeGroupSnapshotClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "foo",
+			Labels: map[string]string{
+				velerov1api.VolumeGroupSnapshotClassSelectorLabel: "true",
+			},
+		},
+		Driver: "foo.csi.k8s.io",
+	}
+	foo2Class := &groupsnapshotv1alpha1api.VolumeGroupSnapshotClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo2"},
+		Driver:     "foo.csi.k8s.io",
+	}
+	bazClass := &groupsnapshotv1alpha1api.VolumeGroupSnapshotClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "baz"},
+		Driver:     "baz.csi.k8s.io",
+	}
+	ambClass1 := &groupsnapshotv1alpha1api.VolumeGroupSnapshotClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "amb1"},
+		Driver:     "amb.csi.k8s.io",
+	}
+	ambClass2 := &groupsnapshotv1alpha1api.VolumeGroupSnapshotClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "amb2"},
+		Driver:     "amb.csi.k8s.io",
+	}
+
+	testCases := []struct {
+		name         string
+		driverName   string
+		expectedName string
+		expectError  bool
+	}{
+		{
+			name:         "should find the labeled class among the classes of the driver",
+			driverName:   "foo.csi.k8s.io",
+			expectedName: "foo",
+		},
+		{
+			name:         "should find the only class of the driver without the label",
+			driverName:   "baz.csi.k8s.io",
+			expectedName: "baz",
+		},
+		{
+			name:        "should not find a class among several of the driver without the label",
+			driverName:  "amb.csi.k8s.io",
+			expectError: true,
+		},
+		{
+			name:        "should not find a class for a driver without any",
+			driverName:  "not-found.csi.k8s.io",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := velerotest.NewFakeControllerRuntimeClient(t, fooClass, foo2Class, bazClass, ambClass1, ambClass2)
+
+			class, err := GetVolumeGroupSnapshotClass(tc.driverName, client)
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Nil(t, class)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedName, class.Name)
+		})
+	}
+}
+
+func TestWaitVolumeGroupSnapshotReady(t *testing.T) {
+	vgs := func(status *groupsnapshotv1alpha1api.VolumeGroupSnapshotStatus) *groupsnapshotv1alpha1api.VolumeGroupSnapshot {
+		return &groupsnapshotv1alpha1api.VolumeGroupSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: "fake-vgs", Namespace: "fake-ns"},
+			Status:     status,
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		objs      []runtime.Object
+		expectErr string
+	}{
+		{
+			name:      "the VolumeGroupSnapshot doesn't exist",
+			expectErr: "error getting VolumeGroupSnapshot fake-ns/fake-vgs",
+		},
+		{
+			name:      "the VolumeGroupSnapshot isn't ready to use",
+			objs:      []runtime.Object{vgs(&groupsnapshotv1alpha1api.VolumeGroupSnapshotStatus{ReadyToUse: boolptr.False()})},
+			expectErr: "timeout to wait VolumeGroupSnapshot fake-ns/fake-vgs ready to use",
+		},
+		{
+			name: "the VolumeGroupSnapshot is ready to use",
+			objs: []runtime.Object{vgs(&groupsnapshotv1alpha1api.VolumeGroupSnapshotStatus{
+				ReadyToUse:                          boolptr.True(),
+				BoundVolumeGroupSnapshotContentName: ptr.To("fake-vgsc"),
+				VolumeSnapshotRefList:               []corev1api.ObjectReference{{Name: "snapshot-1"}},
+			})},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := velerotest.NewFakeControllerRuntimeClient(t, tc.objs...)
+
+			result, err := WaitVolumeGroupSnapshotReady(context.Background(), client, "fake-vgs", "fake-ns", time.Millisecond, velerotest.NewLogger())
+			if tc.expectErr != "" {
+				require.ErrorContains(t, err, tc.expectErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "fake-vgsc", *result.Status.BoundVolumeGroupSnapshotContentName)
+		})
+	}
+}
+
+func TestGetVolumeSnapshotSourcePVC(t *testing.T) {
+	testCases := []struct {
+		name     string
+		vs       *snapshotv1api.VolumeSnapshot
+		expected string
+	}{
+		{
+			name: "the PVC is the source of the VolumeSnapshot",
+			vs: &snapshotv1api.VolumeSnapshot{
+				Spec: snapshotv1api.VolumeSnapshotSpec{
+					Source: snapshotv1api.VolumeSnapshotSource{PersistentVolumeClaimName: ptr.To("pvc-1")},
+				},
+			},
+			expected: "pvc-1",
+		},
+		{
+			name: "the PVC of a member of a VolumeGroupSnapshot is in the annotation",
+			vs: &snapshotv1api.VolumeSnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{velerov1api.VolumeSnapshotSourcePVCAnnotation: "pvc-2"},
+				},
+				Spec: snapshotv1api.VolumeSnapshotSpec{
+					Source: snapshotv1api.VolumeSnapshotSource{VolumeSnapshotContentName: ptr.To("vsc-2")},
+				},
+			},
+			expected: "pvc-2",
+		},
+		{
+			name: "the PVC is unknown",
+			vs: &snapshotv1api.VolumeSnapshot{
+				Spec: snapshotv1api.VolumeSnapshotSpec{
+					Source: snapshotv1api.VolumeSnapshotSource{VolumeSnapshotContentName: ptr.To("vsc-3")},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, GetVolumeSnapshotSourcePVC(tc.vs))
+		})
+	}
+}
+
+func TestGetVolumeGroupMembers(t *testing.T) {
+	pvc := func(name string, labels map[string]string) *corev1api.PersistentVolumeClaim {
+		return &corev1api.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name, Labels: labels}}
+	}
+	objs := []runtime.Object{
+		pvc("pvc-1", map[string]string{velerov1api.VolumeGroupSnapshotLabel: "db", "app": "db"}),
+		pvc("pvc-2", map[string]string{velerov1api.VolumeGroupSnapshotLabel: "db"}),
+		pvc("pvc-3", map[string]string{velerov1api.VolumeGroupSnapshotLabel: "db", "app": "db", velerov1api.ExcludeFromBackupLabel: "true"}),
+		pvc("pvc-4", map[string]string{velerov1api.VolumeGroupSnapshotLabel: "logs", "app": "db"}),
+	}
+
+	testCases := []struct {
+		name     string
+		backup   *velerov1api.Backup
+		expected []string
+	}{
+		{
+			name:     "the backup has no label selector",
+			backup:   &velerov1api.Backup{},
+			expected: []string{"pvc-1", "pvc-2"},
+		},
+		{
+			name: "the backup has a label selector",
+			backup: &velerov1api.Backup{Spec: velerov1api.BackupSpec{
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+			}},
+			expected: []string{"pvc-1"},
+		},
+		{
+			name: "the backup has orLabelSelectors",
+			backup: &velerov1api.Backup{Spec: velerov1api.BackupSpec{
+				OrLabelSelectors: []*metav1.LabelSelector{
+					{MatchLabels: map[string]string{"app": "web"}},
+					{MatchLabels: map[string]string{"app": "db"}},
+				},
+			}},
+			expected: []string{"pvc-1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := velerotest.NewFakeControllerRuntimeClient(t, objs...)
+			members, err := GetVolumeGroupMembers(context.Background(), client, tc.backup, "ns", velerov1api.VolumeGroupSnapshotLabel, "db")
+			require.NoError(t, err)
+
+			var names []string
+			for _, member := range members {
+				names = append(names, member.Name)
+			}
+			assert.Equal(t, tc.expected, names)
+		})
+	}
+}
+
+func TestGetVolumeGroupSnapshotMembers(t *testing.T) {
+	vgs := &groupsnapshotv1alpha1api.VolumeGroupSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "vgs", UID: "vgs-uid"},
+		Spec: groupsnapshotv1alpha1api.VolumeGroupSnapshotSpec{
+			Source: groupsnapshotv1alpha1api.VolumeGroupSnapshotSource{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{velerov1api.VolumeGroupSnapshotLabel: "db"}},
+			},
+		},
+	}
+	pvc := func(name, volume string) *corev1api.PersistentVolumeClaim {
+		return &corev1api.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name, Labels: map[string]string{velerov1api.VolumeGroupSnapshotLabel: "db"}},
+			Spec:       corev1api.PersistentVolumeClaimSpec{VolumeName: volume},
+		}
+	}
+	pv := func(name, handle string) *corev1api.PersistentVolume {
+		return &corev1api.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: corev1api.PersistentVolumeSpec{PersistentVolumeSource: corev1api.PersistentVolumeSource{
+				CSI: &corev1api.CSIPersistentVolumeSource{VolumeHandle: handle},
+			}},
+		}
+	}
+	vs := func(name, content string, owner types.UID) *snapshotv1api.VolumeSnapshot {
+		return &snapshotv1api.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       "ns",
+				Name:            name,
+				OwnerReferences: []metav1.OwnerReference{{Kind: "VolumeGroupSnapshot", Name: "vgs", UID: owner}},
+			},
+			Spec: snapshotv1api.VolumeSnapshotSpec{Source: snapshotv1api.VolumeSnapshotSource{VolumeSnapshotContentName: ptr.To(content)}},
+		}
+	}
+	vsc := func(name string, handle *string) *snapshotv1api.VolumeSnapshotContent {
+		return &snapshotv1api.VolumeSnapshotContent{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       snapshotv1api.VolumeSnapshotContentSpec{Source: snapshotv1api.VolumeSnapshotContentSource{VolumeHandle: handle}},
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		objs      []runtime.Object
+		expected  map[string]string
+		expectErr string
+	}{
+		{
+			name: "the PVCs are found from the volumes of the contents and by elimination",
+			objs: []runtime.Object{
+				pvc("pvc-1", "pv-1"), pv("pv-1", "handle-1"), vs("vs-1", "vsc-1", "vgs-uid"), vsc("vsc-1", ptr.To("handle-1")),
+				pvc("pvc-2", "pv-2"), pv("pv-2", "handle-2"), vs("vs-2", "vsc-2", "vgs-uid"), vsc("vsc-2", nil),
+				vs("vs-3", "vsc-3", "other-uid"),
+			},
+			expected: map[string]string{"pvc-1": "vs-1", "pvc-2": "vs-2"},
+		},
+		{
+			name: "the PVCs of several VolumeSnapshots can't be found",
+			objs: []runtime.Object{
+				pvc("pvc-1", "pv-1"), pv("pv-1", "handle-1"), vs("vs-1", "vsc-1", "vgs-uid"), vsc("vsc-1", nil),
+				pvc("pvc-2", "pv-2"), pv("pv-2", "handle-2"), vs("vs-2", "vsc-2", "vgs-uid"), vsc("vsc-2", nil),
+			},
+			expectErr: "unable to find the PVC of VolumeSnapshot ns/vs-1 of VolumeGroupSnapshot vgs",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := velerotest.NewFakeControllerRuntimeClient(t, tc.objs...)
+			members, err := GetVolumeGroupSnapshotMembers(context.Background(), client, vgs)
+			if tc.expectErr != "" {
+				require.ErrorContains(t, err, tc.expectErr)
+				return
+			}
+
+			require.NoError(t, err)
+			names := make(map[string]string)
+			for pvcName, member := range members {
+				names[pvcName] = member.Name
+			}
+			assert.Equal(t, tc.expected, names)
+		})
+	}
+}
+
+func TestGetVolumeGroupSnapshotSelector(t *testing.T) {
+	notExcluded := metav1.LabelSelectorRequirement{
+		Key:      velerov1api.ExcludeFromBackupLabel,
+		Operator: metav1.LabelSelectorOpNotIn,
+		Values:   []string{"true"},
+	}
+	appIn := metav1.LabelSelectorRequirement{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"db"}}
+
+	testCases := []struct {
+		name      string
+		backup    *velerov1api.Backup
+		pvcLabels map[string]string
+		expected  *metav1.LabelSelector
+	}{
+		{
+			name:   "the backup has no label selector",
+			backup: &velerov1api.Backup{},
+			expected: &metav1.LabelSelector{
+				MatchLabels:      map[string]string{velerov1api.VolumeGroupSnapshotLabel: "db"},
+				MatchExpressions: []metav1.LabelSelectorRequirement{notExcluded},
+			},
+		},
+		{
+			name: "the label selector of the backup is added",
+			backup: &velerov1api.Backup{Spec: velerov1api.BackupSpec{
+				LabelSelector: &metav1.LabelSelector{
+					MatchLabels:      map[string]string{"tier": "data"},
+					MatchExpressions: []metav1.LabelSelectorRequirement{appIn},
+				},
+			}},
+			expected: &metav1.LabelSelector{
+				MatchLabels:      map[string]string{velerov1api.VolumeGroupSnapshotLabel: "db", "tier": "data"},
+				MatchExpressions: []metav1.LabelSelectorRequirement{notExcluded, appIn},
+			},
+		},
+		{
+			name: "the orLabelSelector the PVC matches is added",
+			backup: &velerov1api.Backup{Spec: velerov1api.BackupSpec{
+				OrLabelSelectors: []*metav1.LabelSelector{
+					{MatchLabels: map[string]string{"app": "web"}},
+					{MatchLabels: map[string]string{"app": "db"}},
+				},
+			}},
+			pvcLabels: map[string]string{"app": "db"},
+			expected: &metav1.LabelSelector{
+				MatchLabels:      map[string]string{velerov1api.VolumeGroupSnapshotLabel: "db", "app": "db"},
+				MatchExpressions: []metav1.LabelSelectorRequirement{notExcluded},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			selector, err := GetVolumeGroupSnapshotSelector(tc.backup, velerov1api.VolumeGroupSnapshotLabel, "db", tc.pvcLabels)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, selector)
+		})
+	}
+}
@@ -306,10 +306,15 @@ func patchVSC(
 	return patched, nil
 }
 
+// GetVolumeSnapshotClass returns the VolumeSnapshotClass to snapshot the PVC with, selected in
+// order by the PVC annotation, by the snapshotClass parameter of the snapshot action of the
+// volume policy matching the PVC, by the backup annotation and by the label of the
+// VolumeSnapshotClasses of the provisioner.
 func GetVolumeSnapshotClass(
 	provisioner string,
 	backup *velerov1api.Backup,
 	pvc *corev1api.PersistentVolumeClaim,
+	policySnapshotClass string,
 	log logrus.FieldLogger,
 	crClient crclient.Client,
 ) (*snapshotv1api.VolumeSnapshotClass, error) {
@@ -329,7 +334,12 @@ func GetVolumeSnapshotClass(
 		return snapshotClass, nil
 	}
 
-	// If there is no annotation in PVC, attempt to fetch it from backup annotations
+	// If there is no annotation in PVC, use the snapshot class of the matching volume policy
+	if policySnapshotClass != "" {
+		return GetVolumeSnapshotClassForVolumePolicy(policySnapshotClass, provisioner, snapshotClasses)
+	}
+
+	// If there is no volume policy setting, attempt to fetch it from backup annotations
 	snapshotClass, err = GetVolumeSnapshotClassFromBackupAnnotationsForDriver(
 		backup, provisioner, snapshotClasses)
 	if err != nil {
@@ -349,6 +359,29 @@ func GetVolumeSnapshotClass(
 	return snapshotClass, nil
 }
 
+// GetVolumeSnapshotClassForVolumePolicy returns the VolumeSnapshotClass named by the snapshotClass
+// parameter of a volume policy, which must be for the provisioner.
+func GetVolumeSnapshotClassForVolumePolicy(
+	snapshotClassName string,
+	provisioner string,
+	snapshotClasses *snapshotv1api.VolumeSnapshotClassList,
+) (*snapshotv1api.VolumeSnapshotClass, error) {
+	for _, sc := range snapshotClasses.Items {
+		if sc.Name == snapshotClassName {
+			if !strings.EqualFold(sc.Driver, provisioner) {
+				return nil, errors.Errorf(
+					"VolumeSnapshotClass %s of the volume policy is not for driver %s",
+					sc.Name, provisioner,
+				)
+			}
+			return &sc, nil
+		}
+	}
+	return nil, errors.Errorf(
+		"No CSI VolumeSnapshotClass found with name %s of the volume policy", snapshotClassName,
+	)
+}
+
 func GetVolumeSnapshotClassFromPVCAnnotationsForDriver(
 	pvc *corev1api.PersistentVolumeClaim,
 	provisioner string,
@@ -491,6 +524,18 @@ func SetVolumeSnapshotContentDeletionPolicy(
 	return crClient.Patch(context.TODO(), vsc, crclient.MergeFrom(originVSC))
 }
 
+// ReleaseRetainedVolumeSnapshotContent deletes the VolumeSnapshotContent retained for changed
+// block tracking along with its snapshot, if it still exists.
+func ReleaseRetainedVolumeSnapshotContent(ctx context.Context, crClient crclient.Client, vscName string) error {
+	if err := SetVolumeSnapshotContentDeletionPolicy(vscName, crClient, snapshotv1api.VolumeSnapshotContentDelete); err != nil {
+		return crclient.IgnoreNotFound(err)
+	}
+
+	vsc := &snapshotv1api.VolumeSnapshotContent{}
+	vsc.Name = vscName
+	return crclient.IgnoreNotFound(crClient.Delete(ctx, vsc))
+}
+
 // CleanupVolumeSnapshot deletes the VolumeSnapshot and the associated VolumeSnapshotContent.  It will make sure the
 // physical snapshot is also deleted.
 func CleanupVolumeSnapshot(
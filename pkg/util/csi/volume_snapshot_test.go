@@ -955,12 +955,13 @@ func TestGetVolumeSnapshotClass(t *testing.T) {
 	fakeClient := velerotest.NewFakeControllerRuntimeClient(t, objs...)
 
 	testCases := []struct {
-		name        string
-		driverName  string
-		pvc         *v1.PersistentVolumeClaim
-		backup      *velerov1api.Backup
-		expectedVSC *snapshotv1api.VolumeSnapshotClass
-		expectError bool
+		name                string
+		driverName          string
+		pvc                 *v1.PersistentVolumeClaim
+		backup              *velerov1api.Backup
+		policySnapshotClass string
+		expectedVSC         *snapshotv1api.VolumeSnapshotClass
+		expectError         bool
 	}{
 		{
 			name:        "no annotations on pvc and backup, should find hostpath volumesnapshotclass using default behavior of labels",
@@ -1018,6 +1019,38 @@ func TestGetVolumeSnapshotClass(t *testing.T) {
 			expectedVSC: nil,
 			expectError: true,
 		},
+		{
+			name:                "volume policy snapshot class takes precedence over the backup annotation",
+			driverName:          "foo.csi.k8s.io",
+			pvc:                 pvcNone,
+			backup:              backupFoo2,
+			policySnapshotClass: "foowithoutlabel",
+			expectedVSC:         fooClassWithoutLabel,
+		},
+		{
+			name:                "pvc annotation takes precedence over the volume policy snapshot class",
+			driverName:          "foo.csi.k8s.io",
+			pvc:                 pvcFoo,
+			backup:              backupNone,
+			policySnapshotClass: "foo",
+			expectedVSC:         fooClassWithoutLabel,
+		},
+		{
+			name:                "volume policy snapshot class for another driver",
+			driverName:          "foo.csi.k8s.io",
+			pvc:                 pvcNone,
+			backup:              backupNone,
+			policySnapshotClass: "bar",
+			expectError:         true,
+		},
+		{
+			name:                "volume policy snapshot class doesn't exist",
+			driverName:          "foo.csi.k8s.io",
+			pvc:                 pvcNone,
+			backup:              backupNone,
+			policySnapshotClass: "gold",
+			expectError:         true,
+		},
 		{
 			name:        "foo2 VSC annotations on pvc, but doesn't exist in cluster, fallback to default behavior of labels",
 			driverName:  "foo.csi.k8s.io",
@@ -1030,7 +1063,7 @@ func TestGetVolumeSnapshotClass(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			actualSnapshotClass, actualError := GetVolumeSnapshotClass(
-				tc.driverName, tc.backup, tc.pvc, logrus.New(), fakeClient)
+				tc.driverName, tc.backup, tc.pvc, tc.policySnapshotClass, logrus.New(), fakeClient)
 			if tc.expectError {
 				assert.Error(t, actualError)
 				assert.Nil(t, actualSnapshotClass)
@@ -1462,6 +1495,20 @@ func TestSetVolumeSnapshotContentDeletionPolicy(t *testing.T) {
 	}
 }
 
+func TestReleaseRetainedVolumeSnapshotContent(t *testing.T) {
+	fakeClient := velerotest.NewFakeControllerRuntimeClient(t, &snapshotv1api.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{Name: "retainVSC"},
+		Spec:       snapshotv1api.VolumeSnapshotContentSpec{DeletionPolicy: snapshotv1api.VolumeSnapshotContentRetain},
+	})
+
+	require.NoError(t, ReleaseRetainedVolumeSnapshotContent(context.TODO(), fakeClient, "retainVSC"))
+	err := fakeClient.Get(context.TODO(), crclient.ObjectKey{Name: "retainVSC"}, new(snapshotv1api.VolumeSnapshotContent))
+	assert.True(t, apierrors.IsNotFound(err))
+
+	// a VolumeSnapshotContent already deleted is released
+	assert.NoError(t, ReleaseRetainedVolumeSnapshotContent(context.TODO(), fakeClient, "retainVSC"))
+}
+
 func TestDeleteVolumeSnapshots(t *testing.T) {
 	tests := []struct {
 		name         string
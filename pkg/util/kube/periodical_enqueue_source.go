@@ -64,6 +64,7 @@ type PeriodicalEnqueueSource struct {
 type PeriodicalEnqueueSourceOption struct {
 	OrderFunc  func(objList client.ObjectList) client.ObjectList
 	Predicates []predicate.Predicate // the predicates only apply to the GenericEvent
+	Heartbeat  func()                // called each time the resources are listed, to report the loop is alive
 }
 
 // Start enqueue items periodically
@@ -79,6 +80,9 @@ func (p *PeriodicalEnqueueSource) Start(ctx context.Context, q workqueue.TypedRa
 			p.logger.WithError(err).Error("error listing resources")
 			return
 		}
+		if p.option.Heartbeat != nil {
+			p.option.Heartbeat()
+		}
 		if meta.LenList(p.objList) == 0 {
 			p.logger.Debug("no resources, skip")
 			return
@@ -181,3 +181,26 @@ func TestOrder(t *testing.T) {
 
 	cancelFunc()
 }
+
+func TestHeartbeat(t *testing.T) {
+	require.NoError(t, velerov1.AddToScheme(scheme.Scheme))
+
+	ctx, cancelFunc := context.WithCancel(context.TODO())
+	defer cancelFunc()
+	client := (&fake.ClientBuilder{}).Build()
+	queue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedItemBasedRateLimiter[reconcile.Request]())
+	heartbeats := make(chan struct{}, 10)
+	source := NewPeriodicalEnqueueSource(logrus.WithContext(ctx).WithField("controller", "PES_TEST"), client, &velerov1.ScheduleList{}, 1*time.Second, PeriodicalEnqueueSourceOption{
+		Heartbeat: func() { heartbeats <- struct{}{} },
+	})
+
+	require.NoError(t, source.Start(ctx, queue))
+
+	// the heartbeat is reported even when there are no resources to enqueue
+	select {
+	case <-heartbeats:
+	case <-time.After(2 * time.Second):
+		t.Fatal("no heartbeat reported")
+	}
+	require.Equal(t, 0, queue.Len())
+}
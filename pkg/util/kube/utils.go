@@ -56,6 +56,16 @@ const (
 
 var ErrorPodVolumeIsNotPVC = errors.New("pod volume is not a PVC")
 
+// GetClusterID returns the ID of the cluster, the UID of its kube-system namespace, which lives as
+// long as the cluster does.
+func GetClusterID(ctx context.Context, cli client.Client) (string, error) {
+	ns := new(corev1api.Namespace)
+	if err := cli.Get(ctx, client.ObjectKey{Name: metav1.NamespaceSystem}, ns); err != nil {
+		return "", errors.Wrapf(err, "error getting namespace %s", metav1.NamespaceSystem)
+	}
+	return string(ns.UID), nil
+}
+
 // NamespaceAndName returns a string in the format <namespace>/<name>
 func NamespaceAndName(objMeta metav1.Object) string {
 	if objMeta.GetNamespace() == "" {
@@ -0,0 +1,296 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package swift
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/pkg/errors"
+)
+
+const (
+	// the keys of the OpenStack variables in the credential, the same as the ones of the openrc files
+	CredentialKeyAuthURL                     = "OS_AUTH_URL"
+	CredentialKeyUsername                    = "OS_USERNAME"
+	CredentialKeyUserID                      = "OS_USER_ID"
+	CredentialKeyPassword                    = "OS_PASSWORD"            // #nosec
+	CredentialKeyUserDomainName              = "OS_USER_DOMAIN_NAME"    // #nosec
+	CredentialKeyUserDomainID                = "OS_USER_DOMAIN_ID"      // #nosec
+	CredentialKeyProjectName                 = "OS_PROJECT_NAME"        // #nosec
+	CredentialKeyProjectID                   = "OS_PROJECT_ID"          // #nosec
+	CredentialKeyProjectDomainName           = "OS_PROJECT_DOMAIN_NAME" // #nosec
+	CredentialKeyProjectDomainID             = "OS_PROJECT_DOMAIN_ID"   // #nosec
+	CredentialKeyApplicationCredentialID     = "OS_APPLICATION_CREDENTIAL_ID"
+	CredentialKeyApplicationCredentialName   = "OS_APPLICATION_CREDENTIAL_NAME"
+	CredentialKeyApplicationCredentialSecret = "OS_APPLICATION_CREDENTIAL_SECRET" // #nosec
+	CredentialKeyRegionName                  = "OS_REGION_NAME"
+	CredentialKeyInterface                   = "OS_INTERFACE"
+
+	credentialFile = "credentialsFile"
+
+	// the type of the Swift endpoints in the Keystone catalog
+	objectStoreServiceType = "object-store"
+
+	// the token is renewed a bit before it expires, not to expire during a request, and well
+	// before it expires when it's used to upload a segment which can't be sent again
+	tokenExpiryMargin        = 5 * time.Minute
+	segmentTokenExpiryMargin = 30 * time.Minute
+)
+
+var credentialKeys = []string{
+	CredentialKeyAuthURL,
+	CredentialKeyUsername,
+	CredentialKeyUserID,
+	CredentialKeyPassword,
+	CredentialKeyUserDomainName,
+	CredentialKeyUserDomainID,
+	CredentialKeyProjectName,
+	CredentialKeyProjectID,
+	CredentialKeyProjectDomainName,
+	CredentialKeyProjectDomainID,
+	CredentialKeyApplicationCredentialID,
+	CredentialKeyApplicationCredentialName,
+	CredentialKeyApplicationCredentialSecret,
+	CredentialKeyRegionName,
+	CredentialKeyInterface,
+}
+
+// LoadCredentials reads the credential file from the config, or the OpenStack variables of the
+// environment when there's none.
+func LoadCredentials(config map[string]string) (map[string]string, error) {
+	if credFile := config[credentialFile]; credFile != "" {
+		creds, err := godotenv.Read(credFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read credentials from file %s", credFile)
+		}
+		return creds, nil
+	}
+
+	creds := map[string]string{}
+	for _, key := range credentialKeys {
+		if value := os.Getenv(key); value != "" {
+			creds[key] = value
+		}
+	}
+	return creds, nil
+}
+
+// keystoneAuth authenticates to Keystone v3 with a password or an application credential.
+type keystoneAuth struct {
+	httpClient *http.Client
+	authURL    string
+	creds      map[string]string
+	region     string
+	iface      string
+}
+
+// token is a Keystone token and the Swift endpoint of its catalog.
+type token struct {
+	id         string
+	expiresAt  time.Time
+	storageURL string
+}
+
+// valid returns whether the token is still valid after the margin.
+func (t *token) valid(now time.Time, margin time.Duration) bool {
+	return t != nil && (t.expiresAt.IsZero() || now.Add(margin).Before(t.expiresAt))
+}
+
+type authRequest struct {
+	Auth struct {
+		Identity identity `json:"identity"`
+		Scope    *scope   `json:"scope,omitempty"`
+	} `json:"auth"`
+}
+
+type identity struct {
+	Methods               []string               `json:"methods"`
+	Password              *passwordMethod        `json:"password,omitempty"`
+	ApplicationCredential *applicationCredential `json:"application_credential,omitempty"`
+}
+
+type passwordMethod struct {
+	User user `json:"user"`
+}
+
+type user struct {
+	ID       string  `json:"id,omitempty"`
+	Name     string  `json:"name,omitempty"`
+	Password string  `json:"password,omitempty"`
+	Domain   *domain `json:"domain,omitempty"`
+}
+
+type applicationCredential struct {
+	ID     string `json:"id,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Secret string `json:"secret"`
+	User   *user  `json:"user,omitempty"`
+}
+
+type domain struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type scope struct {
+	Project project `json:"project"`
+}
+
+type project struct {
+	ID     string  `json:"id,omitempty"`
+	Name   string  `json:"name,omitempty"`
+	Domain *domain `json:"domain,omitempty"`
+}
+
+type authResponse struct {
+	Token struct {
+		ExpiresAt time.Time `json:"expires_at"`
+		Catalog   []struct {
+			Type      string `json:"type"`
+			Endpoints []struct {
+				Interface string `json:"interface"`
+				Region    string `json:"region"`
+				RegionID  string `json:"region_id"`
+				URL       string `json:"url"`
+			} `json:"endpoints"`
+		} `json:"catalog"`
+	} `json:"token"`
+}
+
+func newDomain(id, name string) *domain {
+	if id == "" && name == "" {
+		return nil
+	}
+	return &domain{ID: id, Name: name}
+}
+
+// newAuthRequest returns the request of a token, authenticated with the application credential
+// of the credentials if any, or with the password of the user scoped to the project.
+func newAuthRequest(creds map[string]string) (*authRequest, error) {
+	req := &authRequest{}
+	credentialUser := &user{
+		ID:     creds[CredentialKeyUserID],
+		Name:   creds[CredentialKeyUsername],
+		Domain: newDomain(creds[CredentialKeyUserDomainID], creds[CredentialKeyUserDomainName]),
+	}
+
+	if secret := creds[CredentialKeyApplicationCredentialSecret]; secret != "" {
+		credential := &applicationCredential{ID: creds[CredentialKeyApplicationCredentialID], Secret: secret}
+		if credential.ID == "" {
+			// an application credential is identified by its name along with its user
+			credential.Name = creds[CredentialKeyApplicationCredentialName]
+			if credential.Name == "" || (credentialUser.ID == "" && credentialUser.Name == "") {
+				return nil, errors.Errorf("%s, or %s and the user, are required with an application credential", CredentialKeyApplicationCredentialID, CredentialKeyApplicationCredentialName)
+			}
+			credential.User = credentialUser
+		}
+		req.Auth.Identity = identity{Methods: []string{"application_credential"}, ApplicationCredential: credential}
+		// the application credentials are scoped to their project
+		return req, nil
+	}
+
+	if creds[CredentialKeyPassword] == "" || (credentialUser.ID == "" && credentialUser.Name == "") {
+		return nil, errors.Errorf("either %s or the user and %s are required", CredentialKeyApplicationCredentialSecret, CredentialKeyPassword)
+	}
+	credentialUser.Password = creds[CredentialKeyPassword]
+	req.Auth.Identity = identity{Methods: []string{"password"}, Password: &passwordMethod{User: *credentialUser}}
+
+	if id, name := creds[CredentialKeyProjectID], creds[CredentialKeyProjectName]; id != "" || name != "" {
+		projectDomain := newDomain(creds[CredentialKeyProjectDomainID], creds[CredentialKeyProjectDomainName])
+		if id == "" && projectDomain == nil {
+			// the project is in the domain of the user when not set
+			projectDomain = credentialUser.Domain
+		}
+		req.Auth.Scope = &scope{Project: project{ID: id, Name: name, Domain: projectDomain}}
+	}
+	return req, nil
+}
+
+// authenticate gets a new token from Keystone.
+func (a *keystoneAuth) authenticate(ctx context.Context) (*token, error) {
+	authReq, err := newAuthRequest(a.creds)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(authReq)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	authURL := strings.TrimSuffix(a.authURL, "/")
+	if !strings.HasSuffix(authURL, "/v3") {
+		authURL += "/v3"
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, authURL+"/auth/tokens", bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := a.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "error authenticating to Keystone")
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusCreated {
+		return nil, errors.Errorf("error authenticating to Keystone: %s", res.Status)
+	}
+
+	authRes := &authResponse{}
+	if err := json.NewDecoder(res.Body).Decode(authRes); err != nil {
+		return nil, errors.Wrap(err, "error decoding the Keystone token")
+	}
+	storageURL, err := a.storageURL(authRes)
+	if err != nil {
+		return nil, err
+	}
+	return &token{
+		id:         res.Header.Get("X-Subject-Token"),
+		expiresAt:  authRes.Token.ExpiresAt,
+		storageURL: storageURL,
+	}, nil
+}
+
+// storageURL returns the URL of the Swift endpoint of the catalog in the region with the interface.
+func (a *keystoneAuth) storageURL(res *authResponse) (string, error) {
+	iface := a.iface
+	if iface == "" {
+		iface = "public"
+	}
+	for _, service := range res.Token.Catalog {
+		if service.Type != objectStoreServiceType {
+			continue
+		}
+		for _, endpoint := range service.Endpoints {
+			if endpoint.Interface != iface {
+				continue
+			}
+			if a.region != "" && endpoint.Region != a.region && endpoint.RegionID != a.region {
+				continue
+			}
+			return strings.TrimSuffix(endpoint.URL, "/"), nil
+		}
+	}
+	return "", errors.Errorf("no %s %s endpoint in region %q in the Keystone catalog", iface, objectStoreServiceType, a.region)
+}
@@ -0,0 +1,146 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package swift
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuthRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		creds       map[string]string
+		expected    string
+		expectedErr string
+	}{
+		{
+			name: "password scoped to a project of the domain of the user",
+			creds: map[string]string{
+				CredentialKeyUsername:       "velero",
+				CredentialKeyPassword:       "password",
+				CredentialKeyUserDomainName: "Default",
+				CredentialKeyProjectName:    "backups",
+			},
+			expected: `{"auth":{"identity":{"methods":["password"],"password":{"user":{"name":"velero","password":"password","domain":{"name":"Default"}}}},"scope":{"project":{"name":"backups","domain":{"name":"Default"}}}}}`,
+		},
+		{
+			name: "password of a user ID scoped to a project ID",
+			creds: map[string]string{
+				CredentialKeyUserID:    "user-id",
+				CredentialKeyPassword:  "password",
+				CredentialKeyProjectID: "project-id",
+			},
+			expected: `{"auth":{"identity":{"methods":["password"],"password":{"user":{"id":"user-id","password":"password"}}},"scope":{"project":{"id":"project-id"}}}}`,
+		},
+		{
+			name: "application credential ID",
+			creds: map[string]string{
+				CredentialKeyApplicationCredentialID:     "credential-id",
+				CredentialKeyApplicationCredentialSecret: "secret",
+			},
+			expected: `{"auth":{"identity":{"methods":["application_credential"],"application_credential":{"id":"credential-id","secret":"secret"}}}}`,
+		},
+		{
+			name: "application credential name of a user",
+			creds: map[string]string{
+				CredentialKeyApplicationCredentialName:   "velero",
+				CredentialKeyApplicationCredentialSecret: "secret",
+				CredentialKeyUsername:                    "velero",
+				CredentialKeyUserDomainID:                "default",
+			},
+			expected: `{"auth":{"identity":{"methods":["application_credential"],"application_credential":{"name":"velero","secret":"secret","user":{"name":"velero","domain":{"id":"default"}}}}}}`,
+		},
+		{
+			name: "application credential name without user",
+			creds: map[string]string{
+				CredentialKeyApplicationCredentialName:   "velero",
+				CredentialKeyApplicationCredentialSecret: "secret",
+			},
+			expectedErr: "OS_APPLICATION_CREDENTIAL_ID, or OS_APPLICATION_CREDENTIAL_NAME and the user, are required with an application credential",
+		},
+		{
+			name:        "no password",
+			creds:       map[string]string{CredentialKeyUsername: "velero"},
+			expectedErr: "either OS_APPLICATION_CREDENTIAL_SECRET or the user and OS_PASSWORD are required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := newAuthRequest(tc.creds)
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			data, err := json.Marshal(req)
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expected, string(data))
+		})
+	}
+}
+
+func TestStorageURL(t *testing.T) {
+	res := &authResponse{}
+	require.NoError(t, json.Unmarshal([]byte(`{"token":{"catalog":[
+		{"type":"compute","endpoints":[{"interface":"public","region":"RegionOne","url":"https://compute"}]},
+		{"type":"object-store","endpoints":[
+			{"interface":"public","region":"RegionOne","region_id":"region-one","url":"https://swift-one/v1/AUTH_project/"},
+			{"interface":"internal","region":"RegionOne","url":"https://swift-one-internal/v1/AUTH_project"},
+			{"interface":"public","region":"RegionTwo","url":"https://swift-two/v1/AUTH_project"}]}]}}`), res))
+
+	tests := []struct {
+		region, iface string
+		expected      string
+		expectedErr   string
+	}{
+		{expected: "https://swift-one/v1/AUTH_project"},
+		{region: "region-one", expected: "https://swift-one/v1/AUTH_project"},
+		{region: "RegionOne", iface: "internal", expected: "https://swift-one-internal/v1/AUTH_project"},
+		{region: "RegionTwo", expected: "https://swift-two/v1/AUTH_project"},
+		{region: "RegionTwo", iface: "internal", expectedErr: `no internal object-store endpoint in region "RegionTwo" in the Keystone catalog`},
+	}
+	for _, tc := range tests {
+		storageURL, err := (&keystoneAuth{region: tc.region, iface: tc.iface}).storageURL(res)
+		if tc.expectedErr != "" {
+			assert.EqualError(t, err, tc.expectedErr)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, storageURL)
+	}
+}
+
+func TestLoadCredentials(t *testing.T) {
+	t.Setenv(CredentialKeyUsername, "env-user")
+
+	creds, err := LoadCredentials(map[string]string{})
+	require.NoError(t, err)
+	assert.Equal(t, "env-user", creds[CredentialKeyUsername])
+
+	name := filepath.Join(t.TempDir(), "openrc")
+	require.NoError(t, os.WriteFile(name, []byte("export OS_USERNAME=file-user\nexport OS_PASSWORD=password\n"), 0600))
+	creds, err = LoadCredentials(map[string]string{"credentialsFile": name})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{CredentialKeyUsername: "file-user", CredentialKeyPassword: "password"}, creds)
+}
@@ -0,0 +1,634 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package swift
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// the keys of the Swift BSL config
+	BSLConfigAuthURL          = "authURL"
+	BSLConfigRegion           = "region"
+	BSLConfigInterface        = "interface"
+	BSLConfigSegmentSize      = "segmentSize"
+	BSLConfigSegmentContainer = "segmentContainer"
+
+	// the objects larger than the segment size are uploaded as static large objects, Swift
+	// refusing the objects larger than 5GiB
+	defaultSegmentSize = 1 << 30
+	maxSegmentSize     = 5 << 30
+
+	// the objects up to this size, or the segment size if smaller, are buffered and uploaded
+	// as a single object, the larger ones are streamed as static large objects
+	maxBufferedObjectSize = 16 << 20
+
+	// the number of objects of a page of a container listing
+	listLimit = 10000
+)
+
+// ObjectStore is the Swift object store, authenticated with Keystone v3. The objects larger
+// than the segment size are uploaded as static large objects, whose segments are stored in the
+// segment container.
+type ObjectStore struct {
+	log        logrus.FieldLogger
+	httpClient *http.Client
+	auth       *keystoneAuth
+
+	segmentSize      int64
+	segmentContainer string
+
+	lock  sync.Mutex
+	token *token
+}
+
+// NewObjectStore returns the Swift object store, initialized by Init.
+func NewObjectStore(log logrus.FieldLogger) *ObjectStore {
+	return &ObjectStore{log: log}
+}
+
+func (o *ObjectStore) Init(config map[string]string) error {
+	creds, err := LoadCredentials(config)
+	if err != nil {
+		return err
+	}
+
+	o.httpClient = &http.Client{Transport: http.DefaultTransport}
+	if caCert := config["caCert"]; caCert != "" {
+		certPool, _ := x509.SystemCertPool()
+		if certPool == nil {
+			certPool = x509.NewCertPool()
+		}
+		certPool.AppendCertsFromPEM([]byte(caCert))
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			RootCAs:    certPool,
+		}
+		o.httpClient.Transport = transport
+	}
+
+	o.auth = &keystoneAuth{
+		httpClient: o.httpClient,
+		authURL:    getFromConfigOrCredential(config, creds, BSLConfigAuthURL, CredentialKeyAuthURL),
+		creds:      creds,
+		region:     getFromConfigOrCredential(config, creds, BSLConfigRegion, CredentialKeyRegionName),
+		iface:      getFromConfigOrCredential(config, creds, BSLConfigInterface, CredentialKeyInterface),
+	}
+	if o.auth.authURL == "" {
+		return errors.Errorf("either %s in the config or %s in the credentials is required", BSLConfigAuthURL, CredentialKeyAuthURL)
+	}
+
+	o.segmentSize = defaultSegmentSize
+	if value := config[BSLConfigSegmentSize]; value != "" {
+		size, err := resource.ParseQuantity(value)
+		if err != nil {
+			return errors.Wrapf(err, "invalid %s %q", BSLConfigSegmentSize, value)
+		}
+		if size.Value() <= 0 || size.Value() > maxSegmentSize {
+			return errors.Errorf("%s must be between 1 and 5Gi", BSLConfigSegmentSize)
+		}
+		o.segmentSize = size.Value()
+	}
+
+	o.segmentContainer = config[BSLConfigSegmentContainer]
+	return nil
+}
+
+// getFromConfigOrCredential returns the value of the key of the config, or of the credentials
+// when not set.
+func getFromConfigOrCredential(config, creds map[string]string, configKey, credKey string) string {
+	if value := config[configKey]; value != "" {
+		return value
+	}
+	return creds[credKey]
+}
+
+// getToken returns a token valid for the margin, authenticating again when the current one
+// expires before.
+func (o *ObjectStore) getToken(ctx context.Context, renew bool, margin time.Duration) (*token, error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	if !renew && o.token.valid(time.Now(), margin) {
+		return o.token, nil
+	}
+	t, err := o.auth.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	o.token = t
+	return t, nil
+}
+
+// objectPath returns the escaped path of the object of the container under the storage URL,
+// the container itself when the object is empty, and the account when both are.
+func objectPath(container, object string) string {
+	if container == "" {
+		return ""
+	}
+	p := "/" + url.PathEscape(container)
+	if object != "" {
+		segments := strings.Split(object, "/")
+		for i := range segments {
+			segments[i] = url.PathEscape(segments[i])
+		}
+		p += "/" + strings.Join(segments, "/")
+	}
+	return p
+}
+
+// do sends the request to the object, and authenticates again then retries the request once
+// when the token was refused and the body can be sent again. The token used to send a body which
+// can't be sent again is renewed well before it expires, so as not to expire during the upload.
+func (o *ObjectStore) do(method, container, object string, query url.Values, header http.Header, body io.Reader) (*http.Response, error) {
+	ctx := context.Background()
+	seeker, replayable := body.(io.Seeker)
+	replayable = replayable || body == nil
+	margin := tokenExpiryMargin
+	if !replayable {
+		margin = segmentTokenExpiryMargin
+	}
+
+	renew := false
+	for {
+		t, err := o.getToken(ctx, renew, margin)
+		if err != nil {
+			return nil, err
+		}
+
+		u := t.storageURL + objectPath(container, object)
+		if len(query) > 0 {
+			u += "?" + query.Encode()
+		}
+		req, err := http.NewRequestWithContext(ctx, method, u, body)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		for key, values := range header {
+			req.Header[key] = values
+		}
+		req.Header.Set("X-Auth-Token", t.id)
+
+		res, err := o.httpClient.Do(req)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if res.StatusCode == http.StatusUnauthorized && !renew {
+			if replayable {
+				res.Body.Close()
+				if seeker != nil {
+					if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+						return nil, errors.WithStack(err)
+					}
+				}
+				renew = true
+				continue
+			}
+			// the token is renewed for the next requests
+			o.lock.Lock()
+			o.token = nil
+			o.lock.Unlock()
+		}
+		return res, nil
+	}
+}
+
+// statusError returns the error of the unexpected response, and closes its body.
+func statusError(res *http.Response, action string) error {
+	defer res.Body.Close()
+	message, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+	return errors.Errorf("error %s: %s %s", action, res.Status, strings.TrimSpace(string(message)))
+}
+
+func (o *ObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	// the small objects are uploaded as a single object, the others are uploaded as segments
+	// then as the manifest of a static large object
+	bufferSize := min(o.segmentSize, maxBufferedObjectSize)
+	reader := bufio.NewReaderSize(body, int(bufferSize)+1)
+	data, err := reader.Peek(int(bufferSize) + 1)
+	if err != nil && err != io.EOF {
+		return errors.Wrapf(err, "error reading object %s", key)
+	}
+
+	// the segments of the object overwritten are deleted once it is
+	oldSegments, err := o.getSegments(bucket, key)
+	if err != nil {
+		return err
+	}
+
+	if len(data) <= int(bufferSize) {
+		res, err := o.do(http.MethodPut, bucket, key, nil, nil, bytes.NewReader(data))
+		if err != nil {
+			return errors.Wrapf(err, "error putting object %s", key)
+		}
+		if res.StatusCode != http.StatusCreated {
+			return statusError(res, fmt.Sprintf("putting object %s", key))
+		}
+		res.Body.Close()
+	} else if err := o.putLargeObject(bucket, key, reader); err != nil {
+		return err
+	}
+
+	o.deleteSegments(oldSegments)
+	return nil
+}
+
+// putLargeObject uploads the reader as the segments of a static large object in the segment
+// container, then uploads its manifest as the object. The segments uploaded are deleted if the
+// upload fails.
+func (o *ObjectStore) putLargeObject(bucket, key string, reader *bufio.Reader) (err error) {
+	segmentContainer := o.segmentContainer
+	if segmentContainer == "" {
+		// the segment container of the swift client
+		segmentContainer = bucket + "_segments"
+	}
+	if err := o.ensureContainer(segmentContainer); err != nil {
+		return err
+	}
+	prefix := fmt.Sprintf("%s/slo/%d", key, time.Now().UnixNano())
+
+	var manifest []sloSegment
+	defer func() {
+		if err != nil {
+			o.deleteSegments(manifest)
+		}
+	}()
+	for i := 0; ; i++ {
+		if more, err := hasMore(reader); err != nil {
+			return err
+		} else if !more {
+			break
+		}
+		segment := fmt.Sprintf("%s/%08d", prefix, i)
+		manifest = append(manifest, sloSegment{Path: objectPath(segmentContainer, segment)})
+		etag, size, err := o.putSegment(segmentContainer, segment, reader)
+		if err != nil {
+			return err
+		}
+		manifest[i].Etag, manifest[i].SizeBytes = etag, size
+	}
+
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	res, err := o.do(http.MethodPut, bucket, key, url.Values{"multipart-manifest": {"put"}}, nil, bytes.NewReader(data))
+	if err != nil {
+		return errors.Wrapf(err, "error putting the manifest of object %s", key)
+	}
+	if res.StatusCode != http.StatusCreated {
+		return statusError(res, fmt.Sprintf("putting the manifest of object %s", key))
+	}
+	res.Body.Close()
+
+	o.log.Debugf("Uploaded object %s as a static large object of %d segments", key, len(manifest))
+	return nil
+}
+
+// getSegments returns the segments of the object if it's a static large object.
+func (o *ObjectStore) getSegments(bucket, key string) ([]sloSegment, error) {
+	res, err := o.do(http.MethodHead, bucket, key, nil, nil, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting object %s", key)
+	}
+	res.Body.Close()
+	if res.StatusCode == http.StatusNotFound || !strings.EqualFold(res.Header.Get("X-Static-Large-Object"), "true") {
+		return nil, nil
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("error getting object %s: %s", key, res.Status)
+	}
+
+	res, err = o.do(http.MethodGet, bucket, key, url.Values{"multipart-manifest": {"get"}, "format": {"raw"}}, nil, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting the manifest of object %s", key)
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, statusError(res, fmt.Sprintf("getting the manifest of object %s", key))
+	}
+	defer res.Body.Close()
+
+	var manifest []sloSegment
+	if err := json.NewDecoder(res.Body).Decode(&manifest); err != nil {
+		return nil, errors.Wrapf(err, "error decoding the manifest of object %s", key)
+	}
+	return manifest, nil
+}
+
+// deleteSegments deletes the segments, logging the ones which can't be.
+func (o *ObjectStore) deleteSegments(segments []sloSegment) {
+	for _, segment := range segments {
+		segmentPath, err := url.PathUnescape(segment.Path)
+		if err != nil {
+			segmentPath = segment.Path
+		}
+		container, object, _ := strings.Cut(strings.TrimPrefix(segmentPath, "/"), "/")
+
+		res, err := o.do(http.MethodDelete, container, object, nil, nil, nil)
+		if err == nil {
+			res.Body.Close()
+			if res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
+				err = errors.Errorf("unexpected status %s", res.Status)
+			}
+		}
+		if err != nil {
+			o.log.WithError(err).Warnf("Unable to delete segment %s", segment.Path)
+		}
+	}
+}
+
+// sloSegment is a segment of the manifest of a static large object.
+type sloSegment struct {
+	Path      string `json:"path"`
+	Etag      string `json:"etag"`
+	SizeBytes int64  `json:"size_bytes"`
+}
+
+// putSegment uploads the next segment of the reader to the object, and returns its ETag and size.
+func (o *ObjectStore) putSegment(container, object string, reader io.Reader) (string, int64, error) {
+	counter := &countingReader{reader: io.LimitReader(reader, o.segmentSize)}
+	res, err := o.do(http.MethodPut, container, object, nil, nil, counter)
+	if err != nil {
+		return "", 0, errors.Wrapf(err, "error putting object %s", object)
+	}
+	if res.StatusCode != http.StatusCreated {
+		return "", 0, statusError(res, fmt.Sprintf("putting object %s", object))
+	}
+	res.Body.Close()
+	return strings.Trim(res.Header.Get("Etag"), `"`), counter.count, nil
+}
+
+// hasMore returns whether the reader has more data.
+func hasMore(reader *bufio.Reader) (bool, error) {
+	if _, err := reader.Peek(1); err == io.EOF {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Wrap(err, "error reading the object")
+	}
+	return true, nil
+}
+
+type countingReader struct {
+	reader io.Reader
+	count  int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.count += int64(n)
+	return n, err
+}
+
+// ensureContainer creates the container if it doesn't exist.
+func (o *ObjectStore) ensureContainer(container string) error {
+	res, err := o.do(http.MethodPut, container, "", nil, nil, nil)
+	if err != nil {
+		return errors.Wrapf(err, "error creating container %s", container)
+	}
+	if res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusAccepted {
+		return statusError(res, fmt.Sprintf("creating container %s", container))
+	}
+	res.Body.Close()
+	return nil
+}
+
+func (o *ObjectStore) ObjectExists(bucket, key string) (bool, error) {
+	res, err := o.do(http.MethodHead, bucket, key, nil, nil, nil)
+	if err != nil {
+		return false, errors.Wrapf(err, "error getting object %s", key)
+	}
+	res.Body.Close()
+	switch res.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, errors.Errorf("error getting object %s: %s", key, res.Status)
+	}
+}
+
+func (o *ObjectStore) GetObject(bucket, key string) (io.ReadCloser, error) {
+	res, err := o.do(http.MethodGet, bucket, key, nil, nil, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting object %s", key)
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, statusError(res, fmt.Sprintf("getting object %s", key))
+	}
+	return res.Body, nil
+}
+
+// listEntry is an entry of a container listing, either an object or a common prefix.
+type listEntry struct {
+	Name   string `json:"name"`
+	Subdir string `json:"subdir"`
+}
+
+// list lists the objects and the common prefixes of the container under the prefix, page by page.
+func (o *ObjectStore) list(bucket, prefix, delimiter string) ([]listEntry, error) {
+	var entries []listEntry
+	marker := ""
+	for {
+		query := url.Values{
+			"format": {"json"},
+			"prefix": {prefix},
+			"limit":  {strconv.Itoa(listLimit)},
+		}
+		if delimiter != "" {
+			query.Set("delimiter", delimiter)
+		}
+		if marker != "" {
+			query.Set("marker", marker)
+		}
+		res, err := o.do(http.MethodGet, bucket, "", query, nil, nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error listing container %s", bucket)
+		}
+		if res.StatusCode == http.StatusNoContent {
+			res.Body.Close()
+			return entries, nil
+		}
+		if res.StatusCode != http.StatusOK {
+			return nil, statusError(res, fmt.Sprintf("listing container %s", bucket))
+		}
+
+		var page []listEntry
+		err = json.NewDecoder(res.Body).Decode(&page)
+		res.Body.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "error decoding the listing of container %s", bucket)
+		}
+		if len(page) == 0 {
+			return entries, nil
+		}
+		entries = append(entries, page...)
+		if last := page[len(page)-1]; last.Subdir != "" {
+			marker = last.Subdir
+		} else {
+			marker = last.Name
+		}
+	}
+}
+
+func (o *ObjectStore) ListCommonPrefixes(bucket, prefix, delimiter string) ([]string, error) {
+	entries, err := o.list(bucket, prefix, delimiter)
+	if err != nil {
+		return nil, err
+	}
+	var prefixes []string
+	for _, entry := range entries {
+		if entry.Subdir != "" {
+			prefixes = append(prefixes, entry.Subdir)
+		}
+	}
+	return prefixes, nil
+}
+
+func (o *ObjectStore) ListObjects(bucket, prefix string) ([]string, error) {
+	entries, err := o.list(bucket, prefix, "")
+	if err != nil {
+		return nil, err
+	}
+	var objects []string
+	for _, entry := range entries {
+		objects = append(objects, entry.Name)
+	}
+	return objects, nil
+}
+
+func (o *ObjectStore) DeleteObject(bucket, key string) error {
+	res, err := o.do(http.MethodHead, bucket, key, nil, nil, nil)
+	if err != nil {
+		return errors.Wrapf(err, "error getting object %s", key)
+	}
+	res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf("error getting object %s: %s", key, res.Status)
+	}
+
+	// the segments of the static large objects are deleted along with their manifest
+	var query url.Values
+	header := http.Header{}
+	if strings.EqualFold(res.Header.Get("X-Static-Large-Object"), "true") {
+		query = url.Values{"multipart-manifest": {"delete"}}
+		header.Set("Accept", "application/json")
+	}
+	res, err = o.do(http.MethodDelete, bucket, key, query, header, nil)
+	if err != nil {
+		return errors.Wrapf(err, "error deleting object %s", key)
+	}
+	switch {
+	case res.StatusCode == http.StatusNotFound, res.StatusCode == http.StatusNoContent:
+		res.Body.Close()
+		return nil
+	case res.StatusCode != http.StatusOK:
+		return statusError(res, fmt.Sprintf("deleting object %s", key))
+	}
+	defer res.Body.Close()
+
+	if query == nil {
+		return nil
+	}
+	// the bulk deletion of the segments reports its errors in the body
+	result := struct {
+		Errors [][]string `json:"Errors"`
+	}{}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return errors.Wrapf(err, "error decoding the deletion of object %s", key)
+	}
+	if len(result.Errors) > 0 {
+		return errors.Errorf("error deleting the segments of object %s: %v", key, result.Errors)
+	}
+	return nil
+}
+
+// CreateSignedURL returns a temporary URL of the object, signed with the temporary URL key of
+// the container, or of the account when the container has none.
+func (o *ObjectStore) CreateSignedURL(bucket, key string, ttl time.Duration) (string, error) {
+	tempURLKey, err := o.tempURLKey(bucket)
+	if err != nil {
+		return "", err
+	}
+	t, err := o.getToken(context.Background(), false, tokenExpiryMargin)
+	if err != nil {
+		return "", err
+	}
+	storageURL, err := url.Parse(t.storageURL)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	// the signature is the one of the unescaped path of the object
+	expires := strconv.FormatInt(time.Now().Add(ttl).Unix(), 10)
+	objectURL := *storageURL
+	objectURL.Path = storageURL.Path + "/" + bucket + "/" + key
+	objectURL.RawPath = ""
+
+	mac := hmac.New(sha256.New, []byte(tempURLKey))
+	mac.Write([]byte(http.MethodGet + "\n" + expires + "\n" + objectURL.Path))
+	objectURL.RawQuery = url.Values{
+		"temp_url_sig":     {hex.EncodeToString(mac.Sum(nil))},
+		"temp_url_expires": {expires},
+	}.Encode()
+	return objectURL.String(), nil
+}
+
+// tempURLKey returns the temporary URL key of the container, or of the account.
+func (o *ObjectStore) tempURLKey(bucket string) (string, error) {
+	for _, target := range []struct{ container, header string }{
+		{bucket, "X-Container-Meta-Temp-Url-Key"},
+		{"", "X-Account-Meta-Temp-Url-Key"},
+	} {
+		res, err := o.do(http.MethodHead, target.container, "", nil, nil, nil)
+		if err != nil {
+			return "", errors.Wrap(err, "error getting the temporary URL key")
+		}
+		res.Body.Close()
+		if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent {
+			return "", errors.Errorf("error getting the temporary URL key: %s", res.Status)
+		}
+		if key := res.Header.Get(target.header); key != "" {
+			return key, nil
+		}
+	}
+	return "", errors.Errorf("neither container %s nor the account has a temporary URL key", bucket)
+}
@@ -0,0 +1,360 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package swift
+
+import (
+	"crypto/md5" // #nosec
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+const accountPath = "/v1/AUTH_project"
+
+// fakeSwift is a Keystone v3 and Swift server keeping the objects in memory.
+type fakeSwift struct {
+	*httptest.Server
+	lock       sync.Mutex
+	token      string
+	tokens     int
+	objects    map[string][]byte
+	manifests  map[string][]sloSegment
+	tempURLKey string
+	// the lifetime of the tokens, an hour when not set
+	tokenTTL time.Duration
+	// the manifests of static large objects are refused when set
+	refuseManifests bool
+}
+
+func newFakeSwift(t *testing.T) *fakeSwift {
+	f := &fakeSwift{objects: map[string][]byte{}, manifests: map[string][]sloSegment{}}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(f.Close)
+	return f
+}
+
+func (f *fakeSwift) serve(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if r.URL.Path == "/v3/auth/tokens" {
+		f.tokens++
+		f.token = fmt.Sprintf("token-%d", f.tokens)
+		ttl := f.tokenTTL
+		if ttl == 0 {
+			ttl = time.Hour
+		}
+		w.Header().Set("X-Subject-Token", f.token)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"token":{"expires_at":%q,"catalog":[{"type":"object-store","endpoints":[
+			{"interface":"internal","region":"RegionOne","url":"http://internal%s"},
+			{"interface":"public","region":"RegionOne","url":"%s%s"}]}]}}`,
+			time.Now().Add(ttl).Format(time.RFC3339), accountPath, f.URL, accountPath)
+		return
+	}
+	if r.Header.Get("X-Auth-Token") != f.token {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	name := strings.TrimPrefix(r.URL.Path, accountPath)
+	switch {
+	case name == "":
+		w.Header().Set("X-Account-Meta-Temp-Url-Key", f.tempURLKey)
+		w.WriteHeader(http.StatusNoContent)
+	case !strings.Contains(name[1:], "/"):
+		f.serveContainer(w, r, name[1:])
+	default:
+		f.serveObject(w, r, name)
+	}
+}
+
+func (f *fakeSwift) serveContainer(w http.ResponseWriter, r *http.Request, container string) {
+	switch r.Method {
+	case http.MethodPut:
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodHead:
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodGet:
+		query := r.URL.Query()
+		prefix := "/" + container + "/" + query.Get("prefix")
+		var names []string
+		for name := range f.objects {
+			if strings.HasPrefix(name, prefix) {
+				names = append(names, strings.TrimPrefix(name, "/"+container+"/"))
+			}
+		}
+		sort.Strings(names)
+
+		var entries []listEntry
+		for _, name := range names {
+			entry := listEntry{Name: name}
+			if delimiter := query.Get("delimiter"); delimiter != "" {
+				if i := strings.Index(strings.TrimPrefix(name, query.Get("prefix")), delimiter); i >= 0 {
+					entry = listEntry{Subdir: name[:len(query.Get("prefix"))+i+1]}
+				}
+			}
+			if len(entries) > 0 && entry.Subdir != "" && entries[len(entries)-1].Subdir == entry.Subdir {
+				continue
+			}
+			if marker := query.Get("marker"); marker != "" && entry.Name+entry.Subdir <= marker {
+				continue
+			}
+			entries = append(entries, entry)
+		}
+		// a page has two entries at most
+		if len(entries) > 2 {
+			entries = entries[:2]
+		}
+		if len(entries) == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(entries)
+	}
+}
+
+func (f *fakeSwift) serveObject(w http.ResponseWriter, r *http.Request, name string) {
+	switch r.Method {
+	case http.MethodPut:
+		var data []byte
+		if source := r.Header.Get("X-Copy-From"); source != "" {
+			data = f.objects[source]
+		} else {
+			data, _ = io.ReadAll(r.Body)
+		}
+		if r.URL.Query().Get("multipart-manifest") == "put" {
+			if f.refuseManifests {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			var manifest []sloSegment
+			_ = json.Unmarshal(data, &manifest)
+			data = nil
+			for _, segment := range manifest {
+				data = append(data, f.objects[segment.Path]...)
+			}
+			f.manifests[name] = manifest
+		} else {
+			delete(f.manifests, name)
+		}
+		f.objects[name] = data
+		sum := md5.Sum(data) // #nosec
+		w.Header().Set("Etag", hex.EncodeToString(sum[:]))
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodHead, http.MethodGet:
+		data, found := f.objects[name]
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		manifest, slo := f.manifests[name]
+		if slo {
+			w.Header().Set("X-Static-Large-Object", "True")
+			if r.URL.Query().Get("multipart-manifest") == "get" {
+				_ = json.NewEncoder(w).Encode(manifest)
+				return
+			}
+		}
+		_, _ = w.Write(data)
+	case http.MethodDelete:
+		if _, found := f.objects[name]; !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(f.objects, name)
+		if r.URL.Query().Get("multipart-manifest") != "delete" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		for _, segment := range f.manifests[name] {
+			delete(f.objects, segment.Path)
+		}
+		delete(f.manifests, name)
+		fmt.Fprint(w, `{"Errors":[]}`)
+	}
+}
+
+func newTestObjectStore(t *testing.T, f *fakeSwift, config map[string]string) *ObjectStore {
+	t.Setenv(CredentialKeyAuthURL, f.URL)
+	t.Setenv(CredentialKeyUsername, "velero")
+	t.Setenv(CredentialKeyPassword, "password")
+	t.Setenv(CredentialKeyUserDomainName, "Default")
+	t.Setenv(CredentialKeyProjectName, "velero")
+	t.Setenv(CredentialKeyRegionName, "RegionOne")
+
+	o := NewObjectStore(velerotest.NewLogger())
+	require.NoError(t, o.Init(config))
+	return o
+}
+
+func TestObjectStore(t *testing.T) {
+	f := newFakeSwift(t)
+	o := newTestObjectStore(t, f, map[string]string{"bucket": "velero"})
+
+	require.NoError(t, o.PutObject("velero", "backups/backup-1/velero-backup.json", strings.NewReader("{}")))
+	require.NoError(t, o.PutObject("velero", "backups/backup-2/velero-backup.json", strings.NewReader("{}")))
+	require.NoError(t, o.PutObject("velero", "backups/backup-3/velero-backup.json", strings.NewReader("{}")))
+	require.NoError(t, o.PutObject("velero", "restores/restore 1/restore-logs.gz", strings.NewReader("logs")))
+
+	exists, err := o.ObjectExists("velero", "restores/restore 1/restore-logs.gz")
+	require.NoError(t, err)
+	assert.True(t, exists)
+	exists, err = o.ObjectExists("velero", "restores/restore-2/restore-logs.gz")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	body, err := o.GetObject("velero", "restores/restore 1/restore-logs.gz")
+	require.NoError(t, err)
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+	assert.Equal(t, "logs", string(data))
+
+	// the listings span several pages
+	prefixes, err := o.ListCommonPrefixes("velero", "", "/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backups/", "restores/"}, prefixes)
+	prefixes, err = o.ListCommonPrefixes("velero", "backups/", "/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backups/backup-1/", "backups/backup-2/", "backups/backup-3/"}, prefixes)
+	objects, err := o.ListObjects("velero", "backups/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backups/backup-1/velero-backup.json", "backups/backup-2/velero-backup.json", "backups/backup-3/velero-backup.json"}, objects)
+
+	require.NoError(t, o.DeleteObject("velero", "backups/backup-1/velero-backup.json"))
+	require.NoError(t, o.DeleteObject("velero", "backups/backup-1/velero-backup.json"))
+	assert.NotContains(t, f.objects, "/velero/backups/backup-1/velero-backup.json")
+
+	// the token is renewed once revoked
+	f.token = "revoked"
+	_, err = o.ObjectExists("velero", "backups/backup-2/velero-backup.json")
+	require.NoError(t, err)
+	assert.Equal(t, 2, f.tokens)
+}
+
+// segments returns the names of the segments in the segment container.
+func (f *fakeSwift) segments() []string {
+	var segments []string
+	for name := range f.objects {
+		if strings.HasPrefix(name, "/velero_segments/") {
+			segments = append(segments, name)
+		}
+	}
+	sort.Strings(segments)
+	return segments
+}
+
+func TestObjectStoreLargeObject(t *testing.T) {
+	f := newFakeSwift(t)
+	o := newTestObjectStore(t, f, map[string]string{"bucket": "velero", BSLConfigSegmentSize: "4"})
+
+	require.NoError(t, o.PutObject("velero", "backups/backup-1/backup-1.tar.gz", strings.NewReader("0123456789")))
+	assert.Equal(t, "0123456789", string(f.objects["/velero/backups/backup-1/backup-1.tar.gz"]))
+	manifest := f.manifests["/velero/backups/backup-1/backup-1.tar.gz"]
+	require.Len(t, manifest, 3)
+	for i, size := range []int64{4, 4, 2} {
+		assert.True(t, strings.HasPrefix(manifest[i].Path, "/velero_segments/backups/backup-1/backup-1.tar.gz/slo/"))
+		assert.Equal(t, size, manifest[i].SizeBytes)
+	}
+	assert.Len(t, f.segments(), 3)
+
+	// the segments of the object overwritten are deleted
+	require.NoError(t, o.PutObject("velero", "backups/backup-1/backup-1.tar.gz", strings.NewReader("abcdef")))
+	assert.Equal(t, "abcdef", string(f.objects["/velero/backups/backup-1/backup-1.tar.gz"]))
+	assert.Len(t, f.segments(), 2)
+	require.NoError(t, o.PutObject("velero", "backups/backup-1/backup-1.tar.gz", strings.NewReader("abc")))
+	assert.NotContains(t, f.manifests, "/velero/backups/backup-1/backup-1.tar.gz")
+	assert.Empty(t, f.segments())
+
+	// an object of a single segment isn't segmented
+	require.NoError(t, o.PutObject("velero", "backups/backup-1/velero-backup.json", strings.NewReader("{}")))
+	assert.NotContains(t, f.manifests, "/velero/backups/backup-1/velero-backup.json")
+
+	require.NoError(t, o.PutObject("velero", "backups/backup-1/backup-1.tar.gz", strings.NewReader("0123456789")))
+	require.NoError(t, o.DeleteObject("velero", "backups/backup-1/backup-1.tar.gz"))
+	assert.Empty(t, f.segments())
+}
+
+func TestObjectStoreLargeObjectFailure(t *testing.T) {
+	f := newFakeSwift(t)
+	o := newTestObjectStore(t, f, map[string]string{"bucket": "velero", BSLConfigSegmentSize: "4"})
+	require.NoError(t, o.PutObject("velero", "backups/backup-1/backup-1.tar.gz", strings.NewReader("0123456789")))
+
+	// the segments uploaded are deleted, and the object overwritten is kept
+	f.refuseManifests = true
+	assert.ErrorContains(t, o.PutObject("velero", "backups/backup-1/backup-1.tar.gz", strings.NewReader("abcdef")), "error putting the manifest of object backups/backup-1/backup-1.tar.gz: 400 Bad Request")
+	assert.Equal(t, "0123456789", string(f.objects["/velero/backups/backup-1/backup-1.tar.gz"]))
+	assert.Len(t, f.segments(), 3)
+}
+
+func TestObjectStoreTokenRenewal(t *testing.T) {
+	f := newFakeSwift(t)
+	f.tokenTTL = 10 * time.Minute
+	o := newTestObjectStore(t, f, map[string]string{"bucket": "velero", BSLConfigSegmentSize: "4"})
+
+	// the token is renewed before each segment, which can't be sent again
+	require.NoError(t, o.PutObject("velero", "backups/backup-1/backup-1.tar.gz", strings.NewReader("0123456789")))
+	assert.Equal(t, "0123456789", string(f.objects["/velero/backups/backup-1/backup-1.tar.gz"]))
+	assert.Equal(t, 4, f.tokens)
+
+	// a buffered object is sent again once the token is renewed
+	f.token = "revoked"
+	require.NoError(t, o.PutObject("velero", "backups/backup-1/velero-backup.json", strings.NewReader("{}")))
+	assert.Equal(t, "{}", string(f.objects["/velero/backups/backup-1/velero-backup.json"]))
+}
+
+func TestCreateSignedURL(t *testing.T) {
+	f := newFakeSwift(t)
+	o := newTestObjectStore(t, f, map[string]string{})
+
+	_, err := o.CreateSignedURL("velero", "backups/backup-1/backup-1.tar.gz", time.Hour)
+	require.ErrorContains(t, err, "temporary URL key")
+
+	f.tempURLKey = "secret"
+	signedURL, err := o.CreateSignedURL("velero", "backups/backup 1/backup-1.tar.gz", time.Hour)
+	require.NoError(t, err)
+	u, err := url.Parse(signedURL)
+	require.NoError(t, err)
+	assert.Equal(t, accountPath+"/velero/backups/backup 1/backup-1.tar.gz", u.Path)
+	assert.Len(t, u.Query().Get("temp_url_sig"), 64)
+	assert.NotEmpty(t, u.Query().Get("temp_url_expires"))
+}
+
+func TestInit(t *testing.T) {
+	f := newFakeSwift(t)
+	o := newTestObjectStore(t, f, map[string]string{BSLConfigSegmentSize: "2Gi"})
+	assert.Equal(t, int64(2<<30), o.segmentSize)
+
+	assert.ErrorContains(t, o.Init(map[string]string{BSLConfigSegmentSize: "6Gi"}), "segmentSize must be between 1 and 5Gi")
+
+	t.Setenv(CredentialKeyAuthURL, "")
+	assert.ErrorContains(t, o.Init(map[string]string{}), "authURL")
+}
@@ -0,0 +1,96 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package velero
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// phaseRetryBackoff is the backoff between the re-runs of a failed phase of a backup, long enough
+// for an API server rollout to complete.
+var phaseRetryBackoff = wait.Backoff{
+	Duration: time.Second,
+	Factor:   2,
+	Jitter:   0.1,
+	Cap:      time.Minute,
+}
+
+// PhaseRetries returns how many times the phases of the backup are re-run after they failed.
+func PhaseRetries(backup *velerov1api.Backup) velerov1api.BackupPhaseRetries {
+	if backup == nil || backup.Spec.PhaseRetries == nil {
+		return velerov1api.BackupPhaseRetries{}
+	}
+	return *backup.Spec.PhaseRetries
+}
+
+// IsTransientAPIError returns whether the error returned by the API server is likely to go away
+// when the request is retried, e.g. while the API server is rolled out.
+func IsTransientAPIError(err error) bool {
+	return apierrors.IsServerTimeout(err) ||
+		apierrors.IsTimeout(err) ||
+		apierrors.IsTooManyRequests(err) ||
+		apierrors.IsServiceUnavailable(err) ||
+		apierrors.IsInternalError(err) ||
+		utilnet.IsConnectionRefused(err) ||
+		utilnet.IsConnectionReset(err) ||
+		utilnet.IsProbableEOF(err)
+}
+
+// IsTransientPluginError returns whether the error returned by a plugin is likely to go away when
+// the call is retried: the plugin process being unavailable, a deadline exceeded, or an error the
+// plugin returned with a gRPC status code telling so, besides the transient errors of the API
+// server. The plugins return the other errors with the Unknown code, which aren't retried.
+func IsTransientPluginError(err error) bool {
+	if IsTransientAPIError(err) {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// RetryPhase runs fn, and re-runs it up to retries times while it fails with an error retriable
+// returns true for. fn must be idempotent.
+func RetryPhase(log logrus.FieldLogger, phase string, retries int, retriable func(error) bool, fn func() error) error {
+	backoff := phaseRetryBackoff
+	backoff.Steps = retries + 1
+
+	attempt := 0
+	return retry.OnError(backoff, func(err error) bool {
+		if !retriable(err) || attempt > retries {
+			return false
+		}
+		log.WithError(err).Warnf("The %s phase failed, retrying it (%d/%d)", phase, attempt, retries)
+		return true
+	}, func() error {
+		attempt++
+		return fn()
+	})
+}
@@ -0,0 +1,112 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package velero
+
+import (
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestIsTransientAPIError(t *testing.T) {
+	pods := schema.GroupResource{Resource: "pods"}
+
+	assert.True(t, IsTransientAPIError(apierrors.NewServiceUnavailable("rolling out")))
+	assert.True(t, IsTransientAPIError(apierrors.NewTooManyRequests("throttled", 1)))
+	assert.True(t, IsTransientAPIError(apierrors.NewServerTimeout(pods, "list", 1)))
+	assert.True(t, IsTransientAPIError(errors.Wrap(syscall.ECONNREFUSED, "dial tcp")))
+	assert.False(t, IsTransientAPIError(apierrors.NewForbidden(pods, "", errors.New("denied"))))
+	assert.False(t, IsTransientAPIError(apierrors.NewNotFound(pods, "pod")))
+}
+
+func TestIsTransientPluginError(t *testing.T) {
+	assert.True(t, IsTransientPluginError(apierrors.NewServiceUnavailable("rolling out")))
+	assert.True(t, IsTransientPluginError(status.Error(codes.Unavailable, "plugin exited")))
+	assert.True(t, IsTransientPluginError(status.Error(codes.ResourceExhausted, "rate limited")))
+	assert.False(t, IsTransientPluginError(status.Error(codes.Unknown, "volume not found")))
+	assert.False(t, IsTransientPluginError(errors.New("volume not found")))
+}
+
+func TestRetryPhase(t *testing.T) {
+	backoff := phaseRetryBackoff
+	phaseRetryBackoff.Duration = time.Millisecond
+	defer func() { phaseRetryBackoff = backoff }()
+
+	transient := apierrors.NewServiceUnavailable("rolling out")
+	tests := []struct {
+		name        string
+		retries     int
+		errs        []error
+		expectErr   error
+		expectCalls int
+	}{
+		{
+			name:        "succeeds",
+			retries:     2,
+			errs:        []error{nil},
+			expectCalls: 1,
+		},
+		{
+			name:        "no retry",
+			errs:        []error{transient},
+			expectErr:   transient,
+			expectCalls: 1,
+		},
+		{
+			name:        "succeeds after retries",
+			retries:     2,
+			errs:        []error{transient, transient, nil},
+			expectCalls: 3,
+		},
+		{
+			name:        "retries exhausted",
+			retries:     2,
+			errs:        []error{transient, transient, transient, nil},
+			expectErr:   transient,
+			expectCalls: 3,
+		},
+		{
+			name:        "non transient error",
+			retries:     2,
+			errs:        []error{apierrors.NewNotFound(schema.GroupResource{Resource: "pods"}, "pod"), nil},
+			expectErr:   apierrors.NewNotFound(schema.GroupResource{Resource: "pods"}, "pod"),
+			expectCalls: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			calls := 0
+			err := RetryPhase(velerotest.NewLogger(), "item collection", test.retries, IsTransientAPIError, func() error {
+				err := test.errs[calls]
+				calls++
+				return err
+			})
+			assert.Equal(t, test.expectErr, err)
+			assert.Equal(t, test.expectCalls, calls)
+		})
+	}
+}
@@ -5,7 +5,8 @@ import (
 )
 
 func IsResourcePolicyValid(resourcePolicy string) bool {
-	if resourcePolicy == string(api.PolicyTypeNone) || resourcePolicy == string(api.PolicyTypeUpdate) {
+	if resourcePolicy == string(api.PolicyTypeNone) || resourcePolicy == string(api.PolicyTypeUpdate) ||
+		resourcePolicy == string(api.PolicyTypeReconcile) || resourcePolicy == string(api.PolicyTypeSSA) {
 		return true
 	}
 	return false
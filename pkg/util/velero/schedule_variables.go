@@ -0,0 +1,162 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package velero
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// scheduleVariable matches the variables, e.g. ${date}, in the labels, annotations and storage
+// location of the backups of the schedules.
+var scheduleVariable = regexp.MustCompile(`\$\{([A-Za-z0-9_.-]+)\}`)
+
+// ScheduleVariables returns the variables of the backup of the schedule created at timestamp, or
+// nil when the schedule has no variables ConfigMap, the expansion being opt-in so that the labels
+// and annotations of the existing schedules are kept as they are. The variables are the data of
+// the ConfigMap, the date of the backup in the time zone of the schedule, the name of the schedule,
+// and the name of the cluster, which defaults to the cluster of the v2 storage layout of the
+// storage location of the schedule when the ConfigMap doesn't set it.
+func ScheduleVariables(ctx context.Context, client kbclient.Client, schedule *velerov1api.Schedule, timestamp time.Time) (map[string]string, error) {
+	if schedule.Spec.VariablesConfigMap == "" {
+		return nil, nil
+	}
+
+	configMap := new(corev1api.ConfigMap)
+	if err := client.Get(ctx, kbclient.ObjectKey{Namespace: schedule.Namespace, Name: schedule.Spec.VariablesConfigMap}, configMap); err != nil {
+		return nil, errors.Wrapf(err, "error getting the variables ConfigMap %s", schedule.Spec.VariablesConfigMap)
+	}
+	variables := make(map[string]string, len(configMap.Data)+3)
+	for name, value := range configMap.Data {
+		variables[name] = value
+	}
+	if _, ok := variables["clusterName"]; !ok {
+		clusterName, err := layoutClusterName(ctx, client, schedule)
+		if err != nil {
+			return nil, err
+		}
+		if clusterName != "" {
+			variables["clusterName"] = clusterName
+		}
+	}
+
+	if schedule.Spec.Timezone != "" {
+		location, err := time.LoadLocation(schedule.Spec.Timezone)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid timezone %q", schedule.Spec.Timezone)
+		}
+		timestamp = timestamp.In(location)
+	}
+	// the built-in variables take precedence over the ConfigMap
+	variables["date"] = timestamp.Format("2006-01-02")
+	variables["schedule"] = schedule.Name
+	return variables, nil
+}
+
+// layoutClusterName returns the cluster of the v2 storage layout of the storage location of the
+// schedule, or of the default location, or an empty string.
+func layoutClusterName(ctx context.Context, client kbclient.Client, schedule *velerov1api.Schedule) (string, error) {
+	var location *velerov1api.BackupStorageLocation
+	if name := schedule.Spec.Template.StorageLocation; name != "" && !scheduleVariable.MatchString(name) {
+		location = new(velerov1api.BackupStorageLocation)
+		if err := client.Get(ctx, kbclient.ObjectKey{Namespace: schedule.Namespace, Name: name}, location); err != nil {
+			if apierrors.IsNotFound(err) {
+				return "", nil
+			}
+			return "", errors.Wrapf(err, "error getting the backup storage location %s", name)
+		}
+	} else {
+		locations := new(velerov1api.BackupStorageLocationList)
+		if err := client.List(ctx, locations, kbclient.InNamespace(schedule.Namespace)); err != nil {
+			return "", errors.Wrap(err, "error listing the backup storage locations")
+		}
+		for i := range locations.Items {
+			if locations.Items[i].Spec.Default {
+				location = &locations.Items[i]
+				break
+			}
+		}
+	}
+
+	if location == nil || location.Spec.StorageLayout == nil || location.Spec.StorageLayout.Version != velerov1api.StorageLayoutV2 {
+		return "", nil
+	}
+	return location.Spec.StorageLayout.Cluster, nil
+}
+
+// ExpandScheduleVariables expands the variables in the values of the labels and annotations of the
+// backup, and in the name of its storage location, e.g. to store the backups of each cluster under
+// the prefix of its own location. It does nothing without variables. It returns an error if a
+// variable isn't defined, or if an expanded label value or location name is invalid.
+func ExpandScheduleVariables(backup *velerov1api.Backup, variables map[string]string) error {
+	if variables == nil {
+		return nil
+	}
+
+	var undefined []string
+	expand := func(value string) string {
+		return scheduleVariable.ReplaceAllStringFunc(value, func(variable string) string {
+			name := scheduleVariable.FindStringSubmatch(variable)[1]
+			expanded, ok := variables[name]
+			if !ok {
+				undefined = append(undefined, name)
+			}
+			return expanded
+		})
+	}
+
+	// the maps may be shared with the schedule, so they're replaced rather than updated
+	labels := make(map[string]string, len(backup.Labels))
+	for key, value := range backup.Labels {
+		expanded := expand(value)
+		if errs := validation.IsValidLabelValue(expanded); expanded != value && len(errs) > 0 {
+			return errors.Errorf("invalid value %q of label %s once expanded: %s", expanded, key, strings.Join(errs, "; "))
+		}
+		labels[key] = expanded
+	}
+	annotations := make(map[string]string, len(backup.Annotations))
+	for key, value := range backup.Annotations {
+		annotations[key] = expand(value)
+	}
+
+	storageLocation := expand(backup.Spec.StorageLocation)
+
+	if len(undefined) > 0 {
+		return errors.Errorf("undefined variables %s", strings.Join(undefined, ", "))
+	}
+	if errs := validation.IsDNS1123Subdomain(storageLocation); storageLocation != backup.Spec.StorageLocation && len(errs) > 0 {
+		return errors.Errorf("invalid storage location %q once expanded: %s", storageLocation, strings.Join(errs, "; "))
+	}
+	backup.Spec.StorageLocation = storageLocation
+	if backup.Labels != nil {
+		backup.Labels = labels
+	}
+	if backup.Annotations != nil {
+		backup.Annotations = annotations
+	}
+	return nil
+}
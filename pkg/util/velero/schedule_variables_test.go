@@ -0,0 +1,100 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package velero
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestScheduleVariables(t *testing.T) {
+	configMap := builder.ForConfigMap("velero", "variables").Data("clusterName", "prod-eu", "gitSHA", "4f2a9c1", "date", "ignored").Result()
+	noClusterName := builder.ForConfigMap("velero", "no-cluster-name").Data("gitSHA", "4f2a9c1").Result()
+	location := builder.ForBackupStorageLocation("velero", "default").Default(true).Result()
+	location.Spec.StorageLayout = &velerov1api.StorageLayout{Version: velerov1api.StorageLayoutV2, Cluster: "prod-us"}
+	client := velerotest.NewFakeControllerRuntimeClient(t, configMap, noClusterName, location)
+	// 23:30 UTC is already the next day in Sydney
+	timestamp := time.Date(2026, 3, 1, 23, 30, 0, 0, time.UTC)
+
+	// the variables are only expanded with a variables ConfigMap
+	schedule := builder.ForSchedule("velero", "daily").Result()
+	variables, err := ScheduleVariables(context.TODO(), client, schedule, timestamp)
+	require.NoError(t, err)
+	assert.Nil(t, variables)
+
+	schedule.Spec.VariablesConfigMap = "variables"
+	schedule.Spec.Timezone = "Australia/Sydney"
+	variables, err = ScheduleVariables(context.TODO(), client, schedule, timestamp)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"date": "2026-03-02", "schedule": "daily", "clusterName": "prod-eu", "gitSHA": "4f2a9c1"}, variables)
+
+	// the name of the cluster defaults to the cluster of the storage layout of the location
+	schedule.Spec.VariablesConfigMap = "no-cluster-name"
+	variables, err = ScheduleVariables(context.TODO(), client, schedule, timestamp)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"date": "2026-03-02", "schedule": "daily", "clusterName": "prod-us", "gitSHA": "4f2a9c1"}, variables)
+
+	schedule.Spec.Template.StorageLocation = "missing"
+	variables, err = ScheduleVariables(context.TODO(), client, schedule, timestamp)
+	require.NoError(t, err)
+	assert.NotContains(t, variables, "clusterName")
+
+	schedule.Spec.VariablesConfigMap = "missing"
+	_, err = ScheduleVariables(context.TODO(), client, schedule, timestamp)
+	assert.Error(t, err)
+}
+
+func TestExpandScheduleVariables(t *testing.T) {
+	variables := map[string]string{"date": "2026-03-02", "clusterName": "prod-eu", "gitSHA": "4f2a9c1"}
+
+	schedule := builder.ForSchedule("velero", "daily").ObjectMeta(
+		builder.WithLabels("day", "${date}", "team", "payments"),
+		builder.WithAnnotations("origin", "${clusterName}@${gitSHA}"),
+	).Result()
+	backup := builder.ForBackup("velero", "daily-20260302000000").FromSchedule(schedule).Result()
+	require.NoError(t, ExpandScheduleVariables(backup, variables))
+	assert.Equal(t, map[string]string{"day": "2026-03-02", "team": "payments", velerov1api.ScheduleNameLabel: "daily"}, backup.Labels)
+	assert.Equal(t, map[string]string{"origin": "prod-eu@4f2a9c1"}, backup.Annotations)
+	// the labels of the schedule are kept as they are
+	assert.Equal(t, "${date}", schedule.Labels["day"])
+
+	backup = builder.ForBackup("velero", "daily").ObjectMeta(builder.WithLabels("release", "${version}")).Result()
+	assert.EqualError(t, ExpandScheduleVariables(backup, variables), "undefined variables version")
+
+	backup = builder.ForBackup("velero", "daily").ObjectMeta(builder.WithLabels("origin", "${clusterName}@${gitSHA}")).Result()
+	assert.ErrorContains(t, ExpandScheduleVariables(backup, variables), `invalid value "prod-eu@4f2a9c1" of label origin once expanded`)
+
+	backup = builder.ForBackup("velero", "daily").StorageLocation("${clusterName}-backups").Result()
+	require.NoError(t, ExpandScheduleVariables(backup, variables))
+	assert.Equal(t, "prod-eu-backups", backup.Spec.StorageLocation)
+
+	backup = builder.ForBackup("velero", "daily").StorageLocation("${clusterName}@${gitSHA}").Result()
+	assert.ErrorContains(t, ExpandScheduleVariables(backup, variables), `invalid storage location "prod-eu@4f2a9c1" once expanded`)
+
+	// nothing is expanded without variables
+	backup = builder.ForBackup("velero", "daily").ObjectMeta(builder.WithLabels("day", "${date}")).Result()
+	require.NoError(t, ExpandScheduleVariables(backup, nil))
+	assert.Equal(t, "${date}", backup.Labels["day"])
+}